@@ -239,6 +239,12 @@ type Interface interface {
 		Request *admin.UpdateOperationalDynamicConfigRequest,
 		opts ...yarpc.CallOption,
 	) error
+
+	UpsertWorkflowSearchAttributes(
+		ctx context.Context,
+		Request *shared.UpsertWorkflowSearchAttributesRequest,
+		opts ...yarpc.CallOption,
+	) error
 }
 
 // New builds a new client for the AdminService service.
@@ -1304,3 +1310,31 @@ func (c client) UpdateOperationalDynamicConfig(
 	err = admin.AdminService_UpdateOperationalDynamicConfig_Helper.UnwrapResponse(&result)
 	return
 }
+
+func (c client) UpsertWorkflowSearchAttributes(
+	ctx context.Context,
+	_Request *shared.UpsertWorkflowSearchAttributesRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	var result admin.AdminService_UpsertWorkflowSearchAttributes_Result
+	args := admin.AdminService_UpsertWorkflowSearchAttributes_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	err = admin.AdminService_UpsertWorkflowSearchAttributes_Helper.UnwrapResponse(&result)
+	return
+}
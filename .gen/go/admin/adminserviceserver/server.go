@@ -202,6 +202,11 @@ type Interface interface {
 		ctx context.Context,
 		Request *admin.UpdateOperationalDynamicConfigRequest,
 	) error
+
+	UpsertWorkflowSearchAttributes(
+		ctx context.Context,
+		Request *shared.UpsertWorkflowSearchAttributesRequest,
+	) error
 }
 
 // New prepares an implementation of the AdminService service for
@@ -658,10 +663,22 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				Signature:    "UpdateOperationalDynamicConfig(Request *admin.UpdateOperationalDynamicConfigRequest)",
 				ThriftModule: admin.ThriftModule,
 			},
+
+			thrift.Method{
+				Name: "UpsertWorkflowSearchAttributes",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.UpsertWorkflowSearchAttributes),
+					NoWire: upsertworkflowsearchattributes_NoWireHandler{impl},
+				},
+				Signature:    "UpsertWorkflowSearchAttributes(Request *shared.UpsertWorkflowSearchAttributesRequest)",
+				ThriftModule: admin.ThriftModule,
+			},
 		},
 	}
 
-	procedures := make([]transport.Procedure, 0, 37)
+	procedures := make([]transport.Procedure, 0, 38)
 	procedures = append(procedures, thrift.BuildProcedures(service, opts...)...)
 	return procedures
 }
@@ -1782,6 +1799,36 @@ func (h handler) UpdateOperationalDynamicConfig(ctx context.Context, body wire.V
 	return response, err
 }
 
+func (h handler) UpsertWorkflowSearchAttributes(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_UpsertWorkflowSearchAttributes_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'AdminService' procedure 'UpsertWorkflowSearchAttributes': %w", err)
+	}
+
+	appErr := h.impl.UpsertWorkflowSearchAttributes(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_UpsertWorkflowSearchAttributes_Helper.WrapResponse(appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
 type addsearchattribute_NoWireHandler struct{ impl Interface }
 
 func (h addsearchattribute_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
@@ -3150,3 +3197,40 @@ func (h updateoperationaldynamicconfig_NoWireHandler) HandleNoWire(ctx context.C
 	return response, err
 
 }
+
+type upsertworkflowsearchattributes_NoWireHandler struct{ impl Interface }
+
+func (h upsertworkflowsearchattributes_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args admin.AdminService_UpsertWorkflowSearchAttributes_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'AdminService' procedure 'UpsertWorkflowSearchAttributes': %w", err)
+	}
+
+	appErr := h.impl.UpsertWorkflowSearchAttributes(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_UpsertWorkflowSearchAttributes_Helper.WrapResponse(appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
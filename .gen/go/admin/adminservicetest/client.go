@@ -1240,3 +1240,34 @@ func (mr *_MockClientRecorder) UpdateOperationalDynamicConfig(
 	args := append([]interface{}{ctx, _Request}, opts...)
 	return mr.mock.ctrl.RecordCall(mr.mock, "UpdateOperationalDynamicConfig", args...)
 }
+
+// UpsertWorkflowSearchAttributes responds to a UpsertWorkflowSearchAttributes call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().UpsertWorkflowSearchAttributes(gomock.Any(), ...).Return(...)
+//	... := client.UpsertWorkflowSearchAttributes(...)
+func (m *MockClient) UpsertWorkflowSearchAttributes(
+	ctx context.Context,
+	_Request *shared.UpsertWorkflowSearchAttributesRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "UpsertWorkflowSearchAttributes", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) UpsertWorkflowSearchAttributes(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "UpsertWorkflowSearchAttributes", args...)
+}
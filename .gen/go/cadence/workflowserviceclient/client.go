@@ -18,6 +18,12 @@ import (
 
 // Interface is a client for the WorkflowService service.
 type Interface interface {
+	AggregateWorkflowExecutions(
+		ctx context.Context,
+		AggregateRequest *shared.AggregateWorkflowExecutionsRequest,
+		opts ...yarpc.CallOption,
+	) (*shared.AggregateWorkflowExecutionsResponse, error)
+
 	BackfillSchedule(
 		ctx context.Context,
 		Request *shared.BackfillScheduleRequest,
@@ -36,6 +42,12 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) (*shared.CreateScheduleResponse, error)
 
+	CreateSemaphore(
+		ctx context.Context,
+		Request *shared.CreateSemaphoreRequest,
+		opts ...yarpc.CallOption,
+	) (*shared.CreateSemaphoreResponse, error)
+
 	DeleteDomain(
 		ctx context.Context,
 		DeleteRequest *shared.DeleteDomainRequest,
@@ -48,6 +60,12 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) (*shared.DeleteScheduleResponse, error)
 
+	DeleteWorkflowExecution(
+		ctx context.Context,
+		DeleteRequest *shared.DeleteWorkflowExecutionRequest,
+		opts ...yarpc.CallOption,
+	) error
+
 	DeprecateDomain(
 		ctx context.Context,
 		DeprecateRequest *shared.DeprecateDomainRequest,
@@ -66,6 +84,12 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) (*shared.DescribeScheduleResponse, error)
 
+	DescribeSemaphore(
+		ctx context.Context,
+		Request *shared.DescribeSemaphoreRequest,
+		opts ...yarpc.CallOption,
+	) (*shared.DescribeSemaphoreResponse, error)
+
 	DescribeTaskList(
 		ctx context.Context,
 		Request *shared.DescribeTaskListRequest,
@@ -148,6 +172,12 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) (*shared.ListSchedulesResponse, error)
 
+	ListSemaphores(
+		ctx context.Context,
+		Request *shared.ListSemaphoresRequest,
+		opts ...yarpc.CallOption,
+	) (*shared.ListSemaphoresResponse, error)
+
 	ListTaskListPartitions(
 		ctx context.Context,
 		Request *shared.ListTaskListPartitionsRequest,
@@ -160,12 +190,24 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) (*shared.ListWorkflowExecutionsResponse, error)
 
+	PauseActivity(
+		ctx context.Context,
+		PauseRequest *shared.PauseActivityRequest,
+		opts ...yarpc.CallOption,
+	) error
+
 	PauseSchedule(
 		ctx context.Context,
 		Request *shared.PauseScheduleRequest,
 		opts ...yarpc.CallOption,
 	) (*shared.PauseScheduleResponse, error)
 
+	PauseWorkflowExecution(
+		ctx context.Context,
+		PauseRequest *shared.PauseWorkflowExecutionRequest,
+		opts ...yarpc.CallOption,
+	) error
+
 	PollForActivityTask(
 		ctx context.Context,
 		PollRequest *shared.PollForActivityTaskRequest,
@@ -214,6 +256,12 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) error
 
+	ResetActivityAttempt(
+		ctx context.Context,
+		ResetRequest *shared.ResetActivityAttemptRequest,
+		opts ...yarpc.CallOption,
+	) error
+
 	ResetStickyTaskList(
 		ctx context.Context,
 		ResetRequest *shared.ResetStickyTaskListRequest,
@@ -286,6 +334,12 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) (*shared.RestartWorkflowExecutionResponse, error)
 
+	RetryActivityNow(
+		ctx context.Context,
+		RetryRequest *shared.RetryActivityNowRequest,
+		opts ...yarpc.CallOption,
+	) error
+
 	ScanWorkflowExecutions(
 		ctx context.Context,
 		ListRequest *shared.ListWorkflowExecutionsRequest,
@@ -328,12 +382,30 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) error
 
+	TriggerSchedule(
+		ctx context.Context,
+		Request *shared.TriggerScheduleRequest,
+		opts ...yarpc.CallOption,
+	) (*shared.TriggerScheduleResponse, error)
+
+	UnpauseActivity(
+		ctx context.Context,
+		UnpauseRequest *shared.UnpauseActivityRequest,
+		opts ...yarpc.CallOption,
+	) error
+
 	UnpauseSchedule(
 		ctx context.Context,
 		Request *shared.UnpauseScheduleRequest,
 		opts ...yarpc.CallOption,
 	) (*shared.UnpauseScheduleResponse, error)
 
+	UnpauseWorkflowExecution(
+		ctx context.Context,
+		UnpauseRequest *shared.UnpauseWorkflowExecutionRequest,
+		opts ...yarpc.CallOption,
+	) error
+
 	UpdateDomain(
 		ctx context.Context,
 		UpdateRequest *shared.UpdateDomainRequest,
@@ -345,6 +417,12 @@ type Interface interface {
 		Request *shared.UpdateScheduleRequest,
 		opts ...yarpc.CallOption,
 	) (*shared.UpdateScheduleResponse, error)
+
+	UpdateWorkflowExecution(
+		ctx context.Context,
+		UpdateRequest *shared.UpdateWorkflowExecutionRequest,
+		opts ...yarpc.CallOption,
+	) (*shared.UpdateWorkflowExecutionResponse, error)
 }
 
 // New builds a new client for the WorkflowService service.
@@ -376,6 +454,34 @@ type client struct {
 	nwc thrift.NoWireClient
 }
 
+func (c client) AggregateWorkflowExecutions(
+	ctx context.Context,
+	_AggregateRequest *shared.AggregateWorkflowExecutionsRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.AggregateWorkflowExecutionsResponse, err error) {
+
+	var result cadence.WorkflowService_AggregateWorkflowExecutions_Result
+	args := cadence.WorkflowService_AggregateWorkflowExecutions_Helper.Args(_AggregateRequest)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	success, err = cadence.WorkflowService_AggregateWorkflowExecutions_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) BackfillSchedule(
 	ctx context.Context,
 	_Request *shared.BackfillScheduleRequest,
@@ -460,6 +566,34 @@ func (c client) CreateSchedule(
 	return
 }
 
+func (c client) CreateSemaphore(
+	ctx context.Context,
+	_Request *shared.CreateSemaphoreRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.CreateSemaphoreResponse, err error) {
+
+	var result cadence.WorkflowService_CreateSemaphore_Result
+	args := cadence.WorkflowService_CreateSemaphore_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	success, err = cadence.WorkflowService_CreateSemaphore_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) DeleteDomain(
 	ctx context.Context,
 	_DeleteRequest *shared.DeleteDomainRequest,
@@ -516,6 +650,34 @@ func (c client) DeleteSchedule(
 	return
 }
 
+func (c client) DeleteWorkflowExecution(
+	ctx context.Context,
+	_DeleteRequest *shared.DeleteWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	var result cadence.WorkflowService_DeleteWorkflowExecution_Result
+	args := cadence.WorkflowService_DeleteWorkflowExecution_Helper.Args(_DeleteRequest)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	err = cadence.WorkflowService_DeleteWorkflowExecution_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) DeprecateDomain(
 	ctx context.Context,
 	_DeprecateRequest *shared.DeprecateDomainRequest,
@@ -600,6 +762,34 @@ func (c client) DescribeSchedule(
 	return
 }
 
+func (c client) DescribeSemaphore(
+	ctx context.Context,
+	_Request *shared.DescribeSemaphoreRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.DescribeSemaphoreResponse, err error) {
+
+	var result cadence.WorkflowService_DescribeSemaphore_Result
+	args := cadence.WorkflowService_DescribeSemaphore_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	success, err = cadence.WorkflowService_DescribeSemaphore_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) DescribeTaskList(
 	ctx context.Context,
 	_Request *shared.DescribeTaskListRequest,
@@ -990,6 +1180,34 @@ func (c client) ListSchedules(
 	return
 }
 
+func (c client) ListSemaphores(
+	ctx context.Context,
+	_Request *shared.ListSemaphoresRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.ListSemaphoresResponse, err error) {
+
+	var result cadence.WorkflowService_ListSemaphores_Result
+	args := cadence.WorkflowService_ListSemaphores_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	success, err = cadence.WorkflowService_ListSemaphores_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) ListTaskListPartitions(
 	ctx context.Context,
 	_Request *shared.ListTaskListPartitionsRequest,
@@ -1046,6 +1264,34 @@ func (c client) ListWorkflowExecutions(
 	return
 }
 
+func (c client) PauseActivity(
+	ctx context.Context,
+	_PauseRequest *shared.PauseActivityRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	var result cadence.WorkflowService_PauseActivity_Result
+	args := cadence.WorkflowService_PauseActivity_Helper.Args(_PauseRequest)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	err = cadence.WorkflowService_PauseActivity_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) PauseSchedule(
 	ctx context.Context,
 	_Request *shared.PauseScheduleRequest,
@@ -1074,6 +1320,34 @@ func (c client) PauseSchedule(
 	return
 }
 
+func (c client) PauseWorkflowExecution(
+	ctx context.Context,
+	_PauseRequest *shared.PauseWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	var result cadence.WorkflowService_PauseWorkflowExecution_Result
+	args := cadence.WorkflowService_PauseWorkflowExecution_Helper.Args(_PauseRequest)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	err = cadence.WorkflowService_PauseWorkflowExecution_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) PollForActivityTask(
 	ctx context.Context,
 	_PollRequest *shared.PollForActivityTaskRequest,
@@ -1298,6 +1572,34 @@ func (c client) RequestCancelWorkflowExecution(
 	return
 }
 
+func (c client) ResetActivityAttempt(
+	ctx context.Context,
+	_ResetRequest *shared.ResetActivityAttemptRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	var result cadence.WorkflowService_ResetActivityAttempt_Result
+	args := cadence.WorkflowService_ResetActivityAttempt_Helper.Args(_ResetRequest)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	err = cadence.WorkflowService_ResetActivityAttempt_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) ResetStickyTaskList(
 	ctx context.Context,
 	_ResetRequest *shared.ResetStickyTaskListRequest,
@@ -1634,6 +1936,34 @@ func (c client) RestartWorkflowExecution(
 	return
 }
 
+func (c client) RetryActivityNow(
+	ctx context.Context,
+	_RetryRequest *shared.RetryActivityNowRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	var result cadence.WorkflowService_RetryActivityNow_Result
+	args := cadence.WorkflowService_RetryActivityNow_Helper.Args(_RetryRequest)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	err = cadence.WorkflowService_RetryActivityNow_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) ScanWorkflowExecutions(
 	ctx context.Context,
 	_ListRequest *shared.ListWorkflowExecutionsRequest,
@@ -1830,6 +2160,62 @@ func (c client) TerminateWorkflowExecution(
 	return
 }
 
+func (c client) TriggerSchedule(
+	ctx context.Context,
+	_Request *shared.TriggerScheduleRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.TriggerScheduleResponse, err error) {
+
+	var result cadence.WorkflowService_TriggerSchedule_Result
+	args := cadence.WorkflowService_TriggerSchedule_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	success, err = cadence.WorkflowService_TriggerSchedule_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) UnpauseActivity(
+	ctx context.Context,
+	_UnpauseRequest *shared.UnpauseActivityRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	var result cadence.WorkflowService_UnpauseActivity_Result
+	args := cadence.WorkflowService_UnpauseActivity_Helper.Args(_UnpauseRequest)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	err = cadence.WorkflowService_UnpauseActivity_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) UnpauseSchedule(
 	ctx context.Context,
 	_Request *shared.UnpauseScheduleRequest,
@@ -1858,6 +2244,34 @@ func (c client) UnpauseSchedule(
 	return
 }
 
+func (c client) UnpauseWorkflowExecution(
+	ctx context.Context,
+	_UnpauseRequest *shared.UnpauseWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	var result cadence.WorkflowService_UnpauseWorkflowExecution_Result
+	args := cadence.WorkflowService_UnpauseWorkflowExecution_Helper.Args(_UnpauseRequest)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	err = cadence.WorkflowService_UnpauseWorkflowExecution_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) UpdateDomain(
 	ctx context.Context,
 	_UpdateRequest *shared.UpdateDomainRequest,
@@ -1913,3 +2327,31 @@ func (c client) UpdateSchedule(
 	success, err = cadence.WorkflowService_UpdateSchedule_Helper.UnwrapResponse(&result)
 	return
 }
+
+func (c client) UpdateWorkflowExecution(
+	ctx context.Context,
+	_UpdateRequest *shared.UpdateWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.UpdateWorkflowExecutionResponse, err error) {
+
+	var result cadence.WorkflowService_UpdateWorkflowExecution_Result
+	args := cadence.WorkflowService_UpdateWorkflowExecution_Helper.Args(_UpdateRequest)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	success, err = cadence.WorkflowService_UpdateWorkflowExecution_Helper.UnwrapResponse(&result)
+	return
+}
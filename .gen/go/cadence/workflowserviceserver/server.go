@@ -18,6 +18,11 @@ import (
 
 // Interface is the server-side interface for the WorkflowService service.
 type Interface interface {
+	AggregateWorkflowExecutions(
+		ctx context.Context,
+		AggregateRequest *shared.AggregateWorkflowExecutionsRequest,
+	) (*shared.AggregateWorkflowExecutionsResponse, error)
+
 	BackfillSchedule(
 		ctx context.Context,
 		Request *shared.BackfillScheduleRequest,
@@ -33,6 +38,11 @@ type Interface interface {
 		Request *shared.CreateScheduleRequest,
 	) (*shared.CreateScheduleResponse, error)
 
+	CreateSemaphore(
+		ctx context.Context,
+		Request *shared.CreateSemaphoreRequest,
+	) (*shared.CreateSemaphoreResponse, error)
+
 	DeleteDomain(
 		ctx context.Context,
 		DeleteRequest *shared.DeleteDomainRequest,
@@ -43,6 +53,11 @@ type Interface interface {
 		Request *shared.DeleteScheduleRequest,
 	) (*shared.DeleteScheduleResponse, error)
 
+	DeleteWorkflowExecution(
+		ctx context.Context,
+		DeleteRequest *shared.DeleteWorkflowExecutionRequest,
+	) error
+
 	DeprecateDomain(
 		ctx context.Context,
 		DeprecateRequest *shared.DeprecateDomainRequest,
@@ -58,6 +73,11 @@ type Interface interface {
 		Request *shared.DescribeScheduleRequest,
 	) (*shared.DescribeScheduleResponse, error)
 
+	DescribeSemaphore(
+		ctx context.Context,
+		Request *shared.DescribeSemaphoreRequest,
+	) (*shared.DescribeSemaphoreResponse, error)
+
 	DescribeTaskList(
 		ctx context.Context,
 		Request *shared.DescribeTaskListRequest,
@@ -126,6 +146,11 @@ type Interface interface {
 		Request *shared.ListSchedulesRequest,
 	) (*shared.ListSchedulesResponse, error)
 
+	ListSemaphores(
+		ctx context.Context,
+		Request *shared.ListSemaphoresRequest,
+	) (*shared.ListSemaphoresResponse, error)
+
 	ListTaskListPartitions(
 		ctx context.Context,
 		Request *shared.ListTaskListPartitionsRequest,
@@ -136,11 +161,21 @@ type Interface interface {
 		ListRequest *shared.ListWorkflowExecutionsRequest,
 	) (*shared.ListWorkflowExecutionsResponse, error)
 
+	PauseActivity(
+		ctx context.Context,
+		PauseRequest *shared.PauseActivityRequest,
+	) error
+
 	PauseSchedule(
 		ctx context.Context,
 		Request *shared.PauseScheduleRequest,
 	) (*shared.PauseScheduleResponse, error)
 
+	PauseWorkflowExecution(
+		ctx context.Context,
+		PauseRequest *shared.PauseWorkflowExecutionRequest,
+	) error
+
 	PollForActivityTask(
 		ctx context.Context,
 		PollRequest *shared.PollForActivityTaskRequest,
@@ -181,6 +216,11 @@ type Interface interface {
 		CancelRequest *shared.RequestCancelWorkflowExecutionRequest,
 	) error
 
+	ResetActivityAttempt(
+		ctx context.Context,
+		ResetRequest *shared.ResetActivityAttemptRequest,
+	) error
+
 	ResetStickyTaskList(
 		ctx context.Context,
 		ResetRequest *shared.ResetStickyTaskListRequest,
@@ -241,6 +281,11 @@ type Interface interface {
 		RestartRequest *shared.RestartWorkflowExecutionRequest,
 	) (*shared.RestartWorkflowExecutionResponse, error)
 
+	RetryActivityNow(
+		ctx context.Context,
+		RetryRequest *shared.RetryActivityNowRequest,
+	) error
+
 	ScanWorkflowExecutions(
 		ctx context.Context,
 		ListRequest *shared.ListWorkflowExecutionsRequest,
@@ -276,11 +321,26 @@ type Interface interface {
 		TerminateRequest *shared.TerminateWorkflowExecutionRequest,
 	) error
 
+	TriggerSchedule(
+		ctx context.Context,
+		Request *shared.TriggerScheduleRequest,
+	) (*shared.TriggerScheduleResponse, error)
+
+	UnpauseActivity(
+		ctx context.Context,
+		UnpauseRequest *shared.UnpauseActivityRequest,
+	) error
+
 	UnpauseSchedule(
 		ctx context.Context,
 		Request *shared.UnpauseScheduleRequest,
 	) (*shared.UnpauseScheduleResponse, error)
 
+	UnpauseWorkflowExecution(
+		ctx context.Context,
+		UnpauseRequest *shared.UnpauseWorkflowExecutionRequest,
+	) error
+
 	UpdateDomain(
 		ctx context.Context,
 		UpdateRequest *shared.UpdateDomainRequest,
@@ -290,6 +350,11 @@ type Interface interface {
 		ctx context.Context,
 		Request *shared.UpdateScheduleRequest,
 	) (*shared.UpdateScheduleResponse, error)
+
+	UpdateWorkflowExecution(
+		ctx context.Context,
+		UpdateRequest *shared.UpdateWorkflowExecutionRequest,
+	) (*shared.UpdateWorkflowExecutionResponse, error)
 }
 
 // New prepares an implementation of the WorkflowService service for
@@ -303,6 +368,18 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 		Name: "WorkflowService",
 		Methods: []thrift.Method{
 
+			thrift.Method{
+				Name: "AggregateWorkflowExecutions",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.AggregateWorkflowExecutions),
+					NoWire: aggregateworkflowexecutions_NoWireHandler{impl},
+				},
+				Signature:    "AggregateWorkflowExecutions(AggregateRequest *shared.AggregateWorkflowExecutionsRequest) (*shared.AggregateWorkflowExecutionsResponse)",
+				ThriftModule: cadence.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "BackfillSchedule",
 				HandlerSpec: thrift.HandlerSpec{
@@ -339,6 +416,18 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: cadence.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "CreateSemaphore",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.CreateSemaphore),
+					NoWire: createsemaphore_NoWireHandler{impl},
+				},
+				Signature:    "CreateSemaphore(Request *shared.CreateSemaphoreRequest) (*shared.CreateSemaphoreResponse)",
+				ThriftModule: cadence.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "DeleteDomain",
 				HandlerSpec: thrift.HandlerSpec{
@@ -363,6 +452,18 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: cadence.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "DeleteWorkflowExecution",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.DeleteWorkflowExecution),
+					NoWire: deleteworkflowexecution_NoWireHandler{impl},
+				},
+				Signature:    "DeleteWorkflowExecution(DeleteRequest *shared.DeleteWorkflowExecutionRequest)",
+				ThriftModule: cadence.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "DeprecateDomain",
 				HandlerSpec: thrift.HandlerSpec{
@@ -399,6 +500,18 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: cadence.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "DescribeSemaphore",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.DescribeSemaphore),
+					NoWire: describesemaphore_NoWireHandler{impl},
+				},
+				Signature:    "DescribeSemaphore(Request *shared.DescribeSemaphoreRequest) (*shared.DescribeSemaphoreResponse)",
+				ThriftModule: cadence.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "DescribeTaskList",
 				HandlerSpec: thrift.HandlerSpec{
@@ -567,6 +680,18 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: cadence.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "ListSemaphores",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.ListSemaphores),
+					NoWire: listsemaphores_NoWireHandler{impl},
+				},
+				Signature:    "ListSemaphores(Request *shared.ListSemaphoresRequest) (*shared.ListSemaphoresResponse)",
+				ThriftModule: cadence.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "ListTaskListPartitions",
 				HandlerSpec: thrift.HandlerSpec{
@@ -591,6 +716,18 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: cadence.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "PauseActivity",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.PauseActivity),
+					NoWire: pauseactivity_NoWireHandler{impl},
+				},
+				Signature:    "PauseActivity(PauseRequest *shared.PauseActivityRequest)",
+				ThriftModule: cadence.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "PauseSchedule",
 				HandlerSpec: thrift.HandlerSpec{
@@ -603,6 +740,18 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: cadence.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "PauseWorkflowExecution",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.PauseWorkflowExecution),
+					NoWire: pauseworkflowexecution_NoWireHandler{impl},
+				},
+				Signature:    "PauseWorkflowExecution(PauseRequest *shared.PauseWorkflowExecutionRequest)",
+				ThriftModule: cadence.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "PollForActivityTask",
 				HandlerSpec: thrift.HandlerSpec{
@@ -699,6 +848,18 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: cadence.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "ResetActivityAttempt",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.ResetActivityAttempt),
+					NoWire: resetactivityattempt_NoWireHandler{impl},
+				},
+				Signature:    "ResetActivityAttempt(ResetRequest *shared.ResetActivityAttemptRequest)",
+				ThriftModule: cadence.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "ResetStickyTaskList",
 				HandlerSpec: thrift.HandlerSpec{
@@ -843,6 +1004,18 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: cadence.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "RetryActivityNow",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.RetryActivityNow),
+					NoWire: retryactivitynow_NoWireHandler{impl},
+				},
+				Signature:    "RetryActivityNow(RetryRequest *shared.RetryActivityNowRequest)",
+				ThriftModule: cadence.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "ScanWorkflowExecutions",
 				HandlerSpec: thrift.HandlerSpec{
@@ -927,6 +1100,30 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: cadence.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "TriggerSchedule",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.TriggerSchedule),
+					NoWire: triggerschedule_NoWireHandler{impl},
+				},
+				Signature:    "TriggerSchedule(Request *shared.TriggerScheduleRequest) (*shared.TriggerScheduleResponse)",
+				ThriftModule: cadence.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "UnpauseActivity",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.UnpauseActivity),
+					NoWire: unpauseactivity_NoWireHandler{impl},
+				},
+				Signature:    "UnpauseActivity(UnpauseRequest *shared.UnpauseActivityRequest)",
+				ThriftModule: cadence.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "UnpauseSchedule",
 				HandlerSpec: thrift.HandlerSpec{
@@ -939,6 +1136,18 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: cadence.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "UnpauseWorkflowExecution",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.UnpauseWorkflowExecution),
+					NoWire: unpauseworkflowexecution_NoWireHandler{impl},
+				},
+				Signature:    "UnpauseWorkflowExecution(UnpauseRequest *shared.UnpauseWorkflowExecutionRequest)",
+				ThriftModule: cadence.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "UpdateDomain",
 				HandlerSpec: thrift.HandlerSpec{
@@ -962,10 +1171,22 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				Signature:    "UpdateSchedule(Request *shared.UpdateScheduleRequest) (*shared.UpdateScheduleResponse)",
 				ThriftModule: cadence.ThriftModule,
 			},
+
+			thrift.Method{
+				Name: "UpdateWorkflowExecution",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.UpdateWorkflowExecution),
+					NoWire: updateworkflowexecution_NoWireHandler{impl},
+				},
+				Signature:    "UpdateWorkflowExecution(UpdateRequest *shared.UpdateWorkflowExecutionRequest) (*shared.UpdateWorkflowExecutionResponse)",
+				ThriftModule: cadence.ThriftModule,
+			},
 		},
 	}
 
-	procedures := make([]transport.Procedure, 0, 55)
+	procedures := make([]transport.Procedure, 0, 68)
 	procedures = append(procedures, thrift.BuildProcedures(service, opts...)...)
 	return procedures
 }
@@ -976,6 +1197,36 @@ type yarpcErrorNamer interface{ YARPCErrorName() string }
 
 type yarpcErrorCoder interface{ YARPCErrorCode() *yarpcerrors.Code }
 
+func (h handler) AggregateWorkflowExecutions(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_AggregateWorkflowExecutions_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'WorkflowService' procedure 'AggregateWorkflowExecutions': %w", err)
+	}
+
+	success, appErr := h.impl.AggregateWorkflowExecutions(ctx, args.AggregateRequest)
+
+	hadError := appErr != nil
+	result, err := cadence.WorkflowService_AggregateWorkflowExecutions_Helper.WrapResponse(success, appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
 func (h handler) BackfillSchedule(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args cadence.WorkflowService_BackfillSchedule_Args
 	if err := args.FromWire(body); err != nil {
@@ -1066,6 +1317,36 @@ func (h handler) CreateSchedule(ctx context.Context, body wire.Value) (thrift.Re
 	return response, err
 }
 
+func (h handler) CreateSemaphore(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_CreateSemaphore_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'WorkflowService' procedure 'CreateSemaphore': %w", err)
+	}
+
+	success, appErr := h.impl.CreateSemaphore(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := cadence.WorkflowService_CreateSemaphore_Helper.WrapResponse(success, appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
 func (h handler) DeleteDomain(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args cadence.WorkflowService_DeleteDomain_Args
 	if err := args.FromWire(body); err != nil {
@@ -1126,6 +1407,36 @@ func (h handler) DeleteSchedule(ctx context.Context, body wire.Value) (thrift.Re
 	return response, err
 }
 
+func (h handler) DeleteWorkflowExecution(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_DeleteWorkflowExecution_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'WorkflowService' procedure 'DeleteWorkflowExecution': %w", err)
+	}
+
+	appErr := h.impl.DeleteWorkflowExecution(ctx, args.DeleteRequest)
+
+	hadError := appErr != nil
+	result, err := cadence.WorkflowService_DeleteWorkflowExecution_Helper.WrapResponse(appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
 func (h handler) DeprecateDomain(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args cadence.WorkflowService_DeprecateDomain_Args
 	if err := args.FromWire(body); err != nil {
@@ -1216,6 +1527,36 @@ func (h handler) DescribeSchedule(ctx context.Context, body wire.Value) (thrift.
 	return response, err
 }
 
+func (h handler) DescribeSemaphore(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_DescribeSemaphore_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'WorkflowService' procedure 'DescribeSemaphore': %w", err)
+	}
+
+	success, appErr := h.impl.DescribeSemaphore(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := cadence.WorkflowService_DescribeSemaphore_Helper.WrapResponse(success, appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
 func (h handler) DescribeTaskList(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args cadence.WorkflowService_DescribeTaskList_Args
 	if err := args.FromWire(body); err != nil {
@@ -1636,17 +1977,17 @@ func (h handler) ListSchedules(ctx context.Context, body wire.Value) (thrift.Res
 	return response, err
 }
 
-func (h handler) ListTaskListPartitions(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args cadence.WorkflowService_ListTaskListPartitions_Args
+func (h handler) ListSemaphores(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_ListSemaphores_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'WorkflowService' procedure 'ListTaskListPartitions': %w", err)
+			"could not decode Thrift request for service 'WorkflowService' procedure 'ListSemaphores': %w", err)
 	}
 
-	success, appErr := h.impl.ListTaskListPartitions(ctx, args.Request)
+	success, appErr := h.impl.ListSemaphores(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := cadence.WorkflowService_ListTaskListPartitions_Helper.WrapResponse(success, appErr)
+	result, err := cadence.WorkflowService_ListSemaphores_Helper.WrapResponse(success, appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1666,17 +2007,17 @@ func (h handler) ListTaskListPartitions(ctx context.Context, body wire.Value) (t
 	return response, err
 }
 
-func (h handler) ListWorkflowExecutions(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args cadence.WorkflowService_ListWorkflowExecutions_Args
+func (h handler) ListTaskListPartitions(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_ListTaskListPartitions_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'WorkflowService' procedure 'ListWorkflowExecutions': %w", err)
+			"could not decode Thrift request for service 'WorkflowService' procedure 'ListTaskListPartitions': %w", err)
 	}
 
-	success, appErr := h.impl.ListWorkflowExecutions(ctx, args.ListRequest)
+	success, appErr := h.impl.ListTaskListPartitions(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := cadence.WorkflowService_ListWorkflowExecutions_Helper.WrapResponse(success, appErr)
+	result, err := cadence.WorkflowService_ListTaskListPartitions_Helper.WrapResponse(success, appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1696,8 +2037,68 @@ func (h handler) ListWorkflowExecutions(ctx context.Context, body wire.Value) (t
 	return response, err
 }
 
-func (h handler) PauseSchedule(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args cadence.WorkflowService_PauseSchedule_Args
+func (h handler) ListWorkflowExecutions(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_ListWorkflowExecutions_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'WorkflowService' procedure 'ListWorkflowExecutions': %w", err)
+	}
+
+	success, appErr := h.impl.ListWorkflowExecutions(ctx, args.ListRequest)
+
+	hadError := appErr != nil
+	result, err := cadence.WorkflowService_ListWorkflowExecutions_Helper.WrapResponse(success, appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
+func (h handler) PauseActivity(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_PauseActivity_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'WorkflowService' procedure 'PauseActivity': %w", err)
+	}
+
+	appErr := h.impl.PauseActivity(ctx, args.PauseRequest)
+
+	hadError := appErr != nil
+	result, err := cadence.WorkflowService_PauseActivity_Helper.WrapResponse(appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
+func (h handler) PauseSchedule(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_PauseSchedule_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
 			"could not decode Thrift request for service 'WorkflowService' procedure 'PauseSchedule': %w", err)
@@ -1726,6 +2127,36 @@ func (h handler) PauseSchedule(ctx context.Context, body wire.Value) (thrift.Res
 	return response, err
 }
 
+func (h handler) PauseWorkflowExecution(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_PauseWorkflowExecution_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'WorkflowService' procedure 'PauseWorkflowExecution': %w", err)
+	}
+
+	appErr := h.impl.PauseWorkflowExecution(ctx, args.PauseRequest)
+
+	hadError := appErr != nil
+	result, err := cadence.WorkflowService_PauseWorkflowExecution_Helper.WrapResponse(appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
 func (h handler) PollForActivityTask(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args cadence.WorkflowService_PollForActivityTask_Args
 	if err := args.FromWire(body); err != nil {
@@ -1966,6 +2397,36 @@ func (h handler) RequestCancelWorkflowExecution(ctx context.Context, body wire.V
 	return response, err
 }
 
+func (h handler) ResetActivityAttempt(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_ResetActivityAttempt_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'WorkflowService' procedure 'ResetActivityAttempt': %w", err)
+	}
+
+	appErr := h.impl.ResetActivityAttempt(ctx, args.ResetRequest)
+
+	hadError := appErr != nil
+	result, err := cadence.WorkflowService_ResetActivityAttempt_Helper.WrapResponse(appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
 func (h handler) ResetStickyTaskList(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args cadence.WorkflowService_ResetStickyTaskList_Args
 	if err := args.FromWire(body); err != nil {
@@ -2326,6 +2787,36 @@ func (h handler) RestartWorkflowExecution(ctx context.Context, body wire.Value)
 	return response, err
 }
 
+func (h handler) RetryActivityNow(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_RetryActivityNow_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'WorkflowService' procedure 'RetryActivityNow': %w", err)
+	}
+
+	appErr := h.impl.RetryActivityNow(ctx, args.RetryRequest)
+
+	hadError := appErr != nil
+	result, err := cadence.WorkflowService_RetryActivityNow_Helper.WrapResponse(appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
 func (h handler) ScanWorkflowExecutions(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args cadence.WorkflowService_ScanWorkflowExecutions_Args
 	if err := args.FromWire(body); err != nil {
@@ -2536,6 +3027,66 @@ func (h handler) TerminateWorkflowExecution(ctx context.Context, body wire.Value
 	return response, err
 }
 
+func (h handler) TriggerSchedule(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_TriggerSchedule_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'WorkflowService' procedure 'TriggerSchedule': %w", err)
+	}
+
+	success, appErr := h.impl.TriggerSchedule(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := cadence.WorkflowService_TriggerSchedule_Helper.WrapResponse(success, appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
+func (h handler) UnpauseActivity(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_UnpauseActivity_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'WorkflowService' procedure 'UnpauseActivity': %w", err)
+	}
+
+	appErr := h.impl.UnpauseActivity(ctx, args.UnpauseRequest)
+
+	hadError := appErr != nil
+	result, err := cadence.WorkflowService_UnpauseActivity_Helper.WrapResponse(appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
 func (h handler) UnpauseSchedule(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args cadence.WorkflowService_UnpauseSchedule_Args
 	if err := args.FromWire(body); err != nil {
@@ -2566,6 +3117,36 @@ func (h handler) UnpauseSchedule(ctx context.Context, body wire.Value) (thrift.R
 	return response, err
 }
 
+func (h handler) UnpauseWorkflowExecution(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_UnpauseWorkflowExecution_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'WorkflowService' procedure 'UnpauseWorkflowExecution': %w", err)
+	}
+
+	appErr := h.impl.UnpauseWorkflowExecution(ctx, args.UnpauseRequest)
+
+	hadError := appErr != nil
+	result, err := cadence.WorkflowService_UnpauseWorkflowExecution_Helper.WrapResponse(appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
 func (h handler) UpdateDomain(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args cadence.WorkflowService_UpdateDomain_Args
 	if err := args.FromWire(body); err != nil {
@@ -2626,6 +3207,73 @@ func (h handler) UpdateSchedule(ctx context.Context, body wire.Value) (thrift.Re
 	return response, err
 }
 
+func (h handler) UpdateWorkflowExecution(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_UpdateWorkflowExecution_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'WorkflowService' procedure 'UpdateWorkflowExecution': %w", err)
+	}
+
+	success, appErr := h.impl.UpdateWorkflowExecution(ctx, args.UpdateRequest)
+
+	hadError := appErr != nil
+	result, err := cadence.WorkflowService_UpdateWorkflowExecution_Helper.WrapResponse(success, appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
+type aggregateworkflowexecutions_NoWireHandler struct{ impl Interface }
+
+func (h aggregateworkflowexecutions_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args cadence.WorkflowService_AggregateWorkflowExecutions_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'WorkflowService' procedure 'AggregateWorkflowExecutions': %w", err)
+	}
+
+	success, appErr := h.impl.AggregateWorkflowExecutions(ctx, args.AggregateRequest)
+
+	hadError := appErr != nil
+	result, err := cadence.WorkflowService_AggregateWorkflowExecutions_Helper.WrapResponse(success, appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
 type backfillschedule_NoWireHandler struct{ impl Interface }
 
 func (h backfillschedule_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
@@ -2737,6 +3385,43 @@ func (h createschedule_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thr
 
 }
 
+type createsemaphore_NoWireHandler struct{ impl Interface }
+
+func (h createsemaphore_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args cadence.WorkflowService_CreateSemaphore_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'WorkflowService' procedure 'CreateSemaphore': %w", err)
+	}
+
+	success, appErr := h.impl.CreateSemaphore(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := cadence.WorkflowService_CreateSemaphore_Helper.WrapResponse(success, appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
 type deletedomain_NoWireHandler struct{ impl Interface }
 
 func (h deletedomain_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
@@ -2811,6 +3496,43 @@ func (h deleteschedule_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thr
 
 }
 
+type deleteworkflowexecution_NoWireHandler struct{ impl Interface }
+
+func (h deleteworkflowexecution_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args cadence.WorkflowService_DeleteWorkflowExecution_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'WorkflowService' procedure 'DeleteWorkflowExecution': %w", err)
+	}
+
+	appErr := h.impl.DeleteWorkflowExecution(ctx, args.DeleteRequest)
+
+	hadError := appErr != nil
+	result, err := cadence.WorkflowService_DeleteWorkflowExecution_Helper.WrapResponse(appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
 type deprecatedomain_NoWireHandler struct{ impl Interface }
 
 func (h deprecatedomain_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
@@ -2922,6 +3644,43 @@ func (h describeschedule_NoWireHandler) HandleNoWire(ctx context.Context, nwc *t
 
 }
 
+type describesemaphore_NoWireHandler struct{ impl Interface }
+
+func (h describesemaphore_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args cadence.WorkflowService_DescribeSemaphore_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'WorkflowService' procedure 'DescribeSemaphore': %w", err)
+	}
+
+	success, appErr := h.impl.DescribeSemaphore(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := cadence.WorkflowService_DescribeSemaphore_Helper.WrapResponse(success, appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
 type describetasklist_NoWireHandler struct{ impl Interface }
 
 func (h describetasklist_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
@@ -3270,10 +4029,121 @@ func (h listclosedworkflowexecutions_NoWireHandler) HandleNoWire(ctx context.Con
 			"could not decode (via no wire) Thrift request for service 'WorkflowService' procedure 'ListClosedWorkflowExecutions': %w", err)
 	}
 
-	success, appErr := h.impl.ListClosedWorkflowExecutions(ctx, args.ListRequest)
+	success, appErr := h.impl.ListClosedWorkflowExecutions(ctx, args.ListRequest)
+
+	hadError := appErr != nil
+	result, err := cadence.WorkflowService_ListClosedWorkflowExecutions_Helper.WrapResponse(success, appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
+type listdomains_NoWireHandler struct{ impl Interface }
+
+func (h listdomains_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args cadence.WorkflowService_ListDomains_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'WorkflowService' procedure 'ListDomains': %w", err)
+	}
+
+	success, appErr := h.impl.ListDomains(ctx, args.ListRequest)
+
+	hadError := appErr != nil
+	result, err := cadence.WorkflowService_ListDomains_Helper.WrapResponse(success, appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
+type listfailoverhistory_NoWireHandler struct{ impl Interface }
+
+func (h listfailoverhistory_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args cadence.WorkflowService_ListFailoverHistory_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'WorkflowService' procedure 'ListFailoverHistory': %w", err)
+	}
+
+	success, appErr := h.impl.ListFailoverHistory(ctx, args.ListRequest)
+
+	hadError := appErr != nil
+	result, err := cadence.WorkflowService_ListFailoverHistory_Helper.WrapResponse(success, appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
+type listopenworkflowexecutions_NoWireHandler struct{ impl Interface }
+
+func (h listopenworkflowexecutions_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args cadence.WorkflowService_ListOpenWorkflowExecutions_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'WorkflowService' procedure 'ListOpenWorkflowExecutions': %w", err)
+	}
+
+	success, appErr := h.impl.ListOpenWorkflowExecutions(ctx, args.ListRequest)
 
 	hadError := appErr != nil
-	result, err := cadence.WorkflowService_ListClosedWorkflowExecutions_Helper.WrapResponse(success, appErr)
+	result, err := cadence.WorkflowService_ListOpenWorkflowExecutions_Helper.WrapResponse(success, appErr)
 	response := thrift.NoWireResponse{ResponseWriter: rw}
 	if err == nil {
 		response.IsApplicationError = hadError
@@ -3292,11 +4162,11 @@ func (h listclosedworkflowexecutions_NoWireHandler) HandleNoWire(ctx context.Con
 
 }
 
-type listdomains_NoWireHandler struct{ impl Interface }
+type listschedules_NoWireHandler struct{ impl Interface }
 
-func (h listdomains_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+func (h listschedules_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
 	var (
-		args cadence.WorkflowService_ListDomains_Args
+		args cadence.WorkflowService_ListSchedules_Args
 		rw   stream.ResponseWriter
 		err  error
 	)
@@ -3304,13 +4174,13 @@ func (h listdomains_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift
 	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
 	if err != nil {
 		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode (via no wire) Thrift request for service 'WorkflowService' procedure 'ListDomains': %w", err)
+			"could not decode (via no wire) Thrift request for service 'WorkflowService' procedure 'ListSchedules': %w", err)
 	}
 
-	success, appErr := h.impl.ListDomains(ctx, args.ListRequest)
+	success, appErr := h.impl.ListSchedules(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := cadence.WorkflowService_ListDomains_Helper.WrapResponse(success, appErr)
+	result, err := cadence.WorkflowService_ListSchedules_Helper.WrapResponse(success, appErr)
 	response := thrift.NoWireResponse{ResponseWriter: rw}
 	if err == nil {
 		response.IsApplicationError = hadError
@@ -3329,11 +4199,11 @@ func (h listdomains_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift
 
 }
 
-type listfailoverhistory_NoWireHandler struct{ impl Interface }
+type listsemaphores_NoWireHandler struct{ impl Interface }
 
-func (h listfailoverhistory_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+func (h listsemaphores_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
 	var (
-		args cadence.WorkflowService_ListFailoverHistory_Args
+		args cadence.WorkflowService_ListSemaphores_Args
 		rw   stream.ResponseWriter
 		err  error
 	)
@@ -3341,13 +4211,13 @@ func (h listfailoverhistory_NoWireHandler) HandleNoWire(ctx context.Context, nwc
 	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
 	if err != nil {
 		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode (via no wire) Thrift request for service 'WorkflowService' procedure 'ListFailoverHistory': %w", err)
+			"could not decode (via no wire) Thrift request for service 'WorkflowService' procedure 'ListSemaphores': %w", err)
 	}
 
-	success, appErr := h.impl.ListFailoverHistory(ctx, args.ListRequest)
+	success, appErr := h.impl.ListSemaphores(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := cadence.WorkflowService_ListFailoverHistory_Helper.WrapResponse(success, appErr)
+	result, err := cadence.WorkflowService_ListSemaphores_Helper.WrapResponse(success, appErr)
 	response := thrift.NoWireResponse{ResponseWriter: rw}
 	if err == nil {
 		response.IsApplicationError = hadError
@@ -3366,11 +4236,11 @@ func (h listfailoverhistory_NoWireHandler) HandleNoWire(ctx context.Context, nwc
 
 }
 
-type listopenworkflowexecutions_NoWireHandler struct{ impl Interface }
+type listtasklistpartitions_NoWireHandler struct{ impl Interface }
 
-func (h listopenworkflowexecutions_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+func (h listtasklistpartitions_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
 	var (
-		args cadence.WorkflowService_ListOpenWorkflowExecutions_Args
+		args cadence.WorkflowService_ListTaskListPartitions_Args
 		rw   stream.ResponseWriter
 		err  error
 	)
@@ -3378,13 +4248,13 @@ func (h listopenworkflowexecutions_NoWireHandler) HandleNoWire(ctx context.Conte
 	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
 	if err != nil {
 		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode (via no wire) Thrift request for service 'WorkflowService' procedure 'ListOpenWorkflowExecutions': %w", err)
+			"could not decode (via no wire) Thrift request for service 'WorkflowService' procedure 'ListTaskListPartitions': %w", err)
 	}
 
-	success, appErr := h.impl.ListOpenWorkflowExecutions(ctx, args.ListRequest)
+	success, appErr := h.impl.ListTaskListPartitions(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := cadence.WorkflowService_ListOpenWorkflowExecutions_Helper.WrapResponse(success, appErr)
+	result, err := cadence.WorkflowService_ListTaskListPartitions_Helper.WrapResponse(success, appErr)
 	response := thrift.NoWireResponse{ResponseWriter: rw}
 	if err == nil {
 		response.IsApplicationError = hadError
@@ -3403,11 +4273,11 @@ func (h listopenworkflowexecutions_NoWireHandler) HandleNoWire(ctx context.Conte
 
 }
 
-type listschedules_NoWireHandler struct{ impl Interface }
+type listworkflowexecutions_NoWireHandler struct{ impl Interface }
 
-func (h listschedules_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+func (h listworkflowexecutions_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
 	var (
-		args cadence.WorkflowService_ListSchedules_Args
+		args cadence.WorkflowService_ListWorkflowExecutions_Args
 		rw   stream.ResponseWriter
 		err  error
 	)
@@ -3415,13 +4285,13 @@ func (h listschedules_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thri
 	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
 	if err != nil {
 		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode (via no wire) Thrift request for service 'WorkflowService' procedure 'ListSchedules': %w", err)
+			"could not decode (via no wire) Thrift request for service 'WorkflowService' procedure 'ListWorkflowExecutions': %w", err)
 	}
 
-	success, appErr := h.impl.ListSchedules(ctx, args.Request)
+	success, appErr := h.impl.ListWorkflowExecutions(ctx, args.ListRequest)
 
 	hadError := appErr != nil
-	result, err := cadence.WorkflowService_ListSchedules_Helper.WrapResponse(success, appErr)
+	result, err := cadence.WorkflowService_ListWorkflowExecutions_Helper.WrapResponse(success, appErr)
 	response := thrift.NoWireResponse{ResponseWriter: rw}
 	if err == nil {
 		response.IsApplicationError = hadError
@@ -3440,11 +4310,11 @@ func (h listschedules_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thri
 
 }
 
-type listtasklistpartitions_NoWireHandler struct{ impl Interface }
+type pauseactivity_NoWireHandler struct{ impl Interface }
 
-func (h listtasklistpartitions_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+func (h pauseactivity_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
 	var (
-		args cadence.WorkflowService_ListTaskListPartitions_Args
+		args cadence.WorkflowService_PauseActivity_Args
 		rw   stream.ResponseWriter
 		err  error
 	)
@@ -3452,13 +4322,13 @@ func (h listtasklistpartitions_NoWireHandler) HandleNoWire(ctx context.Context,
 	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
 	if err != nil {
 		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode (via no wire) Thrift request for service 'WorkflowService' procedure 'ListTaskListPartitions': %w", err)
+			"could not decode (via no wire) Thrift request for service 'WorkflowService' procedure 'PauseActivity': %w", err)
 	}
 
-	success, appErr := h.impl.ListTaskListPartitions(ctx, args.Request)
+	appErr := h.impl.PauseActivity(ctx, args.PauseRequest)
 
 	hadError := appErr != nil
-	result, err := cadence.WorkflowService_ListTaskListPartitions_Helper.WrapResponse(success, appErr)
+	result, err := cadence.WorkflowService_PauseActivity_Helper.WrapResponse(appErr)
 	response := thrift.NoWireResponse{ResponseWriter: rw}
 	if err == nil {
 		response.IsApplicationError = hadError
@@ -3477,11 +4347,11 @@ func (h listtasklistpartitions_NoWireHandler) HandleNoWire(ctx context.Context,
 
 }
 
-type listworkflowexecutions_NoWireHandler struct{ impl Interface }
+type pauseschedule_NoWireHandler struct{ impl Interface }
 
-func (h listworkflowexecutions_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+func (h pauseschedule_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
 	var (
-		args cadence.WorkflowService_ListWorkflowExecutions_Args
+		args cadence.WorkflowService_PauseSchedule_Args
 		rw   stream.ResponseWriter
 		err  error
 	)
@@ -3489,13 +4359,13 @@ func (h listworkflowexecutions_NoWireHandler) HandleNoWire(ctx context.Context,
 	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
 	if err != nil {
 		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode (via no wire) Thrift request for service 'WorkflowService' procedure 'ListWorkflowExecutions': %w", err)
+			"could not decode (via no wire) Thrift request for service 'WorkflowService' procedure 'PauseSchedule': %w", err)
 	}
 
-	success, appErr := h.impl.ListWorkflowExecutions(ctx, args.ListRequest)
+	success, appErr := h.impl.PauseSchedule(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := cadence.WorkflowService_ListWorkflowExecutions_Helper.WrapResponse(success, appErr)
+	result, err := cadence.WorkflowService_PauseSchedule_Helper.WrapResponse(success, appErr)
 	response := thrift.NoWireResponse{ResponseWriter: rw}
 	if err == nil {
 		response.IsApplicationError = hadError
@@ -3514,11 +4384,11 @@ func (h listworkflowexecutions_NoWireHandler) HandleNoWire(ctx context.Context,
 
 }
 
-type pauseschedule_NoWireHandler struct{ impl Interface }
+type pauseworkflowexecution_NoWireHandler struct{ impl Interface }
 
-func (h pauseschedule_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+func (h pauseworkflowexecution_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
 	var (
-		args cadence.WorkflowService_PauseSchedule_Args
+		args cadence.WorkflowService_PauseWorkflowExecution_Args
 		rw   stream.ResponseWriter
 		err  error
 	)
@@ -3526,13 +4396,13 @@ func (h pauseschedule_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thri
 	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
 	if err != nil {
 		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode (via no wire) Thrift request for service 'WorkflowService' procedure 'PauseSchedule': %w", err)
+			"could not decode (via no wire) Thrift request for service 'WorkflowService' procedure 'PauseWorkflowExecution': %w", err)
 	}
 
-	success, appErr := h.impl.PauseSchedule(ctx, args.Request)
+	appErr := h.impl.PauseWorkflowExecution(ctx, args.PauseRequest)
 
 	hadError := appErr != nil
-	result, err := cadence.WorkflowService_PauseSchedule_Helper.WrapResponse(success, appErr)
+	result, err := cadence.WorkflowService_PauseWorkflowExecution_Helper.WrapResponse(appErr)
 	response := thrift.NoWireResponse{ResponseWriter: rw}
 	if err == nil {
 		response.IsApplicationError = hadError
@@ -3847,6 +4717,43 @@ func (h requestcancelworkflowexecution_NoWireHandler) HandleNoWire(ctx context.C
 
 }
 
+type resetactivityattempt_NoWireHandler struct{ impl Interface }
+
+func (h resetactivityattempt_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args cadence.WorkflowService_ResetActivityAttempt_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'WorkflowService' procedure 'ResetActivityAttempt': %w", err)
+	}
+
+	appErr := h.impl.ResetActivityAttempt(ctx, args.ResetRequest)
+
+	hadError := appErr != nil
+	result, err := cadence.WorkflowService_ResetActivityAttempt_Helper.WrapResponse(appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
 type resetstickytasklist_NoWireHandler struct{ impl Interface }
 
 func (h resetstickytasklist_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
@@ -4291,6 +5198,43 @@ func (h restartworkflowexecution_NoWireHandler) HandleNoWire(ctx context.Context
 
 }
 
+type retryactivitynow_NoWireHandler struct{ impl Interface }
+
+func (h retryactivitynow_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args cadence.WorkflowService_RetryActivityNow_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'WorkflowService' procedure 'RetryActivityNow': %w", err)
+	}
+
+	appErr := h.impl.RetryActivityNow(ctx, args.RetryRequest)
+
+	hadError := appErr != nil
+	result, err := cadence.WorkflowService_RetryActivityNow_Helper.WrapResponse(appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
 type scanworkflowexecutions_NoWireHandler struct{ impl Interface }
 
 func (h scanworkflowexecutions_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
@@ -4550,6 +5494,80 @@ func (h terminateworkflowexecution_NoWireHandler) HandleNoWire(ctx context.Conte
 
 }
 
+type triggerschedule_NoWireHandler struct{ impl Interface }
+
+func (h triggerschedule_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args cadence.WorkflowService_TriggerSchedule_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'WorkflowService' procedure 'TriggerSchedule': %w", err)
+	}
+
+	success, appErr := h.impl.TriggerSchedule(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := cadence.WorkflowService_TriggerSchedule_Helper.WrapResponse(success, appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
+type unpauseactivity_NoWireHandler struct{ impl Interface }
+
+func (h unpauseactivity_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args cadence.WorkflowService_UnpauseActivity_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'WorkflowService' procedure 'UnpauseActivity': %w", err)
+	}
+
+	appErr := h.impl.UnpauseActivity(ctx, args.UnpauseRequest)
+
+	hadError := appErr != nil
+	result, err := cadence.WorkflowService_UnpauseActivity_Helper.WrapResponse(appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
 type unpauseschedule_NoWireHandler struct{ impl Interface }
 
 func (h unpauseschedule_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
@@ -4587,6 +5605,43 @@ func (h unpauseschedule_NoWireHandler) HandleNoWire(ctx context.Context, nwc *th
 
 }
 
+type unpauseworkflowexecution_NoWireHandler struct{ impl Interface }
+
+func (h unpauseworkflowexecution_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args cadence.WorkflowService_UnpauseWorkflowExecution_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'WorkflowService' procedure 'UnpauseWorkflowExecution': %w", err)
+	}
+
+	appErr := h.impl.UnpauseWorkflowExecution(ctx, args.UnpauseRequest)
+
+	hadError := appErr != nil
+	result, err := cadence.WorkflowService_UnpauseWorkflowExecution_Helper.WrapResponse(appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
 type updatedomain_NoWireHandler struct{ impl Interface }
 
 func (h updatedomain_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
@@ -4660,3 +5715,40 @@ func (h updateschedule_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thr
 	return response, err
 
 }
+
+type updateworkflowexecution_NoWireHandler struct{ impl Interface }
+
+func (h updateworkflowexecution_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args cadence.WorkflowService_UpdateWorkflowExecution_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'WorkflowService' procedure 'UpdateWorkflowExecution': %w", err)
+	}
+
+	success, appErr := h.impl.UpdateWorkflowExecution(ctx, args.UpdateRequest)
+
+	hadError := appErr != nil
+	result, err := cadence.WorkflowService_UpdateWorkflowExecution_Helper.WrapResponse(success, appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
@@ -44,6 +44,39 @@ func (m *MockClient) EXPECT() *_MockClientRecorder {
 	return m.recorder
 }
 
+// AggregateWorkflowExecutions responds to a AggregateWorkflowExecutions call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().AggregateWorkflowExecutions(gomock.Any(), ...).Return(...)
+//	... := client.AggregateWorkflowExecutions(...)
+func (m *MockClient) AggregateWorkflowExecutions(
+	ctx context.Context,
+	_AggregateRequest *shared.AggregateWorkflowExecutionsRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.AggregateWorkflowExecutionsResponse, err error) {
+
+	args := []interface{}{ctx, _AggregateRequest}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "AggregateWorkflowExecutions", args...)
+	success, _ = ret[i].(*shared.AggregateWorkflowExecutionsResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) AggregateWorkflowExecutions(
+	ctx interface{},
+	_AggregateRequest interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _AggregateRequest}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "AggregateWorkflowExecutions", args...)
+}
+
 // BackfillSchedule responds to a BackfillSchedule call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -143,6 +176,39 @@ func (mr *_MockClientRecorder) CreateSchedule(
 	return mr.mock.ctrl.RecordCall(mr.mock, "CreateSchedule", args...)
 }
 
+// CreateSemaphore responds to a CreateSemaphore call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().CreateSemaphore(gomock.Any(), ...).Return(...)
+//	... := client.CreateSemaphore(...)
+func (m *MockClient) CreateSemaphore(
+	ctx context.Context,
+	_Request *shared.CreateSemaphoreRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.CreateSemaphoreResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "CreateSemaphore", args...)
+	success, _ = ret[i].(*shared.CreateSemaphoreResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) CreateSemaphore(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "CreateSemaphore", args...)
+}
+
 // DeleteDomain responds to a DeleteDomain call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -207,6 +273,37 @@ func (mr *_MockClientRecorder) DeleteSchedule(
 	return mr.mock.ctrl.RecordCall(mr.mock, "DeleteSchedule", args...)
 }
 
+// DeleteWorkflowExecution responds to a DeleteWorkflowExecution call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().DeleteWorkflowExecution(gomock.Any(), ...).Return(...)
+//	... := client.DeleteWorkflowExecution(...)
+func (m *MockClient) DeleteWorkflowExecution(
+	ctx context.Context,
+	_DeleteRequest *shared.DeleteWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _DeleteRequest}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "DeleteWorkflowExecution", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) DeleteWorkflowExecution(
+	ctx interface{},
+	_DeleteRequest interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _DeleteRequest}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "DeleteWorkflowExecution", args...)
+}
+
 // DeprecateDomain responds to a DeprecateDomain call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -304,6 +401,39 @@ func (mr *_MockClientRecorder) DescribeSchedule(
 	return mr.mock.ctrl.RecordCall(mr.mock, "DescribeSchedule", args...)
 }
 
+// DescribeSemaphore responds to a DescribeSemaphore call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().DescribeSemaphore(gomock.Any(), ...).Return(...)
+//	... := client.DescribeSemaphore(...)
+func (m *MockClient) DescribeSemaphore(
+	ctx context.Context,
+	_Request *shared.DescribeSemaphoreRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.DescribeSemaphoreResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "DescribeSemaphore", args...)
+	success, _ = ret[i].(*shared.DescribeSemaphoreResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) DescribeSemaphore(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "DescribeSemaphore", args...)
+}
+
 // DescribeTaskList responds to a DescribeTaskList call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -762,6 +892,39 @@ func (mr *_MockClientRecorder) ListSchedules(
 	return mr.mock.ctrl.RecordCall(mr.mock, "ListSchedules", args...)
 }
 
+// ListSemaphores responds to a ListSemaphores call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().ListSemaphores(gomock.Any(), ...).Return(...)
+//	... := client.ListSemaphores(...)
+func (m *MockClient) ListSemaphores(
+	ctx context.Context,
+	_Request *shared.ListSemaphoresRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.ListSemaphoresResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "ListSemaphores", args...)
+	success, _ = ret[i].(*shared.ListSemaphoresResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) ListSemaphores(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "ListSemaphores", args...)
+}
+
 // ListTaskListPartitions responds to a ListTaskListPartitions call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -828,6 +991,37 @@ func (mr *_MockClientRecorder) ListWorkflowExecutions(
 	return mr.mock.ctrl.RecordCall(mr.mock, "ListWorkflowExecutions", args...)
 }
 
+// PauseActivity responds to a PauseActivity call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().PauseActivity(gomock.Any(), ...).Return(...)
+//	... := client.PauseActivity(...)
+func (m *MockClient) PauseActivity(
+	ctx context.Context,
+	_PauseRequest *shared.PauseActivityRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _PauseRequest}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "PauseActivity", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) PauseActivity(
+	ctx interface{},
+	_PauseRequest interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _PauseRequest}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "PauseActivity", args...)
+}
+
 // PauseSchedule responds to a PauseSchedule call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -861,6 +1055,37 @@ func (mr *_MockClientRecorder) PauseSchedule(
 	return mr.mock.ctrl.RecordCall(mr.mock, "PauseSchedule", args...)
 }
 
+// PauseWorkflowExecution responds to a PauseWorkflowExecution call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().PauseWorkflowExecution(gomock.Any(), ...).Return(...)
+//	... := client.PauseWorkflowExecution(...)
+func (m *MockClient) PauseWorkflowExecution(
+	ctx context.Context,
+	_PauseRequest *shared.PauseWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _PauseRequest}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "PauseWorkflowExecution", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) PauseWorkflowExecution(
+	ctx interface{},
+	_PauseRequest interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _PauseRequest}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "PauseWorkflowExecution", args...)
+}
+
 // PollForActivityTask responds to a PollForActivityTask call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -1119,6 +1344,37 @@ func (mr *_MockClientRecorder) RequestCancelWorkflowExecution(
 	return mr.mock.ctrl.RecordCall(mr.mock, "RequestCancelWorkflowExecution", args...)
 }
 
+// ResetActivityAttempt responds to a ResetActivityAttempt call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().ResetActivityAttempt(gomock.Any(), ...).Return(...)
+//	... := client.ResetActivityAttempt(...)
+func (m *MockClient) ResetActivityAttempt(
+	ctx context.Context,
+	_ResetRequest *shared.ResetActivityAttemptRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _ResetRequest}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "ResetActivityAttempt", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) ResetActivityAttempt(
+	ctx interface{},
+	_ResetRequest interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _ResetRequest}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "ResetActivityAttempt", args...)
+}
+
 // ResetStickyTaskList responds to a ResetStickyTaskList call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -1499,6 +1755,37 @@ func (mr *_MockClientRecorder) RestartWorkflowExecution(
 	return mr.mock.ctrl.RecordCall(mr.mock, "RestartWorkflowExecution", args...)
 }
 
+// RetryActivityNow responds to a RetryActivityNow call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().RetryActivityNow(gomock.Any(), ...).Return(...)
+//	... := client.RetryActivityNow(...)
+func (m *MockClient) RetryActivityNow(
+	ctx context.Context,
+	_RetryRequest *shared.RetryActivityNowRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _RetryRequest}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "RetryActivityNow", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) RetryActivityNow(
+	ctx interface{},
+	_RetryRequest interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _RetryRequest}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "RetryActivityNow", args...)
+}
+
 // ScanWorkflowExecutions responds to a ScanWorkflowExecutions call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -1726,6 +2013,70 @@ func (mr *_MockClientRecorder) TerminateWorkflowExecution(
 	return mr.mock.ctrl.RecordCall(mr.mock, "TerminateWorkflowExecution", args...)
 }
 
+// TriggerSchedule responds to a TriggerSchedule call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().TriggerSchedule(gomock.Any(), ...).Return(...)
+//	... := client.TriggerSchedule(...)
+func (m *MockClient) TriggerSchedule(
+	ctx context.Context,
+	_Request *shared.TriggerScheduleRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.TriggerScheduleResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "TriggerSchedule", args...)
+	success, _ = ret[i].(*shared.TriggerScheduleResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) TriggerSchedule(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "TriggerSchedule", args...)
+}
+
+// UnpauseActivity responds to a UnpauseActivity call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().UnpauseActivity(gomock.Any(), ...).Return(...)
+//	... := client.UnpauseActivity(...)
+func (m *MockClient) UnpauseActivity(
+	ctx context.Context,
+	_UnpauseRequest *shared.UnpauseActivityRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _UnpauseRequest}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "UnpauseActivity", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) UnpauseActivity(
+	ctx interface{},
+	_UnpauseRequest interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _UnpauseRequest}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "UnpauseActivity", args...)
+}
+
 // UnpauseSchedule responds to a UnpauseSchedule call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -1759,6 +2110,37 @@ func (mr *_MockClientRecorder) UnpauseSchedule(
 	return mr.mock.ctrl.RecordCall(mr.mock, "UnpauseSchedule", args...)
 }
 
+// UnpauseWorkflowExecution responds to a UnpauseWorkflowExecution call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().UnpauseWorkflowExecution(gomock.Any(), ...).Return(...)
+//	... := client.UnpauseWorkflowExecution(...)
+func (m *MockClient) UnpauseWorkflowExecution(
+	ctx context.Context,
+	_UnpauseRequest *shared.UnpauseWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _UnpauseRequest}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "UnpauseWorkflowExecution", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) UnpauseWorkflowExecution(
+	ctx interface{},
+	_UnpauseRequest interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _UnpauseRequest}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "UnpauseWorkflowExecution", args...)
+}
+
 // UpdateDomain responds to a UpdateDomain call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -1824,3 +2206,36 @@ func (mr *_MockClientRecorder) UpdateSchedule(
 	args := append([]interface{}{ctx, _Request}, opts...)
 	return mr.mock.ctrl.RecordCall(mr.mock, "UpdateSchedule", args...)
 }
+
+// UpdateWorkflowExecution responds to a UpdateWorkflowExecution call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().UpdateWorkflowExecution(gomock.Any(), ...).Return(...)
+//	... := client.UpdateWorkflowExecution(...)
+func (m *MockClient) UpdateWorkflowExecution(
+	ctx context.Context,
+	_UpdateRequest *shared.UpdateWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.UpdateWorkflowExecutionResponse, err error) {
+
+	args := []interface{}{ctx, _UpdateRequest}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "UpdateWorkflowExecution", args...)
+	success, _ = ret[i].(*shared.UpdateWorkflowExecutionResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) UpdateWorkflowExecution(
+	ctx interface{},
+	_UpdateRequest interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _UpdateRequest}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "UpdateWorkflowExecution", args...)
+}
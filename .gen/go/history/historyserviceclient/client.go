@@ -25,6 +25,12 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) error
 
+	DeleteWorkflowExecution(
+		ctx context.Context,
+		DeleteRequest *history.DeleteWorkflowExecutionRequest,
+		opts ...yarpc.CallOption,
+	) error
+
 	DescribeHistoryHost(
 		ctx context.Context,
 		Request *shared.DescribeHistoryHostRequest,
@@ -91,6 +97,18 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) error
 
+	PauseActivity(
+		ctx context.Context,
+		PauseRequest *history.PauseActivityRequest,
+		opts ...yarpc.CallOption,
+	) error
+
+	PauseWorkflowExecution(
+		ctx context.Context,
+		PauseRequest *history.PauseWorkflowExecutionRequest,
+		opts ...yarpc.CallOption,
+	) error
+
 	PollMutableState(
 		ctx context.Context,
 		PollRequest *history.PollMutableStateRequest,
@@ -169,18 +187,36 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) error
 
+	ReplicateDeleteWorkflowExecution(
+		ctx context.Context,
+		Request *replicator.DeleteWorkflowExecutionTaskAttributes,
+		opts ...yarpc.CallOption,
+	) error
+
 	ReplicateEventsV2(
 		ctx context.Context,
 		ReplicateV2Request *history.ReplicateEventsV2Request,
 		opts ...yarpc.CallOption,
 	) error
 
+	ReplicateUpsertWorkflowSearchAttributes(
+		ctx context.Context,
+		Request *replicator.UpsertWorkflowSearchAttributesTaskAttributes,
+		opts ...yarpc.CallOption,
+	) error
+
 	RequestCancelWorkflowExecution(
 		ctx context.Context,
 		CancelRequest *history.RequestCancelWorkflowExecutionRequest,
 		opts ...yarpc.CallOption,
 	) error
 
+	ResetActivityAttempt(
+		ctx context.Context,
+		ResetRequest *history.ResetActivityAttemptRequest,
+		opts ...yarpc.CallOption,
+	) error
+
 	ResetQueue(
 		ctx context.Context,
 		Request *shared.ResetQueueRequest,
@@ -235,6 +271,12 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) error
 
+	RetryActivityNow(
+		ctx context.Context,
+		RetryRequest *history.RetryActivityNowRequest,
+		opts ...yarpc.CallOption,
+	) error
+
 	ScheduleDecisionTask(
 		ctx context.Context,
 		ScheduleRequest *history.ScheduleDecisionTaskRequest,
@@ -276,6 +318,30 @@ type Interface interface {
 		TerminateRequest *history.TerminateWorkflowExecutionRequest,
 		opts ...yarpc.CallOption,
 	) error
+
+	UnpauseActivity(
+		ctx context.Context,
+		UnpauseRequest *history.UnpauseActivityRequest,
+		opts ...yarpc.CallOption,
+	) error
+
+	UnpauseWorkflowExecution(
+		ctx context.Context,
+		UnpauseRequest *history.UnpauseWorkflowExecutionRequest,
+		opts ...yarpc.CallOption,
+	) error
+
+	UpdateWorkflowExecution(
+		ctx context.Context,
+		UpdateRequest *history.UpdateWorkflowExecutionRequest,
+		opts ...yarpc.CallOption,
+	) (*shared.UpdateWorkflowExecutionResponse, error)
+
+	UpsertWorkflowSearchAttributes(
+		ctx context.Context,
+		UpsertRequest *history.UpsertWorkflowSearchAttributesRequest,
+		opts ...yarpc.CallOption,
+	) error
 }
 
 // New builds a new client for the HistoryService service.
@@ -335,6 +401,34 @@ func (c client) CloseShard(
 	return
 }
 
+func (c client) DeleteWorkflowExecution(
+	ctx context.Context,
+	_DeleteRequest *history.DeleteWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	var result history.HistoryService_DeleteWorkflowExecution_Result
+	args := history.HistoryService_DeleteWorkflowExecution_Helper.Args(_DeleteRequest)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	err = history.HistoryService_DeleteWorkflowExecution_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) DescribeHistoryHost(
 	ctx context.Context,
 	_Request *shared.DescribeHistoryHostRequest,
@@ -643,6 +737,62 @@ func (c client) NotifyFailoverMarkers(
 	return
 }
 
+func (c client) PauseActivity(
+	ctx context.Context,
+	_PauseRequest *history.PauseActivityRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	var result history.HistoryService_PauseActivity_Result
+	args := history.HistoryService_PauseActivity_Helper.Args(_PauseRequest)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	err = history.HistoryService_PauseActivity_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) PauseWorkflowExecution(
+	ctx context.Context,
+	_PauseRequest *history.PauseWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	var result history.HistoryService_PauseWorkflowExecution_Result
+	args := history.HistoryService_PauseWorkflowExecution_Helper.Args(_PauseRequest)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	err = history.HistoryService_PauseWorkflowExecution_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) PollMutableState(
 	ctx context.Context,
 	_PollRequest *history.PollMutableStateRequest,
@@ -1007,6 +1157,34 @@ func (c client) RemoveTask(
 	return
 }
 
+func (c client) ReplicateDeleteWorkflowExecution(
+	ctx context.Context,
+	_Request *replicator.DeleteWorkflowExecutionTaskAttributes,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	var result history.HistoryService_ReplicateDeleteWorkflowExecution_Result
+	args := history.HistoryService_ReplicateDeleteWorkflowExecution_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	err = history.HistoryService_ReplicateDeleteWorkflowExecution_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) ReplicateEventsV2(
 	ctx context.Context,
 	_ReplicateV2Request *history.ReplicateEventsV2Request,
@@ -1035,6 +1213,34 @@ func (c client) ReplicateEventsV2(
 	return
 }
 
+func (c client) ReplicateUpsertWorkflowSearchAttributes(
+	ctx context.Context,
+	_Request *replicator.UpsertWorkflowSearchAttributesTaskAttributes,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	var result history.HistoryService_ReplicateUpsertWorkflowSearchAttributes_Result
+	args := history.HistoryService_ReplicateUpsertWorkflowSearchAttributes_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	err = history.HistoryService_ReplicateUpsertWorkflowSearchAttributes_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) RequestCancelWorkflowExecution(
 	ctx context.Context,
 	_CancelRequest *history.RequestCancelWorkflowExecutionRequest,
@@ -1063,6 +1269,34 @@ func (c client) RequestCancelWorkflowExecution(
 	return
 }
 
+func (c client) ResetActivityAttempt(
+	ctx context.Context,
+	_ResetRequest *history.ResetActivityAttemptRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	var result history.HistoryService_ResetActivityAttempt_Result
+	args := history.HistoryService_ResetActivityAttempt_Helper.Args(_ResetRequest)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	err = history.HistoryService_ResetActivityAttempt_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) ResetQueue(
 	ctx context.Context,
 	_Request *shared.ResetQueueRequest,
@@ -1315,6 +1549,34 @@ func (c client) RespondDecisionTaskFailed(
 	return
 }
 
+func (c client) RetryActivityNow(
+	ctx context.Context,
+	_RetryRequest *history.RetryActivityNowRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	var result history.HistoryService_RetryActivityNow_Result
+	args := history.HistoryService_RetryActivityNow_Helper.Args(_RetryRequest)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	err = history.HistoryService_RetryActivityNow_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) ScheduleDecisionTask(
 	ctx context.Context,
 	_ScheduleRequest *history.ScheduleDecisionTaskRequest,
@@ -1510,3 +1772,115 @@ func (c client) TerminateWorkflowExecution(
 	err = history.HistoryService_TerminateWorkflowExecution_Helper.UnwrapResponse(&result)
 	return
 }
+
+func (c client) UnpauseActivity(
+	ctx context.Context,
+	_UnpauseRequest *history.UnpauseActivityRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	var result history.HistoryService_UnpauseActivity_Result
+	args := history.HistoryService_UnpauseActivity_Helper.Args(_UnpauseRequest)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	err = history.HistoryService_UnpauseActivity_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) UnpauseWorkflowExecution(
+	ctx context.Context,
+	_UnpauseRequest *history.UnpauseWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	var result history.HistoryService_UnpauseWorkflowExecution_Result
+	args := history.HistoryService_UnpauseWorkflowExecution_Helper.Args(_UnpauseRequest)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	err = history.HistoryService_UnpauseWorkflowExecution_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) UpdateWorkflowExecution(
+	ctx context.Context,
+	_UpdateRequest *history.UpdateWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.UpdateWorkflowExecutionResponse, err error) {
+
+	var result history.HistoryService_UpdateWorkflowExecution_Result
+	args := history.HistoryService_UpdateWorkflowExecution_Helper.Args(_UpdateRequest)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	success, err = history.HistoryService_UpdateWorkflowExecution_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) UpsertWorkflowSearchAttributes(
+	ctx context.Context,
+	_UpsertRequest *history.UpsertWorkflowSearchAttributesRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	var result history.HistoryService_UpsertWorkflowSearchAttributes_Result
+	args := history.HistoryService_UpsertWorkflowSearchAttributes_Helper.Args(_UpsertRequest)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	err = history.HistoryService_UpsertWorkflowSearchAttributes_Helper.UnwrapResponse(&result)
+	return
+}
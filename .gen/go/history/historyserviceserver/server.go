@@ -24,6 +24,11 @@ type Interface interface {
 		Request *shared.CloseShardRequest,
 	) error
 
+	DeleteWorkflowExecution(
+		ctx context.Context,
+		DeleteRequest *history.DeleteWorkflowExecutionRequest,
+	) error
+
 	DescribeHistoryHost(
 		ctx context.Context,
 		Request *shared.DescribeHistoryHostRequest,
@@ -79,6 +84,16 @@ type Interface interface {
 		Request *history.NotifyFailoverMarkersRequest,
 	) error
 
+	PauseActivity(
+		ctx context.Context,
+		PauseRequest *history.PauseActivityRequest,
+	) error
+
+	PauseWorkflowExecution(
+		ctx context.Context,
+		PauseRequest *history.PauseWorkflowExecutionRequest,
+	) error
+
 	PollMutableState(
 		ctx context.Context,
 		PollRequest *history.PollMutableStateRequest,
@@ -144,16 +159,31 @@ type Interface interface {
 		Request *shared.RemoveTaskRequest,
 	) error
 
+	ReplicateDeleteWorkflowExecution(
+		ctx context.Context,
+		Request *replicator.DeleteWorkflowExecutionTaskAttributes,
+	) error
+
 	ReplicateEventsV2(
 		ctx context.Context,
 		ReplicateV2Request *history.ReplicateEventsV2Request,
 	) error
 
+	ReplicateUpsertWorkflowSearchAttributes(
+		ctx context.Context,
+		Request *replicator.UpsertWorkflowSearchAttributesTaskAttributes,
+	) error
+
 	RequestCancelWorkflowExecution(
 		ctx context.Context,
 		CancelRequest *history.RequestCancelWorkflowExecutionRequest,
 	) error
 
+	ResetActivityAttempt(
+		ctx context.Context,
+		ResetRequest *history.ResetActivityAttemptRequest,
+	) error
+
 	ResetQueue(
 		ctx context.Context,
 		Request *shared.ResetQueueRequest,
@@ -199,6 +229,11 @@ type Interface interface {
 		FailedRequest *history.RespondDecisionTaskFailedRequest,
 	) error
 
+	RetryActivityNow(
+		ctx context.Context,
+		RetryRequest *history.RetryActivityNowRequest,
+	) error
+
 	ScheduleDecisionTask(
 		ctx context.Context,
 		ScheduleRequest *history.ScheduleDecisionTaskRequest,
@@ -233,6 +268,26 @@ type Interface interface {
 		ctx context.Context,
 		TerminateRequest *history.TerminateWorkflowExecutionRequest,
 	) error
+
+	UnpauseActivity(
+		ctx context.Context,
+		UnpauseRequest *history.UnpauseActivityRequest,
+	) error
+
+	UnpauseWorkflowExecution(
+		ctx context.Context,
+		UnpauseRequest *history.UnpauseWorkflowExecutionRequest,
+	) error
+
+	UpdateWorkflowExecution(
+		ctx context.Context,
+		UpdateRequest *history.UpdateWorkflowExecutionRequest,
+	) (*shared.UpdateWorkflowExecutionResponse, error)
+
+	UpsertWorkflowSearchAttributes(
+		ctx context.Context,
+		UpsertRequest *history.UpsertWorkflowSearchAttributesRequest,
+	) error
 }
 
 // New prepares an implementation of the HistoryService service for
@@ -258,6 +313,18 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: history.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "DeleteWorkflowExecution",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.DeleteWorkflowExecution),
+					NoWire: deleteworkflowexecution_NoWireHandler{impl},
+				},
+				Signature:    "DeleteWorkflowExecution(DeleteRequest *history.DeleteWorkflowExecutionRequest)",
+				ThriftModule: history.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "DescribeHistoryHost",
 				HandlerSpec: thrift.HandlerSpec{
@@ -390,6 +457,30 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: history.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "PauseActivity",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.PauseActivity),
+					NoWire: pauseactivity_NoWireHandler{impl},
+				},
+				Signature:    "PauseActivity(PauseRequest *history.PauseActivityRequest)",
+				ThriftModule: history.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "PauseWorkflowExecution",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.PauseWorkflowExecution),
+					NoWire: pauseworkflowexecution_NoWireHandler{impl},
+				},
+				Signature:    "PauseWorkflowExecution(PauseRequest *history.PauseWorkflowExecutionRequest)",
+				ThriftModule: history.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "PollMutableState",
 				HandlerSpec: thrift.HandlerSpec{
@@ -546,6 +637,18 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: history.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "ReplicateDeleteWorkflowExecution",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.ReplicateDeleteWorkflowExecution),
+					NoWire: replicatedeleteworkflowexecution_NoWireHandler{impl},
+				},
+				Signature:    "ReplicateDeleteWorkflowExecution(Request *replicator.DeleteWorkflowExecutionTaskAttributes)",
+				ThriftModule: history.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "ReplicateEventsV2",
 				HandlerSpec: thrift.HandlerSpec{
@@ -558,6 +661,18 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: history.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "ReplicateUpsertWorkflowSearchAttributes",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.ReplicateUpsertWorkflowSearchAttributes),
+					NoWire: replicateupsertworkflowsearchattributes_NoWireHandler{impl},
+				},
+				Signature:    "ReplicateUpsertWorkflowSearchAttributes(Request *replicator.UpsertWorkflowSearchAttributesTaskAttributes)",
+				ThriftModule: history.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "RequestCancelWorkflowExecution",
 				HandlerSpec: thrift.HandlerSpec{
@@ -570,6 +685,18 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: history.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "ResetActivityAttempt",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.ResetActivityAttempt),
+					NoWire: resetactivityattempt_NoWireHandler{impl},
+				},
+				Signature:    "ResetActivityAttempt(ResetRequest *history.ResetActivityAttemptRequest)",
+				ThriftModule: history.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "ResetQueue",
 				HandlerSpec: thrift.HandlerSpec{
@@ -678,6 +805,18 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: history.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "RetryActivityNow",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.RetryActivityNow),
+					NoWire: retryactivitynow_NoWireHandler{impl},
+				},
+				Signature:    "RetryActivityNow(RetryRequest *history.RetryActivityNowRequest)",
+				ThriftModule: history.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "ScheduleDecisionTask",
 				HandlerSpec: thrift.HandlerSpec{
@@ -761,10 +900,58 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				Signature:    "TerminateWorkflowExecution(TerminateRequest *history.TerminateWorkflowExecutionRequest)",
 				ThriftModule: history.ThriftModule,
 			},
+
+			thrift.Method{
+				Name: "UnpauseActivity",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.UnpauseActivity),
+					NoWire: unpauseactivity_NoWireHandler{impl},
+				},
+				Signature:    "UnpauseActivity(UnpauseRequest *history.UnpauseActivityRequest)",
+				ThriftModule: history.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "UnpauseWorkflowExecution",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.UnpauseWorkflowExecution),
+					NoWire: unpauseworkflowexecution_NoWireHandler{impl},
+				},
+				Signature:    "UnpauseWorkflowExecution(UnpauseRequest *history.UnpauseWorkflowExecutionRequest)",
+				ThriftModule: history.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "UpdateWorkflowExecution",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.UpdateWorkflowExecution),
+					NoWire: updateworkflowexecution_NoWireHandler{impl},
+				},
+				Signature:    "UpdateWorkflowExecution(UpdateRequest *history.UpdateWorkflowExecutionRequest) (*shared.UpdateWorkflowExecutionResponse)",
+				ThriftModule: history.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "UpsertWorkflowSearchAttributes",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.UpsertWorkflowSearchAttributes),
+					NoWire: upsertworkflowsearchattributes_NoWireHandler{impl},
+				},
+				Signature:    "UpsertWorkflowSearchAttributes(UpsertRequest *history.UpsertWorkflowSearchAttributesRequest)",
+				ThriftModule: history.ThriftModule,
+			},
 		},
 	}
 
-	procedures := make([]transport.Procedure, 0, 43)
+	procedures := make([]transport.Procedure, 0, 54)
 	procedures = append(procedures, thrift.BuildProcedures(service, opts...)...)
 	return procedures
 }
@@ -805,6 +992,36 @@ func (h handler) CloseShard(ctx context.Context, body wire.Value) (thrift.Respon
 	return response, err
 }
 
+func (h handler) DeleteWorkflowExecution(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_DeleteWorkflowExecution_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'HistoryService' procedure 'DeleteWorkflowExecution': %w", err)
+	}
+
+	appErr := h.impl.DeleteWorkflowExecution(ctx, args.DeleteRequest)
+
+	hadError := appErr != nil
+	result, err := history.HistoryService_DeleteWorkflowExecution_Helper.WrapResponse(appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
 func (h handler) DescribeHistoryHost(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args history.HistoryService_DescribeHistoryHost_Args
 	if err := args.FromWire(body); err != nil {
@@ -1135,6 +1352,66 @@ func (h handler) NotifyFailoverMarkers(ctx context.Context, body wire.Value) (th
 	return response, err
 }
 
+func (h handler) PauseActivity(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_PauseActivity_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'HistoryService' procedure 'PauseActivity': %w", err)
+	}
+
+	appErr := h.impl.PauseActivity(ctx, args.PauseRequest)
+
+	hadError := appErr != nil
+	result, err := history.HistoryService_PauseActivity_Helper.WrapResponse(appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
+func (h handler) PauseWorkflowExecution(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_PauseWorkflowExecution_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'HistoryService' procedure 'PauseWorkflowExecution': %w", err)
+	}
+
+	appErr := h.impl.PauseWorkflowExecution(ctx, args.PauseRequest)
+
+	hadError := appErr != nil
+	result, err := history.HistoryService_PauseWorkflowExecution_Helper.WrapResponse(appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
 func (h handler) PollMutableState(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args history.HistoryService_PollMutableState_Args
 	if err := args.FromWire(body); err != nil {
@@ -1525,17 +1802,17 @@ func (h handler) RemoveTask(ctx context.Context, body wire.Value) (thrift.Respon
 	return response, err
 }
 
-func (h handler) ReplicateEventsV2(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args history.HistoryService_ReplicateEventsV2_Args
+func (h handler) ReplicateDeleteWorkflowExecution(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_ReplicateDeleteWorkflowExecution_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'HistoryService' procedure 'ReplicateEventsV2': %w", err)
+			"could not decode Thrift request for service 'HistoryService' procedure 'ReplicateDeleteWorkflowExecution': %w", err)
 	}
 
-	appErr := h.impl.ReplicateEventsV2(ctx, args.ReplicateV2Request)
+	appErr := h.impl.ReplicateDeleteWorkflowExecution(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := history.HistoryService_ReplicateEventsV2_Helper.WrapResponse(appErr)
+	result, err := history.HistoryService_ReplicateDeleteWorkflowExecution_Helper.WrapResponse(appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1555,17 +1832,17 @@ func (h handler) ReplicateEventsV2(ctx context.Context, body wire.Value) (thrift
 	return response, err
 }
 
-func (h handler) RequestCancelWorkflowExecution(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args history.HistoryService_RequestCancelWorkflowExecution_Args
+func (h handler) ReplicateEventsV2(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_ReplicateEventsV2_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'HistoryService' procedure 'RequestCancelWorkflowExecution': %w", err)
+			"could not decode Thrift request for service 'HistoryService' procedure 'ReplicateEventsV2': %w", err)
 	}
 
-	appErr := h.impl.RequestCancelWorkflowExecution(ctx, args.CancelRequest)
+	appErr := h.impl.ReplicateEventsV2(ctx, args.ReplicateV2Request)
 
 	hadError := appErr != nil
-	result, err := history.HistoryService_RequestCancelWorkflowExecution_Helper.WrapResponse(appErr)
+	result, err := history.HistoryService_ReplicateEventsV2_Helper.WrapResponse(appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1585,17 +1862,17 @@ func (h handler) RequestCancelWorkflowExecution(ctx context.Context, body wire.V
 	return response, err
 }
 
-func (h handler) ResetQueue(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args history.HistoryService_ResetQueue_Args
+func (h handler) ReplicateUpsertWorkflowSearchAttributes(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_ReplicateUpsertWorkflowSearchAttributes_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'HistoryService' procedure 'ResetQueue': %w", err)
+			"could not decode Thrift request for service 'HistoryService' procedure 'ReplicateUpsertWorkflowSearchAttributes': %w", err)
 	}
 
-	appErr := h.impl.ResetQueue(ctx, args.Request)
+	appErr := h.impl.ReplicateUpsertWorkflowSearchAttributes(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := history.HistoryService_ResetQueue_Helper.WrapResponse(appErr)
+	result, err := history.HistoryService_ReplicateUpsertWorkflowSearchAttributes_Helper.WrapResponse(appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1615,17 +1892,17 @@ func (h handler) ResetQueue(ctx context.Context, body wire.Value) (thrift.Respon
 	return response, err
 }
 
-func (h handler) ResetStickyTaskList(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args history.HistoryService_ResetStickyTaskList_Args
+func (h handler) RequestCancelWorkflowExecution(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_RequestCancelWorkflowExecution_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'HistoryService' procedure 'ResetStickyTaskList': %w", err)
+			"could not decode Thrift request for service 'HistoryService' procedure 'RequestCancelWorkflowExecution': %w", err)
 	}
 
-	success, appErr := h.impl.ResetStickyTaskList(ctx, args.ResetRequest)
+	appErr := h.impl.RequestCancelWorkflowExecution(ctx, args.CancelRequest)
 
 	hadError := appErr != nil
-	result, err := history.HistoryService_ResetStickyTaskList_Helper.WrapResponse(success, appErr)
+	result, err := history.HistoryService_RequestCancelWorkflowExecution_Helper.WrapResponse(appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1645,17 +1922,17 @@ func (h handler) ResetStickyTaskList(ctx context.Context, body wire.Value) (thri
 	return response, err
 }
 
-func (h handler) ResetWorkflowExecution(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args history.HistoryService_ResetWorkflowExecution_Args
+func (h handler) ResetActivityAttempt(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_ResetActivityAttempt_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'HistoryService' procedure 'ResetWorkflowExecution': %w", err)
+			"could not decode Thrift request for service 'HistoryService' procedure 'ResetActivityAttempt': %w", err)
 	}
 
-	success, appErr := h.impl.ResetWorkflowExecution(ctx, args.ResetRequest)
+	appErr := h.impl.ResetActivityAttempt(ctx, args.ResetRequest)
 
 	hadError := appErr != nil
-	result, err := history.HistoryService_ResetWorkflowExecution_Helper.WrapResponse(success, appErr)
+	result, err := history.HistoryService_ResetActivityAttempt_Helper.WrapResponse(appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1675,17 +1952,17 @@ func (h handler) ResetWorkflowExecution(ctx context.Context, body wire.Value) (t
 	return response, err
 }
 
-func (h handler) RespondActivityTaskCanceled(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args history.HistoryService_RespondActivityTaskCanceled_Args
+func (h handler) ResetQueue(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_ResetQueue_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'HistoryService' procedure 'RespondActivityTaskCanceled': %w", err)
+			"could not decode Thrift request for service 'HistoryService' procedure 'ResetQueue': %w", err)
 	}
 
-	appErr := h.impl.RespondActivityTaskCanceled(ctx, args.CanceledRequest)
+	appErr := h.impl.ResetQueue(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := history.HistoryService_RespondActivityTaskCanceled_Helper.WrapResponse(appErr)
+	result, err := history.HistoryService_ResetQueue_Helper.WrapResponse(appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1705,17 +1982,17 @@ func (h handler) RespondActivityTaskCanceled(ctx context.Context, body wire.Valu
 	return response, err
 }
 
-func (h handler) RespondActivityTaskCompleted(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args history.HistoryService_RespondActivityTaskCompleted_Args
+func (h handler) ResetStickyTaskList(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_ResetStickyTaskList_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'HistoryService' procedure 'RespondActivityTaskCompleted': %w", err)
+			"could not decode Thrift request for service 'HistoryService' procedure 'ResetStickyTaskList': %w", err)
 	}
 
-	appErr := h.impl.RespondActivityTaskCompleted(ctx, args.CompleteRequest)
+	success, appErr := h.impl.ResetStickyTaskList(ctx, args.ResetRequest)
 
 	hadError := appErr != nil
-	result, err := history.HistoryService_RespondActivityTaskCompleted_Helper.WrapResponse(appErr)
+	result, err := history.HistoryService_ResetStickyTaskList_Helper.WrapResponse(success, appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1735,11 +2012,101 @@ func (h handler) RespondActivityTaskCompleted(ctx context.Context, body wire.Val
 	return response, err
 }
 
-func (h handler) RespondActivityTaskFailed(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args history.HistoryService_RespondActivityTaskFailed_Args
+func (h handler) ResetWorkflowExecution(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_ResetWorkflowExecution_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'HistoryService' procedure 'RespondActivityTaskFailed': %w", err)
+			"could not decode Thrift request for service 'HistoryService' procedure 'ResetWorkflowExecution': %w", err)
+	}
+
+	success, appErr := h.impl.ResetWorkflowExecution(ctx, args.ResetRequest)
+
+	hadError := appErr != nil
+	result, err := history.HistoryService_ResetWorkflowExecution_Helper.WrapResponse(success, appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
+func (h handler) RespondActivityTaskCanceled(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_RespondActivityTaskCanceled_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'HistoryService' procedure 'RespondActivityTaskCanceled': %w", err)
+	}
+
+	appErr := h.impl.RespondActivityTaskCanceled(ctx, args.CanceledRequest)
+
+	hadError := appErr != nil
+	result, err := history.HistoryService_RespondActivityTaskCanceled_Helper.WrapResponse(appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
+func (h handler) RespondActivityTaskCompleted(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_RespondActivityTaskCompleted_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'HistoryService' procedure 'RespondActivityTaskCompleted': %w", err)
+	}
+
+	appErr := h.impl.RespondActivityTaskCompleted(ctx, args.CompleteRequest)
+
+	hadError := appErr != nil
+	result, err := history.HistoryService_RespondActivityTaskCompleted_Helper.WrapResponse(appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
+func (h handler) RespondActivityTaskFailed(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_RespondActivityTaskFailed_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'HistoryService' procedure 'RespondActivityTaskFailed': %w", err)
 	}
 
 	appErr := h.impl.RespondActivityTaskFailed(ctx, args.FailRequest)
@@ -1855,6 +2222,36 @@ func (h handler) RespondDecisionTaskFailed(ctx context.Context, body wire.Value)
 	return response, err
 }
 
+func (h handler) RetryActivityNow(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_RetryActivityNow_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'HistoryService' procedure 'RetryActivityNow': %w", err)
+	}
+
+	appErr := h.impl.RetryActivityNow(ctx, args.RetryRequest)
+
+	hadError := appErr != nil
+	result, err := history.HistoryService_RetryActivityNow_Helper.WrapResponse(appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
 func (h handler) ScheduleDecisionTask(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args history.HistoryService_ScheduleDecisionTask_Args
 	if err := args.FromWire(body); err != nil {
@@ -2065,6 +2462,126 @@ func (h handler) TerminateWorkflowExecution(ctx context.Context, body wire.Value
 	return response, err
 }
 
+func (h handler) UnpauseActivity(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_UnpauseActivity_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'HistoryService' procedure 'UnpauseActivity': %w", err)
+	}
+
+	appErr := h.impl.UnpauseActivity(ctx, args.UnpauseRequest)
+
+	hadError := appErr != nil
+	result, err := history.HistoryService_UnpauseActivity_Helper.WrapResponse(appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
+func (h handler) UnpauseWorkflowExecution(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_UnpauseWorkflowExecution_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'HistoryService' procedure 'UnpauseWorkflowExecution': %w", err)
+	}
+
+	appErr := h.impl.UnpauseWorkflowExecution(ctx, args.UnpauseRequest)
+
+	hadError := appErr != nil
+	result, err := history.HistoryService_UnpauseWorkflowExecution_Helper.WrapResponse(appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
+func (h handler) UpdateWorkflowExecution(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_UpdateWorkflowExecution_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'HistoryService' procedure 'UpdateWorkflowExecution': %w", err)
+	}
+
+	success, appErr := h.impl.UpdateWorkflowExecution(ctx, args.UpdateRequest)
+
+	hadError := appErr != nil
+	result, err := history.HistoryService_UpdateWorkflowExecution_Helper.WrapResponse(success, appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
+func (h handler) UpsertWorkflowSearchAttributes(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_UpsertWorkflowSearchAttributes_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'HistoryService' procedure 'UpsertWorkflowSearchAttributes': %w", err)
+	}
+
+	appErr := h.impl.UpsertWorkflowSearchAttributes(ctx, args.UpsertRequest)
+
+	hadError := appErr != nil
+	result, err := history.HistoryService_UpsertWorkflowSearchAttributes_Helper.WrapResponse(appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
 type closeshard_NoWireHandler struct{ impl Interface }
 
 func (h closeshard_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
@@ -2102,6 +2619,43 @@ func (h closeshard_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.
 
 }
 
+type deleteworkflowexecution_NoWireHandler struct{ impl Interface }
+
+func (h deleteworkflowexecution_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args history.HistoryService_DeleteWorkflowExecution_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'HistoryService' procedure 'DeleteWorkflowExecution': %w", err)
+	}
+
+	appErr := h.impl.DeleteWorkflowExecution(ctx, args.DeleteRequest)
+
+	hadError := appErr != nil
+	result, err := history.HistoryService_DeleteWorkflowExecution_Helper.WrapResponse(appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
 type describehistoryhost_NoWireHandler struct{ impl Interface }
 
 func (h describehistoryhost_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
@@ -2450,10 +3004,84 @@ func (h mergedlqmessages_NoWireHandler) HandleNoWire(ctx context.Context, nwc *t
 			"could not decode (via no wire) Thrift request for service 'HistoryService' procedure 'MergeDLQMessages': %w", err)
 	}
 
-	success, appErr := h.impl.MergeDLQMessages(ctx, args.Request)
+	success, appErr := h.impl.MergeDLQMessages(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := history.HistoryService_MergeDLQMessages_Helper.WrapResponse(success, appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
+type notifyfailovermarkers_NoWireHandler struct{ impl Interface }
+
+func (h notifyfailovermarkers_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args history.HistoryService_NotifyFailoverMarkers_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'HistoryService' procedure 'NotifyFailoverMarkers': %w", err)
+	}
+
+	appErr := h.impl.NotifyFailoverMarkers(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := history.HistoryService_NotifyFailoverMarkers_Helper.WrapResponse(appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
+type pauseactivity_NoWireHandler struct{ impl Interface }
+
+func (h pauseactivity_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args history.HistoryService_PauseActivity_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'HistoryService' procedure 'PauseActivity': %w", err)
+	}
+
+	appErr := h.impl.PauseActivity(ctx, args.PauseRequest)
 
 	hadError := appErr != nil
-	result, err := history.HistoryService_MergeDLQMessages_Helper.WrapResponse(success, appErr)
+	result, err := history.HistoryService_PauseActivity_Helper.WrapResponse(appErr)
 	response := thrift.NoWireResponse{ResponseWriter: rw}
 	if err == nil {
 		response.IsApplicationError = hadError
@@ -2472,11 +3100,11 @@ func (h mergedlqmessages_NoWireHandler) HandleNoWire(ctx context.Context, nwc *t
 
 }
 
-type notifyfailovermarkers_NoWireHandler struct{ impl Interface }
+type pauseworkflowexecution_NoWireHandler struct{ impl Interface }
 
-func (h notifyfailovermarkers_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+func (h pauseworkflowexecution_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
 	var (
-		args history.HistoryService_NotifyFailoverMarkers_Args
+		args history.HistoryService_PauseWorkflowExecution_Args
 		rw   stream.ResponseWriter
 		err  error
 	)
@@ -2484,13 +3112,13 @@ func (h notifyfailovermarkers_NoWireHandler) HandleNoWire(ctx context.Context, n
 	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
 	if err != nil {
 		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode (via no wire) Thrift request for service 'HistoryService' procedure 'NotifyFailoverMarkers': %w", err)
+			"could not decode (via no wire) Thrift request for service 'HistoryService' procedure 'PauseWorkflowExecution': %w", err)
 	}
 
-	appErr := h.impl.NotifyFailoverMarkers(ctx, args.Request)
+	appErr := h.impl.PauseWorkflowExecution(ctx, args.PauseRequest)
 
 	hadError := appErr != nil
-	result, err := history.HistoryService_NotifyFailoverMarkers_Helper.WrapResponse(appErr)
+	result, err := history.HistoryService_PauseWorkflowExecution_Helper.WrapResponse(appErr)
 	response := thrift.NoWireResponse{ResponseWriter: rw}
 	if err == nil {
 		response.IsApplicationError = hadError
@@ -2990,6 +3618,43 @@ func (h removetask_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.
 
 }
 
+type replicatedeleteworkflowexecution_NoWireHandler struct{ impl Interface }
+
+func (h replicatedeleteworkflowexecution_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args history.HistoryService_ReplicateDeleteWorkflowExecution_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'HistoryService' procedure 'ReplicateDeleteWorkflowExecution': %w", err)
+	}
+
+	appErr := h.impl.ReplicateDeleteWorkflowExecution(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := history.HistoryService_ReplicateDeleteWorkflowExecution_Helper.WrapResponse(appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
 type replicateeventsv2_NoWireHandler struct{ impl Interface }
 
 func (h replicateeventsv2_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
@@ -3027,6 +3692,43 @@ func (h replicateeventsv2_NoWireHandler) HandleNoWire(ctx context.Context, nwc *
 
 }
 
+type replicateupsertworkflowsearchattributes_NoWireHandler struct{ impl Interface }
+
+func (h replicateupsertworkflowsearchattributes_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args history.HistoryService_ReplicateUpsertWorkflowSearchAttributes_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'HistoryService' procedure 'ReplicateUpsertWorkflowSearchAttributes': %w", err)
+	}
+
+	appErr := h.impl.ReplicateUpsertWorkflowSearchAttributes(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := history.HistoryService_ReplicateUpsertWorkflowSearchAttributes_Helper.WrapResponse(appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
 type requestcancelworkflowexecution_NoWireHandler struct{ impl Interface }
 
 func (h requestcancelworkflowexecution_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
@@ -3064,6 +3766,43 @@ func (h requestcancelworkflowexecution_NoWireHandler) HandleNoWire(ctx context.C
 
 }
 
+type resetactivityattempt_NoWireHandler struct{ impl Interface }
+
+func (h resetactivityattempt_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args history.HistoryService_ResetActivityAttempt_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'HistoryService' procedure 'ResetActivityAttempt': %w", err)
+	}
+
+	appErr := h.impl.ResetActivityAttempt(ctx, args.ResetRequest)
+
+	hadError := appErr != nil
+	result, err := history.HistoryService_ResetActivityAttempt_Helper.WrapResponse(appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
 type resetqueue_NoWireHandler struct{ impl Interface }
 
 func (h resetqueue_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
@@ -3397,6 +4136,43 @@ func (h responddecisiontaskfailed_NoWireHandler) HandleNoWire(ctx context.Contex
 
 }
 
+type retryactivitynow_NoWireHandler struct{ impl Interface }
+
+func (h retryactivitynow_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args history.HistoryService_RetryActivityNow_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'HistoryService' procedure 'RetryActivityNow': %w", err)
+	}
+
+	appErr := h.impl.RetryActivityNow(ctx, args.RetryRequest)
+
+	hadError := appErr != nil
+	result, err := history.HistoryService_RetryActivityNow_Helper.WrapResponse(appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
 type scheduledecisiontask_NoWireHandler struct{ impl Interface }
 
 func (h scheduledecisiontask_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
@@ -3655,3 +4431,151 @@ func (h terminateworkflowexecution_NoWireHandler) HandleNoWire(ctx context.Conte
 	return response, err
 
 }
+
+type unpauseactivity_NoWireHandler struct{ impl Interface }
+
+func (h unpauseactivity_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args history.HistoryService_UnpauseActivity_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'HistoryService' procedure 'UnpauseActivity': %w", err)
+	}
+
+	appErr := h.impl.UnpauseActivity(ctx, args.UnpauseRequest)
+
+	hadError := appErr != nil
+	result, err := history.HistoryService_UnpauseActivity_Helper.WrapResponse(appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
+type unpauseworkflowexecution_NoWireHandler struct{ impl Interface }
+
+func (h unpauseworkflowexecution_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args history.HistoryService_UnpauseWorkflowExecution_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'HistoryService' procedure 'UnpauseWorkflowExecution': %w", err)
+	}
+
+	appErr := h.impl.UnpauseWorkflowExecution(ctx, args.UnpauseRequest)
+
+	hadError := appErr != nil
+	result, err := history.HistoryService_UnpauseWorkflowExecution_Helper.WrapResponse(appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
+type updateworkflowexecution_NoWireHandler struct{ impl Interface }
+
+func (h updateworkflowexecution_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args history.HistoryService_UpdateWorkflowExecution_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'HistoryService' procedure 'UpdateWorkflowExecution': %w", err)
+	}
+
+	success, appErr := h.impl.UpdateWorkflowExecution(ctx, args.UpdateRequest)
+
+	hadError := appErr != nil
+	result, err := history.HistoryService_UpdateWorkflowExecution_Helper.WrapResponse(success, appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
+type upsertworkflowsearchattributes_NoWireHandler struct{ impl Interface }
+
+func (h upsertworkflowsearchattributes_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args history.HistoryService_UpsertWorkflowSearchAttributes_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'HistoryService' procedure 'UpsertWorkflowSearchAttributes': %w", err)
+	}
+
+	appErr := h.impl.UpsertWorkflowSearchAttributes(ctx, args.UpsertRequest)
+
+	hadError := appErr != nil
+	result, err := history.HistoryService_UpsertWorkflowSearchAttributes_Helper.WrapResponse(appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
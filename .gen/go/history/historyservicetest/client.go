@@ -77,6 +77,37 @@ func (mr *_MockClientRecorder) CloseShard(
 	return mr.mock.ctrl.RecordCall(mr.mock, "CloseShard", args...)
 }
 
+// DeleteWorkflowExecution responds to a DeleteWorkflowExecution call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().DeleteWorkflowExecution(gomock.Any(), ...).Return(...)
+//	... := client.DeleteWorkflowExecution(...)
+func (m *MockClient) DeleteWorkflowExecution(
+	ctx context.Context,
+	_DeleteRequest *history.DeleteWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _DeleteRequest}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "DeleteWorkflowExecution", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) DeleteWorkflowExecution(
+	ctx interface{},
+	_DeleteRequest interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _DeleteRequest}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "DeleteWorkflowExecution", args...)
+}
+
 // DescribeHistoryHost responds to a DescribeHistoryHost call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -438,6 +469,68 @@ func (mr *_MockClientRecorder) NotifyFailoverMarkers(
 	return mr.mock.ctrl.RecordCall(mr.mock, "NotifyFailoverMarkers", args...)
 }
 
+// PauseActivity responds to a PauseActivity call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().PauseActivity(gomock.Any(), ...).Return(...)
+//	... := client.PauseActivity(...)
+func (m *MockClient) PauseActivity(
+	ctx context.Context,
+	_PauseRequest *history.PauseActivityRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _PauseRequest}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "PauseActivity", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) PauseActivity(
+	ctx interface{},
+	_PauseRequest interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _PauseRequest}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "PauseActivity", args...)
+}
+
+// PauseWorkflowExecution responds to a PauseWorkflowExecution call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().PauseWorkflowExecution(gomock.Any(), ...).Return(...)
+//	... := client.PauseWorkflowExecution(...)
+func (m *MockClient) PauseWorkflowExecution(
+	ctx context.Context,
+	_PauseRequest *history.PauseWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _PauseRequest}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "PauseWorkflowExecution", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) PauseWorkflowExecution(
+	ctx interface{},
+	_PauseRequest interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _PauseRequest}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "PauseWorkflowExecution", args...)
+}
+
 // PollMutableState responds to a PollMutableState call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -855,6 +948,37 @@ func (mr *_MockClientRecorder) RemoveTask(
 	return mr.mock.ctrl.RecordCall(mr.mock, "RemoveTask", args...)
 }
 
+// ReplicateDeleteWorkflowExecution responds to a ReplicateDeleteWorkflowExecution call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().ReplicateDeleteWorkflowExecution(gomock.Any(), ...).Return(...)
+//	... := client.ReplicateDeleteWorkflowExecution(...)
+func (m *MockClient) ReplicateDeleteWorkflowExecution(
+	ctx context.Context,
+	_Request *replicator.DeleteWorkflowExecutionTaskAttributes,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "ReplicateDeleteWorkflowExecution", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) ReplicateDeleteWorkflowExecution(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "ReplicateDeleteWorkflowExecution", args...)
+}
+
 // ReplicateEventsV2 responds to a ReplicateEventsV2 call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -886,6 +1010,37 @@ func (mr *_MockClientRecorder) ReplicateEventsV2(
 	return mr.mock.ctrl.RecordCall(mr.mock, "ReplicateEventsV2", args...)
 }
 
+// ReplicateUpsertWorkflowSearchAttributes responds to a ReplicateUpsertWorkflowSearchAttributes call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().ReplicateUpsertWorkflowSearchAttributes(gomock.Any(), ...).Return(...)
+//	... := client.ReplicateUpsertWorkflowSearchAttributes(...)
+func (m *MockClient) ReplicateUpsertWorkflowSearchAttributes(
+	ctx context.Context,
+	_Request *replicator.UpsertWorkflowSearchAttributesTaskAttributes,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "ReplicateUpsertWorkflowSearchAttributes", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) ReplicateUpsertWorkflowSearchAttributes(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "ReplicateUpsertWorkflowSearchAttributes", args...)
+}
+
 // RequestCancelWorkflowExecution responds to a RequestCancelWorkflowExecution call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -917,6 +1072,37 @@ func (mr *_MockClientRecorder) RequestCancelWorkflowExecution(
 	return mr.mock.ctrl.RecordCall(mr.mock, "RequestCancelWorkflowExecution", args...)
 }
 
+// ResetActivityAttempt responds to a ResetActivityAttempt call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().ResetActivityAttempt(gomock.Any(), ...).Return(...)
+//	... := client.ResetActivityAttempt(...)
+func (m *MockClient) ResetActivityAttempt(
+	ctx context.Context,
+	_ResetRequest *history.ResetActivityAttemptRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _ResetRequest}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "ResetActivityAttempt", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) ResetActivityAttempt(
+	ctx interface{},
+	_ResetRequest interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _ResetRequest}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "ResetActivityAttempt", args...)
+}
+
 // ResetQueue responds to a ResetQueue call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -1204,6 +1390,37 @@ func (mr *_MockClientRecorder) RespondDecisionTaskFailed(
 	return mr.mock.ctrl.RecordCall(mr.mock, "RespondDecisionTaskFailed", args...)
 }
 
+// RetryActivityNow responds to a RetryActivityNow call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().RetryActivityNow(gomock.Any(), ...).Return(...)
+//	... := client.RetryActivityNow(...)
+func (m *MockClient) RetryActivityNow(
+	ctx context.Context,
+	_RetryRequest *history.RetryActivityNowRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _RetryRequest}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "RetryActivityNow", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) RetryActivityNow(
+	ctx interface{},
+	_RetryRequest interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _RetryRequest}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "RetryActivityNow", args...)
+}
+
 // ScheduleDecisionTask responds to a ScheduleDecisionTask call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -1424,3 +1641,129 @@ func (mr *_MockClientRecorder) TerminateWorkflowExecution(
 	args := append([]interface{}{ctx, _TerminateRequest}, opts...)
 	return mr.mock.ctrl.RecordCall(mr.mock, "TerminateWorkflowExecution", args...)
 }
+
+// UnpauseActivity responds to a UnpauseActivity call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().UnpauseActivity(gomock.Any(), ...).Return(...)
+//	... := client.UnpauseActivity(...)
+func (m *MockClient) UnpauseActivity(
+	ctx context.Context,
+	_UnpauseRequest *history.UnpauseActivityRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _UnpauseRequest}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "UnpauseActivity", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) UnpauseActivity(
+	ctx interface{},
+	_UnpauseRequest interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _UnpauseRequest}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "UnpauseActivity", args...)
+}
+
+// UnpauseWorkflowExecution responds to a UnpauseWorkflowExecution call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().UnpauseWorkflowExecution(gomock.Any(), ...).Return(...)
+//	... := client.UnpauseWorkflowExecution(...)
+func (m *MockClient) UnpauseWorkflowExecution(
+	ctx context.Context,
+	_UnpauseRequest *history.UnpauseWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _UnpauseRequest}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "UnpauseWorkflowExecution", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) UnpauseWorkflowExecution(
+	ctx interface{},
+	_UnpauseRequest interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _UnpauseRequest}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "UnpauseWorkflowExecution", args...)
+}
+
+// UpdateWorkflowExecution responds to a UpdateWorkflowExecution call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().UpdateWorkflowExecution(gomock.Any(), ...).Return(...)
+//	... := client.UpdateWorkflowExecution(...)
+func (m *MockClient) UpdateWorkflowExecution(
+	ctx context.Context,
+	_UpdateRequest *history.UpdateWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.UpdateWorkflowExecutionResponse, err error) {
+
+	args := []interface{}{ctx, _UpdateRequest}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "UpdateWorkflowExecution", args...)
+	success, _ = ret[i].(*shared.UpdateWorkflowExecutionResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) UpdateWorkflowExecution(
+	ctx interface{},
+	_UpdateRequest interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _UpdateRequest}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "UpdateWorkflowExecution", args...)
+}
+
+// UpsertWorkflowSearchAttributes responds to a UpsertWorkflowSearchAttributes call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().UpsertWorkflowSearchAttributes(gomock.Any(), ...).Return(...)
+//	... := client.UpsertWorkflowSearchAttributes(...)
+func (m *MockClient) UpsertWorkflowSearchAttributes(
+	ctx context.Context,
+	_UpsertRequest *history.UpsertWorkflowSearchAttributesRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _UpsertRequest}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "UpsertWorkflowSearchAttributes", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) UpsertWorkflowSearchAttributes(
+	ctx interface{},
+	_UpsertRequest interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _UpsertRequest}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "UpsertWorkflowSearchAttributes", args...)
+}
@@ -220,6 +220,428 @@ func (v *DLQType) UnmarshalJSON(text []byte) error {
 	}
 }
 
+type DeleteWorkflowExecutionTaskAttributes struct {
+	DomainId   *string `json:"domainId,omitempty"`
+	WorkflowId *string `json:"workflowId,omitempty"`
+	RunId      *string `json:"runId,omitempty"`
+	Version    *int64  `json:"version,omitempty"`
+}
+
+// ToWire translates a DeleteWorkflowExecutionTaskAttributes struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//	x, err := v.ToWire()
+//	if err != nil {
+//		return err
+//	}
+//
+//	if err := binaryProtocol.Encode(x, writer); err != nil {
+//		return err
+//	}
+func (v *DeleteWorkflowExecutionTaskAttributes) ToWire() (wire.Value, error) {
+	var (
+		fields [4]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.DomainId != nil {
+		w, err = wire.NewValueString(*(v.DomainId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.WorkflowId != nil {
+		w, err = wire.NewValueString(*(v.WorkflowId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.RunId != nil {
+		w, err = wire.NewValueString(*(v.RunId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.Version != nil {
+		w, err = wire.NewValueI64(*(v.Version)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a DeleteWorkflowExecutionTaskAttributes struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a DeleteWorkflowExecutionTaskAttributes struct
+// from the provided intermediate representation.
+//
+//	x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//	if err != nil {
+//		return nil, err
+//	}
+//
+//	var v DeleteWorkflowExecutionTaskAttributes
+//	if err := v.FromWire(x); err != nil {
+//		return nil, err
+//	}
+//	return &v, nil
+func (v *DeleteWorkflowExecutionTaskAttributes) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.DomainId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.WorkflowId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.RunId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.Version = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// Encode serializes a DeleteWorkflowExecutionTaskAttributes struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a DeleteWorkflowExecutionTaskAttributes struct could not be encoded.
+func (v *DeleteWorkflowExecutionTaskAttributes) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.DomainId != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.DomainId)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.WorkflowId != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.WorkflowId)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.RunId != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.RunId)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Version != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 40, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.Version)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a DeleteWorkflowExecutionTaskAttributes struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a DeleteWorkflowExecutionTaskAttributes struct could not be generated from the wire
+// representation.
+func (v *DeleteWorkflowExecutionTaskAttributes) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 10 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.DomainId = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 20 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.WorkflowId = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 30 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.RunId = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 40 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.Version = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a DeleteWorkflowExecutionTaskAttributes
+// struct.
+func (v *DeleteWorkflowExecutionTaskAttributes) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [4]string
+	i := 0
+	if v.DomainId != nil {
+		fields[i] = fmt.Sprintf("DomainId: %v", *(v.DomainId))
+		i++
+	}
+	if v.WorkflowId != nil {
+		fields[i] = fmt.Sprintf("WorkflowId: %v", *(v.WorkflowId))
+		i++
+	}
+	if v.RunId != nil {
+		fields[i] = fmt.Sprintf("RunId: %v", *(v.RunId))
+		i++
+	}
+	if v.Version != nil {
+		fields[i] = fmt.Sprintf("Version: %v", *(v.Version))
+		i++
+	}
+
+	return fmt.Sprintf("DeleteWorkflowExecutionTaskAttributes{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _String_EqualsPtr(lhs, rhs *string) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+func _I64_EqualsPtr(lhs, rhs *int64) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this DeleteWorkflowExecutionTaskAttributes match the
+// provided DeleteWorkflowExecutionTaskAttributes.
+//
+// This function performs a deep comparison.
+func (v *DeleteWorkflowExecutionTaskAttributes) Equals(rhs *DeleteWorkflowExecutionTaskAttributes) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.DomainId, rhs.DomainId) {
+		return false
+	}
+	if !_String_EqualsPtr(v.WorkflowId, rhs.WorkflowId) {
+		return false
+	}
+	if !_String_EqualsPtr(v.RunId, rhs.RunId) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.Version, rhs.Version) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of DeleteWorkflowExecutionTaskAttributes.
+func (v *DeleteWorkflowExecutionTaskAttributes) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.DomainId != nil {
+		enc.AddString("domainId", *v.DomainId)
+	}
+	if v.WorkflowId != nil {
+		enc.AddString("workflowId", *v.WorkflowId)
+	}
+	if v.RunId != nil {
+		enc.AddString("runId", *v.RunId)
+	}
+	if v.Version != nil {
+		enc.AddInt64("version", *v.Version)
+	}
+	return err
+}
+
+// GetDomainId returns the value of DomainId if it is set or its
+// zero value if it is unset.
+func (v *DeleteWorkflowExecutionTaskAttributes) GetDomainId() (o string) {
+	if v != nil && v.DomainId != nil {
+		return *v.DomainId
+	}
+
+	return
+}
+
+// IsSetDomainId returns true if DomainId is not nil.
+func (v *DeleteWorkflowExecutionTaskAttributes) IsSetDomainId() bool {
+	return v != nil && v.DomainId != nil
+}
+
+// GetWorkflowId returns the value of WorkflowId if it is set or its
+// zero value if it is unset.
+func (v *DeleteWorkflowExecutionTaskAttributes) GetWorkflowId() (o string) {
+	if v != nil && v.WorkflowId != nil {
+		return *v.WorkflowId
+	}
+
+	return
+}
+
+// IsSetWorkflowId returns true if WorkflowId is not nil.
+func (v *DeleteWorkflowExecutionTaskAttributes) IsSetWorkflowId() bool {
+	return v != nil && v.WorkflowId != nil
+}
+
+// GetRunId returns the value of RunId if it is set or its
+// zero value if it is unset.
+func (v *DeleteWorkflowExecutionTaskAttributes) GetRunId() (o string) {
+	if v != nil && v.RunId != nil {
+		return *v.RunId
+	}
+
+	return
+}
+
+// IsSetRunId returns true if RunId is not nil.
+func (v *DeleteWorkflowExecutionTaskAttributes) IsSetRunId() bool {
+	return v != nil && v.RunId != nil
+}
+
+// GetVersion returns the value of Version if it is set or its
+// zero value if it is unset.
+func (v *DeleteWorkflowExecutionTaskAttributes) GetVersion() (o int64) {
+	if v != nil && v.Version != nil {
+		return *v.Version
+	}
+
+	return
+}
+
+// IsSetVersion returns true if Version is not nil.
+func (v *DeleteWorkflowExecutionTaskAttributes) IsSetVersion() bool {
+	return v != nil && v.Version != nil
+}
+
 type DomainOperation int32
 
 const (
@@ -945,26 +1367,6 @@ func _DomainOperation_EqualsPtr(lhs, rhs *DomainOperation) bool {
 	return lhs == nil && rhs == nil
 }
 
-func _String_EqualsPtr(lhs, rhs *string) bool {
-	if lhs != nil && rhs != nil {
-
-		x := *lhs
-		y := *rhs
-		return (x == y)
-	}
-	return lhs == nil && rhs == nil
-}
-
-func _I64_EqualsPtr(lhs, rhs *int64) bool {
-	if lhs != nil && rhs != nil {
-
-		x := *lhs
-		y := *rhs
-		return (x == y)
-	}
-	return lhs == nil && rhs == nil
-}
-
 // Equals returns true if all the fields of this DomainTaskAttributes match the
 // provided DomainTaskAttributes.
 //
@@ -7090,14 +7492,16 @@ func (v *ReplicationMessages) IsSetSyncShardStatus() bool {
 }
 
 type ReplicationTask struct {
-	TaskType                      *ReplicationTaskType           `json:"taskType,omitempty"`
-	SourceTaskId                  *int64                         `json:"sourceTaskId,omitempty"`
-	DomainTaskAttributes          *DomainTaskAttributes          `json:"domainTaskAttributes,omitempty"`
-	SyncShardStatusTaskAttributes *SyncShardStatusTaskAttributes `json:"syncShardStatusTaskAttributes,omitempty"`
-	SyncActivityTaskAttributes    *SyncActivityTaskAttributes    `json:"syncActivityTaskAttributes,omitempty"`
-	HistoryTaskV2Attributes       *HistoryTaskV2Attributes       `json:"historyTaskV2Attributes,omitempty"`
-	FailoverMarkerAttributes      *FailoverMarkerAttributes      `json:"failoverMarkerAttributes,omitempty"`
-	CreationTime                  *int64                         `json:"creationTime,omitempty"`
+	TaskType                                     *ReplicationTaskType                          `json:"taskType,omitempty"`
+	SourceTaskId                                 *int64                                        `json:"sourceTaskId,omitempty"`
+	DomainTaskAttributes                         *DomainTaskAttributes                         `json:"domainTaskAttributes,omitempty"`
+	SyncShardStatusTaskAttributes                *SyncShardStatusTaskAttributes                `json:"syncShardStatusTaskAttributes,omitempty"`
+	SyncActivityTaskAttributes                   *SyncActivityTaskAttributes                   `json:"syncActivityTaskAttributes,omitempty"`
+	HistoryTaskV2Attributes                      *HistoryTaskV2Attributes                      `json:"historyTaskV2Attributes,omitempty"`
+	FailoverMarkerAttributes                     *FailoverMarkerAttributes                     `json:"failoverMarkerAttributes,omitempty"`
+	CreationTime                                 *int64                                        `json:"creationTime,omitempty"`
+	DeleteWorkflowExecutionTaskAttributes        *DeleteWorkflowExecutionTaskAttributes        `json:"deleteWorkflowExecutionTaskAttributes,omitempty"`
+	UpsertWorkflowSearchAttributesTaskAttributes *UpsertWorkflowSearchAttributesTaskAttributes `json:"upsertWorkflowSearchAttributesTaskAttributes,omitempty"`
 }
 
 // ToWire translates a ReplicationTask struct into a Thrift-level intermediate
@@ -7117,7 +7521,7 @@ type ReplicationTask struct {
 //	}
 func (v *ReplicationTask) ToWire() (wire.Value, error) {
 	var (
-		fields [8]wire.Field
+		fields [10]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -7187,6 +7591,22 @@ func (v *ReplicationTask) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 90, Value: w}
 		i++
 	}
+	if v.DeleteWorkflowExecutionTaskAttributes != nil {
+		w, err = v.DeleteWorkflowExecutionTaskAttributes.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 100, Value: w}
+		i++
+	}
+	if v.UpsertWorkflowSearchAttributesTaskAttributes != nil {
+		w, err = v.UpsertWorkflowSearchAttributesTaskAttributes.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 110, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -7221,6 +7641,18 @@ func _HistoryTaskV2Attributes_Read(w wire.Value) (*HistoryTaskV2Attributes, erro
 	return &v, err
 }
 
+func _DeleteWorkflowExecutionTaskAttributes_Read(w wire.Value) (*DeleteWorkflowExecutionTaskAttributes, error) {
+	var v DeleteWorkflowExecutionTaskAttributes
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _UpsertWorkflowSearchAttributesTaskAttributes_Read(w wire.Value) (*UpsertWorkflowSearchAttributesTaskAttributes, error) {
+	var v UpsertWorkflowSearchAttributesTaskAttributes
+	err := v.FromWire(w)
+	return &v, err
+}
+
 // FromWire deserializes a ReplicationTask struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
@@ -7312,6 +7744,22 @@ func (v *ReplicationTask) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 100:
+			if field.Value.Type() == wire.TStruct {
+				v.DeleteWorkflowExecutionTaskAttributes, err = _DeleteWorkflowExecutionTaskAttributes_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 110:
+			if field.Value.Type() == wire.TStruct {
+				v.UpsertWorkflowSearchAttributesTaskAttributes, err = _UpsertWorkflowSearchAttributesTaskAttributes_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -7424,6 +7872,30 @@ func (v *ReplicationTask) Encode(sw stream.Writer) error {
 		}
 	}
 
+	if v.DeleteWorkflowExecutionTaskAttributes != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 100, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.DeleteWorkflowExecutionTaskAttributes.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.UpsertWorkflowSearchAttributesTaskAttributes != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 110, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.UpsertWorkflowSearchAttributesTaskAttributes.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
 	return sw.WriteStructEnd()
 }
 
@@ -7457,6 +7929,18 @@ func _HistoryTaskV2Attributes_Decode(sr stream.Reader) (*HistoryTaskV2Attributes
 	return &v, err
 }
 
+func _DeleteWorkflowExecutionTaskAttributes_Decode(sr stream.Reader) (*DeleteWorkflowExecutionTaskAttributes, error) {
+	var v DeleteWorkflowExecutionTaskAttributes
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func _UpsertWorkflowSearchAttributesTaskAttributes_Decode(sr stream.Reader) (*UpsertWorkflowSearchAttributesTaskAttributes, error) {
+	var v UpsertWorkflowSearchAttributesTaskAttributes
+	err := v.Decode(sr)
+	return &v, err
+}
+
 // Decode deserializes a ReplicationTask struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
@@ -7529,6 +8013,18 @@ func (v *ReplicationTask) Decode(sr stream.Reader) error {
 				return err
 			}
 
+		case fh.ID == 100 && fh.Type == wire.TStruct:
+			v.DeleteWorkflowExecutionTaskAttributes, err = _DeleteWorkflowExecutionTaskAttributes_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 110 && fh.Type == wire.TStruct:
+			v.UpsertWorkflowSearchAttributesTaskAttributes, err = _UpsertWorkflowSearchAttributesTaskAttributes_Decode(sr)
+			if err != nil {
+				return err
+			}
+
 		default:
 			if err := sr.Skip(fh.Type); err != nil {
 				return err
@@ -7558,7 +8054,7 @@ func (v *ReplicationTask) String() string {
 		return "<nil>"
 	}
 
-	var fields [8]string
+	var fields [10]string
 	i := 0
 	if v.TaskType != nil {
 		fields[i] = fmt.Sprintf("TaskType: %v", *(v.TaskType))
@@ -7592,6 +8088,14 @@ func (v *ReplicationTask) String() string {
 		fields[i] = fmt.Sprintf("CreationTime: %v", *(v.CreationTime))
 		i++
 	}
+	if v.DeleteWorkflowExecutionTaskAttributes != nil {
+		fields[i] = fmt.Sprintf("DeleteWorkflowExecutionTaskAttributes: %v", v.DeleteWorkflowExecutionTaskAttributes)
+		i++
+	}
+	if v.UpsertWorkflowSearchAttributesTaskAttributes != nil {
+		fields[i] = fmt.Sprintf("UpsertWorkflowSearchAttributesTaskAttributes: %v", v.UpsertWorkflowSearchAttributesTaskAttributes)
+		i++
+	}
 
 	return fmt.Sprintf("ReplicationTask{%v}", strings.Join(fields[:i], ", "))
 }
@@ -7640,6 +8144,12 @@ func (v *ReplicationTask) Equals(rhs *ReplicationTask) bool {
 	if !_I64_EqualsPtr(v.CreationTime, rhs.CreationTime) {
 		return false
 	}
+	if !((v.DeleteWorkflowExecutionTaskAttributes == nil && rhs.DeleteWorkflowExecutionTaskAttributes == nil) || (v.DeleteWorkflowExecutionTaskAttributes != nil && rhs.DeleteWorkflowExecutionTaskAttributes != nil && v.DeleteWorkflowExecutionTaskAttributes.Equals(rhs.DeleteWorkflowExecutionTaskAttributes))) {
+		return false
+	}
+	if !((v.UpsertWorkflowSearchAttributesTaskAttributes == nil && rhs.UpsertWorkflowSearchAttributesTaskAttributes == nil) || (v.UpsertWorkflowSearchAttributesTaskAttributes != nil && rhs.UpsertWorkflowSearchAttributesTaskAttributes != nil && v.UpsertWorkflowSearchAttributesTaskAttributes.Equals(rhs.UpsertWorkflowSearchAttributesTaskAttributes))) {
+		return false
+	}
 
 	return true
 }
@@ -7674,6 +8184,12 @@ func (v *ReplicationTask) MarshalLogObject(enc zapcore.ObjectEncoder) (err error
 	if v.CreationTime != nil {
 		enc.AddInt64("creationTime", *v.CreationTime)
 	}
+	if v.DeleteWorkflowExecutionTaskAttributes != nil {
+		err = multierr.Append(err, enc.AddObject("deleteWorkflowExecutionTaskAttributes", v.DeleteWorkflowExecutionTaskAttributes))
+	}
+	if v.UpsertWorkflowSearchAttributesTaskAttributes != nil {
+		err = multierr.Append(err, enc.AddObject("upsertWorkflowSearchAttributesTaskAttributes", v.UpsertWorkflowSearchAttributesTaskAttributes))
+	}
 	return err
 }
 
@@ -7777,24 +8293,54 @@ func (v *ReplicationTask) GetFailoverMarkerAttributes() (o *FailoverMarkerAttrib
 	return
 }
 
-// IsSetFailoverMarkerAttributes returns true if FailoverMarkerAttributes is not nil.
-func (v *ReplicationTask) IsSetFailoverMarkerAttributes() bool {
-	return v != nil && v.FailoverMarkerAttributes != nil
+// IsSetFailoverMarkerAttributes returns true if FailoverMarkerAttributes is not nil.
+func (v *ReplicationTask) IsSetFailoverMarkerAttributes() bool {
+	return v != nil && v.FailoverMarkerAttributes != nil
+}
+
+// GetCreationTime returns the value of CreationTime if it is set or its
+// zero value if it is unset.
+func (v *ReplicationTask) GetCreationTime() (o int64) {
+	if v != nil && v.CreationTime != nil {
+		return *v.CreationTime
+	}
+
+	return
+}
+
+// IsSetCreationTime returns true if CreationTime is not nil.
+func (v *ReplicationTask) IsSetCreationTime() bool {
+	return v != nil && v.CreationTime != nil
+}
+
+// GetDeleteWorkflowExecutionTaskAttributes returns the value of DeleteWorkflowExecutionTaskAttributes if it is set or its
+// zero value if it is unset.
+func (v *ReplicationTask) GetDeleteWorkflowExecutionTaskAttributes() (o *DeleteWorkflowExecutionTaskAttributes) {
+	if v != nil && v.DeleteWorkflowExecutionTaskAttributes != nil {
+		return v.DeleteWorkflowExecutionTaskAttributes
+	}
+
+	return
+}
+
+// IsSetDeleteWorkflowExecutionTaskAttributes returns true if DeleteWorkflowExecutionTaskAttributes is not nil.
+func (v *ReplicationTask) IsSetDeleteWorkflowExecutionTaskAttributes() bool {
+	return v != nil && v.DeleteWorkflowExecutionTaskAttributes != nil
 }
 
-// GetCreationTime returns the value of CreationTime if it is set or its
+// GetUpsertWorkflowSearchAttributesTaskAttributes returns the value of UpsertWorkflowSearchAttributesTaskAttributes if it is set or its
 // zero value if it is unset.
-func (v *ReplicationTask) GetCreationTime() (o int64) {
-	if v != nil && v.CreationTime != nil {
-		return *v.CreationTime
+func (v *ReplicationTask) GetUpsertWorkflowSearchAttributesTaskAttributes() (o *UpsertWorkflowSearchAttributesTaskAttributes) {
+	if v != nil && v.UpsertWorkflowSearchAttributesTaskAttributes != nil {
+		return v.UpsertWorkflowSearchAttributesTaskAttributes
 	}
 
 	return
 }
 
-// IsSetCreationTime returns true if CreationTime is not nil.
-func (v *ReplicationTask) IsSetCreationTime() bool {
-	return v != nil && v.CreationTime != nil
+// IsSetUpsertWorkflowSearchAttributesTaskAttributes returns true if UpsertWorkflowSearchAttributesTaskAttributes is not nil.
+func (v *ReplicationTask) IsSetUpsertWorkflowSearchAttributesTaskAttributes() bool {
+	return v != nil && v.UpsertWorkflowSearchAttributesTaskAttributes != nil
 }
 
 type ReplicationTaskInfo struct {
@@ -8532,13 +9078,15 @@ func (v *ReplicationTaskInfo) IsSetScheduledID() bool {
 type ReplicationTaskType int32
 
 const (
-	ReplicationTaskTypeDomain          ReplicationTaskType = 0
-	ReplicationTaskTypeHistory         ReplicationTaskType = 1
-	ReplicationTaskTypeSyncShardStatus ReplicationTaskType = 2
-	ReplicationTaskTypeSyncActivity    ReplicationTaskType = 3
-	ReplicationTaskTypeHistoryMetadata ReplicationTaskType = 4
-	ReplicationTaskTypeHistoryV2       ReplicationTaskType = 5
-	ReplicationTaskTypeFailoverMarker  ReplicationTaskType = 6
+	ReplicationTaskTypeDomain                         ReplicationTaskType = 0
+	ReplicationTaskTypeHistory                        ReplicationTaskType = 1
+	ReplicationTaskTypeSyncShardStatus                ReplicationTaskType = 2
+	ReplicationTaskTypeSyncActivity                   ReplicationTaskType = 3
+	ReplicationTaskTypeHistoryMetadata                ReplicationTaskType = 4
+	ReplicationTaskTypeHistoryV2                      ReplicationTaskType = 5
+	ReplicationTaskTypeFailoverMarker                 ReplicationTaskType = 6
+	ReplicationTaskTypeDeleteWorkflowExecution        ReplicationTaskType = 7
+	ReplicationTaskTypeUpsertWorkflowSearchAttributes ReplicationTaskType = 8
 )
 
 // ReplicationTaskType_Values returns all recognized values of ReplicationTaskType.
@@ -8551,6 +9099,8 @@ func ReplicationTaskType_Values() []ReplicationTaskType {
 		ReplicationTaskTypeHistoryMetadata,
 		ReplicationTaskTypeHistoryV2,
 		ReplicationTaskTypeFailoverMarker,
+		ReplicationTaskTypeDeleteWorkflowExecution,
+		ReplicationTaskTypeUpsertWorkflowSearchAttributes,
 	}
 }
 
@@ -8582,6 +9132,12 @@ func (v *ReplicationTaskType) UnmarshalText(value []byte) error {
 	case "FailoverMarker":
 		*v = ReplicationTaskTypeFailoverMarker
 		return nil
+	case "DeleteWorkflowExecution":
+		*v = ReplicationTaskTypeDeleteWorkflowExecution
+		return nil
+	case "UpsertWorkflowSearchAttributes":
+		*v = ReplicationTaskTypeUpsertWorkflowSearchAttributes
+		return nil
 	default:
 		val, err := strconv.ParseInt(s, 10, 32)
 		if err != nil {
@@ -8614,6 +9170,10 @@ func (v ReplicationTaskType) MarshalText() ([]byte, error) {
 		return []byte("HistoryV2"), nil
 	case 6:
 		return []byte("FailoverMarker"), nil
+	case 7:
+		return []byte("DeleteWorkflowExecution"), nil
+	case 8:
+		return []byte("UpsertWorkflowSearchAttributes"), nil
 	}
 	return []byte(strconv.FormatInt(int64(v), 10)), nil
 }
@@ -8639,6 +9199,10 @@ func (v ReplicationTaskType) MarshalLogObject(enc zapcore.ObjectEncoder) error {
 		enc.AddString("name", "HistoryV2")
 	case 6:
 		enc.AddString("name", "FailoverMarker")
+	case 7:
+		enc.AddString("name", "DeleteWorkflowExecution")
+	case 8:
+		enc.AddString("name", "UpsertWorkflowSearchAttributes")
 	}
 	return nil
 }
@@ -8721,6 +9285,10 @@ func (v ReplicationTaskType) String() string {
 		return "HistoryV2"
 	case 6:
 		return "FailoverMarker"
+	case 7:
+		return "DeleteWorkflowExecution"
+	case 8:
+		return "UpsertWorkflowSearchAttributes"
 	}
 	return fmt.Sprintf("ReplicationTaskType(%d)", w)
 }
@@ -8753,6 +9321,10 @@ func (v ReplicationTaskType) MarshalJSON() ([]byte, error) {
 		return ([]byte)("\"HistoryV2\""), nil
 	case 6:
 		return ([]byte)("\"FailoverMarker\""), nil
+	case 7:
+		return ([]byte)("\"DeleteWorkflowExecution\""), nil
+	case 8:
+		return ([]byte)("\"UpsertWorkflowSearchAttributes\""), nil
 	}
 	return ([]byte)(strconv.FormatInt(int64(v), 10)), nil
 }
@@ -9148,6 +9720,7 @@ type SyncActivityTaskAttributes struct {
 	LastFailureDetails []byte                 `json:"lastFailureDetails,omitempty"`
 	LastFailureOptions *shared.FailureOptions `json:"lastFailureOptions,omitempty"`
 	VersionHistory     *shared.VersionHistory `json:"versionHistory,omitempty"`
+	Paused             *bool                  `json:"paused,omitempty"`
 }
 
 // ToWire translates a SyncActivityTaskAttributes struct into a Thrift-level intermediate
@@ -9167,7 +9740,7 @@ type SyncActivityTaskAttributes struct {
 //	}
 func (v *SyncActivityTaskAttributes) ToWire() (wire.Value, error) {
 	var (
-		fields [16]wire.Field
+		fields [17]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -9301,6 +9874,14 @@ func (v *SyncActivityTaskAttributes) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 150, Value: w}
 		i++
 	}
+	if v.Paused != nil {
+		w, err = wire.NewValueBool(*(v.Paused)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 160, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -9490,6 +10071,16 @@ func (v *SyncActivityTaskAttributes) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 160:
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.Paused = &x
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -9698,6 +10289,18 @@ func (v *SyncActivityTaskAttributes) Encode(sw stream.Writer) error {
 		}
 	}
 
+	if v.Paused != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 160, Type: wire.TBool}); err != nil {
+			return err
+		}
+		if err := sw.WriteBool(*(v.Paused)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
 	return sw.WriteStructEnd()
 }
 
@@ -9851,6 +10454,14 @@ func (v *SyncActivityTaskAttributes) Decode(sr stream.Reader) error {
 				return err
 			}
 
+		case fh.ID == 160 && fh.Type == wire.TBool:
+			var x bool
+			x, err = sr.ReadBool()
+			v.Paused = &x
+			if err != nil {
+				return err
+			}
+
 		default:
 			if err := sr.Skip(fh.Type); err != nil {
 				return err
@@ -9880,7 +10491,7 @@ func (v *SyncActivityTaskAttributes) String() string {
 		return "<nil>"
 	}
 
-	var fields [16]string
+	var fields [17]string
 	i := 0
 	if v.DomainId != nil {
 		fields[i] = fmt.Sprintf("DomainId: %v", *(v.DomainId))
@@ -9946,6 +10557,10 @@ func (v *SyncActivityTaskAttributes) String() string {
 		fields[i] = fmt.Sprintf("VersionHistory: %v", v.VersionHistory)
 		i++
 	}
+	if v.Paused != nil {
+		fields[i] = fmt.Sprintf("Paused: %v", *(v.Paused))
+		i++
+	}
 
 	return fmt.Sprintf("SyncActivityTaskAttributes{%v}", strings.Join(fields[:i], ", "))
 }
@@ -10008,6 +10623,9 @@ func (v *SyncActivityTaskAttributes) Equals(rhs *SyncActivityTaskAttributes) boo
 	if !((v.VersionHistory == nil && rhs.VersionHistory == nil) || (v.VersionHistory != nil && rhs.VersionHistory != nil && v.VersionHistory.Equals(rhs.VersionHistory))) {
 		return false
 	}
+	if !_Bool_EqualsPtr(v.Paused, rhs.Paused) {
+		return false
+	}
 
 	return true
 }
@@ -10066,6 +10684,9 @@ func (v *SyncActivityTaskAttributes) MarshalLogObject(enc zapcore.ObjectEncoder)
 	if v.VersionHistory != nil {
 		err = multierr.Append(err, enc.AddObject("versionHistory", v.VersionHistory))
 	}
+	if v.Paused != nil {
+		enc.AddBool("paused", *v.Paused)
+	}
 	return err
 }
 
@@ -10294,26 +10915,253 @@ func (v *SyncActivityTaskAttributes) IsSetLastFailureOptions() bool {
 	return v != nil && v.LastFailureOptions != nil
 }
 
-// GetVersionHistory returns the value of VersionHistory if it is set or its
+// GetVersionHistory returns the value of VersionHistory if it is set or its
+// zero value if it is unset.
+func (v *SyncActivityTaskAttributes) GetVersionHistory() (o *shared.VersionHistory) {
+	if v != nil && v.VersionHistory != nil {
+		return v.VersionHistory
+	}
+
+	return
+}
+
+// IsSetVersionHistory returns true if VersionHistory is not nil.
+func (v *SyncActivityTaskAttributes) IsSetVersionHistory() bool {
+	return v != nil && v.VersionHistory != nil
+}
+
+// GetPaused returns the value of Paused if it is set or its
+// zero value if it is unset.
+func (v *SyncActivityTaskAttributes) GetPaused() (o bool) {
+	if v != nil && v.Paused != nil {
+		return *v.Paused
+	}
+
+	return
+}
+
+// IsSetPaused returns true if Paused is not nil.
+func (v *SyncActivityTaskAttributes) IsSetPaused() bool {
+	return v != nil && v.Paused != nil
+}
+
+type SyncShardStatus struct {
+	Timestamp *int64 `json:"timestamp,omitempty"`
+}
+
+// ToWire translates a SyncShardStatus struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//	x, err := v.ToWire()
+//	if err != nil {
+//		return err
+//	}
+//
+//	if err := binaryProtocol.Encode(x, writer); err != nil {
+//		return err
+//	}
+func (v *SyncShardStatus) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Timestamp != nil {
+		w, err = wire.NewValueI64(*(v.Timestamp)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a SyncShardStatus struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a SyncShardStatus struct
+// from the provided intermediate representation.
+//
+//	x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//	if err != nil {
+//		return nil, err
+//	}
+//
+//	var v SyncShardStatus
+//	if err := v.FromWire(x); err != nil {
+//		return nil, err
+//	}
+//	return &v, nil
+func (v *SyncShardStatus) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.Timestamp = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// Encode serializes a SyncShardStatus struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a SyncShardStatus struct could not be encoded.
+func (v *SyncShardStatus) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Timestamp != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.Timestamp)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a SyncShardStatus struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a SyncShardStatus struct could not be generated from the wire
+// representation.
+func (v *SyncShardStatus) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 10 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.Timestamp = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a SyncShardStatus
+// struct.
+func (v *SyncShardStatus) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Timestamp != nil {
+		fields[i] = fmt.Sprintf("Timestamp: %v", *(v.Timestamp))
+		i++
+	}
+
+	return fmt.Sprintf("SyncShardStatus{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this SyncShardStatus match the
+// provided SyncShardStatus.
+//
+// This function performs a deep comparison.
+func (v *SyncShardStatus) Equals(rhs *SyncShardStatus) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_I64_EqualsPtr(v.Timestamp, rhs.Timestamp) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of SyncShardStatus.
+func (v *SyncShardStatus) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Timestamp != nil {
+		enc.AddInt64("timestamp", *v.Timestamp)
+	}
+	return err
+}
+
+// GetTimestamp returns the value of Timestamp if it is set or its
 // zero value if it is unset.
-func (v *SyncActivityTaskAttributes) GetVersionHistory() (o *shared.VersionHistory) {
-	if v != nil && v.VersionHistory != nil {
-		return v.VersionHistory
+func (v *SyncShardStatus) GetTimestamp() (o int64) {
+	if v != nil && v.Timestamp != nil {
+		return *v.Timestamp
 	}
 
 	return
 }
 
-// IsSetVersionHistory returns true if VersionHistory is not nil.
-func (v *SyncActivityTaskAttributes) IsSetVersionHistory() bool {
-	return v != nil && v.VersionHistory != nil
+// IsSetTimestamp returns true if Timestamp is not nil.
+func (v *SyncShardStatus) IsSetTimestamp() bool {
+	return v != nil && v.Timestamp != nil
 }
 
-type SyncShardStatus struct {
-	Timestamp *int64 `json:"timestamp,omitempty"`
+type SyncShardStatusTaskAttributes struct {
+	SourceCluster *string `json:"sourceCluster,omitempty"`
+	ShardId       *int64  `json:"shardId,omitempty"`
+	Timestamp     *int64  `json:"timestamp,omitempty"`
 }
 
-// ToWire translates a SyncShardStatus struct into a Thrift-level intermediate
+// ToWire translates a SyncShardStatusTaskAttributes struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -10328,31 +11176,47 @@ type SyncShardStatus struct {
 //	if err := binaryProtocol.Encode(x, writer); err != nil {
 //		return err
 //	}
-func (v *SyncShardStatus) ToWire() (wire.Value, error) {
+func (v *SyncShardStatusTaskAttributes) ToWire() (wire.Value, error) {
 	var (
-		fields [1]wire.Field
+		fields [3]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
+	if v.SourceCluster != nil {
+		w, err = wire.NewValueString(*(v.SourceCluster)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.ShardId != nil {
+		w, err = wire.NewValueI64(*(v.ShardId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
 	if v.Timestamp != nil {
 		w, err = wire.NewValueI64(*(v.Timestamp)), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 10, Value: w}
+		fields[i] = wire.Field{ID: 30, Value: w}
 		i++
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a SyncShardStatus struct from its Thrift-level
+// FromWire deserializes a SyncShardStatusTaskAttributes struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a SyncShardStatus struct
+// An error is returned if we were unable to build a SyncShardStatusTaskAttributes struct
 // from the provided intermediate representation.
 //
 //	x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -10360,17 +11224,37 @@ func (v *SyncShardStatus) ToWire() (wire.Value, error) {
 //		return nil, err
 //	}
 //
-//	var v SyncShardStatus
+//	var v SyncShardStatusTaskAttributes
 //	if err := v.FromWire(x); err != nil {
 //		return nil, err
 //	}
 //	return &v, nil
-func (v *SyncShardStatus) FromWire(w wire.Value) error {
+func (v *SyncShardStatusTaskAttributes) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.SourceCluster = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.ShardId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
 			if field.Value.Type() == wire.TI64 {
 				var x int64
 				x, err = field.Value.GetI64(), error(nil)
@@ -10386,17 +11270,41 @@ func (v *SyncShardStatus) FromWire(w wire.Value) error {
 	return nil
 }
 
-// Encode serializes a SyncShardStatus struct directly into bytes, without going
+// Encode serializes a SyncShardStatusTaskAttributes struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a SyncShardStatus struct could not be encoded.
-func (v *SyncShardStatus) Encode(sw stream.Writer) error {
+// An error is returned if a SyncShardStatusTaskAttributes struct could not be encoded.
+func (v *SyncShardStatusTaskAttributes) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
 
+	if v.SourceCluster != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.SourceCluster)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.ShardId != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.ShardId)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
 	if v.Timestamp != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TI64}); err != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TI64}); err != nil {
 			return err
 		}
 		if err := sw.WriteInt64(*(v.Timestamp)); err != nil {
@@ -10410,12 +11318,12 @@ func (v *SyncShardStatus) Encode(sw stream.Writer) error {
 	return sw.WriteStructEnd()
 }
 
-// Decode deserializes a SyncShardStatus struct directly from its Thrift-level
+// Decode deserializes a SyncShardStatusTaskAttributes struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a SyncShardStatus struct could not be generated from the wire
+// An error is returned if a SyncShardStatusTaskAttributes struct could not be generated from the wire
 // representation.
-func (v *SyncShardStatus) Decode(sr stream.Reader) error {
+func (v *SyncShardStatusTaskAttributes) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -10428,7 +11336,23 @@ func (v *SyncShardStatus) Decode(sr stream.Reader) error {
 
 	for ok {
 		switch {
-		case fh.ID == 10 && fh.Type == wire.TI64:
+		case fh.ID == 10 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.SourceCluster = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 20 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.ShardId = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 30 && fh.Type == wire.TI64:
 			var x int64
 			x, err = sr.ReadInt64()
 			v.Timestamp = &x
@@ -10458,33 +11382,47 @@ func (v *SyncShardStatus) Decode(sr stream.Reader) error {
 	return nil
 }
 
-// String returns a readable string representation of a SyncShardStatus
+// String returns a readable string representation of a SyncShardStatusTaskAttributes
 // struct.
-func (v *SyncShardStatus) String() string {
+func (v *SyncShardStatusTaskAttributes) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [1]string
+	var fields [3]string
 	i := 0
+	if v.SourceCluster != nil {
+		fields[i] = fmt.Sprintf("SourceCluster: %v", *(v.SourceCluster))
+		i++
+	}
+	if v.ShardId != nil {
+		fields[i] = fmt.Sprintf("ShardId: %v", *(v.ShardId))
+		i++
+	}
 	if v.Timestamp != nil {
 		fields[i] = fmt.Sprintf("Timestamp: %v", *(v.Timestamp))
 		i++
 	}
 
-	return fmt.Sprintf("SyncShardStatus{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("SyncShardStatusTaskAttributes{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this SyncShardStatus match the
-// provided SyncShardStatus.
+// Equals returns true if all the fields of this SyncShardStatusTaskAttributes match the
+// provided SyncShardStatusTaskAttributes.
 //
 // This function performs a deep comparison.
-func (v *SyncShardStatus) Equals(rhs *SyncShardStatus) bool {
+func (v *SyncShardStatusTaskAttributes) Equals(rhs *SyncShardStatusTaskAttributes) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
+	if !_String_EqualsPtr(v.SourceCluster, rhs.SourceCluster) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.ShardId, rhs.ShardId) {
+		return false
+	}
 	if !_I64_EqualsPtr(v.Timestamp, rhs.Timestamp) {
 		return false
 	}
@@ -10493,20 +11431,56 @@ func (v *SyncShardStatus) Equals(rhs *SyncShardStatus) bool {
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of SyncShardStatus.
-func (v *SyncShardStatus) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of SyncShardStatusTaskAttributes.
+func (v *SyncShardStatusTaskAttributes) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
+	if v.SourceCluster != nil {
+		enc.AddString("sourceCluster", *v.SourceCluster)
+	}
+	if v.ShardId != nil {
+		enc.AddInt64("shardId", *v.ShardId)
+	}
 	if v.Timestamp != nil {
 		enc.AddInt64("timestamp", *v.Timestamp)
 	}
 	return err
 }
 
+// GetSourceCluster returns the value of SourceCluster if it is set or its
+// zero value if it is unset.
+func (v *SyncShardStatusTaskAttributes) GetSourceCluster() (o string) {
+	if v != nil && v.SourceCluster != nil {
+		return *v.SourceCluster
+	}
+
+	return
+}
+
+// IsSetSourceCluster returns true if SourceCluster is not nil.
+func (v *SyncShardStatusTaskAttributes) IsSetSourceCluster() bool {
+	return v != nil && v.SourceCluster != nil
+}
+
+// GetShardId returns the value of ShardId if it is set or its
+// zero value if it is unset.
+func (v *SyncShardStatusTaskAttributes) GetShardId() (o int64) {
+	if v != nil && v.ShardId != nil {
+		return *v.ShardId
+	}
+
+	return
+}
+
+// IsSetShardId returns true if ShardId is not nil.
+func (v *SyncShardStatusTaskAttributes) IsSetShardId() bool {
+	return v != nil && v.ShardId != nil
+}
+
 // GetTimestamp returns the value of Timestamp if it is set or its
 // zero value if it is unset.
-func (v *SyncShardStatus) GetTimestamp() (o int64) {
+func (v *SyncShardStatusTaskAttributes) GetTimestamp() (o int64) {
 	if v != nil && v.Timestamp != nil {
 		return *v.Timestamp
 	}
@@ -10515,17 +11489,20 @@ func (v *SyncShardStatus) GetTimestamp() (o int64) {
 }
 
 // IsSetTimestamp returns true if Timestamp is not nil.
-func (v *SyncShardStatus) IsSetTimestamp() bool {
+func (v *SyncShardStatusTaskAttributes) IsSetTimestamp() bool {
 	return v != nil && v.Timestamp != nil
 }
 
-type SyncShardStatusTaskAttributes struct {
-	SourceCluster *string `json:"sourceCluster,omitempty"`
-	ShardId       *int64  `json:"shardId,omitempty"`
-	Timestamp     *int64  `json:"timestamp,omitempty"`
+type UpsertWorkflowSearchAttributesTaskAttributes struct {
+	DomainId         *string                  `json:"domainId,omitempty"`
+	WorkflowId       *string                  `json:"workflowId,omitempty"`
+	RunId            *string                  `json:"runId,omitempty"`
+	Version          *int64                   `json:"version,omitempty"`
+	SearchAttributes *shared.SearchAttributes `json:"searchAttributes,omitempty"`
+	Memo             *shared.Memo             `json:"memo,omitempty"`
 }
 
-// ToWire translates a SyncShardStatusTaskAttributes struct into a Thrift-level intermediate
+// ToWire translates a UpsertWorkflowSearchAttributesTaskAttributes struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -10540,47 +11517,83 @@ type SyncShardStatusTaskAttributes struct {
 //	if err := binaryProtocol.Encode(x, writer); err != nil {
 //		return err
 //	}
-func (v *SyncShardStatusTaskAttributes) ToWire() (wire.Value, error) {
+func (v *UpsertWorkflowSearchAttributesTaskAttributes) ToWire() (wire.Value, error) {
 	var (
-		fields [3]wire.Field
+		fields [6]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.SourceCluster != nil {
-		w, err = wire.NewValueString(*(v.SourceCluster)), error(nil)
+	if v.DomainId != nil {
+		w, err = wire.NewValueString(*(v.DomainId)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.ShardId != nil {
-		w, err = wire.NewValueI64(*(v.ShardId)), error(nil)
+	if v.WorkflowId != nil {
+		w, err = wire.NewValueString(*(v.WorkflowId)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.Timestamp != nil {
-		w, err = wire.NewValueI64(*(v.Timestamp)), error(nil)
+	if v.RunId != nil {
+		w, err = wire.NewValueString(*(v.RunId)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 30, Value: w}
 		i++
 	}
+	if v.Version != nil {
+		w, err = wire.NewValueI64(*(v.Version)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	if v.SearchAttributes != nil {
+		w, err = v.SearchAttributes.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+	if v.Memo != nil {
+		w, err = v.Memo.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a SyncShardStatusTaskAttributes struct from its Thrift-level
+func _SearchAttributes_Read(w wire.Value) (*shared.SearchAttributes, error) {
+	var v shared.SearchAttributes
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _Memo_Read(w wire.Value) (*shared.Memo, error) {
+	var v shared.Memo
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a UpsertWorkflowSearchAttributesTaskAttributes struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a SyncShardStatusTaskAttributes struct
+// An error is returned if we were unable to build a UpsertWorkflowSearchAttributesTaskAttributes struct
 // from the provided intermediate representation.
 //
 //	x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -10588,12 +11601,12 @@ func (v *SyncShardStatusTaskAttributes) ToWire() (wire.Value, error) {
 //		return nil, err
 //	}
 //
-//	var v SyncShardStatusTaskAttributes
+//	var v UpsertWorkflowSearchAttributesTaskAttributes
 //	if err := v.FromWire(x); err != nil {
 //		return nil, err
 //	}
 //	return &v, nil
-func (v *SyncShardStatusTaskAttributes) FromWire(w wire.Value) error {
+func (v *UpsertWorkflowSearchAttributesTaskAttributes) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
@@ -10602,27 +11615,53 @@ func (v *SyncShardStatusTaskAttributes) FromWire(w wire.Value) error {
 			if field.Value.Type() == wire.TBinary {
 				var x string
 				x, err = field.Value.GetString(), error(nil)
-				v.SourceCluster = &x
+				v.DomainId = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 20:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.ShardId = &x
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.WorkflowId = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 30:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.RunId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
 			if field.Value.Type() == wire.TI64 {
 				var x int64
 				x, err = field.Value.GetI64(), error(nil)
-				v.Timestamp = &x
+				v.Version = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 50:
+			if field.Value.Type() == wire.TStruct {
+				v.SearchAttributes, err = _SearchAttributes_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 60:
+			if field.Value.Type() == wire.TStruct {
+				v.Memo, err = _Memo_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -10634,20 +11673,20 @@ func (v *SyncShardStatusTaskAttributes) FromWire(w wire.Value) error {
 	return nil
 }
 
-// Encode serializes a SyncShardStatusTaskAttributes struct directly into bytes, without going
+// Encode serializes a UpsertWorkflowSearchAttributesTaskAttributes struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a SyncShardStatusTaskAttributes struct could not be encoded.
-func (v *SyncShardStatusTaskAttributes) Encode(sw stream.Writer) error {
+// An error is returned if a UpsertWorkflowSearchAttributesTaskAttributes struct could not be encoded.
+func (v *UpsertWorkflowSearchAttributesTaskAttributes) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
 
-	if v.SourceCluster != nil {
+	if v.DomainId != nil {
 		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
 			return err
 		}
-		if err := sw.WriteString(*(v.SourceCluster)); err != nil {
+		if err := sw.WriteString(*(v.DomainId)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -10655,11 +11694,11 @@ func (v *SyncShardStatusTaskAttributes) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.ShardId != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TI64}); err != nil {
+	if v.WorkflowId != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TBinary}); err != nil {
 			return err
 		}
-		if err := sw.WriteInt64(*(v.ShardId)); err != nil {
+		if err := sw.WriteString(*(v.WorkflowId)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -10667,11 +11706,47 @@ func (v *SyncShardStatusTaskAttributes) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.Timestamp != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TI64}); err != nil {
+	if v.RunId != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TBinary}); err != nil {
 			return err
 		}
-		if err := sw.WriteInt64(*(v.Timestamp)); err != nil {
+		if err := sw.WriteString(*(v.RunId)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Version != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 40, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.Version)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.SearchAttributes != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 50, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.SearchAttributes.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Memo != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 60, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.Memo.Encode(sw); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -10682,12 +11757,24 @@ func (v *SyncShardStatusTaskAttributes) Encode(sw stream.Writer) error {
 	return sw.WriteStructEnd()
 }
 
-// Decode deserializes a SyncShardStatusTaskAttributes struct directly from its Thrift-level
+func _SearchAttributes_Decode(sr stream.Reader) (*shared.SearchAttributes, error) {
+	var v shared.SearchAttributes
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func _Memo_Decode(sr stream.Reader) (*shared.Memo, error) {
+	var v shared.Memo
+	err := v.Decode(sr)
+	return &v, err
+}
+
+// Decode deserializes a UpsertWorkflowSearchAttributesTaskAttributes struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a SyncShardStatusTaskAttributes struct could not be generated from the wire
+// An error is returned if a UpsertWorkflowSearchAttributesTaskAttributes struct could not be generated from the wire
 // representation.
-func (v *SyncShardStatusTaskAttributes) Decode(sr stream.Reader) error {
+func (v *UpsertWorkflowSearchAttributesTaskAttributes) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -10703,23 +11790,43 @@ func (v *SyncShardStatusTaskAttributes) Decode(sr stream.Reader) error {
 		case fh.ID == 10 && fh.Type == wire.TBinary:
 			var x string
 			x, err = sr.ReadString()
-			v.SourceCluster = &x
+			v.DomainId = &x
 			if err != nil {
 				return err
 			}
 
-		case fh.ID == 20 && fh.Type == wire.TI64:
-			var x int64
-			x, err = sr.ReadInt64()
-			v.ShardId = &x
+		case fh.ID == 20 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.WorkflowId = &x
 			if err != nil {
 				return err
 			}
 
-		case fh.ID == 30 && fh.Type == wire.TI64:
+		case fh.ID == 30 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.RunId = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 40 && fh.Type == wire.TI64:
 			var x int64
 			x, err = sr.ReadInt64()
-			v.Timestamp = &x
+			v.Version = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 50 && fh.Type == wire.TStruct:
+			v.SearchAttributes, err = _SearchAttributes_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 60 && fh.Type == wire.TStruct:
+			v.Memo, err = _Memo_Decode(sr)
 			if err != nil {
 				return err
 			}
@@ -10746,48 +11853,69 @@ func (v *SyncShardStatusTaskAttributes) Decode(sr stream.Reader) error {
 	return nil
 }
 
-// String returns a readable string representation of a SyncShardStatusTaskAttributes
+// String returns a readable string representation of a UpsertWorkflowSearchAttributesTaskAttributes
 // struct.
-func (v *SyncShardStatusTaskAttributes) String() string {
+func (v *UpsertWorkflowSearchAttributesTaskAttributes) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [3]string
+	var fields [6]string
 	i := 0
-	if v.SourceCluster != nil {
-		fields[i] = fmt.Sprintf("SourceCluster: %v", *(v.SourceCluster))
+	if v.DomainId != nil {
+		fields[i] = fmt.Sprintf("DomainId: %v", *(v.DomainId))
 		i++
 	}
-	if v.ShardId != nil {
-		fields[i] = fmt.Sprintf("ShardId: %v", *(v.ShardId))
+	if v.WorkflowId != nil {
+		fields[i] = fmt.Sprintf("WorkflowId: %v", *(v.WorkflowId))
 		i++
 	}
-	if v.Timestamp != nil {
-		fields[i] = fmt.Sprintf("Timestamp: %v", *(v.Timestamp))
+	if v.RunId != nil {
+		fields[i] = fmt.Sprintf("RunId: %v", *(v.RunId))
+		i++
+	}
+	if v.Version != nil {
+		fields[i] = fmt.Sprintf("Version: %v", *(v.Version))
+		i++
+	}
+	if v.SearchAttributes != nil {
+		fields[i] = fmt.Sprintf("SearchAttributes: %v", v.SearchAttributes)
+		i++
+	}
+	if v.Memo != nil {
+		fields[i] = fmt.Sprintf("Memo: %v", v.Memo)
 		i++
 	}
 
-	return fmt.Sprintf("SyncShardStatusTaskAttributes{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("UpsertWorkflowSearchAttributesTaskAttributes{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this SyncShardStatusTaskAttributes match the
-// provided SyncShardStatusTaskAttributes.
+// Equals returns true if all the fields of this UpsertWorkflowSearchAttributesTaskAttributes match the
+// provided UpsertWorkflowSearchAttributesTaskAttributes.
 //
 // This function performs a deep comparison.
-func (v *SyncShardStatusTaskAttributes) Equals(rhs *SyncShardStatusTaskAttributes) bool {
+func (v *UpsertWorkflowSearchAttributesTaskAttributes) Equals(rhs *UpsertWorkflowSearchAttributesTaskAttributes) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !_String_EqualsPtr(v.SourceCluster, rhs.SourceCluster) {
+	if !_String_EqualsPtr(v.DomainId, rhs.DomainId) {
 		return false
 	}
-	if !_I64_EqualsPtr(v.ShardId, rhs.ShardId) {
+	if !_String_EqualsPtr(v.WorkflowId, rhs.WorkflowId) {
 		return false
 	}
-	if !_I64_EqualsPtr(v.Timestamp, rhs.Timestamp) {
+	if !_String_EqualsPtr(v.RunId, rhs.RunId) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.Version, rhs.Version) {
+		return false
+	}
+	if !((v.SearchAttributes == nil && rhs.SearchAttributes == nil) || (v.SearchAttributes != nil && rhs.SearchAttributes != nil && v.SearchAttributes.Equals(rhs.SearchAttributes))) {
+		return false
+	}
+	if !((v.Memo == nil && rhs.Memo == nil) || (v.Memo != nil && rhs.Memo != nil && v.Memo.Equals(rhs.Memo))) {
 		return false
 	}
 
@@ -10795,66 +11923,120 @@ func (v *SyncShardStatusTaskAttributes) Equals(rhs *SyncShardStatusTaskAttribute
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of SyncShardStatusTaskAttributes.
-func (v *SyncShardStatusTaskAttributes) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of UpsertWorkflowSearchAttributesTaskAttributes.
+func (v *UpsertWorkflowSearchAttributesTaskAttributes) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.SourceCluster != nil {
-		enc.AddString("sourceCluster", *v.SourceCluster)
+	if v.DomainId != nil {
+		enc.AddString("domainId", *v.DomainId)
 	}
-	if v.ShardId != nil {
-		enc.AddInt64("shardId", *v.ShardId)
+	if v.WorkflowId != nil {
+		enc.AddString("workflowId", *v.WorkflowId)
 	}
-	if v.Timestamp != nil {
-		enc.AddInt64("timestamp", *v.Timestamp)
+	if v.RunId != nil {
+		enc.AddString("runId", *v.RunId)
+	}
+	if v.Version != nil {
+		enc.AddInt64("version", *v.Version)
+	}
+	if v.SearchAttributes != nil {
+		err = multierr.Append(err, enc.AddObject("searchAttributes", v.SearchAttributes))
+	}
+	if v.Memo != nil {
+		err = multierr.Append(err, enc.AddObject("memo", v.Memo))
 	}
 	return err
 }
 
-// GetSourceCluster returns the value of SourceCluster if it is set or its
+// GetDomainId returns the value of DomainId if it is set or its
 // zero value if it is unset.
-func (v *SyncShardStatusTaskAttributes) GetSourceCluster() (o string) {
-	if v != nil && v.SourceCluster != nil {
-		return *v.SourceCluster
+func (v *UpsertWorkflowSearchAttributesTaskAttributes) GetDomainId() (o string) {
+	if v != nil && v.DomainId != nil {
+		return *v.DomainId
 	}
 
 	return
 }
 
-// IsSetSourceCluster returns true if SourceCluster is not nil.
-func (v *SyncShardStatusTaskAttributes) IsSetSourceCluster() bool {
-	return v != nil && v.SourceCluster != nil
+// IsSetDomainId returns true if DomainId is not nil.
+func (v *UpsertWorkflowSearchAttributesTaskAttributes) IsSetDomainId() bool {
+	return v != nil && v.DomainId != nil
 }
 
-// GetShardId returns the value of ShardId if it is set or its
+// GetWorkflowId returns the value of WorkflowId if it is set or its
 // zero value if it is unset.
-func (v *SyncShardStatusTaskAttributes) GetShardId() (o int64) {
-	if v != nil && v.ShardId != nil {
-		return *v.ShardId
+func (v *UpsertWorkflowSearchAttributesTaskAttributes) GetWorkflowId() (o string) {
+	if v != nil && v.WorkflowId != nil {
+		return *v.WorkflowId
 	}
 
 	return
 }
 
-// IsSetShardId returns true if ShardId is not nil.
-func (v *SyncShardStatusTaskAttributes) IsSetShardId() bool {
-	return v != nil && v.ShardId != nil
+// IsSetWorkflowId returns true if WorkflowId is not nil.
+func (v *UpsertWorkflowSearchAttributesTaskAttributes) IsSetWorkflowId() bool {
+	return v != nil && v.WorkflowId != nil
 }
 
-// GetTimestamp returns the value of Timestamp if it is set or its
+// GetRunId returns the value of RunId if it is set or its
 // zero value if it is unset.
-func (v *SyncShardStatusTaskAttributes) GetTimestamp() (o int64) {
-	if v != nil && v.Timestamp != nil {
-		return *v.Timestamp
+func (v *UpsertWorkflowSearchAttributesTaskAttributes) GetRunId() (o string) {
+	if v != nil && v.RunId != nil {
+		return *v.RunId
 	}
 
 	return
 }
 
-// IsSetTimestamp returns true if Timestamp is not nil.
-func (v *SyncShardStatusTaskAttributes) IsSetTimestamp() bool {
-	return v != nil && v.Timestamp != nil
+// IsSetRunId returns true if RunId is not nil.
+func (v *UpsertWorkflowSearchAttributesTaskAttributes) IsSetRunId() bool {
+	return v != nil && v.RunId != nil
+}
+
+// GetVersion returns the value of Version if it is set or its
+// zero value if it is unset.
+func (v *UpsertWorkflowSearchAttributesTaskAttributes) GetVersion() (o int64) {
+	if v != nil && v.Version != nil {
+		return *v.Version
+	}
+
+	return
+}
+
+// IsSetVersion returns true if Version is not nil.
+func (v *UpsertWorkflowSearchAttributesTaskAttributes) IsSetVersion() bool {
+	return v != nil && v.Version != nil
+}
+
+// GetSearchAttributes returns the value of SearchAttributes if it is set or its
+// zero value if it is unset.
+func (v *UpsertWorkflowSearchAttributesTaskAttributes) GetSearchAttributes() (o *shared.SearchAttributes) {
+	if v != nil && v.SearchAttributes != nil {
+		return v.SearchAttributes
+	}
+
+	return
+}
+
+// IsSetSearchAttributes returns true if SearchAttributes is not nil.
+func (v *UpsertWorkflowSearchAttributesTaskAttributes) IsSetSearchAttributes() bool {
+	return v != nil && v.SearchAttributes != nil
+}
+
+// GetMemo returns the value of Memo if it is set or its
+// zero value if it is unset.
+func (v *UpsertWorkflowSearchAttributesTaskAttributes) GetMemo() (o *shared.Memo) {
+	if v != nil && v.Memo != nil {
+		return v.Memo
+	}
+
+	return
+}
+
+// IsSetMemo returns true if Memo is not nil.
+func (v *UpsertWorkflowSearchAttributesTaskAttributes) IsSetMemo() bool {
+	return v != nil && v.Memo != nil
 }
 
 // ThriftModule represents the IDL file used to generate this package.
@@ -10862,11 +12044,11 @@ var ThriftModule = &thriftreflect.ThriftModule{
 	Name:     "replicator",
 	Package:  "github.com/uber/cadence/.gen/go/replicator",
 	FilePath: "replicator.thrift",
-	SHA1:     "3ec11471346b90a0b3295914ddfd0627f0c4afc6",
+	SHA1:     "0dc65eb2995f05959afed72ffc90030d35b214a5",
 	Includes: []*thriftreflect.ThriftModule{
 		shared.ThriftModule,
 	},
 	Raw: rawIDL,
 }
 
-const rawIDL = "// Copyright (c) 2017 Uber Technologies, Inc.\n//\n// Permission is hereby granted, free of charge, to any person obtaining a copy\n// of this software and associated documentation files (the \"Software\"), to deal\n// in the Software without restriction, including without limitation the rights\n// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell\n// copies of the Software, and to permit persons to whom the Software is\n// furnished to do so, subject to the following conditions:\n//\n// The above copyright notice and this permission notice shall be included in\n// all copies or substantial portions of the Software.\n//\n// THE SOFTWARE IS PROVIDED \"AS IS\", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR\n// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,\n// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE\n// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER\n// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,\n// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN\n// THE SOFTWARE.\n\nnamespace java com.uber.cadence.replicator\n\ninclude \"shared.thrift\"\n\nenum ReplicationTaskType {\n  Domain\n  History\n  SyncShardStatus\n  SyncActivity\n  HistoryMetadata\n  HistoryV2\n  FailoverMarker\n}\n\nenum DomainOperation {\n  Create\n  Update\n  Delete\n}\n\nstruct DomainTaskAttributes {\n  05: optional DomainOperation domainOperation\n  10: optional string id\n  20: optional shared.DomainInfo info\n  30: optional shared.DomainConfiguration config\n  40: optional shared.DomainReplicationConfiguration replicationConfig\n  50: optional i64 (js.type = \"Long\") configVersion\n  60: optional i64 (js.type = \"Long\") failoverVersion\n  70: optional i64 (js.type = \"Long\") previousFailoverVersion\n}\n\nstruct SyncShardStatusTaskAttributes {\n  10: optional string sourceCluster\n  20: optional i64 (js.type = \"Long\") shardId\n  30: optional i64 (js.type = \"Long\") timestamp\n}\n\nstruct SyncActivityTaskAttributes {\n  10: optional string domainId\n  20: optional string workflowId\n  30: optional string runId\n  40: optional i64 (js.type = \"Long\") version\n  50: optional i64 (js.type = \"Long\") scheduledId\n  60: optional i64 (js.type = \"Long\") scheduledTime\n  70: optional i64 (js.type = \"Long\") startedId\n  80: optional i64 (js.type = \"Long\") startedTime\n  90: optional i64 (js.type = \"Long\") lastHeartbeatTime\n  100: optional binary details\n  110: optional i32 attempt\n  120: optional string lastFailureReason\n  130: optional string lastWorkerIdentity\n  140: optional binary lastFailureDetails\n  145: optional shared.FailureOptions lastFailureOptions\n  150: optional shared.VersionHistory versionHistory\n}\n\nstruct HistoryTaskV2Attributes {\n  05: optional i64 (js.type = \"Long\") taskId\n  10: optional string domainId\n  20: optional string workflowId\n  30: optional string runId\n  40: optional list<shared.VersionHistoryItem> versionHistoryItems\n  50: optional shared.DataBlob events\n  // new run events does not need version history since there is no prior events\n  70: optional shared.DataBlob newRunEvents\n}\n\nstruct FailoverMarkerAttributes{\n\t10: optional string domainID\n\t20: optional i64 (js.type = \"Long\") failoverVersion\n\t30: optional i64 (js.type = \"Long\") creationTime\n}\n\nstruct FailoverMarkers{\n\t10: optional list<FailoverMarkerAttributes> failoverMarkers\n}\n\nstruct ReplicationTask {\n  10: optional ReplicationTaskType taskType\n  11: optional i64 (js.type = \"Long\") sourceTaskId\n  20: optional DomainTaskAttributes domainTaskAttributes\n  40: optional SyncShardStatusTaskAttributes syncShardStatusTaskAttributes\n  50: optional SyncActivityTaskAttributes syncActivityTaskAttributes\n  70: optional HistoryTaskV2Attributes historyTaskV2Attributes\n  80: optional FailoverMarkerAttributes failoverMarkerAttributes\n  90: optional i64 (js.type = \"Long\") creationTime\n}\n\nstruct ReplicationToken {\n  10: optional i32 shardID\n  // lastRetrivedMessageId is where the next fetch should begin with\n  20: optional i64 (js.type = \"Long\") lastRetrievedMessageId\n  // lastProcessedMessageId is the last messageId that is processed on the passive side.\n  // This can be different than lastRetrievedMessageId if passive side supports prefetching messages.\n  30: optional i64 (js.type = \"Long\") lastProcessedMessageId\n}\n\nstruct SyncShardStatus {\n  10: optional i64 (js.type = \"Long\") timestamp\n}\n\nstruct ReplicationMessages {\n  10: optional list<ReplicationTask> replicationTasks\n  // This can be different than the last taskId in the above list, because sender can decide to skip tasks (e.g. for completed workflows).\n  20: optional i64 (js.type = \"Long\") lastRetrievedMessageId\n  30: optional bool hasMore // Hint for flow control\n  40: optional SyncShardStatus syncShardStatus\n}\n\nstruct ReplicationTaskInfo {\n  10: optional string domainID\n  20: optional string workflowID\n  30: optional string runID\n  40: optional i16 taskType\n  50: optional i64 (js.type = \"Long\") taskID\n  60: optional i64 (js.type = \"Long\") version\n  70: optional i64 (js.type = \"Long\") firstEventID\n  80: optional i64 (js.type = \"Long\") nextEventID\n  90: optional i64 (js.type = \"Long\") scheduledID\n}\n\nstruct GetReplicationMessagesRequest {\n  10: optional list<ReplicationToken> tokens\n  20: optional string clusterName\n}\n\nstruct GetReplicationMessagesResponse {\n  10: optional map<i32, ReplicationMessages> messagesByShard\n}\n\nstruct GetDomainReplicationMessagesRequest {\n  // lastRetrievedMessageId is where the next fetch should begin with\n  10: optional i64 (js.type = \"Long\") lastRetrievedMessageId\n  // lastProcessedMessageId is the last messageId that is processed on the passive side.\n  // This can be different than lastRetrievedMessageId if passive side supports prefetching messages.\n  20: optional i64 (js.type = \"Long\") lastProcessedMessageId\n  // clusterName is the name of the pulling cluster\n  30: optional string clusterName\n}\n\nstruct GetDomainReplicationMessagesResponse {\n  10: optional ReplicationMessages messages\n}\n\nstruct GetDLQReplicationMessagesRequest {\n  10: optional list<ReplicationTaskInfo> taskInfos\n}\n\nstruct GetDLQReplicationMessagesResponse {\n  10: optional list<ReplicationTask> replicationTasks\n}\n\nenum DLQType {\n  Replication,\n  Domain,\n}\n\nstruct ReadDLQMessagesRequest{\n  10: optional DLQType type\n  20: optional i32 shardID\n  30: optional string sourceCluster\n  40: optional i64 (js.type = \"Long\") inclusiveEndMessageID\n  50: optional i32 maximumPageSize\n  60: optional binary nextPageToken\n}\n\nstruct ReadDLQMessagesResponse{\n  10: optional DLQType type\n  20: optional list<ReplicationTask> replicationTasks\n  30: optional binary nextPageToken\n  40: optional list<ReplicationTaskInfo> replicationTasksInfo\n}\n\nstruct PurgeDLQMessagesRequest{\n  10: optional DLQType type\n  20: optional i32 shardID\n  30: optional string sourceCluster\n  40: optional i64 (js.type = \"Long\") inclusiveEndMessageID\n}\n\nstruct MergeDLQMessagesRequest{\n  10: optional DLQType type\n  20: optional i32 shardID\n  30: optional string sourceCluster\n  40: optional i64 (js.type = \"Long\") inclusiveEndMessageID\n  50: optional i32 maximumPageSize\n  60: optional binary nextPageToken\n}\n\nstruct MergeDLQMessagesResponse{\n  10: optional binary nextPageToken\n}\n"
+const rawIDL = "// Copyright (c) 2017 Uber Technologies, Inc.\n//\n// Permission is hereby granted, free of charge, to any person obtaining a copy\n// of this software and associated documentation files (the \"Software\"), to deal\n// in the Software without restriction, including without limitation the rights\n// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell\n// copies of the Software, and to permit persons to whom the Software is\n// furnished to do so, subject to the following conditions:\n//\n// The above copyright notice and this permission notice shall be included in\n// all copies or substantial portions of the Software.\n//\n// THE SOFTWARE IS PROVIDED \"AS IS\", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR\n// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,\n// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE\n// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER\n// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,\n// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN\n// THE SOFTWARE.\n\nnamespace java com.uber.cadence.replicator\n\ninclude \"shared.thrift\"\n\nenum ReplicationTaskType {\n  Domain\n  History\n  SyncShardStatus\n  SyncActivity\n  HistoryMetadata\n  HistoryV2\n  FailoverMarker\n  DeleteWorkflowExecution\n  UpsertWorkflowSearchAttributes\n}\n\nenum DomainOperation {\n  Create\n  Update\n  Delete\n}\n\nstruct DomainTaskAttributes {\n  05: optional DomainOperation domainOperation\n  10: optional string id\n  20: optional shared.DomainInfo info\n  30: optional shared.DomainConfiguration config\n  40: optional shared.DomainReplicationConfiguration replicationConfig\n  50: optional i64 (js.type = \"Long\") configVersion\n  60: optional i64 (js.type = \"Long\") failoverVersion\n  70: optional i64 (js.type = \"Long\") previousFailoverVersion\n}\n\nstruct SyncShardStatusTaskAttributes {\n  10: optional string sourceCluster\n  20: optional i64 (js.type = \"Long\") shardId\n  30: optional i64 (js.type = \"Long\") timestamp\n}\n\nstruct SyncActivityTaskAttributes {\n  10: optional string domainId\n  20: optional string workflowId\n  30: optional string runId\n  40: optional i64 (js.type = \"Long\") version\n  50: optional i64 (js.type = \"Long\") scheduledId\n  60: optional i64 (js.type = \"Long\") scheduledTime\n  70: optional i64 (js.type = \"Long\") startedId\n  80: optional i64 (js.type = \"Long\") startedTime\n  90: optional i64 (js.type = \"Long\") lastHeartbeatTime\n  100: optional binary details\n  110: optional i32 attempt\n  120: optional string lastFailureReason\n  130: optional string lastWorkerIdentity\n  140: optional binary lastFailureDetails\n  145: optional shared.FailureOptions lastFailureOptions\n  150: optional shared.VersionHistory versionHistory\n  160: optional bool paused\n}\n\nstruct HistoryTaskV2Attributes {\n  05: optional i64 (js.type = \"Long\") taskId\n  10: optional string domainId\n  20: optional string workflowId\n  30: optional string runId\n  40: optional list<shared.VersionHistoryItem> versionHistoryItems\n  50: optional shared.DataBlob events\n  // new run events does not need version history since there is no prior events\n  70: optional shared.DataBlob newRunEvents\n}\n\nstruct FailoverMarkerAttributes{\n\t10: optional string domainID\n\t20: optional i64 (js.type = \"Long\") failoverVersion\n\t30: optional i64 (js.type = \"Long\") creationTime\n}\n\nstruct FailoverMarkers{\n\t10: optional list<FailoverMarkerAttributes> failoverMarkers\n}\n\nstruct DeleteWorkflowExecutionTaskAttributes {\n  10: optional string domainId\n  20: optional string workflowId\n  30: optional string runId\n  40: optional i64 (js.type = \"Long\") version\n}\n\nstruct UpsertWorkflowSearchAttributesTaskAttributes {\n  10: optional string domainId\n  20: optional string workflowId\n  30: optional string runId\n  40: optional i64 (js.type = \"Long\") version\n  50: optional shared.SearchAttributes searchAttributes\n  60: optional shared.Memo memo\n}\n\nstruct ReplicationTask {\n  10: optional ReplicationTaskType taskType\n  11: optional i64 (js.type = \"Long\") sourceTaskId\n  20: optional DomainTaskAttributes domainTaskAttributes\n  40: optional SyncShardStatusTaskAttributes syncShardStatusTaskAttributes\n  50: optional SyncActivityTaskAttributes syncActivityTaskAttributes\n  70: optional HistoryTaskV2Attributes historyTaskV2Attributes\n  80: optional FailoverMarkerAttributes failoverMarkerAttributes\n  90: optional i64 (js.type = \"Long\") creationTime\n  100: optional DeleteWorkflowExecutionTaskAttributes deleteWorkflowExecutionTaskAttributes\n  110: optional UpsertWorkflowSearchAttributesTaskAttributes upsertWorkflowSearchAttributesTaskAttributes\n}\n\nstruct ReplicationToken {\n  10: optional i32 shardID\n  // lastRetrivedMessageId is where the next fetch should begin with\n  20: optional i64 (js.type = \"Long\") lastRetrievedMessageId\n  // lastProcessedMessageId is the last messageId that is processed on the passive side.\n  // This can be different than lastRetrievedMessageId if passive side supports prefetching messages.\n  30: optional i64 (js.type = \"Long\") lastProcessedMessageId\n}\n\nstruct SyncShardStatus {\n  10: optional i64 (js.type = \"Long\") timestamp\n}\n\nstruct ReplicationMessages {\n  10: optional list<ReplicationTask> replicationTasks\n  // This can be different than the last taskId in the above list, because sender can decide to skip tasks (e.g. for completed workflows).\n  20: optional i64 (js.type = \"Long\") lastRetrievedMessageId\n  30: optional bool hasMore // Hint for flow control\n  40: optional SyncShardStatus syncShardStatus\n}\n\nstruct ReplicationTaskInfo {\n  10: optional string domainID\n  20: optional string workflowID\n  30: optional string runID\n  40: optional i16 taskType\n  50: optional i64 (js.type = \"Long\") taskID\n  60: optional i64 (js.type = \"Long\") version\n  70: optional i64 (js.type = \"Long\") firstEventID\n  80: optional i64 (js.type = \"Long\") nextEventID\n  90: optional i64 (js.type = \"Long\") scheduledID\n}\n\nstruct GetReplicationMessagesRequest {\n  10: optional list<ReplicationToken> tokens\n  20: optional string clusterName\n}\n\nstruct GetReplicationMessagesResponse {\n  10: optional map<i32, ReplicationMessages> messagesByShard\n}\n\nstruct GetDomainReplicationMessagesRequest {\n  // lastRetrievedMessageId is where the next fetch should begin with\n  10: optional i64 (js.type = \"Long\") lastRetrievedMessageId\n  // lastProcessedMessageId is the last messageId that is processed on the passive side.\n  // This can be different than lastRetrievedMessageId if passive side supports prefetching messages.\n  20: optional i64 (js.type = \"Long\") lastProcessedMessageId\n  // clusterName is the name of the pulling cluster\n  30: optional string clusterName\n}\n\nstruct GetDomainReplicationMessagesResponse {\n  10: optional ReplicationMessages messages\n}\n\nstruct GetDLQReplicationMessagesRequest {\n  10: optional list<ReplicationTaskInfo> taskInfos\n}\n\nstruct GetDLQReplicationMessagesResponse {\n  10: optional list<ReplicationTask> replicationTasks\n}\n\nenum DLQType {\n  Replication,\n  Domain,\n}\n\nstruct ReadDLQMessagesRequest{\n  10: optional DLQType type\n  20: optional i32 shardID\n  30: optional string sourceCluster\n  40: optional i64 (js.type = \"Long\") inclusiveEndMessageID\n  50: optional i32 maximumPageSize\n  60: optional binary nextPageToken\n}\n\nstruct ReadDLQMessagesResponse{\n  10: optional DLQType type\n  20: optional list<ReplicationTask> replicationTasks\n  30: optional binary nextPageToken\n  40: optional list<ReplicationTaskInfo> replicationTasksInfo\n}\n\nstruct PurgeDLQMessagesRequest{\n  10: optional DLQType type\n  20: optional i32 shardID\n  30: optional string sourceCluster\n  40: optional i64 (js.type = \"Long\") inclusiveEndMessageID\n}\n\nstruct MergeDLQMessagesRequest{\n  10: optional DLQType type\n  20: optional i32 shardID\n  30: optional string sourceCluster\n  40: optional i64 (js.type = \"Long\") inclusiveEndMessageID\n  50: optional i32 maximumPageSize\n  60: optional binary nextPageToken\n}\n\nstruct MergeDLQMessagesResponse{\n  10: optional binary nextPageToken\n}\n"
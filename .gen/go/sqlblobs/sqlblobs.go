@@ -22,39 +22,45 @@ import (
 )
 
 type ActivityInfo struct {
-	Version                       *int64                 `json:"version,omitempty"`
-	ScheduledEventBatchID         *int64                 `json:"scheduledEventBatchID,omitempty"`
-	ScheduledEvent                []byte                 `json:"scheduledEvent,omitempty"`
-	ScheduledEventEncoding        *string                `json:"scheduledEventEncoding,omitempty"`
-	ScheduledTimeNanos            *int64                 `json:"scheduledTimeNanos,omitempty"`
-	StartedID                     *int64                 `json:"startedID,omitempty"`
-	StartedEvent                  []byte                 `json:"startedEvent,omitempty"`
-	StartedEventEncoding          *string                `json:"startedEventEncoding,omitempty"`
-	StartedTimeNanos              *int64                 `json:"startedTimeNanos,omitempty"`
-	ActivityID                    *string                `json:"activityID,omitempty"`
-	RequestID                     *string                `json:"requestID,omitempty"`
-	ScheduleToStartTimeoutSeconds *int32                 `json:"scheduleToStartTimeoutSeconds,omitempty"`
-	ScheduleToCloseTimeoutSeconds *int32                 `json:"scheduleToCloseTimeoutSeconds,omitempty"`
-	StartToCloseTimeoutSeconds    *int32                 `json:"startToCloseTimeoutSeconds,omitempty"`
-	HeartbeatTimeoutSeconds       *int32                 `json:"heartbeatTimeoutSeconds,omitempty"`
-	CancelRequested               *bool                  `json:"cancelRequested,omitempty"`
-	CancelRequestID               *int64                 `json:"cancelRequestID,omitempty"`
-	TimerTaskStatus               *int32                 `json:"timerTaskStatus,omitempty"`
-	Attempt                       *int32                 `json:"attempt,omitempty"`
-	TaskList                      *string                `json:"taskList,omitempty"`
-	TaskListKind                  *shared.TaskListKind   `json:"taskListKind,omitempty"`
-	StartedIdentity               *string                `json:"startedIdentity,omitempty"`
-	HasRetryPolicy                *bool                  `json:"hasRetryPolicy,omitempty"`
-	RetryInitialIntervalSeconds   *int32                 `json:"retryInitialIntervalSeconds,omitempty"`
-	RetryMaximumIntervalSeconds   *int32                 `json:"retryMaximumIntervalSeconds,omitempty"`
-	RetryMaximumAttempts          *int32                 `json:"retryMaximumAttempts,omitempty"`
-	RetryExpirationTimeNanos      *int64                 `json:"retryExpirationTimeNanos,omitempty"`
-	RetryBackoffCoefficient       *float64               `json:"retryBackoffCoefficient,omitempty"`
-	RetryNonRetryableErrors       []string               `json:"retryNonRetryableErrors,omitempty"`
-	RetryLastFailureReason        *string                `json:"retryLastFailureReason,omitempty"`
-	RetryLastWorkerIdentity       *string                `json:"retryLastWorkerIdentity,omitempty"`
-	RetryLastFailureDetails       []byte                 `json:"retryLastFailureDetails,omitempty"`
-	RetryLastFailureOptions       *shared.FailureOptions `json:"retryLastFailureOptions,omitempty"`
+	Version                       *int64                        `json:"version,omitempty"`
+	ScheduledEventBatchID         *int64                        `json:"scheduledEventBatchID,omitempty"`
+	ScheduledEvent                []byte                        `json:"scheduledEvent,omitempty"`
+	ScheduledEventEncoding        *string                       `json:"scheduledEventEncoding,omitempty"`
+	ScheduledTimeNanos            *int64                        `json:"scheduledTimeNanos,omitempty"`
+	StartedID                     *int64                        `json:"startedID,omitempty"`
+	StartedEvent                  []byte                        `json:"startedEvent,omitempty"`
+	StartedEventEncoding          *string                       `json:"startedEventEncoding,omitempty"`
+	StartedTimeNanos              *int64                        `json:"startedTimeNanos,omitempty"`
+	ActivityID                    *string                       `json:"activityID,omitempty"`
+	RequestID                     *string                       `json:"requestID,omitempty"`
+	ScheduleToStartTimeoutSeconds *int32                        `json:"scheduleToStartTimeoutSeconds,omitempty"`
+	ScheduleToCloseTimeoutSeconds *int32                        `json:"scheduleToCloseTimeoutSeconds,omitempty"`
+	StartToCloseTimeoutSeconds    *int32                        `json:"startToCloseTimeoutSeconds,omitempty"`
+	HeartbeatTimeoutSeconds       *int32                        `json:"heartbeatTimeoutSeconds,omitempty"`
+	CancelRequested               *bool                         `json:"cancelRequested,omitempty"`
+	CancelRequestID               *int64                        `json:"cancelRequestID,omitempty"`
+	TimerTaskStatus               *int32                        `json:"timerTaskStatus,omitempty"`
+	Attempt                       *int32                        `json:"attempt,omitempty"`
+	TaskList                      *string                       `json:"taskList,omitempty"`
+	TaskListKind                  *shared.TaskListKind          `json:"taskListKind,omitempty"`
+	StartedIdentity               *string                       `json:"startedIdentity,omitempty"`
+	HasRetryPolicy                *bool                         `json:"hasRetryPolicy,omitempty"`
+	RetryInitialIntervalSeconds   *int32                        `json:"retryInitialIntervalSeconds,omitempty"`
+	RetryMaximumIntervalSeconds   *int32                        `json:"retryMaximumIntervalSeconds,omitempty"`
+	RetryMaximumAttempts          *int32                        `json:"retryMaximumAttempts,omitempty"`
+	RetryExpirationTimeNanos      *int64                        `json:"retryExpirationTimeNanos,omitempty"`
+	RetryBackoffCoefficient       *float64                      `json:"retryBackoffCoefficient,omitempty"`
+	RetryNonRetryableErrors       []string                      `json:"retryNonRetryableErrors,omitempty"`
+	RetryLastFailureReason        *string                       `json:"retryLastFailureReason,omitempty"`
+	RetryLastWorkerIdentity       *string                       `json:"retryLastWorkerIdentity,omitempty"`
+	RetryLastFailureDetails       []byte                        `json:"retryLastFailureDetails,omitempty"`
+	RetryLastFailureOptions       *shared.FailureOptions        `json:"retryLastFailureOptions,omitempty"`
+	Priority                      *int32                        `json:"priority,omitempty"`
+	FairnessKey                   *string                       `json:"fairnessKey,omitempty"`
+	Paused                        *bool                         `json:"paused,omitempty"`
+	RetryJitterCoefficient        *float64                      `json:"retryJitterCoefficient,omitempty"`
+	RetryRetryableErrors          []string                      `json:"retryRetryableErrors,omitempty"`
+	RetryErrorReasonOverrides     []*shared.RetryPolicyOverride `json:"retryErrorReasonOverrides,omitempty"`
 }
 
 type _List_String_ValueList []string
@@ -83,6 +89,35 @@ func (_List_String_ValueList) ValueType() wire.Type {
 
 func (_List_String_ValueList) Close() {}
 
+type _List_RetryPolicyOverride_ValueList []*shared.RetryPolicyOverride
+
+func (v _List_RetryPolicyOverride_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid list '[]*shared.RetryPolicyOverride', index [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_RetryPolicyOverride_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_RetryPolicyOverride_ValueList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_List_RetryPolicyOverride_ValueList) Close() {}
+
 // ToWire translates a ActivityInfo struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
@@ -100,7 +135,7 @@ func (_List_String_ValueList) Close() {}
 //	}
 func (v *ActivityInfo) ToWire() (wire.Value, error) {
 	var (
-		fields [33]wire.Field
+		fields [39]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -370,6 +405,54 @@ func (v *ActivityInfo) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 72, Value: w}
 		i++
 	}
+	if v.Priority != nil {
+		w, err = wire.NewValueI32(*(v.Priority)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 74, Value: w}
+		i++
+	}
+	if v.FairnessKey != nil {
+		w, err = wire.NewValueString(*(v.FairnessKey)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 76, Value: w}
+		i++
+	}
+	if v.Paused != nil {
+		w, err = wire.NewValueBool(*(v.Paused)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 78, Value: w}
+		i++
+	}
+	if v.RetryJitterCoefficient != nil {
+		w, err = wire.NewValueDouble(*(v.RetryJitterCoefficient)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 80, Value: w}
+		i++
+	}
+	if v.RetryRetryableErrors != nil {
+		w, err = wire.NewValueList(_List_String_ValueList(v.RetryRetryableErrors)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 82, Value: w}
+		i++
+	}
+	if v.RetryErrorReasonOverrides != nil {
+		w, err = wire.NewValueList(_List_RetryPolicyOverride_ValueList(v.RetryErrorReasonOverrides)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 84, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -404,6 +487,30 @@ func _FailureOptions_Read(w wire.Value) (*shared.FailureOptions, error) {
 	return &v, err
 }
 
+func _RetryPolicyOverride_Read(w wire.Value) (*shared.RetryPolicyOverride, error) {
+	var v shared.RetryPolicyOverride
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _List_RetryPolicyOverride_Read(l wire.ValueList) ([]*shared.RetryPolicyOverride, error) {
+	if l.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make([]*shared.RetryPolicyOverride, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _RetryPolicyOverride_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
 // FromWire deserializes a ActivityInfo struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
@@ -745,6 +852,62 @@ func (v *ActivityInfo) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 74:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.Priority = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 76:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.FairnessKey = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 78:
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.Paused = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 80:
+			if field.Value.Type() == wire.TDouble {
+				var x float64
+				x, err = field.Value.GetDouble(), error(nil)
+				v.RetryJitterCoefficient = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 82:
+			if field.Value.Type() == wire.TList {
+				v.RetryRetryableErrors, err = _List_String_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 84:
+			if field.Value.Type() == wire.TList {
+				v.RetryErrorReasonOverrides, err = _List_RetryPolicyOverride_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -770,6 +933,27 @@ func _List_String_Encode(val []string, sw stream.Writer) error {
 	return sw.WriteListEnd()
 }
 
+func _List_RetryPolicyOverride_Encode(val []*shared.RetryPolicyOverride, sw stream.Writer) error {
+
+	lh := stream.ListHeader{
+		Type:   wire.TStruct,
+		Length: len(val),
+	}
+	if err := sw.WriteListBegin(lh); err != nil {
+		return err
+	}
+
+	for i, v := range val {
+		if v == nil {
+			return fmt.Errorf("invalid list '[]*shared.RetryPolicyOverride', index [%v]: value is nil", i)
+		}
+		if err := v.Encode(sw); err != nil {
+			return err
+		}
+	}
+	return sw.WriteListEnd()
+}
+
 // Encode serializes a ActivityInfo struct directly into bytes, without going
 // through an intermediary type.
 //
@@ -1175,6 +1359,78 @@ func (v *ActivityInfo) Encode(sw stream.Writer) error {
 		}
 	}
 
+	if v.Priority != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 74, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.Priority)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.FairnessKey != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 76, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.FairnessKey)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Paused != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 78, Type: wire.TBool}); err != nil {
+			return err
+		}
+		if err := sw.WriteBool(*(v.Paused)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.RetryJitterCoefficient != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 80, Type: wire.TDouble}); err != nil {
+			return err
+		}
+		if err := sw.WriteDouble(*(v.RetryJitterCoefficient)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.RetryRetryableErrors != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 82, Type: wire.TList}); err != nil {
+			return err
+		}
+		if err := _List_String_Encode(v.RetryRetryableErrors, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.RetryErrorReasonOverrides != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 84, Type: wire.TList}); err != nil {
+			return err
+		}
+		if err := _List_RetryPolicyOverride_Encode(v.RetryErrorReasonOverrides, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
 	return sw.WriteStructEnd()
 }
 
@@ -1220,6 +1476,42 @@ func _FailureOptions_Decode(sr stream.Reader) (*shared.FailureOptions, error) {
 	return &v, err
 }
 
+func _RetryPolicyOverride_Decode(sr stream.Reader) (*shared.RetryPolicyOverride, error) {
+	var v shared.RetryPolicyOverride
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func _List_RetryPolicyOverride_Decode(sr stream.Reader) ([]*shared.RetryPolicyOverride, error) {
+	lh, err := sr.ReadListBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if lh.Type != wire.TStruct {
+		for i := 0; i < lh.Length; i++ {
+			if err := sr.Skip(lh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadListEnd()
+	}
+
+	o := make([]*shared.RetryPolicyOverride, 0, lh.Length)
+	for i := 0; i < lh.Length; i++ {
+		v, err := _RetryPolicyOverride_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+		o = append(o, v)
+	}
+
+	if err = sr.ReadListEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
 // Decode deserializes a ActivityInfo struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
@@ -1492,24 +1784,68 @@ func (v *ActivityInfo) Decode(sr stream.Reader) error {
 				return err
 			}
 
-		default:
-			if err := sr.Skip(fh.Type); err != nil {
+		case fh.ID == 74 && fh.Type == wire.TI32:
+			var x int32
+			x, err = sr.ReadInt32()
+			v.Priority = &x
+			if err != nil {
 				return err
 			}
-		}
-
-		if err := sr.ReadFieldEnd(); err != nil {
-			return err
-		}
 
-		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
-			return err
-		}
-	}
+		case fh.ID == 76 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.FairnessKey = &x
+			if err != nil {
+				return err
+			}
 
-	if err := sr.ReadStructEnd(); err != nil {
-		return err
-	}
+		case fh.ID == 78 && fh.Type == wire.TBool:
+			var x bool
+			x, err = sr.ReadBool()
+			v.Paused = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 80 && fh.Type == wire.TDouble:
+			var x float64
+			x, err = sr.ReadDouble()
+			v.RetryJitterCoefficient = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 82 && fh.Type == wire.TList:
+			v.RetryRetryableErrors, err = _List_String_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 84 && fh.Type == wire.TList:
+			v.RetryErrorReasonOverrides, err = _List_RetryPolicyOverride_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -1521,7 +1857,7 @@ func (v *ActivityInfo) String() string {
 		return "<nil>"
 	}
 
-	var fields [33]string
+	var fields [39]string
 	i := 0
 	if v.Version != nil {
 		fields[i] = fmt.Sprintf("Version: %v", *(v.Version))
@@ -1655,6 +1991,30 @@ func (v *ActivityInfo) String() string {
 		fields[i] = fmt.Sprintf("RetryLastFailureOptions: %v", v.RetryLastFailureOptions)
 		i++
 	}
+	if v.Priority != nil {
+		fields[i] = fmt.Sprintf("Priority: %v", *(v.Priority))
+		i++
+	}
+	if v.FairnessKey != nil {
+		fields[i] = fmt.Sprintf("FairnessKey: %v", *(v.FairnessKey))
+		i++
+	}
+	if v.Paused != nil {
+		fields[i] = fmt.Sprintf("Paused: %v", *(v.Paused))
+		i++
+	}
+	if v.RetryJitterCoefficient != nil {
+		fields[i] = fmt.Sprintf("RetryJitterCoefficient: %v", *(v.RetryJitterCoefficient))
+		i++
+	}
+	if v.RetryRetryableErrors != nil {
+		fields[i] = fmt.Sprintf("RetryRetryableErrors: %v", v.RetryRetryableErrors)
+		i++
+	}
+	if v.RetryErrorReasonOverrides != nil {
+		fields[i] = fmt.Sprintf("RetryErrorReasonOverrides: %v", v.RetryErrorReasonOverrides)
+		i++
+	}
 
 	return fmt.Sprintf("ActivityInfo{%v}", strings.Join(fields[:i], ", "))
 }
@@ -1734,6 +2094,21 @@ func _List_String_Equals(lhs, rhs []string) bool {
 	return true
 }
 
+func _List_RetryPolicyOverride_Equals(lhs, rhs []*shared.RetryPolicyOverride) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
 // Equals returns true if all the fields of this ActivityInfo match the
 // provided ActivityInfo.
 //
@@ -1843,6 +2218,24 @@ func (v *ActivityInfo) Equals(rhs *ActivityInfo) bool {
 	if !((v.RetryLastFailureOptions == nil && rhs.RetryLastFailureOptions == nil) || (v.RetryLastFailureOptions != nil && rhs.RetryLastFailureOptions != nil && v.RetryLastFailureOptions.Equals(rhs.RetryLastFailureOptions))) {
 		return false
 	}
+	if !_I32_EqualsPtr(v.Priority, rhs.Priority) {
+		return false
+	}
+	if !_String_EqualsPtr(v.FairnessKey, rhs.FairnessKey) {
+		return false
+	}
+	if !_Bool_EqualsPtr(v.Paused, rhs.Paused) {
+		return false
+	}
+	if !_Double_EqualsPtr(v.RetryJitterCoefficient, rhs.RetryJitterCoefficient) {
+		return false
+	}
+	if !((v.RetryRetryableErrors == nil && rhs.RetryRetryableErrors == nil) || (v.RetryRetryableErrors != nil && rhs.RetryRetryableErrors != nil && _List_String_Equals(v.RetryRetryableErrors, rhs.RetryRetryableErrors))) {
+		return false
+	}
+	if !((v.RetryErrorReasonOverrides == nil && rhs.RetryErrorReasonOverrides == nil) || (v.RetryErrorReasonOverrides != nil && rhs.RetryErrorReasonOverrides != nil && _List_RetryPolicyOverride_Equals(v.RetryErrorReasonOverrides, rhs.RetryErrorReasonOverrides))) {
+		return false
+	}
 
 	return true
 }
@@ -1858,6 +2251,17 @@ func (l _List_String_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err erro
 	return err
 }
 
+type _List_RetryPolicyOverride_Zapper []*shared.RetryPolicyOverride
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_RetryPolicyOverride_Zapper.
+func (l _List_RetryPolicyOverride_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		err = multierr.Append(err, enc.AppendObject(v))
+	}
+	return err
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of ActivityInfo.
 func (v *ActivityInfo) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -1963,6 +2367,24 @@ func (v *ActivityInfo) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v.RetryLastFailureOptions != nil {
 		err = multierr.Append(err, enc.AddObject("retryLastFailureOptions", v.RetryLastFailureOptions))
 	}
+	if v.Priority != nil {
+		enc.AddInt32("priority", *v.Priority)
+	}
+	if v.FairnessKey != nil {
+		enc.AddString("fairnessKey", *v.FairnessKey)
+	}
+	if v.Paused != nil {
+		enc.AddBool("paused", *v.Paused)
+	}
+	if v.RetryJitterCoefficient != nil {
+		enc.AddFloat64("retryJitterCoefficient", *v.RetryJitterCoefficient)
+	}
+	if v.RetryRetryableErrors != nil {
+		err = multierr.Append(err, enc.AddArray("retryRetryableErrors", (_List_String_Zapper)(v.RetryRetryableErrors)))
+	}
+	if v.RetryErrorReasonOverrides != nil {
+		err = multierr.Append(err, enc.AddArray("retryErrorReasonOverrides", (_List_RetryPolicyOverride_Zapper)(v.RetryErrorReasonOverrides)))
+	}
 	return err
 }
 
@@ -2461,6 +2883,96 @@ func (v *ActivityInfo) IsSetRetryLastFailureOptions() bool {
 	return v != nil && v.RetryLastFailureOptions != nil
 }
 
+// GetPriority returns the value of Priority if it is set or its
+// zero value if it is unset.
+func (v *ActivityInfo) GetPriority() (o int32) {
+	if v != nil && v.Priority != nil {
+		return *v.Priority
+	}
+
+	return
+}
+
+// IsSetPriority returns true if Priority is not nil.
+func (v *ActivityInfo) IsSetPriority() bool {
+	return v != nil && v.Priority != nil
+}
+
+// GetFairnessKey returns the value of FairnessKey if it is set or its
+// zero value if it is unset.
+func (v *ActivityInfo) GetFairnessKey() (o string) {
+	if v != nil && v.FairnessKey != nil {
+		return *v.FairnessKey
+	}
+
+	return
+}
+
+// IsSetFairnessKey returns true if FairnessKey is not nil.
+func (v *ActivityInfo) IsSetFairnessKey() bool {
+	return v != nil && v.FairnessKey != nil
+}
+
+// GetPaused returns the value of Paused if it is set or its
+// zero value if it is unset.
+func (v *ActivityInfo) GetPaused() (o bool) {
+	if v != nil && v.Paused != nil {
+		return *v.Paused
+	}
+
+	return
+}
+
+// IsSetPaused returns true if Paused is not nil.
+func (v *ActivityInfo) IsSetPaused() bool {
+	return v != nil && v.Paused != nil
+}
+
+// GetRetryJitterCoefficient returns the value of RetryJitterCoefficient if it is set or its
+// zero value if it is unset.
+func (v *ActivityInfo) GetRetryJitterCoefficient() (o float64) {
+	if v != nil && v.RetryJitterCoefficient != nil {
+		return *v.RetryJitterCoefficient
+	}
+
+	return
+}
+
+// IsSetRetryJitterCoefficient returns true if RetryJitterCoefficient is not nil.
+func (v *ActivityInfo) IsSetRetryJitterCoefficient() bool {
+	return v != nil && v.RetryJitterCoefficient != nil
+}
+
+// GetRetryRetryableErrors returns the value of RetryRetryableErrors if it is set or its
+// zero value if it is unset.
+func (v *ActivityInfo) GetRetryRetryableErrors() (o []string) {
+	if v != nil && v.RetryRetryableErrors != nil {
+		return v.RetryRetryableErrors
+	}
+
+	return
+}
+
+// IsSetRetryRetryableErrors returns true if RetryRetryableErrors is not nil.
+func (v *ActivityInfo) IsSetRetryRetryableErrors() bool {
+	return v != nil && v.RetryRetryableErrors != nil
+}
+
+// GetRetryErrorReasonOverrides returns the value of RetryErrorReasonOverrides if it is set or its
+// zero value if it is unset.
+func (v *ActivityInfo) GetRetryErrorReasonOverrides() (o []*shared.RetryPolicyOverride) {
+	if v != nil && v.RetryErrorReasonOverrides != nil {
+		return v.RetryErrorReasonOverrides
+	}
+
+	return
+}
+
+// IsSetRetryErrorReasonOverrides returns true if RetryErrorReasonOverrides is not nil.
+func (v *ActivityInfo) IsSetRetryErrorReasonOverrides() bool {
+	return v != nil && v.RetryErrorReasonOverrides != nil
+}
+
 type AsyncRequestMessage struct {
 	PartitionKey *string           `json:"partitionKey,omitempty"`
 	Type         *AsyncRequestType `json:"type,omitempty"`
@@ -4024,162 +4536,556 @@ func (v *ChildExecutionInfo) GetInitiatedEvent() (o []byte) {
 		return v.InitiatedEvent
 	}
 
-	return
+	return
+}
+
+// IsSetInitiatedEvent returns true if InitiatedEvent is not nil.
+func (v *ChildExecutionInfo) IsSetInitiatedEvent() bool {
+	return v != nil && v.InitiatedEvent != nil
+}
+
+// GetInitiatedEventEncoding returns the value of InitiatedEventEncoding if it is set or its
+// zero value if it is unset.
+func (v *ChildExecutionInfo) GetInitiatedEventEncoding() (o string) {
+	if v != nil && v.InitiatedEventEncoding != nil {
+		return *v.InitiatedEventEncoding
+	}
+
+	return
+}
+
+// IsSetInitiatedEventEncoding returns true if InitiatedEventEncoding is not nil.
+func (v *ChildExecutionInfo) IsSetInitiatedEventEncoding() bool {
+	return v != nil && v.InitiatedEventEncoding != nil
+}
+
+// GetStartedWorkflowID returns the value of StartedWorkflowID if it is set or its
+// zero value if it is unset.
+func (v *ChildExecutionInfo) GetStartedWorkflowID() (o string) {
+	if v != nil && v.StartedWorkflowID != nil {
+		return *v.StartedWorkflowID
+	}
+
+	return
+}
+
+// IsSetStartedWorkflowID returns true if StartedWorkflowID is not nil.
+func (v *ChildExecutionInfo) IsSetStartedWorkflowID() bool {
+	return v != nil && v.StartedWorkflowID != nil
+}
+
+// GetStartedRunID returns the value of StartedRunID if it is set or its
+// zero value if it is unset.
+func (v *ChildExecutionInfo) GetStartedRunID() (o []byte) {
+	if v != nil && v.StartedRunID != nil {
+		return v.StartedRunID
+	}
+
+	return
+}
+
+// IsSetStartedRunID returns true if StartedRunID is not nil.
+func (v *ChildExecutionInfo) IsSetStartedRunID() bool {
+	return v != nil && v.StartedRunID != nil
+}
+
+// GetStartedEvent returns the value of StartedEvent if it is set or its
+// zero value if it is unset.
+func (v *ChildExecutionInfo) GetStartedEvent() (o []byte) {
+	if v != nil && v.StartedEvent != nil {
+		return v.StartedEvent
+	}
+
+	return
+}
+
+// IsSetStartedEvent returns true if StartedEvent is not nil.
+func (v *ChildExecutionInfo) IsSetStartedEvent() bool {
+	return v != nil && v.StartedEvent != nil
+}
+
+// GetStartedEventEncoding returns the value of StartedEventEncoding if it is set or its
+// zero value if it is unset.
+func (v *ChildExecutionInfo) GetStartedEventEncoding() (o string) {
+	if v != nil && v.StartedEventEncoding != nil {
+		return *v.StartedEventEncoding
+	}
+
+	return
+}
+
+// IsSetStartedEventEncoding returns true if StartedEventEncoding is not nil.
+func (v *ChildExecutionInfo) IsSetStartedEventEncoding() bool {
+	return v != nil && v.StartedEventEncoding != nil
+}
+
+// GetCreateRequestID returns the value of CreateRequestID if it is set or its
+// zero value if it is unset.
+func (v *ChildExecutionInfo) GetCreateRequestID() (o string) {
+	if v != nil && v.CreateRequestID != nil {
+		return *v.CreateRequestID
+	}
+
+	return
+}
+
+// IsSetCreateRequestID returns true if CreateRequestID is not nil.
+func (v *ChildExecutionInfo) IsSetCreateRequestID() bool {
+	return v != nil && v.CreateRequestID != nil
+}
+
+// GetDomainID returns the value of DomainID if it is set or its
+// zero value if it is unset.
+func (v *ChildExecutionInfo) GetDomainID() (o string) {
+	if v != nil && v.DomainID != nil {
+		return *v.DomainID
+	}
+
+	return
+}
+
+// IsSetDomainID returns true if DomainID is not nil.
+func (v *ChildExecutionInfo) IsSetDomainID() bool {
+	return v != nil && v.DomainID != nil
+}
+
+// GetDomainName returns the value of DomainName if it is set or its
+// zero value if it is unset.
+func (v *ChildExecutionInfo) GetDomainName() (o string) {
+	if v != nil && v.DomainName != nil {
+		return *v.DomainName
+	}
+
+	return
+}
+
+// IsSetDomainName returns true if DomainName is not nil.
+func (v *ChildExecutionInfo) IsSetDomainName() bool {
+	return v != nil && v.DomainName != nil
+}
+
+// GetWorkflowTypeName returns the value of WorkflowTypeName if it is set or its
+// zero value if it is unset.
+func (v *ChildExecutionInfo) GetWorkflowTypeName() (o string) {
+	if v != nil && v.WorkflowTypeName != nil {
+		return *v.WorkflowTypeName
+	}
+
+	return
+}
+
+// IsSetWorkflowTypeName returns true if WorkflowTypeName is not nil.
+func (v *ChildExecutionInfo) IsSetWorkflowTypeName() bool {
+	return v != nil && v.WorkflowTypeName != nil
+}
+
+// GetParentClosePolicy returns the value of ParentClosePolicy if it is set or its
+// zero value if it is unset.
+func (v *ChildExecutionInfo) GetParentClosePolicy() (o int32) {
+	if v != nil && v.ParentClosePolicy != nil {
+		return *v.ParentClosePolicy
+	}
+
+	return
+}
+
+// IsSetParentClosePolicy returns true if ParentClosePolicy is not nil.
+func (v *ChildExecutionInfo) IsSetParentClosePolicy() bool {
+	return v != nil && v.ParentClosePolicy != nil
+}
+
+type CompletedUpdate struct {
+	UpdateID       *string `json:"updateID,omitempty"`
+	Result         []byte  `json:"result,omitempty"`
+	FailureReason  *string `json:"failureReason,omitempty"`
+	FailureDetails []byte  `json:"failureDetails,omitempty"`
+}
+
+// ToWire translates a CompletedUpdate struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//	x, err := v.ToWire()
+//	if err != nil {
+//		return err
+//	}
+//
+//	if err := binaryProtocol.Encode(x, writer); err != nil {
+//		return err
+//	}
+func (v *CompletedUpdate) ToWire() (wire.Value, error) {
+	var (
+		fields [4]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.UpdateID != nil {
+		w, err = wire.NewValueString(*(v.UpdateID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.Result != nil {
+		w, err = wire.NewValueBinary(v.Result), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 12, Value: w}
+		i++
+	}
+	if v.FailureReason != nil {
+		w, err = wire.NewValueString(*(v.FailureReason)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 14, Value: w}
+		i++
+	}
+	if v.FailureDetails != nil {
+		w, err = wire.NewValueBinary(v.FailureDetails), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 16, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a CompletedUpdate struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a CompletedUpdate struct
+// from the provided intermediate representation.
+//
+//	x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//	if err != nil {
+//		return nil, err
+//	}
+//
+//	var v CompletedUpdate
+//	if err := v.FromWire(x); err != nil {
+//		return nil, err
+//	}
+//	return &v, nil
+func (v *CompletedUpdate) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.UpdateID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 12:
+			if field.Value.Type() == wire.TBinary {
+				v.Result, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 14:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.FailureReason = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 16:
+			if field.Value.Type() == wire.TBinary {
+				v.FailureDetails, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// Encode serializes a CompletedUpdate struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a CompletedUpdate struct could not be encoded.
+func (v *CompletedUpdate) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.UpdateID != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.UpdateID)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Result != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 12, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteBinary(v.Result); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.FailureReason != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 14, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.FailureReason)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.FailureDetails != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 16, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteBinary(v.FailureDetails); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
 }
 
-// IsSetInitiatedEvent returns true if InitiatedEvent is not nil.
-func (v *ChildExecutionInfo) IsSetInitiatedEvent() bool {
-	return v != nil && v.InitiatedEvent != nil
-}
+// Decode deserializes a CompletedUpdate struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a CompletedUpdate struct could not be generated from the wire
+// representation.
+func (v *CompletedUpdate) Decode(sr stream.Reader) error {
 
-// GetInitiatedEventEncoding returns the value of InitiatedEventEncoding if it is set or its
-// zero value if it is unset.
-func (v *ChildExecutionInfo) GetInitiatedEventEncoding() (o string) {
-	if v != nil && v.InitiatedEventEncoding != nil {
-		return *v.InitiatedEventEncoding
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
 	}
 
-	return
-}
-
-// IsSetInitiatedEventEncoding returns true if InitiatedEventEncoding is not nil.
-func (v *ChildExecutionInfo) IsSetInitiatedEventEncoding() bool {
-	return v != nil && v.InitiatedEventEncoding != nil
-}
-
-// GetStartedWorkflowID returns the value of StartedWorkflowID if it is set or its
-// zero value if it is unset.
-func (v *ChildExecutionInfo) GetStartedWorkflowID() (o string) {
-	if v != nil && v.StartedWorkflowID != nil {
-		return *v.StartedWorkflowID
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
 	}
 
-	return
-}
+	for ok {
+		switch {
+		case fh.ID == 10 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.UpdateID = &x
+			if err != nil {
+				return err
+			}
 
-// IsSetStartedWorkflowID returns true if StartedWorkflowID is not nil.
-func (v *ChildExecutionInfo) IsSetStartedWorkflowID() bool {
-	return v != nil && v.StartedWorkflowID != nil
-}
+		case fh.ID == 12 && fh.Type == wire.TBinary:
+			v.Result, err = sr.ReadBinary()
+			if err != nil {
+				return err
+			}
 
-// GetStartedRunID returns the value of StartedRunID if it is set or its
-// zero value if it is unset.
-func (v *ChildExecutionInfo) GetStartedRunID() (o []byte) {
-	if v != nil && v.StartedRunID != nil {
-		return v.StartedRunID
-	}
+		case fh.ID == 14 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.FailureReason = &x
+			if err != nil {
+				return err
+			}
 
-	return
-}
+		case fh.ID == 16 && fh.Type == wire.TBinary:
+			v.FailureDetails, err = sr.ReadBinary()
+			if err != nil {
+				return err
+			}
 
-// IsSetStartedRunID returns true if StartedRunID is not nil.
-func (v *ChildExecutionInfo) IsSetStartedRunID() bool {
-	return v != nil && v.StartedRunID != nil
-}
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
 
-// GetStartedEvent returns the value of StartedEvent if it is set or its
-// zero value if it is unset.
-func (v *ChildExecutionInfo) GetStartedEvent() (o []byte) {
-	if v != nil && v.StartedEvent != nil {
-		return v.StartedEvent
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
 	}
 
-	return
-}
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
 
-// IsSetStartedEvent returns true if StartedEvent is not nil.
-func (v *ChildExecutionInfo) IsSetStartedEvent() bool {
-	return v != nil && v.StartedEvent != nil
+	return nil
 }
 
-// GetStartedEventEncoding returns the value of StartedEventEncoding if it is set or its
-// zero value if it is unset.
-func (v *ChildExecutionInfo) GetStartedEventEncoding() (o string) {
-	if v != nil && v.StartedEventEncoding != nil {
-		return *v.StartedEventEncoding
+// String returns a readable string representation of a CompletedUpdate
+// struct.
+func (v *CompletedUpdate) String() string {
+	if v == nil {
+		return "<nil>"
 	}
 
-	return
-}
+	var fields [4]string
+	i := 0
+	if v.UpdateID != nil {
+		fields[i] = fmt.Sprintf("UpdateID: %v", *(v.UpdateID))
+		i++
+	}
+	if v.Result != nil {
+		fields[i] = fmt.Sprintf("Result: %v", v.Result)
+		i++
+	}
+	if v.FailureReason != nil {
+		fields[i] = fmt.Sprintf("FailureReason: %v", *(v.FailureReason))
+		i++
+	}
+	if v.FailureDetails != nil {
+		fields[i] = fmt.Sprintf("FailureDetails: %v", v.FailureDetails)
+		i++
+	}
 
-// IsSetStartedEventEncoding returns true if StartedEventEncoding is not nil.
-func (v *ChildExecutionInfo) IsSetStartedEventEncoding() bool {
-	return v != nil && v.StartedEventEncoding != nil
+	return fmt.Sprintf("CompletedUpdate{%v}", strings.Join(fields[:i], ", "))
 }
 
-// GetCreateRequestID returns the value of CreateRequestID if it is set or its
-// zero value if it is unset.
-func (v *ChildExecutionInfo) GetCreateRequestID() (o string) {
-	if v != nil && v.CreateRequestID != nil {
-		return *v.CreateRequestID
+// Equals returns true if all the fields of this CompletedUpdate match the
+// provided CompletedUpdate.
+//
+// This function performs a deep comparison.
+func (v *CompletedUpdate) Equals(rhs *CompletedUpdate) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.UpdateID, rhs.UpdateID) {
+		return false
+	}
+	if !((v.Result == nil && rhs.Result == nil) || (v.Result != nil && rhs.Result != nil && bytes.Equal(v.Result, rhs.Result))) {
+		return false
+	}
+	if !_String_EqualsPtr(v.FailureReason, rhs.FailureReason) {
+		return false
+	}
+	if !((v.FailureDetails == nil && rhs.FailureDetails == nil) || (v.FailureDetails != nil && rhs.FailureDetails != nil && bytes.Equal(v.FailureDetails, rhs.FailureDetails))) {
+		return false
 	}
 
-	return
+	return true
 }
 
-// IsSetCreateRequestID returns true if CreateRequestID is not nil.
-func (v *ChildExecutionInfo) IsSetCreateRequestID() bool {
-	return v != nil && v.CreateRequestID != nil
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of CompletedUpdate.
+func (v *CompletedUpdate) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.UpdateID != nil {
+		enc.AddString("updateID", *v.UpdateID)
+	}
+	if v.Result != nil {
+		enc.AddString("result", base64.StdEncoding.EncodeToString(v.Result))
+	}
+	if v.FailureReason != nil {
+		enc.AddString("failureReason", *v.FailureReason)
+	}
+	if v.FailureDetails != nil {
+		enc.AddString("failureDetails", base64.StdEncoding.EncodeToString(v.FailureDetails))
+	}
+	return err
 }
 
-// GetDomainID returns the value of DomainID if it is set or its
+// GetUpdateID returns the value of UpdateID if it is set or its
 // zero value if it is unset.
-func (v *ChildExecutionInfo) GetDomainID() (o string) {
-	if v != nil && v.DomainID != nil {
-		return *v.DomainID
+func (v *CompletedUpdate) GetUpdateID() (o string) {
+	if v != nil && v.UpdateID != nil {
+		return *v.UpdateID
 	}
 
 	return
 }
 
-// IsSetDomainID returns true if DomainID is not nil.
-func (v *ChildExecutionInfo) IsSetDomainID() bool {
-	return v != nil && v.DomainID != nil
+// IsSetUpdateID returns true if UpdateID is not nil.
+func (v *CompletedUpdate) IsSetUpdateID() bool {
+	return v != nil && v.UpdateID != nil
 }
 
-// GetDomainName returns the value of DomainName if it is set or its
+// GetResult returns the value of Result if it is set or its
 // zero value if it is unset.
-func (v *ChildExecutionInfo) GetDomainName() (o string) {
-	if v != nil && v.DomainName != nil {
-		return *v.DomainName
+func (v *CompletedUpdate) GetResult() (o []byte) {
+	if v != nil && v.Result != nil {
+		return v.Result
 	}
 
 	return
 }
 
-// IsSetDomainName returns true if DomainName is not nil.
-func (v *ChildExecutionInfo) IsSetDomainName() bool {
-	return v != nil && v.DomainName != nil
+// IsSetResult returns true if Result is not nil.
+func (v *CompletedUpdate) IsSetResult() bool {
+	return v != nil && v.Result != nil
 }
 
-// GetWorkflowTypeName returns the value of WorkflowTypeName if it is set or its
+// GetFailureReason returns the value of FailureReason if it is set or its
 // zero value if it is unset.
-func (v *ChildExecutionInfo) GetWorkflowTypeName() (o string) {
-	if v != nil && v.WorkflowTypeName != nil {
-		return *v.WorkflowTypeName
+func (v *CompletedUpdate) GetFailureReason() (o string) {
+	if v != nil && v.FailureReason != nil {
+		return *v.FailureReason
 	}
 
 	return
 }
 
-// IsSetWorkflowTypeName returns true if WorkflowTypeName is not nil.
-func (v *ChildExecutionInfo) IsSetWorkflowTypeName() bool {
-	return v != nil && v.WorkflowTypeName != nil
+// IsSetFailureReason returns true if FailureReason is not nil.
+func (v *CompletedUpdate) IsSetFailureReason() bool {
+	return v != nil && v.FailureReason != nil
 }
 
-// GetParentClosePolicy returns the value of ParentClosePolicy if it is set or its
+// GetFailureDetails returns the value of FailureDetails if it is set or its
 // zero value if it is unset.
-func (v *ChildExecutionInfo) GetParentClosePolicy() (o int32) {
-	if v != nil && v.ParentClosePolicy != nil {
-		return *v.ParentClosePolicy
+func (v *CompletedUpdate) GetFailureDetails() (o []byte) {
+	if v != nil && v.FailureDetails != nil {
+		return v.FailureDetails
 	}
 
 	return
 }
 
-// IsSetParentClosePolicy returns true if ParentClosePolicy is not nil.
-func (v *ChildExecutionInfo) IsSetParentClosePolicy() bool {
-	return v != nil && v.ParentClosePolicy != nil
+// IsSetFailureDetails returns true if FailureDetails is not nil.
+func (v *CompletedUpdate) IsSetFailureDetails() bool {
+	return v != nil && v.FailureDetails != nil
 }
 
 type DomainInfo struct {
@@ -14929,72 +15835,77 @@ func (v *TransferTaskInfo) IsSetOriginalTaskListKind() bool {
 }
 
 type WorkflowExecutionInfo struct {
-	ParentDomainID                          []byte                    `json:"parentDomainID,omitempty"`
-	ParentWorkflowID                        *string                   `json:"parentWorkflowID,omitempty"`
-	ParentRunID                             []byte                    `json:"parentRunID,omitempty"`
-	InitiatedID                             *int64                    `json:"initiatedID,omitempty"`
-	CompletionEventBatchID                  *int64                    `json:"completionEventBatchID,omitempty"`
-	CompletionEvent                         []byte                    `json:"completionEvent,omitempty"`
-	CompletionEventEncoding                 *string                   `json:"completionEventEncoding,omitempty"`
-	TaskList                                *string                   `json:"taskList,omitempty"`
-	TaskListKind                            *shared.TaskListKind      `json:"taskListKind,omitempty"`
-	WorkflowTypeName                        *string                   `json:"workflowTypeName,omitempty"`
-	WorkflowTimeoutSeconds                  *int32                    `json:"workflowTimeoutSeconds,omitempty"`
-	DecisionTaskTimeoutSeconds              *int32                    `json:"decisionTaskTimeoutSeconds,omitempty"`
-	ExecutionContext                        []byte                    `json:"executionContext,omitempty"`
-	State                                   *int32                    `json:"state,omitempty"`
-	CloseStatus                             *int32                    `json:"closeStatus,omitempty"`
-	StartVersion                            *int64                    `json:"startVersion,omitempty"`
-	LastWriteEventID                        *int64                    `json:"lastWriteEventID,omitempty"`
-	LastEventTaskID                         *int64                    `json:"lastEventTaskID,omitempty"`
-	LastFirstEventID                        *int64                    `json:"lastFirstEventID,omitempty"`
-	LastProcessedEvent                      *int64                    `json:"lastProcessedEvent,omitempty"`
-	StartTimeNanos                          *int64                    `json:"startTimeNanos,omitempty"`
-	LastUpdatedTimeNanos                    *int64                    `json:"lastUpdatedTimeNanos,omitempty"`
-	DecisionVersion                         *int64                    `json:"decisionVersion,omitempty"`
-	DecisionScheduleID                      *int64                    `json:"decisionScheduleID,omitempty"`
-	DecisionStartedID                       *int64                    `json:"decisionStartedID,omitempty"`
-	DecisionTimeout                         *int32                    `json:"decisionTimeout,omitempty"`
-	DecisionAttempt                         *int64                    `json:"decisionAttempt,omitempty"`
-	DecisionStartedTimestampNanos           *int64                    `json:"decisionStartedTimestampNanos,omitempty"`
-	DecisionScheduledTimestampNanos         *int64                    `json:"decisionScheduledTimestampNanos,omitempty"`
-	CancelRequested                         *bool                     `json:"cancelRequested,omitempty"`
-	DecisionOriginalScheduledTimestampNanos *int64                    `json:"decisionOriginalScheduledTimestampNanos,omitempty"`
-	CreateRequestID                         *string                   `json:"createRequestID,omitempty"`
-	DecisionRequestID                       *string                   `json:"decisionRequestID,omitempty"`
-	CancelRequestID                         *string                   `json:"cancelRequestID,omitempty"`
-	StickyTaskList                          *string                   `json:"stickyTaskList,omitempty"`
-	StickyScheduleToStartTimeout            *int64                    `json:"stickyScheduleToStartTimeout,omitempty"`
-	RetryAttempt                            *int64                    `json:"retryAttempt,omitempty"`
-	RetryInitialIntervalSeconds             *int32                    `json:"retryInitialIntervalSeconds,omitempty"`
-	RetryMaximumIntervalSeconds             *int32                    `json:"retryMaximumIntervalSeconds,omitempty"`
-	RetryMaximumAttempts                    *int32                    `json:"retryMaximumAttempts,omitempty"`
-	RetryExpirationSeconds                  *int32                    `json:"retryExpirationSeconds,omitempty"`
-	RetryBackoffCoefficient                 *float64                  `json:"retryBackoffCoefficient,omitempty"`
-	RetryExpirationTimeNanos                *int64                    `json:"retryExpirationTimeNanos,omitempty"`
-	RetryNonRetryableErrors                 []string                  `json:"retryNonRetryableErrors,omitempty"`
-	HasRetryPolicy                          *bool                     `json:"hasRetryPolicy,omitempty"`
-	CronSchedule                            *string                   `json:"cronSchedule,omitempty"`
-	EventStoreVersion                       *int32                    `json:"eventStoreVersion,omitempty"`
-	EventBranchToken                        []byte                    `json:"eventBranchToken,omitempty"`
-	SignalCount                             *int64                    `json:"signalCount,omitempty"`
-	HistorySize                             *int64                    `json:"historySize,omitempty"`
-	ClientLibraryVersion                    *string                   `json:"clientLibraryVersion,omitempty"`
-	ClientFeatureVersion                    *string                   `json:"clientFeatureVersion,omitempty"`
-	ClientImpl                              *string                   `json:"clientImpl,omitempty"`
-	AutoResetPoints                         []byte                    `json:"autoResetPoints,omitempty"`
-	AutoResetPointsEncoding                 *string                   `json:"autoResetPointsEncoding,omitempty"`
-	SearchAttributes                        map[string][]byte         `json:"searchAttributes,omitempty"`
-	Memo                                    map[string][]byte         `json:"memo,omitempty"`
-	VersionHistories                        []byte                    `json:"versionHistories,omitempty"`
-	VersionHistoriesEncoding                *string                   `json:"versionHistoriesEncoding,omitempty"`
-	FirstExecutionRunID                     []byte                    `json:"firstExecutionRunID,omitempty"`
-	PartitionConfig                         map[string]string         `json:"partitionConfig,omitempty"`
-	Checksum                                []byte                    `json:"checksum,omitempty"`
-	ChecksumEncoding                        *string                   `json:"checksumEncoding,omitempty"`
-	CronOverlapPolicy                       *shared.CronOverlapPolicy `json:"cronOverlapPolicy,omitempty"`
-	ActiveClusterSelectionPolicy            []byte                    `json:"activeClusterSelectionPolicy,omitempty"`
-	ActiveClusterSelectionPolicyEncoding    *string                   `json:"activeClusterSelectionPolicyEncoding,omitempty"`
+	ParentDomainID                          []byte                        `json:"parentDomainID,omitempty"`
+	ParentWorkflowID                        *string                       `json:"parentWorkflowID,omitempty"`
+	ParentRunID                             []byte                        `json:"parentRunID,omitempty"`
+	InitiatedID                             *int64                        `json:"initiatedID,omitempty"`
+	CompletionEventBatchID                  *int64                        `json:"completionEventBatchID,omitempty"`
+	CompletionEvent                         []byte                        `json:"completionEvent,omitempty"`
+	CompletionEventEncoding                 *string                       `json:"completionEventEncoding,omitempty"`
+	TaskList                                *string                       `json:"taskList,omitempty"`
+	TaskListKind                            *shared.TaskListKind          `json:"taskListKind,omitempty"`
+	WorkflowTypeName                        *string                       `json:"workflowTypeName,omitempty"`
+	WorkflowTimeoutSeconds                  *int32                        `json:"workflowTimeoutSeconds,omitempty"`
+	DecisionTaskTimeoutSeconds              *int32                        `json:"decisionTaskTimeoutSeconds,omitempty"`
+	ExecutionContext                        []byte                        `json:"executionContext,omitempty"`
+	State                                   *int32                        `json:"state,omitempty"`
+	CloseStatus                             *int32                        `json:"closeStatus,omitempty"`
+	StartVersion                            *int64                        `json:"startVersion,omitempty"`
+	LastWriteEventID                        *int64                        `json:"lastWriteEventID,omitempty"`
+	LastEventTaskID                         *int64                        `json:"lastEventTaskID,omitempty"`
+	LastFirstEventID                        *int64                        `json:"lastFirstEventID,omitempty"`
+	LastProcessedEvent                      *int64                        `json:"lastProcessedEvent,omitempty"`
+	StartTimeNanos                          *int64                        `json:"startTimeNanos,omitempty"`
+	LastUpdatedTimeNanos                    *int64                        `json:"lastUpdatedTimeNanos,omitempty"`
+	DecisionVersion                         *int64                        `json:"decisionVersion,omitempty"`
+	DecisionScheduleID                      *int64                        `json:"decisionScheduleID,omitempty"`
+	DecisionStartedID                       *int64                        `json:"decisionStartedID,omitempty"`
+	DecisionTimeout                         *int32                        `json:"decisionTimeout,omitempty"`
+	DecisionAttempt                         *int64                        `json:"decisionAttempt,omitempty"`
+	DecisionStartedTimestampNanos           *int64                        `json:"decisionStartedTimestampNanos,omitempty"`
+	DecisionScheduledTimestampNanos         *int64                        `json:"decisionScheduledTimestampNanos,omitempty"`
+	CancelRequested                         *bool                         `json:"cancelRequested,omitempty"`
+	DecisionOriginalScheduledTimestampNanos *int64                        `json:"decisionOriginalScheduledTimestampNanos,omitempty"`
+	CreateRequestID                         *string                       `json:"createRequestID,omitempty"`
+	DecisionRequestID                       *string                       `json:"decisionRequestID,omitempty"`
+	CancelRequestID                         *string                       `json:"cancelRequestID,omitempty"`
+	StickyTaskList                          *string                       `json:"stickyTaskList,omitempty"`
+	StickyScheduleToStartTimeout            *int64                        `json:"stickyScheduleToStartTimeout,omitempty"`
+	RetryAttempt                            *int64                        `json:"retryAttempt,omitempty"`
+	RetryInitialIntervalSeconds             *int32                        `json:"retryInitialIntervalSeconds,omitempty"`
+	RetryMaximumIntervalSeconds             *int32                        `json:"retryMaximumIntervalSeconds,omitempty"`
+	RetryMaximumAttempts                    *int32                        `json:"retryMaximumAttempts,omitempty"`
+	RetryExpirationSeconds                  *int32                        `json:"retryExpirationSeconds,omitempty"`
+	RetryBackoffCoefficient                 *float64                      `json:"retryBackoffCoefficient,omitempty"`
+	RetryExpirationTimeNanos                *int64                        `json:"retryExpirationTimeNanos,omitempty"`
+	RetryNonRetryableErrors                 []string                      `json:"retryNonRetryableErrors,omitempty"`
+	HasRetryPolicy                          *bool                         `json:"hasRetryPolicy,omitempty"`
+	CronSchedule                            *string                       `json:"cronSchedule,omitempty"`
+	EventStoreVersion                       *int32                        `json:"eventStoreVersion,omitempty"`
+	EventBranchToken                        []byte                        `json:"eventBranchToken,omitempty"`
+	SignalCount                             *int64                        `json:"signalCount,omitempty"`
+	HistorySize                             *int64                        `json:"historySize,omitempty"`
+	ClientLibraryVersion                    *string                       `json:"clientLibraryVersion,omitempty"`
+	ClientFeatureVersion                    *string                       `json:"clientFeatureVersion,omitempty"`
+	ClientImpl                              *string                       `json:"clientImpl,omitempty"`
+	AutoResetPoints                         []byte                        `json:"autoResetPoints,omitempty"`
+	AutoResetPointsEncoding                 *string                       `json:"autoResetPointsEncoding,omitempty"`
+	SearchAttributes                        map[string][]byte             `json:"searchAttributes,omitempty"`
+	Memo                                    map[string][]byte             `json:"memo,omitempty"`
+	VersionHistories                        []byte                        `json:"versionHistories,omitempty"`
+	VersionHistoriesEncoding                *string                       `json:"versionHistoriesEncoding,omitempty"`
+	FirstExecutionRunID                     []byte                        `json:"firstExecutionRunID,omitempty"`
+	PartitionConfig                         map[string]string             `json:"partitionConfig,omitempty"`
+	Checksum                                []byte                        `json:"checksum,omitempty"`
+	ChecksumEncoding                        *string                       `json:"checksumEncoding,omitempty"`
+	CronOverlapPolicy                       *shared.CronOverlapPolicy     `json:"cronOverlapPolicy,omitempty"`
+	ActiveClusterSelectionPolicy            []byte                        `json:"activeClusterSelectionPolicy,omitempty"`
+	ActiveClusterSelectionPolicyEncoding    *string                       `json:"activeClusterSelectionPolicyEncoding,omitempty"`
+	Paused                                  *bool                         `json:"paused,omitempty"`
+	RetryJitterCoefficient                  *float64                      `json:"retryJitterCoefficient,omitempty"`
+	RetryRetryableErrors                    []string                      `json:"retryRetryableErrors,omitempty"`
+	RetryErrorReasonOverrides               []*shared.RetryPolicyOverride `json:"retryErrorReasonOverrides,omitempty"`
+	CompletedUpdates                        []*CompletedUpdate            `json:"completedUpdates,omitempty"`
 }
 
 type _Map_String_Binary_MapItemList map[string][]byte
@@ -15035,6 +15946,35 @@ func (_Map_String_Binary_MapItemList) ValueType() wire.Type {
 
 func (_Map_String_Binary_MapItemList) Close() {}
 
+type _List_CompletedUpdate_ValueList []*CompletedUpdate
+
+func (v _List_CompletedUpdate_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid list '[]*CompletedUpdate', index [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_CompletedUpdate_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_CompletedUpdate_ValueList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_List_CompletedUpdate_ValueList) Close() {}
+
 // ToWire translates a WorkflowExecutionInfo struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
@@ -15052,7 +15992,7 @@ func (_Map_String_Binary_MapItemList) Close() {}
 //	}
 func (v *WorkflowExecutionInfo) ToWire() (wire.Value, error) {
 	var (
-		fields [66]wire.Field
+		fields [71]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -15586,6 +16526,46 @@ func (v *WorkflowExecutionInfo) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 138, Value: w}
 		i++
 	}
+	if v.Paused != nil {
+		w, err = wire.NewValueBool(*(v.Paused)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 139, Value: w}
+		i++
+	}
+	if v.RetryJitterCoefficient != nil {
+		w, err = wire.NewValueDouble(*(v.RetryJitterCoefficient)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 140, Value: w}
+		i++
+	}
+	if v.RetryRetryableErrors != nil {
+		w, err = wire.NewValueList(_List_String_ValueList(v.RetryRetryableErrors)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 141, Value: w}
+		i++
+	}
+	if v.RetryErrorReasonOverrides != nil {
+		w, err = wire.NewValueList(_List_RetryPolicyOverride_ValueList(v.RetryErrorReasonOverrides)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 142, Value: w}
+		i++
+	}
+	if v.CompletedUpdates != nil {
+		w, err = wire.NewValueList(_List_CompletedUpdate_ValueList(v.CompletedUpdates)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 143, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -15606,24 +16586,48 @@ func _Map_String_Binary_Read(m wire.MapItemList) (map[string][]byte, error) {
 			return err
 		}
 
-		v, err := x.Value.GetBinary(), error(nil)
+		v, err := x.Value.GetBinary(), error(nil)
+		if err != nil {
+			return err
+		}
+
+		o[k] = v
+		return nil
+	})
+	m.Close()
+	return o, err
+}
+
+func _CronOverlapPolicy_Read(w wire.Value) (shared.CronOverlapPolicy, error) {
+	var v shared.CronOverlapPolicy
+	err := v.FromWire(w)
+	return v, err
+}
+
+func _CompletedUpdate_Read(w wire.Value) (*CompletedUpdate, error) {
+	var v CompletedUpdate
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _List_CompletedUpdate_Read(l wire.ValueList) ([]*CompletedUpdate, error) {
+	if l.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make([]*CompletedUpdate, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _CompletedUpdate_Read(x)
 		if err != nil {
 			return err
 		}
-
-		o[k] = v
+		o = append(o, i)
 		return nil
 	})
-	m.Close()
+	l.Close()
 	return o, err
 }
 
-func _CronOverlapPolicy_Read(w wire.Value) (shared.CronOverlapPolicy, error) {
-	var v shared.CronOverlapPolicy
-	err := v.FromWire(w)
-	return v, err
-}
-
 // FromWire deserializes a WorkflowExecutionInfo struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
@@ -16277,6 +17281,50 @@ func (v *WorkflowExecutionInfo) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 139:
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.Paused = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 140:
+			if field.Value.Type() == wire.TDouble {
+				var x float64
+				x, err = field.Value.GetDouble(), error(nil)
+				v.RetryJitterCoefficient = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 141:
+			if field.Value.Type() == wire.TList {
+				v.RetryRetryableErrors, err = _List_String_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 142:
+			if field.Value.Type() == wire.TList {
+				v.RetryErrorReasonOverrides, err = _List_RetryPolicyOverride_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 143:
+			if field.Value.Type() == wire.TList {
+				v.CompletedUpdates, err = _List_CompletedUpdate_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -16310,6 +17358,27 @@ func _Map_String_Binary_Encode(val map[string][]byte, sw stream.Writer) error {
 	return sw.WriteMapEnd()
 }
 
+func _List_CompletedUpdate_Encode(val []*CompletedUpdate, sw stream.Writer) error {
+
+	lh := stream.ListHeader{
+		Type:   wire.TStruct,
+		Length: len(val),
+	}
+	if err := sw.WriteListBegin(lh); err != nil {
+		return err
+	}
+
+	for i, v := range val {
+		if v == nil {
+			return fmt.Errorf("invalid list '[]*CompletedUpdate', index [%v]: value is nil", i)
+		}
+		if err := v.Encode(sw); err != nil {
+			return err
+		}
+	}
+	return sw.WriteListEnd()
+}
+
 // Encode serializes a WorkflowExecutionInfo struct directly into bytes, without going
 // through an intermediary type.
 //
@@ -17111,6 +18180,66 @@ func (v *WorkflowExecutionInfo) Encode(sw stream.Writer) error {
 		}
 	}
 
+	if v.Paused != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 139, Type: wire.TBool}); err != nil {
+			return err
+		}
+		if err := sw.WriteBool(*(v.Paused)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.RetryJitterCoefficient != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 140, Type: wire.TDouble}); err != nil {
+			return err
+		}
+		if err := sw.WriteDouble(*(v.RetryJitterCoefficient)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.RetryRetryableErrors != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 141, Type: wire.TList}); err != nil {
+			return err
+		}
+		if err := _List_String_Encode(v.RetryRetryableErrors, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.RetryErrorReasonOverrides != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 142, Type: wire.TList}); err != nil {
+			return err
+		}
+		if err := _List_RetryPolicyOverride_Encode(v.RetryErrorReasonOverrides, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.CompletedUpdates != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 143, Type: wire.TList}); err != nil {
+			return err
+		}
+		if err := _List_CompletedUpdate_Encode(v.CompletedUpdates, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
 	return sw.WriteStructEnd()
 }
 
@@ -17160,6 +18289,42 @@ func _CronOverlapPolicy_Decode(sr stream.Reader) (shared.CronOverlapPolicy, erro
 	return v, err
 }
 
+func _CompletedUpdate_Decode(sr stream.Reader) (*CompletedUpdate, error) {
+	var v CompletedUpdate
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func _List_CompletedUpdate_Decode(sr stream.Reader) ([]*CompletedUpdate, error) {
+	lh, err := sr.ReadListBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if lh.Type != wire.TStruct {
+		for i := 0; i < lh.Length; i++ {
+			if err := sr.Skip(lh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadListEnd()
+	}
+
+	o := make([]*CompletedUpdate, 0, lh.Length)
+	for i := 0; i < lh.Length; i++ {
+		v, err := _CompletedUpdate_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+		o = append(o, v)
+	}
+
+	if err = sr.ReadListEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
 // Decode deserializes a WorkflowExecutionInfo struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
@@ -17678,6 +18843,40 @@ func (v *WorkflowExecutionInfo) Decode(sr stream.Reader) error {
 				return err
 			}
 
+		case fh.ID == 139 && fh.Type == wire.TBool:
+			var x bool
+			x, err = sr.ReadBool()
+			v.Paused = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 140 && fh.Type == wire.TDouble:
+			var x float64
+			x, err = sr.ReadDouble()
+			v.RetryJitterCoefficient = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 141 && fh.Type == wire.TList:
+			v.RetryRetryableErrors, err = _List_String_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 142 && fh.Type == wire.TList:
+			v.RetryErrorReasonOverrides, err = _List_RetryPolicyOverride_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 143 && fh.Type == wire.TList:
+			v.CompletedUpdates, err = _List_CompletedUpdate_Decode(sr)
+			if err != nil {
+				return err
+			}
+
 		default:
 			if err := sr.Skip(fh.Type); err != nil {
 				return err
@@ -17707,7 +18906,7 @@ func (v *WorkflowExecutionInfo) String() string {
 		return "<nil>"
 	}
 
-	var fields [66]string
+	var fields [71]string
 	i := 0
 	if v.ParentDomainID != nil {
 		fields[i] = fmt.Sprintf("ParentDomainID: %v", v.ParentDomainID)
@@ -17973,6 +19172,26 @@ func (v *WorkflowExecutionInfo) String() string {
 		fields[i] = fmt.Sprintf("ActiveClusterSelectionPolicyEncoding: %v", *(v.ActiveClusterSelectionPolicyEncoding))
 		i++
 	}
+	if v.Paused != nil {
+		fields[i] = fmt.Sprintf("Paused: %v", *(v.Paused))
+		i++
+	}
+	if v.RetryJitterCoefficient != nil {
+		fields[i] = fmt.Sprintf("RetryJitterCoefficient: %v", *(v.RetryJitterCoefficient))
+		i++
+	}
+	if v.RetryRetryableErrors != nil {
+		fields[i] = fmt.Sprintf("RetryRetryableErrors: %v", v.RetryRetryableErrors)
+		i++
+	}
+	if v.RetryErrorReasonOverrides != nil {
+		fields[i] = fmt.Sprintf("RetryErrorReasonOverrides: %v", v.RetryErrorReasonOverrides)
+		i++
+	}
+	if v.CompletedUpdates != nil {
+		fields[i] = fmt.Sprintf("CompletedUpdates: %v", v.CompletedUpdates)
+		i++
+	}
 
 	return fmt.Sprintf("WorkflowExecutionInfo{%v}", strings.Join(fields[:i], ", "))
 }
@@ -18004,6 +19223,21 @@ func _CronOverlapPolicy_EqualsPtr(lhs, rhs *shared.CronOverlapPolicy) bool {
 	return lhs == nil && rhs == nil
 }
 
+func _List_CompletedUpdate_Equals(lhs, rhs []*CompletedUpdate) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
 // Equals returns true if all the fields of this WorkflowExecutionInfo match the
 // provided WorkflowExecutionInfo.
 //
@@ -18212,6 +19446,21 @@ func (v *WorkflowExecutionInfo) Equals(rhs *WorkflowExecutionInfo) bool {
 	if !_String_EqualsPtr(v.ActiveClusterSelectionPolicyEncoding, rhs.ActiveClusterSelectionPolicyEncoding) {
 		return false
 	}
+	if !_Bool_EqualsPtr(v.Paused, rhs.Paused) {
+		return false
+	}
+	if !_Double_EqualsPtr(v.RetryJitterCoefficient, rhs.RetryJitterCoefficient) {
+		return false
+	}
+	if !((v.RetryRetryableErrors == nil && rhs.RetryRetryableErrors == nil) || (v.RetryRetryableErrors != nil && rhs.RetryRetryableErrors != nil && _List_String_Equals(v.RetryRetryableErrors, rhs.RetryRetryableErrors))) {
+		return false
+	}
+	if !((v.RetryErrorReasonOverrides == nil && rhs.RetryErrorReasonOverrides == nil) || (v.RetryErrorReasonOverrides != nil && rhs.RetryErrorReasonOverrides != nil && _List_RetryPolicyOverride_Equals(v.RetryErrorReasonOverrides, rhs.RetryErrorReasonOverrides))) {
+		return false
+	}
+	if !((v.CompletedUpdates == nil && rhs.CompletedUpdates == nil) || (v.CompletedUpdates != nil && rhs.CompletedUpdates != nil && _List_CompletedUpdate_Equals(v.CompletedUpdates, rhs.CompletedUpdates))) {
+		return false
+	}
 
 	return true
 }
@@ -18227,6 +19476,17 @@ func (m _Map_String_Binary_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) (
 	return err
 }
 
+type _List_CompletedUpdate_Zapper []*CompletedUpdate
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_CompletedUpdate_Zapper.
+func (l _List_CompletedUpdate_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		err = multierr.Append(err, enc.AppendObject(v))
+	}
+	return err
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of WorkflowExecutionInfo.
 func (v *WorkflowExecutionInfo) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -18431,6 +19691,21 @@ func (v *WorkflowExecutionInfo) MarshalLogObject(enc zapcore.ObjectEncoder) (err
 	if v.ActiveClusterSelectionPolicyEncoding != nil {
 		enc.AddString("activeClusterSelectionPolicyEncoding", *v.ActiveClusterSelectionPolicyEncoding)
 	}
+	if v.Paused != nil {
+		enc.AddBool("paused", *v.Paused)
+	}
+	if v.RetryJitterCoefficient != nil {
+		enc.AddFloat64("retryJitterCoefficient", *v.RetryJitterCoefficient)
+	}
+	if v.RetryRetryableErrors != nil {
+		err = multierr.Append(err, enc.AddArray("retryRetryableErrors", (_List_String_Zapper)(v.RetryRetryableErrors)))
+	}
+	if v.RetryErrorReasonOverrides != nil {
+		err = multierr.Append(err, enc.AddArray("retryErrorReasonOverrides", (_List_RetryPolicyOverride_Zapper)(v.RetryErrorReasonOverrides)))
+	}
+	if v.CompletedUpdates != nil {
+		err = multierr.Append(err, enc.AddArray("completedUpdates", (_List_CompletedUpdate_Zapper)(v.CompletedUpdates)))
+	}
 	return err
 }
 
@@ -19424,6 +20699,81 @@ func (v *WorkflowExecutionInfo) IsSetActiveClusterSelectionPolicyEncoding() bool
 	return v != nil && v.ActiveClusterSelectionPolicyEncoding != nil
 }
 
+// GetPaused returns the value of Paused if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionInfo) GetPaused() (o bool) {
+	if v != nil && v.Paused != nil {
+		return *v.Paused
+	}
+
+	return
+}
+
+// IsSetPaused returns true if Paused is not nil.
+func (v *WorkflowExecutionInfo) IsSetPaused() bool {
+	return v != nil && v.Paused != nil
+}
+
+// GetRetryJitterCoefficient returns the value of RetryJitterCoefficient if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionInfo) GetRetryJitterCoefficient() (o float64) {
+	if v != nil && v.RetryJitterCoefficient != nil {
+		return *v.RetryJitterCoefficient
+	}
+
+	return
+}
+
+// IsSetRetryJitterCoefficient returns true if RetryJitterCoefficient is not nil.
+func (v *WorkflowExecutionInfo) IsSetRetryJitterCoefficient() bool {
+	return v != nil && v.RetryJitterCoefficient != nil
+}
+
+// GetRetryRetryableErrors returns the value of RetryRetryableErrors if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionInfo) GetRetryRetryableErrors() (o []string) {
+	if v != nil && v.RetryRetryableErrors != nil {
+		return v.RetryRetryableErrors
+	}
+
+	return
+}
+
+// IsSetRetryRetryableErrors returns true if RetryRetryableErrors is not nil.
+func (v *WorkflowExecutionInfo) IsSetRetryRetryableErrors() bool {
+	return v != nil && v.RetryRetryableErrors != nil
+}
+
+// GetRetryErrorReasonOverrides returns the value of RetryErrorReasonOverrides if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionInfo) GetRetryErrorReasonOverrides() (o []*shared.RetryPolicyOverride) {
+	if v != nil && v.RetryErrorReasonOverrides != nil {
+		return v.RetryErrorReasonOverrides
+	}
+
+	return
+}
+
+// IsSetRetryErrorReasonOverrides returns true if RetryErrorReasonOverrides is not nil.
+func (v *WorkflowExecutionInfo) IsSetRetryErrorReasonOverrides() bool {
+	return v != nil && v.RetryErrorReasonOverrides != nil
+}
+
+// GetCompletedUpdates returns the value of CompletedUpdates if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionInfo) GetCompletedUpdates() (o []*CompletedUpdate) {
+	if v != nil && v.CompletedUpdates != nil {
+		return v.CompletedUpdates
+	}
+
+	return
+}
+
+// IsSetCompletedUpdates returns true if CompletedUpdates is not nil.
+func (v *WorkflowExecutionInfo) IsSetCompletedUpdates() bool {
+	return v != nil && v.CompletedUpdates != nil
+}
+
 type WorkflowTimerTaskInfo struct {
 	References []*TimerReference `json:"references,omitempty"`
 }
@@ -19771,11 +21121,11 @@ var ThriftModule = &thriftreflect.ThriftModule{
 	Name:     "sqlblobs",
 	Package:  "github.com/uber/cadence/.gen/go/sqlblobs",
 	FilePath: "sqlblobs.thrift",
-	SHA1:     "38fa1b68d6a600e0cac1282cb9c08d9bd9f5a7b5",
+	SHA1:     "8afcb6eb001e77c8e24b29e600405067d3370c32",
 	Includes: []*thriftreflect.ThriftModule{
 		shared.ThriftModule,
 	},
 	Raw: rawIDL,
 }
 
-const rawIDL = "// Copyright (c) 2017 Uber Technologies, Inc.\n//\n// Permission is hereby granted, free of charge, to any person obtaining a copy\n// of this software and associated documentation files (the \"Software\"), to deal\n// in the Software without restriction, including without limitation the rights\n// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell\n// copies of the Software, and to permit persons to whom the Software is\n// furnished to do so, subject to the following conditions:\n//\n// The above copyright notice and this permission notice shall be included in\n// all copies or substantial portions of the Software.\n//\n// THE SOFTWARE IS PROVIDED \"AS IS\", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR\n// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,\n// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE\n// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER\n// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,\n// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN\n// THE SOFTWARE.\n\nnamespace java com.uber.cadence.sqlblobs\n\ninclude \"shared.thrift\"\n\nstruct ShardInfo {\n  10: optional i32 stolenSinceRenew\n  12: optional i64 (js.type = \"Long\") updatedAtNanos\n  14: optional i64 (js.type = \"Long\") replicationAckLevel\n  16: optional i64 (js.type = \"Long\") transferAckLevel\n  18: optional i64 (js.type = \"Long\") timerAckLevelNanos\n  24: optional i64 (js.type = \"Long\") domainNotificationVersion\n  34: optional map<string, i64> clusterTransferAckLevel\n  36: optional map<string, i64> clusterTimerAckLevel\n  38: optional string owner\n  40: optional map<string, i64> clusterReplicationLevel\n  42: optional binary pendingFailoverMarkers\n  44: optional string pendingFailoverMarkersEncoding\n  46: optional map<string, i64> replicationDlqAckLevel\n  50: optional binary transferProcessingQueueStates\n  51: optional string transferProcessingQueueStatesEncoding\n  55: optional binary timerProcessingQueueStates\n  56: optional string timerProcessingQueueStatesEncoding\n  60: optional binary crossClusterProcessingQueueStates\n  61: optional string crossClusterProcessingQueueStatesEncoding\n  64: optional map<i32, shared.QueueState> queueStates\n}\n\nstruct DomainInfo {\n  10: optional string name\n  12: optional string description\n  14: optional string owner\n  16: optional i32 status\n  18: optional i16 retentionDays\n  20: optional bool emitMetric\n  22: optional string archivalBucket\n  24: optional i16 archivalStatus\n  26: optional i64 (js.type = \"Long\") configVersion\n  28: optional i64 (js.type = \"Long\") notificationVersion\n  30: optional i64 (js.type = \"Long\") failoverNotificationVersion\n  32: optional i64 (js.type = \"Long\") failoverVersion\n  34: optional string activeClusterName\n  36: optional list<string> clusters\n  38: optional map<string, string> data\n  39: optional binary badBinaries\n  40: optional string badBinariesEncoding\n  42: optional i16 historyArchivalStatus\n  44: optional string historyArchivalURI\n  46: optional i16 visibilityArchivalStatus\n  48: optional string visibilityArchivalURI\n  50: optional i64 (js.type = \"Long\") failoverEndTime\n  52: optional i64 (js.type = \"Long\") previousFailoverVersion\n  54: optional i64 (js.type = \"Long\") lastUpdatedTime\n  56: optional binary isolationGroupsConfiguration\n  58: optional string isolationGroupsConfigurationEncoding\n  60: optional binary asyncWorkflowConfiguration\n  62: optional string asyncWorkflowConfigurationEncoding\n  64: optional binary activeClustersConfiguration\n  66: optional string activeClustersConfigurationEncoding\n}\n\nstruct HistoryTreeInfo {\n  10: optional i64 (js.type = \"Long\") createdTimeNanos // For fork operation to prevent race condition of leaking event data when forking branches fail. Also can be used for clean up leaked data\n  12: optional list<shared.HistoryBranchRange> ancestors\n  14: optional string info // For lookup back to workflow during debugging, also background cleanup when fork operation cannot finish self cleanup due to crash.\n}\n\nstruct WorkflowExecutionInfo {\n  10: optional binary parentDomainID\n  12: optional string parentWorkflowID\n  14: optional binary parentRunID\n  16: optional i64 (js.type = \"Long\") initiatedID\n  18: optional i64 (js.type = \"Long\") completionEventBatchID\n  20: optional binary completionEvent\n  22: optional string completionEventEncoding\n  24: optional string taskList\n  25: optional shared.TaskListKind taskListKind\n  26: optional string workflowTypeName\n  28: optional i32 workflowTimeoutSeconds\n  30: optional i32 decisionTaskTimeoutSeconds\n  32: optional binary executionContext\n  34: optional i32 state\n  36: optional i32 closeStatus\n  38: optional i64 (js.type = \"Long\") startVersion\n  44: optional i64 (js.type = \"Long\") lastWriteEventID\n  48: optional i64 (js.type = \"Long\") lastEventTaskID\n  50: optional i64 (js.type = \"Long\") lastFirstEventID\n  52: optional i64 (js.type = \"Long\") lastProcessedEvent\n  54: optional i64 (js.type = \"Long\") startTimeNanos\n  56: optional i64 (js.type = \"Long\") lastUpdatedTimeNanos\n  58: optional i64 (js.type = \"Long\") decisionVersion\n  60: optional i64 (js.type = \"Long\") decisionScheduleID\n  62: optional i64 (js.type = \"Long\") decisionStartedID\n  64: optional i32 decisionTimeout\n  66: optional i64 (js.type = \"Long\") decisionAttempt\n  68: optional i64 (js.type = \"Long\") decisionStartedTimestampNanos\n  69: optional i64 (js.type = \"Long\") decisionScheduledTimestampNanos\n  70: optional bool cancelRequested\n  71: optional i64 (js.type = \"Long\") decisionOriginalScheduledTimestampNanos\n  72: optional string createRequestID\n  74: optional string decisionRequestID\n  76: optional string cancelRequestID\n  78: optional string stickyTaskList\n  80: optional i64 (js.type = \"Long\") stickyScheduleToStartTimeout\n  82: optional i64 (js.type = \"Long\") retryAttempt\n  84: optional i32 retryInitialIntervalSeconds\n  86: optional i32 retryMaximumIntervalSeconds\n  88: optional i32 retryMaximumAttempts\n  90: optional i32 retryExpirationSeconds\n  92: optional double retryBackoffCoefficient\n  94: optional i64 (js.type = \"Long\") retryExpirationTimeNanos\n  96: optional list<string> retryNonRetryableErrors\n  98: optional bool hasRetryPolicy\n  100: optional string cronSchedule\n  102: optional i32 eventStoreVersion\n  104: optional binary eventBranchToken\n  106: optional i64 (js.type = \"Long\") signalCount\n  108: optional i64 (js.type = \"Long\") historySize\n  110: optional string clientLibraryVersion\n  112: optional string clientFeatureVersion\n  114: optional string clientImpl\n  115: optional binary autoResetPoints\n  116: optional string autoResetPointsEncoding\n  118: optional map<string, binary> searchAttributes\n  120: optional map<string, binary> memo\n  122: optional binary versionHistories\n  124: optional string versionHistoriesEncoding\n  126: optional binary firstExecutionRunID\n  128: optional map<string, string> partitionConfig\n  130: optional binary checksum\n  132: optional string checksumEncoding\n  134: optional shared.CronOverlapPolicy cronOverlapPolicy\n  137: optional binary activeClusterSelectionPolicy\n  138: optional string activeClusterSelectionPolicyEncoding\n}\n\nstruct ActivityInfo {\n  10: optional i64 (js.type = \"Long\") version\n  12: optional i64 (js.type = \"Long\") scheduledEventBatchID\n  14: optional binary scheduledEvent\n  16: optional string scheduledEventEncoding\n  18: optional i64 (js.type = \"Long\") scheduledTimeNanos\n  20: optional i64 (js.type = \"Long\") startedID\n  22: optional binary startedEvent\n  24: optional string startedEventEncoding\n  26: optional i64 (js.type = \"Long\") startedTimeNanos\n  28: optional string activityID\n  30: optional string requestID\n  32: optional i32 scheduleToStartTimeoutSeconds\n  34: optional i32 scheduleToCloseTimeoutSeconds\n  36: optional i32 startToCloseTimeoutSeconds\n  38: optional i32 heartbeatTimeoutSeconds\n  40: optional bool cancelRequested\n  42: optional i64 (js.type = \"Long\") cancelRequestID\n  44: optional i32 timerTaskStatus\n  46: optional i32 attempt\n  48: optional string taskList\n  49: optional shared.TaskListKind taskListKind\n  50: optional string startedIdentity\n  52: optional bool hasRetryPolicy\n  54: optional i32 retryInitialIntervalSeconds\n  56: optional i32 retryMaximumIntervalSeconds\n  58: optional i32 retryMaximumAttempts\n  60: optional i64 (js.type = \"Long\") retryExpirationTimeNanos\n  62: optional double retryBackoffCoefficient\n  64: optional list<string> retryNonRetryableErrors\n  66: optional string retryLastFailureReason\n  68: optional string retryLastWorkerIdentity\n  70: optional binary retryLastFailureDetails\n  72: optional shared.FailureOptions retryLastFailureOptions\n}\n\nstruct ChildExecutionInfo {\n  10: optional i64 (js.type = \"Long\") version\n  12: optional i64 (js.type = \"Long\") initiatedEventBatchID\n  14: optional i64 (js.type = \"Long\") startedID\n  16: optional binary initiatedEvent\n  18: optional string initiatedEventEncoding\n  20: optional string startedWorkflowID\n  22: optional binary startedRunID\n  24: optional binary startedEvent\n  26: optional string startedEventEncoding\n  28: optional string createRequestID\n  29: optional string domainID\n  30: optional string domainName // deprecated\n  32: optional string workflowTypeName\n  35: optional i32 parentClosePolicy\n}\n\nstruct SignalInfo {\n  10: optional i64 (js.type = \"Long\") version\n  11: optional i64 (js.type = \"Long\") initiatedEventBatchID\n  12: optional string requestID\n  14: optional string name\n  16: optional binary input\n  18: optional binary control\n}\n\nstruct RequestCancelInfo {\n  10: optional i64 (js.type = \"Long\") version\n  11: optional i64 (js.type = \"Long\") initiatedEventBatchID\n  12: optional string cancelRequestID\n}\n\nstruct TimerInfo {\n  10: optional i64 (js.type = \"Long\") version\n  12: optional i64 (js.type = \"Long\") startedID\n  14: optional i64 (js.type = \"Long\") expiryTimeNanos\n  // TaskID is a misleading variable, it actually serves\n  // the purpose of indicating whether a timer task is\n  // generated for this timer info\n  16: optional i64 (js.type = \"Long\") taskID\n}\n\nstruct TaskInfo {\n  10: optional string workflowID\n  12: optional binary runID\n  13: optional i64 (js.type = \"Long\") scheduleID\n  14: optional i64 (js.type = \"Long\") expiryTimeNanos\n  15: optional i64 (js.type = \"Long\") createdTimeNanos\n  17: optional map<string, string> partitionConfig\n}\n\nstruct TaskListPartition {\n    10: optional list<string> isolationGroups\n}\n\nstruct TaskListPartitionConfig {\n  10: optional i64 (js.type = \"Long\") version\n  12: optional i32 numReadPartitions\n  14: optional i32 numWritePartitions\n  16: optional map<i32, TaskListPartition> readPartitions\n  18: optional map<i32, TaskListPartition> writePartitions\n}\n\nstruct TaskListInfo {\n  10: optional i16 kind // {Normal, Sticky}\n  12: optional i64 (js.type = \"Long\") ackLevel\n  14: optional i64 (js.type = \"Long\") expiryTimeNanos\n  16: optional i64 (js.type = \"Long\") lastUpdatedNanos\n  18: optional TaskListPartitionConfig adaptivePartitionConfig\n}\n\nstruct TransferTaskInfo {\n  10: optional binary domainID\n  12: optional string workflowID\n  14: optional binary runID\n  16: optional i16 taskType\n  18: optional binary targetDomainID\n  20: optional string targetWorkflowID\n  22: optional binary targetRunID\n  24: optional string taskList\n  26: optional bool targetChildWorkflowOnly\n  28: optional i64 (js.type = \"Long\") scheduleID\n  30: optional i64 (js.type = \"Long\") version\n  32: optional i64 (js.type = \"Long\") visibilityTimestampNanos\n  34: optional set<binary> targetDomainIDs\n  36: optional string originalTaskList\n  38: optional shared.TaskListKind originalTaskListKind\n}\n\nstruct TimerTaskInfo {\n  10: optional binary domainID\n  12: optional string workflowID\n  14: optional binary runID\n  16: optional i16 taskType\n  18: optional i16 timeoutType\n  20: optional i64 (js.type = \"Long\") version\n  22: optional i64 (js.type = \"Long\") scheduleAttempt\n  24: optional i64 (js.type = \"Long\") eventID\n  26: optional string taskList\n}\n\nstruct ReplicationTaskInfo {\n  10: optional binary domainID\n  12: optional string workflowID\n  14: optional binary runID\n  16: optional i16 taskType\n  18: optional i64 (js.type = \"Long\") version\n  20: optional i64 (js.type = \"Long\") firstEventID\n  22: optional i64 (js.type = \"Long\") nextEventID\n  24: optional i64 (js.type = \"Long\") scheduledID\n  26: optional i32 eventStoreVersion\n  28: optional i32 newRunEventStoreVersion\n  30: optional binary branch_token\n  34: optional binary newRunBranchToken\n  38: optional i64 (js.type = \"Long\") creationTime\n}\n\nenum AsyncRequestType {\n  StartWorkflowExecutionAsyncRequest\n  SignalWithStartWorkflowExecutionAsyncRequest\n}\n\nstruct AsyncRequestMessage {\n  10: optional string partitionKey\n  12: optional AsyncRequestType type\n  14: optional shared.Header header\n  16: optional string encoding\n  18: optional binary payload\n}\n\n// a substruct on the executions record which is intended to be used to track\n// timers and other records for debugging and cleanup\nstruct WorkflowTimerTaskInfo {\n    10: optional list<TimerReference> references\n}\n\nstruct TimerReference {\n    // Primary Keys. Always required\n    // a reference to the the execution table task_id\n    10: optional i64 taskID\n    // a reference to the execution table visibility_ts\n    11: optional i64 (js.type = \"Long\") visibilityTimestamp\n\n    // Reference fields:\n    // for workflow timer values, the type of timeout\n    13: optional i16 TimeoutType\n}\n"
+const rawIDL = "// Copyright (c) 2017 Uber Technologies, Inc.\n//\n// Permission is hereby granted, free of charge, to any person obtaining a copy\n// of this software and associated documentation files (the \"Software\"), to deal\n// in the Software without restriction, including without limitation the rights\n// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell\n// copies of the Software, and to permit persons to whom the Software is\n// furnished to do so, subject to the following conditions:\n//\n// The above copyright notice and this permission notice shall be included in\n// all copies or substantial portions of the Software.\n//\n// THE SOFTWARE IS PROVIDED \"AS IS\", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR\n// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,\n// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE\n// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER\n// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,\n// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN\n// THE SOFTWARE.\n\nnamespace java com.uber.cadence.sqlblobs\n\ninclude \"shared.thrift\"\n\nstruct ShardInfo {\n  10: optional i32 stolenSinceRenew\n  12: optional i64 (js.type = \"Long\") updatedAtNanos\n  14: optional i64 (js.type = \"Long\") replicationAckLevel\n  16: optional i64 (js.type = \"Long\") transferAckLevel\n  18: optional i64 (js.type = \"Long\") timerAckLevelNanos\n  24: optional i64 (js.type = \"Long\") domainNotificationVersion\n  34: optional map<string, i64> clusterTransferAckLevel\n  36: optional map<string, i64> clusterTimerAckLevel\n  38: optional string owner\n  40: optional map<string, i64> clusterReplicationLevel\n  42: optional binary pendingFailoverMarkers\n  44: optional string pendingFailoverMarkersEncoding\n  46: optional map<string, i64> replicationDlqAckLevel\n  50: optional binary transferProcessingQueueStates\n  51: optional string transferProcessingQueueStatesEncoding\n  55: optional binary timerProcessingQueueStates\n  56: optional string timerProcessingQueueStatesEncoding\n  60: optional binary crossClusterProcessingQueueStates\n  61: optional string crossClusterProcessingQueueStatesEncoding\n  64: optional map<i32, shared.QueueState> queueStates\n}\n\nstruct DomainInfo {\n  10: optional string name\n  12: optional string description\n  14: optional string owner\n  16: optional i32 status\n  18: optional i16 retentionDays\n  20: optional bool emitMetric\n  22: optional string archivalBucket\n  24: optional i16 archivalStatus\n  26: optional i64 (js.type = \"Long\") configVersion\n  28: optional i64 (js.type = \"Long\") notificationVersion\n  30: optional i64 (js.type = \"Long\") failoverNotificationVersion\n  32: optional i64 (js.type = \"Long\") failoverVersion\n  34: optional string activeClusterName\n  36: optional list<string> clusters\n  38: optional map<string, string> data\n  39: optional binary badBinaries\n  40: optional string badBinariesEncoding\n  42: optional i16 historyArchivalStatus\n  44: optional string historyArchivalURI\n  46: optional i16 visibilityArchivalStatus\n  48: optional string visibilityArchivalURI\n  50: optional i64 (js.type = \"Long\") failoverEndTime\n  52: optional i64 (js.type = \"Long\") previousFailoverVersion\n  54: optional i64 (js.type = \"Long\") lastUpdatedTime\n  56: optional binary isolationGroupsConfiguration\n  58: optional string isolationGroupsConfigurationEncoding\n  60: optional binary asyncWorkflowConfiguration\n  62: optional string asyncWorkflowConfigurationEncoding\n  64: optional binary activeClustersConfiguration\n  66: optional string activeClustersConfigurationEncoding\n}\n\nstruct HistoryTreeInfo {\n  10: optional i64 (js.type = \"Long\") createdTimeNanos // For fork operation to prevent race condition of leaking event data when forking branches fail. Also can be used for clean up leaked data\n  12: optional list<shared.HistoryBranchRange> ancestors\n  14: optional string info // For lookup back to workflow during debugging, also background cleanup when fork operation cannot finish self cleanup due to crash.\n}\n\nstruct WorkflowExecutionInfo {\n  10: optional binary parentDomainID\n  12: optional string parentWorkflowID\n  14: optional binary parentRunID\n  16: optional i64 (js.type = \"Long\") initiatedID\n  18: optional i64 (js.type = \"Long\") completionEventBatchID\n  20: optional binary completionEvent\n  22: optional string completionEventEncoding\n  24: optional string taskList\n  25: optional shared.TaskListKind taskListKind\n  26: optional string workflowTypeName\n  28: optional i32 workflowTimeoutSeconds\n  30: optional i32 decisionTaskTimeoutSeconds\n  32: optional binary executionContext\n  34: optional i32 state\n  36: optional i32 closeStatus\n  38: optional i64 (js.type = \"Long\") startVersion\n  44: optional i64 (js.type = \"Long\") lastWriteEventID\n  48: optional i64 (js.type = \"Long\") lastEventTaskID\n  50: optional i64 (js.type = \"Long\") lastFirstEventID\n  52: optional i64 (js.type = \"Long\") lastProcessedEvent\n  54: optional i64 (js.type = \"Long\") startTimeNanos\n  56: optional i64 (js.type = \"Long\") lastUpdatedTimeNanos\n  58: optional i64 (js.type = \"Long\") decisionVersion\n  60: optional i64 (js.type = \"Long\") decisionScheduleID\n  62: optional i64 (js.type = \"Long\") decisionStartedID\n  64: optional i32 decisionTimeout\n  66: optional i64 (js.type = \"Long\") decisionAttempt\n  68: optional i64 (js.type = \"Long\") decisionStartedTimestampNanos\n  69: optional i64 (js.type = \"Long\") decisionScheduledTimestampNanos\n  70: optional bool cancelRequested\n  71: optional i64 (js.type = \"Long\") decisionOriginalScheduledTimestampNanos\n  72: optional string createRequestID\n  74: optional string decisionRequestID\n  76: optional string cancelRequestID\n  78: optional string stickyTaskList\n  80: optional i64 (js.type = \"Long\") stickyScheduleToStartTimeout\n  82: optional i64 (js.type = \"Long\") retryAttempt\n  84: optional i32 retryInitialIntervalSeconds\n  86: optional i32 retryMaximumIntervalSeconds\n  88: optional i32 retryMaximumAttempts\n  90: optional i32 retryExpirationSeconds\n  92: optional double retryBackoffCoefficient\n  94: optional i64 (js.type = \"Long\") retryExpirationTimeNanos\n  96: optional list<string> retryNonRetryableErrors\n  98: optional bool hasRetryPolicy\n  100: optional string cronSchedule\n  102: optional i32 eventStoreVersion\n  104: optional binary eventBranchToken\n  106: optional i64 (js.type = \"Long\") signalCount\n  108: optional i64 (js.type = \"Long\") historySize\n  110: optional string clientLibraryVersion\n  112: optional string clientFeatureVersion\n  114: optional string clientImpl\n  115: optional binary autoResetPoints\n  116: optional string autoResetPointsEncoding\n  118: optional map<string, binary> searchAttributes\n  120: optional map<string, binary> memo\n  122: optional binary versionHistories\n  124: optional string versionHistoriesEncoding\n  126: optional binary firstExecutionRunID\n  128: optional map<string, string> partitionConfig\n  130: optional binary checksum\n  132: optional string checksumEncoding\n  134: optional shared.CronOverlapPolicy cronOverlapPolicy\n  137: optional binary activeClusterSelectionPolicy\n  138: optional string activeClusterSelectionPolicyEncoding\n  139: optional bool paused\n  140: optional double retryJitterCoefficient\n  141: optional list<string> retryRetryableErrors\n  142: optional list<shared.RetryPolicyOverride> retryErrorReasonOverrides\n  143: optional list<CompletedUpdate> completedUpdates\n}\n\nstruct CompletedUpdate {\n  10: optional string updateID\n  12: optional binary result\n  14: optional string failureReason\n  16: optional binary failureDetails\n}\n\nstruct ActivityInfo {\n  10: optional i64 (js.type = \"Long\") version\n  12: optional i64 (js.type = \"Long\") scheduledEventBatchID\n  14: optional binary scheduledEvent\n  16: optional string scheduledEventEncoding\n  18: optional i64 (js.type = \"Long\") scheduledTimeNanos\n  20: optional i64 (js.type = \"Long\") startedID\n  22: optional binary startedEvent\n  24: optional string startedEventEncoding\n  26: optional i64 (js.type = \"Long\") startedTimeNanos\n  28: optional string activityID\n  30: optional string requestID\n  32: optional i32 scheduleToStartTimeoutSeconds\n  34: optional i32 scheduleToCloseTimeoutSeconds\n  36: optional i32 startToCloseTimeoutSeconds\n  38: optional i32 heartbeatTimeoutSeconds\n  40: optional bool cancelRequested\n  42: optional i64 (js.type = \"Long\") cancelRequestID\n  44: optional i32 timerTaskStatus\n  46: optional i32 attempt\n  48: optional string taskList\n  49: optional shared.TaskListKind taskListKind\n  50: optional string startedIdentity\n  52: optional bool hasRetryPolicy\n  54: optional i32 retryInitialIntervalSeconds\n  56: optional i32 retryMaximumIntervalSeconds\n  58: optional i32 retryMaximumAttempts\n  60: optional i64 (js.type = \"Long\") retryExpirationTimeNanos\n  62: optional double retryBackoffCoefficient\n  64: optional list<string> retryNonRetryableErrors\n  66: optional string retryLastFailureReason\n  68: optional string retryLastWorkerIdentity\n  70: optional binary retryLastFailureDetails\n  72: optional shared.FailureOptions retryLastFailureOptions\n  74: optional i32 priority\n  76: optional string fairnessKey\n  78: optional bool paused\n  80: optional double retryJitterCoefficient\n  82: optional list<string> retryRetryableErrors\n  84: optional list<shared.RetryPolicyOverride> retryErrorReasonOverrides\n}\n\nstruct ChildExecutionInfo {\n  10: optional i64 (js.type = \"Long\") version\n  12: optional i64 (js.type = \"Long\") initiatedEventBatchID\n  14: optional i64 (js.type = \"Long\") startedID\n  16: optional binary initiatedEvent\n  18: optional string initiatedEventEncoding\n  20: optional string startedWorkflowID\n  22: optional binary startedRunID\n  24: optional binary startedEvent\n  26: optional string startedEventEncoding\n  28: optional string createRequestID\n  29: optional string domainID\n  30: optional string domainName // deprecated\n  32: optional string workflowTypeName\n  35: optional i32 parentClosePolicy\n}\n\nstruct SignalInfo {\n  10: optional i64 (js.type = \"Long\") version\n  11: optional i64 (js.type = \"Long\") initiatedEventBatchID\n  12: optional string requestID\n  14: optional string name\n  16: optional binary input\n  18: optional binary control\n}\n\nstruct RequestCancelInfo {\n  10: optional i64 (js.type = \"Long\") version\n  11: optional i64 (js.type = \"Long\") initiatedEventBatchID\n  12: optional string cancelRequestID\n}\n\nstruct TimerInfo {\n  10: optional i64 (js.type = \"Long\") version\n  12: optional i64 (js.type = \"Long\") startedID\n  14: optional i64 (js.type = \"Long\") expiryTimeNanos\n  // TaskID is a misleading variable, it actually serves\n  // the purpose of indicating whether a timer task is\n  // generated for this timer info\n  16: optional i64 (js.type = \"Long\") taskID\n}\n\nstruct TaskInfo {\n  10: optional string workflowID\n  12: optional binary runID\n  13: optional i64 (js.type = \"Long\") scheduleID\n  14: optional i64 (js.type = \"Long\") expiryTimeNanos\n  15: optional i64 (js.type = \"Long\") createdTimeNanos\n  17: optional map<string, string> partitionConfig\n}\n\nstruct TaskListPartition {\n    10: optional list<string> isolationGroups\n}\n\nstruct TaskListPartitionConfig {\n  10: optional i64 (js.type = \"Long\") version\n  12: optional i32 numReadPartitions\n  14: optional i32 numWritePartitions\n  16: optional map<i32, TaskListPartition> readPartitions\n  18: optional map<i32, TaskListPartition> writePartitions\n}\n\nstruct TaskListInfo {\n  10: optional i16 kind // {Normal, Sticky}\n  12: optional i64 (js.type = \"Long\") ackLevel\n  14: optional i64 (js.type = \"Long\") expiryTimeNanos\n  16: optional i64 (js.type = \"Long\") lastUpdatedNanos\n  18: optional TaskListPartitionConfig adaptivePartitionConfig\n}\n\nstruct TransferTaskInfo {\n  10: optional binary domainID\n  12: optional string workflowID\n  14: optional binary runID\n  16: optional i16 taskType\n  18: optional binary targetDomainID\n  20: optional string targetWorkflowID\n  22: optional binary targetRunID\n  24: optional string taskList\n  26: optional bool targetChildWorkflowOnly\n  28: optional i64 (js.type = \"Long\") scheduleID\n  30: optional i64 (js.type = \"Long\") version\n  32: optional i64 (js.type = \"Long\") visibilityTimestampNanos\n  34: optional set<binary> targetDomainIDs\n  36: optional string originalTaskList\n  38: optional shared.TaskListKind originalTaskListKind\n}\n\nstruct TimerTaskInfo {\n  10: optional binary domainID\n  12: optional string workflowID\n  14: optional binary runID\n  16: optional i16 taskType\n  18: optional i16 timeoutType\n  20: optional i64 (js.type = \"Long\") version\n  22: optional i64 (js.type = \"Long\") scheduleAttempt\n  24: optional i64 (js.type = \"Long\") eventID\n  26: optional string taskList\n}\n\nstruct ReplicationTaskInfo {\n  10: optional binary domainID\n  12: optional string workflowID\n  14: optional binary runID\n  16: optional i16 taskType\n  18: optional i64 (js.type = \"Long\") version\n  20: optional i64 (js.type = \"Long\") firstEventID\n  22: optional i64 (js.type = \"Long\") nextEventID\n  24: optional i64 (js.type = \"Long\") scheduledID\n  26: optional i32 eventStoreVersion\n  28: optional i32 newRunEventStoreVersion\n  30: optional binary branch_token\n  34: optional binary newRunBranchToken\n  38: optional i64 (js.type = \"Long\") creationTime\n}\n\nenum AsyncRequestType {\n  StartWorkflowExecutionAsyncRequest\n  SignalWithStartWorkflowExecutionAsyncRequest\n}\n\nstruct AsyncRequestMessage {\n  10: optional string partitionKey\n  12: optional AsyncRequestType type\n  14: optional shared.Header header\n  16: optional string encoding\n  18: optional binary payload\n}\n\n// a substruct on the executions record which is intended to be used to track\n// timers and other records for debugging and cleanup\nstruct WorkflowTimerTaskInfo {\n    10: optional list<TimerReference> references\n}\n\nstruct TimerReference {\n    // Primary Keys. Always required\n    // a reference to the the execution table task_id\n    10: optional i64 taskID\n    // a reference to the execution table visibility_ts\n    11: optional i64 (js.type = \"Long\") visibilityTimestamp\n\n    // Reference fields:\n    // for workflow timer values, the type of timeout\n    13: optional i16 TimeoutType\n}\n"
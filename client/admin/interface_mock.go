@@ -770,3 +770,22 @@ func (mr *MockClientMockRecorder) UpdateTaskListPartitionConfig(ctx, request any
 	varargs := append([]any{ctx, request}, opts...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTaskListPartitionConfig", reflect.TypeOf((*MockClient)(nil).UpdateTaskListPartitionConfig), varargs...)
 }
+
+// UpsertWorkflowSearchAttributes mocks base method.
+func (m *MockClient) UpsertWorkflowSearchAttributes(arg0 context.Context, arg1 *types.AdminUpsertWorkflowSearchAttributesRequest, arg2 ...yarpc.CallOption) error {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpsertWorkflowSearchAttributes", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertWorkflowSearchAttributes indicates an expected call of UpsertWorkflowSearchAttributes.
+func (mr *MockClientMockRecorder) UpsertWorkflowSearchAttributes(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertWorkflowSearchAttributes", reflect.TypeOf((*MockClient)(nil).UpsertWorkflowSearchAttributes), varargs...)
+}
@@ -38,6 +38,7 @@ import (
 
 // Client is the interface exposed by frontend service client
 type Client interface {
+	AggregateWorkflowExecutions(context.Context, *types.AggregateWorkflowExecutionsRequest, ...yarpc.CallOption) (*types.AggregateWorkflowExecutionsResponse, error)
 	CountWorkflowExecutions(context.Context, *types.CountWorkflowExecutionsRequest, ...yarpc.CallOption) (*types.CountWorkflowExecutionsResponse, error)
 	DeleteDomain(context.Context, *types.DeleteDomainRequest, ...yarpc.CallOption) error
 	DeprecateDomain(context.Context, *types.DeprecateDomainRequest, ...yarpc.CallOption) error
@@ -83,6 +84,14 @@ type Client interface {
 	StartWorkflowExecutionAsync(context.Context, *types.StartWorkflowExecutionAsyncRequest, ...yarpc.CallOption) (*types.StartWorkflowExecutionAsyncResponse, error)
 	TerminateWorkflowExecution(context.Context, *types.TerminateWorkflowExecutionRequest, ...yarpc.CallOption) error
 	UpdateDomain(context.Context, *types.UpdateDomainRequest, ...yarpc.CallOption) (*types.UpdateDomainResponse, error)
+	UpdateWorkflowExecution(context.Context, *types.UpdateWorkflowExecutionRequest, ...yarpc.CallOption) (*types.UpdateWorkflowExecutionResponse, error)
+	PauseWorkflowExecution(context.Context, *types.PauseWorkflowExecutionRequest, ...yarpc.CallOption) error
+	UnpauseWorkflowExecution(context.Context, *types.UnpauseWorkflowExecutionRequest, ...yarpc.CallOption) error
+	PauseActivity(context.Context, *types.PauseActivityRequest, ...yarpc.CallOption) error
+	UnpauseActivity(context.Context, *types.UnpauseActivityRequest, ...yarpc.CallOption) error
+	ResetActivityAttempt(context.Context, *types.ResetActivityAttemptRequest, ...yarpc.CallOption) error
+	RetryActivityNow(context.Context, *types.RetryActivityNowRequest, ...yarpc.CallOption) error
+	DeleteWorkflowExecution(context.Context, *types.DeleteWorkflowExecutionRequest, ...yarpc.CallOption) error
 	FailoverDomain(context.Context, *types.FailoverDomainRequest, ...yarpc.CallOption) (*types.FailoverDomainResponse, error)
 	ListFailoverHistory(context.Context, *types.ListFailoverHistoryRequest, ...yarpc.CallOption) (*types.ListFailoverHistoryResponse, error)
 
@@ -93,5 +102,10 @@ type Client interface {
 	PauseSchedule(context.Context, *types.PauseScheduleRequest, ...yarpc.CallOption) (*types.PauseScheduleResponse, error)
 	UnpauseSchedule(context.Context, *types.UnpauseScheduleRequest, ...yarpc.CallOption) (*types.UnpauseScheduleResponse, error)
 	BackfillSchedule(context.Context, *types.BackfillScheduleRequest, ...yarpc.CallOption) (*types.BackfillScheduleResponse, error)
+	TriggerSchedule(context.Context, *types.TriggerScheduleRequest, ...yarpc.CallOption) (*types.TriggerScheduleResponse, error)
 	ListSchedules(context.Context, *types.ListSchedulesRequest, ...yarpc.CallOption) (*types.ListSchedulesResponse, error)
+
+	CreateSemaphore(context.Context, *types.CreateSemaphoreRequest, ...yarpc.CallOption) (*types.CreateSemaphoreResponse, error)
+	DescribeSemaphore(context.Context, *types.DescribeSemaphoreRequest, ...yarpc.CallOption) (*types.DescribeSemaphoreResponse, error)
+	ListSemaphores(context.Context, *types.ListSemaphoresRequest, ...yarpc.CallOption) (*types.ListSemaphoresResponse, error)
 }
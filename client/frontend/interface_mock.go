@@ -38,11 +38,50 @@ func NewMockClient(ctrl *gomock.Controller) *MockClient {
 	return mock
 }
 
+// DeleteWorkflowExecution mocks base method.
+func (m *MockClient) DeleteWorkflowExecution(arg0 context.Context, arg1 *types.DeleteWorkflowExecutionRequest, arg2 ...yarpc.CallOption) error {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteWorkflowExecution", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWorkflowExecution indicates an expected call of DeleteWorkflowExecution.
+func (mr *MockClientMockRecorder) DeleteWorkflowExecution(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWorkflowExecution", reflect.TypeOf((*MockClient)(nil).DeleteWorkflowExecution), varargs...)
+}
+
 // EXPECT returns an object that allows the caller to indicate expected use.
 func (m *MockClient) EXPECT() *MockClientMockRecorder {
 	return m.recorder
 }
 
+// AggregateWorkflowExecutions mocks base method.
+func (m *MockClient) AggregateWorkflowExecutions(arg0 context.Context, arg1 *types.AggregateWorkflowExecutionsRequest, arg2 ...yarpc.CallOption) (*types.AggregateWorkflowExecutionsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AggregateWorkflowExecutions", varargs...)
+	ret0, _ := ret[0].(*types.AggregateWorkflowExecutionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AggregateWorkflowExecutions indicates an expected call of AggregateWorkflowExecutions.
+func (mr *MockClientMockRecorder) AggregateWorkflowExecutions(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AggregateWorkflowExecutions", reflect.TypeOf((*MockClient)(nil).AggregateWorkflowExecutions), varargs...)
+}
+
 // BackfillSchedule mocks base method.
 func (m *MockClient) BackfillSchedule(arg0 context.Context, arg1 *types.BackfillScheduleRequest, arg2 ...yarpc.CallOption) (*types.BackfillScheduleResponse, error) {
 	m.ctrl.T.Helper()
@@ -103,6 +142,26 @@ func (mr *MockClientMockRecorder) CreateSchedule(arg0, arg1 any, arg2 ...any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSchedule", reflect.TypeOf((*MockClient)(nil).CreateSchedule), varargs...)
 }
 
+// CreateSemaphore mocks base method.
+func (m *MockClient) CreateSemaphore(arg0 context.Context, arg1 *types.CreateSemaphoreRequest, arg2 ...yarpc.CallOption) (*types.CreateSemaphoreResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateSemaphore", varargs...)
+	ret0, _ := ret[0].(*types.CreateSemaphoreResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSemaphore indicates an expected call of CreateSemaphore.
+func (mr *MockClientMockRecorder) CreateSemaphore(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSemaphore", reflect.TypeOf((*MockClient)(nil).CreateSemaphore), varargs...)
+}
+
 // DeleteDomain mocks base method.
 func (m *MockClient) DeleteDomain(arg0 context.Context, arg1 *types.DeleteDomainRequest, arg2 ...yarpc.CallOption) error {
 	m.ctrl.T.Helper()
@@ -201,6 +260,26 @@ func (mr *MockClientMockRecorder) DescribeSchedule(arg0, arg1 any, arg2 ...any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeSchedule", reflect.TypeOf((*MockClient)(nil).DescribeSchedule), varargs...)
 }
 
+// DescribeSemaphore mocks base method.
+func (m *MockClient) DescribeSemaphore(arg0 context.Context, arg1 *types.DescribeSemaphoreRequest, arg2 ...yarpc.CallOption) (*types.DescribeSemaphoreResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeSemaphore", varargs...)
+	ret0, _ := ret[0].(*types.DescribeSemaphoreResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeSemaphore indicates an expected call of DescribeSemaphore.
+func (mr *MockClientMockRecorder) DescribeSemaphore(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeSemaphore", reflect.TypeOf((*MockClient)(nil).DescribeSemaphore), varargs...)
+}
+
 // DescribeTaskList mocks base method.
 func (m *MockClient) DescribeTaskList(arg0 context.Context, arg1 *types.DescribeTaskListRequest, arg2 ...yarpc.CallOption) (*types.DescribeTaskListResponse, error) {
 	m.ctrl.T.Helper()
@@ -481,6 +560,26 @@ func (mr *MockClientMockRecorder) ListSchedules(arg0, arg1 any, arg2 ...any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSchedules", reflect.TypeOf((*MockClient)(nil).ListSchedules), varargs...)
 }
 
+// ListSemaphores mocks base method.
+func (m *MockClient) ListSemaphores(arg0 context.Context, arg1 *types.ListSemaphoresRequest, arg2 ...yarpc.CallOption) (*types.ListSemaphoresResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListSemaphores", varargs...)
+	ret0, _ := ret[0].(*types.ListSemaphoresResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSemaphores indicates an expected call of ListSemaphores.
+func (mr *MockClientMockRecorder) ListSemaphores(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSemaphores", reflect.TypeOf((*MockClient)(nil).ListSemaphores), varargs...)
+}
+
 // ListTaskListPartitions mocks base method.
 func (m *MockClient) ListTaskListPartitions(arg0 context.Context, arg1 *types.ListTaskListPartitionsRequest, arg2 ...yarpc.CallOption) (*types.ListTaskListPartitionsResponse, error) {
 	m.ctrl.T.Helper()
@@ -521,6 +620,25 @@ func (mr *MockClientMockRecorder) ListWorkflowExecutions(arg0, arg1 any, arg2 ..
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWorkflowExecutions", reflect.TypeOf((*MockClient)(nil).ListWorkflowExecutions), varargs...)
 }
 
+// PauseActivity mocks base method.
+func (m *MockClient) PauseActivity(arg0 context.Context, arg1 *types.PauseActivityRequest, arg2 ...yarpc.CallOption) error {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PauseActivity", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PauseActivity indicates an expected call of PauseActivity.
+func (mr *MockClientMockRecorder) PauseActivity(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseActivity", reflect.TypeOf((*MockClient)(nil).PauseActivity), varargs...)
+}
+
 // PauseSchedule mocks base method.
 func (m *MockClient) PauseSchedule(arg0 context.Context, arg1 *types.PauseScheduleRequest, arg2 ...yarpc.CallOption) (*types.PauseScheduleResponse, error) {
 	m.ctrl.T.Helper()
@@ -541,6 +659,25 @@ func (mr *MockClientMockRecorder) PauseSchedule(arg0, arg1 any, arg2 ...any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseSchedule", reflect.TypeOf((*MockClient)(nil).PauseSchedule), varargs...)
 }
 
+// PauseWorkflowExecution mocks base method.
+func (m *MockClient) PauseWorkflowExecution(arg0 context.Context, arg1 *types.PauseWorkflowExecutionRequest, arg2 ...yarpc.CallOption) error {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PauseWorkflowExecution", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PauseWorkflowExecution indicates an expected call of PauseWorkflowExecution.
+func (mr *MockClientMockRecorder) PauseWorkflowExecution(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseWorkflowExecution", reflect.TypeOf((*MockClient)(nil).PauseWorkflowExecution), varargs...)
+}
+
 // PollForActivityTask mocks base method.
 func (m *MockClient) PollForActivityTask(arg0 context.Context, arg1 *types.PollForActivityTaskRequest, arg2 ...yarpc.CallOption) (*types.PollForActivityTaskResponse, error) {
 	m.ctrl.T.Helper()
@@ -698,6 +835,25 @@ func (mr *MockClientMockRecorder) RequestCancelWorkflowExecution(arg0, arg1 any,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestCancelWorkflowExecution", reflect.TypeOf((*MockClient)(nil).RequestCancelWorkflowExecution), varargs...)
 }
 
+// ResetActivityAttempt mocks base method.
+func (m *MockClient) ResetActivityAttempt(arg0 context.Context, arg1 *types.ResetActivityAttemptRequest, arg2 ...yarpc.CallOption) error {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ResetActivityAttempt", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResetActivityAttempt indicates an expected call of ResetActivityAttempt.
+func (mr *MockClientMockRecorder) ResetActivityAttempt(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetActivityAttempt", reflect.TypeOf((*MockClient)(nil).ResetActivityAttempt), varargs...)
+}
+
 // ResetStickyTaskList mocks base method.
 func (m *MockClient) ResetStickyTaskList(arg0 context.Context, arg1 *types.ResetStickyTaskListRequest, arg2 ...yarpc.CallOption) (*types.ResetStickyTaskListResponse, error) {
 	m.ctrl.T.Helper()
@@ -930,6 +1086,25 @@ func (mr *MockClientMockRecorder) RestartWorkflowExecution(arg0, arg1 any, arg2
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestartWorkflowExecution", reflect.TypeOf((*MockClient)(nil).RestartWorkflowExecution), varargs...)
 }
 
+// RetryActivityNow mocks base method.
+func (m *MockClient) RetryActivityNow(arg0 context.Context, arg1 *types.RetryActivityNowRequest, arg2 ...yarpc.CallOption) error {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RetryActivityNow", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RetryActivityNow indicates an expected call of RetryActivityNow.
+func (mr *MockClientMockRecorder) RetryActivityNow(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetryActivityNow", reflect.TypeOf((*MockClient)(nil).RetryActivityNow), varargs...)
+}
+
 // ScanWorkflowExecutions mocks base method.
 func (m *MockClient) ScanWorkflowExecutions(arg0 context.Context, arg1 *types.ListWorkflowExecutionsRequest, arg2 ...yarpc.CallOption) (*types.ListWorkflowExecutionsResponse, error) {
 	m.ctrl.T.Helper()
@@ -1068,6 +1243,45 @@ func (mr *MockClientMockRecorder) TerminateWorkflowExecution(arg0, arg1 any, arg
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TerminateWorkflowExecution", reflect.TypeOf((*MockClient)(nil).TerminateWorkflowExecution), varargs...)
 }
 
+// TriggerSchedule mocks base method.
+func (m *MockClient) TriggerSchedule(arg0 context.Context, arg1 *types.TriggerScheduleRequest, arg2 ...yarpc.CallOption) (*types.TriggerScheduleResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "TriggerSchedule", varargs...)
+	ret0, _ := ret[0].(*types.TriggerScheduleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TriggerSchedule indicates an expected call of TriggerSchedule.
+func (mr *MockClientMockRecorder) TriggerSchedule(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TriggerSchedule", reflect.TypeOf((*MockClient)(nil).TriggerSchedule), varargs...)
+}
+
+// UnpauseActivity mocks base method.
+func (m *MockClient) UnpauseActivity(arg0 context.Context, arg1 *types.UnpauseActivityRequest, arg2 ...yarpc.CallOption) error {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UnpauseActivity", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnpauseActivity indicates an expected call of UnpauseActivity.
+func (mr *MockClientMockRecorder) UnpauseActivity(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnpauseActivity", reflect.TypeOf((*MockClient)(nil).UnpauseActivity), varargs...)
+}
+
 // UnpauseSchedule mocks base method.
 func (m *MockClient) UnpauseSchedule(arg0 context.Context, arg1 *types.UnpauseScheduleRequest, arg2 ...yarpc.CallOption) (*types.UnpauseScheduleResponse, error) {
 	m.ctrl.T.Helper()
@@ -1088,6 +1302,25 @@ func (mr *MockClientMockRecorder) UnpauseSchedule(arg0, arg1 any, arg2 ...any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnpauseSchedule", reflect.TypeOf((*MockClient)(nil).UnpauseSchedule), varargs...)
 }
 
+// UnpauseWorkflowExecution mocks base method.
+func (m *MockClient) UnpauseWorkflowExecution(arg0 context.Context, arg1 *types.UnpauseWorkflowExecutionRequest, arg2 ...yarpc.CallOption) error {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UnpauseWorkflowExecution", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnpauseWorkflowExecution indicates an expected call of UnpauseWorkflowExecution.
+func (mr *MockClientMockRecorder) UnpauseWorkflowExecution(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnpauseWorkflowExecution", reflect.TypeOf((*MockClient)(nil).UnpauseWorkflowExecution), varargs...)
+}
+
 // UpdateDomain mocks base method.
 func (m *MockClient) UpdateDomain(arg0 context.Context, arg1 *types.UpdateDomainRequest, arg2 ...yarpc.CallOption) (*types.UpdateDomainResponse, error) {
 	m.ctrl.T.Helper()
@@ -1127,3 +1360,23 @@ func (mr *MockClientMockRecorder) UpdateSchedule(arg0, arg1 any, arg2 ...any) *g
 	varargs := append([]any{arg0, arg1}, arg2...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSchedule", reflect.TypeOf((*MockClient)(nil).UpdateSchedule), varargs...)
 }
+
+// UpdateWorkflowExecution mocks base method.
+func (m *MockClient) UpdateWorkflowExecution(arg0 context.Context, arg1 *types.UpdateWorkflowExecutionRequest, arg2 ...yarpc.CallOption) (*types.UpdateWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateWorkflowExecution", varargs...)
+	ret0, _ := ret[0].(*types.UpdateWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateWorkflowExecution indicates an expected call of UpdateWorkflowExecution.
+func (mr *MockClientMockRecorder) UpdateWorkflowExecution(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkflowExecution", reflect.TypeOf((*MockClient)(nil).UpdateWorkflowExecution), varargs...)
+}
@@ -599,6 +599,134 @@ func (c *clientImpl) TerminateWorkflowExecution(
 	return err
 }
 
+func (c *clientImpl) PauseWorkflowExecution(
+	ctx context.Context,
+	request *types.HistoryPauseWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) error {
+	peer, err := c.peerResolver.FromWorkflowID(request.GetPauseRequest().GetWorkflowExecution().GetWorkflowID())
+	if err != nil {
+		return err
+	}
+	op := func(ctx context.Context, peer string) error {
+		return c.client.PauseWorkflowExecution(ctx, request, append(opts, yarpc.WithShardKey(peer))...)
+	}
+	err = c.executeWithRedirect(ctx, peer, op)
+	return err
+}
+
+func (c *clientImpl) UnpauseWorkflowExecution(
+	ctx context.Context,
+	request *types.HistoryUnpauseWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) error {
+	peer, err := c.peerResolver.FromWorkflowID(request.GetUnpauseRequest().GetWorkflowExecution().GetWorkflowID())
+	if err != nil {
+		return err
+	}
+	op := func(ctx context.Context, peer string) error {
+		return c.client.UnpauseWorkflowExecution(ctx, request, append(opts, yarpc.WithShardKey(peer))...)
+	}
+	err = c.executeWithRedirect(ctx, peer, op)
+	return err
+}
+
+func (c *clientImpl) PauseActivity(
+	ctx context.Context,
+	request *types.HistoryPauseActivityRequest,
+	opts ...yarpc.CallOption,
+) error {
+	peer, err := c.peerResolver.FromWorkflowID(request.GetPauseRequest().GetWorkflowExecution().GetWorkflowID())
+	if err != nil {
+		return err
+	}
+	op := func(ctx context.Context, peer string) error {
+		return c.client.PauseActivity(ctx, request, append(opts, yarpc.WithShardKey(peer))...)
+	}
+	err = c.executeWithRedirect(ctx, peer, op)
+	return err
+}
+
+func (c *clientImpl) UnpauseActivity(
+	ctx context.Context,
+	request *types.HistoryUnpauseActivityRequest,
+	opts ...yarpc.CallOption,
+) error {
+	peer, err := c.peerResolver.FromWorkflowID(request.GetUnpauseRequest().GetWorkflowExecution().GetWorkflowID())
+	if err != nil {
+		return err
+	}
+	op := func(ctx context.Context, peer string) error {
+		return c.client.UnpauseActivity(ctx, request, append(opts, yarpc.WithShardKey(peer))...)
+	}
+	err = c.executeWithRedirect(ctx, peer, op)
+	return err
+}
+
+func (c *clientImpl) ResetActivityAttempt(
+	ctx context.Context,
+	request *types.HistoryResetActivityAttemptRequest,
+	opts ...yarpc.CallOption,
+) error {
+	peer, err := c.peerResolver.FromWorkflowID(request.GetResetRequest().GetWorkflowExecution().GetWorkflowID())
+	if err != nil {
+		return err
+	}
+	op := func(ctx context.Context, peer string) error {
+		return c.client.ResetActivityAttempt(ctx, request, append(opts, yarpc.WithShardKey(peer))...)
+	}
+	err = c.executeWithRedirect(ctx, peer, op)
+	return err
+}
+
+func (c *clientImpl) RetryActivityNow(
+	ctx context.Context,
+	request *types.HistoryRetryActivityNowRequest,
+	opts ...yarpc.CallOption,
+) error {
+	peer, err := c.peerResolver.FromWorkflowID(request.GetRetryRequest().GetWorkflowExecution().GetWorkflowID())
+	if err != nil {
+		return err
+	}
+	op := func(ctx context.Context, peer string) error {
+		return c.client.RetryActivityNow(ctx, request, append(opts, yarpc.WithShardKey(peer))...)
+	}
+	err = c.executeWithRedirect(ctx, peer, op)
+	return err
+}
+
+func (c *clientImpl) DeleteWorkflowExecution(
+	ctx context.Context,
+	request *types.HistoryDeleteWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) error {
+	peer, err := c.peerResolver.FromWorkflowID(request.GetDeleteRequest().GetWorkflowExecution().GetWorkflowID())
+	if err != nil {
+		return err
+	}
+	op := func(ctx context.Context, peer string) error {
+		return c.client.DeleteWorkflowExecution(ctx, request, append(opts, yarpc.WithShardKey(peer))...)
+	}
+	err = c.executeWithRedirect(ctx, peer, op)
+	return err
+}
+
+func (c *clientImpl) UpsertWorkflowSearchAttributes(
+	ctx context.Context,
+	request *types.HistoryUpsertWorkflowSearchAttributesRequest,
+	opts ...yarpc.CallOption,
+) error {
+	peer, err := c.peerResolver.FromWorkflowID(request.GetRequest().GetExecution().GetWorkflowID())
+	if err != nil {
+		return err
+	}
+	op := func(ctx context.Context, peer string) error {
+		return c.client.UpsertWorkflowSearchAttributes(ctx, request, append(opts, yarpc.WithShardKey(peer))...)
+	}
+	err = c.executeWithRedirect(ctx, peer, op)
+	return err
+}
+
 func (c *clientImpl) ResetWorkflowExecution(
 	ctx context.Context,
 	request *types.HistoryResetWorkflowExecutionRequest,
@@ -704,6 +832,40 @@ func (c *clientImpl) SyncActivity(
 	return err
 }
 
+func (c *clientImpl) ReplicateDeleteWorkflowExecution(
+	ctx context.Context,
+	request *types.DeleteWorkflowExecutionTaskAttributes,
+	opts ...yarpc.CallOption,
+) error {
+
+	peer, err := c.peerResolver.FromWorkflowID(request.GetWorkflowID())
+	if err != nil {
+		return err
+	}
+	op := func(ctx context.Context, peer string) error {
+		return c.client.ReplicateDeleteWorkflowExecution(ctx, request, append(opts, yarpc.WithShardKey(peer))...)
+	}
+	err = c.executeWithRedirect(ctx, peer, op)
+	return err
+}
+
+func (c *clientImpl) ReplicateUpsertWorkflowSearchAttributes(
+	ctx context.Context,
+	request *types.UpsertWorkflowSearchAttributesTaskAttributes,
+	opts ...yarpc.CallOption,
+) error {
+
+	peer, err := c.peerResolver.FromWorkflowID(request.GetWorkflowID())
+	if err != nil {
+		return err
+	}
+	op := func(ctx context.Context, peer string) error {
+		return c.client.ReplicateUpsertWorkflowSearchAttributes(ctx, request, append(opts, yarpc.WithShardKey(peer))...)
+	}
+	err = c.executeWithRedirect(ctx, peer, op)
+	return err
+}
+
 func (c *clientImpl) QueryWorkflow(
 	ctx context.Context,
 	request *types.HistoryQueryWorkflowRequest,
@@ -726,6 +888,28 @@ func (c *clientImpl) QueryWorkflow(
 	return response, nil
 }
 
+func (c *clientImpl) UpdateWorkflowExecution(
+	ctx context.Context,
+	request *types.HistoryUpdateWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (*types.UpdateWorkflowExecutionResponse, error) {
+	peer, err := c.peerResolver.FromWorkflowID(request.GetUpdateRequest().GetWorkflowExecution().GetWorkflowID())
+	if err != nil {
+		return nil, err
+	}
+	var response *types.UpdateWorkflowExecutionResponse
+	op := func(ctx context.Context, peer string) error {
+		var err error
+		response, err = c.client.UpdateWorkflowExecution(ctx, request, append(opts, yarpc.WithShardKey(peer))...)
+		return err
+	}
+	err = c.executeWithRedirect(ctx, peer, op)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
 func (c *clientImpl) GetReplicationMessages(
 	ctx context.Context,
 	request *types.GetReplicationMessagesRequest,
@@ -1027,6 +1027,126 @@ func TestClient_withNoResponse(t *testing.T) {
 					Return(nil).Times(1)
 			},
 		},
+		{
+			name: "PauseWorkflowExecution",
+			op: func(c Client) error {
+				return c.PauseWorkflowExecution(context.Background(), &types.HistoryPauseWorkflowExecutionRequest{
+					PauseRequest: &types.PauseWorkflowExecutionRequest{
+						WorkflowExecution: &types.WorkflowExecution{WorkflowID: "test-workflow"},
+					},
+				})
+			},
+			mock: func(p *MockPeerResolver, c *MockClient) {
+				p.EXPECT().FromWorkflowID("test-workflow").Return("test-peer", nil).Times(1)
+				c.EXPECT().PauseWorkflowExecution(gomock.Any(), gomock.Any(), []yarpc.CallOption{yarpc.WithShardKey("test-peer")}).
+					Return(nil).Times(1)
+			},
+		},
+		{
+			name: "UnpauseWorkflowExecution",
+			op: func(c Client) error {
+				return c.UnpauseWorkflowExecution(context.Background(), &types.HistoryUnpauseWorkflowExecutionRequest{
+					UnpauseRequest: &types.UnpauseWorkflowExecutionRequest{
+						WorkflowExecution: &types.WorkflowExecution{WorkflowID: "test-workflow"},
+					},
+				})
+			},
+			mock: func(p *MockPeerResolver, c *MockClient) {
+				p.EXPECT().FromWorkflowID("test-workflow").Return("test-peer", nil).Times(1)
+				c.EXPECT().UnpauseWorkflowExecution(gomock.Any(), gomock.Any(), []yarpc.CallOption{yarpc.WithShardKey("test-peer")}).
+					Return(nil).Times(1)
+			},
+		},
+		{
+			name: "PauseActivity",
+			op: func(c Client) error {
+				return c.PauseActivity(context.Background(), &types.HistoryPauseActivityRequest{
+					PauseRequest: &types.PauseActivityRequest{
+						WorkflowExecution: &types.WorkflowExecution{WorkflowID: "test-workflow"},
+					},
+				})
+			},
+			mock: func(p *MockPeerResolver, c *MockClient) {
+				p.EXPECT().FromWorkflowID("test-workflow").Return("test-peer", nil).Times(1)
+				c.EXPECT().PauseActivity(gomock.Any(), gomock.Any(), []yarpc.CallOption{yarpc.WithShardKey("test-peer")}).
+					Return(nil).Times(1)
+			},
+		},
+		{
+			name: "UnpauseActivity",
+			op: func(c Client) error {
+				return c.UnpauseActivity(context.Background(), &types.HistoryUnpauseActivityRequest{
+					UnpauseRequest: &types.UnpauseActivityRequest{
+						WorkflowExecution: &types.WorkflowExecution{WorkflowID: "test-workflow"},
+					},
+				})
+			},
+			mock: func(p *MockPeerResolver, c *MockClient) {
+				p.EXPECT().FromWorkflowID("test-workflow").Return("test-peer", nil).Times(1)
+				c.EXPECT().UnpauseActivity(gomock.Any(), gomock.Any(), []yarpc.CallOption{yarpc.WithShardKey("test-peer")}).
+					Return(nil).Times(1)
+			},
+		},
+		{
+			name: "ResetActivityAttempt",
+			op: func(c Client) error {
+				return c.ResetActivityAttempt(context.Background(), &types.HistoryResetActivityAttemptRequest{
+					ResetRequest: &types.ResetActivityAttemptRequest{
+						WorkflowExecution: &types.WorkflowExecution{WorkflowID: "test-workflow"},
+					},
+				})
+			},
+			mock: func(p *MockPeerResolver, c *MockClient) {
+				p.EXPECT().FromWorkflowID("test-workflow").Return("test-peer", nil).Times(1)
+				c.EXPECT().ResetActivityAttempt(gomock.Any(), gomock.Any(), []yarpc.CallOption{yarpc.WithShardKey("test-peer")}).
+					Return(nil).Times(1)
+			},
+		},
+		{
+			name: "RetryActivityNow",
+			op: func(c Client) error {
+				return c.RetryActivityNow(context.Background(), &types.HistoryRetryActivityNowRequest{
+					RetryRequest: &types.RetryActivityNowRequest{
+						WorkflowExecution: &types.WorkflowExecution{WorkflowID: "test-workflow"},
+					},
+				})
+			},
+			mock: func(p *MockPeerResolver, c *MockClient) {
+				p.EXPECT().FromWorkflowID("test-workflow").Return("test-peer", nil).Times(1)
+				c.EXPECT().RetryActivityNow(gomock.Any(), gomock.Any(), []yarpc.CallOption{yarpc.WithShardKey("test-peer")}).
+					Return(nil).Times(1)
+			},
+		},
+		{
+			name: "DeleteWorkflowExecution",
+			op: func(c Client) error {
+				return c.DeleteWorkflowExecution(context.Background(), &types.HistoryDeleteWorkflowExecutionRequest{
+					DeleteRequest: &types.DeleteWorkflowExecutionRequest{
+						WorkflowExecution: &types.WorkflowExecution{WorkflowID: "test-workflow"},
+					},
+				})
+			},
+			mock: func(p *MockPeerResolver, c *MockClient) {
+				p.EXPECT().FromWorkflowID("test-workflow").Return("test-peer", nil).Times(1)
+				c.EXPECT().DeleteWorkflowExecution(gomock.Any(), gomock.Any(), []yarpc.CallOption{yarpc.WithShardKey("test-peer")}).
+					Return(nil).Times(1)
+			},
+		},
+		{
+			name: "UpsertWorkflowSearchAttributes",
+			op: func(c Client) error {
+				return c.UpsertWorkflowSearchAttributes(context.Background(), &types.HistoryUpsertWorkflowSearchAttributesRequest{
+					Request: &types.AdminUpsertWorkflowSearchAttributesRequest{
+						Execution: &types.WorkflowExecution{WorkflowID: "test-workflow"},
+					},
+				})
+			},
+			mock: func(p *MockPeerResolver, c *MockClient) {
+				p.EXPECT().FromWorkflowID("test-workflow").Return("test-peer", nil).Times(1)
+				c.EXPECT().UpsertWorkflowSearchAttributes(gomock.Any(), gomock.Any(), []yarpc.CallOption{yarpc.WithShardKey("test-peer")}).
+					Return(nil).Times(1)
+			},
+		},
 		{
 			name: "NotifyFailoverMarkers",
 			op: func(c Client) error {
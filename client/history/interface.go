@@ -62,7 +62,9 @@ type Client interface {
 	RefreshWorkflowTasks(context.Context, *types.HistoryRefreshWorkflowTasksRequest, ...yarpc.CallOption) error
 	RemoveSignalMutableState(context.Context, *types.RemoveSignalMutableStateRequest, ...yarpc.CallOption) error
 	RemoveTask(context.Context, *types.RemoveTaskRequest, ...yarpc.CallOption) error
+	ReplicateDeleteWorkflowExecution(context.Context, *types.DeleteWorkflowExecutionTaskAttributes, ...yarpc.CallOption) error
 	ReplicateEventsV2(context.Context, *types.ReplicateEventsV2Request, ...yarpc.CallOption) error
+	ReplicateUpsertWorkflowSearchAttributes(context.Context, *types.UpsertWorkflowSearchAttributesTaskAttributes, ...yarpc.CallOption) error
 	RequestCancelWorkflowExecution(context.Context, *types.HistoryRequestCancelWorkflowExecutionRequest, ...yarpc.CallOption) error
 	ResetQueue(context.Context, *types.ResetQueueRequest, ...yarpc.CallOption) error
 	ResetStickyTaskList(context.Context, *types.HistoryResetStickyTaskListRequest, ...yarpc.CallOption) (*types.HistoryResetStickyTaskListResponse, error)
@@ -80,6 +82,15 @@ type Client interface {
 	SyncActivity(context.Context, *types.SyncActivityRequest, ...yarpc.CallOption) error
 	SyncShardStatus(context.Context, *types.SyncShardStatusRequest, ...yarpc.CallOption) error
 	TerminateWorkflowExecution(context.Context, *types.HistoryTerminateWorkflowExecutionRequest, ...yarpc.CallOption) error
+	UpdateWorkflowExecution(context.Context, *types.HistoryUpdateWorkflowExecutionRequest, ...yarpc.CallOption) (*types.UpdateWorkflowExecutionResponse, error)
+	PauseWorkflowExecution(context.Context, *types.HistoryPauseWorkflowExecutionRequest, ...yarpc.CallOption) error
+	UnpauseWorkflowExecution(context.Context, *types.HistoryUnpauseWorkflowExecutionRequest, ...yarpc.CallOption) error
+	PauseActivity(context.Context, *types.HistoryPauseActivityRequest, ...yarpc.CallOption) error
+	UnpauseActivity(context.Context, *types.HistoryUnpauseActivityRequest, ...yarpc.CallOption) error
+	ResetActivityAttempt(context.Context, *types.HistoryResetActivityAttemptRequest, ...yarpc.CallOption) error
+	RetryActivityNow(context.Context, *types.HistoryRetryActivityNowRequest, ...yarpc.CallOption) error
+	DeleteWorkflowExecution(context.Context, *types.HistoryDeleteWorkflowExecutionRequest, ...yarpc.CallOption) error
+	UpsertWorkflowSearchAttributes(context.Context, *types.HistoryUpsertWorkflowSearchAttributesRequest, ...yarpc.CallOption) error
 	GetFailoverInfo(context.Context, *types.GetFailoverInfoRequest, ...yarpc.CallOption) (*types.GetFailoverInfoResponse, error)
 
 	// RatelimitUpdate pushes usage info for the passed ratelimit keys, and requests updated weight info from aggregating hosts.
@@ -82,6 +82,25 @@ func (mr *MockClientMockRecorder) CountDLQMessages(arg0, arg1 any, arg2 ...any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountDLQMessages", reflect.TypeOf((*MockClient)(nil).CountDLQMessages), varargs...)
 }
 
+// DeleteWorkflowExecution mocks base method.
+func (m *MockClient) DeleteWorkflowExecution(arg0 context.Context, arg1 *types.HistoryDeleteWorkflowExecutionRequest, arg2 ...yarpc.CallOption) error {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteWorkflowExecution", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWorkflowExecution indicates an expected call of DeleteWorkflowExecution.
+func (mr *MockClientMockRecorder) DeleteWorkflowExecution(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWorkflowExecution", reflect.TypeOf((*MockClient)(nil).DeleteWorkflowExecution), varargs...)
+}
+
 // DescribeHistoryHost mocks base method.
 func (m *MockClient) DescribeHistoryHost(arg0 context.Context, arg1 *types.DescribeHistoryHostRequest, arg2 ...yarpc.CallOption) (*types.DescribeHistoryHostResponse, error) {
 	m.ctrl.T.Helper()
@@ -301,6 +320,44 @@ func (mr *MockClientMockRecorder) NotifyFailoverMarkers(arg0, arg1 any, arg2 ...
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotifyFailoverMarkers", reflect.TypeOf((*MockClient)(nil).NotifyFailoverMarkers), varargs...)
 }
 
+// PauseActivity mocks base method.
+func (m *MockClient) PauseActivity(arg0 context.Context, arg1 *types.HistoryPauseActivityRequest, arg2 ...yarpc.CallOption) error {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PauseActivity", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PauseActivity indicates an expected call of PauseActivity.
+func (mr *MockClientMockRecorder) PauseActivity(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseActivity", reflect.TypeOf((*MockClient)(nil).PauseActivity), varargs...)
+}
+
+// PauseWorkflowExecution mocks base method.
+func (m *MockClient) PauseWorkflowExecution(arg0 context.Context, arg1 *types.HistoryPauseWorkflowExecutionRequest, arg2 ...yarpc.CallOption) error {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PauseWorkflowExecution", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PauseWorkflowExecution indicates an expected call of PauseWorkflowExecution.
+func (mr *MockClientMockRecorder) PauseWorkflowExecution(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseWorkflowExecution", reflect.TypeOf((*MockClient)(nil).PauseWorkflowExecution), varargs...)
+}
+
 // PollMutableState mocks base method.
 func (m *MockClient) PollMutableState(arg0 context.Context, arg1 *types.PollMutableStateRequest, arg2 ...yarpc.CallOption) (*types.PollMutableStateResponse, error) {
 	m.ctrl.T.Helper()
@@ -555,6 +612,25 @@ func (mr *MockClientMockRecorder) RemoveTask(arg0, arg1 any, arg2 ...any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveTask", reflect.TypeOf((*MockClient)(nil).RemoveTask), varargs...)
 }
 
+// ReplicateDeleteWorkflowExecution mocks base method.
+func (m *MockClient) ReplicateDeleteWorkflowExecution(arg0 context.Context, arg1 *types.DeleteWorkflowExecutionTaskAttributes, arg2 ...yarpc.CallOption) error {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ReplicateDeleteWorkflowExecution", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplicateDeleteWorkflowExecution indicates an expected call of ReplicateDeleteWorkflowExecution.
+func (mr *MockClientMockRecorder) ReplicateDeleteWorkflowExecution(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplicateDeleteWorkflowExecution", reflect.TypeOf((*MockClient)(nil).ReplicateDeleteWorkflowExecution), varargs...)
+}
+
 // ReplicateEventsV2 mocks base method.
 func (m *MockClient) ReplicateEventsV2(arg0 context.Context, arg1 *types.ReplicateEventsV2Request, arg2 ...yarpc.CallOption) error {
 	m.ctrl.T.Helper()
@@ -574,6 +650,25 @@ func (mr *MockClientMockRecorder) ReplicateEventsV2(arg0, arg1 any, arg2 ...any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplicateEventsV2", reflect.TypeOf((*MockClient)(nil).ReplicateEventsV2), varargs...)
 }
 
+// ReplicateUpsertWorkflowSearchAttributes mocks base method.
+func (m *MockClient) ReplicateUpsertWorkflowSearchAttributes(arg0 context.Context, arg1 *types.UpsertWorkflowSearchAttributesTaskAttributes, arg2 ...yarpc.CallOption) error {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ReplicateUpsertWorkflowSearchAttributes", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplicateUpsertWorkflowSearchAttributes indicates an expected call of ReplicateUpsertWorkflowSearchAttributes.
+func (mr *MockClientMockRecorder) ReplicateUpsertWorkflowSearchAttributes(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplicateUpsertWorkflowSearchAttributes", reflect.TypeOf((*MockClient)(nil).ReplicateUpsertWorkflowSearchAttributes), varargs...)
+}
+
 // RequestCancelWorkflowExecution mocks base method.
 func (m *MockClient) RequestCancelWorkflowExecution(arg0 context.Context, arg1 *types.HistoryRequestCancelWorkflowExecutionRequest, arg2 ...yarpc.CallOption) error {
 	m.ctrl.T.Helper()
@@ -593,6 +688,25 @@ func (mr *MockClientMockRecorder) RequestCancelWorkflowExecution(arg0, arg1 any,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestCancelWorkflowExecution", reflect.TypeOf((*MockClient)(nil).RequestCancelWorkflowExecution), varargs...)
 }
 
+// ResetActivityAttempt mocks base method.
+func (m *MockClient) ResetActivityAttempt(arg0 context.Context, arg1 *types.HistoryResetActivityAttemptRequest, arg2 ...yarpc.CallOption) error {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ResetActivityAttempt", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResetActivityAttempt indicates an expected call of ResetActivityAttempt.
+func (mr *MockClientMockRecorder) ResetActivityAttempt(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetActivityAttempt", reflect.TypeOf((*MockClient)(nil).ResetActivityAttempt), varargs...)
+}
+
 // ResetQueue mocks base method.
 func (m *MockClient) ResetQueue(arg0 context.Context, arg1 *types.ResetQueueRequest, arg2 ...yarpc.CallOption) error {
 	m.ctrl.T.Helper()
@@ -768,6 +882,25 @@ func (mr *MockClientMockRecorder) RespondDecisionTaskFailed(arg0, arg1 any, arg2
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RespondDecisionTaskFailed", reflect.TypeOf((*MockClient)(nil).RespondDecisionTaskFailed), varargs...)
 }
 
+// RetryActivityNow mocks base method.
+func (m *MockClient) RetryActivityNow(arg0 context.Context, arg1 *types.HistoryRetryActivityNowRequest, arg2 ...yarpc.CallOption) error {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RetryActivityNow", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RetryActivityNow indicates an expected call of RetryActivityNow.
+func (mr *MockClientMockRecorder) RetryActivityNow(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetryActivityNow", reflect.TypeOf((*MockClient)(nil).RetryActivityNow), varargs...)
+}
+
 // ScheduleDecisionTask mocks base method.
 func (m *MockClient) ScheduleDecisionTask(arg0 context.Context, arg1 *types.ScheduleDecisionTaskRequest, arg2 ...yarpc.CallOption) error {
 	m.ctrl.T.Helper()
@@ -902,3 +1035,80 @@ func (mr *MockClientMockRecorder) TerminateWorkflowExecution(arg0, arg1 any, arg
 	varargs := append([]any{arg0, arg1}, arg2...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TerminateWorkflowExecution", reflect.TypeOf((*MockClient)(nil).TerminateWorkflowExecution), varargs...)
 }
+
+// UnpauseActivity mocks base method.
+func (m *MockClient) UnpauseActivity(arg0 context.Context, arg1 *types.HistoryUnpauseActivityRequest, arg2 ...yarpc.CallOption) error {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UnpauseActivity", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnpauseActivity indicates an expected call of UnpauseActivity.
+func (mr *MockClientMockRecorder) UnpauseActivity(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnpauseActivity", reflect.TypeOf((*MockClient)(nil).UnpauseActivity), varargs...)
+}
+
+// UnpauseWorkflowExecution mocks base method.
+func (m *MockClient) UnpauseWorkflowExecution(arg0 context.Context, arg1 *types.HistoryUnpauseWorkflowExecutionRequest, arg2 ...yarpc.CallOption) error {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UnpauseWorkflowExecution", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnpauseWorkflowExecution indicates an expected call of UnpauseWorkflowExecution.
+func (mr *MockClientMockRecorder) UnpauseWorkflowExecution(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnpauseWorkflowExecution", reflect.TypeOf((*MockClient)(nil).UnpauseWorkflowExecution), varargs...)
+}
+
+// UpdateWorkflowExecution mocks base method.
+func (m *MockClient) UpdateWorkflowExecution(arg0 context.Context, arg1 *types.HistoryUpdateWorkflowExecutionRequest, arg2 ...yarpc.CallOption) (*types.UpdateWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateWorkflowExecution", varargs...)
+	ret0, _ := ret[0].(*types.UpdateWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateWorkflowExecution indicates an expected call of UpdateWorkflowExecution.
+func (mr *MockClientMockRecorder) UpdateWorkflowExecution(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkflowExecution", reflect.TypeOf((*MockClient)(nil).UpdateWorkflowExecution), varargs...)
+}
+
+// UpsertWorkflowSearchAttributes mocks base method.
+func (m *MockClient) UpsertWorkflowSearchAttributes(arg0 context.Context, arg1 *types.HistoryUpsertWorkflowSearchAttributesRequest, arg2 ...yarpc.CallOption) error {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpsertWorkflowSearchAttributes", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertWorkflowSearchAttributes indicates an expected call of UpsertWorkflowSearchAttributes.
+func (mr *MockClientMockRecorder) UpsertWorkflowSearchAttributes(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertWorkflowSearchAttributes", reflect.TypeOf((*MockClient)(nil).UpsertWorkflowSearchAttributes), varargs...)
+}
@@ -779,3 +779,23 @@ func (c *adminClient) UpdateTaskListPartitionConfig(ctx context.Context, request
 	}
 	return
 }
+
+func (c *adminClient) UpsertWorkflowSearchAttributes(ctx context.Context, ap1 *types.AdminUpsertWorkflowSearchAttributesRequest, p1 ...yarpc.CallOption) (err error) {
+	fakeErr := c.fakeErrFn(c.errorRate)
+	var forwardCall bool
+	if forwardCall = c.forwardCallFn(fakeErr); forwardCall {
+		err = c.client.UpsertWorkflowSearchAttributes(ctx, ap1, p1...)
+	}
+
+	if fakeErr != nil {
+		c.logger.Error(msgAdminInjectedFakeErr,
+			tag.AdminClientOperationUpsertWorkflowSearchAttributes,
+			tag.Error(fakeErr),
+			tag.Bool(forwardCall),
+			tag.ClientError(err),
+		)
+		err = fakeErr
+		return
+	}
+	return
+}
@@ -198,3 +198,7 @@ func (g adminClient) UpdateTaskListPartitionConfig(ctx context.Context, request
 	response, err := g.c.UpdateTaskListPartitionConfig(ctx, proto.FromAdminUpdateTaskListPartitionConfigRequest(request), opts...)
 	return proto.ToAdminUpdateTaskListPartitionConfigResponse(response), proto.ToError(err)
 }
+
+func (g adminClient) UpsertWorkflowSearchAttributes(ctx context.Context, ap1 *types.AdminUpsertWorkflowSearchAttributesRequest, p1 ...yarpc.CallOption) (err error) {
+	return &types.BadRequestError{Message: "Feature not supported on gRPC"}
+}
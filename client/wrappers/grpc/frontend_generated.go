@@ -14,6 +14,10 @@ import (
 	"github.com/uber/cadence/common/types/mapper/proto"
 )
 
+func (g frontendClient) AggregateWorkflowExecutions(ctx context.Context, ap1 *types.AggregateWorkflowExecutionsRequest, p1 ...yarpc.CallOption) (ap2 *types.AggregateWorkflowExecutionsResponse, err error) {
+	return nil, &types.BadRequestError{Message: "Feature not supported on gRPC"}
+}
+
 func (g frontendClient) BackfillSchedule(ctx context.Context, bp1 *types.BackfillScheduleRequest, p1 ...yarpc.CallOption) (bp2 *types.BackfillScheduleResponse, err error) {
 	response, err := g.c.BackfillSchedule(ctx, proto.FromBackfillScheduleRequest(bp1), p1...)
 	return proto.ToBackfillScheduleResponse(response), proto.ToError(err)
@@ -29,6 +33,10 @@ func (g frontendClient) CreateSchedule(ctx context.Context, cp1 *types.CreateSch
 	return proto.ToCreateScheduleResponse(response), proto.ToError(err)
 }
 
+func (g frontendClient) CreateSemaphore(ctx context.Context, cp1 *types.CreateSemaphoreRequest, p1 ...yarpc.CallOption) (cp2 *types.CreateSemaphoreResponse, err error) {
+	return nil, &types.BadRequestError{Message: "Feature not supported on gRPC"}
+}
+
 func (g frontendClient) DeleteDomain(ctx context.Context, dp1 *types.DeleteDomainRequest, p1 ...yarpc.CallOption) (err error) {
 	_, err = g.c.DeleteDomain(ctx, proto.FromDeleteDomainRequest(dp1), p1...)
 	return proto.ToError(err)
@@ -39,6 +47,10 @@ func (g frontendClient) DeleteSchedule(ctx context.Context, dp1 *types.DeleteSch
 	return proto.ToDeleteScheduleResponse(response), proto.ToError(err)
 }
 
+func (g frontendClient) DeleteWorkflowExecution(ctx context.Context, dp1 *types.DeleteWorkflowExecutionRequest, p1 ...yarpc.CallOption) (err error) {
+	return &types.BadRequestError{Message: "Feature not supported on gRPC"}
+}
+
 func (g frontendClient) DeprecateDomain(ctx context.Context, dp1 *types.DeprecateDomainRequest, p1 ...yarpc.CallOption) (err error) {
 	_, err = g.c.DeprecateDomain(ctx, proto.FromDeprecateDomainRequest(dp1), p1...)
 	return proto.ToError(err)
@@ -54,6 +66,10 @@ func (g frontendClient) DescribeSchedule(ctx context.Context, dp1 *types.Describ
 	return proto.ToDescribeScheduleResponse(response), proto.ToError(err)
 }
 
+func (g frontendClient) DescribeSemaphore(ctx context.Context, dp1 *types.DescribeSemaphoreRequest, p1 ...yarpc.CallOption) (dp2 *types.DescribeSemaphoreResponse, err error) {
+	return nil, &types.BadRequestError{Message: "Feature not supported on gRPC"}
+}
+
 func (g frontendClient) DescribeTaskList(ctx context.Context, dp1 *types.DescribeTaskListRequest, p1 ...yarpc.CallOption) (dp2 *types.DescribeTaskListResponse, err error) {
 	response, err := g.c.DescribeTaskList(ctx, proto.FromDescribeTaskListRequest(dp1), p1...)
 	return proto.ToDescribeTaskListResponse(response), proto.ToError(err)
@@ -124,6 +140,10 @@ func (g frontendClient) ListSchedules(ctx context.Context, lp1 *types.ListSchedu
 	return proto.ToListSchedulesResponse(response), proto.ToError(err)
 }
 
+func (g frontendClient) ListSemaphores(ctx context.Context, lp1 *types.ListSemaphoresRequest, p1 ...yarpc.CallOption) (lp2 *types.ListSemaphoresResponse, err error) {
+	return nil, &types.BadRequestError{Message: "Feature not supported on gRPC"}
+}
+
 func (g frontendClient) ListTaskListPartitions(ctx context.Context, lp1 *types.ListTaskListPartitionsRequest, p1 ...yarpc.CallOption) (lp2 *types.ListTaskListPartitionsResponse, err error) {
 	response, err := g.c.ListTaskListPartitions(ctx, proto.FromListTaskListPartitionsRequest(lp1), p1...)
 	return proto.ToListTaskListPartitionsResponse(response), proto.ToError(err)
@@ -134,11 +154,19 @@ func (g frontendClient) ListWorkflowExecutions(ctx context.Context, lp1 *types.L
 	return proto.ToListWorkflowExecutionsResponse(response), proto.ToError(err)
 }
 
+func (g frontendClient) PauseActivity(ctx context.Context, pp1 *types.PauseActivityRequest, p1 ...yarpc.CallOption) (err error) {
+	return &types.BadRequestError{Message: "Feature not supported on gRPC"}
+}
+
 func (g frontendClient) PauseSchedule(ctx context.Context, pp1 *types.PauseScheduleRequest, p1 ...yarpc.CallOption) (pp2 *types.PauseScheduleResponse, err error) {
 	response, err := g.c.PauseSchedule(ctx, proto.FromPauseScheduleRequest(pp1), p1...)
 	return proto.ToPauseScheduleResponse(response), proto.ToError(err)
 }
 
+func (g frontendClient) PauseWorkflowExecution(ctx context.Context, pp1 *types.PauseWorkflowExecutionRequest, p1 ...yarpc.CallOption) (err error) {
+	return &types.BadRequestError{Message: "Feature not supported on gRPC"}
+}
+
 func (g frontendClient) PollForActivityTask(ctx context.Context, pp1 *types.PollForActivityTaskRequest, p1 ...yarpc.CallOption) (pp2 *types.PollForActivityTaskResponse, err error) {
 	response, err := g.c.PollForActivityTask(ctx, proto.FromPollForActivityTaskRequest(pp1), p1...)
 	return proto.ToPollForActivityTaskResponse(response), proto.ToError(err)
@@ -179,6 +207,10 @@ func (g frontendClient) RequestCancelWorkflowExecution(ctx context.Context, rp1
 	return proto.ToError(err)
 }
 
+func (g frontendClient) ResetActivityAttempt(ctx context.Context, rp1 *types.ResetActivityAttemptRequest, p1 ...yarpc.CallOption) (err error) {
+	return &types.BadRequestError{Message: "Feature not supported on gRPC"}
+}
+
 func (g frontendClient) ResetStickyTaskList(ctx context.Context, rp1 *types.ResetStickyTaskListRequest, p1 ...yarpc.CallOption) (rp2 *types.ResetStickyTaskListResponse, err error) {
 	response, err := g.c.ResetStickyTaskList(ctx, proto.FromResetStickyTaskListRequest(rp1), p1...)
 	return proto.ToResetStickyTaskListResponse(response), proto.ToError(err)
@@ -239,6 +271,10 @@ func (g frontendClient) RestartWorkflowExecution(ctx context.Context, rp1 *types
 	return proto.ToRestartWorkflowExecutionResponse(response), proto.ToError(err)
 }
 
+func (g frontendClient) RetryActivityNow(ctx context.Context, rp1 *types.RetryActivityNowRequest, p1 ...yarpc.CallOption) (err error) {
+	return &types.BadRequestError{Message: "Feature not supported on gRPC"}
+}
+
 func (g frontendClient) ScanWorkflowExecutions(ctx context.Context, lp1 *types.ListWorkflowExecutionsRequest, p1 ...yarpc.CallOption) (lp2 *types.ListWorkflowExecutionsResponse, err error) {
 	response, err := g.c.ScanWorkflowExecutions(ctx, proto.FromScanWorkflowExecutionsRequest(lp1), p1...)
 	return proto.ToScanWorkflowExecutionsResponse(response), proto.ToError(err)
@@ -274,11 +310,23 @@ func (g frontendClient) TerminateWorkflowExecution(ctx context.Context, tp1 *typ
 	return proto.ToError(err)
 }
 
+func (g frontendClient) TriggerSchedule(ctx context.Context, tp1 *types.TriggerScheduleRequest, p1 ...yarpc.CallOption) (tp2 *types.TriggerScheduleResponse, err error) {
+	return nil, &types.BadRequestError{Message: "Feature not supported on gRPC"}
+}
+
+func (g frontendClient) UnpauseActivity(ctx context.Context, up1 *types.UnpauseActivityRequest, p1 ...yarpc.CallOption) (err error) {
+	return &types.BadRequestError{Message: "Feature not supported on gRPC"}
+}
+
 func (g frontendClient) UnpauseSchedule(ctx context.Context, up1 *types.UnpauseScheduleRequest, p1 ...yarpc.CallOption) (up2 *types.UnpauseScheduleResponse, err error) {
 	response, err := g.c.UnpauseSchedule(ctx, proto.FromUnpauseScheduleRequest(up1), p1...)
 	return proto.ToUnpauseScheduleResponse(response), proto.ToError(err)
 }
 
+func (g frontendClient) UnpauseWorkflowExecution(ctx context.Context, up1 *types.UnpauseWorkflowExecutionRequest, p1 ...yarpc.CallOption) (err error) {
+	return &types.BadRequestError{Message: "Feature not supported on gRPC"}
+}
+
 func (g frontendClient) UpdateDomain(ctx context.Context, up1 *types.UpdateDomainRequest, p1 ...yarpc.CallOption) (up2 *types.UpdateDomainResponse, err error) {
 	response, err := g.c.UpdateDomain(ctx, proto.FromUpdateDomainRequest(up1), p1...)
 	return proto.ToUpdateDomainResponse(response), proto.ToError(err)
@@ -288,3 +336,7 @@ func (g frontendClient) UpdateSchedule(ctx context.Context, up1 *types.UpdateSch
 	response, err := g.c.UpdateSchedule(ctx, proto.FromUpdateScheduleRequest(up1), p1...)
 	return proto.ToUpdateScheduleResponse(response), proto.ToError(err)
 }
+
+func (g frontendClient) UpdateWorkflowExecution(ctx context.Context, up1 *types.UpdateWorkflowExecutionRequest, p1 ...yarpc.CallOption) (up2 *types.UpdateWorkflowExecutionResponse, err error) {
+	return nil, &types.BadRequestError{Message: "Feature not supported on gRPC"}
+}
@@ -23,6 +23,11 @@ func (g historyClient) CountDLQMessages(ctx context.Context, cp1 *types.CountDLQ
 	return proto.ToHistoryCountDLQMessagesResponse(response), proto.ToError(err)
 }
 
+func (g historyClient) DeleteWorkflowExecution(ctx context.Context, hp1 *types.HistoryDeleteWorkflowExecutionRequest, p1 ...yarpc.CallOption) (err error) {
+	_, err = g.c.DeleteWorkflowExecution(ctx, proto.FromHistoryDeleteWorkflowExecutionRequest(hp1), p1...)
+	return proto.ToError(err)
+}
+
 func (g historyClient) DescribeHistoryHost(ctx context.Context, dp1 *types.DescribeHistoryHostRequest, p1 ...yarpc.CallOption) (dp2 *types.DescribeHistoryHostResponse, err error) {
 	response, err := g.c.DescribeHistoryHost(ctx, proto.FromHistoryDescribeHistoryHostRequest(dp1), p1...)
 	return proto.ToHistoryDescribeHistoryHostResponse(response), proto.ToError(err)
@@ -78,6 +83,16 @@ func (g historyClient) NotifyFailoverMarkers(ctx context.Context, np1 *types.Not
 	return proto.ToError(err)
 }
 
+func (g historyClient) PauseActivity(ctx context.Context, hp1 *types.HistoryPauseActivityRequest, p1 ...yarpc.CallOption) (err error) {
+	_, err = g.c.PauseActivity(ctx, proto.FromHistoryPauseActivityRequest(hp1), p1...)
+	return proto.ToError(err)
+}
+
+func (g historyClient) PauseWorkflowExecution(ctx context.Context, hp1 *types.HistoryPauseWorkflowExecutionRequest, p1 ...yarpc.CallOption) (err error) {
+	_, err = g.c.PauseWorkflowExecution(ctx, proto.FromHistoryPauseWorkflowExecutionRequest(hp1), p1...)
+	return proto.ToError(err)
+}
+
 func (g historyClient) PollMutableState(ctx context.Context, pp1 *types.PollMutableStateRequest, p1 ...yarpc.CallOption) (pp2 *types.PollMutableStateResponse, err error) {
 	response, err := g.c.PollMutableState(ctx, proto.FromHistoryPollMutableStateRequest(pp1), p1...)
 	return proto.ToHistoryPollMutableStateResponse(response), proto.ToError(err)
@@ -143,16 +158,31 @@ func (g historyClient) RemoveTask(ctx context.Context, rp1 *types.RemoveTaskRequ
 	return proto.ToError(err)
 }
 
+func (g historyClient) ReplicateDeleteWorkflowExecution(ctx context.Context, dp1 *types.DeleteWorkflowExecutionTaskAttributes, p1 ...yarpc.CallOption) (err error) {
+	_, err = g.c.ReplicateDeleteWorkflowExecution(ctx, proto.FromHistoryReplicateDeleteWorkflowExecutionRequest(dp1), p1...)
+	return proto.ToError(err)
+}
+
 func (g historyClient) ReplicateEventsV2(ctx context.Context, rp1 *types.ReplicateEventsV2Request, p1 ...yarpc.CallOption) (err error) {
 	_, err = g.c.ReplicateEventsV2(ctx, proto.FromHistoryReplicateEventsV2Request(rp1), p1...)
 	return proto.ToError(err)
 }
 
+func (g historyClient) ReplicateUpsertWorkflowSearchAttributes(ctx context.Context, up1 *types.UpsertWorkflowSearchAttributesTaskAttributes, p1 ...yarpc.CallOption) (err error) {
+	_, err = g.c.ReplicateUpsertWorkflowSearchAttributes(ctx, proto.FromHistoryReplicateUpsertWorkflowSearchAttributesRequest(up1), p1...)
+	return proto.ToError(err)
+}
+
 func (g historyClient) RequestCancelWorkflowExecution(ctx context.Context, hp1 *types.HistoryRequestCancelWorkflowExecutionRequest, p1 ...yarpc.CallOption) (err error) {
 	_, err = g.c.RequestCancelWorkflowExecution(ctx, proto.FromHistoryRequestCancelWorkflowExecutionRequest(hp1), p1...)
 	return proto.ToError(err)
 }
 
+func (g historyClient) ResetActivityAttempt(ctx context.Context, hp1 *types.HistoryResetActivityAttemptRequest, p1 ...yarpc.CallOption) (err error) {
+	_, err = g.c.ResetActivityAttempt(ctx, proto.FromHistoryResetActivityAttemptRequest(hp1), p1...)
+	return proto.ToError(err)
+}
+
 func (g historyClient) ResetQueue(ctx context.Context, rp1 *types.ResetQueueRequest, p1 ...yarpc.CallOption) (err error) {
 	_, err = g.c.ResetQueue(ctx, proto.FromHistoryResetQueueRequest(rp1), p1...)
 	return proto.ToError(err)
@@ -198,6 +228,11 @@ func (g historyClient) RespondDecisionTaskFailed(ctx context.Context, hp1 *types
 	return proto.ToError(err)
 }
 
+func (g historyClient) RetryActivityNow(ctx context.Context, hp1 *types.HistoryRetryActivityNowRequest, p1 ...yarpc.CallOption) (err error) {
+	_, err = g.c.RetryActivityNow(ctx, proto.FromHistoryRetryActivityNowRequest(hp1), p1...)
+	return proto.ToError(err)
+}
+
 func (g historyClient) ScheduleDecisionTask(ctx context.Context, sp1 *types.ScheduleDecisionTaskRequest, p1 ...yarpc.CallOption) (err error) {
 	_, err = g.c.ScheduleDecisionTask(ctx, proto.FromHistoryScheduleDecisionTaskRequest(sp1), p1...)
 	return proto.ToError(err)
@@ -232,3 +267,23 @@ func (g historyClient) TerminateWorkflowExecution(ctx context.Context, hp1 *type
 	_, err = g.c.TerminateWorkflowExecution(ctx, proto.FromHistoryTerminateWorkflowExecutionRequest(hp1), p1...)
 	return proto.ToError(err)
 }
+
+func (g historyClient) UnpauseActivity(ctx context.Context, hp1 *types.HistoryUnpauseActivityRequest, p1 ...yarpc.CallOption) (err error) {
+	_, err = g.c.UnpauseActivity(ctx, proto.FromHistoryUnpauseActivityRequest(hp1), p1...)
+	return proto.ToError(err)
+}
+
+func (g historyClient) UnpauseWorkflowExecution(ctx context.Context, hp1 *types.HistoryUnpauseWorkflowExecutionRequest, p1 ...yarpc.CallOption) (err error) {
+	_, err = g.c.UnpauseWorkflowExecution(ctx, proto.FromHistoryUnpauseWorkflowExecutionRequest(hp1), p1...)
+	return proto.ToError(err)
+}
+
+func (g historyClient) UpdateWorkflowExecution(ctx context.Context, hp1 *types.HistoryUpdateWorkflowExecutionRequest, p1 ...yarpc.CallOption) (up1 *types.UpdateWorkflowExecutionResponse, err error) {
+	response, err := g.c.UpdateWorkflowExecution(ctx, proto.FromHistoryUpdateWorkflowExecutionRequest(hp1), p1...)
+	return proto.ToHistoryUpdateWorkflowExecutionResponse(response), proto.ToError(err)
+}
+
+func (g historyClient) UpsertWorkflowSearchAttributes(ctx context.Context, hp1 *types.HistoryUpsertWorkflowSearchAttributesRequest, p1 ...yarpc.CallOption) (err error) {
+	_, err = g.c.UpsertWorkflowSearchAttributes(ctx, proto.FromHistoryUpsertWorkflowSearchAttributesRequest(hp1), p1...)
+	return proto.ToError(err)
+}
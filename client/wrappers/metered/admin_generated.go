@@ -916,3 +916,27 @@ func (c *adminClient) UpdateTaskListPartitionConfig(ctx context.Context, request
 	}
 	return up1, err
 }
+
+func (c *adminClient) UpsertWorkflowSearchAttributes(ctx context.Context, ap1 *types.AdminUpsertWorkflowSearchAttributesRequest, p1 ...yarpc.CallOption) (err error) {
+	retryCount := getRetryCountFromContext(ctx)
+
+	var scope metrics.Scope
+	if retryCount == -1 {
+		scope = c.metricsClient.Scope(metrics.AdminClientUpsertWorkflowSearchAttributesScope)
+	} else {
+		scope = c.metricsClient.Scope(metrics.AdminClientUpsertWorkflowSearchAttributesScope, metrics.IsRetryTag(retryCount > 0))
+	}
+
+	scope.IncCounter(metrics.CadenceClientRequests)
+
+	clientLatencyStart := time.Now()
+	sw := scope.StartTimer(metrics.CadenceClientLatency)
+	err = c.client.UpsertWorkflowSearchAttributes(ctx, ap1, p1...)
+	sw.Stop()
+	scope.ExponentialHistogram(metrics.CadenceClientLatencyHistogram, time.Since(clientLatencyStart))
+
+	if err != nil {
+		scope.IncCounter(metrics.CadenceClientFailures)
+	}
+	return err
+}
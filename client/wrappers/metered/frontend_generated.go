@@ -29,6 +29,30 @@ func NewFrontendClient(client _sourceFrontend.Client, metricsClient metrics.Clie
 	}
 }
 
+func (c *frontendClient) AggregateWorkflowExecutions(ctx context.Context, ap1 *types.AggregateWorkflowExecutionsRequest, p1 ...yarpc.CallOption) (ap2 *types.AggregateWorkflowExecutionsResponse, err error) {
+	retryCount := getRetryCountFromContext(ctx)
+
+	var scope metrics.Scope
+	if retryCount == -1 {
+		scope = c.metricsClient.Scope(metrics.FrontendClientAggregateWorkflowExecutionsScope)
+	} else {
+		scope = c.metricsClient.Scope(metrics.FrontendClientAggregateWorkflowExecutionsScope, metrics.IsRetryTag(retryCount > 0))
+	}
+
+	scope.IncCounter(metrics.CadenceClientRequests)
+
+	clientLatencyStart := time.Now()
+	sw := scope.StartTimer(metrics.CadenceClientLatency)
+	ap2, err = c.client.AggregateWorkflowExecutions(ctx, ap1, p1...)
+	sw.Stop()
+	scope.ExponentialHistogram(metrics.CadenceClientLatencyHistogram, time.Since(clientLatencyStart))
+
+	if err != nil {
+		scope.IncCounter(metrics.CadenceClientFailures)
+	}
+	return ap2, err
+}
+
 func (c *frontendClient) BackfillSchedule(ctx context.Context, bp1 *types.BackfillScheduleRequest, p1 ...yarpc.CallOption) (bp2 *types.BackfillScheduleResponse, err error) {
 	retryCount := getRetryCountFromContext(ctx)
 
@@ -101,6 +125,30 @@ func (c *frontendClient) CreateSchedule(ctx context.Context, cp1 *types.CreateSc
 	return cp2, err
 }
 
+func (c *frontendClient) CreateSemaphore(ctx context.Context, cp1 *types.CreateSemaphoreRequest, p1 ...yarpc.CallOption) (cp2 *types.CreateSemaphoreResponse, err error) {
+	retryCount := getRetryCountFromContext(ctx)
+
+	var scope metrics.Scope
+	if retryCount == -1 {
+		scope = c.metricsClient.Scope(metrics.FrontendClientCreateSemaphoreScope)
+	} else {
+		scope = c.metricsClient.Scope(metrics.FrontendClientCreateSemaphoreScope, metrics.IsRetryTag(retryCount > 0))
+	}
+
+	scope.IncCounter(metrics.CadenceClientRequests)
+
+	clientLatencyStart := time.Now()
+	sw := scope.StartTimer(metrics.CadenceClientLatency)
+	cp2, err = c.client.CreateSemaphore(ctx, cp1, p1...)
+	sw.Stop()
+	scope.ExponentialHistogram(metrics.CadenceClientLatencyHistogram, time.Since(clientLatencyStart))
+
+	if err != nil {
+		scope.IncCounter(metrics.CadenceClientFailures)
+	}
+	return cp2, err
+}
+
 func (c *frontendClient) DeleteDomain(ctx context.Context, dp1 *types.DeleteDomainRequest, p1 ...yarpc.CallOption) (err error) {
 	retryCount := getRetryCountFromContext(ctx)
 
@@ -149,6 +197,30 @@ func (c *frontendClient) DeleteSchedule(ctx context.Context, dp1 *types.DeleteSc
 	return dp2, err
 }
 
+func (c *frontendClient) DeleteWorkflowExecution(ctx context.Context, dp1 *types.DeleteWorkflowExecutionRequest, p1 ...yarpc.CallOption) (err error) {
+	retryCount := getRetryCountFromContext(ctx)
+
+	var scope metrics.Scope
+	if retryCount == -1 {
+		scope = c.metricsClient.Scope(metrics.FrontendClientDeleteWorkflowExecutionScope)
+	} else {
+		scope = c.metricsClient.Scope(metrics.FrontendClientDeleteWorkflowExecutionScope, metrics.IsRetryTag(retryCount > 0))
+	}
+
+	scope.IncCounter(metrics.CadenceClientRequests)
+
+	clientLatencyStart := time.Now()
+	sw := scope.StartTimer(metrics.CadenceClientLatency)
+	err = c.client.DeleteWorkflowExecution(ctx, dp1, p1...)
+	sw.Stop()
+	scope.ExponentialHistogram(metrics.CadenceClientLatencyHistogram, time.Since(clientLatencyStart))
+
+	if err != nil {
+		scope.IncCounter(metrics.CadenceClientFailures)
+	}
+	return err
+}
+
 func (c *frontendClient) DeprecateDomain(ctx context.Context, dp1 *types.DeprecateDomainRequest, p1 ...yarpc.CallOption) (err error) {
 	retryCount := getRetryCountFromContext(ctx)
 
@@ -221,6 +293,30 @@ func (c *frontendClient) DescribeSchedule(ctx context.Context, dp1 *types.Descri
 	return dp2, err
 }
 
+func (c *frontendClient) DescribeSemaphore(ctx context.Context, dp1 *types.DescribeSemaphoreRequest, p1 ...yarpc.CallOption) (dp2 *types.DescribeSemaphoreResponse, err error) {
+	retryCount := getRetryCountFromContext(ctx)
+
+	var scope metrics.Scope
+	if retryCount == -1 {
+		scope = c.metricsClient.Scope(metrics.FrontendClientDescribeSemaphoreScope)
+	} else {
+		scope = c.metricsClient.Scope(metrics.FrontendClientDescribeSemaphoreScope, metrics.IsRetryTag(retryCount > 0))
+	}
+
+	scope.IncCounter(metrics.CadenceClientRequests)
+
+	clientLatencyStart := time.Now()
+	sw := scope.StartTimer(metrics.CadenceClientLatency)
+	dp2, err = c.client.DescribeSemaphore(ctx, dp1, p1...)
+	sw.Stop()
+	scope.ExponentialHistogram(metrics.CadenceClientLatencyHistogram, time.Since(clientLatencyStart))
+
+	if err != nil {
+		scope.IncCounter(metrics.CadenceClientFailures)
+	}
+	return dp2, err
+}
+
 func (c *frontendClient) DescribeTaskList(ctx context.Context, dp1 *types.DescribeTaskListRequest, p1 ...yarpc.CallOption) (dp2 *types.DescribeTaskListResponse, err error) {
 	retryCount := getRetryCountFromContext(ctx)
 
@@ -557,6 +653,30 @@ func (c *frontendClient) ListSchedules(ctx context.Context, lp1 *types.ListSched
 	return lp2, err
 }
 
+func (c *frontendClient) ListSemaphores(ctx context.Context, lp1 *types.ListSemaphoresRequest, p1 ...yarpc.CallOption) (lp2 *types.ListSemaphoresResponse, err error) {
+	retryCount := getRetryCountFromContext(ctx)
+
+	var scope metrics.Scope
+	if retryCount == -1 {
+		scope = c.metricsClient.Scope(metrics.FrontendClientListSemaphoresScope)
+	} else {
+		scope = c.metricsClient.Scope(metrics.FrontendClientListSemaphoresScope, metrics.IsRetryTag(retryCount > 0))
+	}
+
+	scope.IncCounter(metrics.CadenceClientRequests)
+
+	clientLatencyStart := time.Now()
+	sw := scope.StartTimer(metrics.CadenceClientLatency)
+	lp2, err = c.client.ListSemaphores(ctx, lp1, p1...)
+	sw.Stop()
+	scope.ExponentialHistogram(metrics.CadenceClientLatencyHistogram, time.Since(clientLatencyStart))
+
+	if err != nil {
+		scope.IncCounter(metrics.CadenceClientFailures)
+	}
+	return lp2, err
+}
+
 func (c *frontendClient) ListTaskListPartitions(ctx context.Context, lp1 *types.ListTaskListPartitionsRequest, p1 ...yarpc.CallOption) (lp2 *types.ListTaskListPartitionsResponse, err error) {
 	retryCount := getRetryCountFromContext(ctx)
 
@@ -605,6 +725,30 @@ func (c *frontendClient) ListWorkflowExecutions(ctx context.Context, lp1 *types.
 	return lp2, err
 }
 
+func (c *frontendClient) PauseActivity(ctx context.Context, pp1 *types.PauseActivityRequest, p1 ...yarpc.CallOption) (err error) {
+	retryCount := getRetryCountFromContext(ctx)
+
+	var scope metrics.Scope
+	if retryCount == -1 {
+		scope = c.metricsClient.Scope(metrics.FrontendClientPauseActivityScope)
+	} else {
+		scope = c.metricsClient.Scope(metrics.FrontendClientPauseActivityScope, metrics.IsRetryTag(retryCount > 0))
+	}
+
+	scope.IncCounter(metrics.CadenceClientRequests)
+
+	clientLatencyStart := time.Now()
+	sw := scope.StartTimer(metrics.CadenceClientLatency)
+	err = c.client.PauseActivity(ctx, pp1, p1...)
+	sw.Stop()
+	scope.ExponentialHistogram(metrics.CadenceClientLatencyHistogram, time.Since(clientLatencyStart))
+
+	if err != nil {
+		scope.IncCounter(metrics.CadenceClientFailures)
+	}
+	return err
+}
+
 func (c *frontendClient) PauseSchedule(ctx context.Context, pp1 *types.PauseScheduleRequest, p1 ...yarpc.CallOption) (pp2 *types.PauseScheduleResponse, err error) {
 	retryCount := getRetryCountFromContext(ctx)
 
@@ -629,6 +773,30 @@ func (c *frontendClient) PauseSchedule(ctx context.Context, pp1 *types.PauseSche
 	return pp2, err
 }
 
+func (c *frontendClient) PauseWorkflowExecution(ctx context.Context, pp1 *types.PauseWorkflowExecutionRequest, p1 ...yarpc.CallOption) (err error) {
+	retryCount := getRetryCountFromContext(ctx)
+
+	var scope metrics.Scope
+	if retryCount == -1 {
+		scope = c.metricsClient.Scope(metrics.FrontendClientPauseWorkflowExecutionScope)
+	} else {
+		scope = c.metricsClient.Scope(metrics.FrontendClientPauseWorkflowExecutionScope, metrics.IsRetryTag(retryCount > 0))
+	}
+
+	scope.IncCounter(metrics.CadenceClientRequests)
+
+	clientLatencyStart := time.Now()
+	sw := scope.StartTimer(metrics.CadenceClientLatency)
+	err = c.client.PauseWorkflowExecution(ctx, pp1, p1...)
+	sw.Stop()
+	scope.ExponentialHistogram(metrics.CadenceClientLatencyHistogram, time.Since(clientLatencyStart))
+
+	if err != nil {
+		scope.IncCounter(metrics.CadenceClientFailures)
+	}
+	return err
+}
+
 func (c *frontendClient) PollForActivityTask(ctx context.Context, pp1 *types.PollForActivityTaskRequest, p1 ...yarpc.CallOption) (pp2 *types.PollForActivityTaskResponse, err error) {
 	retryCount := getRetryCountFromContext(ctx)
 
@@ -821,6 +989,30 @@ func (c *frontendClient) RequestCancelWorkflowExecution(ctx context.Context, rp1
 	return err
 }
 
+func (c *frontendClient) ResetActivityAttempt(ctx context.Context, rp1 *types.ResetActivityAttemptRequest, p1 ...yarpc.CallOption) (err error) {
+	retryCount := getRetryCountFromContext(ctx)
+
+	var scope metrics.Scope
+	if retryCount == -1 {
+		scope = c.metricsClient.Scope(metrics.FrontendClientResetActivityAttemptScope)
+	} else {
+		scope = c.metricsClient.Scope(metrics.FrontendClientResetActivityAttemptScope, metrics.IsRetryTag(retryCount > 0))
+	}
+
+	scope.IncCounter(metrics.CadenceClientRequests)
+
+	clientLatencyStart := time.Now()
+	sw := scope.StartTimer(metrics.CadenceClientLatency)
+	err = c.client.ResetActivityAttempt(ctx, rp1, p1...)
+	sw.Stop()
+	scope.ExponentialHistogram(metrics.CadenceClientLatencyHistogram, time.Since(clientLatencyStart))
+
+	if err != nil {
+		scope.IncCounter(metrics.CadenceClientFailures)
+	}
+	return err
+}
+
 func (c *frontendClient) ResetStickyTaskList(ctx context.Context, rp1 *types.ResetStickyTaskListRequest, p1 ...yarpc.CallOption) (rp2 *types.ResetStickyTaskListResponse, err error) {
 	retryCount := getRetryCountFromContext(ctx)
 
@@ -1109,6 +1301,30 @@ func (c *frontendClient) RestartWorkflowExecution(ctx context.Context, rp1 *type
 	return rp2, err
 }
 
+func (c *frontendClient) RetryActivityNow(ctx context.Context, rp1 *types.RetryActivityNowRequest, p1 ...yarpc.CallOption) (err error) {
+	retryCount := getRetryCountFromContext(ctx)
+
+	var scope metrics.Scope
+	if retryCount == -1 {
+		scope = c.metricsClient.Scope(metrics.FrontendClientRetryActivityNowScope)
+	} else {
+		scope = c.metricsClient.Scope(metrics.FrontendClientRetryActivityNowScope, metrics.IsRetryTag(retryCount > 0))
+	}
+
+	scope.IncCounter(metrics.CadenceClientRequests)
+
+	clientLatencyStart := time.Now()
+	sw := scope.StartTimer(metrics.CadenceClientLatency)
+	err = c.client.RetryActivityNow(ctx, rp1, p1...)
+	sw.Stop()
+	scope.ExponentialHistogram(metrics.CadenceClientLatencyHistogram, time.Since(clientLatencyStart))
+
+	if err != nil {
+		scope.IncCounter(metrics.CadenceClientFailures)
+	}
+	return err
+}
+
 func (c *frontendClient) ScanWorkflowExecutions(ctx context.Context, lp1 *types.ListWorkflowExecutionsRequest, p1 ...yarpc.CallOption) (lp2 *types.ListWorkflowExecutionsResponse, err error) {
 	retryCount := getRetryCountFromContext(ctx)
 
@@ -1277,6 +1493,54 @@ func (c *frontendClient) TerminateWorkflowExecution(ctx context.Context, tp1 *ty
 	return err
 }
 
+func (c *frontendClient) TriggerSchedule(ctx context.Context, tp1 *types.TriggerScheduleRequest, p1 ...yarpc.CallOption) (tp2 *types.TriggerScheduleResponse, err error) {
+	retryCount := getRetryCountFromContext(ctx)
+
+	var scope metrics.Scope
+	if retryCount == -1 {
+		scope = c.metricsClient.Scope(metrics.FrontendClientTriggerScheduleScope)
+	} else {
+		scope = c.metricsClient.Scope(metrics.FrontendClientTriggerScheduleScope, metrics.IsRetryTag(retryCount > 0))
+	}
+
+	scope.IncCounter(metrics.CadenceClientRequests)
+
+	clientLatencyStart := time.Now()
+	sw := scope.StartTimer(metrics.CadenceClientLatency)
+	tp2, err = c.client.TriggerSchedule(ctx, tp1, p1...)
+	sw.Stop()
+	scope.ExponentialHistogram(metrics.CadenceClientLatencyHistogram, time.Since(clientLatencyStart))
+
+	if err != nil {
+		scope.IncCounter(metrics.CadenceClientFailures)
+	}
+	return tp2, err
+}
+
+func (c *frontendClient) UnpauseActivity(ctx context.Context, up1 *types.UnpauseActivityRequest, p1 ...yarpc.CallOption) (err error) {
+	retryCount := getRetryCountFromContext(ctx)
+
+	var scope metrics.Scope
+	if retryCount == -1 {
+		scope = c.metricsClient.Scope(metrics.FrontendClientUnpauseActivityScope)
+	} else {
+		scope = c.metricsClient.Scope(metrics.FrontendClientUnpauseActivityScope, metrics.IsRetryTag(retryCount > 0))
+	}
+
+	scope.IncCounter(metrics.CadenceClientRequests)
+
+	clientLatencyStart := time.Now()
+	sw := scope.StartTimer(metrics.CadenceClientLatency)
+	err = c.client.UnpauseActivity(ctx, up1, p1...)
+	sw.Stop()
+	scope.ExponentialHistogram(metrics.CadenceClientLatencyHistogram, time.Since(clientLatencyStart))
+
+	if err != nil {
+		scope.IncCounter(metrics.CadenceClientFailures)
+	}
+	return err
+}
+
 func (c *frontendClient) UnpauseSchedule(ctx context.Context, up1 *types.UnpauseScheduleRequest, p1 ...yarpc.CallOption) (up2 *types.UnpauseScheduleResponse, err error) {
 	retryCount := getRetryCountFromContext(ctx)
 
@@ -1301,6 +1565,30 @@ func (c *frontendClient) UnpauseSchedule(ctx context.Context, up1 *types.Unpause
 	return up2, err
 }
 
+func (c *frontendClient) UnpauseWorkflowExecution(ctx context.Context, up1 *types.UnpauseWorkflowExecutionRequest, p1 ...yarpc.CallOption) (err error) {
+	retryCount := getRetryCountFromContext(ctx)
+
+	var scope metrics.Scope
+	if retryCount == -1 {
+		scope = c.metricsClient.Scope(metrics.FrontendClientUnpauseWorkflowExecutionScope)
+	} else {
+		scope = c.metricsClient.Scope(metrics.FrontendClientUnpauseWorkflowExecutionScope, metrics.IsRetryTag(retryCount > 0))
+	}
+
+	scope.IncCounter(metrics.CadenceClientRequests)
+
+	clientLatencyStart := time.Now()
+	sw := scope.StartTimer(metrics.CadenceClientLatency)
+	err = c.client.UnpauseWorkflowExecution(ctx, up1, p1...)
+	sw.Stop()
+	scope.ExponentialHistogram(metrics.CadenceClientLatencyHistogram, time.Since(clientLatencyStart))
+
+	if err != nil {
+		scope.IncCounter(metrics.CadenceClientFailures)
+	}
+	return err
+}
+
 func (c *frontendClient) UpdateDomain(ctx context.Context, up1 *types.UpdateDomainRequest, p1 ...yarpc.CallOption) (up2 *types.UpdateDomainResponse, err error) {
 	retryCount := getRetryCountFromContext(ctx)
 
@@ -1348,3 +1636,27 @@ func (c *frontendClient) UpdateSchedule(ctx context.Context, up1 *types.UpdateSc
 	}
 	return up2, err
 }
+
+func (c *frontendClient) UpdateWorkflowExecution(ctx context.Context, up1 *types.UpdateWorkflowExecutionRequest, p1 ...yarpc.CallOption) (up2 *types.UpdateWorkflowExecutionResponse, err error) {
+	retryCount := getRetryCountFromContext(ctx)
+
+	var scope metrics.Scope
+	if retryCount == -1 {
+		scope = c.metricsClient.Scope(metrics.FrontendClientUpdateWorkflowExecutionScope)
+	} else {
+		scope = c.metricsClient.Scope(metrics.FrontendClientUpdateWorkflowExecutionScope, metrics.IsRetryTag(retryCount > 0))
+	}
+
+	scope.IncCounter(metrics.CadenceClientRequests)
+
+	clientLatencyStart := time.Now()
+	sw := scope.StartTimer(metrics.CadenceClientLatency)
+	up2, err = c.client.UpdateWorkflowExecution(ctx, up1, p1...)
+	sw.Stop()
+	scope.ExponentialHistogram(metrics.CadenceClientLatencyHistogram, time.Since(clientLatencyStart))
+
+	if err != nil {
+		scope.IncCounter(metrics.CadenceClientFailures)
+	}
+	return up2, err
+}
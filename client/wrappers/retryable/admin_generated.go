@@ -389,3 +389,10 @@ func (c *adminClient) UpdateTaskListPartitionConfig(ctx context.Context, request
 	err = c.throttleRetry.Do(ctx, op)
 	return resp, err
 }
+
+func (c *adminClient) UpsertWorkflowSearchAttributes(ctx context.Context, ap1 *types.AdminUpsertWorkflowSearchAttributesRequest, p1 ...yarpc.CallOption) (err error) {
+	op := func(ctx context.Context) error {
+		return c.client.UpsertWorkflowSearchAttributes(ctx, ap1, p1...)
+	}
+	return c.throttleRetry.Do(ctx, op)
+}
@@ -31,6 +31,17 @@ func NewFrontendClient(client _sourceFrontend.Client, policy backoff.RetryPolicy
 	}
 }
 
+func (c *frontendClient) AggregateWorkflowExecutions(ctx context.Context, ap1 *types.AggregateWorkflowExecutionsRequest, p1 ...yarpc.CallOption) (ap2 *types.AggregateWorkflowExecutionsResponse, err error) {
+	var resp *types.AggregateWorkflowExecutionsResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.AggregateWorkflowExecutions(ctx, ap1, p1...)
+		return err
+	}
+	err = c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
+
 func (c *frontendClient) BackfillSchedule(ctx context.Context, bp1 *types.BackfillScheduleRequest, p1 ...yarpc.CallOption) (bp2 *types.BackfillScheduleResponse, err error) {
 	var resp *types.BackfillScheduleResponse
 	op := func(ctx context.Context) error {
@@ -64,6 +75,17 @@ func (c *frontendClient) CreateSchedule(ctx context.Context, cp1 *types.CreateSc
 	return resp, err
 }
 
+func (c *frontendClient) CreateSemaphore(ctx context.Context, cp1 *types.CreateSemaphoreRequest, p1 ...yarpc.CallOption) (cp2 *types.CreateSemaphoreResponse, err error) {
+	var resp *types.CreateSemaphoreResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.CreateSemaphore(ctx, cp1, p1...)
+		return err
+	}
+	err = c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
+
 func (c *frontendClient) DeleteDomain(ctx context.Context, dp1 *types.DeleteDomainRequest, p1 ...yarpc.CallOption) (err error) {
 	op := func(ctx context.Context) error {
 		return c.client.DeleteDomain(ctx, dp1, p1...)
@@ -82,6 +104,13 @@ func (c *frontendClient) DeleteSchedule(ctx context.Context, dp1 *types.DeleteSc
 	return resp, err
 }
 
+func (c *frontendClient) DeleteWorkflowExecution(ctx context.Context, dp1 *types.DeleteWorkflowExecutionRequest, p1 ...yarpc.CallOption) (err error) {
+	op := func(ctx context.Context) error {
+		return c.client.DeleteWorkflowExecution(ctx, dp1, p1...)
+	}
+	return c.throttleRetry.Do(ctx, op)
+}
+
 func (c *frontendClient) DeprecateDomain(ctx context.Context, dp1 *types.DeprecateDomainRequest, p1 ...yarpc.CallOption) (err error) {
 	op := func(ctx context.Context) error {
 		return c.client.DeprecateDomain(ctx, dp1, p1...)
@@ -111,6 +140,17 @@ func (c *frontendClient) DescribeSchedule(ctx context.Context, dp1 *types.Descri
 	return resp, err
 }
 
+func (c *frontendClient) DescribeSemaphore(ctx context.Context, dp1 *types.DescribeSemaphoreRequest, p1 ...yarpc.CallOption) (dp2 *types.DescribeSemaphoreResponse, err error) {
+	var resp *types.DescribeSemaphoreResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.DescribeSemaphore(ctx, dp1, p1...)
+		return err
+	}
+	err = c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
+
 func (c *frontendClient) DescribeTaskList(ctx context.Context, dp1 *types.DescribeTaskListRequest, p1 ...yarpc.CallOption) (dp2 *types.DescribeTaskListResponse, err error) {
 	var resp *types.DescribeTaskListResponse
 	op := func(ctx context.Context) error {
@@ -265,6 +305,17 @@ func (c *frontendClient) ListSchedules(ctx context.Context, lp1 *types.ListSched
 	return resp, err
 }
 
+func (c *frontendClient) ListSemaphores(ctx context.Context, lp1 *types.ListSemaphoresRequest, p1 ...yarpc.CallOption) (lp2 *types.ListSemaphoresResponse, err error) {
+	var resp *types.ListSemaphoresResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ListSemaphores(ctx, lp1, p1...)
+		return err
+	}
+	err = c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
+
 func (c *frontendClient) ListTaskListPartitions(ctx context.Context, lp1 *types.ListTaskListPartitionsRequest, p1 ...yarpc.CallOption) (lp2 *types.ListTaskListPartitionsResponse, err error) {
 	var resp *types.ListTaskListPartitionsResponse
 	op := func(ctx context.Context) error {
@@ -287,6 +338,13 @@ func (c *frontendClient) ListWorkflowExecutions(ctx context.Context, lp1 *types.
 	return resp, err
 }
 
+func (c *frontendClient) PauseActivity(ctx context.Context, pp1 *types.PauseActivityRequest, p1 ...yarpc.CallOption) (err error) {
+	op := func(ctx context.Context) error {
+		return c.client.PauseActivity(ctx, pp1, p1...)
+	}
+	return c.throttleRetry.Do(ctx, op)
+}
+
 func (c *frontendClient) PauseSchedule(ctx context.Context, pp1 *types.PauseScheduleRequest, p1 ...yarpc.CallOption) (pp2 *types.PauseScheduleResponse, err error) {
 	var resp *types.PauseScheduleResponse
 	op := func(ctx context.Context) error {
@@ -298,6 +356,13 @@ func (c *frontendClient) PauseSchedule(ctx context.Context, pp1 *types.PauseSche
 	return resp, err
 }
 
+func (c *frontendClient) PauseWorkflowExecution(ctx context.Context, pp1 *types.PauseWorkflowExecutionRequest, p1 ...yarpc.CallOption) (err error) {
+	op := func(ctx context.Context) error {
+		return c.client.PauseWorkflowExecution(ctx, pp1, p1...)
+	}
+	return c.throttleRetry.Do(ctx, op)
+}
+
 func (c *frontendClient) PollForActivityTask(ctx context.Context, pp1 *types.PollForActivityTaskRequest, p1 ...yarpc.CallOption) (pp2 *types.PollForActivityTaskResponse, err error) {
 	var resp *types.PollForActivityTaskResponse
 	op := func(ctx context.Context) error {
@@ -374,6 +439,13 @@ func (c *frontendClient) RequestCancelWorkflowExecution(ctx context.Context, rp1
 	return c.throttleRetry.Do(ctx, op)
 }
 
+func (c *frontendClient) ResetActivityAttempt(ctx context.Context, rp1 *types.ResetActivityAttemptRequest, p1 ...yarpc.CallOption) (err error) {
+	op := func(ctx context.Context) error {
+		return c.client.ResetActivityAttempt(ctx, rp1, p1...)
+	}
+	return c.throttleRetry.Do(ctx, op)
+}
+
 func (c *frontendClient) ResetStickyTaskList(ctx context.Context, rp1 *types.ResetStickyTaskListRequest, p1 ...yarpc.CallOption) (rp2 *types.ResetStickyTaskListResponse, err error) {
 	var resp *types.ResetStickyTaskListResponse
 	op := func(ctx context.Context) error {
@@ -474,6 +546,13 @@ func (c *frontendClient) RestartWorkflowExecution(ctx context.Context, rp1 *type
 	return resp, err
 }
 
+func (c *frontendClient) RetryActivityNow(ctx context.Context, rp1 *types.RetryActivityNowRequest, p1 ...yarpc.CallOption) (err error) {
+	op := func(ctx context.Context) error {
+		return c.client.RetryActivityNow(ctx, rp1, p1...)
+	}
+	return c.throttleRetry.Do(ctx, op)
+}
+
 func (c *frontendClient) ScanWorkflowExecutions(ctx context.Context, lp1 *types.ListWorkflowExecutionsRequest, p1 ...yarpc.CallOption) (lp2 *types.ListWorkflowExecutionsResponse, err error) {
 	var resp *types.ListWorkflowExecutionsResponse
 	op := func(ctx context.Context) error {
@@ -543,6 +622,24 @@ func (c *frontendClient) TerminateWorkflowExecution(ctx context.Context, tp1 *ty
 	return c.throttleRetry.Do(ctx, op)
 }
 
+func (c *frontendClient) TriggerSchedule(ctx context.Context, tp1 *types.TriggerScheduleRequest, p1 ...yarpc.CallOption) (tp2 *types.TriggerScheduleResponse, err error) {
+	var resp *types.TriggerScheduleResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.TriggerSchedule(ctx, tp1, p1...)
+		return err
+	}
+	err = c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
+
+func (c *frontendClient) UnpauseActivity(ctx context.Context, up1 *types.UnpauseActivityRequest, p1 ...yarpc.CallOption) (err error) {
+	op := func(ctx context.Context) error {
+		return c.client.UnpauseActivity(ctx, up1, p1...)
+	}
+	return c.throttleRetry.Do(ctx, op)
+}
+
 func (c *frontendClient) UnpauseSchedule(ctx context.Context, up1 *types.UnpauseScheduleRequest, p1 ...yarpc.CallOption) (up2 *types.UnpauseScheduleResponse, err error) {
 	var resp *types.UnpauseScheduleResponse
 	op := func(ctx context.Context) error {
@@ -554,6 +651,13 @@ func (c *frontendClient) UnpauseSchedule(ctx context.Context, up1 *types.Unpause
 	return resp, err
 }
 
+func (c *frontendClient) UnpauseWorkflowExecution(ctx context.Context, up1 *types.UnpauseWorkflowExecutionRequest, p1 ...yarpc.CallOption) (err error) {
+	op := func(ctx context.Context) error {
+		return c.client.UnpauseWorkflowExecution(ctx, up1, p1...)
+	}
+	return c.throttleRetry.Do(ctx, op)
+}
+
 func (c *frontendClient) UpdateDomain(ctx context.Context, up1 *types.UpdateDomainRequest, p1 ...yarpc.CallOption) (up2 *types.UpdateDomainResponse, err error) {
 	var resp *types.UpdateDomainResponse
 	op := func(ctx context.Context) error {
@@ -575,3 +679,14 @@ func (c *frontendClient) UpdateSchedule(ctx context.Context, up1 *types.UpdateSc
 	err = c.throttleRetry.Do(ctx, op)
 	return resp, err
 }
+
+func (c *frontendClient) UpdateWorkflowExecution(ctx context.Context, up1 *types.UpdateWorkflowExecutionRequest, p1 ...yarpc.CallOption) (up2 *types.UpdateWorkflowExecutionResponse, err error) {
+	var resp *types.UpdateWorkflowExecutionResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.UpdateWorkflowExecution(ctx, up1, p1...)
+		return err
+	}
+	err = c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
@@ -49,6 +49,13 @@ func (c *historyClient) CountDLQMessages(ctx context.Context, cp1 *types.CountDL
 	return resp, err
 }
 
+func (c *historyClient) DeleteWorkflowExecution(ctx context.Context, hp1 *types.HistoryDeleteWorkflowExecutionRequest, p1 ...yarpc.CallOption) (err error) {
+	op := func(ctx context.Context) error {
+		return c.client.DeleteWorkflowExecution(ctx, hp1, p1...)
+	}
+	return c.throttleRetry.Do(ctx, op)
+}
+
 func (c *historyClient) DescribeHistoryHost(ctx context.Context, dp1 *types.DescribeHistoryHostRequest, p1 ...yarpc.CallOption) (dp2 *types.DescribeHistoryHostResponse, err error) {
 	var resp *types.DescribeHistoryHostResponse
 	op := func(ctx context.Context) error {
@@ -166,6 +173,20 @@ func (c *historyClient) NotifyFailoverMarkers(ctx context.Context, np1 *types.No
 	return c.throttleRetry.Do(ctx, op)
 }
 
+func (c *historyClient) PauseActivity(ctx context.Context, hp1 *types.HistoryPauseActivityRequest, p1 ...yarpc.CallOption) (err error) {
+	op := func(ctx context.Context) error {
+		return c.client.PauseActivity(ctx, hp1, p1...)
+	}
+	return c.throttleRetry.Do(ctx, op)
+}
+
+func (c *historyClient) PauseWorkflowExecution(ctx context.Context, hp1 *types.HistoryPauseWorkflowExecutionRequest, p1 ...yarpc.CallOption) (err error) {
+	op := func(ctx context.Context) error {
+		return c.client.PauseWorkflowExecution(ctx, hp1, p1...)
+	}
+	return c.throttleRetry.Do(ctx, op)
+}
+
 func (c *historyClient) PollMutableState(ctx context.Context, pp1 *types.PollMutableStateRequest, p1 ...yarpc.CallOption) (pp2 *types.PollMutableStateResponse, err error) {
 	var resp *types.PollMutableStateResponse
 	op := func(ctx context.Context) error {
@@ -285,6 +306,13 @@ func (c *historyClient) RemoveTask(ctx context.Context, rp1 *types.RemoveTaskReq
 	return c.throttleRetry.Do(ctx, op)
 }
 
+func (c *historyClient) ReplicateDeleteWorkflowExecution(ctx context.Context, dp1 *types.DeleteWorkflowExecutionTaskAttributes, p1 ...yarpc.CallOption) (err error) {
+	op := func(ctx context.Context) error {
+		return c.client.ReplicateDeleteWorkflowExecution(ctx, dp1, p1...)
+	}
+	return c.throttleRetry.Do(ctx, op)
+}
+
 func (c *historyClient) ReplicateEventsV2(ctx context.Context, rp1 *types.ReplicateEventsV2Request, p1 ...yarpc.CallOption) (err error) {
 	op := func(ctx context.Context) error {
 		return c.client.ReplicateEventsV2(ctx, rp1, p1...)
@@ -292,6 +320,13 @@ func (c *historyClient) ReplicateEventsV2(ctx context.Context, rp1 *types.Replic
 	return c.throttleRetry.Do(ctx, op)
 }
 
+func (c *historyClient) ReplicateUpsertWorkflowSearchAttributes(ctx context.Context, up1 *types.UpsertWorkflowSearchAttributesTaskAttributes, p1 ...yarpc.CallOption) (err error) {
+	op := func(ctx context.Context) error {
+		return c.client.ReplicateUpsertWorkflowSearchAttributes(ctx, up1, p1...)
+	}
+	return c.throttleRetry.Do(ctx, op)
+}
+
 func (c *historyClient) RequestCancelWorkflowExecution(ctx context.Context, hp1 *types.HistoryRequestCancelWorkflowExecutionRequest, p1 ...yarpc.CallOption) (err error) {
 	op := func(ctx context.Context) error {
 		return c.client.RequestCancelWorkflowExecution(ctx, hp1, p1...)
@@ -299,6 +334,13 @@ func (c *historyClient) RequestCancelWorkflowExecution(ctx context.Context, hp1
 	return c.throttleRetry.Do(ctx, op)
 }
 
+func (c *historyClient) ResetActivityAttempt(ctx context.Context, hp1 *types.HistoryResetActivityAttemptRequest, p1 ...yarpc.CallOption) (err error) {
+	op := func(ctx context.Context) error {
+		return c.client.ResetActivityAttempt(ctx, hp1, p1...)
+	}
+	return c.throttleRetry.Do(ctx, op)
+}
+
 func (c *historyClient) ResetQueue(ctx context.Context, rp1 *types.ResetQueueRequest, p1 ...yarpc.CallOption) (err error) {
 	op := func(ctx context.Context) error {
 		return c.client.ResetQueue(ctx, rp1, p1...)
@@ -378,6 +420,13 @@ func (c *historyClient) RespondDecisionTaskFailed(ctx context.Context, hp1 *type
 	return c.throttleRetry.Do(ctx, op)
 }
 
+func (c *historyClient) RetryActivityNow(ctx context.Context, hp1 *types.HistoryRetryActivityNowRequest, p1 ...yarpc.CallOption) (err error) {
+	op := func(ctx context.Context) error {
+		return c.client.RetryActivityNow(ctx, hp1, p1...)
+	}
+	return c.throttleRetry.Do(ctx, op)
+}
+
 func (c *historyClient) ScheduleDecisionTask(ctx context.Context, sp1 *types.ScheduleDecisionTaskRequest, p1 ...yarpc.CallOption) (err error) {
 	op := func(ctx context.Context) error {
 		return c.client.ScheduleDecisionTask(ctx, sp1, p1...)
@@ -434,3 +483,35 @@ func (c *historyClient) TerminateWorkflowExecution(ctx context.Context, hp1 *typ
 	}
 	return c.throttleRetry.Do(ctx, op)
 }
+
+func (c *historyClient) UnpauseActivity(ctx context.Context, hp1 *types.HistoryUnpauseActivityRequest, p1 ...yarpc.CallOption) (err error) {
+	op := func(ctx context.Context) error {
+		return c.client.UnpauseActivity(ctx, hp1, p1...)
+	}
+	return c.throttleRetry.Do(ctx, op)
+}
+
+func (c *historyClient) UnpauseWorkflowExecution(ctx context.Context, hp1 *types.HistoryUnpauseWorkflowExecutionRequest, p1 ...yarpc.CallOption) (err error) {
+	op := func(ctx context.Context) error {
+		return c.client.UnpauseWorkflowExecution(ctx, hp1, p1...)
+	}
+	return c.throttleRetry.Do(ctx, op)
+}
+
+func (c *historyClient) UpdateWorkflowExecution(ctx context.Context, hp1 *types.HistoryUpdateWorkflowExecutionRequest, p1 ...yarpc.CallOption) (up1 *types.UpdateWorkflowExecutionResponse, err error) {
+	var resp *types.UpdateWorkflowExecutionResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.UpdateWorkflowExecution(ctx, hp1, p1...)
+		return err
+	}
+	err = c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
+
+func (c *historyClient) UpsertWorkflowSearchAttributes(ctx context.Context, hp1 *types.HistoryUpsertWorkflowSearchAttributesRequest, p1 ...yarpc.CallOption) (err error) {
+	op := func(ctx context.Context) error {
+		return c.client.UpsertWorkflowSearchAttributes(ctx, hp1, p1...)
+	}
+	return c.throttleRetry.Do(ctx, op)
+}
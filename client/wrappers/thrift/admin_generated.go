@@ -195,3 +195,8 @@ func (g adminClient) UpdateOperationalDynamicConfig(ctx context.Context, up1 *ty
 func (g adminClient) UpdateTaskListPartitionConfig(ctx context.Context, request *types.UpdateTaskListPartitionConfigRequest, opts ...yarpc.CallOption) (up1 *types.UpdateTaskListPartitionConfigResponse, err error) {
 	return nil, thrift.ToError(&types.BadRequestError{Message: "Feature not supported on TChannel"})
 }
+
+func (g adminClient) UpsertWorkflowSearchAttributes(ctx context.Context, ap1 *types.AdminUpsertWorkflowSearchAttributesRequest, p1 ...yarpc.CallOption) (err error) {
+	err = g.c.UpsertWorkflowSearchAttributes(ctx, thrift.FromAdminUpsertWorkflowSearchAttributesRequest(ap1), p1...)
+	return thrift.ToError(err)
+}
@@ -13,6 +13,11 @@ import (
 	"github.com/uber/cadence/common/types/mapper/thrift"
 )
 
+func (g frontendClient) AggregateWorkflowExecutions(ctx context.Context, ap1 *types.AggregateWorkflowExecutionsRequest, p1 ...yarpc.CallOption) (ap2 *types.AggregateWorkflowExecutionsResponse, err error) {
+	response, err := g.c.AggregateWorkflowExecutions(ctx, thrift.FromAggregateWorkflowExecutionsRequest(ap1), p1...)
+	return thrift.ToAggregateWorkflowExecutionsResponse(response), thrift.ToError(err)
+}
+
 func (g frontendClient) BackfillSchedule(ctx context.Context, bp1 *types.BackfillScheduleRequest, p1 ...yarpc.CallOption) (bp2 *types.BackfillScheduleResponse, err error) {
 	return nil, thrift.ToError(&types.BadRequestError{Message: "Feature not supported on TChannel"})
 }
@@ -26,6 +31,11 @@ func (g frontendClient) CreateSchedule(ctx context.Context, cp1 *types.CreateSch
 	return nil, thrift.ToError(&types.BadRequestError{Message: "Feature not supported on TChannel"})
 }
 
+func (g frontendClient) CreateSemaphore(ctx context.Context, cp1 *types.CreateSemaphoreRequest, p1 ...yarpc.CallOption) (cp2 *types.CreateSemaphoreResponse, err error) {
+	response, err := g.c.CreateSemaphore(ctx, thrift.FromCreateSemaphoreRequest(cp1), p1...)
+	return thrift.ToCreateSemaphoreResponse(response), thrift.ToError(err)
+}
+
 func (g frontendClient) DeleteDomain(ctx context.Context, dp1 *types.DeleteDomainRequest, p1 ...yarpc.CallOption) (err error) {
 	err = g.c.DeleteDomain(ctx, thrift.FromDeleteDomainRequest(dp1), p1...)
 	return thrift.ToError(err)
@@ -35,6 +45,11 @@ func (g frontendClient) DeleteSchedule(ctx context.Context, dp1 *types.DeleteSch
 	return nil, thrift.ToError(&types.BadRequestError{Message: "Feature not supported on TChannel"})
 }
 
+func (g frontendClient) DeleteWorkflowExecution(ctx context.Context, dp1 *types.DeleteWorkflowExecutionRequest, p1 ...yarpc.CallOption) (err error) {
+	err = g.c.DeleteWorkflowExecution(ctx, thrift.FromDeleteWorkflowExecutionRequest(dp1), p1...)
+	return thrift.ToError(err)
+}
+
 func (g frontendClient) DeprecateDomain(ctx context.Context, dp1 *types.DeprecateDomainRequest, p1 ...yarpc.CallOption) (err error) {
 	err = g.c.DeprecateDomain(ctx, thrift.FromDeprecateDomainRequest(dp1), p1...)
 	return thrift.ToError(err)
@@ -49,6 +64,11 @@ func (g frontendClient) DescribeSchedule(ctx context.Context, dp1 *types.Describ
 	return nil, thrift.ToError(&types.BadRequestError{Message: "Feature not supported on TChannel"})
 }
 
+func (g frontendClient) DescribeSemaphore(ctx context.Context, dp1 *types.DescribeSemaphoreRequest, p1 ...yarpc.CallOption) (dp2 *types.DescribeSemaphoreResponse, err error) {
+	response, err := g.c.DescribeSemaphore(ctx, thrift.FromDescribeSemaphoreRequest(dp1), p1...)
+	return thrift.ToDescribeSemaphoreResponse(response), thrift.ToError(err)
+}
+
 func (g frontendClient) DescribeTaskList(ctx context.Context, dp1 *types.DescribeTaskListRequest, p1 ...yarpc.CallOption) (dp2 *types.DescribeTaskListResponse, err error) {
 	response, err := g.c.DescribeTaskList(ctx, thrift.FromDescribeTaskListRequest(dp1), p1...)
 	return thrift.ToDescribeTaskListResponse(response), thrift.ToError(err)
@@ -118,6 +138,11 @@ func (g frontendClient) ListSchedules(ctx context.Context, lp1 *types.ListSchedu
 	return nil, thrift.ToError(&types.BadRequestError{Message: "Feature not supported on TChannel"})
 }
 
+func (g frontendClient) ListSemaphores(ctx context.Context, lp1 *types.ListSemaphoresRequest, p1 ...yarpc.CallOption) (lp2 *types.ListSemaphoresResponse, err error) {
+	response, err := g.c.ListSemaphores(ctx, thrift.FromListSemaphoresRequest(lp1), p1...)
+	return thrift.ToListSemaphoresResponse(response), thrift.ToError(err)
+}
+
 func (g frontendClient) ListTaskListPartitions(ctx context.Context, lp1 *types.ListTaskListPartitionsRequest, p1 ...yarpc.CallOption) (lp2 *types.ListTaskListPartitionsResponse, err error) {
 	response, err := g.c.ListTaskListPartitions(ctx, thrift.FromListTaskListPartitionsRequest(lp1), p1...)
 	return thrift.ToListTaskListPartitionsResponse(response), thrift.ToError(err)
@@ -128,10 +153,20 @@ func (g frontendClient) ListWorkflowExecutions(ctx context.Context, lp1 *types.L
 	return thrift.ToListWorkflowExecutionsResponse(response), thrift.ToError(err)
 }
 
+func (g frontendClient) PauseActivity(ctx context.Context, pp1 *types.PauseActivityRequest, p1 ...yarpc.CallOption) (err error) {
+	err = g.c.PauseActivity(ctx, thrift.FromPauseActivityRequest(pp1), p1...)
+	return thrift.ToError(err)
+}
+
 func (g frontendClient) PauseSchedule(ctx context.Context, pp1 *types.PauseScheduleRequest, p1 ...yarpc.CallOption) (pp2 *types.PauseScheduleResponse, err error) {
 	return nil, thrift.ToError(&types.BadRequestError{Message: "Feature not supported on TChannel"})
 }
 
+func (g frontendClient) PauseWorkflowExecution(ctx context.Context, pp1 *types.PauseWorkflowExecutionRequest, p1 ...yarpc.CallOption) (err error) {
+	err = g.c.PauseWorkflowExecution(ctx, thrift.FromPauseWorkflowExecutionRequest(pp1), p1...)
+	return thrift.ToError(err)
+}
+
 func (g frontendClient) PollForActivityTask(ctx context.Context, pp1 *types.PollForActivityTaskRequest, p1 ...yarpc.CallOption) (pp2 *types.PollForActivityTaskResponse, err error) {
 	response, err := g.c.PollForActivityTask(ctx, thrift.FromPollForActivityTaskRequest(pp1), p1...)
 	return thrift.ToPollForActivityTaskResponse(response), thrift.ToError(err)
@@ -172,6 +207,11 @@ func (g frontendClient) RequestCancelWorkflowExecution(ctx context.Context, rp1
 	return thrift.ToError(err)
 }
 
+func (g frontendClient) ResetActivityAttempt(ctx context.Context, rp1 *types.ResetActivityAttemptRequest, p1 ...yarpc.CallOption) (err error) {
+	err = g.c.ResetActivityAttempt(ctx, thrift.FromResetActivityAttemptRequest(rp1), p1...)
+	return thrift.ToError(err)
+}
+
 func (g frontendClient) ResetStickyTaskList(ctx context.Context, rp1 *types.ResetStickyTaskListRequest, p1 ...yarpc.CallOption) (rp2 *types.ResetStickyTaskListResponse, err error) {
 	response, err := g.c.ResetStickyTaskList(ctx, thrift.FromResetStickyTaskListRequest(rp1), p1...)
 	return thrift.ToResetStickyTaskListResponse(response), thrift.ToError(err)
@@ -232,6 +272,11 @@ func (g frontendClient) RestartWorkflowExecution(ctx context.Context, rp1 *types
 	return thrift.ToRestartWorkflowExecutionResponse(response), thrift.ToError(err)
 }
 
+func (g frontendClient) RetryActivityNow(ctx context.Context, rp1 *types.RetryActivityNowRequest, p1 ...yarpc.CallOption) (err error) {
+	err = g.c.RetryActivityNow(ctx, thrift.FromRetryActivityNowRequest(rp1), p1...)
+	return thrift.ToError(err)
+}
+
 func (g frontendClient) ScanWorkflowExecutions(ctx context.Context, lp1 *types.ListWorkflowExecutionsRequest, p1 ...yarpc.CallOption) (lp2 *types.ListWorkflowExecutionsResponse, err error) {
 	response, err := g.c.ScanWorkflowExecutions(ctx, thrift.FromScanWorkflowExecutionsRequest(lp1), p1...)
 	return thrift.ToScanWorkflowExecutionsResponse(response), thrift.ToError(err)
@@ -267,10 +312,25 @@ func (g frontendClient) TerminateWorkflowExecution(ctx context.Context, tp1 *typ
 	return thrift.ToError(err)
 }
 
+func (g frontendClient) TriggerSchedule(ctx context.Context, tp1 *types.TriggerScheduleRequest, p1 ...yarpc.CallOption) (tp2 *types.TriggerScheduleResponse, err error) {
+	response, err := g.c.TriggerSchedule(ctx, thrift.FromTriggerScheduleRequest(tp1), p1...)
+	return thrift.ToTriggerScheduleResponse(response), thrift.ToError(err)
+}
+
+func (g frontendClient) UnpauseActivity(ctx context.Context, up1 *types.UnpauseActivityRequest, p1 ...yarpc.CallOption) (err error) {
+	err = g.c.UnpauseActivity(ctx, thrift.FromUnpauseActivityRequest(up1), p1...)
+	return thrift.ToError(err)
+}
+
 func (g frontendClient) UnpauseSchedule(ctx context.Context, up1 *types.UnpauseScheduleRequest, p1 ...yarpc.CallOption) (up2 *types.UnpauseScheduleResponse, err error) {
 	return nil, thrift.ToError(&types.BadRequestError{Message: "Feature not supported on TChannel"})
 }
 
+func (g frontendClient) UnpauseWorkflowExecution(ctx context.Context, up1 *types.UnpauseWorkflowExecutionRequest, p1 ...yarpc.CallOption) (err error) {
+	err = g.c.UnpauseWorkflowExecution(ctx, thrift.FromUnpauseWorkflowExecutionRequest(up1), p1...)
+	return thrift.ToError(err)
+}
+
 func (g frontendClient) UpdateDomain(ctx context.Context, up1 *types.UpdateDomainRequest, p1 ...yarpc.CallOption) (up2 *types.UpdateDomainResponse, err error) {
 	response, err := g.c.UpdateDomain(ctx, thrift.FromUpdateDomainRequest(up1), p1...)
 	return thrift.ToUpdateDomainResponse(response), thrift.ToError(err)
@@ -279,3 +339,8 @@ func (g frontendClient) UpdateDomain(ctx context.Context, up1 *types.UpdateDomai
 func (g frontendClient) UpdateSchedule(ctx context.Context, up1 *types.UpdateScheduleRequest, p1 ...yarpc.CallOption) (up2 *types.UpdateScheduleResponse, err error) {
 	return nil, thrift.ToError(&types.BadRequestError{Message: "Feature not supported on TChannel"})
 }
+
+func (g frontendClient) UpdateWorkflowExecution(ctx context.Context, up1 *types.UpdateWorkflowExecutionRequest, p1 ...yarpc.CallOption) (up2 *types.UpdateWorkflowExecutionResponse, err error) {
+	response, err := g.c.UpdateWorkflowExecution(ctx, thrift.FromUpdateWorkflowExecutionRequest(up1), p1...)
+	return thrift.ToUpdateWorkflowExecutionResponse(response), thrift.ToError(err)
+}
@@ -22,6 +22,11 @@ func (g historyClient) CountDLQMessages(ctx context.Context, cp1 *types.CountDLQ
 	return nil, thrift.ToError(&types.BadRequestError{Message: "Feature not supported on TChannel"})
 }
 
+func (g historyClient) DeleteWorkflowExecution(ctx context.Context, hp1 *types.HistoryDeleteWorkflowExecutionRequest, p1 ...yarpc.CallOption) (err error) {
+	err = g.c.DeleteWorkflowExecution(ctx, thrift.FromHistoryDeleteWorkflowExecutionRequest(hp1), p1...)
+	return thrift.ToError(err)
+}
+
 func (g historyClient) DescribeHistoryHost(ctx context.Context, dp1 *types.DescribeHistoryHostRequest, p1 ...yarpc.CallOption) (dp2 *types.DescribeHistoryHostResponse, err error) {
 	response, err := g.c.DescribeHistoryHost(ctx, thrift.FromHistoryDescribeHistoryHostRequest(dp1), p1...)
 	return thrift.ToHistoryDescribeHistoryHostResponse(response), thrift.ToError(err)
@@ -77,6 +82,16 @@ func (g historyClient) NotifyFailoverMarkers(ctx context.Context, np1 *types.Not
 	return thrift.ToError(err)
 }
 
+func (g historyClient) PauseActivity(ctx context.Context, hp1 *types.HistoryPauseActivityRequest, p1 ...yarpc.CallOption) (err error) {
+	err = g.c.PauseActivity(ctx, thrift.FromHistoryPauseActivityRequest(hp1), p1...)
+	return thrift.ToError(err)
+}
+
+func (g historyClient) PauseWorkflowExecution(ctx context.Context, hp1 *types.HistoryPauseWorkflowExecutionRequest, p1 ...yarpc.CallOption) (err error) {
+	err = g.c.PauseWorkflowExecution(ctx, thrift.FromHistoryPauseWorkflowExecutionRequest(hp1), p1...)
+	return thrift.ToError(err)
+}
+
 func (g historyClient) PollMutableState(ctx context.Context, pp1 *types.PollMutableStateRequest, p1 ...yarpc.CallOption) (pp2 *types.PollMutableStateResponse, err error) {
 	response, err := g.c.PollMutableState(ctx, thrift.FromHistoryPollMutableStateRequest(pp1), p1...)
 	return thrift.ToHistoryPollMutableStateResponse(response), thrift.ToError(err)
@@ -142,16 +157,31 @@ func (g historyClient) RemoveTask(ctx context.Context, rp1 *types.RemoveTaskRequ
 	return thrift.ToError(err)
 }
 
+func (g historyClient) ReplicateDeleteWorkflowExecution(ctx context.Context, dp1 *types.DeleteWorkflowExecutionTaskAttributes, p1 ...yarpc.CallOption) (err error) {
+	err = g.c.ReplicateDeleteWorkflowExecution(ctx, thrift.FromHistoryReplicateDeleteWorkflowExecutionRequest(dp1), p1...)
+	return thrift.ToError(err)
+}
+
 func (g historyClient) ReplicateEventsV2(ctx context.Context, rp1 *types.ReplicateEventsV2Request, p1 ...yarpc.CallOption) (err error) {
 	err = g.c.ReplicateEventsV2(ctx, thrift.FromHistoryReplicateEventsV2Request(rp1), p1...)
 	return thrift.ToError(err)
 }
 
+func (g historyClient) ReplicateUpsertWorkflowSearchAttributes(ctx context.Context, up1 *types.UpsertWorkflowSearchAttributesTaskAttributes, p1 ...yarpc.CallOption) (err error) {
+	err = g.c.ReplicateUpsertWorkflowSearchAttributes(ctx, thrift.FromHistoryReplicateUpsertWorkflowSearchAttributesRequest(up1), p1...)
+	return thrift.ToError(err)
+}
+
 func (g historyClient) RequestCancelWorkflowExecution(ctx context.Context, hp1 *types.HistoryRequestCancelWorkflowExecutionRequest, p1 ...yarpc.CallOption) (err error) {
 	err = g.c.RequestCancelWorkflowExecution(ctx, thrift.FromHistoryRequestCancelWorkflowExecutionRequest(hp1), p1...)
 	return thrift.ToError(err)
 }
 
+func (g historyClient) ResetActivityAttempt(ctx context.Context, hp1 *types.HistoryResetActivityAttemptRequest, p1 ...yarpc.CallOption) (err error) {
+	err = g.c.ResetActivityAttempt(ctx, thrift.FromHistoryResetActivityAttemptRequest(hp1), p1...)
+	return thrift.ToError(err)
+}
+
 func (g historyClient) ResetQueue(ctx context.Context, rp1 *types.ResetQueueRequest, p1 ...yarpc.CallOption) (err error) {
 	err = g.c.ResetQueue(ctx, thrift.FromHistoryResetQueueRequest(rp1), p1...)
 	return thrift.ToError(err)
@@ -197,6 +227,11 @@ func (g historyClient) RespondDecisionTaskFailed(ctx context.Context, hp1 *types
 	return thrift.ToError(err)
 }
 
+func (g historyClient) RetryActivityNow(ctx context.Context, hp1 *types.HistoryRetryActivityNowRequest, p1 ...yarpc.CallOption) (err error) {
+	err = g.c.RetryActivityNow(ctx, thrift.FromHistoryRetryActivityNowRequest(hp1), p1...)
+	return thrift.ToError(err)
+}
+
 func (g historyClient) ScheduleDecisionTask(ctx context.Context, sp1 *types.ScheduleDecisionTaskRequest, p1 ...yarpc.CallOption) (err error) {
 	err = g.c.ScheduleDecisionTask(ctx, thrift.FromHistoryScheduleDecisionTaskRequest(sp1), p1...)
 	return thrift.ToError(err)
@@ -231,3 +266,23 @@ func (g historyClient) TerminateWorkflowExecution(ctx context.Context, hp1 *type
 	err = g.c.TerminateWorkflowExecution(ctx, thrift.FromHistoryTerminateWorkflowExecutionRequest(hp1), p1...)
 	return thrift.ToError(err)
 }
+
+func (g historyClient) UnpauseActivity(ctx context.Context, hp1 *types.HistoryUnpauseActivityRequest, p1 ...yarpc.CallOption) (err error) {
+	err = g.c.UnpauseActivity(ctx, thrift.FromHistoryUnpauseActivityRequest(hp1), p1...)
+	return thrift.ToError(err)
+}
+
+func (g historyClient) UnpauseWorkflowExecution(ctx context.Context, hp1 *types.HistoryUnpauseWorkflowExecutionRequest, p1 ...yarpc.CallOption) (err error) {
+	err = g.c.UnpauseWorkflowExecution(ctx, thrift.FromHistoryUnpauseWorkflowExecutionRequest(hp1), p1...)
+	return thrift.ToError(err)
+}
+
+func (g historyClient) UpdateWorkflowExecution(ctx context.Context, hp1 *types.HistoryUpdateWorkflowExecutionRequest, p1 ...yarpc.CallOption) (up1 *types.UpdateWorkflowExecutionResponse, err error) {
+	response, err := g.c.UpdateWorkflowExecution(ctx, thrift.FromHistoryUpdateWorkflowExecutionRequest(hp1), p1...)
+	return thrift.ToHistoryUpdateWorkflowExecutionResponse(response), thrift.ToError(err)
+}
+
+func (g historyClient) UpsertWorkflowSearchAttributes(ctx context.Context, hp1 *types.HistoryUpsertWorkflowSearchAttributesRequest, p1 ...yarpc.CallOption) (err error) {
+	err = g.c.UpsertWorkflowSearchAttributes(ctx, thrift.FromHistoryUpsertWorkflowSearchAttributesRequest(hp1), p1...)
+	return thrift.ToError(err)
+}
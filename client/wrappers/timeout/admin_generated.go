@@ -257,3 +257,9 @@ func (c *adminClient) UpdateTaskListPartitionConfig(ctx context.Context, request
 	defer cancel()
 	return c.client.UpdateTaskListPartitionConfig(ctx, request, opts...)
 }
+
+func (c *adminClient) UpsertWorkflowSearchAttributes(ctx context.Context, ap1 *types.AdminUpsertWorkflowSearchAttributesRequest, p1 ...yarpc.CallOption) (err error) {
+	ctx, cancel := createContext(ctx, c.timeout)
+	defer cancel()
+	return c.client.UpsertWorkflowSearchAttributes(ctx, ap1, p1...)
+}
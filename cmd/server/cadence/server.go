@@ -22,6 +22,7 @@ package cadence
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"time"
@@ -44,9 +45,12 @@ import (
 
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/archiver"
+	gcsblobstore "github.com/uber/cadence/common/archiver/gcloud/blobstore"
 	"github.com/uber/cadence/common/archiver/provider"
 	"github.com/uber/cadence/common/asyncworkflow/queue"
+	"github.com/uber/cadence/common/blobstore"
 	"github.com/uber/cadence/common/blobstore/filestore"
+	"github.com/uber/cadence/common/blobstore/s3store"
 	"github.com/uber/cadence/common/clock"
 	"github.com/uber/cadence/common/cluster"
 	"github.com/uber/cadence/common/config"
@@ -296,9 +300,9 @@ func (s *server) startService() common.Daemon {
 	params.ArchivalMetadata = s.archivalMetadata
 	params.ArchiverProvider = s.archiverProvider
 	params.AuthorizationConfig = s.cfg.Authorization
-	params.BlobstoreClient, err = filestore.NewFilestoreClient(s.cfg.Blobstore.Filestore)
+	params.BlobstoreClient, err = newBlobstoreClient(s.cfg.Blobstore)
 	if err != nil {
-		s.logger.Warn("failed to create file blobstore client, will continue startup without it: %v", tag.Error(err))
+		s.logger.Warn("failed to create blobstore client, will continue startup without it: %v", tag.Error(err))
 		params.BlobstoreClient = nil
 	}
 
@@ -481,6 +485,27 @@ func (s *server) setupOSClient(params *resource.Params, advancedVisStore config.
 	}
 }
 
+// newBlobstoreClient creates the client of the configured blobstore, it falls back to the file blobstore
+// when no object store is configured
+func newBlobstoreClient(cfg config.Blobstore) (blobstore.Client, error) {
+	var client blobstore.Client
+	var err error
+	switch {
+	case cfg.S3 != nil && cfg.GCS != nil:
+		return nil, errors.New("only one of s3 and gcs blobstore can be configured")
+	case cfg.S3 != nil:
+		client, err = s3store.NewS3Client(cfg.S3)
+	case cfg.GCS != nil:
+		client, err = gcsblobstore.NewGCSClient(context.Background(), cfg.GCS)
+	default:
+		client, err = filestore.NewFilestoreClient(cfg.Filestore)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return blobstore.NewRetryableClient(client, common.CreateBlobstoreRetryPolicy()), nil
+}
+
 func validateIndex(config *config.ElasticSearchConfig) error {
 	indexName, ok := config.Indices[constants.VisibilityAppName]
 	if !ok || len(indexName) == 0 {
@@ -177,3 +177,26 @@ func TestSettingGettingZonalIsolationGroupsFromIGError(t *testing.T) {
 		}, dc)()
 	})
 }
+
+func TestNewBlobstoreClient(t *testing.T) {
+	_, err := newBlobstoreClient(config.Blobstore{})
+	assert.Error(t, err)
+
+	client, err := newBlobstoreClient(config.Blobstore{
+		Filestore: &config.FileBlobstore{OutputDirectory: t.TempDir()},
+	})
+	assert.NoError(t, err)
+	assert.NotNil(t, client)
+
+	client, err = newBlobstoreClient(config.Blobstore{
+		S3: &config.S3Blobstore{Bucket: "bucket", Region: "us-east-1"},
+	})
+	assert.NoError(t, err)
+	assert.NotNil(t, client)
+
+	_, err = newBlobstoreClient(config.Blobstore{
+		S3:  &config.S3Blobstore{Bucket: "bucket", Region: "us-east-1"},
+		GCS: &config.GCSBlobstore{Bucket: "bucket"},
+	})
+	assert.Error(t, err)
+}
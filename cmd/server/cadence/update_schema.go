@@ -71,6 +71,7 @@ func newPersistenceFactory(cfg config.Config, logger log.Logger) persistenceClie
 		nil, // no metrics client needed for schema updates
 		logger,
 		persistence.NewDynamicConfiguration(dc),
+		nil, // history is not read or written by schema updates
 	)
 }
 
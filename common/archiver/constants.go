@@ -53,6 +53,10 @@ var (
 	ErrContextTimeout = errors.New("archive aborted because context timed out")
 	// ErrInvalidGetHistoryRequest is the error for invalid GetHistory request
 	ErrInvalidGetHistoryRequest = errors.New("get archived history request is invalid")
+	// ErrInvalidDeleteHistoryRequest is the error for invalid DeleteHistory request
+	ErrInvalidDeleteHistoryRequest = errors.New("delete archived history request is invalid")
+	// ErrInvalidDeleteVisibilityRequest is the error for invalid DeleteVisibility request
+	ErrInvalidDeleteVisibilityRequest = errors.New("delete archived visibility request is invalid")
 	// ErrInvalidQueryVisibilityRequest is the error for invalid Query Visibility request
 	ErrInvalidQueryVisibilityRequest = errors.New("query visiblity request is invalid")
 	// ErrNextPageTokenCorrupted is the error for corrupted GetHistory token
@@ -22,7 +22,9 @@
 
 // Each Archive() request results in a file named in the format of
 // hash(domainID, workflowID, runID)_version.history being created in the specified
-// directory. Workflow histories stored in that file are encoded in JSON format.
+// directory. Workflow histories stored in that file are encoded in JSON format, optionally
+// wrapped in the versioned archival format which adds compression and a checksum.
+// Get() reads both formats, ReencodeHistory() rewrites existing files to another format.
 
 // The Get() method retrieves the archived histories from the directory specified in the
 // URI. It optionally takes in a NextPageToken which specifies the workflow close failover
@@ -32,14 +34,18 @@
 // of NextPageToken or close failover version is specified, the highest close failover version
 // will be picked.
 
+// The Delete() method removes the files of every close failover version of a workflow run.
+
 package filestore
 
 import (
 	"context"
 	"errors"
+	"fmt"
 	"os"
 	"path"
 	"strconv"
+	"strings"
 
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/archiver"
@@ -59,6 +65,8 @@ const (
 	errMakeDirectory = "failed to make directory"
 	errWriteFile     = "failed to write history to file"
 
+	historyFileSuffix = ".history"
+
 	targetHistoryBlobSize = 2 * 1024 * 1024 // 2MB
 )
 
@@ -69,9 +77,10 @@ var (
 
 type (
 	historyArchiver struct {
-		container *archiver.HistoryBootstrapContainer
-		fileMode  os.FileMode
-		dirMode   os.FileMode
+		container   *archiver.HistoryBootstrapContainer
+		fileMode    os.FileMode
+		dirMode     os.FileMode
+		compression archiver.ArchiveCompression
 
 		// only set in test code
 		historyIterator archiver.HistoryIterator
@@ -104,10 +113,15 @@ func newHistoryArchiver(
 	if err != nil {
 		return nil, errInvalidDirMode
 	}
+	compression, err := archiver.ParseArchiveCompression(config.Compression)
+	if err != nil {
+		return nil, err
+	}
 	return &historyArchiver{
 		container:       container,
 		fileMode:        os.FileMode(fileMode),
 		dirMode:         os.FileMode(dirMode),
+		compression:     compression,
 		historyIterator: historyIterator,
 	}, nil
 }
@@ -139,7 +153,7 @@ func (h *historyArchiver) Archive(
 
 	historyIterator := h.historyIterator
 	if historyIterator == nil { // will only be set by testing code
-		historyIterator = archiver.NewHistoryIterator(ctx, request, h.container.HistoryV2Manager, targetHistoryBlobSize)
+		historyIterator = archiver.NewHistoryIterator(ctx, request, h.container.HistoryV2Manager, h.container.PayloadCodec, targetHistoryBlobSize)
 	}
 
 	historyBatches := []*types.History{}
@@ -172,7 +186,7 @@ func (h *historyArchiver) Archive(
 		historyBatches = append(historyBatches, historyBlob.Body...)
 	}
 
-	encodedHistoryBatches, err := encode(historyBatches)
+	encodedHistoryBatches, err := encodeHistoryBatches(historyBatches, h.compression)
 	if err != nil {
 		logger.Error(archiver.ArchiveNonRetriableErrorMsg, tag.ArchivalArchiveFailReason(errEncodeHistory), tag.Error(err))
 		return err
@@ -257,6 +271,9 @@ func (h *historyArchiver) Get(
 		return nil, &types.InternalServiceError{Message: err.Error()}
 	}
 	historyBatches = historyBatches[token.NextBatchIdx:]
+	if err := archiver.DecodeHistoryBatches(h.container.PayloadCodec, historyBatches, h.container.Logger); err != nil {
+		return nil, &types.InternalServiceError{Message: err.Error()}
+	}
 
 	response := &archiver.GetHistoryResponse{}
 	numOfEvents := 0
@@ -282,6 +299,44 @@ func (h *historyArchiver) Get(
 	return response, nil
 }
 
+var _ archiver.HistoryDeleter = (*historyArchiver)(nil)
+
+func (h *historyArchiver) Delete(
+	ctx context.Context,
+	URI archiver.URI,
+	request *archiver.DeleteHistoryRequest,
+) error {
+	if err := h.ValidateURI(URI); err != nil {
+		return &types.BadRequestError{Message: archiver.ErrInvalidURI.Error()}
+	}
+
+	if err := archiver.ValidateDeleteRequest(request); err != nil {
+		return &types.BadRequestError{Message: archiver.ErrInvalidDeleteHistoryRequest.Error()}
+	}
+
+	dirPath := URI.Path()
+	exists, err := util.DirectoryExists(dirPath)
+	if err != nil {
+		return &types.InternalServiceError{Message: err.Error()}
+	}
+	if !exists {
+		return nil
+	}
+
+	// the separator keeps the prefix from matching the hashes of another run
+	prefix := constructHistoryFilenamePrefix(request.DomainID, request.WorkflowID, request.RunID) + "_"
+	filenames, err := util.ListFilesByPrefix(dirPath, prefix)
+	if err != nil {
+		return &types.InternalServiceError{Message: err.Error()}
+	}
+	for _, filename := range filenames {
+		if err := os.Remove(path.Join(dirPath, filename)); err != nil && !os.IsNotExist(err) {
+			return &types.InternalServiceError{Message: err.Error()}
+		}
+	}
+	return nil
+}
+
 func (h *historyArchiver) ValidateURI(URI archiver.URI) error {
 	if URI.Scheme() != URIScheme {
 		return archiver.ErrURISchemeMismatch
@@ -333,3 +388,66 @@ func getHighestVersion(dirPath string, request *archiver.GetHistoryRequest) (*in
 	}
 	return highestVersion, nil
 }
+
+// ReencodeHistory rewrites in place the histories archived in the directory of the URI with the given compression.
+// Files already written with that compression are skipped. It returns the number of rewritten files.
+func ReencodeHistory(URI archiver.URI, compression archiver.ArchiveCompression) (int, error) {
+	if URI.Scheme() != URIScheme {
+		return 0, archiver.ErrURISchemeMismatch
+	}
+	dirPath := URI.Path()
+	if err := validateDirPath(dirPath); err != nil {
+		return 0, err
+	}
+	filenames, err := util.ListFiles(dirPath)
+	if err != nil {
+		return 0, err
+	}
+
+	count := 0
+	for _, filename := range filenames {
+		if !strings.HasSuffix(filename, historyFileSuffix) {
+			continue
+		}
+		reencoded, err := reencodeHistoryFile(path.Join(dirPath, filename), compression)
+		if err != nil {
+			return count, fmt.Errorf("failed to re-encode %v: %w", filename, err)
+		}
+		if reencoded {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func reencodeHistoryFile(filepath string, compression archiver.ArchiveCompression) (bool, error) {
+	info, err := os.Stat(filepath)
+	if err != nil {
+		return false, err
+	}
+	blob, err := util.ReadFile(filepath)
+	if err != nil {
+		return false, err
+	}
+	if archiver.GetArchiveBlobCompression(blob) == compression {
+		return false, nil
+	}
+	data, err := archiver.DecodeArchiveBlob(blob)
+	if err != nil {
+		return false, err
+	}
+	encoded, err := archiver.EncodeArchiveBlob(data, compression)
+	if err != nil {
+		return false, err
+	}
+
+	// write to a temporary file first so that readers never see a partially written history
+	tmpFilepath := filepath + ".tmp"
+	if err := util.WriteFile(tmpFilepath, encoded, info.Mode().Perm()); err != nil {
+		return false, err
+	}
+	if err := os.Rename(tmpFilepath, filepath); err != nil {
+		return false, err
+	}
+	return true, nil
+}
@@ -555,6 +555,156 @@ func (s *historyArchiverSuite) TestArchiveAndGet() {
 	s.Equal(s.historyBatchesV100, response.HistoryBatches)
 }
 
+func (s *historyArchiverSuite) TestNewHistoryArchiver_InvalidCompression() {
+	config := &config.FilestoreArchiver{
+		FileMode:    testFileModeStr,
+		DirMode:     testDirModeStr,
+		Compression: "gzip",
+	}
+	_, err := newHistoryArchiver(s.container, config, nil)
+	s.Equal(archiver.ErrInvalidArchiveCompression, err)
+}
+
+func (s *historyArchiverSuite) TestArchiveAndGet_CompressedAndReencoded() {
+	mockCtrl := gomock.NewController(s.T())
+	historyIterator := archiver.NewMockHistoryIterator(mockCtrl)
+	historyBlob := &archiver.HistoryBlob{
+		Header: &archiver.HistoryBlobHeader{
+			IsLast: common.BoolPtr(true),
+		},
+		Body: s.historyBatchesV100,
+	}
+	gomock.InOrder(
+		historyIterator.EXPECT().HasNext().Return(true),
+		historyIterator.EXPECT().Next().Return(historyBlob, nil),
+		historyIterator.EXPECT().HasNext().Return(false),
+	)
+
+	dir, err := ioutil.TempDir("", "TestArchiveAndGet_CompressedAndReencoded")
+	s.NoError(err)
+	defer os.RemoveAll(dir)
+
+	historyArchiver, err := newHistoryArchiver(s.container, &config.FilestoreArchiver{
+		FileMode:    testFileModeStr,
+		DirMode:     testDirModeStr,
+		Compression: string(archiver.ArchiveCompressionZstd),
+	}, historyIterator)
+	s.NoError(err)
+	archiveRequest := &archiver.ArchiveHistoryRequest{
+		DomainID:             testDomainID,
+		DomainName:           testDomainName,
+		WorkflowID:           testWorkflowID,
+		RunID:                testRunID,
+		BranchToken:          testBranchToken,
+		NextEventID:          testNextEventID,
+		CloseFailoverVersion: testCloseFailoverVersion,
+	}
+	URI, err := archiver.NewURI("file://" + dir)
+	s.NoError(err)
+	err = historyArchiver.Archive(context.Background(), URI, archiveRequest)
+	s.NoError(err)
+
+	filepath := path.Join(dir, constructHistoryFilename(testDomainID, testWorkflowID, testRunID, testCloseFailoverVersion))
+	s.assertFileCompression(filepath, archiver.ArchiveCompressionZstd)
+
+	getRequest := &archiver.GetHistoryRequest{
+		DomainID:   testDomainID,
+		WorkflowID: testWorkflowID,
+		RunID:      testRunID,
+		PageSize:   testPageSize,
+	}
+	for _, compression := range []archiver.ArchiveCompression{
+		archiver.ArchiveCompressionZstd,
+		archiver.ArchiveCompressionSnappy,
+		archiver.ArchiveCompressionLegacy,
+	} {
+		count, err := ReencodeHistory(URI, compression)
+		s.NoError(err)
+		if compression == archiver.ArchiveCompressionZstd {
+			s.Equal(0, count)
+		} else {
+			s.Equal(1, count)
+		}
+		s.assertFileCompression(filepath, compression)
+
+		response, err := historyArchiver.Get(context.Background(), URI, getRequest)
+		s.NoError(err)
+		s.Equal(s.historyBatchesV100, response.HistoryBatches)
+	}
+}
+
+func (s *historyArchiverSuite) TestDelete_Fail_InvalidURI() {
+	historyArchiver := s.newTestHistoryArchiver(nil)
+	request := &archiver.DeleteHistoryRequest{
+		DomainID:   testDomainID,
+		WorkflowID: testWorkflowID,
+		RunID:      testRunID,
+	}
+	URI, err := archiver.NewURI("wrongscheme://")
+	s.NoError(err)
+	err = historyArchiver.Delete(context.Background(), URI, request)
+	s.IsType(&types.BadRequestError{}, err)
+}
+
+func (s *historyArchiverSuite) TestDelete_Fail_InvalidRequest() {
+	historyArchiver := s.newTestHistoryArchiver(nil)
+	request := &archiver.DeleteHistoryRequest{
+		DomainID:   testDomainID,
+		WorkflowID: testWorkflowID,
+	}
+	err := historyArchiver.Delete(context.Background(), s.testArchivalURI, request)
+	s.IsType(&types.BadRequestError{}, err)
+}
+
+func (s *historyArchiverSuite) TestDelete_Success_DirectoryNotExist() {
+	historyArchiver := s.newTestHistoryArchiver(nil)
+	request := &archiver.DeleteHistoryRequest{
+		DomainID:   testDomainID,
+		WorkflowID: testWorkflowID,
+		RunID:      testRunID,
+	}
+	s.NoError(historyArchiver.Delete(context.Background(), s.testArchivalURI, request))
+}
+
+func (s *historyArchiverSuite) TestDelete_Success() {
+	dir, err := ioutil.TempDir("", "TestDelete")
+	s.NoError(err)
+	defer os.RemoveAll(dir)
+
+	otherRunFilename := constructHistoryFilename(testDomainID, testWorkflowID, "other-run-id", testCloseFailoverVersion)
+	for _, filename := range []string{
+		constructHistoryFilename(testDomainID, testWorkflowID, testRunID, 1),
+		constructHistoryFilename(testDomainID, testWorkflowID, testRunID, testCloseFailoverVersion),
+		otherRunFilename,
+	} {
+		s.NoError(util.WriteFile(path.Join(dir, filename), []byte("history"), testFileMode))
+	}
+
+	historyArchiver := s.newTestHistoryArchiver(nil)
+	URI, err := archiver.NewURI("file://" + dir)
+	s.NoError(err)
+	request := &archiver.DeleteHistoryRequest{
+		DomainID:   testDomainID,
+		WorkflowID: testWorkflowID,
+		RunID:      testRunID,
+	}
+	s.NoError(historyArchiver.Delete(context.Background(), URI, request))
+
+	filenames, err := util.ListFiles(dir)
+	s.NoError(err)
+	s.Equal([]string{otherRunFilename}, filenames)
+
+	// deleting again is a no-op
+	s.NoError(historyArchiver.Delete(context.Background(), URI, request))
+}
+
+func (s *historyArchiverSuite) TestReencodeHistory_Fail_InvalidURI() {
+	URI, err := archiver.NewURI("wrongscheme:///a/b/c")
+	s.NoError(err)
+	_, err = ReencodeHistory(URI, archiver.ArchiveCompressionZstd)
+	s.Equal(archiver.ErrURISchemeMismatch, err)
+}
+
 func (s *historyArchiverSuite) newTestHistoryArchiver(historyIterator archiver.HistoryIterator) *historyArchiver {
 	config := &config.FilestoreArchiver{
 		FileMode: testFileModeStr,
@@ -616,6 +766,12 @@ func (s *historyArchiverSuite) writeHistoryBatchesForGetTest(historyBatches []*t
 	s.Require().NoError(err)
 }
 
+func (s *historyArchiverSuite) assertFileCompression(filepath string, compression archiver.ArchiveCompression) {
+	data, err := util.ReadFile(filepath)
+	s.NoError(err)
+	s.Equal(compression, archiver.GetArchiveBlobCompression(data))
+}
+
 func (s *historyArchiverSuite) assertFileExists(filepath string) {
 	exists, err := util.FileExists(filepath)
 	s.NoError(err)
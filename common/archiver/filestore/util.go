@@ -31,6 +31,7 @@ import (
 
 	"github.com/dgryski/go-farm"
 
+	"github.com/uber/cadence/common/archiver"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/common/util"
 )
@@ -45,9 +46,21 @@ func encode(v interface{}) ([]byte, error) {
 	return json.Marshal(v)
 }
 
-func decodeHistoryBatches(data []byte) ([]*types.History, error) {
+func encodeHistoryBatches(historyBatches []*types.History, compression archiver.ArchiveCompression) ([]byte, error) {
+	data, err := encode(historyBatches)
+	if err != nil {
+		return nil, err
+	}
+	return archiver.EncodeArchiveBlob(data, compression)
+}
+
+func decodeHistoryBatches(blob []byte) ([]*types.History, error) {
+	data, err := archiver.DecodeArchiveBlob(blob)
+	if err != nil {
+		return nil, err
+	}
 	historyBatches := []*types.History{}
-	err := json.Unmarshal(data, &historyBatches)
+	err = json.Unmarshal(data, &historyBatches)
 	if err != nil {
 		return nil, err
 	}
@@ -86,7 +99,7 @@ func deserializeQueryVisibilityToken(bytes []byte) (*queryVisibilityToken, error
 
 func constructHistoryFilename(domainID, workflowID, runID string, version int64) string {
 	combinedHash := constructHistoryFilenamePrefix(domainID, workflowID, runID)
-	return fmt.Sprintf("%s_%v.history", combinedHash, version)
+	return fmt.Sprintf("%s_%v%s", combinedHash, version, historyFileSuffix)
 }
 
 func constructHistoryFilenamePrefix(domainID, workflowID, runID string) string {
@@ -94,7 +107,11 @@ func constructHistoryFilenamePrefix(domainID, workflowID, runID string) string {
 }
 
 func constructVisibilityFilename(closeTimestamp int64, runID string) string {
-	return fmt.Sprintf("%v_%s.visibility", closeTimestamp, hash(runID))
+	return fmt.Sprintf("%v%s", closeTimestamp, constructVisibilityFilenameSuffix(runID))
+}
+
+func constructVisibilityFilenameSuffix(runID string) string {
+	return fmt.Sprintf("_%s.visibility", hash(runID))
 }
 
 func hash(s string) string {
@@ -237,6 +237,47 @@ func (v *visibilityArchiver) query(
 	return response, nil
 }
 
+var _ archiver.VisibilityDeleter = (*visibilityArchiver)(nil)
+
+func (v *visibilityArchiver) Delete(
+	ctx context.Context,
+	URI archiver.URI,
+	request *archiver.DeleteVisibilityRequest,
+) error {
+	if err := v.ValidateURI(URI); err != nil {
+		return &types.BadRequestError{Message: archiver.ErrInvalidURI.Error()}
+	}
+
+	if err := archiver.ValidateDeleteVisibilityRequest(request); err != nil {
+		return &types.BadRequestError{Message: archiver.ErrInvalidDeleteVisibilityRequest.Error()}
+	}
+
+	dirPath := path.Join(URI.Path(), request.DomainID)
+	exists, err := util.DirectoryExists(dirPath)
+	if err != nil {
+		return &types.InternalServiceError{Message: err.Error()}
+	}
+	if !exists {
+		return nil
+	}
+
+	filenames, err := util.ListFiles(dirPath)
+	if err != nil {
+		return &types.InternalServiceError{Message: err.Error()}
+	}
+	// the record is named after its close timestamp, which is not known once the execution is gone
+	suffix := constructVisibilityFilenameSuffix(request.RunID)
+	for _, filename := range filenames {
+		if !strings.HasSuffix(filename, suffix) {
+			continue
+		}
+		if err := os.Remove(path.Join(dirPath, filename)); err != nil && !os.IsNotExist(err) {
+			return &types.InternalServiceError{Message: err.Error()}
+		}
+	}
+	return nil
+}
+
 func (v *visibilityArchiver) ValidateURI(URI archiver.URI) error {
 	if URI.Scheme() != URIScheme {
 		return archiver.ErrURISchemeMismatch
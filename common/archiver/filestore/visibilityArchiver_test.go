@@ -479,6 +479,56 @@ func (s *visibilityArchiverSuite) TestArchiveAndQuery() {
 	s.Equal(convertToExecutionInfo(s.visibilityRecords[1]), executions[1])
 }
 
+func (s *visibilityArchiverSuite) TestDelete_Fail_InvalidURI() {
+	visibilityArchiver := s.newTestVisibilityArchiver()
+	URI, err := archiver.NewURI("wrongscheme://")
+	s.NoError(err)
+	err = visibilityArchiver.Delete(context.Background(), URI, &archiver.DeleteVisibilityRequest{
+		DomainID:   testDomainID,
+		WorkflowID: testWorkflowID,
+		RunID:      testRunID,
+	})
+	s.IsType(&types.BadRequestError{}, err)
+}
+
+func (s *visibilityArchiverSuite) TestDelete_Fail_InvalidRequest() {
+	visibilityArchiver := s.newTestVisibilityArchiver()
+	err := visibilityArchiver.Delete(context.Background(), s.testArchivalURI, &archiver.DeleteVisibilityRequest{
+		DomainID:   testDomainID,
+		WorkflowID: testWorkflowID,
+	})
+	s.IsType(&types.BadRequestError{}, err)
+}
+
+func (s *visibilityArchiverSuite) TestDelete_Success() {
+	dir := s.T().TempDir()
+	dirPath := path.Join(dir, testDomainID)
+	s.NoError(util.MkdirAll(dirPath, testDirMode))
+	otherRunFilename := constructVisibilityFilename(2, "other-run-id")
+	for _, filename := range []string{constructVisibilityFilename(1, testRunID), otherRunFilename} {
+		s.NoError(util.WriteFile(path.Join(dirPath, filename), []byte("visibility"), testFileMode))
+	}
+
+	visibilityArchiver := s.newTestVisibilityArchiver()
+	URI, err := archiver.NewURI("file://" + dir)
+	s.NoError(err)
+	request := &archiver.DeleteVisibilityRequest{
+		DomainID:   testDomainID,
+		WorkflowID: testWorkflowID,
+		RunID:      testRunID,
+	}
+	s.NoError(visibilityArchiver.Delete(context.Background(), URI, request))
+
+	filenames, err := util.ListFiles(dirPath)
+	s.NoError(err)
+	s.Equal([]string{otherRunFilename}, filenames)
+
+	// deleting again is a no-op, as is deleting from a domain without records
+	s.NoError(visibilityArchiver.Delete(context.Background(), URI, request))
+	request.DomainID = "other-domain-id"
+	s.NoError(visibilityArchiver.Delete(context.Background(), URI, request))
+}
+
 func (s *visibilityArchiverSuite) newTestVisibilityArchiver() *visibilityArchiver {
 	config := &config.FilestoreArchiver{
 		FileMode: testFileModeStr,
@@ -0,0 +1,194 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package archiver
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/golang/snappy"
+	"github.com/klauspost/compress/zstd"
+
+	"github.com/uber/cadence/common/checksum"
+)
+
+// ArchiveCompression is the compression used by the versioned archival format
+type ArchiveCompression string
+
+const (
+	// ArchiveCompressionLegacy writes the legacy unversioned format, which is plain JSON without checksum
+	ArchiveCompressionLegacy ArchiveCompression = ""
+	// ArchiveCompressionNone writes the versioned format without compression
+	ArchiveCompressionNone ArchiveCompression = "none"
+	// ArchiveCompressionSnappy writes the versioned format compressed with snappy
+	ArchiveCompressionSnappy ArchiveCompression = "snappy"
+	// ArchiveCompressionZstd writes the versioned format compressed with zstd
+	ArchiveCompressionZstd ArchiveCompression = "zstd"
+)
+
+// ArchiveFormatVersion1 is the first version of the versioned archival format.
+// A blob of this version is laid out as:
+//
+//	magic (4 bytes) | version (1 byte) | compression (1 byte) | crc32 of the uncompressed payload (4 bytes) | compressed payload
+const ArchiveFormatVersion1 = 1
+
+const archiveFormatHeaderSize = 10
+
+// MaxArchiveBlobDecodedSize is the maximum size of the data of a compressed blob, it bounds the memory
+// used to decode a corrupted or forged blob
+const MaxArchiveBlobDecodedSize = 512 * 1024 * 1024
+
+var (
+	// archiveFormatMagic starts every versioned blob, it cannot be mistaken for a legacy JSON blob
+	archiveFormatMagic = []byte{0x00, 'c', 'a', 'r'}
+
+	archiveCompressionCodes = map[ArchiveCompression]byte{
+		ArchiveCompressionNone:   1,
+		ArchiveCompressionSnappy: 2,
+		ArchiveCompressionZstd:   3,
+	}
+
+	zstdEncoder, _ = zstd.NewWriter(nil)
+	zstdDecoder, _ = zstd.NewReader(nil, zstd.WithDecoderMaxMemory(MaxArchiveBlobDecodedSize))
+)
+
+var (
+	// ErrInvalidArchiveCompression is the error for an unknown archive compression
+	ErrInvalidArchiveCompression = errors.New("archive compression must be one of none, snappy or zstd")
+	// ErrArchiveBlobCorrupted is the error for an archived blob that cannot be decoded
+	ErrArchiveBlobCorrupted = errors.New("archived blob is corrupted")
+	// ErrArchiveBlobTooLarge is the error for an archived blob whose data exceeds MaxArchiveBlobDecodedSize
+	ErrArchiveBlobTooLarge = fmt.Errorf("archived blob exceeds the maximum decoded size of %d bytes", MaxArchiveBlobDecodedSize)
+)
+
+// ParseArchiveCompression parses the compression of the archiver config, an empty value selects the legacy format
+func ParseArchiveCompression(s string) (ArchiveCompression, error) {
+	compression := ArchiveCompression(s)
+	if compression == ArchiveCompressionLegacy {
+		return compression, nil
+	}
+	if _, ok := archiveCompressionCodes[compression]; !ok {
+		return "", ErrInvalidArchiveCompression
+	}
+	return compression, nil
+}
+
+// EncodeArchiveBlob wraps the JSON encoded data in the versioned archival format with the given compression,
+// the data is returned as is for the legacy format
+func EncodeArchiveBlob(data []byte, compression ArchiveCompression) ([]byte, error) {
+	if compression == ArchiveCompressionLegacy {
+		return data, nil
+	}
+	code, ok := archiveCompressionCodes[compression]
+	if !ok {
+		return nil, ErrInvalidArchiveCompression
+	}
+
+	var payload []byte
+	switch compression {
+	case ArchiveCompressionSnappy:
+		payload = snappy.Encode(nil, data)
+	case ArchiveCompressionZstd:
+		payload = zstdEncoder.EncodeAll(data, nil)
+	default:
+		payload = data
+	}
+
+	csum := checksum.GenerateCRC32OverBytes(data, ArchiveFormatVersion1)
+	blob := make([]byte, 0, archiveFormatHeaderSize+len(payload))
+	blob = append(blob, archiveFormatMagic...)
+	blob = append(blob, ArchiveFormatVersion1, code)
+	blob = append(blob, csum.Value...)
+	return append(blob, payload...), nil
+}
+
+// DecodeArchiveBlob returns the JSON encoded data of a blob written in either the versioned or the legacy format,
+// the checksum of versioned blobs is verified
+func DecodeArchiveBlob(blob []byte) ([]byte, error) {
+	if !isVersionedArchiveBlob(blob) {
+		return blob, nil
+	}
+	if len(blob) < archiveFormatHeaderSize {
+		return nil, ErrArchiveBlobCorrupted
+	}
+	version := blob[len(archiveFormatMagic)]
+	if version != ArchiveFormatVersion1 {
+		return nil, fmt.Errorf("unsupported archive format version %v", version)
+	}
+	compression, ok := archiveCompressionFromCode(blob[len(archiveFormatMagic)+1])
+	if !ok {
+		return nil, ErrArchiveBlobCorrupted
+	}
+	csum := checksum.Checksum{
+		Version: int(version),
+		Flavor:  checksum.FlavorIEEECRC32OverBytes,
+		Value:   blob[len(archiveFormatMagic)+2 : archiveFormatHeaderSize],
+	}
+	payload := blob[archiveFormatHeaderSize:]
+
+	var data []byte
+	var err error
+	switch compression {
+	case ArchiveCompressionSnappy:
+		if size, lenErr := snappy.DecodedLen(payload); lenErr == nil && size > MaxArchiveBlobDecodedSize {
+			return nil, ErrArchiveBlobTooLarge
+		}
+		data, err = snappy.Decode(nil, payload)
+	case ArchiveCompressionZstd:
+		data, err = zstdDecoder.DecodeAll(payload, nil)
+		if errors.Is(err, zstd.ErrDecoderSizeExceeded) || errors.Is(err, zstd.ErrWindowSizeExceeded) {
+			return nil, ErrArchiveBlobTooLarge
+		}
+	default:
+		data = payload
+	}
+	if err != nil {
+		return nil, ErrArchiveBlobCorrupted
+	}
+	if err := checksum.VerifyBytes(data, csum); err != nil {
+		return nil, ErrArchiveBlobCorrupted
+	}
+	return data, nil
+}
+
+// GetArchiveBlobCompression returns the compression of a blob, ArchiveCompressionLegacy is returned for legacy blobs
+// and for blobs with an unknown compression
+func GetArchiveBlobCompression(blob []byte) ArchiveCompression {
+	if !isVersionedArchiveBlob(blob) || len(blob) < archiveFormatHeaderSize {
+		return ArchiveCompressionLegacy
+	}
+	compression, _ := archiveCompressionFromCode(blob[len(archiveFormatMagic)+1])
+	return compression
+}
+
+func isVersionedArchiveBlob(blob []byte) bool {
+	return bytes.HasPrefix(blob, archiveFormatMagic)
+}
+
+func archiveCompressionFromCode(code byte) (ArchiveCompression, bool) {
+	for compression, c := range archiveCompressionCodes {
+		if c == code {
+			return compression, true
+		}
+	}
+	return ArchiveCompressionLegacy, false
+}
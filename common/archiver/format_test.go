@@ -0,0 +1,112 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package archiver
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseArchiveCompression(t *testing.T) {
+	for _, s := range []string{"", "none", "snappy", "zstd"} {
+		compression, err := ParseArchiveCompression(s)
+		assert.NoError(t, err)
+		assert.Equal(t, ArchiveCompression(s), compression)
+	}
+	_, err := ParseArchiveCompression("gzip")
+	assert.Equal(t, ErrInvalidArchiveCompression, err)
+}
+
+func TestArchiveBlobRoundTrip(t *testing.T) {
+	data := []byte(`[{"events":[{"eventId":1,"timestamp":1}]}]`)
+	for _, compression := range []ArchiveCompression{
+		ArchiveCompressionLegacy,
+		ArchiveCompressionNone,
+		ArchiveCompressionSnappy,
+		ArchiveCompressionZstd,
+	} {
+		t.Run(string(compression), func(t *testing.T) {
+			blob, err := EncodeArchiveBlob(data, compression)
+			require.NoError(t, err)
+			assert.Equal(t, compression, GetArchiveBlobCompression(blob))
+
+			decoded, err := DecodeArchiveBlob(blob)
+			require.NoError(t, err)
+			assert.Equal(t, data, decoded)
+		})
+	}
+}
+
+func TestEncodeArchiveBlob_Legacy(t *testing.T) {
+	data := []byte(`[]`)
+	blob, err := EncodeArchiveBlob(data, ArchiveCompressionLegacy)
+	assert.NoError(t, err)
+	assert.Equal(t, data, blob)
+
+	_, err = EncodeArchiveBlob(data, "gzip")
+	assert.Equal(t, ErrInvalidArchiveCompression, err)
+}
+
+func TestEncodeArchiveBlob_Compresses(t *testing.T) {
+	data := bytes.Repeat([]byte(`{"eventId":1,"eventType":"ActivityTaskScheduled"}`), 1000)
+	for _, compression := range []ArchiveCompression{ArchiveCompressionSnappy, ArchiveCompressionZstd} {
+		blob, err := EncodeArchiveBlob(data, compression)
+		require.NoError(t, err)
+		assert.Less(t, len(blob), len(data)/10, "compression %v", compression)
+	}
+}
+
+func TestDecodeArchiveBlob_Corrupted(t *testing.T) {
+	data := []byte(`[{"events":[{"eventId":1,"timestamp":1}]}]`)
+	for _, compression := range []ArchiveCompression{ArchiveCompressionNone, ArchiveCompressionSnappy, ArchiveCompressionZstd} {
+		t.Run(string(compression), func(t *testing.T) {
+			blob, err := EncodeArchiveBlob(data, compression)
+			require.NoError(t, err)
+
+			corrupted := append([]byte(nil), blob...)
+			corrupted[len(corrupted)-1] ^= 0xff
+			_, err = DecodeArchiveBlob(corrupted)
+			assert.Equal(t, ErrArchiveBlobCorrupted, err)
+
+			_, err = DecodeArchiveBlob(blob[:archiveFormatHeaderSize-1])
+			assert.Equal(t, ErrArchiveBlobCorrupted, err)
+		})
+	}
+
+	blob, err := EncodeArchiveBlob(data, ArchiveCompressionNone)
+	require.NoError(t, err)
+	blob[len(archiveFormatMagic)] = ArchiveFormatVersion1 + 1
+	_, err = DecodeArchiveBlob(blob)
+	assert.Error(t, err)
+}
+
+func TestDecodeArchiveBlob_TooLarge(t *testing.T) {
+	blob, err := EncodeArchiveBlob([]byte(`{}`), ArchiveCompressionSnappy)
+	require.NoError(t, err)
+	// a snappy payload starts with its decoded length
+	blob = binary.AppendUvarint(blob[:archiveFormatHeaderSize], MaxArchiveBlobDecodedSize+1)
+	_, err = DecodeArchiveBlob(blob)
+	assert.Equal(t, ErrArchiveBlobTooLarge, err)
+}
@@ -0,0 +1,255 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package blobstore provides a blobstore backed by Google Cloud Storage.
+// It lives in the gcloud module so that the main module does not depend on the Google Cloud SDK.
+package blobstore
+
+import (
+	"context"
+	"errors"
+	"io"
+	"path"
+	"strings"
+
+	"cloud.google.com/go/storage"
+	"google.golang.org/api/iterator"
+	"google.golang.org/api/option"
+
+	"github.com/uber/cadence/common/blobstore"
+	"github.com/uber/cadence/common/config"
+)
+
+// defaultListPageSize is the number of objects listed per page if the page size is not given
+const defaultListPageSize = 1000
+
+type (
+	client struct {
+		store  objectStore
+		bucket string
+		prefix string
+	}
+
+	// objectStore is the subset of the storage API used by the client
+	objectStore interface {
+		write(ctx context.Context, bucket, name string, body []byte, metadata map[string]string) error
+		read(ctx context.Context, bucket, name string) ([]byte, map[string]string, error)
+		exists(ctx context.Context, bucket, name string) (bool, error)
+		delete(ctx context.Context, bucket, name string) error
+		// list returns the objects whose name starts with the prefix, the keys of the returned blobs are the object names
+		list(ctx context.Context, bucket, prefix string, pageSize int, pageToken string) ([]blobstore.BlobInfo, string, error)
+	}
+
+	storageDelegate struct {
+		client *storage.Client
+	}
+)
+
+// NewGCSClient constructs a blobstore backed by Google Cloud Storage.
+// The bucket must already exist, it is not created by the client.
+// Default application credentials are used unless a credentials file is configured.
+func NewGCSClient(ctx context.Context, cfg *config.GCSBlobstore) (blobstore.Client, error) {
+	if cfg == nil {
+		return nil, errors.New("gcs blobstore config is nil")
+	}
+	if len(cfg.Bucket) == 0 {
+		return nil, errors.New("bucket not given for gcs blobstore")
+	}
+	var opts []option.ClientOption
+	if cfg.Endpoint != "" {
+		opts = append(opts, option.WithEndpoint(cfg.Endpoint), option.WithoutAuthentication())
+	} else if cfg.CredentialsPath != "" {
+		opts = append(opts, option.WithCredentialsFile(cfg.CredentialsPath))
+	}
+	storageClient, err := storage.NewClient(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return newClient(&storageDelegate{client: storageClient}, cfg), nil
+}
+
+func newClient(store objectStore, cfg *config.GCSBlobstore) *client {
+	return &client{
+		store:  store,
+		bucket: cfg.Bucket,
+		prefix: cfg.Prefix,
+	}
+}
+
+// Put stores a blob
+func (c *client) Put(ctx context.Context, request *blobstore.PutRequest) (*blobstore.PutResponse, error) {
+	tags, err := blobstore.EncodeTags(request.Blob.Tags)
+	if err != nil {
+		return nil, err
+	}
+	var metadata map[string]string
+	if tags != "" {
+		metadata = map[string]string{blobstore.TagsMetadataKey: tags}
+	}
+	if err := c.store.write(ctx, c.bucket, c.objectName(request.Key), request.Blob.Body, metadata); err != nil {
+		return nil, err
+	}
+	return &blobstore.PutResponse{}, nil
+}
+
+// Get fetches a blob
+func (c *client) Get(ctx context.Context, request *blobstore.GetRequest) (*blobstore.GetResponse, error) {
+	body, metadata, err := c.store.read(ctx, c.bucket, c.objectName(request.Key))
+	if err != nil {
+		return nil, err
+	}
+	tags, err := blobstore.DecodeTags(metadata[blobstore.TagsMetadataKey])
+	if err != nil {
+		return nil, err
+	}
+	return &blobstore.GetResponse{
+		Blob: blobstore.Blob{
+			Body: body,
+			Tags: tags,
+		},
+	}, nil
+}
+
+// Exists determines if a blob exists
+func (c *client) Exists(ctx context.Context, request *blobstore.ExistsRequest) (*blobstore.ExistsResponse, error) {
+	exists, err := c.store.exists(ctx, c.bucket, c.objectName(request.Key))
+	if err != nil {
+		return nil, err
+	}
+	return &blobstore.ExistsResponse{Exists: exists}, nil
+}
+
+// Delete deletes a blob
+func (c *client) Delete(ctx context.Context, request *blobstore.DeleteRequest) (*blobstore.DeleteResponse, error) {
+	if err := c.store.delete(ctx, c.bucket, c.objectName(request.Key)); err != nil {
+		return nil, err
+	}
+	return &blobstore.DeleteResponse{}, nil
+}
+
+// List lists the blobs whose key starts with the prefix
+func (c *client) List(ctx context.Context, request *blobstore.ListRequest) (*blobstore.ListResponse, error) {
+	objects, nextPageToken, err := c.store.list(ctx, c.bucket, c.objectName(request.Prefix), request.PageSize, string(request.NextPageToken))
+	if err != nil {
+		return nil, err
+	}
+	resp := &blobstore.ListResponse{NextPageToken: []byte(nextPageToken)}
+	if nextPageToken == "" {
+		resp.NextPageToken = nil
+	}
+	for _, object := range objects {
+		// the object prefix loses a trailing slash of the requested prefix when it is joined with the configured one
+		key, ok := c.blobKey(object.Key)
+		if !ok || !strings.HasPrefix(key, request.Prefix) {
+			continue
+		}
+		resp.Blobs = append(resp.Blobs, blobstore.BlobInfo{
+			Key:          key,
+			LastModified: object.LastModified,
+		})
+	}
+	return resp, nil
+}
+
+// IsRetryableError returns true if the error is retryable false otherwise
+func (c *client) IsRetryableError(err error) bool {
+	if err == nil || errors.Is(err, storage.ErrObjectNotExist) || errors.Is(err, storage.ErrBucketNotExist) {
+		return false
+	}
+	return storage.ShouldRetry(err)
+}
+
+func (c *client) objectName(key string) string {
+	if c.prefix == "" {
+		return key
+	}
+	return path.Join(c.prefix, key)
+}
+
+// blobKey returns the key of the blob stored in the object, the returned bool is false if the object is outside of the prefix
+func (c *client) blobKey(objectName string) (string, bool) {
+	if c.prefix == "" {
+		return objectName, true
+	}
+	prefix := strings.TrimSuffix(c.prefix, "/") + "/"
+	if !strings.HasPrefix(objectName, prefix) {
+		return "", false
+	}
+	return strings.TrimPrefix(objectName, prefix), true
+}
+
+func (d *storageDelegate) write(ctx context.Context, bucket, name string, body []byte, metadata map[string]string) error {
+	writer := d.client.Bucket(bucket).Object(name).NewWriter(ctx)
+	writer.Metadata = metadata
+	if _, err := writer.Write(body); err != nil {
+		writer.Close()
+		return err
+	}
+	return writer.Close()
+}
+
+func (d *storageDelegate) read(ctx context.Context, bucket, name string) ([]byte, map[string]string, error) {
+	object := d.client.Bucket(bucket).Object(name)
+	attrs, err := object.Attrs(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	// pin the generation so that the body matches the metadata even if the object is overwritten concurrently
+	reader, err := object.Generation(attrs.Generation).NewReader(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer reader.Close()
+	body, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, nil, err
+	}
+	return body, attrs.Metadata, nil
+}
+
+func (d *storageDelegate) exists(ctx context.Context, bucket, name string) (bool, error) {
+	if _, err := d.client.Bucket(bucket).Object(name).Attrs(ctx); err != nil {
+		if errors.Is(err, storage.ErrObjectNotExist) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+func (d *storageDelegate) delete(ctx context.Context, bucket, name string) error {
+	return d.client.Bucket(bucket).Object(name).Delete(ctx)
+}
+
+func (d *storageDelegate) list(ctx context.Context, bucket, prefix string, pageSize int, pageToken string) ([]blobstore.BlobInfo, string, error) {
+	if pageSize <= 0 {
+		pageSize = defaultListPageSize
+	}
+	var attrs []*storage.ObjectAttrs
+	nextPageToken, err := iterator.NewPager(d.client.Bucket(bucket).Objects(ctx, &storage.Query{Prefix: prefix}), pageSize, pageToken).NextPage(&attrs)
+	if err != nil {
+		return nil, "", err
+	}
+	objects := make([]blobstore.BlobInfo, 0, len(attrs))
+	for _, attr := range attrs {
+		objects = append(objects, blobstore.BlobInfo{Key: attr.Name, LastModified: attr.Updated})
+	}
+	return objects, nextPageToken, nil
+}
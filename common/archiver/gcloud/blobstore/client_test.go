@@ -0,0 +1,223 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package blobstore
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/storage"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/uber/cadence/common/blobstore"
+	"github.com/uber/cadence/common/config"
+)
+
+type (
+	clientSuite struct {
+		*require.Assertions
+		suite.Suite
+	}
+
+	// fakeObjectStore is an in-memory stand-in for Google Cloud Storage
+	fakeObjectStore struct {
+		sync.Mutex
+		objects map[string]fakeObject
+	}
+
+	fakeObject struct {
+		body     []byte
+		metadata map[string]string
+		updated  time.Time
+	}
+)
+
+func TestClientSuite(t *testing.T) {
+	suite.Run(t, new(clientSuite))
+}
+
+func (s *clientSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+}
+
+func (s *clientSuite) TestNewGCSClient_InvalidConfig() {
+	_, err := NewGCSClient(context.Background(), nil)
+	s.Error(err)
+	_, err = NewGCSClient(context.Background(), &config.GCSBlobstore{Prefix: "scanner"})
+	s.Error(err)
+}
+
+func (s *clientSuite) TestCrudOperations() {
+	store := newFakeObjectStore()
+	c := newClient(store, &config.GCSBlobstore{Bucket: "bucket", Prefix: "scanner"})
+	ctx := context.Background()
+
+	blob1 := blobstore.Blob{
+		Tags: nil,
+		Body: []byte{1, 2, 3},
+	}
+	blob2 := blobstore.Blob{
+		Tags: map[string]string{"key1": "value1", "key2": "value2"},
+		Body: nil,
+	}
+	_, err := c.Put(ctx, &blobstore.PutRequest{Key: "key1", Blob: blob1})
+	s.NoError(err)
+	_, err = c.Put(ctx, &blobstore.PutRequest{Key: "key2", Blob: blob2})
+	s.NoError(err)
+	s.Contains(store.objects, "bucket/scanner/key1")
+	s.Contains(store.objects, "bucket/scanner/key2")
+
+	get1, err := c.Get(ctx, &blobstore.GetRequest{Key: "key1"})
+	s.NoError(err)
+	s.Nil(get1.Blob.Tags)
+	s.Equal([]byte{1, 2, 3}, get1.Blob.Body)
+	get2, err := c.Get(ctx, &blobstore.GetRequest{Key: "key2"})
+	s.NoError(err)
+	s.Equal(map[string]string{"key1": "value1", "key2": "value2"}, get2.Blob.Tags)
+	s.Empty(get2.Blob.Body)
+
+	exists, err := c.Exists(ctx, &blobstore.ExistsRequest{Key: "key1"})
+	s.NoError(err)
+	s.True(exists.Exists)
+	exists, err = c.Exists(ctx, &blobstore.ExistsRequest{Key: "missing"})
+	s.NoError(err)
+	s.False(exists.Exists)
+
+	_, err = c.Delete(ctx, &blobstore.DeleteRequest{Key: "key1"})
+	s.NoError(err)
+	exists, err = c.Exists(ctx, &blobstore.ExistsRequest{Key: "key1"})
+	s.NoError(err)
+	s.False(exists.Exists)
+	_, err = c.Get(ctx, &blobstore.GetRequest{Key: "key1"})
+	s.ErrorIs(err, storage.ErrObjectNotExist)
+	s.False(c.IsRetryableError(err))
+}
+
+func (s *clientSuite) TestList() {
+	store := newFakeObjectStore()
+	c := newClient(store, &config.GCSBlobstore{Bucket: "bucket", Prefix: "scanner"})
+	ctx := context.Background()
+
+	for _, key := range []string{"b/2/key", "a/key", "b/1/key", "bb/key"} {
+		_, err := c.Put(ctx, &blobstore.PutRequest{Key: key, Blob: blobstore.Blob{Body: []byte(key)}})
+		s.NoError(err)
+	}
+	// outside of the configured prefix
+	store.objects["bucket/scanner2/b/key"] = fakeObject{}
+
+	var keys []string
+	req := &blobstore.ListRequest{Prefix: "b/", PageSize: 1}
+	for {
+		list, err := c.List(ctx, req)
+		s.NoError(err)
+		for _, blob := range list.Blobs {
+			s.False(blob.LastModified.IsZero())
+			keys = append(keys, blob.Key)
+		}
+		if len(list.NextPageToken) == 0 {
+			break
+		}
+		req.NextPageToken = list.NextPageToken
+	}
+	s.Equal([]string{"b/1/key", "b/2/key"}, keys)
+
+	list, err := c.List(ctx, &blobstore.ListRequest{})
+	s.NoError(err)
+	s.Len(list.Blobs, 4)
+	s.Nil(list.NextPageToken)
+}
+
+func (s *clientSuite) TestObjectName() {
+	s.Equal("key", newClient(newFakeObjectStore(), &config.GCSBlobstore{}).objectName("key"))
+	s.Equal("a/b/key", newClient(newFakeObjectStore(), &config.GCSBlobstore{Prefix: "a/b/"}).objectName("key"))
+}
+
+func (s *clientSuite) TestIsRetryableError() {
+	c := newClient(newFakeObjectStore(), &config.GCSBlobstore{})
+	s.False(c.IsRetryableError(nil))
+	s.False(c.IsRetryableError(errors.New("some error")))
+	s.False(c.IsRetryableError(storage.ErrObjectNotExist))
+	s.False(c.IsRetryableError(storage.ErrBucketNotExist))
+	s.True(c.IsRetryableError(io.ErrUnexpectedEOF))
+}
+
+func newFakeObjectStore() *fakeObjectStore {
+	return &fakeObjectStore{objects: make(map[string]fakeObject)}
+}
+
+func (f *fakeObjectStore) write(_ context.Context, bucket, name string, body []byte, metadata map[string]string) error {
+	f.Lock()
+	defer f.Unlock()
+	f.objects[bucket+"/"+name] = fakeObject{body: append([]byte(nil), body...), metadata: metadata, updated: time.Now()}
+	return nil
+}
+
+func (f *fakeObjectStore) read(_ context.Context, bucket, name string) ([]byte, map[string]string, error) {
+	f.Lock()
+	defer f.Unlock()
+	obj, ok := f.objects[bucket+"/"+name]
+	if !ok {
+		return nil, nil, storage.ErrObjectNotExist
+	}
+	return obj.body, obj.metadata, nil
+}
+
+func (f *fakeObjectStore) exists(_ context.Context, bucket, name string) (bool, error) {
+	f.Lock()
+	defer f.Unlock()
+	_, ok := f.objects[bucket+"/"+name]
+	return ok, nil
+}
+
+func (f *fakeObjectStore) delete(_ context.Context, bucket, name string) error {
+	f.Lock()
+	defer f.Unlock()
+	delete(f.objects, bucket+"/"+name)
+	return nil
+}
+
+func (f *fakeObjectStore) list(_ context.Context, bucket, prefix string, pageSize int, pageToken string) ([]blobstore.BlobInfo, string, error) {
+	f.Lock()
+	defer f.Unlock()
+	var names []string
+	for key := range f.objects {
+		name := strings.TrimPrefix(key, bucket+"/")
+		if strings.HasPrefix(key, bucket+"/") && strings.HasPrefix(name, prefix) && name > pageToken {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	var objects []blobstore.BlobInfo
+	for _, name := range names {
+		if pageSize > 0 && len(objects) == pageSize {
+			return objects, objects[len(objects)-1].Key, nil
+		}
+		objects = append(objects, blobstore.BlobInfo{Key: name, LastModified: f.objects[bucket+"/"+name].updated})
+	}
+	return objects, "", nil
+}
@@ -52,6 +52,7 @@ type (
 		Query(ctx context.Context, URI archiver.URI, fileNamePrefix string) ([]string, error)
 		QueryWithFilters(ctx context.Context, URI archiver.URI, fileNamePrefix string, pageSize, offset int, filters []Precondition) ([]string, bool, int, error)
 		Exist(ctx context.Context, URI archiver.URI, fileName string) (bool, error)
+		Delete(ctx context.Context, URI archiver.URI, fileName string) error
 	}
 
 	// Config structure used to parse from the storage-provider yaml nodes in [github.com/uber/cadence/common/config.HistoryArchiverProvider]
@@ -136,6 +137,17 @@ func (s *storageWrapper) Get(ctx context.Context, URI archiver.URI, fileName str
 	return nil, err
 }
 
+// Delete removes a file, it is not an error if the file does not exist
+func (s *storageWrapper) Delete(ctx context.Context, URI archiver.URI, fileName string) error {
+	bucket := s.client.Bucket(URI.Hostname())
+	err := bucket.Object(formatSinkPath(URI.Path()) + "/" + fileName).Delete(ctx)
+	if err == storage.ErrObjectNotExist {
+		return nil
+	}
+
+	return err
+}
+
 // Query, retieves file names by provided storage query
 func (s *storageWrapper) Query(ctx context.Context, URI archiver.URI, fileNamePrefix string) (fileNames []string, err error) {
 	fileNames = make([]string, 0)
@@ -217,6 +217,26 @@ func (s *clientSuite) TestGet() {
 	s.Require().NoError(err)
 }
 
+func (s *clientSuite) TestDelete() {
+	ctx := context.Background()
+	mockStorageClient := &mocks.GcloudStorageClient{}
+	mockBucketHandleClient := &mocks.BucketHandleWrapper{}
+	mockObjectHandler := &mocks.ObjectHandleWrapper{}
+
+	storageWrapper, _ := connector.NewClientWithParams(mockStorageClient)
+
+	mockStorageClient.On("Bucket", "my-bucket-cad").Return(mockBucketHandleClient).Times(2)
+	mockBucketHandleClient.On("Object", "cadence_archival/development/myfile.history").Return(mockObjectHandler).Times(1)
+	mockBucketHandleClient.On("Object", "cadence_archival/development/missing.history").Return(mockObjectHandler).Times(1)
+	mockObjectHandler.On("Delete", ctx).Return(nil).Times(1)
+	mockObjectHandler.On("Delete", ctx).Return(storage.ErrObjectNotExist).Times(1)
+
+	URI, err := archiver.NewURI("gs://my-bucket-cad/cadence_archival/development")
+	s.Require().NoError(err)
+	s.Require().NoError(storageWrapper.Delete(ctx, URI, "myfile.history"))
+	s.Require().NoError(storageWrapper.Delete(ctx, URI, "missing.history"))
+}
+
 func (s *clientSuite) TestWrongGoogleCredentialsPath() {
 	ctx := context.Background()
 	s.T().Setenv("GOOGLE_APPLICATION_CREDENTIALS", "/Wrong/path")
@@ -40,6 +40,69 @@ func (_m *Client) EXPECT() *Client_Expecter {
 	return &Client_Expecter{mock: &_m.Mock}
 }
 
+// Delete provides a mock function for the type Client
+func (_mock *Client) Delete(ctx context.Context, URI archiver.URI, fileName string) error {
+	ret := _mock.Called(ctx, URI, fileName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, archiver.URI, string) error); ok {
+		r0 = returnFunc(ctx, URI, fileName)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Client_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type Client_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - URI archiver.URI
+//   - fileName string
+func (_e *Client_Expecter) Delete(ctx interface{}, URI interface{}, fileName interface{}) *Client_Delete_Call {
+	return &Client_Delete_Call{Call: _e.mock.On("Delete", ctx, URI, fileName)}
+}
+
+func (_c *Client_Delete_Call) Run(run func(ctx context.Context, URI archiver.URI, fileName string)) *Client_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 archiver.URI
+		if args[1] != nil {
+			arg1 = args[1].(archiver.URI)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *Client_Delete_Call) Return(err error) *Client_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Client_Delete_Call) RunAndReturn(run func(ctx context.Context, URI archiver.URI, fileName string) error) *Client_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Exist provides a mock function for the type Client
 func (_mock *Client) Exist(ctx context.Context, URI archiver.URI, fileName string) (bool, error) {
 	ret := _mock.Called(ctx, URI, fileName)
@@ -102,6 +102,57 @@ func (_c *ObjectHandleWrapper_Attrs_Call) RunAndReturn(run func(ctx context.Cont
 	return _c
 }
 
+// Delete provides a mock function for the type ObjectHandleWrapper
+func (_mock *ObjectHandleWrapper) Delete(ctx context.Context) error {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// ObjectHandleWrapper_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type ObjectHandleWrapper_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *ObjectHandleWrapper_Expecter) Delete(ctx interface{}) *ObjectHandleWrapper_Delete_Call {
+	return &ObjectHandleWrapper_Delete_Call{Call: _e.mock.On("Delete", ctx)}
+}
+
+func (_c *ObjectHandleWrapper_Delete_Call) Run(run func(ctx context.Context)) *ObjectHandleWrapper_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *ObjectHandleWrapper_Delete_Call) Return(err error) *ObjectHandleWrapper_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *ObjectHandleWrapper_Delete_Call) RunAndReturn(run func(ctx context.Context) error) *ObjectHandleWrapper_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewReader provides a mock function for the type ObjectHandleWrapper
 func (_mock *ObjectHandleWrapper) NewReader(ctx context.Context) (connector.ReaderWrapper, error) {
 	ret := _mock.Called(ctx)
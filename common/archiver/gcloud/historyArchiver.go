@@ -140,7 +140,7 @@ func (h *historyArchiver) Archive(ctx context.Context, URI archiver.URI, request
 	historyIterator := h.historyIterator
 	var progress progress
 	if historyIterator == nil { // will only be set by testing code
-		historyIterator, _ = loadHistoryIterator(ctx, request, h.container.HistoryV2Manager, featureCatalog, &progress)
+		historyIterator, _ = loadHistoryIterator(ctx, request, h.container.HistoryV2Manager, h.container.PayloadCodec, featureCatalog, &progress)
 	}
 
 	for historyIterator.HasNext() {
@@ -262,6 +262,9 @@ outer:
 		}
 		// trim the batches in the beginning based on token.BatchIdxOffset
 		batches = batches[token.BatchIdxOffset:]
+		if err := archiver.DecodeHistoryBatches(h.container.PayloadCodec, batches, h.container.Logger); err != nil {
+			return nil, &types.InternalServiceError{Message: err.Error()}
+		}
 
 		for idx, batch := range batches {
 			response.HistoryBatches = append(response.HistoryBatches, batch)
@@ -295,6 +298,34 @@ outer:
 	return response, nil
 }
 
+var _ archiver.HistoryDeleter = (*historyArchiver)(nil)
+
+// Delete removes every archived part of a workflow run, across all close failover versions.
+// It succeeds if nothing was archived for the run.
+func (h *historyArchiver) Delete(ctx context.Context, URI archiver.URI, request *archiver.DeleteHistoryRequest) error {
+
+	if err := h.ValidateURI(URI); err != nil {
+		return &types.BadRequestError{Message: archiver.ErrInvalidURI.Error()}
+	}
+
+	if err := archiver.ValidateDeleteRequest(request); err != nil {
+		return &types.BadRequestError{Message: archiver.ErrInvalidDeleteHistoryRequest.Error()}
+	}
+
+	filenames, err := h.gcloudStorage.Query(ctx, URI, constructHistoryFilenamePrefix(request.DomainID, request.WorkflowID, request.RunID)+"_")
+	if err != nil {
+		return &types.InternalServiceError{Message: err.Error()}
+	}
+
+	for _, filename := range filenames {
+		if err := h.gcloudStorage.Delete(ctx, URI, filepath.Base(filename)); err != nil {
+			return &types.InternalServiceError{Message: err.Error()}
+		}
+	}
+
+	return nil
+}
+
 // ValidateURI is used to define what a valid URI for an implementation is.
 func (h *historyArchiver) ValidateURI(URI archiver.URI) (err error) {
 
@@ -385,11 +416,11 @@ func (h *historyArchiver) getHighestVersion(ctx context.Context, URI archiver.UR
 	return highestVersion, highestVersionPart, lowestVersionPart, nil
 }
 
-func loadHistoryIterator(ctx context.Context, request *archiver.ArchiveHistoryRequest, historyManager persistence.HistoryManager, featureCatalog *archiver.ArchiveFeatureCatalog, progress *progress) (historyIterator archiver.HistoryIterator, err error) {
+func loadHistoryIterator(ctx context.Context, request *archiver.ArchiveHistoryRequest, historyManager persistence.HistoryManager, payloadCodec persistence.PayloadCodec, featureCatalog *archiver.ArchiveFeatureCatalog, progress *progress) (historyIterator archiver.HistoryIterator, err error) {
 
 	defer func() {
 		if err != nil || historyIterator == nil {
-			historyIterator, err = archiver.NewHistoryIteratorFromState(ctx, request, historyManager, targetHistoryBlobSize, nil)
+			historyIterator, err = archiver.NewHistoryIteratorFromState(ctx, request, historyManager, payloadCodec, targetHistoryBlobSize, nil)
 		}
 	}()
 
@@ -397,7 +428,7 @@ func loadHistoryIterator(ctx context.Context, request *archiver.ArchiveHistoryRe
 		if featureCatalog.ProgressManager.HasProgress(ctx) {
 			err = featureCatalog.ProgressManager.LoadProgress(ctx, &progress)
 			if err == nil {
-				historyIterator, err = archiver.NewHistoryIteratorFromState(ctx, request, historyManager, targetHistoryBlobSize, progress.IteratorState)
+				historyIterator, err = archiver.NewHistoryIteratorFromState(ctx, request, historyManager, payloadCodec, targetHistoryBlobSize, progress.IteratorState)
 			}
 		}
 
@@ -593,3 +593,69 @@ func (h *historyArchiverSuite) TestGet_Success_FromToken() {
 
 	h.EqualValues(4, numOfEvents)
 }
+
+func (h *historyArchiverSuite) TestDelete_Fail_InvalidURI() {
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(h.T())
+	mockStorageClient := &mocks.GcloudStorageClient{}
+	storageWrapper, _ := connector.NewClientWithParams(mockStorageClient)
+	historyIterator := archiver.NewMockHistoryIterator(mockCtrl)
+	historyArchiver := newHistoryArchiver(h.container, historyIterator, storageWrapper)
+
+	request := &archiver.DeleteHistoryRequest{
+		DomainID:   testDomainID,
+		WorkflowID: testWorkflowID,
+		RunID:      testRunID,
+	}
+	URI, err := archiver.NewURI("wrongscheme://")
+	h.NoError(err)
+	err = historyArchiver.(archiver.HistoryDeleter).Delete(ctx, URI, request)
+	h.Error(err)
+	h.IsType(&types.BadRequestError{}, err)
+}
+
+func (h *historyArchiverSuite) TestDelete_Fail_InvalidRequest() {
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(h.T())
+	URI, err := archiver.NewURI("gs://my-bucket-cad/cadence_archival/development")
+	h.NoError(err)
+	storageWrapper := &mocks.Client{}
+	storageWrapper.On("Exist", ctx, URI, "").Return(true, nil).Times(1)
+	historyIterator := archiver.NewMockHistoryIterator(mockCtrl)
+	historyArchiver := newHistoryArchiver(h.container, historyIterator, storageWrapper)
+
+	request := &archiver.DeleteHistoryRequest{
+		DomainID:   testDomainID,
+		WorkflowID: "",
+		RunID:      testRunID,
+	}
+	err = historyArchiver.(archiver.HistoryDeleter).Delete(ctx, URI, request)
+	h.Error(err)
+	h.IsType(&types.BadRequestError{}, err)
+}
+
+func (h *historyArchiverSuite) TestDelete_Success() {
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(h.T())
+	URI, err := archiver.NewURI("gs://my-bucket-cad/cadence_archival/development")
+	h.NoError(err)
+	storageWrapper := &mocks.Client{}
+	storageWrapper.On("Exist", ctx, URI, "").Return(true, nil).Times(1)
+	storageWrapper.On("Query", ctx, URI, "71817125141568232911739672280485489488911532452831150339470_").Return([]string{
+		"cadence_archival/development/71817125141568232911739672280485489488911532452831150339470_-24_0.history",
+		"cadence_archival/development/71817125141568232911739672280485489488911532452831150339470_-25_0.history",
+	}, nil).Times(1)
+	storageWrapper.On("Delete", ctx, URI, "71817125141568232911739672280485489488911532452831150339470_-24_0.history").Return(nil).Times(1)
+	storageWrapper.On("Delete", ctx, URI, "71817125141568232911739672280485489488911532452831150339470_-25_0.history").Return(nil).Times(1)
+	historyIterator := archiver.NewMockHistoryIterator(mockCtrl)
+	historyArchiver := newHistoryArchiver(h.container, historyIterator, storageWrapper)
+
+	request := &archiver.DeleteHistoryRequest{
+		DomainID:   testDomainID,
+		WorkflowID: testWorkflowID,
+		RunID:      testRunID,
+	}
+	err = historyArchiver.(archiver.HistoryDeleter).Delete(ctx, URI, request)
+	h.NoError(err)
+	storageWrapper.AssertExpectations(h.T())
+}
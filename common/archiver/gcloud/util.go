@@ -141,7 +141,11 @@ func decodeVisibilityRecord(data []byte) (*visibilityRecord, error) {
 func constructVisibilityFilename(domain, workflowTypeName, workflowID, runID, tag string, timestamp int64) string {
 	t := time.Unix(0, timestamp).In(time.UTC)
 	prefix := constructVisibilityFilenamePrefix(domain, tag)
-	return fmt.Sprintf("%s_%s_%s_%s_%s.visibility", prefix, t.Format(time.RFC3339), hash(workflowTypeName), hash(workflowID), hash(runID))
+	return fmt.Sprintf("%s_%s_%s%s", prefix, t.Format(time.RFC3339), hash(workflowTypeName), constructVisibilityFilenameSuffix(workflowID, runID))
+}
+
+func constructVisibilityFilenameSuffix(workflowID, runID string) string {
+	return fmt.Sprintf("_%s_%s.visibility", hash(workflowID), hash(runID))
 }
 
 func deserializeQueryVisibilityToken(bytes []byte) (*queryVisibilityToken, error) {
@@ -25,6 +25,7 @@ import (
 	"errors"
 	"fmt"
 	"path/filepath"
+	"strings"
 	"time"
 
 	"github.com/uber/cadence/common/archiver"
@@ -239,6 +240,38 @@ func (v *visibilityArchiver) query(ctx context.Context, URI archiver.URI, reques
 	return response, nil
 }
 
+var _ archiver.VisibilityDeleter = (*visibilityArchiver)(nil)
+
+// Delete removes the archived visibility records of a workflow run
+func (v *visibilityArchiver) Delete(ctx context.Context, URI archiver.URI, request *archiver.DeleteVisibilityRequest) error {
+	if err := v.ValidateURI(URI); err != nil {
+		return &types.BadRequestError{Message: archiver.ErrInvalidURI.Error()}
+	}
+
+	if err := archiver.ValidateDeleteVisibilityRequest(request); err != nil {
+		return &types.BadRequestError{Message: archiver.ErrInvalidDeleteVisibilityRequest.Error()}
+	}
+
+	// the records are named after their timestamps, which are not known once the execution is gone
+	suffix := constructVisibilityFilenameSuffix(request.WorkflowID, request.RunID)
+	for _, indexKey := range []string{indexKeyCloseTimeout, indexKeyStartTimeout} {
+		filenames, err := v.gcloudStorage.Query(ctx, URI, constructVisibilityFilenamePrefix(request.DomainID, indexKey))
+		if err != nil {
+			return &types.InternalServiceError{Message: err.Error()}
+		}
+		for _, filename := range filenames {
+			if !strings.HasSuffix(filename, suffix) {
+				continue
+			}
+			if err := v.gcloudStorage.Delete(ctx, URI, request.DomainID+"/"+filepath.Base(filename)); err != nil {
+				return &types.InternalServiceError{Message: err.Error()}
+			}
+		}
+	}
+
+	return nil
+}
+
 // ValidateURI is used to define what a valid URI for an implementation is.
 func (v *visibilityArchiver) ValidateURI(URI archiver.URI) (err error) {
 	ctx, cancel := context.WithTimeout(context.Background(), timeoutInSeconds*time.Second)
@@ -185,6 +185,50 @@ func (s *visibilityArchiverSuite) TestVisibilityArchive() {
 	s.NoError(err)
 }
 
+func (s *visibilityArchiverSuite) TestDelete_Fail_InvalidRequest() {
+	ctx := context.Background()
+	URI, err := archiver.NewURI("gs://my-bucket-cad/cadence_archival/development")
+	s.NoError(err)
+	storageWrapper := &mocks.Client{}
+	storageWrapper.On("Exist", mock.Anything, URI, "").Return(true, nil).Times(1)
+
+	visibilityArchiver := newVisibilityArchiver(s.container, storageWrapper)
+	err = visibilityArchiver.Delete(ctx, URI, &archiver.DeleteVisibilityRequest{
+		DomainID:   testDomainID,
+		WorkflowID: testWorkflowID,
+	})
+	s.IsType(&types.BadRequestError{}, err)
+}
+
+func (s *visibilityArchiverSuite) TestDelete_Success() {
+	ctx := context.Background()
+	URI, err := archiver.NewURI("gs://my-bucket-cad/cadence_archival/development")
+	s.NoError(err)
+	request := &archiver.DeleteVisibilityRequest{
+		DomainID:   testDomainID,
+		WorkflowID: testWorkflowID,
+		RunID:      testRunID,
+	}
+	closeFilename := constructVisibilityFilename(testDomainID, testWorkflowTypeName, testWorkflowID, testRunID, indexKeyCloseTimeout, 1580896575946478000)
+	startFilename := constructVisibilityFilename(testDomainID, testWorkflowTypeName, testWorkflowID, testRunID, indexKeyStartTimeout, 1580896574804475000)
+	otherRunFilename := constructVisibilityFilename(testDomainID, testWorkflowTypeName, testWorkflowID, "other-run-id", indexKeyCloseTimeout, 1580896575946478000)
+	storageWrapper := &mocks.Client{}
+	storageWrapper.On("Exist", mock.Anything, URI, "").Return(true, nil).Times(1)
+	storageWrapper.On("Query", ctx, URI, constructVisibilityFilenamePrefix(testDomainID, indexKeyCloseTimeout)).Return([]string{
+		"cadence_archival/development/" + closeFilename,
+		"cadence_archival/development/" + otherRunFilename,
+	}, nil).Times(1)
+	storageWrapper.On("Query", ctx, URI, constructVisibilityFilenamePrefix(testDomainID, indexKeyStartTimeout)).Return([]string{
+		"cadence_archival/development/" + startFilename,
+	}, nil).Times(1)
+	storageWrapper.On("Delete", ctx, URI, closeFilename).Return(nil).Times(1)
+	storageWrapper.On("Delete", ctx, URI, startFilename).Return(nil).Times(1)
+
+	visibilityArchiver := newVisibilityArchiver(s.container, storageWrapper)
+	s.NoError(visibilityArchiver.Delete(ctx, URI, request))
+	storageWrapper.AssertExpectations(s.T())
+}
+
 func (s *visibilityArchiverSuite) TestQuery_Fail_InvalidQuery() {
 	ctx := context.Background()
 	URI, err := archiver.NewURI("gs://my-bucket-cad/cadence_archival/visibility")
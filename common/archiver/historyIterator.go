@@ -77,6 +77,7 @@ type (
 		ctx                   context.Context
 		request               *ArchiveHistoryRequest
 		historyV2Manager      persistence.HistoryManager
+		payloadCodec          persistence.PayloadCodec
 		sizeEstimator         SizeEstimator
 		historyPageSize       int
 		targetHistoryBlobSize int
@@ -87,14 +88,16 @@ var (
 	errIteratorDepleted = errors.New("iterator is depleted")
 )
 
-// NewHistoryIterator returns a new HistoryIterator
+// NewHistoryIterator returns a new HistoryIterator, the payloads of the returned blobs are encoded at rest
+// with payloadCodec, which is optional
 func NewHistoryIterator(
 	ctx context.Context,
 	request *ArchiveHistoryRequest,
 	historyV2Manager persistence.HistoryManager,
+	payloadCodec persistence.PayloadCodec,
 	targetHistoryBlobSize int,
 ) HistoryIterator {
-	return newHistoryIterator(ctx, request, historyV2Manager, targetHistoryBlobSize)
+	return newHistoryIterator(ctx, request, historyV2Manager, payloadCodec, targetHistoryBlobSize)
 }
 
 // NewHistoryIteratorFromState returns a new HistoryIterator with specified state
@@ -102,10 +105,11 @@ func NewHistoryIteratorFromState(
 	ctx context.Context,
 	request *ArchiveHistoryRequest,
 	historyV2Manager persistence.HistoryManager,
+	payloadCodec persistence.PayloadCodec,
 	targetHistoryBlobSize int,
 	initialState []byte,
 ) (HistoryIterator, error) {
-	it := newHistoryIterator(ctx, request, historyV2Manager, targetHistoryBlobSize)
+	it := newHistoryIterator(ctx, request, historyV2Manager, payloadCodec, targetHistoryBlobSize)
 	if initialState == nil {
 		return it, nil
 	}
@@ -119,6 +123,7 @@ func newHistoryIterator(
 	ctx context.Context,
 	request *ArchiveHistoryRequest,
 	historyV2Manager persistence.HistoryManager,
+	payloadCodec persistence.PayloadCodec,
 	targetHistoryBlobSize int,
 ) *historyIterator {
 	return &historyIterator{
@@ -129,6 +134,7 @@ func newHistoryIterator(
 		ctx:                   ctx,
 		request:               request,
 		historyV2Manager:      historyV2Manager,
+		payloadCodec:          payloadCodec,
 		historyPageSize:       historyPageSize,
 		targetHistoryBlobSize: targetHistoryBlobSize,
 		sizeEstimator:         NewJSONSizeEstimator(),
@@ -166,6 +172,13 @@ func (i *historyIterator) Next() (*HistoryBlob, error) {
 		EventCount:           common.Int64Ptr(eventCount),
 	}
 
+	// the archived history is protected like the history it is read from
+	for _, batch := range historyBatches {
+		if batch.Events, err = persistence.EncodeEventsAtRest(i.payloadCodec, i.request.DomainName, batch.Events); err != nil {
+			return nil, err
+		}
+	}
+
 	return &HistoryBlob{
 		Header: header,
 		Body:   historyBatches,
@@ -61,6 +61,13 @@ type (
 		NextPageToken  []byte
 	}
 
+	// DeleteHistoryRequest is the request to delete the archived history of a workflow run
+	DeleteHistoryRequest struct {
+		DomainID   string
+		WorkflowID string
+		RunID      string
+	}
+
 	// HistoryBootstrapContainer contains components needed by all history Archiver implementations
 	HistoryBootstrapContainer struct {
 		HistoryV2Manager  persistence.HistoryManager
@@ -69,6 +76,8 @@ type (
 		ClusterMetadata   cluster.Metadata
 		DomainCache       cache.DomainCache
 		DynamicCollection *dynamicconfig.Collection
+		// PayloadCodec encodes the payloads of the archived history at rest, it is optional
+		PayloadCodec persistence.PayloadCodec
 	}
 
 	// HistoryArchiver is used to archive history and read archived history
@@ -78,6 +87,13 @@ type (
 		ValidateURI(URI) error
 	}
 
+	// HistoryDeleter is optionally implemented by a HistoryArchiver that can delete archived history.
+	// Archivers that don't implement it keep archived history until it expires in the blobstore.
+	HistoryDeleter interface {
+		// Delete removes every archived copy of the history of a workflow run, it succeeds if there is none
+		Delete(context.Context, URI, *DeleteHistoryRequest) error
+	}
+
 	// VisibilityBootstrapContainer contains components needed by all visibility Archiver implementations
 	VisibilityBootstrapContainer struct {
 		Logger            log.Logger
@@ -118,10 +134,24 @@ type (
 		NextPageToken []byte
 	}
 
+	// DeleteVisibilityRequest is the request to delete the archived visibility records of a workflow run
+	DeleteVisibilityRequest struct {
+		DomainID   string
+		WorkflowID string
+		RunID      string
+	}
+
 	// VisibilityArchiver is used to archive visibility and read archived visibility
 	VisibilityArchiver interface {
 		Archive(context.Context, URI, *ArchiveVisibilityRequest, ...ArchiveOption) error
 		Query(context.Context, URI, *QueryVisibilityRequest) (*QueryVisibilityResponse, error)
 		ValidateURI(URI) error
 	}
+
+	// VisibilityDeleter is optionally implemented by a VisibilityArchiver that can delete archived visibility
+	// records. Archivers that don't implement it keep archived visibility records until they expire in the blobstore.
+	VisibilityDeleter interface {
+		// Delete removes every archived visibility record of a workflow run, it succeeds if there is none
+		Delete(context.Context, URI, *DeleteVisibilityRequest) error
+	}
 )
@@ -115,7 +115,6 @@ func (_c *HistoryArchiverMock_Archive_Call) RunAndReturn(run func(context1 conte
 	return _c
 }
 
-// Get provides a mock function for the type HistoryArchiverMock
 func (_mock *HistoryArchiverMock) Get(context1 context.Context, uRI URI, getHistoryRequest *GetHistoryRequest) (*GetHistoryResponse, error) {
 	ret := _mock.Called(context1, uRI, getHistoryRequest)
 
@@ -240,6 +239,96 @@ func (_c *HistoryArchiverMock_ValidateURI_Call) RunAndReturn(run func(uRI URI) e
 	return _c
 }
 
+// NewHistoryDeleterMock creates a new instance of HistoryDeleterMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewHistoryDeleterMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *HistoryDeleterMock {
+	mock := &HistoryDeleterMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// HistoryDeleterMock is an autogenerated mock type for the HistoryDeleter type
+type HistoryDeleterMock struct {
+	mock.Mock
+}
+
+type HistoryDeleterMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *HistoryDeleterMock) EXPECT() *HistoryDeleterMock_Expecter {
+	return &HistoryDeleterMock_Expecter{mock: &_m.Mock}
+}
+
+// Delete provides a mock function for the type HistoryDeleterMock
+func (_mock *HistoryDeleterMock) Delete(context1 context.Context, uRI URI, deleteHistoryRequest *DeleteHistoryRequest) error {
+	ret := _mock.Called(context1, uRI, deleteHistoryRequest)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, URI, *DeleteHistoryRequest) error); ok {
+		r0 = returnFunc(context1, uRI, deleteHistoryRequest)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// HistoryDeleterMock_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type HistoryDeleterMock_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - context1 context.Context
+//   - uRI URI
+//   - deleteHistoryRequest *DeleteHistoryRequest
+func (_e *HistoryDeleterMock_Expecter) Delete(context1 interface{}, uRI interface{}, deleteHistoryRequest interface{}) *HistoryDeleterMock_Delete_Call {
+	return &HistoryDeleterMock_Delete_Call{Call: _e.mock.On("Delete", context1, uRI, deleteHistoryRequest)}
+}
+
+func (_c *HistoryDeleterMock_Delete_Call) Run(run func(context1 context.Context, uRI URI, deleteHistoryRequest *DeleteHistoryRequest)) *HistoryDeleterMock_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 URI
+		if args[1] != nil {
+			arg1 = args[1].(URI)
+		}
+		var arg2 *DeleteHistoryRequest
+		if args[2] != nil {
+			arg2 = args[2].(*DeleteHistoryRequest)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *HistoryDeleterMock_Delete_Call) Return(err error) *HistoryDeleterMock_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *HistoryDeleterMock_Delete_Call) RunAndReturn(run func(context1 context.Context, uRI URI, deleteHistoryRequest *DeleteHistoryRequest) error) *HistoryDeleterMock_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewVisibilityArchiverMock creates a new instance of VisibilityArchiverMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewVisibilityArchiverMock(t interface {
@@ -469,3 +558,93 @@ func (_c *VisibilityArchiverMock_ValidateURI_Call) RunAndReturn(run func(uRI URI
 	_c.Call.Return(run)
 	return _c
 }
+
+// NewVisibilityDeleterMock creates a new instance of VisibilityDeleterMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewVisibilityDeleterMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *VisibilityDeleterMock {
+	mock := &VisibilityDeleterMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// VisibilityDeleterMock is an autogenerated mock type for the VisibilityDeleter type
+type VisibilityDeleterMock struct {
+	mock.Mock
+}
+
+type VisibilityDeleterMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *VisibilityDeleterMock) EXPECT() *VisibilityDeleterMock_Expecter {
+	return &VisibilityDeleterMock_Expecter{mock: &_m.Mock}
+}
+
+// Delete provides a mock function for the type VisibilityDeleterMock
+func (_mock *VisibilityDeleterMock) Delete(context1 context.Context, uRI URI, deleteVisibilityRequest *DeleteVisibilityRequest) error {
+	ret := _mock.Called(context1, uRI, deleteVisibilityRequest)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, URI, *DeleteVisibilityRequest) error); ok {
+		r0 = returnFunc(context1, uRI, deleteVisibilityRequest)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// VisibilityDeleterMock_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type VisibilityDeleterMock_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - context1 context.Context
+//   - uRI URI
+//   - deleteVisibilityRequest *DeleteVisibilityRequest
+func (_e *VisibilityDeleterMock_Expecter) Delete(context1 interface{}, uRI interface{}, deleteVisibilityRequest interface{}) *VisibilityDeleterMock_Delete_Call {
+	return &VisibilityDeleterMock_Delete_Call{Call: _e.mock.On("Delete", context1, uRI, deleteVisibilityRequest)}
+}
+
+func (_c *VisibilityDeleterMock_Delete_Call) Run(run func(context1 context.Context, uRI URI, deleteVisibilityRequest *DeleteVisibilityRequest)) *VisibilityDeleterMock_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 URI
+		if args[1] != nil {
+			arg1 = args[1].(URI)
+		}
+		var arg2 *DeleteVisibilityRequest
+		if args[2] != nil {
+			arg2 = args[2].(*DeleteVisibilityRequest)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *VisibilityDeleterMock_Delete_Call) Return(err error) *VisibilityDeleterMock_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *VisibilityDeleterMock_Delete_Call) RunAndReturn(run func(context1 context.Context, uRI URI, deleteVisibilityRequest *DeleteVisibilityRequest) error) *VisibilityDeleterMock_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
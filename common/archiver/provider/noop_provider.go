@@ -58,6 +58,10 @@ func (*noOpHistoryArchiver) Get(context.Context, archiver.URI, *archiver.GetHist
 	return &archiver.GetHistoryResponse{}, nil
 }
 
+func (*noOpHistoryArchiver) Delete(context.Context, archiver.URI, *archiver.DeleteHistoryRequest) error {
+	return nil
+}
+
 func (*noOpHistoryArchiver) ValidateURI(archiver.URI) error {
 	return nil
 }
@@ -26,6 +26,7 @@ import (
 	"context"
 	"encoding/binary"
 	"errors"
+	"fmt"
 	"strconv"
 	"strings"
 	"time"
@@ -71,9 +72,10 @@ var (
 
 type (
 	historyArchiver struct {
-		container *archiver.HistoryBootstrapContainer
-		s3cli     s3iface.S3API
-		region    string
+		container   *archiver.HistoryBootstrapContainer
+		s3cli       s3iface.S3API
+		region      string
+		compression archiver.ArchiveCompression
 		// only set in test code
 		historyIterator archiver.HistoryIterator
 	}
@@ -104,6 +106,25 @@ func newHistoryArchiver(
 	config *config.S3Archiver,
 	historyIterator archiver.HistoryIterator,
 ) (*historyArchiver, error) {
+	s3cli, err := newS3Client(config)
+	if err != nil {
+		return nil, err
+	}
+	compression, err := archiver.ParseArchiveCompression(config.Compression)
+	if err != nil {
+		return nil, err
+	}
+
+	return &historyArchiver{
+		container:       container,
+		s3cli:           s3cli,
+		region:          config.Region,
+		compression:     compression,
+		historyIterator: historyIterator,
+	}, nil
+}
+
+func newS3Client(config *config.S3Archiver) (s3iface.S3API, error) {
 	if len(config.Region) == 0 {
 		return nil, errEmptyAwsRegion
 	}
@@ -116,13 +137,7 @@ func newHistoryArchiver(
 	if err != nil {
 		return nil, err
 	}
-
-	return &historyArchiver{
-		container:       container,
-		s3cli:           s3.New(sess),
-		region:          config.Region,
-		historyIterator: historyIterator,
-	}, nil
+	return s3.New(sess), nil
 }
 func (h *historyArchiver) Archive(
 	ctx context.Context,
@@ -162,7 +177,7 @@ func (h *historyArchiver) Archive(
 	var progress uploadProgress
 	historyIterator := h.historyIterator
 	if historyIterator == nil { // will only be set by testing code
-		historyIterator = loadHistoryIterator(ctx, request, h.container.HistoryV2Manager, featureCatalog, &progress)
+		historyIterator = loadHistoryIterator(ctx, request, h.container.HistoryV2Manager, h.container.PayloadCodec, featureCatalog, &progress)
 	}
 	for historyIterator.HasNext() {
 		historyBlob, err := getNextHistoryBlob(ctx, historyIterator)
@@ -191,7 +206,7 @@ func (h *historyArchiver) Archive(
 			}
 		}
 
-		encodedHistoryBlob, err := encode(historyBlob)
+		encodedHistoryBlob, err := encodeHistoryBlob(historyBlob, h.compression)
 		if err != nil {
 			logger.Error(archiver.ArchiveNonRetriableErrorMsg, tag.ArchivalArchiveFailReason(errEncodeHistory), tag.Error(err))
 			return err
@@ -242,12 +257,12 @@ func (h *historyArchiver) Archive(
 	return nil
 }
 
-func loadHistoryIterator(ctx context.Context, request *archiver.ArchiveHistoryRequest, historyManager persistence.HistoryManager, featureCatalog *archiver.ArchiveFeatureCatalog, progress *uploadProgress) (historyIterator archiver.HistoryIterator) {
+func loadHistoryIterator(ctx context.Context, request *archiver.ArchiveHistoryRequest, historyManager persistence.HistoryManager, payloadCodec persistence.PayloadCodec, featureCatalog *archiver.ArchiveFeatureCatalog, progress *uploadProgress) (historyIterator archiver.HistoryIterator) {
 	if featureCatalog.ProgressManager != nil {
 		if featureCatalog.ProgressManager.HasProgress(ctx) {
 			err := featureCatalog.ProgressManager.LoadProgress(ctx, progress)
 			if err == nil {
-				historyIterator, err := archiver.NewHistoryIteratorFromState(ctx, request, historyManager, targetHistoryBlobSize, progress.IteratorState)
+				historyIterator, err := archiver.NewHistoryIteratorFromState(ctx, request, historyManager, payloadCodec, targetHistoryBlobSize, progress.IteratorState)
 				if err == nil {
 					return historyIterator
 				}
@@ -258,7 +273,7 @@ func loadHistoryIterator(ctx context.Context, request *archiver.ArchiveHistoryRe
 			progress.uploadedSize = 0
 		}
 	}
-	return archiver.NewHistoryIterator(ctx, request, historyManager, targetHistoryBlobSize)
+	return archiver.NewHistoryIterator(ctx, request, historyManager, payloadCodec, targetHistoryBlobSize)
 }
 
 func saveHistoryIteratorState(ctx context.Context, featureCatalog *archiver.ArchiveFeatureCatalog, historyIterator archiver.HistoryIterator, progress *uploadProgress) {
@@ -337,6 +352,9 @@ func (h *historyArchiver) Get(
 		if err != nil {
 			return nil, &types.InternalServiceError{Message: err.Error()}
 		}
+		if err := archiver.DecodeHistoryBatches(h.container.PayloadCodec, historyBlob.Body, h.container.Logger); err != nil {
+			return nil, &types.InternalServiceError{Message: err.Error()}
+		}
 
 		for _, batch := range historyBlob.Body {
 			response.HistoryBatches = append(response.HistoryBatches, batch)
@@ -360,6 +378,64 @@ func (h *historyArchiver) Get(
 	return response, nil
 }
 
+var _ archiver.HistoryDeleter = (*historyArchiver)(nil)
+
+func (h *historyArchiver) Delete(
+	ctx context.Context,
+	URI archiver.URI,
+	request *archiver.DeleteHistoryRequest,
+) error {
+	if err := softValidateURI(URI); err != nil {
+		return &types.BadRequestError{Message: archiver.ErrInvalidURI.Error()}
+	}
+
+	if err := archiver.ValidateDeleteRequest(request); err != nil {
+		return &types.BadRequestError{Message: archiver.ErrInvalidDeleteHistoryRequest.Error()}
+	}
+
+	ctx, cancel := ensureContextTimeout(ctx)
+	defer cancel()
+	bucket, err := s3Bucket(URI, h.region)
+	if err != nil {
+		return &types.BadRequestError{Message: err.Error()}
+	}
+
+	// list every version and batch first, deleting while paginating could skip keys
+	prefix := constructHistoryKeyPrefix(s3KeyPath(URI), request.DomainID, request.WorkflowID, request.RunID) + "/"
+	var keys []*string
+	var continuationToken *string
+	for {
+		results, err := h.s3cli.ListObjectsV2WithContext(ctx, &s3.ListObjectsV2Input{
+			Bucket:            aws.String(bucket),
+			Prefix:            aws.String(prefix),
+			ContinuationToken: continuationToken,
+		})
+		if err != nil {
+			if aerr, ok := err.(awserr.Error); ok && aerr.Code() == s3.ErrCodeNoSuchBucket {
+				return &types.BadRequestError{Message: errBucketNotExists.Error()}
+			}
+			return &types.InternalServiceError{Message: err.Error()}
+		}
+		for _, item := range results.Contents {
+			keys = append(keys, item.Key)
+		}
+		if !aws.BoolValue(results.IsTruncated) {
+			break
+		}
+		continuationToken = results.NextContinuationToken
+	}
+
+	for _, key := range keys {
+		if _, err := h.s3cli.DeleteObjectWithContext(ctx, &s3.DeleteObjectInput{
+			Bucket: aws.String(bucket),
+			Key:    key,
+		}); err != nil {
+			return &types.InternalServiceError{Message: err.Error()}
+		}
+	}
+	return nil
+}
+
 func (h *historyArchiver) ValidateURI(URI archiver.URI) error {
 	err := softValidateURI(URI)
 	if err != nil {
@@ -454,3 +530,99 @@ func isStatusCodeRetryable(err error) bool {
 	}
 	return false
 }
+
+// ReencodeHistory rewrites in place the histories archived under the URI with the given compression.
+// Blobs already written with that compression are skipped. It returns the number of rewritten blobs.
+func ReencodeHistory(
+	ctx context.Context,
+	URI archiver.URI,
+	config *config.S3Archiver,
+	compression archiver.ArchiveCompression,
+) (int, error) {
+	if err := softValidateURI(URI); err != nil {
+		return 0, err
+	}
+	s3cli, err := newS3Client(config)
+	if err != nil {
+		return 0, err
+	}
+	return reencodeHistory(ctx, s3cli, URI, config.Region, compression)
+}
+
+func reencodeHistory(
+	ctx context.Context,
+	s3cli s3iface.S3API,
+	URI archiver.URI,
+	region string,
+	compression archiver.ArchiveCompression,
+) (int, error) {
+	bucket, err := s3Bucket(URI, region)
+	if err != nil {
+		return 0, err
+	}
+	keyPath := strings.TrimLeft(s3KeyPath(URI), "/")
+	prefix := ""
+	if keyPath != "" {
+		prefix = keyPath + "/"
+	}
+
+	count := 0
+	var continuationToken *string
+	for {
+		results, err := s3cli.ListObjectsV2WithContext(ctx, &s3.ListObjectsV2Input{
+			Bucket:            aws.String(bucket),
+			Prefix:            aws.String(prefix),
+			ContinuationToken: continuationToken,
+		})
+		if err != nil {
+			return count, err
+		}
+		for _, item := range results.Contents {
+			key := aws.StringValue(item.Key)
+			if !isHistoryKey(prefix, key) {
+				continue
+			}
+			reencoded, err := reencodeHistoryBlob(ctx, s3cli, URI, region, key, compression)
+			if err != nil {
+				return count, fmt.Errorf("failed to re-encode %v: %w", key, err)
+			}
+			if reencoded {
+				count++
+			}
+		}
+		if !aws.BoolValue(results.IsTruncated) {
+			return count, nil
+		}
+		continuationToken = results.NextContinuationToken
+	}
+}
+
+func reencodeHistoryBlob(
+	ctx context.Context,
+	s3cli s3iface.S3API,
+	URI archiver.URI,
+	region string,
+	key string,
+	compression archiver.ArchiveCompression,
+) (bool, error) {
+	blob, err := download(ctx, s3cli, URI, region, key)
+	if err != nil {
+		return false, err
+	}
+	if archiver.GetArchiveBlobCompression(blob) == compression {
+		return false, nil
+	}
+	data, err := archiver.DecodeArchiveBlob(blob)
+	if err != nil {
+		return false, err
+	}
+	encoded, err := archiver.EncodeArchiveBlob(data, compression)
+	if err != nil {
+		return false, err
+	}
+	// a single PUT replaces the object atomically
+	if err := upload(ctx, s3cli, URI, region, key, encoded); err != nil {
+		return false, err
+	}
+	return true, nil
+}
@@ -208,6 +208,12 @@ func setupFsEmulation(s3cli *mocks.S3API) {
 		return !ok
 	})).Return(nil, awserr.New(s3.ErrCodeNoSuchKey, "", nil))
 	s3cli.On("GetObjectWithContext", mock.Anything, mock.Anything).Return(getObjectFn, nil)
+
+	s3cli.On("DeleteObjectWithContext", mock.Anything, mock.Anything).
+		Return(func(_ aws.Context, input *s3.DeleteObjectInput, _ ...request.Option) *s3.DeleteObjectOutput {
+			delete(fs, *input.Bucket+*input.Key)
+			return &s3.DeleteObjectOutput{}
+		}, nil)
 }
 
 func (s *historyArchiverSuite) TestValidateURI() {
@@ -657,6 +663,108 @@ func (s *historyArchiverSuite) TestArchiveAndGet() {
 	s.Equal(append(s.historyBatchesV100[0].Body, s.historyBatchesV100[1].Body...), response.HistoryBatches)
 }
 
+func (s *historyArchiverSuite) TestDelete_Fail_InvalidRequest() {
+	historyArchiver := s.newTestHistoryArchiver(nil)
+	err := historyArchiver.Delete(context.Background(), s.testArchivalURI, &archiver.DeleteHistoryRequest{
+		DomainID:   testDomainID,
+		WorkflowID: testWorkflowID,
+	})
+	s.IsType(&types.BadRequestError{}, err)
+}
+
+func (s *historyArchiverSuite) TestDelete_Success() {
+	s3cli := &mocks.S3API{}
+	setupFsEmulation(s3cli)
+	URI, err := archiver.NewURI(testBucketURI + "/TestDelete")
+	s.NoError(err)
+	for _, runID := range []string{testRunID, "other-run-id"} {
+		for _, version := range []int64{1, testCloseFailoverVersion} {
+			key := constructHistoryKey("/TestDelete", testDomainID, testWorkflowID, runID, version, 0)
+			s.Require().NoError(upload(context.Background(), s3cli, URI, "", key, []byte("history")))
+		}
+	}
+
+	historyArchiver := &historyArchiver{
+		container: s.container,
+		s3cli:     s3cli,
+	}
+	request := &archiver.DeleteHistoryRequest{
+		DomainID:   testDomainID,
+		WorkflowID: testWorkflowID,
+		RunID:      testRunID,
+	}
+	s.NoError(historyArchiver.Delete(context.Background(), URI, request))
+
+	for _, version := range []int64{1, testCloseFailoverVersion} {
+		exists, err := keyExists(context.Background(), s3cli, URI, constructHistoryKey("/TestDelete", testDomainID, testWorkflowID, testRunID, version, 0), "")
+		s.NoError(err)
+		s.False(exists)
+		exists, err = keyExists(context.Background(), s3cli, URI, constructHistoryKey("/TestDelete", testDomainID, testWorkflowID, "other-run-id", version, 0), "")
+		s.NoError(err)
+		s.True(exists)
+	}
+
+	// deleting again is a no-op
+	s.NoError(historyArchiver.Delete(context.Background(), URI, request))
+}
+
+func (s *historyArchiverSuite) TestReencodeHistory() {
+	s3cli := &mocks.S3API{}
+	setupFsEmulation(s3cli)
+	for i, batch := range s.historyBatchesV100 {
+		data, err := encode(batch)
+		s.Require().NoError(err)
+		key := constructHistoryKey("/archival", testDomainID, testWorkflowID, testRunID, testCloseFailoverVersion, i)
+		s.Require().NoError(upload(context.Background(), s3cli, s.testArchivalURI, "", key, data))
+	}
+	visibilityKey := constructVisibilitySearchPrefix("/archival", testDomainID, "workflowTypeName", "test-workflow-type", "closeTimeout")
+	s.Require().NoError(upload(context.Background(), s3cli, s.testArchivalURI, "", visibilityKey, []byte("{}")))
+
+	URI, err := archiver.NewURI(testBucketURI + "/archival")
+	s.NoError(err)
+	count, err := reencodeHistory(context.Background(), s3cli, URI, "", archiver.ArchiveCompressionZstd)
+	s.NoError(err)
+	s.Equal(len(s.historyBatchesV100), count)
+	count, err = reencodeHistory(context.Background(), s3cli, URI, "", archiver.ArchiveCompressionZstd)
+	s.NoError(err)
+	s.Equal(0, count)
+
+	for i := range s.historyBatchesV100 {
+		key := constructHistoryKey("/archival", testDomainID, testWorkflowID, testRunID, testCloseFailoverVersion, i)
+		data, err := download(context.Background(), s3cli, URI, "", key)
+		s.NoError(err)
+		s.Equal(archiver.ArchiveCompressionZstd, archiver.GetArchiveBlobCompression(data))
+	}
+	data, err := download(context.Background(), s3cli, URI, "", visibilityKey)
+	s.NoError(err)
+	s.Equal([]byte("{}"), data)
+
+	historyArchiver := &historyArchiver{
+		container: s.container,
+		s3cli:     s3cli,
+	}
+	response, err := historyArchiver.Get(context.Background(), URI, &archiver.GetHistoryRequest{
+		DomainID:             testDomainID,
+		WorkflowID:           testWorkflowID,
+		RunID:                testRunID,
+		PageSize:             testPageSize,
+		CloseFailoverVersion: common.Int64Ptr(testCloseFailoverVersion),
+	})
+	s.NoError(err)
+	s.Nil(response.NextPageToken)
+	expectedHistory := []*types.History{}
+	for _, batch := range s.historyBatchesV100 {
+		expectedHistory = append(expectedHistory, batch.Body...)
+	}
+	s.Equal(expectedHistory, response.HistoryBatches)
+}
+
+func (s *historyArchiverSuite) TestIsHistoryKey() {
+	s.True(isHistoryKey("", constructHistoryKey("", testDomainID, testWorkflowID, testRunID, testCloseFailoverVersion, 0)))
+	s.True(isHistoryKey("a/b/", constructHistoryKey("/a/b", testDomainID, testWorkflowID, testRunID, testCloseFailoverVersion, 0)))
+	s.False(isHistoryKey("a/b/", constructTimestampIndex("/a/b", testDomainID, "workflowID", testWorkflowID, "closeTimeout", 1, testRunID)))
+}
+
 func (s *historyArchiverSuite) newTestHistoryArchiver(historyIterator archiver.HistoryIterator) *historyArchiver {
 	// config := &config.S3Archiver{}
 	// archiver, err := newHistoryArchiver(s.container, config, historyIterator)
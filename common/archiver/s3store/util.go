@@ -46,9 +46,21 @@ func encode(v interface{}) ([]byte, error) {
 	return json.Marshal(v)
 }
 
-func decodeHistoryBlob(data []byte) (*archiver.HistoryBlob, error) {
+func encodeHistoryBlob(historyBlob *archiver.HistoryBlob, compression archiver.ArchiveCompression) ([]byte, error) {
+	data, err := encode(historyBlob)
+	if err != nil {
+		return nil, err
+	}
+	return archiver.EncodeArchiveBlob(data, compression)
+}
+
+func decodeHistoryBlob(blob []byte) (*archiver.HistoryBlob, error) {
+	data, err := archiver.DecodeArchiveBlob(blob)
+	if err != nil {
+		return nil, err
+	}
 	historyBlob := &archiver.HistoryBlob{}
-	err := json.Unmarshal(data, historyBlob)
+	err = json.Unmarshal(data, historyBlob)
 	if err != nil {
 		return nil, err
 	}
@@ -240,6 +252,12 @@ func constructHistoryKeyPrefix(path, domainID, workflowID, runID string) string
 	return strings.TrimLeft(strings.Join([]string{path, domainID, "history", workflowID, runID}, "/"), "/")
 }
 
+// isHistoryKey returns true if the key, listed under the given prefix, is a history blob built by constructHistoryKey
+func isHistoryKey(prefix, key string) bool {
+	parts := strings.SplitN(strings.TrimPrefix(key, prefix), "/", 3)
+	return len(parts) == 3 && parts[1] == "history"
+}
+
 func constructTimeBasedSearchKey(path, domainID, primaryIndexKey, primaryIndexValue, secondaryIndexKey string, timestamp int64, precision string) string {
 	t := time.Unix(0, timestamp).In(time.UTC)
 	var timeFormat = ""
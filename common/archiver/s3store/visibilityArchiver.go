@@ -22,8 +22,10 @@ package s3store
 
 import (
 	"context"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/aws/aws-sdk-go/service/s3/s3iface"
@@ -250,6 +252,91 @@ func (v *visibilityArchiver) query(
 	return response, nil
 }
 
+var _ archiver.VisibilityDeleter = (*visibilityArchiver)(nil)
+
+func (v *visibilityArchiver) Delete(
+	ctx context.Context,
+	URI archiver.URI,
+	request *archiver.DeleteVisibilityRequest,
+) error {
+	if err := softValidateURI(URI); err != nil {
+		return &types.BadRequestError{Message: archiver.ErrInvalidURI.Error()}
+	}
+
+	if err := archiver.ValidateDeleteVisibilityRequest(request); err != nil {
+		return &types.BadRequestError{Message: archiver.ErrInvalidDeleteVisibilityRequest.Error()}
+	}
+
+	ctx, cancel := ensureContextTimeout(ctx)
+	defer cancel()
+	bucket, err := s3Bucket(URI, v.region)
+	if err != nil {
+		return &types.BadRequestError{Message: err.Error()}
+	}
+
+	// the record is indexed by its timestamps and workflow type, which are not known once the execution
+	// is gone, so the record is found through the workflow ID indexes and read to find the other keys
+	var found []string
+	for _, secondaryIndex := range []string{secondaryIndexKeyCloseTimeout, secondaryIndexKeyStartTimeout} {
+		prefix := constructVisibilitySearchPrefix(s3KeyPath(URI), request.DomainID, primaryIndexKeyWorkflowID, request.WorkflowID, secondaryIndex) + "/"
+		var continuationToken *string
+		for {
+			results, err := v.s3cli.ListObjectsV2WithContext(ctx, &s3.ListObjectsV2Input{
+				Bucket:            aws.String(bucket),
+				Prefix:            aws.String(prefix),
+				ContinuationToken: continuationToken,
+			})
+			if err != nil {
+				if aerr, ok := err.(awserr.Error); ok && aerr.Code() == s3.ErrCodeNoSuchBucket {
+					return &types.BadRequestError{Message: errBucketNotExists.Error()}
+				}
+				return &types.InternalServiceError{Message: err.Error()}
+			}
+			for _, item := range results.Contents {
+				if strings.HasSuffix(aws.StringValue(item.Key), "/"+request.RunID) {
+					found = append(found, aws.StringValue(item.Key))
+				}
+			}
+			if !aws.BoolValue(results.IsTruncated) {
+				break
+			}
+			continuationToken = results.NextContinuationToken
+		}
+	}
+
+	keys := make(map[string]struct{}, len(found))
+	for _, key := range found {
+		keys[key] = struct{}{}
+	}
+	for _, key := range found {
+		encodedRecord, err := download(ctx, v.s3cli, URI, v.region, key)
+		if err != nil {
+			if _, ok := err.(*types.EntityNotExistsError); ok {
+				continue
+			}
+			return &types.InternalServiceError{Message: err.Error()}
+		}
+		record, err := decodeVisibilityRecord(encodedRecord)
+		if err != nil {
+			return &types.InternalServiceError{Message: err.Error()}
+		}
+		for _, element := range createIndexesToArchive((*archiver.ArchiveVisibilityRequest)(record)) {
+			keys[constructTimestampIndex(s3KeyPath(URI), request.DomainID, element.primaryIndex, element.primaryIndexValue, element.secondaryIndex, element.secondaryIndexTimestamp, request.RunID)] = struct{}{}
+		}
+		break
+	}
+
+	for key := range keys {
+		if _, err := v.s3cli.DeleteObjectWithContext(ctx, &s3.DeleteObjectInput{
+			Bucket: aws.String(bucket),
+			Key:    aws.String(key),
+		}); err != nil {
+			return &types.InternalServiceError{Message: err.Error()}
+		}
+	}
+	return nil
+}
+
 func (v *visibilityArchiver) ValidateURI(URI archiver.URI) error {
 	err := softValidateURI(URI)
 	if err != nil {
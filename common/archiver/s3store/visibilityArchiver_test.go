@@ -219,6 +219,55 @@ func (s *visibilityArchiverSuite) TestArchive_Success() {
 	s.Equal(request, archivedRecord)
 }
 
+func (s *visibilityArchiverSuite) TestDelete_Fail_InvalidRequest() {
+	visibilityArchiver := s.newTestVisibilityArchiver()
+	err := visibilityArchiver.Delete(context.Background(), s.testArchivalURI, &archiver.DeleteVisibilityRequest{
+		DomainID:   testDomainID,
+		WorkflowID: testWorkflowID,
+	})
+	s.IsType(&types.BadRequestError{}, err)
+}
+
+func (s *visibilityArchiverSuite) TestDelete_Success() {
+	visibilityArchiver := s.newTestVisibilityArchiver()
+	URI, err := archiver.NewURI(testBucketURI + "/test-delete-success")
+	s.NoError(err)
+	closeTimestamp := time.Now()
+	requests := make(map[string]*archiver.ArchiveVisibilityRequest)
+	for i, runID := range []string{testRunID, "other-run-id"} {
+		requests[runID] = &archiver.ArchiveVisibilityRequest{
+			DomainID:         testDomainID,
+			DomainName:       testDomainName,
+			WorkflowID:       testWorkflowID,
+			RunID:            runID,
+			WorkflowTypeName: testWorkflowTypeName,
+			StartTimestamp:   closeTimestamp.Add(-time.Hour).UnixNano(),
+			CloseTimestamp:   closeTimestamp.Add(time.Duration(i) * time.Second).UnixNano(),
+			CloseStatus:      types.WorkflowExecutionCloseStatusCompleted,
+		}
+		s.NoError(visibilityArchiver.Archive(context.Background(), URI, requests[runID]))
+	}
+
+	request := &archiver.DeleteVisibilityRequest{
+		DomainID:   testDomainID,
+		WorkflowID: testWorkflowID,
+		RunID:      testRunID,
+	}
+	s.NoError(visibilityArchiver.Delete(context.Background(), URI, request))
+
+	for runID, archived := range requests {
+		for _, element := range createIndexesToArchive(archived) {
+			key := constructTimestampIndex(URI.Path(), testDomainID, element.primaryIndex, element.primaryIndexValue, element.secondaryIndex, element.secondaryIndexTimestamp, runID)
+			exists, err := keyExists(context.Background(), visibilityArchiver.s3cli, URI, key, visibilityArchiver.region)
+			s.NoError(err)
+			s.Equal(runID != testRunID, exists, key)
+		}
+	}
+
+	// deleting again is a no-op
+	s.NoError(visibilityArchiver.Delete(context.Background(), URI, request))
+}
+
 func (s *visibilityArchiverSuite) TestQuery_Fail_InvalidURI() {
 	visibilityArchiver := s.newTestVisibilityArchiver()
 	URI, err := archiver.NewURI("wrongscheme://")
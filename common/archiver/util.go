@@ -25,6 +25,7 @@ import (
 
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/types"
 )
 
@@ -40,6 +41,23 @@ var (
 	errEmptyQuery            = errors.New("Query string is empty")
 )
 
+// DecodeHistoryBatches decodes the payloads of archived history batches encoded by the history iterator in place,
+// payloads whose key is no longer available are redacted
+func DecodeHistoryBatches(payloadCodec persistence.PayloadCodec, batches []*types.History, logger log.Logger) error {
+	redacted := 0
+	for _, batch := range batches {
+		batchRedacted, err := persistence.DecodeEventsAtRest(payloadCodec, batch.Events)
+		if err != nil {
+			return err
+		}
+		redacted += batchRedacted
+	}
+	if redacted > 0 {
+		logger.Warn("Redacted archived history event payloads encoded with an unavailable key", tag.Counter(redacted))
+	}
+	return nil
+}
+
 // TagLoggerWithArchiveHistoryRequestAndURI tags logger with fields in the archive history request and the URI
 func TagLoggerWithArchiveHistoryRequestAndURI(logger log.Logger, request *ArchiveHistoryRequest, URI string) log.Logger {
 	return logger.WithTags(
@@ -103,6 +121,34 @@ func ValidateGetRequest(request *GetHistoryRequest) error {
 	return nil
 }
 
+// ValidateDeleteRequest validates the delete history request
+func ValidateDeleteRequest(request *DeleteHistoryRequest) error {
+	if request.DomainID == "" {
+		return errEmptyDomainID
+	}
+	if request.WorkflowID == "" {
+		return errEmptyWorkflowID
+	}
+	if request.RunID == "" {
+		return errEmptyRunID
+	}
+	return nil
+}
+
+// ValidateDeleteVisibilityRequest validates the delete visibility request
+func ValidateDeleteVisibilityRequest(request *DeleteVisibilityRequest) error {
+	if request.DomainID == "" {
+		return errEmptyDomainID
+	}
+	if request.WorkflowID == "" {
+		return errEmptyWorkflowID
+	}
+	if request.RunID == "" {
+		return errEmptyRunID
+	}
+	return nil
+}
+
 // ValidateVisibilityArchivalRequest validates the archive visibility request
 func ValidateVisibilityArchivalRequest(request *ArchiveVisibilityRequest) error {
 	if request.DomainID == "" {
@@ -27,6 +27,7 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	"github.com/uber/cadence/common/log/testlogger"
+	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/types"
 )
 
@@ -142,3 +143,21 @@ func (s *UtilSuite) TestHistoryMutated() {
 		s.Equal(tc.isMutated, IsHistoryMutated(tc.request, tc.historyBatches, tc.isLast, testlogger.New(s.T())))
 	}
 }
+
+func (s *UtilSuite) TestDecodeHistoryBatches() {
+	newEvents := func() []*types.HistoryEvent {
+		return []*types.HistoryEvent{{
+			ID: 1,
+			ActivityTaskCompletedEventAttributes: &types.ActivityTaskCompletedEventAttributes{
+				Result: append(append([]byte{}, persistence.ReservedPayloadPrefix...), "result"...),
+			},
+		}}
+	}
+	encoded, err := persistence.EncodeEventsAtRest(nil, "domain", newEvents())
+	s.NoError(err)
+	s.NotEqual(newEvents(), encoded)
+
+	batches := []*types.History{{Events: encoded}}
+	s.NoError(DecodeHistoryBatches(nil, batches, testlogger.New(s.T())))
+	s.Equal(newEvents(), batches[0].Events)
+}
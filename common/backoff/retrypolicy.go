@@ -23,9 +23,15 @@ package backoff
 import (
 	"math"
 	"math/rand"
+	"regexp"
+	"sync"
+	"sync/atomic"
 	"time"
 
+	"github.com/robfig/cron/v3"
+
 	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/types"
 )
 
 const (
@@ -38,6 +44,14 @@ const (
 	defaultMaximumInterval    = 10 * time.Second
 	defaultExpirationInterval = time.Minute
 	defaultMaximumAttempts    = noMaximumAttempts
+
+	// maxCachedReasonPatterns bounds the compiled ReasonPatterns of retry policy overrides kept in memory
+	maxCachedReasonPatterns = 1000
+)
+
+var (
+	reasonPatterns     sync.Map // ReasonPattern -> *regexp.Regexp
+	reasonPatternCount atomic.Int32
 )
 
 type (
@@ -244,3 +258,151 @@ func (r *retrierImpl) NextBackOff() time.Duration {
 func (r *retrierImpl) getElapsedTime() time.Duration {
 	return r.clock.Now().Sub(r.startTime)
 }
+
+// IsRetryableErrorReason returns whether a failure with the given reason may be retried under the retry policy.
+// Reasons listed in NonRetriableErrorReasons are never retried. When RetryableErrorReasons is not empty,
+// only the reasons listed there are retried.
+func IsRetryableErrorReason(policy *types.RetryPolicy, reason string) bool {
+	for _, r := range policy.GetNonRetriableErrorReasons() {
+		if r == reason {
+			return false
+		}
+	}
+
+	retryable := policy.GetRetryableErrorReasons()
+	if len(retryable) == 0 {
+		return true
+	}
+	for _, r := range retryable {
+		if r == reason {
+			return true
+		}
+	}
+	return false
+}
+
+// GetBackoffForNextRetry calculates the backoff before the next attempt of an activity or workflow
+// which failed with failureReason. currAttempt starts from 0 and NoBackoff is returned when no more
+// retries should be made.
+func GetBackoffForNextRetry(
+	now time.Time,
+	expirationTime time.Time,
+	currAttempt int32,
+	policy *types.RetryPolicy,
+	failureReason string,
+) time.Duration {
+	if policy == nil || !IsRetryableErrorReason(policy, failureReason) {
+		return NoBackoff
+	}
+
+	initInterval := policy.GetInitialIntervalInSeconds()
+	backoffCoefficient := policy.GetBackoffCoefficient()
+	maxInterval := policy.GetMaximumIntervalInSeconds()
+	maxAttempts := policy.GetMaximumAttempts()
+	if override := matchRetryPolicyOverride(policy, failureReason); override != nil {
+		if override.GetInitialIntervalInSeconds() > 0 {
+			initInterval = override.GetInitialIntervalInSeconds()
+		}
+		if override.GetBackoffCoefficient() > 0 {
+			backoffCoefficient = override.GetBackoffCoefficient()
+		}
+		if override.GetMaximumIntervalInSeconds() > 0 {
+			maxInterval = override.GetMaximumIntervalInSeconds()
+		}
+		if override.GetMaximumAttempts() > 0 {
+			maxAttempts = override.GetMaximumAttempts()
+		}
+	}
+
+	if maxAttempts == 0 && expirationTime.IsZero() {
+		return NoBackoff
+	}
+
+	if maxAttempts > 0 && currAttempt >= maxAttempts-1 {
+		// currAttempt starts from 0.
+		// MaximumAttempts is the total attempts, including initial (non-retry) attempt.
+		return NoBackoff
+	}
+
+	nextInterval := int64(float64(initInterval) * math.Pow(backoffCoefficient, float64(currAttempt)))
+	if nextInterval <= 0 {
+		// math.Pow() could overflow
+		if maxInterval > 0 {
+			nextInterval = int64(maxInterval)
+		} else {
+			return NoBackoff
+		}
+	}
+
+	if maxInterval > 0 && nextInterval > int64(maxInterval) {
+		// cap next interval to MaxInterval
+		nextInterval = int64(maxInterval)
+	}
+
+	backoffInterval := time.Duration(nextInterval) * time.Second
+	if jitter := policy.GetJitterCoefficient(); jitter > 0 && jitter < 1 {
+		// jitter only shortens the interval so that MaximumInterval and the expiration are still honored
+		backoffInterval -= time.Duration(rand.Float64() * jitter * float64(backoffInterval))
+	}
+
+	nextScheduleTime := now.Add(backoffInterval)
+	if !expirationTime.IsZero() && nextScheduleTime.After(expirationTime) {
+		return NoBackoff
+	}
+
+	return backoffInterval
+}
+
+// GetRetryExpirationForCron returns the expiration of the retries of a failed cron workflow run. The retries
+// stop at the next time the cron schedule fires, so that a retry never delays or overlaps the next scheduled run
+// and the cron schedule takes over instead.
+func GetRetryExpirationForCron(
+	sched cron.Schedule,
+	now time.Time,
+	expirationTime time.Time,
+) time.Time {
+	nextScheduleTime := sched.Next(now.In(time.UTC))
+	if nextScheduleTime.IsZero() {
+		return expirationTime
+	}
+	if expirationTime.IsZero() || nextScheduleTime.Before(expirationTime) {
+		return nextScheduleTime
+	}
+	return expirationTime
+}
+
+// matchRetryPolicyOverride returns the first override whose pattern matches the whole failure reason
+func matchRetryPolicyOverride(policy *types.RetryPolicy, failureReason string) *types.RetryPolicyOverride {
+	for _, override := range policy.GetErrorReasonOverrides() {
+		if override == nil {
+			continue
+		}
+		re, err := compileReasonPattern(override.GetReasonPattern())
+		if err != nil {
+			// patterns are validated when the policy is accepted, skip anything that slipped through
+			continue
+		}
+		if re.MatchString(failureReason) {
+			return override
+		}
+	}
+	return nil
+}
+
+// compileReasonPattern compiles a ReasonPattern to match whole failure reasons. Compiled patterns are cached
+// as every retry of an activity or workflow evaluates them, up to maxCachedReasonPatterns patterns.
+func compileReasonPattern(pattern string) (*regexp.Regexp, error) {
+	if re, ok := reasonPatterns.Load(pattern); ok {
+		return re.(*regexp.Regexp), nil
+	}
+	re, err := regexp.Compile("^(?:" + pattern + ")$")
+	if err != nil {
+		return nil, err
+	}
+	if reasonPatternCount.Load() < maxCachedReasonPatterns {
+		if _, loaded := reasonPatterns.LoadOrStore(pattern, re); !loaded {
+			reasonPatternCount.Add(1)
+		}
+	}
+	return re, nil
+}
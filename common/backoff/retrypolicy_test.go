@@ -25,10 +25,12 @@ import (
 	"testing"
 	"time"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/types"
 )
 
 type (
@@ -259,3 +261,245 @@ func getNextBackoffRange(duration time.Duration) (time.Duration, time.Duration)
 	rangeMin := time.Duration(0.8 * float64(duration))
 	return rangeMin, duration
 }
+
+func TestIsRetryableErrorReason(t *testing.T) {
+	tests := []struct {
+		name   string
+		policy *types.RetryPolicy
+		reason string
+		want   bool
+	}{
+		{
+			name:   "nil policy",
+			reason: "any",
+			want:   true,
+		},
+		{
+			name:   "non-retriable reason",
+			policy: &types.RetryPolicy{NonRetriableErrorReasons: []string{"bad"}},
+			reason: "bad",
+			want:   false,
+		},
+		{
+			name:   "reason not in allowlist",
+			policy: &types.RetryPolicy{RetryableErrorReasons: []string{"transient"}},
+			reason: "bad",
+			want:   false,
+		},
+		{
+			name:   "reason in allowlist",
+			policy: &types.RetryPolicy{RetryableErrorReasons: []string{"transient"}},
+			reason: "transient",
+			want:   true,
+		},
+		{
+			name: "non-retriable wins over allowlist",
+			policy: &types.RetryPolicy{
+				NonRetriableErrorReasons: []string{"transient"},
+				RetryableErrorReasons:    []string{"transient"},
+			},
+			reason: "transient",
+			want:   false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, IsRetryableErrorReason(tt.policy, tt.reason))
+		})
+	}
+}
+
+func TestGetBackoffForNextRetry(t *testing.T) {
+	now := time.Date(2018, 4, 13, 16, 8, 8, 0, time.UTC)
+	basePolicy := func() *types.RetryPolicy {
+		return &types.RetryPolicy{
+			InitialIntervalInSeconds: 1,
+			BackoffCoefficient:       2,
+			MaximumIntervalInSeconds: 10,
+			MaximumAttempts:          5,
+		}
+	}
+
+	tests := []struct {
+		name           string
+		policy         func() *types.RetryPolicy
+		expirationTime time.Time
+		attempt        int32
+		reason         string
+		want           time.Duration
+	}{
+		{
+			name:   "nil policy",
+			policy: func() *types.RetryPolicy { return nil },
+			reason: "any",
+			want:   NoBackoff,
+		},
+		{
+			name:    "exponential backoff",
+			policy:  basePolicy,
+			attempt: 2,
+			reason:  "any",
+			want:    4 * time.Second,
+		},
+		{
+			name:    "capped at maximum interval",
+			policy:  basePolicy,
+			attempt: 3,
+			reason:  "any",
+			want:    8 * time.Second,
+		},
+		{
+			name:    "maximum attempts reached",
+			policy:  basePolicy,
+			attempt: 4,
+			reason:  "any",
+			want:    NoBackoff,
+		},
+		{
+			name: "no attempts limit and no expiration",
+			policy: func() *types.RetryPolicy {
+				p := basePolicy()
+				p.MaximumAttempts = 0
+				return p
+			},
+			reason: "any",
+			want:   NoBackoff,
+		},
+		{
+			name:           "next attempt after expiration",
+			policy:         basePolicy,
+			expirationTime: now.Add(time.Second),
+			attempt:        2,
+			reason:         "any",
+			want:           NoBackoff,
+		},
+		{
+			name: "reason outside allowlist",
+			policy: func() *types.RetryPolicy {
+				p := basePolicy()
+				p.RetryableErrorReasons = []string{"transient"}
+				return p
+			},
+			reason: "any",
+			want:   NoBackoff,
+		},
+		{
+			name: "override applies to matching reason",
+			policy: func() *types.RetryPolicy {
+				p := basePolicy()
+				p.ErrorReasonOverrides = []*types.RetryPolicyOverride{
+					{ReasonPattern: "rate-limit.*", InitialIntervalInSeconds: 30, MaximumIntervalInSeconds: 60, MaximumAttempts: 10},
+				}
+				return p
+			},
+			attempt: 6,
+			reason:  "rate-limit-exceeded",
+			want:    60 * time.Second,
+		},
+		{
+			name: "override must match the whole reason",
+			policy: func() *types.RetryPolicy {
+				p := basePolicy()
+				p.ErrorReasonOverrides = []*types.RetryPolicyOverride{
+					{ReasonPattern: "rate-limit", InitialIntervalInSeconds: 30},
+				}
+				return p
+			},
+			attempt: 1,
+			reason:  "rate-limit-exceeded",
+			want:    2 * time.Second,
+		},
+		{
+			name: "first matching override wins",
+			policy: func() *types.RetryPolicy {
+				p := basePolicy()
+				p.ErrorReasonOverrides = []*types.RetryPolicyOverride{
+					nil,
+					{ReasonPattern: "rate-.*", BackoffCoefficient: 3},
+					{ReasonPattern: "rate-limit", BackoffCoefficient: 1},
+				}
+				return p
+			},
+			attempt: 2,
+			reason:  "rate-limit",
+			want:    9 * time.Second,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, GetBackoffForNextRetry(now, tt.expirationTime, tt.attempt, tt.policy(), tt.reason))
+		})
+	}
+}
+
+func TestGetBackoffForNextRetryWithJitter(t *testing.T) {
+	now := time.Date(2018, 4, 13, 16, 8, 8, 0, time.UTC)
+	policy := &types.RetryPolicy{
+		InitialIntervalInSeconds: 10,
+		BackoffCoefficient:       1,
+		MaximumAttempts:          100,
+		JitterCoefficient:        0.5,
+	}
+
+	for i := 0; i < 100; i++ {
+		backoff := GetBackoffForNextRetry(now, time.Time{}, 1, policy, "any")
+		assert.True(t, backoff > 5*time.Second, "backoff %v too low", backoff)
+		assert.True(t, backoff <= 10*time.Second, "backoff %v too high", backoff)
+	}
+}
+
+func TestCompileReasonPatternIsCached(t *testing.T) {
+	re, err := compileReasonPattern("cached-reason-[0-9]+")
+	require.NoError(t, err)
+	cached, err := compileReasonPattern("cached-reason-[0-9]+")
+	require.NoError(t, err)
+	assert.Same(t, re, cached)
+	assert.True(t, re.MatchString("cached-reason-42"))
+	assert.False(t, re.MatchString("prefix-cached-reason-42"))
+
+	_, err = compileReasonPattern("invalid-reason-[")
+	assert.Error(t, err)
+	_, ok := reasonPatterns.Load("invalid-reason-[")
+	assert.False(t, ok)
+}
+
+func TestGetRetryExpirationForCron(t *testing.T) {
+	sched, err := ValidateSchedule("0 * * * *")
+	require.NoError(t, err)
+	now := time.Date(2024, 1, 1, 10, 20, 0, 0, time.UTC)
+	nextSchedule := time.Date(2024, 1, 1, 11, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		expirationTime time.Time
+		want           time.Time
+	}{
+		{
+			name: "no expiration",
+			want: nextSchedule,
+		},
+		{
+			name:           "expiration after next schedule",
+			expirationTime: now.Add(2 * time.Hour),
+			want:           nextSchedule,
+		},
+		{
+			name:           "expiration before next schedule",
+			expirationTime: now.Add(10 * time.Minute),
+			want:           now.Add(10 * time.Minute),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, GetRetryExpirationForCron(sched, now, tt.expirationTime))
+		})
+	}
+
+	policy := &types.RetryPolicy{InitialIntervalInSeconds: 30 * 60, BackoffCoefficient: 2}
+	expirationTime := GetRetryExpirationForCron(sched, now, time.Time{})
+	assert.Equal(t, 30*time.Minute, GetBackoffForNextRetry(now, expirationTime, 0, policy, "reason"))
+	assert.Equal(t, NoBackoff, GetBackoffForNextRetry(now, expirationTime, 1, policy, "reason"), "a retry after the next cron run gives way to the cron schedule")
+}
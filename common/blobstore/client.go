@@ -22,7 +22,10 @@
 
 package blobstore
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
 //go:generate mockgen -package=$GOPACKAGE -destination=client_mock.go -self_package=github.com/uber/cadence/common/blobstore github.com/uber/cadence/common/blobstore Client
 
@@ -33,6 +36,7 @@ type (
 		Get(context.Context, *GetRequest) (*GetResponse, error)
 		Exists(context.Context, *ExistsRequest) (*ExistsResponse, error)
 		Delete(context.Context, *DeleteRequest) (*DeleteResponse, error)
+		List(context.Context, *ListRequest) (*ListResponse, error)
 		IsRetryableError(error) bool
 	}
 
@@ -73,6 +77,27 @@ type (
 	// DeleteResponse is the response from Delete
 	DeleteResponse struct{}
 
+	// ListRequest is the request to List
+	ListRequest struct {
+		// Only the blobs whose key starts with Prefix are listed
+		Prefix string
+		// Maximum number of blobs to list, the store decides the size of the pages if it is 0
+		PageSize      int
+		NextPageToken []byte
+	}
+
+	// ListResponse is the response from List, the blobs are ordered by key
+	ListResponse struct {
+		Blobs         []BlobInfo
+		NextPageToken []byte
+	}
+
+	// BlobInfo describes a listed blob
+	BlobInfo struct {
+		Key          string
+		LastModified time.Time
+	}
+
 	// Blob defines a blob which can be stored and fetched from blobstore
 	Blob struct {
 		Tags map[string]string
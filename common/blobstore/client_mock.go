@@ -99,6 +99,21 @@ func (mr *MockClientMockRecorder) IsRetryableError(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsRetryableError", reflect.TypeOf((*MockClient)(nil).IsRetryableError), arg0)
 }
 
+// List mocks base method.
+func (m *MockClient) List(arg0 context.Context, arg1 *ListRequest) (*ListResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", arg0, arg1)
+	ret0, _ := ret[0].(*ListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockClientMockRecorder) List(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockClient)(nil).List), arg0, arg1)
+}
+
 // Put mocks base method.
 func (m *MockClient) Put(arg0 context.Context, arg1 *PutRequest) (*PutResponse, error) {
 	m.ctrl.T.Helper()
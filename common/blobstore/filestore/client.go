@@ -27,7 +27,11 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io/fs"
 	"os"
+	"path/filepath"
+	"sort"
+	"strings"
 
 	"github.com/uber/cadence/common/blobstore"
 	"github.com/uber/cadence/common/config"
@@ -71,6 +75,12 @@ func (c *client) Put(_ context.Context, request *blobstore.PutRequest) (resp *bl
 			os.Remove(c.tagsPath(request.Key))
 		}
 	}()
+	// keys may contain slashes, the blobs are stored in the corresponding sub directories
+	for _, path := range []string{c.bodyPath(request.Key), c.tagsPath(request.Key)} {
+		if err := util.MkdirAll(filepath.Dir(path), os.FileMode(0766)); err != nil {
+			return nil, err
+		}
+	}
 	if err := util.WriteFile(c.bodyPath(request.Key), request.Blob.Body, os.FileMode(0666)); err != nil {
 		return nil, err
 	}
@@ -128,6 +138,55 @@ func (c *client) Delete(_ context.Context, request *blobstore.DeleteRequest) (*b
 	return &blobstore.DeleteResponse{}, nil
 }
 
+// List lists the blobs whose key starts with the prefix, the page token is the last key of the previous page
+func (c *client) List(_ context.Context, request *blobstore.ListRequest) (*blobstore.ListResponse, error) {
+	lastKey := string(request.NextPageToken)
+	var blobs []blobstore.BlobInfo
+	if err := filepath.WalkDir(c.outputDirectory, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if path == c.outputDirectory {
+			return nil
+		}
+		// the tags are stored in hidden files and directories
+		if strings.HasPrefix(entry.Name(), ".") {
+			if entry.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if entry.IsDir() {
+			return nil
+		}
+		relPath, err := filepath.Rel(c.outputDirectory, path)
+		if err != nil {
+			return err
+		}
+		key := filepath.ToSlash(relPath)
+		if !strings.HasPrefix(key, request.Prefix) || key <= lastKey {
+			return nil
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return err
+		}
+		blobs = append(blobs, blobstore.BlobInfo{Key: key, LastModified: info.ModTime()})
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	sort.Slice(blobs, func(i, j int) bool {
+		return blobs[i].Key < blobs[j].Key
+	})
+	resp := &blobstore.ListResponse{Blobs: blobs}
+	if request.PageSize > 0 && len(blobs) > request.PageSize {
+		resp.Blobs = blobs[:request.PageSize]
+		resp.NextPageToken = []byte(resp.Blobs[request.PageSize-1].Key)
+	}
+	return resp, nil
+}
+
 // IsRetryableError returns true if the error is retryable false otherwise
 func (c *client) IsRetryableError(err error) bool {
 	return false
@@ -151,3 +151,47 @@ func (s *ClientSuite) TestCrudOperations() {
 	s.Error(err)
 	s.Nil(get1)
 }
+
+func (s *ClientSuite) TestList() {
+	name := s.T().TempDir()
+	c, err := NewFilestoreClient(&config.FileBlobstore{OutputDirectory: name})
+	s.NoError(err)
+	ctx := context.Background()
+
+	for _, key := range []string{"b/2/key", "a/key", "b/1/key", "c"} {
+		_, err = c.Put(ctx, &blobstore.PutRequest{
+			Key:  key,
+			Blob: blobstore.Blob{Tags: map[string]string{"key": "value"}, Body: []byte(key)},
+		})
+		s.NoError(err)
+	}
+
+	list, err := c.List(ctx, &blobstore.ListRequest{})
+	s.NoError(err)
+	s.Len(list.Blobs, 4)
+	s.Equal("a/key", list.Blobs[0].Key)
+	s.False(list.Blobs[0].LastModified.IsZero())
+	s.Nil(list.NextPageToken)
+
+	var keys []string
+	req := &blobstore.ListRequest{Prefix: "b/", PageSize: 1}
+	for {
+		list, err = c.List(ctx, req)
+		s.NoError(err)
+		for _, blob := range list.Blobs {
+			keys = append(keys, blob.Key)
+		}
+		if len(list.NextPageToken) == 0 {
+			break
+		}
+		req.NextPageToken = list.NextPageToken
+	}
+	s.Equal([]string{"b/1/key", "b/2/key"}, keys)
+
+	_, err = c.Delete(ctx, &blobstore.DeleteRequest{Key: "b/1/key"})
+	s.NoError(err)
+	list, err = c.List(ctx, &blobstore.ListRequest{Prefix: "b/"})
+	s.NoError(err)
+	s.Len(list.Blobs, 1)
+	s.Equal("b/2/key", list.Blobs[0].Key)
+}
@@ -0,0 +1,59 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package blobstore
+
+import (
+	"encoding/base64"
+	"encoding/json"
+)
+
+// TagsMetadataKey is the object metadata key under which object store backed clients keep the tags of a blob
+const TagsMetadataKey = "cadence-tags"
+
+// EncodeTags serializes the tags of a blob into a value that is safe to use as object metadata,
+// nil tags are encoded into an empty value
+func EncodeTags(tags map[string]string) (string, error) {
+	if tags == nil {
+		return "", nil
+	}
+	data, err := json.Marshal(tags)
+	if err != nil {
+		return "", err
+	}
+	// object stores only accept ASCII metadata values
+	return base64.StdEncoding.EncodeToString(data), nil
+}
+
+// DecodeTags is the inverse of EncodeTags
+func DecodeTags(value string) (map[string]string, error) {
+	if value == "" {
+		return nil, nil
+	}
+	data, err := base64.StdEncoding.DecodeString(value)
+	if err != nil {
+		return nil, err
+	}
+	tags := make(map[string]string)
+	if err := json.Unmarshal(data, &tags); err != nil {
+		return nil, err
+	}
+	return tags, nil
+}
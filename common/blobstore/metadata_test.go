@@ -0,0 +1,56 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package blobstore
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncodeDecodeTags(t *testing.T) {
+	tests := map[string]map[string]string{
+		"nil tags":      nil,
+		"empty tags":    {},
+		"ascii tags":    {"key1": "value1", "key2": "value2"},
+		"non-ascii tag": {"région": "café"},
+	}
+	for name, tags := range tests {
+		t.Run(name, func(t *testing.T) {
+			encoded, err := EncodeTags(tags)
+			require.NoError(t, err)
+			for _, r := range encoded {
+				assert.Less(t, r, rune(128), "encoded tags must be ASCII")
+			}
+			decoded, err := DecodeTags(encoded)
+			require.NoError(t, err)
+			assert.Equal(t, tags, decoded)
+		})
+	}
+}
+
+func TestDecodeTags_Invalid(t *testing.T) {
+	_, err := DecodeTags("not base64!")
+	assert.Error(t, err)
+	_, err = DecodeTags("bm90IGpzb24=") // "not json"
+	assert.Error(t, err)
+}
@@ -102,6 +102,20 @@ func (c *retryableClient) Delete(ctx context.Context, req *DeleteRequest) (*Dele
 	return resp, nil
 }
 
+func (c *retryableClient) List(ctx context.Context, req *ListRequest) (*ListResponse, error) {
+	var resp *ListResponse
+	var err error
+	op := func(ctx context.Context) error {
+		resp, err = c.client.List(ctx, req)
+		return err
+	}
+	err = c.throttleRetry.Do(ctx, op)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
 func (c *retryableClient) IsRetryableError(err error) bool {
 	return c.client.IsRetryableError(err)
 }
@@ -138,6 +138,23 @@ func TestRetryableClient(t *testing.T) {
 				assert.Equal(t, resp.(*DeleteResponse), result)
 			},
 		},
+		{
+			name:           "List",
+			retryPolicy:    backoff.NewExponentialRetryPolicy(0),
+			retryableError: false,
+			req:            &ListRequest{},
+			resp:           &ListResponse{},
+			expectFn: func(m *MockClient, req, resp any) {
+				m.EXPECT().List(gomock.Any(), req.(*ListRequest)).Return(resp.(*ListResponse), nil).Times(1)
+			},
+			callFn: func(c Client, ctx context.Context, req any) (any, error) {
+				return c.List(ctx, req.(*ListRequest))
+			},
+			assertFn: func(t *testing.T, req any, resp any, result any, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, resp.(*ListResponse), result)
+			},
+		},
 		{
 			name:           "RetryOnError",
 			retryPolicy:    backoff.NewExponentialRetryPolicy(1),
@@ -0,0 +1,253 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package s3store
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"path"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+
+	"github.com/uber/cadence/common/blobstore"
+	"github.com/uber/cadence/common/config"
+)
+
+type (
+	client struct {
+		s3cli  s3iface.S3API
+		bucket string
+		prefix string
+	}
+)
+
+// NewS3Client constructs a blobstore backed by S3 or any S3 compatible object store.
+// The bucket must already exist, it is not created by the client.
+func NewS3Client(cfg *config.S3Blobstore) (blobstore.Client, error) {
+	if cfg == nil {
+		return nil, errors.New("s3 blobstore config is nil")
+	}
+	if len(cfg.Bucket) == 0 {
+		return nil, errors.New("bucket not given for s3 blobstore")
+	}
+	if len(cfg.Region) == 0 {
+		return nil, errors.New("region not given for s3 blobstore")
+	}
+	sess, err := session.NewSession(&aws.Config{
+		Endpoint:         cfg.Endpoint,
+		Region:           aws.String(cfg.Region),
+		S3ForcePathStyle: aws.Bool(cfg.S3ForcePathStyle),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return newClient(s3.New(sess), cfg), nil
+}
+
+func newClient(s3cli s3iface.S3API, cfg *config.S3Blobstore) *client {
+	return &client{
+		s3cli:  s3cli,
+		bucket: cfg.Bucket,
+		prefix: cfg.Prefix,
+	}
+}
+
+// Put stores a blob
+func (c *client) Put(ctx context.Context, request *blobstore.PutRequest) (*blobstore.PutResponse, error) {
+	tags, err := blobstore.EncodeTags(request.Blob.Tags)
+	if err != nil {
+		return nil, err
+	}
+	var metadata map[string]*string
+	if tags != "" {
+		metadata = map[string]*string{blobstore.TagsMetadataKey: aws.String(tags)}
+	}
+	if _, err := c.s3cli.PutObjectWithContext(ctx, &s3.PutObjectInput{
+		Bucket:   aws.String(c.bucket),
+		Key:      aws.String(c.objectKey(request.Key)),
+		Body:     bytes.NewReader(request.Blob.Body),
+		Metadata: metadata,
+	}); err != nil {
+		return nil, err
+	}
+	return &blobstore.PutResponse{}, nil
+}
+
+// Get fetches a blob
+func (c *client) Get(ctx context.Context, request *blobstore.GetRequest) (*blobstore.GetResponse, error) {
+	output, err := c.s3cli.GetObjectWithContext(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(c.bucket),
+		Key:    aws.String(c.objectKey(request.Key)),
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer output.Body.Close()
+	body, err := io.ReadAll(output.Body)
+	if err != nil {
+		return nil, err
+	}
+	tags, err := blobstore.DecodeTags(getMetadata(output.Metadata, blobstore.TagsMetadataKey))
+	if err != nil {
+		return nil, err
+	}
+	return &blobstore.GetResponse{
+		Blob: blobstore.Blob{
+			Body: body,
+			Tags: tags,
+		},
+	}, nil
+}
+
+// Exists determines if a blob exists
+func (c *client) Exists(ctx context.Context, request *blobstore.ExistsRequest) (*blobstore.ExistsResponse, error) {
+	_, err := c.s3cli.HeadObjectWithContext(ctx, &s3.HeadObjectInput{
+		Bucket: aws.String(c.bucket),
+		Key:    aws.String(c.objectKey(request.Key)),
+	})
+	if err != nil {
+		if isNotFound(err) {
+			return &blobstore.ExistsResponse{Exists: false}, nil
+		}
+		return nil, err
+	}
+	return &blobstore.ExistsResponse{Exists: true}, nil
+}
+
+// Delete deletes a blob
+func (c *client) Delete(ctx context.Context, request *blobstore.DeleteRequest) (*blobstore.DeleteResponse, error) {
+	if _, err := c.s3cli.DeleteObjectWithContext(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(c.bucket),
+		Key:    aws.String(c.objectKey(request.Key)),
+	}); err != nil {
+		return nil, err
+	}
+	return &blobstore.DeleteResponse{}, nil
+}
+
+// List lists the blobs whose key starts with the prefix
+func (c *client) List(ctx context.Context, request *blobstore.ListRequest) (*blobstore.ListResponse, error) {
+	input := &s3.ListObjectsV2Input{
+		Bucket: aws.String(c.bucket),
+		Prefix: aws.String(c.objectKey(request.Prefix)),
+	}
+	if request.PageSize > 0 {
+		input.MaxKeys = aws.Int64(int64(request.PageSize))
+	}
+	if len(request.NextPageToken) > 0 {
+		input.ContinuationToken = aws.String(string(request.NextPageToken))
+	}
+	output, err := c.s3cli.ListObjectsV2WithContext(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	resp := &blobstore.ListResponse{}
+	for _, object := range output.Contents {
+		// the object prefix loses a trailing slash of the requested prefix when it is joined with the configured one
+		key, ok := c.blobKey(aws.StringValue(object.Key))
+		if !ok || !strings.HasPrefix(key, request.Prefix) {
+			continue
+		}
+		resp.Blobs = append(resp.Blobs, blobstore.BlobInfo{
+			Key:          key,
+			LastModified: aws.TimeValue(object.LastModified),
+		})
+	}
+	if aws.BoolValue(output.IsTruncated) {
+		resp.NextPageToken = []byte(aws.StringValue(output.NextContinuationToken))
+	}
+	return resp, nil
+}
+
+// IsRetryableError returns true if the error is retryable false otherwise
+func (c *client) IsRetryableError(err error) bool {
+	if err == nil {
+		return false
+	}
+	if aerr, ok := err.(awserr.Error); ok {
+		return isStatusCodeRetryable(aerr) || request.IsErrorRetryable(aerr) || request.IsErrorThrottle(aerr)
+	}
+	return false
+}
+
+func (c *client) objectKey(key string) string {
+	if c.prefix == "" {
+		return key
+	}
+	return path.Join(c.prefix, key)
+}
+
+// blobKey returns the key of the blob stored in the object, the returned bool is false if the object is outside of the prefix
+func (c *client) blobKey(objectKey string) (string, bool) {
+	if c.prefix == "" {
+		return objectKey, true
+	}
+	prefix := strings.TrimSuffix(c.prefix, "/") + "/"
+	if !strings.HasPrefix(objectKey, prefix) {
+		return "", false
+	}
+	return strings.TrimPrefix(objectKey, prefix), true
+}
+
+// getMetadata looks up a metadata value ignoring case, as S3 returns metadata keys in canonical header form
+func getMetadata(metadata map[string]*string, key string) string {
+	for k, v := range metadata {
+		if strings.EqualFold(k, key) {
+			return aws.StringValue(v)
+		}
+	}
+	return ""
+}
+
+func isNotFound(err error) bool {
+	if rerr, ok := err.(awserr.RequestFailure); ok && rerr.StatusCode() == http.StatusNotFound {
+		return true
+	}
+	if aerr, ok := err.(awserr.Error); ok {
+		// HeadObject has no response body, so the error code is derived from the status code
+		return aerr.Code() == s3.ErrCodeNoSuchKey || aerr.Code() == "NotFound"
+	}
+	return false
+}
+
+func isStatusCodeRetryable(err error) bool {
+	if aerr, ok := err.(awserr.Error); ok {
+		if rerr, ok := err.(awserr.RequestFailure); ok {
+			if rerr.StatusCode() == http.StatusTooManyRequests {
+				return true
+			}
+			if rerr.StatusCode() >= http.StatusInternalServerError && rerr.StatusCode() != http.StatusNotImplemented {
+				return true
+			}
+		}
+		return isStatusCodeRetryable(aerr.OrigErr())
+	}
+	return false
+}
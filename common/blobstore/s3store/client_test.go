@@ -0,0 +1,282 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package s3store
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/uber/cadence/common/blobstore"
+	"github.com/uber/cadence/common/config"
+)
+
+type (
+	ClientSuite struct {
+		*require.Assertions
+		suite.Suite
+	}
+
+	// fakeS3 is an in-memory stand-in for the subset of the S3 API used by the client
+	fakeS3 struct {
+		s3iface.S3API
+
+		sync.Mutex
+		objects map[string]fakeS3Object
+	}
+
+	fakeS3Object struct {
+		body         []byte
+		metadata     map[string]*string
+		lastModified time.Time
+	}
+)
+
+func TestClientSuite(t *testing.T) {
+	suite.Run(t, new(ClientSuite))
+}
+
+func (s *ClientSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+}
+
+func (s *ClientSuite) TestNewS3Client_InvalidConfig() {
+	_, err := NewS3Client(nil)
+	s.Error(err)
+	_, err = NewS3Client(&config.S3Blobstore{Region: "us-east-1"})
+	s.Error(err)
+	_, err = NewS3Client(&config.S3Blobstore{Bucket: "bucket"})
+	s.Error(err)
+}
+
+func (s *ClientSuite) TestNewS3Client() {
+	c, err := NewS3Client(&config.S3Blobstore{
+		Bucket:           "bucket",
+		Prefix:           "scanner",
+		Region:           "us-east-1",
+		Endpoint:         aws.String("http://127.0.0.1:9000"),
+		S3ForcePathStyle: true,
+	})
+	s.NoError(err)
+	s.Equal("bucket", c.(*client).bucket)
+	s.Equal("scanner", c.(*client).prefix)
+}
+
+func (s *ClientSuite) TestCrudOperations() {
+	fake := newFakeS3()
+	c := newClient(fake, &config.S3Blobstore{Bucket: "bucket", Prefix: "scanner"})
+	ctx := context.Background()
+
+	blob1 := blobstore.Blob{
+		Tags: nil,
+		Body: []byte{1, 2, 3},
+	}
+	blob2 := blobstore.Blob{
+		Tags: map[string]string{"key1": "value1", "key2": "value2"},
+		Body: nil,
+	}
+	_, err := c.Put(ctx, &blobstore.PutRequest{Key: "key1", Blob: blob1})
+	s.NoError(err)
+	_, err = c.Put(ctx, &blobstore.PutRequest{Key: "key2", Blob: blob2})
+	s.NoError(err)
+	s.Contains(fake.objects, "bucket/scanner/key1")
+	s.Contains(fake.objects, "bucket/scanner/key2")
+
+	get1, err := c.Get(ctx, &blobstore.GetRequest{Key: "key1"})
+	s.NoError(err)
+	s.Nil(get1.Blob.Tags)
+	s.Equal([]byte{1, 2, 3}, get1.Blob.Body)
+	get2, err := c.Get(ctx, &blobstore.GetRequest{Key: "key2"})
+	s.NoError(err)
+	s.Equal(map[string]string{"key1": "value1", "key2": "value2"}, get2.Blob.Tags)
+	s.Empty(get2.Blob.Body)
+
+	exists, err := c.Exists(ctx, &blobstore.ExistsRequest{Key: "key1"})
+	s.NoError(err)
+	s.True(exists.Exists)
+	exists, err = c.Exists(ctx, &blobstore.ExistsRequest{Key: "missing"})
+	s.NoError(err)
+	s.False(exists.Exists)
+
+	_, err = c.Delete(ctx, &blobstore.DeleteRequest{Key: "key1"})
+	s.NoError(err)
+	exists, err = c.Exists(ctx, &blobstore.ExistsRequest{Key: "key1"})
+	s.NoError(err)
+	s.False(exists.Exists)
+	_, err = c.Get(ctx, &blobstore.GetRequest{Key: "key1"})
+	s.Error(err)
+	s.False(c.IsRetryableError(err))
+}
+
+func (s *ClientSuite) TestList() {
+	fake := newFakeS3()
+	c := newClient(fake, &config.S3Blobstore{Bucket: "bucket", Prefix: "scanner"})
+	ctx := context.Background()
+
+	for _, key := range []string{"b/2/key", "a/key", "b/1/key", "bb/key"} {
+		_, err := c.Put(ctx, &blobstore.PutRequest{Key: key, Blob: blobstore.Blob{Body: []byte(key)}})
+		s.NoError(err)
+	}
+	// outside of the configured prefix
+	fake.objects["bucket/scanner2/b/key"] = fakeS3Object{}
+
+	var keys []string
+	req := &blobstore.ListRequest{Prefix: "b/", PageSize: 1}
+	for {
+		list, err := c.List(ctx, req)
+		s.NoError(err)
+		for _, blob := range list.Blobs {
+			s.False(blob.LastModified.IsZero())
+			keys = append(keys, blob.Key)
+		}
+		if len(list.NextPageToken) == 0 {
+			break
+		}
+		req.NextPageToken = list.NextPageToken
+	}
+	s.Equal([]string{"b/1/key", "b/2/key"}, keys)
+
+	list, err := c.List(ctx, &blobstore.ListRequest{})
+	s.NoError(err)
+	s.Len(list.Blobs, 4)
+	s.Nil(list.NextPageToken)
+}
+
+func (s *ClientSuite) TestObjectKey() {
+	s.Equal("key", newClient(newFakeS3(), &config.S3Blobstore{}).objectKey("key"))
+	s.Equal("a/b/key", newClient(newFakeS3(), &config.S3Blobstore{Prefix: "a/b/"}).objectKey("key"))
+}
+
+func (s *ClientSuite) TestIsRetryableError() {
+	c := newClient(newFakeS3(), &config.S3Blobstore{})
+	tests := map[string]struct {
+		err      error
+		expected bool
+	}{
+		"nil":               {err: nil, expected: false},
+		"not an aws error":  {err: errors.New("some error"), expected: false},
+		"not found":         {err: awserr.NewRequestFailure(awserr.New(s3.ErrCodeNoSuchKey, "", nil), http.StatusNotFound, ""), expected: false},
+		"throttled":         {err: awserr.NewRequestFailure(awserr.New("SlowDown", "", nil), http.StatusServiceUnavailable, ""), expected: true},
+		"too many requests": {err: awserr.NewRequestFailure(awserr.New("TooManyRequests", "", nil), http.StatusTooManyRequests, ""), expected: true},
+		"internal error":    {err: awserr.NewRequestFailure(awserr.New("InternalError", "", nil), http.StatusInternalServerError, ""), expected: true},
+		"not implemented":   {err: awserr.NewRequestFailure(awserr.New("NotImplemented", "", nil), http.StatusNotImplemented, ""), expected: false},
+		"connection reset":  {err: awserr.New(request.ErrCodeSerialization, "", errors.New("connection reset")), expected: true},
+	}
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.Equal(tc.expected, c.IsRetryableError(tc.err))
+		})
+	}
+}
+
+func newFakeS3() *fakeS3 {
+	return &fakeS3{objects: make(map[string]fakeS3Object)}
+}
+
+func (f *fakeS3) PutObjectWithContext(_ aws.Context, input *s3.PutObjectInput, _ ...request.Option) (*s3.PutObjectOutput, error) {
+	body, err := io.ReadAll(input.Body)
+	if err != nil {
+		return nil, err
+	}
+	// S3 returns metadata keys in canonical header form
+	metadata := make(map[string]*string, len(input.Metadata))
+	for k, v := range input.Metadata {
+		metadata[http.CanonicalHeaderKey(k)] = v
+	}
+	f.Lock()
+	defer f.Unlock()
+	f.objects[fakeObjectKey(input.Bucket, input.Key)] = fakeS3Object{body: body, metadata: metadata, lastModified: time.Now()}
+	return &s3.PutObjectOutput{}, nil
+}
+
+func (f *fakeS3) GetObjectWithContext(_ aws.Context, input *s3.GetObjectInput, _ ...request.Option) (*s3.GetObjectOutput, error) {
+	f.Lock()
+	defer f.Unlock()
+	obj, ok := f.objects[fakeObjectKey(input.Bucket, input.Key)]
+	if !ok {
+		return nil, awserr.NewRequestFailure(awserr.New(s3.ErrCodeNoSuchKey, "The specified key does not exist.", nil), http.StatusNotFound, "")
+	}
+	return &s3.GetObjectOutput{
+		Body:     io.NopCloser(strings.NewReader(string(obj.body))),
+		Metadata: obj.metadata,
+	}, nil
+}
+
+func (f *fakeS3) HeadObjectWithContext(_ aws.Context, input *s3.HeadObjectInput, _ ...request.Option) (*s3.HeadObjectOutput, error) {
+	f.Lock()
+	defer f.Unlock()
+	obj, ok := f.objects[fakeObjectKey(input.Bucket, input.Key)]
+	if !ok {
+		return nil, awserr.NewRequestFailure(awserr.New("NotFound", "Not Found", nil), http.StatusNotFound, "")
+	}
+	return &s3.HeadObjectOutput{Metadata: obj.metadata}, nil
+}
+
+func (f *fakeS3) DeleteObjectWithContext(_ aws.Context, input *s3.DeleteObjectInput, _ ...request.Option) (*s3.DeleteObjectOutput, error) {
+	f.Lock()
+	defer f.Unlock()
+	delete(f.objects, fakeObjectKey(input.Bucket, input.Key))
+	return &s3.DeleteObjectOutput{}, nil
+}
+
+func (f *fakeS3) ListObjectsV2WithContext(_ aws.Context, input *s3.ListObjectsV2Input, _ ...request.Option) (*s3.ListObjectsV2Output, error) {
+	f.Lock()
+	defer f.Unlock()
+	var keys []string
+	prefix := fakeObjectKey(input.Bucket, input.Prefix)
+	for key := range f.objects {
+		if strings.HasPrefix(key, prefix) && key > fakeObjectKey(input.Bucket, input.ContinuationToken) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	output := &s3.ListObjectsV2Output{IsTruncated: aws.Bool(false)}
+	for _, key := range keys {
+		if input.MaxKeys != nil && int64(len(output.Contents)) == *input.MaxKeys {
+			output.IsTruncated = aws.Bool(true)
+			output.NextContinuationToken = output.Contents[len(output.Contents)-1].Key
+			break
+		}
+		output.Contents = append(output.Contents, &s3.Object{
+			Key:          aws.String(strings.TrimPrefix(key, aws.StringValue(input.Bucket)+"/")),
+			LastModified: aws.Time(f.objects[key].lastModified),
+		})
+	}
+	return output, nil
+}
+
+func fakeObjectKey(bucket, key *string) string {
+	return aws.StringValue(bucket) + "/" + aws.StringValue(key)
+}
@@ -43,16 +43,27 @@ func GenerateCRC32(
 		return Checksum{}, err
 	}
 
-	crc := crc32.ChecksumIEEE(payloadBytes)
-	checksum := make([]byte, 4)
-	binary.BigEndian.PutUint32(checksum, crc)
 	return Checksum{
-		Value:   checksum,
+		Value:   crc32Bytes(payloadBytes),
 		Version: payloadVersion,
 		Flavor:  FlavorIEEECRC32OverThriftBinary,
 	}, nil
 }
 
+// GenerateCRC32OverBytes generates an IEEE crc32 checksum
+// on the given byte array
+func GenerateCRC32OverBytes(
+	payload []byte,
+	payloadVersion int,
+) Checksum {
+
+	return Checksum{
+		Value:   crc32Bytes(payload),
+		Version: payloadVersion,
+		Flavor:  FlavorIEEECRC32OverBytes,
+	}
+}
+
 // Verify verifies that the checksum generated from the
 // given thrift object matches the specified expected checksum
 // Return ErrMismatch when checksums mismatch
@@ -76,3 +87,28 @@ func Verify(
 
 	return nil
 }
+
+// VerifyBytes verifies that the checksum generated from the
+// given byte array matches the specified expected checksum
+// Return ErrMismatch when checksums mismatch
+func VerifyBytes(
+	payload []byte,
+	checksum Checksum,
+) error {
+
+	if !checksum.Flavor.IsValid() || checksum.Flavor != FlavorIEEECRC32OverBytes {
+		return fmt.Errorf("unknown checksum flavor %v", checksum.Flavor)
+	}
+
+	if !bytes.Equal(crc32Bytes(payload), checksum.Value) {
+		return ErrMismatch
+	}
+
+	return nil
+}
+
+func crc32Bytes(payload []byte) []byte {
+	checksum := make([]byte, 4)
+	binary.BigEndian.PutUint32(checksum, crc32.ChecksumIEEE(payload))
+	return checksum
+}
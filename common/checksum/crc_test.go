@@ -77,3 +77,18 @@ func TestCRC32OverThrift(t *testing.T) {
 	assert.True(t, success, "timed out waiting for goroutines to finish")
 	assert.Equal(t, int64(parallism*loopCount), successCount)
 }
+
+func TestCRC32OverBytes(t *testing.T) {
+	payload := []byte("archived history batches")
+
+	csum := GenerateCRC32OverBytes(payload, 1)
+	assert.Equal(t, FlavorIEEECRC32OverBytes, csum.Flavor)
+	assert.Equal(t, 1, csum.Version)
+	assert.Len(t, csum.Value, 4)
+	assert.NoError(t, VerifyBytes(payload, csum))
+
+	assert.Equal(t, ErrMismatch, VerifyBytes([]byte("corrupted history batches"), csum))
+
+	csum.Flavor = FlavorIEEECRC32OverThriftBinary
+	assert.Error(t, VerifyBytes(payload, csum))
+}
@@ -43,6 +43,8 @@ const (
 	FlavorUnknown Flavor = iota
 	// FlavorIEEECRC32OverThriftBinary represents crc32 checksum generated over thriftRW serialized payload
 	FlavorIEEECRC32OverThriftBinary
+	// FlavorIEEECRC32OverBytes represents crc32 checksum generated over a raw byte payload
+	FlavorIEEECRC32OverBytes
 	maxFlavors
 )
 
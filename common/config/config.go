@@ -180,9 +180,11 @@ type (
 		TLSMode yarpctls.Mode `yaml:"TLSMode"`
 	}
 
-	// Blobstore contains the config for blobstore
+	// Blobstore contains the config for blobstore, at most one backend should be configured
 	Blobstore struct {
 		Filestore *FileBlobstore `yaml:"filestore"`
+		S3        *S3Blobstore   `yaml:"s3"`
+		GCS       *GCSBlobstore  `yaml:"gcs"`
 	}
 
 	// FileBlobstore contains the config for a file backed blobstore
@@ -190,6 +192,25 @@ type (
 		OutputDirectory string `yaml:"outputDirectory"`
 	}
 
+	// S3Blobstore contains the config for an S3 backed blobstore.
+	// Endpoint and S3ForcePathStyle allow using S3 compatible stores such as MinIO.
+	S3Blobstore struct {
+		Bucket           string  `yaml:"bucket"`
+		Prefix           string  `yaml:"prefix"`
+		Region           string  `yaml:"region"`
+		Endpoint         *string `yaml:"endpoint"`
+		S3ForcePathStyle bool    `yaml:"s3ForcePathStyle"`
+	}
+
+	// GCSBlobstore contains the config for a Google Cloud Storage backed blobstore.
+	// Endpoint allows using a local stand-in such as fake-gcs-server, requests are then unauthenticated.
+	GCSBlobstore struct {
+		Bucket          string `yaml:"bucket"`
+		Prefix          string `yaml:"prefix"`
+		CredentialsPath string `yaml:"credentialsPath"`
+		Endpoint        string `yaml:"endpoint"`
+	}
+
 	// Persistence contains the configuration for data store / persistence layer
 	Persistence struct {
 		// DefaultStore is the name of the default data store to use
@@ -212,10 +233,20 @@ type (
 		NumHistoryShards int `yaml:"numHistoryShards" validate:"nonzero"`
 		// DataStores contains the configuration for all datastores
 		DataStores map[string]DataStore `yaml:"datastores"`
+		// PayloadEncryption contains the config for encrypting history event payloads and workflow memos at rest
+		// Encryption is enabled per domain through dynamic config
+		PayloadEncryption *PayloadEncryption `yaml:"payloadEncryption"`
 		// HostName for emitting per-host metrics
 		HostName string `yaml:"-" json:"-"`
 	}
 
+	// PayloadEncryption is the configuration for history event payload and workflow memo encryption
+	PayloadEncryption struct {
+		// KeyringFile is the path to the yaml file containing the encryption keys
+		// All clusters of a domain must be configured with the same keys
+		KeyringFile string `yaml:"keyringFile"`
+	}
+
 	// DataStore is the configuration for a single datastore
 	DataStore struct {
 		// Cassandra contains the config for a cassandra datastore
@@ -555,6 +586,8 @@ type (
 	FilestoreArchiver struct {
 		FileMode string `yaml:"fileMode"`
 		DirMode  string `yaml:"dirMode"`
+		// Compression of archived histories: none, snappy or zstd. Legacy uncompressed JSON is written when empty.
+		Compression string `yaml:"compression"`
 	}
 
 	// S3Archiver contains the config for S3 archiver
@@ -562,6 +595,8 @@ type (
 		Region           string  `yaml:"region"`
 		Endpoint         *string `yaml:"endpoint"`
 		S3ForcePathStyle bool    `yaml:"s3ForcePathStyle"`
+		// Compression of archived histories: none, snappy or zstd. Legacy uncompressed JSON is written when empty.
+		Compression string `yaml:"compression"`
 	}
 
 	// PublicClient is config for connecting to cadence frontend
@@ -192,6 +192,9 @@ const (
 	DomainDataKeyForWriteGroups = "WRITE_GROUPS"
 	// DomainDataKeyForProcessGroups stores which groups have process permission of the domain API
 	DomainDataKeyForProcessGroups = "PROCESS_GROUPS"
+	// DomainDataKeyPrefixForSavedVisibilityQuery is the prefix of the keys of DomainData storing the saved visibility queries
+	// of the domain, followed by the name of the query. Updating a query with an empty value deletes it.
+	DomainDataKeyPrefixForSavedVisibilityQuery = "SavedVisibilityQuery."
 )
 
 type (
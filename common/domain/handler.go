@@ -27,6 +27,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"regexp"
+	"strings"
 	"time"
 
 	guuid "github.com/google/uuid"
@@ -1428,6 +1429,11 @@ func (d *handlerImpl) mergeDomainData(
 		old = map[string]string{}
 	}
 	for k, v := range new {
+		// saved visibility queries are deleted by updating them with an empty value
+		if v == "" && strings.HasPrefix(k, constants.DomainDataKeyPrefixForSavedVisibilityQuery) {
+			delete(old, k)
+			continue
+		}
 		old[k] = v
 	}
 	return old
@@ -189,6 +189,25 @@ func (s *domainHandlerCommonSuite) TestMergeDomainData_Merging() {
 	}, out)
 }
 
+func (s *domainHandlerCommonSuite) TestMergeDomainData_DeletingSavedQuery() {
+	out := s.handler.mergeDomainData(
+		map[string]string{
+			"k0":                          "v0",
+			"SavedVisibilityQuery.failed": "CloseStatus = 1",
+		},
+		map[string]string{
+			"SavedVisibilityQuery.failed":  "",
+			"SavedVisibilityQuery.unknown": "",
+			"k1":                           "",
+		},
+	)
+
+	assert.Equal(s.T(), map[string]string{
+		"k0": "v0",
+		"k1": "",
+	}, out)
+}
+
 func (s *domainHandlerCommonSuite) TestMergeDomainData_Nil() {
 	out := s.handler.mergeDomainData(
 		nil,
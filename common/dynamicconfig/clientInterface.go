@@ -52,6 +52,9 @@ type Client interface {
 	GetListValue(name dynamicproperties.ListKey, filters map[dynamicproperties.Filter]interface{}) ([]interface{}, error)
 	// UpdateValue takes value as map and updates by overriding. It doesn't support update with filters.
 	UpdateValue(name dynamicproperties.Key, value interface{}) error
+	// UpdateValueWith replaces the values of the key with the ones computed by update from the current values.
+	// The write is conditional on the values read: if a concurrent update wins, update is called again on the latest values.
+	UpdateValueWith(name dynamicproperties.Key, update func(current []*types.DynamicConfigValue) ([]*types.DynamicConfigValue, error)) error
 	RestoreValue(name dynamicproperties.Key, filters map[dynamicproperties.Filter]interface{}) error
 	ListValue(name dynamicproperties.Key) ([]*types.DynamicConfigEntry, error)
 }
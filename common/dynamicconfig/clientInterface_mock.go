@@ -220,3 +220,17 @@ func (mr *MockClientMockRecorder) UpdateValue(name, value any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateValue", reflect.TypeOf((*MockClient)(nil).UpdateValue), name, value)
 }
+
+// UpdateValueWith mocks base method.
+func (m *MockClient) UpdateValueWith(name dynamicproperties.Key, update func([]*types.DynamicConfigValue) ([]*types.DynamicConfigValue, error)) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateValueWith", name, update)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateValueWith indicates an expected call of UpdateValueWith.
+func (mr *MockClientMockRecorder) UpdateValueWith(name, update any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateValueWith", reflect.TypeOf((*MockClient)(nil).UpdateValueWith), name, update)
+}
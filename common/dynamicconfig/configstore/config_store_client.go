@@ -301,7 +301,16 @@ func (csc *configStoreClient) UpdateValue(name dynamicproperties.Key, value inte
 	if !ok && value != nil {
 		return errors.New("invalid value")
 	}
-	return csc.updateValue(name, dcValues, csc.config.UpdateRetryAttempts)
+	return csc.updateValue(name, func([]*types.DynamicConfigValue) ([]*types.DynamicConfigValue, error) {
+		return dcValues, nil
+	}, csc.config.UpdateRetryAttempts)
+}
+
+func (csc *configStoreClient) UpdateValueWith(
+	name dynamicproperties.Key,
+	update func(current []*types.DynamicConfigValue) ([]*types.DynamicConfigValue, error),
+) error {
+	return csc.updateValue(name, update, csc.config.UpdateRetryAttempts)
 }
 
 func (csc *configStoreClient) RestoreValue(name dynamicproperties.Key, filters map[dynamicproperties.Filter]interface{}) error {
@@ -317,27 +326,27 @@ func (csc *configStoreClient) RestoreValue(name dynamicproperties.Key, filters m
 		return dc.NotFoundError
 	}
 
-	val, ok := currentCached.dcEntries[name.String()]
-	if !ok {
+	if _, ok := currentCached.dcEntries[name.String()]; !ok {
 		return dc.NotFoundError
 	}
 
-	newValues := make([]*types.DynamicConfigValue, 0, len(val.Values))
-	if filters == nil {
-		for _, dcValue := range val.Values {
-			if dcValue.Filters != nil || len(dcValue.Filters) != 0 {
-				newValues = append(newValues, dcValue.Copy())
+	return csc.updateValue(name, func(current []*types.DynamicConfigValue) ([]*types.DynamicConfigValue, error) {
+		newValues := make([]*types.DynamicConfigValue, 0, len(current))
+		if filters == nil {
+			for _, dcValue := range current {
+				if dcValue.Filters != nil || len(dcValue.Filters) != 0 {
+					newValues = append(newValues, dcValue)
+				}
 			}
-		}
-	} else {
-		for _, dcValue := range val.Values {
-			if !matchFilters(dcValue, filters) || dcValue.Filters == nil || len(dcValue.Filters) == 0 {
-				newValues = append(newValues, dcValue.Copy())
+		} else {
+			for _, dcValue := range current {
+				if !matchFilters(dcValue, filters) || dcValue.Filters == nil || len(dcValue.Filters) == 0 {
+					newValues = append(newValues, dcValue)
+				}
 			}
 		}
-	}
-
-	return csc.updateValue(name, newValues, csc.config.UpdateRetryAttempts)
+		return newValues, nil
+	}, csc.config.UpdateRetryAttempts)
 }
 
 func (csc *configStoreClient) ListValue(name dynamicproperties.Key) ([]*types.DynamicConfigEntry, error) {
@@ -394,15 +403,18 @@ func (csc *configStoreClient) Start() {
 	}
 }
 
-func (csc *configStoreClient) updateValue(name dynamicproperties.Key, dcValues []*types.DynamicConfigValue, retryAttempts int) error {
+// updateValue replaces the values of the key with the ones returned by update, which is
+// given a copy of the currently cached values. The write is conditional on the cached
+// snapshot version: if it fails because of a concurrent update, the cache is refreshed
+// and update is called again on the latest values.
+func (csc *configStoreClient) updateValue(
+	name dynamicproperties.Key,
+	update func(current []*types.DynamicConfigValue) ([]*types.DynamicConfigValue, error),
+	retryAttempts int,
+) error {
 	// since values are not unique, no way to know if you are trying to update a specific value
 	// or if you want to add another of the same value with different filters.
 	// UpdateValue will replace everything associated with dc key.
-	for _, dcValue := range dcValues {
-		if err := validateKeyDataBlobPair(name, dcValue.Value); err != nil {
-			return err
-		}
-	}
 	loaded := csc.values.Load()
 	var currentCached cacheEntry
 	if loaded == nil {
@@ -420,6 +432,20 @@ func (csc *configStoreClient) updateValue(name dynamicproperties.Key, dcValues [
 
 	existingEntry, entryExists := currentCached.dcEntries[keyName]
 
+	var currentValues []*types.DynamicConfigValue
+	if entryExists {
+		currentValues = existingEntry.Copy().Values
+	}
+	dcValues, err := update(currentValues)
+	if err != nil {
+		return err
+	}
+	for _, dcValue := range dcValues {
+		if err := validateKeyDataBlobPair(name, dcValue.Value); err != nil {
+			return err
+		}
+	}
+
 	if len(dcValues) == 0 {
 		newEntries = make([]*types.DynamicConfigEntry, 0, len(currentCached.dcEntries))
 
@@ -466,7 +492,7 @@ func (csc *configStoreClient) updateValue(name dynamicproperties.Key, dcValues [
 	ctx, cancel := context.WithTimeout(context.Background(), csc.config.UpdateTimeout)
 	defer cancel()
 
-	err := csc.configStoreManager.UpdateDynamicConfig(
+	err = csc.configStoreManager.UpdateDynamicConfig(
 		ctx,
 		&persistence.UpdateDynamicConfigRequest{
 			Snapshot: newSnapshot,
@@ -485,7 +511,7 @@ func (csc *configStoreClient) updateValue(name dynamicproperties.Key, dcValues [
 				if err != nil {
 					return err
 				}
-				return csc.updateValue(name, dcValues, retryAttempts-1)
+				return csc.updateValue(name, update, retryAttempts-1)
 			}
 
 			if retryAttempts == 0 {
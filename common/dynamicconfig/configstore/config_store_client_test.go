@@ -813,6 +813,81 @@ func (s *configStoreClientSuite) TestUpdateValue_RetryFailure() {
 	s.Error(err)
 }
 
+func (s *configStoreClientSuite) TestUpdateValueWith_RetryRecomputesValues() {
+	fallbackValue := &types.DynamicConfigValue{
+		Value: &types.DataBlob{
+			EncodingType: types.EncodingTypeJSON.Ptr(),
+			Data:         jsonMarshalHelper(true),
+		},
+	}
+	domainValue := &types.DynamicConfigValue{
+		Value: &types.DataBlob{
+			EncodingType: types.EncodingTypeJSON.Ptr(),
+			Data:         jsonMarshalHelper(false),
+		},
+		Filters: []*types.DynamicConfigFilter{
+			{
+				Name: "domainName",
+				Value: &types.DataBlob{
+					EncodingType: types.EncodingTypeJSON.Ptr(),
+					Data:         jsonMarshalHelper("new-domain"),
+				},
+			},
+		},
+	}
+	// snapshot written concurrently, after the client cached snapshot1
+	snapshot2 := &p.DynamicConfigSnapshot{
+		Version: 2,
+		Values: &types.DynamicConfigBlob{
+			SchemaVersion: 1,
+			Entries: []*types.DynamicConfigEntry{
+				{
+					Name:   dynamicproperties.TestGetBoolPropertyKey.String(),
+					Values: []*types.DynamicConfigValue{fallbackValue},
+				},
+			},
+		},
+	}
+
+	s.mockManager.EXPECT().
+		FetchDynamicConfig(gomock.Any(), p.DynamicConfig).
+		Return(&p.FetchDynamicConfigResponse{Snapshot: snapshot1}, nil).Times(1)
+	s.mockManager.EXPECT().
+		FetchDynamicConfig(gomock.Any(), p.DynamicConfig).
+		Return(&p.FetchDynamicConfigResponse{Snapshot: snapshot2}, nil).AnyTimes()
+	s.mockManager.EXPECT().
+		UpdateDynamicConfig(gomock.Any(), EqSnapshotVersion(2), p.DynamicConfig).
+		Return(&p.ConditionFailedError{}).Times(1)
+	s.mockManager.EXPECT().
+		UpdateDynamicConfig(gomock.Any(), EqSnapshotVersion(3), p.DynamicConfig).
+		DoAndReturn(func(_ context.Context, request *p.UpdateDynamicConfigRequest, _ p.ConfigType) error {
+			s.Len(request.Snapshot.Values.Entries, 1)
+			s.Equal([]*types.DynamicConfigValue{fallbackValue, domainValue}, request.Snapshot.Values.Entries[0].Values)
+			return nil
+		}).Times(1)
+
+	s.NoError(s.client.update())
+
+	var calls [][]*types.DynamicConfigValue
+	err := s.client.UpdateValueWith(dynamicproperties.TestGetBoolPropertyKey, func(current []*types.DynamicConfigValue) ([]*types.DynamicConfigValue, error) {
+		calls = append(calls, current)
+		return append(current, domainValue), nil
+	})
+	s.NoError(err)
+	s.Len(calls, 2)
+	s.Len(calls[0], 3)
+	s.Equal([]*types.DynamicConfigValue{fallbackValue}, calls[1])
+}
+
+func (s *configStoreClientSuite) TestUpdateValueWith_UpdateError() {
+	defaultTestSetup(s)
+
+	err := s.client.UpdateValueWith(dynamicproperties.TestGetBoolPropertyKey, func([]*types.DynamicConfigValue) ([]*types.DynamicConfigValue, error) {
+		return nil, errors.New("update failed")
+	})
+	s.EqualError(err, "update failed")
+}
+
 func (s *configStoreClientSuite) TestUpdateValue_Timeout() {
 	defaultTestSetup(s)
 	s.mockManager.EXPECT().
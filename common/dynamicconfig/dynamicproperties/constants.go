@@ -353,6 +353,13 @@ const (
 	// Default value: 14680064 (14*1024*1024, ~14MB)
 	// Allowed filters: N/A
 	TransactionSizeLimit
+	// PayloadOffloadThreshold is the size in bytes above which a history event payload of a domain is stored in the blobstore
+	// and only referenced from the history, it requires a blobstore to be configured. 0 disables offloading.
+	// KeyName: system.payloadOffloadThreshold
+	// Value type: Int
+	// Default value: 0
+	// Allowed filters: DomainName
+	PayloadOffloadThreshold
 	// MaxRetentionDays is the maximum allowed retention period in days for workflow history after workflow close for all domains
 	// KeyName: system.maxRetentionDays
 	// Value type: Int
@@ -383,6 +390,13 @@ const (
 	// Default value: 262144 (256*1024)
 	// Allowed filters: DomainName
 	BlobSizeLimitWarn
+	// OffloadedBlobSizeLimitError is the per payload size limit of the payloads of a domain which are stored in the blobstore
+	// because they are larger than system.payloadOffloadThreshold, the RPC message size limits must allow payloads of this size
+	// KeyName: limit.offloadedBlobSize.error
+	// Value type: Int
+	// Default value: 16777216 (16*1024*1024)
+	// Allowed filters: DomainName
+	OffloadedBlobSizeLimitError
 	// HistorySizeLimitError is the per workflow execution history size limit
 	// KeyName: limit.historySize.error
 	// Value type: Int
@@ -1245,6 +1259,12 @@ const (
 	// Default value: 10000
 	// Allowed filters: DomainName
 	MaximumSignalsPerExecution
+	// MaximumCompletedUpdatesPerExecution is max number of completed update outcomes kept in the mutable state of an execution
+	// KeyName: history.maximumCompletedUpdatesPerExecution
+	// Value type: Int
+	// Default value: 100
+	// Allowed filters: DomainName
+	MaximumCompletedUpdatesPerExecution
 	// NumArchiveSystemWorkflows is key for number of archive system workflows running in total
 	// KeyName: history.numArchiveSystemWorkflows
 	// Value type: Int
@@ -1781,6 +1801,12 @@ const (
 	// Default value: false
 	// Allowed filters: N/A
 	EnableDomainAuditLogging
+	// EnablePayloadEncryption enables encryption of the history event payloads of a domain at rest, it requires a payload encryption keyring in the persistence config
+	// KeyName: system.enablePayloadEncryption
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: DomainName
+	EnablePayloadEncryption
 
 	// key for frontend
 
@@ -2112,6 +2138,12 @@ const (
 	// Default value: false
 	// Allowed filters: DomainName
 	EnableScheduler
+	// EnableSemaphore decides whether to run the semaphore workflows of a domain on the worker service.
+	// KeyName: worker.enableSemaphore
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: DomainName
+	EnableSemaphore
 	// EnableParentClosePolicyWorker decides whether or not enable system workers for processing parent close policy task
 	// KeyName: system.enableParentClosePolicyWorker
 	// Value type: Bool
@@ -2143,6 +2175,13 @@ const (
 	// Default value: true
 	// Allowed filters: N/A
 	EnableFailoverManager
+	// EnableVisibilityMigration decides whether the worker service hosts the visibility store migration workflow.
+	// The worker only creates its visibility manager when this is set, so changing it requires a worker restart.
+	// KeyName: worker.enableVisibilityMigration
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: N/A
+	EnableVisibilityMigration
 	// ConcreteExecutionFixerDomainAllow is which domains are allowed to be fixed by concrete fixer workflow
 	// KeyName: worker.concreteExecutionFixerDomainAllow
 	// Value type: Bool
@@ -2304,6 +2343,20 @@ const (
 	// Allowed filters: DomainName,TasklistName,TaskType
 	MatchingEnableClientAutoConfig
 
+	// MatchingEnableTaskPriority enables dispatching backlog tasks by priority instead of FIFO
+	// KeyName: matching.enableTaskPriority
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: DomainName,TasklistName,TaskType
+	MatchingEnableTaskPriority
+
+	// MatchingEnableTaskFairness enables round robin dispatch of backlog tasks between fairness keys
+	// KeyName: matching.enableTaskFairness
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: DomainName,TasklistName,TaskType
+	MatchingEnableTaskFairness
+
 	// EnableNoSQLHistoryTaskDualWriteMode is to enable dual write of history events
 	// KeyName: history.enableNoSQLHistoryTaskDualWrite
 	// Value type: Bool
@@ -2726,6 +2779,12 @@ const (
 	// Value type: String
 	// Default value: "thriftrw"
 	SerializationEncoding
+	// PayloadEncryptionKeyID is the ID of the keyring key used to encrypt the history event payloads of a domain
+	// KeyName: system.payloadEncryptionKeyID
+	// Value type: String
+	// Default value: "" (the active key of the keyring)
+	// Allowed filters: DomainName
+	PayloadEncryptionKeyID
 
 	// HistoryTaskDLQMode enables writing tasks to the History Task Dead Letter Queue rather than discarding them.
 	// To enable this key, HistoryTaskDLQProcessorEnabled must be enabled.
@@ -3243,6 +3302,13 @@ const (
 	// Default value: 1m (time.Minute)
 	// Allowed filters: N/A
 	SchedulerWorkerRefreshInterval
+	// SemaphoreWorkerRefreshInterval is how often the semaphore worker manager
+	// reconciles per-domain semaphore workers and semaphore workflows.
+	// KeyName: worker.semaphoreRefreshInterval
+	// Value type: Duration
+	// Default value: 1m (time.Minute)
+	// Allowed filters: N/A
+	SemaphoreWorkerRefreshInterval
 	// WorkerTimeLimitPerArchivalIteration is controls the time limit of each iteration of archival workflow
 	// KeyName: worker.TimeLimitPerArchivalIteration
 	// Value type: Duration
@@ -3449,6 +3515,21 @@ const (
 	// Allowed filters: N/A
 	SearchAttributesHiddenValueKeys
 
+	// key for matching
+
+	// MatchingTaskPriorityRoundRobinWeights is the dispatch weight of each task priority level for backlog tasks
+	// KeyName: matching.taskPriorityRoundRobinWeights
+	// Value type: Map
+	// Default value: see common.ConvertIntMapToDynamicConfigMapProperty(DefaultMatchingTaskPriorityRoundRobinWeights) in code base
+	// Allowed filters: N/A
+	MatchingTaskPriorityRoundRobinWeights
+	// MatchingTaskFairnessKeyWeights is the dispatch weight of each fairness key for backlog tasks, keys not listed get a weight of 1
+	// KeyName: matching.taskFairnessKeyWeights
+	// Value type: Map
+	// Default value: empty map
+	// Allowed filters: DomainName
+	MatchingTaskFairnessKeyWeights
+
 	// LastMapKey must be the last one in this const group
 	LastMapKey
 )
@@ -3528,6 +3609,12 @@ var IntKeys = map[IntKey]DynamicInt{
 		Description:  "TransactionSizeLimit is the largest allowed transaction size to persistence",
 		DefaultValue: 14680064,
 	},
+	PayloadOffloadThreshold: {
+		KeyName:      "system.payloadOffloadThreshold",
+		Filters:      []Filter{DomainName},
+		Description:  "PayloadOffloadThreshold is the size in bytes above which a history event payload of a domain is stored in the blobstore, 0 disables offloading",
+		DefaultValue: 0,
+	},
 	MaxRetentionDays: {
 		KeyName:      "system.maxRetentionDays",
 		Description:  "MaxRetentionDays is the maximum allowed retention days for domain",
@@ -3554,6 +3641,12 @@ var IntKeys = map[IntKey]DynamicInt{
 		Description:  "BlobSizeLimitWarn is the per event blob size limit for warning",
 		DefaultValue: 256 * 1024,
 	},
+	OffloadedBlobSizeLimitError: {
+		KeyName:      "limit.offloadedBlobSize.error",
+		Filters:      []Filter{DomainName},
+		Description:  "OffloadedBlobSizeLimitError is the per payload size limit of the payloads of a domain which are stored in the blobstore because they are larger than system.payloadOffloadThreshold",
+		DefaultValue: 16 * 1024 * 1024,
+	},
 	HistorySizeLimitError: {
 		KeyName:      "limit.historySize.error",
 		Filters:      []Filter{DomainName},
@@ -4253,6 +4346,12 @@ var IntKeys = map[IntKey]DynamicInt{
 		Description:  "MaximumSignalsPerExecution is max number of signals supported by single execution",
 		DefaultValue: 10000, // 10K signals should big enough given workflow execution has 200K history lengh limit. It needs to be non-zero to protect continueAsNew from infinit loop
 	},
+	MaximumCompletedUpdatesPerExecution: {
+		KeyName:      "history.maximumCompletedUpdatesPerExecution",
+		Filters:      []Filter{DomainName},
+		Description:  "MaximumCompletedUpdatesPerExecution is max number of completed update outcomes kept in the mutable state of an execution, a retried update whose outcome was dropped runs again",
+		DefaultValue: 100,
+	},
 	NumArchiveSystemWorkflows: {
 		KeyName:      "history.numArchiveSystemWorkflows",
 		Description:  "NumArchiveSystemWorkflows is key for number of archive system workflows running in total",
@@ -4700,6 +4799,12 @@ var BoolKeys = map[BoolKey]DynamicBool{
 		Description:  "EnableDomainAuditLogging enables audit logging for a domain to the domain audit log table",
 		DefaultValue: false,
 	},
+	EnablePayloadEncryption: {
+		KeyName:      "system.enablePayloadEncryption",
+		Filters:      []Filter{DomainName},
+		Description:  "EnablePayloadEncryption enables encryption of the history event payloads and workflow memos of a domain at rest",
+		DefaultValue: false,
+	},
 	DisallowQuery: {
 		KeyName:      "system.disallowQuery",
 		Filters:      []Filter{DomainName},
@@ -5008,6 +5113,12 @@ var BoolKeys = map[BoolKey]DynamicBool{
 		Description:  "EnableScheduler decides whether to start the scheduler worker for cron-based scheduling. Can be filtered by domain to enable/disable per domain.",
 		DefaultValue: false,
 	},
+	EnableSemaphore: {
+		KeyName:      "worker.enableSemaphore",
+		Filters:      []Filter{DomainName},
+		Description:  "EnableSemaphore decides whether to run the semaphore workflows of a domain on the worker service",
+		DefaultValue: false,
+	},
 	EnableParentClosePolicyWorker: {
 		KeyName:      "system.enableParentClosePolicyWorker",
 		Description:  "EnableParentClosePolicyWorker decides whether or not enable system workers for processing parent close policy task",
@@ -5034,6 +5145,11 @@ var BoolKeys = map[BoolKey]DynamicBool{
 		Description:  "EnableFailoverManager indicates if failover manager is enabled",
 		DefaultValue: true,
 	},
+	EnableVisibilityMigration: {
+		KeyName:      "worker.enableVisibilityMigration",
+		Description:  "EnableVisibilityMigration decides whether the worker service hosts the visibility store migration workflow. Changing it requires a worker restart",
+		DefaultValue: false,
+	},
 	ConcreteExecutionFixerDomainAllow: {
 		KeyName:      "worker.concreteExecutionFixerDomainAllow",
 		Filters:      []Filter{DomainName},
@@ -5163,6 +5279,18 @@ var BoolKeys = map[BoolKey]DynamicBool{
 		Description:  "MatchingEnableClientAutoConfig is to enable auto config on worker side",
 		DefaultValue: false,
 	},
+	MatchingEnableTaskPriority: {
+		KeyName:      "matching.enableTaskPriority",
+		Filters:      []Filter{DomainName, TaskListName, TaskType},
+		Description:  "MatchingEnableTaskPriority enables dispatching backlog tasks by priority instead of FIFO",
+		DefaultValue: false,
+	},
+	MatchingEnableTaskFairness: {
+		KeyName:      "matching.enableTaskFairness",
+		Filters:      []Filter{DomainName, TaskListName, TaskType},
+		Description:  "MatchingEnableTaskFairness enables round robin dispatch of backlog tasks between fairness keys",
+		DefaultValue: false,
+	},
 	EnablePartitionIsolationGroupAssignment: {
 		KeyName:      "matching.enablePartitionIsolationGroupAssignment",
 		Filters:      []Filter{DomainName, TaskListName, TaskType},
@@ -5522,6 +5650,12 @@ var StringKeys = map[StringKey]DynamicString{
 		Description:  "SerializationEncoding is the encoding type for blobs",
 		DefaultValue: string(constants.EncodingTypeThriftRW),
 	},
+	PayloadEncryptionKeyID: {
+		KeyName:      "system.payloadEncryptionKeyID",
+		Description:  "PayloadEncryptionKeyID is the ID of the keyring key used to encrypt the history event payloads and workflow memos of a domain, the active key of the keyring is used when empty",
+		DefaultValue: "",
+		Filters:      []Filter{DomainName},
+	},
 	HistoryTaskDLQMode: {
 		KeyName:      "history.historyTaskDLQMode",
 		Description:  "HistoryTaskDLQMode is the key to enable history task dead letter queue. When enabled, the history task will be sent to a dead letter queue if it fails to be processed after a certain number of retries.",
@@ -5975,6 +6109,11 @@ var DurationKeys = map[DurationKey]DynamicDuration{
 		Description:  "SchedulerWorkerRefreshInterval is how often the scheduler worker manager scans the domain cache to start/stop per-domain workers",
 		DefaultValue: time.Minute,
 	},
+	SemaphoreWorkerRefreshInterval: {
+		KeyName:      "worker.semaphoreRefreshInterval",
+		Description:  "SemaphoreWorkerRefreshInterval is how often the semaphore worker manager reconciles per-domain semaphore workers and semaphore workflows",
+		DefaultValue: time.Minute,
+	},
 	WorkerTimeLimitPerArchivalIteration: {
 		KeyName:      "worker.TimeLimitPerArchivalIteration",
 		Description:  "WorkerTimeLimitPerArchivalIteration is controls the time limit of each iteration of archival workflow",
@@ -6144,6 +6283,17 @@ var MapKeys = map[MapKey]DynamicMap{
 		Description:  "SearchAttributesHiddenValueKeys is the list of search attributes that values should be hidden",
 		DefaultValue: map[string]interface{}{},
 	},
+	MatchingTaskPriorityRoundRobinWeights: {
+		KeyName:      "matching.taskPriorityRoundRobinWeights",
+		Description:  "MatchingTaskPriorityRoundRobinWeights is the dispatch weight of each task priority level for backlog tasks",
+		DefaultValue: ConvertIntMapToDynamicConfigMapProperty(DefaultMatchingTaskPriorityRoundRobinWeights),
+	},
+	MatchingTaskFairnessKeyWeights: {
+		KeyName:      "matching.taskFairnessKeyWeights",
+		Description:  "MatchingTaskFairnessKeyWeights is the dispatch weight of each fairness key for backlog tasks, keys not listed get a weight of 1",
+		Filters:      []Filter{DomainName},
+		DefaultValue: map[string]interface{}{},
+	},
 }
 
 var ListKeys = map[ListKey]DynamicList{
@@ -6229,4 +6379,14 @@ var (
 		constants.GetTaskPriority(constants.DefaultPriorityClass, constants.DefaultPrioritySubclass): 20,
 		constants.GetTaskPriority(constants.LowPriorityClass, constants.DefaultPrioritySubclass):     5,
 	}
+
+	// DefaultMatchingTaskPriorityRoundRobinWeights gives each priority level, from 1 (highest) to 5 (lowest),
+	// twice the dispatch share of the next one so lower levels still make progress under load
+	DefaultMatchingTaskPriorityRoundRobinWeights = map[int]int{
+		1: 16,
+		2: 8,
+		3: 4,
+		4: 2,
+		5: 1,
+	}
 )
@@ -231,6 +231,13 @@ func (fc *fileBasedClient) UpdateValue(name dynamicproperties.Key, value interfa
 	return fc.storeValues(currentValues)
 }
 
+func (fc *fileBasedClient) UpdateValueWith(
+	name dynamicproperties.Key,
+	update func(current []*types.DynamicConfigValue) ([]*types.DynamicConfigValue, error),
+) error {
+	return errors.New("not supported for file based client")
+}
+
 func (fc *fileBasedClient) RestoreValue(name dynamicproperties.Key, filters map[dynamicproperties.Filter]interface{}) error {
 	return errors.New("not supported for file based client")
 }
@@ -144,6 +144,13 @@ func (mc *inMemoryClient) UpdateValue(key dynamicproperties.Key, value interface
 	return nil
 }
 
+func (mc *inMemoryClient) UpdateValueWith(
+	name dynamicproperties.Key,
+	update func(current []*types.DynamicConfigValue) ([]*types.DynamicConfigValue, error),
+) error {
+	return errors.New("not supported for in-memory client")
+}
+
 func (mc *inMemoryClient) RestoreValue(name dynamicproperties.Key, filters map[dynamicproperties.Filter]interface{}) error {
 	return errors.New("not supported for in-memory client")
 }
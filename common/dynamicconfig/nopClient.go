@@ -75,6 +75,13 @@ func (mc *nopClient) UpdateValue(name dynamicproperties.Key, value interface{})
 	return errors.New("not supported for nop client")
 }
 
+func (mc *nopClient) UpdateValueWith(
+	name dynamicproperties.Key,
+	update func(current []*types.DynamicConfigValue) ([]*types.DynamicConfigValue, error),
+) error {
+	return errors.New("not supported for nop client")
+}
+
 func (mc *nopClient) RestoreValue(name dynamicproperties.Key, filters map[dynamicproperties.Filter]interface{}) error {
 	return errors.New("not supported for nop client")
 }
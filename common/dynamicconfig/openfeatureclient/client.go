@@ -293,13 +293,20 @@ func (c *openFeatureClient) GetListValue(name dynamicproperties.ListKey, filters
 	return listVal, nil
 }
 
-// UpdateValue, RestoreValue, ListValue: OpenFeature is a read/evaluation API
+// UpdateValue, UpdateValueWith, RestoreValue, ListValue: OpenFeature is a read/evaluation API
 // with no admin write path. Flag mutation happens in the provider's own
 // control plane (e.g. flagd's flag source file, a vendor console), not here.
 func (c *openFeatureClient) UpdateValue(name dynamicproperties.Key, value interface{}) error {
 	return errors.New("not supported for openfeature client: manage flags via the configured provider")
 }
 
+func (c *openFeatureClient) UpdateValueWith(
+	name dynamicproperties.Key,
+	update func(current []*types.DynamicConfigValue) ([]*types.DynamicConfigValue, error),
+) error {
+	return errors.New("not supported for openfeature client")
+}
+
 func (c *openFeatureClient) RestoreValue(name dynamicproperties.Key, filters map[dynamicproperties.Filter]interface{}) error {
 	return errors.New("not supported for openfeature client")
 }
@@ -25,6 +25,7 @@ package elasticsearch
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"math"
@@ -45,6 +46,9 @@ import (
 
 var _ GenericClient = (*ESClient)(nil)
 
+// pointInTimeKeepAlive is how long a point in time is kept between pages of a scan, the same as for scroll
+const pointInTimeKeepAlive = time.Minute
+
 type ESClient struct {
 	Client client.Client
 	Logger log.Logger
@@ -128,12 +132,79 @@ func (c *ESClient) esHitsToExecutions(eshits *client.SearchHits, filter IsRecord
 	return hits
 }
 
+// ScanByQuery pages with a point in time and search_after. Scroll is only used when a point in time can't be
+// opened, e.g. on Elasticsearch 6, and to finish scans whose page token holds a scroll ID.
 func (c *ESClient) ScanByQuery(ctx context.Context, request *ScanByQueryRequest) (*SearchResponse, error) {
-	var err error
 	token, err := GetNextPageToken(request.NextPageToken)
 	if err != nil {
 		return nil, err
 	}
+	if len(token.ScrollID) != 0 {
+		return c.scanByScroll(ctx, request, token)
+	}
+
+	pitID := token.PitID
+	if len(pitID) == 0 { // first page
+		pitID, err = c.Client.OpenPointInTime(ctx, request.Index, pointInTimeKeepAlive)
+		if err != nil {
+			if !errors.Is(err, client.ErrPointInTimeNotSupported) {
+				c.Logger.Warn("opening point in time failed, falling back to scroll", tag.Error(err))
+			}
+			return c.scanByScroll(ctx, request, token)
+		}
+	}
+	return c.scanByPointInTime(ctx, request, pitID, token.SearchAfter)
+}
+
+func (c *ESClient) scanByPointInTime(ctx context.Context, request *ScanByQueryRequest, pitID string, searchAfter []interface{}) (*SearchResponse, error) {
+	searchResult, err := c.searchPointInTime(ctx, request, pitID, searchAfter)
+	if err != nil && (c.Client.IsNotFoundError(err) || isNodeUnavailableError(err)) {
+		// the point in time is gone, e.g. it expired or a node restarted. The sort values in the page token
+		// still tell where the scan stopped, so it resumes from a new point in time
+		c.Logger.Warn("point in time not available, retrying with a new point in time", tag.Error(err))
+		pitID, err = c.Client.OpenPointInTime(ctx, request.Index, pointInTimeKeepAlive)
+		if err == nil {
+			searchResult, err = c.searchPointInTime(ctx, request, pitID, searchAfter)
+		}
+	}
+	if err != nil {
+		return nil, &types.InternalServiceError{
+			Message: fmt.Sprintf("ScanByQuery failed. Error: %v", err),
+		}
+	}
+	// the ID may change between searches, only the latest one is valid
+	if len(searchResult.PitID) != 0 {
+		pitID = searchResult.PitID
+	}
+
+	response := &p.InternalListWorkflowExecutionsResponse{}
+	response.Executions = c.esHitsToExecutions(searchResult.Hits, nil /* no filter */)
+
+	if len(searchResult.Hits.Hits) < request.PageSize || len(searchResult.Sort) == 0 { // no more result
+		if err := c.Client.ClosePointInTime(ctx, pitID); err != nil {
+			c.Logger.Warn("point in time close failed", tag.Error(err))
+		}
+		return response, nil
+	}
+
+	nextPageToken, err := SerializePageToken(&ElasticVisibilityPageToken{PitID: pitID, SearchAfter: searchResult.Sort})
+	if err != nil {
+		return nil, err
+	}
+	response.NextPageToken = make([]byte, len(nextPageToken))
+	copy(response.NextPageToken, nextPageToken)
+	return response, nil
+}
+
+func (c *ESClient) searchPointInTime(ctx context.Context, request *ScanByQueryRequest, pitID string, searchAfter []interface{}) (*client.Response, error) {
+	body, err := getPointInTimeQuery(request.Query, pitID, request.PageSize, searchAfter)
+	if err != nil {
+		return nil, err
+	}
+	return c.Client.SearchPointInTime(ctx, body)
+}
+
+func (c *ESClient) scanByScroll(ctx context.Context, request *ScanByQueryRequest, token *ElasticVisibilityPageToken) (*SearchResponse, error) {
 	searchResult, err := c.Client.Scroll(ctx, request.Index, request.Query, token.ScrollID)
 
 	isLastPage := false
@@ -391,6 +462,38 @@ func buildPutMappingBody(root, key, valueType string) map[string]interface{} {
 	return body
 }
 
+// getPointInTimeQuery turns a scan query into a search on the point in time, starting after searchAfter.
+// The scan is sorted by RunID, which is unique, so it can resume from a new point in time with the same sort values.
+func getPointInTimeQuery(query, pitID string, pageSize int, searchAfter []interface{}) (string, error) {
+	body := map[string]interface{}{}
+	if len(query) != 0 {
+		dec := json.NewDecoder(strings.NewReader(query))
+		dec.UseNumber()
+		if err := dec.Decode(&body); err != nil {
+			return "", fmt.Errorf("decoding scan query: %w", err)
+		}
+	}
+
+	delete(body, "from") // pages are selected by search_after
+	body["size"] = pageSize
+	body["pit"] = map[string]interface{}{
+		"id":         pitID,
+		"keep_alive": fmt.Sprintf("%ds", int64(pointInTimeKeepAlive.Seconds())),
+	}
+	body["sort"] = []interface{}{
+		map[string]interface{}{RunID: "asc"},
+	}
+	if len(searchAfter) != 0 {
+		body["search_after"] = searchAfter
+	}
+
+	data, err := json.Marshal(body)
+	if err != nil {
+		return "", fmt.Errorf("encoding point in time query: %w", err)
+	}
+	return string(data), nil
+}
+
 // Helper to check if error is node unavailable error from OpenSearch
 func isNodeUnavailableError(err error) bool {
 	if err == nil {
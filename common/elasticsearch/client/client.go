@@ -25,24 +25,35 @@ package client
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"time"
 
 	"github.com/uber/cadence/common/elasticsearch/bulk"
 )
 
 //go:generate mockgen -package $GOPACKAGE -destination client_mock.go github.com/uber/cadence/common/elasticsearch/client Client
 
+// ErrPointInTimeNotSupported is returned by clients for versions without point in time search,
+// callers are expected to fall back to scroll
+var ErrPointInTimeNotSupported = errors.New("point in time search is not supported")
+
 // Client is a generic ES client implementation.
 // This interface allows to use different Elasticsearch and OpenSearch versions
 // without exposing implementation details and structs
 type Client interface {
 	// ClearScroll clears the search context and results for a scrolling search.
 	ClearScroll(ctx context.Context, scrollID string) error
+	// ClosePointInTime releases the search context kept by a point in time.
+	ClosePointInTime(ctx context.Context, pitID string) error
 	// Count returns number of document matches by given query
 	Count(ctx context.Context, index, body string) (int64, error)
 	// CreateIndex creates index with given name
 	CreateIndex(ctx context.Context, index string) error
 	// IsNotFoundError checks if error is a "not found"
 	IsNotFoundError(err error) bool
+	// OpenPointInTime opens a point in time on the index and returns its ID.
+	// The point in time keeps a consistent view of the index for keepAlive after each use.
+	OpenPointInTime(ctx context.Context, index string, keepAlive time.Duration) (string, error)
 	// RunBulkProcessor starts bulk indexing processor
 	// @TODO consider to extract Bulk Processor as a separate entity
 	RunBulkProcessor(ctx context.Context, p *bulk.BulkProcessorParameters) (bulk.GenericBulkProcessor, error)
@@ -50,6 +61,9 @@ type Client interface {
 	Scroll(ctx context.Context, index, body, scrollID string) (*Response, error)
 	// Search returns Elasticsearch hit bytes and additional metadata
 	Search(ctx context.Context, index, body string) (*Response, error)
+	// SearchPointInTime runs a search against the point in time referenced in the body.
+	// Response.PitID holds the point in time ID to use for the next page.
+	SearchPointInTime(ctx context.Context, body string) (*Response, error)
 
 	// Admin schema management methods
 	// IsHealthy checks if the client can connect to the server
@@ -78,6 +92,7 @@ type Response struct {
 	Aggregations map[string]json.RawMessage
 	Sort         []interface{}
 	ScrollID     string
+	PitID        string
 }
 
 // SearchHits specifies the list of search hits.
@@ -12,6 +12,7 @@ package client
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	gomock "go.uber.org/mock/gomock"
 
@@ -56,6 +57,20 @@ func (mr *MockClientMockRecorder) ClearScroll(ctx, scrollID any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClearScroll", reflect.TypeOf((*MockClient)(nil).ClearScroll), ctx, scrollID)
 }
 
+// ClosePointInTime mocks base method.
+func (m *MockClient) ClosePointInTime(ctx context.Context, pitID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClosePointInTime", ctx, pitID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ClosePointInTime indicates an expected call of ClosePointInTime.
+func (mr *MockClientMockRecorder) ClosePointInTime(ctx, pitID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClosePointInTime", reflect.TypeOf((*MockClient)(nil).ClosePointInTime), ctx, pitID)
+}
+
 // Count mocks base method.
 func (m *MockClient) Count(ctx context.Context, index, body string) (int64, error) {
 	m.ctrl.T.Helper()
@@ -186,6 +201,21 @@ func (mr *MockClientMockRecorder) MappingsFromTemplate(template any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MappingsFromTemplate", reflect.TypeOf((*MockClient)(nil).MappingsFromTemplate), template)
 }
 
+// OpenPointInTime mocks base method.
+func (m *MockClient) OpenPointInTime(ctx context.Context, index string, keepAlive time.Duration) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OpenPointInTime", ctx, index, keepAlive)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OpenPointInTime indicates an expected call of OpenPointInTime.
+func (mr *MockClientMockRecorder) OpenPointInTime(ctx, index, keepAlive any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OpenPointInTime", reflect.TypeOf((*MockClient)(nil).OpenPointInTime), ctx, index, keepAlive)
+}
+
 // PutIndexTemplate mocks base method.
 func (m *MockClient) PutIndexTemplate(ctx context.Context, templateName string, template []byte) error {
 	m.ctrl.T.Helper()
@@ -258,3 +288,18 @@ func (mr *MockClientMockRecorder) Search(ctx, index, body any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockClient)(nil).Search), ctx, index, body)
 }
+
+// SearchPointInTime mocks base method.
+func (m *MockClient) SearchPointInTime(ctx context.Context, body string) (*Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchPointInTime", ctx, body)
+	ret0, _ := ret[0].(*Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchPointInTime indicates an expected call of SearchPointInTime.
+func (mr *MockClientMockRecorder) SearchPointInTime(ctx, body any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchPointInTime", reflect.TypeOf((*MockClient)(nil).SearchPointInTime), ctx, body)
+}
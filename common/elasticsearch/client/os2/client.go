@@ -66,6 +66,7 @@ type (
 		Aggregations map[string]json.RawMessage `json:"aggregations,omitempty"`
 		Sort         []interface{}              `json:"sort,omitempty"` // sort information
 		ScrollID     string                     `json:"_scroll_id,omitempty"`
+		PitID        string                     `json:"pit_id,omitempty"`
 	}
 
 	// searchHits specifies the list of search hits.
@@ -349,6 +350,75 @@ func (c *OS2) Search(ctx context.Context, index, body string) (*client.Response,
 	}, nil
 }
 
+func (c *OS2) OpenPointInTime(ctx context.Context, index string, keepAlive time.Duration) (string, error) {
+	resp, err := c.client.PointInTime.Create(ctx, osapi.PointInTimeCreateReq{
+		Indices: []string{index},
+		Params: osapi.PointInTimeCreateParams{
+			KeepAlive: keepAlive,
+		},
+	})
+	if err != nil {
+		return "", fmt.Errorf("OpenSearch OpenPointInTime: %w", err)
+	}
+	if resp.PitID == "" {
+		return "", fmt.Errorf("OpenSearch OpenPointInTime: response has no pit_id")
+	}
+	return resp.PitID, nil
+}
+
+func (c *OS2) ClosePointInTime(ctx context.Context, pitID string) error {
+	_, err := c.client.PointInTime.Delete(ctx, osapi.PointInTimeDeleteReq{
+		PitID: []string{pitID},
+	})
+	if err != nil && !c.IsNotFoundError(err) { // not found means the point in time has already expired
+		return fmt.Errorf("OpenSearch ClosePointInTime: %w", err)
+	}
+	return nil
+}
+
+func (c *OS2) SearchPointInTime(ctx context.Context, body string) (*client.Response, error) {
+	// the index comes from the point in time referenced in the body
+	resp, err := c.client.Search(ctx, &osapi.SearchReq{
+		Body: strings.NewReader(body),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("OpenSearch point in time search error: %w", err)
+	}
+	if resp.Inspect().Response == nil {
+		return nil, fmt.Errorf("OpenSearch point in time search response nil")
+	}
+	var osResponse response
+	bodyBytes, err := io.ReadAll(resp.Inspect().Response.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read OpenSearch point in time search response body: %w", err)
+	}
+	if err := c.decoder.Decode(bytes.NewReader(bodyBytes), &osResponse); err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("decoding OpenSearch point in time result to Response: %w", err)
+	}
+
+	var hits []*client.SearchHit
+	var sort []interface{}
+	var totalHits int64
+	if osResponse.Hits != nil {
+		if osResponse.Hits.TotalHits != nil {
+			totalHits = osResponse.Hits.TotalHits.Value
+		}
+		for _, h := range osResponse.Hits.Hits {
+			sort = h.Sort
+			hits = append(hits, &client.SearchHit{Source: h.Source, Sort: h.Sort})
+		}
+	}
+
+	return &client.Response{
+		TookInMillis: osResponse.TookInMillis,
+		TotalHits:    totalHits,
+		Hits:         &client.SearchHits{Hits: hits},
+		Aggregations: osResponse.Aggregations,
+		Sort:         sort,
+		PitID:        osResponse.PitID,
+	}, nil
+}
+
 // Admin schema management methods
 
 func (c *OS2) IsHealthy(ctx context.Context) error {
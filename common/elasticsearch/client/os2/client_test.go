@@ -32,6 +32,7 @@ import (
 	"net/http/httptest"
 	"net/url"
 	"testing"
+	"time"
 
 	"github.com/opensearch-project/opensearch-go/v4"
 	osapi "github.com/opensearch-project/opensearch-go/v4/opensearchapi"
@@ -691,6 +692,147 @@ func TestClearScroll(t *testing.T) {
 	}
 }
 
+func TestOpenPointInTime(t *testing.T) {
+	testCases := []struct {
+		name          string
+		handler       http.HandlerFunc
+		expectedPitID string
+		expectedError bool
+	}{
+		{
+			name: "Successful Open",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/testIndex/_search/point_in_time", r.URL.Path)
+				assert.NotEmpty(t, r.URL.Query().Get("keep_alive"))
+				fmt.Fprintln(w, `{"pit_id": "testPitID", "_shards": {"total": 1, "successful": 1}, "creation_time": 1750950124525}`)
+			},
+			expectedPitID: "testPitID",
+		},
+		{
+			name: "OpenSearch Error",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusBadRequest)
+				fmt.Fprintln(w, `{"error": "Bad request"}`)
+			},
+			expectedError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			os2Client, testServer := getSecureMockOS2Client(t, tc.handler, true)
+			defer testServer.Close()
+
+			pitID, err := os2Client.OpenPointInTime(context.Background(), "testIndex", time.Minute)
+
+			if tc.expectedError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedPitID, pitID)
+			}
+		})
+	}
+}
+
+func TestClosePointInTime(t *testing.T) {
+	testCases := []struct {
+		name          string
+		handler       http.HandlerFunc
+		expectedError bool
+	}{
+		{
+			name: "Successful Close",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodDelete, r.Method)
+				assert.Equal(t, "/_search/point_in_time", r.URL.Path)
+				fmt.Fprintln(w, `{"pits": [{"pit_id": "testPitID", "successful": true}]}`)
+			},
+			expectedError: false,
+		},
+		{
+			name: "Already Expired",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusNotFound)
+				json.NewEncoder(w).Encode(map[string]interface{}{
+					"error": map[string]interface{}{
+						"type": "search_context_missing_exception",
+					},
+					"status": 404,
+				})
+			},
+			expectedError: false,
+		},
+		{
+			name: "OpenSearch Server Error",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusInternalServerError)
+				fmt.Fprintln(w, `{"error": {"root_cause": [{"type": "internal_server_error","reason": "Internal server error"}],"type": "internal_server_error","reason": "Internal server error"}}`)
+			},
+			expectedError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			os2Client, testServer := getSecureMockOS2Client(t, tc.handler, true)
+			defer testServer.Close()
+
+			err := os2Client.ClosePointInTime(context.Background(), "testPitID")
+
+			if tc.expectedError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSearchPointInTime(t *testing.T) {
+	testCases := []struct {
+		name          string
+		handler       http.HandlerFunc
+		expectedError bool
+	}{
+		{
+			name: "Successful Search",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/_search", r.URL.Path)
+				fmt.Fprintln(w, `{"pit_id": "newPitID", "took": 10, "hits": {"total": {"value": 2}, "hits": [{"_source": {"field": "value"}, "sort": ["rid1", 1750950124525781262]}, {"_source": {"field": "another value"}, "sort": ["rid2", 1750950124525781269]}]}}`)
+			},
+			expectedError: false,
+		},
+		{
+			name: "OpenSearch Error",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusBadRequest)
+				fmt.Fprintln(w, `{"error": "Bad request"}`)
+			},
+			expectedError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			os2Client, testServer := getSecureMockOS2Client(t, tc.handler, true)
+			defer testServer.Close()
+
+			resp, err := os2Client.SearchPointInTime(context.Background(), `{"pit": {"id": "testPitID"}, "size": 2}`)
+
+			if tc.expectedError {
+				assert.Error(t, err)
+				assert.Nil(t, resp)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, "newPitID", resp.PitID)
+				assert.Len(t, resp.Hits.Hits, 2)
+				assert.Equal(t, []interface{}{"rid2", json.Number("1750950124525781269")}, resp.Sort)
+			}
+		})
+	}
+}
+
 func TestSearch(t *testing.T) {
 	testCases := []struct {
 		name          string
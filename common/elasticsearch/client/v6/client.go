@@ -105,6 +105,20 @@ func (c *ElasticV6) Count(ctx context.Context, index, query string) (int64, erro
 func (c *ElasticV6) ClearScroll(ctx context.Context, scrollID string) error {
 	return elastic.NewScrollService(c.client).ScrollId(scrollID).Clear(ctx)
 }
+
+// OpenPointInTime is not available before Elasticsearch 7.10
+func (c *ElasticV6) OpenPointInTime(ctx context.Context, index string, keepAlive time.Duration) (string, error) {
+	return "", client.ErrPointInTimeNotSupported
+}
+
+func (c *ElasticV6) ClosePointInTime(ctx context.Context, pitID string) error {
+	return client.ErrPointInTimeNotSupported
+}
+
+func (c *ElasticV6) SearchPointInTime(ctx context.Context, body string) (*client.Response, error) {
+	return nil, client.ErrPointInTimeNotSupported
+}
+
 func (c *ElasticV6) Scroll(ctx context.Context, index, body, scrollID string) (*client.Response, error) {
 	scrollService := elastic.NewScrollService(c.client)
 	var esResult *elastic.SearchResult
@@ -31,11 +31,13 @@ import (
 	"net/url"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/olivere/elastic"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/elasticsearch/client"
 	"github.com/uber/cadence/common/log/testlogger"
 	schemaes "github.com/uber/cadence/schema/elasticsearch"
 )
@@ -611,6 +613,15 @@ func TestClearScroll(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestPointInTimeNotSupported(t *testing.T) {
+	elasticV6 := ElasticV6{}
+	_, err := elasticV6.OpenPointInTime(context.Background(), "testIndex", time.Minute)
+	assert.ErrorIs(t, err, client.ErrPointInTimeNotSupported)
+	_, err = elasticV6.SearchPointInTime(context.Background(), `{}`)
+	assert.ErrorIs(t, err, client.ErrPointInTimeNotSupported)
+	assert.ErrorIs(t, elasticV6.ClosePointInTime(context.Background(), "pitID"), client.ErrPointInTimeNotSupported)
+}
+
 func TestIsNotFoundError(t *testing.T) {
 	testCases := []struct {
 		name     string
@@ -21,10 +21,12 @@
 package v7
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
 	"time"
 
 	"github.com/olivere/elastic/v7"
@@ -174,6 +176,93 @@ func (c *ElasticV7) Scroll(ctx context.Context, index, body, scrollID string) (*
 	}, err
 }
 
+func (c *ElasticV7) OpenPointInTime(ctx context.Context, index string, keepAlive time.Duration) (string, error) {
+	// the point in time API was added after the olivere client, so the request is built by hand
+	resp, err := c.client.PerformRequest(ctx, elastic.PerformRequestOptions{
+		Method: http.MethodPost,
+		Path:   fmt.Sprintf("/%s/_pit", url.PathEscape(index)),
+		Params: url.Values{"keep_alive": []string{fmt.Sprintf("%ds", int64(keepAlive.Seconds()))}},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	var result struct {
+		ID string `json:"id"`
+	}
+	if err := json.Unmarshal(resp.Body, &result); err != nil {
+		return "", fmt.Errorf("decoding open point in time response: %w", err)
+	}
+	if result.ID == "" {
+		return "", fmt.Errorf("open point in time response has no id")
+	}
+	return result.ID, nil
+}
+
+func (c *ElasticV7) ClosePointInTime(ctx context.Context, pitID string) error {
+	_, err := c.client.PerformRequest(ctx, elastic.PerformRequestOptions{
+		Method: http.MethodDelete,
+		Path:   "/_pit",
+		Body:   map[string]string{"id": pitID},
+		// the point in time has already expired
+		IgnoreErrors: []int{http.StatusNotFound},
+	})
+	return err
+}
+
+func (c *ElasticV7) SearchPointInTime(ctx context.Context, body string) (*client.Response, error) {
+	resp, err := c.client.PerformRequest(ctx, elastic.PerformRequestOptions{
+		Method: http.MethodPost,
+		Path:   "/_search",
+		Body:   body,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// the olivere SearchResult has no field for the point in time ID
+	var pit struct {
+		ID string `json:"pit_id"`
+	}
+	if err := json.Unmarshal(resp.Body, &pit); err != nil {
+		return nil, fmt.Errorf("decoding point in time search response: %w", err)
+	}
+	esResult := &elastic.SearchResult{}
+	dec := json.NewDecoder(bytes.NewReader(resp.Body))
+	dec.UseNumber() // critical to ensure decode of int64 won't lose precise
+	if err := dec.Decode(esResult); err != nil {
+		return nil, fmt.Errorf("decoding point in time search response: %w", err)
+	}
+
+	if esResult.Error != nil {
+		return nil, types.InternalServiceError{
+			Message: fmt.Sprintf("ElasticSearch Error: %#v", esResult.Error),
+		}
+	} else if esResult.TimedOut {
+		return nil, types.InternalServiceError{
+			Message: fmt.Sprintf("ElasticSearch Error: Request timed out: %v ms", esResult.TookInMillis),
+		}
+	}
+
+	var sort []interface{}
+	var hits []*client.SearchHit
+	if esResult.Hits != nil {
+		for _, h := range esResult.Hits.Hits {
+			hits = append(hits, &client.SearchHit{Source: h.Source, Sort: h.Sort})
+			sort = h.Sort
+		}
+	}
+
+	return &client.Response{
+		TookInMillis: esResult.TookInMillis,
+		TotalHits:    esResult.TotalHits(),
+		Hits:         &client.SearchHits{Hits: hits},
+		Aggregations: esResult.Aggregations,
+		Sort:         sort,
+		PitID:        pit.ID,
+	}, nil
+}
+
 // Admin schema management methods
 
 func (c *ElasticV7) IsHealthy(ctx context.Context) error {
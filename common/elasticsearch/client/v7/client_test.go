@@ -31,6 +31,7 @@ import (
 	"net/url"
 	"strings"
 	"testing"
+	"time"
 
 	elastic "github.com/olivere/elastic/v7"
 	"github.com/stretchr/testify/assert"
@@ -611,6 +612,94 @@ func TestClearScroll(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestOpenPointInTime(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "POST" && r.URL.Path == "/testIndex/_pit" {
+			assert.Equal(t, "60s", r.URL.Query().Get("keep_alive"))
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"id": "pitID"}`))
+		} else {
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	elasticV7, testServer := getMockClient(t, handler)
+	defer testServer.Close()
+	pitID, err := elasticV7.OpenPointInTime(context.Background(), "testIndex", time.Minute)
+	assert.NoError(t, err)
+	assert.Equal(t, "pitID", pitID)
+}
+
+func TestClosePointInTime(t *testing.T) {
+	testCases := []struct {
+		name   string
+		status int
+	}{
+		{
+			name:   "closed",
+			status: http.StatusOK,
+		},
+		{
+			name:   "already expired",
+			status: http.StatusNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var handlerCalled bool
+			handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				handlerCalled = true
+				assert.Equal(t, "DELETE", r.Method)
+				assert.Equal(t, "/_pit", r.URL.Path)
+				body, err := io.ReadAll(r.Body)
+				assert.NoError(t, err)
+				assert.JSONEq(t, `{"id":"pitID"}`, string(body))
+				w.WriteHeader(tc.status)
+				w.Write([]byte(`{"succeeded": true, "num_freed": 1}`))
+			})
+			elasticV7, testServer := getMockClient(t, handler)
+			defer testServer.Close()
+			err := elasticV7.ClosePointInTime(context.Background(), "pitID")
+			assert.True(t, handlerCalled, "Expected handler to be called")
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestSearchPointInTime(t *testing.T) {
+	query := `{"pit":{"id":"pitID","keep_alive":"60s"},"size":2,"sort":[{"RunID":"asc"}]}`
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "POST" && r.URL.Path == "/_search" {
+			body, err := io.ReadAll(r.Body)
+			assert.NoError(t, err)
+			assert.JSONEq(t, query, string(body))
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{
+				"pit_id": "newPitID",
+				"took": 5,
+				"timed_out": false,
+				"hits": {
+					"total": {"value": 2, "relation": "eq"},
+					"hits": [
+						{"_source": {"RunID": "rid1"}, "sort": ["rid1", 1]},
+						{"_source": {"RunID": "rid2"}, "sort": ["rid2", 2]}
+					]
+				}
+			}`))
+		} else {
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	elasticV7, testServer := getMockClient(t, handler)
+	defer testServer.Close()
+	resp, err := elasticV7.SearchPointInTime(context.Background(), query)
+	assert.NoError(t, err)
+	assert.Equal(t, "newPitID", resp.PitID)
+	assert.Equal(t, int64(2), resp.TotalHits)
+	assert.Len(t, resp.Hits.Hits, 2)
+	assert.Equal(t, []interface{}{"rid2", json.Number("2")}, resp.Sort)
+}
+
 func TestIsNotFoundError(t *testing.T) {
 	testCases := []struct {
 		name     string
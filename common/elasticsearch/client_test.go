@@ -0,0 +1,184 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package elasticsearch
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/common/elasticsearch/client"
+	"github.com/uber/cadence/common/log/testlogger"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	testScanIndex = "test-index"
+	testScanQuery = `{"query":{"match_all":{}},"from":0,"size":2}`
+)
+
+func TestScanByQuery(t *testing.T) {
+	twoHits := &client.Response{
+		Hits: &client.SearchHits{Hits: []*client.SearchHit{
+			{Source: json.RawMessage(`{"WorkflowID":"wid1","RunID":"rid1"}`)},
+			{Source: json.RawMessage(`{"WorkflowID":"wid2","RunID":"rid2"}`)},
+		}},
+		Sort:  []interface{}{"rid2", json.Number("12")},
+		PitID: "pit-2",
+	}
+	oneHit := &client.Response{
+		Hits: &client.SearchHits{Hits: []*client.SearchHit{
+			{Source: json.RawMessage(`{"WorkflowID":"wid3","RunID":"rid3"}`)},
+		}},
+		Sort:  []interface{}{"rid3", json.Number("13")},
+		PitID: "pit-2",
+	}
+	searchAfterToken := mustSerializePageToken(t, &ElasticVisibilityPageToken{PitID: "pit-1", SearchAfter: []interface{}{"rid2", json.Number("12")}})
+
+	tests := map[string]struct {
+		token         []byte
+		setupMocks    func(c *client.MockClient)
+		expectedRuns  []string
+		expectedToken *ElasticVisibilityPageToken
+		expectedError string
+	}{
+		"first page opens a point in time": {
+			setupMocks: func(c *client.MockClient) {
+				c.EXPECT().OpenPointInTime(gomock.Any(), testScanIndex, pointInTimeKeepAlive).Return("pit-1", nil)
+				c.EXPECT().SearchPointInTime(gomock.Any(), `{"pit":{"id":"pit-1","keep_alive":"60s"},"query":{"match_all":{}},"size":2,"sort":[{"RunID":"asc"}]}`).Return(twoHits, nil)
+			},
+			expectedRuns:  []string{"rid1", "rid2"},
+			expectedToken: &ElasticVisibilityPageToken{PitID: "pit-2", SearchAfter: []interface{}{"rid2", json.Number("12")}},
+		},
+		"last page closes the point in time": {
+			token: searchAfterToken,
+			setupMocks: func(c *client.MockClient) {
+				c.EXPECT().SearchPointInTime(gomock.Any(), `{"pit":{"id":"pit-1","keep_alive":"60s"},"query":{"match_all":{}},"search_after":["rid2",12],"size":2,"sort":[{"RunID":"asc"}]}`).Return(oneHit, nil)
+				c.EXPECT().ClosePointInTime(gomock.Any(), "pit-2").Return(nil)
+			},
+			expectedRuns: []string{"rid3"},
+		},
+		"lost point in time is reopened": {
+			token: searchAfterToken,
+			setupMocks: func(c *client.MockClient) {
+				notFound := errors.New("search_context_missing_exception")
+				c.EXPECT().SearchPointInTime(gomock.Any(), gomock.Any()).Return(nil, notFound)
+				c.EXPECT().IsNotFoundError(notFound).Return(true)
+				c.EXPECT().OpenPointInTime(gomock.Any(), testScanIndex, pointInTimeKeepAlive).Return("pit-3", nil)
+				c.EXPECT().SearchPointInTime(gomock.Any(), `{"pit":{"id":"pit-3","keep_alive":"60s"},"query":{"match_all":{}},"search_after":["rid2",12],"size":2,"sort":[{"RunID":"asc"}]}`).Return(oneHit, nil)
+				c.EXPECT().ClosePointInTime(gomock.Any(), "pit-2").Return(nil)
+			},
+			expectedRuns: []string{"rid3"},
+		},
+		"search error": {
+			token: searchAfterToken,
+			setupMocks: func(c *client.MockClient) {
+				searchErr := errors.New("search failed")
+				c.EXPECT().SearchPointInTime(gomock.Any(), gomock.Any()).Return(nil, searchErr)
+				c.EXPECT().IsNotFoundError(searchErr).Return(false)
+			},
+			expectedError: "ScanByQuery failed. Error: search failed",
+		},
+		"falls back to scroll when point in time is not supported": {
+			setupMocks: func(c *client.MockClient) {
+				c.EXPECT().OpenPointInTime(gomock.Any(), testScanIndex, pointInTimeKeepAlive).Return("", client.ErrPointInTimeNotSupported)
+				c.EXPECT().Scroll(gomock.Any(), testScanIndex, testScanQuery, "").Return(&client.Response{
+					Hits:     twoHits.Hits,
+					ScrollID: "scroll-1",
+				}, nil)
+			},
+			expectedRuns:  []string{"rid1", "rid2"},
+			expectedToken: &ElasticVisibilityPageToken{ScrollID: "scroll-1"},
+		},
+		"scroll token keeps using scroll": {
+			token: mustSerializePageToken(t, &ElasticVisibilityPageToken{ScrollID: "scroll-1"}),
+			setupMocks: func(c *client.MockClient) {
+				c.EXPECT().Scroll(gomock.Any(), testScanIndex, testScanQuery, "scroll-1").Return(&client.Response{
+					Hits:     oneHit.Hits,
+					ScrollID: "scroll-1",
+				}, io.EOF)
+				c.EXPECT().ClearScroll(gomock.Any(), "scroll-1").Return(nil)
+			},
+			expectedRuns: []string{"rid3"},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockClient := client.NewMockClient(ctrl)
+			test.setupMocks(mockClient)
+			esClient := &ESClient{Client: mockClient, Logger: testlogger.New(t)}
+
+			resp, err := esClient.ScanByQuery(context.Background(), &ScanByQueryRequest{
+				Index:         testScanIndex,
+				Query:         testScanQuery,
+				NextPageToken: test.token,
+				PageSize:      2,
+			})
+			if test.expectedError != "" {
+				var internalErr *types.InternalServiceError
+				require.ErrorAs(t, err, &internalErr)
+				assert.Equal(t, test.expectedError, internalErr.Message)
+				return
+			}
+			require.NoError(t, err)
+
+			var runs []string
+			for _, execution := range resp.Executions {
+				runs = append(runs, execution.RunID)
+			}
+			assert.Equal(t, test.expectedRuns, runs)
+			if test.expectedToken == nil {
+				assert.Empty(t, resp.NextPageToken)
+			} else {
+				token, err := GetNextPageToken(resp.NextPageToken)
+				require.NoError(t, err)
+				assert.Equal(t, test.expectedToken, token)
+			}
+		})
+	}
+}
+
+func TestGetPointInTimeQuery(t *testing.T) {
+	body, err := getPointInTimeQuery(`{"query":{"match_all":{}},"from":0,"size":1000}`, "pit-id", 10, nil)
+	require.NoError(t, err)
+	assert.Equal(t, `{"pit":{"id":"pit-id","keep_alive":"60s"},"query":{"match_all":{}},"size":10,"sort":[{"RunID":"asc"}]}`, body)
+
+	body, err = getPointInTimeQuery(`{"query":{"range":{"StartTime":{"gte":1700000000000000001}}}}`, "pit-id", 10, []interface{}{"rid", json.Number("7")})
+	require.NoError(t, err)
+	assert.Equal(t, `{"pit":{"id":"pit-id","keep_alive":"60s"},"query":{"range":{"StartTime":{"gte":1700000000000000001}}},"search_after":["rid",7],"size":10,"sort":[{"RunID":"asc"}]}`, body)
+
+	_, err = getPointInTimeQuery(`invalid`, "pit-id", 10, nil)
+	assert.Error(t, err)
+}
+
+func mustSerializePageToken(t *testing.T, token *ElasticVisibilityPageToken) []byte {
+	data, err := SerializePageToken(token)
+	require.NoError(t, err)
+	return data
+}
@@ -107,8 +107,8 @@ type (
 		SearchByQuery(ctx context.Context, request *SearchByQueryRequest) (*SearchResponse, error)
 		// SearchRaw is for searching with raw json. Returns RawResult object which is subset of ESv6 and ESv7 response
 		SearchRaw(ctx context.Context, index, query string) (*RawResponse, error)
-		// ScanByQuery is also generic purpose searching, but implemented with ScrollService of ElasticSearch,
-		// which is more performant for pagination, but comes with some limitation of in-parallel requests.
+		// ScanByQuery is also generic purpose searching, but implemented with a point in time and search_after,
+		// which is more performant for pagination. Scroll is used when the cluster doesn't support point in time.
 		ScanByQuery(ctx context.Context, request *ScanByQueryRequest) (*SearchResponse, error)
 		// TODO remove it in https://github.com/uber/cadence/issues/3682
 		SearchForOneClosedExecution(ctx context.Context, index string, request *SearchForOneClosedExecutionRequest) (*SearchForOneClosedExecutionResponse, error)
@@ -38,6 +38,9 @@ type (
 		TieBreaker string // runID
 		// for ES scroll API
 		ScrollID string
+		// for point in time scans, which continue with search_after
+		PitID       string
+		SearchAfter []interface{}
 	}
 )
 
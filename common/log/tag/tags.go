@@ -351,6 +351,16 @@ func WorkflowQueryType(qt string) Tag {
 	return newStringTag("wf-query-type", qt)
 }
 
+// WorkflowUpdateName returns tag for WorkflowUpdateName
+func WorkflowUpdateName(updateName string) Tag {
+	return newStringTag("wf-update-name", updateName)
+}
+
+// WorkflowUpdateID returns tag for WorkflowUpdateID
+func WorkflowUpdateID(updateID string) Tag {
+	return newStringTag("wf-update-id", updateID)
+}
+
 // WorkflowDecisionFailCause returns tag for WorkflowDecisionFailCause
 func WorkflowDecisionFailCause(decisionFailCause int64) Tag {
 	return newInt64("wf-decision-fail-cause", decisionFailCause)
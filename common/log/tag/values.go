@@ -29,6 +29,8 @@ var (
 	WorkflowActionWorkflowFailed        = workflowAction("add-workflow-failed-event")
 	WorkflowActionWorkflowTimeout       = workflowAction("add-workflow-timeout-event")
 	WorkflowActionWorkflowTerminated    = workflowAction("add-workflow-terminated-event")
+	WorkflowActionWorkflowPaused        = workflowAction("pause-workflow")
+	WorkflowActionWorkflowUnpaused      = workflowAction("unpause-workflow")
 	WorkflowActionWorkflowContinueAsNew = workflowAction("add-workflow-continue-as-new-event")
 
 	// workflow cancellation / sign
@@ -58,6 +60,10 @@ var (
 	WorkflowActionActivityTaskCancelRequested = workflowAction("add-activitytask-cancel-requested-event")
 	WorkflowActionActivityTaskCancelFailed    = workflowAction("add-activitytask-cancel-failed-event")
 	WorkflowActionActivityTaskRetry           = workflowAction("add-activitytask-retry-event")
+	WorkflowActionActivityTaskPaused          = workflowAction("pause-activitytask")
+	WorkflowActionActivityTaskUnpaused        = workflowAction("unpause-activitytask")
+	WorkflowActionActivityTaskAttemptReset    = workflowAction("reset-activitytask-attempt")
+	WorkflowActionActivityTaskRetryNow        = workflowAction("retry-activitytask-now")
 
 	// timer
 	WorkflowActionTimerStarted      = workflowAction("add-timer-started-event")
@@ -129,6 +135,8 @@ var (
 	ComponentArchiver                         = component("archiver")
 	ComponentBatcher                          = component("batcher")
 	ComponentScheduler                        = component("scheduler")
+	ComponentSemaphore                        = component("semaphore")
+	ComponentVisibilityMigration              = component("visibility-migration")
 	ComponentWorker                           = component("worker")
 	ComponentServiceResolver                  = component("service-resolver")
 	ComponentFailoverCoordinator              = component("failover-coordinator")
@@ -281,14 +289,16 @@ var (
 	StoreOperationDeleteUninitializedWorkflowExecution     = storeOperation("delete-uninitialized-wf-execution")
 	StoreOperationRecordWorkflowExecutionUninitialized     = storeOperation("record-wf-execution-uninitialized")
 
-	StoreOperationAppendHistoryNodes        = storeOperation("append-history-nodes")
-	StoreOperationReadHistoryBranch         = storeOperation("read-history-branch")
-	StoreOperationReadHistoryBranchByBatch  = storeOperation("read-history-branch-by-batch")
-	StoreOperationReadRawHistoryBranch      = storeOperation("read-raw-history-branch")
-	StoreOperationForkHistoryBranch         = storeOperation("fork-history-branch")
-	StoreOperationDeleteHistoryBranch       = storeOperation("delete-history-branch")
-	StoreOperationGetHistoryTree            = storeOperation("get-history-tree")
-	StoreOperationGetAllHistoryTreeBranches = storeOperation("get-all-history-tree-branches")
+	StoreOperationAppendHistoryNodes            = storeOperation("append-history-nodes")
+	StoreOperationReadHistoryBranch             = storeOperation("read-history-branch")
+	StoreOperationReadHistoryBranchByBatch      = storeOperation("read-history-branch-by-batch")
+	StoreOperationReadRawHistoryBranch          = storeOperation("read-raw-history-branch")
+	StoreOperationForkHistoryBranch             = storeOperation("fork-history-branch")
+	StoreOperationDeleteHistoryBranch           = storeOperation("delete-history-branch")
+	StoreOperationGetHistoryTree                = storeOperation("get-history-tree")
+	StoreOperationGetAllHistoryTreeBranches     = storeOperation("get-all-history-tree-branches")
+	StoreOperationReencodeHistoryBranch         = storeOperation("reencode-history-branch")
+	StoreOperationDeleteOrphanedHistoryPayloads = storeOperation("delete-orphaned-history-payloads")
 
 	StoreOperationEnqueueMessage             = storeOperation("enqueue-message")
 	StoreOperationReadMessages               = storeOperation("read-messages")
@@ -350,6 +360,7 @@ var (
 	AdminDeleteWorkflow                                       = clientOperation("admin-delete-workflow")
 	MaintainCorruptWorkflow                                   = clientOperation("maintain-corrupt-workflow")
 	AdminClientOperationUpdateTaskListPartitionConfig         = clientOperation("admin-update-task-list-partition-config")
+	AdminClientOperationUpsertWorkflowSearchAttributes        = clientOperation("admin-upsert-workflow-search-attributes")
 
 	FrontendClientOperationDeleteDomain                          = clientOperation("frontend-delete-domain")
 	FrontendClientOperationDeprecateDomain                       = clientOperation("frontend-deprecate-domain")
@@ -365,6 +376,7 @@ var (
 	FrontendClientOperationListWorkflowExecutions                = clientOperation("frontend-list-wf-executions")
 	FrontendClientOperationScanWorkflowExecutions                = clientOperation("frontend-scan-wf-executions")
 	FrontendClientOperationCountWorkflowExecutions               = clientOperation("frontend-count-wf-executions")
+	FrontendClientOperationAggregateWorkflowExecutions           = clientOperation("frontend-aggregate-wf-executions")
 	FrontendClientOperationGetSearchAttributes                   = clientOperation("frontend-get-search-attributes")
 	FrontendClientOperationPollForActivityTask                   = clientOperation("frontend-poll-for-activity-task")
 	FrontendClientOperationPollForDecisionTask                   = clientOperation("frontend-poll-for-decision-task")
@@ -392,6 +404,14 @@ var (
 	FrontendClientOperationStartWorkflowExecution                = clientOperation("frontend-start-wf-execution")
 	FrontendClientOperationStartWorkflowExecutionAsync           = clientOperation("frontend-start-wf-execution-async")
 	FrontendClientOperationTerminateWorkflowExecution            = clientOperation("frontend-terminate-wf-execution")
+	FrontendClientOperationUpdateWorkflowExecution               = clientOperation("frontend-update-wf-execution")
+	FrontendClientOperationPauseWorkflowExecution                = clientOperation("frontend-pause-wf-execution")
+	FrontendClientOperationUnpauseWorkflowExecution              = clientOperation("frontend-unpause-wf-execution")
+	FrontendClientOperationPauseActivity                         = clientOperation("frontend-pause-activity")
+	FrontendClientOperationUnpauseActivity                       = clientOperation("frontend-unpause-activity")
+	FrontendClientOperationResetActivityAttempt                  = clientOperation("frontend-reset-activity-attempt")
+	FrontendClientOperationRetryActivityNow                      = clientOperation("frontend-retry-activity-now")
+	FrontendClientOperationDeleteWorkflowExecution               = clientOperation("frontend-delete-workflow-execution")
 	FrontendClientOperationUpdateDomain                          = clientOperation("frontend-update-domain")
 	FrontendClientOperationFailoverDomain                        = clientOperation("frontend-failover-domain")
 	FrontendClientOperationListFailoverHistory                   = clientOperation("frontend-list-failover-history")
@@ -405,57 +425,72 @@ var (
 	FrontendClientOperationPauseSchedule                         = clientOperation("frontend-pause-schedule")
 	FrontendClientOperationUnpauseSchedule                       = clientOperation("frontend-unpause-schedule")
 	FrontendClientOperationBackfillSchedule                      = clientOperation("frontend-backfill-schedule")
+	FrontendClientOperationTriggerSchedule                       = clientOperation("frontend-trigger-schedule")
 	FrontendClientOperationListSchedules                         = clientOperation("frontend-list-schedules")
+	FrontendClientOperationCreateSemaphore                       = clientOperation("frontend-create-semaphore")
+	FrontendClientOperationDescribeSemaphore                     = clientOperation("frontend-describe-semaphore")
+	FrontendClientOperationListSemaphores                        = clientOperation("frontend-list-semaphores")
 
-	HistoryClientOperationStartWorkflowExecution            = clientOperation("history-start-wf-execution")
-	HistoryClientOperationDescribeHistoryHost               = clientOperation("history-describe-history-host")
-	HistoryClientOperationCloseShard                        = clientOperation("history-close-shard")
-	HistoryClientOperationResetQueue                        = clientOperation("history-reset-queue")
-	HistoryClientOperationDescribeQueue                     = clientOperation("history-describe-queue")
-	HistoryClientOperationRemoveTask                        = clientOperation("history-remove-task")
-	HistoryClientOperationDescribeMutableState              = clientOperation("history-describe-mutable-state")
-	HistoryClientOperationGetMutableState                   = clientOperation("history-get-mutable-state")
-	HistoryClientOperationPollMutableState                  = clientOperation("history-poll-mutable-state")
-	HistoryClientOperationResetStickyTaskList               = clientOperation("history-reset-task-list")
-	HistoryClientOperationDescribeWorkflowExecution         = clientOperation("history-describe-wf-execution")
-	HistoryClientOperationRecordDecisionTaskStarted         = clientOperation("history-record-decision-task-started")
-	HistoryClientOperationRecordActivityTaskStarted         = clientOperation("history-record-activity-task-started")
-	HistoryClientOperationRecordDecisionTaskCompleted       = clientOperation("history-record-decision-task-completed")
-	HistoryClientOperationRecordDecisionTaskFailed          = clientOperation("history-record-decision-task-failed")
-	HistoryClientOperationRecordActivityTaskCompleted       = clientOperation("history-record-activity-task-completed")
-	HistoryClientOperationRecordActivityTaskFailed          = clientOperation("history-record-activity-task-failed")
-	HistoryClientOperationRecordActivityTaskCanceled        = clientOperation("history-record-activity-task-canceled")
-	HistoryClientOperationRecordActivityTaskHeartbeat       = clientOperation("history-record-activity-task-heartbeat")
-	HistoryClientOperationRequestCancelWorkflowExecution    = clientOperation("history-request-cancel-wf-execution")
-	HistoryClientOperationSignalWorkflowExecution           = clientOperation("history-signal-wf-execution")
-	HistoryClientOperationSignalWithStartWorkflowExecution  = clientOperation("history-signal-with-start-wf-execution")
-	HistoryClientOperationRemoveSignalMutableState          = clientOperation("history-remove-signal-mutable-state")
-	HistoryClientOperationTerminateWorkflowExecution        = clientOperation("history-terminate-wf-execution")
-	HistoryClientOperationResetWorkflowExecution            = clientOperation("history-reset-wf-execution")
-	HistoryClientOperationScheduleDecisionTask              = clientOperation("history-schedule-decision-task")
-	HistoryClientOperationRecordChildExecutionCompleted     = clientOperation("history-record-child-execution-completed")
-	HistoryClientOperationReplicateEventsV2                 = clientOperation("history-replicate-events-v2")
-	HistoryClientOperationSyncShardStatus                   = clientOperation("history-sync-shard-status")
-	HistoryClientOperationSyncActivity                      = clientOperation("history-sync-activity")
-	HistoryClientOperationGetReplicationMessages            = clientOperation("history-get-replication-messages")
-	HistoryClientOperationGetDLQReplicationMessages         = clientOperation("history-get-dlq-replication-messages")
-	HistoryClientOperationQueryWorkflow                     = clientOperation("history-query-wf")
-	HistoryClientOperationReapplyEvents                     = clientOperation("history-reapply-events")
-	HistoryClientOperationCountDLQMessages                  = clientOperation("history-count-dlq-messages")
-	HistoryClientOperationReadDLQMessages                   = clientOperation("history-read-dlq-messages")
-	HistoryClientOperationPurgeDLQMessages                  = clientOperation("history-purge-dlq-messages")
-	HistoryClientOperationMergeDLQMessages                  = clientOperation("history-merge-dlq-messages")
-	HistoryClientOperationRefreshWorkflowTasks              = clientOperation("history-refresh-wf-tasks")
-	HistoryClientOperationNotifyFailoverMarkers             = clientOperation("history-notify-failover-markers")
-	HistoryClientOperationGetCrossClusterTasks              = clientOperation("history-get-cross-cluster-tasks")
-	HistoryClientOperationRespondCrossClusterTasksCompleted = clientOperation("history-respond-cross-cluster-tasks-completed")
-	HistoryClientOperationGetFailoverInfo                   = clientOperation("history-get-failover-info")
-	HistoryClientOperationRespondActivityTaskCanceled       = clientOperation("history-respond-activity-task-canceled")
-	HistoryClientOperationRespondActivityTaskCompleted      = clientOperation("history-respond-activity-task-completed")
-	HistoryClientOperationRespondActivityTaskFailed         = clientOperation("history-respond-activity-task-failed")
-	HistoryClientOperationRespondDecisionTaskCompleted      = clientOperation("history-respond-decision-task-completed")
-	HistoryClientOperationRespondDecisionTaskFailed         = clientOperation("history-respond-decision-task-failed")
-	HistoryClientOperationRatelimitUpdate                   = clientOperation("history-ratelimit-update")
+	HistoryClientOperationStartWorkflowExecution                  = clientOperation("history-start-wf-execution")
+	HistoryClientOperationDescribeHistoryHost                     = clientOperation("history-describe-history-host")
+	HistoryClientOperationCloseShard                              = clientOperation("history-close-shard")
+	HistoryClientOperationResetQueue                              = clientOperation("history-reset-queue")
+	HistoryClientOperationDescribeQueue                           = clientOperation("history-describe-queue")
+	HistoryClientOperationRemoveTask                              = clientOperation("history-remove-task")
+	HistoryClientOperationDescribeMutableState                    = clientOperation("history-describe-mutable-state")
+	HistoryClientOperationGetMutableState                         = clientOperation("history-get-mutable-state")
+	HistoryClientOperationPollMutableState                        = clientOperation("history-poll-mutable-state")
+	HistoryClientOperationResetStickyTaskList                     = clientOperation("history-reset-task-list")
+	HistoryClientOperationDescribeWorkflowExecution               = clientOperation("history-describe-wf-execution")
+	HistoryClientOperationRecordDecisionTaskStarted               = clientOperation("history-record-decision-task-started")
+	HistoryClientOperationRecordActivityTaskStarted               = clientOperation("history-record-activity-task-started")
+	HistoryClientOperationRecordDecisionTaskCompleted             = clientOperation("history-record-decision-task-completed")
+	HistoryClientOperationRecordDecisionTaskFailed                = clientOperation("history-record-decision-task-failed")
+	HistoryClientOperationRecordActivityTaskCompleted             = clientOperation("history-record-activity-task-completed")
+	HistoryClientOperationRecordActivityTaskFailed                = clientOperation("history-record-activity-task-failed")
+	HistoryClientOperationRecordActivityTaskCanceled              = clientOperation("history-record-activity-task-canceled")
+	HistoryClientOperationRecordActivityTaskHeartbeat             = clientOperation("history-record-activity-task-heartbeat")
+	HistoryClientOperationRequestCancelWorkflowExecution          = clientOperation("history-request-cancel-wf-execution")
+	HistoryClientOperationSignalWorkflowExecution                 = clientOperation("history-signal-wf-execution")
+	HistoryClientOperationSignalWithStartWorkflowExecution        = clientOperation("history-signal-with-start-wf-execution")
+	HistoryClientOperationRemoveSignalMutableState                = clientOperation("history-remove-signal-mutable-state")
+	HistoryClientOperationTerminateWorkflowExecution              = clientOperation("history-terminate-wf-execution")
+	HistoryClientOperationUpdateWorkflowExecution                 = clientOperation("history-update-wf-execution")
+	HistoryClientOperationPauseWorkflowExecution                  = clientOperation("history-pause-wf-execution")
+	HistoryClientOperationUnpauseWorkflowExecution                = clientOperation("history-unpause-wf-execution")
+	HistoryClientOperationPauseActivity                           = clientOperation("history-pause-activity")
+	HistoryClientOperationUnpauseActivity                         = clientOperation("history-unpause-activity")
+	HistoryClientOperationResetActivityAttempt                    = clientOperation("history-reset-activity-attempt")
+	HistoryClientOperationRetryActivityNow                        = clientOperation("history-retry-activity-now")
+	HistoryClientOperationDeleteWorkflowExecution                 = clientOperation("history-delete-workflow-execution")
+	HistoryClientOperationUpsertWorkflowSearchAttributes          = clientOperation("history-upsert-workflow-search-attributes")
+	HistoryClientOperationResetWorkflowExecution                  = clientOperation("history-reset-wf-execution")
+	HistoryClientOperationScheduleDecisionTask                    = clientOperation("history-schedule-decision-task")
+	HistoryClientOperationRecordChildExecutionCompleted           = clientOperation("history-record-child-execution-completed")
+	HistoryClientOperationReplicateEventsV2                       = clientOperation("history-replicate-events-v2")
+	HistoryClientOperationReplicateDeleteWorkflowExecution        = clientOperation("history-replicate-delete-workflow-execution")
+	HistoryClientOperationReplicateUpsertWorkflowSearchAttributes = clientOperation("history-replicate-upsert-workflow-search-attributes")
+	HistoryClientOperationSyncShardStatus                         = clientOperation("history-sync-shard-status")
+	HistoryClientOperationSyncActivity                            = clientOperation("history-sync-activity")
+	HistoryClientOperationGetReplicationMessages                  = clientOperation("history-get-replication-messages")
+	HistoryClientOperationGetDLQReplicationMessages               = clientOperation("history-get-dlq-replication-messages")
+	HistoryClientOperationQueryWorkflow                           = clientOperation("history-query-wf")
+	HistoryClientOperationReapplyEvents                           = clientOperation("history-reapply-events")
+	HistoryClientOperationCountDLQMessages                        = clientOperation("history-count-dlq-messages")
+	HistoryClientOperationReadDLQMessages                         = clientOperation("history-read-dlq-messages")
+	HistoryClientOperationPurgeDLQMessages                        = clientOperation("history-purge-dlq-messages")
+	HistoryClientOperationMergeDLQMessages                        = clientOperation("history-merge-dlq-messages")
+	HistoryClientOperationRefreshWorkflowTasks                    = clientOperation("history-refresh-wf-tasks")
+	HistoryClientOperationNotifyFailoverMarkers                   = clientOperation("history-notify-failover-markers")
+	HistoryClientOperationGetCrossClusterTasks                    = clientOperation("history-get-cross-cluster-tasks")
+	HistoryClientOperationRespondCrossClusterTasksCompleted       = clientOperation("history-respond-cross-cluster-tasks-completed")
+	HistoryClientOperationGetFailoverInfo                         = clientOperation("history-get-failover-info")
+	HistoryClientOperationRespondActivityTaskCanceled             = clientOperation("history-respond-activity-task-canceled")
+	HistoryClientOperationRespondActivityTaskCompleted            = clientOperation("history-respond-activity-task-completed")
+	HistoryClientOperationRespondActivityTaskFailed               = clientOperation("history-respond-activity-task-failed")
+	HistoryClientOperationRespondDecisionTaskCompleted            = clientOperation("history-respond-decision-task-completed")
+	HistoryClientOperationRespondDecisionTaskFailed               = clientOperation("history-respond-decision-task-failed")
+	HistoryClientOperationRatelimitUpdate                         = clientOperation("history-ratelimit-update")
 
 	MatchingClientOperationAddActivityTask                = clientOperation("matching-add-activity-task")
 	MatchingClientOperationAddDecisionTask                = clientOperation("matching-add-decision-task")
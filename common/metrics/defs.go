@@ -299,6 +299,8 @@ const (
 	PersistenceScanWorkflowExecutionsScope
 	// PersistenceCountWorkflowExecutionsScope tracks CountWorkflowExecutions calls made by service to persistence layer
 	PersistenceCountWorkflowExecutionsScope
+	// PersistenceAggregateWorkflowExecutionsScope tracks AggregateWorkflowExecutions calls made by service to persistence layer
+	PersistenceAggregateWorkflowExecutionsScope
 	// PersistenceEnqueueMessageScope tracks Enqueue calls made by service to persistence layer
 	PersistenceEnqueueMessageScope
 	// PersistenceEnqueueMessageToDLQScope tracks Enqueue DLQ calls made by service to persistence layer
@@ -394,6 +396,24 @@ const (
 	HistoryClientRemoveSignalMutableStateScope
 	// HistoryClientTerminateWorkflowExecutionScope tracks RPC calls to history service
 	HistoryClientTerminateWorkflowExecutionScope
+	// HistoryClientUpdateWorkflowExecutionScope tracks RPC calls to history service
+	HistoryClientUpdateWorkflowExecutionScope
+	// HistoryClientPauseWorkflowExecutionScope tracks RPC calls to history service
+	HistoryClientPauseWorkflowExecutionScope
+	// HistoryClientUnpauseWorkflowExecutionScope tracks RPC calls to history service
+	HistoryClientUnpauseWorkflowExecutionScope
+	// HistoryClientPauseActivityScope tracks RPC calls to history service
+	HistoryClientPauseActivityScope
+	// HistoryClientUnpauseActivityScope tracks RPC calls to history service
+	HistoryClientUnpauseActivityScope
+	// HistoryClientResetActivityAttemptScope tracks RPC calls to history service
+	HistoryClientResetActivityAttemptScope
+	// HistoryClientRetryActivityNowScope tracks RPC calls to history service
+	HistoryClientRetryActivityNowScope
+	// HistoryClientDeleteWorkflowExecutionScope tracks RPC calls to history service
+	HistoryClientDeleteWorkflowExecutionScope
+	// HistoryClientUpsertWorkflowSearchAttributesScope tracks RPC calls to history service
+	HistoryClientUpsertWorkflowSearchAttributesScope
 	// HistoryClientResetWorkflowExecutionScope tracks RPC calls to history service
 	HistoryClientResetWorkflowExecutionScope
 	// HistoryClientScheduleDecisionTaskScope tracks RPC calls to history service
@@ -402,6 +422,10 @@ const (
 	HistoryClientRecordChildExecutionCompletedScope
 	// HistoryClientSyncShardStatusScope tracks RPC calls to history service
 	HistoryClientReplicateEventsV2Scope
+	// HistoryClientReplicateDeleteWorkflowExecutionScope tracks RPC calls to history service
+	HistoryClientReplicateDeleteWorkflowExecutionScope
+	// HistoryClientReplicateUpsertWorkflowSearchAttributesScope tracks RPC calls to history service
+	HistoryClientReplicateUpsertWorkflowSearchAttributesScope
 	// HistoryClientReplicateRawEventsV2Scope tracks RPC calls to history service
 	HistoryClientSyncShardStatusScope
 	// HistoryClientSyncActivityScope tracks RPC calls to history service
@@ -544,6 +568,22 @@ const (
 	FrontendClientRestartWorkflowExecutionScope
 	// FrontendClientTerminateWorkflowExecutionScope tracks RPC calls to frontend service
 	FrontendClientTerminateWorkflowExecutionScope
+	// FrontendClientUpdateWorkflowExecutionScope tracks RPC calls to frontend service
+	FrontendClientUpdateWorkflowExecutionScope
+	// FrontendClientPauseWorkflowExecutionScope tracks RPC calls to frontend service
+	FrontendClientPauseWorkflowExecutionScope
+	// FrontendClientUnpauseWorkflowExecutionScope tracks RPC calls to frontend service
+	FrontendClientUnpauseWorkflowExecutionScope
+	// FrontendClientPauseActivityScope tracks RPC calls to frontend service
+	FrontendClientPauseActivityScope
+	// FrontendClientUnpauseActivityScope tracks RPC calls to frontend service
+	FrontendClientUnpauseActivityScope
+	// FrontendClientResetActivityAttemptScope tracks RPC calls to frontend service
+	FrontendClientResetActivityAttemptScope
+	// FrontendClientRetryActivityNowScope tracks RPC calls to frontend service
+	FrontendClientRetryActivityNowScope
+	// FrontendClientDeleteWorkflowExecutionScope tracks RPC calls to frontend service
+	FrontendClientDeleteWorkflowExecutionScope
 	// FrontendClientUpdateDomainScope tracks RPC calls to frontend service
 	FrontendClientUpdateDomainScope
 	// FrontendClientFailoverDomainScope tracks RPC calls to frontend service
@@ -564,14 +604,24 @@ const (
 	FrontendClientUnpauseScheduleScope
 	// FrontendClientBackfillScheduleScope tracks RPC calls to frontend service
 	FrontendClientBackfillScheduleScope
+	// FrontendClientTriggerScheduleScope tracks RPC calls to frontend service
+	FrontendClientTriggerScheduleScope
 	// FrontendClientListSchedulesScope tracks RPC calls to frontend service
 	FrontendClientListSchedulesScope
+	// FrontendClientCreateSemaphoreScope tracks RPC calls to frontend service
+	FrontendClientCreateSemaphoreScope
+	// FrontendClientDescribeSemaphoreScope tracks RPC calls to frontend service
+	FrontendClientDescribeSemaphoreScope
+	// FrontendClientListSemaphoresScope tracks RPC calls to frontend service
+	FrontendClientListSemaphoresScope
 	// FrontendClientListWorkflowExecutionsScope tracks RPC calls to frontend service
 	FrontendClientListWorkflowExecutionsScope
 	// FrontendClientScanWorkflowExecutionsScope tracks RPC calls to frontend service
 	FrontendClientScanWorkflowExecutionsScope
 	// FrontendClientCountWorkflowExecutionsScope tracks RPC calls to frontend service
 	FrontendClientCountWorkflowExecutionsScope
+	// FrontendClientAggregateWorkflowExecutionsScope tracks RPC calls to frontend service
+	FrontendClientAggregateWorkflowExecutionsScope
 	// FrontendClientGetSearchAttributesScope tracks RPC calls to frontend service
 	FrontendClientGetSearchAttributesScope
 	// FrontendClientGetReplicationTasksScope tracks RPC calls to frontend service
@@ -669,6 +719,8 @@ const (
 	AdminClientUpdateDomainAsyncWorkflowConfiguratonScope
 	// AdminClientUpdateTaskListPartitionConfigScope is the metrics scope for admin.UpdateTaskListPartitionConfig
 	AdminClientUpdateTaskListPartitionConfigScope
+	// AdminClientUpsertWorkflowSearchAttributesScope is the metric scope for admin.UpsertWorkflowSearchAttributes
+	AdminClientUpsertWorkflowSearchAttributesScope
 
 	// DCRedirectionDeleteDomainScope tracks RPC calls for dc redirection
 	DCRedirectionDeleteDomainScope
@@ -706,6 +758,8 @@ const (
 	DCRedirectionScanWorkflowExecutionsScope
 	// DCRedirectionCountWorkflowExecutionsScope tracks RPC calls for dc redirection
 	DCRedirectionCountWorkflowExecutionsScope
+	// DCRedirectionAggregateWorkflowExecutionsScope tracks RPC calls for dc redirection
+	DCRedirectionAggregateWorkflowExecutionsScope
 	// DCRedirectionGetSearchAttributesScope tracks RPC calls for dc redirection
 	DCRedirectionGetSearchAttributesScope
 	// DCRedirectionPollForActivityTaskScope tracks RPC calls for dc redirection
@@ -756,6 +810,22 @@ const (
 	DCRedirectionStartWorkflowExecutionAsyncScope
 	// DCRedirectionTerminateWorkflowExecutionScope tracks RPC calls for dc redirection
 	DCRedirectionTerminateWorkflowExecutionScope
+	// DCRedirectionUpdateWorkflowExecutionScope tracks RPC calls for dc redirection
+	DCRedirectionUpdateWorkflowExecutionScope
+	// DCRedirectionPauseWorkflowExecutionScope tracks RPC calls for dc redirection
+	DCRedirectionPauseWorkflowExecutionScope
+	// DCRedirectionUnpauseWorkflowExecutionScope tracks RPC calls for dc redirection
+	DCRedirectionUnpauseWorkflowExecutionScope
+	// DCRedirectionPauseActivityScope tracks RPC calls for dc redirection
+	DCRedirectionPauseActivityScope
+	// DCRedirectionUnpauseActivityScope tracks RPC calls for dc redirection
+	DCRedirectionUnpauseActivityScope
+	// DCRedirectionResetActivityAttemptScope tracks RPC calls for dc redirection
+	DCRedirectionResetActivityAttemptScope
+	// DCRedirectionRetryActivityNowScope tracks RPC calls for dc redirection
+	DCRedirectionRetryActivityNowScope
+	// DCRedirectionDeleteWorkflowExecutionScope tracks RPC calls for dc redirection
+	DCRedirectionDeleteWorkflowExecutionScope
 	// DCRedirectionUpdateDomainScope tracks RPC calls for dc redirection
 	DCRedirectionUpdateDomainScope
 	// DCRedirectionListTaskListPartitionsScope tracks RPC calls for dc redirection
@@ -780,8 +850,16 @@ const (
 	DCRedirectionUnpauseScheduleScope
 	// DCRedirectionBackfillScheduleScope tracks RPC calls for dc redirection
 	DCRedirectionBackfillScheduleScope
+	// DCRedirectionTriggerScheduleScope tracks RPC calls for dc redirection
+	DCRedirectionTriggerScheduleScope
 	// DCRedirectionListSchedulesScope tracks RPC calls for dc redirection
 	DCRedirectionListSchedulesScope
+	// DCRedirectionCreateSemaphoreScope tracks RPC calls for dc redirection
+	DCRedirectionCreateSemaphoreScope
+	// DCRedirectionDescribeSemaphoreScope tracks RPC calls for dc redirection
+	DCRedirectionDescribeSemaphoreScope
+	// DCRedirectionListSemaphoresScope tracks RPC calls for dc redirection
+	DCRedirectionListSemaphoresScope
 	// DCRedirectionForwardingPolicyScope tracks cluster redirection decisions
 	DCRedirectionForwardingPolicyScope
 
@@ -823,6 +901,10 @@ const (
 	PersistenceGetHistoryTreeScope
 	// PersistenceGetAllHistoryTreeBranchesScope tracks GetHistoryTree calls made by service to persistence layer
 	PersistenceGetAllHistoryTreeBranchesScope
+	// PersistenceReencodeHistoryBranchScope tracks ReencodeHistoryBranch calls made by service to persistence layer
+	PersistenceReencodeHistoryBranchScope
+	// PersistenceDeleteOrphanedHistoryPayloadsScope tracks DeleteOrphanedHistoryPayloads calls made by service to persistence layer
+	PersistenceDeleteOrphanedHistoryPayloadsScope
 
 	// ClusterMetadataArchivalConfigScope tracks ArchivalConfig calls to ClusterMetadata
 	ClusterMetadataArchivalConfigScope
@@ -857,6 +939,8 @@ const (
 	ElasticsearchScanWorkflowExecutionsScope
 	// ElasticsearchCountWorkflowExecutionsScope tracks CountWorkflowExecutions calls made by service to persistence layer
 	ElasticsearchCountWorkflowExecutionsScope
+	// ElasticsearchAggregateWorkflowExecutionsScope tracks AggregateWorkflowExecutions calls made by service to persistence layer
+	ElasticsearchAggregateWorkflowExecutionsScope
 	// ElasticsearchDeleteWorkflowExecutionsScope tracks DeleteWorkflowExecution calls made by service to persistence layer
 	ElasticsearchDeleteWorkflowExecutionsScope
 	// ElasticsearchDeleteUninitializedWorkflowExecutionsScope tracks DeleteUninitializedWorkflowExecution calls made by service to persistence layer
@@ -892,6 +976,8 @@ const (
 	PinotScanWorkflowExecutionsScope
 	// PinotCountWorkflowExecutionsScope tracks CountWorkflowExecutions calls made by service to persistence layer
 	PinotCountWorkflowExecutionsScope
+	// PinotAggregateWorkflowExecutionsScope tracks AggregateWorkflowExecutions calls made by service to persistence layer
+	PinotAggregateWorkflowExecutionsScope
 	// PinotDeleteWorkflowExecutionsScope tracks DeleteWorkflowExecution calls made by service to persistence layer
 	PinotDeleteWorkflowExecutionsScope
 	// PinotDeleteUninitializedWorkflowExecutionsScope tracks DeleteUninitializedWorkflowExecution calls made by service to persistence layer
@@ -1042,6 +1128,8 @@ const (
 	AdminListOperationalDynamicConfigScope
 	// AdminDeleteWorkflowScope is the metric scope for admin.DeleteWorkflow
 	AdminDeleteWorkflowScope
+	// AdminUpsertWorkflowSearchAttributesScope is the metric scope for admin.UpsertWorkflowSearchAttributes
+	AdminUpsertWorkflowSearchAttributesScope
 	// GetGlobalIsolationGroups is the scope for getting global isolation groups
 	GetGlobalIsolationGroups
 	// UpdateGlobalIsolationGroups is the scope for getting global isolation groups
@@ -1108,6 +1196,22 @@ const (
 	FrontendSignalWithStartWorkflowExecutionAsyncScope
 	// FrontendTerminateWorkflowExecutionScope is the metric scope for frontend.TerminateWorkflowExecution
 	FrontendTerminateWorkflowExecutionScope
+	// FrontendUpdateWorkflowExecutionScope is the metric scope for frontend.UpdateWorkflowExecution
+	FrontendUpdateWorkflowExecutionScope
+	// FrontendPauseWorkflowExecutionScope is the metric scope for frontend.PauseWorkflowExecution
+	FrontendPauseWorkflowExecutionScope
+	// FrontendUnpauseWorkflowExecutionScope is the metric scope for frontend.UnpauseWorkflowExecution
+	FrontendUnpauseWorkflowExecutionScope
+	// FrontendPauseActivityScope is the metric scope for frontend.PauseActivity
+	FrontendPauseActivityScope
+	// FrontendUnpauseActivityScope is the metric scope for frontend.UnpauseActivity
+	FrontendUnpauseActivityScope
+	// FrontendResetActivityAttemptScope is the metric scope for frontend.ResetActivityAttempt
+	FrontendResetActivityAttemptScope
+	// FrontendRetryActivityNowScope is the metric scope for frontend.RetryActivityNow
+	FrontendRetryActivityNowScope
+	// FrontendDeleteWorkflowExecutionScope is the metric scope for frontend.DeleteWorkflowExecution
+	FrontendDeleteWorkflowExecutionScope
 	// FrontendRequestCancelWorkflowExecutionScope is the metric scope for frontend.RequestCancelWorkflowExecution
 	FrontendRequestCancelWorkflowExecutionScope
 	// FrontendListArchivedWorkflowExecutionsScope is the metric scope for frontend.ListArchivedWorkflowExecutions
@@ -1122,6 +1226,8 @@ const (
 	FrontendScanWorkflowExecutionsScope
 	// FrontendCountWorkflowExecutionsScope is the metric scope for frontend.CountWorkflowExecutions
 	FrontendCountWorkflowExecutionsScope
+	// FrontendAggregateWorkflowExecutionsScope is the metric scope for frontend.AggregateWorkflowExecutions
+	FrontendAggregateWorkflowExecutionsScope
 	// FrontendRegisterDomainScope is the metric scope for frontend.RegisterDomain
 	FrontendRegisterDomainScope
 	// FrontendDescribeDomainScope is the metric scope for frontend.DescribeDomain
@@ -1177,8 +1283,16 @@ const (
 	FrontendUnpauseScheduleScope
 	// FrontendBackfillScheduleScope is the metric scope for frontend.BackfillSchedule
 	FrontendBackfillScheduleScope
+	// FrontendTriggerScheduleScope is the metric scope for frontend.TriggerSchedule
+	FrontendTriggerScheduleScope
 	// FrontendListSchedulesScope is the metric scope for frontend.ListSchedules
 	FrontendListSchedulesScope
+	// FrontendCreateSemaphoreScope is the metric scope for frontend.CreateSemaphore
+	FrontendCreateSemaphoreScope
+	// FrontendDescribeSemaphoreScope is the metric scope for frontend.DescribeSemaphore
+	FrontendDescribeSemaphoreScope
+	// FrontendListSemaphoresScope is the metric scope for frontend.ListSemaphores
+	FrontendListSemaphoresScope
 
 	NumFrontendScopes
 )
@@ -1225,6 +1339,24 @@ const (
 	HistoryRemoveSignalMutableStateScope
 	// HistoryTerminateWorkflowExecutionScope tracks TerminateWorkflowExecution API calls received by service
 	HistoryTerminateWorkflowExecutionScope
+	// HistoryUpdateWorkflowExecutionScope tracks UpdateWorkflowExecution API calls received by service
+	HistoryUpdateWorkflowExecutionScope
+	// HistoryPauseWorkflowExecutionScope tracks PauseWorkflowExecution API calls received by service
+	HistoryPauseWorkflowExecutionScope
+	// HistoryUnpauseWorkflowExecutionScope tracks UnpauseWorkflowExecution API calls received by service
+	HistoryUnpauseWorkflowExecutionScope
+	// HistoryPauseActivityScope tracks PauseActivity API calls received by service
+	HistoryPauseActivityScope
+	// HistoryUnpauseActivityScope tracks UnpauseActivity API calls received by service
+	HistoryUnpauseActivityScope
+	// HistoryResetActivityAttemptScope tracks ResetActivityAttempt API calls received by service
+	HistoryResetActivityAttemptScope
+	// HistoryRetryActivityNowScope tracks RetryActivityNow API calls received by service
+	HistoryRetryActivityNowScope
+	// HistoryDeleteWorkflowExecutionScope tracks DeleteWorkflowExecution API calls received by service
+	HistoryDeleteWorkflowExecutionScope
+	// HistoryUpsertWorkflowSearchAttributesScope tracks UpsertWorkflowSearchAttributes API calls received by service
+	HistoryUpsertWorkflowSearchAttributesScope
 	// HistoryScheduleDecisionTaskScope tracks ScheduleDecisionTask API calls received by service
 	HistoryScheduleDecisionTaskScope
 	// HistoryRecordChildExecutionCompletedScope tracks CompleteChildExecution API calls received by service
@@ -1237,6 +1369,10 @@ const (
 	HistoryReplicateRawEventsScope
 	// HistoryReplicateEventsV2Scope tracks ReplicateEvents API calls received by service
 	HistoryReplicateEventsV2Scope
+	// HistoryReplicateDeleteWorkflowExecutionScope tracks ReplicateDeleteWorkflowExecution API calls received by service
+	HistoryReplicateDeleteWorkflowExecutionScope
+	// HistoryReplicateUpsertWorkflowSearchAttributesScope tracks ReplicateUpsertWorkflowSearchAttributes API calls received by service
+	HistoryReplicateUpsertWorkflowSearchAttributesScope
 	// HistorySyncShardStatusScope tracks HistorySyncShardStatus API calls received by service
 	HistorySyncShardStatusScope
 	// HistorySyncActivityScope tracks HistoryActivity API calls received by service
@@ -1305,6 +1441,8 @@ const (
 	TransferActiveTaskRecordChildExecutionCompletedScope
 	// TransferActiveTaskApplyParentClosePolicyScope is the scope used for apply parent close policy task processing by transfer queue processor
 	TransferActiveTaskApplyParentClosePolicyScope
+	// TransferActiveTaskDeleteWorkflowExecutionScope is the scope used for delete workflow execution task processing by transfer queue processor
+	TransferActiveTaskDeleteWorkflowExecutionScope
 	// TransferStandbyTaskResetWorkflowScope is the scope used for record workflow started task processing by transfer queue processor
 	TransferStandbyTaskResetWorkflowScope
 	// TransferStandbyTaskActivityScope is the scope used for activity task processing by transfer queue processor
@@ -1329,6 +1467,8 @@ const (
 	TransferStandbyTaskRecordChildExecutionCompletedScope
 	// TransferActiveTaskApplyParentClosePolicyScope is the scope used for apply parent close policy task processing by transfer queue processor
 	TransferStandbyTaskApplyParentClosePolicyScope
+	// TransferStandbyTaskDeleteWorkflowExecutionScope is the scope used for delete workflow execution task processing by transfer queue processor
+	TransferStandbyTaskDeleteWorkflowExecutionScope
 	// TimerQueueProcessorScope is the scope used by all metric emitted by timer queue processor
 	TimerQueueProcessorScope
 	// TimerQueueProcessorV2Scope is the scope used by all metric emitted by timer queue processor
@@ -1471,6 +1611,10 @@ const (
 	WorkflowCorruptionRepairScope
 	// HistoryTaskDLQProcessorScope is the scope used by the history task DLQ re-injection processor
 	HistoryTaskDLQProcessorScope
+	// DeleteWorkflowExecutionReplicationTaskScope is the scope used by replicated workflow deletion processing
+	DeleteWorkflowExecutionReplicationTaskScope
+	// UpsertWorkflowSearchAttributesReplicationTaskScope is the scope used by replicated search attributes upsert processing
+	UpsertWorkflowSearchAttributesReplicationTaskScope
 	NumHistoryScopes
 )
 
@@ -1558,6 +1702,8 @@ const (
 	SchedulerWorkerScope
 	// SchedulerActivityScope is scope used by the scheduler fire activity
 	SchedulerActivityScope
+	// VisibilityMigrationScope is scope used by the visibility store migration workflow
+	VisibilityMigrationScope
 
 	NumWorkerScopes
 )
@@ -1636,6 +1782,7 @@ var ScopeDefs = map[ServiceIdx]map[ScopeIdx]scopeDefinition{
 		PersistenceListWorkflowExecutionsScope:                   {operation: "ListWorkflowExecutions"},
 		PersistenceScanWorkflowExecutionsScope:                   {operation: "ScanWorkflowExecutions"},
 		PersistenceCountWorkflowExecutionsScope:                  {operation: "CountWorkflowExecutions"},
+		PersistenceAggregateWorkflowExecutionsScope:              {operation: "AggregateWorkflowExecutions"},
 		PersistenceAppendHistoryNodesScope:                       {operation: "AppendHistoryNodes"},
 		PersistenceReadHistoryBranchScope:                        {operation: "ReadHistoryBranch"},
 		PersistenceReadHistoryBranchByBatchScope:                 {operation: "ReadHistoryBranch"},
@@ -1645,6 +1792,8 @@ var ScopeDefs = map[ServiceIdx]map[ScopeIdx]scopeDefinition{
 		PersistenceCompleteForkBranchScope:                       {operation: "CompleteForkBranch"},
 		PersistenceGetHistoryTreeScope:                           {operation: "GetHistoryTree"},
 		PersistenceGetAllHistoryTreeBranchesScope:                {operation: "GetAllHistoryTreeBranches"},
+		PersistenceReencodeHistoryBranchScope:                    {operation: "ReencodeHistoryBranch"},
+		PersistenceDeleteOrphanedHistoryPayloadsScope:            {operation: "DeleteOrphanedHistoryPayloads"},
 		PersistenceEnqueueMessageScope:                           {operation: "EnqueueMessage"},
 		PersistenceEnqueueMessageToDLQScope:                      {operation: "EnqueueMessageToDLQ"},
 		PersistenceReadMessagesScope:                             {operation: "ReadQueueMessages"},
@@ -1668,53 +1817,64 @@ var ScopeDefs = map[ServiceIdx]map[ScopeIdx]scopeDefinition{
 
 		ClusterMetadataArchivalConfigScope: {operation: "ArchivalConfig"},
 
-		HistoryClientStartWorkflowExecutionScope:            {operation: "HistoryClientStartWorkflowExecution", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
-		HistoryClientDescribeHistoryHostScope:               {operation: "HistoryClientDescribeHistoryHost", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
-		HistoryClientRemoveTaskScope:                        {operation: "HistoryClientRemoveTask", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
-		HistoryClientCloseShardScope:                        {operation: "HistoryClientCloseShard", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
-		HistoryClientResetQueueScope:                        {operation: "HistoryClientResetQueue", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
-		HistoryClientDescribeQueueScope:                     {operation: "HistoryClientDescribeQueue", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
-		HistoryClientRecordActivityTaskHeartbeatScope:       {operation: "HistoryClientRecordActivityTaskHeartbeat", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
-		HistoryClientRespondDecisionTaskCompletedScope:      {operation: "HistoryClientRespondDecisionTaskCompleted", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
-		HistoryClientRespondDecisionTaskFailedScope:         {operation: "HistoryClientRespondDecisionTaskFailed", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
-		HistoryClientRespondActivityTaskCompletedScope:      {operation: "HistoryClientRespondActivityTaskCompleted", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
-		HistoryClientRespondActivityTaskFailedScope:         {operation: "HistoryClientRespondActivityTaskFailed", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
-		HistoryClientRespondActivityTaskCanceledScope:       {operation: "HistoryClientRespondActivityTaskCanceled", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
-		HistoryClientDescribeMutableStateScope:              {operation: "HistoryClientDescribeMutableState", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
-		HistoryClientGetMutableStateScope:                   {operation: "HistoryClientGetMutableState", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
-		HistoryClientPollMutableStateScope:                  {operation: "HistoryClientPollMutableState", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
-		HistoryClientResetStickyTaskListScope:               {operation: "HistoryClientResetStickyTaskList", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
-		HistoryClientDescribeWorkflowExecutionScope:         {operation: "HistoryClientDescribeWorkflowExecution", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
-		HistoryClientRecordDecisionTaskStartedScope:         {operation: "HistoryClientRecordDecisionTaskStarted", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
-		HistoryClientRecordActivityTaskStartedScope:         {operation: "HistoryClientRecordActivityTaskStarted", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
-		HistoryClientRequestCancelWorkflowExecutionScope:    {operation: "HistoryClientRequestCancelWorkflowExecution", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
-		HistoryClientSignalWorkflowExecutionScope:           {operation: "HistoryClientSignalWorkflowExecution", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
-		HistoryClientSignalWithStartWorkflowExecutionScope:  {operation: "HistoryClientSignalWithStartWorkflowExecution", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
-		HistoryClientRemoveSignalMutableStateScope:          {operation: "HistoryClientRemoveSignalMutableState", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
-		HistoryClientTerminateWorkflowExecutionScope:        {operation: "HistoryClientTerminateWorkflowExecution", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
-		HistoryClientResetWorkflowExecutionScope:            {operation: "HistoryClientResetWorkflowExecution", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
-		HistoryClientScheduleDecisionTaskScope:              {operation: "HistoryClientScheduleDecisionTask", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
-		HistoryClientRecordChildExecutionCompletedScope:     {operation: "HistoryClientRecordChildExecutionCompleted", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
-		HistoryClientReplicateEventsV2Scope:                 {operation: "HistoryClientReplicateEventsV2", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
-		HistoryClientSyncShardStatusScope:                   {operation: "HistoryClientSyncShardStatus", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
-		HistoryClientSyncActivityScope:                      {operation: "HistoryClientSyncActivity", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
-		HistoryClientGetReplicationTasksScope:               {operation: "HistoryClientGetReplicationTasks", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
-		HistoryClientGetDLQReplicationTasksScope:            {operation: "HistoryClientGetDLQReplicationTasks", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
-		HistoryClientQueryWorkflowScope:                     {operation: "HistoryClientQueryWorkflow", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
-		HistoryClientReapplyEventsScope:                     {operation: "HistoryClientReapplyEvents", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
-		HistoryClientCountDLQMessagesScope:                  {operation: "HistoryClientCountDLQMessages", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
-		HistoryClientReadDLQMessagesScope:                   {operation: "HistoryClientReadDLQMessages", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
-		HistoryClientPurgeDLQMessagesScope:                  {operation: "HistoryClientPurgeDLQMessages", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
-		HistoryClientMergeDLQMessagesScope:                  {operation: "HistoryClientMergeDLQMessages", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
-		HistoryClientRefreshWorkflowTasksScope:              {operation: "HistoryClientRefreshWorkflowTasks", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
-		HistoryClientNotifyFailoverMarkersScope:             {operation: "HistoryClientNotifyFailoverMarkers", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
-		HistoryClientGetCrossClusterTasksScope:              {operation: "HistoryClientGetCrossClusterTasks", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
-		HistoryClientRespondCrossClusterTasksCompletedScope: {operation: "HistoryClientRespondCrossClusterTasksCompleted", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
-		HistoryClientGetFailoverInfoScope:                   {operation: "HistoryClientGetFailoverInfo", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
-		HistoryClientGetDLQReplicationMessagesScope:         {operation: "HistoryClientGetDLQReplicationMessages", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
-		HistoryClientGetReplicationMessagesScope:            {operation: "HistoryClientGetReplicationMessages", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
-		HistoryClientWfIDCacheScope:                         {operation: "HistoryClientWfIDCache", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
-		HistoryClientRatelimitUpdateScope:                   {operation: "HistoryClientRatelimitUpdate", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientStartWorkflowExecutionScope:                  {operation: "HistoryClientStartWorkflowExecution", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientDescribeHistoryHostScope:                     {operation: "HistoryClientDescribeHistoryHost", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientRemoveTaskScope:                              {operation: "HistoryClientRemoveTask", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientCloseShardScope:                              {operation: "HistoryClientCloseShard", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientResetQueueScope:                              {operation: "HistoryClientResetQueue", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientDescribeQueueScope:                           {operation: "HistoryClientDescribeQueue", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientRecordActivityTaskHeartbeatScope:             {operation: "HistoryClientRecordActivityTaskHeartbeat", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientRespondDecisionTaskCompletedScope:            {operation: "HistoryClientRespondDecisionTaskCompleted", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientRespondDecisionTaskFailedScope:               {operation: "HistoryClientRespondDecisionTaskFailed", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientRespondActivityTaskCompletedScope:            {operation: "HistoryClientRespondActivityTaskCompleted", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientRespondActivityTaskFailedScope:               {operation: "HistoryClientRespondActivityTaskFailed", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientRespondActivityTaskCanceledScope:             {operation: "HistoryClientRespondActivityTaskCanceled", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientDescribeMutableStateScope:                    {operation: "HistoryClientDescribeMutableState", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientGetMutableStateScope:                         {operation: "HistoryClientGetMutableState", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientPollMutableStateScope:                        {operation: "HistoryClientPollMutableState", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientResetStickyTaskListScope:                     {operation: "HistoryClientResetStickyTaskList", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientDescribeWorkflowExecutionScope:               {operation: "HistoryClientDescribeWorkflowExecution", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientRecordDecisionTaskStartedScope:               {operation: "HistoryClientRecordDecisionTaskStarted", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientRecordActivityTaskStartedScope:               {operation: "HistoryClientRecordActivityTaskStarted", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientRequestCancelWorkflowExecutionScope:          {operation: "HistoryClientRequestCancelWorkflowExecution", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientSignalWorkflowExecutionScope:                 {operation: "HistoryClientSignalWorkflowExecution", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientSignalWithStartWorkflowExecutionScope:        {operation: "HistoryClientSignalWithStartWorkflowExecution", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientRemoveSignalMutableStateScope:                {operation: "HistoryClientRemoveSignalMutableState", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientTerminateWorkflowExecutionScope:              {operation: "HistoryClientTerminateWorkflowExecution", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientUpdateWorkflowExecutionScope:                 {operation: "HistoryClientUpdateWorkflowExecution", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientPauseWorkflowExecutionScope:                  {operation: "HistoryClientPauseWorkflowExecution", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientUnpauseWorkflowExecutionScope:                {operation: "HistoryClientUnpauseWorkflowExecution", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientPauseActivityScope:                           {operation: "HistoryClientPauseActivity", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientUnpauseActivityScope:                         {operation: "HistoryClientUnpauseActivity", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientResetActivityAttemptScope:                    {operation: "HistoryClientResetActivityAttempt", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientRetryActivityNowScope:                        {operation: "HistoryClientRetryActivityNow", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientDeleteWorkflowExecutionScope:                 {operation: "HistoryClientDeleteWorkflowExecution", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientUpsertWorkflowSearchAttributesScope:          {operation: "HistoryClientUpsertWorkflowSearchAttributes", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientResetWorkflowExecutionScope:                  {operation: "HistoryClientResetWorkflowExecution", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientScheduleDecisionTaskScope:                    {operation: "HistoryClientScheduleDecisionTask", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientRecordChildExecutionCompletedScope:           {operation: "HistoryClientRecordChildExecutionCompleted", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientReplicateEventsV2Scope:                       {operation: "HistoryClientReplicateEventsV2", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientReplicateDeleteWorkflowExecutionScope:        {operation: "HistoryClientReplicateDeleteWorkflowExecution", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientReplicateUpsertWorkflowSearchAttributesScope: {operation: "HistoryClientReplicateUpsertWorkflowSearchAttributes", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientSyncShardStatusScope:                         {operation: "HistoryClientSyncShardStatus", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientSyncActivityScope:                            {operation: "HistoryClientSyncActivity", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientGetReplicationTasksScope:                     {operation: "HistoryClientGetReplicationTasks", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientGetDLQReplicationTasksScope:                  {operation: "HistoryClientGetDLQReplicationTasks", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientQueryWorkflowScope:                           {operation: "HistoryClientQueryWorkflow", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientReapplyEventsScope:                           {operation: "HistoryClientReapplyEvents", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientCountDLQMessagesScope:                        {operation: "HistoryClientCountDLQMessages", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientReadDLQMessagesScope:                         {operation: "HistoryClientReadDLQMessages", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientPurgeDLQMessagesScope:                        {operation: "HistoryClientPurgeDLQMessages", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientMergeDLQMessagesScope:                        {operation: "HistoryClientMergeDLQMessages", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientRefreshWorkflowTasksScope:                    {operation: "HistoryClientRefreshWorkflowTasks", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientNotifyFailoverMarkersScope:                   {operation: "HistoryClientNotifyFailoverMarkers", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientGetCrossClusterTasksScope:                    {operation: "HistoryClientGetCrossClusterTasks", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientRespondCrossClusterTasksCompletedScope:       {operation: "HistoryClientRespondCrossClusterTasksCompleted", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientGetFailoverInfoScope:                         {operation: "HistoryClientGetFailoverInfo", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientGetDLQReplicationMessagesScope:               {operation: "HistoryClientGetDLQReplicationMessages", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientGetReplicationMessagesScope:                  {operation: "HistoryClientGetReplicationMessages", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientWfIDCacheScope:                               {operation: "HistoryClientWfIDCache", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientRatelimitUpdateScope:                         {operation: "HistoryClientRatelimitUpdate", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
 
 		MatchingClientPollForDecisionTaskScope:            {operation: "MatchingClientPollForDecisionTask", tags: map[string]string{CadenceRoleTagName: MatchingClientRoleTagValue}},
 		MatchingClientPollForActivityTaskScope:            {operation: "MatchingClientPollForActivityTask", tags: map[string]string{CadenceRoleTagName: MatchingClientRoleTagValue}},
@@ -1767,12 +1927,21 @@ var ScopeDefs = map[ServiceIdx]map[ScopeIdx]scopeDefinition{
 		FrontendClientStartWorkflowExecutionScope:                {operation: "FrontendClientStartWorkflowExecution", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
 		FrontendClientStartWorkflowExecutionAsyncScope:           {operation: "FrontendClientStartWorkflowExecutionAsync", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
 		FrontendClientTerminateWorkflowExecutionScope:            {operation: "FrontendClientTerminateWorkflowExecution", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
+		FrontendClientUpdateWorkflowExecutionScope:               {operation: "FrontendClientUpdateWorkflowExecution", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
+		FrontendClientPauseWorkflowExecutionScope:                {operation: "FrontendClientPauseWorkflowExecution", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
+		FrontendClientUnpauseWorkflowExecutionScope:              {operation: "FrontendClientUnpauseWorkflowExecution", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
+		FrontendClientPauseActivityScope:                         {operation: "FrontendClientPauseActivity", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
+		FrontendClientUnpauseActivityScope:                       {operation: "FrontendClientUnpauseActivity", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
+		FrontendClientResetActivityAttemptScope:                  {operation: "FrontendClientResetActivityAttempt", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
+		FrontendClientRetryActivityNowScope:                      {operation: "FrontendClientRetryActivityNow", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
+		FrontendClientDeleteWorkflowExecutionScope:               {operation: "FrontendClientDeleteWorkflowExecution", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
 		FrontendClientUpdateDomainScope:                          {operation: "FrontendClientUpdateDomain", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
 		FrontendClientFailoverDomainScope:                        {operation: "FrontendClientFailoverDomain", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
 		FrontendClientListFailoverHistoryScope:                   {operation: "FrontendClientListFailoverHistory", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
 		FrontendClientListWorkflowExecutionsScope:                {operation: "FrontendClientListWorkflowExecutions", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
 		FrontendClientScanWorkflowExecutionsScope:                {operation: "FrontendClientScanWorkflowExecutions", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
 		FrontendClientCountWorkflowExecutionsScope:               {operation: "FrontendClientCountWorkflowExecutions", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
+		FrontendClientAggregateWorkflowExecutionsScope:           {operation: "FrontendClientAggregateWorkflowExecutions", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
 		FrontendClientGetSearchAttributesScope:                   {operation: "FrontendClientGetSearchAttributes", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
 		FrontendClientGetReplicationTasksScope:                   {operation: "FrontendClientGetReplicationTasks", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
 		FrontendClientGetDomainReplicationTasksScope:             {operation: "FrontendClientGetDomainReplicationTasks", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
@@ -1789,7 +1958,11 @@ var ScopeDefs = map[ServiceIdx]map[ScopeIdx]scopeDefinition{
 		FrontendClientPauseScheduleScope:                         {operation: "FrontendClientPauseSchedule", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
 		FrontendClientUnpauseScheduleScope:                       {operation: "FrontendClientUnpauseSchedule", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
 		FrontendClientBackfillScheduleScope:                      {operation: "FrontendClientBackfillSchedule", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
+		FrontendClientTriggerScheduleScope:                       {operation: "FrontendClientTriggerSchedule", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
 		FrontendClientListSchedulesScope:                         {operation: "FrontendClientListSchedules", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
+		FrontendClientCreateSemaphoreScope:                       {operation: "FrontendClientCreateSemaphore", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
+		FrontendClientDescribeSemaphoreScope:                     {operation: "FrontendClientDescribeSemaphore", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
+		FrontendClientListSemaphoresScope:                        {operation: "FrontendClientListSemaphores", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
 
 		AdminClientGetReplicationTasksScope:                   {operation: "AdminClientGetReplicationTasks", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
 		AdminClientAddSearchAttributeScope:                    {operation: "AdminClientAddSearchAttribute", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
@@ -1831,6 +2004,7 @@ var ScopeDefs = map[ServiceIdx]map[ScopeIdx]scopeDefinition{
 		AdminClientGetDomainAsyncWorkflowConfiguratonScope:    {operation: "AdminClientGetDomainAsyncWorkflowConfiguraton", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
 		AdminClientUpdateDomainAsyncWorkflowConfiguratonScope: {operation: "AdminClientUpdateDomainAsyncWorkflowConfiguraton", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
 		AdminClientUpdateTaskListPartitionConfigScope:         {operation: "AdminClientUpdateTaskListPartitionConfig", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
+		AdminClientUpsertWorkflowSearchAttributesScope:        {operation: "AdminClientUpsertWorkflowSearchAttributes", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
 
 		DCRedirectionDeleteDomainScope:                          {operation: "DCRedirectionDeleteDomain", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
 		DCRedirectionDeprecateDomainScope:                       {operation: "DCRedirectionDeprecateDomain", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
@@ -1850,6 +2024,7 @@ var ScopeDefs = map[ServiceIdx]map[ScopeIdx]scopeDefinition{
 		DCRedirectionListWorkflowExecutionsScope:                {operation: "DCRedirectionListWorkflowExecutions", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
 		DCRedirectionScanWorkflowExecutionsScope:                {operation: "DCRedirectionScanWorkflowExecutions", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
 		DCRedirectionCountWorkflowExecutionsScope:               {operation: "DCRedirectionCountWorkflowExecutions", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionAggregateWorkflowExecutionsScope:           {operation: "DCRedirectionAggregateWorkflowExecutions", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
 		DCRedirectionGetSearchAttributesScope:                   {operation: "DCRedirectionGetSearchAttributes", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
 		DCRedirectionPollForActivityTaskScope:                   {operation: "DCRedirectionPollForActivityTask", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
 		DCRedirectionPollForDecisionTaskScope:                   {operation: "DCRedirectionPollForDecisionTask", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
@@ -1875,6 +2050,14 @@ var ScopeDefs = map[ServiceIdx]map[ScopeIdx]scopeDefinition{
 		DCRedirectionStartWorkflowExecutionScope:                {operation: "DCRedirectionStartWorkflowExecution", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
 		DCRedirectionStartWorkflowExecutionAsyncScope:           {operation: "DCRedirectionStartWorkflowExecutionAsync", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
 		DCRedirectionTerminateWorkflowExecutionScope:            {operation: "DCRedirectionTerminateWorkflowExecution", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionUpdateWorkflowExecutionScope:               {operation: "DCRedirectionUpdateWorkflowExecution", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionPauseWorkflowExecutionScope:                {operation: "DCRedirectionPauseWorkflowExecution", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionUnpauseWorkflowExecutionScope:              {operation: "DCRedirectionUnpauseWorkflowExecution", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionPauseActivityScope:                         {operation: "DCRedirectionPauseActivity", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionUnpauseActivityScope:                       {operation: "DCRedirectionUnpauseActivity", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionResetActivityAttemptScope:                  {operation: "DCRedirectionResetActivityAttempt", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionRetryActivityNowScope:                      {operation: "DCRedirectionRetryActivityNow", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionDeleteWorkflowExecutionScope:               {operation: "DCRedirectionDeleteWorkflowExecution", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
 		DCRedirectionUpdateDomainScope:                          {operation: "DCRedirectionUpdateDomain", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
 		DCRedirectionListTaskListPartitionsScope:                {operation: "DCRedirectionListTaskListPartitions", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
 		DCRedirectionGetTaskListsByDomainScope:                  {operation: "DCRedirectionGetTaskListsByDomain", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
@@ -1887,7 +2070,11 @@ var ScopeDefs = map[ServiceIdx]map[ScopeIdx]scopeDefinition{
 		DCRedirectionPauseScheduleScope:                         {operation: "DCRedirectionPauseSchedule", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
 		DCRedirectionUnpauseScheduleScope:                       {operation: "DCRedirectionUnpauseSchedule", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
 		DCRedirectionBackfillScheduleScope:                      {operation: "DCRedirectionBackfillSchedule", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionTriggerScheduleScope:                       {operation: "DCRedirectionTriggerSchedule", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
 		DCRedirectionListSchedulesScope:                         {operation: "DCRedirectionListSchedules", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionCreateSemaphoreScope:                       {operation: "DCRedirectionCreateSemaphore", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionDescribeSemaphoreScope:                     {operation: "DCRedirectionDescribeSemaphore", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionListSemaphoresScope:                        {operation: "DCRedirectionListSemaphores", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
 		DCRedirectionForwardingPolicyScope:                      {operation: "DCRedirectionForwardingPolicy", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
 
 		MessagingClientPublishScope:      {operation: "MessagingClientPublish"},
@@ -1916,6 +2103,7 @@ var ScopeDefs = map[ServiceIdx]map[ScopeIdx]scopeDefinition{
 		ElasticsearchListWorkflowExecutionsScope:                   {operation: "ListWorkflowExecutions"},
 		ElasticsearchScanWorkflowExecutionsScope:                   {operation: "ScanWorkflowExecutions"},
 		ElasticsearchCountWorkflowExecutionsScope:                  {operation: "CountWorkflowExecutions"},
+		ElasticsearchAggregateWorkflowExecutionsScope:              {operation: "AggregateWorkflowExecutions"},
 		ElasticsearchDeleteWorkflowExecutionsScope:                 {operation: "DeleteWorkflowExecution"},
 		ElasticsearchDeleteUninitializedWorkflowExecutionsScope:    {operation: "DeleteUninitializedWorkflowExecution"},
 		PinotRecordWorkflowExecutionStartedScope:                   {operation: "RecordWorkflowExecutionStarted"},
@@ -1933,6 +2121,7 @@ var ScopeDefs = map[ServiceIdx]map[ScopeIdx]scopeDefinition{
 		PinotListWorkflowExecutionsScope:                           {operation: "ListWorkflowExecutions"},
 		PinotScanWorkflowExecutionsScope:                           {operation: "ScanWorkflowExecutions"},
 		PinotCountWorkflowExecutionsScope:                          {operation: "CountWorkflowExecutions"},
+		PinotAggregateWorkflowExecutionsScope:                      {operation: "AggregateWorkflowExecutions"},
 		PinotDeleteWorkflowExecutionsScope:                         {operation: "DeleteWorkflowExecution"},
 		PinotDeleteUninitializedWorkflowExecutionsScope:            {operation: "DeleteUninitializedWorkflowExecution"},
 		SequentialTaskProcessingScope:                              {operation: "SequentialTaskProcessing"},
@@ -2015,6 +2204,7 @@ var ScopeDefs = map[ServiceIdx]map[ScopeIdx]scopeDefinition{
 		AdminRestoreOperationalDynamicConfigScope:   {operation: "AdminRestoreOperationalDynamicConfig"},
 		AdminListOperationalDynamicConfigScope:      {operation: "AdminListOperationalDynamicConfig"},
 		AdminDeleteWorkflowScope:                    {operation: "AdminDeleteWorkflow"},
+		AdminUpsertWorkflowSearchAttributesScope:    {operation: "AdminUpsertWorkflowSearchAttributes"},
 		GetGlobalIsolationGroups:                    {operation: "GetGlobalIsolationGroups"},
 		UpdateGlobalIsolationGroups:                 {operation: "UpdateGlobalIsolationGroups"},
 		GetDomainIsolationGroups:                    {operation: "GetDomainIsolationGroups"},
@@ -2046,6 +2236,14 @@ var ScopeDefs = map[ServiceIdx]map[ScopeIdx]scopeDefinition{
 		FrontendSignalWithStartWorkflowExecutionScope:      {operation: "SignalWithStartWorkflowExecution"},
 		FrontendSignalWithStartWorkflowExecutionAsyncScope: {operation: "SignalWithStartWorkflowExecutionAsync"},
 		FrontendTerminateWorkflowExecutionScope:            {operation: "TerminateWorkflowExecution"},
+		FrontendUpdateWorkflowExecutionScope:               {operation: "UpdateWorkflowExecution"},
+		FrontendPauseWorkflowExecutionScope:                {operation: "PauseWorkflowExecution"},
+		FrontendUnpauseWorkflowExecutionScope:              {operation: "UnpauseWorkflowExecution"},
+		FrontendPauseActivityScope:                         {operation: "PauseActivity"},
+		FrontendUnpauseActivityScope:                       {operation: "UnpauseActivity"},
+		FrontendResetActivityAttemptScope:                  {operation: "ResetActivityAttempt"},
+		FrontendRetryActivityNowScope:                      {operation: "RetryActivityNow"},
+		FrontendDeleteWorkflowExecutionScope:               {operation: "DeleteWorkflowExecution"},
 		FrontendResetWorkflowExecutionScope:                {operation: "ResetWorkflowExecution"},
 		FrontendRequestCancelWorkflowExecutionScope:        {operation: "RequestCancelWorkflowExecution"},
 		FrontendListArchivedWorkflowExecutionsScope:        {operation: "ListArchivedWorkflowExecutions"},
@@ -2054,6 +2252,7 @@ var ScopeDefs = map[ServiceIdx]map[ScopeIdx]scopeDefinition{
 		FrontendListWorkflowExecutionsScope:                {operation: "ListWorkflowExecutions"},
 		FrontendScanWorkflowExecutionsScope:                {operation: "ScanWorkflowExecutions"},
 		FrontendCountWorkflowExecutionsScope:               {operation: "CountWorkflowExecutions"},
+		FrontendAggregateWorkflowExecutionsScope:           {operation: "AggregateWorkflowExecutions"},
 		FrontendRegisterDomainScope:                        {operation: "RegisterDomain"},
 		FrontendDescribeDomainScope:                        {operation: "DescribeDomain"},
 		FrontendListDomainsScope:                           {operation: "ListDomain"},
@@ -2078,7 +2277,11 @@ var ScopeDefs = map[ServiceIdx]map[ScopeIdx]scopeDefinition{
 		FrontendPauseScheduleScope:                         {operation: "PauseSchedule"},
 		FrontendUnpauseScheduleScope:                       {operation: "UnpauseSchedule"},
 		FrontendBackfillScheduleScope:                      {operation: "BackfillSchedule"},
+		FrontendTriggerScheduleScope:                       {operation: "TriggerSchedule"},
 		FrontendListSchedulesScope:                         {operation: "ListSchedules"},
+		FrontendCreateSemaphoreScope:                       {operation: "CreateSemaphore"},
+		FrontendDescribeSemaphoreScope:                     {operation: "DescribeSemaphore"},
+		FrontendListSemaphoresScope:                        {operation: "ListSemaphores"},
 		FrontendGetSearchAttributesScope:                   {operation: "GetSearchAttributes"},
 		FrontendGetClusterInfoScope:                        {operation: "GetClusterInfo"},
 	},
@@ -2104,6 +2307,15 @@ var ScopeDefs = map[ServiceIdx]map[ScopeIdx]scopeDefinition{
 		HistorySignalWithStartWorkflowExecutionScope:                    {operation: "SignalWithStartWorkflowExecution"},
 		HistoryRemoveSignalMutableStateScope:                            {operation: "RemoveSignalMutableState"},
 		HistoryTerminateWorkflowExecutionScope:                          {operation: "TerminateWorkflowExecution"},
+		HistoryUpdateWorkflowExecutionScope:                             {operation: "UpdateWorkflowExecution"},
+		HistoryPauseWorkflowExecutionScope:                              {operation: "PauseWorkflowExecution"},
+		HistoryUnpauseWorkflowExecutionScope:                            {operation: "UnpauseWorkflowExecution"},
+		HistoryPauseActivityScope:                                       {operation: "PauseActivity"},
+		HistoryUnpauseActivityScope:                                     {operation: "UnpauseActivity"},
+		HistoryResetActivityAttemptScope:                                {operation: "ResetActivityAttempt"},
+		HistoryRetryActivityNowScope:                                    {operation: "RetryActivityNow"},
+		HistoryDeleteWorkflowExecutionScope:                             {operation: "DeleteWorkflowExecution"},
+		HistoryUpsertWorkflowSearchAttributesScope:                      {operation: "UpsertWorkflowSearchAttributes"},
 		HistoryResetWorkflowExecutionScope:                              {operation: "ResetWorkflowExecution"},
 		HistoryQueryWorkflowScope:                                       {operation: "QueryWorkflow"},
 		HistoryProcessDeleteHistoryEventScope:                           {operation: "ProcessDeleteHistoryEvent"},
@@ -2113,6 +2325,8 @@ var ScopeDefs = map[ServiceIdx]map[ScopeIdx]scopeDefinition{
 		HistoryReplicateEventsScope:                                     {operation: "ReplicateEvents"},
 		HistoryReplicateRawEventsScope:                                  {operation: "ReplicateRawEvents"},
 		HistoryReplicateEventsV2Scope:                                   {operation: "ReplicateEventsV2"},
+		HistoryReplicateDeleteWorkflowExecutionScope:                    {operation: "ReplicateDeleteWorkflowExecution"},
+		HistoryReplicateUpsertWorkflowSearchAttributesScope:             {operation: "ReplicateUpsertWorkflowSearchAttributes"},
 		HistorySyncShardStatusScope:                                     {operation: "SyncShardStatus"},
 		HistorySyncActivityScope:                                        {operation: "SyncActivity"},
 		HistoryDescribeMutableStateScope:                                {operation: "DescribeMutableState"},
@@ -2147,6 +2361,7 @@ var ScopeDefs = map[ServiceIdx]map[ScopeIdx]scopeDefinition{
 		TransferActiveTaskRecordWorkflowClosedScope:                     {operation: "TransferActiveTaskRecordWorkflowClosed"},
 		TransferActiveTaskRecordChildExecutionCompletedScope:            {operation: "TransferActiveTaskRecordChildExecutionCompleted"},
 		TransferActiveTaskApplyParentClosePolicyScope:                   {operation: "TransferActiveTaskApplyParentClosePolicy"},
+		TransferActiveTaskDeleteWorkflowExecutionScope:                  {operation: "TransferActiveTaskDeleteWorkflowExecution"},
 		TransferStandbyTaskActivityScope:                                {operation: "TransferStandbyTaskActivity"},
 		TransferStandbyTaskDecisionScope:                                {operation: "TransferStandbyTaskDecision"},
 		TransferStandbyTaskCloseExecutionScope:                          {operation: "TransferStandbyTaskCloseExecution"},
@@ -2159,6 +2374,7 @@ var ScopeDefs = map[ServiceIdx]map[ScopeIdx]scopeDefinition{
 		TransferStandbyTaskRecordWorkflowClosedScope:                    {operation: "TransferStandbyTaskRecordWorkflowClosed"},
 		TransferStandbyTaskRecordChildExecutionCompletedScope:           {operation: "TransferStandbyTaskRecordChildExecutionCompleted"},
 		TransferStandbyTaskApplyParentClosePolicyScope:                  {operation: "TransferStandbyTaskApplyParentClosePolicy"},
+		TransferStandbyTaskDeleteWorkflowExecutionScope:                 {operation: "TransferStandbyTaskDeleteWorkflowExecution"},
 		TimerQueueProcessorScope:                                        {operation: "TimerQueueProcessor"},
 		TimerQueueProcessorV2Scope:                                      {operation: "TimerQueueProcessorV2"},
 		TimerActiveQueueProcessorScope:                                  {operation: "TimerActiveQueueProcessor"},
@@ -2227,6 +2443,8 @@ var ScopeDefs = map[ServiceIdx]map[ScopeIdx]scopeDefinition{
 		HistoryTaskSchedulerMigrationScope:                              {operation: "HistoryTaskSchedulerMigration"},
 		WorkflowCorruptionRepairScope:                                   {operation: "WorkflowCorruptionRepair"},
 		HistoryTaskDLQProcessorScope:                                    {operation: "HistoryTaskDLQProcessor"},
+		DeleteWorkflowExecutionReplicationTaskScope:                     {operation: "DeleteWorkflowExecutionReplicationTask"},
+		UpsertWorkflowSearchAttributesReplicationTaskScope:              {operation: "UpsertWorkflowSearchAttributesReplicationTask"},
 	},
 	// Matching Scope Names
 	Matching: {
@@ -2271,6 +2489,7 @@ var ScopeDefs = map[ServiceIdx]map[ScopeIdx]scopeDefinition{
 		DiagnosticsWorkflowScope:               {operation: "DiagnosticsWorkflow"},
 		SchedulerWorkerScope:                   {operation: "SchedulerWorker"},
 		SchedulerActivityScope:                 {operation: "SchedulerActivity"},
+		VisibilityMigrationScope:               {operation: "VisibilityMigration"},
 	},
 }
 
@@ -2949,6 +3168,10 @@ const (
 	QueryBufferExceededCount
 	QueryRegistryInvalidStateCount
 	WorkerNotSupportsConsistentQueryCount
+	WorkflowUpdateAcceptedCount
+	WorkflowUpdateRejectedCount
+	WorkflowUpdateTimeoutCount
+	WorkflowUpdateCompletedCount
 	DecisionStartToCloseTimeoutOverrideCount
 	ReplicationTaskCleanupCount
 	ReplicationTaskCleanupFailure
@@ -3180,6 +3403,7 @@ const (
 	HistoryScavengerSuccessCount
 	HistoryScavengerErrorCount
 	HistoryScavengerSkipCount
+	HistoryScavengerPayloadDeletedCount
 	DomainReplicationEnqueueDLQCount
 	ScannerExecutionsGauge
 	ScannerCorruptedGauge
@@ -3248,6 +3472,20 @@ const (
 	// SchedulerOverlapTerminateCountPerDomain measures confirmed terminates under TerminatePrevious policy; excludes workflows already gone.
 	SchedulerOverlapTerminateCountPerDomain
 
+	// Visibility migration metrics
+	// VisibilityMigrationBackfilledCount counts closed executions copied from the source store into the target store
+	VisibilityMigrationBackfilledCount
+	// VisibilityMigrationBackfillFailedCount counts closed executions that could not be written into the target store
+	VisibilityMigrationBackfillFailedCount
+	// VisibilityMigrationVerifyMatchCount counts verification rounds where source and target agreed
+	VisibilityMigrationVerifyMatchCount
+	// VisibilityMigrationVerifyMismatchCount counts verification rounds where source and target disagreed
+	VisibilityMigrationVerifyMismatchCount
+	// VisibilityMigrationDiscrepancyCount counts individual executions missing from or unexpected in the target store
+	VisibilityMigrationDiscrepancyCount
+	// VisibilityMigrationCutoverCount counts read store cutovers applied to a domain
+	VisibilityMigrationCutoverCount
+
 	NumWorkerMetrics
 )
 
@@ -3935,6 +4173,10 @@ var MetricDefs = map[ServiceIdx]map[MetricIdx]metricDefinition{
 		QueryBufferExceededCount:                                      {metricName: "query_buffer_exceeded", metricType: Counter},
 		QueryRegistryInvalidStateCount:                                {metricName: "query_registry_invalid_state", metricType: Counter},
 		WorkerNotSupportsConsistentQueryCount:                         {metricName: "worker_not_supports_consistent_query", metricType: Counter},
+		WorkflowUpdateAcceptedCount:                                   {metricName: "workflow_update_accepted", metricType: Counter},
+		WorkflowUpdateRejectedCount:                                   {metricName: "workflow_update_rejected", metricType: Counter},
+		WorkflowUpdateTimeoutCount:                                    {metricName: "workflow_update_timeout", metricType: Counter},
+		WorkflowUpdateCompletedCount:                                  {metricName: "workflow_update_completed", metricType: Counter},
 		DecisionStartToCloseTimeoutOverrideCount:                      {metricName: "decision_start_to_close_timeout_overrides", metricType: Counter},
 		ReplicationTaskCleanupCount:                                   {metricName: "replication_task_cleanup_count", metricType: Counter},
 		ReplicationTaskCleanupFailure:                                 {metricName: "replication_task_cleanup_failed", metricType: Counter},
@@ -4151,6 +4393,7 @@ var MetricDefs = map[ServiceIdx]map[MetricIdx]metricDefinition{
 		HistoryScavengerSuccessCount:                    {metricName: "scavenger_success", metricType: Counter},
 		HistoryScavengerErrorCount:                      {metricName: "scavenger_errors", metricType: Counter},
 		HistoryScavengerSkipCount:                       {metricName: "scavenger_skips", metricType: Counter},
+		HistoryScavengerPayloadDeletedCount:             {metricName: "scavenger_payloads_deleted", metricType: Counter},
 		DomainReplicationEnqueueDLQCount:                {metricName: "domain_replication_dlq_enqueue_requests", metricType: Counter},
 		ScannerExecutionsGauge:                          {metricName: "scanner_executions", metricType: Gauge},
 		ScannerCorruptedGauge:                           {metricName: "scanner_corrupted", metricType: Gauge},
@@ -4199,6 +4442,12 @@ var MetricDefs = map[ServiceIdx]map[MetricIdx]metricDefinition{
 		SchedulerFireLatencyPerDomainHistogram:          {metricName: "scheduler_fire_latency_per_domain_ns", metricType: Histogram, exponentialBuckets: Default1ms100s},
 		SchedulerOverlapCancelCountPerDomain:            {metricName: "scheduler_overlap_cancel_per_domain", metricType: Counter},
 		SchedulerOverlapTerminateCountPerDomain:         {metricName: "scheduler_overlap_terminate_per_domain", metricType: Counter},
+		VisibilityMigrationBackfilledCount:              {metricName: "visibility_migration_backfilled", metricType: Counter},
+		VisibilityMigrationBackfillFailedCount:          {metricName: "visibility_migration_backfill_failed", metricType: Counter},
+		VisibilityMigrationVerifyMatchCount:             {metricName: "visibility_migration_verify_match", metricType: Counter},
+		VisibilityMigrationVerifyMismatchCount:          {metricName: "visibility_migration_verify_mismatch", metricType: Counter},
+		VisibilityMigrationDiscrepancyCount:             {metricName: "visibility_migration_discrepancy", metricType: Counter},
+		VisibilityMigrationCutoverCount:                 {metricName: "visibility_migration_cutover", metricType: Counter},
 	},
 }
 
@@ -197,6 +197,74 @@ func (_c *HistoryV2Manager_DeleteHistoryBranch_Call) RunAndReturn(run func(ctx c
 	return _c
 }
 
+// DeleteOrphanedHistoryPayloads provides a mock function for the type HistoryV2Manager
+func (_mock *HistoryV2Manager) DeleteOrphanedHistoryPayloads(ctx context.Context, request *persistence.DeleteOrphanedHistoryPayloadsRequest) (*persistence.DeleteOrphanedHistoryPayloadsResponse, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteOrphanedHistoryPayloads")
+	}
+
+	var r0 *persistence.DeleteOrphanedHistoryPayloadsResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *persistence.DeleteOrphanedHistoryPayloadsRequest) (*persistence.DeleteOrphanedHistoryPayloadsResponse, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *persistence.DeleteOrphanedHistoryPayloadsRequest) *persistence.DeleteOrphanedHistoryPayloadsResponse); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*persistence.DeleteOrphanedHistoryPayloadsResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *persistence.DeleteOrphanedHistoryPayloadsRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// HistoryV2Manager_DeleteOrphanedHistoryPayloads_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteOrphanedHistoryPayloads'
+type HistoryV2Manager_DeleteOrphanedHistoryPayloads_Call struct {
+	*mock.Call
+}
+
+// DeleteOrphanedHistoryPayloads is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *persistence.DeleteOrphanedHistoryPayloadsRequest
+func (_e *HistoryV2Manager_Expecter) DeleteOrphanedHistoryPayloads(ctx interface{}, request interface{}) *HistoryV2Manager_DeleteOrphanedHistoryPayloads_Call {
+	return &HistoryV2Manager_DeleteOrphanedHistoryPayloads_Call{Call: _e.mock.On("DeleteOrphanedHistoryPayloads", ctx, request)}
+}
+
+func (_c *HistoryV2Manager_DeleteOrphanedHistoryPayloads_Call) Run(run func(ctx context.Context, request *persistence.DeleteOrphanedHistoryPayloadsRequest)) *HistoryV2Manager_DeleteOrphanedHistoryPayloads_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *persistence.DeleteOrphanedHistoryPayloadsRequest
+		if args[1] != nil {
+			arg1 = args[1].(*persistence.DeleteOrphanedHistoryPayloadsRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *HistoryV2Manager_DeleteOrphanedHistoryPayloads_Call) Return(deleteOrphanedHistoryPayloadsResponse *persistence.DeleteOrphanedHistoryPayloadsResponse, err error) *HistoryV2Manager_DeleteOrphanedHistoryPayloads_Call {
+	_c.Call.Return(deleteOrphanedHistoryPayloadsResponse, err)
+	return _c
+}
+
+func (_c *HistoryV2Manager_DeleteOrphanedHistoryPayloads_Call) RunAndReturn(run func(ctx context.Context, request *persistence.DeleteOrphanedHistoryPayloadsRequest) (*persistence.DeleteOrphanedHistoryPayloadsResponse, error)) *HistoryV2Manager_DeleteOrphanedHistoryPayloads_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ForkHistoryBranch provides a mock function for the type HistoryV2Manager
 func (_mock *HistoryV2Manager) ForkHistoryBranch(ctx context.Context, request *persistence.ForkHistoryBranchRequest) (*persistence.ForkHistoryBranchResponse, error) {
 	ret := _mock.Called(ctx, request)
@@ -648,3 +716,71 @@ func (_c *HistoryV2Manager_ReadRawHistoryBranch_Call) RunAndReturn(run func(ctx
 	_c.Call.Return(run)
 	return _c
 }
+
+// ReencodeHistoryBranch provides a mock function for the type HistoryV2Manager
+func (_mock *HistoryV2Manager) ReencodeHistoryBranch(ctx context.Context, request *persistence.ReencodeHistoryBranchRequest) (*persistence.ReencodeHistoryBranchResponse, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReencodeHistoryBranch")
+	}
+
+	var r0 *persistence.ReencodeHistoryBranchResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *persistence.ReencodeHistoryBranchRequest) (*persistence.ReencodeHistoryBranchResponse, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *persistence.ReencodeHistoryBranchRequest) *persistence.ReencodeHistoryBranchResponse); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*persistence.ReencodeHistoryBranchResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *persistence.ReencodeHistoryBranchRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// HistoryV2Manager_ReencodeHistoryBranch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReencodeHistoryBranch'
+type HistoryV2Manager_ReencodeHistoryBranch_Call struct {
+	*mock.Call
+}
+
+// ReencodeHistoryBranch is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *persistence.ReencodeHistoryBranchRequest
+func (_e *HistoryV2Manager_Expecter) ReencodeHistoryBranch(ctx interface{}, request interface{}) *HistoryV2Manager_ReencodeHistoryBranch_Call {
+	return &HistoryV2Manager_ReencodeHistoryBranch_Call{Call: _e.mock.On("ReencodeHistoryBranch", ctx, request)}
+}
+
+func (_c *HistoryV2Manager_ReencodeHistoryBranch_Call) Run(run func(ctx context.Context, request *persistence.ReencodeHistoryBranchRequest)) *HistoryV2Manager_ReencodeHistoryBranch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *persistence.ReencodeHistoryBranchRequest
+		if args[1] != nil {
+			arg1 = args[1].(*persistence.ReencodeHistoryBranchRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *HistoryV2Manager_ReencodeHistoryBranch_Call) Return(reencodeHistoryBranchResponse *persistence.ReencodeHistoryBranchResponse, err error) *HistoryV2Manager_ReencodeHistoryBranch_Call {
+	_c.Call.Return(reencodeHistoryBranchResponse, err)
+	return _c
+}
+
+func (_c *HistoryV2Manager_ReencodeHistoryBranch_Call) RunAndReturn(run func(ctx context.Context, request *persistence.ReencodeHistoryBranchRequest) (*persistence.ReencodeHistoryBranchResponse, error)) *HistoryV2Manager_ReencodeHistoryBranch_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -39,6 +39,74 @@ func (_m *VisibilityManager) EXPECT() *VisibilityManager_Expecter {
 	return &VisibilityManager_Expecter{mock: &_m.Mock}
 }
 
+// AggregateWorkflowExecutions provides a mock function for the type VisibilityManager
+func (_mock *VisibilityManager) AggregateWorkflowExecutions(ctx context.Context, request *persistence.AggregateWorkflowExecutionsRequest) (*persistence.AggregateWorkflowExecutionsResponse, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AggregateWorkflowExecutions")
+	}
+
+	var r0 *persistence.AggregateWorkflowExecutionsResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *persistence.AggregateWorkflowExecutionsRequest) (*persistence.AggregateWorkflowExecutionsResponse, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *persistence.AggregateWorkflowExecutionsRequest) *persistence.AggregateWorkflowExecutionsResponse); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*persistence.AggregateWorkflowExecutionsResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *persistence.AggregateWorkflowExecutionsRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// VisibilityManager_AggregateWorkflowExecutions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AggregateWorkflowExecutions'
+type VisibilityManager_AggregateWorkflowExecutions_Call struct {
+	*mock.Call
+}
+
+// AggregateWorkflowExecutions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *persistence.AggregateWorkflowExecutionsRequest
+func (_e *VisibilityManager_Expecter) AggregateWorkflowExecutions(ctx interface{}, request interface{}) *VisibilityManager_AggregateWorkflowExecutions_Call {
+	return &VisibilityManager_AggregateWorkflowExecutions_Call{Call: _e.mock.On("AggregateWorkflowExecutions", ctx, request)}
+}
+
+func (_c *VisibilityManager_AggregateWorkflowExecutions_Call) Run(run func(ctx context.Context, request *persistence.AggregateWorkflowExecutionsRequest)) *VisibilityManager_AggregateWorkflowExecutions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *persistence.AggregateWorkflowExecutionsRequest
+		if args[1] != nil {
+			arg1 = args[1].(*persistence.AggregateWorkflowExecutionsRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *VisibilityManager_AggregateWorkflowExecutions_Call) Return(aggregateWorkflowExecutionsResponse *persistence.AggregateWorkflowExecutionsResponse, err error) *VisibilityManager_AggregateWorkflowExecutions_Call {
+	_c.Call.Return(aggregateWorkflowExecutionsResponse, err)
+	return _c
+}
+
+func (_c *VisibilityManager_AggregateWorkflowExecutions_Call) RunAndReturn(run func(ctx context.Context, request *persistence.AggregateWorkflowExecutionsRequest) (*persistence.AggregateWorkflowExecutionsResponse, error)) *VisibilityManager_AggregateWorkflowExecutions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Close provides a mock function for the type VisibilityManager
 func (_mock *VisibilityManager) Close() {
 	_mock.Called()
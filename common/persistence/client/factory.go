@@ -26,6 +26,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/uber/cadence/common/blobstore"
 	"github.com/uber/cadence/common/clock"
 	"github.com/uber/cadence/common/codec"
 	"github.com/uber/cadence/common/config"
@@ -37,6 +38,7 @@ import (
 	"github.com/uber/cadence/common/metrics"
 	p "github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/persistence/elasticsearch"
+	"github.com/uber/cadence/common/persistence/encryption"
 	"github.com/uber/cadence/common/persistence/nosql"
 	pinotVisibility "github.com/uber/cadence/common/persistence/pinot"
 	"github.com/uber/cadence/common/persistence/serialization"
@@ -119,12 +121,13 @@ type (
 	}
 	factoryImpl struct {
 		sync.RWMutex
-		config        *config.Persistence
-		metricsClient metrics.Client
-		logger        log.Logger
-		datastores    map[storeType]Datastore
-		clusterName   string
-		dc            *p.DynamicConfiguration
+		config          *config.Persistence
+		metricsClient   metrics.Client
+		logger          log.Logger
+		datastores      map[storeType]Datastore
+		clusterName     string
+		dc              *p.DynamicConfiguration
+		blobstoreClient blobstore.Client
 	}
 
 	storeType int
@@ -159,6 +162,8 @@ var storeTypes = []storeType{
 //
 // The objects returned by this factory enforce ratelimit and maxconns according to
 // given configuration. In addition, all objects will emit metrics automatically
+//
+// The blobstore client is optional, it enables offloading large history event payloads.
 func NewFactory(
 	cfg *config.Persistence,
 	persistenceMaxQPS quotas.RPSFunc,
@@ -166,13 +171,15 @@ func NewFactory(
 	metricsClient metrics.Client,
 	logger log.Logger,
 	dc *p.DynamicConfiguration,
+	blobstoreClient blobstore.Client,
 ) Factory {
 	factory := &factoryImpl{
-		config:        cfg,
-		metricsClient: metricsClient,
-		logger:        logger,
-		clusterName:   clusterName,
-		dc:            dc,
+		config:          cfg,
+		metricsClient:   metricsClient,
+		logger:          logger,
+		clusterName:     clusterName,
+		dc:              dc,
+		blobstoreClient: blobstoreClient,
 	}
 	limiters := buildRatelimiters(cfg, persistenceMaxQPS)
 	factory.init(clusterName, limiters)
@@ -226,7 +233,20 @@ func (f *factoryImpl) NewHistoryManager() (p.HistoryManager, error) {
 	if err != nil {
 		return nil, err
 	}
-	result := p.NewHistoryV2ManagerImpl(store, f.logger, p.NewPayloadSerializer(), codec.NewThriftRWEncoder(), f.dc.TransactionSizeLimit)
+	payloadCodec, err := f.newPayloadCodec()
+	if err != nil {
+		return nil, err
+	}
+	result := p.NewHistoryV2ManagerImpl(
+		store,
+		f.logger,
+		p.NewPayloadSerializer(),
+		payloadCodec,
+		f.blobstoreClient,
+		f.dc.PayloadOffloadThreshold,
+		codec.NewThriftRWEncoder(),
+		f.dc.TransactionSizeLimit,
+	)
 	if errorRate := f.dc.ErrorInjectionRate(); errorRate != 0 {
 		result = errorinjectors.NewHistoryManager(result, errorRate, f.logger, time.Now())
 	}
@@ -321,7 +341,11 @@ func (f *factoryImpl) NewExecutionManager() (p.ExecutionManager, error) {
 	if err != nil {
 		return nil, err
 	}
-	result := p.NewExecutionManagerImpl(store, f.logger, p.NewPayloadSerializer(), f.dc)
+	payloadCodec, err := f.newPayloadCodec()
+	if err != nil {
+		return nil, err
+	}
+	result := p.NewExecutionManagerImpl(store, f.logger, p.NewPayloadSerializer(), payloadCodec, f.dc)
 	if errorRate := f.dc.ErrorInjectionRate(); errorRate != 0 {
 		result = errorinjectors.NewExecutionManager(result, errorRate, f.logger, time.Now())
 	}
@@ -343,10 +367,13 @@ func (f *factoryImpl) NewVisibilityManager(
 		// No need to create visibility manager as no read/write needed
 		return nil, nil
 	}
+	payloadCodec, err := f.newPayloadCodec()
+	if err != nil {
+		return nil, err
+	}
 	var visibilityFromDB, visibilityFromES, visibilityFromPinot, visibilityFromOS p.VisibilityManager
-	var err error
 	if params.PersistenceConfig.VisibilityStore != "" {
-		visibilityFromDB, err = f.newDBVisibilityManager(resourceConfig)
+		visibilityFromDB, err = f.newDBVisibilityManager(resourceConfig, payloadCodec)
 		if err != nil {
 			return nil, err
 		}
@@ -354,7 +381,7 @@ func (f *factoryImpl) NewVisibilityManager(
 
 	switch params.PersistenceConfig.AdvancedVisibilityStore {
 	case constants.PinotVisibilityStoreName:
-		visibilityFromPinot, err = setupPinotVisibilityManager(params, resourceConfig, f.logger, f.dc, quotas.NewCallerBypass(f.dc.RateLimiterBypassCallerTypes))
+		visibilityFromPinot, err = setupPinotVisibilityManager(params, resourceConfig, f.logger, payloadCodec, f.dc, quotas.NewCallerBypass(f.dc.RateLimiterBypassCallerTypes))
 		if err != nil {
 			f.logger.Fatal("Creating Pinot advanced visibility manager failed", tag.Error(err))
 		}
@@ -365,7 +392,7 @@ func (f *factoryImpl) NewVisibilityManager(
 		}
 
 		if params.PinotConfig.Migration.Enabled {
-			visibilityFromES, err = setupESVisibilityManager(params, resourceConfig, f.logger, f.dc, quotas.NewCallerBypass(f.dc.RateLimiterBypassCallerTypes))
+			visibilityFromES, err = setupESVisibilityManager(params, resourceConfig, f.logger, payloadCodec, f.dc, quotas.NewCallerBypass(f.dc.RateLimiterBypassCallerTypes))
 			if err != nil {
 				f.logger.Fatal("Creating ES advanced visibility manager failed", tag.Error(err))
 			}
@@ -382,7 +409,7 @@ func (f *factoryImpl) NewVisibilityManager(
 			f.logger,
 		), nil
 	case constants.OSVisibilityStoreName:
-		visibilityFromOS, err = setupOSVisibilityManager(params, resourceConfig, f.logger, f.dc, quotas.NewCallerBypass(f.dc.RateLimiterBypassCallerTypes))
+		visibilityFromOS, err = setupOSVisibilityManager(params, resourceConfig, f.logger, payloadCodec, f.dc, quotas.NewCallerBypass(f.dc.RateLimiterBypassCallerTypes))
 		if err != nil {
 			f.logger.Fatal("Creating OS advanced visibility manager failed", tag.Error(err))
 		}
@@ -392,7 +419,7 @@ func (f *factoryImpl) NewVisibilityManager(
 			constants.VisibilityModeOS: visibilityFromOS,
 		}
 		if params.OSConfig.Migration.Enabled {
-			visibilityFromES, err = setupESVisibilityManager(params, resourceConfig, f.logger, f.dc, quotas.NewCallerBypass(f.dc.RateLimiterBypassCallerTypes))
+			visibilityFromES, err = setupESVisibilityManager(params, resourceConfig, f.logger, payloadCodec, f.dc, quotas.NewCallerBypass(f.dc.RateLimiterBypassCallerTypes))
 			if err != nil {
 				f.logger.Fatal("Creating ES advanced visibility manager failed", tag.Error(err))
 			}
@@ -408,7 +435,7 @@ func (f *factoryImpl) NewVisibilityManager(
 			f.logger,
 		), nil
 	case constants.ESVisibilityStoreName:
-		visibilityFromES, err = setupESVisibilityManager(params, resourceConfig, f.logger, f.dc, quotas.NewCallerBypass(f.dc.RateLimiterBypassCallerTypes))
+		visibilityFromES, err = setupESVisibilityManager(params, resourceConfig, f.logger, payloadCodec, f.dc, quotas.NewCallerBypass(f.dc.RateLimiterBypassCallerTypes))
 		if err != nil {
 			f.logger.Fatal("Creating advanced visibility manager failed", tag.Error(err))
 		}
@@ -451,11 +478,12 @@ func newPinotVisibilityManager(
 	producer messaging.Producer,
 	metricsClient metrics.Client,
 	log log.Logger,
+	payloadCodec p.PayloadCodec,
 	dc *p.DynamicConfiguration,
 	callerBypass quotas.CallerBypass,
 ) p.VisibilityManager {
 	visibilityFromPinotStore := pinotVisibility.NewPinotVisibilityStore(pinotClient, visibilityConfig, producer, log)
-	visibilityFromPinot := p.NewVisibilityManagerImpl(visibilityFromPinotStore, log, dc)
+	visibilityFromPinot := p.NewVisibilityManagerImpl(visibilityFromPinotStore, log, payloadCodec, dc)
 
 	// wrap with rate limiter
 	if visibilityConfig.PersistenceMaxQPS != nil && visibilityConfig.PersistenceMaxQPS() != 0 {
@@ -481,12 +509,13 @@ func newESVisibilityManager(
 	producer messaging.Producer,
 	metricsClient metrics.Client,
 	log log.Logger,
+	payloadCodec p.PayloadCodec,
 	dc *p.DynamicConfiguration,
 	callerBypass quotas.CallerBypass,
 ) p.VisibilityManager {
 
 	visibilityFromESStore := elasticsearch.NewElasticSearchVisibilityStore(esClient, indexName, producer, visibilityConfig, log)
-	visibilityFromES := p.NewVisibilityManagerImpl(visibilityFromESStore, log, dc)
+	visibilityFromES := p.NewVisibilityManagerImpl(visibilityFromESStore, log, payloadCodec, dc)
 
 	// wrap with rate limiter
 	if visibilityConfig.PersistenceMaxQPS != nil && visibilityConfig.PersistenceMaxQPS() != 0 {
@@ -503,6 +532,7 @@ func newESVisibilityManager(
 
 func (f *factoryImpl) newDBVisibilityManager(
 	visibilityConfig *service.Config,
+	payloadCodec p.PayloadCodec,
 ) (p.VisibilityManager, error) {
 	enableReadFromClosedExecutionV2 := false
 	if visibilityConfig.EnableReadDBVisibilityFromClosedExecutionV2 != nil {
@@ -516,7 +546,7 @@ func (f *factoryImpl) newDBVisibilityManager(
 	if err != nil {
 		return nil, err
 	}
-	result := p.NewVisibilityManagerImpl(store, f.logger, f.dc)
+	result := p.NewVisibilityManagerImpl(store, f.logger, payloadCodec, f.dc)
 	if errorRate := f.dc.ErrorInjectionRate(); errorRate != 0 {
 		result = errorinjectors.NewVisibilityManager(result, errorRate, f.logger, time.Now())
 	}
@@ -696,6 +726,24 @@ func (f *factoryImpl) init(clusterName string, limiters map[string]quotas.Limite
 	f.datastores[storeTypeVisibility] = visibilityDataStore
 }
 
+// newPayloadCodec returns the codec encoding payloads at rest, or nil if payload encryption is not configured
+func (f *factoryImpl) newPayloadCodec() (p.PayloadCodec, error) {
+	return NewPayloadCodec(f.config, f.dc)
+}
+
+// NewPayloadCodec returns the codec encoding payloads at rest, or nil if payload encryption is not configured.
+// Components which store payloads outside of the persistence managers, e.g. archivers, use it to encode them the same way.
+func NewPayloadCodec(cfg *config.Persistence, dc *p.DynamicConfiguration) (p.PayloadCodec, error) {
+	if cfg.PayloadEncryption == nil {
+		return nil, nil
+	}
+	keyring, err := encryption.NewFileKeyring(cfg.PayloadEncryption.KeyringFile)
+	if err != nil {
+		return nil, err
+	}
+	return encryption.NewPayloadCodec(keyring, dc.EnablePayloadEncryption, dc.PayloadEncryptionKeyID), nil
+}
+
 func (f *factoryImpl) getParser() serialization.Parser {
 	parser, err := serialization.NewParser(f.dc)
 	if err != nil {
@@ -714,28 +762,28 @@ func buildRatelimiters(cfg *config.Persistence, maxQPS quotas.RPSFunc) map[strin
 	return result
 }
 
-func setupPinotVisibilityManager(params *Params, resourceConfig *service.Config, logger log.Logger, dc *p.DynamicConfiguration, callerBypass quotas.CallerBypass) (p.VisibilityManager, error) {
+func setupPinotVisibilityManager(params *Params, resourceConfig *service.Config, logger log.Logger, payloadCodec p.PayloadCodec, dc *p.DynamicConfiguration, callerBypass quotas.CallerBypass) (p.VisibilityManager, error) {
 	visibilityProducer, err := params.MessagingClient.NewProducer(constants.PinotVisibilityAppName)
 	if err != nil {
 		return nil, err
 	}
-	return newPinotVisibilityManager(params.PinotClient, resourceConfig, visibilityProducer, params.MetricsClient, logger, dc, callerBypass), nil
+	return newPinotVisibilityManager(params.PinotClient, resourceConfig, visibilityProducer, params.MetricsClient, logger, payloadCodec, dc, callerBypass), nil
 }
 
-func setupESVisibilityManager(params *Params, resourceConfig *service.Config, logger log.Logger, dc *p.DynamicConfiguration, callerBypass quotas.CallerBypass) (p.VisibilityManager, error) {
+func setupESVisibilityManager(params *Params, resourceConfig *service.Config, logger log.Logger, payloadCodec p.PayloadCodec, dc *p.DynamicConfiguration, callerBypass quotas.CallerBypass) (p.VisibilityManager, error) {
 	visibilityIndexName := params.ESConfig.Indices[constants.VisibilityAppName]
 	visibilityProducer, err := params.MessagingClient.NewProducer(constants.VisibilityAppName)
 	if err != nil {
 		return nil, err
 	}
-	return newESVisibilityManager(visibilityIndexName, params.ESClient, resourceConfig, visibilityProducer, params.MetricsClient, logger, dc, callerBypass), nil
+	return newESVisibilityManager(visibilityIndexName, params.ESClient, resourceConfig, visibilityProducer, params.MetricsClient, logger, payloadCodec, dc, callerBypass), nil
 }
 
-func setupOSVisibilityManager(params *Params, resourceConfig *service.Config, logger log.Logger, dc *p.DynamicConfiguration, callerBypass quotas.CallerBypass) (p.VisibilityManager, error) {
+func setupOSVisibilityManager(params *Params, resourceConfig *service.Config, logger log.Logger, payloadCodec p.PayloadCodec, dc *p.DynamicConfiguration, callerBypass quotas.CallerBypass) (p.VisibilityManager, error) {
 	visibilityIndexName := params.OSConfig.Indices[constants.VisibilityAppName]
 	visibilityProducer, err := params.MessagingClient.NewProducer(constants.VisibilityAppName)
 	if err != nil {
 		return nil, err
 	}
-	return newESVisibilityManager(visibilityIndexName, params.OSClient, resourceConfig, visibilityProducer, params.MetricsClient, logger, dc, callerBypass), nil
+	return newESVisibilityManager(visibilityIndexName, params.OSClient, resourceConfig, visibilityProducer, params.MetricsClient, logger, payloadCodec, dc, callerBypass), nil
 }
@@ -42,6 +42,10 @@ type (
 		RateLimiterBypassCallerTypes             dynamicproperties.ListPropertyFn
 		TransactionSizeLimit                     dynamicproperties.IntPropertyFn
 		ErrorInjectionRate                       dynamicproperties.FloatPropertyFn
+		EnablePayloadEncryption                  dynamicproperties.BoolPropertyFnWithDomainFilter
+		PayloadEncryptionKeyID                   dynamicproperties.StringPropertyFnWithDomainFilter
+		PayloadOffloadThreshold                  dynamicproperties.IntPropertyFnWithDomainFilter
+		ValidSearchAttributes                    dynamicproperties.MapPropertyFn
 	}
 )
 
@@ -62,6 +66,10 @@ func NewDynamicConfiguration(dc *dynamicconfig.Collection) *DynamicConfiguration
 		RateLimiterBypassCallerTypes:             dc.GetListProperty(dynamicproperties.RateLimiterBypassCallerTypes),
 		TransactionSizeLimit:                     dc.GetIntProperty(dynamicproperties.TransactionSizeLimit),
 		ErrorInjectionRate:                       dc.GetFloat64Property(dynamicproperties.PersistenceErrorInjectionRate),
+		EnablePayloadEncryption:                  dc.GetBoolPropertyFilteredByDomain(dynamicproperties.EnablePayloadEncryption),
+		PayloadEncryptionKeyID:                   dc.GetStringPropertyFilteredByDomain(dynamicproperties.PayloadEncryptionKeyID),
+		PayloadOffloadThreshold:                  dc.GetIntPropertyFilteredByDomain(dynamicproperties.PayloadOffloadThreshold),
+		ValidSearchAttributes:                    dc.GetMapProperty(dynamicproperties.ValidSearchAttributes),
 	}
 }
 
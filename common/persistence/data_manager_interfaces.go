@@ -260,6 +260,7 @@ const (
 	TransferTaskTypeRecordWorkflowClosed
 	TransferTaskTypeRecordChildExecutionCompleted
 	TransferTaskTypeApplyParentClosePolicy // Deprecated: this is related to cross-cluster tasks
+	TransferTaskTypeDeleteWorkflowExecution
 )
 
 // Types of replication tasks
@@ -267,6 +268,8 @@ const (
 	ReplicationTaskTypeHistory = iota
 	ReplicationTaskTypeSyncActivity
 	ReplicationTaskTypeFailoverMarker
+	ReplicationTaskTypeDeleteWorkflowExecution
+	ReplicationTaskTypeUpsertWorkflowSearchAttributes
 )
 
 // Types of timers
@@ -298,6 +301,7 @@ const (
 	DomainAuditOperationTypeDeprecate
 	DomainAuditOperationTypeDelete
 	DomainAuditOperationTypeFailover
+	DomainAuditOperationTypeDeleteWorkflow
 )
 
 func (d DomainAuditOperationType) String() string {
@@ -312,6 +316,8 @@ func (d DomainAuditOperationType) String() string {
 		return "Deprecate"
 	case DomainAuditOperationTypeDelete:
 		return "Delete"
+	case DomainAuditOperationTypeDeleteWorkflow:
+		return "DeleteWorkflow"
 	default:
 		return "Invalid"
 	}
@@ -453,8 +459,25 @@ type (
 		CronOverlapPolicy types.CronOverlapPolicy
 		ExpirationSeconds int32 // TODO: is this field useful?
 		CronSchedule      string
+		// retry policy extensions, see types.RetryPolicy
+		RetryJitterCoefficient    float64
+		RetryableErrors           []string
+		RetryErrorReasonOverrides []*types.RetryPolicyOverride
 
 		ActiveClusterSelectionPolicy *types.ActiveClusterSelectionPolicy
+		// Paused is set while the dispatch of the decision and activity tasks and the user timers is paused
+		Paused bool
+		// CompletedUpdates are the outcomes of the most recently completed updates, oldest first
+		CompletedUpdates []*CompletedUpdate
+	}
+
+	// CompletedUpdate is the outcome of a workflow update recorded by its completed update marker
+	CompletedUpdate struct {
+		UpdateID string
+		Result   []byte
+		// FailureReason is set when the update handler failed
+		FailureReason  *string
+		FailureDetails []byte
 	}
 
 	// ExecutionStats is the statistics about workflow execution
@@ -674,6 +697,16 @@ type (
 		LastFailureDetails       []byte
 		LastFailureCategory      types.FailureCategory
 		LastRetryIntervalSeconds int32
+		// retry policy extensions, see types.RetryPolicy
+		RetryJitterCoefficient    float64
+		RetryableErrors           []string
+		RetryErrorReasonOverrides []*types.RetryPolicyOverride
+		// Priority requested by the schedule decision, it takes precedence over the workflow priority
+		Priority int32
+		// FairnessKey requested by the schedule decision, it takes precedence over the workflow fairness key
+		FairnessKey string
+		// Paused is set while no attempt of the activity is dispatched and no retry is scheduled
+		Paused bool
 		// Not written to database - This is used only for deduping heartbeat timer creation
 		LastHeartbeatTimeoutVisibilityInSeconds int64
 	}
@@ -1534,7 +1567,7 @@ type (
 
 	// AppendHistoryNodesResponse is a response to AppendHistoryNodesRequest
 	AppendHistoryNodesResponse struct {
-		// The data blob that was persisted to database
+		// The data blob that was persisted to database, before its payloads are encoded by the payload codec
 		DataBlob DataBlob
 	}
 
@@ -1639,6 +1672,43 @@ type (
 		DomainName string
 	}
 
+	// ReencodeHistoryBranchRequest is used to re-encode the history nodes of a branch
+	ReencodeHistoryBranchRequest struct {
+		// branch to be re-encoded, only the nodes owned by this branch are rewritten
+		BranchToken []byte
+		// The shard of the history branch data
+		ShardID *int
+		// DomainName to select the codec settings and to generate metrics for Domain Cost Attribution
+		DomainName string
+	}
+
+	// ReencodeHistoryBranchResponse is the response to ReencodeHistoryBranchRequest
+	ReencodeHistoryBranchResponse struct {
+		// number of history nodes which have been rewritten
+		ReencodedNodeCount int
+	}
+
+	// DeleteOrphanedHistoryPayloadsRequest is used to delete the offloaded payloads which no history node reads anymore
+	DeleteOrphanedHistoryPayloadsRequest struct {
+		// only the payloads offloaded before this time are considered, the node referencing a newer payload
+		// may not have been persisted yet
+		OffloadedBefore time.Time
+		// maximum number of offloaded payloads to check
+		PageSize int
+		// Pagination token
+		NextPageToken []byte
+	}
+
+	// DeleteOrphanedHistoryPayloadsResponse is the response to DeleteOrphanedHistoryPayloadsRequest
+	DeleteOrphanedHistoryPayloadsResponse struct {
+		// number of offloaded payloads which have been checked
+		ScannedCount int
+		// number of orphaned payloads which have been deleted
+		DeletedCount int
+		// Pagination token, empty if all the offloaded payloads have been checked
+		NextPageToken []byte
+	}
+
 	// GetHistoryTreeRequest is used to retrieve branch info of a history tree
 	GetHistoryTreeRequest struct {
 		// A UUID of a tree
@@ -1811,6 +1881,11 @@ type (
 		GetHistoryTree(ctx context.Context, request *GetHistoryTreeRequest) (*GetHistoryTreeResponse, error)
 		// GetAllHistoryTreeBranches returns all branches of all trees
 		GetAllHistoryTreeBranches(ctx context.Context, request *GetAllHistoryTreeBranchesRequest) (*GetAllHistoryTreeBranchesResponse, error)
+		// ReencodeHistoryBranch rewrites the history nodes of a branch whose payloads are not encoded with the current codec settings
+		// NOTE: this API must only be used for branches which are no longer appended to
+		ReencodeHistoryBranch(ctx context.Context, request *ReencodeHistoryBranchRequest) (*ReencodeHistoryBranchResponse, error)
+		// DeleteOrphanedHistoryPayloads deletes a page of the offloaded payloads which no history node reads anymore
+		DeleteOrphanedHistoryPayloads(ctx context.Context, request *DeleteOrphanedHistoryPayloadsRequest) (*DeleteOrphanedHistoryPayloadsResponse, error)
 	}
 
 	// DomainManager is used to manage metadata CRUD for domain entities
@@ -2174,6 +2249,12 @@ func (t *TransferTaskInfo) ToTask() (Task, error) {
 			TaskData:           taskData,
 			TaskList:           t.TaskList,
 		}, nil
+	case TransferTaskTypeDeleteWorkflowExecution:
+		return &DeleteWorkflowExecutionTask{
+			WorkflowIdentifier: workflowIdentifier,
+			TaskData:           taskData,
+			TaskList:           t.TaskList,
+		}, nil
 	case TransferTaskTypeRecordChildExecutionCompleted:
 		targetRunID := t.TargetRunID
 		if t.TargetRunID == TransferTaskTransferTargetRunID {
@@ -1064,6 +1064,21 @@ func (mr *MockHistoryManagerMockRecorder) DeleteHistoryBranch(ctx, request any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteHistoryBranch", reflect.TypeOf((*MockHistoryManager)(nil).DeleteHistoryBranch), ctx, request)
 }
 
+// DeleteOrphanedHistoryPayloads mocks base method.
+func (m *MockHistoryManager) DeleteOrphanedHistoryPayloads(ctx context.Context, request *DeleteOrphanedHistoryPayloadsRequest) (*DeleteOrphanedHistoryPayloadsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteOrphanedHistoryPayloads", ctx, request)
+	ret0, _ := ret[0].(*DeleteOrphanedHistoryPayloadsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteOrphanedHistoryPayloads indicates an expected call of DeleteOrphanedHistoryPayloads.
+func (mr *MockHistoryManagerMockRecorder) DeleteOrphanedHistoryPayloads(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOrphanedHistoryPayloads", reflect.TypeOf((*MockHistoryManager)(nil).DeleteOrphanedHistoryPayloads), ctx, request)
+}
+
 // ForkHistoryBranch mocks base method.
 func (m *MockHistoryManager) ForkHistoryBranch(ctx context.Context, request *ForkHistoryBranchRequest) (*ForkHistoryBranchResponse, error) {
 	m.ctrl.T.Helper()
@@ -1168,6 +1183,21 @@ func (mr *MockHistoryManagerMockRecorder) ReadRawHistoryBranch(ctx, request any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadRawHistoryBranch", reflect.TypeOf((*MockHistoryManager)(nil).ReadRawHistoryBranch), ctx, request)
 }
 
+// ReencodeHistoryBranch mocks base method.
+func (m *MockHistoryManager) ReencodeHistoryBranch(ctx context.Context, request *ReencodeHistoryBranchRequest) (*ReencodeHistoryBranchResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReencodeHistoryBranch", ctx, request)
+	ret0, _ := ret[0].(*ReencodeHistoryBranchResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReencodeHistoryBranch indicates an expected call of ReencodeHistoryBranch.
+func (mr *MockHistoryManagerMockRecorder) ReencodeHistoryBranch(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReencodeHistoryBranch", reflect.TypeOf((*MockHistoryManager)(nil).ReencodeHistoryBranch), ctx, request)
+}
+
 // MockDomainManager is a mock of DomainManager interface.
 type MockDomainManager struct {
 	ctrl     *gomock.Controller
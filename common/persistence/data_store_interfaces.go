@@ -207,6 +207,7 @@ type (
 		ListWorkflowExecutions(ctx context.Context, request *ListWorkflowExecutionsByQueryRequest) (*InternalListWorkflowExecutionsResponse, error)
 		ScanWorkflowExecutions(ctx context.Context, request *ListWorkflowExecutionsByQueryRequest) (*InternalListWorkflowExecutionsResponse, error)
 		CountWorkflowExecutions(ctx context.Context, request *CountWorkflowExecutionsRequest) (*CountWorkflowExecutionsResponse, error)
+		AggregateWorkflowExecutions(ctx context.Context, request *AggregateWorkflowExecutionsRequest) (*AggregateWorkflowExecutionsResponse, error)
 		DeleteUninitializedWorkflowExecution(ctx context.Context, request *VisibilityDeleteWorkflowExecutionRequest) error
 	}
 
@@ -445,7 +446,13 @@ type (
 		SearchAttributes   map[string][]byte
 		PartitionConfig    map[string]string
 
+		RetryJitterCoefficient    float64
+		RetryableErrors           []string
+		RetryErrorReasonOverrides []*types.RetryPolicyOverride
+
 		ActiveClusterSelectionPolicy *DataBlob
+		Paused                       bool
+		CompletedUpdates             []*CompletedUpdate
 
 		// attributes which are not related to mutable state at all
 		HistorySize int64
@@ -511,6 +518,13 @@ type (
 		LastFailureDetails       []byte
 		LastFailureCategory      types.FailureCategory
 		LastRetryIntervalSeconds int32
+		Priority                 int32
+		FairnessKey              string
+		Paused                   bool
+
+		RetryJitterCoefficient    float64
+		RetryableErrors           []string
+		RetryErrorReasonOverrides []*types.RetryPolicyOverride
 		// Not written to database - This is used only for deduping heartbeat timer creation
 		LastHeartbeatTimeoutVisibilityInSeconds int64
 	}
@@ -653,6 +667,8 @@ type (
 		Events *DataBlob
 		// Requested TransactionID for conditional update
 		TransactionID int64
+		// True to replace the node with the same NodeID and TransactionID instead of failing if it already exists
+		Overwrite bool
 		// Used in sharded data stores to identify which shard to use
 		ShardID int
 
@@ -1326,6 +1342,32 @@ func (t *InternalReplicationTaskInfo) ToTask() (Task, error) {
 			},
 			DomainID: t.DomainID,
 		}, nil
+	case ReplicationTaskTypeDeleteWorkflowExecution:
+		return &DeleteWorkflowExecutionReplicationTask{
+			WorkflowIdentifier: WorkflowIdentifier{
+				DomainID:   t.DomainID,
+				WorkflowID: t.WorkflowID,
+				RunID:      t.RunID,
+			},
+			TaskData: TaskData{
+				Version:             t.Version,
+				TaskID:              t.TaskID,
+				VisibilityTimestamp: t.CreationTime,
+			},
+		}, nil
+	case ReplicationTaskTypeUpsertWorkflowSearchAttributes:
+		return &UpsertWorkflowSearchAttributesReplicationTask{
+			WorkflowIdentifier: WorkflowIdentifier{
+				DomainID:   t.DomainID,
+				WorkflowID: t.WorkflowID,
+				RunID:      t.RunID,
+			},
+			TaskData: TaskData{
+				Version:             t.Version,
+				TaskID:              t.TaskID,
+				VisibilityTimestamp: t.CreationTime,
+			},
+		}, nil
 	default:
 		return nil, fmt.Errorf("unknown task type: %d", t.TaskType)
 	}
@@ -109,7 +109,8 @@ func (m *domainAuditManagerImpl) CreateDomainAuditLog(
 	// To support non-nullable columns in SQL databases we serialize an empty GetDomainResponse{} if nil
 	stateAfter := request.StateAfter
 	if stateAfter == nil {
-		if request.OperationType != DomainAuditOperationTypeDelete {
+		// workflow deletions don't change the domain, so they are recorded without a domain state
+		if request.OperationType != DomainAuditOperationTypeDelete && request.OperationType != DomainAuditOperationTypeDeleteWorkflow {
 			m.logger.Warn("Domain has been updated to an empty state, this could be a bug", tag.WorkflowDomainID(request.DomainID), tag.DomainAuditOperationType(request.OperationType))
 		}
 		stateAfter = &GetDomainResponse{}
@@ -363,6 +363,27 @@ func (p *visibilityMetricsClient) CountWorkflowExecutions(
 	return response, err
 }
 
+func (p *visibilityMetricsClient) AggregateWorkflowExecutions(
+	ctx context.Context,
+	request *p.AggregateWorkflowExecutionsRequest,
+) (*p.AggregateWorkflowExecutionsResponse, error) {
+
+	scopeWithDomainTag := p.metricClient.Scope(metrics.ElasticsearchAggregateWorkflowExecutionsScope, metrics.DomainTag(request.Domain))
+	scopeWithDomainTag.IncCounter(metrics.ElasticsearchRequestsPerDomain)
+	before := time.Now()
+
+	response, err := p.persistence.AggregateWorkflowExecutions(ctx, request)
+	duration := time.Since(before)
+	scopeWithDomainTag.RecordTimer(metrics.ElasticsearchLatencyPerDomain, duration)
+	scopeWithDomainTag.ExponentialHistogram(metrics.ElasticsearchLatencyPerDomainHistogram, duration)
+
+	if err != nil {
+		p.updateErrorMetric(scopeWithDomainTag, metrics.ElasticsearchAggregateWorkflowExecutionsScope, err)
+	}
+
+	return response, err
+}
+
 func (p *visibilityMetricsClient) DeleteWorkflowExecution(
 	ctx context.Context,
 	request *p.VisibilityDeleteWorkflowExecutionRequest,
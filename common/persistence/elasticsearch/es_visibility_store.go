@@ -467,7 +467,7 @@ func (v *esVisibilityStore) ScanWorkflowExecutions(
 	}
 
 	var queryDSL string
-	if len(token.ScrollID) == 0 { // first call
+	if len(token.ScrollID) == 0 { // scroll pages don't need the query, point in time pages run it again
 		queryDSL, err = getESQueryDSLForScan(request)
 		if err != nil {
 			return nil, &types.BadRequestError{Message: fmt.Sprintf("Error when parse query: %v", err)}
@@ -512,6 +512,32 @@ func (v *esVisibilityStore) CountWorkflowExecutions(
 	return response, nil
 }
 
+func (v *esVisibilityStore) AggregateWorkflowExecutions(
+	ctx context.Context,
+	request *p.AggregateWorkflowExecutionsRequest,
+) (*p.AggregateWorkflowExecutionsResponse, error) {
+
+	queryDSL, err := getESQueryDSLForAggregate(request)
+	if err != nil {
+		return nil, &types.BadRequestError{Message: fmt.Sprintf("Error when parse query: %v", err)}
+	}
+
+	resp, err := v.esClient.SearchRaw(ctx, v.index, queryDSL)
+	if err != nil {
+		return nil, &types.InternalServiceError{
+			Message: fmt.Sprintf("AggregateWorkflowExecutions failed. Error: %v", err),
+		}
+	}
+
+	groups, err := getGroupsFromAggregation(resp.Aggregations[groupByAggregationName])
+	if err != nil {
+		return nil, &types.InternalServiceError{
+			Message: fmt.Sprintf("AggregateWorkflowExecutions failed to parse aggregation. Error: %v", err),
+		}
+	}
+	return &p.AggregateWorkflowExecutionsResponse{Groups: groups}, nil
+}
+
 const (
 	jsonMissingCloseTime     = `{"missing":{"field":"CloseTime"}}`
 	jsonRangeOnExecutionTime = `{"range":{"ExecutionTime":`
@@ -523,6 +549,9 @@ const (
 	dslFieldSearchAfter = "search_after"
 	dslFieldFrom        = "from"
 	dslFieldSize        = "size"
+	dslFieldAggs        = "aggs"
+
+	groupByAggregationName = "groupby"
 
 	defaultDateTimeFormat = time.RFC3339 // used for converting UnixNano to string like 2018-02-15T16:16:36-08:00
 )
@@ -573,6 +602,73 @@ func getESQueryDSLForCount(request *p.CountWorkflowExecutionsRequest) (string, e
 	return dsl.String(), nil
 }
 
+func getESQueryDSLForAggregate(request *p.AggregateWorkflowExecutionsRequest) (string, error) {
+	sql := getSQLFromCountRequest(&p.CountWorkflowExecutionsRequest{Query: request.Query})
+	dsl, err := getCustomizedDSLFromSQL(sql, request.DomainUUID)
+	if err != nil {
+		return "", err
+	}
+
+	// only the buckets are needed, not the matching documents
+	dsl.Del(dslFieldFrom)
+	dsl.Del(dslFieldSort)
+	dsl.Set(dslFieldSize, fastjson.MustParse("0"))
+
+	field := request.GroupBy
+	if !definition.IsSystemIndexedKey(field) {
+		field = definition.Attr + "." + field
+	}
+	aggs, err := json.Marshal(map[string]interface{}{
+		groupByAggregationName: map[string]interface{}{
+			"terms": map[string]interface{}{
+				"field": field,
+				"size":  request.MaxGroups,
+			},
+		},
+	})
+	if err != nil {
+		return "", err
+	}
+	dsl.Set(dslFieldAggs, fastjson.MustParseBytes(aggs))
+
+	return dsl.String(), nil
+}
+
+// getGroupsFromAggregation converts the buckets of a terms aggregation, which are already ordered by count
+func getGroupsFromAggregation(aggregation json.RawMessage) ([]*types.WorkflowExecutionGroup, error) {
+	if len(aggregation) == 0 {
+		return nil, errors.New("aggregation not found in response")
+	}
+
+	var terms struct {
+		Buckets []struct {
+			Key         json.RawMessage `json:"key"`
+			KeyAsString *string         `json:"key_as_string"`
+			DocCount    int64           `json:"doc_count"`
+		} `json:"buckets"`
+	}
+	if err := json.Unmarshal(aggregation, &terms); err != nil {
+		return nil, err
+	}
+
+	groups := make([]*types.WorkflowExecutionGroup, 0, len(terms.Buckets))
+	for _, bucket := range terms.Buckets {
+		// bool fields have numeric keys and carry their value in key_as_string
+		key := string(bucket.Key)
+		var keywordKey string
+		if bucket.KeyAsString != nil {
+			key = *bucket.KeyAsString
+		} else if err := json.Unmarshal(bucket.Key, &keywordKey); err == nil {
+			key = keywordKey
+		}
+		groups = append(groups, &types.WorkflowExecutionGroup{
+			Key:   key,
+			Count: bucket.DocCount,
+		})
+	}
+	return groups, nil
+}
+
 func (v *esVisibilityStore) getESQueryDSL(request *p.ListWorkflowExecutionsByQueryRequest, token *es.ElasticVisibilityPageToken) (string, error) {
 	sql := getSQLFromListRequest(request)
 	return v.processedDSLfromSQL(sql, request.DomainUUID, token)
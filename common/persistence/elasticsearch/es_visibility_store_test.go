@@ -780,6 +780,89 @@ func (s *ESVisibilitySuite) TestGetESQueryDSLForCount() {
 
 }
 
+func (s *ESVisibilitySuite) TestGetESQueryDSLForAggregate() {
+	request := &p.AggregateWorkflowExecutionsRequest{
+		DomainUUID: testDomainID,
+		GroupBy:    "WorkflowType",
+		MaxGroups:  10,
+	}
+
+	dsl, err := getESQueryDSLForAggregate(request)
+	s.Nil(err)
+	s.Equal(`{"query":{"bool":{"must":[{"match_phrase":{"DomainID":{"query":"bfd5c907-f899-4baf-a7b2-2ab85e623ebd"}}},{"bool":{"must":[{"match_all":{}}]}}]}},"size":0,"aggs":{"groupby":{"terms":{"field":"WorkflowType","size":10}}}}`, dsl)
+
+	request.Query = `WorkflowID = 'wid' order by StartTime desc`
+	request.GroupBy = "CustomKeywordField"
+	dsl, err = getESQueryDSLForAggregate(request)
+	s.Nil(err)
+	s.Equal(`{"query":{"bool":{"must":[{"match_phrase":{"DomainID":{"query":"bfd5c907-f899-4baf-a7b2-2ab85e623ebd"}}},{"bool":{"must":[{"match_phrase":{"WorkflowID":{"query":"wid"}}}]}}]}},"size":0,"aggs":{"groupby":{"terms":{"field":"Attr.CustomKeywordField","size":10}}}}`, dsl)
+
+	request.Query = `invalid query`
+	_, err = getESQueryDSLForAggregate(request)
+	s.Error(err)
+}
+
+func (s *ESVisibilitySuite) TestGetGroupsFromAggregation() {
+	groups, err := getGroupsFromAggregation(json.RawMessage(`{"doc_count_error_upper_bound":0,"sum_other_doc_count":3,"buckets":[{"key":"wf-type-1","doc_count":10},{"key":"wf-type-2","doc_count":2}]}`))
+	s.NoError(err)
+	s.Equal([]*types.WorkflowExecutionGroup{{Key: "wf-type-1", Count: 10}, {Key: "wf-type-2", Count: 2}}, groups)
+
+	groups, err = getGroupsFromAggregation(json.RawMessage(`{"buckets":[{"key":2,"doc_count":7}]}`))
+	s.NoError(err)
+	s.Equal([]*types.WorkflowExecutionGroup{{Key: "2", Count: 7}}, groups)
+
+	groups, err = getGroupsFromAggregation(json.RawMessage(`{"buckets":[{"key":1,"key_as_string":"true","doc_count":4}]}`))
+	s.NoError(err)
+	s.Equal([]*types.WorkflowExecutionGroup{{Key: "true", Count: 4}}, groups)
+
+	_, err = getGroupsFromAggregation(nil)
+	s.Error(err)
+}
+
+func (s *ESVisibilitySuite) TestAggregateWorkflowExecutions() {
+	s.mockESClient.On("SearchRaw", mock.Anything, testIndex, mock.MatchedBy(func(input string) bool {
+		s.True(strings.Contains(input, `{"match_phrase":{"CloseStatus":{"query":"5"}}}`))
+		s.True(strings.Contains(input, `"terms":{"field":"WorkflowType","size":10}`))
+		return true
+	})).Return(&es.RawResponse{
+		Aggregations: map[string]json.RawMessage{
+			"groupby": json.RawMessage(`{"buckets":[{"key":"wf-type","doc_count":3}]}`),
+		},
+	}, nil).Once()
+
+	request := &p.AggregateWorkflowExecutionsRequest{
+		DomainUUID: testDomainID,
+		Domain:     testDomain,
+		Query:      `CloseStatus = 5`,
+		GroupBy:    "WorkflowType",
+		MaxGroups:  10,
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), testContextTimeout)
+	defer cancel()
+
+	resp, err := s.visibilityStore.AggregateWorkflowExecutions(ctx, request)
+	s.NoError(err)
+	s.Equal([]*types.WorkflowExecutionGroup{{Key: "wf-type", Count: 3}}, resp.Groups)
+
+	// test internal error
+	s.mockESClient.On("SearchRaw", mock.Anything, testIndex, mock.Anything).Return(nil, errTestESSearch).Once()
+
+	_, err = s.visibilityStore.AggregateWorkflowExecutions(ctx, request)
+	s.Error(err)
+	_, ok := err.(*types.InternalServiceError)
+	s.True(ok)
+	s.True(strings.Contains(err.Error(), "AggregateWorkflowExecutions failed"))
+
+	// test bad request
+	request.Query = `invalid query`
+	_, err = s.visibilityStore.AggregateWorkflowExecutions(ctx, request)
+	s.Error(err)
+	_, ok = err.(*types.BadRequestError)
+	s.True(ok)
+	s.True(strings.Contains(err.Error(), "Error when parse query"))
+}
+
 func (s *ESVisibilitySuite) TestAddDomainToQuery() {
 	dsl := fastjson.MustParse(`{}`)
 	dslStr := dsl.String()
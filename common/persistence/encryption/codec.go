@@ -0,0 +1,167 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package encryption
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
+	"github.com/uber/cadence/common/persistence"
+)
+
+// Encrypted payloads are enveloped as:
+//
+//	magic (4 bytes) | version (1 byte) | key ID length (1 byte) | key ID | nonce (12 bytes) | AES-GCM ciphertext
+//
+// The magic starts with persistence.ReservedPayloadPrefix. The persistence layer escapes the payloads which start
+// with it before they are encoded, so the envelope is marked outside of the bytes a user can control.
+const (
+	envelopeVersion = 1
+	maxKeyIDLength  = 255
+	nonceSize       = 12
+)
+
+var envelopeMagic = append(append([]byte{}, persistence.ReservedPayloadPrefix...), "EN"...)
+
+var errMalformedEnvelope = errors.New("malformed encrypted payload")
+
+type codec struct {
+	keyring Keyring
+	enabled dynamicproperties.BoolPropertyFnWithDomainFilter
+	keyID   dynamicproperties.StringPropertyFnWithDomainFilter
+}
+
+// NewPayloadCodec returns a codec encrypting the payloads of the domains for which enabled returns true,
+// with the key returned by keyID, or with the active key of the keyring if keyID returns an empty string
+func NewPayloadCodec(
+	keyring Keyring,
+	enabled dynamicproperties.BoolPropertyFnWithDomainFilter,
+	keyID dynamicproperties.StringPropertyFnWithDomainFilter,
+) persistence.PayloadCodec {
+	return &codec{
+		keyring: keyring,
+		enabled: enabled,
+		keyID:   keyID,
+	}
+}
+
+func (c *codec) Encode(domainName string, payload []byte) ([]byte, error) {
+	if len(payload) == 0 || !c.enabled(domainName) {
+		return payload, nil
+	}
+	keyID := c.targetKeyID(domainName)
+	key, ok := c.keyring.Key(keyID)
+	if !ok {
+		return nil, fmt.Errorf("encryption key %q of domain %v is not in the keyring", keyID, domainName)
+	}
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+
+	header := make([]byte, 0, len(envelopeMagic)+2+len(keyID)+nonceSize)
+	header = append(header, envelopeMagic...)
+	header = append(header, envelopeVersion, byte(len(keyID)))
+	header = append(header, keyID...)
+	nonce := make([]byte, nonceSize)
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+	header = append(header, nonce...)
+	// the header is authenticated as additional data, so that the key ID can't be tampered with
+	return aead.Seal(header, nonce, payload, header[:len(header)-nonceSize]), nil
+}
+
+func (c *codec) Decode(payload []byte) ([]byte, error) {
+	if !isEnveloped(payload) {
+		return payload, nil
+	}
+	keyID, nonce, ciphertext, err := parseEnvelope(payload)
+	if err != nil {
+		return nil, err
+	}
+	key, ok := c.keyring.Key(keyID)
+	if !ok {
+		return nil, persistence.ErrPayloadKeyNotFound
+	}
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+	headerLength := len(payload) - len(ciphertext) - nonceSize
+	plaintext, err := aead.Open(nil, nonce, ciphertext, payload[:headerLength])
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt payload with key %q: %w", keyID, err)
+	}
+	return plaintext, nil
+}
+
+func (c *codec) IsCurrent(domainName string, payload []byte) bool {
+	if !c.enabled(domainName) {
+		return !isEnveloped(payload)
+	}
+	if len(payload) == 0 {
+		return true
+	}
+	if !isEnveloped(payload) {
+		return false
+	}
+	keyID, _, _, err := parseEnvelope(payload)
+	return err == nil && keyID == c.targetKeyID(domainName)
+}
+
+func (c *codec) targetKeyID(domainName string) string {
+	if keyID := c.keyID(domainName); keyID != "" {
+		return keyID
+	}
+	return c.keyring.ActiveKeyID()
+}
+
+func isEnveloped(payload []byte) bool {
+	return bytes.HasPrefix(payload, envelopeMagic)
+}
+
+func parseEnvelope(payload []byte) (keyID string, nonce []byte, ciphertext []byte, err error) {
+	rest := payload[len(envelopeMagic):]
+	if len(rest) < 2 || rest[0] != envelopeVersion {
+		return "", nil, nil, errMalformedEnvelope
+	}
+	keyIDLength := int(rest[1])
+	rest = rest[2:]
+	if len(rest) < keyIDLength+nonceSize {
+		return "", nil, nil, errMalformedEnvelope
+	}
+	return string(rest[:keyIDLength]), rest[keyIDLength : keyIDLength+nonceSize], rest[keyIDLength+nonceSize:], nil
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCMWithNonceSize(block, nonceSize)
+}
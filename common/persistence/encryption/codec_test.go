@@ -0,0 +1,157 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package encryption
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
+	"github.com/uber/cadence/common/persistence"
+)
+
+const (
+	testEncryptedDomain = "encrypted-domain"
+	testPlainDomain     = "plain-domain"
+)
+
+func newTestKeyring(t *testing.T, activeKeyID string, ids ...string) Keyring {
+	keys := make(map[string][]byte, len(ids))
+	for _, id := range ids {
+		// the key only depends on its ID, so that keyrings built by different tests share keys
+		keys[id] = bytes.Repeat([]byte{id[len(id)-1]}, KeySize)
+	}
+	keyring, err := NewKeyring(activeKeyID, keys)
+	require.NoError(t, err)
+	return keyring
+}
+
+func newTestCodec(keyring Keyring, keyID string) persistence.PayloadCodec {
+	return NewPayloadCodec(
+		keyring,
+		func(domain string) bool { return domain == testEncryptedDomain },
+		dynamicproperties.GetStringPropertyFnFilteredByDomain(keyID),
+	)
+}
+
+func TestCodec_RoundTrip(t *testing.T) {
+	codec := newTestCodec(newTestKeyring(t, "key-1", "key-1"), "")
+	payload := []byte(`{"card":"4111111111111111"}`)
+
+	encoded, err := codec.Encode(testEncryptedDomain, payload)
+	require.NoError(t, err)
+	assert.NotContains(t, string(encoded), "4111111111111111")
+	assert.True(t, codec.IsCurrent(testEncryptedDomain, encoded))
+
+	decoded, err := codec.Decode(encoded)
+	require.NoError(t, err)
+	assert.Equal(t, payload, decoded)
+
+	// payloads which look like an envelope are encrypted like any other payload
+	wrapped, err := codec.Encode(testEncryptedDomain, encoded)
+	require.NoError(t, err)
+	assert.NotEqual(t, encoded, wrapped)
+	decoded, err = codec.Decode(wrapped)
+	require.NoError(t, err)
+	assert.Equal(t, encoded, decoded)
+}
+
+func TestCodec_DomainNotEnabled(t *testing.T) {
+	codec := newTestCodec(newTestKeyring(t, "key-1", "key-1"), "")
+	payload := []byte(`"input"`)
+
+	encoded, err := codec.Encode(testPlainDomain, payload)
+	require.NoError(t, err)
+	assert.Equal(t, payload, encoded)
+	assert.True(t, codec.IsCurrent(testPlainDomain, encoded))
+	assert.False(t, codec.IsCurrent(testEncryptedDomain, encoded))
+
+	decoded, err := codec.Decode(encoded)
+	require.NoError(t, err)
+	assert.Equal(t, payload, decoded)
+
+	// payloads which were encrypted before encryption was disabled are still readable
+	encrypted, err := codec.Encode(testEncryptedDomain, payload)
+	require.NoError(t, err)
+	assert.False(t, codec.IsCurrent(testPlainDomain, encrypted))
+	decoded, err = codec.Decode(encrypted)
+	require.NoError(t, err)
+	assert.Equal(t, payload, decoded)
+}
+
+func TestCodec_EmptyPayload(t *testing.T) {
+	codec := newTestCodec(newTestKeyring(t, "key-1", "key-1"), "")
+
+	encoded, err := codec.Encode(testEncryptedDomain, nil)
+	require.NoError(t, err)
+	assert.Nil(t, encoded)
+	assert.True(t, codec.IsCurrent(testEncryptedDomain, nil))
+}
+
+func TestCodec_KeyRotation(t *testing.T) {
+	payload := []byte(`"result"`)
+	oldCodec := newTestCodec(newTestKeyring(t, "key-1", "key-1", "key-2"), "")
+	encoded, err := oldCodec.Encode(testEncryptedDomain, payload)
+	require.NoError(t, err)
+
+	// the domain is switched to the new key while the old key stays in the keyring
+	newCodec := newTestCodec(newTestKeyring(t, "key-1", "key-1", "key-2"), "key-2")
+	assert.False(t, newCodec.IsCurrent(testEncryptedDomain, encoded))
+	decoded, err := newCodec.Decode(encoded)
+	require.NoError(t, err)
+	assert.Equal(t, payload, decoded)
+
+	reencoded, err := newCodec.Encode(testEncryptedDomain, decoded)
+	require.NoError(t, err)
+	assert.True(t, newCodec.IsCurrent(testEncryptedDomain, reencoded))
+
+	// once the old key is removed, payloads encrypted with it can no longer be read
+	retiredCodec := newTestCodec(newTestKeyring(t, "key-2", "key-2"), "")
+	_, err = retiredCodec.Decode(encoded)
+	assert.ErrorIs(t, err, persistence.ErrPayloadKeyNotFound)
+	decoded, err = retiredCodec.Decode(reencoded)
+	require.NoError(t, err)
+	assert.Equal(t, payload, decoded)
+}
+
+func TestCodec_UnknownTargetKey(t *testing.T) {
+	codec := newTestCodec(newTestKeyring(t, "key-1", "key-1"), "key-3")
+
+	_, err := codec.Encode(testEncryptedDomain, []byte(`"input"`))
+	assert.ErrorContains(t, err, "key-3")
+}
+
+func TestCodec_TamperedPayload(t *testing.T) {
+	codec := newTestCodec(newTestKeyring(t, "key-1", "key-1"), "")
+	encoded, err := codec.Encode(testEncryptedDomain, []byte(`"input"`))
+	require.NoError(t, err)
+
+	tampered := append([]byte{}, encoded...)
+	tampered[len(tampered)-1] ^= 0xff
+	_, err = codec.Decode(tampered)
+	assert.Error(t, err)
+
+	_, err = codec.Decode(encoded[:len(envelopeMagic)+3])
+	assert.Equal(t, errMalformedEnvelope, err)
+}
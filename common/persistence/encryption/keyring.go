@@ -0,0 +1,119 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package encryption
+
+import (
+	"encoding/base64"
+	"fmt"
+	"os"
+
+	"gopkg.in/yaml.v2"
+)
+
+// KeySize is the size of the keys in bytes, payloads are encrypted with AES-256
+const KeySize = 32
+
+type (
+	// Keyring holds the keys used to encrypt and decrypt payloads.
+	// Keys which were used to encrypt persisted payloads must stay in the keyring until those payloads are re-encrypted,
+	// payloads encrypted with a key which has been removed can no longer be read.
+	Keyring interface {
+		// ActiveKeyID returns the ID of the key used to encrypt new payloads
+		ActiveKeyID() string
+		// Key returns the key with the given ID
+		Key(id string) ([]byte, bool)
+	}
+
+	keyringFile struct {
+		ActiveKey string         `yaml:"activeKey"`
+		Keys      []keyringEntry `yaml:"keys"`
+	}
+
+	keyringEntry struct {
+		ID string `yaml:"id"`
+		// Key is the base64 encoded key
+		Key string `yaml:"key"`
+	}
+
+	staticKeyring struct {
+		activeKeyID string
+		keys        map[string][]byte
+	}
+)
+
+// NewKeyring returns a keyring holding the given keys
+func NewKeyring(activeKeyID string, keys map[string][]byte) (Keyring, error) {
+	for id, key := range keys {
+		if id == "" || len(id) > maxKeyIDLength {
+			return nil, fmt.Errorf("invalid key ID %q", id)
+		}
+		if len(key) != KeySize {
+			return nil, fmt.Errorf("key %q must be %d bytes, got %d", id, KeySize, len(key))
+		}
+	}
+	if _, ok := keys[activeKeyID]; !ok {
+		return nil, fmt.Errorf("active key %q is not in the keyring", activeKeyID)
+	}
+	return &staticKeyring{
+		activeKeyID: activeKeyID,
+		keys:        keys,
+	}, nil
+}
+
+// NewFileKeyring loads a keyring from a yaml file of the form:
+//
+//	activeKey: key-2
+//	keys:
+//	  - id: key-1
+//	    key: <base64 encoded 32 bytes key>
+//	  - id: key-2
+//	    key: <base64 encoded 32 bytes key>
+func NewFileKeyring(path string) (Keyring, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read keyring file: %w", err)
+	}
+	var file keyringFile
+	if err := yaml.UnmarshalStrict(data, &file); err != nil {
+		return nil, fmt.Errorf("failed to parse keyring file: %w", err)
+	}
+	keys := make(map[string][]byte, len(file.Keys))
+	for _, entry := range file.Keys {
+		if _, ok := keys[entry.ID]; ok {
+			return nil, fmt.Errorf("duplicate key %q in keyring file", entry.ID)
+		}
+		key, err := base64.StdEncoding.DecodeString(entry.Key)
+		if err != nil {
+			return nil, fmt.Errorf("key %q is not base64 encoded: %w", entry.ID, err)
+		}
+		keys[entry.ID] = key
+	}
+	return NewKeyring(file.ActiveKey, keys)
+}
+
+func (k *staticKeyring) ActiveKeyID() string {
+	return k.activeKeyID
+}
+
+func (k *staticKeyring) Key(id string) ([]byte, bool) {
+	key, ok := k.keys[id]
+	return key, ok
+}
@@ -0,0 +1,91 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package encryption
+
+import (
+	"bytes"
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewFileKeyring(t *testing.T) {
+	key1 := base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{1}, KeySize))
+	key2 := base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{2}, KeySize))
+
+	tests := map[string]struct {
+		content     string
+		expectedErr string
+	}{
+		"valid": {
+			content: "activeKey: key-2\nkeys:\n  - id: key-1\n    key: " + key1 + "\n  - id: key-2\n    key: " + key2 + "\n",
+		},
+		"active key missing": {
+			content:     "activeKey: key-3\nkeys:\n  - id: key-1\n    key: " + key1 + "\n",
+			expectedErr: `active key "key-3" is not in the keyring`,
+		},
+		"duplicate key": {
+			content:     "activeKey: key-1\nkeys:\n  - id: key-1\n    key: " + key1 + "\n  - id: key-1\n    key: " + key2 + "\n",
+			expectedErr: `duplicate key "key-1"`,
+		},
+		"invalid base64": {
+			content:     "activeKey: key-1\nkeys:\n  - id: key-1\n    key: not-base64\n",
+			expectedErr: `key "key-1" is not base64 encoded`,
+		},
+		"invalid key size": {
+			content:     "activeKey: key-1\nkeys:\n  - id: key-1\n    key: " + base64.StdEncoding.EncodeToString([]byte("short")) + "\n",
+			expectedErr: `key "key-1" must be 32 bytes, got 5`,
+		},
+		"unknown field": {
+			content:     "active: key-1\n",
+			expectedErr: "failed to parse keyring file",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "keyring.yaml")
+			require.NoError(t, os.WriteFile(path, []byte(test.content), 0600))
+
+			keyring, err := NewFileKeyring(path)
+			if test.expectedErr != "" {
+				assert.ErrorContains(t, err, test.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "key-2", keyring.ActiveKeyID())
+			key, ok := keyring.Key("key-1")
+			assert.True(t, ok)
+			assert.Equal(t, bytes.Repeat([]byte{1}, KeySize), key)
+			_, ok = keyring.Key("key-3")
+			assert.False(t, ok)
+		})
+	}
+}
+
+func TestNewFileKeyring_MissingFile(t *testing.T) {
+	_, err := NewFileKeyring(filepath.Join(t.TempDir(), "missing.yaml"))
+	assert.ErrorContains(t, err, "failed to read keyring file")
+}
@@ -37,6 +37,7 @@ type (
 	// executionManagerImpl implements ExecutionManager based on ExecutionStore, statsComputer and PayloadSerializer
 	executionManagerImpl struct {
 		serializer    PayloadSerializer
+		payloadCodec  PayloadCodec
 		persistence   ExecutionStore
 		statsComputer statsComputer
 		logger        log.Logger
@@ -47,15 +48,17 @@ type (
 
 var _ ExecutionManager = (*executionManagerImpl)(nil)
 
-// NewExecutionManagerImpl returns new ExecutionManager
+// NewExecutionManagerImpl returns new ExecutionManager, payloadCodec is optional and encodes the payloads of mutable state at rest
 func NewExecutionManagerImpl(
 	persistence ExecutionStore,
 	logger log.Logger,
 	serializer PayloadSerializer,
+	payloadCodec PayloadCodec,
 	dc *DynamicConfiguration,
 ) ExecutionManager {
 	return &executionManagerImpl{
 		serializer:    serializer,
+		payloadCodec:  payloadCodec,
 		persistence:   persistence,
 		statsComputer: statsComputer{},
 		logger:        logger,
@@ -117,6 +120,9 @@ func (m *executionManagerImpl) GetWorkflowExecution(
 		return nil, err
 	}
 	newResponse.State.VersionHistories = versionHistories
+	if err := m.decodeWorkflowMutableState(newResponse.State); err != nil {
+		return nil, err
+	}
 	newResponse.MutableStateStats = m.statsComputer.computeMutableStateStats(response)
 
 	if len(newResponse.State.Checksum.Value) == 0 {
@@ -201,6 +207,9 @@ func (m *executionManagerImpl) DeserializeExecutionInfo(
 		ExpirationTime:                     info.ExpirationTime,
 		MaximumAttempts:                    info.MaximumAttempts,
 		NonRetriableErrors:                 info.NonRetriableErrors,
+		RetryJitterCoefficient:             info.RetryJitterCoefficient,
+		RetryableErrors:                    info.RetryableErrors,
+		RetryErrorReasonOverrides:          info.RetryErrorReasonOverrides,
 		BranchToken:                        info.BranchToken,
 		CronSchedule:                       info.CronSchedule,
 		CronOverlapPolicy:                  info.CronOverlapPolicy,
@@ -210,6 +219,8 @@ func (m *executionManagerImpl) DeserializeExecutionInfo(
 		Memo:                               info.Memo,
 		PartitionConfig:                    info.PartitionConfig,
 		ActiveClusterSelectionPolicy:       activeClusterSelectionPolicy,
+		Paused:                             info.Paused,
+		CompletedUpdates:                   info.CompletedUpdates,
 	}
 	newStats := &ExecutionStats{
 		HistorySize: info.HistorySize,
@@ -326,11 +337,17 @@ func (m *executionManagerImpl) DeserializeActivityInfos(
 			ExpirationTime:                          v.ExpirationTime,
 			MaximumAttempts:                         v.MaximumAttempts,
 			NonRetriableErrors:                      v.NonRetriableErrors,
+			RetryJitterCoefficient:                  v.RetryJitterCoefficient,
+			RetryableErrors:                         v.RetryableErrors,
+			RetryErrorReasonOverrides:               v.RetryErrorReasonOverrides,
 			LastFailureReason:                       v.LastFailureReason,
 			LastWorkerIdentity:                      v.LastWorkerIdentity,
 			LastFailureDetails:                      v.LastFailureDetails,
 			LastFailureCategory:                     v.LastFailureCategory,
 			LastRetryIntervalSeconds:                v.LastRetryIntervalSeconds,
+			Priority:                                v.Priority,
+			FairnessKey:                             v.FairnessKey,
+			Paused:                                  v.Paused,
 			LastHeartbeatTimeoutVisibilityInSeconds: v.LastHeartbeatTimeoutVisibilityInSeconds,
 		}
 		newInfos[k] = a
@@ -343,13 +360,21 @@ func (m *executionManagerImpl) UpdateWorkflowExecution(
 	request *UpdateWorkflowExecutionRequest,
 ) (*UpdateWorkflowExecutionResponse, error) {
 
-	serializedWorkflowMutation, err := m.SerializeWorkflowMutation(&request.UpdateWorkflowMutation, request.Encoding)
+	encodedWorkflowMutation, err := m.encodeWorkflowMutation(request.DomainName, &request.UpdateWorkflowMutation)
+	if err != nil {
+		return nil, err
+	}
+	serializedWorkflowMutation, err := m.SerializeWorkflowMutation(encodedWorkflowMutation, request.Encoding)
 	if err != nil {
 		return nil, err
 	}
 	var serializedNewWorkflowSnapshot *InternalWorkflowSnapshot
 	if request.NewWorkflowSnapshot != nil {
-		serializedNewWorkflowSnapshot, err = m.SerializeWorkflowSnapshot(request.NewWorkflowSnapshot, request.Encoding)
+		encodedNewWorkflowSnapshot, err := m.encodeWorkflowSnapshot(request.DomainName, request.NewWorkflowSnapshot)
+		if err != nil {
+			return nil, err
+		}
+		serializedNewWorkflowSnapshot, err = m.SerializeWorkflowSnapshot(encodedNewWorkflowSnapshot, request.Encoding)
 		if err != nil {
 			return nil, err
 		}
@@ -459,11 +484,17 @@ func (m *executionManagerImpl) SerializeUpsertActivityInfos(
 			ExpirationTime:                          v.ExpirationTime,
 			MaximumAttempts:                         v.MaximumAttempts,
 			NonRetriableErrors:                      v.NonRetriableErrors,
+			RetryJitterCoefficient:                  v.RetryJitterCoefficient,
+			RetryableErrors:                         v.RetryableErrors,
+			RetryErrorReasonOverrides:               v.RetryErrorReasonOverrides,
 			LastFailureReason:                       v.LastFailureReason,
 			LastWorkerIdentity:                      v.LastWorkerIdentity,
 			LastFailureDetails:                      v.LastFailureDetails,
 			LastFailureCategory:                     v.LastFailureCategory,
 			LastRetryIntervalSeconds:                v.LastRetryIntervalSeconds,
+			Priority:                                v.Priority,
+			FairnessKey:                             v.FairnessKey,
+			Paused:                                  v.Paused,
 			LastHeartbeatTimeoutVisibilityInSeconds: v.LastHeartbeatTimeoutVisibilityInSeconds,
 		}
 		newInfos = append(newInfos, i)
@@ -547,6 +578,9 @@ func (m *executionManagerImpl) SerializeExecutionInfo(
 		ExpirationTime:                     info.ExpirationTime,
 		MaximumAttempts:                    info.MaximumAttempts,
 		NonRetriableErrors:                 info.NonRetriableErrors,
+		RetryJitterCoefficient:             info.RetryJitterCoefficient,
+		RetryableErrors:                    info.RetryableErrors,
+		RetryErrorReasonOverrides:          info.RetryErrorReasonOverrides,
 		BranchToken:                        info.BranchToken,
 		CronSchedule:                       info.CronSchedule,
 		ExpirationInterval:                 common.SecondsToDuration(int64(info.ExpirationSeconds)),
@@ -555,6 +589,8 @@ func (m *executionManagerImpl) SerializeExecutionInfo(
 		PartitionConfig:                    info.PartitionConfig,
 		CronOverlapPolicy:                  info.CronOverlapPolicy,
 		ActiveClusterSelectionPolicy:       activeClusterSelectionPolicy,
+		Paused:                             info.Paused,
+		CompletedUpdates:                   info.CompletedUpdates,
 
 		// attributes which are not related to mutable state
 		HistorySize: stats.HistorySize,
@@ -566,20 +602,32 @@ func (m *executionManagerImpl) ConflictResolveWorkflowExecution(
 	request *ConflictResolveWorkflowExecutionRequest,
 ) (*ConflictResolveWorkflowExecutionResponse, error) {
 
-	serializedResetWorkflowSnapshot, err := m.SerializeWorkflowSnapshot(&request.ResetWorkflowSnapshot, request.Encoding)
+	encodedResetWorkflowSnapshot, err := m.encodeWorkflowSnapshot(request.DomainName, &request.ResetWorkflowSnapshot)
+	if err != nil {
+		return nil, err
+	}
+	serializedResetWorkflowSnapshot, err := m.SerializeWorkflowSnapshot(encodedResetWorkflowSnapshot, request.Encoding)
 	if err != nil {
 		return nil, err
 	}
 	var serializedCurrentWorkflowMutation *InternalWorkflowMutation
 	if request.CurrentWorkflowMutation != nil {
-		serializedCurrentWorkflowMutation, err = m.SerializeWorkflowMutation(request.CurrentWorkflowMutation, request.Encoding)
+		encodedCurrentWorkflowMutation, err := m.encodeWorkflowMutation(request.DomainName, request.CurrentWorkflowMutation)
+		if err != nil {
+			return nil, err
+		}
+		serializedCurrentWorkflowMutation, err = m.SerializeWorkflowMutation(encodedCurrentWorkflowMutation, request.Encoding)
 		if err != nil {
 			return nil, err
 		}
 	}
 	var serializedNewWorkflowMutation *InternalWorkflowSnapshot
 	if request.NewWorkflowSnapshot != nil {
-		serializedNewWorkflowMutation, err = m.SerializeWorkflowSnapshot(request.NewWorkflowSnapshot, request.Encoding)
+		encodedNewWorkflowSnapshot, err := m.encodeWorkflowSnapshot(request.DomainName, request.NewWorkflowSnapshot)
+		if err != nil {
+			return nil, err
+		}
+		serializedNewWorkflowMutation, err = m.SerializeWorkflowSnapshot(encodedNewWorkflowSnapshot, request.Encoding)
 		if err != nil {
 			return nil, err
 		}
@@ -613,7 +661,11 @@ func (m *executionManagerImpl) CreateWorkflowExecution(
 	ctx context.Context,
 	request *CreateWorkflowExecutionRequest,
 ) (*CreateWorkflowExecutionResponse, error) {
-	serializedNewWorkflowSnapshot, err := m.SerializeWorkflowSnapshot(&request.NewWorkflowSnapshot, constants.EncodingType(m.dc.SerializationEncoding()))
+	encodedNewWorkflowSnapshot, err := m.encodeWorkflowSnapshot(request.DomainName, &request.NewWorkflowSnapshot)
+	if err != nil {
+		return nil, err
+	}
+	serializedNewWorkflowSnapshot, err := m.SerializeWorkflowSnapshot(encodedNewWorkflowSnapshot, constants.EncodingType(m.dc.SerializationEncoding()))
 	if err != nil {
 		return nil, err
 	}
@@ -642,6 +694,201 @@ func (m *executionManagerImpl) CreateWorkflowExecution(
 	return &CreateWorkflowExecutionResponse{MutableStateUpdateSessionStats: msuss}, nil
 }
 
+// encodeWorkflowMutation returns a copy of the mutation with the payloads it persists escaped and encoded with the
+// payload codec if it is configured, i.e. the memo, the cached events, the outcomes of completed updates, the heartbeat
+// and failure details of activities and the buffered events. The mutation is shared with the mutable state, so it is never modified.
+func (m *executionManagerImpl) encodeWorkflowMutation(domainName string, mutation *WorkflowMutation) (*WorkflowMutation, error) {
+	encoded := *mutation
+	var err error
+	if encoded.ExecutionInfo, err = m.encodeExecutionInfo(domainName, mutation.ExecutionInfo); err != nil {
+		return nil, err
+	}
+	if encoded.UpsertActivityInfos, err = m.encodeActivityInfos(domainName, mutation.UpsertActivityInfos); err != nil {
+		return nil, err
+	}
+	if encoded.UpsertChildExecutionInfos, err = m.encodeChildExecutionInfos(domainName, mutation.UpsertChildExecutionInfos); err != nil {
+		return nil, err
+	}
+	if encoded.NewBufferedEvents != nil {
+		if encoded.NewBufferedEvents, err = EncodeEventsAtRest(m.payloadCodec, domainName, mutation.NewBufferedEvents); err != nil {
+			return nil, err
+		}
+	}
+	return &encoded, nil
+}
+
+// encodeWorkflowSnapshot returns a copy of the snapshot with the payloads it persists encoded like encodeWorkflowMutation does
+func (m *executionManagerImpl) encodeWorkflowSnapshot(domainName string, snapshot *WorkflowSnapshot) (*WorkflowSnapshot, error) {
+	encoded := *snapshot
+	var err error
+	if encoded.ExecutionInfo, err = m.encodeExecutionInfo(domainName, snapshot.ExecutionInfo); err != nil {
+		return nil, err
+	}
+	if encoded.ActivityInfos, err = m.encodeActivityInfos(domainName, snapshot.ActivityInfos); err != nil {
+		return nil, err
+	}
+	if encoded.ChildExecutionInfos, err = m.encodeChildExecutionInfos(domainName, snapshot.ChildExecutionInfos); err != nil {
+		return nil, err
+	}
+	return &encoded, nil
+}
+
+func (m *executionManagerImpl) encodeExecutionInfo(domainName string, info *WorkflowExecutionInfo) (*WorkflowExecutionInfo, error) {
+	if info == nil {
+		return nil, nil
+	}
+	encoded := *info
+	var err error
+	if encoded.Memo, err = encodeMemoFields(m.payloadCodec, domainName, info.Memo); err != nil {
+		return nil, err
+	}
+	if encoded.CompletionEvent, err = m.encodeEvent(domainName, info.CompletionEvent); err != nil {
+		return nil, err
+	}
+	if encoded.CompletedUpdates, err = m.encodeCompletedUpdates(domainName, info.CompletedUpdates); err != nil {
+		return nil, err
+	}
+	return &encoded, nil
+}
+
+func (m *executionManagerImpl) encodeCompletedUpdates(domainName string, updates []*CompletedUpdate) ([]*CompletedUpdate, error) {
+	if len(updates) == 0 {
+		return updates, nil
+	}
+	encodedUpdates := make([]*CompletedUpdate, 0, len(updates))
+	for _, update := range updates {
+		encoded := *update
+		var err error
+		if encoded.Result, err = encodePayloadAtRest(m.payloadCodec, domainName, update.Result); err != nil {
+			return nil, err
+		}
+		if encoded.FailureDetails, err = encodePayloadAtRest(m.payloadCodec, domainName, update.FailureDetails); err != nil {
+			return nil, err
+		}
+		encodedUpdates = append(encodedUpdates, &encoded)
+	}
+	return encodedUpdates, nil
+}
+
+func (m *executionManagerImpl) encodeActivityInfos(domainName string, infos []*ActivityInfo) ([]*ActivityInfo, error) {
+	if len(infos) == 0 {
+		return infos, nil
+	}
+	encodedInfos := make([]*ActivityInfo, 0, len(infos))
+	for _, info := range infos {
+		encoded := *info
+		var err error
+		if encoded.Details, err = encodePayloadAtRest(m.payloadCodec, domainName, info.Details); err != nil {
+			return nil, err
+		}
+		if encoded.LastFailureDetails, err = encodePayloadAtRest(m.payloadCodec, domainName, info.LastFailureDetails); err != nil {
+			return nil, err
+		}
+		if encoded.ScheduledEvent, err = m.encodeEvent(domainName, info.ScheduledEvent); err != nil {
+			return nil, err
+		}
+		if encoded.StartedEvent, err = m.encodeEvent(domainName, info.StartedEvent); err != nil {
+			return nil, err
+		}
+		encodedInfos = append(encodedInfos, &encoded)
+	}
+	return encodedInfos, nil
+}
+
+func (m *executionManagerImpl) encodeChildExecutionInfos(domainName string, infos []*ChildExecutionInfo) ([]*ChildExecutionInfo, error) {
+	if len(infos) == 0 {
+		return infos, nil
+	}
+	encodedInfos := make([]*ChildExecutionInfo, 0, len(infos))
+	for _, info := range infos {
+		encoded := *info
+		var err error
+		if encoded.InitiatedEvent, err = m.encodeEvent(domainName, info.InitiatedEvent); err != nil {
+			return nil, err
+		}
+		if encoded.StartedEvent, err = m.encodeEvent(domainName, info.StartedEvent); err != nil {
+			return nil, err
+		}
+		encodedInfos = append(encodedInfos, &encoded)
+	}
+	return encodedInfos, nil
+}
+
+func (m *executionManagerImpl) encodeEvent(domainName string, event *types.HistoryEvent) (*types.HistoryEvent, error) {
+	if event == nil {
+		return nil, nil
+	}
+	encoded, err := EncodeEventsAtRest(m.payloadCodec, domainName, []*types.HistoryEvent{event})
+	if err != nil {
+		return nil, err
+	}
+	return encoded[0], nil
+}
+
+// decodeWorkflowMutableState decodes the payloads encoded by encodeWorkflowMutation in place. Payloads whose key
+// is no longer available are redacted rather than failing the load, which would make the workflow unusable.
+func (m *executionManagerImpl) decodeWorkflowMutableState(state *WorkflowMutableState) error {
+	redacted, err := m.decodeExecutionInfo(state.ExecutionInfo)
+	if err != nil {
+		return err
+	}
+	for _, info := range state.ActivityInfos {
+		if info.Details, err = decodePayloadAtRest(m.payloadCodec, info.Details, &redacted); err != nil {
+			return err
+		}
+		if info.LastFailureDetails, err = decodePayloadAtRest(m.payloadCodec, info.LastFailureDetails, &redacted); err != nil {
+			return err
+		}
+		if err := m.decodeEvents(&redacted, info.ScheduledEvent, info.StartedEvent); err != nil {
+			return err
+		}
+	}
+	for _, info := range state.ChildExecutionInfos {
+		if err := m.decodeEvents(&redacted, info.InitiatedEvent, info.StartedEvent); err != nil {
+			return err
+		}
+	}
+	if err := m.decodeEvents(&redacted, state.BufferedEvents...); err != nil {
+		return err
+	}
+	if redacted > 0 {
+		m.logger.Warn("Redacted mutable state payloads encoded with an unavailable key",
+			tag.WorkflowDomainID(state.ExecutionInfo.DomainID),
+			tag.WorkflowID(state.ExecutionInfo.WorkflowID),
+			tag.WorkflowRunID(state.ExecutionInfo.RunID),
+			tag.Counter(redacted))
+	}
+	return nil
+}
+
+func (m *executionManagerImpl) decodeExecutionInfo(info *WorkflowExecutionInfo) (int, error) {
+	redacted := 0
+	memo, memoRedacted, err := decodeMemoFields(m.payloadCodec, info.Memo)
+	if err != nil {
+		return 0, err
+	}
+	info.Memo = memo
+	redacted += memoRedacted
+	if err := m.decodeEvents(&redacted, info.CompletionEvent); err != nil {
+		return 0, err
+	}
+	for _, update := range info.CompletedUpdates {
+		if update.Result, err = decodePayloadAtRest(m.payloadCodec, update.Result, &redacted); err != nil {
+			return 0, err
+		}
+		if update.FailureDetails, err = decodePayloadAtRest(m.payloadCodec, update.FailureDetails, &redacted); err != nil {
+			return 0, err
+		}
+	}
+	return redacted, nil
+}
+
+func (m *executionManagerImpl) decodeEvents(redacted *int, events ...*types.HistoryEvent) error {
+	eventsRedacted, err := DecodeEventsAtRest(m.payloadCodec, events)
+	*redacted += eventsRedacted
+	return err
+}
+
 // syncTimerTaskTrackingKeys extracts timer tasks from tasksByCategory and returns them as
 // HistoryTaskKey entries to be persisted in the workflow_timer_tasks tracking column.
 // Returns nil when the feature flag is disabled or no eligible tasks are found.
@@ -908,6 +1155,9 @@ func (m *executionManagerImpl) ListConcreteExecutions(
 		if err != nil {
 			return nil, err
 		}
+		if _, err := m.decodeExecutionInfo(info); err != nil {
+			return nil, err
+		}
 		vh, err := m.DeserializeVersionHistories(e.VersionHistories)
 		if err != nil {
 			return nil, err
@@ -141,7 +141,7 @@ func TestExecutionManager_ProxyStoreMethods(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			mockedStore := NewMockExecutionStore(ctrl)
 			tc.prepareMocks(mockedStore)
-			manager := NewExecutionManagerImpl(mockedStore, testlogger.New(t), nil, NewDefaultDynamicConfiguration())
+			manager := NewExecutionManagerImpl(mockedStore, testlogger.New(t), nil, nil, NewDefaultDynamicConfiguration())
 			v := reflect.ValueOf(manager)
 			method := v.MethodByName(tc.method)
 			methodType := method.Type()
@@ -175,7 +175,7 @@ func TestExecutionManager_GetWorkflowExecution(t *testing.T) {
 	mockedStore := NewMockExecutionStore(ctrl)
 	mockedSerializer := NewMockPayloadSerializer(ctrl)
 
-	manager := NewExecutionManagerImpl(mockedStore, testlogger.New(t), mockedSerializer, NewDefaultDynamicConfiguration())
+	manager := NewExecutionManagerImpl(mockedStore, testlogger.New(t), mockedSerializer, nil, NewDefaultDynamicConfiguration())
 
 	request := &GetWorkflowExecutionRequest{
 		DomainID: testDomainID,
@@ -265,7 +265,7 @@ func TestExecutionManager_GetWorkflowExecution_NoWorkflow(t *testing.T) {
 	mockedStore := NewMockExecutionStore(ctrl)
 	mockedSerializer := NewMockPayloadSerializer(ctrl)
 
-	manager := NewExecutionManagerImpl(mockedStore, testlogger.New(t), mockedSerializer, NewDefaultDynamicConfiguration())
+	manager := NewExecutionManagerImpl(mockedStore, testlogger.New(t), mockedSerializer, nil, NewDefaultDynamicConfiguration())
 
 	request := &GetWorkflowExecutionRequest{
 		DomainID: "testDomain",
@@ -288,7 +288,7 @@ func TestExecutionManager_UpdateWorkflowExecution(t *testing.T) {
 	mockedStore := NewMockExecutionStore(ctrl)
 	mockedSerializer := NewMockPayloadSerializer(ctrl)
 
-	manager := NewExecutionManagerImpl(mockedStore, testlogger.New(t), mockedSerializer, NewDefaultDynamicConfiguration())
+	manager := NewExecutionManagerImpl(mockedStore, testlogger.New(t), mockedSerializer, nil, NewDefaultDynamicConfiguration())
 
 	expectedInfo := sampleInternalWorkflowMutation()
 
@@ -492,7 +492,7 @@ func TestSerializeWorkflowSnapshot(t *testing.T) {
 
 			mockedSerializer := NewMockPayloadSerializer(ctrl)
 			tc.prepareMocks(mockedSerializer)
-			manager := NewExecutionManagerImpl(nil, testlogger.New(t), mockedSerializer, NewDefaultDynamicConfiguration()).(*executionManagerImpl)
+			manager := NewExecutionManagerImpl(nil, testlogger.New(t), mockedSerializer, nil, NewDefaultDynamicConfiguration()).(*executionManagerImpl)
 			res, err := manager.SerializeWorkflowSnapshot(tc.input, constants.EncodingTypeThriftRW)
 			tc.checkRes(t, res, err)
 		})
@@ -536,7 +536,7 @@ func TestDeserializeBufferedEvents(t *testing.T) {
 
 			tc.prepareMocks(mockedSerializer)
 
-			manager := NewExecutionManagerImpl(nil, testlogger.New(t), mockedSerializer, NewDefaultDynamicConfiguration()).(*executionManagerImpl)
+			manager := NewExecutionManagerImpl(nil, testlogger.New(t), mockedSerializer, nil, NewDefaultDynamicConfiguration()).(*executionManagerImpl)
 
 			events := []*DataBlob{
 				sampleEventData(),
@@ -552,7 +552,7 @@ func TestDeserializeBufferedEvents(t *testing.T) {
 func TestPutReplicationTaskToDLQ(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	mockedStore := NewMockExecutionStore(ctrl)
-	manager := NewExecutionManagerImpl(mockedStore, testlogger.New(t), NewPayloadSerializer(), NewDefaultDynamicConfiguration())
+	manager := NewExecutionManagerImpl(mockedStore, testlogger.New(t), NewPayloadSerializer(), nil, NewDefaultDynamicConfiguration())
 
 	now := time.Now().UTC()
 
@@ -584,7 +584,7 @@ func TestPutReplicationTaskToDLQ(t *testing.T) {
 func TestGetReplicationTasksFromDLQ(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	mockedStore := NewMockExecutionStore(ctrl)
-	manager := NewExecutionManagerImpl(mockedStore, testlogger.New(t), NewPayloadSerializer(), NewDefaultDynamicConfiguration())
+	manager := NewExecutionManagerImpl(mockedStore, testlogger.New(t), NewPayloadSerializer(), nil, NewDefaultDynamicConfiguration())
 
 	request := &GetReplicationTasksFromDLQRequest{
 		SourceClusterName: "test-cluster",
@@ -623,7 +623,7 @@ func TestGetReplicationTasksFromDLQ_WithBlob(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	mockedStore := NewMockExecutionStore(ctrl)
 	mockedSerializer := NewMockPayloadSerializer(ctrl)
-	manager := NewExecutionManagerImpl(mockedStore, testlogger.New(t), mockedSerializer, NewDefaultDynamicConfiguration())
+	manager := NewExecutionManagerImpl(mockedStore, testlogger.New(t), mockedSerializer, nil, NewDefaultDynamicConfiguration())
 
 	request := &GetReplicationTasksFromDLQRequest{
 		SourceClusterName: "test-cluster",
@@ -668,7 +668,7 @@ func TestGetReplicationTasksFromDLQ_CorruptBlob(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	mockedStore := NewMockExecutionStore(ctrl)
 	mockedSerializer := NewMockPayloadSerializer(ctrl)
-	manager := NewExecutionManagerImpl(mockedStore, testlogger.New(t), mockedSerializer, NewDefaultDynamicConfiguration())
+	manager := NewExecutionManagerImpl(mockedStore, testlogger.New(t), mockedSerializer, nil, NewDefaultDynamicConfiguration())
 
 	request := &GetReplicationTasksFromDLQRequest{
 		SourceClusterName: "test-cluster",
@@ -950,7 +950,7 @@ func TestListConcreteExecutions(t *testing.T) {
 
 			tc.prepareMocks(mockedStore, mockedSerializer)
 
-			manager := NewExecutionManagerImpl(mockedStore, testlogger.New(t), mockedSerializer, NewDefaultDynamicConfiguration())
+			manager := NewExecutionManagerImpl(mockedStore, testlogger.New(t), mockedSerializer, nil, NewDefaultDynamicConfiguration())
 
 			res, err := manager.ListConcreteExecutions(context.Background(), request)
 
@@ -1065,7 +1065,7 @@ func TestCreateWorkflowExecution(t *testing.T) {
 				WorkflowRequestMode:      CreateWorkflowRequestModeReplicated,
 			}
 
-			manager := NewExecutionManagerImpl(mockedStore, testlogger.New(t), mockedSerializer, NewDefaultDynamicConfiguration())
+			manager := NewExecutionManagerImpl(mockedStore, testlogger.New(t), mockedSerializer, nil, NewDefaultDynamicConfiguration())
 
 			res, err := manager.CreateWorkflowExecution(context.Background(), request)
 
@@ -1231,7 +1231,7 @@ func TestConflictResolveWorkflowExecution(t *testing.T) {
 
 			tc.prepareMocks(mockedStore, mockedSerializer)
 
-			manager := NewExecutionManagerImpl(mockedStore, testlogger.New(t), mockedSerializer, NewDefaultDynamicConfiguration())
+			manager := NewExecutionManagerImpl(mockedStore, testlogger.New(t), mockedSerializer, nil, NewDefaultDynamicConfiguration())
 
 			res, err := manager.ConflictResolveWorkflowExecution(context.Background(), tc.request)
 
@@ -1243,7 +1243,7 @@ func TestConflictResolveWorkflowExecution(t *testing.T) {
 func TestCreateFailoverMarkerTasks(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	mockedStore := NewMockExecutionStore(ctrl)
-	manager := NewExecutionManagerImpl(mockedStore, testlogger.New(t), nil, NewDefaultDynamicConfiguration())
+	manager := NewExecutionManagerImpl(mockedStore, testlogger.New(t), nil, nil, NewDefaultDynamicConfiguration())
 
 	req := &CreateFailoverMarkersRequest{
 		Markers: []*FailoverMarkerTask{{
@@ -1334,7 +1334,7 @@ func TestGetActiveClusterSelectionPolicy(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			mockedStore := NewMockExecutionStore(ctrl)
 			mockedSerializer := NewMockPayloadSerializer(ctrl)
-			manager := NewExecutionManagerImpl(mockedStore, testlogger.New(t), mockedSerializer, NewDefaultDynamicConfiguration())
+			manager := NewExecutionManagerImpl(mockedStore, testlogger.New(t), mockedSerializer, nil, NewDefaultDynamicConfiguration())
 
 			test.prepareMocks(mockedStore, mockedSerializer)
 
@@ -1385,7 +1385,7 @@ func TestDeleteActiveClusterSelectionPolicy(t *testing.T) {
 		t.Run(test.name, func(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			mockedStore := NewMockExecutionStore(ctrl)
-			manager := NewExecutionManagerImpl(mockedStore, testlogger.New(t), nil, NewDefaultDynamicConfiguration())
+			manager := NewExecutionManagerImpl(mockedStore, testlogger.New(t), nil, nil, NewDefaultDynamicConfiguration())
 
 			test.prepareMocks(mockedStore)
 
@@ -1748,7 +1748,7 @@ func TestUpdateWorkflowExecution_TimerTaskTracking(t *testing.T) {
 	dc := NewDefaultDynamicConfiguration()
 	dc.EnableWorkflowTimerTaskCleanup = dynamicproperties.GetBoolPropertyFn(true)
 	dc.WorkflowTimerTaskCleanupMinTTL = dynamicproperties.GetDurationPropertyFn(minTTL)
-	manager := NewExecutionManagerImpl(mockedStore, testlogger.New(t), mockedSerializer, dc)
+	manager := NewExecutionManagerImpl(mockedStore, testlogger.New(t), mockedSerializer, nil, dc)
 
 	mutation := sampleWorkflowMutation()
 	mutation.TasksByCategory = map[HistoryTaskCategory][]Task{
@@ -1810,7 +1810,7 @@ func TestCreateWorkflowExecution_TimerTaskTracking(t *testing.T) {
 	dc := NewDefaultDynamicConfiguration()
 	dc.EnableWorkflowTimerTaskCleanup = dynamicproperties.GetBoolPropertyFn(true)
 	dc.WorkflowTimerTaskCleanupMinTTL = dynamicproperties.GetDurationPropertyFn(minTTL)
-	manager := NewExecutionManagerImpl(mockedStore, testlogger.New(t), mockedSerializer, dc)
+	manager := NewExecutionManagerImpl(mockedStore, testlogger.New(t), mockedSerializer, nil, dc)
 
 	snapshot := sampleWorkflowSnapshot()
 	snapshot.TasksByCategory = map[HistoryTaskCategory][]Task{
@@ -1856,6 +1856,98 @@ func TestCreateWorkflowExecution_TimerTaskTracking(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestCreateWorkflowExecution_PayloadCodec(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockedStore := NewMockExecutionStore(ctrl)
+	manager := NewExecutionManagerImpl(mockedStore, testlogger.New(t), NewPayloadSerializer(), testPayloadCodec{}, NewDefaultDynamicConfiguration())
+
+	snapshot := sampleWorkflowSnapshot()
+	snapshot.ExecutionInfo.Memo = map[string][]byte{"key": []byte("value")}
+
+	var persisted *InternalWorkflowExecutionInfo
+	mockedStore.EXPECT().CreateWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, req *InternalCreateWorkflowExecutionRequest) (*CreateWorkflowExecutionResponse, error) {
+			persisted = req.NewWorkflowSnapshot.ExecutionInfo
+			return &CreateWorkflowExecutionResponse{}, nil
+		}).Times(1)
+
+	_, err := manager.CreateWorkflowExecution(context.Background(), &CreateWorkflowExecutionRequest{
+		RangeID:             1,
+		NewWorkflowSnapshot: *snapshot,
+		DomainName:          testDomain,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]byte{"key": []byte("enc|value")}, persisted.Memo)
+	// the memo is shared with the mutable state, so it must not be modified
+	assert.Equal(t, map[string][]byte{"key": []byte("value")}, snapshot.ExecutionInfo.Memo)
+
+	info, _, err := manager.(*executionManagerImpl).DeserializeExecutionInfo(persisted)
+	require.NoError(t, err)
+	redacted, err := manager.(*executionManagerImpl).decodeExecutionInfo(info)
+	require.NoError(t, err)
+	assert.Zero(t, redacted)
+	assert.Equal(t, snapshot.ExecutionInfo.Memo, info.Memo)
+
+	// values encoded with a key which is no longer available are redacted
+	persisted.Memo = map[string][]byte{"key": []byte("lost|value")}
+	info, _, err = manager.(*executionManagerImpl).DeserializeExecutionInfo(persisted)
+	require.NoError(t, err)
+	redacted, err = manager.(*executionManagerImpl).decodeExecutionInfo(info)
+	require.NoError(t, err)
+	assert.Equal(t, 1, redacted)
+	assert.Equal(t, map[string][]byte{"key": RedactedPayload}, info.Memo)
+}
+
+func TestUpdateWorkflowExecution_PayloadCodec(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockedStore := NewMockExecutionStore(ctrl)
+	manager := NewExecutionManagerImpl(mockedStore, testlogger.New(t), NewPayloadSerializer(), testPayloadCodec{}, NewDefaultDynamicConfiguration())
+
+	mutation := sampleWorkflowMutation()
+	mutation.UpsertActivityInfos[0].Details = []byte("heartbeat")
+	mutation.NewBufferedEvents = []*types.HistoryEvent{{
+		ID: constants.BufferedEventID,
+		WorkflowExecutionSignaledEventAttributes: &types.WorkflowExecutionSignaledEventAttributes{
+			SignalName: "signal",
+			Input:      []byte("input"),
+		},
+	}}
+
+	var persisted *InternalWorkflowMutation
+	mockedStore.EXPECT().UpdateWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, req *InternalUpdateWorkflowExecutionRequest) error {
+			persisted = &req.UpdateWorkflowMutation
+			return nil
+		}).Times(1)
+
+	_, err := manager.UpdateWorkflowExecution(context.Background(), &UpdateWorkflowExecutionRequest{
+		RangeID:                1,
+		UpdateWorkflowMutation: *mutation,
+		Encoding:               constants.EncodingTypeThriftRW,
+		DomainName:             testDomain,
+	})
+	require.NoError(t, err)
+	require.Len(t, persisted.UpsertActivityInfos, 1)
+	assert.Equal(t, []byte("enc|heartbeat"), persisted.UpsertActivityInfos[0].Details)
+	bufferedEvents, err := NewPayloadSerializer().DeserializeBatchEvents(persisted.NewBufferedEvents)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("enc|input"), bufferedEvents[0].WorkflowExecutionSignaledEventAttributes.Input)
+	// the mutation is shared with the mutable state, so it must not be modified
+	assert.Equal(t, []byte("heartbeat"), mutation.UpsertActivityInfos[0].Details)
+	assert.Equal(t, []byte("input"), mutation.NewBufferedEvents[0].WorkflowExecutionSignaledEventAttributes.Input)
+
+	activityInfos, err := manager.(*executionManagerImpl).DeserializeActivityInfos(map[int64]*InternalActivityInfo{1: persisted.UpsertActivityInfos[0]})
+	require.NoError(t, err)
+	state := &WorkflowMutableState{
+		ExecutionInfo:  sampleWorkflowExecutionInfo(),
+		ActivityInfos:  activityInfos,
+		BufferedEvents: bufferedEvents,
+	}
+	require.NoError(t, manager.(*executionManagerImpl).decodeWorkflowMutableState(state))
+	assert.Equal(t, []byte("heartbeat"), state.ActivityInfos[1].Details)
+	assert.Equal(t, mutation.NewBufferedEvents, state.BufferedEvents)
+}
+
 // TestUpdateWorkflowExecution_TimerTaskTrackingFlagOff verifies that timer tasks are not
 // tracked when EnableWorkflowTimerTaskCleanup is disabled.
 func TestUpdateWorkflowExecution_TimerTaskTrackingFlagOff(t *testing.T) {
@@ -1864,7 +1956,7 @@ func TestUpdateWorkflowExecution_TimerTaskTrackingFlagOff(t *testing.T) {
 	mockedSerializer := NewMockPayloadSerializer(ctrl)
 
 	// EnableWorkflowTimerTaskCleanup is false by default
-	manager := NewExecutionManagerImpl(mockedStore, testlogger.New(t), mockedSerializer, NewDefaultDynamicConfiguration())
+	manager := NewExecutionManagerImpl(mockedStore, testlogger.New(t), mockedSerializer, nil, NewDefaultDynamicConfiguration())
 
 	mutation := sampleWorkflowMutation()
 	mutation.TasksByCategory = map[HistoryTaskCategory][]Task{
@@ -1904,7 +1996,7 @@ func TestCreateWorkflowExecution_TimerTaskTrackingFlagOff(t *testing.T) {
 	mockedSerializer := NewMockPayloadSerializer(ctrl)
 
 	// EnableWorkflowTimerTaskCleanup is false by default
-	manager := NewExecutionManagerImpl(mockedStore, testlogger.New(t), mockedSerializer, NewDefaultDynamicConfiguration())
+	manager := NewExecutionManagerImpl(mockedStore, testlogger.New(t), mockedSerializer, nil, NewDefaultDynamicConfiguration())
 
 	snapshot := sampleWorkflowSnapshot()
 	snapshot.TasksByCategory = map[HistoryTaskCategory][]Task{
@@ -1953,7 +2045,7 @@ func TestConflictResolveWorkflowExecution_TimerTaskTracking(t *testing.T) {
 	dc := NewDefaultDynamicConfiguration()
 	dc.EnableWorkflowTimerTaskCleanup = dynamicproperties.GetBoolPropertyFn(true)
 	dc.WorkflowTimerTaskCleanupMinTTL = dynamicproperties.GetDurationPropertyFn(minTTL)
-	manager := NewExecutionManagerImpl(mockedStore, testlogger.New(t), mockedSerializer, dc)
+	manager := NewExecutionManagerImpl(mockedStore, testlogger.New(t), mockedSerializer, nil, dc)
 
 	makeTimerTask := func(taskID int64) Task {
 		return &WorkflowTimeoutTask{
@@ -2025,7 +2117,7 @@ func TestConflictResolveWorkflowExecution_TimerTaskTrackingFlagOff(t *testing.T)
 	mockedStore := NewMockExecutionStore(ctrl)
 	mockedSerializer := NewMockPayloadSerializer(ctrl)
 
-	manager := NewExecutionManagerImpl(mockedStore, testlogger.New(t), mockedSerializer, NewDefaultDynamicConfiguration())
+	manager := NewExecutionManagerImpl(mockedStore, testlogger.New(t), mockedSerializer, nil, NewDefaultDynamicConfiguration())
 
 	makeTimerTask := func(taskID int64) Task {
 		return &WorkflowTimeoutTask{
@@ -2075,7 +2167,7 @@ func TestFetchWorkflowTimerTasksForCleanup_FiltersCorrectly(t *testing.T) {
 
 	dc := NewDefaultDynamicConfiguration()
 	dc.WorkflowTimerTaskCleanupMinTTL = dynamicproperties.GetDurationPropertyFn(minTTL)
-	manager := NewExecutionManagerImpl(mockedStore, testlogger.New(t), mockedSerializer, dc)
+	manager := NewExecutionManagerImpl(mockedStore, testlogger.New(t), mockedSerializer, nil, dc)
 
 	shardID := 0
 	mockedStore.EXPECT().SelectWorkflowTimerTasks(gomock.Any(), &SelectWorkflowTimerTasksRequest{
@@ -2108,7 +2200,7 @@ func TestFetchWorkflowTimerTasksForCleanup_EmptyMap(t *testing.T) {
 
 	dc := NewDefaultDynamicConfiguration()
 	dc.WorkflowTimerTaskCleanupMinTTL = dynamicproperties.GetDurationPropertyFn(time.Hour)
-	manager := NewExecutionManagerImpl(mockedStore, testlogger.New(t), mockedSerializer, dc)
+	manager := NewExecutionManagerImpl(mockedStore, testlogger.New(t), mockedSerializer, nil, dc)
 
 	shardID := 0
 	mockedStore.EXPECT().SelectWorkflowTimerTasks(gomock.Any(), &SelectWorkflowTimerTasksRequest{
@@ -2136,7 +2228,7 @@ func TestCompleteHistoryTasks(t *testing.T) {
 	taskID := int64(1)
 	visTS := time.Now().Add(48 * time.Hour)
 
-	manager := NewExecutionManagerImpl(mockedStore, testlogger.New(t), mockedSerializer, NewDefaultDynamicConfiguration())
+	manager := NewExecutionManagerImpl(mockedStore, testlogger.New(t), mockedSerializer, nil, NewDefaultDynamicConfiguration())
 
 	shardID := 0
 	mockedStore.EXPECT().CompleteHistoryTask(gomock.Any(), gomock.Cond(func(req *CompleteHistoryTaskRequest) bool {
@@ -29,6 +29,7 @@ import (
 
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/blobstore"
 	"github.com/uber/cadence/common/clock"
 	"github.com/uber/cadence/common/codec"
 	"github.com/uber/cadence/common/constants"
@@ -59,6 +60,9 @@ type (
 	// historyManagerImpl implements HistoryManager based on HistoryStore and PayloadSerializer
 	historyV2ManagerImpl struct {
 		historySerializer      PayloadSerializer
+		payloadCodec           PayloadCodec
+		blobstoreClient        blobstore.Client
+		offloadThreshold       dynamicproperties.IntPropertyFnWithDomainFilter
 		persistence            HistoryStore
 		logger                 log.Logger
 		thriftEncoder          codec.BinaryEncoder
@@ -75,6 +79,8 @@ const (
 	notStartedIndex          = -1
 	defaultLastNodeID        = constants.FirstEventID - 1
 	defaultLastTransactionID = int64(0)
+
+	defaultOffloadedPayloadScanPageSize = 100
 )
 
 var (
@@ -83,16 +89,23 @@ var (
 
 var _ HistoryManager = (*historyV2ManagerImpl)(nil)
 
-// NewHistoryV2ManagerImpl returns new HistoryManager
+// NewHistoryV2ManagerImpl returns new HistoryManager, payloadCodec is optional and encodes the event payloads at rest,
+// blobstoreClient is optional and stores the event payloads larger than offloadThreshold outside of the history
 func NewHistoryV2ManagerImpl(
 	persistence HistoryStore,
 	logger log.Logger,
 	historySerializer PayloadSerializer,
+	payloadCodec PayloadCodec,
+	blobstoreClient blobstore.Client,
+	offloadThreshold dynamicproperties.IntPropertyFnWithDomainFilter,
 	binaryEncoder codec.BinaryEncoder,
 	transactionSizeLimit dynamicproperties.IntPropertyFn,
 ) HistoryManager {
 	hm := &historyV2ManagerImpl{
 		historySerializer:    historySerializer,
+		payloadCodec:         payloadCodec,
+		blobstoreClient:      blobstoreClient,
+		offloadThreshold:     offloadThreshold,
 		persistence:          persistence,
 		logger:               logger,
 		thriftEncoder:        binaryEncoder,
@@ -179,9 +192,86 @@ func (m *historyV2ManagerImpl) DeleteHistoryBranch(
 		BranchInfo: *thrift.ToHistoryBranch(&branch),
 		ShardID:    shardID,
 	}
+	if m.blobstoreClient != nil {
+		// the blobs are deleted first, so that a failure leaves the branch in place to retry the deletion
+		if err := m.deleteBranchOffloadedPayloads(ctx, shardID, req.BranchInfo); err != nil {
+			return err
+		}
+	}
 	return m.persistence.DeleteHistoryBranch(ctx, req)
 }
 
+// deleteBranchOffloadedPayloads deletes the offloaded payloads of the history nodes of the branch,
+// except for the nodes which are still read by other branches of the tree
+func (m *historyV2ManagerImpl) deleteBranchOffloadedPayloads(
+	ctx context.Context,
+	shardID int,
+	branch types.HistoryBranch,
+) error {
+	treeResp, err := m.persistence.GetHistoryTree(ctx, &InternalGetHistoryTreeRequest{
+		TreeID:  branch.TreeID,
+		ShardID: &shardID,
+	})
+	if err != nil {
+		return err
+	}
+	otherBranches := make([]*types.HistoryBranch, 0, len(treeResp.Branches))
+	for _, b := range treeResp.Branches {
+		if b.BranchID != branch.BranchID {
+			otherBranches = append(otherBranches, b)
+		}
+	}
+
+	ranges := append([]*types.HistoryBranchRange{}, branch.Ancestors...)
+	beginNodeID := constants.FirstEventID
+	if len(branch.Ancestors) > 0 {
+		beginNodeID = branch.Ancestors[len(branch.Ancestors)-1].EndNodeID
+	}
+	ranges = append(ranges, &types.HistoryBranchRange{
+		BranchID:    branch.BranchID,
+		BeginNodeID: beginNodeID,
+		EndNodeID:   constants.EndEventID,
+	})
+
+	var keys []string
+	for _, r := range ranges {
+		req := &InternalReadHistoryBranchRequest{
+			TreeID:            branch.TreeID,
+			BranchID:          r.BranchID,
+			MinNodeID:         r.BeginNodeID,
+			MaxNodeID:         r.EndNodeID,
+			LastNodeID:        r.BeginNodeID - 1,
+			LastTransactionID: 0,
+			ShardID:           shardID,
+			PageSize:          defaultOffloadedPayloadScanPageSize,
+		}
+		for {
+			resp, err := m.persistence.ReadHistoryBranch(ctx, req)
+			if err != nil {
+				return err
+			}
+			for _, blob := range resp.History {
+				events, err := m.historySerializer.DeserializeBatchEvents(blob)
+				if err != nil {
+					return err
+				}
+				if len(events) == 0 || historyNodeIsReferenced(otherBranches, r.BranchID, events[0].ID) {
+					continue
+				}
+				node := offloadedPayloadNode{shardID: shardID, treeID: branch.TreeID, branchID: r.BranchID, nodeID: events[0].ID}
+				keys = append(keys, offloadedHistoryEventPayloadKeys(node, events)...)
+			}
+			if len(resp.NextPageToken) == 0 {
+				break
+			}
+			req.NextPageToken = resp.NextPageToken
+			req.LastNodeID = resp.LastNodeID
+			req.LastTransactionID = resp.LastTransactionID
+		}
+	}
+	return deleteOffloadedPayloads(ctx, m.blobstoreClient, keys)
+}
+
 // GetHistoryTree returns all branch information of a tree
 func (m *historyV2ManagerImpl) GetHistoryTree(
 	ctx context.Context,
@@ -262,7 +352,37 @@ func (m *historyV2ManagerImpl) AppendHistoryNodes(
 	if err != nil {
 		return nil, err
 	}
-	size := len(blob.Data)
+	// the returned blob is replicated to other clusters, so it always holds the plain payloads
+	persistedBlob := blob
+	persistedEvents, transformed := escapeHistoryEventPayloads(request.Events)
+	if m.payloadCodec != nil {
+		persistedEvents, err = encodeHistoryEventPayloads(m.payloadCodec, request.DomainName, persistedEvents)
+		if err != nil {
+			return nil, err
+		}
+		transformed = true
+	}
+	if m.blobstoreClient != nil {
+		var offloaded bool
+		persistedEvents, offloaded, err = offloadHistoryEventPayloads(
+			ctx,
+			m.blobstoreClient,
+			m.offloadThreshold(request.DomainName),
+			offloadedPayloadKeyPrefix(shardID, branch.GetTreeID(), branch.GetBranchID(), nodeID, request.TransactionID),
+			persistedEvents,
+		)
+		if err != nil {
+			return nil, err
+		}
+		transformed = transformed || offloaded
+	}
+	if transformed {
+		persistedBlob, err = m.historySerializer.SerializeBatchEvents(persistedEvents, request.Encoding)
+		if err != nil {
+			return nil, err
+		}
+	}
+	size := len(persistedBlob.Data)
 	sizeLimit := m.transactionSizeLimit()
 	if size > sizeLimit {
 		return nil, &TransactionSizeLimitError{
@@ -274,7 +394,7 @@ func (m *historyV2ManagerImpl) AppendHistoryNodes(
 		Info:             request.Info,
 		BranchInfo:       *thrift.ToHistoryBranch(&branch),
 		NodeID:           nodeID,
-		Events:           blob,
+		Events:           persistedBlob,
 		TransactionID:    request.TransactionID,
 		ShardID:          shardID,
 		CurrentTimeStamp: m.timeSrc.Now(),
@@ -334,10 +454,18 @@ func (m *historyV2ManagerImpl) ReadRawHistoryBranch(
 	request *ReadHistoryBranchRequest,
 ) (*ReadRawHistoryBranchResponse, error) {
 
-	dataBlobs, token, dataSize, _, err := m.readRawHistoryBranchFn(ctx, request)
+	dataBlobs, token, dataSize, logger, err := m.readRawHistoryBranchFn(ctx, request)
 	if err != nil {
 		return nil, err
 	}
+	for i, blob := range dataBlobs {
+		if m.payloadCodec == nil && m.blobstoreClient == nil && !mayHoldEscapedPayloads(blob) {
+			continue
+		}
+		if dataBlobs[i], err = m.decodeRawHistoryBlob(ctx, request, blob, logger); err != nil {
+			return nil, err
+		}
+	}
 
 	nextPageToken, err := m.serializeTokenFn(token)
 	if err != nil {
@@ -490,6 +618,9 @@ func (m *historyV2ManagerImpl) readHistoryBranch(
 		if err != nil {
 			return nil, nil, nil, 0, 0, err
 		}
+		if err := m.decodeHistoryEvents(ctx, request, events, true, logger); err != nil {
+			return nil, nil, nil, 0, 0, err
+		}
 		if len(events) == 0 {
 			logger.Error("Empty events in a batch")
 			return nil, nil, nil, 0, 0, &types.InternalDataInconsistencyError{
@@ -588,6 +719,280 @@ func serializeToken(pagingToken *historyV2PagingToken) ([]byte, error) {
 	return json.Marshal(pagingToken)
 }
 
+// ReencodeHistoryBranch rewrites the history nodes owned by the branch whose payloads are not encoded
+// with the current codec settings of the domain. Each node is overwritten in place, i.e. with its own
+// transaction ID, so that no copy of the payloads encoded with the previous settings is left behind.
+func (m *historyV2ManagerImpl) ReencodeHistoryBranch(
+	ctx context.Context,
+	request *ReencodeHistoryBranchRequest,
+) (*ReencodeHistoryBranchResponse, error) {
+	if m.payloadCodec == nil {
+		return nil, &InvalidPersistenceRequestError{Msg: "payload codec is not configured"}
+	}
+	shardID, err := getShardID(request.ShardID)
+	if err != nil {
+		m.logger.Error("shardID is not set in reencode history branch operation", tag.Error(err))
+		return nil, &types.InternalServiceError{Message: err.Error()}
+	}
+
+	var branch workflow.HistoryBranch
+	err = m.thriftEncoder.Decode(request.BranchToken, &branch)
+	if err != nil {
+		return nil, err
+	}
+	// nodes before the end of the last ancestor are owned by the ancestors
+	minNodeID := constants.FirstEventID
+	if len(branch.Ancestors) > 0 {
+		minNodeID = branch.Ancestors[len(branch.Ancestors)-1].GetEndNodeID()
+	}
+
+	req := &InternalReadHistoryBranchRequest{
+		TreeID:            branch.GetTreeID(),
+		BranchID:          branch.GetBranchID(),
+		MinNodeID:         minNodeID,
+		MaxNodeID:         constants.EndEventID,
+		LastNodeID:        minNodeID - 1,
+		LastTransactionID: 0,
+		ShardID:           shardID,
+		// one node per page so that the transaction ID of every node is known
+		PageSize: 1,
+	}
+	reencoded := 0
+	for {
+		resp, err := m.persistence.ReadHistoryBranch(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		for _, blob := range resp.History {
+			events, err := m.historySerializer.DeserializeBatchEvents(blob)
+			if err != nil {
+				return nil, err
+			}
+			if len(events) == 0 {
+				continue
+			}
+			node := offloadedPayloadNode{shardID: shardID, treeID: branch.GetTreeID(), branchID: branch.GetBranchID(), nodeID: events[0].ID}
+			offloadedKeys := offloadedHistoryEventPayloadKeys(node, events)
+			if err := loadHistoryEventPayloads(ctx, m.blobstoreClient, node, events); err != nil {
+				return nil, err
+			}
+			if historyEventPayloadsAreCurrent(m.payloadCodec, request.DomainName, events) {
+				continue
+			}
+			if _, err := decodeHistoryEventPayloads(m.payloadCodec, events, false); err != nil {
+				return nil, err
+			}
+			encodedEvents, err := encodeHistoryEventPayloads(m.payloadCodec, request.DomainName, events)
+			if err != nil {
+				return nil, err
+			}
+			transactionID := resp.LastTransactionID
+			if m.blobstoreClient != nil {
+				encodedEvents, _, err = offloadHistoryEventPayloads(
+					ctx,
+					m.blobstoreClient,
+					m.offloadThreshold(request.DomainName),
+					offloadedPayloadKeyPrefix(shardID, branch.GetTreeID(), branch.GetBranchID(), events[0].ID, transactionID),
+					encodedEvents,
+				)
+				if err != nil {
+					return nil, err
+				}
+			}
+			encodedBlob, err := m.historySerializer.SerializeBatchEvents(encodedEvents, blob.Encoding)
+			if err != nil {
+				return nil, err
+			}
+			if err := m.persistence.AppendHistoryNodes(ctx, &InternalAppendHistoryNodesRequest{
+				BranchInfo:       *thrift.ToHistoryBranch(&branch),
+				NodeID:           events[0].ID,
+				Events:           encodedBlob,
+				TransactionID:    transactionID,
+				Overwrite:        true,
+				ShardID:          shardID,
+				CurrentTimeStamp: m.timeSrc.Now(),
+			}); err != nil {
+				return nil, err
+			}
+			// the blobs the overwritten node offloaded under the same keys were overwritten too, the rest are not read anymore
+			if err := deleteOffloadedPayloads(ctx, m.blobstoreClient, supersededPayloadKeys(node, offloadedKeys, encodedEvents)); err != nil {
+				return nil, err
+			}
+			reencoded++
+		}
+		if len(resp.NextPageToken) == 0 {
+			break
+		}
+		req.NextPageToken = resp.NextPageToken
+		req.LastNodeID = resp.LastNodeID
+		req.LastTransactionID = resp.LastTransactionID
+	}
+	return &ReencodeHistoryBranchResponse{ReencodedNodeCount: reencoded}, nil
+}
+
+// DeleteOrphanedHistoryPayloads deletes a page of the offloaded payloads which no history node reads anymore, i.e. the
+// payloads of nodes whose branches were deleted, of appends which failed after offloading and of nodes which were
+// superseded by a node with a larger transaction ID
+func (m *historyV2ManagerImpl) DeleteOrphanedHistoryPayloads(
+	ctx context.Context,
+	request *DeleteOrphanedHistoryPayloadsRequest,
+) (*DeleteOrphanedHistoryPayloadsResponse, error) {
+	if m.blobstoreClient == nil {
+		return &DeleteOrphanedHistoryPayloadsResponse{}, nil
+	}
+	listResp, err := m.blobstoreClient.List(ctx, &blobstore.ListRequest{
+		Prefix:        offloadedPayloadKeyRoot,
+		PageSize:      request.PageSize,
+		NextPageToken: request.NextPageToken,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &DeleteOrphanedHistoryPayloadsResponse{NextPageToken: listResp.NextPageToken}
+	trees := make(map[string][]*types.HistoryBranch)
+	// the blobs of a node are listed next to each other, so only the keys of the last node are kept
+	lastNodeKeyPrefix := ""
+	var lastNodeKeys map[string]struct{}
+	var orphanedKeys []string
+	for _, blob := range listResp.Blobs {
+		if !blob.LastModified.Before(request.OffloadedBefore) {
+			continue
+		}
+		resp.ScannedCount++
+		node, err := parseOffloadedPayloadKey(blob.Key)
+		if err != nil {
+			m.logger.Warn("Skipping unexpected blob in the offloaded history event payloads", tag.Key(blob.Key), tag.Error(err))
+			continue
+		}
+		keyPrefix := offloadedPayloadKeyPrefix(node.shardID, node.treeID, node.branchID, node.nodeID, node.transactionID)
+		if keyPrefix != lastNodeKeyPrefix {
+			lastNodeKeys, err = m.readOffloadedPayloadKeys(ctx, node, trees)
+			if err != nil {
+				return nil, err
+			}
+			lastNodeKeyPrefix = keyPrefix
+		}
+		if _, ok := lastNodeKeys[blob.Key]; !ok {
+			orphanedKeys = append(orphanedKeys, blob.Key)
+		}
+	}
+	if err := deleteOffloadedPayloads(ctx, m.blobstoreClient, orphanedKeys); err != nil {
+		return nil, err
+	}
+	resp.DeletedCount = len(orphanedKeys)
+	return resp, nil
+}
+
+// readOffloadedPayloadKeys returns the keys of the payloads offloaded by the node, it returns no keys if the node
+// is not read anymore. The branches of the trees which have already been read are cached in trees.
+func (m *historyV2ManagerImpl) readOffloadedPayloadKeys(
+	ctx context.Context,
+	node *offloadedPayloadNode,
+	trees map[string][]*types.HistoryBranch,
+) (map[string]struct{}, error) {
+	branches, ok := trees[node.treeID]
+	if !ok {
+		treeResp, err := m.persistence.GetHistoryTree(ctx, &InternalGetHistoryTreeRequest{
+			TreeID:  node.treeID,
+			ShardID: common.IntPtr(node.shardID),
+		})
+		if err != nil {
+			return nil, err
+		}
+		branches = treeResp.Branches
+		trees[node.treeID] = branches
+	}
+	if !historyNodeIsReferenced(branches, node.branchID, node.nodeID) {
+		return nil, nil
+	}
+
+	// only the node with the largest transaction ID is read
+	readResp, err := m.persistence.ReadHistoryBranch(ctx, &InternalReadHistoryBranchRequest{
+		TreeID:            node.treeID,
+		BranchID:          node.branchID,
+		MinNodeID:         node.nodeID,
+		MaxNodeID:         node.nodeID + 1,
+		LastNodeID:        node.nodeID - 1,
+		LastTransactionID: 0,
+		ShardID:           node.shardID,
+		PageSize:          1,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(readResp.History) == 0 || readResp.LastTransactionID != node.transactionID {
+		return nil, nil
+	}
+	events, err := m.historySerializer.DeserializeBatchEvents(readResp.History[0])
+	if err != nil {
+		return nil, err
+	}
+	keys := make(map[string]struct{})
+	for _, key := range offloadedHistoryEventPayloadKeys(*node, events) {
+		keys[key] = struct{}{}
+	}
+	return keys, nil
+}
+
+// decodeHistoryEvents loads the offloaded payloads, decodes and unescapes the payloads of a batch of events read
+// by the request in place. If redact is true payloads whose key is no longer available are redacted rather than
+// failing the read.
+func (m *historyV2ManagerImpl) decodeHistoryEvents(
+	ctx context.Context,
+	request *ReadHistoryBranchRequest,
+	events []*types.HistoryEvent,
+	redact bool,
+	logger log.Logger,
+) error {
+	if len(events) == 0 {
+		return nil
+	}
+	if referencesOffloadedPayloads(events) {
+		shardID, err := getShardID(request.ShardID)
+		if err != nil {
+			return &types.InternalServiceError{Message: err.Error()}
+		}
+		branch, err := m.decodeBranchToken(request.BranchToken)
+		if err != nil {
+			return err
+		}
+		if err := loadHistoryEventPayloads(ctx, m.blobstoreClient, historyBatchNode(shardID, branch, events[0].ID), events); err != nil {
+			return err
+		}
+	}
+	if m.payloadCodec != nil {
+		redacted, err := decodeHistoryEventPayloads(m.payloadCodec, events, redact)
+		if err != nil {
+			logger.Error("Failed to decode history event payloads", tag.Error(err))
+			return err
+		}
+		if redacted > 0 {
+			logger.Warn("Redacted history event payloads encoded with an unavailable key", tag.Counter(redacted))
+		}
+	}
+	unescapeHistoryEventPayloads(events)
+	return nil
+}
+
+// decodeRawHistoryBlob returns the blob with the payloads of its events decoded, using the encoding of the blob
+func (m *historyV2ManagerImpl) decodeRawHistoryBlob(
+	ctx context.Context,
+	request *ReadHistoryBranchRequest,
+	blob *DataBlob,
+	logger log.Logger,
+) (*DataBlob, error) {
+	events, err := m.historySerializer.DeserializeBatchEvents(blob)
+	if err != nil {
+		return nil, err
+	}
+	// raw history is replicated and persisted again, so it must never hold redacted payloads
+	if err := m.decodeHistoryEvents(ctx, request, events, false, logger); err != nil {
+		return nil, err
+	}
+	return m.historySerializer.SerializeBatchEvents(events, blob.Encoding)
+}
+
 func (m *historyV2ManagerImpl) Close() {
 	m.persistence.Close()
 }
@@ -598,3 +1003,29 @@ func getShardID(shardID *int) (int, error) {
 	}
 	return *shardID, nil
 }
+
+func (m *historyV2ManagerImpl) decodeBranchToken(branchToken []byte) (*workflow.HistoryBranch, error) {
+	var branch workflow.HistoryBranch
+	if err := m.thriftEncoder.Decode(branchToken, &branch); err != nil {
+		return nil, err
+	}
+	return &branch, nil
+}
+
+// historyBatchNode returns the node storing the batch of events starting with firstEventID which is read through
+// the branch, i.e. the node of the ancestor branch owning the batch if the branch was forked after it
+func historyBatchNode(shardID int, branch *workflow.HistoryBranch, firstEventID int64) offloadedPayloadNode {
+	branchID := branch.GetBranchID()
+	for _, ancestor := range branch.Ancestors {
+		if ancestor.GetBeginNodeID() <= firstEventID && firstEventID < ancestor.GetEndNodeID() {
+			branchID = ancestor.GetBranchID()
+			break
+		}
+	}
+	return offloadedPayloadNode{
+		shardID:  shardID,
+		treeID:   branch.GetTreeID(),
+		branchID: branchID,
+		nodeID:   firstEventID,
+	}
+}
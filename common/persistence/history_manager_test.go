@@ -26,12 +26,14 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
 
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/blobstore"
 	"github.com/uber/cadence/common/codec"
 	"github.com/uber/cadence/common/constants"
 	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
@@ -52,6 +54,9 @@ func setUpMocksForHistoryV2Manager(t *testing.T) (*historyV2ManagerImpl, *MockHi
 		mockStore,
 		logger,
 		mockSerializer,
+		nil,
+		nil,
+		nil,
 		mockEncoder,
 		dynamicproperties.GetIntPropertyFn(1024*10),
 	)
@@ -1577,3 +1582,518 @@ func TestTokenRoundtrip(t *testing.T) {
 		})
 	}
 }
+
+func setUpPayloadCodecForHistoryV2Manager(t *testing.T) (*historyV2ManagerImpl, *MockHistoryStore, *codec.MockBinaryEncoder) {
+	historyManager, mockStore, _, mockEncoder := setUpMocksForHistoryV2Manager(t)
+	historyManager.historySerializer = NewPayloadSerializer()
+	historyManager.payloadCodec = testPayloadCodec{}
+	mockEncoder.EXPECT().
+		Decode([]byte("branch-token"), &workflow.HistoryBranch{}).DoAndReturn(func(data []byte, value *workflow.HistoryBranch) error {
+		value.TreeID = common.Ptr("tree-id")
+		value.BranchID = common.Ptr("branch-id")
+		value.Ancestors = []*workflow.HistoryBranchRange{
+			{BranchID: common.Ptr("ancestor-id"), BeginNodeID: common.Ptr(int64(1)), EndNodeID: common.Ptr(int64(3))},
+		}
+		return nil
+	}).AnyTimes()
+	return historyManager, mockStore, mockEncoder
+}
+
+func newPayloadCodecTestBlob(t *testing.T, events ...*types.HistoryEvent) *DataBlob {
+	blob, err := NewPayloadSerializer().SerializeBatchEvents(events, constants.EncodingTypeThriftRW)
+	assert.NoError(t, err)
+	return blob
+}
+
+func TestAppendHistoryNodes_PayloadCodec(t *testing.T) {
+	historyManager, mockStore, _ := setUpPayloadCodecForHistoryV2Manager(t)
+	events := []*types.HistoryEvent{{
+		ID:      3,
+		Version: 1,
+		ActivityTaskCompletedEventAttributes: &types.ActivityTaskCompletedEventAttributes{
+			Result: []byte("result"),
+		},
+	}}
+
+	mockStore.EXPECT().
+		AppendHistoryNodes(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, request *InternalAppendHistoryNodesRequest) error {
+		persisted, err := historyManager.historySerializer.DeserializeBatchEvents(request.Events)
+		assert.NoError(t, err)
+		assert.Equal(t, []byte("enc|result"), persisted[0].ActivityTaskCompletedEventAttributes.Result)
+		return nil
+	}).Times(1)
+
+	resp, err := historyManager.AppendHistoryNodes(context.Background(), &AppendHistoryNodesRequest{
+		BranchToken:   []byte("branch-token"),
+		Events:        events,
+		TransactionID: 1234,
+		ShardID:       common.Ptr(10),
+		Encoding:      constants.EncodingTypeThriftRW,
+		DomainName:    "domain",
+	})
+	assert.NoError(t, err)
+	// the returned blob is replicated, so its payloads must not be encoded
+	assert.Equal(t, *newPayloadCodecTestBlob(t, events...), resp.DataBlob)
+	assert.Equal(t, []byte("result"), events[0].ActivityTaskCompletedEventAttributes.Result)
+}
+
+func TestReadHistoryBranch_PayloadCodec(t *testing.T) {
+	historyManager, _, _ := setUpPayloadCodecForHistoryV2Manager(t)
+	storedBlob := newPayloadCodecTestBlob(t,
+		&types.HistoryEvent{
+			ID:      1,
+			Version: 1,
+			WorkflowExecutionStartedEventAttributes: &types.WorkflowExecutionStartedEventAttributes{
+				Input: []byte("enc|input"),
+			},
+		},
+		&types.HistoryEvent{
+			ID:      2,
+			Version: 1,
+			MarkerRecordedEventAttributes: &types.MarkerRecordedEventAttributes{
+				Details: []byte("details"),
+			},
+		},
+	)
+	historyManager.readRawHistoryBranchFn = func(ctx context.Context, request *ReadHistoryBranchRequest) ([]*DataBlob, *historyV2PagingToken, int, log.Logger, error) {
+		token, err := deserializeToken(nil, 0)
+		assert.NoError(t, err)
+		return []*DataBlob{storedBlob}, token, len(storedBlob.Data), log.NewNoop(), nil
+	}
+	request := &ReadHistoryBranchRequest{
+		BranchToken: []byte("branch-token"),
+		PageSize:    10,
+		MinEventID:  1,
+		MaxEventID:  100,
+		ShardID:     common.Ptr(10),
+	}
+
+	resp, err := historyManager.ReadHistoryBranch(context.Background(), request)
+	assert.NoError(t, err)
+	assert.Len(t, resp.HistoryEvents, 2)
+	assert.Equal(t, []byte("input"), resp.HistoryEvents[0].WorkflowExecutionStartedEventAttributes.Input)
+	assert.Equal(t, []byte("details"), resp.HistoryEvents[1].MarkerRecordedEventAttributes.Details)
+
+	rawResp, err := historyManager.ReadRawHistoryBranch(context.Background(), request)
+	assert.NoError(t, err)
+	assert.Len(t, rawResp.HistoryEventBlobs, 1)
+	rawEvents, err := historyManager.historySerializer.DeserializeBatchEvents(rawResp.HistoryEventBlobs[0])
+	assert.NoError(t, err)
+	assert.Equal(t, resp.HistoryEvents, rawEvents)
+}
+
+func TestReadHistoryBranch_PayloadCodecKeyNotFound(t *testing.T) {
+	historyManager, _, _ := setUpPayloadCodecForHistoryV2Manager(t)
+	storedBlob := newPayloadCodecTestBlob(t, &types.HistoryEvent{
+		ID:      1,
+		Version: 1,
+		MarkerRecordedEventAttributes: &types.MarkerRecordedEventAttributes{
+			Details: []byte("lost|details"),
+		},
+	})
+	historyManager.readRawHistoryBranchFn = func(ctx context.Context, request *ReadHistoryBranchRequest) ([]*DataBlob, *historyV2PagingToken, int, log.Logger, error) {
+		token, err := deserializeToken(nil, 0)
+		assert.NoError(t, err)
+		return []*DataBlob{storedBlob}, token, len(storedBlob.Data), log.NewNoop(), nil
+	}
+	request := &ReadHistoryBranchRequest{
+		BranchToken: []byte("branch-token"),
+		PageSize:    10,
+		MinEventID:  1,
+		MaxEventID:  100,
+		ShardID:     common.Ptr(10),
+	}
+
+	_, err := historyManager.ReadHistoryBranch(context.Background(), request)
+	assert.True(t, errors.Is(err, ErrPayloadKeyNotFound))
+
+	_, err = historyManager.ReadRawHistoryBranch(context.Background(), request)
+	assert.True(t, errors.Is(err, ErrPayloadKeyNotFound))
+}
+
+func TestReencodeHistoryBranch(t *testing.T) {
+	historyManager, mockStore, _ := setUpPayloadCodecForHistoryV2Manager(t)
+	currentBlob := newPayloadCodecTestBlob(t, &types.HistoryEvent{
+		ID:      3,
+		Version: 1,
+		ActivityTaskScheduledEventAttributes: &types.ActivityTaskScheduledEventAttributes{
+			Input: []byte("enc|input"),
+		},
+	})
+	staleBlob := newPayloadCodecTestBlob(t, &types.HistoryEvent{
+		ID:      5,
+		Version: 1,
+		ActivityTaskCompletedEventAttributes: &types.ActivityTaskCompletedEventAttributes{
+			Result: []byte("result"),
+		},
+	})
+
+	gomock.InOrder(
+		mockStore.EXPECT().
+			ReadHistoryBranch(gomock.Any(), &InternalReadHistoryBranchRequest{
+				TreeID:     "tree-id",
+				BranchID:   "branch-id",
+				MinNodeID:  3,
+				MaxNodeID:  constants.EndEventID,
+				LastNodeID: 2,
+				ShardID:    10,
+				PageSize:   1,
+			}).
+			Return(&InternalReadHistoryBranchResponse{
+				History:           []*DataBlob{currentBlob},
+				NextPageToken:     []byte("page-2"),
+				LastNodeID:        3,
+				LastTransactionID: 100,
+			}, nil).Times(1),
+		mockStore.EXPECT().
+			ReadHistoryBranch(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, request *InternalReadHistoryBranchRequest) (*InternalReadHistoryBranchResponse, error) {
+			assert.Equal(t, []byte("page-2"), request.NextPageToken)
+			assert.Equal(t, int64(3), request.LastNodeID)
+			assert.Equal(t, int64(100), request.LastTransactionID)
+			return &InternalReadHistoryBranchResponse{
+				History:           []*DataBlob{staleBlob},
+				LastNodeID:        5,
+				LastTransactionID: 105,
+			}, nil
+		}).Times(1),
+		mockStore.EXPECT().
+			AppendHistoryNodes(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, request *InternalAppendHistoryNodesRequest) error {
+			assert.False(t, request.IsNewBranch)
+			assert.Equal(t, "branch-id", request.BranchInfo.BranchID)
+			assert.Equal(t, int64(5), request.NodeID)
+			// the stale node is overwritten rather than shadowed by a node with a larger transaction ID
+			assert.Equal(t, int64(105), request.TransactionID)
+			assert.True(t, request.Overwrite)
+			assert.Equal(t, 10, request.ShardID)
+			reencoded, err := historyManager.historySerializer.DeserializeBatchEvents(request.Events)
+			assert.NoError(t, err)
+			assert.Equal(t, []byte("enc|result"), reencoded[0].ActivityTaskCompletedEventAttributes.Result)
+			return nil
+		}).Times(1),
+	)
+
+	resp, err := historyManager.ReencodeHistoryBranch(context.Background(), &ReencodeHistoryBranchRequest{
+		BranchToken: []byte("branch-token"),
+		ShardID:     common.Ptr(10),
+		DomainName:  "domain",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, &ReencodeHistoryBranchResponse{ReencodedNodeCount: 1}, resp)
+}
+
+func TestReencodeHistoryBranch_NoPayloadCodec(t *testing.T) {
+	historyManager, _, _, _ := setUpMocksForHistoryV2Manager(t)
+
+	_, err := historyManager.ReencodeHistoryBranch(context.Background(), &ReencodeHistoryBranchRequest{
+		BranchToken: []byte("branch-token"),
+		ShardID:     common.Ptr(10),
+	})
+	assert.ErrorContains(t, err, "payload codec is not configured")
+}
+
+func newOffloadedPayloadTestEvent(id int64, key string) *types.HistoryEvent {
+	return &types.HistoryEvent{
+		ID:      id,
+		Version: 1,
+		ActivityTaskCompletedEventAttributes: &types.ActivityTaskCompletedEventAttributes{
+			Result: append(append([]byte{}, offloadedPayloadMagic...), key...),
+		},
+	}
+}
+
+func TestAppendHistoryNodes_PayloadOffload(t *testing.T) {
+	historyManager, mockStore, _ := setUpPayloadCodecForHistoryV2Manager(t)
+	mockBlobstore := blobstore.NewMockClient(gomock.NewController(t))
+	historyManager.blobstoreClient = mockBlobstore
+	historyManager.offloadThreshold = dynamicproperties.GetIntPropertyFilteredByDomain(10)
+	events := []*types.HistoryEvent{
+		{
+			ID:      3,
+			Version: 1,
+			ActivityTaskCompletedEventAttributes: &types.ActivityTaskCompletedEventAttributes{
+				Result: []byte("large result"),
+			},
+		},
+		{
+			ID:      4,
+			Version: 1,
+			MarkerRecordedEventAttributes: &types.MarkerRecordedEventAttributes{
+				Details: []byte("small"),
+			},
+		},
+	}
+
+	// payloads are offloaded after they are encoded, so that the blobs are encoded too
+	mockBlobstore.EXPECT().Put(gomock.Any(), &blobstore.PutRequest{
+		Key:  "history-payloads/10/tree-id/branch-id/3/1234/0",
+		Blob: blobstore.Blob{Body: []byte("enc|large result")},
+	}).Return(&blobstore.PutResponse{}, nil).Times(1)
+	mockStore.EXPECT().
+		AppendHistoryNodes(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, request *InternalAppendHistoryNodesRequest) error {
+		persisted, err := historyManager.historySerializer.DeserializeBatchEvents(request.Events)
+		assert.NoError(t, err)
+		assert.Equal(t, newOffloadedPayloadTestEvent(3, "history-payloads/10/tree-id/branch-id/3/1234/0"), persisted[0])
+		assert.Equal(t, []byte("enc|small"), persisted[1].MarkerRecordedEventAttributes.Details)
+		return nil
+	}).Times(1)
+
+	resp, err := historyManager.AppendHistoryNodes(context.Background(), &AppendHistoryNodesRequest{
+		BranchToken:   []byte("branch-token"),
+		Events:        events,
+		TransactionID: 1234,
+		ShardID:       common.Ptr(10),
+		Encoding:      constants.EncodingTypeThriftRW,
+		DomainName:    "domain",
+	})
+	assert.NoError(t, err)
+	// the returned blob is replicated, so it must hold the payloads rather than references
+	assert.Equal(t, *newPayloadCodecTestBlob(t, events...), resp.DataBlob)
+}
+
+func TestAppendHistoryNodes_EscapesReservedPayloads(t *testing.T) {
+	historyManager, mockStore, _ := setUpPayloadCodecForHistoryV2Manager(t)
+	historyManager.payloadCodec = nil
+	// the mock fails the test if any blob is read
+	historyManager.blobstoreClient = blobstore.NewMockClient(gomock.NewController(t))
+	// a user payload which refers to a blob of another workflow
+	spoofed := newOffloadedPayloadTestEvent(3, "history-payloads/7/other-tree-id/branch-id/3/1/0")
+
+	var stored *DataBlob
+	mockStore.EXPECT().
+		AppendHistoryNodes(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, request *InternalAppendHistoryNodesRequest) error {
+		stored = request.Events
+		persisted, err := historyManager.historySerializer.DeserializeBatchEvents(request.Events)
+		assert.NoError(t, err)
+		assert.False(t, referencesOffloadedPayloads(persisted))
+		return nil
+	}).Times(1)
+	_, err := historyManager.AppendHistoryNodes(context.Background(), &AppendHistoryNodesRequest{
+		BranchToken:   []byte("branch-token"),
+		Events:        []*types.HistoryEvent{spoofed},
+		TransactionID: 1234,
+		ShardID:       common.Ptr(10),
+		Encoding:      constants.EncodingTypeThriftRW,
+		DomainName:    "domain",
+	})
+	assert.NoError(t, err)
+
+	historyManager.readRawHistoryBranchFn = func(ctx context.Context, request *ReadHistoryBranchRequest) ([]*DataBlob, *historyV2PagingToken, int, log.Logger, error) {
+		token, err := deserializeToken(nil, 0)
+		assert.NoError(t, err)
+		return []*DataBlob{stored}, token, len(stored.Data), log.NewNoop(), nil
+	}
+	resp, err := historyManager.ReadHistoryBranch(context.Background(), &ReadHistoryBranchRequest{
+		BranchToken: []byte("branch-token"),
+		PageSize:    10,
+		MinEventID:  1,
+		MaxEventID:  100,
+		ShardID:     common.Ptr(10),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []*types.HistoryEvent{spoofed}, resp.HistoryEvents)
+}
+
+func TestReadHistoryBranch_PayloadOffload(t *testing.T) {
+	historyManager, _, _ := setUpPayloadCodecForHistoryV2Manager(t)
+	mockBlobstore := blobstore.NewMockClient(gomock.NewController(t))
+	historyManager.blobstoreClient = mockBlobstore
+	storedBlob := newPayloadCodecTestBlob(t, newOffloadedPayloadTestEvent(1, "history-payloads/10/tree-id/ancestor-id/1/1/0"))
+	historyManager.readRawHistoryBranchFn = func(ctx context.Context, request *ReadHistoryBranchRequest) ([]*DataBlob, *historyV2PagingToken, int, log.Logger, error) {
+		token, err := deserializeToken(nil, 0)
+		assert.NoError(t, err)
+		return []*DataBlob{storedBlob}, token, len(storedBlob.Data), log.NewNoop(), nil
+	}
+	mockBlobstore.EXPECT().Get(gomock.Any(), &blobstore.GetRequest{Key: "history-payloads/10/tree-id/ancestor-id/1/1/0"}).
+		Return(&blobstore.GetResponse{Blob: blobstore.Blob{Body: []byte("enc|result")}}, nil).Times(2)
+	request := &ReadHistoryBranchRequest{
+		BranchToken: []byte("branch-token"),
+		PageSize:    10,
+		MinEventID:  1,
+		MaxEventID:  100,
+		ShardID:     common.Ptr(10),
+	}
+
+	resp, err := historyManager.ReadHistoryBranch(context.Background(), request)
+	assert.NoError(t, err)
+	assert.Len(t, resp.HistoryEvents, 1)
+	assert.Equal(t, []byte("result"), resp.HistoryEvents[0].ActivityTaskCompletedEventAttributes.Result)
+
+	rawResp, err := historyManager.ReadRawHistoryBranch(context.Background(), request)
+	assert.NoError(t, err)
+	assert.Len(t, rawResp.HistoryEventBlobs, 1)
+	rawEvents, err := historyManager.historySerializer.DeserializeBatchEvents(rawResp.HistoryEventBlobs[0])
+	assert.NoError(t, err)
+	assert.Equal(t, resp.HistoryEvents, rawEvents)
+}
+
+func TestDeleteHistoryBranch_PayloadOffload(t *testing.T) {
+	historyManager, mockStore, _ := setUpPayloadCodecForHistoryV2Manager(t)
+	mockBlobstore := blobstore.NewMockClient(gomock.NewController(t))
+	historyManager.blobstoreClient = mockBlobstore
+
+	mockStore.EXPECT().
+		GetHistoryTree(gomock.Any(), &InternalGetHistoryTreeRequest{TreeID: "tree-id", ShardID: common.Ptr(10)}).
+		Return(&InternalGetHistoryTreeResponse{
+			Branches: []*types.HistoryBranch{
+				{
+					TreeID:   "tree-id",
+					BranchID: "branch-id",
+					Ancestors: []*types.HistoryBranchRange{
+						{BranchID: "ancestor-id", BeginNodeID: 1, EndNodeID: 3},
+					},
+				},
+				// a fork of the deleted branch still reads its nodes before node 5
+				{
+					TreeID:   "tree-id",
+					BranchID: "fork-id",
+					Ancestors: []*types.HistoryBranchRange{
+						{BranchID: "ancestor-id", BeginNodeID: 1, EndNodeID: 3},
+						{BranchID: "branch-id", BeginNodeID: 3, EndNodeID: 5},
+					},
+				},
+			},
+		}, nil).Times(1)
+	mockStore.EXPECT().
+		ReadHistoryBranch(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, request *InternalReadHistoryBranchRequest) (*InternalReadHistoryBranchResponse, error) {
+		assert.Equal(t, request.MinNodeID-1, request.LastNodeID)
+		switch request.BranchID {
+		case "ancestor-id":
+			assert.Equal(t, int64(3), request.MaxNodeID)
+			return &InternalReadHistoryBranchResponse{
+				History: []*DataBlob{newPayloadCodecTestBlob(t, newOffloadedPayloadTestEvent(1, "history-payloads/10/tree-id/ancestor-id/1/1/0"))},
+			}, nil
+		case "branch-id":
+			assert.Equal(t, constants.EndEventID, request.MaxNodeID)
+			return &InternalReadHistoryBranchResponse{
+				History: []*DataBlob{
+					newPayloadCodecTestBlob(t, newOffloadedPayloadTestEvent(3, "history-payloads/10/tree-id/branch-id/3/1/0")),
+					newPayloadCodecTestBlob(t, newOffloadedPayloadTestEvent(5, "history-payloads/10/tree-id/branch-id/5/1/0")),
+				},
+			}, nil
+		}
+		return nil, errors.New("unexpected branch")
+	}).Times(2)
+	gomock.InOrder(
+		mockBlobstore.EXPECT().Exists(gomock.Any(), &blobstore.ExistsRequest{Key: "history-payloads/10/tree-id/branch-id/5/1/0"}).
+			Return(&blobstore.ExistsResponse{Exists: true}, nil).Times(1),
+		mockBlobstore.EXPECT().Delete(gomock.Any(), &blobstore.DeleteRequest{Key: "history-payloads/10/tree-id/branch-id/5/1/0"}).
+			Return(&blobstore.DeleteResponse{}, nil).Times(1),
+		mockStore.EXPECT().DeleteHistoryBranch(gomock.Any(), gomock.Any()).Return(nil).Times(1),
+	)
+
+	err := historyManager.DeleteHistoryBranch(context.Background(), &DeleteHistoryBranchRequest{
+		BranchToken: []byte("branch-token"),
+		ShardID:     common.Ptr(10),
+	})
+	assert.NoError(t, err)
+}
+
+func TestDeleteHistoryBranch_PayloadOffloadError(t *testing.T) {
+	historyManager, mockStore, _ := setUpPayloadCodecForHistoryV2Manager(t)
+	mockBlobstore := blobstore.NewMockClient(gomock.NewController(t))
+	historyManager.blobstoreClient = mockBlobstore
+
+	mockStore.EXPECT().GetHistoryTree(gomock.Any(), gomock.Any()).Return(&InternalGetHistoryTreeResponse{}, nil).Times(1)
+	mockStore.EXPECT().ReadHistoryBranch(gomock.Any(), gomock.Any()).Return(&InternalReadHistoryBranchResponse{
+		History: []*DataBlob{newPayloadCodecTestBlob(t, newOffloadedPayloadTestEvent(3, "history-payloads/10/tree-id/branch-id/3/1/0"))},
+	}, nil).Times(2)
+	mockBlobstore.EXPECT().Exists(gomock.Any(), gomock.Any()).Return(nil, errors.New("blobstore error")).Times(1)
+
+	// the branch is kept when its blobs cannot be deleted, so that the deletion is retried
+	err := historyManager.DeleteHistoryBranch(context.Background(), &DeleteHistoryBranchRequest{
+		BranchToken: []byte("branch-token"),
+		ShardID:     common.Ptr(10),
+	})
+	assert.ErrorContains(t, err, "blobstore error")
+}
+
+func TestDeleteOrphanedHistoryPayloads(t *testing.T) {
+	historyManager, mockStore, _ := setUpPayloadCodecForHistoryV2Manager(t)
+	mockBlobstore := blobstore.NewMockClient(gomock.NewController(t))
+	historyManager.blobstoreClient = mockBlobstore
+	now := time.Now()
+	offloadedBefore := now.Add(-time.Hour)
+	old := offloadedBefore.Add(-time.Minute)
+
+	referencedKey := "history-payloads/1/tree-id/branch-id/3/10/0"
+	supersededKey := "history-payloads/1/tree-id/branch-id/3/10/1"
+	failedAppendKey := "history-payloads/1/tree-id/branch-id/5/11/0"
+	deletedTreeKey := "history-payloads/1/deleted-tree-id/branch-id/3/12/0"
+	mockBlobstore.EXPECT().List(gomock.Any(), &blobstore.ListRequest{
+		Prefix:        "history-payloads/",
+		PageSize:      10,
+		NextPageToken: []byte("token"),
+	}).Return(&blobstore.ListResponse{
+		Blobs: []blobstore.BlobInfo{
+			{Key: deletedTreeKey, LastModified: old},
+			{Key: "history-payloads/invalid", LastModified: old},
+			{Key: referencedKey, LastModified: old},
+			{Key: supersededKey, LastModified: old},
+			{Key: failedAppendKey, LastModified: old},
+			// the append may not have persisted the node yet
+			{Key: "history-payloads/1/tree-id/branch-id/7/13/0", LastModified: now},
+		},
+		NextPageToken: []byte("next"),
+	}, nil).Times(1)
+	mockStore.EXPECT().GetHistoryTree(gomock.Any(), &InternalGetHistoryTreeRequest{
+		TreeID:  "deleted-tree-id",
+		ShardID: common.IntPtr(1),
+	}).Return(&InternalGetHistoryTreeResponse{}, nil).Times(1)
+	mockStore.EXPECT().GetHistoryTree(gomock.Any(), &InternalGetHistoryTreeRequest{
+		TreeID:  "tree-id",
+		ShardID: common.IntPtr(1),
+	}).Return(&InternalGetHistoryTreeResponse{
+		Branches: []*types.HistoryBranch{{TreeID: "tree-id", BranchID: "branch-id"}},
+	}, nil).Times(1)
+	mockStore.EXPECT().ReadHistoryBranch(gomock.Any(), &InternalReadHistoryBranchRequest{
+		TreeID:     "tree-id",
+		BranchID:   "branch-id",
+		MinNodeID:  3,
+		MaxNodeID:  4,
+		LastNodeID: 2,
+		ShardID:    1,
+		PageSize:   1,
+	}).Return(&InternalReadHistoryBranchResponse{
+		History:           []*DataBlob{newPayloadCodecTestBlob(t, newOffloadedPayloadTestEvent(3, referencedKey))},
+		LastNodeID:        3,
+		LastTransactionID: 10,
+	}, nil).Times(1)
+	// the node was overwritten by an append with a larger transaction ID
+	mockStore.EXPECT().ReadHistoryBranch(gomock.Any(), &InternalReadHistoryBranchRequest{
+		TreeID:     "tree-id",
+		BranchID:   "branch-id",
+		MinNodeID:  5,
+		MaxNodeID:  6,
+		LastNodeID: 4,
+		ShardID:    1,
+		PageSize:   1,
+	}).Return(&InternalReadHistoryBranchResponse{
+		History:           []*DataBlob{newPayloadCodecTestBlob(t, newOffloadedPayloadTestEvent(5, "history-payloads/1/tree-id/branch-id/5/14/0"))},
+		LastNodeID:        5,
+		LastTransactionID: 14,
+	}, nil).Times(1)
+	for _, key := range []string{deletedTreeKey, supersededKey, failedAppendKey} {
+		mockBlobstore.EXPECT().Exists(gomock.Any(), &blobstore.ExistsRequest{Key: key}).Return(&blobstore.ExistsResponse{Exists: true}, nil).Times(1)
+		mockBlobstore.EXPECT().Delete(gomock.Any(), &blobstore.DeleteRequest{Key: key}).Return(&blobstore.DeleteResponse{}, nil).Times(1)
+	}
+
+	resp, err := historyManager.DeleteOrphanedHistoryPayloads(context.Background(), &DeleteOrphanedHistoryPayloadsRequest{
+		OffloadedBefore: offloadedBefore,
+		PageSize:        10,
+		NextPageToken:   []byte("token"),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, &DeleteOrphanedHistoryPayloadsResponse{
+		ScannedCount:  5,
+		DeletedCount:  3,
+		NextPageToken: []byte("next"),
+	}, resp)
+}
+
+func TestDeleteOrphanedHistoryPayloads_NotConfigured(t *testing.T) {
+	historyManager, _, _ := setUpPayloadCodecForHistoryV2Manager(t)
+
+	resp, err := historyManager.DeleteOrphanedHistoryPayloads(context.Background(), &DeleteOrphanedHistoryPayloadsRequest{PageSize: 10})
+	assert.NoError(t, err)
+	assert.Equal(t, &DeleteOrphanedHistoryPayloadsResponse{}, resp)
+}
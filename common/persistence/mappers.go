@@ -182,3 +182,36 @@ func FromDescribeDomainResponse(resp *types.DescribeDomainResponse) *GetDomainRe
 
 	return result
 }
+
+// NewRecordWorkflowExecutionClosedRequest builds the request which writes the closed visibility record of a workflow
+// execution again from the record read from a visibility store. TaskID is left as zero.
+func NewRecordWorkflowExecutionClosedRequest(
+	domainID string,
+	domainName string,
+	retentionSeconds int64,
+	execution *types.WorkflowExecutionInfo,
+) *RecordWorkflowExecutionClosedRequest {
+	request := &RecordWorkflowExecutionClosedRequest{
+		DomainUUID:                  domainID,
+		Domain:                      domainName,
+		Execution:                   *execution.GetExecution(),
+		WorkflowTypeName:            execution.GetType().GetName(),
+		StartTimestamp:              execution.GetStartTime(),
+		ExecutionTimestamp:          execution.GetExecutionTime(),
+		CloseTimestamp:              execution.GetCloseTime(),
+		Status:                      execution.GetCloseStatus(),
+		HistoryLength:               execution.HistoryLength,
+		RetentionSeconds:            retentionSeconds,
+		Memo:                        execution.Memo,
+		TaskList:                    execution.TaskList.GetName(),
+		IsCron:                      execution.IsCron,
+		CronSchedule:                execution.GetCronSchedule(),
+		UpdateTimestamp:             execution.GetUpdateTime(),
+		ExecutionStatus:             execution.GetExecutionStatus(),
+		ScheduledExecutionTimestamp: execution.GetScheduledExecutionTime(),
+	}
+	if execution.SearchAttributes != nil {
+		request.SearchAttributes = execution.SearchAttributes.IndexedFields
+	}
+	return request
+}
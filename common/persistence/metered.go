@@ -276,6 +276,10 @@ func (r GetHistoryTreeRequest) MetricTags() []metrics.Tag {
 	return []metrics.Tag{metrics.DomainTag(r.DomainName)}
 }
 
+func (r ReencodeHistoryBranchRequest) MetricTags() []metrics.Tag {
+	return []metrics.Tag{metrics.DomainTag(r.DomainName)}
+}
+
 func (r CompleteTaskRequest) MetricTags() []metrics.Tag {
 	return []metrics.Tag{metrics.DomainTag(r.DomainName)}
 }
@@ -271,6 +271,10 @@ func (d *nosqlExecutionStore) prepareReplicationTasksForWorkflowTxn(domainID, wo
 		case persistence.ReplicationTaskTypeFailoverMarker:
 			version = task.GetVersion()
 
+		case persistence.ReplicationTaskTypeDeleteWorkflowExecution,
+			persistence.ReplicationTaskTypeUpsertWorkflowSearchAttributes:
+			version = task.GetVersion()
+
 		default:
 			return nil, &types.InternalServiceError{
 				Message: fmt.Sprintf("Unknown replication type: %v", task.GetTaskType()),
@@ -392,3 +392,10 @@ func (v *nosqlVisibilityStore) CountWorkflowExecutions(
 ) (*persistence.CountWorkflowExecutionsResponse, error) {
 	return nil, persistence.ErrVisibilityOperationNotSupported
 }
+
+func (v *nosqlVisibilityStore) AggregateWorkflowExecutions(
+	_ context.Context,
+	_ *persistence.AggregateWorkflowExecutionsRequest,
+) (*persistence.AggregateWorkflowExecutionsResponse, error) {
+	return nil, persistence.ErrVisibilityOperationNotSupported
+}
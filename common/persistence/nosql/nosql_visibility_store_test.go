@@ -584,3 +584,10 @@ func TestCountWorkflowExecutions(t *testing.T) {
 	assert.Error(t, err)
 	assert.Equal(t, persistence.ErrVisibilityOperationNotSupported, err)
 }
+
+func TestAggregateWorkflowExecutions(t *testing.T) {
+	visibilityStore, _ := setupNoSQLVisibilityStoreMocks(t)
+	_, err := visibilityStore.AggregateWorkflowExecutions(context.Background(), &persistence.AggregateWorkflowExecutionsRequest{})
+	assert.Error(t, err)
+	assert.Equal(t, persistence.ErrVisibilityOperationNotSupported, err)
+}
@@ -86,7 +86,12 @@ const (
 		`memo: ?, ` +
 		`partition_config: ?, ` +
 		`active_cluster_selection_policy: ?, ` +
-		`active_cluster_selection_policy_encoding: ?` +
+		`active_cluster_selection_policy_encoding: ?, ` +
+		`paused: ?, ` +
+		`retry_jitter_coefficient: ?, ` +
+		`retryable_errors: ?, ` +
+		`retry_error_reason_overrides: ?, ` +
+		`completed_updates: ?` +
 		`}`
 
 	templateTransferTaskType = `{` +
@@ -178,6 +183,12 @@ const (
 		`last_failure_details: ?, ` +
 		`last_failure_category: ?, ` +
 		`last_retry_interval_seconds: ?, ` +
+		`priority: ?, ` +
+		`fairness_key: ?, ` +
+		`paused: ?, ` +
+		`retry_jitter_coefficient: ?, ` +
+		`retryable_errors: ?, ` +
+		`retry_error_reason_overrides: ?, ` +
 		`event_data_encoding: ?` +
 		`}`
 
@@ -189,6 +189,16 @@ func parseWorkflowExecutionInfo(result map[string]interface{}) (*persistence.Int
 			activeClusterSelectionPolicyEncoding = constants.EncodingType(v.(string))
 		case "cron_overlap_policy":
 			info.CronOverlapPolicy = types.CronOverlapPolicy(int32(v.(int)))
+		case "paused":
+			info.Paused = v.(bool)
+		case "retry_jitter_coefficient":
+			info.RetryJitterCoefficient = v.(float64)
+		case "retryable_errors":
+			info.RetryableErrors = v.([]string)
+		case "retry_error_reason_overrides":
+			info.RetryErrorReasonOverrides = parseRetryPolicyOverrides(v.([]map[string]interface{}))
+		case "completed_updates":
+			info.CompletedUpdates = parseCompletedUpdates(v.([]map[string]interface{}))
 		}
 	}
 	info.CompletionEvent = persistence.NewDataBlob(completionEventData, completionEventEncoding)
@@ -331,6 +341,18 @@ func parseActivityInfo(
 			info.LastFailureCategory = types.FailureCategory(v.(int))
 		case "last_retry_interval_seconds":
 			info.LastRetryIntervalSeconds = int32(v.(int))
+		case "priority":
+			info.Priority = int32(v.(int))
+		case "fairness_key":
+			info.FairnessKey = v.(string)
+		case "paused":
+			info.Paused = v.(bool)
+		case "retry_jitter_coefficient":
+			info.RetryJitterCoefficient = v.(float64)
+		case "retryable_errors":
+			info.RetryableErrors = v.([]string)
+		case "retry_error_reason_overrides":
+			info.RetryErrorReasonOverrides = parseRetryPolicyOverrides(v.([]map[string]interface{}))
 		case "event_data_encoding":
 			sharedEncoding = constants.EncodingType(v.(string))
 		}
@@ -608,6 +630,57 @@ func parseReplicationTaskInfo(
 	return info
 }
 
+func parseCompletedUpdates(result []map[string]interface{}) []*persistence.CompletedUpdate {
+	var updates []*persistence.CompletedUpdate
+	for _, m := range result {
+		u := &persistence.CompletedUpdate{}
+		failed := false
+		failureReason := ""
+		for k, v := range m {
+			switch k {
+			case "update_id":
+				u.UpdateID = v.(string)
+			case "result":
+				u.Result = v.([]byte)
+			case "failed":
+				failed = v.(bool)
+			case "failure_reason":
+				failureReason = v.(string)
+			case "failure_details":
+				u.FailureDetails = v.([]byte)
+			}
+		}
+		if failed {
+			u.FailureReason = &failureReason
+		}
+		updates = append(updates, u)
+	}
+	return updates
+}
+
+func parseRetryPolicyOverrides(result []map[string]interface{}) []*types.RetryPolicyOverride {
+	var overrides []*types.RetryPolicyOverride
+	for _, m := range result {
+		o := &types.RetryPolicyOverride{}
+		for k, v := range m {
+			switch k {
+			case "reason_pattern":
+				o.ReasonPattern = v.(string)
+			case "init_interval":
+				o.InitialIntervalInSeconds = int32(v.(int))
+			case "backoff_coefficient":
+				o.BackoffCoefficient = v.(float64)
+			case "max_interval":
+				o.MaximumIntervalInSeconds = int32(v.(int))
+			case "max_attempts":
+				o.MaximumAttempts = int32(v.(int))
+			}
+		}
+		overrides = append(overrides, o)
+	}
+	return overrides
+}
+
 func parseChecksum(result map[string]interface{}) checksum.Checksum {
 	csum := checksum.Checksum{}
 	if len(result) == 0 {
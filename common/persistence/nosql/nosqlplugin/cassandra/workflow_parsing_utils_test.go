@@ -125,6 +125,7 @@ func Test_parseWorkflowExecutionInfo(t *testing.T) {
 					"auto_reset_points_encoding":               "Proto3",
 					"active_cluster_selection_policy":          activeClusterSelectionPolicyData,
 					"active_cluster_selection_policy_encoding": "Proto3",
+					"paused":                                   true,
 				},
 				"next_event_id": int64(5),
 			},
@@ -180,6 +181,7 @@ func Test_parseWorkflowExecutionInfo(t *testing.T) {
 				Memo:                               memo,
 				PartitionConfig:                    partitionConfig,
 				ActiveClusterSelectionPolicy:       persistence.NewDataBlob(activeClusterSelectionPolicyData, "Proto3"),
+				Paused:                             true,
 			},
 		},
 		{
@@ -284,6 +286,7 @@ func Test_parseWorkflowExecutionInfo(t *testing.T) {
 			assert.Equal(t, result.DecisionAttempt, tt.want.DecisionAttempt)
 			assert.Equal(t, result.ParentDomainID, tt.want.ParentDomainID)
 			assert.Equal(t, result.ActiveClusterSelectionPolicy, tt.want.ActiveClusterSelectionPolicy)
+			assert.Equal(t, result.Paused, tt.want.Paused)
 		})
 	}
 }
@@ -423,6 +426,9 @@ func Test_parseActivityInfo(t *testing.T) {
 		"last_failure_details":        []byte("last_failure_details"),
 		"last_failure_category":       2,
 		"last_retry_interval_seconds": 14,
+		"priority":                    2,
+		"fairness_key":                "fairness_key",
+		"paused":                      true,
 		"event_data_encoding":         "Proto3",
 	}
 
@@ -462,6 +468,9 @@ func Test_parseActivityInfo(t *testing.T) {
 		LastFailureDetails:       []byte("last_failure_details"),
 		LastFailureCategory:      types.FailureCategoryFatal,
 		LastRetryIntervalSeconds: int32(14),
+		Priority:                 int32(2),
+		FairnessKey:              "fairness_key",
+		Paused:                   true,
 		DomainID:                 "domain_id",
 	}
 
@@ -680,6 +689,55 @@ func Test_parseTransferTaskInfo(t *testing.T) {
 	assert.Equal(t, expected, parseTransferTaskInfo(testInput))
 }
 
+func Test_parseRetryPolicyOverrides(t *testing.T) {
+	overrides := []*types.RetryPolicyOverride{
+		{
+			ReasonPattern:            "timeout.*",
+			InitialIntervalInSeconds: 5,
+			BackoffCoefficient:       3,
+			MaximumIntervalInSeconds: 60,
+			MaximumAttempts:          10,
+		},
+		{
+			ReasonPattern: "throttled",
+		},
+	}
+	assert.Equal(t, overrides, parseRetryPolicyOverrides(toCassandraUDTList(serializeRetryPolicyOverrides(overrides))))
+	assert.Nil(t, parseRetryPolicyOverrides(nil))
+}
+
+func Test_parseCompletedUpdates(t *testing.T) {
+	updates := []*persistence.CompletedUpdate{
+		{
+			UpdateID: "update-1",
+			Result:   []byte("result"),
+		},
+		{
+			UpdateID:       "update-2",
+			FailureReason:  common.StringPtr(""),
+			FailureDetails: []byte("details"),
+		},
+	}
+	assert.Equal(t, updates, parseCompletedUpdates(toCassandraUDTList(serializeCompletedUpdates(updates))))
+	assert.Nil(t, parseCompletedUpdates(nil))
+}
+
+// toCassandraUDTList mirrors how gocql hands back UDT fields, with int columns as int
+func toCassandraUDTList(serialized []map[string]interface{}) []map[string]interface{} {
+	var result []map[string]interface{}
+	for _, m := range serialized {
+		converted := make(map[string]interface{}, len(m))
+		for k, v := range m {
+			if i, ok := v.(int32); ok {
+				v = int(i)
+			}
+			converted[k] = v
+		}
+		result = append(result, converted)
+	}
+	return result
+}
+
 func Test_parseChecksum(t *testing.T) {
 	testInput := map[string]interface{}{
 		"version": 1,
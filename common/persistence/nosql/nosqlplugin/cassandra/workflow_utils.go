@@ -1103,6 +1103,12 @@ func resetActivityInfoMap(activityInfos map[int64]*persistence.InternalActivityI
 		aInfo["last_failure_details"] = a.LastFailureDetails
 		aInfo["last_failure_category"] = int32(a.LastFailureCategory)
 		aInfo["last_retry_interval_seconds"] = a.LastRetryIntervalSeconds
+		aInfo["priority"] = a.Priority
+		aInfo["fairness_key"] = a.FairnessKey
+		aInfo["paused"] = a.Paused
+		aInfo["retry_jitter_coefficient"] = a.RetryJitterCoefficient
+		aInfo["retryable_errors"] = a.RetryableErrors
+		aInfo["retry_error_reason_overrides"] = serializeRetryPolicyOverrides(a.RetryErrorReasonOverrides)
 
 		aMap[a.ScheduleID] = aInfo
 	}
@@ -1158,6 +1164,12 @@ func updateActivityInfos(
 			a.LastFailureDetails,
 			int32(a.LastFailureCategory),
 			a.LastRetryIntervalSeconds,
+			a.Priority,
+			a.FairnessKey,
+			a.Paused,
+			a.RetryJitterCoefficient,
+			a.RetryableErrors,
+			serializeRetryPolicyOverrides(a.RetryErrorReasonOverrides),
 			a.ScheduledEvent.GetEncodingString(),
 			timeStamp,
 			shardID,
@@ -1473,6 +1485,11 @@ func updateWorkflowExecution(
 		execution.PartitionConfig,
 		execution.ActiveClusterSelectionPolicy.GetData(),
 		execution.ActiveClusterSelectionPolicy.GetEncodingString(),
+		execution.Paused,
+		execution.RetryJitterCoefficient,
+		execution.RetryableErrors,
+		serializeRetryPolicyOverrides(execution.RetryErrorReasonOverrides),
+		serializeCompletedUpdates(execution.CompletedUpdates),
 		execution.NextEventID,
 		execution.VersionHistories.Data,
 		execution.VersionHistories.GetEncodingString(),
@@ -1575,6 +1592,11 @@ func createWorkflowExecution(
 		execution.PartitionConfig,
 		execution.ActiveClusterSelectionPolicy.GetData(),
 		execution.ActiveClusterSelectionPolicy.GetEncodingString(),
+		execution.Paused,
+		execution.RetryJitterCoefficient,
+		execution.RetryableErrors,
+		serializeRetryPolicyOverrides(execution.RetryErrorReasonOverrides),
+		serializeCompletedUpdates(execution.CompletedUpdates),
 		execution.NextEventID,
 		defaultVisibilityTimestamp,
 		rowTypeExecutionTaskID,
@@ -1781,6 +1803,40 @@ func createOrUpdateCurrentWorkflow(
 	return nil
 }
 
+// serializeCompletedUpdates flattens the completed updates into the completed_update UDT representation
+func serializeCompletedUpdates(updates []*persistence.CompletedUpdate) []map[string]interface{} {
+	var serialized []map[string]interface{}
+	for _, u := range updates {
+		failureReason := ""
+		if u.FailureReason != nil {
+			failureReason = *u.FailureReason
+		}
+		serialized = append(serialized, map[string]interface{}{
+			"update_id":       u.UpdateID,
+			"result":          u.Result,
+			"failed":          u.FailureReason != nil,
+			"failure_reason":  failureReason,
+			"failure_details": u.FailureDetails,
+		})
+	}
+	return serialized
+}
+
+// serializeRetryPolicyOverrides flattens the overrides into the retry_policy_override UDT representation
+func serializeRetryPolicyOverrides(overrides []*types.RetryPolicyOverride) []map[string]interface{} {
+	var serialized []map[string]interface{}
+	for _, o := range overrides {
+		serialized = append(serialized, map[string]interface{}{
+			"reason_pattern":      o.GetReasonPattern(),
+			"init_interval":       o.GetInitialIntervalInSeconds(),
+			"backoff_coefficient": o.GetBackoffCoefficient(),
+			"max_interval":        o.GetMaximumIntervalInSeconds(),
+			"max_attempts":        o.GetMaximumAttempts(),
+		})
+	}
+	return serialized
+}
+
 func mustConvertToSlice(value interface{}) []interface{} {
 	v := reflect.ValueOf(value)
 	switch v.Kind() {
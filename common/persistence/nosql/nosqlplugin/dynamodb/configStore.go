@@ -22,15 +22,62 @@ package dynamodb
 
 import (
 	"context"
-	"errors"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
 
 	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+)
+
+// Attribute names of config_store table
+const (
+	attrRowType   = "row_type"
+	attrTimestamp = "timestamp"
+	attrValues    = "values"
 )
 
 func (db *ddb) InsertConfig(ctx context.Context, row *persistence.InternalConfigStoreEntry) error {
-	return errors.New("TODO")
+	it := item{
+		attrRowType:   attrN(int64(row.RowType)),
+		attrVersion:   attrN(row.Version),
+		attrTimestamp: attrTime(row.Timestamp),
+	}
+	setDataBlob(it, attrValues, attrEncoding, row.Values)
+	_, err := db.client.PutItemWithContext(ctx, &dynamodb.PutItemInput{
+		TableName:                db.tableName(tableConfigStore),
+		Item:                     it,
+		ConditionExpression:      aws.String("attribute_not_exists(#version)"),
+		ExpressionAttributeNames: map[string]*string{"#version": aws.String(attrVersion)},
+	})
+	if isConditionalCheckFailed(err) {
+		return nosqlplugin.NewConditionFailure("InsertConfig operation failed because of version collision")
+	}
+	return err
 }
 
 func (db *ddb) SelectLatestConfig(ctx context.Context, rowType int) (*persistence.InternalConfigStoreEntry, error) {
-	return nil, errors.New("TODO")
+	resp, err := db.client.QueryWithContext(ctx, &dynamodb.QueryInput{
+		TableName:                 db.tableName(tableConfigStore),
+		KeyConditionExpression:    aws.String("#row_type = :row_type"),
+		ExpressionAttributeNames:  map[string]*string{"#row_type": aws.String(attrRowType)},
+		ExpressionAttributeValues: map[string]*dynamodb.AttributeValue{":row_type": attrN(int64(rowType))},
+		// the latest version first
+		ScanIndexForward: aws.Bool(false),
+		Limit:            aws.Int64(1),
+		ConsistentRead:   aws.Bool(true),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.Items) == 0 {
+		return nil, errNotFound
+	}
+	it := resp.Items[0]
+	return &persistence.InternalConfigStoreEntry{
+		RowType:   rowType,
+		Version:   getN(it, attrVersion),
+		Timestamp: getTime(it, attrTimestamp),
+		Values:    getDataBlob(it, attrValues, attrEncoding),
+	}, nil
 }
@@ -0,0 +1,124 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamodb
+
+import (
+	"time"
+)
+
+// Table names, the actual table name is prefixed by the keyspace of the config
+const (
+	tableExecutions             = "executions"
+	tableHistoryTree            = "history_tree"
+	tableHistoryNode            = "history_node"
+	tableTaskLists              = "task_lists"
+	tableTasks                  = "tasks"
+	tableQueueMessages          = "queue_messages"
+	tableQueueMetadata          = "queue_metadata"
+	tableDomains                = "domains"
+	tableDomainsByName          = "domains_by_name"
+	tableVisibility             = "visibility"
+	tableConfigStore            = "config_store"
+	tableDomainAuditLog         = "domain_audit_log"
+	tableSemaphoreMetadata      = "semaphore_metadata"
+	tableHistoryTaskDLQ         = "history_task_dlq"
+	tableHistoryTaskDLQAckLevel = "history_task_dlq_ack_level"
+	tableSchemaVersion          = "schema_version"
+	tableSchemaUpdateHistory    = "schema_update_history"
+)
+
+// Index names of visibility table
+const (
+	indexVisibilityStartTime = "start_time_index"
+	indexVisibilityCloseTime = "close_time_index"
+)
+
+// Sort key prefixes of the different row types living in executions table.
+// All of them share the same partition(shard_id) so that a single transaction can cover
+// the shard range check, the workflow rows and the history tasks.
+const (
+	rowTypeShard                        = "shard"
+	rowTypeCurrentWorkflow              = "current"
+	rowTypeExecution                    = "execution"
+	rowTypeExecutionEntry               = "execution_entry"
+	rowTypeWorkflowTimerTask            = "workflow_timer"
+	rowTypeTransferTask                 = "transfer"
+	rowTypeTimerTask                    = "timer"
+	rowTypeReplicationTask              = "replication"
+	rowTypeReplicationDLQTask           = "replication_dlq"
+	rowTypeCrossClusterTask             = "cross_cluster"
+	rowTypeWorkflowRequest              = "request"
+	rowTypeActiveClusterSelectionPolicy = "acsp"
+)
+
+const (
+	// keySeparator joins the components of a composite key.
+	keySeparator = "#"
+
+	// permanentRunID is returned as RunID of the current workflow rows in SelectAllCurrentWorkflows,
+	// to be consistent with Cassandra.
+	permanentRunID = "30000000-0000-f000-f000-000000000001"
+
+	// DynamoDB doesn't allow more than 100 items in one transaction
+	maxTransactionItems = 100
+	// DynamoDB doesn't allow more than 25 items in one BatchWriteItem request
+	maxBatchWriteItems = 25
+	// DynamoDB doesn't allow a transaction larger than 4MB, some room is left for the expressions and attribute names
+	maxTransactionDataSize = 3 * 1024 * 1024
+	// DynamoDB doesn't allow an item larger than 400KB, larger data is split into chunks of this size,
+	// leaving room for the keys and the other attributes of the item
+	maxItemDataSize = 350 * 1024
+	// execution info larger than this is moved out of the execution row, to leave room for the index of the entries
+	maxInlineExecutionInfoSize = 64 * 1024
+	// orphanEntryGracePeriod is how long an entry item not referred by the execution row is kept,
+	// writes too large for one transaction put the entry items before updating the execution row
+	orphanEntryGracePeriod = 10 * time.Minute
+	// maxOrphanEntryDeletes bounds the number of orphan entry items removed by one read of the mutable state
+	maxOrphanEntryDeletes = 100
+
+	// cancellation reason code of a transaction which conflicts with another ongoing transaction
+	cancellationReasonTransactionConflict = "TransactionConflict"
+	// cancellation reason code of a transaction operation which failed on its condition
+	cancellationReasonConditionalCheckFailed = "ConditionalCheckFailed"
+
+	workflowRequestTTL = 3 * time.Hour
+
+	// domainsByNamePartition is the single partition of domains_by_name table, so that all domains can be paged through
+	domainsByNamePartition = 0
+	// domainMetadataRecordName is the name of the record holding the notification version in domains_by_name table
+	domainMetadataRecordName = "cadence-domain-metadata"
+)
+
+// Attribute names shared by the tables
+const (
+	attrShardID          = "shard_id"
+	attrSortKey          = "sk"
+	attrPartitionKey     = "pk"
+	attrRangeID          = "range_id"
+	attrData             = "data"
+	attrDataEncoding     = "data_encoding"
+	attrExpiry           = "expiry"
+	attrCreatedTime      = "created_time"
+	attrLastUpdatedTime  = "last_updated_time"
+	attrVersion          = "version"
+	attrRunID            = "run_id"
+	attrCurrentTimestamp = "current_timestamp"
+)
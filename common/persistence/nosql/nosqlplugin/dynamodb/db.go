@@ -23,34 +23,117 @@ package dynamodb
 import (
 	"errors"
 	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbiface"
 
 	"github.com/uber/cadence/common/config"
 	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
 )
 
 const (
 	// PluginName is the name of the plugin
 	PluginName = "dynamodb"
+
+	defaultRegion = "us-east-1"
 )
 
 var (
 	errConditionFailed = errors.New("internal condition fail error")
+	// errNotFound is returned by single-item reads when the item doesn't exist
+	errNotFound = errors.New("dynamodb item not found")
 )
 
 // ddb represents a logical connection to DynamoDB database
 type ddb struct {
+	client dynamodbiface.DynamoDBAPI
+	cfg    *config.NoSQL
+	logger log.Logger
+	dc     *persistence.DynamicConfiguration
+	// tablePrefix namespaces all tables of a cluster, DynamoDB has no keyspace concept
+	tablePrefix string
 }
 
 var _ nosqlplugin.DB = (*ddb)(nil)
 
 // NewDynamoDB return a new DB
 func NewDynamoDB(cfg config.NoSQL, logger log.Logger) (nosqlplugin.DB, error) {
-	return nil, fmt.Errorf("TODO")
+	return newDynamoDB(&cfg, logger, nil)
+}
+
+func newDynamoDB(cfg *config.NoSQL, logger log.Logger, dc *persistence.DynamicConfiguration) (*ddb, error) {
+	if cfg.Keyspace == "" {
+		return nil, fmt.Errorf("keyspace(table prefix) cannot be empty")
+	}
+	sess, err := session.NewSession(newAWSConfig(cfg))
+	if err != nil {
+		return nil, err
+	}
+	return newDynamoDBWithClient(cfg, dynamodb.New(sess), logger, dc), nil
+}
+
+func newDynamoDBWithClient(
+	cfg *config.NoSQL,
+	client dynamodbiface.DynamoDBAPI,
+	logger log.Logger,
+	dc *persistence.DynamicConfiguration,
+) *ddb {
+	return &ddb{
+		client:      client,
+		cfg:         cfg,
+		logger:      logger,
+		dc:          dc,
+		tablePrefix: cfg.Keyspace + "_",
+	}
+}
+
+// newAWSConfig translates the NoSQL config into AWS client config.
+// Hosts is treated as the endpoint override(e.g. DynamoDB Local), and User/Password as static access keys.
+// When they are empty, the default AWS endpoint and credential chain are used.
+func newAWSConfig(cfg *config.NoSQL) *aws.Config {
+	region := cfg.Region
+	if region == "" {
+		region = defaultRegion
+	}
+	awsCfg := aws.NewConfig().WithRegion(region)
+	if cfg.Hosts != "" {
+		awsCfg = awsCfg.WithEndpoint(endpointFromConfig(cfg))
+	}
+	if cfg.User != "" {
+		awsCfg = awsCfg.WithCredentials(credentials.NewStaticCredentials(cfg.User, cfg.Password, ""))
+	}
+	if cfg.Timeout > 0 {
+		awsCfg = awsCfg.WithHTTPClient(&http.Client{Timeout: cfg.Timeout})
+	}
+	return awsCfg
+}
+
+func endpointFromConfig(cfg *config.NoSQL) string {
+	host := strings.Split(cfg.Hosts, ",")[0]
+	if strings.Contains(host, "://") {
+		return host
+	}
+	scheme := "http"
+	if cfg.TLS != nil && cfg.TLS.Enabled {
+		scheme = "https"
+	}
+	if cfg.Port > 0 {
+		return fmt.Sprintf("%v://%v:%v", scheme, host, cfg.Port)
+	}
+	return fmt.Sprintf("%v://%v", scheme, host)
 }
 
 func (db *ddb) Close() {
-	panic("TODO")
+	// the AWS client is stateless, nothing to release
 }
 
 func (db *ddb) PluginName() string {
@@ -58,21 +141,66 @@ func (db *ddb) PluginName() string {
 }
 
 func (db *ddb) IsNotFoundError(err error) bool {
-	panic("TODO")
+	return err == errNotFound
 }
 
 func (db *ddb) IsTimeoutError(err error) bool {
-	panic("TODO")
+	if err == nil {
+		return false
+	}
+	if isAWSErrorCode(err, request.CanceledErrorCode) {
+		return true
+	}
+	return strings.Contains(err.Error(), "timeout") || strings.Contains(err.Error(), "deadline exceeded")
 }
 
 func (db *ddb) IsThrottlingError(err error) bool {
-	panic("TODO")
+	if isAWSErrorCode(err,
+		dynamodb.ErrCodeProvisionedThroughputExceededException,
+		dynamodb.ErrCodeRequestLimitExceeded,
+		"ThrottlingException",
+	) {
+		return true
+	}
+	var txnErr *dynamodb.TransactionCanceledException
+	if errors.As(err, &txnErr) {
+		for _, reason := range txnErr.CancellationReasons {
+			if aws.StringValue(reason.Code) == cancellationReasonTransactionConflict ||
+				aws.StringValue(reason.Code) == dynamodb.ErrCodeProvisionedThroughputExceededException ||
+				aws.StringValue(reason.Code) == "ThrottlingError" {
+				return true
+			}
+		}
+	}
+	return false
 }
 
 func (db *ddb) IsDBUnavailableError(err error) bool {
-	panic("TODO")
+	return isAWSErrorCode(err, dynamodb.ErrCodeInternalServerError, "ServiceUnavailable")
 }
 
 func (db *ddb) IsConditionFailedError(err error) bool {
 	return err == errConditionFailed
 }
+
+func isAWSErrorCode(err error, codes ...string) bool {
+	var awsErr awserr.Error
+	if !errors.As(err, &awsErr) {
+		return false
+	}
+	for _, code := range codes {
+		if awsErr.Code() == code {
+			return true
+		}
+	}
+	return false
+}
+
+func isConditionalCheckFailed(err error) bool {
+	return isAWSErrorCode(err, dynamodb.ErrCodeConditionalCheckFailedException)
+}
+
+// tableName returns the full name of a table of this cluster
+func (db *ddb) tableName(name string) *string {
+	return aws.String(db.tablePrefix + name)
+}
@@ -22,8 +22,27 @@ package dynamodb
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	attrDomainID            = "domain_id"
+	attrDomainName          = "name"
+	attrDomainPartition     = "partition"
+	attrDomain              = "domain"
+	attrNotificationVersion = "notification_version"
 )
 
 // Insert a new record to domain, return error if failed or already exists
@@ -32,7 +51,69 @@ func (db *ddb) InsertDomain(
 	ctx context.Context,
 	row *nosqlplugin.DomainRow,
 ) error {
-	panic("TODO")
+	_, err := db.client.PutItemWithContext(ctx, &dynamodb.PutItemInput{
+		TableName: db.tableName(tableDomains),
+		Item: item{
+			attrDomainID:    attrS(row.Info.ID),
+			attrDomainName:  attrS(row.Info.Name),
+			attrCreatedTime: attrTime(row.CurrentTimeStamp),
+		},
+		ConditionExpression:      aws.String("attribute_not_exists(#domain_id)"),
+		ExpressionAttributeNames: map[string]*string{"#domain_id": aws.String(attrDomainID)},
+	})
+	if err != nil {
+		if isConditionalCheckFailed(err) {
+			return fmt.Errorf("CreateDomain operation failed because of uuid collision")
+		}
+		return err
+	}
+
+	metadataNotificationVersion, err := db.SelectDomainMetadata(ctx)
+	if err != nil {
+		return err
+	}
+
+	newRow := *row
+	newRow.FailoverNotificationVersion = persistence.InitialFailoverNotificationVersion
+	newRow.PreviousFailoverVersion = constants.InitialPreviousFailoverVersion
+	newRow.NotificationVersion = metadataNotificationVersion
+	it, err := newDomainItem(&newRow)
+	if err != nil {
+		return err
+	}
+
+	err = db.transactWrite(ctx, []*dynamodb.TransactWriteItem{
+		{
+			Put: &dynamodb.Put{
+				TableName:                           db.tableName(tableDomainsByName),
+				Item:                                it,
+				ConditionExpression:                 aws.String("attribute_not_exists(#name)"),
+				ExpressionAttributeNames:            map[string]*string{"#name": aws.String(attrDomainName)},
+				ReturnValuesOnConditionCheckFailure: aws.String(dynamodb.ReturnValuesOnConditionCheckFailureAllOld),
+			},
+		},
+		db.updateDomainMetadata(metadataNotificationVersion),
+	})
+	if err != nil {
+		var txnErr *dynamodb.TransactionCanceledException
+		if !errors.As(err, &txnErr) {
+			return err
+		}
+		// Domain already exist.  Delete orphan domain record before returning back to user
+		if errDelete := db.deleteItem(ctx, tableDomains, item{attrDomainID: attrS(row.Info.ID)}); errDelete != nil {
+			db.logger.Warn("Unable to delete orphan domain record. Error", tag.Error(errDelete))
+		}
+		if len(txnErr.CancellationReasons) > 0 &&
+			aws.StringValue(txnErr.CancellationReasons[0].Code) == cancellationReasonConditionalCheckFailed {
+			db.logger.Warn("Domain already exists", tag.WorkflowDomainName(row.Info.Name))
+			return &types.DomainAlreadyExistsError{
+				Message: fmt.Sprintf("Domain %v already exists", row.Info.Name),
+			}
+		}
+		db.logger.Warn("Create domain operation failed because of condition update failure on domain metadata record")
+		return nosqlplugin.NewConditionFailure("domain")
+	}
+	return nil
 }
 
 // Update domain
@@ -40,7 +121,49 @@ func (db *ddb) UpdateDomain(
 	ctx context.Context,
 	row *nosqlplugin.DomainRow,
 ) error {
-	panic("TODO")
+	it, err := newDomainItem(row)
+	if err != nil {
+		return err
+	}
+	err = db.transactWrite(ctx, []*dynamodb.TransactWriteItem{
+		{
+			Put: &dynamodb.Put{
+				TableName: db.tableName(tableDomainsByName),
+				Item:      it,
+			},
+		},
+		db.updateDomainMetadata(row.NotificationVersion),
+	})
+	if err != nil {
+		var txnErr *dynamodb.TransactionCanceledException
+		if errors.As(err, &txnErr) {
+			return nosqlplugin.NewConditionFailure("domain")
+		}
+		return err
+	}
+	return nil
+}
+
+// updateDomainMetadata increases the notification version of the domain metadata record by one,
+// with the condition that the current version is still the same as the given one
+func (db *ddb) updateDomainMetadata(notificationVersion int64) *dynamodb.TransactWriteItem {
+	update := &dynamodb.Update{
+		TableName:                db.tableName(tableDomainsByName),
+		Key:                      domainByNameKey(domainMetadataRecordName),
+		UpdateExpression:         aws.String("SET #version = :next_version"),
+		ExpressionAttributeNames: map[string]*string{"#version": aws.String(attrNotificationVersion)},
+		ExpressionAttributeValues: map[string]*dynamodb.AttributeValue{
+			":next_version": attrN(notificationVersion + 1),
+		},
+	}
+	if notificationVersion > 0 {
+		update.ConditionExpression = aws.String("#version = :current_version")
+		update.ExpressionAttributeValues[":current_version"] = attrN(notificationVersion)
+	} else {
+		update.ConditionExpression = aws.String("attribute_not_exists(#version)")
+		update.ExpressionAttributeValues[":next_version"] = attrN(1)
+	}
+	return &dynamodb.TransactWriteItem{Update: update}
 }
 
 // Get one domain data, either by domainID or domainName
@@ -49,7 +172,25 @@ func (db *ddb) SelectDomain(
 	domainID *string,
 	domainName *string,
 ) (*nosqlplugin.DomainRow, error) {
-	panic("TODO")
+	if domainID != nil && domainName != nil {
+		return nil, fmt.Errorf("GetDomain operation failed.  Both ID and Name specified in request")
+	} else if domainID == nil && domainName == nil {
+		return nil, fmt.Errorf("GetDomain operation failed.  Both ID and Name are empty")
+	}
+
+	if domainID != nil {
+		it, err := db.getItem(ctx, tableDomains, item{attrDomainID: attrS(*domainID)})
+		if err != nil {
+			return nil, err
+		}
+		domainName = common.StringPtr(getS(it, attrDomainName))
+	}
+
+	it, err := db.getItem(ctx, tableDomainsByName, domainByNameKey(*domainName))
+	if err != nil {
+		return nil, err
+	}
+	return newDomainRow(it)
 }
 
 // Get all domain data
@@ -58,7 +199,29 @@ func (db *ddb) SelectAllDomains(
 	pageSize int,
 	pageToken []byte,
 ) ([]*nosqlplugin.DomainRow, []byte, error) {
-	panic("TODO")
+	items, nextPageToken, err := db.queryPage(ctx, &dynamodb.QueryInput{
+		TableName:                 db.tableName(tableDomainsByName),
+		KeyConditionExpression:    aws.String("#partition = :partition"),
+		ExpressionAttributeNames:  map[string]*string{"#partition": aws.String(attrDomainPartition)},
+		ExpressionAttributeValues: map[string]*dynamodb.AttributeValue{":partition": attrN(domainsByNamePartition)},
+	}, pageSize, pageToken)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var rows []*nosqlplugin.DomainRow
+	for _, it := range items {
+		if getS(it, attrDomainName) == domainMetadataRecordName {
+			// do not include the metadata record
+			continue
+		}
+		row, err := newDomainRow(it)
+		if err != nil {
+			return nil, nil, err
+		}
+		rows = append(rows, row)
+	}
+	return rows, nextPageToken, nil
 }
 
 // Delete a domain, either by domainID or domainName
@@ -67,11 +230,95 @@ func (db *ddb) DeleteDomain(
 	domainID *string,
 	domainName *string,
 ) error {
-	panic("TODO")
+	if domainName == nil && domainID == nil {
+		return fmt.Errorf("must provide either domainID or domainName")
+	}
+
+	if domainName == nil {
+		it, err := db.getItem(ctx, tableDomains, item{attrDomainID: attrS(*domainID)})
+		if err != nil {
+			if db.IsNotFoundError(err) {
+				return nil
+			}
+			return err
+		}
+		domainName = common.StringPtr(getS(it, attrDomainName))
+	} else {
+		it, err := db.getItem(ctx, tableDomainsByName, domainByNameKey(*domainName))
+		if err != nil {
+			if db.IsNotFoundError(err) {
+				return nil
+			}
+			return err
+		}
+		row, err := newDomainRow(it)
+		if err != nil {
+			return err
+		}
+		domainID = common.StringPtr(row.Info.ID)
+	}
+
+	if err := db.deleteItem(ctx, tableDomainsByName, domainByNameKey(*domainName)); err != nil {
+		return err
+	}
+	return db.deleteItem(ctx, tableDomains, item{attrDomainID: attrS(*domainID)})
 }
 
 func (db *ddb) SelectDomainMetadata(
 	ctx context.Context,
 ) (int64, error) {
-	panic("TODO")
+	it, err := db.getItem(ctx, tableDomainsByName, domainByNameKey(domainMetadataRecordName))
+	if err != nil {
+		if db.IsNotFoundError(err) {
+			// this error can be thrown in the very beginning,
+			// i.e. when domains_by_name is initialized
+			return 0, nil
+		}
+		return -1, err
+	}
+	return getN(it, attrNotificationVersion), nil
+}
+
+func domainByNameKey(name string) item {
+	return item{
+		attrDomainPartition: attrN(domainsByNamePartition),
+		attrDomainName:      attrS(name),
+	}
+}
+
+func newDomainItem(row *nosqlplugin.DomainRow) (item, error) {
+	domainRow := *row
+	domainRow.CurrentTimeStamp = time.Time{}
+	domain, err := attrJSON(&domainRow)
+	if err != nil {
+		return nil, err
+	}
+	it := domainByNameKey(row.Info.Name)
+	it[attrDomainID] = attrS(row.Info.ID)
+	it[attrDomain] = domain
+	it[attrNotificationVersion] = attrN(row.NotificationVersion)
+	it[attrLastUpdatedTime] = attrTime(row.CurrentTimeStamp)
+	return it, nil
+}
+
+func newDomainRow(it item) (*nosqlplugin.DomainRow, error) {
+	row := &nosqlplugin.DomainRow{}
+	if err := getJSON(it, attrDomain, row); err != nil {
+		return nil, err
+	}
+	if row.Info == nil {
+		row.Info = &persistence.DomainInfo{}
+	}
+	if row.Config == nil {
+		row.Config = &persistence.InternalDomainConfig{}
+	}
+	if row.ReplicationConfig == nil {
+		row.ReplicationConfig = &persistence.InternalDomainReplicationConfig{}
+	}
+	row.Config.BadBinaries = normalizeDataBlob(row.Config.BadBinaries)
+	row.Config.IsolationGroups = normalizeDataBlob(row.Config.IsolationGroups)
+	row.Config.AsyncWorkflowsConfig = normalizeDataBlob(row.Config.AsyncWorkflowsConfig)
+	row.ReplicationConfig.ActiveClustersConfig = normalizeDataBlob(row.ReplicationConfig.ActiveClustersConfig)
+	row.NotificationVersion = getN(it, attrNotificationVersion)
+	return row, nil
 }
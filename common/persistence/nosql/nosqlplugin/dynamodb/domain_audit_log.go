@@ -22,16 +22,91 @@ package dynamodb
 
 import (
 	"context"
+	"strconv"
+	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+
+	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+	"github.com/uber/cadence/common/types"
 )
 
+const attrAuditLog = "audit_log"
+
 // InsertDomainAuditLog inserts a new audit log entry for a domain operation
 func (db *ddb) InsertDomainAuditLog(ctx context.Context, row *nosqlplugin.DomainAuditLogRow) error {
-	panic("TODO: InsertDomainAuditLog not implemented")
+	auditLog, err := attrJSON(row)
+	if err != nil {
+		return err
+	}
+	it := domainAuditLogKey(row.DomainID, row.OperationType, row.CreatedTime, row.EventID)
+	it[attrAuditLog] = auditLog
+	if row.TTLSeconds > 0 {
+		it[attrExpiry] = attrN(expiryFromTTL(time.Now(), row.TTLSeconds))
+	}
+	_, err = db.client.PutItemWithContext(ctx, &dynamodb.PutItemInput{
+		TableName: db.tableName(tableDomainAuditLog),
+		Item:      it,
+	})
+	return err
 }
 
-// SelectDomainAuditLogs returns audit log entries for a domain and operation type
+// SelectDomainAuditLogs returns audit log entries for a domain and operation type, the latest first
 func (db *ddb) SelectDomainAuditLogs(ctx context.Context, filter *nosqlplugin.DomainAuditLogFilter) ([]*nosqlplugin.DomainAuditLogRow, []byte, error) {
-	panic("TODO: SelectDomainAuditLogs not implemented")
+	if filter.MinCreatedTime == nil || filter.MaxCreatedTime == nil {
+		return nil, nil, &types.InternalServiceError{
+			Message: "SelectDomainAuditLogs requires non-nil MinCreatedTime and MaxCreatedTime",
+		}
+	}
+	if !filter.MinCreatedTime.Before(*filter.MaxCreatedTime) {
+		return nil, nil, nil
+	}
+
+	// The sort key is in reversed order of created_time, so that the latest entries come first.
+	// The entries at MaxCreatedTime are excluded as they sort before the lower bound.
+	input := &dynamodb.QueryInput{
+		TableName:              db.tableName(tableDomainAuditLog),
+		KeyConditionExpression: aws.String("#pk = :pk AND #sk BETWEEN :lower AND :upper"),
+		ExpressionAttributeNames: map[string]*string{
+			"#pk": aws.String(attrPartitionKey),
+			"#sk": aws.String(attrSortKey),
+		},
+		ExpressionAttributeValues: map[string]*dynamodb.AttributeValue{
+			":pk":    attrS(domainAuditLogPartitionKey(filter.DomainID, filter.OperationType)),
+			":lower": attrS(compositeKey(reversedTimeSortKey(*filter.MaxCreatedTime), sortKeyUpperBound)),
+			":upper": attrS(compositeKey(reversedTimeSortKey(*filter.MinCreatedTime), sortKeyUpperBound)),
+		},
+	}
+	items, nextPageToken, err := db.queryPage(ctx, input, filter.PageSize, filter.NextPageToken)
+	if err != nil {
+		return nil, nil, err
+	}
+	rows := make([]*nosqlplugin.DomainAuditLogRow, 0, len(items))
+	for _, it := range items {
+		row := &nosqlplugin.DomainAuditLogRow{}
+		if err := getJSON(it, attrAuditLog, row); err != nil {
+			return nil, nil, err
+		}
+		row.TTLSeconds = 0
+		rows = append(rows, row)
+	}
+	return rows, nextPageToken, nil
+}
+
+func domainAuditLogPartitionKey(domainID string, operationType persistence.DomainAuditOperationType) string {
+	return compositeKey(domainID, strconv.Itoa(int(operationType)))
+}
+
+func domainAuditLogKey(domainID string, operationType persistence.DomainAuditOperationType, createdTime time.Time, eventID string) item {
+	return item{
+		attrPartitionKey: attrS(domainAuditLogPartitionKey(domainID, operationType)),
+		attrSortKey:      attrS(compositeKey(reversedTimeSortKey(createdTime), eventID)),
+	}
+}
+
+// reversedTimeSortKey encodes the time so that the later time sorts first
+func reversedTimeSortKey(t time.Time) string {
+	return encodeSortableInt64(^timeToUnixNano(t))
 }
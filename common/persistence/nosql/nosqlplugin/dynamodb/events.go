@@ -22,31 +22,357 @@ package dynamodb
 
 import (
 	"context"
+	"fmt"
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
 
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	attrTreeID    = "tree_id"
+	attrBranchID  = "branch_id"
+	attrAncestors = "ancestors"
+	attrInfo      = "info"
+	attrNodeID    = "node_id"
+	attrTxnID     = "txn_id"
+	attrChunks    = "chunks"
+
+	// sortKeyUpperBound is larger than any encoded number, it's used to compose the upper bound of a sort key range
+	sortKeyUpperBound = "~"
 )
 
 // InsertIntoHistoryTreeAndNode inserts one or two rows: tree row and node row(at least one of them)
 func (db *ddb) InsertIntoHistoryTreeAndNode(ctx context.Context, treeRow *nosqlplugin.HistoryTreeRow, nodeRow *nosqlplugin.HistoryNodeRow) error {
-	panic("TODO")
+	if treeRow == nil && nodeRow == nil {
+		return fmt.Errorf("require at least a tree row or a node row to insert")
+	}
+
+	var txnItems []*dynamodb.TransactWriteItem
+	if treeRow != nil {
+		it, err := newHistoryTreeItem(treeRow)
+		if err != nil {
+			return err
+		}
+		txnItems = append(txnItems, &dynamodb.TransactWriteItem{
+			Put: &dynamodb.Put{TableName: db.tableName(tableHistoryTree), Item: it},
+		})
+	}
+	if nodeRow != nil {
+		node, chunks := newHistoryNodeItems(nodeRow)
+		// the extra chunks of a large node are written ahead, the node is not visible until its first item is written
+		if err := db.batchPut(ctx, tableHistoryNode, chunks); err != nil {
+			return err
+		}
+		txnItems = append(txnItems, &dynamodb.TransactWriteItem{
+			Put: &dynamodb.Put{TableName: db.tableName(tableHistoryNode), Item: node},
+		})
+	}
+
+	if len(txnItems) == 1 {
+		_, err := db.client.PutItemWithContext(ctx, &dynamodb.PutItemInput{
+			TableName: txnItems[0].Put.TableName,
+			Item:      txnItems[0].Put.Item,
+		})
+		return err
+	}
+	return db.transactWrite(ctx, txnItems)
 }
 
 // SelectFromHistoryNode read nodes based on a filter
 func (db *ddb) SelectFromHistoryNode(ctx context.Context, filter *nosqlplugin.HistoryNodeFilter) ([]*nosqlplugin.HistoryNodeRow, []byte, error) {
-	panic("TODO")
+	if filter.MinNodeID >= filter.MaxNodeID {
+		return nil, nil, nil
+	}
+	// nodes of the upper bound are excluded since their sort keys are longer than the bound
+	items, nextPageToken, err := db.queryPage(ctx, &dynamodb.QueryInput{
+		TableName:              db.tableName(tableHistoryNode),
+		KeyConditionExpression: aws.String("#tree_id = :tree_id AND #sk BETWEEN :min_sk AND :max_sk"),
+		ExpressionAttributeNames: map[string]*string{
+			"#tree_id": aws.String(attrTreeID),
+			"#sk":      aws.String(attrSortKey),
+		},
+		ExpressionAttributeValues: map[string]*dynamodb.AttributeValue{
+			":tree_id": attrS(filter.TreeID),
+			":min_sk":  attrS(compositeKey(filter.BranchID, encodeSortableInt64(filter.MinNodeID))),
+			":max_sk":  attrS(compositeKey(filter.BranchID, encodeSortableInt64(filter.MaxNodeID))),
+		},
+	}, filter.PageSize, filter.NextPageToken)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rows := make([]*nosqlplugin.HistoryNodeRow, 0, len(items))
+	var chunkedRows []*nosqlplugin.HistoryNodeRow
+	for _, it := range items {
+		txnID := getN(it, attrTxnID)
+		row := &nosqlplugin.HistoryNodeRow{
+			ShardID:         filter.ShardID,
+			TreeID:          filter.TreeID,
+			BranchID:        filter.BranchID,
+			NodeID:          getN(it, attrNodeID),
+			TxnID:           &txnID,
+			Data:            getB(it, attrData),
+			DataEncoding:    getS(it, attrDataEncoding),
+			CreateTimestamp: getTime(it, attrCreatedTime),
+		}
+		rows = append(rows, row)
+		if getN(it, attrChunks) > 1 {
+			chunkedRows = append(chunkedRows, row)
+		}
+	}
+	if err := db.selectHistoryNodeChunks(ctx, filter.TreeID, filter.BranchID, chunkedRows, items); err != nil {
+		return nil, nil, err
+	}
+	return rows, nextPageToken, nil
+}
+
+// selectHistoryNodeChunks reads the extra chunks of the large nodes and appends them to the data of the nodes
+func (db *ddb) selectHistoryNodeChunks(ctx context.Context, treeID, branchID string, rows []*nosqlplugin.HistoryNodeRow, nodes []item) error {
+	if len(rows) == 0 {
+		return nil
+	}
+	chunks, _, err := db.queryPage(ctx, &dynamodb.QueryInput{
+		TableName:              db.tableName(tableHistoryNode),
+		KeyConditionExpression: aws.String("#tree_id = :tree_id AND #sk BETWEEN :min_sk AND :max_sk"),
+		ExpressionAttributeNames: map[string]*string{
+			"#tree_id": aws.String(attrTreeID),
+			"#sk":      aws.String(attrSortKey),
+		},
+		ExpressionAttributeValues: map[string]*dynamodb.AttributeValue{
+			":tree_id": attrS(treeID),
+			":min_sk":  attrS(historyNodeChunkKey(branchID, rows[0].NodeID)),
+			":max_sk":  attrS(compositeKey(historyNodeChunkKey(branchID, rows[len(rows)-1].NodeID), sortKeyUpperBound)),
+		},
+	}, 0, nil)
+	if err != nil {
+		return err
+	}
+
+	type nodeKey struct{ nodeID, txnID int64 }
+	data := make(map[nodeKey]map[int64][]byte)
+	for _, it := range chunks {
+		key := nodeKey{getN(it, attrNodeID), getN(it, attrTxnID)}
+		if data[key] == nil {
+			data[key] = make(map[int64][]byte)
+		}
+		data[key][getN(it, attrChunk)] = getB(it, attrData)
+	}
+	chunkCounts := make(map[nodeKey]int64, len(rows))
+	for _, it := range nodes {
+		chunkCounts[nodeKey{getN(it, attrNodeID), getN(it, attrTxnID)}] = getN(it, attrChunks)
+	}
+	for _, row := range rows {
+		key := nodeKey{row.NodeID, *row.TxnID}
+		for i := int64(1); i < chunkCounts[key]; i++ {
+			chunk, ok := data[key][i]
+			if !ok {
+				return fmt.Errorf("corrupted history node %v of branch %v: chunk %v is missing", row.NodeID, branchID, i)
+			}
+			row.Data = append(row.Data, chunk...)
+		}
+	}
+	return nil
 }
 
 // DeleteFromHistoryTreeAndNode delete a branch record, and a list of ranges of nodes.
 func (db *ddb) DeleteFromHistoryTreeAndNode(ctx context.Context, treeFilter *nosqlplugin.HistoryTreeFilter, nodeFilters []*nosqlplugin.HistoryNodeFilter) error {
-	panic("TODO")
+	// nodes are deleted first, so that a failed deletion can be retried as long as the branch record is still there
+	for _, nodeFilter := range nodeFilters {
+		_, err := db.deleteByQuery(ctx, tableHistoryNode, &dynamodb.QueryInput{
+			TableName:              db.tableName(tableHistoryNode),
+			KeyConditionExpression: aws.String("#tree_id = :tree_id AND #sk BETWEEN :min_sk AND :max_sk"),
+			ExpressionAttributeNames: map[string]*string{
+				"#tree_id": aws.String(attrTreeID),
+				"#sk":      aws.String(attrSortKey),
+			},
+			ExpressionAttributeValues: map[string]*dynamodb.AttributeValue{
+				":tree_id": attrS(nodeFilter.TreeID),
+				":min_sk":  attrS(compositeKey(nodeFilter.BranchID, encodeSortableInt64(nodeFilter.MinNodeID))),
+				":max_sk":  attrS(compositeKey(nodeFilter.BranchID, sortKeyUpperBound)),
+			},
+		}, []string{attrTreeID, attrSortKey}, 0)
+		if err != nil {
+			return err
+		}
+		_, err = db.deleteByQuery(ctx, tableHistoryNode, &dynamodb.QueryInput{
+			TableName:              db.tableName(tableHistoryNode),
+			KeyConditionExpression: aws.String("#tree_id = :tree_id AND #sk BETWEEN :min_sk AND :max_sk"),
+			ExpressionAttributeNames: map[string]*string{
+				"#tree_id": aws.String(attrTreeID),
+				"#sk":      aws.String(attrSortKey),
+			},
+			ExpressionAttributeValues: map[string]*dynamodb.AttributeValue{
+				":tree_id": attrS(nodeFilter.TreeID),
+				":min_sk":  attrS(historyNodeChunkKey(nodeFilter.BranchID, nodeFilter.MinNodeID)),
+				":max_sk":  attrS(compositeKey(sortKeyUpperBound, nodeFilter.BranchID, sortKeyUpperBound)),
+			},
+		}, []string{attrTreeID, attrSortKey}, 0)
+		if err != nil {
+			return err
+		}
+	}
+
+	if treeFilter.BranchID == nil {
+		return nil
+	}
+	return db.deleteItem(ctx, tableHistoryTree, item{
+		attrTreeID:   attrS(treeFilter.TreeID),
+		attrBranchID: attrS(*treeFilter.BranchID),
+	})
 }
 
 // SelectAllHistoryTrees will return all tree branches with pagination
 func (db *ddb) SelectAllHistoryTrees(ctx context.Context, nextPageToken []byte, pageSize int) ([]*nosqlplugin.HistoryTreeRow, []byte, error) {
-	panic("TODO")
+	items, pagingToken, err := db.scanPage(ctx, &dynamodb.ScanInput{
+		TableName: db.tableName(tableHistoryTree),
+	}, pageSize, nextPageToken)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rows := make([]*nosqlplugin.HistoryTreeRow, 0, len(items))
+	for _, it := range items {
+		row, err := newHistoryTreeRow(it)
+		if err != nil {
+			return nil, nil, err
+		}
+		rows = append(rows, row)
+	}
+	return rows, pagingToken, nil
 }
 
 // SelectFromHistoryTree read branch records for a tree
 func (db *ddb) SelectFromHistoryTree(ctx context.Context, filter *nosqlplugin.HistoryTreeFilter) ([]*nosqlplugin.HistoryTreeRow, error) {
-	panic("TODO")
+	items, _, err := db.queryPage(ctx, &dynamodb.QueryInput{
+		TableName:                 db.tableName(tableHistoryTree),
+		KeyConditionExpression:    aws.String("#tree_id = :tree_id"),
+		ExpressionAttributeNames:  map[string]*string{"#tree_id": aws.String(attrTreeID)},
+		ExpressionAttributeValues: map[string]*dynamodb.AttributeValue{":tree_id": attrS(filter.TreeID)},
+	}, 0, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make([]*nosqlplugin.HistoryTreeRow, 0, len(items))
+	for _, it := range items {
+		row, err := newHistoryTreeRow(it)
+		if err != nil {
+			return nil, err
+		}
+		row.ShardID = filter.ShardID
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+// historyBranchAncestor is the stored form of an ancestor, BeginNodeID is derived when reading
+type historyBranchAncestor struct {
+	BranchID  string `json:"branch_id"`
+	EndNodeID int64  `json:"end_node_id"`
+}
+
+func newHistoryTreeItem(row *nosqlplugin.HistoryTreeRow) (item, error) {
+	ancestors := make([]historyBranchAncestor, 0, len(row.Ancestors))
+	for _, an := range row.Ancestors {
+		ancestors = append(ancestors, historyBranchAncestor{
+			BranchID:  an.BranchID,
+			EndNodeID: an.EndNodeID,
+		})
+	}
+	ancestorsAttr, err := attrJSON(ancestors)
+	if err != nil {
+		return nil, err
+	}
+	it := item{
+		attrTreeID:      attrS(row.TreeID),
+		attrBranchID:    attrS(row.BranchID),
+		attrAncestors:   ancestorsAttr,
+		attrCreatedTime: attrTime(row.CreateTimestamp),
+		attrShardID:     attrN(int64(row.ShardID)),
+	}
+	if row.Info != "" {
+		it[attrInfo] = attrS(row.Info)
+	}
+	return it, nil
+}
+
+func newHistoryTreeRow(it item) (*nosqlplugin.HistoryTreeRow, error) {
+	var ancestors []historyBranchAncestor
+	if err := getJSON(it, attrAncestors, &ancestors); err != nil {
+		return nil, err
+	}
+	return &nosqlplugin.HistoryTreeRow{
+		ShardID:         int(getN(it, attrShardID)),
+		TreeID:          getS(it, attrTreeID),
+		BranchID:        getS(it, attrBranchID),
+		Ancestors:       parseBranchAncestors(ancestors),
+		CreateTimestamp: getTime(it, attrCreatedTime),
+		Info:            getS(it, attrInfo),
+	}, nil
+}
+
+func parseBranchAncestors(ancestors []historyBranchAncestor) []*types.HistoryBranchRange {
+	ans := make([]*types.HistoryBranchRange, 0, len(ancestors))
+	for _, e := range ancestors {
+		ans = append(ans, &types.HistoryBranchRange{
+			BranchID:  e.BranchID,
+			EndNodeID: e.EndNodeID,
+		})
+	}
+	if len(ans) > 0 {
+		// sort ans based onf EndNodeID so that we can set BeginNodeID
+		sort.Slice(ans, func(i, j int) bool { return ans[i].EndNodeID < ans[j].EndNodeID })
+		ans[0].BeginNodeID = int64(1)
+		for i := 1; i < len(ans); i++ {
+			ans[i].BeginNodeID = ans[i-1].EndNodeID
+		}
+	}
+	return ans
+}
+
+// newHistoryNodeItems returns the item of the node, and the extra chunks when the data is too large for one item.
+// The extra chunks are sorted after all nodes, so that they are never read by the range queries of the nodes.
+func newHistoryNodeItems(row *nosqlplugin.HistoryNodeRow) (item, []item) {
+	var txnID int64
+	if row.TxnID != nil {
+		txnID = *row.TxnID
+	}
+	chunks := splitChunks(row.Data)
+	// nodes are sorted by nodeID ASC and then txnID DESC, bitwise NOT reverses the order of txnID without overflow
+	it := item{
+		attrTreeID:      attrS(row.TreeID),
+		attrSortKey:     attrS(compositeKey(row.BranchID, encodeSortableInt64(row.NodeID), encodeSortableInt64(^txnID))),
+		attrBranchID:    attrS(row.BranchID),
+		attrNodeID:      attrN(row.NodeID),
+		attrTxnID:       attrN(txnID),
+		attrCreatedTime: attrTime(row.CreateTimestamp),
+	}
+	setBlob(it, attrData, attrDataEncoding, chunks[0], row.DataEncoding)
+	if len(chunks) == 1 {
+		return it, nil
+	}
+	it[attrChunks] = attrN(int64(len(chunks)))
+
+	extra := make([]item, 0, len(chunks)-1)
+	for i := 1; i < len(chunks); i++ {
+		extra = append(extra, item{
+			attrTreeID:  attrS(row.TreeID),
+			attrSortKey: attrS(compositeKey(historyNodeChunkKey(row.BranchID, row.NodeID), encodeSortableInt64(^txnID), encodeSortableInt64(int64(i)))),
+			attrNodeID:  attrN(row.NodeID),
+			attrTxnID:   attrN(txnID),
+			attrChunk:   attrN(int64(i)),
+			attrData:    attrB(chunks[i]),
+		})
+	}
+	return it, extra
+}
+
+// historyNodeChunkKey is the sort key prefix of the extra chunks of the nodes from the nodeID,
+// branch IDs are UUIDs so the prefix is larger than the keys of all the nodes
+func historyNodeChunkKey(branchID string, nodeID int64) string {
+	return compositeKey(sortKeyUpperBound, branchID, encodeSortableInt64(nodeID))
 }
@@ -0,0 +1,62 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamodb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+)
+
+func TestNewHistoryNodeItems(t *testing.T) {
+	row := &nosqlplugin.HistoryNodeRow{
+		TreeID:       "tree",
+		BranchID:     "0e7a1d36-3c4f-4e8f-9d8e-2b1f0c3a4d5e",
+		NodeID:       10,
+		TxnID:        common.Int64Ptr(20),
+		Data:         []byte("data"),
+		DataEncoding: "thriftrw",
+	}
+	node, chunks := newHistoryNodeItems(row)
+	assert.Empty(t, chunks)
+	assert.Equal(t, []byte("data"), getB(node, attrData))
+	assert.False(t, hasAttr(node, attrChunks))
+
+	row.Data = make([]byte, maxItemDataSize+1)
+	node, chunks = newHistoryNodeItems(row)
+	require.Len(t, chunks, 1)
+	assert.Equal(t, int64(2), getN(node, attrChunks))
+	assert.Len(t, getB(node, attrData), maxItemDataSize)
+	assert.Len(t, getB(chunks[0], attrData), 1)
+	assert.Equal(t, int64(1), getN(chunks[0], attrChunk))
+
+	// chunks are out of the range of the nodes of the branch
+	nodeKey := getS(node, attrSortKey)
+	chunkKey := getS(chunks[0], attrSortKey)
+	assert.Greater(t, chunkKey, compositeKey(row.BranchID, sortKeyUpperBound))
+	assert.Less(t, nodeKey, compositeKey(row.BranchID, sortKeyUpperBound))
+	assert.GreaterOrEqual(t, chunkKey, historyNodeChunkKey(row.BranchID, row.NodeID))
+	assert.Less(t, chunkKey, compositeKey(historyNodeChunkKey(row.BranchID, row.NodeID), sortKeyUpperBound))
+}
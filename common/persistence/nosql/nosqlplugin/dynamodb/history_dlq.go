@@ -22,32 +22,198 @@ package dynamodb
 
 import (
 	"context"
-	"fmt"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
 
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
 )
 
+// Attribute names of history_task_dlq and history_task_dlq_ack_level tables
+const (
+	attrClusterAttributeScope = "cluster_attribute_scope"
+	attrClusterAttributeName  = "cluster_attribute_name"
+	attrTaskCategory          = "task_category"
+	attrAckLevelVisibilityTS  = "ack_level_visibility_ts"
+	attrAckLevelTaskID        = "ack_level_task_id"
+)
+
+// historyDLQTaskKeyAttrs are the key attributes of history_task_dlq table, used for deleting the rows by query
+var historyDLQTaskKeyAttrs = []string{attrPartitionKey, attrSortKey}
+
 // InsertHistoryDLQTaskRow writes a task to the history DLQ.
+// The partition key is (shard_id, domain_id, cluster_attribute_scope, cluster_attribute_name),
+// and the sort key is (task_category, visibility_ts, task_id), same as the Cassandra table.
 func (db *ddb) InsertHistoryDLQTaskRow(ctx context.Context, task *nosqlplugin.HistoryDLQTaskRow) error {
-	return fmt.Errorf("InsertHistoryDLQTaskRow not implemented for DynamoDB")
+	it := item{
+		attrPartitionKey:          attrS(historyDLQPartitionKey(task.ShardID, task.DomainID, task.ClusterAttributeScope, task.ClusterAttributeName)),
+		attrSortKey:               attrS(historyDLQTaskSortKey(task.TaskCategory, encodeSortableTime(task.VisibilityTimestamp), task.TaskID)),
+		attrShardID:               attrN(int64(task.ShardID)),
+		attrDomainID:              attrS(task.DomainID),
+		attrClusterAttributeScope: attrS(task.ClusterAttributeScope),
+		attrClusterAttributeName:  attrS(task.ClusterAttributeName),
+		attrTaskCategory:          attrN(int64(task.TaskCategory)),
+		attrVisibilityTimestamp:   attrTime(task.VisibilityTimestamp),
+		attrTaskID:                attrN(task.TaskID),
+		attrWorkflowID:            attrS(task.WorkflowID),
+		attrRunID:                 attrS(task.RunID),
+		attrVersion:               attrN(task.Version),
+		attrCreatedTime:           attrTime(task.CreatedAt),
+	}
+	setBlob(it, attrData, attrDataEncoding, task.Data, task.DataEncoding)
+	_, err := db.client.PutItemWithContext(ctx, &dynamodb.PutItemInput{
+		TableName: db.tableName(tableHistoryTaskDLQ),
+		Item:      it,
+	})
+	return err
 }
 
+// SelectHistoryDLQTaskRows reads paginated tasks from the history DLQ within the given bounds.
 func (db *ddb) SelectHistoryDLQTaskRows(ctx context.Context, filter nosqlplugin.HistoryDLQTaskFilter) ([]*nosqlplugin.HistoryDLQTaskRow, []byte, error) {
-	return nil, nil, fmt.Errorf("SelectHistoryDLQTaskRows not implemented for DynamoDB")
+	// (visibility_ts, task_id) < (max_ts, max_task_id) is the same as <= (max_ts, max_task_id - 1)
+	input := db.historyDLQTaskRangeQuery(
+		historyDLQPartitionKey(filter.ShardID, filter.DomainID, filter.ClusterAttributeScope, filter.ClusterAttributeName),
+		historyDLQTaskSortKey(filter.TaskCategory, encodeSortableTime(filter.InclusiveMinVisibilityTS), filter.InclusiveMinTaskID),
+		historyDLQTaskSortKey(filter.TaskCategory, encodeSortableTime(filter.ExclusiveMaxVisibilityTS), filter.ExclusiveMaxTaskID-1),
+	)
+	items, nextPageToken, err := db.queryPage(ctx, input, filter.PageSize, filter.NextPageToken)
+	if err != nil {
+		return nil, nil, err
+	}
+	rows := make([]*nosqlplugin.HistoryDLQTaskRow, 0, len(items))
+	for _, it := range items {
+		rows = append(rows, &nosqlplugin.HistoryDLQTaskRow{
+			ShardID:               int(getN(it, attrShardID)),
+			DomainID:              getS(it, attrDomainID),
+			ClusterAttributeScope: getS(it, attrClusterAttributeScope),
+			ClusterAttributeName:  getS(it, attrClusterAttributeName),
+			TaskCategory:          int(getN(it, attrTaskCategory)),
+			VisibilityTimestamp:   getTime(it, attrVisibilityTimestamp),
+			TaskID:                getN(it, attrTaskID),
+			WorkflowID:            getS(it, attrWorkflowID),
+			RunID:                 getS(it, attrRunID),
+			Version:               getN(it, attrVersion),
+			Data:                  getB(it, attrData),
+			DataEncoding:          getS(it, attrDataEncoding),
+			CreatedAt:             getTime(it, attrCreatedTime),
+		})
+	}
+	return rows, nextPageToken, nil
 }
 
+// RangeDeleteHistoryDLQTaskRows deletes all tasks of the category below the exclusive upper bound.
 func (db *ddb) RangeDeleteHistoryDLQTaskRows(ctx context.Context, filter nosqlplugin.HistoryDLQTaskRangeDeleteFilter) error {
-	return fmt.Errorf("RangeDeleteHistoryDLQTaskRows not implemented for DynamoDB")
+	input := db.historyDLQTaskRangeQuery(
+		historyDLQPartitionKey(filter.ShardID, filter.DomainID, filter.ClusterAttributeScope, filter.ClusterAttributeName),
+		compositeKey(strconv.Itoa(filter.TaskCategory), ""),
+		historyDLQTaskSortKey(filter.TaskCategory, encodeSortableTime(filter.ExclusiveMaxVisibilityTS), filter.ExclusiveMaxTaskID-1),
+	)
+	input.ProjectionExpression = aws.String("#pk, #sk")
+	_, err := db.deleteByQuery(ctx, tableHistoryTaskDLQ, input, historyDLQTaskKeyAttrs, 0)
+	return err
 }
 
+// SelectHistoryDLQAckLevelRows reads ack-level rows for a shard.
+// If domainID is non-empty the query is restricted to that domain.
+// If clusterAttributeScope and clusterAttributeName are also non-empty it is
+// further restricted to that cluster attribute.
 func (db *ddb) SelectHistoryDLQAckLevelRows(ctx context.Context, filter nosqlplugin.HistoryDLQAckLevelFilter) ([]*nosqlplugin.HistoryDLQAckLevelRow, error) {
-	return nil, fmt.Errorf("SelectHistoryDLQAckLevelRows not implemented for DynamoDB")
+	var prefix string
+	switch {
+	case filter.DomainID != "" && filter.ClusterAttributeScope != "" && filter.ClusterAttributeName != "":
+		prefix = compositeKey(filter.DomainID, filter.ClusterAttributeScope, filter.ClusterAttributeName)
+	case filter.DomainID != "":
+		prefix = filter.DomainID
+	}
+	input := &dynamodb.QueryInput{
+		TableName:                 db.tableName(tableHistoryTaskDLQAckLevel),
+		KeyConditionExpression:    aws.String("#shard_id = :shard_id"),
+		ExpressionAttributeNames:  map[string]*string{"#shard_id": aws.String(attrShardID)},
+		ExpressionAttributeValues: map[string]*dynamodb.AttributeValue{":shard_id": attrN(int64(filter.ShardID))},
+	}
+	if prefix != "" {
+		input = rowTypeQuery(db.tableName(tableHistoryTaskDLQAckLevel), filter.ShardID, prefix)
+	}
+	items, _, err := db.queryPage(ctx, input, 0, nil)
+	if err != nil {
+		return nil, err
+	}
+	rows := make([]*nosqlplugin.HistoryDLQAckLevelRow, 0, len(items))
+	for _, it := range items {
+		rows = append(rows, &nosqlplugin.HistoryDLQAckLevelRow{
+			ShardID:               filter.ShardID,
+			DomainID:              getS(it, attrDomainID),
+			ClusterAttributeScope: getS(it, attrClusterAttributeScope),
+			ClusterAttributeName:  getS(it, attrClusterAttributeName),
+			TaskCategory:          int(getN(it, attrTaskCategory)),
+			AckLevelVisibilityTS:  getTime(it, attrAckLevelVisibilityTS),
+			AckLevelTaskID:        getN(it, attrAckLevelTaskID),
+			LastUpdatedAt:         getTime(it, attrLastUpdatedTime),
+		})
+	}
+	return rows, nil
 }
 
+// InsertOrUpdateHistoryDLQAckLevelRow upserts a single ack-level row.
 func (db *ddb) InsertOrUpdateHistoryDLQAckLevelRow(ctx context.Context, row *nosqlplugin.HistoryDLQAckLevelRow) error {
-	return fmt.Errorf("InsertOrUpdateHistoryDLQAckLevelRow not implemented for DynamoDB")
+	_, err := db.client.PutItemWithContext(ctx, &dynamodb.PutItemInput{
+		TableName: db.tableName(tableHistoryTaskDLQAckLevel),
+		Item:      newHistoryDLQAckLevelItem(row),
+	})
+	return err
 }
 
+// InsertHistoryDLQAckLevelIfNotExistsRow inserts a sentinel ack-level row if it does not already exist
+// for this (shard, domain, scope, name, task_category) key.
+// Returns success if the row is written or if it already exists.
 func (db *ddb) InsertHistoryDLQAckLevelIfNotExistsRow(ctx context.Context, row *nosqlplugin.HistoryDLQAckLevelRow) error {
-	return fmt.Errorf("InsertHistoryDLQAckLevelIfNotExistsRow not implemented for DynamoDB")
+	_, err := db.client.PutItemWithContext(ctx, &dynamodb.PutItemInput{
+		TableName:                db.tableName(tableHistoryTaskDLQAckLevel),
+		Item:                     newHistoryDLQAckLevelItem(row),
+		ConditionExpression:      aws.String("attribute_not_exists(#sk)"),
+		ExpressionAttributeNames: map[string]*string{"#sk": aws.String(attrSortKey)},
+	})
+	if isConditionalCheckFailed(err) {
+		return nil
+	}
+	return err
+}
+
+func (db *ddb) historyDLQTaskRangeQuery(partitionKey, lower, upper string) *dynamodb.QueryInput {
+	return &dynamodb.QueryInput{
+		TableName:              db.tableName(tableHistoryTaskDLQ),
+		KeyConditionExpression: aws.String("#pk = :pk AND #sk BETWEEN :lower AND :upper"),
+		ExpressionAttributeNames: map[string]*string{
+			"#pk": aws.String(attrPartitionKey),
+			"#sk": aws.String(attrSortKey),
+		},
+		ExpressionAttributeValues: map[string]*dynamodb.AttributeValue{
+			":pk":    attrS(partitionKey),
+			":lower": attrS(lower),
+			":upper": attrS(upper),
+		},
+	}
+}
+
+func newHistoryDLQAckLevelItem(row *nosqlplugin.HistoryDLQAckLevelRow) item {
+	return item{
+		attrShardID:               attrN(int64(row.ShardID)),
+		attrSortKey:               attrS(compositeKey(row.DomainID, row.ClusterAttributeScope, row.ClusterAttributeName, strconv.Itoa(row.TaskCategory))),
+		attrDomainID:              attrS(row.DomainID),
+		attrClusterAttributeScope: attrS(row.ClusterAttributeScope),
+		attrClusterAttributeName:  attrS(row.ClusterAttributeName),
+		attrTaskCategory:          attrN(int64(row.TaskCategory)),
+		attrAckLevelVisibilityTS:  attrTime(row.AckLevelVisibilityTS),
+		attrAckLevelTaskID:        attrN(row.AckLevelTaskID),
+		attrLastUpdatedTime:       attrTime(row.LastUpdatedAt),
+	}
+}
+
+func historyDLQPartitionKey(shardID int, domainID, scope, name string) string {
+	return compositeKey(strconv.Itoa(shardID), domainID, scope, name)
+}
+
+func historyDLQTaskSortKey(taskCategory int, visibilityTS string, taskID int64) string {
+	return compositeKey(strconv.Itoa(taskCategory), visibilityTS, encodeSortableInt64(taskID))
 }
@@ -0,0 +1,439 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamodb
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+
+	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/persistence"
+)
+
+// item is a DynamoDB item, or the key of an item
+type item = map[string]*dynamodb.AttributeValue
+
+var (
+	minUnixNanoTime = time.Unix(0, math.MinInt64)
+	maxUnixNanoTime = time.Unix(0, math.MaxInt64)
+)
+
+func attrS(v string) *dynamodb.AttributeValue {
+	return &dynamodb.AttributeValue{S: aws.String(v)}
+}
+
+func attrN(v int64) *dynamodb.AttributeValue {
+	return &dynamodb.AttributeValue{N: aws.String(strconv.FormatInt(v, 10))}
+}
+
+func attrB(v []byte) *dynamodb.AttributeValue {
+	return &dynamodb.AttributeValue{B: v}
+}
+
+func attrBool(v bool) *dynamodb.AttributeValue {
+	return &dynamodb.AttributeValue{BOOL: aws.Bool(v)}
+}
+
+func attrTime(t time.Time) *dynamodb.AttributeValue {
+	return attrN(timeToUnixNano(t))
+}
+
+func attrJSON(v interface{}) (*dynamodb.AttributeValue, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	return attrB(data), nil
+}
+
+// setBlob sets the data and encoding attributes of a blob, empty blobs are omitted
+// because DynamoDB doesn't accept empty binary values in all versions
+func setBlob(it item, dataAttr, encodingAttr string, data []byte, encoding string) {
+	if len(data) > 0 {
+		it[dataAttr] = attrB(data)
+	}
+	if encoding != "" {
+		it[encodingAttr] = attrS(encoding)
+	}
+}
+
+func setDataBlob(it item, dataAttr, encodingAttr string, blob *persistence.DataBlob) {
+	data, encoding := persistence.FromDataBlob(blob)
+	setBlob(it, dataAttr, encodingAttr, data, encoding)
+}
+
+func getS(it item, name string) string {
+	if v, ok := it[name]; ok && v != nil {
+		return aws.StringValue(v.S)
+	}
+	return ""
+}
+
+func getN(it item, name string) int64 {
+	if v, ok := it[name]; ok && v != nil && v.N != nil {
+		n, err := strconv.ParseInt(*v.N, 10, 64)
+		if err == nil {
+			return n
+		}
+	}
+	return 0
+}
+
+func hasAttr(it item, name string) bool {
+	v, ok := it[name]
+	return ok && v != nil && (v.NULL == nil || !*v.NULL)
+}
+
+func getB(it item, name string) []byte {
+	if v, ok := it[name]; ok && v != nil {
+		return v.B
+	}
+	return nil
+}
+
+func getBool(it item, name string) bool {
+	if v, ok := it[name]; ok && v != nil {
+		return aws.BoolValue(v.BOOL)
+	}
+	return false
+}
+
+func getTime(it item, name string) time.Time {
+	if !hasAttr(it, name) {
+		return time.Time{}
+	}
+	return unixNanoToTime(getN(it, name))
+}
+
+func getJSON(it item, name string, v interface{}) error {
+	data := getB(it, name)
+	if len(data) == 0 {
+		return nil
+	}
+	return json.Unmarshal(data, v)
+}
+
+func getDataBlob(it item, dataAttr, encodingAttr string) *persistence.DataBlob {
+	return persistence.NewDataBlob(getB(it, dataAttr), constants.EncodingType(getS(it, encodingAttr)))
+}
+
+// timeToUnixNano converts a time into nanoseconds, clamped into the range that int64 can represent.
+// Zero time is converted to math.MinInt64 so that it can be restored as zero time.
+func timeToUnixNano(t time.Time) int64 {
+	if t.IsZero() || !t.After(minUnixNanoTime) {
+		return math.MinInt64
+	}
+	if t.After(maxUnixNanoTime) {
+		return math.MaxInt64
+	}
+	return t.UnixNano()
+}
+
+func unixNanoToTime(n int64) time.Time {
+	if n == math.MinInt64 {
+		return time.Time{}
+	}
+	return time.Unix(0, n).UTC()
+}
+
+// encodeSortableInt64 encodes an int64 into a fixed width string whose lexicographical order is the same as the numeric order,
+// so that it can be used as a component of a string sort key
+func encodeSortableInt64(v int64) string {
+	return fmt.Sprintf("%020d", uint64(v)^(1<<63))
+}
+
+func decodeSortableInt64(s string) (int64, error) {
+	u, err := strconv.ParseUint(s, 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	return int64(u ^ (1 << 63)), nil
+}
+
+func encodeSortableTime(t time.Time) string {
+	return encodeSortableInt64(timeToUnixNano(t))
+}
+
+func compositeKey(parts ...string) string {
+	return strings.Join(parts, keySeparator)
+}
+
+// expiryFromTTL returns the epoch seconds when an item with the TTL should expire, used by DynamoDB TTL feature
+func expiryFromTTL(now time.Time, ttlSeconds int64) int64 {
+	return now.Unix() + ttlSeconds
+}
+
+// isExpired tells if an item is expired but not yet removed by DynamoDB, which may take up to days.
+func isExpired(it item, now time.Time) bool {
+	return hasAttr(it, attrExpiry) && getN(it, attrExpiry) <= now.Unix()
+}
+
+func serializePageToken(lastEvaluatedKey item) ([]byte, error) {
+	if len(lastEvaluatedKey) == 0 {
+		return nil, nil
+	}
+	return json.Marshal(lastEvaluatedKey)
+}
+
+func deserializePageToken(token []byte) (item, error) {
+	if len(token) == 0 {
+		return nil, nil
+	}
+	var key item
+	if err := json.Unmarshal(token, &key); err != nil {
+		return nil, fmt.Errorf("invalid page token: %v", err)
+	}
+	return key, nil
+}
+
+func (db *ddb) getItem(ctx context.Context, table string, key item) (item, error) {
+	resp, err := db.client.GetItemWithContext(ctx, &dynamodb.GetItemInput{
+		TableName:      db.tableName(table),
+		Key:            key,
+		ConsistentRead: aws.Bool(true),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.Item) == 0 {
+		return nil, errNotFound
+	}
+	return resp.Item, nil
+}
+
+func (db *ddb) deleteItem(ctx context.Context, table string, key item) error {
+	_, err := db.client.DeleteItemWithContext(ctx, &dynamodb.DeleteItemInput{
+		TableName: db.tableName(table),
+		Key:       key,
+	})
+	return err
+}
+
+// queryPage reads one page of the query. DynamoDB may return less items than the limit when a filter is applied,
+// so it keeps reading until the page is full or there are no more items. pageSize <= 0 means reading all items.
+func (db *ddb) queryPage(ctx context.Context, input *dynamodb.QueryInput, pageSize int, pageToken []byte) ([]item, []byte, error) {
+	startKey, err := deserializePageToken(pageToken)
+	if err != nil {
+		return nil, nil, err
+	}
+	input.ExclusiveStartKey = startKey
+	input.ConsistentRead = aws.Bool(true)
+
+	var items []item
+	for {
+		if pageSize > 0 {
+			input.Limit = aws.Int64(int64(pageSize - len(items)))
+		}
+		resp, err := db.client.QueryWithContext(ctx, input)
+		if err != nil {
+			return nil, nil, err
+		}
+		items = append(items, resp.Items...)
+		if len(resp.LastEvaluatedKey) == 0 {
+			return items, nil, nil
+		}
+		if pageSize > 0 && len(items) >= pageSize {
+			token, err := serializePageToken(resp.LastEvaluatedKey)
+			return items, token, err
+		}
+		input.ExclusiveStartKey = resp.LastEvaluatedKey
+	}
+}
+
+// scanPage is the same as queryPage but for scanning the whole table
+func (db *ddb) scanPage(ctx context.Context, input *dynamodb.ScanInput, pageSize int, pageToken []byte) ([]item, []byte, error) {
+	startKey, err := deserializePageToken(pageToken)
+	if err != nil {
+		return nil, nil, err
+	}
+	input.ExclusiveStartKey = startKey
+	input.ConsistentRead = aws.Bool(true)
+
+	var items []item
+	for {
+		if pageSize > 0 {
+			input.Limit = aws.Int64(int64(pageSize - len(items)))
+		}
+		resp, err := db.client.ScanWithContext(ctx, input)
+		if err != nil {
+			return nil, nil, err
+		}
+		items = append(items, resp.Items...)
+		if len(resp.LastEvaluatedKey) == 0 {
+			return items, nil, nil
+		}
+		if pageSize > 0 && len(items) >= pageSize {
+			token, err := serializePageToken(resp.LastEvaluatedKey)
+			return items, token, err
+		}
+		input.ExclusiveStartKey = resp.LastEvaluatedKey
+	}
+}
+
+// queryCount returns the number of items matching the query
+func (db *ddb) queryCount(ctx context.Context, input *dynamodb.QueryInput) (int64, error) {
+	input.Select = aws.String(dynamodb.SelectCount)
+	input.ConsistentRead = aws.Bool(true)
+	var count int64
+	for {
+		resp, err := db.client.QueryWithContext(ctx, input)
+		if err != nil {
+			return 0, err
+		}
+		count += aws.Int64Value(resp.Count)
+		if len(resp.LastEvaluatedKey) == 0 {
+			return count, nil
+		}
+		input.ExclusiveStartKey = resp.LastEvaluatedKey
+	}
+}
+
+// deleteByQuery deletes all the items matching the query, and returns the number of deleted items.
+// DynamoDB has no range delete, so the keys are read first and then deleted in batches.
+// limit <= 0 means no limit.
+func (db *ddb) deleteByQuery(ctx context.Context, table string, input *dynamodb.QueryInput, keyAttrs []string, limit int) (int, error) {
+	items, _, err := db.queryPage(ctx, input, limit, nil)
+	if err != nil {
+		return 0, err
+	}
+	keys := make([]item, 0, len(items))
+	for _, it := range items {
+		key := make(item, len(keyAttrs))
+		for _, attr := range keyAttrs {
+			key[attr] = it[attr]
+		}
+		keys = append(keys, key)
+	}
+	if err := db.batchDelete(ctx, table, keys); err != nil {
+		return 0, err
+	}
+	return len(keys), nil
+}
+
+// batchDelete deletes the items of the keys, in batches of maxBatchWriteItems
+func (db *ddb) batchDelete(ctx context.Context, table string, keys []item) error {
+	requests := make([]*dynamodb.WriteRequest, 0, len(keys))
+	for _, key := range keys {
+		requests = append(requests, &dynamodb.WriteRequest{
+			DeleteRequest: &dynamodb.DeleteRequest{Key: key},
+		})
+	}
+	return db.batchWrite(ctx, table, requests)
+}
+
+// batchPut writes the items without any condition, in batches of maxBatchWriteItems
+func (db *ddb) batchPut(ctx context.Context, table string, items []item) error {
+	requests := make([]*dynamodb.WriteRequest, 0, len(items))
+	for _, it := range items {
+		requests = append(requests, &dynamodb.WriteRequest{
+			PutRequest: &dynamodb.PutRequest{Item: it},
+		})
+	}
+	return db.batchWrite(ctx, table, requests)
+}
+
+func (db *ddb) batchWrite(ctx context.Context, table string, requests []*dynamodb.WriteRequest) error {
+	tableName := db.tableName(table)
+	for start := 0; start < len(requests); start += maxBatchWriteItems {
+		end := start + maxBatchWriteItems
+		if end > len(requests) {
+			end = len(requests)
+		}
+		pending := map[string][]*dynamodb.WriteRequest{*tableName: requests[start:end]}
+		for len(pending) > 0 {
+			resp, err := db.client.BatchWriteItemWithContext(ctx, &dynamodb.BatchWriteItemInput{
+				RequestItems: pending,
+			})
+			if err != nil {
+				return err
+			}
+			pending = resp.UnprocessedItems
+		}
+	}
+	return nil
+}
+
+// transactWrite executes the write operations atomically
+func (db *ddb) transactWrite(ctx context.Context, items []*dynamodb.TransactWriteItem) error {
+	if len(items) > maxTransactionItems {
+		return fmt.Errorf("too many items(%v) in one transaction, DynamoDB only supports up to %v", len(items), maxTransactionItems)
+	}
+	_, err := db.client.TransactWriteItemsWithContext(ctx, &dynamodb.TransactWriteItemsInput{
+		TransactItems: items,
+	})
+	return err
+}
+
+// splitChunks splits the data into chunks no larger than maxItemDataSize, empty data is one empty chunk
+func splitChunks(data []byte) [][]byte {
+	chunks := make([][]byte, 0, len(data)/maxItemDataSize+1)
+	for len(data) > maxItemDataSize {
+		chunks = append(chunks, data[:maxItemDataSize])
+		data = data[maxItemDataSize:]
+	}
+	return append(chunks, data)
+}
+
+// attrValueSize estimates the size of an attribute value the same way as DynamoDB counts it
+func attrValueSize(v *dynamodb.AttributeValue) int {
+	if v == nil {
+		return 0
+	}
+	size := len(aws.StringValue(v.S)) + len(aws.StringValue(v.N)) + len(v.B) + 1
+	for _, e := range v.L {
+		size += attrValueSize(e)
+	}
+	for k, e := range v.M {
+		size += len(k) + attrValueSize(e)
+	}
+	return size
+}
+
+func itemSize(it item) int {
+	size := 0
+	for name, v := range it {
+		size += len(name) + attrValueSize(v)
+	}
+	return size
+}
+
+// transactWriteItemSize estimates the size of a transaction operation, which counts towards the size limit of the transaction
+func transactWriteItemSize(op *dynamodb.TransactWriteItem) int {
+	switch {
+	case op.Put != nil:
+		return itemSize(op.Put.Item)
+	case op.Update != nil:
+		return itemSize(op.Update.Key) + itemSize(op.Update.ExpressionAttributeValues) + len(aws.StringValue(op.Update.UpdateExpression))
+	case op.Delete != nil:
+		return itemSize(op.Delete.Key)
+	case op.ConditionCheck != nil:
+		return itemSize(op.ConditionCheck.Key)
+	}
+	return 0
+}
@@ -0,0 +1,114 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamodb
+
+import (
+	"math"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncodeSortableInt64(t *testing.T) {
+	values := []int64{math.MinInt64, -1 << 40, -2, -1, 0, 1, 2, 1 << 40, math.MaxInt64}
+	encoded := make([]string, 0, len(values))
+	for _, v := range values {
+		s := encodeSortableInt64(v)
+		decoded, err := decodeSortableInt64(s)
+		require.NoError(t, err)
+		assert.Equal(t, v, decoded)
+		encoded = append(encoded, s)
+	}
+	assert.True(t, sort.StringsAreSorted(encoded), "encoded values should keep the numeric order: %v", encoded)
+}
+
+func TestTimeToUnixNano(t *testing.T) {
+	now := time.Now().UTC()
+	tests := map[string]struct {
+		input    time.Time
+		expected time.Time
+	}{
+		"zero time": {
+			input:    time.Time{},
+			expected: time.Time{},
+		},
+		"now": {
+			input:    now,
+			expected: now,
+		},
+		"after max": {
+			input:    time.Date(3000, 1, 1, 0, 0, 0, 0, time.UTC),
+			expected: maxUnixNanoTime.UTC(),
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			it := item{attrCreatedTime: attrTime(tc.input)}
+			actual := getTime(it, attrCreatedTime)
+			assert.True(t, tc.expected.Equal(actual), "expected %v, actual %v", tc.expected, actual)
+		})
+	}
+	assert.True(t, getTime(item{}, attrCreatedTime).IsZero())
+}
+
+func TestPageToken(t *testing.T) {
+	token, err := serializePageToken(nil)
+	require.NoError(t, err)
+	assert.Nil(t, token)
+
+	key := item{
+		attrShardID: attrN(1),
+		attrSortKey: attrS(compositeKey(rowTypeTransferTask, encodeSortableInt64(10))),
+	}
+	token, err = serializePageToken(key)
+	require.NoError(t, err)
+	decoded, err := deserializePageToken(token)
+	require.NoError(t, err)
+	assert.Equal(t, key, decoded)
+
+	_, err = deserializePageToken([]byte("invalid"))
+	assert.Error(t, err)
+}
+
+func TestSetBlob(t *testing.T) {
+	it := item{}
+	setBlob(it, attrData, attrDataEncoding, nil, "")
+	assert.Empty(t, it)
+
+	setBlob(it, attrData, attrDataEncoding, []byte("data"), "thriftrw")
+	assert.Equal(t, []byte("data"), getB(it, attrData))
+	assert.Equal(t, "thriftrw", getS(it, attrDataEncoding))
+}
+
+func TestSplitChunks(t *testing.T) {
+	assert.Equal(t, [][]byte{nil}, splitChunks(nil))
+	assert.Equal(t, [][]byte{[]byte("data")}, splitChunks([]byte("data")))
+
+	data := make([]byte, 2*maxItemDataSize+1)
+	chunks := splitChunks(data)
+	require.Len(t, chunks, 3)
+	assert.Len(t, chunks[0], maxItemDataSize)
+	assert.Len(t, chunks[1], maxItemDataSize)
+	assert.Len(t, chunks[2], 1)
+}
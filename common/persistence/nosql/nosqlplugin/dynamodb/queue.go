@@ -22,18 +22,43 @@ package dynamodb
 
 import (
 	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
 
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
 )
 
+const (
+	attrQueueType        = "queue_type"
+	attrMessageID        = "message_id"
+	attrMessagePayload   = "message_payload"
+	attrClusterAckLevels = "cluster_ack_level"
+)
+
 // Insert message into queue, return error if failed or already exists
 // Return ConditionFailure if the condition doesn't meet
 func (db *ddb) InsertIntoQueue(
 	ctx context.Context,
 	row *nosqlplugin.QueueMessageRow,
 ) error {
-	panic("TODO")
+	it := queueMessageKey(row.QueueType, row.ID)
+	if len(row.Payload) > 0 {
+		it[attrMessagePayload] = attrB(row.Payload)
+	}
+	it[attrCreatedTime] = attrTime(row.CurrentTimeStamp)
+	_, err := db.client.PutItemWithContext(ctx, &dynamodb.PutItemInput{
+		TableName:                db.tableName(tableQueueMessages),
+		Item:                     it,
+		ConditionExpression:      aws.String("attribute_not_exists(#message_id)"),
+		ExpressionAttributeNames: map[string]*string{"#message_id": aws.String(attrMessageID)},
+	})
+	if isConditionalCheckFailed(err) {
+		return nosqlplugin.NewConditionFailure("queue")
+	}
+	return err
 }
 
 // Get the ID of last message inserted into the queue
@@ -41,7 +66,22 @@ func (db *ddb) SelectLastEnqueuedMessageID(
 	ctx context.Context,
 	queueType persistence.QueueType,
 ) (int64, error) {
-	panic("TODO")
+	resp, err := db.client.QueryWithContext(ctx, &dynamodb.QueryInput{
+		TableName:                 db.tableName(tableQueueMessages),
+		KeyConditionExpression:    aws.String("#queue_type = :queue_type"),
+		ExpressionAttributeNames:  map[string]*string{"#queue_type": aws.String(attrQueueType)},
+		ExpressionAttributeValues: map[string]*dynamodb.AttributeValue{":queue_type": attrN(int64(queueType))},
+		ScanIndexForward:          aws.Bool(false),
+		Limit:                     aws.Int64(1),
+		ConsistentRead:            aws.Bool(true),
+	})
+	if err != nil {
+		return 0, err
+	}
+	if len(resp.Items) == 0 {
+		return 0, errNotFound
+	}
+	return getN(resp.Items[0], attrMessageID), nil
 }
 
 // Read queue messages starting from the exclusiveBeginMessageID
@@ -51,7 +91,28 @@ func (db *ddb) SelectMessagesFrom(
 	exclusiveBeginMessageID int64,
 	maxRows int,
 ) ([]*nosqlplugin.QueueMessageRow, error) {
-	panic("TODO")
+	items, _, err := db.queryPage(ctx, &dynamodb.QueryInput{
+		TableName:              db.tableName(tableQueueMessages),
+		KeyConditionExpression: aws.String("#queue_type = :queue_type AND #message_id > :begin_id"),
+		ExpressionAttributeNames: map[string]*string{
+			"#queue_type": aws.String(attrQueueType),
+			"#message_id": aws.String(attrMessageID),
+		},
+		ExpressionAttributeValues: map[string]*dynamodb.AttributeValue{
+			":queue_type": attrN(int64(queueType)),
+			":begin_id":   attrN(exclusiveBeginMessageID),
+		},
+	}, maxRows, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []*nosqlplugin.QueueMessageRow
+	for _, it := range items {
+		row := newQueueMessageRow(it)
+		result = append(result, &row)
+	}
+	return result, nil
 }
 
 // Read queue message starting from exclusiveBeginMessageID int64, inclusiveEndMessageID int64
@@ -59,7 +120,34 @@ func (db *ddb) SelectMessagesBetween(
 	ctx context.Context,
 	request nosqlplugin.SelectMessagesBetweenRequest,
 ) (*nosqlplugin.SelectMessagesBetweenResponse, error) {
-	panic("TODO")
+	if request.ExclusiveBeginMessageID >= request.InclusiveEndMessageID {
+		return &nosqlplugin.SelectMessagesBetweenResponse{}, nil
+	}
+	items, token, err := db.queryPage(ctx, &dynamodb.QueryInput{
+		TableName:              db.tableName(tableQueueMessages),
+		KeyConditionExpression: aws.String("#queue_type = :queue_type AND #message_id BETWEEN :begin_id AND :end_id"),
+		ExpressionAttributeNames: map[string]*string{
+			"#queue_type": aws.String(attrQueueType),
+			"#message_id": aws.String(attrMessageID),
+		},
+		ExpressionAttributeValues: map[string]*dynamodb.AttributeValue{
+			":queue_type": attrN(int64(request.QueueType)),
+			":begin_id":   attrN(request.ExclusiveBeginMessageID + 1),
+			":end_id":     attrN(request.InclusiveEndMessageID),
+		},
+	}, request.PageSize, request.NextPageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	var rows []nosqlplugin.QueueMessageRow
+	for _, it := range items {
+		rows = append(rows, newQueueMessageRow(it))
+	}
+	return &nosqlplugin.SelectMessagesBetweenResponse{
+		Rows:          rows,
+		NextPageToken: token,
+	}, nil
 }
 
 // Delete all messages before exclusiveBeginMessageID
@@ -68,7 +156,19 @@ func (db *ddb) DeleteMessagesBefore(
 	queueType persistence.QueueType,
 	exclusiveBeginMessageID int64,
 ) error {
-	panic("TODO")
+	_, err := db.deleteByQuery(ctx, tableQueueMessages, &dynamodb.QueryInput{
+		TableName:              db.tableName(tableQueueMessages),
+		KeyConditionExpression: aws.String("#queue_type = :queue_type AND #message_id < :begin_id"),
+		ExpressionAttributeNames: map[string]*string{
+			"#queue_type": aws.String(attrQueueType),
+			"#message_id": aws.String(attrMessageID),
+		},
+		ExpressionAttributeValues: map[string]*dynamodb.AttributeValue{
+			":queue_type": attrN(int64(queueType)),
+			":begin_id":   attrN(exclusiveBeginMessageID),
+		},
+	}, []string{attrQueueType, attrMessageID}, 0)
+	return err
 }
 
 // Delete all messages in a range between exclusiveBeginMessageID and inclusiveEndMessageID
@@ -78,7 +178,23 @@ func (db *ddb) DeleteMessagesInRange(
 	exclusiveBeginMessageID int64,
 	inclusiveEndMessageID int64,
 ) error {
-	panic("TODO")
+	if exclusiveBeginMessageID >= inclusiveEndMessageID {
+		return nil
+	}
+	_, err := db.deleteByQuery(ctx, tableQueueMessages, &dynamodb.QueryInput{
+		TableName:              db.tableName(tableQueueMessages),
+		KeyConditionExpression: aws.String("#queue_type = :queue_type AND #message_id BETWEEN :begin_id AND :end_id"),
+		ExpressionAttributeNames: map[string]*string{
+			"#queue_type": aws.String(attrQueueType),
+			"#message_id": aws.String(attrMessageID),
+		},
+		ExpressionAttributeValues: map[string]*dynamodb.AttributeValue{
+			":queue_type": attrN(int64(queueType)),
+			":begin_id":   attrN(exclusiveBeginMessageID + 1),
+			":end_id":     attrN(inclusiveEndMessageID),
+		},
+	}, []string{attrQueueType, attrMessageID}, 0)
+	return err
 }
 
 // Delete one message
@@ -87,12 +203,26 @@ func (db *ddb) DeleteMessage(
 	queueType persistence.QueueType,
 	messageID int64,
 ) error {
-	panic("TODO")
+	return db.deleteItem(ctx, tableQueueMessages, queueMessageKey(queueType, messageID))
 }
 
 // Insert an empty metadata row, starting from a version
 func (db *ddb) InsertQueueMetadata(ctx context.Context, row nosqlplugin.QueueMetadataRow) error {
-	panic("TODO")
+	it, err := newQueueMetadataItem(row.QueueType, map[string]int64{}, row.Version, row.CurrentTimeStamp)
+	if err != nil {
+		return err
+	}
+	_, err = db.client.PutItemWithContext(ctx, &dynamodb.PutItemInput{
+		TableName:                db.tableName(tableQueueMetadata),
+		Item:                     it,
+		ConditionExpression:      aws.String("attribute_not_exists(#queue_type)"),
+		ExpressionAttributeNames: map[string]*string{"#queue_type": aws.String(attrQueueType)},
+	})
+	// it's ok if the item is not written, which means that the record exists already.
+	if isConditionalCheckFailed(err) {
+		return nil
+	}
+	return err
 }
 
 // **Conditionally** update a queue metadata row, if current version is matched(meaning current == row.Version - 1),
@@ -102,7 +232,21 @@ func (db *ddb) UpdateQueueMetadataCas(
 	ctx context.Context,
 	row nosqlplugin.QueueMetadataRow,
 ) error {
-	panic("TODO")
+	it, err := newQueueMetadataItem(row.QueueType, row.ClusterAckLevels, row.Version, row.CurrentTimeStamp)
+	if err != nil {
+		return err
+	}
+	_, err = db.client.PutItemWithContext(ctx, &dynamodb.PutItemInput{
+		TableName:                 db.tableName(tableQueueMetadata),
+		Item:                      it,
+		ConditionExpression:       aws.String("#version = :previous_version"),
+		ExpressionAttributeNames:  map[string]*string{"#version": aws.String(attrVersion)},
+		ExpressionAttributeValues: map[string]*dynamodb.AttributeValue{":previous_version": attrN(row.Version - 1)},
+	})
+	if isConditionalCheckFailed(err) {
+		return nosqlplugin.NewConditionFailure("queue")
+	}
+	return err
 }
 
 // Read a QueueMetadata
@@ -110,12 +254,66 @@ func (db *ddb) SelectQueueMetadata(
 	ctx context.Context,
 	queueType persistence.QueueType,
 ) (*nosqlplugin.QueueMetadataRow, error) {
-	panic("TODO")
+	it, err := db.getItem(ctx, tableQueueMetadata, item{attrQueueType: attrN(int64(queueType))})
+	if err != nil {
+		return nil, err
+	}
+	var ackLevels map[string]int64
+	if err := getJSON(it, attrClusterAckLevels, &ackLevels); err != nil {
+		return nil, err
+	}
+	// if record exist but ackLevels is empty, we initialize the map
+	if ackLevels == nil {
+		ackLevels = make(map[string]int64)
+	}
+	return &nosqlplugin.QueueMetadataRow{
+		QueueType:        queueType,
+		ClusterAckLevels: ackLevels,
+		Version:          getN(it, attrVersion),
+	}, nil
 }
 
 func (db *ddb) GetQueueSize(
 	ctx context.Context,
 	queueType persistence.QueueType,
 ) (int64, error) {
-	panic("TODO")
+	return db.queryCount(ctx, &dynamodb.QueryInput{
+		TableName:                 db.tableName(tableQueueMessages),
+		KeyConditionExpression:    aws.String("#queue_type = :queue_type"),
+		ExpressionAttributeNames:  map[string]*string{"#queue_type": aws.String(attrQueueType)},
+		ExpressionAttributeValues: map[string]*dynamodb.AttributeValue{":queue_type": attrN(int64(queueType))},
+	})
+}
+
+func queueMessageKey(queueType persistence.QueueType, messageID int64) item {
+	return item{
+		attrQueueType: attrN(int64(queueType)),
+		attrMessageID: attrN(messageID),
+	}
+}
+
+func newQueueMessageRow(it item) nosqlplugin.QueueMessageRow {
+	return nosqlplugin.QueueMessageRow{
+		QueueType: persistence.QueueType(getN(it, attrQueueType)),
+		ID:        getN(it, attrMessageID),
+		Payload:   getB(it, attrMessagePayload),
+	}
+}
+
+func newQueueMetadataItem(
+	queueType persistence.QueueType,
+	clusterAckLevels map[string]int64,
+	version int64,
+	now time.Time,
+) (item, error) {
+	ackLevels, err := attrJSON(clusterAckLevels)
+	if err != nil {
+		return nil, err
+	}
+	return item{
+		attrQueueType:        attrN(int64(queueType)),
+		attrClusterAckLevels: ackLevels,
+		attrVersion:          attrN(version),
+		attrLastUpdatedTime:  attrTime(now),
+	}, nil
 }
@@ -0,0 +1,213 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamodb
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+
+	"github.com/uber/cadence/common/persistence"
+)
+
+// Attribute names of schema_version and schema_update_history tables
+const (
+	attrKeyspaceName         = "keyspace_name"
+	attrCurrVersion          = "curr_version"
+	attrMinCompatibleVersion = "min_compatible_version"
+	attrCreationTime         = "creation_time"
+	attrYearMonth            = "year_month"
+	attrUpdateTime           = "update_time"
+	attrDescription          = "description"
+	attrManifestMD5          = "manifest_md5"
+	attrNewVersion           = "new_version"
+	attrOldVersion           = "old_version"
+)
+
+// tableDefinition is a DDL statement of the DynamoDB schema, which is the JSON of a CreateTable request.
+// TimeToLiveAttribute optionally enables the TTL of the table on the attribute.
+type tableDefinition struct {
+	dynamodb.CreateTableInput
+	TimeToLiveAttribute string
+}
+
+var (
+	schemaVersionTable = &tableDefinition{
+		CreateTableInput: dynamodb.CreateTableInput{
+			TableName: aws.String(tableSchemaVersion),
+			AttributeDefinitions: []*dynamodb.AttributeDefinition{
+				{AttributeName: aws.String(attrKeyspaceName), AttributeType: aws.String(dynamodb.ScalarAttributeTypeS)},
+			},
+			KeySchema: []*dynamodb.KeySchemaElement{
+				{AttributeName: aws.String(attrKeyspaceName), KeyType: aws.String(dynamodb.KeyTypeHash)},
+			},
+		},
+	}
+	schemaUpdateHistoryTable = &tableDefinition{
+		CreateTableInput: dynamodb.CreateTableInput{
+			TableName: aws.String(tableSchemaUpdateHistory),
+			AttributeDefinitions: []*dynamodb.AttributeDefinition{
+				{AttributeName: aws.String(attrYearMonth), AttributeType: aws.String(dynamodb.ScalarAttributeTypeS)},
+				{AttributeName: aws.String(attrUpdateTime), AttributeType: aws.String(dynamodb.ScalarAttributeTypeN)},
+			},
+			KeySchema: []*dynamodb.KeySchemaElement{
+				{AttributeName: aws.String(attrYearMonth), KeyType: aws.String(dynamodb.KeyTypeHash)},
+				{AttributeName: aws.String(attrUpdateTime), KeyType: aws.String(dynamodb.KeyTypeRange)},
+			},
+		},
+	}
+)
+
+type schemaDB struct {
+	*ddb
+	latest persistence.Schema
+}
+
+func (s *schemaDB) LatestSchema() persistence.Schema {
+	return s.latest
+}
+
+func (db *ddb) HasSchemaVersioning(ctx context.Context) (bool, error) {
+	_, err := db.client.DescribeTableWithContext(ctx, &dynamodb.DescribeTableInput{
+		TableName: db.tableName(tableSchemaVersion),
+	})
+	if isAWSErrorCode(err, dynamodb.ErrCodeResourceNotFoundException) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("error checking for schema_version table: %w", err)
+	}
+	return true, nil
+}
+
+func (db *ddb) SetupVersioning(ctx context.Context) error {
+	if err := db.createTable(ctx, schemaVersionTable); err != nil {
+		return err
+	}
+	return db.createTable(ctx, schemaUpdateHistoryTable)
+}
+
+func (db *ddb) GetSchemaVersion(ctx context.Context) (persistence.Version, error) {
+	it, err := db.getItem(ctx, tableSchemaVersion, item{attrKeyspaceName: attrS(db.cfg.Keyspace)})
+	if err != nil {
+		return persistence.Version{}, fmt.Errorf("reading schemaDB version: %w", err)
+	}
+	return persistence.ParseVersion(getS(it, attrCurrVersion))
+}
+
+func (db *ddb) UpdateSchema(ctx context.Context, update *persistence.SchemaUpdate) error {
+	current, err := db.GetSchemaVersion(ctx)
+	if err != nil {
+		return err
+	}
+	if !current.IsBefore(update.Version) {
+		return fmt.Errorf("unable to update backwards from %s to %s", current, update.Version)
+	}
+	err = db.applyUpdate(ctx, update)
+	if err != nil {
+		return fmt.Errorf("unable to apply update: %w", err)
+	}
+
+	now := time.Now().UTC()
+	_, err = db.client.PutItemWithContext(ctx, &dynamodb.PutItemInput{
+		TableName: db.tableName(tableSchemaVersion),
+		Item: item{
+			attrKeyspaceName:         attrS(db.cfg.Keyspace),
+			attrCreationTime:         attrTime(now),
+			attrCurrVersion:          attrS(update.Version.String()),
+			attrMinCompatibleVersion: attrS(update.MinCompatibleVersion.String()),
+		},
+	})
+	if err != nil {
+		return err
+	}
+	_, err = db.client.PutItemWithContext(ctx, &dynamodb.PutItemInput{
+		TableName: db.tableName(tableSchemaUpdateHistory),
+		Item: item{
+			attrYearMonth:   attrS(now.Format("2006-01")),
+			attrUpdateTime:  attrTime(now),
+			attrOldVersion:  attrS(current.String()),
+			attrNewVersion:  attrS(update.Version.String()),
+			attrManifestMD5: attrS(update.ManifestMD5),
+			attrDescription: attrS(update.Description),
+		},
+	})
+	return err
+}
+
+func (db *ddb) ForceApplySchema(ctx context.Context, update *persistence.SchemaUpdate) error {
+	return db.applyUpdate(ctx, update)
+}
+
+func (db *ddb) applyUpdate(ctx context.Context, update *persistence.SchemaUpdate) error {
+	for _, ddl := range update.DDLStatements {
+		table := &tableDefinition{}
+		if err := json.Unmarshal([]byte(strings.TrimSuffix(ddl, ";")), table); err != nil {
+			return fmt.Errorf("invalid table definition %q: %w", ddl, err)
+		}
+		if err := db.createTable(ctx, table); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// createTable creates the table with the table prefix and waits until it's active.
+// It's a no-op if the table already exists, so that applying a schema is idempotent as Cassandra "IF NOT EXISTS".
+func (db *ddb) createTable(ctx context.Context, table *tableDefinition) error {
+	input := table.CreateTableInput
+	input.TableName = db.tableName(aws.StringValue(table.TableName))
+	if input.BillingMode == nil && input.ProvisionedThroughput == nil {
+		input.BillingMode = aws.String(dynamodb.BillingModePayPerRequest)
+	}
+	_, err := db.client.CreateTableWithContext(ctx, &input)
+	if err != nil && !isAWSErrorCode(err, dynamodb.ErrCodeResourceInUseException) {
+		return fmt.Errorf("creating table %v: %w", aws.StringValue(input.TableName), err)
+	}
+	err = db.client.WaitUntilTableExistsWithContext(ctx, &dynamodb.DescribeTableInput{TableName: input.TableName})
+	if err != nil {
+		return err
+	}
+	if table.TimeToLiveAttribute == "" {
+		return nil
+	}
+	ttl, err := db.client.DescribeTimeToLiveWithContext(ctx, &dynamodb.DescribeTimeToLiveInput{TableName: input.TableName})
+	if err != nil {
+		return err
+	}
+	if ttl.TimeToLiveDescription != nil &&
+		aws.StringValue(ttl.TimeToLiveDescription.TimeToLiveStatus) == dynamodb.TimeToLiveStatusEnabled {
+		return nil
+	}
+	_, err = db.client.UpdateTimeToLiveWithContext(ctx, &dynamodb.UpdateTimeToLiveInput{
+		TableName: input.TableName,
+		TimeToLiveSpecification: &dynamodb.TimeToLiveSpecification{
+			AttributeName: aws.String(table.TimeToLiveAttribute),
+			Enabled:       aws.Bool(true),
+		},
+	})
+	return err
+}
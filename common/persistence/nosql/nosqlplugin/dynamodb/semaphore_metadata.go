@@ -23,17 +23,78 @@ package dynamodb
 import (
 	"context"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
 )
 
+// Attribute names of semaphore_metadata table
+const (
+	attrSemaphoreName = "semaphore_name"
+	attrSize          = "size"
+	attrBucketSize    = "bucket_size"
+)
+
+// InsertSemaphoreMetadata creates a semaphore's metadata with a conditional put.
+// It does not overwrite: if a row with the same (domain_id, semaphore_name) already exists, it returns a ConditionFailure.
 func (db *ddb) InsertSemaphoreMetadata(ctx context.Context, row *nosqlplugin.SemaphoreMetadataRow) error {
-	panic("TODO: InsertSemaphoreMetadata is not implemented")
+	it := item{
+		attrDomainID:      attrS(row.DomainID),
+		attrSemaphoreName: attrS(row.SemaphoreName),
+		attrSize:          attrN(int64(row.Size)),
+		attrBucketSize:    attrN(int64(row.BucketSize)),
+		attrCreatedTime:   attrTime(row.CreatedTime),
+	}
+	_, err := db.client.PutItemWithContext(ctx, &dynamodb.PutItemInput{
+		TableName:                db.tableName(tableSemaphoreMetadata),
+		Item:                     it,
+		ConditionExpression:      aws.String("attribute_not_exists(#semaphore_name)"),
+		ExpressionAttributeNames: map[string]*string{"#semaphore_name": aws.String(attrSemaphoreName)},
+	})
+	if isConditionalCheckFailed(err) {
+		return nosqlplugin.NewConditionFailure("InsertSemaphoreMetadata operation failed because the semaphore already exists")
+	}
+	return err
 }
 
+// SelectSemaphoreMetadata returns a single semaphore's metadata by (domainID, semaphoreName).
 func (db *ddb) SelectSemaphoreMetadata(ctx context.Context, domainID, semaphoreName string) (*nosqlplugin.SemaphoreMetadataRow, error) {
-	panic("TODO: SelectSemaphoreMetadata is not implemented")
+	it, err := db.getItem(ctx, tableSemaphoreMetadata, item{
+		attrDomainID:      attrS(domainID),
+		attrSemaphoreName: attrS(semaphoreName),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return newSemaphoreMetadataRow(it), nil
 }
 
+// SelectSemaphoreMetadataByDomain returns the semaphores in a domain, paginated.
 func (db *ddb) SelectSemaphoreMetadataByDomain(ctx context.Context, filter *nosqlplugin.SemaphoreMetadataFilter) ([]*nosqlplugin.SemaphoreMetadataRow, []byte, error) {
-	panic("TODO: SelectSemaphoreMetadataByDomain is not implemented")
+	input := &dynamodb.QueryInput{
+		TableName:                 db.tableName(tableSemaphoreMetadata),
+		KeyConditionExpression:    aws.String("#domain_id = :domain_id"),
+		ExpressionAttributeNames:  map[string]*string{"#domain_id": aws.String(attrDomainID)},
+		ExpressionAttributeValues: map[string]*dynamodb.AttributeValue{":domain_id": attrS(filter.DomainID)},
+	}
+	items, nextPageToken, err := db.queryPage(ctx, input, filter.PageSize, filter.NextPageToken)
+	if err != nil {
+		return nil, nil, err
+	}
+	rows := make([]*nosqlplugin.SemaphoreMetadataRow, 0, len(items))
+	for _, it := range items {
+		rows = append(rows, newSemaphoreMetadataRow(it))
+	}
+	return rows, nextPageToken, nil
+}
+
+func newSemaphoreMetadataRow(it item) *nosqlplugin.SemaphoreMetadataRow {
+	return &nosqlplugin.SemaphoreMetadataRow{
+		DomainID:      getS(it, attrDomainID),
+		SemaphoreName: getS(it, attrSemaphoreName),
+		Size:          int(getN(it, attrSize)),
+		BucketSize:    int(getN(it, attrBucketSize)),
+		CreatedTime:   getTime(it, attrCreatedTime),
+	}
 }
@@ -0,0 +1,62 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamodb
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+)
+
+// IsSetup returns true as DynamoDB has no database/keyspace concept.
+// The tables of a cluster are namespaced by the table prefix, and they are created by the schema.
+func (db *ddb) IsSetup(_ context.Context) (bool, error) {
+	return true, nil
+}
+
+// Setup is a no-op, see IsSetup
+func (db *ddb) Setup(_ context.Context, _ map[string]string) error {
+	return nil
+}
+
+// Teardown deletes all the tables of the table prefix
+func (db *ddb) Teardown(ctx context.Context) error {
+	var tables []string
+	err := db.client.ListTablesPagesWithContext(ctx, &dynamodb.ListTablesInput{}, func(page *dynamodb.ListTablesOutput, _ bool) bool {
+		for _, table := range page.TableNames {
+			if strings.HasPrefix(*table, db.tablePrefix) {
+				tables = append(tables, *table)
+			}
+		}
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	for _, table := range tables {
+		_, err := db.client.DeleteTableWithContext(ctx, &dynamodb.DeleteTableInput{TableName: &table})
+		if err != nil && !isAWSErrorCode(err, dynamodb.ErrCodeResourceNotFoundException) {
+			return err
+		}
+	}
+	return nil
+}
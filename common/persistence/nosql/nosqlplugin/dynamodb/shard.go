@@ -22,29 +22,169 @@ package dynamodb
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+
+	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
 )
 
+const (
+	attrShardInfo = "shard"
+)
+
 // InsertShard creates a new shard, return error is there is any.
 // Return ShardOperationConditionFailure if the condition doesn't meet
 func (db *ddb) InsertShard(ctx context.Context, row *nosqlplugin.ShardRow) error {
-	panic("TODO")
+	it, err := newShardItem(row)
+	if err != nil {
+		return err
+	}
+	_, err = db.client.PutItemWithContext(ctx, &dynamodb.PutItemInput{
+		TableName:                           db.tableName(tableExecutions),
+		Item:                                it,
+		ConditionExpression:                 aws.String("attribute_not_exists(#sk)"),
+		ExpressionAttributeNames:            map[string]*string{"#sk": aws.String(attrSortKey)},
+		ReturnValuesOnConditionCheckFailure: aws.String(dynamodb.ReturnValuesOnConditionCheckFailureAllOld),
+	})
+	return convertShardConditionFailure(err)
 }
 
 // SelectShard gets a shard
 func (db *ddb) SelectShard(ctx context.Context, shardID int, currentClusterName string) (int64, *nosqlplugin.ShardRow, error) {
-	panic("TODO")
+	it, err := db.getItem(ctx, tableExecutions, shardKey(shardID))
+	if err != nil {
+		return 0, nil, err
+	}
+
+	info := &persistence.InternalShardInfo{}
+	if err := getJSON(it, attrShardInfo, info); err != nil {
+		return 0, nil, err
+	}
+	if info.ClusterTransferAckLevel == nil {
+		info.ClusterTransferAckLevel = map[string]int64{
+			currentClusterName: info.TransferAckLevel,
+		}
+	}
+	if info.ClusterTimerAckLevel == nil {
+		info.ClusterTimerAckLevel = map[string]time.Time{
+			currentClusterName: info.TimerAckLevel,
+		}
+	}
+	if info.ClusterReplicationLevel == nil {
+		info.ClusterReplicationLevel = make(map[string]int64)
+	}
+	if info.ReplicationDLQAckLevel == nil {
+		info.ReplicationDLQAckLevel = make(map[string]int64)
+	}
+	info.PendingFailoverMarkers = normalizeDataBlob(info.PendingFailoverMarkers)
+	info.TransferProcessingQueueStates = normalizeDataBlob(info.TransferProcessingQueueStates)
+	info.TimerProcessingQueueStates = normalizeDataBlob(info.TimerProcessingQueueStates)
+
+	return getN(it, attrRangeID), &nosqlplugin.ShardRow{
+		InternalShardInfo: info,
+		Data:              getB(it, attrData),
+		DataEncoding:      getS(it, attrDataEncoding),
+	}, nil
 }
 
 // UpdateRangeID updates the rangeID, return error is there is any
 // Return ShardOperationConditionFailure if the condition doesn't meet
 func (db *ddb) UpdateRangeID(ctx context.Context, shardID int, rangeID int64, previousRangeID int64) error {
-	panic("TODO")
+	_, err := db.client.UpdateItemWithContext(ctx, &dynamodb.UpdateItemInput{
+		TableName:           db.tableName(tableExecutions),
+		Key:                 shardKey(shardID),
+		UpdateExpression:    aws.String("SET #range_id = :range_id"),
+		ConditionExpression: aws.String("#range_id = :previous_range_id"),
+		ExpressionAttributeNames: map[string]*string{
+			"#range_id": aws.String(attrRangeID),
+		},
+		ExpressionAttributeValues: map[string]*dynamodb.AttributeValue{
+			":range_id":          attrN(rangeID),
+			":previous_range_id": attrN(previousRangeID),
+		},
+		ReturnValuesOnConditionCheckFailure: aws.String(dynamodb.ReturnValuesOnConditionCheckFailureAllOld),
+	})
+	return convertShardConditionFailure(err)
 }
 
 // UpdateShard updates a shard, return error is there is any.
 // Return ShardOperationConditionFailure if the condition doesn't meet
 func (db *ddb) UpdateShard(ctx context.Context, row *nosqlplugin.ShardRow, previousRangeID int64) error {
-	panic("TODO")
+	it, err := newShardItem(row)
+	if err != nil {
+		return err
+	}
+	_, err = db.client.PutItemWithContext(ctx, &dynamodb.PutItemInput{
+		TableName:           db.tableName(tableExecutions),
+		Item:                it,
+		ConditionExpression: aws.String("#range_id = :previous_range_id"),
+		ExpressionAttributeNames: map[string]*string{
+			"#range_id": aws.String(attrRangeID),
+		},
+		ExpressionAttributeValues: map[string]*dynamodb.AttributeValue{
+			":previous_range_id": attrN(previousRangeID),
+		},
+		ReturnValuesOnConditionCheckFailure: aws.String(dynamodb.ReturnValuesOnConditionCheckFailureAllOld),
+	})
+	return convertShardConditionFailure(err)
+}
+
+func shardKey(shardID int) item {
+	return item{
+		attrShardID: attrN(int64(shardID)),
+		attrSortKey: attrS(rowTypeShard),
+	}
+}
+
+func newShardItem(row *nosqlplugin.ShardRow) (item, error) {
+	info := *row.InternalShardInfo
+	info.UpdatedAt = row.CurrentTimestamp
+	shard, err := attrJSON(&info)
+	if err != nil {
+		return nil, err
+	}
+	it := shardKey(row.ShardID)
+	it[attrRangeID] = attrN(row.RangeID)
+	it[attrShardInfo] = shard
+	setBlob(it, attrData, attrDataEncoding, row.Data, row.DataEncoding)
+	return it, nil
+}
+
+func convertShardConditionFailure(err error) error {
+	var condErr *dynamodb.ConditionalCheckFailedException
+	if !errors.As(err, &condErr) {
+		return err
+	}
+	return &nosqlplugin.ShardOperationConditionFailure{
+		RangeID: getN(condErr.Item, attrRangeID),
+		Details: itemDetails(condErr.Item),
+	}
+}
+
+// itemDetails formats the significant attributes of an item for logging purpose
+func itemDetails(it item) string {
+	var columns []string
+	for k, v := range it {
+		if v == nil || v.B != nil {
+			continue
+		}
+		columns = append(columns, fmt.Sprintf("%s=%v", k, strings.Join(strings.Fields(v.String()), " ")))
+	}
+	sort.Strings(columns)
+	return strings.Join(columns, ",")
+}
+
+// normalizeDataBlob makes sure an empty blob is restored as nil, the same as persistence.NewDataBlob
+func normalizeDataBlob(blob *persistence.DataBlob) *persistence.DataBlob {
+	if blob == nil {
+		return nil
+	}
+	return persistence.NewDataBlob(blob.Data, blob.Encoding)
 }
@@ -1,4 +1,4 @@
-// Copyright (c) 2021 Uber Technologies, Inc.
+// Copyright (c) 2020 Uber Technologies, Inc.
 //
 // Permission is hereby granted, free of charge, to any person obtaining a copy
 // of this software and associated documentation files (the "Software"), to deal
@@ -22,20 +22,53 @@ package dynamodb
 
 import (
 	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
 
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
 )
 
+const (
+	initialRangeID = 1 // Id of the first range of a new task list
+
+	attrTaskListKey = "tasklist_key"
+	attrTaskID      = "task_id"
+	attrTaskList    = "tasklist"
+	attrTask        = "task"
+)
+
 // SelectTaskList returns a single tasklist row.
 // Return IsNotFoundError if the row doesn't exist
 func (db *ddb) SelectTaskList(ctx context.Context, filter *nosqlplugin.TaskListFilter) (*nosqlplugin.TaskListRow, error) {
-	panic("TODO")
+	it, err := db.getItem(ctx, tableTaskLists, taskListKey(filter))
+	if err != nil {
+		return nil, err
+	}
+	return newTaskListRow(it)
 }
 
 // InsertTaskList insert a single tasklist row
 // Return IsConditionFailedError if the row already exists, and also the existing row
 func (db *ddb) InsertTaskList(ctx context.Context, row *nosqlplugin.TaskListRow) error {
-	panic("TODO")
+	newRow := *row
+	newRow.RangeID = initialRangeID
+	newRow.AckLevel = 0
+	it, err := newTaskListItem(&newRow)
+	if err != nil {
+		return err
+	}
+	_, err = db.client.PutItemWithContext(ctx, &dynamodb.PutItemInput{
+		TableName:                           db.tableName(tableTaskLists),
+		Item:                                it,
+		ConditionExpression:                 aws.String("attribute_not_exists(#key)"),
+		ExpressionAttributeNames:            map[string]*string{"#key": aws.String(attrTaskListKey)},
+		ReturnValuesOnConditionCheckFailure: aws.String(dynamodb.ReturnValuesOnConditionCheckFailureAllOld),
+	})
+	return convertTaskListConditionFailure(err)
 }
 
 // UpdateTaskList updates a single tasklist row
@@ -45,7 +78,7 @@ func (db *ddb) UpdateTaskList(
 	row *nosqlplugin.TaskListRow,
 	previousRangeID int64,
 ) error {
-	panic("TODO")
+	return db.putTaskList(ctx, row, previousRangeID)
 }
 
 // UpdateTaskList updates a single tasklist row, and set an TTL on the record
@@ -58,19 +91,62 @@ func (db *ddb) UpdateTaskListWithTTL(
 	row *nosqlplugin.TaskListRow,
 	previousRangeID int64,
 ) error {
-	panic("TODO")
+	// TTL is ignored since the TaskListScavenger cleans up the idle tasklists using ListTaskList
+	newRow := *row
+	newRow.LastUpdatedTime = row.CurrentTimeStamp
+	return db.putTaskList(ctx, &newRow, previousRangeID)
+}
+
+func (db *ddb) putTaskList(ctx context.Context, row *nosqlplugin.TaskListRow, previousRangeID int64) error {
+	it, err := newTaskListItem(row)
+	if err != nil {
+		return err
+	}
+	_, err = db.client.PutItemWithContext(ctx, &dynamodb.PutItemInput{
+		TableName:                           db.tableName(tableTaskLists),
+		Item:                                it,
+		ConditionExpression:                 aws.String("#range_id = :previous_range_id"),
+		ExpressionAttributeNames:            map[string]*string{"#range_id": aws.String(attrRangeID)},
+		ExpressionAttributeValues:           map[string]*dynamodb.AttributeValue{":previous_range_id": attrN(previousRangeID)},
+		ReturnValuesOnConditionCheckFailure: aws.String(dynamodb.ReturnValuesOnConditionCheckFailureAllOld),
+	})
+	return convertTaskListConditionFailure(err)
 }
 
 // ListTaskList returns all tasklists.
 // Noop if TTL is already implemented in other methods
 func (db *ddb) ListTaskList(ctx context.Context, pageSize int, nextPageToken []byte) (*nosqlplugin.ListTaskListResult, error) {
-	panic("TODO")
+	items, token, err := db.scanPage(ctx, &dynamodb.ScanInput{
+		TableName: db.tableName(tableTaskLists),
+	}, pageSize, nextPageToken)
+	if err != nil {
+		return nil, err
+	}
+	result := &nosqlplugin.ListTaskListResult{
+		NextPageToken: token,
+	}
+	for _, it := range items {
+		row, err := newTaskListRow(it)
+		if err != nil {
+			return nil, err
+		}
+		result.TaskLists = append(result.TaskLists, row)
+	}
+	return result, nil
 }
 
 // DeleteTaskList deletes a single tasklist row
 // Return TaskOperationConditionFailure if the condition doesn't meet
 func (db *ddb) DeleteTaskList(ctx context.Context, filter *nosqlplugin.TaskListFilter, previousRangeID int64) error {
-	panic("TODO")
+	_, err := db.client.DeleteItemWithContext(ctx, &dynamodb.DeleteItemInput{
+		TableName:                           db.tableName(tableTaskLists),
+		Key:                                 taskListKey(filter),
+		ConditionExpression:                 aws.String("#range_id = :previous_range_id"),
+		ExpressionAttributeNames:            map[string]*string{"#range_id": aws.String(attrRangeID)},
+		ExpressionAttributeValues:           map[string]*dynamodb.AttributeValue{":previous_range_id": attrN(previousRangeID)},
+		ReturnValuesOnConditionCheckFailure: aws.String(dynamodb.ReturnValuesOnConditionCheckFailureAllOld),
+	})
+	return convertTaskListConditionFailure(err)
 }
 
 // InsertTasks inserts a batch of tasks
@@ -80,23 +156,205 @@ func (db *ddb) InsertTasks(
 	tasksToInsert []*nosqlplugin.TaskRowForInsert,
 	tasklistCondition *nosqlplugin.TaskListRow,
 ) error {
-	panic("TODO")
+	filter := &nosqlplugin.TaskListFilter{
+		DomainID:     tasklistCondition.DomainID,
+		TaskListName: tasklistCondition.TaskListName,
+		TaskListType: tasklistCondition.TaskListType,
+	}
+	// one slot of each transaction is reserved for checking the range_id of the tasklist
+	chunkSize := maxTransactionItems - 1
+	for start := 0; start < len(tasksToInsert); start += chunkSize {
+		end := start + chunkSize
+		if end > len(tasksToInsert) {
+			end = len(tasksToInsert)
+		}
+		var txnItems []*dynamodb.TransactWriteItem
+		for _, task := range tasksToInsert[start:end] {
+			it, err := newTaskItem(filter, task, tasklistCondition.CurrentTimeStamp)
+			if err != nil {
+				return err
+			}
+			txnItems = append(txnItems, &dynamodb.TransactWriteItem{
+				Put: &dynamodb.Put{
+					TableName: db.tableName(tableTasks),
+					Item:      it,
+				},
+			})
+		}
+		txnItems = append(txnItems, &dynamodb.TransactWriteItem{
+			ConditionCheck: &dynamodb.ConditionCheck{
+				TableName:                           db.tableName(tableTaskLists),
+				Key:                                 taskListKey(filter),
+				ConditionExpression:                 aws.String("#range_id = :range_id"),
+				ExpressionAttributeNames:            map[string]*string{"#range_id": aws.String(attrRangeID)},
+				ExpressionAttributeValues:           map[string]*dynamodb.AttributeValue{":range_id": attrN(tasklistCondition.RangeID)},
+				ReturnValuesOnConditionCheckFailure: aws.String(dynamodb.ReturnValuesOnConditionCheckFailureAllOld),
+			},
+		})
+
+		if err := db.transactWrite(ctx, txnItems); err != nil {
+			var txnErr *dynamodb.TransactionCanceledException
+			if errors.As(err, &txnErr) && len(txnErr.CancellationReasons) == len(txnItems) {
+				reason := txnErr.CancellationReasons[len(txnItems)-1]
+				if aws.StringValue(reason.Code) == cancellationReasonConditionalCheckFailed {
+					return &nosqlplugin.TaskOperationConditionFailure{
+						RangeID: getN(reason.Item, attrRangeID),
+						Details: itemDetails(reason.Item),
+					}
+				}
+			}
+			return err
+		}
+	}
+	return nil
 }
 
 // SelectTasks return tasks that associated to a tasklist
 func (db *ddb) SelectTasks(ctx context.Context, filter *nosqlplugin.TasksFilter) ([]*nosqlplugin.TaskRow, error) {
-	panic("TODO")
+	if filter.MinTaskID >= filter.MaxTaskID {
+		return nil, nil
+	}
+	items, _, err := db.queryPage(ctx, &dynamodb.QueryInput{
+		TableName:              db.tableName(tableTasks),
+		KeyConditionExpression: aws.String("#key = :key AND #task_id BETWEEN :min_task_id AND :max_task_id"),
+		ExpressionAttributeNames: map[string]*string{
+			"#key":     aws.String(attrTaskListKey),
+			"#task_id": aws.String(attrTaskID),
+		},
+		ExpressionAttributeValues: map[string]*dynamodb.AttributeValue{
+			":key":         attrS(tasksPartitionKey(&filter.TaskListFilter)),
+			":min_task_id": attrN(filter.MinTaskID + 1),
+			":max_task_id": attrN(filter.MaxTaskID),
+		},
+	}, filter.BatchSize, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	var response []*nosqlplugin.TaskRow
+	for _, it := range items {
+		// DynamoDB deletes the expired items in background, they are still readable before then
+		if isExpired(it, now) {
+			continue
+		}
+		task := &nosqlplugin.TaskRow{}
+		if err := getJSON(it, attrTask, task); err != nil {
+			return nil, err
+		}
+		task.TaskID = getN(it, attrTaskID)
+		if hasAttr(it, attrExpiry) {
+			task.Expiry = time.Unix(getN(it, attrExpiry), 0).UTC()
+		}
+		response = append(response, task)
+	}
+	return response, nil
 }
 
 // SelectTasks return tasks that associated to a tasklist
 func (db *ddb) GetTasksCount(ctx context.Context, filter *nosqlplugin.TasksFilter) (int64, error) {
-	panic("TODO")
+	return db.queryCount(ctx, &dynamodb.QueryInput{
+		TableName:              db.tableName(tableTasks),
+		KeyConditionExpression: aws.String("#key = :key AND #task_id > :min_task_id"),
+		ExpressionAttributeNames: map[string]*string{
+			"#key":     aws.String(attrTaskListKey),
+			"#task_id": aws.String(attrTaskID),
+		},
+		ExpressionAttributeValues: map[string]*dynamodb.AttributeValue{
+			":key":         attrS(tasksPartitionKey(&filter.TaskListFilter)),
+			":min_task_id": attrN(filter.MinTaskID),
+		},
+	})
 }
 
 // DeleteTask delete a batch tasks that taskIDs less than the row
 // If TTL is not implemented, then should also return the number of rows deleted, otherwise persistence.UnknownNumRowsAffected
-// NOTE: This API ignores the `BatchSize` request parameter i.e. either all tasks leq the task_id will be deleted or an error will
-// be returned to the caller, because rowsDeleted is not supported by Cassandra
+// NOTE: DynamoDB has no range delete, the tasks are read and deleted in batches, so BatchSize is respected
+// and the actual number of deleted rows is returned.
 func (db *ddb) RangeDeleteTasks(ctx context.Context, filter *nosqlplugin.TasksFilter) (rowsDeleted int, err error) {
-	panic("TODO")
+	if filter.MinTaskID >= filter.MaxTaskID {
+		return 0, nil
+	}
+	return db.deleteByQuery(ctx, tableTasks, &dynamodb.QueryInput{
+		TableName:              db.tableName(tableTasks),
+		KeyConditionExpression: aws.String("#key = :key AND #task_id BETWEEN :min_task_id AND :max_task_id"),
+		ExpressionAttributeNames: map[string]*string{
+			"#key":     aws.String(attrTaskListKey),
+			"#task_id": aws.String(attrTaskID),
+		},
+		ExpressionAttributeValues: map[string]*dynamodb.AttributeValue{
+			":key":         attrS(tasksPartitionKey(&filter.TaskListFilter)),
+			":min_task_id": attrN(filter.MinTaskID + 1),
+			":max_task_id": attrN(filter.MaxTaskID),
+		},
+	}, []string{attrTaskListKey, attrTaskID}, filter.BatchSize)
+}
+
+func tasksPartitionKey(filter *nosqlplugin.TaskListFilter) string {
+	return compositeKey(filter.DomainID, filter.TaskListName, strconv.Itoa(filter.TaskListType))
+}
+
+func taskListKey(filter *nosqlplugin.TaskListFilter) item {
+	return item{
+		attrTaskListKey: attrS(tasksPartitionKey(filter)),
+	}
+}
+
+func newTaskListItem(row *nosqlplugin.TaskListRow) (item, error) {
+	tasklist, err := attrJSON(row)
+	if err != nil {
+		return nil, err
+	}
+	it := taskListKey(&nosqlplugin.TaskListFilter{
+		DomainID:     row.DomainID,
+		TaskListName: row.TaskListName,
+		TaskListType: row.TaskListType,
+	})
+	it[attrRangeID] = attrN(row.RangeID)
+	it[attrTaskList] = tasklist
+	it[attrCurrentTimestamp] = attrTime(row.CurrentTimeStamp)
+	return it, nil
+}
+
+func newTaskListRow(it item) (*nosqlplugin.TaskListRow, error) {
+	row := &nosqlplugin.TaskListRow{}
+	if err := getJSON(it, attrTaskList, row); err != nil {
+		return nil, err
+	}
+	row.RangeID = getN(it, attrRangeID)
+	row.CurrentTimeStamp = time.Time{}
+	return row, nil
+}
+
+func newTaskItem(filter *nosqlplugin.TaskListFilter, task *nosqlplugin.TaskRowForInsert, now time.Time) (item, error) {
+	row := task.TaskRow
+	row.DomainID = filter.DomainID
+	row.TaskListName = filter.TaskListName
+	row.TaskListType = filter.TaskListType
+	row.Expiry = time.Time{}
+	data, err := attrJSON(&row)
+	if err != nil {
+		return nil, err
+	}
+	it := item{
+		attrTaskListKey:      attrS(tasksPartitionKey(filter)),
+		attrTaskID:           attrN(task.TaskID),
+		attrTask:             data,
+		attrCurrentTimestamp: attrTime(now),
+	}
+	if task.TTLSeconds > 0 {
+		it[attrExpiry] = attrN(expiryFromTTL(time.Now(), int64(task.TTLSeconds)))
+	}
+	return it, nil
+}
+
+func convertTaskListConditionFailure(err error) error {
+	var condErr *dynamodb.ConditionalCheckFailedException
+	if !errors.As(err, &condErr) {
+		return err
+	}
+	return &nosqlplugin.TaskOperationConditionFailure{
+		RangeID: getN(condErr.Item, attrRangeID),
+		Details: itemDetails(condErr.Item),
+	}
 }
@@ -22,16 +22,63 @@ package dynamodb
 
 import (
 	"context"
+	"errors"
+	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+
+	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
 )
 
+// Attribute names of visibility table
+const (
+	attrVisibilityPartition = "domain_state"
+	attrStartTime           = "start_time"
+	attrCloseTime           = "close_time"
+	attrTypeName            = "type_name"
+	attrVisibility          = "visibility"
+
+	visibilityStateOpen   = "open"
+	visibilityStateClosed = "closed"
+)
+
+// InsertVisibility creates a new visibility record, return error is there is any.
+// TODO: DynamoDB implementation ignores search attributes, the same as Cassandra
 func (db *ddb) InsertVisibility(
 	ctx context.Context,
 	ttlSeconds int64,
 	row *nosqlplugin.VisibilityRowForInsert,
 ) error {
-	panic("TODO")
+	it, err := newVisibilityItem(row.DomainID, visibilityStateOpen, &row.VisibilityRow, ttlSeconds)
+	if err != nil {
+		return err
+	}
+	// The started record may arrive after the closed one, e.g. when cross DC replication is involved.
+	// Cassandra relies on write timestamps to ignore it, here the closed record is checked instead.
+	err = db.transactWrite(ctx, []*dynamodb.TransactWriteItem{
+		{
+			Put: &dynamodb.Put{
+				TableName: db.tableName(tableVisibility),
+				Item:      it,
+			},
+		},
+		{
+			ConditionCheck: &dynamodb.ConditionCheck{
+				TableName:                db.tableName(tableVisibility),
+				Key:                      visibilityKey(row.DomainID, visibilityStateClosed, row.RunID),
+				ConditionExpression:      aws.String("attribute_not_exists(#run_id)"),
+				ExpressionAttributeNames: map[string]*string{"#run_id": aws.String(attrRunID)},
+			},
+		},
+	})
+	var txnErr *dynamodb.TransactionCanceledException
+	if errors.As(err, &txnErr) && len(txnErr.CancellationReasons) == 2 &&
+		aws.StringValue(txnErr.CancellationReasons[1].Code) == cancellationReasonConditionalCheckFailed {
+		return nil
+	}
+	return err
 }
 
 func (db *ddb) UpdateVisibility(
@@ -39,26 +86,174 @@ func (db *ddb) UpdateVisibility(
 	ttlSeconds int64,
 	row *nosqlplugin.VisibilityRowForUpdate,
 ) error {
-	panic("TODO")
+	if row.UpdateCloseToOpen {
+		// TODO implement it when where is a need
+		panic("not supported operation")
+	}
+
+	it, err := newVisibilityItem(row.DomainID, visibilityStateClosed, &row.VisibilityRow, ttlSeconds)
+	if err != nil {
+		return err
+	}
+	txnItems := []*dynamodb.TransactWriteItem{
+		{
+			Put: &dynamodb.Put{
+				TableName: db.tableName(tableVisibility),
+				Item:      it,
+			},
+		},
+	}
+	if row.UpdateOpenToClose {
+		txnItems = append(txnItems, &dynamodb.TransactWriteItem{
+			Delete: &dynamodb.Delete{
+				TableName: db.tableName(tableVisibility),
+				Key:       visibilityKey(row.DomainID, visibilityStateOpen, row.RunID),
+			},
+		})
+	}
+	return db.transactWrite(ctx, txnItems)
 }
 
 func (db *ddb) SelectVisibility(
 	ctx context.Context,
 	filter *nosqlplugin.VisibilityFilter,
 ) (*nosqlplugin.SelectVisibilityResponse, error) {
-	panic("TODO")
+	request := &filter.ListRequest
+	var state, index, timeAttr string
+	switch filter.FilterType {
+	case nosqlplugin.AllOpen, nosqlplugin.OpenByWorkflowType, nosqlplugin.OpenByWorkflowID:
+		state, index, timeAttr = visibilityStateOpen, indexVisibilityStartTime, attrStartTime
+	case nosqlplugin.AllClosed, nosqlplugin.ClosedByWorkflowType, nosqlplugin.ClosedByWorkflowID, nosqlplugin.ClosedByClosedStatus:
+		state = visibilityStateClosed
+		switch filter.SortType {
+		case nosqlplugin.SortByStartTime:
+			index, timeAttr = indexVisibilityStartTime, attrStartTime
+		case nosqlplugin.SortByClosedTime:
+			index, timeAttr = indexVisibilityCloseTime, attrCloseTime
+		default:
+			panic("not supported sorting type")
+		}
+	default:
+		panic("no supported filter type")
+	}
+
+	input := &dynamodb.QueryInput{
+		TableName:              db.tableName(tableVisibility),
+		IndexName:              aws.String(index),
+		KeyConditionExpression: aws.String("#pk = :pk AND #time BETWEEN :earliest AND :latest"),
+		ExpressionAttributeNames: map[string]*string{
+			"#pk":   aws.String(attrVisibilityPartition),
+			"#time": aws.String(timeAttr),
+		},
+		ExpressionAttributeValues: map[string]*dynamodb.AttributeValue{
+			":pk":       attrS(compositeKey(request.DomainUUID, state)),
+			":earliest": attrTime(request.EarliestTime),
+			":latest":   attrTime(request.LatestTime),
+		},
+		// latest records first
+		ScanIndexForward: aws.Bool(false),
+	}
+	switch filter.FilterType {
+	case nosqlplugin.OpenByWorkflowType, nosqlplugin.ClosedByWorkflowType:
+		setVisibilityFilter(input, attrTypeName, attrS(filter.WorkflowType))
+	case nosqlplugin.OpenByWorkflowID, nosqlplugin.ClosedByWorkflowID:
+		setVisibilityFilter(input, attrWorkflowID, attrS(filter.WorkflowID))
+	case nosqlplugin.ClosedByClosedStatus:
+		setVisibilityFilter(input, attrCloseStatus, attrN(int64(filter.CloseStatus)))
+	}
+
+	items, nextPageToken, err := db.queryPage(ctx, input, request.PageSize, request.NextPageToken)
+	if err != nil {
+		return nil, err
+	}
+	response := &nosqlplugin.SelectVisibilityResponse{
+		Executions:    make([]*nosqlplugin.VisibilityRow, 0, len(items)),
+		NextPageToken: nextPageToken,
+	}
+	for _, it := range items {
+		row, err := newVisibilityRow(it)
+		if err != nil {
+			return nil, err
+		}
+		response.Executions = append(response.Executions, row)
+	}
+	return response, nil
 }
 
+// DeleteVisibility is noop as records are deleted by TTL,
+// except the open record is deleted explicitly when an admin command is issued
 func (db *ddb) DeleteVisibility(
 	ctx context.Context,
 	domainID, workflowID, runID string,
 ) error {
-	panic("TODO")
+	key := persistence.VisibilityAdminDeletionKey("visibilityAdminDelete")
+	if v := ctx.Value(key); v != nil && v.(bool) {
+		return db.deleteItem(ctx, tableVisibility, visibilityKey(domainID, visibilityStateOpen, runID))
+	}
+	return nil
 }
 
 func (db *ddb) SelectOneClosedWorkflow(
 	ctx context.Context,
 	domainID, workflowID, runID string,
 ) (*nosqlplugin.VisibilityRow, error) {
-	panic("TODO")
+	it, err := db.getItem(ctx, tableVisibility, visibilityKey(domainID, visibilityStateClosed, runID))
+	if err != nil {
+		if db.IsNotFoundError(err) {
+			// Special case: return nil,nil if not found(since we will deprecate it, it's not worth refactor to be consistent)
+			return nil, nil
+		}
+		return nil, err
+	}
+	if getS(it, attrWorkflowID) != workflowID {
+		return nil, nil
+	}
+	return newVisibilityRow(it)
+}
+
+func visibilityKey(domainID, state, runID string) item {
+	return item{
+		attrVisibilityPartition: attrS(compositeKey(domainID, state)),
+		attrRunID:               attrS(runID),
+	}
+}
+
+func newVisibilityItem(domainID, state string, row *nosqlplugin.VisibilityRow, ttlSeconds int64) (item, error) {
+	record := *row
+	record.DomainID = domainID
+	record.SearchAttributes = nil
+	visibility, err := attrJSON(&record)
+	if err != nil {
+		return nil, err
+	}
+	it := visibilityKey(domainID, state, row.RunID)
+	it[attrWorkflowID] = attrS(row.WorkflowID)
+	it[attrTypeName] = attrS(row.TypeName)
+	it[attrStartTime] = attrTime(row.StartTime)
+	it[attrVisibility] = visibility
+	if state == visibilityStateClosed {
+		it[attrCloseTime] = attrTime(row.CloseTime)
+		if row.Status != nil {
+			it[attrCloseStatus] = attrN(int64(*row.Status))
+		}
+	}
+	if ttlSeconds > 0 {
+		it[attrExpiry] = attrN(expiryFromTTL(time.Now(), ttlSeconds))
+	}
+	return it, nil
+}
+
+func newVisibilityRow(it item) (*nosqlplugin.VisibilityRow, error) {
+	row := &nosqlplugin.VisibilityRow{}
+	if err := getJSON(it, attrVisibility, row); err != nil {
+		return nil, err
+	}
+	row.Memo = normalizeDataBlob(row.Memo)
+	return row, nil
+}
+
+func setVisibilityFilter(input *dynamodb.QueryInput, attr string, v *dynamodb.AttributeValue) {
+	input.FilterExpression = aws.String("#filter = :filter")
+	input.ExpressionAttributeNames["#filter"] = aws.String(attr)
+	input.ExpressionAttributeValues[":filter"] = v
 }
@@ -23,14 +23,23 @@ package dynamodb
 
 import (
 	"context"
+	"fmt"
+	"math"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+
+	"github.com/uber/cadence/common/constants"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
 )
 
 var _ nosqlplugin.WorkflowCRUD = (*ddb)(nil)
 
+// historyTaskKeyAttrs are the key attributes of executions table, used for deleting the rows by query
+var historyTaskKeyAttrs = []string{attrShardID, attrSortKey}
+
 func (db *ddb) InsertWorkflowExecutionWithTasks(
 	ctx context.Context,
 	requests *nosqlplugin.WorkflowRequestsWriteRequest,
@@ -40,7 +49,31 @@ func (db *ddb) InsertWorkflowExecutionWithTasks(
 	activeClusterSelectionPolicyRow *nosqlplugin.ActiveClusterSelectionPolicyRow,
 	shardCondition *nosqlplugin.ShardCondition,
 ) error {
-	panic("TODO")
+	shardID := shardCondition.ShardID
+	domainID := execution.DomainID
+	workflowID := execution.WorkflowID
+	timeStamp := execution.CurrentTimeStamp
+
+	failures, err := db.executeWorkflowWrite(ctx, shardCondition, timeStamp, func(w *workflowWrite) error {
+		db.insertWorkflowActiveClusterSelectionPolicyRow(&w.txn, activeClusterSelectionPolicyRow)
+		err := db.insertOrUpsertWorkflowRequestRow(&w.txn, requests, timeStamp)
+		if err != nil {
+			return err
+		}
+		err = db.createOrUpdateCurrentWorkflow(&w.txn, shardID, domainID, workflowID, currentWorkflowRequest, timeStamp)
+		if err != nil {
+			return err
+		}
+		err = db.createWorkflowExecutionWithMergeMaps(w, execution)
+		if err != nil {
+			return err
+		}
+		return db.createTasksByCategory(w, tasksByCategory)
+	})
+	if len(failures) > 0 {
+		return convertCreateWorkflowFailure(failures, currentWorkflowRequest, execution, shardCondition)
+	}
+	return err
 }
 
 func (db *ddb) UpdateWorkflowExecutionWithTasks(
@@ -54,113 +87,527 @@ func (db *ddb) UpdateWorkflowExecutionWithTasks(
 	tasksByCategory map[persistence.HistoryTaskCategory][]*nosqlplugin.HistoryMigrationTask,
 	shardCondition *nosqlplugin.ShardCondition,
 ) error {
-	panic("TODO")
+	shardID := shardCondition.ShardID
+	var domainID, workflowID string
+	var previousNextEventIDCondition int64
+	var timeStamp time.Time
+	if mutatedExecution != nil {
+		domainID = mutatedExecution.DomainID
+		workflowID = mutatedExecution.WorkflowID
+		previousNextEventIDCondition = *mutatedExecution.PreviousNextEventIDCondition
+		timeStamp = mutatedExecution.CurrentTimeStamp
+	} else if resetExecution != nil {
+		domainID = resetExecution.DomainID
+		workflowID = resetExecution.WorkflowID
+		previousNextEventIDCondition = *resetExecution.PreviousNextEventIDCondition
+		timeStamp = resetExecution.CurrentTimeStamp
+	} else {
+		return fmt.Errorf("at least one of mutatedExecution and resetExecution should be provided")
+	}
+
+	// the entries superseded by the write are only known from the current index
+	var mutatedIndex, resetIndex item
+	var err error
+	if mutatedExecution != nil && requiresExecutionIndex(mutatedExecution) {
+		if mutatedIndex, err = db.selectExecutionIndex(ctx, shardID, mutatedExecution); err != nil {
+			return err
+		}
+	}
+	if resetExecution != nil {
+		if resetIndex, err = db.selectExecutionIndex(ctx, shardID, resetExecution); err != nil {
+			return err
+		}
+	}
+
+	failures, err := db.executeWorkflowWrite(ctx, shardCondition, timeStamp, func(w *workflowWrite) error {
+		err := db.insertOrUpsertWorkflowRequestRow(&w.txn, requests, timeStamp)
+		if err != nil {
+			return err
+		}
+		err = db.createOrUpdateCurrentWorkflow(&w.txn, shardID, domainID, workflowID, currentWorkflowRequest, timeStamp)
+		if err != nil {
+			return err
+		}
+
+		if mutatedExecution != nil {
+			err = db.updateWorkflowExecutionAndEventBufferWithMergeAndDeleteMaps(w, mutatedExecution, mutatedIndex)
+			if err != nil {
+				return err
+			}
+		}
+
+		if insertedExecution != nil {
+			err = db.createWorkflowExecutionWithMergeMaps(w, insertedExecution)
+			if err != nil {
+				return err
+			}
+			db.insertWorkflowActiveClusterSelectionPolicyRow(&w.txn, activeClusterSelectionPolicyRow)
+		}
+
+		if resetExecution != nil {
+			err = db.resetWorkflowExecutionAndMapsAndEventBuffer(w, resetExecution, resetIndex)
+			if err != nil {
+				return err
+			}
+		}
+
+		return db.createTasksByCategory(w, tasksByCategory)
+	})
+	if len(failures) > 0 {
+		return convertUpdateWorkflowFailure(failures, currentWorkflowRequest, previousNextEventIDCondition, shardCondition)
+	}
+	return err
 }
 
 func (db *ddb) SelectCurrentWorkflow(ctx context.Context, shardID int, domainID, workflowID string) (*nosqlplugin.CurrentWorkflowRow, error) {
-	panic("TODO")
+	it, err := db.getItem(ctx, tableExecutions, currentWorkflowKey(shardID, domainID, workflowID))
+	if err != nil {
+		return nil, err
+	}
+	row := newCurrentWorkflowRow(shardID, it)
+	if !hasAttr(it, attrLastWriteVersion) {
+		row.LastWriteVersion = constants.EmptyVersion
+	}
+	return row, nil
 }
 
 func (db *ddb) SelectWorkflowExecution(ctx context.Context, shardID int, domainID, workflowID, runID string) (*nosqlplugin.WorkflowExecution, error) {
-	panic("TODO")
+	it, err := db.getItem(ctx, tableExecutions, executionKey(shardID, domainID, workflowID, runID))
+	if err != nil {
+		return nil, err
+	}
+	entries, err := db.selectExecutionEntries(ctx, shardID, executionEntriesPrefix(domainID, runID))
+	if err != nil {
+		return nil, err
+	}
+	state, err := parseWorkflowExecution(it, entries)
+	if err != nil {
+		return nil, err
+	}
+	db.deleteOrphanEntries(ctx, entries.orphans(time.Now()))
+	return state, nil
 }
 
-func (db *ddb) DeleteCurrentWorkflow(ctx context.Context, shardID int, domainID, workflowID, currentRunIDCondition string) error {
-	panic("TODO")
+// SelectWorkflowTimerTasks reads the keys of the timer tasks recorded for the workflow.
+func (db *ddb) SelectWorkflowTimerTasks(ctx context.Context, shardID int, domainID, workflowID, runID string) ([]persistence.HistoryTaskKey, error) {
+	items, _, err := db.queryPage(ctx, rowTypeQuery(db.tableName(tableExecutions), shardID, workflowTimerTasksPrefix(domainID, runID)), 0, nil)
+	if err != nil {
+		return nil, err
+	}
+	return parseWorkflowTimerTasks(items), nil
 }
 
-func (db *ddb) DeleteWorkflowExecution(ctx context.Context, shardID int, domainID, workflowID, runID string) error {
-	panic("TODO")
+func (db *ddb) DeleteCurrentWorkflow(ctx context.Context, shardID int, domainID, workflowID, currentRunIDCondition string) error {
+	_, err := db.client.DeleteItemWithContext(ctx, &dynamodb.DeleteItemInput{
+		TableName:                 db.tableName(tableExecutions),
+		Key:                       currentWorkflowKey(shardID, domainID, workflowID),
+		ConditionExpression:       aws.String("#current_run_id = :current_run_id"),
+		ExpressionAttributeNames:  map[string]*string{"#current_run_id": aws.String(attrCurrentRunID)},
+		ExpressionAttributeValues: map[string]*dynamodb.AttributeValue{":current_run_id": attrS(currentRunIDCondition)},
+	})
+	if isConditionalCheckFailed(err) {
+		// the current workflow has moved on to another run, there is nothing to delete
+		return nil
+	}
+	return err
 }
 
-func (db *ddb) SelectWorkflowTimerTasks(ctx context.Context, shardID int, domainID, workflowID, runID string) ([]persistence.HistoryTaskKey, error) {
-	panic("TODO")
+// DeleteWorkflowExecution deletes the execution row, then the entry items and the workflow timer task keys of the execution.
+// A failed deletion leaves no partial mutable state behind, and retrying it removes the rest.
+func (db *ddb) DeleteWorkflowExecution(ctx context.Context, shardID int, domainID, workflowID, runID string) error {
+	if err := db.deleteItem(ctx, tableExecutions, executionKey(shardID, domainID, workflowID, runID)); err != nil {
+		return err
+	}
+	for _, prefix := range []string{executionEntriesPrefix(domainID, runID), workflowTimerTasksPrefix(domainID, runID)} {
+		_, err := db.deleteByQuery(ctx, tableExecutions, rowTypeQuery(db.tableName(tableExecutions), shardID, prefix), historyTaskKeyAttrs, 0)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 func (db *ddb) SelectAllCurrentWorkflows(ctx context.Context, shardID int, pageToken []byte, pageSize int) ([]*persistence.CurrentWorkflowExecution, []byte, error) {
-	panic("TODO")
+	items, nextPageToken, err := db.queryPage(ctx, rowTypeQuery(db.tableName(tableExecutions), shardID, rowTypeCurrentWorkflow), pageSize, pageToken)
+	if err != nil {
+		return nil, nil, err
+	}
+	executions := make([]*persistence.CurrentWorkflowExecution, 0, len(items))
+	for _, it := range items {
+		executions = append(executions, &persistence.CurrentWorkflowExecution{
+			DomainID:     getS(it, attrDomainID),
+			WorkflowID:   getS(it, attrWorkflowID),
+			RunID:        permanentRunID,
+			State:        int(getN(it, attrWorkflowState)),
+			CurrentRunID: getS(it, attrCurrentRunID),
+		})
+	}
+	return executions, nextPageToken, nil
 }
 
 func (db *ddb) SelectAllWorkflowExecutions(ctx context.Context, shardID int, pageToken []byte, pageSize int) ([]*persistence.InternalListConcreteExecutionsEntity, []byte, error) {
-	panic("TODO")
+	items, nextPageToken, err := db.queryPage(ctx, rowTypeQuery(db.tableName(tableExecutions), shardID, rowTypeExecution), pageSize, pageToken)
+	if err != nil {
+		return nil, nil, err
+	}
+	executions := make([]*persistence.InternalListConcreteExecutionsEntity, 0, len(items))
+	for _, it := range items {
+		// large execution info lives in an entry item
+		entries := newEntryItems(nil)
+		if hasAttr(it, attrExecutionRef) {
+			prefix := compositeKey(executionEntriesPrefix(getS(it, attrDomainID), getS(it, attrRunID)), entryKindExecution)
+			if entries, err = db.selectExecutionEntries(ctx, shardID, prefix); err != nil {
+				return nil, nil, err
+			}
+		}
+		executionInfo, err := parseWorkflowExecutionInfo(it, entries)
+		if err != nil {
+			return nil, nil, err
+		}
+		executions = append(executions, &persistence.InternalListConcreteExecutionsEntity{
+			ExecutionInfo:    executionInfo,
+			VersionHistories: getDataBlob(it, attrVersionHistories, attrVersionHistoriesEncoding),
+		})
+	}
+	return executions, nextPageToken, nil
 }
 
 func (db *ddb) IsWorkflowExecutionExists(ctx context.Context, shardID int, domainID, workflowID, runID string) (bool, error) {
-	panic("TODO")
+	resp, err := db.client.GetItemWithContext(ctx, &dynamodb.GetItemInput{
+		TableName:                db.tableName(tableExecutions),
+		Key:                      executionKey(shardID, domainID, workflowID, runID),
+		ConsistentRead:           aws.Bool(true),
+		ProjectionExpression:     aws.String("#sk"),
+		ExpressionAttributeNames: map[string]*string{"#sk": aws.String(attrSortKey)},
+	})
+	if err != nil {
+		return false, err
+	}
+	return len(resp.Item) > 0, nil
 }
 
 func (db *ddb) SelectTransferTasksOrderByTaskID(ctx context.Context, shardID, pageSize int, pageToken []byte, inclusiveMinTaskID, exclusiveMaxTaskID int64) ([]*nosqlplugin.HistoryMigrationTask, []byte, error) {
-	panic("TODO")
+	if inclusiveMinTaskID >= exclusiveMaxTaskID {
+		return nil, nil, nil
+	}
+	input := sortKeyRangeQuery(db.tableName(tableExecutions), shardID,
+		compositeKey(rowTypeTransferTask, encodeSortableInt64(inclusiveMinTaskID)),
+		compositeKey(rowTypeTransferTask, encodeSortableInt64(exclusiveMaxTaskID-1)),
+	)
+	items, nextPageToken, err := db.queryPage(ctx, input, pageSize, pageToken)
+	if err != nil {
+		return nil, nil, err
+	}
+	tasks := make([]*nosqlplugin.HistoryMigrationTask, 0, len(items))
+	for _, it := range items {
+		task, err := newTransferTask(it)
+		if err != nil {
+			return nil, nil, err
+		}
+		tasks = append(tasks, task)
+	}
+	return tasks, nextPageToken, nil
 }
 
 func (db *ddb) DeleteTransferTask(ctx context.Context, shardID int, keys []persistence.HistoryTaskKey) error {
-	panic("TODO")
+	itemKeys := make([]item, 0, len(keys))
+	for _, key := range keys {
+		itemKeys = append(itemKeys, transferTaskKey(shardID, key.GetTaskID()))
+	}
+	return db.batchDelete(ctx, tableExecutions, itemKeys)
 }
 
 func (db *ddb) RangeDeleteTransferTasks(ctx context.Context, shardID int, inclusiveBeginTaskID, exclusiveEndTaskID int64) error {
-	panic("TODO")
+	if inclusiveBeginTaskID >= exclusiveEndTaskID {
+		return nil
+	}
+	input := sortKeyRangeQuery(db.tableName(tableExecutions), shardID,
+		compositeKey(rowTypeTransferTask, encodeSortableInt64(inclusiveBeginTaskID)),
+		compositeKey(rowTypeTransferTask, encodeSortableInt64(exclusiveEndTaskID-1)),
+	)
+	_, err := db.deleteByQuery(ctx, tableExecutions, input, historyTaskKeyAttrs, 0)
+	return err
 }
 
 func (db *ddb) SelectTimerTasksOrderByVisibilityTime(ctx context.Context, shardID, pageSize int, pageToken []byte, inclusiveMinTime, exclusiveMaxTime time.Time) ([]*nosqlplugin.HistoryMigrationTask, []byte, error) {
-	panic("TODO")
+	input, ok := db.timerTasksRangeQuery(shardID, inclusiveMinTime, exclusiveMaxTime)
+	if !ok {
+		return nil, nil, nil
+	}
+	items, nextPageToken, err := db.queryPage(ctx, input, pageSize, pageToken)
+	if err != nil {
+		return nil, nil, err
+	}
+	timers := make([]*nosqlplugin.HistoryMigrationTask, 0, len(items))
+	for _, it := range items {
+		timer, err := newTimerTask(it)
+		if err != nil {
+			return nil, nil, err
+		}
+		timers = append(timers, timer)
+	}
+	return timers, nextPageToken, nil
 }
 
 func (db *ddb) DeleteTimerTask(ctx context.Context, shardID int, keys []persistence.HistoryTaskKey) error {
-	panic("TODO")
+	itemKeys := make([]item, 0, len(keys))
+	for _, key := range keys {
+		itemKeys = append(itemKeys, timerTaskKey(shardID, key.GetScheduledTime(), key.GetTaskID()))
+	}
+	return db.batchDelete(ctx, tableExecutions, itemKeys)
 }
 
 func (db *ddb) RangeDeleteTimerTasks(ctx context.Context, shardID int, inclusiveMinTime, exclusiveMaxTime time.Time) error {
-	panic("TODO")
+	input, ok := db.timerTasksRangeQuery(shardID, inclusiveMinTime, exclusiveMaxTime)
+	if !ok {
+		return nil
+	}
+	_, err := db.deleteByQuery(ctx, tableExecutions, input, historyTaskKeyAttrs, 0)
+	return err
 }
 
 func (db *ddb) SelectReplicationTasksOrderByTaskID(ctx context.Context, shardID, pageSize int, pageToken []byte, inclusiveMinTaskID, exclusiveMaxTaskID int64) ([]*nosqlplugin.HistoryMigrationTask, []byte, error) {
-	panic("TODO")
+	if inclusiveMinTaskID >= exclusiveMaxTaskID {
+		return nil, nil, nil
+	}
+	input := sortKeyRangeQuery(db.tableName(tableExecutions), shardID,
+		compositeKey(rowTypeReplicationTask, encodeSortableInt64(inclusiveMinTaskID)),
+		compositeKey(rowTypeReplicationTask, encodeSortableInt64(exclusiveMaxTaskID-1)),
+	)
+	return db.selectReplicationTasks(ctx, input, pageSize, pageToken)
 }
 
 func (db *ddb) DeleteReplicationTask(ctx context.Context, shardID int, keys []persistence.HistoryTaskKey) error {
-	panic("TODO")
+	itemKeys := make([]item, 0, len(keys))
+	for _, key := range keys {
+		itemKeys = append(itemKeys, replicationTaskKey(shardID, key.GetTaskID()))
+	}
+	return db.batchDelete(ctx, tableExecutions, itemKeys)
 }
 
 func (db *ddb) RangeDeleteReplicationTasks(ctx context.Context, shardID int, exclusiveEndTaskID int64) error {
-	panic("TODO")
+	if exclusiveEndTaskID == math.MinInt64 {
+		return nil
+	}
+	input := sortKeyRangeQuery(db.tableName(tableExecutions), shardID,
+		compositeKey(rowTypeReplicationTask, encodeSortableInt64(math.MinInt64)),
+		compositeKey(rowTypeReplicationTask, encodeSortableInt64(exclusiveEndTaskID-1)),
+	)
+	_, err := db.deleteByQuery(ctx, tableExecutions, input, historyTaskKeyAttrs, 0)
+	return err
 }
 
 func (db *ddb) InsertReplicationTask(ctx context.Context, tasks []*nosqlplugin.HistoryMigrationTask, condition nosqlplugin.ShardCondition) error {
-	panic("TODO")
+	if len(tasks) == 0 {
+		return nil
+	}
+
+	timeStamp := tasks[0].Replication.CurrentTimeStamp
+	puts := make([]*dynamodb.TransactWriteItem, 0, len(tasks))
+	for _, task := range tasks {
+		put, err := db.newHistoryTaskPut(condition.ShardID, persistence.HistoryTaskCategoryReplication, task, timeStamp)
+		if err != nil {
+			return err
+		}
+		puts = append(puts, put)
+	}
+	return db.insertHistoryTasksWithShardCondition(ctx, puts, condition)
 }
 
 func (db *ddb) InsertHistoryTasks(ctx context.Context, tasksByCategory map[persistence.HistoryTaskCategory][]*nosqlplugin.HistoryMigrationTask, currentTimeStamp time.Time, condition nosqlplugin.ShardCondition) error {
-	panic("TODO")
+	var puts []*dynamodb.TransactWriteItem
+	for category, tasks := range tasksByCategory {
+		for _, task := range tasks {
+			put, err := db.newHistoryTaskPut(condition.ShardID, category, task, currentTimeStamp)
+			if err != nil {
+				return err
+			}
+			if put != nil {
+				puts = append(puts, put)
+			}
+		}
+	}
+	if len(puts) == 0 {
+		return nil
+	}
+	return db.insertHistoryTasksWithShardCondition(ctx, puts, condition)
+}
+
+// insertHistoryTasksWithShardCondition writes the tasks together with the shard range check.
+func (db *ddb) insertHistoryTasksWithShardCondition(ctx context.Context, puts []*dynamodb.TransactWriteItem, condition nosqlplugin.ShardCondition) error {
+	failures, err := db.writeHistoryTasksWithShardCondition(ctx, puts, condition)
+	if len(failures) > 0 {
+		return convertShardOperationFailure(failures, condition)
+	}
+	return err
+}
+
+// writeHistoryTasksWithShardCondition writes the tasks and returns the failed conditions if the range check fails.
+// DynamoDB limits the size of a transaction, so large batches are split into multiple transactions,
+// each of them is still guarded by the range check and tasks are idempotent to be written again.
+func (db *ddb) writeHistoryTasksWithShardCondition(ctx context.Context, puts []*dynamodb.TransactWriteItem, condition nosqlplugin.ShardCondition) ([]conditionFailure, error) {
+	// one slot of each transaction is reserved for checking the range_id of the shard
+	chunkSize := maxTransactionItems - 1
+	for start := 0; start < len(puts); start += chunkSize {
+		end := start + chunkSize
+		if end > len(puts) {
+			end = len(puts)
+		}
+		txn := &workflowTxn{}
+		for _, put := range puts[start:end] {
+			txn.add(workflowTxnItem{itemType: workflowTxnItemOther}, put)
+		}
+		db.assertShardRangeID(txn, condition.ShardID, condition.RangeID)
+
+		err := db.transactWrite(ctx, txn.items)
+		if failures := txn.conditionFailures(err); len(failures) > 0 {
+			return failures, err
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return nil, nil
 }
 
 func (db *ddb) DeleteCrossClusterTask(ctx context.Context, shardID int, targetCluster string, taskID int64) error {
-	panic("TODO")
+	return db.deleteItem(ctx, tableExecutions, crossClusterTaskKey(shardID, targetCluster, taskID))
 }
 
 func (db *ddb) InsertReplicationDLQTask(ctx context.Context, shardID int, sourceCluster string, task *nosqlplugin.HistoryMigrationTask) error {
-	panic("TODO")
+	info, err := attrJSON(task.Replication)
+	if err != nil {
+		return err
+	}
+	it := replicationDLQTaskKey(shardID, sourceCluster, task.Replication.TaskID)
+	it[attrReplication] = info
+	it[attrTaskID] = attrN(task.Replication.TaskID)
+	it[attrCreatedTime] = attrTime(task.Replication.CurrentTimeStamp)
+	setDataBlob(it, attrData, attrDataEncoding, task.Task)
+	_, err = db.client.PutItemWithContext(ctx, &dynamodb.PutItemInput{
+		TableName: db.tableName(tableExecutions),
+		Item:      it,
+	})
+	return err
 }
 
 func (db *ddb) SelectReplicationDLQTasksOrderByTaskID(ctx context.Context, shardID int, sourceCluster string, pageSize int, pageToken []byte, inclusiveMinTaskID, exclusiveMaxTaskID int64) ([]*nosqlplugin.HistoryMigrationTask, []byte, error) {
-	panic("TODO")
+	if inclusiveMinTaskID >= exclusiveMaxTaskID {
+		return nil, nil, nil
+	}
+	input := sortKeyRangeQuery(db.tableName(tableExecutions), shardID,
+		compositeKey(rowTypeReplicationDLQTask, sourceCluster, encodeSortableInt64(inclusiveMinTaskID)),
+		compositeKey(rowTypeReplicationDLQTask, sourceCluster, encodeSortableInt64(exclusiveMaxTaskID-1)),
+	)
+	return db.selectReplicationTasks(ctx, input, pageSize, pageToken)
 }
 
 func (db *ddb) SelectReplicationDLQTasksCount(ctx context.Context, shardID int, sourceCluster string) (int64, error) {
-	panic("TODO")
+	input := rowTypeQuery(db.tableName(tableExecutions), shardID, compositeKey(rowTypeReplicationDLQTask, sourceCluster))
+	count, err := db.queryCount(ctx, input)
+	if err != nil {
+		return -1, err
+	}
+	return count, nil
 }
 
 func (db *ddb) DeleteReplicationDLQTask(ctx context.Context, shardID int, sourceCluster string, taskID int64) error {
-	panic("TODO")
+	return db.deleteItem(ctx, tableExecutions, replicationDLQTaskKey(shardID, sourceCluster, taskID))
 }
 
 func (db *ddb) RangeDeleteReplicationDLQTasks(ctx context.Context, shardID int, sourceCluster string, inclusiveBeginTaskID, exclusiveEndTaskID int64) error {
-	panic("TODO")
+	if inclusiveBeginTaskID >= exclusiveEndTaskID {
+		return nil
+	}
+	input := sortKeyRangeQuery(db.tableName(tableExecutions), shardID,
+		compositeKey(rowTypeReplicationDLQTask, sourceCluster, encodeSortableInt64(inclusiveBeginTaskID)),
+		compositeKey(rowTypeReplicationDLQTask, sourceCluster, encodeSortableInt64(exclusiveEndTaskID-1)),
+	)
+	_, err := db.deleteByQuery(ctx, tableExecutions, input, historyTaskKeyAttrs, 0)
+	return err
 }
 
 func (db *ddb) SelectActiveClusterSelectionPolicy(ctx context.Context, shardID int, domainID, wfID, rID string) (*nosqlplugin.ActiveClusterSelectionPolicyRow, error) {
-	panic("TODO")
+	it, err := db.getItem(ctx, tableExecutions, activeClusterSelectionPolicyKey(shardID, domainID, wfID, rID))
+	if err != nil {
+		if db.IsNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &nosqlplugin.ActiveClusterSelectionPolicyRow{
+		ShardID:    shardID,
+		DomainID:   domainID,
+		WorkflowID: wfID,
+		RunID:      rID,
+		Policy:     getDataBlob(it, attrData, attrDataEncoding),
+	}, nil
 }
 
 func (db *ddb) DeleteActiveClusterSelectionPolicy(ctx context.Context, shardID int, domainID, wfID, rID string) error {
-	panic("TODO")
+	return db.deleteItem(ctx, tableExecutions, activeClusterSelectionPolicyKey(shardID, domainID, wfID, rID))
+}
+
+func (db *ddb) selectReplicationTasks(ctx context.Context, input *dynamodb.QueryInput, pageSize int, pageToken []byte) ([]*nosqlplugin.HistoryMigrationTask, []byte, error) {
+	items, nextPageToken, err := db.queryPage(ctx, input, pageSize, pageToken)
+	if err != nil {
+		return nil, nil, err
+	}
+	tasks := make([]*nosqlplugin.HistoryMigrationTask, 0, len(items))
+	for _, it := range items {
+		task, err := newReplicationTask(it)
+		if err != nil {
+			return nil, nil, err
+		}
+		tasks = append(tasks, task)
+	}
+	return tasks, nextPageToken, nil
+}
+
+// timerTasksRangeQuery returns the query of timer tasks in [inclusiveMinTime, exclusiveMaxTime).
+// The upper bound only has the timestamp component, so it's smaller than any timer at exclusiveMaxTime.
+func (db *ddb) timerTasksRangeQuery(shardID int, inclusiveMinTime, exclusiveMaxTime time.Time) (*dynamodb.QueryInput, bool) {
+	minTime := timerTimestamp(inclusiveMinTime)
+	maxTime := timerTimestamp(exclusiveMaxTime)
+	if !minTime.Before(maxTime) {
+		return nil, false
+	}
+	return sortKeyRangeQuery(db.tableName(tableExecutions), shardID,
+		compositeKey(rowTypeTimerTask, encodeSortableTime(minTime)),
+		compositeKey(rowTypeTimerTask, encodeSortableTime(maxTime)),
+	), true
+}
+
+// sortKeyRangeQuery returns the query of the rows of a shard whose sort key is in [lower, upper]
+func sortKeyRangeQuery(tableName *string, shardID int, lower, upper string) *dynamodb.QueryInput {
+	return &dynamodb.QueryInput{
+		TableName:              tableName,
+		KeyConditionExpression: aws.String("#shard_id = :shard_id AND #sk BETWEEN :lower AND :upper"),
+		ExpressionAttributeNames: map[string]*string{
+			"#shard_id": aws.String(attrShardID),
+			"#sk":       aws.String(attrSortKey),
+		},
+		ExpressionAttributeValues: map[string]*dynamodb.AttributeValue{
+			":shard_id": attrN(int64(shardID)),
+			":lower":    attrS(lower),
+			":upper":    attrS(upper),
+		},
+	}
+}
+
+// rowTypeQuery returns the query of the rows of a shard whose sort key starts with the prefix
+func rowTypeQuery(tableName *string, shardID int, prefix string) *dynamodb.QueryInput {
+	return &dynamodb.QueryInput{
+		TableName:              tableName,
+		KeyConditionExpression: aws.String("#shard_id = :shard_id AND begins_with(#sk, :prefix)"),
+		ExpressionAttributeNames: map[string]*string{
+			"#shard_id": aws.String(attrShardID),
+			"#sk":       aws.String(attrSortKey),
+		},
+		ExpressionAttributeValues: map[string]*dynamodb.AttributeValue{
+			":shard_id": attrN(int64(shardID)),
+			":prefix":   attrS(prefix + keySeparator),
+		},
+	}
 }
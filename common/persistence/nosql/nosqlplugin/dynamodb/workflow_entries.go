@@ -0,0 +1,667 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamodb
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/google/uuid"
+
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+)
+
+// Mutable state easily grows beyond the 400KB item size limit of DynamoDB, so the entries of the workflow maps,
+// the buffered event batches and the large execution info are stored as entry items next to the execution row,
+// which only keeps the references to them. Values larger than maxItemDataSize are split into multiple chunk items.
+//
+// Writes fitting into one transaction keep the entries in the stable slot, and overwrite the entry items in place
+// together with the references. Larger writes put the entries into a slot unique to the write before the transaction,
+// so that a failed write never changes the items referred by the execution row. Entry items not referred by the
+// execution row are orphans, they are removed when the mutable state is read or the execution is deleted.
+// The workflow rows and the history tasks are always written in one transaction guarded by the range check of the
+// shard, a write whose rows and tasks don't fit into one transaction is rejected.
+
+// Attribute names of the entry items and the references to them
+const (
+	attrEntryKind    = "entry_kind"
+	attrEntryKey     = "entry_key"
+	attrEntrySlot    = "entry_slot"
+	attrChunk        = "chunk"
+	attrExecutionRef = "execution_ref"
+
+	attrRefKey      = "k"
+	attrRefSlot     = "s"
+	attrRefChunks   = "c"
+	attrRefEncoding = "e"
+)
+
+const (
+	entryKindExecution      = "execution"
+	entryKindActivity       = "activity"
+	entryKindTimer          = "timer"
+	entryKindChildExecution = "child_execution"
+	entryKindRequestCancel  = "request_cancel"
+	entryKindSignal         = "signal"
+	entryKindBufferedEvents = "buffered_events"
+
+	// stableSlot is the slot of the entries written in the same transaction as the execution row
+	stableSlot = ""
+
+	// DynamoDB limits an expression to 4KB and an attribute name to 255 bytes, larger map updates are merged
+	// into the current maps and written as a whole instead of entry by entry
+	maxMapEntryUpdates   = 100
+	maxMapEntryKeyLength = 255
+)
+
+// entryMapKinds are the kinds of the entries referred by the map attributes of the execution row
+var entryMapKinds = map[string]string{
+	attrActivityMap:        entryKindActivity,
+	attrTimerMap:           entryKindTimer,
+	attrChildExecutionsMap: entryKindChildExecution,
+	attrRequestCancelMap:   entryKindRequestCancel,
+	attrSignalMap:          entryKindSignal,
+}
+
+// executionIndexAttrs are the attributes of the execution row referring to the entry items
+var executionIndexAttrs = []string{
+	attrExecutionRef,
+	attrActivityMap,
+	attrTimerMap,
+	attrChildExecutionsMap,
+	attrRequestCancelMap,
+	attrSignalMap,
+	attrSignalRequested,
+	attrBufferedEvents,
+}
+
+type (
+	// entryRef is a reference to an entry kept by the execution row, key is only set for buffered event batches
+	// since the map entries are referred by their map keys
+	entryRef struct {
+		key      string
+		slot     string
+		chunks   int
+		encoding string
+	}
+
+	entryID struct {
+		kind string
+		key  string
+		slot string
+	}
+
+	// entryItems are the entry items of an execution, indexed to assemble the values referred by the execution row
+	entryItems struct {
+		chunks     map[entryID]map[int64]item
+		referenced map[string]struct{}
+	}
+
+	// workflowWrite collects the writes of a workflow operation. The workflow rows are written in one transaction,
+	// the entry items and the history tasks join the transaction when they fit.
+	workflowWrite struct {
+		txn       workflowTxn
+		tableName *string
+		shardID   int
+		timeStamp time.Time
+		// writeID is unique to the write, it names the buffered event batch appended by the write
+		writeID string
+		slot    string
+		entries []*dynamodb.TransactWriteItem
+		tasks   []*dynamodb.TransactWriteItem
+		// staleKeys are the keys of the entry items superseded by the write, they are deleted once the write succeeds
+		staleKeys []item
+	}
+)
+
+// executionEntriesPrefix is the sort key prefix of the entry items of an execution. Run ID is unique, so the workflow ID
+// which may contain the key separator is left out, and the prefix never matches the items of another execution.
+func executionEntriesPrefix(domainID, runID string) string {
+	return compositeKey(rowTypeExecutionEntry, domainID, runID)
+}
+
+func executionEntryKey(shardID int, domainID, runID string, id entryID, chunk int64) item {
+	return item{
+		attrShardID: attrN(int64(shardID)),
+		attrSortKey: attrS(compositeKey(executionEntriesPrefix(domainID, runID), id.kind, id.key, id.slot, encodeSortableInt64(chunk))),
+	}
+}
+
+func workflowTimerTasksPrefix(domainID, runID string) string {
+	return compositeKey(rowTypeWorkflowTimerTask, domainID, runID)
+}
+
+func workflowTimerTaskKey(shardID int, domainID, runID string, key persistence.HistoryTaskKey) item {
+	return item{
+		attrShardID: attrN(int64(shardID)),
+		attrSortKey: attrS(compositeKey(workflowTimerTasksPrefix(domainID, runID), encodeSortableTime(key.GetScheduledTime()), encodeSortableInt64(key.GetTaskID()))),
+	}
+}
+
+func (r entryRef) attr() *dynamodb.AttributeValue {
+	m := item{attrRefChunks: attrN(int64(r.chunks))}
+	if r.key != "" {
+		m[attrRefKey] = attrS(r.key)
+	}
+	if r.slot != stableSlot {
+		m[attrRefSlot] = attrS(r.slot)
+	}
+	if r.encoding != "" {
+		m[attrRefEncoding] = attrS(r.encoding)
+	}
+	return &dynamodb.AttributeValue{M: m}
+}
+
+func parseEntryRef(v *dynamodb.AttributeValue) entryRef {
+	if v == nil {
+		return entryRef{}
+	}
+	return entryRef{
+		key:      getS(v.M, attrRefKey),
+		slot:     getS(v.M, attrRefSlot),
+		chunks:   int(getN(v.M, attrRefChunks)),
+		encoding: getS(v.M, attrRefEncoding),
+	}
+}
+
+func newEntryItems(items []item) *entryItems {
+	e := &entryItems{
+		chunks:     make(map[entryID]map[int64]item),
+		referenced: make(map[string]struct{}),
+	}
+	for _, it := range items {
+		id := entryID{
+			kind: getS(it, attrEntryKind),
+			key:  getS(it, attrEntryKey),
+			slot: getS(it, attrEntrySlot),
+		}
+		if e.chunks[id] == nil {
+			e.chunks[id] = make(map[int64]item)
+		}
+		e.chunks[id][getN(it, attrChunk)] = it
+	}
+	return e
+}
+
+// value assembles the value of the entry referred by the reference
+func (e *entryItems) value(kind, key string, ref entryRef) ([]byte, error) {
+	chunks := e.chunks[entryID{kind: kind, key: key, slot: ref.slot}]
+	var data []byte
+	for i := 0; i < ref.chunks; i++ {
+		it, ok := chunks[int64(i)]
+		if !ok {
+			return nil, fmt.Errorf("corrupted mutable state: chunk %v of %v entry %v is missing", i, kind, key)
+		}
+		data = append(data, getB(it, attrData)...)
+		e.referenced[getS(it, attrSortKey)] = struct{}{}
+	}
+	return data, nil
+}
+
+func (e *entryItems) unmarshal(kind, key string, ref entryRef, v interface{}) error {
+	data, err := e.value(kind, key, ref)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
+
+// orphans returns the items not referred by the execution row, which are old enough to not belong to an ongoing write
+func (e *entryItems) orphans(now time.Time) []item {
+	var orphans []item
+	for _, chunks := range e.chunks {
+		for _, it := range chunks {
+			if _, ok := e.referenced[getS(it, attrSortKey)]; ok {
+				continue
+			}
+			if getTime(it, attrCreatedTime).Add(orphanEntryGracePeriod).After(now) {
+				continue
+			}
+			orphans = append(orphans, it)
+		}
+	}
+	return orphans
+}
+
+func (db *ddb) selectExecutionEntries(ctx context.Context, shardID int, prefix string) (*entryItems, error) {
+	items, _, err := db.queryPage(ctx, rowTypeQuery(db.tableName(tableExecutions), shardID, prefix), 0, nil)
+	if err != nil {
+		return nil, err
+	}
+	return newEntryItems(items), nil
+}
+
+// selectExecutionIndex reads the attributes of the execution row referring to the entry items.
+// A missing execution row results in an empty index, the write relying on it fails on its conditions anyway.
+func (db *ddb) selectExecutionIndex(ctx context.Context, shardID int, execution *nosqlplugin.WorkflowExecutionRequest) (item, error) {
+	b := newUpdateBuilder()
+	projection := make([]string, 0, len(executionIndexAttrs))
+	for _, attr := range executionIndexAttrs {
+		projection = append(projection, b.name(attr))
+	}
+	resp, err := db.client.GetItemWithContext(ctx, &dynamodb.GetItemInput{
+		TableName:                db.tableName(tableExecutions),
+		Key:                      executionKey(shardID, execution.DomainID, execution.WorkflowID, execution.RunID),
+		ConsistentRead:           aws.Bool(true),
+		ProjectionExpression:     aws.String(strings.Join(projection, ", ")),
+		ExpressionAttributeNames: b.names,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if resp.Item == nil {
+		return make(item), nil
+	}
+	return resp.Item, nil
+}
+
+// deleteOrphanEntries removes the orphan entry items at best effort, an item rewritten since it was read is kept
+func (db *ddb) deleteOrphanEntries(ctx context.Context, orphans []item) {
+	if len(orphans) > maxOrphanEntryDeletes {
+		orphans = orphans[:maxOrphanEntryDeletes]
+	}
+	for _, it := range orphans {
+		_, err := db.client.DeleteItemWithContext(ctx, &dynamodb.DeleteItemInput{
+			TableName:                 db.tableName(tableExecutions),
+			Key:                       item{attrShardID: it[attrShardID], attrSortKey: it[attrSortKey]},
+			ConditionExpression:       aws.String("#created_time = :created_time"),
+			ExpressionAttributeNames:  map[string]*string{"#created_time": aws.String(attrCreatedTime)},
+			ExpressionAttributeValues: map[string]*dynamodb.AttributeValue{":created_time": it[attrCreatedTime]},
+		})
+		if err != nil && !isConditionalCheckFailed(err) {
+			db.logger.Warn("Unable to delete orphan mutable state entry", tag.Error(err))
+			return
+		}
+	}
+}
+
+// deleteAtBestEffort deletes the items of executions table written or superseded by a workflow operation,
+// leftovers are harmless and collected later
+func (db *ddb) deleteAtBestEffort(ctx context.Context, keys []item) {
+	if len(keys) == 0 {
+		return
+	}
+	if err := db.batchDelete(ctx, tableExecutions, keys); err != nil {
+		db.logger.Warn("Unable to clean up items of workflow operation", tag.Error(err))
+	}
+}
+
+func (db *ddb) newWorkflowWrite(shardID int, timeStamp time.Time, writeID, slot string) *workflowWrite {
+	return &workflowWrite{
+		tableName: db.tableName(tableExecutions),
+		shardID:   shardID,
+		timeStamp: timeStamp,
+		writeID:   writeID,
+		slot:      slot,
+	}
+}
+
+// executeWorkflowWrite builds and executes the writes of a workflow operation, and returns the failed conditions
+// when the operation is rejected by them.
+// The writes are built into one transaction first, when they don't fit they are built again to put the entries
+// into a slot unique to the write. The entries are then written ahead of the transaction of the workflow rows and
+// the history tasks, so that no task is written unless the conditions of the workflow rows hold.
+func (db *ddb) executeWorkflowWrite(
+	ctx context.Context,
+	shardCondition *nosqlplugin.ShardCondition,
+	timeStamp time.Time,
+	build func(w *workflowWrite) error,
+) ([]conditionFailure, error) {
+	writeID := uuid.New().String()
+	w := db.newWorkflowWrite(shardCondition.ShardID, timeStamp, writeID, stableSlot)
+	if err := build(w); err != nil {
+		return nil, err
+	}
+	if txn, ok := w.singleTransaction(); ok {
+		db.assertShardRangeID(txn, shardCondition.ShardID, shardCondition.RangeID)
+		err := db.transactWrite(ctx, txn.items)
+		return txn.conditionFailures(err), err
+	}
+
+	w = db.newWorkflowWrite(shardCondition.ShardID, timeStamp, writeID, writeID)
+	if err := build(w); err != nil {
+		return nil, err
+	}
+	txn, err := w.rowsTransaction()
+	if err != nil {
+		return nil, err
+	}
+	entryItems := make([]item, 0, len(w.entries))
+	for _, op := range w.entries {
+		entryItems = append(entryItems, op.Put.Item)
+	}
+	if err := db.batchPut(ctx, tableExecutions, entryItems); err != nil {
+		return nil, err
+	}
+	db.assertShardRangeID(txn, shardCondition.ShardID, shardCondition.RangeID)
+	err = db.transactWrite(ctx, txn.items)
+	if failures := txn.conditionFailures(err); len(failures) > 0 {
+		db.deleteAtBestEffort(ctx, transactWriteItemKeys(w.entries))
+		return failures, err
+	}
+	if err != nil {
+		return nil, err
+	}
+	db.deleteAtBestEffort(ctx, w.staleDeletes())
+	return nil, nil
+}
+
+// singleTransaction puts all the writes into one transaction, and tells if the transaction is within the limits
+func (w *workflowWrite) singleTransaction() (*workflowTxn, bool) {
+	txn := &workflowTxn{
+		items: append([]*dynamodb.TransactWriteItem{}, w.txn.items...),
+		types: append([]workflowTxnItem{}, w.txn.types...),
+	}
+	for _, op := range w.entries {
+		txn.add(workflowTxnItem{itemType: workflowTxnItemOther}, op)
+	}
+	for _, op := range w.tasks {
+		txn.add(workflowTxnItem{itemType: workflowTxnItemOther}, op)
+	}
+	for _, key := range w.staleDeletes() {
+		txn.add(workflowTxnItem{itemType: workflowTxnItemOther}, &dynamodb.TransactWriteItem{
+			Delete: &dynamodb.Delete{TableName: w.tableName, Key: key},
+		})
+	}
+	return txn, withinTransactionLimits(txn) == nil
+}
+
+// rowsTransaction puts the writes of the workflow rows and the history tasks into one transaction, and fails if the
+// transaction is beyond the limits
+func (w *workflowWrite) rowsTransaction() (*workflowTxn, error) {
+	txn := &workflowTxn{
+		items: append([]*dynamodb.TransactWriteItem{}, w.txn.items...),
+		types: append([]workflowTxnItem{}, w.txn.types...),
+	}
+	for _, op := range w.tasks {
+		txn.add(workflowTxnItem{itemType: workflowTxnItemOther}, op)
+	}
+	if err := withinTransactionLimits(txn); err != nil {
+		return nil, err
+	}
+	return txn, nil
+}
+
+// withinTransactionLimits returns an error if the transaction can't be written together with the range check of the shard
+func withinTransactionLimits(txn *workflowTxn) error {
+	// one slot is reserved for checking the range_id of the shard
+	if len(txn.items) >= maxTransactionItems {
+		return fmt.Errorf("too many items(%v) in the workflow operation, DynamoDB only supports up to %v in one transaction", len(txn.items)+1, maxTransactionItems)
+	}
+	size := 0
+	for _, op := range txn.items {
+		size += transactWriteItemSize(op)
+	}
+	if size > maxTransactionDataSize {
+		return fmt.Errorf("workflow operation is too large(%v bytes), DynamoDB only supports up to %v bytes in one transaction", size, maxTransactionDataSize)
+	}
+	return nil
+}
+
+// staleDeletes returns the keys of the superseded entry items which are not rewritten by the write
+func (w *workflowWrite) staleDeletes() []item {
+	seen := make(map[string]struct{}, len(w.entries)+len(w.staleKeys))
+	for _, op := range w.entries {
+		seen[getS(op.Put.Item, attrSortKey)] = struct{}{}
+	}
+	var keys []item
+	for _, key := range w.staleKeys {
+		sk := getS(key, attrSortKey)
+		if _, ok := seen[sk]; ok {
+			continue
+		}
+		seen[sk] = struct{}{}
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// putEntry stores the value of an entry in the slot of the write, and returns the reference to it
+func (w *workflowWrite) putEntry(execution *nosqlplugin.WorkflowExecutionRequest, kind, key string, data []byte) entryRef {
+	id := entryID{kind: kind, key: key, slot: w.slot}
+	chunks := splitChunks(data)
+	for i, chunk := range chunks {
+		it := executionEntryKey(w.shardID, execution.DomainID, execution.RunID, id, int64(i))
+		it[attrEntryKind] = attrS(kind)
+		if key != "" {
+			it[attrEntryKey] = attrS(key)
+		}
+		if w.slot != stableSlot {
+			it[attrEntrySlot] = attrS(w.slot)
+		}
+		it[attrChunk] = attrN(int64(i))
+		it[attrCreatedTime] = attrTime(w.timeStamp)
+		if len(chunk) > 0 {
+			it[attrData] = attrB(chunk)
+		}
+		w.entries = append(w.entries, &dynamodb.TransactWriteItem{
+			Put: &dynamodb.Put{TableName: w.tableName, Item: it},
+		})
+	}
+	return entryRef{slot: w.slot, chunks: len(chunks)}
+}
+
+func (w *workflowWrite) putBufferedEvents(execution *nosqlplugin.WorkflowExecutionRequest, blob *persistence.DataBlob) entryRef {
+	data, encoding := persistence.FromDataBlob(blob)
+	ref := w.putEntry(execution, entryKindBufferedEvents, w.writeID, data)
+	ref.key = w.writeID
+	ref.encoding = encoding
+	return ref
+}
+
+// supersedeEntry marks the entry items referred by the reference as stale
+func (w *workflowWrite) supersedeEntry(execution *nosqlplugin.WorkflowExecutionRequest, kind, key string, ref entryRef) {
+	id := entryID{kind: kind, key: key, slot: ref.slot}
+	for i := 0; i < ref.chunks; i++ {
+		w.staleKeys = append(w.staleKeys, executionEntryKey(w.shardID, execution.DomainID, execution.RunID, id, int64(i)))
+	}
+}
+
+// supersedeEntries marks all the entry items referred by the index as stale
+func (w *workflowWrite) supersedeEntries(execution *nosqlplugin.WorkflowExecutionRequest, index item) {
+	for _, attr := range sortedAttrNames(index) {
+		v := index[attr]
+		if v == nil {
+			continue
+		}
+		switch attr {
+		case attrExecutionRef:
+			w.supersedeEntry(execution, entryKindExecution, "", parseEntryRef(v))
+		case attrBufferedEvents:
+			w.supersedeBufferedEvents(execution, index)
+		default:
+			kind, ok := entryMapKinds[attr]
+			if !ok {
+				continue
+			}
+			for key, ref := range v.M {
+				w.supersedeEntry(execution, kind, key, parseEntryRef(ref))
+			}
+		}
+	}
+}
+
+func (w *workflowWrite) supersedeBufferedEvents(execution *nosqlplugin.WorkflowExecutionRequest, index item) {
+	v, ok := index[attrBufferedEvents]
+	if !ok || v == nil {
+		return
+	}
+	for _, e := range v.L {
+		ref := parseEntryRef(e)
+		w.supersedeEntry(execution, entryKindBufferedEvents, ref.key, ref)
+	}
+}
+
+// updateEntryMap upserts and deletes the entries of a map attribute of the execution row, see updateIndexMap
+func (w *workflowWrite) updateEntryMap(
+	b *updateBuilder,
+	index item,
+	execution *nosqlplugin.WorkflowExecutionRequest,
+	attr string,
+	keys []string,
+	values [][]byte,
+	keysToDelete []string,
+) {
+	kind := entryMapKinds[attr]
+	var current item
+	if v, ok := index[attr]; ok && v != nil {
+		current = v.M
+	}
+	deleted := make(map[string]struct{}, len(keysToDelete))
+	for _, k := range keysToDelete {
+		if _, ok := deleted[k]; ok {
+			continue
+		}
+		deleted[k] = struct{}{}
+		if ref, ok := current[k]; ok {
+			w.supersedeEntry(execution, kind, k, parseEntryRef(ref))
+		} else if index == nil {
+			// without the index only the first chunk in the stable slot is known, the rest are collected as orphans
+			w.staleKeys = append(w.staleKeys, executionEntryKey(w.shardID, execution.DomainID, execution.RunID, entryID{kind: kind, key: k}, 0))
+		}
+	}
+	upserted := make(map[string]struct{}, len(keys))
+	refKeys := make([]string, 0, len(keys))
+	refs := make([]*dynamodb.AttributeValue, 0, len(keys))
+	for i, k := range keys {
+		if _, ok := deleted[k]; ok {
+			continue
+		}
+		if _, ok := upserted[k]; ok {
+			continue
+		}
+		upserted[k] = struct{}{}
+		if ref, ok := current[k]; ok {
+			w.supersedeEntry(execution, kind, k, parseEntryRef(ref))
+		}
+		refKeys = append(refKeys, k)
+		refs = append(refs, w.putEntry(execution, kind, k, values[i]).attr())
+	}
+	updateIndexMap(b, index, attr, refKeys, refs, keysToDelete)
+}
+
+// entryMapAttr stores the entries of a map and returns the map attribute referring to them
+func (w *workflowWrite) entryMapAttr(execution *nosqlplugin.WorkflowExecutionRequest, attr string, keys []string, values [][]byte) *dynamodb.AttributeValue {
+	m := make(item, len(keys))
+	for i, k := range keys {
+		m[k] = w.putEntry(execution, entryMapKinds[attr], k, values[i]).attr()
+	}
+	return &dynamodb.AttributeValue{M: m}
+}
+
+// putWorkflowTimerTasks records the keys of the timer tasks created for the workflow, as separate items since they are
+// only removed with the execution
+func (w *workflowWrite) putWorkflowTimerTasks(execution *nosqlplugin.WorkflowExecutionRequest, keys []persistence.HistoryTaskKey) {
+	added := make(map[string]struct{}, len(keys))
+	for _, key := range keys {
+		it := workflowTimerTaskKey(w.shardID, execution.DomainID, execution.RunID, key)
+		sk := getS(it, attrSortKey)
+		if _, ok := added[sk]; ok {
+			continue
+		}
+		added[sk] = struct{}{}
+		it[attrVisibilityTimestamp] = attrTime(key.GetScheduledTime())
+		it[attrTaskID] = attrN(key.GetTaskID())
+		w.entries = append(w.entries, &dynamodb.TransactWriteItem{
+			Put: &dynamodb.Put{TableName: w.tableName, Item: it},
+		})
+	}
+}
+
+// updateIndexMap upserts and deletes the entries of a map attribute. The entries are updated one by one,
+// unless the current index is given, in which case the merged map is written as a whole.
+// An entry both upserted and deleted is only deleted, the same as Cassandra where the deletion wins.
+func updateIndexMap(b *updateBuilder, index item, attr string, keys []string, values []*dynamodb.AttributeValue, keysToDelete []string) {
+	if index == nil {
+		updateMapEntries(b, attr, keys, values, keysToDelete)
+		return
+	}
+	merged := make(item)
+	if v, ok := index[attr]; ok && v != nil {
+		for k, e := range v.M {
+			merged[k] = e
+		}
+	}
+	for i, k := range keys {
+		merged[k] = values[i]
+	}
+	for _, k := range keysToDelete {
+		delete(merged, k)
+	}
+	b.set(attr, &dynamodb.AttributeValue{M: merged})
+}
+
+// requiresExecutionIndex tells if the mutation can't be written without reading the current index first:
+// clearing the buffered events supersedes the batches referred by the index, and large map updates are merged with
+// the index since they don't fit into an update expression
+func requiresExecutionIndex(execution *nosqlplugin.WorkflowExecutionRequest) bool {
+	if execution.EventBufferWriteMode == nosqlplugin.EventBufferWriteModeClear {
+		return true
+	}
+	updates := len(execution.ActivityInfos) + len(execution.ActivityInfoKeysToDelete) +
+		len(execution.TimerInfos) + len(execution.TimerInfoKeysToDelete) +
+		len(execution.ChildWorkflowInfos) + len(execution.ChildWorkflowInfoKeysToDelete) +
+		len(execution.RequestCancelInfos) + len(execution.RequestCancelInfoKeysToDelete) +
+		len(execution.SignalInfos) + len(execution.SignalInfoKeysToDelete) +
+		len(execution.SignalRequestedIDs) + len(execution.SignalRequestedIDsKeysToDelete)
+	if updates > maxMapEntryUpdates {
+		return true
+	}
+	// timer IDs and signal request IDs are given by users, they can be too long as attribute names
+	for id := range execution.TimerInfos {
+		if len(id) > maxMapEntryKeyLength {
+			return true
+		}
+	}
+	for _, ids := range [][]string{execution.TimerInfoKeysToDelete, execution.SignalRequestedIDs, execution.SignalRequestedIDsKeysToDelete} {
+		for _, id := range ids {
+			if len(id) > maxMapEntryKeyLength {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func transactWriteItemKeys(ops []*dynamodb.TransactWriteItem) []item {
+	keys := make([]item, 0, len(ops))
+	for _, op := range ops {
+		if op.Put != nil {
+			keys = append(keys, item{attrShardID: op.Put.Item[attrShardID], attrSortKey: op.Put.Item[attrSortKey]})
+		}
+	}
+	return keys
+}
+
+func parseWorkflowTimerTasks(items []item) []persistence.HistoryTaskKey {
+	keys := make([]persistence.HistoryTaskKey, 0, len(items))
+	for _, it := range items {
+		keys = append(keys, persistence.NewHistoryTaskKey(getTime(it, attrVisibilityTimestamp), getN(it, attrTaskID)))
+	}
+	return keys
+}
@@ -0,0 +1,1105 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamodb
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+)
+
+// Attribute names of the workflow rows in executions table
+const (
+	attrWorkflowID               = "workflow_id"
+	attrCurrentRunID             = "current_run_id"
+	attrCreateRequestID          = "create_request_id"
+	attrWorkflowState            = "workflow_state"
+	attrCloseStatus              = "close_status"
+	attrLastWriteVersion         = "workflow_last_write_version"
+	attrExecution                = "execution"
+	attrNextEventID              = "next_event_id"
+	attrVersionHistories         = "version_histories"
+	attrVersionHistoriesEncoding = "version_histories_encoding"
+	attrChecksum                 = "checksum"
+	attrActivityMap              = "activity_map"
+	attrTimerMap                 = "timer_map"
+	attrChildExecutionsMap       = "child_executions_map"
+	attrRequestCancelMap         = "request_cancel_map"
+	attrSignalMap                = "signal_map"
+	attrSignalRequested          = "signal_requested"
+	attrBufferedEvents           = "buffered_events_list"
+	attrEncoding                 = "encoding"
+	attrTransfer                 = "transfer"
+	attrTimer                    = "timer"
+	attrReplication              = "replication"
+	attrVisibilityTimestamp      = "visibility_ts"
+)
+
+type (
+	// workflowTxnItemType tells what a write of the workflow transaction is for,
+	// so that the cancellation reasons of the transaction can be mapped back to the failed conditions
+	workflowTxnItemType int
+
+	workflowTxnItem struct {
+		itemType    workflowTxnItemType
+		runID       string
+		requestType persistence.WorkflowRequestType
+	}
+
+	// workflowTxn collects the writes of a workflow operation which are executed in one DynamoDB transaction
+	workflowTxn struct {
+		items []*dynamodb.TransactWriteItem
+		types []workflowTxnItem
+	}
+
+	// conditionFailure is a failed condition of a transaction, with the old item returned by DynamoDB
+	conditionFailure struct {
+		workflowTxnItem
+		item item
+	}
+
+	// updateBuilder builds the update and condition expressions of an UpdateItem request.
+	// Map keys of the workflow maps are arbitrary strings, so all names and values are referred by placeholders.
+	updateBuilder struct {
+		sets      []string
+		removes   []string
+		names     map[string]*string
+		nameAlias map[string]string
+		values    map[string]*dynamodb.AttributeValue
+	}
+)
+
+const (
+	workflowTxnItemShard workflowTxnItemType = iota
+	workflowTxnItemCurrentWorkflow
+	workflowTxnItemExecution
+	workflowTxnItemWorkflowRequest
+	workflowTxnItemOther
+)
+
+func (t *workflowTxn) add(itemType workflowTxnItem, it *dynamodb.TransactWriteItem) {
+	t.items = append(t.items, it)
+	t.types = append(t.types, itemType)
+}
+
+// conditionFailures returns the failed conditions if the error is caused by them
+func (t *workflowTxn) conditionFailures(err error) []conditionFailure {
+	var txnErr *dynamodb.TransactionCanceledException
+	if !errors.As(err, &txnErr) || len(txnErr.CancellationReasons) != len(t.items) {
+		return nil
+	}
+	var failures []conditionFailure
+	for i, reason := range txnErr.CancellationReasons {
+		if aws.StringValue(reason.Code) == cancellationReasonConditionalCheckFailed {
+			failures = append(failures, conditionFailure{
+				workflowTxnItem: t.types[i],
+				item:            reason.Item,
+			})
+		}
+	}
+	return failures
+}
+
+func newUpdateBuilder() *updateBuilder {
+	return &updateBuilder{
+		names:     make(map[string]*string),
+		nameAlias: make(map[string]string),
+		values:    make(map[string]*dynamodb.AttributeValue),
+	}
+}
+
+func (b *updateBuilder) name(name string) string {
+	if alias, ok := b.nameAlias[name]; ok {
+		return alias
+	}
+	alias := fmt.Sprintf("#n%v", len(b.nameAlias))
+	b.nameAlias[name] = alias
+	b.names[alias] = aws.String(name)
+	return alias
+}
+
+func (b *updateBuilder) value(v *dynamodb.AttributeValue) string {
+	placeholder := fmt.Sprintf(":v%v", len(b.values))
+	b.values[placeholder] = v
+	return placeholder
+}
+
+func (b *updateBuilder) path(attr string, key string) string {
+	return b.name(attr) + "." + b.name(key)
+}
+
+func (b *updateBuilder) set(attr string, v *dynamodb.AttributeValue) {
+	b.sets = append(b.sets, fmt.Sprintf("%v = %v", b.name(attr), b.value(v)))
+}
+
+func (b *updateBuilder) setMapEntry(attr string, key string, v *dynamodb.AttributeValue) {
+	b.sets = append(b.sets, fmt.Sprintf("%v = %v", b.path(attr, key), b.value(v)))
+}
+
+func (b *updateBuilder) remove(attr string) {
+	b.removes = append(b.removes, b.name(attr))
+}
+
+func (b *updateBuilder) removeMapEntry(attr string, key string) {
+	b.removes = append(b.removes, b.path(attr, key))
+}
+
+func (b *updateBuilder) appendList(attr string, v *dynamodb.AttributeValue) {
+	name := b.name(attr)
+	b.sets = append(b.sets, fmt.Sprintf("%v = list_append(if_not_exists(%v, %v), %v)",
+		name, name, b.value(&dynamodb.AttributeValue{L: []*dynamodb.AttributeValue{}}), b.value(v)))
+}
+
+// equals returns a condition expression checking the attribute equals to the value
+func (b *updateBuilder) equals(attr string, v *dynamodb.AttributeValue) string {
+	return fmt.Sprintf("%v = %v", b.name(attr), b.value(v))
+}
+
+func (b *updateBuilder) expression() *string {
+	var clauses []string
+	if len(b.sets) > 0 {
+		clauses = append(clauses, "SET "+strings.Join(b.sets, ", "))
+	}
+	if len(b.removes) > 0 {
+		clauses = append(clauses, "REMOVE "+strings.Join(b.removes, ", "))
+	}
+	return aws.String(strings.Join(clauses, " "))
+}
+
+func currentWorkflowKey(shardID int, domainID, workflowID string) item {
+	return item{
+		attrShardID: attrN(int64(shardID)),
+		attrSortKey: attrS(compositeKey(rowTypeCurrentWorkflow, domainID, workflowID)),
+	}
+}
+
+func executionKey(shardID int, domainID, workflowID, runID string) item {
+	return item{
+		attrShardID: attrN(int64(shardID)),
+		attrSortKey: attrS(compositeKey(rowTypeExecution, domainID, workflowID, runID)),
+	}
+}
+
+func transferTaskKey(shardID int, taskID int64) item {
+	return item{
+		attrShardID: attrN(int64(shardID)),
+		attrSortKey: attrS(compositeKey(rowTypeTransferTask, encodeSortableInt64(taskID))),
+	}
+}
+
+func timerTaskKey(shardID int, visibilityTimestamp time.Time, taskID int64) item {
+	return item{
+		attrShardID: attrN(int64(shardID)),
+		attrSortKey: attrS(compositeKey(rowTypeTimerTask, encodeSortableTime(timerTimestamp(visibilityTimestamp)), encodeSortableInt64(taskID))),
+	}
+}
+
+func replicationTaskKey(shardID int, taskID int64) item {
+	return item{
+		attrShardID: attrN(int64(shardID)),
+		attrSortKey: attrS(compositeKey(rowTypeReplicationTask, encodeSortableInt64(taskID))),
+	}
+}
+
+func replicationDLQTaskKey(shardID int, sourceCluster string, taskID int64) item {
+	return item{
+		attrShardID: attrN(int64(shardID)),
+		attrSortKey: attrS(compositeKey(rowTypeReplicationDLQTask, sourceCluster, encodeSortableInt64(taskID))),
+	}
+}
+
+func crossClusterTaskKey(shardID int, targetCluster string, taskID int64) item {
+	return item{
+		attrShardID: attrN(int64(shardID)),
+		attrSortKey: attrS(compositeKey(rowTypeCrossClusterTask, targetCluster, encodeSortableInt64(taskID))),
+	}
+}
+
+func activeClusterSelectionPolicyKey(shardID int, domainID, workflowID, runID string) item {
+	return item{
+		attrShardID: attrN(int64(shardID)),
+		attrSortKey: attrS(compositeKey(rowTypeActiveClusterSelectionPolicy, domainID, workflowID, runID)),
+	}
+}
+
+func workflowRequestKey(row *nosqlplugin.WorkflowRequestRow) (item, error) {
+	switch row.RequestType {
+	case persistence.WorkflowRequestTypeStart,
+		persistence.WorkflowRequestTypeSignal,
+		persistence.WorkflowRequestTypeCancel,
+		persistence.WorkflowRequestTypeReset:
+	default:
+		return nil, fmt.Errorf("unknown workflow request type %v", row.RequestType)
+	}
+	return item{
+		attrShardID: attrN(int64(row.ShardID)),
+		attrSortKey: attrS(compositeKey(rowTypeWorkflowRequest, row.DomainID, row.WorkflowID, strconv.Itoa(int(row.RequestType)), row.RequestID)),
+	}, nil
+}
+
+// timerTimestamp truncates the timestamp into milliseconds, the same precision as Cassandra,
+// so that timer tasks can be located by the scheduled time no matter which precision the caller holds
+func timerTimestamp(t time.Time) time.Time {
+	return time.Unix(0, persistence.DBTimestampToUnixNano(persistence.UnixNanoToDBTimestamp(t.UnixNano()))).UTC()
+}
+
+func (db *ddb) assertShardRangeID(txn *workflowTxn, shardID int, rangeID int64) {
+	txn.add(workflowTxnItem{itemType: workflowTxnItemShard}, &dynamodb.TransactWriteItem{
+		ConditionCheck: &dynamodb.ConditionCheck{
+			TableName:                           db.tableName(tableExecutions),
+			Key:                                 shardKey(shardID),
+			ConditionExpression:                 aws.String("#range_id = :range_id"),
+			ExpressionAttributeNames:            map[string]*string{"#range_id": aws.String(attrRangeID)},
+			ExpressionAttributeValues:           map[string]*dynamodb.AttributeValue{":range_id": attrN(rangeID)},
+			ReturnValuesOnConditionCheckFailure: aws.String(dynamodb.ReturnValuesOnConditionCheckFailureAllOld),
+		},
+	})
+}
+
+func (db *ddb) insertOrUpsertWorkflowRequestRow(
+	txn *workflowTxn,
+	requests *nosqlplugin.WorkflowRequestsWriteRequest,
+	timeStamp time.Time,
+) error {
+	if requests == nil {
+		return nil
+	}
+	for _, row := range requests.Rows {
+		key, err := workflowRequestKey(row)
+		if err != nil {
+			return err
+		}
+		it := key
+		it[attrRunID] = attrS(row.RunID)
+		it[attrVersion] = attrN(row.Version)
+		it[attrExpiry] = attrN(expiryFromTTL(timeStamp, int64(workflowRequestTTL/time.Second)))
+		put := &dynamodb.Put{
+			TableName: db.tableName(tableExecutions),
+			Item:      it,
+		}
+		switch requests.WriteMode {
+		case nosqlplugin.WorkflowRequestWriteModeInsert:
+			// expired requests may not be removed by DynamoDB yet, they are treated as not existing
+			put.ConditionExpression = aws.String("attribute_not_exists(#sk) OR #expiry <= :now")
+			put.ExpressionAttributeNames = map[string]*string{
+				"#sk":     aws.String(attrSortKey),
+				"#expiry": aws.String(attrExpiry),
+			}
+			put.ExpressionAttributeValues = map[string]*dynamodb.AttributeValue{":now": attrN(timeStamp.Unix())}
+			put.ReturnValuesOnConditionCheckFailure = aws.String(dynamodb.ReturnValuesOnConditionCheckFailureAllOld)
+		case nosqlplugin.WorkflowRequestWriteModeUpsert:
+		default:
+			return fmt.Errorf("unknown workflow request write mode %v", requests.WriteMode)
+		}
+		txn.add(workflowTxnItem{itemType: workflowTxnItemWorkflowRequest, requestType: row.RequestType}, &dynamodb.TransactWriteItem{Put: put})
+	}
+	return nil
+}
+
+func (db *ddb) createOrUpdateCurrentWorkflow(
+	txn *workflowTxn,
+	shardID int,
+	domainID string,
+	workflowID string,
+	request *nosqlplugin.CurrentWorkflowWriteRequest,
+	timeStamp time.Time,
+) error {
+	it := currentWorkflowKey(shardID, domainID, workflowID)
+	it[attrDomainID] = attrS(domainID)
+	it[attrWorkflowID] = attrS(workflowID)
+	it[attrCurrentRunID] = attrS(request.Row.RunID)
+	it[attrCreateRequestID] = attrS(request.Row.CreateRequestID)
+	it[attrWorkflowState] = attrN(int64(request.Row.State))
+	it[attrCloseStatus] = attrN(int64(request.Row.CloseStatus))
+	it[attrLastWriteVersion] = attrN(request.Row.LastWriteVersion)
+	it[attrLastUpdatedTime] = attrTime(timeStamp)
+	put := &dynamodb.Put{
+		TableName:                           db.tableName(tableExecutions),
+		Item:                                it,
+		ReturnValuesOnConditionCheckFailure: aws.String(dynamodb.ReturnValuesOnConditionCheckFailureAllOld),
+	}
+
+	switch request.WriteMode {
+	case nosqlplugin.CurrentWorkflowWriteModeNoop:
+		return nil
+	case nosqlplugin.CurrentWorkflowWriteModeInsert:
+		put.ConditionExpression = aws.String("attribute_not_exists(#sk)")
+		put.ExpressionAttributeNames = map[string]*string{"#sk": aws.String(attrSortKey)}
+	case nosqlplugin.CurrentWorkflowWriteModeUpdate:
+		if request.Condition == nil || request.Condition.GetCurrentRunID() == "" {
+			return fmt.Errorf("CurrentWorkflowWriteModeUpdate require Condition.CurrentRunID")
+		}
+		b := newUpdateBuilder()
+		conditions := []string{b.equals(attrCurrentRunID, attrS(*request.Condition.CurrentRunID))}
+		if request.Condition.LastWriteVersion != nil && request.Condition.State != nil {
+			conditions = append(conditions,
+				b.equals(attrLastWriteVersion, attrN(*request.Condition.LastWriteVersion)),
+				b.equals(attrWorkflowState, attrN(int64(*request.Condition.State))),
+			)
+		}
+		put.ConditionExpression = aws.String(strings.Join(conditions, " AND "))
+		put.ExpressionAttributeNames = b.names
+		put.ExpressionAttributeValues = b.values
+	default:
+		return fmt.Errorf("unknown mode %v", request.WriteMode)
+	}
+	txn.add(workflowTxnItem{itemType: workflowTxnItemCurrentWorkflow}, &dynamodb.TransactWriteItem{Put: put})
+	return nil
+}
+
+func (db *ddb) insertWorkflowActiveClusterSelectionPolicyRow(
+	txn *workflowTxn,
+	row *nosqlplugin.ActiveClusterSelectionPolicyRow,
+) {
+	if row == nil || row.Policy == nil {
+		return
+	}
+	it := activeClusterSelectionPolicyKey(row.ShardID, row.DomainID, row.WorkflowID, row.RunID)
+	setDataBlob(it, attrData, attrDataEncoding, row.Policy)
+	txn.add(workflowTxnItem{itemType: workflowTxnItemOther}, &dynamodb.TransactWriteItem{
+		Put: &dynamodb.Put{
+			TableName: db.tableName(tableExecutions),
+			Item:      it,
+		},
+	})
+}
+
+func (db *ddb) createWorkflowExecutionWithMergeMaps(
+	w *workflowWrite,
+	execution *nosqlplugin.WorkflowExecutionRequest,
+) error {
+	if execution.EventBufferWriteMode != nosqlplugin.EventBufferWriteModeNone {
+		return fmt.Errorf("should only support EventBufferWriteModeNone")
+	}
+	if execution.MapsWriteMode != nosqlplugin.WorkflowExecutionMapsWriteModeCreate {
+		return fmt.Errorf("should only support WorkflowExecutionMapsWriteModeCreate")
+	}
+
+	it := executionKey(w.shardID, execution.DomainID, execution.WorkflowID, execution.RunID)
+	if err := w.setWorkflowExecution(it, execution); err != nil {
+		return err
+	}
+	maps, err := w.newWorkflowExecutionMaps(execution)
+	if err != nil {
+		return err
+	}
+	for attr, v := range maps {
+		it[attr] = v
+	}
+	it[attrBufferedEvents] = &dynamodb.AttributeValue{L: []*dynamodb.AttributeValue{}}
+	w.putWorkflowTimerTasks(execution, execution.WorkflowTimerTasks)
+
+	w.txn.add(workflowTxnItem{itemType: workflowTxnItemExecution, runID: execution.RunID}, &dynamodb.TransactWriteItem{
+		Put: &dynamodb.Put{
+			TableName:                           w.tableName,
+			Item:                                it,
+			ConditionExpression:                 aws.String("attribute_not_exists(#sk)"),
+			ExpressionAttributeNames:            map[string]*string{"#sk": aws.String(attrSortKey)},
+			ReturnValuesOnConditionCheckFailure: aws.String(dynamodb.ReturnValuesOnConditionCheckFailureAllOld),
+		},
+	})
+	return nil
+}
+
+// updateWorkflowExecutionAndEventBufferWithMergeAndDeleteMaps updates the execution row and the entries.
+// index is the current index of the execution row, it's only read when required, see requiresExecutionIndex.
+func (db *ddb) updateWorkflowExecutionAndEventBufferWithMergeAndDeleteMaps(
+	w *workflowWrite,
+	execution *nosqlplugin.WorkflowExecutionRequest,
+	index item,
+) error {
+	if execution.MapsWriteMode != nosqlplugin.WorkflowExecutionMapsWriteModeUpdate {
+		return fmt.Errorf("should only support WorkflowExecutionMapsWriteModeUpdate")
+	}
+
+	b := newUpdateBuilder()
+	if err := w.updateWorkflowExecution(b, execution); err != nil {
+		return err
+	}
+
+	switch execution.EventBufferWriteMode {
+	case nosqlplugin.EventBufferWriteModeClear:
+		b.set(attrBufferedEvents, &dynamodb.AttributeValue{L: []*dynamodb.AttributeValue{}})
+		w.supersedeBufferedEvents(execution, index)
+	case nosqlplugin.EventBufferWriteModeAppend:
+		ref := w.putBufferedEvents(execution, execution.NewBufferedEventBatch)
+		b.appendList(attrBufferedEvents, &dynamodb.AttributeValue{L: []*dynamodb.AttributeValue{ref.attr()}})
+	}
+
+	// In certain cases, some of the execution update cycles update particular entries asynchronously before reaching the final cycle,
+	// so the maps are merged entry by entry instead of being overridden.
+	activityKeys, activityValues, err := int64MapJSON(execution.ActivityInfos)
+	if err != nil {
+		return err
+	}
+	w.updateEntryMap(b, index, execution, attrActivityMap, activityKeys, activityValues, int64Keys(execution.ActivityInfoKeysToDelete))
+	timerKeys, timerValues, err := stringMapJSON(execution.TimerInfos)
+	if err != nil {
+		return err
+	}
+	w.updateEntryMap(b, index, execution, attrTimerMap, timerKeys, timerValues, execution.TimerInfoKeysToDelete)
+	childKeys, childValues, err := int64MapJSON(execution.ChildWorkflowInfos)
+	if err != nil {
+		return err
+	}
+	w.updateEntryMap(b, index, execution, attrChildExecutionsMap, childKeys, childValues, int64Keys(execution.ChildWorkflowInfoKeysToDelete))
+	requestCancelKeys, requestCancelValues, err := int64MapJSON(execution.RequestCancelInfos)
+	if err != nil {
+		return err
+	}
+	w.updateEntryMap(b, index, execution, attrRequestCancelMap, requestCancelKeys, requestCancelValues, int64Keys(execution.RequestCancelInfoKeysToDelete))
+	signalKeys, signalValues, err := int64MapJSON(execution.SignalInfos)
+	if err != nil {
+		return err
+	}
+	w.updateEntryMap(b, index, execution, attrSignalMap, signalKeys, signalValues, int64Keys(execution.SignalInfoKeysToDelete))
+	signalRequestedValues := make([]*dynamodb.AttributeValue, len(execution.SignalRequestedIDs))
+	for i := range signalRequestedValues {
+		signalRequestedValues[i] = attrBool(true)
+	}
+	updateIndexMap(b, index, attrSignalRequested, execution.SignalRequestedIDs, signalRequestedValues, execution.SignalRequestedIDsKeysToDelete)
+	w.putWorkflowTimerTasks(execution, execution.WorkflowTimerTasks)
+
+	db.addConditionalExecutionUpdate(w, execution, b)
+	return nil
+}
+
+// resetWorkflowExecutionAndMapsAndEventBuffer overrides the execution row and the entries,
+// all the entries referred by the current index are superseded.
+func (db *ddb) resetWorkflowExecutionAndMapsAndEventBuffer(
+	w *workflowWrite,
+	execution *nosqlplugin.WorkflowExecutionRequest,
+	index item,
+) error {
+	if execution.EventBufferWriteMode != nosqlplugin.EventBufferWriteModeClear {
+		return fmt.Errorf("should only support EventBufferWriteModeClear")
+	}
+	if execution.MapsWriteMode != nosqlplugin.WorkflowExecutionMapsWriteModeReset {
+		return fmt.Errorf("should only support WorkflowExecutionMapsWriteModeReset")
+	}
+
+	w.supersedeEntries(execution, index)
+	b := newUpdateBuilder()
+	if err := w.updateWorkflowExecution(b, execution); err != nil {
+		return err
+	}
+	b.set(attrBufferedEvents, &dynamodb.AttributeValue{L: []*dynamodb.AttributeValue{}})
+	maps, err := w.newWorkflowExecutionMaps(execution)
+	if err != nil {
+		return err
+	}
+	for _, attr := range sortedAttrNames(maps) {
+		b.set(attr, maps[attr])
+	}
+	w.putWorkflowTimerTasks(execution, execution.WorkflowTimerTasks)
+
+	db.addConditionalExecutionUpdate(w, execution, b)
+	return nil
+}
+
+func (db *ddb) addConditionalExecutionUpdate(
+	w *workflowWrite,
+	execution *nosqlplugin.WorkflowExecutionRequest,
+	b *updateBuilder,
+) {
+	condition := b.equals(attrNextEventID, attrN(*execution.PreviousNextEventIDCondition))
+	w.txn.add(workflowTxnItem{itemType: workflowTxnItemExecution, runID: execution.RunID}, &dynamodb.TransactWriteItem{
+		Update: &dynamodb.Update{
+			TableName:                           w.tableName,
+			Key:                                 executionKey(w.shardID, execution.DomainID, execution.WorkflowID, execution.RunID),
+			UpdateExpression:                    b.expression(),
+			ConditionExpression:                 aws.String(condition),
+			ExpressionAttributeNames:            b.names,
+			ExpressionAttributeValues:           b.values,
+			ReturnValuesOnConditionCheckFailure: aws.String(dynamodb.ReturnValuesOnConditionCheckFailureAllOld),
+		},
+	})
+}
+
+// setWorkflowExecution sets the attributes of the execution row which are overridden by every write,
+// large execution info is stored as an entry
+func (w *workflowWrite) setWorkflowExecution(it item, execution *nosqlplugin.WorkflowExecutionRequest) error {
+	info, err := json.Marshal(&execution.InternalWorkflowExecutionInfo)
+	if err != nil {
+		return err
+	}
+	if len(info) > maxInlineExecutionInfoSize {
+		it[attrExecutionRef] = w.putEntry(execution, entryKindExecution, "", info).attr()
+	} else {
+		it[attrExecution] = attrB(info)
+	}
+	it[attrDomainID] = attrS(execution.DomainID)
+	it[attrRunID] = attrS(execution.RunID)
+	it[attrNextEventID] = attrN(execution.NextEventID)
+	it[attrWorkflowState] = attrN(int64(execution.State))
+	it[attrLastWriteVersion] = attrN(execution.LastWriteVersion)
+	it[attrLastUpdatedTime] = attrTime(execution.CurrentTimeStamp)
+	setDataBlob(it, attrVersionHistories, attrVersionHistoriesEncoding, execution.VersionHistories)
+	if execution.Checksums != nil {
+		cs, err := attrJSON(execution.Checksums)
+		if err != nil {
+			return err
+		}
+		it[attrChecksum] = cs
+	}
+	return nil
+}
+
+func (w *workflowWrite) updateWorkflowExecution(b *updateBuilder, execution *nosqlplugin.WorkflowExecutionRequest) error {
+	it := make(item)
+	if err := w.setWorkflowExecution(it, execution); err != nil {
+		return err
+	}
+	for _, attr := range sortedAttrNames(it) {
+		b.set(attr, it[attr])
+	}
+	for _, attr := range []string{attrExecution, attrExecutionRef, attrVersionHistories, attrVersionHistoriesEncoding, attrChecksum} {
+		if _, ok := it[attr]; !ok {
+			b.remove(attr)
+		}
+	}
+	return nil
+}
+
+// newWorkflowExecutionMaps stores the entries of the maps, and returns the map attributes of the execution row referring to them
+func (w *workflowWrite) newWorkflowExecutionMaps(execution *nosqlplugin.WorkflowExecutionRequest) (item, error) {
+	maps := make(item)
+	activityKeys, activityValues, err := int64MapJSON(execution.ActivityInfos)
+	if err != nil {
+		return nil, err
+	}
+	maps[attrActivityMap] = w.entryMapAttr(execution, attrActivityMap, activityKeys, activityValues)
+	timerKeys, timerValues, err := stringMapJSON(execution.TimerInfos)
+	if err != nil {
+		return nil, err
+	}
+	maps[attrTimerMap] = w.entryMapAttr(execution, attrTimerMap, timerKeys, timerValues)
+	childKeys, childValues, err := int64MapJSON(execution.ChildWorkflowInfos)
+	if err != nil {
+		return nil, err
+	}
+	maps[attrChildExecutionsMap] = w.entryMapAttr(execution, attrChildExecutionsMap, childKeys, childValues)
+	requestCancelKeys, requestCancelValues, err := int64MapJSON(execution.RequestCancelInfos)
+	if err != nil {
+		return nil, err
+	}
+	maps[attrRequestCancelMap] = w.entryMapAttr(execution, attrRequestCancelMap, requestCancelKeys, requestCancelValues)
+	signalKeys, signalValues, err := int64MapJSON(execution.SignalInfos)
+	if err != nil {
+		return nil, err
+	}
+	maps[attrSignalMap] = w.entryMapAttr(execution, attrSignalMap, signalKeys, signalValues)
+	signalRequested := make(item, len(execution.SignalRequestedIDs))
+	for _, id := range execution.SignalRequestedIDs {
+		signalRequested[id] = attrBool(true)
+	}
+	maps[attrSignalRequested] = &dynamodb.AttributeValue{M: signalRequested}
+	return maps, nil
+}
+
+func int64MapJSON[V any](m map[int64]V) ([]string, [][]byte, error) {
+	keys := make([]string, 0, len(m))
+	values := make([][]byte, 0, len(m))
+	for k, v := range m {
+		value, err := json.Marshal(v)
+		if err != nil {
+			return nil, nil, err
+		}
+		keys = append(keys, strconv.FormatInt(k, 10))
+		values = append(values, value)
+	}
+	return keys, values, nil
+}
+
+func stringMapJSON[V any](m map[string]V) ([]string, [][]byte, error) {
+	keys := make([]string, 0, len(m))
+	values := make([][]byte, 0, len(m))
+	for k, v := range m {
+		value, err := json.Marshal(v)
+		if err != nil {
+			return nil, nil, err
+		}
+		keys = append(keys, k)
+		values = append(values, value)
+	}
+	return keys, values, nil
+}
+
+func int64Keys(keys []int64) []string {
+	result := make([]string, 0, len(keys))
+	for _, k := range keys {
+		result = append(result, strconv.FormatInt(k, 10))
+	}
+	return result
+}
+
+// updateMapEntries upserts and deletes the entries of a map attribute.
+// DynamoDB rejects overlapping paths in one update, so an entry both upserted and deleted is only deleted,
+// the same as Cassandra where the deletion wins.
+func updateMapEntries(b *updateBuilder, attr string, keys []string, values []*dynamodb.AttributeValue, keysToDelete []string) {
+	deleted := make(map[string]struct{}, len(keysToDelete))
+	for _, k := range keysToDelete {
+		if _, ok := deleted[k]; ok {
+			continue
+		}
+		deleted[k] = struct{}{}
+		b.removeMapEntry(attr, k)
+	}
+	upserted := make(map[string]struct{}, len(keys))
+	for i, k := range keys {
+		if _, ok := deleted[k]; ok {
+			continue
+		}
+		if _, ok := upserted[k]; ok {
+			continue
+		}
+		upserted[k] = struct{}{}
+		b.setMapEntry(attr, k, values[i])
+	}
+}
+
+func sortedAttrNames(it item) []string {
+	names := make([]string, 0, len(it))
+	for name := range it {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func parseWorkflowExecutionInfo(it item, entries *entryItems) (*persistence.InternalWorkflowExecutionInfo, error) {
+	info := &persistence.InternalWorkflowExecutionInfo{}
+	if hasAttr(it, attrExecutionRef) {
+		if err := entries.unmarshal(entryKindExecution, "", parseEntryRef(it[attrExecutionRef]), info); err != nil {
+			return nil, err
+		}
+	} else if err := getJSON(it, attrExecution, info); err != nil {
+		return nil, err
+	}
+	info.CompletionEvent = normalizeDataBlob(info.CompletionEvent)
+	info.AutoResetPoints = normalizeDataBlob(info.AutoResetPoints)
+	info.ActiveClusterSelectionPolicy = normalizeDataBlob(info.ActiveClusterSelectionPolicy)
+	return info, nil
+}
+
+func parseWorkflowExecution(it item, entries *entryItems) (*nosqlplugin.WorkflowExecution, error) {
+	info, err := parseWorkflowExecutionInfo(it, entries)
+	if err != nil {
+		return nil, err
+	}
+	state := &nosqlplugin.WorkflowExecution{
+		ExecutionInfo:       info,
+		VersionHistories:    getDataBlob(it, attrVersionHistories, attrVersionHistoriesEncoding),
+		ActivityInfos:       make(map[int64]*persistence.InternalActivityInfo),
+		TimerInfos:          make(map[string]*persistence.TimerInfo),
+		ChildExecutionInfos: make(map[int64]*persistence.InternalChildExecutionInfo),
+		RequestCancelInfos:  make(map[int64]*persistence.RequestCancelInfo),
+		SignalInfos:         make(map[int64]*persistence.SignalInfo),
+		SignalRequestedIDs:  make(map[string]struct{}),
+	}
+	if err := parseInt64EntryMap(it, attrActivityMap, entries, state.ActivityInfos); err != nil {
+		return nil, err
+	}
+	for _, ai := range state.ActivityInfos {
+		ai.ScheduledEvent = normalizeDataBlob(ai.ScheduledEvent)
+		ai.StartedEvent = normalizeDataBlob(ai.StartedEvent)
+	}
+	if err := parseStringEntryMap(it, attrTimerMap, entries, state.TimerInfos); err != nil {
+		return nil, err
+	}
+	if err := parseInt64EntryMap(it, attrChildExecutionsMap, entries, state.ChildExecutionInfos); err != nil {
+		return nil, err
+	}
+	for _, ci := range state.ChildExecutionInfos {
+		ci.InitiatedEvent = normalizeDataBlob(ci.InitiatedEvent)
+		ci.StartedEvent = normalizeDataBlob(ci.StartedEvent)
+	}
+	if err := parseInt64EntryMap(it, attrRequestCancelMap, entries, state.RequestCancelInfos); err != nil {
+		return nil, err
+	}
+	if err := parseInt64EntryMap(it, attrSignalMap, entries, state.SignalInfos); err != nil {
+		return nil, err
+	}
+	if v, ok := it[attrSignalRequested]; ok && v != nil {
+		for id := range v.M {
+			state.SignalRequestedIDs[id] = struct{}{}
+		}
+	}
+
+	var bufferedEvents []*dynamodb.AttributeValue
+	if v, ok := it[attrBufferedEvents]; ok && v != nil {
+		bufferedEvents = v.L
+	}
+	state.BufferedEvents = make([]*persistence.DataBlob, 0, len(bufferedEvents))
+	for _, v := range bufferedEvents {
+		ref := parseEntryRef(v)
+		data, err := entries.value(entryKindBufferedEvents, ref.key, ref)
+		if err != nil {
+			return nil, err
+		}
+		state.BufferedEvents = append(state.BufferedEvents, persistence.NewDataBlob(data, constants.EncodingType(ref.encoding)))
+	}
+
+	if err := getJSON(it, attrChecksum, &state.Checksum); err != nil {
+		return nil, err
+	}
+	return state, nil
+}
+
+func parseInt64EntryMap[V any](it item, attr string, entries *entryItems, result map[int64]V) error {
+	v, ok := it[attr]
+	if !ok || v == nil {
+		return nil
+	}
+	for k, ref := range v.M {
+		key, err := strconv.ParseInt(k, 10, 64)
+		if err != nil {
+			return fmt.Errorf("corrupted key %v of %v: %v", k, attr, err)
+		}
+		var entry V
+		if err := entries.unmarshal(entryMapKinds[attr], k, parseEntryRef(ref), &entry); err != nil {
+			return err
+		}
+		result[key] = entry
+	}
+	return nil
+}
+
+func parseStringEntryMap[V any](it item, attr string, entries *entryItems, result map[string]V) error {
+	v, ok := it[attr]
+	if !ok || v == nil {
+		return nil
+	}
+	for k, ref := range v.M {
+		var entry V
+		if err := entries.unmarshal(entryMapKinds[attr], k, parseEntryRef(ref), &entry); err != nil {
+			return err
+		}
+		result[k] = entry
+	}
+	return nil
+}
+
+func newCurrentWorkflowRow(shardID int, it item) *nosqlplugin.CurrentWorkflowRow {
+	return &nosqlplugin.CurrentWorkflowRow{
+		ShardID:          shardID,
+		DomainID:         getS(it, attrDomainID),
+		WorkflowID:       getS(it, attrWorkflowID),
+		RunID:            getS(it, attrCurrentRunID),
+		CreateRequestID:  getS(it, attrCreateRequestID),
+		State:            int(getN(it, attrWorkflowState)),
+		CloseStatus:      int(getN(it, attrCloseStatus)),
+		LastWriteVersion: getN(it, attrLastWriteVersion),
+	}
+}
+
+func (db *ddb) newHistoryTaskPut(
+	shardID int,
+	category persistence.HistoryTaskCategory,
+	task *nosqlplugin.HistoryMigrationTask,
+	timeStamp time.Time,
+) (*dynamodb.TransactWriteItem, error) {
+	var it item
+	var info *dynamodb.AttributeValue
+	var taskID int64
+	var err error
+	switch category.ID() {
+	case persistence.HistoryTaskCategoryIDTransfer:
+		taskID = task.Transfer.TaskID
+		it = transferTaskKey(shardID, taskID)
+		info, err = attrJSON(task.Transfer)
+		it[attrTransfer] = info
+	case persistence.HistoryTaskCategoryIDTimer:
+		taskID = task.Timer.TaskID
+		ts := timerTimestamp(task.Timer.VisibilityTimestamp)
+		it = timerTaskKey(shardID, ts, taskID)
+		it[attrVisibilityTimestamp] = attrTime(ts)
+		info, err = attrJSON(task.Timer)
+		it[attrTimer] = info
+	case persistence.HistoryTaskCategoryIDReplication:
+		taskID = task.Replication.TaskID
+		it = replicationTaskKey(shardID, taskID)
+		info, err = attrJSON(task.Replication)
+		it[attrReplication] = info
+	default:
+		// TODO: implementing writing tasks for other categories
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	it[attrTaskID] = attrN(taskID)
+	it[attrCreatedTime] = attrTime(timeStamp)
+	setDataBlob(it, attrData, attrDataEncoding, task.Task)
+	return &dynamodb.TransactWriteItem{
+		Put: &dynamodb.Put{
+			TableName: db.tableName(tableExecutions),
+			Item:      it,
+		},
+	}, nil
+}
+
+func (db *ddb) createTasksByCategory(
+	w *workflowWrite,
+	tasksByCategory map[persistence.HistoryTaskCategory][]*nosqlplugin.HistoryMigrationTask,
+) error {
+	for c, tasks := range tasksByCategory {
+		for _, task := range tasks {
+			put, err := db.newHistoryTaskPut(w.shardID, c, task, w.timeStamp)
+			if err != nil {
+				return err
+			}
+			if put != nil {
+				w.tasks = append(w.tasks, put)
+			}
+		}
+	}
+	return nil
+}
+
+func newTransferTask(it item) (*nosqlplugin.HistoryMigrationTask, error) {
+	info := &nosqlplugin.TransferTask{}
+	if err := getJSON(it, attrTransfer, info); err != nil {
+		return nil, err
+	}
+	return &nosqlplugin.HistoryMigrationTask{
+		Transfer: info,
+		Task:     getDataBlob(it, attrData, attrDataEncoding),
+		TaskID:   getN(it, attrTaskID),
+	}, nil
+}
+
+func newTimerTask(it item) (*nosqlplugin.HistoryMigrationTask, error) {
+	info := &nosqlplugin.TimerTask{}
+	if err := getJSON(it, attrTimer, info); err != nil {
+		return nil, err
+	}
+	return &nosqlplugin.HistoryMigrationTask{
+		Timer:         info,
+		Task:          getDataBlob(it, attrData, attrDataEncoding),
+		TaskID:        getN(it, attrTaskID),
+		ScheduledTime: getTime(it, attrVisibilityTimestamp),
+	}, nil
+}
+
+func newReplicationTask(it item) (*nosqlplugin.HistoryMigrationTask, error) {
+	info := &nosqlplugin.ReplicationTask{}
+	if err := getJSON(it, attrReplication, info); err != nil {
+		return nil, err
+	}
+	return &nosqlplugin.HistoryMigrationTask{
+		Replication: info,
+		Task:        getDataBlob(it, attrData, attrDataEncoding),
+		TaskID:      getN(it, attrTaskID),
+	}, nil
+}
+
+// conditionFailureDetails formats the old items of the failed conditions for logging purpose
+func conditionFailureDetails(failures []conditionFailure) string {
+	var columns []string
+	for i, f := range failures {
+		columns = append(columns, fmt.Sprintf("%v: %v", i, itemDetails(f.item)))
+	}
+	return strings.Join(columns, ",")
+}
+
+func convertCreateWorkflowFailure(
+	failures []conditionFailure,
+	currentWorkflowRequest *nosqlplugin.CurrentWorkflowWriteRequest,
+	execution *nosqlplugin.WorkflowExecutionRequest,
+	shardCondition *nosqlplugin.ShardCondition,
+) error {
+	byType := make(map[workflowTxnItemType]conditionFailure, len(failures))
+	for _, f := range failures {
+		if _, ok := byType[f.itemType]; !ok {
+			byType[f.itemType] = f
+		}
+	}
+
+	if f, ok := byType[workflowTxnItemShard]; ok {
+		return &nosqlplugin.WorkflowOperationConditionFailure{
+			ShardRangeIDNotMatch: common.Int64Ptr(getN(f.item, attrRangeID)),
+		}
+	}
+	if f, ok := byType[workflowTxnItemWorkflowRequest]; ok {
+		return &nosqlplugin.WorkflowOperationConditionFailure{
+			DuplicateRequest: &nosqlplugin.DuplicateRequest{
+				RequestType: f.requestType,
+				RunID:       getS(f.item, attrRunID),
+			},
+		}
+	}
+	if f, ok := byType[workflowTxnItemCurrentWorkflow]; ok {
+		current := newCurrentWorkflowRow(shardCondition.ShardID, f.item)
+		switch currentWorkflowRequest.WriteMode {
+		case nosqlplugin.CurrentWorkflowWriteModeInsert:
+			msg := fmt.Sprintf("Workflow execution already running. WorkflowId: %v, RunId: %v", currentWorkflowRequest.Row.WorkflowID, current.RunID)
+			return &nosqlplugin.WorkflowOperationConditionFailure{
+				WorkflowExecutionAlreadyExists: &nosqlplugin.WorkflowExecutionAlreadyExists{
+					OtherInfo:        msg,
+					CreateRequestID:  current.CreateRequestID,
+					RunID:            current.RunID,
+					State:            current.State,
+					CloseStatus:      current.CloseStatus,
+					LastWriteVersion: current.LastWriteVersion,
+				},
+			}
+		case nosqlplugin.CurrentWorkflowWriteModeUpdate:
+			condition := currentWorkflowRequest.Condition
+			if condition.GetCurrentRunID() != "" && current.RunID != condition.GetCurrentRunID() {
+				msg := fmt.Sprintf("Workflow execution creation condition failed by mismatch runID. WorkflowId: %v, Expected Current RunID: %v, Actual Current RunID: %v",
+					currentWorkflowRequest.Row.WorkflowID, condition.GetCurrentRunID(), current.RunID)
+				return &nosqlplugin.WorkflowOperationConditionFailure{
+					CurrentWorkflowConditionFailInfo: &msg,
+				}
+			}
+			if condition.LastWriteVersion != nil && *condition.LastWriteVersion != current.LastWriteVersion {
+				msg := fmt.Sprintf("Workflow execution creation condition failed. WorkflowId: %v, Expected Version: %v, Actual Version: %v",
+					currentWorkflowRequest.Row.WorkflowID, *condition.LastWriteVersion, current.LastWriteVersion)
+				return &nosqlplugin.WorkflowOperationConditionFailure{
+					CurrentWorkflowConditionFailInfo: &msg,
+				}
+			}
+			if condition.State != nil && *condition.State != current.State {
+				msg := fmt.Sprintf("Workflow execution creation condition failed. WorkflowId: %v, Expected State: %v, Actual State: %v",
+					currentWorkflowRequest.Row.WorkflowID, *condition.State, current.State)
+				return &nosqlplugin.WorkflowOperationConditionFailure{
+					CurrentWorkflowConditionFailInfo: &msg,
+				}
+			}
+		}
+	}
+	if f, ok := byType[workflowTxnItemExecution]; ok && len(f.item) > 0 {
+		msg := fmt.Sprintf("Workflow execution already running. WorkflowId: %v, RunId: %v", execution.WorkflowID, execution.RunID)
+		return &nosqlplugin.WorkflowOperationConditionFailure{
+			WorkflowExecutionAlreadyExists: &nosqlplugin.WorkflowExecutionAlreadyExists{
+				OtherInfo:        msg,
+				CreateRequestID:  execution.CreateRequestID,
+				RunID:            execution.RunID,
+				State:            execution.State,
+				CloseStatus:      execution.CloseStatus,
+				LastWriteVersion: getN(f.item, attrLastWriteVersion),
+			},
+		}
+	}
+
+	// At this point we only know that the write was not applied.
+	msg := fmt.Sprintf("Failed to operate on workflow execution.  Request RangeID: %v, columns: (%v)",
+		shardCondition.RangeID, conditionFailureDetails(failures))
+	return &nosqlplugin.WorkflowOperationConditionFailure{
+		UnknownConditionFailureDetails: &msg,
+	}
+}
+
+func convertUpdateWorkflowFailure(
+	failures []conditionFailure,
+	currentWorkflowRequest *nosqlplugin.CurrentWorkflowWriteRequest,
+	previousNextEventIDCondition int64,
+	shardCondition *nosqlplugin.ShardCondition,
+) error {
+	requestRunID := currentWorkflowRequest.Row.RunID
+	requestConditionalRunID := ""
+	if currentWorkflowRequest.Condition != nil {
+		requestConditionalRunID = currentWorkflowRequest.Condition.GetCurrentRunID()
+	}
+
+	var nextEventIDMismatch, runIDMismatch, duplicateRequest *conditionFailure
+	for i := range failures {
+		f := &failures[i]
+		switch f.itemType {
+		case workflowTxnItemShard:
+			return &nosqlplugin.WorkflowOperationConditionFailure{
+				ShardRangeIDNotMatch: common.Int64Ptr(getN(f.item, attrRangeID)),
+			}
+		case workflowTxnItemWorkflowRequest:
+			if duplicateRequest == nil {
+				duplicateRequest = f
+			}
+		case workflowTxnItemCurrentWorkflow:
+			if requestConditionalRunID != "" && getS(f.item, attrCurrentRunID) != requestConditionalRunID {
+				runIDMismatch = f
+			}
+		case workflowTxnItemExecution:
+			if f.runID == requestRunID && hasAttr(f.item, attrNextEventID) && getN(f.item, attrNextEventID) != previousNextEventIDCondition {
+				nextEventIDMismatch = f
+			}
+		}
+	}
+
+	if duplicateRequest != nil {
+		return &nosqlplugin.WorkflowOperationConditionFailure{
+			DuplicateRequest: &nosqlplugin.DuplicateRequest{
+				RequestType: duplicateRequest.requestType,
+				RunID:       getS(duplicateRequest.item, attrRunID),
+			},
+		}
+	}
+	if runIDMismatch != nil {
+		msg := fmt.Sprintf("Failed to update mutable state. requestConditionalRunID: %v, Actual Value: %v",
+			requestConditionalRunID, getS(runIDMismatch.item, attrCurrentRunID))
+		return &nosqlplugin.WorkflowOperationConditionFailure{
+			CurrentWorkflowConditionFailInfo: &msg,
+		}
+	}
+	if nextEventIDMismatch != nil {
+		msg := fmt.Sprintf("Failed to update mutable state. previousNextEventIDCondition: %v, actualNextEventID: %v, Request Current RunID: %v",
+			previousNextEventIDCondition, getN(nextEventIDMismatch.item, attrNextEventID), requestRunID)
+		return &nosqlplugin.WorkflowOperationConditionFailure{
+			UnknownConditionFailureDetails: &msg,
+		}
+	}
+
+	// At this point we only know that the write was not applied.
+	msg := fmt.Sprintf("Failed to update mutable state. ShardID: %v, RangeID: %v, previousNextEventIDCondition: %v, requestConditionalRunID: %v, columns: (%v)",
+		shardCondition.ShardID, shardCondition.RangeID, previousNextEventIDCondition, requestConditionalRunID, conditionFailureDetails(failures))
+	return &nosqlplugin.WorkflowOperationConditionFailure{
+		UnknownConditionFailureDetails: &msg,
+	}
+}
+
+// convertShardOperationFailure converts the failure of writing history tasks with shard range check
+func convertShardOperationFailure(failures []conditionFailure, shardCondition nosqlplugin.ShardCondition) error {
+	for _, f := range failures {
+		if f.itemType == workflowTxnItemShard && hasAttr(f.item, attrRangeID) && getN(f.item, attrRangeID) != shardCondition.RangeID {
+			return &nosqlplugin.ShardOperationConditionFailure{
+				RangeID: getN(f.item, attrRangeID),
+			}
+		}
+	}
+	// It's much safer to return ShardOperationConditionFailure(which will become ShardOwnershipLostError later) as the default to force the application to reload
+	// shard to recover from such errors
+	return &nosqlplugin.ShardOperationConditionFailure{
+		RangeID: -1,
+		Details: conditionFailureDetails(failures),
+	}
+}
@@ -0,0 +1,195 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamodb
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+)
+
+func TestUpdateBuilder(t *testing.T) {
+	b := newUpdateBuilder()
+	b.set(attrNextEventID, attrN(10))
+	b.setMapEntry(attrActivityMap, "1", attrS("activity"))
+	b.removeMapEntry(attrActivityMap, "2")
+	b.remove(attrChecksum)
+	condition := b.equals(attrNextEventID, attrN(5))
+
+	assert.Equal(t, "SET #n0 = :v0, #n1.#n2 = :v1 REMOVE #n1.#n3, #n4", aws.StringValue(b.expression()))
+	assert.Equal(t, "#n0 = :v2", condition)
+	assert.Equal(t, map[string]*string{
+		"#n0": aws.String(attrNextEventID),
+		"#n1": aws.String(attrActivityMap),
+		"#n2": aws.String("1"),
+		"#n3": aws.String("2"),
+		"#n4": aws.String(attrChecksum),
+	}, b.names)
+	assert.Equal(t, map[string]*dynamodb.AttributeValue{
+		":v0": attrN(10),
+		":v1": attrS("activity"),
+		":v2": attrN(5),
+	}, b.values)
+}
+
+func TestTimerTaskKey(t *testing.T) {
+	ts := time.Date(2024, 1, 1, 0, 0, 0, 123456789, time.UTC)
+	truncated := time.Date(2024, 1, 1, 0, 0, 0, 123000000, time.UTC)
+
+	// timer tasks are located by the timestamp in milliseconds regardless of the precision of the caller
+	assert.Equal(t, timerTaskKey(1, truncated, 10), timerTaskKey(1, ts, 10))
+	assert.True(t, truncated.Equal(timerTimestamp(ts)))
+	assert.Less(t,
+		aws.StringValue(timerTaskKey(1, ts, 10)[attrSortKey].S),
+		aws.StringValue(timerTaskKey(1, ts.Add(time.Millisecond), 1)[attrSortKey].S),
+	)
+}
+
+func TestWorkflowRequestKey(t *testing.T) {
+	key, err := workflowRequestKey(&nosqlplugin.WorkflowRequestRow{
+		ShardID:     1,
+		DomainID:    "domain",
+		WorkflowID:  "workflow",
+		RequestType: persistence.WorkflowRequestTypeSignal,
+		RequestID:   "request",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, item{
+		attrShardID: attrN(1),
+		attrSortKey: attrS("request#domain#workflow#1#request"),
+	}, key)
+
+	_, err = workflowRequestKey(&nosqlplugin.WorkflowRequestRow{RequestType: persistence.WorkflowRequestType(100)})
+	assert.Error(t, err)
+}
+
+func TestWorkflowWriteEntries(t *testing.T) {
+	db := &ddb{tablePrefix: "test_"}
+	now := time.Now().UTC()
+	execution := &nosqlplugin.WorkflowExecutionRequest{
+		InternalWorkflowExecutionInfo: persistence.InternalWorkflowExecutionInfo{
+			DomainID:    "domain",
+			WorkflowID:  "workflow",
+			RunID:       "run",
+			NextEventID: 10,
+		},
+		CurrentTimeStamp:     now,
+		MapsWriteMode:        nosqlplugin.WorkflowExecutionMapsWriteModeCreate,
+		EventBufferWriteMode: nosqlplugin.EventBufferWriteModeNone,
+		ActivityInfos: map[int64]*persistence.InternalActivityInfo{
+			5: {
+				ScheduleID:     5,
+				ScheduledEvent: persistence.NewDataBlob(make([]byte, maxItemDataSize), "thriftrw"),
+			},
+		},
+		TimerInfos: map[string]*persistence.TimerInfo{
+			"timer": {TimerID: "timer", StartedID: 6},
+		},
+		WorkflowTimerTasks: []persistence.HistoryTaskKey{persistence.NewHistoryTaskKey(now, 100)},
+	}
+
+	w := db.newWorkflowWrite(1, now, "write", stableSlot)
+	require.NoError(t, db.createWorkflowExecutionWithMergeMaps(w, execution))
+	require.Len(t, w.txn.items, 1)
+	// the activity is split into two chunks, plus one item for the timer and one for the workflow timer task
+	require.Len(t, w.entries, 4)
+	txn, ok := w.singleTransaction()
+	require.True(t, ok)
+	assert.Len(t, txn.items, 5)
+
+	var entries []item
+	for _, op := range w.entries {
+		entries = append(entries, op.Put.Item)
+	}
+	orphan := executionEntryKey(1, "domain", "run", entryID{kind: entryKindActivity, key: "6"}, 0)
+	orphan[attrEntryKind] = attrS(entryKindActivity)
+	orphan[attrEntryKey] = attrS("6")
+	orphan[attrCreatedTime] = attrTime(now.Add(-orphanEntryGracePeriod))
+	// the last item is the workflow timer task, which is not an entry
+	entryItems := newEntryItems(append(entries[:len(entries)-1:len(entries)-1], orphan))
+
+	state, err := parseWorkflowExecution(w.txn.items[0].Put.Item, entryItems)
+	require.NoError(t, err)
+	assert.Equal(t, int64(10), state.ExecutionInfo.NextEventID)
+	require.Contains(t, state.ActivityInfos, int64(5))
+	assert.Len(t, state.ActivityInfos[5].ScheduledEvent.Data, maxItemDataSize)
+	assert.Equal(t, int64(6), state.TimerInfos["timer"].StartedID)
+	assert.Equal(t, []item{orphan}, entryItems.orphans(now))
+	assert.Empty(t, entryItems.orphans(now.Add(-time.Second)))
+
+	timerTasks := parseWorkflowTimerTasks(entries[len(entries)-1:])
+	require.Len(t, timerTasks, 1)
+	assert.Equal(t, int64(100), timerTasks[0].GetTaskID())
+}
+
+func TestWorkflowWriteTooLargeForOneTransaction(t *testing.T) {
+	db := &ddb{tablePrefix: "test_"}
+	execution := &nosqlplugin.WorkflowExecutionRequest{
+		InternalWorkflowExecutionInfo: persistence.InternalWorkflowExecutionInfo{
+			DomainID:   "domain",
+			WorkflowID: "workflow",
+			RunID:      "run",
+		},
+		MapsWriteMode:                nosqlplugin.WorkflowExecutionMapsWriteModeUpdate,
+		EventBufferWriteMode:         nosqlplugin.EventBufferWriteModeNone,
+		PreviousNextEventIDCondition: common.Int64Ptr(5),
+		ActivityInfos:                make(map[int64]*persistence.InternalActivityInfo),
+	}
+	for i := int64(0); i < maxTransactionItems; i++ {
+		execution.ActivityInfos[i] = &persistence.InternalActivityInfo{ScheduleID: i}
+	}
+	assert.False(t, requiresExecutionIndex(execution))
+
+	w := db.newWorkflowWrite(1, time.Now(), "write", "write")
+	require.NoError(t, db.updateWorkflowExecutionAndEventBufferWithMergeAndDeleteMaps(w, execution, nil))
+	_, ok := w.singleTransaction()
+	assert.False(t, ok)
+	for _, op := range w.entries {
+		assert.Equal(t, "write", getS(op.Put.Item, attrEntrySlot))
+	}
+	// the entries are written ahead, the workflow rows and the tasks still fit into one transaction
+	txn, err := w.rowsTransaction()
+	require.NoError(t, err)
+	assert.Len(t, txn.items, len(w.txn.items))
+	for i := 0; i < maxTransactionItems; i++ {
+		w.tasks = append(w.tasks, &dynamodb.TransactWriteItem{Put: &dynamodb.Put{Item: item{}}})
+	}
+	_, err = w.rowsTransaction()
+	assert.Error(t, err)
+
+	execution.ActivityInfos[maxTransactionItems] = &persistence.InternalActivityInfo{}
+	assert.True(t, requiresExecutionIndex(execution))
+	index := item{attrActivityMap: &dynamodb.AttributeValue{M: item{
+		"1000": entryRef{slot: "old", chunks: 1}.attr(),
+	}}}
+	execution.ActivityInfoKeysToDelete = []int64{1000}
+	w = db.newWorkflowWrite(1, time.Now(), "write", stableSlot)
+	require.NoError(t, db.updateWorkflowExecutionAndEventBufferWithMergeAndDeleteMaps(w, execution, index))
+	assert.Equal(t, []item{executionEntryKey(1, "domain", "run", entryID{kind: entryKindActivity, key: "1000", slot: "old"}, 0)}, w.staleDeletes())
+}
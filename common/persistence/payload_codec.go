@@ -0,0 +1,413 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"bytes"
+	"errors"
+
+	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/types"
+)
+
+type (
+	// PayloadCodec transforms the payloads of history events, i.e. inputs, results, failure details, marker
+	// details and memo values, and the memo of workflows in mutable state and visibility before they are
+	// persisted and after they are read back.
+	// Encoded payloads must be self-describing, so that Decode doesn't need to know the settings they were encoded with.
+	PayloadCodec interface {
+		// Encode encodes a payload of the given domain, the payload is returned as is if the codec doesn't apply to the domain
+		Encode(domainName string, payload []byte) ([]byte, error)
+		// Decode decodes a payload, payloads which were not encoded are returned as is.
+		// ErrPayloadKeyNotFound is returned if the payload was encoded with a key which is no longer available.
+		Decode(payload []byte) ([]byte, error)
+		// IsCurrent returns true if the payload is already encoded the way Encode would encode it for the domain now
+		IsCurrent(domainName string, payload []byte) bool
+	}
+)
+
+var (
+	// ErrPayloadKeyNotFound is returned by PayloadCodec.Decode when the payload was encoded with an unknown key
+	ErrPayloadKeyNotFound = errors.New("payload was encoded with a key that is not available")
+
+	// RedactedPayload replaces payloads which cannot be decoded because their key is no longer available
+	RedactedPayload = []byte(`"[redacted]"`)
+
+	// ReservedPayloadPrefix starts the payloads which are transformed at rest, i.e. the payloads encoded by a
+	// PayloadCodec and the references to offloaded payloads. Payloads which already start with it are escaped
+	// before they are persisted, so that they are never mistaken for transformed payloads when they are read back.
+	ReservedPayloadPrefix = []byte("\x00\xca")
+
+	// escapedPayloadMagic prefixes the escaped payloads, the rest of the payload is the original payload
+	escapedPayloadMagic = []byte("\x00\xcaES")
+)
+
+// escapeHistoryEventPayloads returns a copy of the events with the payloads starting with ReservedPayloadPrefix
+// escaped. The returned bool is false if no payload was escaped, in which case the input events are returned.
+func escapeHistoryEventPayloads(events []*types.HistoryEvent) ([]*types.HistoryEvent, bool) {
+	escaped := false
+	result := make([]*types.HistoryEvent, 0, len(events))
+	for _, event := range events {
+		if event == nil {
+			result = append(result, event)
+			continue
+		}
+		eventCopy := *event
+		_ = transformHistoryEventPayloads(&eventCopy, true, func(payload []byte) ([]byte, error) {
+			if !bytes.HasPrefix(payload, ReservedPayloadPrefix) {
+				return payload, nil
+			}
+			escaped = true
+			return escapePayload(payload), nil
+		})
+		result = append(result, &eventCopy)
+	}
+	if !escaped {
+		return events, false
+	}
+	return result, true
+}
+
+// unescapeHistoryEventPayloads restores the escaped payloads of the events in place
+func unescapeHistoryEventPayloads(events []*types.HistoryEvent) {
+	for _, event := range events {
+		if event == nil {
+			continue
+		}
+		_ = transformHistoryEventPayloads(event, false, func(payload []byte) ([]byte, error) {
+			return unescapePayload(payload), nil
+		})
+	}
+}
+
+// mayHoldEscapedPayloads returns false if none of the events serialized in the blob has an escaped payload
+func mayHoldEscapedPayloads(blob *DataBlob) bool {
+	// thriftrw stores the payloads as is, json encodes them in base64
+	return blob.Encoding == constants.EncodingTypeJSON || bytes.Contains(blob.Data, escapedPayloadMagic)
+}
+
+func escapePayload(payload []byte) []byte {
+	if !bytes.HasPrefix(payload, ReservedPayloadPrefix) {
+		return payload
+	}
+	escaped := make([]byte, 0, len(escapedPayloadMagic)+len(payload))
+	return append(append(escaped, escapedPayloadMagic...), payload...)
+}
+
+func unescapePayload(payload []byte) []byte {
+	if !bytes.HasPrefix(payload, escapedPayloadMagic) {
+		return payload
+	}
+	return payload[len(escapedPayloadMagic):]
+}
+
+// encodeHistoryEventPayloads returns a copy of the events with their payloads encoded by the codec.
+// Events without payloads are shared with the input, the input events are never modified since they
+// are still used by the caller after they are persisted.
+func encodeHistoryEventPayloads(
+	codec PayloadCodec,
+	domainName string,
+	events []*types.HistoryEvent,
+) ([]*types.HistoryEvent, error) {
+	encoded := make([]*types.HistoryEvent, 0, len(events))
+	for _, event := range events {
+		if event == nil {
+			encoded = append(encoded, event)
+			continue
+		}
+		eventCopy := *event
+		if err := transformHistoryEventPayloads(&eventCopy, true, func(payload []byte) ([]byte, error) {
+			return codec.Encode(domainName, payload)
+		}); err != nil {
+			return nil, err
+		}
+		encoded = append(encoded, &eventCopy)
+	}
+	return encoded, nil
+}
+
+// decodeHistoryEventPayloads decodes the payloads of the events in place. If redact is true, payloads whose
+// key is no longer available are replaced by RedactedPayload and counted, otherwise ErrPayloadKeyNotFound is returned.
+func decodeHistoryEventPayloads(
+	codec PayloadCodec,
+	events []*types.HistoryEvent,
+	redact bool,
+) (int, error) {
+	redacted := 0
+	for _, event := range events {
+		if event == nil {
+			continue
+		}
+		if err := transformHistoryEventPayloads(event, false, func(payload []byte) ([]byte, error) {
+			return decodeOrRedact(codec, payload, redact, &redacted)
+		}); err != nil {
+			return redacted, err
+		}
+	}
+	return redacted, nil
+}
+
+// EncodeEventsAtRest returns a copy of the events stored outside of the history, e.g. in mutable state or in archives, with their
+// payloads escaped and encoded by the codec if it is configured. The input events are never modified.
+func EncodeEventsAtRest(
+	codec PayloadCodec,
+	domainName string,
+	events []*types.HistoryEvent,
+) ([]*types.HistoryEvent, error) {
+	events, _ = escapeHistoryEventPayloads(events)
+	if codec == nil {
+		return events, nil
+	}
+	return encodeHistoryEventPayloads(codec, domainName, events)
+}
+
+// DecodeEventsAtRest decodes the payloads of events encoded by EncodeEventsAtRest in place, payloads whose key
+// is no longer available are redacted and counted
+func DecodeEventsAtRest(
+	codec PayloadCodec,
+	events []*types.HistoryEvent,
+) (int, error) {
+	redacted := 0
+	if codec != nil {
+		var err error
+		if redacted, err = decodeHistoryEventPayloads(codec, events, true); err != nil {
+			return redacted, err
+		}
+	}
+	unescapeHistoryEventPayloads(events)
+	return redacted, nil
+}
+
+// encodePayloadAtRest escapes a payload stored outside of the history and encodes it with the codec if it is configured
+func encodePayloadAtRest(
+	codec PayloadCodec,
+	domainName string,
+	payload []byte,
+) ([]byte, error) {
+	payload = escapePayload(payload)
+	if codec == nil || len(payload) == 0 {
+		return payload, nil
+	}
+	return codec.Encode(domainName, payload)
+}
+
+// decodePayloadAtRest decodes a payload encoded by encodePayloadAtRest, the payload is redacted and counted
+// if its key is no longer available
+func decodePayloadAtRest(
+	codec PayloadCodec,
+	payload []byte,
+	redacted *int,
+) ([]byte, error) {
+	if codec != nil && len(payload) > 0 {
+		var err error
+		if payload, err = decodeOrRedact(codec, payload, true, redacted); err != nil {
+			return nil, err
+		}
+	}
+	return unescapePayload(payload), nil
+}
+
+// decodeOrRedact decodes the payload with the codec, if redact is true a payload whose key is no longer available
+// is replaced by RedactedPayload and counted
+func decodeOrRedact(codec PayloadCodec, payload []byte, redact bool, redacted *int) ([]byte, error) {
+	decoded, err := codec.Decode(payload)
+	if redact && errors.Is(err, ErrPayloadKeyNotFound) {
+		*redacted++
+		return RedactedPayload, nil
+	}
+	return decoded, err
+}
+
+// historyEventPayloadsAreCurrent returns true if none of the payloads of the events would change if they were encoded again
+func historyEventPayloadsAreCurrent(
+	codec PayloadCodec,
+	domainName string,
+	events []*types.HistoryEvent,
+) bool {
+	current := true
+	for _, event := range events {
+		if event == nil {
+			continue
+		}
+		eventCopy := *event
+		// the transformation is only used to visit the payloads
+		_ = transformHistoryEventPayloads(&eventCopy, true, func(payload []byte) ([]byte, error) {
+			current = current && codec.IsCurrent(domainName, payload)
+			return payload, nil
+		})
+	}
+	return current
+}
+
+// transformHistoryEventPayloads applies fn to every non-empty payload of the event. If copyAttributes is true
+// the attributes of the event are copied before they are modified, so that the event may be a shallow copy.
+func transformHistoryEventPayloads(
+	event *types.HistoryEvent,
+	copyAttributes bool,
+	fn func([]byte) ([]byte, error),
+) error {
+	var payloads []*[]byte
+	var memo **types.Memo
+	switch {
+	case event.WorkflowExecutionStartedEventAttributes != nil:
+		attr := cloneAttributes(&event.WorkflowExecutionStartedEventAttributes, copyAttributes)
+		payloads = []*[]byte{&attr.Input, &attr.ContinuedFailureDetails, &attr.LastCompletionResult}
+		memo = &attr.Memo
+	case event.WorkflowExecutionCompletedEventAttributes != nil:
+		attr := cloneAttributes(&event.WorkflowExecutionCompletedEventAttributes, copyAttributes)
+		payloads = []*[]byte{&attr.Result}
+	case event.WorkflowExecutionFailedEventAttributes != nil:
+		attr := cloneAttributes(&event.WorkflowExecutionFailedEventAttributes, copyAttributes)
+		payloads = []*[]byte{&attr.Details}
+	case event.WorkflowExecutionCanceledEventAttributes != nil:
+		attr := cloneAttributes(&event.WorkflowExecutionCanceledEventAttributes, copyAttributes)
+		payloads = []*[]byte{&attr.Details}
+	case event.WorkflowExecutionTerminatedEventAttributes != nil:
+		attr := cloneAttributes(&event.WorkflowExecutionTerminatedEventAttributes, copyAttributes)
+		payloads = []*[]byte{&attr.Details}
+	case event.WorkflowExecutionContinuedAsNewEventAttributes != nil:
+		attr := cloneAttributes(&event.WorkflowExecutionContinuedAsNewEventAttributes, copyAttributes)
+		payloads = []*[]byte{&attr.Input, &attr.FailureDetails, &attr.LastCompletionResult}
+		memo = &attr.Memo
+	case event.WorkflowExecutionSignaledEventAttributes != nil:
+		attr := cloneAttributes(&event.WorkflowExecutionSignaledEventAttributes, copyAttributes)
+		payloads = []*[]byte{&attr.Input}
+	case event.DecisionTaskFailedEventAttributes != nil:
+		attr := cloneAttributes(&event.DecisionTaskFailedEventAttributes, copyAttributes)
+		payloads = []*[]byte{&attr.Details}
+	case event.ActivityTaskScheduledEventAttributes != nil:
+		attr := cloneAttributes(&event.ActivityTaskScheduledEventAttributes, copyAttributes)
+		payloads = []*[]byte{&attr.Input}
+	case event.ActivityTaskStartedEventAttributes != nil:
+		attr := cloneAttributes(&event.ActivityTaskStartedEventAttributes, copyAttributes)
+		payloads = []*[]byte{&attr.LastFailureDetails}
+	case event.ActivityTaskCompletedEventAttributes != nil:
+		attr := cloneAttributes(&event.ActivityTaskCompletedEventAttributes, copyAttributes)
+		payloads = []*[]byte{&attr.Result}
+	case event.ActivityTaskFailedEventAttributes != nil:
+		attr := cloneAttributes(&event.ActivityTaskFailedEventAttributes, copyAttributes)
+		payloads = []*[]byte{&attr.Details}
+	case event.ActivityTaskTimedOutEventAttributes != nil:
+		attr := cloneAttributes(&event.ActivityTaskTimedOutEventAttributes, copyAttributes)
+		payloads = []*[]byte{&attr.Details, &attr.LastFailureDetails}
+	case event.ActivityTaskCanceledEventAttributes != nil:
+		attr := cloneAttributes(&event.ActivityTaskCanceledEventAttributes, copyAttributes)
+		payloads = []*[]byte{&attr.Details}
+	case event.MarkerRecordedEventAttributes != nil:
+		attr := cloneAttributes(&event.MarkerRecordedEventAttributes, copyAttributes)
+		payloads = []*[]byte{&attr.Details}
+	case event.SignalExternalWorkflowExecutionInitiatedEventAttributes != nil:
+		attr := cloneAttributes(&event.SignalExternalWorkflowExecutionInitiatedEventAttributes, copyAttributes)
+		payloads = []*[]byte{&attr.Input}
+	case event.StartChildWorkflowExecutionInitiatedEventAttributes != nil:
+		attr := cloneAttributes(&event.StartChildWorkflowExecutionInitiatedEventAttributes, copyAttributes)
+		payloads = []*[]byte{&attr.Input}
+		memo = &attr.Memo
+	case event.ChildWorkflowExecutionCompletedEventAttributes != nil:
+		attr := cloneAttributes(&event.ChildWorkflowExecutionCompletedEventAttributes, copyAttributes)
+		payloads = []*[]byte{&attr.Result}
+	case event.ChildWorkflowExecutionFailedEventAttributes != nil:
+		attr := cloneAttributes(&event.ChildWorkflowExecutionFailedEventAttributes, copyAttributes)
+		payloads = []*[]byte{&attr.Details}
+	case event.ChildWorkflowExecutionCanceledEventAttributes != nil:
+		attr := cloneAttributes(&event.ChildWorkflowExecutionCanceledEventAttributes, copyAttributes)
+		payloads = []*[]byte{&attr.Details}
+	}
+
+	for _, payload := range payloads {
+		if len(*payload) == 0 {
+			continue
+		}
+		transformed, err := fn(*payload)
+		if err != nil {
+			return err
+		}
+		*payload = transformed
+	}
+
+	if memo == nil || *memo == nil || len((*memo).Fields) == 0 {
+		return nil
+	}
+	fields, err := transformMemoFields((*memo).Fields, fn)
+	if err != nil {
+		return err
+	}
+	*memo = &types.Memo{Fields: fields}
+	return nil
+}
+
+// encodeMemoFields returns a copy of the memo fields of the domain with their values escaped and encoded
+// by the codec if it is configured
+func encodeMemoFields(
+	codec PayloadCodec,
+	domainName string,
+	fields map[string][]byte,
+) (map[string][]byte, error) {
+	return transformMemoFields(fields, func(payload []byte) ([]byte, error) {
+		return encodePayloadAtRest(codec, domainName, payload)
+	})
+}
+
+// decodeMemoFields returns a copy of the memo fields with their values decoded by encodeMemoFields,
+// the values whose key is no longer available are redacted and counted
+func decodeMemoFields(
+	codec PayloadCodec,
+	fields map[string][]byte,
+) (map[string][]byte, int, error) {
+	redacted := 0
+	fields, err := transformMemoFields(fields, func(payload []byte) ([]byte, error) {
+		return decodePayloadAtRest(codec, payload, &redacted)
+	})
+	return fields, redacted, err
+}
+
+// transformMemoFields returns a copy of the memo fields with fn applied to every non-empty value,
+// the fields are copied since the memo of a workflow is shared with its mutable state
+func transformMemoFields(
+	fields map[string][]byte,
+	fn func([]byte) ([]byte, error),
+) (map[string][]byte, error) {
+	if len(fields) == 0 {
+		return fields, nil
+	}
+	transformed := make(map[string][]byte, len(fields))
+	for key, value := range fields {
+		if len(value) == 0 {
+			transformed[key] = value
+			continue
+		}
+		transformedValue, err := fn(value)
+		if err != nil {
+			return nil, err
+		}
+		transformed[key] = transformedValue
+	}
+	return transformed, nil
+}
+
+// cloneAttributes replaces the attributes with a shallow copy if clone is true and returns the attributes
+func cloneAttributes[T any](attributes **T, clone bool) *T {
+	if clone {
+		attributesCopy := **attributes
+		*attributes = &attributesCopy
+	}
+	return *attributes
+}
@@ -0,0 +1,190 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/types"
+)
+
+var (
+	testPayloadPrefix     = []byte("enc|")
+	testLostPayloadPrefix = []byte("lost|")
+)
+
+// testPayloadCodec prefixes payloads, payloads with testLostPayloadPrefix behave as if their key was removed
+type testPayloadCodec struct{}
+
+func (testPayloadCodec) Encode(domainName string, payload []byte) ([]byte, error) {
+	if bytes.HasPrefix(payload, testPayloadPrefix) {
+		return payload, nil
+	}
+	return append(append([]byte{}, testPayloadPrefix...), payload...), nil
+}
+
+func (testPayloadCodec) Decode(payload []byte) ([]byte, error) {
+	if bytes.HasPrefix(payload, testLostPayloadPrefix) {
+		return nil, ErrPayloadKeyNotFound
+	}
+	return bytes.TrimPrefix(payload, testPayloadPrefix), nil
+}
+
+func (testPayloadCodec) IsCurrent(domainName string, payload []byte) bool {
+	return bytes.HasPrefix(payload, testPayloadPrefix)
+}
+
+func newTestPayloadEvents() []*types.HistoryEvent {
+	return []*types.HistoryEvent{
+		{
+			ID: 1,
+			WorkflowExecutionStartedEventAttributes: &types.WorkflowExecutionStartedEventAttributes{
+				Input: []byte("input"),
+				Memo:  &types.Memo{Fields: map[string][]byte{"key": []byte("memo")}},
+			},
+		},
+		{
+			ID:                                   2,
+			DecisionTaskScheduledEventAttributes: &types.DecisionTaskScheduledEventAttributes{},
+		},
+		{
+			ID: 3,
+			ActivityTaskCompletedEventAttributes: &types.ActivityTaskCompletedEventAttributes{
+				Result: []byte("result"),
+			},
+		},
+		{
+			ID: 4,
+			MarkerRecordedEventAttributes: &types.MarkerRecordedEventAttributes{
+				MarkerName: "marker",
+				Details:    []byte("details"),
+			},
+		},
+	}
+}
+
+func TestEncodeHistoryEventPayloads(t *testing.T) {
+	events := newTestPayloadEvents()
+
+	encoded, err := encodeHistoryEventPayloads(testPayloadCodec{}, "domain", events)
+	require.NoError(t, err)
+	require.Len(t, encoded, len(events))
+	assert.Equal(t, []byte("enc|input"), encoded[0].WorkflowExecutionStartedEventAttributes.Input)
+	assert.Equal(t, []byte("enc|memo"), encoded[0].WorkflowExecutionStartedEventAttributes.Memo.Fields["key"])
+	assert.Equal(t, events[1].DecisionTaskScheduledEventAttributes, encoded[1].DecisionTaskScheduledEventAttributes)
+	assert.Equal(t, []byte("enc|result"), encoded[2].ActivityTaskCompletedEventAttributes.Result)
+	assert.Equal(t, "marker", encoded[3].MarkerRecordedEventAttributes.MarkerName)
+	assert.Equal(t, []byte("enc|details"), encoded[3].MarkerRecordedEventAttributes.Details)
+	assert.True(t, historyEventPayloadsAreCurrent(testPayloadCodec{}, "domain", encoded))
+
+	// the input events are still used after they are persisted, so they must not be modified
+	assert.Equal(t, newTestPayloadEvents(), events)
+	assert.False(t, historyEventPayloadsAreCurrent(testPayloadCodec{}, "domain", events))
+}
+
+func TestDecodeHistoryEventPayloads(t *testing.T) {
+	events, err := encodeHistoryEventPayloads(testPayloadCodec{}, "domain", newTestPayloadEvents())
+	require.NoError(t, err)
+
+	redacted, err := decodeHistoryEventPayloads(testPayloadCodec{}, events, false)
+	require.NoError(t, err)
+	assert.Zero(t, redacted)
+	assert.Equal(t, newTestPayloadEvents(), events)
+}
+
+func TestDecodeHistoryEventPayloads_KeyNotFound(t *testing.T) {
+	newEvents := func() []*types.HistoryEvent {
+		events := newTestPayloadEvents()
+		events[0].WorkflowExecutionStartedEventAttributes.Memo.Fields["key"] = []byte("lost|memo")
+		events[2].ActivityTaskCompletedEventAttributes.Result = []byte("lost|result")
+		return events
+	}
+
+	events := newEvents()
+	redacted, err := decodeHistoryEventPayloads(testPayloadCodec{}, events, true)
+	require.NoError(t, err)
+	assert.Equal(t, 2, redacted)
+	assert.Equal(t, []byte("input"), events[0].WorkflowExecutionStartedEventAttributes.Input)
+	assert.Equal(t, RedactedPayload, events[0].WorkflowExecutionStartedEventAttributes.Memo.Fields["key"])
+	assert.Equal(t, RedactedPayload, events[2].ActivityTaskCompletedEventAttributes.Result)
+	assert.Equal(t, []byte("details"), events[3].MarkerRecordedEventAttributes.Details)
+
+	_, err = decodeHistoryEventPayloads(testPayloadCodec{}, newEvents(), false)
+	assert.True(t, errors.Is(err, ErrPayloadKeyNotFound))
+}
+
+func TestEncodePayloadAtRest(t *testing.T) {
+	for name, codec := range map[string]PayloadCodec{"codec": testPayloadCodec{}, "no codec": nil} {
+		t.Run(name, func(t *testing.T) {
+			for _, payload := range [][]byte{nil, []byte("payload"), []byte("\x00\xcaENpayload")} {
+				encoded, err := encodePayloadAtRest(codec, "domain", payload)
+				require.NoError(t, err)
+				redacted := 0
+				decoded, err := decodePayloadAtRest(codec, encoded, &redacted)
+				require.NoError(t, err)
+				assert.Equal(t, payload, decoded)
+				assert.Zero(t, redacted)
+			}
+		})
+	}
+
+	redacted := 0
+	decoded, err := decodePayloadAtRest(testPayloadCodec{}, []byte("lost|payload"), &redacted)
+	require.NoError(t, err)
+	assert.Equal(t, RedactedPayload, decoded)
+	assert.Equal(t, 1, redacted)
+}
+
+func TestEscapeHistoryEventPayloads(t *testing.T) {
+	events := newTestPayloadEvents()
+	escaped, ok := escapeHistoryEventPayloads(events)
+	assert.False(t, ok)
+	assert.Equal(t, events, escaped)
+
+	// user payloads which look like an offloaded payload reference or an envelope must not be read as one
+	events[2].ActivityTaskCompletedEventAttributes.Result = []byte("\x00\xcaBRhistory-payloads/1/tree-id/branch-id/1/1/0")
+	events[3].MarkerRecordedEventAttributes.Details = []byte("\x00\xcaENdetails")
+	escaped, ok = escapeHistoryEventPayloads(events)
+	require.True(t, ok)
+	assert.Equal(t, []byte("input"), escaped[0].WorkflowExecutionStartedEventAttributes.Input)
+	assert.Equal(t, []byte("\x00\xcaES\x00\xcaBRhistory-payloads/1/tree-id/branch-id/1/1/0"), escaped[2].ActivityTaskCompletedEventAttributes.Result)
+	assert.Equal(t, []byte("\x00\xcaES\x00\xcaENdetails"), escaped[3].MarkerRecordedEventAttributes.Details)
+	assert.False(t, referencesOffloadedPayloads(escaped))
+
+	blob, err := NewPayloadSerializer().SerializeBatchEvents(escaped, constants.EncodingTypeThriftRW)
+	require.NoError(t, err)
+	assert.True(t, mayHoldEscapedPayloads(blob))
+	blob, err = NewPayloadSerializer().SerializeBatchEvents(newTestPayloadEvents(), constants.EncodingTypeThriftRW)
+	require.NoError(t, err)
+	assert.False(t, mayHoldEscapedPayloads(blob))
+
+	// the input events are still used after they are persisted, so they must not be modified
+	assert.Equal(t, []byte("\x00\xcaENdetails"), events[3].MarkerRecordedEventAttributes.Details)
+
+	unescapeHistoryEventPayloads(escaped)
+	assert.Equal(t, events, escaped)
+}
@@ -0,0 +1,276 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/uber/cadence/common/blobstore"
+	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/types"
+)
+
+// offloadedPayloadMagic prefixes the payloads which were replaced by a reference to a blob, the rest of the payload is the blob key
+var offloadedPayloadMagic = []byte("\x00\xcaBR")
+
+// errPayloadOffloadingNotConfigured is returned when an offloaded payload is read without a blobstore
+var errPayloadOffloadingNotConfigured = errors.New("history event payload is stored in the blobstore, but no blobstore is configured")
+
+// offloadedPayloadKeyRoot prefixes the keys of all the blobs offloaded from history nodes
+const offloadedPayloadKeyRoot = "history-payloads/"
+
+// offloadedPayloadNode identifies the history node which offloaded a payload
+type offloadedPayloadNode struct {
+	shardID       int
+	treeID        string
+	branchID      string
+	nodeID        int64
+	transactionID int64
+}
+
+// owns returns true if the blob with the given key was offloaded by the node, by any of its transactions
+func (n offloadedPayloadNode) owns(key string) bool {
+	node, err := parseOffloadedPayloadKey(key)
+	return err == nil &&
+		node.shardID == n.shardID &&
+		node.treeID == n.treeID &&
+		node.branchID == n.branchID &&
+		node.nodeID == n.nodeID
+}
+
+// offloadedPayloadKeyPrefix returns the prefix of the keys of the blobs offloaded from a history node,
+// the keys are deterministic so that a retried append overwrites the blobs of the previous attempt
+func offloadedPayloadKeyPrefix(shardID int, treeID, branchID string, nodeID, transactionID int64) string {
+	return fmt.Sprintf("%s%d/%s/%s/%d/%d", offloadedPayloadKeyRoot, shardID, treeID, branchID, nodeID, transactionID)
+}
+
+// parseOffloadedPayloadKey returns the history node which offloaded the blob with the given key
+func parseOffloadedPayloadKey(key string) (*offloadedPayloadNode, error) {
+	parts := strings.Split(strings.TrimPrefix(key, offloadedPayloadKeyRoot), "/")
+	if !strings.HasPrefix(key, offloadedPayloadKeyRoot) || len(parts) != 6 {
+		return nil, fmt.Errorf("invalid offloaded history event payload key %q", key)
+	}
+	shardID, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return nil, fmt.Errorf("invalid shard ID in offloaded history event payload key %q: %w", key, err)
+	}
+	nodeID, err := strconv.ParseInt(parts[3], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid node ID in offloaded history event payload key %q: %w", key, err)
+	}
+	transactionID, err := strconv.ParseInt(parts[4], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid transaction ID in offloaded history event payload key %q: %w", key, err)
+	}
+	return &offloadedPayloadNode{
+		shardID:       shardID,
+		treeID:        parts[1],
+		branchID:      parts[2],
+		nodeID:        nodeID,
+		transactionID: transactionID,
+	}, nil
+}
+
+// offloadHistoryEventPayloads returns a copy of the events with the payloads larger than threshold stored in the blobstore
+// and replaced by a reference to their blob. The input events are never modified. The returned bool is false if no payload
+// was offloaded, in which case the input events are returned.
+func offloadHistoryEventPayloads(
+	ctx context.Context,
+	client blobstore.Client,
+	threshold int,
+	keyPrefix string,
+	events []*types.HistoryEvent,
+) ([]*types.HistoryEvent, bool, error) {
+	if client == nil || threshold <= 0 {
+		return events, false, nil
+	}
+	offloaded := 0
+	result := make([]*types.HistoryEvent, 0, len(events))
+	for _, event := range events {
+		if event == nil {
+			result = append(result, event)
+			continue
+		}
+		eventCopy := *event
+		if err := transformHistoryEventPayloads(&eventCopy, true, func(payload []byte) ([]byte, error) {
+			// payloads starting with the offloaded payload magic are escaped before they get here
+			if len(payload) <= threshold {
+				return payload, nil
+			}
+			key := fmt.Sprintf("%s/%d", keyPrefix, offloaded)
+			if _, err := client.Put(ctx, &blobstore.PutRequest{
+				Key:  key,
+				Blob: blobstore.Blob{Body: payload},
+			}); err != nil {
+				return nil, fmt.Errorf("failed to offload history event payload %q: %w", key, err)
+			}
+			offloaded++
+			return append(append([]byte{}, offloadedPayloadMagic...), key...), nil
+		}); err != nil {
+			return nil, false, err
+		}
+		result = append(result, &eventCopy)
+	}
+	if offloaded == 0 {
+		return events, false, nil
+	}
+	return result, true, nil
+}
+
+// loadHistoryEventPayloads replaces the references to the payloads offloaded by the node with the payloads in place.
+// References to blobs of other nodes can't have been written by the node, they are payloads which were persisted as is
+// before payloads starting with the offloaded payload magic were escaped, and are left untouched.
+func loadHistoryEventPayloads(
+	ctx context.Context,
+	client blobstore.Client,
+	node offloadedPayloadNode,
+	events []*types.HistoryEvent,
+) error {
+	for _, event := range events {
+		if event == nil {
+			continue
+		}
+		if err := transformHistoryEventPayloads(event, false, func(payload []byte) ([]byte, error) {
+			key, ok := offloadedPayloadKey(node, payload)
+			if !ok {
+				return payload, nil
+			}
+			if client == nil {
+				return nil, errPayloadOffloadingNotConfigured
+			}
+			resp, err := client.Get(ctx, &blobstore.GetRequest{Key: key})
+			if err != nil {
+				return nil, fmt.Errorf("failed to load offloaded history event payload %q: %w", key, err)
+			}
+			return resp.Blob.Body, nil
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// referencesOffloadedPayloads returns true if any payload of the events looks like a reference to an offloaded payload
+func referencesOffloadedPayloads(events []*types.HistoryEvent) bool {
+	references := false
+	for _, event := range events {
+		if event == nil {
+			continue
+		}
+		eventCopy := *event
+		// the transformation is only used to visit the payloads
+		_ = transformHistoryEventPayloads(&eventCopy, true, func(payload []byte) ([]byte, error) {
+			references = references || bytes.HasPrefix(payload, offloadedPayloadMagic)
+			return payload, nil
+		})
+	}
+	return references
+}
+
+// offloadedHistoryEventPayloadKeys returns the blob keys of the payloads of the events offloaded by the node
+func offloadedHistoryEventPayloadKeys(node offloadedPayloadNode, events []*types.HistoryEvent) []string {
+	var keys []string
+	for _, event := range events {
+		if event == nil {
+			continue
+		}
+		eventCopy := *event
+		// the transformation is only used to visit the payloads
+		_ = transformHistoryEventPayloads(&eventCopy, true, func(payload []byte) ([]byte, error) {
+			if key, ok := offloadedPayloadKey(node, payload); ok {
+				keys = append(keys, key)
+			}
+			return payload, nil
+		})
+	}
+	return keys
+}
+
+// supersededPayloadKeys returns the keys which are not referenced by the events of the node anymore
+func supersededPayloadKeys(node offloadedPayloadNode, keys []string, events []*types.HistoryEvent) []string {
+	referenced := make(map[string]struct{})
+	for _, key := range offloadedHistoryEventPayloadKeys(node, events) {
+		referenced[key] = struct{}{}
+	}
+	var superseded []string
+	for _, key := range keys {
+		if _, ok := referenced[key]; !ok {
+			superseded = append(superseded, key)
+		}
+	}
+	return superseded
+}
+
+// deleteOffloadedPayloads deletes the blobs of offloaded payloads, blobs which don't exist anymore are skipped
+// so that an interrupted deletion can be retried. Only the keys of offloaded payloads are ever deleted.
+func deleteOffloadedPayloads(
+	ctx context.Context,
+	client blobstore.Client,
+	keys []string,
+) error {
+	for _, key := range keys {
+		if _, err := parseOffloadedPayloadKey(key); err != nil {
+			return err
+		}
+		existsResp, err := client.Exists(ctx, &blobstore.ExistsRequest{Key: key})
+		if err != nil {
+			return fmt.Errorf("failed to check offloaded history event payload %q: %w", key, err)
+		}
+		if !existsResp.Exists {
+			continue
+		}
+		if _, err := client.Delete(ctx, &blobstore.DeleteRequest{Key: key}); err != nil {
+			return fmt.Errorf("failed to delete offloaded history event payload %q: %w", key, err)
+		}
+	}
+	return nil
+}
+
+// historyNodeIsReferenced returns true if any of the branches reads the node of the given branch
+func historyNodeIsReferenced(branches []*types.HistoryBranch, branchID string, nodeID int64) bool {
+	for _, branch := range branches {
+		beginNodeID := constants.FirstEventID
+		for _, ancestor := range branch.Ancestors {
+			if ancestor.BranchID == branchID && ancestor.BeginNodeID <= nodeID && nodeID < ancestor.EndNodeID {
+				return true
+			}
+			beginNodeID = ancestor.EndNodeID
+		}
+		if branch.BranchID == branchID && nodeID >= beginNodeID {
+			return true
+		}
+	}
+	return false
+}
+
+// offloadedPayloadKey returns the key of the blob the payload refers to, if the payload is a reference to a blob offloaded by the node
+func offloadedPayloadKey(node offloadedPayloadNode, payload []byte) (string, bool) {
+	if !bytes.HasPrefix(payload, offloadedPayloadMagic) {
+		return "", false
+	}
+	key := string(payload[len(offloadedPayloadMagic):])
+	return key, node.owns(key)
+}
@@ -0,0 +1,208 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/common/blobstore"
+	"github.com/uber/cadence/common/types"
+)
+
+// testPayloadNode is the history node the test payloads are offloaded from
+var testPayloadNode = offloadedPayloadNode{shardID: 10, treeID: "tree-id", branchID: "branch-id", nodeID: 3}
+
+// testPayloadKeyPrefix is the key prefix of the test payloads offloaded by a transaction of testPayloadNode
+var testPayloadKeyPrefix = offloadedPayloadKeyPrefix(10, "tree-id", "branch-id", 3, 1)
+
+func TestOffloadHistoryEventPayloads(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := blobstore.NewMockClient(ctrl)
+	events := newTestPayloadEvents()
+
+	client.EXPECT().Put(gomock.Any(), &blobstore.PutRequest{
+		Key:  testPayloadKeyPrefix + "/0",
+		Blob: blobstore.Blob{Body: []byte("result")},
+	}).Return(&blobstore.PutResponse{}, nil)
+	client.EXPECT().Put(gomock.Any(), &blobstore.PutRequest{
+		Key:  testPayloadKeyPrefix + "/1",
+		Blob: blobstore.Blob{Body: []byte("details")},
+	}).Return(&blobstore.PutResponse{}, nil)
+
+	offloaded, ok, err := offloadHistoryEventPayloads(context.Background(), client, 5, testPayloadKeyPrefix, events)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, []byte("input"), offloaded[0].WorkflowExecutionStartedEventAttributes.Input)
+	assert.Equal(t, []byte("memo"), offloaded[0].WorkflowExecutionStartedEventAttributes.Memo.Fields["key"])
+	assert.Equal(t, []byte("\x00\xcaBR"+testPayloadKeyPrefix+"/0"), offloaded[2].ActivityTaskCompletedEventAttributes.Result)
+	assert.Equal(t, []byte("\x00\xcaBR"+testPayloadKeyPrefix+"/1"), offloaded[3].MarkerRecordedEventAttributes.Details)
+	assert.Equal(t, []string{testPayloadKeyPrefix + "/0", testPayloadKeyPrefix + "/1"}, offloadedHistoryEventPayloadKeys(testPayloadNode, offloaded))
+	assert.True(t, referencesOffloadedPayloads(offloaded))
+
+	// the input events are still used after they are persisted, so they must not be modified
+	assert.Equal(t, newTestPayloadEvents(), events)
+	assert.Empty(t, offloadedHistoryEventPayloadKeys(testPayloadNode, events))
+	assert.False(t, referencesOffloadedPayloads(events))
+
+	// blobs of other nodes are never reported as blobs of the node
+	otherNode := testPayloadNode
+	otherNode.branchID = "other-branch-id"
+	assert.Empty(t, offloadedHistoryEventPayloadKeys(otherNode, offloaded))
+}
+
+func TestOffloadHistoryEventPayloads_NotOffloaded(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := blobstore.NewMockClient(ctrl)
+	events := newTestPayloadEvents()
+
+	for name, threshold := range map[string]int{"disabled": 0, "below threshold": 1024} {
+		t.Run(name, func(t *testing.T) {
+			offloaded, ok, err := offloadHistoryEventPayloads(context.Background(), client, threshold, testPayloadKeyPrefix, events)
+			require.NoError(t, err)
+			assert.False(t, ok)
+			assert.Equal(t, events, offloaded)
+		})
+	}
+}
+
+func TestOffloadHistoryEventPayloads_PutError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := blobstore.NewMockClient(ctrl)
+	client.EXPECT().Put(gomock.Any(), gomock.Any()).Return(nil, errors.New("put error"))
+
+	_, _, err := offloadHistoryEventPayloads(context.Background(), client, 5, testPayloadKeyPrefix, newTestPayloadEvents())
+	assert.ErrorContains(t, err, "put error")
+}
+
+func TestLoadHistoryEventPayloads(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := blobstore.NewMockClient(ctrl)
+	newEvents := func() []*types.HistoryEvent {
+		events := newTestPayloadEvents()
+		events[2].ActivityTaskCompletedEventAttributes.Result = []byte("\x00\xcaBR" + testPayloadKeyPrefix + "/0")
+		return events
+	}
+
+	client.EXPECT().Get(gomock.Any(), &blobstore.GetRequest{Key: testPayloadKeyPrefix + "/0"}).
+		Return(&blobstore.GetResponse{Blob: blobstore.Blob{Body: []byte("result")}}, nil)
+	events := newEvents()
+	require.NoError(t, loadHistoryEventPayloads(context.Background(), client, testPayloadNode, events))
+	assert.Equal(t, newTestPayloadEvents(), events)
+
+	client.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, errors.New("get error"))
+	assert.ErrorContains(t, loadHistoryEventPayloads(context.Background(), client, testPayloadNode, newEvents()), "get error")
+
+	assert.Equal(t, errPayloadOffloadingNotConfigured, loadHistoryEventPayloads(context.Background(), nil, testPayloadNode, newEvents()))
+	assert.NoError(t, loadHistoryEventPayloads(context.Background(), nil, testPayloadNode, newTestPayloadEvents()))
+}
+
+func TestLoadHistoryEventPayloads_NotOwned(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	// the mock fails the test if any blob is read
+	client := blobstore.NewMockClient(ctrl)
+
+	for name, payload := range map[string]string{
+		"other shard":      offloadedPayloadKeyPrefix(11, "tree-id", "branch-id", 3, 1) + "/0",
+		"other tree":       offloadedPayloadKeyPrefix(10, "other-tree-id", "branch-id", 3, 1) + "/0",
+		"other branch":     offloadedPayloadKeyPrefix(10, "tree-id", "other-branch-id", 3, 1) + "/0",
+		"other node":       offloadedPayloadKeyPrefix(10, "tree-id", "branch-id", 4, 1) + "/0",
+		"not an offloaded": "some/other/key",
+	} {
+		t.Run(name, func(t *testing.T) {
+			events := newTestPayloadEvents()
+			events[2].ActivityTaskCompletedEventAttributes.Result = []byte("\x00\xcaBR" + payload)
+			require.NoError(t, loadHistoryEventPayloads(context.Background(), client, testPayloadNode, events))
+			assert.Equal(t, []byte("\x00\xcaBR"+payload), events[2].ActivityTaskCompletedEventAttributes.Result)
+		})
+	}
+}
+
+func TestDeleteOffloadedPayloads(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := blobstore.NewMockClient(ctrl)
+
+	client.EXPECT().Exists(gomock.Any(), &blobstore.ExistsRequest{Key: testPayloadKeyPrefix + "/0"}).Return(&blobstore.ExistsResponse{Exists: true}, nil)
+	client.EXPECT().Delete(gomock.Any(), &blobstore.DeleteRequest{Key: testPayloadKeyPrefix + "/0"}).Return(&blobstore.DeleteResponse{}, nil)
+	// blobs deleted by a previous attempt are skipped
+	client.EXPECT().Exists(gomock.Any(), &blobstore.ExistsRequest{Key: testPayloadKeyPrefix + "/1"}).Return(&blobstore.ExistsResponse{Exists: false}, nil)
+
+	assert.NoError(t, deleteOffloadedPayloads(context.Background(), client, []string{testPayloadKeyPrefix + "/0", testPayloadKeyPrefix + "/1"}))
+
+	// keys which are not offloaded payload keys are never deleted
+	assert.Error(t, deleteOffloadedPayloads(context.Background(), client, []string{"some/other/key"}))
+}
+
+func TestParseOffloadedPayloadKey(t *testing.T) {
+	node, err := parseOffloadedPayloadKey(offloadedPayloadKeyPrefix(10, "tree-id", "branch-id", 3, 1234) + "/0")
+	require.NoError(t, err)
+	assert.Equal(t, &offloadedPayloadNode{
+		shardID:       10,
+		treeID:        "tree-id",
+		branchID:      "branch-id",
+		nodeID:        3,
+		transactionID: 1234,
+	}, node)
+
+	for _, key := range []string{
+		"history-payloads/10/tree-id/branch-id/3/1234",
+		"history-payloads/shard/tree-id/branch-id/3/1234/0",
+		"history-payloads/10/tree-id/branch-id/node/1234/0",
+		"history-payloads/10/tree-id/branch-id/3/txn/0",
+		"other/10/tree-id/branch-id/3/1234/0",
+	} {
+		_, err := parseOffloadedPayloadKey(key)
+		assert.Error(t, err, key)
+	}
+}
+
+func TestSupersededPayloadKeys(t *testing.T) {
+	events := []*types.HistoryEvent{newOffloadedPayloadTestEvent(1, testPayloadKeyPrefix+"/0")}
+	keys := []string{testPayloadKeyPrefix + "/0", testPayloadKeyPrefix + "/1"}
+
+	assert.Equal(t, []string{testPayloadKeyPrefix + "/1"}, supersededPayloadKeys(testPayloadNode, keys, events))
+	assert.Empty(t, supersededPayloadKeys(testPayloadNode, keys[:1], events))
+}
+
+func TestHistoryNodeIsReferenced(t *testing.T) {
+	branches := []*types.HistoryBranch{
+		{
+			BranchID: "fork",
+			Ancestors: []*types.HistoryBranchRange{
+				{BranchID: "root", BeginNodeID: 1, EndNodeID: 5},
+			},
+		},
+		{BranchID: "other"},
+	}
+
+	assert.True(t, historyNodeIsReferenced(branches, "root", 1))
+	assert.True(t, historyNodeIsReferenced(branches, "root", 4))
+	assert.False(t, historyNodeIsReferenced(branches, "root", 5))
+	assert.False(t, historyNodeIsReferenced(branches, "fork", 4))
+	assert.True(t, historyNodeIsReferenced(branches, "fork", 5))
+	assert.True(t, historyNodeIsReferenced(branches, "other", 1))
+	assert.False(t, historyNodeIsReferenced(nil, "root", 1))
+}
@@ -202,7 +202,7 @@ func (s *TestBase) Setup() {
 	cfg := s.PersistenceConfig
 	scope := tally.NewTestScope(service.History, make(map[string]string))
 	metricsClient := metrics.NewClient(scope, service.GetMetricsServiceIdx(service.History, s.Logger), metrics.MigrationConfig{})
-	factory := client.NewFactory(&cfg, nil, clusterName, metricsClient, s.Logger, &s.DynamicConfiguration)
+	factory := client.NewFactory(&cfg, nil, clusterName, metricsClient, s.Logger, &s.DynamicConfiguration, nil)
 	s.AdminDBs, err = factory.NewAdminDBs()
 	s.fatalOnError("NewAdminDBs ", err)
 
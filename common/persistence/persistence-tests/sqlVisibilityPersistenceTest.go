@@ -0,0 +1,454 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistencetests
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/pborman/uuid"
+
+	"github.com/uber/cadence/common/definition"
+	p "github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+)
+
+type (
+	// SQLVisibilityPersistenceSuite tests the visibility persistence of SQL databases,
+	// which also supports the query based APIs on top of DBVisibilityPersistenceSuite
+	SQLVisibilityPersistenceSuite struct {
+		DBVisibilityPersistenceSuite
+	}
+
+	sqlVisibilityTestRecord struct {
+		workflowType     string
+		closeStatus      *types.WorkflowExecutionCloseStatus
+		searchAttributes map[string]interface{}
+	}
+)
+
+// TestUpsertWorkflowExecution test
+func (s *SQLVisibilityPersistenceSuite) TestUpsertWorkflowExecution() {
+	ctx, cancel := context.WithTimeout(context.Background(), testContextTimeout)
+	defer cancel()
+
+	testDomainUUID := uuid.New()
+	workflowExecution := types.WorkflowExecution{
+		WorkflowID: "visibility-upsert-test",
+		RunID:      uuid.New(),
+	}
+	startTime := time.Now().Add(time.Second * -5).UnixNano()
+
+	err := s.VisibilityMgr.UpsertWorkflowExecution(ctx, &p.UpsertWorkflowExecutionRequest{
+		DomainUUID: testDomainUUID,
+		Execution:  workflowExecution,
+		SearchAttributes: map[string][]byte{
+			definition.CadenceChangeVersion: []byte("dummy"),
+		},
+	})
+	s.NoError(err)
+	s.assertQueryResult(testDomainUUID, "", 0)
+
+	err = s.VisibilityMgr.RecordWorkflowExecutionStarted(ctx, &p.RecordWorkflowExecutionStartedRequest{
+		DomainUUID:       testDomainUUID,
+		Execution:        workflowExecution,
+		WorkflowTypeName: "visibility-workflow",
+		StartTimestamp:   startTime,
+		SearchAttributes: s.encodeSearchAttributes(map[string]interface{}{definition.CustomKeywordField: "started"}),
+	})
+	s.NoError(err)
+	s.assertQueryResult(testDomainUUID, "`Attr.CustomKeywordField` = 'started'", 1)
+
+	err = s.VisibilityMgr.UpsertWorkflowExecution(ctx, &p.UpsertWorkflowExecutionRequest{
+		DomainUUID:       testDomainUUID,
+		Execution:        workflowExecution,
+		WorkflowTypeName: "visibility-workflow",
+		StartTimestamp:   startTime,
+		SearchAttributes: s.encodeSearchAttributes(map[string]interface{}{
+			definition.CustomKeywordField: "upserted",
+			definition.CustomIntField:     1,
+		}),
+	})
+	s.NoError(err)
+	s.assertQueryResult(testDomainUUID, "`Attr.CustomKeywordField` = 'started'", 0)
+	resp := s.assertQueryResult(testDomainUUID, "`Attr.CustomKeywordField` = 'upserted' and `Attr.CustomIntField` = 1", 1)
+	s.Equal(workflowExecution.RunID, resp.Executions[0].Execution.RunID)
+	s.Equal([]byte(`"upserted"`), resp.Executions[0].SearchAttributes.IndexedFields[definition.CustomKeywordField])
+	s.Equal([]byte(`1`), resp.Executions[0].SearchAttributes.IndexedFields[definition.CustomIntField])
+
+	err = s.VisibilityMgr.RecordWorkflowExecutionClosed(ctx, &p.RecordWorkflowExecutionClosedRequest{
+		DomainUUID:       testDomainUUID,
+		Execution:        workflowExecution,
+		WorkflowTypeName: "visibility-workflow",
+		StartTimestamp:   startTime,
+		Status:           types.WorkflowExecutionCloseStatusCompleted,
+		CloseTimestamp:   time.Now().UnixNano(),
+		HistoryLength:    3,
+		SearchAttributes: s.encodeSearchAttributes(map[string]interface{}{definition.CustomKeywordField: "closed"}),
+	})
+	s.NoError(err)
+
+	// a late upsert does not overwrite the closed record
+	err = s.VisibilityMgr.UpsertWorkflowExecution(ctx, &p.UpsertWorkflowExecutionRequest{
+		DomainUUID:       testDomainUUID,
+		Execution:        workflowExecution,
+		WorkflowTypeName: "visibility-workflow",
+		StartTimestamp:   startTime,
+		SearchAttributes: s.encodeSearchAttributes(map[string]interface{}{definition.CustomKeywordField: "upserted"}),
+	})
+	s.NoError(err)
+	s.assertQueryResult(testDomainUUID, "`Attr.CustomKeywordField` = 'upserted'", 0)
+	resp = s.assertQueryResult(testDomainUUID, "`Attr.CustomKeywordField` = 'closed' and CloseStatus = 'COMPLETED'", 1)
+	s.Equal(int64(3), resp.Executions[0].HistoryLength)
+}
+
+// TestListWorkflowExecutionsByQuery test
+func (s *SQLVisibilityPersistenceSuite) TestListWorkflowExecutionsByQuery() {
+	testDomainUUID := uuid.New()
+	baseTime := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	s.createSQLVisibilityTestRecords(testDomainUUID, baseTime)
+
+	tests := map[string]struct {
+		query    string
+		expected int
+	}{
+		"empty query":             {query: "", expected: 5},
+		"open workflows":          {query: "CloseTime = missing", expected: 3},
+		"closed workflows":        {query: "CloseTime != missing", expected: 2},
+		"system attribute":        {query: "WorkflowType = 'type-b'", expected: 2},
+		"close status name":       {query: "CloseStatus = 'FAILED'", expected: 1},
+		"close status value":      {query: "CloseStatus = 0", expected: 1},
+		"keyword attribute":       {query: "`Attr.CustomKeywordField` = 'keyword-1'", expected: 2},
+		"keyword attribute in":    {query: "`Attr.CustomKeywordField` in ('keyword-1', 'keyword-2')", expected: 3},
+		"keyword attribute like":  {query: "`Attr.CustomKeywordField` like 'keyword-%'", expected: 4},
+		"int attribute range":     {query: "`Attr.CustomIntField` > 1 and `Attr.CustomIntField` <= 4", expected: 3},
+		"int attribute between":   {query: "`Attr.CustomIntField` between 2 and 3", expected: 2},
+		"double attribute":        {query: "`Attr.CustomDoubleField` >= 2.5", expected: 3},
+		"bool attribute":          {query: "`Attr.CustomBoolField` = true", expected: 3},
+		"datetime attribute":      {query: fmt.Sprintf("`Attr.CustomDatetimeField` <= '%s'", baseTime.Add(3*time.Hour).Format(time.RFC3339)), expected: 3},
+		"start time":              {query: fmt.Sprintf("StartTime >= %d", baseTime.Add(2*time.Minute).UnixNano()), expected: 3},
+		"or with parentheses":     {query: "(WorkflowType = 'type-a' or `Attr.CustomBoolField` = false) and CloseTime = missing", expected: 2},
+		"missing attribute":       {query: "`Attr.CustomKeywordField` = missing", expected: 1},
+		"not existing value":      {query: "`Attr.CustomKeywordField` = 'not-existing'", expected: 0},
+		"order by only":           {query: "order by `Attr.CustomIntField` asc", expected: 5},
+		"condition with order by": {query: "CloseTime = missing order by StartTime asc", expected: 3},
+	}
+
+	for _, test := range tests {
+		s.assertQueryResult(testDomainUUID, test.query, test.expected)
+	}
+}
+
+// TestListWorkflowExecutionsByQuerySorting test
+func (s *SQLVisibilityPersistenceSuite) TestListWorkflowExecutionsByQuerySorting() {
+	testDomainUUID := uuid.New()
+	baseTime := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	s.createSQLVisibilityTestRecords(testDomainUUID, baseTime)
+
+	resp := s.assertQueryResult(testDomainUUID, "", 5)
+	for i := 1; i < len(resp.Executions); i++ {
+		s.True(resp.Executions[i-1].GetStartTime() >= resp.Executions[i].GetStartTime())
+	}
+
+	resp = s.assertQueryResult(testDomainUUID, "`Attr.CustomIntField` > 0 order by `Attr.CustomIntField` desc", 5)
+	for i, execution := range resp.Executions {
+		s.Equal([]byte(fmt.Sprint(5-i)), execution.SearchAttributes.IndexedFields[definition.CustomIntField])
+	}
+}
+
+// TestListWorkflowExecutionsByQueryPagination test
+func (s *SQLVisibilityPersistenceSuite) TestListWorkflowExecutionsByQueryPagination() {
+	ctx, cancel := context.WithTimeout(context.Background(), testContextTimeout)
+	defer cancel()
+
+	testDomainUUID := uuid.New()
+	baseTime := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	s.createSQLVisibilityTestRecords(testDomainUUID, baseTime)
+
+	for _, scan := range []bool{false, true} {
+		request := &p.ListWorkflowExecutionsByQueryRequest{
+			DomainUUID: testDomainUUID,
+			PageSize:   2,
+			Query:      "order by StartTime asc",
+		}
+		var runIDs []string
+		for {
+			var resp *p.ListWorkflowExecutionsResponse
+			var err error
+			if scan {
+				resp, err = s.VisibilityMgr.ScanWorkflowExecutions(ctx, request)
+			} else {
+				resp, err = s.VisibilityMgr.ListWorkflowExecutions(ctx, request)
+			}
+			s.NoError(err)
+			s.LessOrEqual(len(resp.Executions), 2)
+			for _, execution := range resp.Executions {
+				runIDs = append(runIDs, execution.Execution.RunID)
+			}
+			if len(resp.NextPageToken) == 0 {
+				break
+			}
+			request.NextPageToken = resp.NextPageToken
+		}
+		s.Len(runIDs, 5)
+		for i := 1; i < len(runIDs); i++ {
+			s.NotEqual(runIDs[i-1], runIDs[i])
+		}
+	}
+}
+
+// TestCountWorkflowExecutions test
+func (s *SQLVisibilityPersistenceSuite) TestCountWorkflowExecutions() {
+	ctx, cancel := context.WithTimeout(context.Background(), testContextTimeout)
+	defer cancel()
+
+	testDomainUUID := uuid.New()
+	baseTime := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	s.createSQLVisibilityTestRecords(testDomainUUID, baseTime)
+
+	tests := map[string]struct {
+		query    string
+		expected int64
+	}{
+		"empty query":       {query: "", expected: 5},
+		"open workflows":    {query: "CloseTime = missing", expected: 3},
+		"custom attributes": {query: "`Attr.CustomIntField` >= 2 and `Attr.CustomBoolField` = true", expected: 2},
+	}
+
+	for name, test := range tests {
+		resp, err := s.VisibilityMgr.CountWorkflowExecutions(ctx, &p.CountWorkflowExecutionsRequest{
+			DomainUUID: testDomainUUID,
+			Query:      test.query,
+		})
+		s.NoError(err, name)
+		s.Equal(test.expected, resp.Count, name)
+	}
+}
+
+// TestAggregateWorkflowExecutions test
+func (s *SQLVisibilityPersistenceSuite) TestAggregateWorkflowExecutions() {
+	ctx, cancel := context.WithTimeout(context.Background(), testContextTimeout)
+	defer cancel()
+
+	testDomainUUID := uuid.New()
+	baseTime := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	s.createSQLVisibilityTestRecords(testDomainUUID, baseTime)
+
+	tests := map[string]struct {
+		query     string
+		groupBy   string
+		maxGroups int
+		expected  []*types.WorkflowExecutionGroup
+	}{
+		"system attribute": {
+			groupBy:   definition.WorkflowType,
+			maxGroups: 10,
+			expected:  []*types.WorkflowExecutionGroup{{Key: "type-a", Count: 2}, {Key: "type-b", Count: 2}, {Key: "type-c", Count: 1}},
+		},
+		"max groups": {
+			groupBy:   definition.WorkflowType,
+			maxGroups: 1,
+			expected:  []*types.WorkflowExecutionGroup{{Key: "type-a", Count: 2}},
+		},
+		"close status skips open workflows": {
+			groupBy:   definition.CloseStatus,
+			maxGroups: 10,
+			expected:  []*types.WorkflowExecutionGroup{{Key: "0", Count: 1}, {Key: "1", Count: 1}},
+		},
+		"keyword attribute skips missing values": {
+			groupBy:   definition.CustomKeywordField,
+			maxGroups: 10,
+			expected:  []*types.WorkflowExecutionGroup{{Key: "keyword-1", Count: 2}, {Key: "keyword-2", Count: 1}, {Key: "keyword-3", Count: 1}},
+		},
+		"bool attribute with query": {
+			query:     "CloseTime = missing",
+			groupBy:   definition.CustomBoolField,
+			maxGroups: 10,
+			expected:  []*types.WorkflowExecutionGroup{{Key: "true", Count: 2}, {Key: "false", Count: 1}},
+		},
+		"int attribute with query": {
+			query:     "`Attr.CustomIntField` >= 4",
+			groupBy:   definition.CustomIntField,
+			maxGroups: 10,
+			expected:  []*types.WorkflowExecutionGroup{{Key: "4", Count: 1}, {Key: "5", Count: 1}},
+		},
+	}
+
+	for name, test := range tests {
+		resp, err := s.VisibilityMgr.AggregateWorkflowExecutions(ctx, &p.AggregateWorkflowExecutionsRequest{
+			DomainUUID: testDomainUUID,
+			Query:      test.query,
+			GroupBy:    test.groupBy,
+			MaxGroups:  test.maxGroups,
+		})
+		s.NoError(err, name)
+		s.Equal(test.expected, resp.Groups, name)
+	}
+
+	_, err := s.VisibilityMgr.AggregateWorkflowExecutions(ctx, &p.AggregateWorkflowExecutionsRequest{
+		DomainUUID: testDomainUUID,
+		GroupBy:    "UnknownField",
+		MaxGroups:  10,
+	})
+	s.IsType(&types.BadRequestError{}, err)
+}
+
+// TestInvalidQuery test
+func (s *SQLVisibilityPersistenceSuite) TestInvalidQuery() {
+	ctx, cancel := context.WithTimeout(context.Background(), testContextTimeout)
+	defer cancel()
+
+	for _, query := range []string{
+		"WorkflowType = ",
+		"`Attr.UnknownField` = 'value'",
+		"TaskList = 'tasklist'",
+		"`Attr.CustomIntField` = 'not a number'",
+		"`Attr.CustomIntField` like '1%'",
+		"order by RunID",
+		"order by `Attr.CustomStringField`",
+		"order by StartTime, `Attr.CustomIntField`",
+	} {
+		_, err := s.VisibilityMgr.ListWorkflowExecutions(ctx, &p.ListWorkflowExecutionsByQueryRequest{
+			DomainUUID: uuid.New(),
+			PageSize:   10,
+			Query:      query,
+		})
+		s.IsType(&types.BadRequestError{}, err, query)
+	}
+}
+
+// createSQLVisibilityTestRecords creates 3 open and 2 closed records, started a minute apart from baseTime
+func (s *SQLVisibilityPersistenceSuite) createSQLVisibilityTestRecords(domainID string, baseTime time.Time) {
+	ctx, cancel := context.WithTimeout(context.Background(), testContextTimeout)
+	defer cancel()
+
+	completed := types.WorkflowExecutionCloseStatusCompleted
+	failed := types.WorkflowExecutionCloseStatusFailed
+	records := []sqlVisibilityTestRecord{
+		{
+			workflowType: "type-a",
+			searchAttributes: map[string]interface{}{
+				definition.CustomKeywordField:  "keyword-1",
+				definition.CustomIntField:      1,
+				definition.CustomDoubleField:   1.5,
+				definition.CustomBoolField:     true,
+				definition.CustomDatetimeField: baseTime.Add(time.Hour).Format(time.RFC3339),
+			},
+		},
+		{
+			workflowType: "type-a",
+			searchAttributes: map[string]interface{}{
+				definition.CustomKeywordField:  "keyword-1",
+				definition.CustomIntField:      2,
+				definition.CustomDoubleField:   2.5,
+				definition.CustomBoolField:     false,
+				definition.CustomDatetimeField: baseTime.Add(2 * time.Hour).Format(time.RFC3339),
+			},
+		},
+		{
+			workflowType: "type-b",
+			searchAttributes: map[string]interface{}{
+				definition.CustomKeywordField:  "keyword-2",
+				definition.CustomIntField:      3,
+				definition.CustomDoubleField:   3.5,
+				definition.CustomBoolField:     true,
+				definition.CustomDatetimeField: baseTime.Add(3 * time.Hour).UnixNano(),
+			},
+		},
+		{
+			workflowType: "type-b",
+			closeStatus:  &completed,
+			searchAttributes: map[string]interface{}{
+				definition.CustomKeywordField:  "keyword-3",
+				definition.CustomIntField:      4,
+				definition.CustomDoubleField:   4.5,
+				definition.CustomBoolField:     true,
+				definition.CustomDatetimeField: baseTime.Add(4 * time.Hour).Format(time.RFC3339),
+			},
+		},
+		{
+			workflowType: "type-c",
+			closeStatus:  &failed,
+			searchAttributes: map[string]interface{}{
+				definition.CustomIntField:      5,
+				definition.CustomDoubleField:   0.5,
+				definition.CustomBoolField:     false,
+				definition.CustomDatetimeField: baseTime.Add(5 * time.Hour).Format(time.RFC3339),
+			},
+		},
+	}
+
+	for i, record := range records {
+		workflowExecution := types.WorkflowExecution{
+			WorkflowID: fmt.Sprintf("visibility-query-test-%d", i),
+			RunID:      uuid.New(),
+		}
+		startTime := baseTime.Add(time.Duration(i) * time.Minute).UnixNano()
+		searchAttributes := s.encodeSearchAttributes(record.searchAttributes)
+		err := s.VisibilityMgr.RecordWorkflowExecutionStarted(ctx, &p.RecordWorkflowExecutionStartedRequest{
+			DomainUUID:       domainID,
+			Execution:        workflowExecution,
+			WorkflowTypeName: record.workflowType,
+			StartTimestamp:   startTime,
+			SearchAttributes: searchAttributes,
+		})
+		s.NoError(err)
+		if record.closeStatus != nil {
+			err = s.VisibilityMgr.RecordWorkflowExecutionClosed(ctx, &p.RecordWorkflowExecutionClosedRequest{
+				DomainUUID:       domainID,
+				Execution:        workflowExecution,
+				WorkflowTypeName: record.workflowType,
+				StartTimestamp:   startTime,
+				Status:           *record.closeStatus,
+				CloseTimestamp:   startTime + int64(time.Second),
+				HistoryLength:    3,
+				SearchAttributes: searchAttributes,
+			})
+			s.NoError(err)
+		}
+	}
+}
+
+func (s *SQLVisibilityPersistenceSuite) assertQueryResult(domainID string, query string, expected int) *p.ListWorkflowExecutionsResponse {
+	ctx, cancel := context.WithTimeout(context.Background(), testContextTimeout)
+	defer cancel()
+
+	resp, err := s.VisibilityMgr.ListWorkflowExecutions(ctx, &p.ListWorkflowExecutionsByQueryRequest{
+		DomainUUID: domainID,
+		PageSize:   10,
+		Query:      query,
+	})
+	s.NoError(err, query)
+	s.Len(resp.Executions, expected, query)
+	return resp
+}
+
+func (s *SQLVisibilityPersistenceSuite) encodeSearchAttributes(attributes map[string]interface{}) map[string][]byte {
+	encoded := make(map[string][]byte, len(attributes))
+	for key, value := range attributes {
+		data, err := json.Marshal(value)
+		s.NoError(err)
+		encoded[key] = data
+	}
+	return encoded
+}
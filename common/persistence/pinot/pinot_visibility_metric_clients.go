@@ -389,6 +389,28 @@ func (p *pinotVisibilityMetricsClient) CountWorkflowExecutions(
 	return response, err
 }
 
+func (p *pinotVisibilityMetricsClient) AggregateWorkflowExecutions(
+	ctx context.Context,
+	request *p.AggregateWorkflowExecutionsRequest,
+) (*p.AggregateWorkflowExecutionsResponse, error) {
+
+	scopeWithDomainTag := p.metricClient.Scope(metrics.PinotAggregateWorkflowExecutionsScope, metrics.DomainTag(request.Domain))
+	scopeWithDomainTag.IncCounter(metrics.PinotRequestsPerDomain)
+	pinotStart := time.Now()
+	sw := scopeWithDomainTag.StartTimer(metrics.PinotLatencyPerDomain)
+	defer func() {
+		sw.Stop()
+		scopeWithDomainTag.ExponentialHistogram(metrics.PinotLatencyPerDomainHistogram, time.Since(pinotStart))
+	}()
+	response, err := p.persistence.AggregateWorkflowExecutions(ctx, request)
+
+	if err != nil {
+		p.updateErrorMetric(scopeWithDomainTag, metrics.PinotAggregateWorkflowExecutionsScope, err)
+	}
+
+	return response, err
+}
+
 func (p *pinotVisibilityMetricsClient) DeleteWorkflowExecution(
 	ctx context.Context,
 	request *p.VisibilityDeleteWorkflowExecutionRequest,
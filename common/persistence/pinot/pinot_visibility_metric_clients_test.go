@@ -117,7 +117,7 @@ func TestMetricClientRecordWorkflowExecutionStarted(t *testing.T) {
 				ESIndexMaxResultWindow: dynamicproperties.GetIntPropertyFn(3),
 			}, mockProducer, testlogger.New(t))
 			visibilityStore := mgr.(*pinotVisibilityStore)
-			pinotVisibilityManager := p.NewVisibilityManagerImpl(visibilityStore, logger, p.NewDefaultDynamicConfiguration())
+			pinotVisibilityManager := p.NewVisibilityManagerImpl(visibilityStore, logger, nil, p.NewDefaultDynamicConfiguration())
 			visibilityMgr := NewPinotVisibilityMetricsClient(pinotVisibilityManager, mockMetricClient, logger)
 			metricsClient := visibilityMgr.(*pinotVisibilityMetricsClient)
 
@@ -202,7 +202,7 @@ func TestMetricClientRecordWorkflowExecutionClosed(t *testing.T) {
 				ESIndexMaxResultWindow: dynamicproperties.GetIntPropertyFn(3),
 			}, mockProducer, testlogger.New(t))
 			visibilityStore := mgr.(*pinotVisibilityStore)
-			pinotVisibilityManager := p.NewVisibilityManagerImpl(visibilityStore, logger, p.NewDefaultDynamicConfiguration())
+			pinotVisibilityManager := p.NewVisibilityManagerImpl(visibilityStore, logger, nil, p.NewDefaultDynamicConfiguration())
 			visibilityMgr := NewPinotVisibilityMetricsClient(pinotVisibilityManager, mockMetricClient, logger)
 			metricsClient := visibilityMgr.(*pinotVisibilityMetricsClient)
 
@@ -277,7 +277,7 @@ func TestMetricClientRecordWorkflowExecutionUninitialized(t *testing.T) {
 				ESIndexMaxResultWindow: dynamicproperties.GetIntPropertyFn(3),
 			}, mockProducer, testlogger.New(t))
 			visibilityStore := mgr.(*pinotVisibilityStore)
-			pinotVisibilityManager := p.NewVisibilityManagerImpl(visibilityStore, logger, p.NewDefaultDynamicConfiguration())
+			pinotVisibilityManager := p.NewVisibilityManagerImpl(visibilityStore, logger, nil, p.NewDefaultDynamicConfiguration())
 			visibilityMgr := NewPinotVisibilityMetricsClient(pinotVisibilityManager, mockMetricClient, logger)
 			metricsClient := visibilityMgr.(*pinotVisibilityMetricsClient)
 
@@ -352,7 +352,7 @@ func TestMetricClientUpsertWorkflowExecution(t *testing.T) {
 				ESIndexMaxResultWindow: dynamicproperties.GetIntPropertyFn(3),
 			}, mockProducer, testlogger.New(t))
 			visibilityStore := mgr.(*pinotVisibilityStore)
-			pinotVisibilityManager := p.NewVisibilityManagerImpl(visibilityStore, logger, p.NewDefaultDynamicConfiguration())
+			pinotVisibilityManager := p.NewVisibilityManagerImpl(visibilityStore, logger, nil, p.NewDefaultDynamicConfiguration())
 			visibilityMgr := NewPinotVisibilityMetricsClient(pinotVisibilityManager, mockMetricClient, logger)
 			metricsClient := visibilityMgr.(*pinotVisibilityMetricsClient)
 
@@ -425,7 +425,7 @@ func TestMetricClientListOpenWorkflowExecutions(t *testing.T) {
 				ESIndexMaxResultWindow: dynamicproperties.GetIntPropertyFn(3),
 			}, mockProducer, testlogger.New(t))
 			visibilityStore := mgr.(*pinotVisibilityStore)
-			pinotVisibilityManager := p.NewVisibilityManagerImpl(visibilityStore, logger, p.NewDefaultDynamicConfiguration())
+			pinotVisibilityManager := p.NewVisibilityManagerImpl(visibilityStore, logger, nil, p.NewDefaultDynamicConfiguration())
 			visibilityMgr := NewPinotVisibilityMetricsClient(pinotVisibilityManager, mockMetricClient, logger)
 			metricsClient := visibilityMgr.(*pinotVisibilityMetricsClient)
 
@@ -498,7 +498,7 @@ func TestMetricClientListClosedWorkflowExecutions(t *testing.T) {
 				ESIndexMaxResultWindow: dynamicproperties.GetIntPropertyFn(3),
 			}, mockProducer, testlogger.New(t))
 			visibilityStore := mgr.(*pinotVisibilityStore)
-			pinotVisibilityManager := p.NewVisibilityManagerImpl(visibilityStore, logger, p.NewDefaultDynamicConfiguration())
+			pinotVisibilityManager := p.NewVisibilityManagerImpl(visibilityStore, logger, nil, p.NewDefaultDynamicConfiguration())
 			visibilityMgr := NewPinotVisibilityMetricsClient(pinotVisibilityManager, mockMetricClient, logger)
 			metricsClient := visibilityMgr.(*pinotVisibilityMetricsClient)
 
@@ -572,7 +572,7 @@ func TestMetricClientListOpenWorkflowExecutionsByType(t *testing.T) {
 				ESIndexMaxResultWindow: dynamicproperties.GetIntPropertyFn(3),
 			}, mockProducer, testlogger.New(t))
 			visibilityStore := mgr.(*pinotVisibilityStore)
-			pinotVisibilityManager := p.NewVisibilityManagerImpl(visibilityStore, logger, p.NewDefaultDynamicConfiguration())
+			pinotVisibilityManager := p.NewVisibilityManagerImpl(visibilityStore, logger, nil, p.NewDefaultDynamicConfiguration())
 			visibilityMgr := NewPinotVisibilityMetricsClient(pinotVisibilityManager, mockMetricClient, logger)
 			metricsClient := visibilityMgr.(*pinotVisibilityMetricsClient)
 
@@ -646,7 +646,7 @@ func TestMetricClientListClosedWorkflowExecutionsByType(t *testing.T) {
 				ESIndexMaxResultWindow: dynamicproperties.GetIntPropertyFn(3),
 			}, mockProducer, testlogger.New(t))
 			visibilityStore := mgr.(*pinotVisibilityStore)
-			pinotVisibilityManager := p.NewVisibilityManagerImpl(visibilityStore, logger, p.NewDefaultDynamicConfiguration())
+			pinotVisibilityManager := p.NewVisibilityManagerImpl(visibilityStore, logger, nil, p.NewDefaultDynamicConfiguration())
 			visibilityMgr := NewPinotVisibilityMetricsClient(pinotVisibilityManager, mockMetricClient, logger)
 			metricsClient := visibilityMgr.(*pinotVisibilityMetricsClient)
 
@@ -719,7 +719,7 @@ func TestMetricClientListOpenWorkflowExecutionsByWorkflowID(t *testing.T) {
 				ESIndexMaxResultWindow: dynamicproperties.GetIntPropertyFn(3),
 			}, mockProducer, testlogger.New(t))
 			visibilityStore := mgr.(*pinotVisibilityStore)
-			pinotVisibilityManager := p.NewVisibilityManagerImpl(visibilityStore, logger, p.NewDefaultDynamicConfiguration())
+			pinotVisibilityManager := p.NewVisibilityManagerImpl(visibilityStore, logger, nil, p.NewDefaultDynamicConfiguration())
 			visibilityMgr := NewPinotVisibilityMetricsClient(pinotVisibilityManager, mockMetricClient, logger)
 			metricsClient := visibilityMgr.(*pinotVisibilityMetricsClient)
 
@@ -792,7 +792,7 @@ func TestMetricClientListClosedWorkflowExecutionsByWorkflowID(t *testing.T) {
 				ESIndexMaxResultWindow: dynamicproperties.GetIntPropertyFn(3),
 			}, mockProducer, testlogger.New(t))
 			visibilityStore := mgr.(*pinotVisibilityStore)
-			pinotVisibilityManager := p.NewVisibilityManagerImpl(visibilityStore, logger, p.NewDefaultDynamicConfiguration())
+			pinotVisibilityManager := p.NewVisibilityManagerImpl(visibilityStore, logger, nil, p.NewDefaultDynamicConfiguration())
 			visibilityMgr := NewPinotVisibilityMetricsClient(pinotVisibilityManager, mockMetricClient, logger)
 			metricsClient := visibilityMgr.(*pinotVisibilityMetricsClient)
 
@@ -869,7 +869,7 @@ func TestMetricClientListClosedWorkflowExecutionsByStatus(t *testing.T) {
 				ESIndexMaxResultWindow: dynamicproperties.GetIntPropertyFn(3),
 			}, mockProducer, testlogger.New(t))
 			visibilityStore := mgr.(*pinotVisibilityStore)
-			pinotVisibilityManager := p.NewVisibilityManagerImpl(visibilityStore, logger, p.NewDefaultDynamicConfiguration())
+			pinotVisibilityManager := p.NewVisibilityManagerImpl(visibilityStore, logger, nil, p.NewDefaultDynamicConfiguration())
 			visibilityMgr := NewPinotVisibilityMetricsClient(pinotVisibilityManager, mockMetricClient, logger)
 			metricsClient := visibilityMgr.(*pinotVisibilityMetricsClient)
 
@@ -944,7 +944,7 @@ func TestMetricClientGetClosedWorkflowExecution(t *testing.T) {
 				ESIndexMaxResultWindow: dynamicproperties.GetIntPropertyFn(3),
 			}, mockProducer, testlogger.New(t))
 			visibilityStore := mgr.(*pinotVisibilityStore)
-			pinotVisibilityManager := p.NewVisibilityManagerImpl(visibilityStore, logger, p.NewDefaultDynamicConfiguration())
+			pinotVisibilityManager := p.NewVisibilityManagerImpl(visibilityStore, logger, nil, p.NewDefaultDynamicConfiguration())
 			visibilityMgr := NewPinotVisibilityMetricsClient(pinotVisibilityManager, mockMetricClient, logger)
 			metricsClient := visibilityMgr.(*pinotVisibilityMetricsClient)
 
@@ -1015,7 +1015,7 @@ func TestMetricClientListWorkflowExecutions(t *testing.T) {
 				ESIndexMaxResultWindow: dynamicproperties.GetIntPropertyFn(3),
 			}, mockProducer, testlogger.New(t))
 			visibilityStore := mgr.(*pinotVisibilityStore)
-			pinotVisibilityManager := p.NewVisibilityManagerImpl(visibilityStore, logger, p.NewDefaultDynamicConfiguration())
+			pinotVisibilityManager := p.NewVisibilityManagerImpl(visibilityStore, logger, nil, p.NewDefaultDynamicConfiguration())
 			visibilityMgr := NewPinotVisibilityMetricsClient(pinotVisibilityManager, mockMetricClient, logger)
 			metricsClient := visibilityMgr.(*pinotVisibilityMetricsClient)
 
@@ -1082,7 +1082,7 @@ func TestMetricClientScanWorkflowExecutions(t *testing.T) {
 				ESIndexMaxResultWindow: dynamicproperties.GetIntPropertyFn(3),
 			}, mockProducer, testlogger.New(t))
 			visibilityStore := mgr.(*pinotVisibilityStore)
-			pinotVisibilityManager := p.NewVisibilityManagerImpl(visibilityStore, logger, p.NewDefaultDynamicConfiguration())
+			pinotVisibilityManager := p.NewVisibilityManagerImpl(visibilityStore, logger, nil, p.NewDefaultDynamicConfiguration())
 			visibilityMgr := NewPinotVisibilityMetricsClient(pinotVisibilityManager, mockMetricClient, logger)
 			metricsClient := visibilityMgr.(*pinotVisibilityMetricsClient)
 
@@ -1149,7 +1149,7 @@ func TestMetricClientCountWorkflowExecutions(t *testing.T) {
 				ESIndexMaxResultWindow: dynamicproperties.GetIntPropertyFn(3),
 			}, mockProducer, testlogger.New(t))
 			visibilityStore := mgr.(*pinotVisibilityStore)
-			pinotVisibilityManager := p.NewVisibilityManagerImpl(visibilityStore, logger, p.NewDefaultDynamicConfiguration())
+			pinotVisibilityManager := p.NewVisibilityManagerImpl(visibilityStore, logger, nil, p.NewDefaultDynamicConfiguration())
 			visibilityMgr := NewPinotVisibilityMetricsClient(pinotVisibilityManager, mockMetricClient, logger)
 			metricsClient := visibilityMgr.(*pinotVisibilityMetricsClient)
 
@@ -1224,7 +1224,7 @@ func TestMetricClientDeleteWorkflowExecution(t *testing.T) {
 				ESIndexMaxResultWindow: dynamicproperties.GetIntPropertyFn(3),
 			}, mockProducer, testlogger.New(t))
 			visibilityStore := mgr.(*pinotVisibilityStore)
-			pinotVisibilityManager := p.NewVisibilityManagerImpl(visibilityStore, logger, p.NewDefaultDynamicConfiguration())
+			pinotVisibilityManager := p.NewVisibilityManagerImpl(visibilityStore, logger, nil, p.NewDefaultDynamicConfiguration())
 			visibilityMgr := NewPinotVisibilityMetricsClient(pinotVisibilityManager, mockMetricClient, logger)
 			metricsClient := visibilityMgr.(*pinotVisibilityMetricsClient)
 
@@ -1295,7 +1295,7 @@ func TestMetricClientDeleteUninitializedWorkflowExecution(t *testing.T) {
 				ESIndexMaxResultWindow: dynamicproperties.GetIntPropertyFn(3),
 			}, mockProducer, testlogger.New(t))
 			visibilityStore := mgr.(*pinotVisibilityStore)
-			pinotVisibilityManager := p.NewVisibilityManagerImpl(visibilityStore, logger, p.NewDefaultDynamicConfiguration())
+			pinotVisibilityManager := p.NewVisibilityManagerImpl(visibilityStore, logger, nil, p.NewDefaultDynamicConfiguration())
 			visibilityMgr := NewPinotVisibilityMetricsClient(pinotVisibilityManager, mockMetricClient, logger)
 			metricsClient := visibilityMgr.(*pinotVisibilityMetricsClient)
 
@@ -1326,7 +1326,7 @@ func TestMetricClientClose(t *testing.T) {
 		ESIndexMaxResultWindow: dynamicproperties.GetIntPropertyFn(3),
 	}, mockProducer, testlogger.New(t))
 	visibilityStore := mgr.(*pinotVisibilityStore)
-	pinotVisibilityManager := p.NewVisibilityManagerImpl(visibilityStore, logger, p.NewDefaultDynamicConfiguration())
+	pinotVisibilityManager := p.NewVisibilityManagerImpl(visibilityStore, logger, nil, p.NewDefaultDynamicConfiguration())
 	visibilityMgr := NewPinotVisibilityMetricsClient(pinotVisibilityManager, mockMetricClient, logger)
 	metricsClient := visibilityMgr.(*pinotVisibilityMetricsClient)
 
@@ -1350,7 +1350,7 @@ func TestMetricClientGetName(t *testing.T) {
 		ESIndexMaxResultWindow: dynamicproperties.GetIntPropertyFn(3),
 	}, mockProducer, testlogger.New(t))
 	visibilityStore := mgr.(*pinotVisibilityStore)
-	pinotVisibilityManager := p.NewVisibilityManagerImpl(visibilityStore, logger, p.NewDefaultDynamicConfiguration())
+	pinotVisibilityManager := p.NewVisibilityManagerImpl(visibilityStore, logger, nil, p.NewDefaultDynamicConfiguration())
 	visibilityMgr := NewPinotVisibilityMetricsClient(pinotVisibilityManager, mockMetricClient, logger)
 	metricsClient := visibilityMgr.(*pinotVisibilityMetricsClient)
 
@@ -24,6 +24,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
@@ -31,6 +32,7 @@ import (
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/definition"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/messaging"
@@ -39,6 +41,7 @@ import (
 	"github.com/uber/cadence/common/service"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/common/types/mapper/thrift"
+	"github.com/uber/cadence/common/visibility"
 )
 
 const (
@@ -533,6 +536,71 @@ func (v *pinotVisibilityStore) CountWorkflowExecutions(ctx context.Context, requ
 	}, nil
 }
 
+func (v *pinotVisibilityStore) AggregateWorkflowExecutions(ctx context.Context, request *p.AggregateWorkflowExecutionsRequest) (*p.AggregateWorkflowExecutionsResponse, error) {
+	query, err := v.getAggregateWorkflowExecutionsQuery(v.pinotClient.GetTableName(), request)
+	if err != nil {
+		v.logger.Error(fmt.Sprintf("failed to build aggregate workflow executions query %v", err))
+		return nil, err
+	}
+
+	rows, err := v.pinotClient.SearchAggr(&pnt.SearchRequest{Query: query})
+	if err != nil {
+		return nil, &types.InternalServiceError{
+			Message: fmt.Sprintf("AggregateWorkflowExecutions failed, %v", err),
+		}
+	}
+
+	groups, err := getGroupsFromRows(rows)
+	if err != nil {
+		return nil, &types.InternalServiceError{
+			Message: fmt.Sprintf("AggregateWorkflowExecutions failed, %v, query = %s", err, query),
+		}
+	}
+	return &p.AggregateWorkflowExecutionsResponse{Groups: groups}, nil
+}
+
+// getGroupsFromRows converts rows of (group key, count), which are already ordered by count
+func getGroupsFromRows(rows pnt.AggrResponse) ([]*types.WorkflowExecutionGroup, error) {
+	groups := make([]*types.WorkflowExecutionGroup, 0, len(rows))
+	for _, row := range rows {
+		if len(row) != 2 {
+			return nil, fmt.Errorf("unexpected aggregation row %v", row)
+		}
+		count, err := aggregationCount(row[1])
+		if err != nil {
+			return nil, err
+		}
+		groups = append(groups, &types.WorkflowExecutionGroup{
+			Key:   aggregationKey(row[0]),
+			Count: count,
+		})
+	}
+	return groups, nil
+}
+
+func aggregationKey(value interface{}) string {
+	switch key := value.(type) {
+	case string:
+		return key
+	case float64:
+		return strconv.FormatFloat(key, 'f', -1, 64)
+	default:
+		return fmt.Sprintf("%v", key)
+	}
+}
+
+// aggregationCount handles counts decoded either as json.Number or as float64
+func aggregationCount(value interface{}) (int64, error) {
+	switch count := value.(type) {
+	case json.Number:
+		return count.Int64()
+	case float64:
+		return int64(count), nil
+	default:
+		return 0, fmt.Errorf("can't convert %v of type %T to integer", value, value)
+	}
+}
+
 // a new function to create visibility message for deletion
 // don't use the other function and provide some nil values because it may cause nil pointer exceptions
 func createDeleteVisibilityMessage(domainID string,
@@ -689,6 +757,17 @@ func NewPinotCountQuery(tableName string) PinotQuery {
 	}
 }
 
+// NewPinotGroupByQuery creates a query counting the matching records for each value of groupByExpr,
+// largest groups first
+func NewPinotGroupByQuery(tableName string, groupByExpr string) PinotQuery {
+	return PinotQuery{
+		query:   fmt.Sprintf("SELECT %s, COUNT(*)\nFROM %s\n", groupByExpr, tableName),
+		filters: PinotQueryFilter{},
+		sorters: fmt.Sprintf("GROUP BY %s\nORDER BY COUNT(*) DESC\n", groupByExpr),
+		limits:  "",
+	}
+}
+
 func (q *PinotQuery) String() string {
 	return fmt.Sprintf("%s%s%s%s", q.query, q.filters.string, q.sorters, q.limits)
 }
@@ -792,6 +871,59 @@ func (v *pinotVisibilityStore) getCountWorkflowExecutionsQuery(tableName string,
 	return query.String(), nil
 }
 
+func (v *pinotVisibilityStore) getAggregateWorkflowExecutionsQuery(tableName string, request *p.AggregateWorkflowExecutionsRequest) (string, error) {
+	if request == nil {
+		return "", nil
+	}
+
+	// the key is put in the query as is, so it must not be able to break out of it
+	if err := visibility.ValidateSearchAttributeKey(request.GroupBy); err != nil {
+		return "", &types.BadRequestError{Message: fmt.Sprintf("invalid group by search attribute %q: %v", request.GroupBy, err)}
+	}
+	groupByExpr := request.GroupBy
+	if !definition.IsSystemIndexedKey(groupByExpr) {
+		groupByExpr = fmt.Sprintf("JSON_EXTRACT_SCALAR(Attr, '$.%s', 'STRING')", request.GroupBy)
+	}
+	query := NewPinotGroupByQuery(tableName, groupByExpr)
+
+	// need to add Domain ID
+	query.filters.addEqual(DomainID, request.DomainUUID)
+
+	// executions without a value are not grouped, the same as in Elasticsearch
+	switch {
+	case request.GroupBy == CloseStatus:
+		query.filters.addGte(CloseStatus, 0)
+	case !definition.IsSystemIndexedKey(request.GroupBy):
+		query.filters.addQuery(fmt.Sprintf("JSON_MATCH(Attr, '\"$.%s\" is not null')", request.GroupBy))
+	}
+
+	if request.MaxGroups > 0 {
+		query.addOffsetAndLimits(0, request.MaxGroups)
+	}
+
+	requestQuery := strings.TrimSpace(request.Query)
+
+	// if customized query is empty, directly return
+	if requestQuery == "" {
+		return query.String(), nil
+	}
+
+	requestQuery = filterPrefix(requestQuery)
+
+	comparExpr, _ := parseOrderBy(requestQuery)
+	comparExpr, err := v.pinotQueryValidator.ValidateQuery(comparExpr)
+	if err != nil {
+		return "", &types.BadRequestError{Message: fmt.Sprintf("pinot query validator error: %s, query: %s", err.Error(), request.Query)}
+	}
+
+	comparExpr = filterPrefix(comparExpr)
+	if comparExpr != "" {
+		query.filters.addQuery(comparExpr)
+	}
+
+	return query.String(), nil
+}
+
 func (v *pinotVisibilityStore) getListWorkflowExecutionsByQueryQuery(tableName string, request *p.ListWorkflowExecutionsByQueryRequest) (string, error) {
 	if request == nil {
 		return "", nil
@@ -1165,6 +1165,189 @@ AND WorkflowID = 'wfid'
 	}
 }
 
+func TestAggregateWorkflowExecutions(t *testing.T) {
+	request := &p.AggregateWorkflowExecutionsRequest{
+		DomainUUID: testDomainID,
+		Domain:     testDomain,
+		GroupBy:    "WorkflowType",
+		MaxGroups:  10,
+	}
+
+	tests := map[string]struct {
+		request                   *p.AggregateWorkflowExecutionsRequest
+		expectedResp              *p.AggregateWorkflowExecutionsResponse
+		pinotClientMockAffordance func(mockPinotClient *pnt.MockGenericClient)
+		expectedError             error
+	}{
+		"Case1: error case": {
+			request:      request,
+			expectedResp: nil,
+			pinotClientMockAffordance: func(mockPinotClient *pnt.MockGenericClient) {
+				mockPinotClient.EXPECT().GetTableName().Return(testTableName).Times(1)
+				mockPinotClient.EXPECT().SearchAggr(gomock.Any()).Return(nil, fmt.Errorf("error")).Times(1)
+			},
+			expectedError: fmt.Errorf("AggregateWorkflowExecutions failed, error"),
+		},
+		"Case2: normal case": {
+			request: request,
+			expectedResp: &p.AggregateWorkflowExecutionsResponse{Groups: []*types.WorkflowExecutionGroup{
+				{Key: "wf-type-1", Count: 5},
+				{Key: "wf-type-2", Count: 2},
+			}},
+			pinotClientMockAffordance: func(mockPinotClient *pnt.MockGenericClient) {
+				mockPinotClient.EXPECT().GetTableName().Return(testTableName).Times(1)
+				mockPinotClient.EXPECT().SearchAggr(gomock.Any()).Return(pnt.AggrResponse{
+					{"wf-type-1", json.Number("5")},
+					{"wf-type-2", float64(2)},
+				}, nil).Times(1)
+			},
+			expectedError: nil,
+		},
+		"Case3: numeric group keys": {
+			request: &p.AggregateWorkflowExecutionsRequest{DomainUUID: testDomainID, Domain: testDomain, GroupBy: "CloseStatus"},
+			expectedResp: &p.AggregateWorkflowExecutionsResponse{Groups: []*types.WorkflowExecutionGroup{
+				{Key: "1", Count: 3},
+			}},
+			pinotClientMockAffordance: func(mockPinotClient *pnt.MockGenericClient) {
+				mockPinotClient.EXPECT().GetTableName().Return(testTableName).Times(1)
+				mockPinotClient.EXPECT().SearchAggr(gomock.Any()).Return(pnt.AggrResponse{
+					{float64(1), json.Number("3")},
+				}, nil).Times(1)
+			},
+			expectedError: nil,
+		},
+		"Case4: invalid count": {
+			request:      request,
+			expectedResp: nil,
+			pinotClientMockAffordance: func(mockPinotClient *pnt.MockGenericClient) {
+				mockPinotClient.EXPECT().GetTableName().Return(testTableName).Times(1)
+				mockPinotClient.EXPECT().SearchAggr(gomock.Any()).Return(pnt.AggrResponse{
+					{"wf-type-1", "five"},
+				}, nil).Times(1)
+			},
+			expectedError: fmt.Errorf("AggregateWorkflowExecutions failed, can't convert five of type string to integer, query = SELECT WorkflowType, COUNT(*)\nFROM test-table-name\nWHERE DomainID = 'bfd5c907-f899-4baf-a7b2-2ab85e623ebd'\nGROUP BY WorkflowType\nORDER BY COUNT(*) DESC\nLIMIT 0, 10\n"),
+		},
+		"Case5: query error case": {
+			request:      &p.AggregateWorkflowExecutionsRequest{Domain: testDomain, DomainUUID: testDomainID, GroupBy: "WorkflowType", Query: "CustomKeywordField = missing"},
+			expectedResp: nil,
+			pinotClientMockAffordance: func(mockPinotClient *pnt.MockGenericClient) {
+				mockPinotClient.EXPECT().GetTableName().Return(testTableName).Times(1)
+			},
+			expectedError: fmt.Errorf("pinot query validator error: invalid comparison expression, right, query: CustomKeywordField = missing"),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockPinotClient := pnt.NewMockGenericClient(ctrl)
+			mockProducer := &mocks.KafkaProducer{}
+			mgr := NewPinotVisibilityStore(mockPinotClient, &service.Config{
+				ValidSearchAttributes:      dynamicproperties.GetMapPropertyFn(definition.GetDefaultIndexedKeys()),
+				ESIndexMaxResultWindow:     dynamicproperties.GetIntPropertyFn(3),
+				PinotOptimizedQueryColumns: dynamicproperties.GetMapPropertyFn(map[string]interface{}{}),
+			}, mockProducer, log.NewNoop())
+			visibilityStore := mgr.(*pinotVisibilityStore)
+
+			test.pinotClientMockAffordance(mockPinotClient)
+
+			resp, err := visibilityStore.AggregateWorkflowExecutions(context.Background(), test.request)
+			assert.Equal(t, test.expectedResp, resp)
+			if test.expectedError != nil {
+				assert.Equal(t, test.expectedError.Error(), err.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestGetAggregateWorkflowExecutionsQuery(t *testing.T) {
+	tests := map[string]struct {
+		request     *p.AggregateWorkflowExecutionsRequest
+		expectedRes string
+		expectedErr bool
+	}{
+		"Case1: system keyword attribute with empty query": {
+			request: &p.AggregateWorkflowExecutionsRequest{
+				DomainUUID: testDomainID,
+				Domain:     testDomain,
+				GroupBy:    "WorkflowType",
+				MaxGroups:  10,
+			},
+			expectedRes: fmt.Sprintf(`SELECT WorkflowType, COUNT(*)
+FROM %s
+WHERE DomainID = 'bfd5c907-f899-4baf-a7b2-2ab85e623ebd'
+GROUP BY WorkflowType
+ORDER BY COUNT(*) DESC
+LIMIT 0, 10
+`, testTableName),
+		},
+		"Case2: close status skips open executions": {
+			request: &p.AggregateWorkflowExecutionsRequest{
+				DomainUUID: testDomainID,
+				Domain:     testDomain,
+				Query:      "WorkflowID = 'wfid'",
+				GroupBy:    "CloseStatus",
+				MaxGroups:  10,
+			},
+			expectedRes: fmt.Sprintf(`SELECT CloseStatus, COUNT(*)
+FROM %s
+WHERE DomainID = 'bfd5c907-f899-4baf-a7b2-2ab85e623ebd'
+AND CloseStatus >= 0
+AND WorkflowID = 'wfid'
+GROUP BY CloseStatus
+ORDER BY COUNT(*) DESC
+LIMIT 0, 10
+`, testTableName),
+		},
+		"Case3: custom attribute": {
+			request: &p.AggregateWorkflowExecutionsRequest{
+				DomainUUID: testDomainID,
+				Domain:     testDomain,
+				GroupBy:    "CustomKeywordField",
+			},
+			expectedRes: fmt.Sprintf(`SELECT JSON_EXTRACT_SCALAR(Attr, '$.CustomKeywordField', 'STRING'), COUNT(*)
+FROM %s
+WHERE DomainID = 'bfd5c907-f899-4baf-a7b2-2ab85e623ebd'
+AND JSON_MATCH(Attr, '"$.CustomKeywordField" is not null')
+GROUP BY JSON_EXTRACT_SCALAR(Attr, '$.CustomKeywordField', 'STRING')
+ORDER BY COUNT(*) DESC
+`, testTableName),
+		},
+		"Case4: invalid attribute": {
+			request: &p.AggregateWorkflowExecutionsRequest{
+				DomainUUID: testDomainID,
+				Domain:     testDomain,
+				GroupBy:    "CustomKeywordField', 'STRING'), DomainID FROM t --",
+			},
+			expectedErr: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockPinotClient := pnt.NewMockGenericClient(ctrl)
+			mockProducer := &mocks.KafkaProducer{}
+			mgr := NewPinotVisibilityStore(mockPinotClient, &service.Config{
+				ValidSearchAttributes:      dynamicproperties.GetMapPropertyFn(definition.GetDefaultIndexedKeys()),
+				ESIndexMaxResultWindow:     dynamicproperties.GetIntPropertyFn(3),
+				PinotOptimizedQueryColumns: dynamicproperties.GetMapPropertyFn(map[string]interface{}{}),
+			}, mockProducer, log.NewNoop())
+			visibilityStore := mgr.(*pinotVisibilityStore)
+
+			res, err := visibilityStore.getAggregateWorkflowExecutionsQuery(testTableName, test.request)
+			if test.expectedErr {
+				assert.IsType(t, &types.BadRequestError{}, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedRes, res)
+		})
+	}
+}
+
 func TestGetListWorkflowExecutionQuery(t *testing.T) {
 	token := pnt.PinotVisibilityPageToken{
 		From: 11,
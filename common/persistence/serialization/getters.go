@@ -25,6 +25,7 @@ package serialization
 import (
 	"time"
 
+	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/types"
 )
 
@@ -555,6 +556,46 @@ func (w *WorkflowExecutionInfo) GetActiveClusterSelectionPolicyEncoding() (o str
 	return
 }
 
+// GetPaused internal sql blob getter
+func (w *WorkflowExecutionInfo) GetPaused() (o bool) {
+	if w != nil {
+		return w.Paused
+	}
+	return
+}
+
+// GetRetryJitterCoefficient internal sql blob getter
+func (w *WorkflowExecutionInfo) GetRetryJitterCoefficient() (o float64) {
+	if w != nil {
+		return w.RetryJitterCoefficient
+	}
+	return
+}
+
+// GetRetryRetryableErrors internal sql blob getter
+func (w *WorkflowExecutionInfo) GetRetryRetryableErrors() (o []string) {
+	if w != nil {
+		return w.RetryRetryableErrors
+	}
+	return
+}
+
+// GetRetryErrorReasonOverrides internal sql blob getter
+func (w *WorkflowExecutionInfo) GetRetryErrorReasonOverrides() (o []*types.RetryPolicyOverride) {
+	if w != nil {
+		return w.RetryErrorReasonOverrides
+	}
+	return
+}
+
+// GetCompletedUpdates internal sql blob getter
+func (w *WorkflowExecutionInfo) GetCompletedUpdates() (o []*persistence.CompletedUpdate) {
+	if w != nil {
+		return w.CompletedUpdates
+	}
+	return
+}
+
 // GetInitiatedID internal sql blob getter
 func (w *WorkflowExecutionInfo) GetInitiatedID() (o int64) {
 	if w != nil {
@@ -1170,6 +1211,30 @@ func (a *ActivityInfo) GetRetryNonRetryableErrors() (o []string) {
 	return
 }
 
+// GetRetryJitterCoefficient internal sql blob getter
+func (a *ActivityInfo) GetRetryJitterCoefficient() (o float64) {
+	if a != nil {
+		return a.RetryJitterCoefficient
+	}
+	return
+}
+
+// GetRetryRetryableErrors internal sql blob getter
+func (a *ActivityInfo) GetRetryRetryableErrors() (o []string) {
+	if a != nil {
+		return a.RetryRetryableErrors
+	}
+	return
+}
+
+// GetRetryErrorReasonOverrides internal sql blob getter
+func (a *ActivityInfo) GetRetryErrorReasonOverrides() (o []*types.RetryPolicyOverride) {
+	if a != nil {
+		return a.RetryErrorReasonOverrides
+	}
+	return
+}
+
 // GetVersion internal sql blob getter
 func (c *ChildExecutionInfo) GetVersion() (o int64) {
 	if c != nil {
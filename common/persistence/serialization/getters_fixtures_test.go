@@ -25,6 +25,7 @@ package serialization
 import (
 	"time"
 
+	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/types"
 )
 
@@ -80,6 +81,7 @@ var expectedNil = map[string]map[string]any{
 		"GetParentRunID":                          []uint8(nil),
 		"GetPartitionConfig":                      map[string]string(nil),
 		"GetHistorySize":                          int64(0),
+		"GetPaused":                               false,
 		"GetRetryAttempt":                         int64(0),
 		"GetRetryBackoffCoefficient":              float64(0),
 		"GetRetryExpiration":                      time.Duration(0),
@@ -88,6 +90,10 @@ var expectedNil = map[string]map[string]any{
 		"GetRetryMaximumAttempts":                 int32(0),
 		"GetRetryMaximumInterval":                 time.Duration(0),
 		"GetRetryNonRetryableErrors":              []string(nil),
+		"GetRetryErrorReasonOverrides":            []*types.RetryPolicyOverride(nil),
+		"GetCompletedUpdates":                     []*persistence.CompletedUpdate(nil),
+		"GetRetryJitterCoefficient":               float64(0),
+		"GetRetryRetryableErrors":                 []string(nil),
 		"GetSearchAttributes":                     map[string][]uint8(nil),
 		"GetSignalCount":                          int64(0),
 		"GetStartTimestamp":                       zeroUnix,
@@ -194,38 +200,41 @@ var expectedNil = map[string]map[string]any{
 		"GetWorkflowTypeName":       "",
 	},
 	"*serialization.ActivityInfo": {
-		"GetActivityID":               "",
-		"GetAttempt":                  int32(0),
-		"GetCancelRequestID":          int64(0),
-		"GetCancelRequested":          false,
-		"GetHasRetryPolicy":           false,
-		"GetHeartbeatTimeout":         time.Duration(0),
-		"GetRequestID":                "",
-		"GetRetryBackoffCoefficient":  float64(0),
-		"GetRetryExpirationTimestamp": zeroUnix,
-		"GetRetryInitialInterval":     time.Duration(0),
-		"GetRetryLastFailureDetails":  []uint8(nil),
-		"GetRetryLastFailureReason":   "",
-		"GetRetryLastWorkerIdentity":  "",
-		"GetRetryMaximumAttempts":     int32(0),
-		"GetRetryMaximumInterval":     time.Duration(0),
-		"GetRetryNonRetryableErrors":  []string(nil),
-		"GetScheduleToCloseTimeout":   time.Duration(0),
-		"GetScheduleToStartTimeout":   time.Duration(0),
-		"GetScheduledEvent":           []uint8(nil),
-		"GetScheduledEventBatchID":    int64(0),
-		"GetScheduledEventEncoding":   "",
-		"GetScheduledTimestamp":       zeroUnix,
-		"GetStartToCloseTimeout":      time.Duration(0),
-		"GetStartedEvent":             []uint8(nil),
-		"GetStartedEventEncoding":     "",
-		"GetStartedID":                int64(0),
-		"GetStartedIdentity":          "",
-		"GetStartedTimestamp":         zeroUnix,
-		"GetTaskList":                 "",
-		"GetTaskListKind":             types.TaskListKindNormal,
-		"GetTimerTaskStatus":          int32(0),
-		"GetVersion":                  int64(0),
+		"GetActivityID":                "",
+		"GetAttempt":                   int32(0),
+		"GetCancelRequestID":           int64(0),
+		"GetCancelRequested":           false,
+		"GetHasRetryPolicy":            false,
+		"GetHeartbeatTimeout":          time.Duration(0),
+		"GetRequestID":                 "",
+		"GetRetryBackoffCoefficient":   float64(0),
+		"GetRetryExpirationTimestamp":  zeroUnix,
+		"GetRetryInitialInterval":      time.Duration(0),
+		"GetRetryLastFailureDetails":   []uint8(nil),
+		"GetRetryLastFailureReason":    "",
+		"GetRetryLastWorkerIdentity":   "",
+		"GetRetryMaximumAttempts":      int32(0),
+		"GetRetryMaximumInterval":      time.Duration(0),
+		"GetRetryNonRetryableErrors":   []string(nil),
+		"GetRetryErrorReasonOverrides": []*types.RetryPolicyOverride(nil),
+		"GetRetryJitterCoefficient":    float64(0),
+		"GetRetryRetryableErrors":      []string(nil),
+		"GetScheduleToCloseTimeout":    time.Duration(0),
+		"GetScheduleToStartTimeout":    time.Duration(0),
+		"GetScheduledEvent":            []uint8(nil),
+		"GetScheduledEventBatchID":     int64(0),
+		"GetScheduledEventEncoding":    "",
+		"GetScheduledTimestamp":        zeroUnix,
+		"GetStartToCloseTimeout":       time.Duration(0),
+		"GetStartedEvent":              []uint8(nil),
+		"GetStartedEventEncoding":      "",
+		"GetStartedID":                 int64(0),
+		"GetStartedIdentity":           "",
+		"GetStartedTimestamp":          zeroUnix,
+		"GetTaskList":                  "",
+		"GetTaskListKind":              types.TaskListKindNormal,
+		"GetTimerTaskStatus":           int32(0),
+		"GetVersion":                   int64(0),
 	},
 	"*serialization.HistoryTreeInfo": {
 		"GetAncestors":        []*types.HistoryBranchRange(nil),
@@ -328,6 +337,7 @@ var expectedEmpty = map[string]map[string]any{
 		"GetParentRunID":                          []uint8(nil),
 		"GetPartitionConfig":                      map[string]string(nil),
 		"GetHistorySize":                          int64(0),
+		"GetPaused":                               false,
 		"GetRetryAttempt":                         int64(0),
 		"GetRetryBackoffCoefficient":              float64(0),
 		"GetRetryExpiration":                      time.Duration(0),
@@ -336,6 +346,10 @@ var expectedEmpty = map[string]map[string]any{
 		"GetRetryMaximumAttempts":                 int32(0),
 		"GetRetryMaximumInterval":                 time.Duration(0),
 		"GetRetryNonRetryableErrors":              []string(nil),
+		"GetRetryErrorReasonOverrides":            []*types.RetryPolicyOverride(nil),
+		"GetCompletedUpdates":                     []*persistence.CompletedUpdate(nil),
+		"GetRetryJitterCoefficient":               float64(0),
+		"GetRetryRetryableErrors":                 []string(nil),
 		"GetSearchAttributes":                     map[string][]uint8(nil),
 		"GetSignalCount":                          int64(0),
 		"GetStartTimestamp":                       time.Time{},
@@ -442,38 +456,41 @@ var expectedEmpty = map[string]map[string]any{
 		"GetWorkflowTypeName":       "",
 	},
 	"*serialization.ActivityInfo": {
-		"GetActivityID":               "",
-		"GetAttempt":                  int32(0),
-		"GetCancelRequestID":          int64(0),
-		"GetCancelRequested":          false,
-		"GetHasRetryPolicy":           false,
-		"GetHeartbeatTimeout":         time.Duration(0),
-		"GetRequestID":                "",
-		"GetRetryBackoffCoefficient":  float64(0),
-		"GetRetryExpirationTimestamp": time.Time{},
-		"GetRetryInitialInterval":     time.Duration(0),
-		"GetRetryLastFailureDetails":  []uint8(nil),
-		"GetRetryLastFailureReason":   "",
-		"GetRetryLastWorkerIdentity":  "",
-		"GetRetryMaximumAttempts":     int32(0),
-		"GetRetryMaximumInterval":     time.Duration(0),
-		"GetRetryNonRetryableErrors":  []string(nil),
-		"GetScheduleToCloseTimeout":   time.Duration(0),
-		"GetScheduleToStartTimeout":   time.Duration(0),
-		"GetScheduledEvent":           []uint8(nil),
-		"GetScheduledEventBatchID":    int64(0),
-		"GetScheduledEventEncoding":   "",
-		"GetScheduledTimestamp":       time.Time{},
-		"GetStartToCloseTimeout":      time.Duration(0),
-		"GetStartedEvent":             []uint8(nil),
-		"GetStartedEventEncoding":     "",
-		"GetStartedID":                int64(0),
-		"GetStartedIdentity":          "",
-		"GetStartedTimestamp":         time.Time{},
-		"GetTaskList":                 "",
-		"GetTaskListKind":             types.TaskListKindNormal,
-		"GetTimerTaskStatus":          int32(0),
-		"GetVersion":                  int64(0),
+		"GetActivityID":                "",
+		"GetAttempt":                   int32(0),
+		"GetCancelRequestID":           int64(0),
+		"GetCancelRequested":           false,
+		"GetHasRetryPolicy":            false,
+		"GetHeartbeatTimeout":          time.Duration(0),
+		"GetRequestID":                 "",
+		"GetRetryBackoffCoefficient":   float64(0),
+		"GetRetryExpirationTimestamp":  time.Time{},
+		"GetRetryInitialInterval":      time.Duration(0),
+		"GetRetryLastFailureDetails":   []uint8(nil),
+		"GetRetryLastFailureReason":    "",
+		"GetRetryLastWorkerIdentity":   "",
+		"GetRetryMaximumAttempts":      int32(0),
+		"GetRetryMaximumInterval":      time.Duration(0),
+		"GetRetryNonRetryableErrors":   []string(nil),
+		"GetRetryErrorReasonOverrides": []*types.RetryPolicyOverride(nil),
+		"GetRetryJitterCoefficient":    float64(0),
+		"GetRetryRetryableErrors":      []string(nil),
+		"GetScheduleToCloseTimeout":    time.Duration(0),
+		"GetScheduleToStartTimeout":    time.Duration(0),
+		"GetScheduledEvent":            []uint8(nil),
+		"GetScheduledEventBatchID":     int64(0),
+		"GetScheduledEventEncoding":    "",
+		"GetScheduledTimestamp":        time.Time{},
+		"GetStartToCloseTimeout":       time.Duration(0),
+		"GetStartedEvent":              []uint8(nil),
+		"GetStartedEventEncoding":      "",
+		"GetStartedID":                 int64(0),
+		"GetStartedIdentity":           "",
+		"GetStartedTimestamp":          time.Time{},
+		"GetTaskList":                  "",
+		"GetTaskListKind":              types.TaskListKindNormal,
+		"GetTimerTaskStatus":           int32(0),
+		"GetVersion":                   int64(0),
 	},
 	"*serialization.HistoryTreeInfo": {
 		"GetAncestors":        []*types.HistoryBranchRange(nil),
@@ -575,6 +592,7 @@ var expectedNonEmpty = map[string]map[string]any{
 		"GetParentRunID":                        []byte(parentRunID),
 		"GetPartitionConfig":                    map[string]string(nil),
 		"GetHistorySize":                        int64(0),
+		"GetPaused":                             false,
 		"GetRetryAttempt":                       int64(0),
 		"GetRetryBackoffCoefficient":            float64(0),
 		"GetRetryExpiration":                    time.Duration(0),
@@ -583,6 +601,10 @@ var expectedNonEmpty = map[string]map[string]any{
 		"GetRetryMaximumAttempts":               int32(0),
 		"GetRetryMaximumInterval":               time.Duration(0),
 		"GetRetryNonRetryableErrors":            []string(nil),
+		"GetRetryErrorReasonOverrides":          []*types.RetryPolicyOverride(nil),
+		"GetCompletedUpdates":                   []*persistence.CompletedUpdate(nil),
+		"GetRetryJitterCoefficient":             float64(0),
+		"GetRetryRetryableErrors":               []string(nil),
 		"GetSearchAttributes": map[string][]uint8{
 			"key": []byte("value"),
 		},
@@ -692,36 +714,39 @@ var expectedNonEmpty = map[string]map[string]any{
 		"GetWorkflowTypeName":       "workflowTypeName",
 	},
 	"*serialization.ActivityInfo": {
-		"GetActivityID":               "activityID",
-		"GetAttempt":                  int32(6),
-		"GetCancelRequestID":          int64(4),
-		"GetCancelRequested":          true,
-		"GetHasRetryPolicy":           true,
-		"GetHeartbeatTimeout":         time.Duration(4),
-		"GetRequestID":                "requestID",
-		"GetRetryBackoffCoefficient":  float64(8),
-		"GetRetryExpirationTimestamp": activeInfoRetryExpirationTime,
-		"GetRetryInitialInterval":     time.Duration(5),
-		"GetRetryLastFailureDetails":  []byte("retryLastFailureDetails"),
-		"GetRetryLastFailureReason":   "retryLastFailureReason",
-		"GetRetryLastWorkerIdentity":  "retryLastWorkerIdentity",
-		"GetRetryMaximumAttempts":     int32(7),
-		"GetRetryMaximumInterval":     time.Duration(6),
-		"GetRetryNonRetryableErrors":  []string{"error1", "error2"},
-		"GetScheduleToCloseTimeout":   time.Duration(1),
-		"GetScheduleToStartTimeout":   time.Duration(2),
-		"GetScheduledEvent":           []byte("scheduledEvent"),
-		"GetScheduledEventBatchID":    int64(2),
-		"GetScheduledEventEncoding":   "scheduledEventEncoding",
-		"GetScheduledTimestamp":       activityInfoScheduledTime,
-		"GetStartToCloseTimeout":      time.Duration(3),
-		"GetStartedEvent":             []byte("startedEvent"),
-		"GetStartedEventEncoding":     "startedEventEncoding",
-		"GetStartedID":                int64(3),
-		"GetStartedIdentity":          "startedIdentity",
-		"GetStartedTimestamp":         activeInfoStartedTime,
-		"GetTaskList":                 "taskList",
-		"GetTaskListKind":             types.TaskListKindSticky,
+		"GetActivityID":                "activityID",
+		"GetAttempt":                   int32(6),
+		"GetCancelRequestID":           int64(4),
+		"GetCancelRequested":           true,
+		"GetHasRetryPolicy":            true,
+		"GetHeartbeatTimeout":          time.Duration(4),
+		"GetRequestID":                 "requestID",
+		"GetRetryBackoffCoefficient":   float64(8),
+		"GetRetryExpirationTimestamp":  activeInfoRetryExpirationTime,
+		"GetRetryInitialInterval":      time.Duration(5),
+		"GetRetryLastFailureDetails":   []byte("retryLastFailureDetails"),
+		"GetRetryLastFailureReason":    "retryLastFailureReason",
+		"GetRetryLastWorkerIdentity":   "retryLastWorkerIdentity",
+		"GetRetryMaximumAttempts":      int32(7),
+		"GetRetryMaximumInterval":      time.Duration(6),
+		"GetRetryNonRetryableErrors":   []string{"error1", "error2"},
+		"GetRetryErrorReasonOverrides": []*types.RetryPolicyOverride(nil),
+		"GetRetryJitterCoefficient":    float64(0),
+		"GetRetryRetryableErrors":      []string(nil),
+		"GetScheduleToCloseTimeout":    time.Duration(1),
+		"GetScheduleToStartTimeout":    time.Duration(2),
+		"GetScheduledEvent":            []byte("scheduledEvent"),
+		"GetScheduledEventBatchID":     int64(2),
+		"GetScheduledEventEncoding":    "scheduledEventEncoding",
+		"GetScheduledTimestamp":        activityInfoScheduledTime,
+		"GetStartToCloseTimeout":       time.Duration(3),
+		"GetStartedEvent":              []byte("startedEvent"),
+		"GetStartedEventEncoding":      "startedEventEncoding",
+		"GetStartedID":                 int64(3),
+		"GetStartedIdentity":           "startedIdentity",
+		"GetStartedTimestamp":          activeInfoStartedTime,
+		"GetTaskList":                  "taskList",
+		"GetTaskListKind":              types.TaskListKindSticky,
 
 		"GetTimerTaskStatus": int32(5),
 		"GetVersion":         int64(1),
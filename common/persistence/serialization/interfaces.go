@@ -178,6 +178,11 @@ type (
 		ChecksumEncoding                     string
 		ActiveClusterSelectionPolicy         []byte
 		ActiveClusterSelectionPolicyEncoding string
+		Paused                               bool
+		RetryJitterCoefficient               float64
+		RetryRetryableErrors                 []string
+		RetryErrorReasonOverrides            []*types.RetryPolicyOverride
+		CompletedUpdates                     []*persistence.CompletedUpdate
 	}
 
 	// ActivityInfo blob in a serialization agnostic format
@@ -216,6 +221,12 @@ type (
 		RetryLastFailureDetails       []byte
 		RetryLastFailureCategory      types.FailureCategory
 		RetryLastRetryIntervalSeconds int32
+		Priority                      int32
+		FairnessKey                   string
+		Paused                        bool
+		RetryJitterCoefficient        float64
+		RetryRetryableErrors          []string
+		RetryErrorReasonOverrides     []*types.RetryPolicyOverride
 	}
 
 	// ChildExecutionInfo blob in a serialization agnostic format
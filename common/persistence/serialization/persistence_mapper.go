@@ -78,6 +78,11 @@ func ToInternalWorkflowExecutionInfo(info *WorkflowExecutionInfo) *persistence.I
 		PartitionConfig:                    info.PartitionConfig,
 		IsCron:                             info.IsCron,
 		CronOverlapPolicy:                  types.CronOverlapPolicy(info.GetCronOverlapPolicy()),
+		Paused:                             info.GetPaused(),
+		RetryJitterCoefficient:             info.GetRetryJitterCoefficient(),
+		RetryableErrors:                    info.GetRetryRetryableErrors(),
+		RetryErrorReasonOverrides:          info.GetRetryErrorReasonOverrides(),
+		CompletedUpdates:                   info.GetCompletedUpdates(),
 	}
 	if info.ParentDomainID != nil {
 		result.ParentDomainID = info.ParentDomainID.String()
@@ -171,6 +176,11 @@ func FromInternalWorkflowExecutionInfo(executionInfo *persistence.InternalWorkfl
 		CronOverlapPolicy:                    executionInfo.CronOverlapPolicy,
 		ActiveClusterSelectionPolicy:         executionInfo.ActiveClusterSelectionPolicy.GetData(),
 		ActiveClusterSelectionPolicyEncoding: string(executionInfo.ActiveClusterSelectionPolicy.GetEncoding()),
+		Paused:                               executionInfo.Paused,
+		RetryJitterCoefficient:               executionInfo.RetryJitterCoefficient,
+		RetryRetryableErrors:                 executionInfo.RetryableErrors,
+		RetryErrorReasonOverrides:            executionInfo.RetryErrorReasonOverrides,
+		CompletedUpdates:                     executionInfo.CompletedUpdates,
 	}
 
 	if executionInfo.CompletionEvent != nil {
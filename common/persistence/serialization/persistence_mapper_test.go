@@ -94,6 +94,7 @@ func TestInternalWorkflowExecutionInfo(t *testing.T) {
 		IsCron:                             true,
 		ActiveClusterSelectionPolicy:       persistence.NewDataBlob([]byte("ActiveClusterSelectionPolicy"), constants.EncodingTypeJSON),
 		CronOverlapPolicy:                  types.CronOverlapPolicySkipped,
+		Paused:                             true,
 	}
 	actual := ToInternalWorkflowExecutionInfo(FromInternalWorkflowExecutionInfo(expected))
 	assert.Equal(t, expected, actual)
@@ -157,6 +157,11 @@ func (s *taskSerializerImpl) serializeTransferTask(task persistence.Task) (persi
 		info.WorkflowID = t.WorkflowID
 		info.RunID = MustParseUUID(t.RunID)
 		info.TaskList = t.TaskList
+	case *persistence.DeleteWorkflowExecutionTask:
+		info.DomainID = MustParseUUID(t.DomainID)
+		info.WorkflowID = t.WorkflowID
+		info.RunID = MustParseUUID(t.RunID)
+		info.TaskList = t.TaskList
 	case *persistence.ResetWorkflowTask:
 		info.DomainID = MustParseUUID(t.DomainID)
 		info.WorkflowID = t.WorkflowID
@@ -270,6 +275,12 @@ func (s *taskSerializerImpl) deserializeTransferTask(blob *persistence.DataBlob)
 			TaskData:           taskData,
 			TaskList:           info.GetTaskList(),
 		}
+	case persistence.TransferTaskTypeDeleteWorkflowExecution:
+		task = &persistence.DeleteWorkflowExecutionTask{
+			WorkflowIdentifier: workflowIdentifier,
+			TaskData:           taskData,
+			TaskList:           info.GetTaskList(),
+		}
 	case persistence.TransferTaskTypeRecordChildExecutionCompleted:
 		task = &persistence.RecordChildExecutionCompletedTask{
 			WorkflowIdentifier: workflowIdentifier,
@@ -445,6 +456,14 @@ func (s *taskSerializerImpl) serializeReplicationTask(task persistence.Task) (pe
 		info.ScheduledID = t.ScheduledID
 	case *persistence.FailoverMarkerTask:
 		info.DomainID = MustParseUUID(t.DomainID)
+	case *persistence.DeleteWorkflowExecutionReplicationTask:
+		info.DomainID = MustParseUUID(t.DomainID)
+		info.WorkflowID = t.WorkflowID
+		info.RunID = MustParseUUID(t.RunID)
+	case *persistence.UpsertWorkflowSearchAttributesReplicationTask:
+		info.DomainID = MustParseUUID(t.DomainID)
+		info.WorkflowID = t.WorkflowID
+		info.RunID = MustParseUUID(t.RunID)
 	default:
 		return persistence.DataBlob{}, &types.InternalServiceError{
 			Message: fmt.Sprintf("Unknown replication task: %v", task.GetTaskType()),
@@ -492,6 +511,24 @@ func (s *taskSerializerImpl) deserializeReplicationTask(blob *persistence.DataBl
 			DomainID: info.DomainID.String(),
 			TaskData: taskData,
 		}
+	case persistence.ReplicationTaskTypeDeleteWorkflowExecution:
+		task = &persistence.DeleteWorkflowExecutionReplicationTask{
+			WorkflowIdentifier: persistence.WorkflowIdentifier{
+				DomainID:   info.DomainID.String(),
+				WorkflowID: info.GetWorkflowID(),
+				RunID:      info.RunID.String(),
+			},
+			TaskData: taskData,
+		}
+	case persistence.ReplicationTaskTypeUpsertWorkflowSearchAttributes:
+		task = &persistence.UpsertWorkflowSearchAttributesReplicationTask{
+			WorkflowIdentifier: persistence.WorkflowIdentifier{
+				DomainID:   info.DomainID.String(),
+				WorkflowID: info.GetWorkflowID(),
+				RunID:      info.RunID.String(),
+			},
+			TaskData: taskData,
+		}
 	}
 	return task, nil
 }
@@ -315,6 +315,40 @@ func TestTaskSerializerThriftRW(t *testing.T) {
 				},
 			},
 		},
+		{
+			category: persistence.HistoryTaskCategoryTransfer,
+			task: &persistence.DeleteWorkflowExecutionTask{
+				WorkflowIdentifier: workflowIdentifier,
+				TaskData: persistence.TaskData{
+					Version:             21,
+					TaskID:              21,
+					VisibilityTimestamp: time.Unix(21, 21),
+				},
+				TaskList: "test-tl3",
+			},
+		},
+		{
+			category: persistence.HistoryTaskCategoryReplication,
+			task: &persistence.DeleteWorkflowExecutionReplicationTask{
+				WorkflowIdentifier: workflowIdentifier,
+				TaskData: persistence.TaskData{
+					Version:             22,
+					TaskID:              22,
+					VisibilityTimestamp: time.Unix(22, 22),
+				},
+			},
+		},
+		{
+			category: persistence.HistoryTaskCategoryReplication,
+			task: &persistence.UpsertWorkflowSearchAttributesReplicationTask{
+				WorkflowIdentifier: workflowIdentifier,
+				TaskData: persistence.TaskData{
+					Version:             23,
+					TaskID:              23,
+					VisibilityTimestamp: time.Unix(23, 23),
+				},
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -28,6 +28,7 @@ import (
 	"github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/.gen/go/sqlblobs"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/common/types/mapper/thrift"
 )
@@ -288,6 +289,11 @@ func workflowExecutionInfoToThrift(info *WorkflowExecutionInfo) *sqlblobs.Workfl
 		ChecksumEncoding:                        &info.ChecksumEncoding,
 		ActiveClusterSelectionPolicy:            info.ActiveClusterSelectionPolicy,
 		ActiveClusterSelectionPolicyEncoding:    &info.ActiveClusterSelectionPolicyEncoding,
+		Paused:                                  &info.Paused,
+		RetryJitterCoefficient:                  &info.RetryJitterCoefficient,
+		RetryRetryableErrors:                    info.RetryRetryableErrors,
+		RetryErrorReasonOverrides:               thrift.FromRetryPolicyOverrideArray(info.RetryErrorReasonOverrides),
+		CompletedUpdates:                        completedUpdatesToThrift(info.CompletedUpdates),
 	}
 }
 
@@ -363,9 +369,46 @@ func workflowExecutionInfoFromThrift(info *sqlblobs.WorkflowExecutionInfo) *Work
 		ChecksumEncoding:                     info.GetChecksumEncoding(),
 		ActiveClusterSelectionPolicy:         info.ActiveClusterSelectionPolicy,
 		ActiveClusterSelectionPolicyEncoding: info.GetActiveClusterSelectionPolicyEncoding(),
+		Paused:                               info.GetPaused(),
+		RetryJitterCoefficient:               info.GetRetryJitterCoefficient(),
+		RetryRetryableErrors:                 info.RetryRetryableErrors,
+		RetryErrorReasonOverrides:            thrift.ToRetryPolicyOverrideArray(info.RetryErrorReasonOverrides),
+		CompletedUpdates:                     completedUpdatesFromThrift(info.CompletedUpdates),
 	}
 }
 
+func completedUpdatesToThrift(updates []*persistence.CompletedUpdate) []*sqlblobs.CompletedUpdate {
+	if updates == nil {
+		return nil
+	}
+	result := make([]*sqlblobs.CompletedUpdate, 0, len(updates))
+	for _, update := range updates {
+		result = append(result, &sqlblobs.CompletedUpdate{
+			UpdateID:       common.StringPtr(update.UpdateID),
+			Result:         update.Result,
+			FailureReason:  update.FailureReason,
+			FailureDetails: update.FailureDetails,
+		})
+	}
+	return result
+}
+
+func completedUpdatesFromThrift(updates []*sqlblobs.CompletedUpdate) []*persistence.CompletedUpdate {
+	if updates == nil {
+		return nil
+	}
+	result := make([]*persistence.CompletedUpdate, 0, len(updates))
+	for _, update := range updates {
+		result = append(result, &persistence.CompletedUpdate{
+			UpdateID:       update.GetUpdateID(),
+			Result:         update.Result,
+			FailureReason:  update.FailureReason,
+			FailureDetails: update.FailureDetails,
+		})
+	}
+	return result
+}
+
 func activityInfoToThrift(info *ActivityInfo) *sqlblobs.ActivityInfo {
 	if info == nil {
 		return nil
@@ -407,6 +450,12 @@ func activityInfoToThrift(info *ActivityInfo) *sqlblobs.ActivityInfo {
 			FailureCategory:          thrift.FromFailureCategory(info.RetryLastFailureCategory.Ptr()),
 			NextRetryIntervalSeconds: &info.RetryLastRetryIntervalSeconds,
 		},
+		Priority:                  &info.Priority,
+		FairnessKey:               &info.FairnessKey,
+		Paused:                    &info.Paused,
+		RetryJitterCoefficient:    &info.RetryJitterCoefficient,
+		RetryRetryableErrors:      info.RetryRetryableErrors,
+		RetryErrorReasonOverrides: thrift.FromRetryPolicyOverrideArray(info.RetryErrorReasonOverrides),
 	}
 }
 
@@ -449,6 +498,12 @@ func activityInfoFromThrift(info *sqlblobs.ActivityInfo) *ActivityInfo {
 		RetryLastFailureDetails:       info.RetryLastFailureDetails,
 		RetryLastFailureCategory:      failureCategoryFromSqlblob(info.RetryLastFailureOptions),
 		RetryLastRetryIntervalSeconds: info.RetryLastFailureOptions.GetNextRetryIntervalSeconds(),
+		Priority:                      info.GetPriority(),
+		FairnessKey:                   info.GetFairnessKey(),
+		Paused:                        info.GetPaused(),
+		RetryJitterCoefficient:        info.GetRetryJitterCoefficient(),
+		RetryRetryableErrors:          info.RetryRetryableErrors,
+		RetryErrorReasonOverrides:     thrift.ToRetryPolicyOverrideArray(info.RetryErrorReasonOverrides),
 	}
 }
 
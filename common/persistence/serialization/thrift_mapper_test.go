@@ -274,6 +274,7 @@ func TestWorkflowExecutionInfo(t *testing.T) {
 		Checksum:                           []byte("Checksum"),
 		ChecksumEncoding:                   "ChecksumEncoding",
 		IsCron:                             true,
+		Paused:                             true,
 	}
 	actual := workflowExecutionInfoFromThrift(workflowExecutionInfoToThrift(expected))
 	assert.Equal(t, expected, actual)
@@ -392,6 +393,9 @@ func TestActivityInfo(t *testing.T) {
 		RetryLastFailureDetails:       []byte("RetryLastFailureDetails"),
 		RetryLastFailureCategory:      types.FailureCategoryFatal,
 		RetryLastRetryIntervalSeconds: int32(rand.Intn(1000)),
+		Priority:                      int32(rand.Intn(5) + 1),
+		FairnessKey:                   "fairnessKey",
+		Paused:                        true,
 	}
 	actual := activityInfoFromThrift(activityInfoToThrift(expected))
 	assert.Equal(t, expected, actual)
@@ -146,7 +146,7 @@ func (f *Factory) NewExecutionStore() (p.ExecutionStore, error) {
 // NewVisibilityStore returns a visibility store
 // TODO sortByCloseTime will be removed and implemented for https://github.com/uber/cadence/issues/3621
 func (f *Factory) NewVisibilityStore(sortByCloseTime bool) (p.VisibilityStore, error) {
-	return NewSQLVisibilityStore(f.cfg, f.logger)
+	return NewSQLVisibilityStore(f.cfg, f.logger, f.dc)
 }
 
 // NewQueue returns a new queue backed by sql
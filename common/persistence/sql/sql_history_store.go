@@ -144,6 +144,13 @@ func (m *sqlHistoryStore) AppendHistoryNodes(
 		})
 	}
 
+	if request.Overwrite {
+		if _, err := m.db.ReplaceIntoHistoryNode(ctx, nodeRow); err != nil {
+			return convertCommonErrors(m.db, "AppendHistoryEvents", "", err)
+		}
+		return nil
+	}
+
 	_, err := m.db.InsertIntoHistoryNode(ctx, nodeRow)
 	if err != nil {
 		if m.db.IsDupEntryError(err) {
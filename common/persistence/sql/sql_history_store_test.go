@@ -783,6 +783,32 @@ func TestAppendHistoryNodes(t *testing.T) {
 				assert.True(t, errors.As(err, &expectedErr), "Expected the error to be ConditionFailedError")
 			},
 		},
+		{
+			name: "Success case - old branch, overwrite",
+			req: &persistence.InternalAppendHistoryNodesRequest{
+				IsNewBranch: false,
+				BranchInfo: types.HistoryBranch{
+					TreeID:   "530ec3d3-f74b-423f-a138-3b35494fe691",
+					BranchID: "630ec3d3-f74b-423f-a138-3b35494fe691",
+				},
+				NodeID:        11,
+				Events:        &persistence.DataBlob{},
+				TransactionID: 100,
+				Overwrite:     true,
+				ShardID:       1,
+			},
+			mockSetup: func(mockDB *sqlplugin.MockDB, mockTx *sqlplugin.MockTx, mockParser *serialization.MockParser) {
+				mockDB.EXPECT().ReplaceIntoHistoryNode(gomock.Any(), &sqlplugin.HistoryNodeRow{
+					TreeID:       serialization.MustParseUUID("530ec3d3-f74b-423f-a138-3b35494fe691"),
+					BranchID:     serialization.MustParseUUID("630ec3d3-f74b-423f-a138-3b35494fe691"),
+					NodeID:       11,
+					TxnID:        common.Int64Ptr(100),
+					DataEncoding: "",
+					ShardID:      1,
+				}).Return(&sqlResult{rowsAffected: 1}, nil)
+			},
+			wantErr: false,
+		},
 		{
 			name: "Error case - invalid history",
 			req: &persistence.InternalAppendHistoryNodesRequest{
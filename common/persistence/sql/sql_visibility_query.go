@@ -0,0 +1,418 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sql
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/xwb1989/sqlparser"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/definition"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/persistence/sql/sqlplugin"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/common/types/mapper/thrift"
+	"github.com/uber/cadence/common/visibility"
+)
+
+const (
+	// missingValue is the value used by the query language to look for the rows without a field, e.g. CloseTime = missing
+	missingValue = "missing"
+)
+
+var (
+	// visibilityQueryColumns maps the system search attributes to the columns of executions_visibility table
+	visibilityQueryColumns = map[string]sqlplugin.VisibilityQueryField{
+		definition.WorkflowID:             {Column: "workflow_id", ValueType: types.IndexedValueTypeKeyword},
+		definition.RunID:                  {Column: "run_id", ValueType: types.IndexedValueTypeKeyword},
+		definition.WorkflowType:           {Column: "workflow_type_name", ValueType: types.IndexedValueTypeKeyword},
+		definition.StartTime:              {Column: "start_time", ValueType: types.IndexedValueTypeDatetime},
+		definition.ExecutionTime:          {Column: "execution_time", ValueType: types.IndexedValueTypeDatetime},
+		definition.CloseTime:              {Column: "close_time", ValueType: types.IndexedValueTypeDatetime},
+		definition.UpdateTime:             {Column: "update_time", ValueType: types.IndexedValueTypeDatetime},
+		definition.ScheduledExecutionTime: {Column: "scheduled_execution_time", ValueType: types.IndexedValueTypeDatetime},
+		definition.CloseStatus:            {Column: "close_status", ValueType: types.IndexedValueTypeInt},
+		definition.ExecutionStatus:        {Column: "execution_status", ValueType: types.IndexedValueTypeInt},
+		definition.HistoryLength:          {Column: "history_length", ValueType: types.IndexedValueTypeInt},
+		definition.IsCron:                 {Column: "is_cron", ValueType: types.IndexedValueTypeBool},
+		definition.NumClusters:            {Column: "num_clusters", ValueType: types.IndexedValueTypeInt},
+		definition.CronSchedule:           {Column: "cron_schedule", ValueType: types.IndexedValueTypeKeyword},
+	}
+
+	visibilityQueryComparisonOperators = map[string]sqlplugin.VisibilityQueryOperator{
+		sqlparser.EqualStr:        sqlplugin.VisibilityQueryEqual,
+		sqlparser.NotEqualStr:     sqlplugin.VisibilityQueryNotEqual,
+		sqlparser.LessThanStr:     sqlplugin.VisibilityQueryLess,
+		sqlparser.LessEqualStr:    sqlplugin.VisibilityQueryLessEqual,
+		sqlparser.GreaterThanStr:  sqlplugin.VisibilityQueryGreater,
+		sqlparser.GreaterEqualStr: sqlplugin.VisibilityQueryGreaterEqual,
+		sqlparser.InStr:           sqlplugin.VisibilityQueryIn,
+		sqlparser.NotInStr:        sqlplugin.VisibilityQueryNotIn,
+		sqlparser.LikeStr:         sqlplugin.VisibilityQueryLike,
+		sqlparser.NotLikeStr:      sqlplugin.VisibilityQueryNotLike,
+	}
+
+	visibilityQueryRangeOperators = map[string]sqlplugin.VisibilityQueryOperator{
+		sqlparser.BetweenStr:    sqlplugin.VisibilityQueryBetween,
+		sqlparser.NotBetweenStr: sqlplugin.VisibilityQueryNotBetween,
+	}
+
+	// the default sorting is the same as the one of Elasticsearch visibility
+	defaultVisibilityQueryOrderBy = []sqlplugin.VisibilityQueryOrderBy{
+		{Field: visibilityQueryColumns[definition.StartTime], Desc: true},
+		{Field: visibilityQueryColumns[definition.RunID], Desc: true},
+	}
+)
+
+type (
+	// visibilityQueryConverter converts the where clause of the visibility query language,
+	// as accepted by the frontend query validator, to a visibility query of executions_visibility table
+	visibilityQueryConverter struct {
+		validSearchAttributes map[string]interface{}
+		logger                log.Logger
+	}
+
+	visibilityQueryPageToken struct {
+		Offset int
+	}
+)
+
+func newVisibilityQueryConverter(validSearchAttributes map[string]interface{}, logger log.Logger) *visibilityQueryConverter {
+	return &visibilityQueryConverter{
+		validSearchAttributes: validSearchAttributes,
+		logger:                logger,
+	}
+}
+
+// convert returns the filter of the rows of the domain matching the query
+func (c *visibilityQueryConverter) convert(domainID string, query string) (*sqlplugin.VisibilityQueryFilter, error) {
+	filter, err := c.convertQuery(domainID, query)
+	if err != nil {
+		return nil, &types.BadRequestError{Message: fmt.Sprintf("Error when parse query: %v", err)}
+	}
+	return filter, nil
+}
+
+func (c *visibilityQueryConverter) convertQuery(domainID string, query string) (*sqlplugin.VisibilityQueryFilter, error) {
+	filter := &sqlplugin.VisibilityQueryFilter{
+		DomainID: domainID,
+		OrderBy:  defaultVisibilityQueryOrderBy,
+	}
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return filter, nil
+	}
+
+	statement := "select * from dummy where " + query
+	if common.IsJustOrderByClause(query) {
+		statement = "select * from dummy " + query
+	}
+	stmt, err := sqlparser.Parse(statement)
+	if err != nil {
+		return nil, err
+	}
+	sel, ok := stmt.(*sqlparser.Select)
+	if !ok {
+		return nil, errors.New("query is not a select statement")
+	}
+	if sel.Limit != nil || sel.GroupBy != nil || sel.Having != nil {
+		return nil, errors.New("only where and order by clauses are supported")
+	}
+
+	if sel.Where != nil {
+		filter.Condition, err = c.convertWhereExpr(sel.Where.Expr)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if len(sel.OrderBy) > 0 {
+		filter.OrderBy, err = c.convertOrderBy(sel.OrderBy)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return filter, nil
+}
+
+func (c *visibilityQueryConverter) convertWhereExpr(expr sqlparser.Expr) (*sqlplugin.VisibilityQueryCondition, error) {
+	switch expr := expr.(type) {
+	case *sqlparser.AndExpr:
+		return c.convertLogicalExpr(sqlplugin.VisibilityQueryAnd, expr.Left, expr.Right)
+	case *sqlparser.OrExpr:
+		return c.convertLogicalExpr(sqlplugin.VisibilityQueryOr, expr.Left, expr.Right)
+	case *sqlparser.ParenExpr:
+		return c.convertWhereExpr(expr.Expr)
+	case *sqlparser.ComparisonExpr:
+		return c.convertComparisonExpr(expr)
+	case *sqlparser.RangeCond:
+		return c.convertRangeCond(expr)
+	default:
+		return nil, fmt.Errorf("unsupported expression: %s", sqlparser.String(expr))
+	}
+}
+
+func (c *visibilityQueryConverter) convertLogicalExpr(
+	operator sqlplugin.VisibilityQueryOperator,
+	left sqlparser.Expr,
+	right sqlparser.Expr,
+) (*sqlplugin.VisibilityQueryCondition, error) {
+	condition := &sqlplugin.VisibilityQueryCondition{Operator: operator}
+	for _, expr := range []sqlparser.Expr{left, right} {
+		child, err := c.convertWhereExpr(expr)
+		if err != nil {
+			return nil, err
+		}
+		// flatten a chain of the same operator, e.g. a AND b AND c
+		if child.Operator == operator {
+			condition.Children = append(condition.Children, child.Children...)
+		} else {
+			condition.Children = append(condition.Children, child)
+		}
+	}
+	return condition, nil
+}
+
+func (c *visibilityQueryConverter) convertComparisonExpr(expr *sqlparser.ComparisonExpr) (*sqlplugin.VisibilityQueryCondition, error) {
+	key, field, err := c.convertField(expr.Left)
+	if err != nil {
+		return nil, err
+	}
+	operator, ok := visibilityQueryComparisonOperators[expr.Operator]
+	if !ok {
+		return nil, fmt.Errorf("operator %s is not supported", expr.Operator)
+	}
+
+	if colName, ok := expr.Right.(*sqlparser.ColName); ok && colName.Qualifier.IsEmpty() && colName.Name.EqualString(missingValue) {
+		switch operator {
+		case sqlplugin.VisibilityQueryEqual:
+			return &sqlplugin.VisibilityQueryCondition{Operator: sqlplugin.VisibilityQueryIsNull, Field: field}, nil
+		case sqlplugin.VisibilityQueryNotEqual:
+			return &sqlplugin.VisibilityQueryCondition{Operator: sqlplugin.VisibilityQueryIsNotNull, Field: field}, nil
+		default:
+			return nil, fmt.Errorf("operator %s is not supported with %s", expr.Operator, missingValue)
+		}
+	}
+
+	if operator == sqlplugin.VisibilityQueryLike || operator == sqlplugin.VisibilityQueryNotLike {
+		if field.ValueType != types.IndexedValueTypeKeyword && field.ValueType != types.IndexedValueTypeString {
+			return nil, fmt.Errorf("operator %s is only supported for string fields, %s is not a string field", expr.Operator, key)
+		}
+	}
+
+	var valueExprs []sqlparser.Expr
+	if operator == sqlplugin.VisibilityQueryIn || operator == sqlplugin.VisibilityQueryNotIn {
+		tuple, ok := expr.Right.(sqlparser.ValTuple)
+		if !ok {
+			return nil, fmt.Errorf("invalid values of operator %s: %s", expr.Operator, sqlparser.String(expr.Right))
+		}
+		valueExprs = tuple
+	} else {
+		valueExprs = []sqlparser.Expr{expr.Right}
+	}
+
+	condition := &sqlplugin.VisibilityQueryCondition{Operator: operator, Field: field}
+	for _, valueExpr := range valueExprs {
+		value, err := c.convertValue(key, field, valueExpr)
+		if err != nil {
+			return nil, err
+		}
+		condition.Values = append(condition.Values, value)
+	}
+	return condition, nil
+}
+
+func (c *visibilityQueryConverter) convertRangeCond(expr *sqlparser.RangeCond) (*sqlplugin.VisibilityQueryCondition, error) {
+	key, field, err := c.convertField(expr.Left)
+	if err != nil {
+		return nil, err
+	}
+	operator, ok := visibilityQueryRangeOperators[expr.Operator]
+	if !ok {
+		return nil, fmt.Errorf("operator %s is not supported", expr.Operator)
+	}
+	from, err := c.convertValue(key, field, expr.From)
+	if err != nil {
+		return nil, err
+	}
+	to, err := c.convertValue(key, field, expr.To)
+	if err != nil {
+		return nil, err
+	}
+	return &sqlplugin.VisibilityQueryCondition{
+		Operator: operator,
+		Field:    field,
+		Values:   []interface{}{from, to},
+	}, nil
+}
+
+func (c *visibilityQueryConverter) convertOrderBy(orderBy sqlparser.OrderBy) ([]sqlplugin.VisibilityQueryOrderBy, error) {
+	if len(orderBy) > 1 {
+		return nil, errors.New("only one field can be used to sort")
+	}
+	key, field, err := c.convertField(orderBy[0].Expr)
+	if err != nil {
+		return nil, err
+	}
+	if key == definition.RunID {
+		return nil, fmt.Errorf("not able to sort by %s", definition.RunID)
+	}
+	if field.ValueType == types.IndexedValueTypeString {
+		return nil, errors.New("not able to sort by IndexedValueTypeString field, use IndexedValueTypeKeyword field")
+	}
+	return []sqlplugin.VisibilityQueryOrderBy{
+		{Field: field, Desc: orderBy[0].Direction == sqlparser.DescScr},
+		// add RunID as tie-breaker
+		{Field: visibilityQueryColumns[definition.RunID], Desc: true},
+	}, nil
+}
+
+// convertField returns the search attribute key referenced by the expression and the field storing it
+func (c *visibilityQueryConverter) convertField(expr sqlparser.Expr) (string, sqlplugin.VisibilityQueryField, error) {
+	colName, ok := expr.(*sqlparser.ColName)
+	if !ok {
+		return "", sqlplugin.VisibilityQueryField{}, fmt.Errorf("invalid field name: %s", sqlparser.String(expr))
+	}
+	key := colName.Name.String()
+	if !colName.Qualifier.IsEmpty() {
+		key = colName.Qualifier.Name.String() + "." + key
+	}
+	// custom search attributes are prefixed by the query validator
+	key = strings.TrimPrefix(key, definition.Attr+".")
+
+	field, err := c.searchAttributeField(key)
+	if err != nil {
+		return "", sqlplugin.VisibilityQueryField{}, err
+	}
+	return key, field, nil
+}
+
+// convertGroupBy returns the field storing the search attribute the rows are grouped by
+func (c *visibilityQueryConverter) convertGroupBy(key string) (sqlplugin.VisibilityQueryField, error) {
+	field, err := c.searchAttributeField(key)
+	if err != nil {
+		return sqlplugin.VisibilityQueryField{}, &types.BadRequestError{Message: fmt.Sprintf("Error when parse group by: %v", err)}
+	}
+	return field, nil
+}
+
+// searchAttributeField returns the field storing a search attribute
+func (c *visibilityQueryConverter) searchAttributeField(key string) (sqlplugin.VisibilityQueryField, error) {
+	if field, ok := visibilityQueryColumns[key]; ok {
+		return field, nil
+	}
+	if definition.IsSystemIndexedKey(key) {
+		return sqlplugin.VisibilityQueryField{}, fmt.Errorf("search attribute %s is not supported by SQL visibility", key)
+	}
+	fieldType, ok := c.validSearchAttributes[key]
+	if !ok {
+		return sqlplugin.VisibilityQueryField{}, fmt.Errorf("unknown search attribute %s", key)
+	}
+	if err := visibility.ValidateSearchAttributeKey(key); err != nil {
+		return sqlplugin.VisibilityQueryField{}, fmt.Errorf("invalid search attribute %s: %v", key, err)
+	}
+	return sqlplugin.VisibilityQueryField{
+		SearchAttribute: key,
+		ValueType:       common.ConvertIndexedValueTypeToInternalType(fieldType, c.logger),
+	}, nil
+}
+
+// convertValue returns the value compared with the field in the representation stored in executions_visibility table
+func (c *visibilityQueryConverter) convertValue(key string, field sqlplugin.VisibilityQueryField, expr sqlparser.Expr) (interface{}, error) {
+	var raw string
+	switch expr := expr.(type) {
+	case sqlparser.BoolVal:
+		raw = strconv.FormatBool(bool(expr))
+	case *sqlparser.SQLVal:
+		if expr.Type != sqlparser.StrVal && expr.Type != sqlparser.IntVal && expr.Type != sqlparser.FloatVal {
+			return nil, fmt.Errorf("invalid value of %s: %s", key, sqlparser.String(expr))
+		}
+		raw = string(expr.Val)
+	default:
+		return nil, fmt.Errorf("invalid value of %s: %s", key, sqlparser.String(expr))
+	}
+
+	switch key {
+	case definition.CloseStatus:
+		var status types.WorkflowExecutionCloseStatus
+		if err := status.UnmarshalText([]byte(raw)); err != nil {
+			return nil, fmt.Errorf("invalid value of %s: %v", key, err)
+		}
+		return int32(*thrift.FromWorkflowExecutionCloseStatus(&status)), nil
+	case definition.ExecutionStatus:
+		var status types.WorkflowExecutionStatus
+		if err := status.UnmarshalText([]byte(raw)); err != nil {
+			return nil, fmt.Errorf("invalid value of %s: %v", key, err)
+		}
+		return int32(status), nil
+	}
+
+	switch field.ValueType {
+	case types.IndexedValueTypeString, types.IndexedValueTypeKeyword:
+		return raw, nil
+	case types.IndexedValueTypeInt:
+		value, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value of %s: %v", key, err)
+		}
+		return value, nil
+	case types.IndexedValueTypeDouble:
+		value, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value of %s: %v", key, err)
+		}
+		return value, nil
+	case types.IndexedValueTypeBool:
+		value, err := strconv.ParseBool(raw)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value of %s: %v", key, err)
+		}
+		return value, nil
+	case types.IndexedValueTypeDatetime:
+		nanos, err := parseVisibilityQueryDatetime(raw)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value of %s: %v", key, err)
+		}
+		// the custom search attributes of datetime type are stored as unix nanoseconds
+		if field.Column == "" {
+			return nanos, nil
+		}
+		return time.Unix(0, nanos).UTC(), nil
+	default:
+		return nil, fmt.Errorf("unknown value type %v of %s", field.ValueType, key)
+	}
+}
+
+// parseVisibilityQueryDatetime accepts unix nanoseconds or a time in RFC3339 format, as Elasticsearch visibility does
+func parseVisibilityQueryDatetime(raw string) (int64, error) {
+	if nanos, err := strconv.ParseInt(raw, 10, 64); err == nil {
+		return nanos, nil
+	}
+	t, err := time.Parse(time.RFC3339, raw)
+	if err != nil {
+		return 0, err
+	}
+	return t.UnixNano(), nil
+}
@@ -0,0 +1,213 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sql
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common/definition"
+	"github.com/uber/cadence/common/log/testlogger"
+	"github.com/uber/cadence/common/persistence/sql/sqlplugin"
+	"github.com/uber/cadence/common/types"
+)
+
+func TestVisibilityQueryConverter(t *testing.T) {
+	keywordField := sqlplugin.VisibilityQueryField{SearchAttribute: definition.CustomKeywordField, ValueType: types.IndexedValueTypeKeyword}
+	intField := sqlplugin.VisibilityQueryField{SearchAttribute: definition.CustomIntField, ValueType: types.IndexedValueTypeInt}
+	datetimeField := sqlplugin.VisibilityQueryField{SearchAttribute: definition.CustomDatetimeField, ValueType: types.IndexedValueTypeDatetime}
+	startTime := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name              string
+		query             string
+		expectedCondition *sqlplugin.VisibilityQueryCondition
+		expectedOrderBy   []sqlplugin.VisibilityQueryOrderBy
+		expectedErr       bool
+	}{
+		{
+			name:            "empty query",
+			query:           "  ",
+			expectedOrderBy: defaultVisibilityQueryOrderBy,
+		},
+		{
+			name:  "comparisons of system and custom search attributes",
+			query: "WorkflowType = 'type' and `Attr.CustomKeywordField` != 'keyword' and CustomIntField >= 10",
+			expectedCondition: &sqlplugin.VisibilityQueryCondition{
+				Operator: sqlplugin.VisibilityQueryAnd,
+				Children: []*sqlplugin.VisibilityQueryCondition{
+					{Operator: sqlplugin.VisibilityQueryEqual, Field: visibilityQueryColumns[definition.WorkflowType], Values: []interface{}{"type"}},
+					{Operator: sqlplugin.VisibilityQueryNotEqual, Field: keywordField, Values: []interface{}{"keyword"}},
+					{Operator: sqlplugin.VisibilityQueryGreaterEqual, Field: intField, Values: []interface{}{int64(10)}},
+				},
+			},
+			expectedOrderBy: defaultVisibilityQueryOrderBy,
+		},
+		{
+			name:  "or in parentheses",
+			query: "CloseTime = missing and (`Attr.CustomKeywordField` in ('a', 'b') or `Attr.CustomKeywordField` like 'c%')",
+			expectedCondition: &sqlplugin.VisibilityQueryCondition{
+				Operator: sqlplugin.VisibilityQueryAnd,
+				Children: []*sqlplugin.VisibilityQueryCondition{
+					{Operator: sqlplugin.VisibilityQueryIsNull, Field: visibilityQueryColumns[definition.CloseTime]},
+					{
+						Operator: sqlplugin.VisibilityQueryOr,
+						Children: []*sqlplugin.VisibilityQueryCondition{
+							{Operator: sqlplugin.VisibilityQueryIn, Field: keywordField, Values: []interface{}{"a", "b"}},
+							{Operator: sqlplugin.VisibilityQueryLike, Field: keywordField, Values: []interface{}{"c%"}},
+						},
+					},
+				},
+			},
+			expectedOrderBy: defaultVisibilityQueryOrderBy,
+		},
+		{
+			name:  "datetime values",
+			query: "StartTime between '2026-01-01T00:00:00Z' and 1767229200000000000 and `Attr.CustomDatetimeField` < '2026-01-01T00:00:00Z'",
+			expectedCondition: &sqlplugin.VisibilityQueryCondition{
+				Operator: sqlplugin.VisibilityQueryAnd,
+				Children: []*sqlplugin.VisibilityQueryCondition{
+					{
+						Operator: sqlplugin.VisibilityQueryBetween,
+						Field:    visibilityQueryColumns[definition.StartTime],
+						Values:   []interface{}{startTime, startTime.Add(time.Hour)},
+					},
+					{Operator: sqlplugin.VisibilityQueryLess, Field: datetimeField, Values: []interface{}{startTime.UnixNano()}},
+				},
+			},
+			expectedOrderBy: defaultVisibilityQueryOrderBy,
+		},
+		{
+			name:  "close status values",
+			query: "CloseStatus = 'terminated' or CloseStatus = 1",
+			expectedCondition: &sqlplugin.VisibilityQueryCondition{
+				Operator: sqlplugin.VisibilityQueryOr,
+				Children: []*sqlplugin.VisibilityQueryCondition{
+					{Operator: sqlplugin.VisibilityQueryEqual, Field: visibilityQueryColumns[definition.CloseStatus], Values: []interface{}{int32(3)}},
+					{Operator: sqlplugin.VisibilityQueryEqual, Field: visibilityQueryColumns[definition.CloseStatus], Values: []interface{}{int32(1)}},
+				},
+			},
+			expectedOrderBy: defaultVisibilityQueryOrderBy,
+		},
+		{
+			name:  "order by custom search attribute",
+			query: "order by `Attr.CustomIntField` asc",
+			expectedOrderBy: []sqlplugin.VisibilityQueryOrderBy{
+				{Field: intField},
+				{Field: visibilityQueryColumns[definition.RunID], Desc: true},
+			},
+		},
+		{
+			name:        "invalid syntax",
+			query:       "WorkflowType = ",
+			expectedErr: true,
+		},
+		{
+			name:        "unknown search attribute",
+			query:       "`Attr.Unknown` = 'value'",
+			expectedErr: true,
+		},
+		{
+			name:        "unsupported system search attribute",
+			query:       "TaskList = 'tasklist'",
+			expectedErr: true,
+		},
+		{
+			name:        "invalid value type",
+			query:       "`Attr.CustomIntField` = 'value'",
+			expectedErr: true,
+		},
+		{
+			name:        "invalid execution status",
+			query:       "ExecutionStatus = 'RUNNING'",
+			expectedErr: true,
+		},
+		{
+			name:        "like on non string field",
+			query:       "`Attr.CustomIntField` like '1%'",
+			expectedErr: true,
+		},
+		{
+			name:        "unsupported expression",
+			query:       "not WorkflowType = 'type'",
+			expectedErr: true,
+		},
+		{
+			name:        "order by run id",
+			query:       "order by RunID desc",
+			expectedErr: true,
+		},
+		{
+			name:        "order by string field",
+			query:       "order by `Attr.CustomStringField` desc",
+			expectedErr: true,
+		},
+		{
+			name:        "order by multiple fields",
+			query:       "order by StartTime desc, WorkflowID asc",
+			expectedErr: true,
+		},
+	}
+
+	converter := newVisibilityQueryConverter(definition.GetDefaultIndexedKeys(), testlogger.New(t))
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			filter, err := converter.convert("domain-id", tc.query)
+			if tc.expectedErr {
+				assert.IsType(t, &types.BadRequestError{}, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "domain-id", filter.DomainID)
+			assert.Equal(t, tc.expectedCondition, filter.Condition)
+			assert.Equal(t, tc.expectedOrderBy, filter.OrderBy)
+		})
+	}
+}
+
+func TestVisibilityQueryConverterGroupBy(t *testing.T) {
+	converter := newVisibilityQueryConverter(definition.GetDefaultIndexedKeys(), testlogger.New(t))
+
+	field, err := converter.convertGroupBy(definition.CloseStatus)
+	require.NoError(t, err)
+	assert.Equal(t, visibilityQueryColumns[definition.CloseStatus], field)
+
+	field, err = converter.convertGroupBy(definition.CustomKeywordField)
+	require.NoError(t, err)
+	assert.Equal(t, sqlplugin.VisibilityQueryField{SearchAttribute: definition.CustomKeywordField, ValueType: types.IndexedValueTypeKeyword}, field)
+
+	for _, key := range []string{"UnknownField", definition.TaskList} {
+		_, err = converter.convertGroupBy(key)
+		assert.IsType(t, &types.BadRequestError{}, err, key)
+	}
+}
+
+func TestGroupKey(t *testing.T) {
+	boolField := sqlplugin.VisibilityQueryField{SearchAttribute: definition.CustomBoolField, ValueType: types.IndexedValueTypeBool}
+	assert.Equal(t, "true", groupKey(boolField, "1"))
+	assert.Equal(t, "false", groupKey(boolField, "0"))
+	assert.Equal(t, "true", groupKey(boolField, "true"))
+	assert.Equal(t, "1", groupKey(visibilityQueryColumns[definition.CloseStatus], "1"))
+	assert.Equal(t, "keyword", groupKey(visibilityQueryColumns[definition.WorkflowType], "keyword"))
+}
@@ -21,17 +21,21 @@
 package sql
 
 import (
+	"bytes"
 	"context"
 	"database/sql"
 	"encoding/json"
 	"fmt"
+	"strconv"
 	"time"
 
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/config"
 	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/definition"
 	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
 	p "github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/persistence/sql/sqlplugin"
 	"github.com/uber/cadence/common/types"
@@ -50,7 +54,7 @@ type (
 )
 
 // NewSQLVisibilityStore creates an instance of ExecutionStore
-func NewSQLVisibilityStore(cfg config.SQL, logger log.Logger) (p.VisibilityStore, error) {
+func NewSQLVisibilityStore(cfg config.SQL, logger log.Logger, dc *p.DynamicConfiguration) (p.VisibilityStore, error) {
 	db, err := NewSQLDB(&cfg)
 	if err != nil {
 		return nil, err
@@ -59,6 +63,7 @@ func NewSQLVisibilityStore(cfg config.SQL, logger log.Logger) (p.VisibilityStore
 		sqlStore: sqlStore{
 			db:     db,
 			logger: logger,
+			dc:     dc,
 		},
 	}, nil
 }
@@ -67,7 +72,11 @@ func (s *sqlVisibilityStore) RecordWorkflowExecutionStarted(
 	ctx context.Context,
 	request *p.InternalRecordWorkflowExecutionStartedRequest,
 ) error {
-	_, err := s.db.InsertIntoVisibility(ctx, &sqlplugin.VisibilityRow{
+	searchAttributes, err := s.serializeSearchAttributes(request.SearchAttributes)
+	if err != nil {
+		return err
+	}
+	_, err = s.db.InsertIntoVisibility(ctx, &sqlplugin.VisibilityRow{
 		DomainID:               request.DomainUUID,
 		WorkflowID:             request.WorkflowID,
 		RunID:                  request.RunID,
@@ -83,6 +92,7 @@ func (s *sqlVisibilityStore) RecordWorkflowExecutionStarted(
 		ShardID:                request.ShardID,
 		ExecutionStatus:        int32(request.ExecutionStatus),
 		ScheduledExecutionTime: request.ScheduledExecutionTime,
+		SearchAttributes:       searchAttributes,
 	})
 
 	if err != nil {
@@ -96,6 +106,10 @@ func (s *sqlVisibilityStore) RecordWorkflowExecutionClosed(
 	request *p.InternalRecordWorkflowExecutionClosedRequest,
 ) error {
 	closeTime := request.CloseTimestamp
+	searchAttributes, err := s.serializeSearchAttributes(request.SearchAttributes)
+	if err != nil {
+		return err
+	}
 
 	// Map CloseStatus to ExecutionStatus
 	executionStatus := types.WorkflowExecutionStatusCompleted // default
@@ -133,6 +147,7 @@ func (s *sqlVisibilityStore) RecordWorkflowExecutionClosed(
 		ShardID:                request.ShardID,
 		ExecutionStatus:        int32(executionStatus),
 		ScheduledExecutionTime: request.ScheduledExecutionTime,
+		SearchAttributes:       searchAttributes,
 	})
 	if err != nil {
 		return convertCommonErrors(s.db, "RecordWorkflowExecutionClosed", "", err)
@@ -160,13 +175,42 @@ func (s *sqlVisibilityStore) RecordWorkflowExecutionUninitialized(
 }
 
 func (s *sqlVisibilityStore) UpsertWorkflowExecution(
-	_ context.Context,
+	ctx context.Context,
 	request *p.InternalUpsertWorkflowExecutionRequest,
 ) error {
 	if p.IsNopUpsertWorkflowRequest(request) {
 		return nil
 	}
-	return p.ErrVisibilityOperationNotSupported
+	searchAttributes, err := s.serializeSearchAttributes(request.SearchAttributes)
+	if err != nil {
+		return err
+	}
+	var scheduledExecutionTime time.Time
+	if request.ScheduledExecutionTimestamp != 0 {
+		scheduledExecutionTime = time.Unix(0, request.ScheduledExecutionTimestamp)
+	}
+	_, err = s.db.UpsertIntoVisibility(ctx, &sqlplugin.VisibilityRow{
+		DomainID:               request.DomainUUID,
+		WorkflowID:             request.WorkflowID,
+		RunID:                  request.RunID,
+		StartTime:              request.StartTimestamp,
+		ExecutionTime:          request.ExecutionTimestamp,
+		WorkflowTypeName:       request.WorkflowTypeName,
+		Memo:                   request.Memo.Data,
+		Encoding:               string(request.Memo.GetEncoding()),
+		IsCron:                 request.IsCron,
+		CronSchedule:           request.CronSchedule,
+		NumClusters:            request.NumClusters,
+		UpdateTime:             request.UpdateTimestamp,
+		ShardID:                int16(request.ShardID),
+		ExecutionStatus:        int32(request.ExecutionStatus),
+		ScheduledExecutionTime: scheduledExecutionTime,
+		SearchAttributes:       searchAttributes,
+	})
+	if err != nil {
+		return convertCommonErrors(s.db, "UpsertWorkflowExecution", "", err)
+	}
+	return nil
 }
 
 func (s *sqlVisibilityStore) ListOpenWorkflowExecutions(
@@ -338,24 +382,115 @@ func (s *sqlVisibilityStore) DeleteUninitializedWorkflowExecution(
 }
 
 func (s *sqlVisibilityStore) ListWorkflowExecutions(
-	_ context.Context,
-	_ *p.ListWorkflowExecutionsByQueryRequest,
+	ctx context.Context,
+	request *p.ListWorkflowExecutionsByQueryRequest,
 ) (*p.InternalListWorkflowExecutionsResponse, error) {
-	return nil, p.ErrVisibilityOperationNotSupported
+	return s.listWorkflowExecutionsByQuery(ctx, "ListWorkflowExecutions", request)
 }
 
+// ScanWorkflowExecutions is the same as ListWorkflowExecutions, as the rows are paged by offset in both cases
 func (s *sqlVisibilityStore) ScanWorkflowExecutions(
-	_ context.Context,
-	_ *p.ListWorkflowExecutionsByQueryRequest,
+	ctx context.Context,
+	request *p.ListWorkflowExecutionsByQueryRequest,
 ) (*p.InternalListWorkflowExecutionsResponse, error) {
-	return nil, p.ErrVisibilityOperationNotSupported
+	return s.listWorkflowExecutionsByQuery(ctx, "ScanWorkflowExecutions", request)
 }
 
 func (s *sqlVisibilityStore) CountWorkflowExecutions(
-	_ context.Context,
-	_ *p.CountWorkflowExecutionsRequest,
+	ctx context.Context,
+	request *p.CountWorkflowExecutionsRequest,
 ) (*p.CountWorkflowExecutionsResponse, error) {
-	return nil, p.ErrVisibilityOperationNotSupported
+	filter, err := newVisibilityQueryConverter(s.validSearchAttributes(), s.logger).convert(request.DomainUUID, request.Query)
+	if err != nil {
+		return nil, err
+	}
+	count, err := s.db.CountFromVisibilityByQuery(ctx, filter)
+	if err != nil {
+		return nil, convertCommonErrors(s.db, "CountWorkflowExecutions", "", err)
+	}
+	return &p.CountWorkflowExecutionsResponse{Count: count}, nil
+}
+
+func (s *sqlVisibilityStore) AggregateWorkflowExecutions(
+	ctx context.Context,
+	request *p.AggregateWorkflowExecutionsRequest,
+) (*p.AggregateWorkflowExecutionsResponse, error) {
+	converter := newVisibilityQueryConverter(s.validSearchAttributes(), s.logger)
+	filter, err := converter.convert(request.DomainUUID, request.Query)
+	if err != nil {
+		return nil, err
+	}
+	groupBy, err := converter.convertGroupBy(request.GroupBy)
+	if err != nil {
+		return nil, err
+	}
+	filter.PageSize = request.MaxGroups
+
+	rows, err := s.db.SelectGroupsFromVisibilityByQuery(ctx, filter, groupBy)
+	if err != nil {
+		return nil, convertCommonErrors(s.db, "AggregateWorkflowExecutions", "", err)
+	}
+	groups := make([]*types.WorkflowExecutionGroup, 0, len(rows))
+	for _, row := range rows {
+		groups = append(groups, &types.WorkflowExecutionGroup{
+			Key:   groupKey(groupBy, row.GroupKey),
+			Count: row.GroupCount,
+		})
+	}
+	return &p.AggregateWorkflowExecutionsResponse{Groups: groups}, nil
+}
+
+// groupKey formats bool values the same way for all the databases, some of them return 1 and 0 for bool fields
+func groupKey(groupBy sqlplugin.VisibilityQueryField, key string) string {
+	if groupBy.ValueType != types.IndexedValueTypeBool {
+		return key
+	}
+	value, err := strconv.ParseBool(key)
+	if err != nil {
+		return key
+	}
+	return strconv.FormatBool(value)
+}
+
+func (s *sqlVisibilityStore) listWorkflowExecutionsByQuery(
+	ctx context.Context,
+	opName string,
+	request *p.ListWorkflowExecutionsByQueryRequest,
+) (*p.InternalListWorkflowExecutionsResponse, error) {
+	filter, err := newVisibilityQueryConverter(s.validSearchAttributes(), s.logger).convert(request.DomainUUID, request.Query)
+	if err != nil {
+		return nil, err
+	}
+	var token visibilityQueryPageToken
+	if len(request.NextPageToken) > 0 {
+		if err := json.Unmarshal(request.NextPageToken, &token); err != nil {
+			return nil, &types.BadRequestError{
+				Message: fmt.Sprintf("%v: unable to deserialize page token. err: %v", opName, err),
+			}
+		}
+	}
+	filter.Offset = token.Offset
+	filter.PageSize = request.PageSize
+
+	rows, err := s.db.SelectFromVisibilityByQuery(ctx, filter)
+	if err != nil {
+		return nil, convertCommonErrors(s.db, opName, "", err)
+	}
+	infos := make([]*p.InternalVisibilityWorkflowExecutionInfo, len(rows))
+	for i := range rows {
+		infos[i] = s.rowToInfo(&rows[i])
+	}
+	var nextPageToken []byte
+	if len(rows) == request.PageSize {
+		nextPageToken, err = json.Marshal(&visibilityQueryPageToken{Offset: token.Offset + len(rows)})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &p.InternalListWorkflowExecutionsResponse{
+		Executions:    infos,
+		NextPageToken: nextPageToken,
+	}, nil
 }
 
 func (s *sqlVisibilityStore) rowToInfo(row *sqlplugin.VisibilityRow) *p.InternalVisibilityWorkflowExecutionInfo {
@@ -383,9 +518,53 @@ func (s *sqlVisibilityStore) rowToInfo(row *sqlplugin.VisibilityRow) *p.Internal
 		info.CloseTime = *row.CloseTime
 		info.HistoryLength = *row.HistoryLength
 	}
+	if len(row.SearchAttributes) > 0 {
+		decoder := json.NewDecoder(bytes.NewReader(row.SearchAttributes))
+		decoder.UseNumber()
+		if err := decoder.Decode(&info.SearchAttributes); err != nil {
+			s.logger.Error("failed to decode search attributes of visibility record", tag.WorkflowRunID(row.RunID), tag.Error(err))
+		}
+	}
 	return info
 }
 
+// serializeSearchAttributes stores the JSON encoded values of the search attributes as a single JSON object.
+// The values of datetime type are converted to unix nanoseconds, so that they are compared as numbers by the queries.
+func (s *sqlVisibilityStore) serializeSearchAttributes(searchAttributes map[string][]byte) ([]byte, error) {
+	if len(searchAttributes) == 0 {
+		return nil, nil
+	}
+	validSearchAttributes := s.validSearchAttributes()
+	values := make(map[string]interface{}, len(searchAttributes))
+	for key, data := range searchAttributes {
+		var value interface{}
+		decoder := json.NewDecoder(bytes.NewReader(data))
+		decoder.UseNumber()
+		if err := decoder.Decode(&value); err != nil {
+			return nil, &types.InternalServiceError{
+				Message: fmt.Sprintf("failed to decode search attribute %v: %v", key, err),
+			}
+		}
+		if fieldType, ok := validSearchAttributes[key]; ok &&
+			common.ConvertIndexedValueTypeToInternalType(fieldType, s.logger) == types.IndexedValueTypeDatetime {
+			if str, ok := value.(string); ok {
+				if t, err := time.Parse(time.RFC3339Nano, str); err == nil {
+					value = t.UnixNano()
+				}
+			}
+		}
+		values[key] = value
+	}
+	return json.Marshal(values)
+}
+
+func (s *sqlVisibilityStore) validSearchAttributes() map[string]interface{} {
+	if s.dc == nil || s.dc.ValidSearchAttributes == nil {
+		return definition.GetDefaultIndexedKeys()
+	}
+	return s.dc.ValidSearchAttributes()
+}
+
 func (s *sqlVisibilityStore) listWorkflowExecutions(opName string, pageToken []byte, earliestTime time.Time, latestTime time.Time, selectOp func(readLevel *visibilityPageToken) ([]sqlplugin.VisibilityRow, error)) (*p.InternalListWorkflowExecutionsResponse, error) {
 	var readLevel *visibilityPageToken
 	var err error
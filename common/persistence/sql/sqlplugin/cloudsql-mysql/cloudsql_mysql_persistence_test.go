@@ -108,7 +108,7 @@ func TestCloudSQLMySQLExecutionManagerWithEventsV2(t *testing.T) {
 
 func TestCloudSQLMySQLVisibilityPersistenceSuite(t *testing.T) {
 	testflags.RequireMySQL(t)
-	s := new(pt.DBVisibilityPersistenceSuite)
+	s := new(pt.SQLVisibilityPersistenceSuite)
 	option, err := GetTestClusterOption()
 	assert.NoError(t, err)
 	s.TestBase = pt.NewTestBaseWithSQL(t, option)
@@ -114,6 +114,21 @@ func (m *MocktableCRUD) EXPECT() *MocktableCRUDMockRecorder {
 	return m.recorder
 }
 
+// CountFromVisibilityByQuery mocks base method.
+func (m *MocktableCRUD) CountFromVisibilityByQuery(ctx context.Context, filter *VisibilityQueryFilter) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountFromVisibilityByQuery", ctx, filter)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountFromVisibilityByQuery indicates an expected call of CountFromVisibilityByQuery.
+func (mr *MocktableCRUDMockRecorder) CountFromVisibilityByQuery(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountFromVisibilityByQuery", reflect.TypeOf((*MocktableCRUD)(nil).CountFromVisibilityByQuery), ctx, filter)
+}
+
 // DeleteFromActiveClusterSelectionPolicy mocks base method.
 func (m *MocktableCRUD) DeleteFromActiveClusterSelectionPolicy(ctx context.Context, filter *ActiveClusterSelectionPolicyFilter) (sql.Result, error) {
 	m.ctrl.T.Helper()
@@ -1146,6 +1161,21 @@ func (mr *MocktableCRUDMockRecorder) ReplaceIntoChildExecutionInfoMaps(ctx, rows
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceIntoChildExecutionInfoMaps", reflect.TypeOf((*MocktableCRUD)(nil).ReplaceIntoChildExecutionInfoMaps), ctx, rows)
 }
 
+// ReplaceIntoHistoryNode mocks base method.
+func (m *MocktableCRUD) ReplaceIntoHistoryNode(ctx context.Context, row *HistoryNodeRow) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceIntoHistoryNode", ctx, row)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReplaceIntoHistoryNode indicates an expected call of ReplaceIntoHistoryNode.
+func (mr *MocktableCRUDMockRecorder) ReplaceIntoHistoryNode(ctx, row any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceIntoHistoryNode", reflect.TypeOf((*MocktableCRUD)(nil).ReplaceIntoHistoryNode), ctx, row)
+}
+
 // ReplaceIntoRequestCancelInfoMaps mocks base method.
 func (m *MocktableCRUD) ReplaceIntoRequestCancelInfoMaps(ctx context.Context, rows []RequestCancelInfoMapsRow) (sql.Result, error) {
 	m.ctrl.T.Helper()
@@ -1581,6 +1611,36 @@ func (mr *MocktableCRUDMockRecorder) SelectFromVisibility(ctx, filter any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFromVisibility", reflect.TypeOf((*MocktableCRUD)(nil).SelectFromVisibility), ctx, filter)
 }
 
+// SelectFromVisibilityByQuery mocks base method.
+func (m *MocktableCRUD) SelectFromVisibilityByQuery(ctx context.Context, filter *VisibilityQueryFilter) ([]VisibilityRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectFromVisibilityByQuery", ctx, filter)
+	ret0, _ := ret[0].([]VisibilityRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectFromVisibilityByQuery indicates an expected call of SelectFromVisibilityByQuery.
+func (mr *MocktableCRUDMockRecorder) SelectFromVisibilityByQuery(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFromVisibilityByQuery", reflect.TypeOf((*MocktableCRUD)(nil).SelectFromVisibilityByQuery), ctx, filter)
+}
+
+// SelectGroupsFromVisibilityByQuery mocks base method.
+func (m *MocktableCRUD) SelectGroupsFromVisibilityByQuery(ctx context.Context, filter *VisibilityQueryFilter, groupBy VisibilityQueryField) ([]VisibilityGroupRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectGroupsFromVisibilityByQuery", ctx, filter, groupBy)
+	ret0, _ := ret[0].([]VisibilityGroupRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectGroupsFromVisibilityByQuery indicates an expected call of SelectGroupsFromVisibilityByQuery.
+func (mr *MocktableCRUDMockRecorder) SelectGroupsFromVisibilityByQuery(ctx, filter, groupBy any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectGroupsFromVisibilityByQuery", reflect.TypeOf((*MocktableCRUD)(nil).SelectGroupsFromVisibilityByQuery), ctx, filter, groupBy)
+}
+
 // SelectLatestConfig mocks base method.
 func (m *MocktableCRUD) SelectLatestConfig(ctx context.Context, rowType int) (*persistence.InternalConfigStoreEntry, error) {
 	m.ctrl.T.Helper()
@@ -1743,6 +1803,21 @@ func (mr *MocktableCRUDMockRecorder) UpdateTaskListsWithTTL(ctx, row any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTaskListsWithTTL", reflect.TypeOf((*MocktableCRUD)(nil).UpdateTaskListsWithTTL), ctx, row)
 }
 
+// UpsertIntoVisibility mocks base method.
+func (m *MocktableCRUD) UpsertIntoVisibility(ctx context.Context, row *VisibilityRow) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertIntoVisibility", ctx, row)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertIntoVisibility indicates an expected call of UpsertIntoVisibility.
+func (mr *MocktableCRUDMockRecorder) UpsertIntoVisibility(ctx, row any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertIntoVisibility", reflect.TypeOf((*MocktableCRUD)(nil).UpsertIntoVisibility), ctx, row)
+}
+
 // WriteLockExecutions mocks base method.
 func (m *MocktableCRUD) WriteLockExecutions(ctx context.Context, filter *ExecutionsFilter) (int, error) {
 	m.ctrl.T.Helper()
@@ -2012,6 +2087,21 @@ func (mr *MockTxMockRecorder) Commit() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Commit", reflect.TypeOf((*MockTx)(nil).Commit))
 }
 
+// CountFromVisibilityByQuery mocks base method.
+func (m *MockTx) CountFromVisibilityByQuery(ctx context.Context, filter *VisibilityQueryFilter) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountFromVisibilityByQuery", ctx, filter)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountFromVisibilityByQuery indicates an expected call of CountFromVisibilityByQuery.
+func (mr *MockTxMockRecorder) CountFromVisibilityByQuery(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountFromVisibilityByQuery", reflect.TypeOf((*MockTx)(nil).CountFromVisibilityByQuery), ctx, filter)
+}
+
 // DeleteFromActiveClusterSelectionPolicy mocks base method.
 func (m *MockTx) DeleteFromActiveClusterSelectionPolicy(ctx context.Context, filter *ActiveClusterSelectionPolicyFilter) (sql.Result, error) {
 	m.ctrl.T.Helper()
@@ -3100,6 +3190,21 @@ func (mr *MockTxMockRecorder) ReplaceIntoChildExecutionInfoMaps(ctx, rows any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceIntoChildExecutionInfoMaps", reflect.TypeOf((*MockTx)(nil).ReplaceIntoChildExecutionInfoMaps), ctx, rows)
 }
 
+// ReplaceIntoHistoryNode mocks base method.
+func (m *MockTx) ReplaceIntoHistoryNode(ctx context.Context, row *HistoryNodeRow) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceIntoHistoryNode", ctx, row)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReplaceIntoHistoryNode indicates an expected call of ReplaceIntoHistoryNode.
+func (mr *MockTxMockRecorder) ReplaceIntoHistoryNode(ctx, row any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceIntoHistoryNode", reflect.TypeOf((*MockTx)(nil).ReplaceIntoHistoryNode), ctx, row)
+}
+
 // ReplaceIntoRequestCancelInfoMaps mocks base method.
 func (m *MockTx) ReplaceIntoRequestCancelInfoMaps(ctx context.Context, rows []RequestCancelInfoMapsRow) (sql.Result, error) {
 	m.ctrl.T.Helper()
@@ -3549,6 +3654,36 @@ func (mr *MockTxMockRecorder) SelectFromVisibility(ctx, filter any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFromVisibility", reflect.TypeOf((*MockTx)(nil).SelectFromVisibility), ctx, filter)
 }
 
+// SelectFromVisibilityByQuery mocks base method.
+func (m *MockTx) SelectFromVisibilityByQuery(ctx context.Context, filter *VisibilityQueryFilter) ([]VisibilityRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectFromVisibilityByQuery", ctx, filter)
+	ret0, _ := ret[0].([]VisibilityRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectFromVisibilityByQuery indicates an expected call of SelectFromVisibilityByQuery.
+func (mr *MockTxMockRecorder) SelectFromVisibilityByQuery(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFromVisibilityByQuery", reflect.TypeOf((*MockTx)(nil).SelectFromVisibilityByQuery), ctx, filter)
+}
+
+// SelectGroupsFromVisibilityByQuery mocks base method.
+func (m *MockTx) SelectGroupsFromVisibilityByQuery(ctx context.Context, filter *VisibilityQueryFilter, groupBy VisibilityQueryField) ([]VisibilityGroupRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectGroupsFromVisibilityByQuery", ctx, filter, groupBy)
+	ret0, _ := ret[0].([]VisibilityGroupRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectGroupsFromVisibilityByQuery indicates an expected call of SelectGroupsFromVisibilityByQuery.
+func (mr *MockTxMockRecorder) SelectGroupsFromVisibilityByQuery(ctx, filter, groupBy any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectGroupsFromVisibilityByQuery", reflect.TypeOf((*MockTx)(nil).SelectGroupsFromVisibilityByQuery), ctx, filter, groupBy)
+}
+
 // SelectLatestConfig mocks base method.
 func (m *MockTx) SelectLatestConfig(ctx context.Context, rowType int) (*persistence.InternalConfigStoreEntry, error) {
 	m.ctrl.T.Helper()
@@ -3711,6 +3846,21 @@ func (mr *MockTxMockRecorder) UpdateTaskListsWithTTL(ctx, row any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTaskListsWithTTL", reflect.TypeOf((*MockTx)(nil).UpdateTaskListsWithTTL), ctx, row)
 }
 
+// UpsertIntoVisibility mocks base method.
+func (m *MockTx) UpsertIntoVisibility(ctx context.Context, row *VisibilityRow) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertIntoVisibility", ctx, row)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertIntoVisibility indicates an expected call of UpsertIntoVisibility.
+func (mr *MockTxMockRecorder) UpsertIntoVisibility(ctx, row any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertIntoVisibility", reflect.TypeOf((*MockTx)(nil).UpsertIntoVisibility), ctx, row)
+}
+
 // WriteLockExecutions mocks base method.
 func (m *MockTx) WriteLockExecutions(ctx context.Context, filter *ExecutionsFilter) (int, error) {
 	m.ctrl.T.Helper()
@@ -3794,6 +3944,21 @@ func (mr *MockDBMockRecorder) Close() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockDB)(nil).Close))
 }
 
+// CountFromVisibilityByQuery mocks base method.
+func (m *MockDB) CountFromVisibilityByQuery(ctx context.Context, filter *VisibilityQueryFilter) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountFromVisibilityByQuery", ctx, filter)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountFromVisibilityByQuery indicates an expected call of CountFromVisibilityByQuery.
+func (mr *MockDBMockRecorder) CountFromVisibilityByQuery(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountFromVisibilityByQuery", reflect.TypeOf((*MockDB)(nil).CountFromVisibilityByQuery), ctx, filter)
+}
+
 // DeleteFromActiveClusterSelectionPolicy mocks base method.
 func (m *MockDB) DeleteFromActiveClusterSelectionPolicy(ctx context.Context, filter *ActiveClusterSelectionPolicyFilter) (sql.Result, error) {
 	m.ctrl.T.Helper()
@@ -4910,6 +5075,21 @@ func (mr *MockDBMockRecorder) ReplaceIntoChildExecutionInfoMaps(ctx, rows any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceIntoChildExecutionInfoMaps", reflect.TypeOf((*MockDB)(nil).ReplaceIntoChildExecutionInfoMaps), ctx, rows)
 }
 
+// ReplaceIntoHistoryNode mocks base method.
+func (m *MockDB) ReplaceIntoHistoryNode(ctx context.Context, row *HistoryNodeRow) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceIntoHistoryNode", ctx, row)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReplaceIntoHistoryNode indicates an expected call of ReplaceIntoHistoryNode.
+func (mr *MockDBMockRecorder) ReplaceIntoHistoryNode(ctx, row any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceIntoHistoryNode", reflect.TypeOf((*MockDB)(nil).ReplaceIntoHistoryNode), ctx, row)
+}
+
 // ReplaceIntoRequestCancelInfoMaps mocks base method.
 func (m *MockDB) ReplaceIntoRequestCancelInfoMaps(ctx context.Context, rows []RequestCancelInfoMapsRow) (sql.Result, error) {
 	m.ctrl.T.Helper()
@@ -5345,6 +5525,36 @@ func (mr *MockDBMockRecorder) SelectFromVisibility(ctx, filter any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFromVisibility", reflect.TypeOf((*MockDB)(nil).SelectFromVisibility), ctx, filter)
 }
 
+// SelectFromVisibilityByQuery mocks base method.
+func (m *MockDB) SelectFromVisibilityByQuery(ctx context.Context, filter *VisibilityQueryFilter) ([]VisibilityRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectFromVisibilityByQuery", ctx, filter)
+	ret0, _ := ret[0].([]VisibilityRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectFromVisibilityByQuery indicates an expected call of SelectFromVisibilityByQuery.
+func (mr *MockDBMockRecorder) SelectFromVisibilityByQuery(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFromVisibilityByQuery", reflect.TypeOf((*MockDB)(nil).SelectFromVisibilityByQuery), ctx, filter)
+}
+
+// SelectGroupsFromVisibilityByQuery mocks base method.
+func (m *MockDB) SelectGroupsFromVisibilityByQuery(ctx context.Context, filter *VisibilityQueryFilter, groupBy VisibilityQueryField) ([]VisibilityGroupRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectGroupsFromVisibilityByQuery", ctx, filter, groupBy)
+	ret0, _ := ret[0].([]VisibilityGroupRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectGroupsFromVisibilityByQuery indicates an expected call of SelectGroupsFromVisibilityByQuery.
+func (mr *MockDBMockRecorder) SelectGroupsFromVisibilityByQuery(ctx, filter, groupBy any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectGroupsFromVisibilityByQuery", reflect.TypeOf((*MockDB)(nil).SelectGroupsFromVisibilityByQuery), ctx, filter, groupBy)
+}
+
 // SelectLatestConfig mocks base method.
 func (m *MockDB) SelectLatestConfig(ctx context.Context, rowType int) (*persistence.InternalConfigStoreEntry, error) {
 	m.ctrl.T.Helper()
@@ -5507,6 +5717,21 @@ func (mr *MockDBMockRecorder) UpdateTaskListsWithTTL(ctx, row any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTaskListsWithTTL", reflect.TypeOf((*MockDB)(nil).UpdateTaskListsWithTTL), ctx, row)
 }
 
+// UpsertIntoVisibility mocks base method.
+func (m *MockDB) UpsertIntoVisibility(ctx context.Context, row *VisibilityRow) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertIntoVisibility", ctx, row)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertIntoVisibility indicates an expected call of UpsertIntoVisibility.
+func (mr *MockDBMockRecorder) UpsertIntoVisibility(ctx, row any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertIntoVisibility", reflect.TypeOf((*MockDB)(nil).UpsertIntoVisibility), ctx, row)
+}
+
 // WriteLockExecutions mocks base method.
 func (m *MockDB) WriteLockExecutions(ctx context.Context, filter *ExecutionsFilter) (int, error) {
 	m.ctrl.T.Helper()
@@ -567,6 +567,13 @@ type (
 		ShardID                int16
 		ExecutionStatus        int32
 		ScheduledExecutionTime time.Time
+		SearchAttributes       []byte
+	}
+
+	// VisibilityGroupRow is the number of rows of visibility table sharing the same value of a field
+	VisibilityGroupRow struct {
+		GroupKey   string
+		GroupCount int64
 	}
 
 	// VisibilityFilter contains the column names within executions_visibility table that
@@ -683,6 +690,7 @@ type (
 
 		// eventsV2
 		InsertIntoHistoryNode(ctx context.Context, row *HistoryNodeRow) (sql.Result, error)
+		ReplaceIntoHistoryNode(ctx context.Context, row *HistoryNodeRow) (sql.Result, error)
 		SelectFromHistoryNode(ctx context.Context, filter *HistoryNodeFilter) ([]HistoryNodeRow, error)
 		DeleteFromHistoryNode(ctx context.Context, filter *HistoryNodeFilter) (sql.Result, error)
 		InsertIntoHistoryTree(ctx context.Context, row *HistoryTreeRow) (sql.Result, error)
@@ -859,6 +867,19 @@ type (
 		//     - workflowID, workflowTypeName, closeStatus (along with closed=true)
 		SelectFromVisibility(ctx context.Context, filter *VisibilityFilter) ([]VisibilityRow, error)
 		DeleteFromVisibility(ctx context.Context, filter *VisibilityFilter) (sql.Result, error)
+		// UpsertIntoVisibility inserts a row into visibility table. If the row already exist and is still open,
+		// its search attributes, memo, update time and execution status are updated
+		UpsertIntoVisibility(ctx context.Context, row *VisibilityRow) (sql.Result, error)
+		// SelectFromVisibilityByQuery returns a page of the rows of visibility table matching a query
+		// Required filter params: {domainID, pageSize, orderBy}
+		SelectFromVisibilityByQuery(ctx context.Context, filter *VisibilityQueryFilter) ([]VisibilityRow, error)
+		// CountFromVisibilityByQuery returns the number of rows of visibility table matching a query
+		// Required filter params: {domainID}
+		CountFromVisibilityByQuery(ctx context.Context, filter *VisibilityQueryFilter) (int64, error)
+		// SelectGroupsFromVisibilityByQuery returns the number of rows of visibility table matching a query for each value of a field,
+		// largest groups first. The rows without a value of the field are not grouped.
+		// Required filter params: {domainID, pageSize}, pageSize is the maximum number of groups returned
+		SelectGroupsFromVisibilityByQuery(ctx context.Context, filter *VisibilityQueryFilter, groupBy VisibilityQueryField) ([]VisibilityGroupRow, error)
 
 		InsertIntoQueue(ctx context.Context, row *QueueRow) (sql.Result, error)
 		GetLastEnqueuedMessageIDForUpdate(ctx context.Context, queueType persistence.QueueType) (int64, error)
@@ -33,6 +33,10 @@ const (
 		`shard_id, tree_id, branch_id, node_id, txn_id, data, data_encoding) ` +
 		`VALUES (:shard_id, :tree_id, :branch_id, :node_id, :txn_id, :data, :data_encoding) `
 
+	replaceHistoryNodesQuery = `REPLACE INTO history_node (` +
+		`shard_id, tree_id, branch_id, node_id, txn_id, data, data_encoding) ` +
+		`VALUES (:shard_id, :tree_id, :branch_id, :node_id, :txn_id, :data, :data_encoding) `
+
 	getHistoryNodesQuery = `SELECT node_id, txn_id, data, data_encoding FROM history_node ` +
 		`WHERE shard_id = ? AND tree_id = ? AND branch_id = ? AND node_id >= ? and node_id < ? ORDER BY shard_id, tree_id, branch_id, node_id, txn_id LIMIT ? `
 
@@ -62,6 +66,13 @@ func (mdb *DB) InsertIntoHistoryNode(ctx context.Context, row *sqlplugin.History
 	return mdb.driver.NamedExecContext(ctx, dbShardID, addHistoryNodesQuery, row)
 }
 
+// ReplaceIntoHistoryNode replaces a row of history_node table, the row is inserted if it doesn't exist
+func (mdb *DB) ReplaceIntoHistoryNode(ctx context.Context, row *sqlplugin.HistoryNodeRow) (sql.Result, error) {
+	*row.TxnID *= -1
+	dbShardID := sqlplugin.GetDBShardIDFromTreeID(row.TreeID, mdb.GetTotalNumDBShards())
+	return mdb.driver.NamedExecContext(ctx, dbShardID, replaceHistoryNodesQuery, row)
+}
+
 // SelectFromHistoryNode reads one or more rows from history_node table
 func (mdb *DB) SelectFromHistoryNode(ctx context.Context, filter *sqlplugin.HistoryNodeFilter) ([]sqlplugin.HistoryNodeRow, error) {
 	var rows []sqlplugin.HistoryNodeRow
@@ -25,18 +25,31 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/uber/cadence/common/persistence/sql/sqlplugin"
+	"github.com/uber/cadence/common/types"
 )
 
 const (
 	templateCreateWorkflowExecutionStarted = `INSERT IGNORE INTO executions_visibility (` +
-		`domain_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, memo, encoding, is_cron, num_clusters, update_time, shard_id, execution_status, cron_schedule, scheduled_execution_time) ` +
-		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+		`domain_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, memo, encoding, is_cron, num_clusters, update_time, shard_id, execution_status, cron_schedule, scheduled_execution_time, search_attributes) ` +
+		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
 
 	templateCreateWorkflowExecutionClosed = `REPLACE INTO executions_visibility (` +
-		`domain_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, close_time, close_status, history_length, memo, encoding, is_cron, num_clusters, update_time, shard_id, execution_status, cron_schedule, scheduled_execution_time) ` +
-		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+		`domain_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, close_time, close_status, history_length, memo, encoding, is_cron, num_clusters, update_time, shard_id, execution_status, cron_schedule, scheduled_execution_time, search_attributes) ` +
+		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+
+	// the fields of a closed row are not updated, as a late upsert must not overwrite the final state of the workflow
+	templateUpsertWorkflowExecution = `INSERT INTO executions_visibility (` +
+		`domain_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, memo, encoding, is_cron, num_clusters, update_time, shard_id, execution_status, cron_schedule, scheduled_execution_time, search_attributes) ` +
+		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+		 ON DUPLICATE KEY UPDATE
+		   search_attributes = IF(close_status IS NULL, VALUES(search_attributes), search_attributes),
+		   memo = IF(close_status IS NULL, VALUES(memo), memo),
+		   encoding = IF(close_status IS NULL, VALUES(encoding), encoding),
+		   update_time = IF(close_status IS NULL, VALUES(update_time), update_time),
+		   execution_status = IF(close_status IS NULL, VALUES(execution_status), execution_status)`
 
 	// RunID condition is needed for correct pagination
 	templateConditions = ` AND domain_id = ?
@@ -72,6 +85,17 @@ const (
 		 AND run_id = ?`
 
 	templateDeleteWorkflowExecution = "DELETE FROM executions_visibility WHERE domain_id=? AND run_id=?"
+
+	// the columns added after the first version of the table may be NULL in older rows
+	templateQueryFieldNames = `workflow_id, run_id, start_time, execution_time, workflow_type_name, close_time, close_status, history_length,
+		 memo, encoding, is_cron, COALESCE(num_clusters, 0) AS num_clusters, update_time, COALESCE(shard_id, 0) AS shard_id,
+		 COALESCE(execution_status, 0) AS execution_status, COALESCE(cron_schedule, '') AS cron_schedule, search_attributes`
+
+	templateSelectFromVisibilityByQuery = `SELECT ` + templateQueryFieldNames + ` FROM executions_visibility WHERE domain_id = ?`
+
+	templateCountFromVisibilityByQuery = `SELECT COUNT(*) FROM executions_visibility WHERE domain_id = ?`
+
+	templateSelectGroupsFromVisibilityByQuery = `SELECT %s AS group_key, COUNT(*) AS group_count FROM executions_visibility WHERE domain_id = ?`
 )
 
 var errCloseParams = errors.New("missing one of {closeStatus, closeTime, historyLength} params")
@@ -99,7 +123,8 @@ func (mdb *DB) InsertIntoVisibility(ctx context.Context, row *sqlplugin.Visibili
 		row.ShardID,
 		row.ExecutionStatus,
 		row.CronSchedule,
-		scheduledExecutionTime)
+		scheduledExecutionTime,
+		searchAttributesArg(row.SearchAttributes))
 }
 
 // ReplaceIntoVisibility replaces an existing row if it exist or creates a new row in visibility table
@@ -130,7 +155,8 @@ func (mdb *DB) ReplaceIntoVisibility(ctx context.Context, row *sqlplugin.Visibil
 			row.ShardID,
 			row.ExecutionStatus,
 			row.CronSchedule,
-			scheduledExecutionTime)
+			scheduledExecutionTime,
+			searchAttributesArg(row.SearchAttributes))
 	default:
 		return nil, errCloseParams
 	}
@@ -235,3 +261,138 @@ func (mdb *DB) SelectFromVisibility(ctx context.Context, filter *sqlplugin.Visib
 	}
 	return rows, err
 }
+
+// UpsertIntoVisibility inserts a row into visibility table. If the row already exist and is still open,
+// its search attributes, memo, update time and execution status are updated
+func (mdb *DB) UpsertIntoVisibility(ctx context.Context, row *sqlplugin.VisibilityRow) (sql.Result, error) {
+	row.StartTime = mdb.converter.ToDateTime(row.StartTime)
+	scheduledExecutionTime := mdb.converter.ToDateTime(row.ScheduledExecutionTime)
+	dbShardID := sqlplugin.GetDBShardIDFromDomainID(row.DomainID, mdb.GetTotalNumDBShards())
+	return mdb.driver.ExecContext(ctx,
+		dbShardID,
+		templateUpsertWorkflowExecution,
+		row.DomainID,
+		row.WorkflowID,
+		row.RunID,
+		row.StartTime,
+		row.ExecutionTime,
+		row.WorkflowTypeName,
+		row.Memo,
+		row.Encoding,
+		row.IsCron,
+		row.NumClusters,
+		row.UpdateTime,
+		row.ShardID,
+		row.ExecutionStatus,
+		row.CronSchedule,
+		scheduledExecutionTime,
+		searchAttributesArg(row.SearchAttributes))
+}
+
+// SelectFromVisibilityByQuery reads a page of the rows of visibility table matching a query
+func (mdb *DB) SelectFromVisibilityByQuery(ctx context.Context, filter *sqlplugin.VisibilityQueryFilter) ([]sqlplugin.VisibilityRow, error) {
+	condition, orderBy, args, err := sqlplugin.BuildVisibilityQueryClauses(visibilityQueryDialect{converter: mdb.converter}, filter, 2)
+	if err != nil {
+		return nil, err
+	}
+	query := templateSelectFromVisibilityByQuery
+	if condition != "" {
+		query += " AND " + condition
+	}
+	query += " ORDER BY " + orderBy + " LIMIT ? OFFSET ?"
+	args = append(append([]interface{}{filter.DomainID}, args...), filter.PageSize, filter.Offset)
+
+	dbShardID := sqlplugin.GetDBShardIDFromDomainID(filter.DomainID, mdb.GetTotalNumDBShards())
+	var rows []sqlplugin.VisibilityRow
+	if err := mdb.driver.SelectContext(ctx, dbShardID, &rows, query, args...); err != nil {
+		return nil, err
+	}
+	for i := range rows {
+		rows[i].StartTime = mdb.converter.FromDateTime(rows[i].StartTime)
+		rows[i].ExecutionTime = mdb.converter.FromDateTime(rows[i].ExecutionTime)
+		if rows[i].CloseTime != nil {
+			closeTime := mdb.converter.FromDateTime(*rows[i].CloseTime)
+			rows[i].CloseTime = &closeTime
+		}
+	}
+	return rows, nil
+}
+
+// CountFromVisibilityByQuery returns the number of rows of visibility table matching a query
+func (mdb *DB) CountFromVisibilityByQuery(ctx context.Context, filter *sqlplugin.VisibilityQueryFilter) (int64, error) {
+	condition, _, args, err := sqlplugin.BuildVisibilityQueryClauses(visibilityQueryDialect{converter: mdb.converter}, &sqlplugin.VisibilityQueryFilter{
+		DomainID:  filter.DomainID,
+		Condition: filter.Condition,
+	}, 2)
+	if err != nil {
+		return 0, err
+	}
+	query := templateCountFromVisibilityByQuery
+	if condition != "" {
+		query += " AND " + condition
+	}
+	args = append([]interface{}{filter.DomainID}, args...)
+
+	dbShardID := sqlplugin.GetDBShardIDFromDomainID(filter.DomainID, mdb.GetTotalNumDBShards())
+	var count int64
+	err = mdb.driver.GetContext(ctx, dbShardID, &count, query, args...)
+	return count, err
+}
+
+// SelectGroupsFromVisibilityByQuery returns the number of rows of visibility table matching a query for each value of a field
+func (mdb *DB) SelectGroupsFromVisibilityByQuery(
+	ctx context.Context,
+	filter *sqlplugin.VisibilityQueryFilter,
+	groupBy sqlplugin.VisibilityQueryField,
+) ([]sqlplugin.VisibilityGroupRow, error) {
+	dialect := visibilityQueryDialect{converter: mdb.converter}
+	groupKey, condition, args, err := sqlplugin.BuildVisibilityQueryGroupClauses(dialect, filter, groupBy, 2)
+	if err != nil {
+		return nil, err
+	}
+	query := fmt.Sprintf(templateSelectGroupsFromVisibilityByQuery, groupKey) + " AND " + condition +
+		" GROUP BY group_key ORDER BY group_count DESC, group_key LIMIT ?"
+	args = append(append([]interface{}{filter.DomainID}, args...), filter.PageSize)
+
+	dbShardID := sqlplugin.GetDBShardIDFromDomainID(filter.DomainID, mdb.GetTotalNumDBShards())
+	var rows []sqlplugin.VisibilityGroupRow
+	if err := mdb.driver.SelectContext(ctx, dbShardID, &rows, query, args...); err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// visibilityQueryDialect reads the custom search attributes from the search_attributes JSON column
+type visibilityQueryDialect struct {
+	converter DataConverter
+}
+
+func (visibilityQueryDialect) Placeholder(int) string {
+	return "?"
+}
+
+func (d visibilityQueryDialect) DateTime(t time.Time) time.Time {
+	return d.converter.ToDateTime(t)
+}
+
+func (visibilityQueryDialect) SearchAttribute(key string, valueType types.IndexedValueType) string {
+	switch valueType {
+	case types.IndexedValueTypeInt, types.IndexedValueTypeDatetime:
+		return fmt.Sprintf(`CAST(search_attributes->'$."%s"' AS SIGNED)`, key)
+	case types.IndexedValueTypeDouble:
+		return fmt.Sprintf(`CAST(search_attributes->'$."%s"' AS DOUBLE)`, key)
+	case types.IndexedValueTypeBool:
+		return fmt.Sprintf(`(search_attributes->>'$."%s"' = 'true')`, key)
+	default:
+		return fmt.Sprintf(`search_attributes->>'$."%s"'`, key)
+	}
+}
+
+// searchAttributesArg returns the search attributes as a string, since the driver sends []byte as binary
+// which is rejected by JSON columns
+func searchAttributesArg(searchAttributes []byte) interface{} {
+	if len(searchAttributes) == 0 {
+		return nil
+	}
+	return string(searchAttributes)
+}
@@ -33,6 +33,9 @@ const (
 		`shard_id, tree_id, branch_id, node_id, txn_id, data, data_encoding) ` +
 		`VALUES (:shard_id, :tree_id, :branch_id, :node_id, :txn_id, :data, :data_encoding) `
 
+	replaceHistoryNodesQuery = addHistoryNodesQuery +
+		`ON CONFLICT (shard_id, tree_id, branch_id, node_id, txn_id) DO UPDATE SET data = excluded.data, data_encoding = excluded.data_encoding`
+
 	getHistoryNodesQuery = `SELECT node_id, txn_id, data, data_encoding FROM history_node ` +
 		`WHERE shard_id = $1 AND tree_id = $2 AND branch_id = $3 AND node_id >= $4 and node_id < $5 ORDER BY shard_id, tree_id, branch_id, node_id, txn_id LIMIT $6 `
 
@@ -63,6 +66,13 @@ func (pdb *db) InsertIntoHistoryNode(ctx context.Context, row *sqlplugin.History
 	return pdb.driver.NamedExecContext(ctx, dbShardID, addHistoryNodesQuery, row)
 }
 
+// ReplaceIntoHistoryNode replaces a row of history_node table, the row is inserted if it doesn't exist
+func (pdb *db) ReplaceIntoHistoryNode(ctx context.Context, row *sqlplugin.HistoryNodeRow) (sql.Result, error) {
+	dbShardID := sqlplugin.GetDBShardIDFromTreeID(row.TreeID, pdb.GetTotalNumDBShards())
+	*row.TxnID *= -1
+	return pdb.driver.NamedExecContext(ctx, dbShardID, replaceHistoryNodesQuery, row)
+}
+
 // SelectFromHistoryNode reads one or more rows from history_node table
 func (pdb *db) SelectFromHistoryNode(ctx context.Context, filter *sqlplugin.HistoryNodeFilter) ([]sqlplugin.HistoryNodeRow, error) {
 	dbShardID := sqlplugin.GetDBShardIDFromTreeID(filter.TreeID, pdb.GetTotalNumDBShards())
@@ -26,19 +26,21 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/uber/cadence/common/persistence/sql/sqlplugin"
+	"github.com/uber/cadence/common/types"
 )
 
 const (
 	templateCreateWorkflowExecutionStarted = `INSERT INTO executions_visibility (` +
-		`domain_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, memo, encoding, is_cron, num_clusters, update_time, shard_id, execution_status, cron_schedule, scheduled_execution_time) ` +
-		`VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)
+		`domain_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, memo, encoding, is_cron, num_clusters, update_time, shard_id, execution_status, cron_schedule, scheduled_execution_time, search_attributes) ` +
+		`VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16)
          ON CONFLICT (domain_id, run_id) DO NOTHING`
 
 	templateCreateWorkflowExecutionClosed = `INSERT INTO executions_visibility (` +
-		`domain_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, close_time, close_status, history_length, memo, encoding, is_cron, num_clusters, update_time, shard_id, execution_status, cron_schedule, scheduled_execution_time) ` +
-		`VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18)
+		`domain_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, close_time, close_status, history_length, memo, encoding, is_cron, num_clusters, update_time, shard_id, execution_status, cron_schedule, scheduled_execution_time, search_attributes) ` +
+		`VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19)
 		ON CONFLICT (domain_id, run_id) DO UPDATE
 		  SET workflow_id = excluded.workflow_id,
 		      start_time = excluded.start_time,
@@ -55,7 +57,20 @@ const (
 				shard_id = excluded.shard_id,
 				execution_status = excluded.execution_status,
 				cron_schedule = excluded.cron_schedule,
-				scheduled_execution_time = excluded.scheduled_execution_time`
+				scheduled_execution_time = excluded.scheduled_execution_time,
+				search_attributes = excluded.search_attributes`
+
+	// the fields of a closed row are not updated, as a late upsert must not overwrite the final state of the workflow
+	templateUpsertWorkflowExecution = `INSERT INTO executions_visibility (` +
+		`domain_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, memo, encoding, is_cron, num_clusters, update_time, shard_id, execution_status, cron_schedule, scheduled_execution_time, search_attributes) ` +
+		`VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16)
+		 ON CONFLICT (domain_id, run_id) DO UPDATE
+		   SET search_attributes = excluded.search_attributes,
+		       memo = excluded.memo,
+		       encoding = excluded.encoding,
+		       update_time = excluded.update_time,
+		       execution_status = excluded.execution_status
+		 WHERE executions_visibility.close_status IS NULL`
 
 	// RunID condition is needed for correct pagination
 	templateConditions1 = ` AND domain_id = $1
@@ -98,6 +113,17 @@ const (
 		 AND run_id = $2`
 
 	templateDeleteWorkflowExecution = "DELETE FROM executions_visibility WHERE domain_id=$1 AND run_id=$2"
+
+	// the columns added after the first version of the table may be NULL in older rows
+	templateQueryFieldNames = `workflow_id, run_id, start_time, execution_time, workflow_type_name, close_time, close_status, history_length,
+		 memo, encoding, is_cron, COALESCE(num_clusters, 0) AS num_clusters, update_time, COALESCE(shard_id, 0) AS shard_id,
+		 COALESCE(execution_status, 0) AS execution_status, COALESCE(cron_schedule, '') AS cron_schedule, search_attributes`
+
+	templateSelectFromVisibilityByQuery = `SELECT ` + templateQueryFieldNames + ` FROM executions_visibility WHERE domain_id = $1`
+
+	templateCountFromVisibilityByQuery = `SELECT COUNT(*) FROM executions_visibility WHERE domain_id = $1`
+
+	templateSelectGroupsFromVisibilityByQuery = `SELECT %s AS group_key, COUNT(*) AS group_count FROM executions_visibility WHERE domain_id = $1`
 )
 
 var errCloseParams = errors.New("missing one of {closeStatus, closeTime, historyLength} params")
@@ -123,7 +149,8 @@ func (pdb *db) InsertIntoVisibility(ctx context.Context, row *sqlplugin.Visibili
 		row.ShardID,
 		row.ExecutionStatus,
 		row.CronSchedule,
-		scheduledExecutionTime)
+		scheduledExecutionTime,
+		searchAttributesArg(row.SearchAttributes))
 }
 
 // ReplaceIntoVisibility replaces an existing row if it exist or creates a new row in visibility table
@@ -152,7 +179,8 @@ func (pdb *db) ReplaceIntoVisibility(ctx context.Context, row *sqlplugin.Visibil
 			row.ShardID,
 			row.ExecutionStatus,
 			row.CronSchedule,
-			scheduledExecutionTime)
+			scheduledExecutionTime,
+			searchAttributesArg(row.SearchAttributes))
 	default:
 		return nil, errCloseParams
 	}
@@ -253,3 +281,139 @@ func (pdb *db) SelectFromVisibility(ctx context.Context, filter *sqlplugin.Visib
 	}
 	return rows, err
 }
+
+// UpsertIntoVisibility inserts a row into visibility table. If the row already exist and is still open,
+// its search attributes, memo, update time and execution status are updated
+func (pdb *db) UpsertIntoVisibility(ctx context.Context, row *sqlplugin.VisibilityRow) (sql.Result, error) {
+	dbShardID := sqlplugin.GetDBShardIDFromDomainID(row.DomainID, pdb.GetTotalNumDBShards())
+	row.StartTime = pdb.converter.ToPostgresDateTime(row.StartTime)
+	scheduledExecutionTime := pdb.converter.ToPostgresDateTime(row.ScheduledExecutionTime)
+	return pdb.driver.ExecContext(ctx, dbShardID, templateUpsertWorkflowExecution,
+		row.DomainID,
+		row.WorkflowID,
+		row.RunID,
+		row.StartTime,
+		row.ExecutionTime,
+		row.WorkflowTypeName,
+		row.Memo,
+		row.Encoding,
+		row.IsCron,
+		row.NumClusters,
+		row.UpdateTime,
+		row.ShardID,
+		row.ExecutionStatus,
+		row.CronSchedule,
+		scheduledExecutionTime,
+		searchAttributesArg(row.SearchAttributes))
+}
+
+// SelectFromVisibilityByQuery reads a page of the rows of visibility table matching a query
+func (pdb *db) SelectFromVisibilityByQuery(ctx context.Context, filter *sqlplugin.VisibilityQueryFilter) ([]sqlplugin.VisibilityRow, error) {
+	dialect := visibilityQueryDialect{converter: pdb.converter}
+	condition, orderBy, args, err := sqlplugin.BuildVisibilityQueryClauses(dialect, filter, 2)
+	if err != nil {
+		return nil, err
+	}
+	query := templateSelectFromVisibilityByQuery
+	if condition != "" {
+		query += " AND " + condition
+	}
+	query += fmt.Sprintf(" ORDER BY %s LIMIT %s OFFSET %s", orderBy, dialect.Placeholder(len(args)+2), dialect.Placeholder(len(args)+3))
+	args = append(append([]interface{}{filter.DomainID}, args...), filter.PageSize, filter.Offset)
+
+	dbShardID := sqlplugin.GetDBShardIDFromDomainID(filter.DomainID, pdb.GetTotalNumDBShards())
+	var rows []sqlplugin.VisibilityRow
+	if err := pdb.driver.SelectContext(ctx, dbShardID, &rows, query, args...); err != nil {
+		return nil, err
+	}
+	for i := range rows {
+		rows[i].StartTime = pdb.converter.FromPostgresDateTime(rows[i].StartTime)
+		rows[i].ExecutionTime = pdb.converter.FromPostgresDateTime(rows[i].ExecutionTime)
+		if rows[i].CloseTime != nil {
+			closeTime := pdb.converter.FromPostgresDateTime(*rows[i].CloseTime)
+			rows[i].CloseTime = &closeTime
+		}
+		rows[i].RunID = strings.TrimSpace(rows[i].RunID)
+		rows[i].WorkflowID = strings.TrimSpace(rows[i].WorkflowID)
+	}
+	return rows, nil
+}
+
+// CountFromVisibilityByQuery returns the number of rows of visibility table matching a query
+func (pdb *db) CountFromVisibilityByQuery(ctx context.Context, filter *sqlplugin.VisibilityQueryFilter) (int64, error) {
+	condition, _, args, err := sqlplugin.BuildVisibilityQueryClauses(visibilityQueryDialect{converter: pdb.converter}, &sqlplugin.VisibilityQueryFilter{
+		DomainID:  filter.DomainID,
+		Condition: filter.Condition,
+	}, 2)
+	if err != nil {
+		return 0, err
+	}
+	query := templateCountFromVisibilityByQuery
+	if condition != "" {
+		query += " AND " + condition
+	}
+	args = append([]interface{}{filter.DomainID}, args...)
+
+	dbShardID := sqlplugin.GetDBShardIDFromDomainID(filter.DomainID, pdb.GetTotalNumDBShards())
+	var count int64
+	err = pdb.driver.GetContext(ctx, dbShardID, &count, query, args...)
+	return count, err
+}
+
+// SelectGroupsFromVisibilityByQuery returns the number of rows of visibility table matching a query for each value of a field
+func (pdb *db) SelectGroupsFromVisibilityByQuery(
+	ctx context.Context,
+	filter *sqlplugin.VisibilityQueryFilter,
+	groupBy sqlplugin.VisibilityQueryField,
+) ([]sqlplugin.VisibilityGroupRow, error) {
+	dialect := visibilityQueryDialect{converter: pdb.converter}
+	groupKey, condition, args, err := sqlplugin.BuildVisibilityQueryGroupClauses(dialect, filter, groupBy, 2)
+	if err != nil {
+		return nil, err
+	}
+	query := fmt.Sprintf(templateSelectGroupsFromVisibilityByQuery, groupKey) + " AND " + condition +
+		fmt.Sprintf(" GROUP BY group_key ORDER BY group_count DESC, group_key LIMIT %s", dialect.Placeholder(len(args)+2))
+	args = append(append([]interface{}{filter.DomainID}, args...), filter.PageSize)
+
+	dbShardID := sqlplugin.GetDBShardIDFromDomainID(filter.DomainID, pdb.GetTotalNumDBShards())
+	var rows []sqlplugin.VisibilityGroupRow
+	if err := pdb.driver.SelectContext(ctx, dbShardID, &rows, query, args...); err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// visibilityQueryDialect reads the custom search attributes from the search_attributes JSONB column
+type visibilityQueryDialect struct {
+	converter DataConverter
+}
+
+func (visibilityQueryDialect) Placeholder(position int) string {
+	return fmt.Sprintf("$%d", position)
+}
+
+func (visibilityQueryDialect) SearchAttribute(key string, valueType types.IndexedValueType) string {
+	switch valueType {
+	case types.IndexedValueTypeInt, types.IndexedValueTypeDatetime:
+		return fmt.Sprintf(`(search_attributes->>'%s')::BIGINT`, key)
+	case types.IndexedValueTypeDouble:
+		return fmt.Sprintf(`(search_attributes->>'%s')::DOUBLE PRECISION`, key)
+	case types.IndexedValueTypeBool:
+		return fmt.Sprintf(`(search_attributes->>'%s')::BOOLEAN`, key)
+	default:
+		return fmt.Sprintf(`search_attributes->>'%s'`, key)
+	}
+}
+
+func (d visibilityQueryDialect) DateTime(t time.Time) time.Time {
+	return d.converter.ToPostgresDateTime(t)
+}
+
+// searchAttributesArg returns the search attributes as a string, since the driver sends []byte as bytea
+// which is rejected by JSONB columns
+func searchAttributesArg(searchAttributes []byte) interface{} {
+	if len(searchAttributes) == 0 {
+		return nil
+	}
+	return string(searchAttributes)
+}
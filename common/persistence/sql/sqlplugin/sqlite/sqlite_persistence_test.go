@@ -94,7 +94,7 @@ func TestSQLiteExecutionManagerWithEventsV2(t *testing.T) {
 }
 
 func TestSQLiteVisibilityPersistenceSuite(t *testing.T) {
-	s := new(pt.DBVisibilityPersistenceSuite)
+	s := new(pt.SQLVisibilityPersistenceSuite)
 	option := GetTestClusterOption()
 	s.TestBase = pt.NewTestBaseWithSQL(t, option)
 	s.TestBase.Setup()
@@ -25,14 +25,41 @@ package sqlite
 import (
 	"context"
 	"database/sql"
+	"fmt"
+	"time"
 
 	"github.com/uber/cadence/common/persistence/sql/sqlplugin"
+	"github.com/uber/cadence/common/persistence/sql/sqlplugin/mysql"
+	"github.com/uber/cadence/common/types"
 )
 
 const (
 	templateCreateWorkflowExecutionStarted = `INSERT OR IGNORE INTO executions_visibility (` +
-		`domain_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, memo, encoding, is_cron, num_clusters, update_time, shard_id) ` +
-		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+		`domain_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, memo, encoding, is_cron, num_clusters, update_time, shard_id, search_attributes) ` +
+		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+
+	// the fields of a closed row are not updated, as a late upsert must not overwrite the final state of the workflow
+	templateUpsertWorkflowExecution = `INSERT INTO executions_visibility (` +
+		`domain_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, memo, encoding, is_cron, num_clusters, update_time, shard_id, execution_status, cron_schedule, scheduled_execution_time, search_attributes) ` +
+		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+		 ON CONFLICT (domain_id, run_id) DO UPDATE
+		   SET search_attributes = excluded.search_attributes,
+		       memo = excluded.memo,
+		       encoding = excluded.encoding,
+		       update_time = excluded.update_time,
+		       execution_status = excluded.execution_status
+		 WHERE executions_visibility.close_status IS NULL`
+
+	// the columns added after the first version of the table may be NULL in older rows
+	templateQueryFieldNames = `workflow_id, run_id, start_time, execution_time, workflow_type_name, close_time, close_status, history_length,
+		 memo, encoding, is_cron, COALESCE(num_clusters, 0) AS num_clusters, update_time, COALESCE(shard_id, 0) AS shard_id,
+		 COALESCE(execution_status, 0) AS execution_status, COALESCE(cron_schedule, '') AS cron_schedule, search_attributes`
+
+	templateSelectFromVisibilityByQuery = `SELECT ` + templateQueryFieldNames + ` FROM executions_visibility WHERE domain_id = ?`
+
+	templateCountFromVisibilityByQuery = `SELECT COUNT(*) FROM executions_visibility WHERE domain_id = ?`
+
+	templateSelectGroupsFromVisibilityByQuery = `SELECT %s AS group_key, COUNT(*) AS group_count FROM executions_visibility WHERE domain_id = ?`
 )
 
 // InsertIntoVisibility inserts a row into visibility table. If an row already exist,
@@ -54,5 +81,122 @@ func (mdb *DB) InsertIntoVisibility(ctx context.Context, row *sqlplugin.Visibili
 		row.IsCron,
 		row.NumClusters,
 		row.UpdateTime,
-		row.ShardID)
+		row.ShardID,
+		searchAttributesArg(row.SearchAttributes))
+}
+
+// UpsertIntoVisibility inserts a row into visibility table. If the row already exist and is still open,
+// its search attributes, memo, update time and execution status are updated
+func (mdb *DB) UpsertIntoVisibility(ctx context.Context, row *sqlplugin.VisibilityRow) (sql.Result, error) {
+	row.StartTime = mdb.converter.ToDateTime(row.StartTime)
+	dbShardID := sqlplugin.GetDBShardIDFromDomainID(row.DomainID, mdb.GetTotalNumDBShards())
+	return mdb.driver.ExecContext(ctx,
+		dbShardID,
+		templateUpsertWorkflowExecution,
+		row.DomainID,
+		row.WorkflowID,
+		row.RunID,
+		row.StartTime,
+		row.ExecutionTime,
+		row.WorkflowTypeName,
+		row.Memo,
+		row.Encoding,
+		row.IsCron,
+		row.NumClusters,
+		row.UpdateTime,
+		row.ShardID,
+		row.ExecutionStatus,
+		row.CronSchedule,
+		row.ScheduledExecutionTime,
+		searchAttributesArg(row.SearchAttributes))
+}
+
+// SelectFromVisibilityByQuery reads a page of the rows of visibility table matching a query
+func (mdb *DB) SelectFromVisibilityByQuery(ctx context.Context, filter *sqlplugin.VisibilityQueryFilter) ([]sqlplugin.VisibilityRow, error) {
+	condition, orderBy, args, err := sqlplugin.BuildVisibilityQueryClauses(visibilityQueryDialect{converter: mdb.converter}, filter, 2)
+	if err != nil {
+		return nil, err
+	}
+	query := templateSelectFromVisibilityByQuery
+	if condition != "" {
+		query += " AND " + condition
+	}
+	query += " ORDER BY " + orderBy + " LIMIT ? OFFSET ?"
+	args = append(append([]interface{}{filter.DomainID}, args...), filter.PageSize, filter.Offset)
+
+	dbShardID := sqlplugin.GetDBShardIDFromDomainID(filter.DomainID, mdb.GetTotalNumDBShards())
+	var rows []sqlplugin.VisibilityRow
+	if err := mdb.driver.SelectContext(ctx, dbShardID, &rows, query, args...); err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// CountFromVisibilityByQuery returns the number of rows of visibility table matching a query
+func (mdb *DB) CountFromVisibilityByQuery(ctx context.Context, filter *sqlplugin.VisibilityQueryFilter) (int64, error) {
+	condition, _, args, err := sqlplugin.BuildVisibilityQueryClauses(visibilityQueryDialect{converter: mdb.converter}, &sqlplugin.VisibilityQueryFilter{
+		DomainID:  filter.DomainID,
+		Condition: filter.Condition,
+	}, 2)
+	if err != nil {
+		return 0, err
+	}
+	query := templateCountFromVisibilityByQuery
+	if condition != "" {
+		query += " AND " + condition
+	}
+	args = append([]interface{}{filter.DomainID}, args...)
+
+	dbShardID := sqlplugin.GetDBShardIDFromDomainID(filter.DomainID, mdb.GetTotalNumDBShards())
+	var count int64
+	err = mdb.driver.GetContext(ctx, dbShardID, &count, query, args...)
+	return count, err
+}
+
+// SelectGroupsFromVisibilityByQuery returns the number of rows of visibility table matching a query for each value of a field
+func (mdb *DB) SelectGroupsFromVisibilityByQuery(
+	ctx context.Context,
+	filter *sqlplugin.VisibilityQueryFilter,
+	groupBy sqlplugin.VisibilityQueryField,
+) ([]sqlplugin.VisibilityGroupRow, error) {
+	dialect := visibilityQueryDialect{converter: mdb.converter}
+	groupKey, condition, args, err := sqlplugin.BuildVisibilityQueryGroupClauses(dialect, filter, groupBy, 2)
+	if err != nil {
+		return nil, err
+	}
+	query := fmt.Sprintf(templateSelectGroupsFromVisibilityByQuery, groupKey) + " AND " + condition +
+		" GROUP BY group_key ORDER BY group_count DESC, group_key LIMIT ?"
+	args = append(append([]interface{}{filter.DomainID}, args...), filter.PageSize)
+
+	dbShardID := sqlplugin.GetDBShardIDFromDomainID(filter.DomainID, mdb.GetTotalNumDBShards())
+	var rows []sqlplugin.VisibilityGroupRow
+	if err := mdb.driver.SelectContext(ctx, dbShardID, &rows, query, args...); err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// visibilityQueryDialect reads the custom search attributes from the search_attributes JSON text column,
+// JSON_EXTRACT returns them as SQL values so that they can be compared without a cast
+type visibilityQueryDialect struct {
+	converter mysql.DataConverter
+}
+
+func (visibilityQueryDialect) Placeholder(int) string {
+	return "?"
+}
+
+func (d visibilityQueryDialect) DateTime(t time.Time) time.Time {
+	return d.converter.ToDateTime(t)
+}
+
+func (visibilityQueryDialect) SearchAttribute(key string, _ types.IndexedValueType) string {
+	return fmt.Sprintf(`JSON_EXTRACT(search_attributes, '$."%s"')`, key)
+}
+
+func searchAttributesArg(searchAttributes []byte) interface{} {
+	if len(searchAttributes) == 0 {
+		return nil
+	}
+	return string(searchAttributes)
 }
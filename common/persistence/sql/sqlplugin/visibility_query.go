@@ -0,0 +1,224 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sqlplugin
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/uber/cadence/common/types"
+)
+
+// VisibilityQueryOperator is an operator of a condition of a visibility query
+type VisibilityQueryOperator string
+
+// Operators of the conditions of a visibility query
+const (
+	VisibilityQueryAnd          VisibilityQueryOperator = "AND"
+	VisibilityQueryOr           VisibilityQueryOperator = "OR"
+	VisibilityQueryEqual        VisibilityQueryOperator = "="
+	VisibilityQueryNotEqual     VisibilityQueryOperator = "!="
+	VisibilityQueryLess         VisibilityQueryOperator = "<"
+	VisibilityQueryLessEqual    VisibilityQueryOperator = "<="
+	VisibilityQueryGreater      VisibilityQueryOperator = ">"
+	VisibilityQueryGreaterEqual VisibilityQueryOperator = ">="
+	VisibilityQueryIn           VisibilityQueryOperator = "IN"
+	VisibilityQueryNotIn        VisibilityQueryOperator = "NOT IN"
+	VisibilityQueryBetween      VisibilityQueryOperator = "BETWEEN"
+	VisibilityQueryNotBetween   VisibilityQueryOperator = "NOT BETWEEN"
+	VisibilityQueryLike         VisibilityQueryOperator = "LIKE"
+	VisibilityQueryNotLike      VisibilityQueryOperator = "NOT LIKE"
+	VisibilityQueryIsNull       VisibilityQueryOperator = "IS NULL"
+	VisibilityQueryIsNotNull    VisibilityQueryOperator = "IS NOT NULL"
+)
+
+type (
+	// VisibilityQueryField is a field of executions_visibility table referenced by a visibility query
+	VisibilityQueryField struct {
+		// Column is the column of a system search attribute, it is empty for custom search attributes
+		Column string
+		// SearchAttribute is the key of a custom search attribute stored in the search_attributes column
+		SearchAttribute string
+		ValueType       types.IndexedValueType
+	}
+
+	// VisibilityQueryCondition is a condition of a visibility query, either the conjunction or disjunction of
+	// its children or the comparison of a field with values
+	VisibilityQueryCondition struct {
+		Operator VisibilityQueryOperator
+		Children []*VisibilityQueryCondition
+		Field    VisibilityQueryField
+		Values   []interface{}
+	}
+
+	// VisibilityQueryOrderBy is a sort order of a visibility query
+	VisibilityQueryOrderBy struct {
+		Field VisibilityQueryField
+		Desc  bool
+	}
+
+	// VisibilityQueryFilter contains the visibility query translated from the visibility query language,
+	// a nil condition matches all the rows of the domain
+	VisibilityQueryFilter struct {
+		DomainID  string
+		Condition *VisibilityQueryCondition
+		OrderBy   []VisibilityQueryOrderBy
+		Offset    int
+		PageSize  int
+	}
+
+	// VisibilityQueryDialect renders the parts of a visibility query which differ between the databases
+	VisibilityQueryDialect interface {
+		// Placeholder returns the placeholder of the argument at the given position, starting from 1
+		Placeholder(position int) string
+		// SearchAttribute returns the expression reading the value of a custom search attribute from the search_attributes column.
+		// The key is validated by the caller, so it can be safely used in the expression.
+		SearchAttribute(key string, valueType types.IndexedValueType) string
+		// DateTime converts a time value compared with a datetime column to the representation stored by the database
+		DateTime(t time.Time) time.Time
+	}
+
+	visibilityQueryBuilder struct {
+		dialect  VisibilityQueryDialect
+		position int
+		args     []interface{}
+	}
+)
+
+// BuildVisibilityQueryClauses renders the condition and the order by clause of a visibility query and returns their arguments.
+// The placeholders are numbered from firstPosition, so that the statement can use the positions before it.
+// The returned condition is empty if the filter has no condition.
+func BuildVisibilityQueryClauses(
+	dialect VisibilityQueryDialect,
+	filter *VisibilityQueryFilter,
+	firstPosition int,
+) (condition string, orderBy string, args []interface{}, err error) {
+	b := &visibilityQueryBuilder{
+		dialect:  dialect,
+		position: firstPosition,
+	}
+	if filter.Condition != nil {
+		condition, err = b.condition(filter.Condition)
+		if err != nil {
+			return "", "", nil, err
+		}
+	}
+	sorters := make([]string, 0, len(filter.OrderBy))
+	for _, o := range filter.OrderBy {
+		order := "ASC"
+		if o.Desc {
+			order = "DESC"
+		}
+		sorters = append(sorters, b.field(o.Field)+" "+order)
+	}
+	return condition, strings.Join(sorters, ", "), b.args, nil
+}
+
+// BuildVisibilityQueryGroupClauses renders the expression of the field the rows are grouped by and the condition of a visibility query
+// grouping the rows by the field, which leaves out the rows without a value of the field, and returns the arguments of the condition.
+// The placeholders are numbered from firstPosition, so that the statement can use the positions before it.
+func BuildVisibilityQueryGroupClauses(
+	dialect VisibilityQueryDialect,
+	filter *VisibilityQueryFilter,
+	groupBy VisibilityQueryField,
+	firstPosition int,
+) (groupKey string, condition string, args []interface{}, err error) {
+	groupCondition := &VisibilityQueryCondition{Operator: VisibilityQueryIsNotNull, Field: groupBy}
+	if filter.Condition != nil {
+		groupCondition = &VisibilityQueryCondition{
+			Operator: VisibilityQueryAnd,
+			Children: []*VisibilityQueryCondition{filter.Condition, groupCondition},
+		}
+	}
+	condition, _, args, err = BuildVisibilityQueryClauses(dialect, &VisibilityQueryFilter{
+		DomainID:  filter.DomainID,
+		Condition: groupCondition,
+	}, firstPosition)
+	if err != nil {
+		return "", "", nil, err
+	}
+	return BuildVisibilityQueryField(dialect, groupBy), condition, args, nil
+}
+
+// BuildVisibilityQueryField renders the expression reading a field of executions_visibility table
+func BuildVisibilityQueryField(dialect VisibilityQueryDialect, field VisibilityQueryField) string {
+	if field.Column != "" {
+		return field.Column
+	}
+	return dialect.SearchAttribute(field.SearchAttribute, field.ValueType)
+}
+
+func (b *visibilityQueryBuilder) condition(c *VisibilityQueryCondition) (string, error) {
+	switch c.Operator {
+	case VisibilityQueryAnd, VisibilityQueryOr:
+		if len(c.Children) == 0 {
+			return "", fmt.Errorf("%s condition without children", c.Operator)
+		}
+		children := make([]string, 0, len(c.Children))
+		for _, child := range c.Children {
+			rendered, err := b.condition(child)
+			if err != nil {
+				return "", err
+			}
+			children = append(children, rendered)
+		}
+		return "(" + strings.Join(children, " "+string(c.Operator)+" ") + ")", nil
+	case VisibilityQueryEqual, VisibilityQueryNotEqual, VisibilityQueryLess, VisibilityQueryLessEqual,
+		VisibilityQueryGreater, VisibilityQueryGreaterEqual, VisibilityQueryLike, VisibilityQueryNotLike:
+		if len(c.Values) != 1 {
+			return "", fmt.Errorf("%s condition requires 1 value, got %d", c.Operator, len(c.Values))
+		}
+		return fmt.Sprintf("%s %s %s", b.field(c.Field), c.Operator, b.arg(c.Values[0])), nil
+	case VisibilityQueryIn, VisibilityQueryNotIn:
+		if len(c.Values) == 0 {
+			return "", fmt.Errorf("%s condition requires values", c.Operator)
+		}
+		placeholders := make([]string, 0, len(c.Values))
+		for _, v := range c.Values {
+			placeholders = append(placeholders, b.arg(v))
+		}
+		return fmt.Sprintf("%s %s (%s)", b.field(c.Field), c.Operator, strings.Join(placeholders, ", ")), nil
+	case VisibilityQueryBetween, VisibilityQueryNotBetween:
+		if len(c.Values) != 2 {
+			return "", fmt.Errorf("%s condition requires 2 values, got %d", c.Operator, len(c.Values))
+		}
+		return fmt.Sprintf("%s %s %s AND %s", b.field(c.Field), c.Operator, b.arg(c.Values[0]), b.arg(c.Values[1])), nil
+	case VisibilityQueryIsNull, VisibilityQueryIsNotNull:
+		return fmt.Sprintf("%s %s", b.field(c.Field), c.Operator), nil
+	default:
+		return "", fmt.Errorf("unknown visibility query operator %q", c.Operator)
+	}
+}
+
+func (b *visibilityQueryBuilder) field(f VisibilityQueryField) string {
+	return BuildVisibilityQueryField(b.dialect, f)
+}
+
+func (b *visibilityQueryBuilder) arg(value interface{}) string {
+	placeholder := b.dialect.Placeholder(b.position)
+	b.position++
+	if t, ok := value.(time.Time); ok {
+		value = b.dialect.DateTime(t)
+	}
+	b.args = append(b.args, value)
+	return placeholder
+}
@@ -85,6 +85,12 @@ func updateActivityInfos(
 				RetryLastFailureDetails:       activityInfo.LastFailureDetails,
 				RetryLastFailureCategory:      activityInfo.LastFailureCategory,
 				RetryLastRetryIntervalSeconds: activityInfo.LastRetryIntervalSeconds,
+				Priority:                      activityInfo.Priority,
+				FairnessKey:                   activityInfo.FairnessKey,
+				Paused:                        activityInfo.Paused,
+				RetryJitterCoefficient:        activityInfo.RetryJitterCoefficient,
+				RetryRetryableErrors:          activityInfo.RetryableErrors,
+				RetryErrorReasonOverrides:     activityInfo.RetryErrorReasonOverrides,
 			}
 			blob, err := parser.ActivityInfoToBlob(info)
 			if err != nil {
@@ -185,6 +191,13 @@ func getActivityInfoMap(
 			LastFailureDetails:       decoded.GetRetryLastFailureDetails(),
 			LastFailureCategory:      decoded.RetryLastFailureCategory,
 			LastRetryIntervalSeconds: decoded.RetryLastRetryIntervalSeconds,
+			Priority:                 decoded.Priority,
+			FairnessKey:              decoded.FairnessKey,
+			Paused:                   decoded.Paused,
+
+			RetryJitterCoefficient:    decoded.GetRetryJitterCoefficient(),
+			RetryableErrors:           decoded.GetRetryRetryableErrors(),
+			RetryErrorReasonOverrides: decoded.GetRetryErrorReasonOverrides(),
 		}
 		if decoded.StartedEvent != nil {
 			info.StartedEvent = persistence.NewDataBlob(decoded.StartedEvent, constants.EncodingType(decoded.GetStartedEventEncoding()))
@@ -211,6 +211,14 @@ type (
 		TaskList string
 	}
 
+	// DeleteWorkflowExecutionTask identifies a transfer task for removing a closed workflow execution,
+	// its history and its archived copies on user request
+	DeleteWorkflowExecutionTask struct {
+		WorkflowIdentifier
+		TaskData
+		TaskList string
+	}
+
 	// RecordChildExecutionCompletedTask identifies a task for recording the competion of a child workflow
 	RecordChildExecutionCompletedTask struct {
 		WorkflowIdentifier
@@ -278,6 +286,19 @@ type (
 		TaskData
 		DomainID string
 	}
+
+	// DeleteWorkflowExecutionReplicationTask is the replication task created for shipping the deletion of a closed workflow execution to other clusters
+	DeleteWorkflowExecutionReplicationTask struct {
+		WorkflowIdentifier
+		TaskData
+	}
+
+	// UpsertWorkflowSearchAttributesReplicationTask is the replication task created for shipping the search attributes and memo
+	// upserted outside of decisions to other clusters, they are read from the mutable state when the task is replicated
+	UpsertWorkflowSearchAttributesReplicationTask struct {
+		WorkflowIdentifier
+		TaskData
+	}
 )
 
 // assert all task types implements Task interface
@@ -296,6 +317,7 @@ var (
 	_ Task = (*UpsertWorkflowSearchAttributesTask)(nil)
 	_ Task = (*StartChildExecutionTask)(nil)
 	_ Task = (*RecordWorkflowClosedTask)(nil)
+	_ Task = (*DeleteWorkflowExecutionTask)(nil)
 	_ Task = (*ActivityTimeoutTask)(nil)
 	_ Task = (*UserTimerTask)(nil)
 	_ Task = (*ActivityRetryTimerTask)(nil)
@@ -303,6 +325,8 @@ var (
 	_ Task = (*HistoryReplicationTask)(nil)
 	_ Task = (*SyncActivityTask)(nil)
 	_ Task = (*FailoverMarkerTask)(nil)
+	_ Task = (*DeleteWorkflowExecutionReplicationTask)(nil)
+	_ Task = (*UpsertWorkflowSearchAttributesReplicationTask)(nil)
 
 	immediateTaskKeyScheduleTime = time.Unix(0, 0).UTC()
 )
@@ -1415,6 +1439,59 @@ func (u *RecordWorkflowClosedTask) ToInternalReplicationTaskInfo() (*types.Repli
 	return nil, fmt.Errorf("record workflow closed task is not replication task")
 }
 
+// GetType returns the type of the delete workflow execution task
+func (u *DeleteWorkflowExecutionTask) GetTaskType() int {
+	return TransferTaskTypeDeleteWorkflowExecution
+}
+
+func (u *DeleteWorkflowExecutionTask) GetTaskCategory() HistoryTaskCategory {
+	return HistoryTaskCategoryTransfer
+}
+
+func (u *DeleteWorkflowExecutionTask) GetTaskKey() HistoryTaskKey {
+	return NewImmediateTaskKey(u.TaskID)
+}
+
+func (u *DeleteWorkflowExecutionTask) GetTaskList() string {
+	return u.TaskList
+}
+
+func (u *DeleteWorkflowExecutionTask) GetOriginalTaskList() string {
+	return u.TaskList
+}
+
+func (u *DeleteWorkflowExecutionTask) GetOriginalTaskListKind() types.TaskListKind {
+	return types.TaskListKindNormal
+}
+
+func (u *DeleteWorkflowExecutionTask) ByteSize() uint64 {
+	return u.WorkflowIdentifier.ByteSize() + u.TaskData.ByteSize() + uint64(len(u.TaskList))
+}
+
+func (u *DeleteWorkflowExecutionTask) ToTransferTaskInfo() (*TransferTaskInfo, error) {
+	return &TransferTaskInfo{
+		TaskType:            TransferTaskTypeDeleteWorkflowExecution,
+		DomainID:            u.DomainID,
+		WorkflowID:          u.WorkflowID,
+		RunID:               u.RunID,
+		TaskID:              u.TaskID,
+		VisibilityTimestamp: u.VisibilityTimestamp,
+		Version:             u.Version,
+		TaskList:            u.TaskList,
+		TargetDomainID:      u.DomainID,
+		TargetWorkflowID:    TransferTaskTransferTargetWorkflowID,
+		TargetRunID:         TransferTaskTransferTargetRunID,
+	}, nil
+}
+
+func (u *DeleteWorkflowExecutionTask) ToTimerTaskInfo() (*TimerTaskInfo, error) {
+	return nil, fmt.Errorf("delete workflow execution task is not timer task")
+}
+
+func (u *DeleteWorkflowExecutionTask) ToInternalReplicationTaskInfo() (*types.ReplicationTaskInfo, error) {
+	return nil, fmt.Errorf("delete workflow execution task is not replication task")
+}
+
 // GetType returns the type of the history replication task
 func (a *HistoryReplicationTask) GetTaskType() int {
 	return ReplicationTaskTypeHistory
@@ -1577,3 +1654,105 @@ func (a *FailoverMarkerTask) GetWorkflowID() string {
 func (a *FailoverMarkerTask) GetRunID() string {
 	return ""
 }
+
+// GetType returns the type of the delete workflow execution replication task
+func (a *DeleteWorkflowExecutionReplicationTask) GetTaskType() int {
+	return ReplicationTaskTypeDeleteWorkflowExecution
+}
+
+func (a *DeleteWorkflowExecutionReplicationTask) GetTaskCategory() HistoryTaskCategory {
+	return HistoryTaskCategoryReplication
+}
+
+func (a *DeleteWorkflowExecutionReplicationTask) GetTaskKey() HistoryTaskKey {
+	return NewImmediateTaskKey(a.TaskID)
+}
+
+func (a *DeleteWorkflowExecutionReplicationTask) GetTaskList() string {
+	return ""
+}
+
+func (a *DeleteWorkflowExecutionReplicationTask) GetOriginalTaskList() string {
+	return ""
+}
+
+func (a *DeleteWorkflowExecutionReplicationTask) GetOriginalTaskListKind() types.TaskListKind {
+	return types.TaskListKindNormal
+}
+
+func (a *DeleteWorkflowExecutionReplicationTask) ByteSize() uint64 {
+	return a.WorkflowIdentifier.ByteSize() + a.TaskData.ByteSize()
+}
+
+func (a *DeleteWorkflowExecutionReplicationTask) ToInternalReplicationTaskInfo() (*types.ReplicationTaskInfo, error) {
+	return &types.ReplicationTaskInfo{
+		DomainID:     a.DomainID,
+		WorkflowID:   a.WorkflowID,
+		RunID:        a.RunID,
+		TaskType:     ReplicationTaskTypeDeleteWorkflowExecution,
+		TaskID:       a.TaskID,
+		Version:      a.Version,
+		FirstEventID: constants.EmptyEventID,
+		NextEventID:  constants.EmptyEventID,
+		ScheduledID:  constants.EmptyEventID,
+	}, nil
+}
+
+func (a *DeleteWorkflowExecutionReplicationTask) ToTransferTaskInfo() (*TransferTaskInfo, error) {
+	return nil, fmt.Errorf("delete workflow execution replication task is not transfer task")
+}
+
+func (a *DeleteWorkflowExecutionReplicationTask) ToTimerTaskInfo() (*TimerTaskInfo, error) {
+	return nil, fmt.Errorf("delete workflow execution replication task is not timer task")
+}
+
+// GetType returns the type of the upsert workflow search attributes replication task
+func (a *UpsertWorkflowSearchAttributesReplicationTask) GetTaskType() int {
+	return ReplicationTaskTypeUpsertWorkflowSearchAttributes
+}
+
+func (a *UpsertWorkflowSearchAttributesReplicationTask) GetTaskCategory() HistoryTaskCategory {
+	return HistoryTaskCategoryReplication
+}
+
+func (a *UpsertWorkflowSearchAttributesReplicationTask) GetTaskKey() HistoryTaskKey {
+	return NewImmediateTaskKey(a.TaskID)
+}
+
+func (a *UpsertWorkflowSearchAttributesReplicationTask) GetTaskList() string {
+	return ""
+}
+
+func (a *UpsertWorkflowSearchAttributesReplicationTask) GetOriginalTaskList() string {
+	return ""
+}
+
+func (a *UpsertWorkflowSearchAttributesReplicationTask) GetOriginalTaskListKind() types.TaskListKind {
+	return types.TaskListKindNormal
+}
+
+func (a *UpsertWorkflowSearchAttributesReplicationTask) ByteSize() uint64 {
+	return a.WorkflowIdentifier.ByteSize() + a.TaskData.ByteSize()
+}
+
+func (a *UpsertWorkflowSearchAttributesReplicationTask) ToInternalReplicationTaskInfo() (*types.ReplicationTaskInfo, error) {
+	return &types.ReplicationTaskInfo{
+		DomainID:     a.DomainID,
+		WorkflowID:   a.WorkflowID,
+		RunID:        a.RunID,
+		TaskType:     ReplicationTaskTypeUpsertWorkflowSearchAttributes,
+		TaskID:       a.TaskID,
+		Version:      a.Version,
+		FirstEventID: constants.EmptyEventID,
+		NextEventID:  constants.EmptyEventID,
+		ScheduledID:  constants.EmptyEventID,
+	}, nil
+}
+
+func (a *UpsertWorkflowSearchAttributesReplicationTask) ToTransferTaskInfo() (*TransferTaskInfo, error) {
+	return nil, fmt.Errorf("upsert workflow search attributes replication task is not transfer task")
+}
+
+func (a *UpsertWorkflowSearchAttributesReplicationTask) ToTimerTaskInfo() (*TimerTaskInfo, error) {
+	return nil, fmt.Errorf("upsert workflow search attributes replication task is not timer task")
+}
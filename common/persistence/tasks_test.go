@@ -51,9 +51,12 @@ func TestTaskCommonMethods(t *testing.T) {
 		&UpsertWorkflowSearchAttributesTask{TaskData: TaskData{Version: 1, TaskID: 1, VisibilityTimestamp: timeNow}},
 		&StartChildExecutionTask{TaskData: TaskData{Version: 1, TaskID: 1, VisibilityTimestamp: timeNow}},
 		&RecordWorkflowClosedTask{TaskData: TaskData{Version: 1, TaskID: 1, VisibilityTimestamp: timeNow}},
+		&DeleteWorkflowExecutionTask{TaskData: TaskData{Version: 1, TaskID: 1, VisibilityTimestamp: timeNow}},
 		&HistoryReplicationTask{TaskData: TaskData{Version: 1, TaskID: 1, VisibilityTimestamp: timeNow}},
 		&SyncActivityTask{TaskData: TaskData{Version: 1, TaskID: 1, VisibilityTimestamp: timeNow}},
 		&FailoverMarkerTask{TaskData: TaskData{Version: 1, TaskID: 1, VisibilityTimestamp: timeNow}},
+		&DeleteWorkflowExecutionReplicationTask{TaskData: TaskData{Version: 1, TaskID: 1, VisibilityTimestamp: timeNow}},
+		&UpsertWorkflowSearchAttributesReplicationTask{TaskData: TaskData{Version: 1, TaskID: 1, VisibilityTimestamp: timeNow}},
 	}
 
 	for _, task := range tasks {
@@ -94,12 +97,18 @@ func TestTaskCommonMethods(t *testing.T) {
 			assert.Equal(t, TransferTaskTypeStartChildExecution, ty.GetTaskType())
 		case *RecordWorkflowClosedTask:
 			assert.Equal(t, TransferTaskTypeRecordWorkflowClosed, ty.GetTaskType())
+		case *DeleteWorkflowExecutionTask:
+			assert.Equal(t, TransferTaskTypeDeleteWorkflowExecution, ty.GetTaskType())
 		case *HistoryReplicationTask:
 			assert.Equal(t, ReplicationTaskTypeHistory, ty.GetTaskType())
 		case *SyncActivityTask:
 			assert.Equal(t, ReplicationTaskTypeSyncActivity, ty.GetTaskType())
 		case *FailoverMarkerTask:
 			assert.Equal(t, ReplicationTaskTypeFailoverMarker, ty.GetTaskType())
+		case *DeleteWorkflowExecutionReplicationTask:
+			assert.Equal(t, ReplicationTaskTypeDeleteWorkflowExecution, ty.GetTaskType())
+		case *UpsertWorkflowSearchAttributesReplicationTask:
+			assert.Equal(t, ReplicationTaskTypeUpsertWorkflowSearchAttributes, ty.GetTaskType())
 		default:
 			t.Fatalf("Unhandled task type: %T", t)
 		}
@@ -142,6 +151,7 @@ func TestTransferTaskMapping(t *testing.T) {
 		&UpsertWorkflowSearchAttributesTask{},
 		&StartChildExecutionTask{},
 		&RecordWorkflowClosedTask{},
+		&DeleteWorkflowExecutionTask{},
 	}
 	for i := 0; i < 1000; i++ {
 		for _, task := range tasks {
@@ -633,6 +643,7 @@ func TestIsTaskCorruptedWithAllTaskTypes(t *testing.T) {
 		&UpsertWorkflowSearchAttributesTask{WorkflowIdentifier: validIdentifier, TaskData: TaskData{Version: 1, TaskID: 1, VisibilityTimestamp: timeNow}},
 		&StartChildExecutionTask{WorkflowIdentifier: validIdentifier, TaskData: TaskData{Version: 1, TaskID: 1, VisibilityTimestamp: timeNow}},
 		&RecordWorkflowClosedTask{WorkflowIdentifier: validIdentifier, TaskData: TaskData{Version: 1, TaskID: 1, VisibilityTimestamp: timeNow}},
+		&DeleteWorkflowExecutionTask{WorkflowIdentifier: validIdentifier, TaskData: TaskData{Version: 1, TaskID: 1, VisibilityTimestamp: timeNow}},
 		&ActivityTimeoutTask{WorkflowIdentifier: validIdentifier, TaskData: TaskData{Version: 1, TaskID: 1, VisibilityTimestamp: timeNow}},
 		&UserTimerTask{WorkflowIdentifier: validIdentifier, TaskData: TaskData{Version: 1, TaskID: 1, VisibilityTimestamp: timeNow}},
 		&ActivityRetryTimerTask{WorkflowIdentifier: validIdentifier, TaskData: TaskData{Version: 1, TaskID: 1, VisibilityTimestamp: timeNow}},
@@ -640,6 +651,8 @@ func TestIsTaskCorruptedWithAllTaskTypes(t *testing.T) {
 		&HistoryReplicationTask{WorkflowIdentifier: validIdentifier, TaskData: TaskData{Version: 1, TaskID: 1, VisibilityTimestamp: timeNow}},
 		&SyncActivityTask{WorkflowIdentifier: validIdentifier, TaskData: TaskData{Version: 1, TaskID: 1, VisibilityTimestamp: timeNow}},
 		&FailoverMarkerTask{TaskData: TaskData{Version: 1, TaskID: 1, VisibilityTimestamp: timeNow}, DomainID: "test-domain"},
+		&DeleteWorkflowExecutionReplicationTask{WorkflowIdentifier: validIdentifier, TaskData: TaskData{Version: 1, TaskID: 1, VisibilityTimestamp: timeNow}},
+		&UpsertWorkflowSearchAttributesReplicationTask{WorkflowIdentifier: validIdentifier, TaskData: TaskData{Version: 1, TaskID: 1, VisibilityTimestamp: timeNow}},
 	}
 
 	// Test all task types with empty identifiers
@@ -658,6 +671,7 @@ func TestIsTaskCorruptedWithAllTaskTypes(t *testing.T) {
 		&UpsertWorkflowSearchAttributesTask{WorkflowIdentifier: emptyIdentifier, TaskData: TaskData{Version: 1, TaskID: 1, VisibilityTimestamp: timeNow}},
 		&StartChildExecutionTask{WorkflowIdentifier: emptyIdentifier, TaskData: TaskData{Version: 1, TaskID: 1, VisibilityTimestamp: timeNow}},
 		&RecordWorkflowClosedTask{WorkflowIdentifier: emptyIdentifier, TaskData: TaskData{Version: 1, TaskID: 1, VisibilityTimestamp: timeNow}},
+		&DeleteWorkflowExecutionTask{WorkflowIdentifier: emptyIdentifier, TaskData: TaskData{Version: 1, TaskID: 1, VisibilityTimestamp: timeNow}},
 		&ActivityTimeoutTask{WorkflowIdentifier: emptyIdentifier, TaskData: TaskData{Version: 1, TaskID: 1, VisibilityTimestamp: timeNow}},
 		&UserTimerTask{WorkflowIdentifier: emptyIdentifier, TaskData: TaskData{Version: 1, TaskID: 1, VisibilityTimestamp: timeNow}},
 		&ActivityRetryTimerTask{WorkflowIdentifier: emptyIdentifier, TaskData: TaskData{Version: 1, TaskID: 1, VisibilityTimestamp: timeNow}},
@@ -665,6 +679,8 @@ func TestIsTaskCorruptedWithAllTaskTypes(t *testing.T) {
 		&HistoryReplicationTask{WorkflowIdentifier: emptyIdentifier, TaskData: TaskData{Version: 1, TaskID: 1, VisibilityTimestamp: timeNow}},
 		&SyncActivityTask{WorkflowIdentifier: emptyIdentifier, TaskData: TaskData{Version: 1, TaskID: 1, VisibilityTimestamp: timeNow}},
 		&FailoverMarkerTask{TaskData: TaskData{Version: 1, TaskID: 1, VisibilityTimestamp: timeNow}, DomainID: ""},
+		&DeleteWorkflowExecutionReplicationTask{WorkflowIdentifier: emptyIdentifier, TaskData: TaskData{Version: 1, TaskID: 1, VisibilityTimestamp: timeNow}},
+		&UpsertWorkflowSearchAttributesReplicationTask{WorkflowIdentifier: emptyIdentifier, TaskData: TaskData{Version: 1, TaskID: 1, VisibilityTimestamp: timeNow}},
 	}
 
 	t.Run("All task types with valid identifiers should not be corrupted", func(t *testing.T) {
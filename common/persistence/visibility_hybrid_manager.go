@@ -45,6 +45,10 @@ type (
 )
 
 const (
+	// ContextKey pins a request to a single visibility store. Its value is the store name
+	// (e.g. "db", "es", "pinot", "os"); reads skip the shadow store and writes skip the
+	// configured write stores, so callers such as the visibility migration workflow can
+	// compare or backfill stores individually.
 	ContextKey           = ResponseComparatorContextKey("visibility-override")
 	dbVisStoreName       = "db"
 	advancedWriteModeOff = "off"
@@ -220,6 +224,13 @@ func (v *visibilityHybridManager) chooseVisibilityModeForAdmin() string {
 }
 
 func (v *visibilityHybridManager) chooseVisibilityManagerForWrite(ctx context.Context, visFunc func(string) error) error {
+	if storeName, ok := visibilityStoreOverride(ctx); ok {
+		if mgr, ok := v.visibilityMgrs[storeName]; !ok || mgr == nil {
+			return fmt.Errorf("Visibility store manager with name %s not found", storeName)
+		}
+		return visFunc(storeName)
+	}
+
 	var writeMode string
 	if v.writeVisibilityStoreName != nil {
 		writeMode = v.writeVisibilityStoreName()
@@ -315,7 +326,10 @@ func (v *visibilityHybridManager) ListOpenWorkflowExecutions(
 	ctx context.Context,
 	request *ListWorkflowExecutionsRequest,
 ) (*ListWorkflowExecutionsResponse, error) {
-	manager, shadowMgr := v.chooseVisibilityManagerForRead(ctx, request.Domain)
+	manager, shadowMgr, err := v.chooseVisibilityManagerForRead(ctx, request.Domain)
+	if err != nil {
+		return nil, err
+	}
 	if shadowMgr != nil {
 		go shadow(shadowMgr.ListOpenWorkflowExecutions, request, v.logger)
 	}
@@ -327,7 +341,10 @@ func (v *visibilityHybridManager) ListClosedWorkflowExecutions(
 	ctx context.Context,
 	request *ListWorkflowExecutionsRequest,
 ) (*ListWorkflowExecutionsResponse, error) {
-	manager, shadowMgr := v.chooseVisibilityManagerForRead(ctx, request.Domain)
+	manager, shadowMgr, err := v.chooseVisibilityManagerForRead(ctx, request.Domain)
+	if err != nil {
+		return nil, err
+	}
 	if shadowMgr != nil {
 		go shadow(shadowMgr.ListClosedWorkflowExecutions, request, v.logger)
 	}
@@ -338,7 +355,10 @@ func (v *visibilityHybridManager) ListOpenWorkflowExecutionsByType(
 	ctx context.Context,
 	request *ListWorkflowExecutionsByTypeRequest,
 ) (*ListWorkflowExecutionsResponse, error) {
-	manager, shadowMgr := v.chooseVisibilityManagerForRead(ctx, request.Domain)
+	manager, shadowMgr, err := v.chooseVisibilityManagerForRead(ctx, request.Domain)
+	if err != nil {
+		return nil, err
+	}
 	if shadowMgr != nil {
 		go shadow(shadowMgr.ListOpenWorkflowExecutionsByType, request, v.logger)
 	}
@@ -349,7 +369,10 @@ func (v *visibilityHybridManager) ListClosedWorkflowExecutionsByType(
 	ctx context.Context,
 	request *ListWorkflowExecutionsByTypeRequest,
 ) (*ListWorkflowExecutionsResponse, error) {
-	manager, shadowMgr := v.chooseVisibilityManagerForRead(ctx, request.Domain)
+	manager, shadowMgr, err := v.chooseVisibilityManagerForRead(ctx, request.Domain)
+	if err != nil {
+		return nil, err
+	}
 	if shadowMgr != nil {
 		go shadow(shadowMgr.ListClosedWorkflowExecutionsByType, request, v.logger)
 	}
@@ -360,7 +383,10 @@ func (v *visibilityHybridManager) ListOpenWorkflowExecutionsByWorkflowID(
 	ctx context.Context,
 	request *ListWorkflowExecutionsByWorkflowIDRequest,
 ) (*ListWorkflowExecutionsResponse, error) {
-	manager, shadowMgr := v.chooseVisibilityManagerForRead(ctx, request.Domain)
+	manager, shadowMgr, err := v.chooseVisibilityManagerForRead(ctx, request.Domain)
+	if err != nil {
+		return nil, err
+	}
 	if shadowMgr != nil {
 		go shadow(shadowMgr.ListOpenWorkflowExecutionsByWorkflowID, request, v.logger)
 	}
@@ -371,7 +397,10 @@ func (v *visibilityHybridManager) ListClosedWorkflowExecutionsByWorkflowID(
 	ctx context.Context,
 	request *ListWorkflowExecutionsByWorkflowIDRequest,
 ) (*ListWorkflowExecutionsResponse, error) {
-	manager, shadowMgr := v.chooseVisibilityManagerForRead(ctx, request.Domain)
+	manager, shadowMgr, err := v.chooseVisibilityManagerForRead(ctx, request.Domain)
+	if err != nil {
+		return nil, err
+	}
 	if shadowMgr != nil {
 		go shadow(shadowMgr.ListClosedWorkflowExecutionsByWorkflowID, request, v.logger)
 	}
@@ -382,7 +411,10 @@ func (v *visibilityHybridManager) ListClosedWorkflowExecutionsByStatus(
 	ctx context.Context,
 	request *ListClosedWorkflowExecutionsByStatusRequest,
 ) (*ListWorkflowExecutionsResponse, error) {
-	manager, shadowMgr := v.chooseVisibilityManagerForRead(ctx, request.Domain)
+	manager, shadowMgr, err := v.chooseVisibilityManagerForRead(ctx, request.Domain)
+	if err != nil {
+		return nil, err
+	}
 	if shadowMgr != nil {
 		go shadow(shadowMgr.ListClosedWorkflowExecutionsByStatus, request, v.logger)
 	}
@@ -393,7 +425,10 @@ func (v *visibilityHybridManager) GetClosedWorkflowExecution(
 	ctx context.Context,
 	request *GetClosedWorkflowExecutionRequest,
 ) (*GetClosedWorkflowExecutionResponse, error) {
-	manager, shadowMgr := v.chooseVisibilityManagerForRead(ctx, request.Domain)
+	manager, shadowMgr, err := v.chooseVisibilityManagerForRead(ctx, request.Domain)
+	if err != nil {
+		return nil, err
+	}
 	if shadowMgr != nil {
 		go shadow(shadowMgr.GetClosedWorkflowExecution, request, v.logger)
 	}
@@ -404,7 +439,10 @@ func (v *visibilityHybridManager) ListWorkflowExecutions(
 	ctx context.Context,
 	request *ListWorkflowExecutionsByQueryRequest,
 ) (*ListWorkflowExecutionsResponse, error) {
-	manager, shadowMgr := v.chooseVisibilityManagerForRead(ctx, request.Domain)
+	manager, shadowMgr, err := v.chooseVisibilityManagerForRead(ctx, request.Domain)
+	if err != nil {
+		return nil, err
+	}
 	if shadowMgr != nil {
 		go shadow(shadowMgr.ListWorkflowExecutions, request, v.logger)
 	}
@@ -415,7 +453,10 @@ func (v *visibilityHybridManager) ScanWorkflowExecutions(
 	ctx context.Context,
 	request *ListWorkflowExecutionsByQueryRequest,
 ) (*ListWorkflowExecutionsResponse, error) {
-	manager, shadowMgr := v.chooseVisibilityManagerForRead(ctx, request.Domain)
+	manager, shadowMgr, err := v.chooseVisibilityManagerForRead(ctx, request.Domain)
+	if err != nil {
+		return nil, err
+	}
 	if shadowMgr != nil {
 		go shadow(shadowMgr.ScanWorkflowExecutions, request, v.logger)
 	}
@@ -426,14 +467,40 @@ func (v *visibilityHybridManager) CountWorkflowExecutions(
 	ctx context.Context,
 	request *CountWorkflowExecutionsRequest,
 ) (*CountWorkflowExecutionsResponse, error) {
-	manager, shadowMgr := v.chooseVisibilityManagerForRead(ctx, request.Domain)
+	manager, shadowMgr, err := v.chooseVisibilityManagerForRead(ctx, request.Domain)
+	if err != nil {
+		return nil, err
+	}
 	if shadowMgr != nil {
 		go shadow(shadowMgr.CountWorkflowExecutions, request, v.logger)
 	}
 	return manager.CountWorkflowExecutions(ctx, request)
 }
 
-func (v *visibilityHybridManager) chooseVisibilityManagerForRead(ctx context.Context, domain string) (VisibilityManager, VisibilityManager) {
+func (v *visibilityHybridManager) AggregateWorkflowExecutions(
+	ctx context.Context,
+	request *AggregateWorkflowExecutionsRequest,
+) (*AggregateWorkflowExecutionsResponse, error) {
+	manager, shadowMgr, err := v.chooseVisibilityManagerForRead(ctx, request.Domain)
+	if err != nil {
+		return nil, err
+	}
+	if shadowMgr != nil {
+		go shadow(shadowMgr.AggregateWorkflowExecutions, request, v.logger)
+	}
+	return manager.AggregateWorkflowExecutions(ctx, request)
+}
+
+func (v *visibilityHybridManager) chooseVisibilityManagerForRead(ctx context.Context, domain string) (VisibilityManager, VisibilityManager, error) {
+	if storeName, ok := visibilityStoreOverride(ctx); ok {
+		// an explicit override must not silently fall back to another store, otherwise
+		// comparing two stores could end up comparing one store with itself
+		if mgr, ok := v.visibilityMgrs[storeName]; ok && mgr != nil {
+			return mgr, nil, nil
+		}
+		return nil, nil, fmt.Errorf("Visibility store manager with name %s not found", storeName)
+	}
+
 	var visibilityMgr, shadowMgr VisibilityManager
 	stores := strings.Split(v.readVisibilityStoreName(domain), ",")
 	for i := range stores {
@@ -452,7 +519,16 @@ func (v *visibilityHybridManager) chooseVisibilityManagerForRead(ctx context.Con
 		shadowMgr = v.visibilityMgrs[stores[1]]
 	}
 
-	return visibilityMgr, shadowMgr
+	return visibilityMgr, shadowMgr, nil
+}
+
+// visibilityStoreOverride returns the store name set under ContextKey, if any.
+func visibilityStoreOverride(ctx context.Context) (string, bool) {
+	storeName, ok := ctx.Value(ContextKey).(string)
+	if !ok || storeName == "" {
+		return "", false
+	}
+	return strings.ToLower(strings.TrimSpace(storeName)), true
 }
 
 func shadow[ReqT any, ResT any](f func(ctx context.Context, request ReqT) (ResT, error), request ReqT, logger log.Logger) {
@@ -2217,3 +2217,52 @@ func TestVisibilityHybridCountWorkflowExecutions(t *testing.T) {
 		})
 	}
 }
+
+func TestVisibilityHybridContextKeyOverride(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockDBVisibilityManager := NewMockVisibilityManager(ctrl)
+	mockESVisibilityManager := NewMockVisibilityManager(ctrl)
+	mockPinotVisibilityManager := NewMockVisibilityManager(ctrl)
+
+	visibilityMgrs := map[string]VisibilityManager{
+		dbVisStoreName: mockDBVisibilityManager,
+		esStoreName:    mockESVisibilityManager,
+		pinotStoreName: mockPinotVisibilityManager,
+	}
+	// read config has a shadow store and write config fans out to two stores, the override must bypass both
+	visibilityManager := NewVisibilityHybridManager(
+		visibilityMgrs,
+		dynamicproperties.GetStringPropertyFnFilteredByDomain(dualStoreName),
+		dynamicproperties.GetStringPropertyFn(dualStoreName),
+		dynamicproperties.GetBoolPropertyFnFilteredByDomain(true),
+		testStoreName,
+		log.NewNoop(),
+	)
+
+	t.Run("read is pinned to the override store", func(t *testing.T) {
+		mockDBVisibilityManager.EXPECT().CountWorkflowExecutions(gomock.Any(), gomock.Any()).
+			Return(&CountWorkflowExecutionsResponse{Count: 10}, nil).Times(1)
+
+		ctx := context.WithValue(context.Background(), ContextKey, dbVisStoreName)
+		resp, err := visibilityManager.CountWorkflowExecutions(ctx, &CountWorkflowExecutionsRequest{Domain: "test-domain"})
+		assert.NoError(t, err)
+		assert.Equal(t, int64(10), resp.Count)
+	})
+
+	t.Run("write is pinned to the override store", func(t *testing.T) {
+		mockPinotVisibilityManager.EXPECT().RecordWorkflowExecutionClosed(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+
+		ctx := context.WithValue(context.Background(), ContextKey, pinotStoreName)
+		err := visibilityManager.RecordWorkflowExecutionClosed(ctx, &RecordWorkflowExecutionClosedRequest{})
+		assert.NoError(t, err)
+	})
+
+	t.Run("unknown override store does not fall back", func(t *testing.T) {
+		ctx := context.WithValue(context.Background(), ContextKey, "os")
+		_, err := visibilityManager.ListClosedWorkflowExecutions(ctx, &ListWorkflowExecutionsRequest{Domain: "test-domain"})
+		assert.Error(t, err)
+
+		err = visibilityManager.RecordWorkflowExecutionClosed(ctx, &RecordWorkflowExecutionClosedRequest{})
+		assert.Error(t, err)
+	})
+}
@@ -179,6 +179,21 @@ type (
 		Count int64
 	}
 
+	// AggregateWorkflowExecutionsRequest is request from AggregateWorkflowExecutions
+	AggregateWorkflowExecutionsRequest struct {
+		DomainUUID string
+		Domain     string // domain name is not persisted, but used as config filter key
+		Query      string
+		// GroupBy is the search attribute whose values the matching executions are grouped by
+		GroupBy   string
+		MaxGroups int
+	}
+
+	// AggregateWorkflowExecutionsResponse is response to AggregateWorkflowExecutions
+	AggregateWorkflowExecutionsResponse struct {
+		Groups []*types.WorkflowExecutionGroup
+	}
+
 	// ListWorkflowExecutionsByTypeRequest is used to list executions of
 	// a specific type in a domain
 	ListWorkflowExecutionsByTypeRequest struct {
@@ -242,6 +257,7 @@ type (
 		ListWorkflowExecutions(ctx context.Context, request *ListWorkflowExecutionsByQueryRequest) (*ListWorkflowExecutionsResponse, error)
 		ScanWorkflowExecutions(ctx context.Context, request *ListWorkflowExecutionsByQueryRequest) (*ListWorkflowExecutionsResponse, error)
 		CountWorkflowExecutions(ctx context.Context, request *CountWorkflowExecutionsRequest) (*CountWorkflowExecutionsResponse, error)
+		AggregateWorkflowExecutions(ctx context.Context, request *AggregateWorkflowExecutionsRequest) (*AggregateWorkflowExecutionsResponse, error)
 		// NOTE: GetClosedWorkflowExecution is only for persistence testing, currently no index is supported for filtering by RunID
 		GetClosedWorkflowExecution(ctx context.Context, request *GetClosedWorkflowExecutionRequest) (*GetClosedWorkflowExecutionResponse, error)
 		DeleteUninitializedWorkflowExecution(ctx context.Context, request *VisibilityDeleteWorkflowExecutionRequest) error
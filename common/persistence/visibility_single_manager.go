@@ -34,22 +34,25 @@ import (
 
 type (
 	visibilityManagerImpl struct {
-		serializer  PayloadSerializer
-		persistence VisibilityStore
-		logger      log.Logger
-		dc          *DynamicConfiguration
+		serializer   PayloadSerializer
+		payloadCodec PayloadCodec
+		persistence  VisibilityStore
+		logger       log.Logger
+		dc           *DynamicConfiguration
 	}
 )
 
 var _ VisibilityManager = (*visibilityManagerImpl)(nil)
 
-// NewVisibilityManagerImpl returns new VisibilityManager via a VisibilityStore
-func NewVisibilityManagerImpl(persistence VisibilityStore, logger log.Logger, dc *DynamicConfiguration) VisibilityManager {
+// NewVisibilityManagerImpl returns new VisibilityManager via a VisibilityStore,
+// payloadCodec is optional and encodes the memo of workflows at rest
+func NewVisibilityManagerImpl(persistence VisibilityStore, logger log.Logger, payloadCodec PayloadCodec, dc *DynamicConfiguration) VisibilityManager {
 	return &visibilityManagerImpl{
-		serializer:  NewPayloadSerializer(),
-		persistence: persistence,
-		logger:      logger,
-		dc:          dc,
+		serializer:   NewPayloadSerializer(),
+		payloadCodec: payloadCodec,
+		persistence:  persistence,
+		logger:       logger,
+		dc:           dc,
 	}
 }
 
@@ -65,6 +68,10 @@ func (v *visibilityManagerImpl) RecordWorkflowExecutionStarted(
 	ctx context.Context,
 	request *RecordWorkflowExecutionStartedRequest,
 ) error {
+	memo, err := v.serializeMemo(request.Memo, request.Domain, request.DomainUUID, request.Execution.GetWorkflowID(), request.Execution.GetRunID())
+	if err != nil {
+		return err
+	}
 	req := &InternalRecordWorkflowExecutionStartedRequest{
 		DomainUUID:             request.DomainUUID,
 		WorkflowID:             request.Execution.GetWorkflowID(),
@@ -80,7 +87,7 @@ func (v *visibilityManagerImpl) RecordWorkflowExecutionStarted(
 		NumClusters:            request.NumClusters,
 		ClusterAttributeScope:  request.ClusterAttributeScope,
 		ClusterAttributeName:   request.ClusterAttributeName,
-		Memo:                   v.serializeMemo(request.Memo, request.DomainUUID, request.Execution.GetWorkflowID(), request.Execution.GetRunID()),
+		Memo:                   memo,
 		UpdateTimestamp:        time.Unix(0, request.UpdateTimestamp),
 		SearchAttributes:       request.SearchAttributes,
 		ShardID:                request.ShardID,
@@ -94,6 +101,10 @@ func (v *visibilityManagerImpl) RecordWorkflowExecutionClosed(
 	ctx context.Context,
 	request *RecordWorkflowExecutionClosedRequest,
 ) error {
+	memo, err := v.serializeMemo(request.Memo, request.Domain, request.DomainUUID, request.Execution.GetWorkflowID(), request.Execution.GetRunID())
+	if err != nil {
+		return err
+	}
 	req := &InternalRecordWorkflowExecutionClosedRequest{
 		DomainUUID:             request.DomainUUID,
 		WorkflowID:             request.Execution.GetWorkflowID(),
@@ -102,7 +113,7 @@ func (v *visibilityManagerImpl) RecordWorkflowExecutionClosed(
 		StartTimestamp:         time.Unix(0, request.StartTimestamp),
 		ExecutionTimestamp:     time.Unix(0, request.ExecutionTimestamp),
 		TaskID:                 request.TaskID,
-		Memo:                   v.serializeMemo(request.Memo, request.DomainUUID, request.Execution.GetWorkflowID(), request.Execution.GetRunID()),
+		Memo:                   memo,
 		TaskList:               request.TaskList,
 		ClusterAttributeScope:  request.ClusterAttributeScope,
 		ClusterAttributeName:   request.ClusterAttributeName,
@@ -141,6 +152,10 @@ func (v *visibilityManagerImpl) UpsertWorkflowExecution(
 	ctx context.Context,
 	request *UpsertWorkflowExecutionRequest,
 ) error {
+	memo, err := v.serializeMemo(request.Memo, request.Domain, request.DomainUUID, request.Execution.GetWorkflowID(), request.Execution.GetRunID())
+	if err != nil {
+		return err
+	}
 	req := &InternalUpsertWorkflowExecutionRequest{
 		DomainUUID:                  request.DomainUUID,
 		WorkflowID:                  request.Execution.GetWorkflowID(),
@@ -149,7 +164,7 @@ func (v *visibilityManagerImpl) UpsertWorkflowExecution(
 		StartTimestamp:              time.Unix(0, request.StartTimestamp),
 		ExecutionTimestamp:          time.Unix(0, request.ExecutionTimestamp),
 		TaskID:                      request.TaskID,
-		Memo:                        v.serializeMemo(request.Memo, request.DomainUUID, request.Execution.GetWorkflowID(), request.Execution.GetRunID()),
+		Memo:                        memo,
 		TaskList:                    request.TaskList,
 		IsCron:                      request.IsCron,
 		CronSchedule:                request.CronSchedule,
@@ -173,7 +188,7 @@ func (v *visibilityManagerImpl) ListOpenWorkflowExecutions(
 	if err != nil {
 		return nil, err
 	}
-	return v.convertInternalListResponse(internalResp), nil
+	return v.convertInternalListResponse(internalResp)
 }
 
 func (v *visibilityManagerImpl) ListClosedWorkflowExecutions(
@@ -184,7 +199,7 @@ func (v *visibilityManagerImpl) ListClosedWorkflowExecutions(
 	if err != nil {
 		return nil, err
 	}
-	return v.convertInternalListResponse(internalResp), nil
+	return v.convertInternalListResponse(internalResp)
 }
 
 func (v *visibilityManagerImpl) ListOpenWorkflowExecutionsByType(
@@ -202,7 +217,7 @@ func (v *visibilityManagerImpl) ListOpenWorkflowExecutionsByType(
 	if err != nil {
 		return nil, err
 	}
-	return v.convertInternalListResponse(internalResp), nil
+	return v.convertInternalListResponse(internalResp)
 }
 
 func (v *visibilityManagerImpl) ListClosedWorkflowExecutionsByType(
@@ -220,7 +235,7 @@ func (v *visibilityManagerImpl) ListClosedWorkflowExecutionsByType(
 	if err != nil {
 		return nil, err
 	}
-	return v.convertInternalListResponse(internalResp), nil
+	return v.convertInternalListResponse(internalResp)
 }
 
 func (v *visibilityManagerImpl) ListOpenWorkflowExecutionsByWorkflowID(
@@ -238,7 +253,7 @@ func (v *visibilityManagerImpl) ListOpenWorkflowExecutionsByWorkflowID(
 	if err != nil {
 		return nil, err
 	}
-	return v.convertInternalListResponse(internalResp), nil
+	return v.convertInternalListResponse(internalResp)
 }
 
 func (v *visibilityManagerImpl) ListClosedWorkflowExecutionsByWorkflowID(
@@ -256,7 +271,7 @@ func (v *visibilityManagerImpl) ListClosedWorkflowExecutionsByWorkflowID(
 	if err != nil {
 		return nil, err
 	}
-	return v.convertInternalListResponse(internalResp), nil
+	return v.convertInternalListResponse(internalResp)
 }
 
 func (v *visibilityManagerImpl) ListClosedWorkflowExecutionsByStatus(
@@ -274,7 +289,7 @@ func (v *visibilityManagerImpl) ListClosedWorkflowExecutionsByStatus(
 	if err != nil {
 		return nil, err
 	}
-	return v.convertInternalListResponse(internalResp), nil
+	return v.convertInternalListResponse(internalResp)
 }
 
 func (v *visibilityManagerImpl) GetClosedWorkflowExecution(
@@ -290,7 +305,7 @@ func (v *visibilityManagerImpl) GetClosedWorkflowExecution(
 	if err != nil {
 		return nil, err
 	}
-	return v.convertInternalGetResponse(internalResp), nil
+	return v.convertInternalGetResponse(internalResp)
 }
 
 func (v *visibilityManagerImpl) DeleteWorkflowExecution(
@@ -315,7 +330,7 @@ func (v *visibilityManagerImpl) ListWorkflowExecutions(
 	if err != nil {
 		return nil, err
 	}
-	return v.convertInternalListResponse(internalResp), nil
+	return v.convertInternalListResponse(internalResp)
 }
 
 func (v *visibilityManagerImpl) ScanWorkflowExecutions(
@@ -326,7 +341,7 @@ func (v *visibilityManagerImpl) ScanWorkflowExecutions(
 	if err != nil {
 		return nil, err
 	}
-	return v.convertInternalListResponse(internalResp), nil
+	return v.convertInternalListResponse(internalResp)
 }
 
 func (v *visibilityManagerImpl) CountWorkflowExecutions(
@@ -336,29 +351,44 @@ func (v *visibilityManagerImpl) CountWorkflowExecutions(
 	return v.persistence.CountWorkflowExecutions(ctx, request)
 }
 
-func (v *visibilityManagerImpl) convertInternalGetResponse(internalResp *InternalGetClosedWorkflowExecutionResponse) *GetClosedWorkflowExecutionResponse {
+func (v *visibilityManagerImpl) AggregateWorkflowExecutions(
+	ctx context.Context,
+	request *AggregateWorkflowExecutionsRequest,
+) (*AggregateWorkflowExecutionsResponse, error) {
+	return v.persistence.AggregateWorkflowExecutions(ctx, request)
+}
+
+func (v *visibilityManagerImpl) convertInternalGetResponse(internalResp *InternalGetClosedWorkflowExecutionResponse) (*GetClosedWorkflowExecutionResponse, error) {
 	if internalResp == nil {
-		return nil
+		return nil, nil
 	}
 
 	resp := &GetClosedWorkflowExecutionResponse{}
-	resp.Execution = v.convertVisibilityWorkflowExecutionInfo(internalResp.Execution)
-	return resp
+	var err error
+	resp.Execution, err = v.convertVisibilityWorkflowExecutionInfo(internalResp.Execution)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
 }
 
-func (v *visibilityManagerImpl) convertInternalListResponse(internalResp *InternalListWorkflowExecutionsResponse) *ListWorkflowExecutionsResponse {
+func (v *visibilityManagerImpl) convertInternalListResponse(internalResp *InternalListWorkflowExecutionsResponse) (*ListWorkflowExecutionsResponse, error) {
 	if internalResp == nil {
-		return nil
+		return nil, nil
 	}
 
 	resp := &ListWorkflowExecutionsResponse{}
 	resp.Executions = make([]*types.WorkflowExecutionInfo, len(internalResp.Executions))
 	for i, execution := range internalResp.Executions {
-		resp.Executions[i] = v.convertVisibilityWorkflowExecutionInfo(execution)
+		var err error
+		resp.Executions[i], err = v.convertVisibilityWorkflowExecutionInfo(execution)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	resp.NextPageToken = internalResp.NextPageToken
-	return resp
+	return resp, nil
 }
 
 func (v *visibilityManagerImpl) getSearchAttributes(attr map[string]interface{}) (*types.SearchAttributes, error) {
@@ -381,7 +411,7 @@ func (v *visibilityManagerImpl) getSearchAttributes(attr map[string]interface{})
 	}, nil
 }
 
-func (v *visibilityManagerImpl) convertVisibilityWorkflowExecutionInfo(execution *InternalVisibilityWorkflowExecutionInfo) *types.WorkflowExecutionInfo {
+func (v *visibilityManagerImpl) convertVisibilityWorkflowExecutionInfo(execution *InternalVisibilityWorkflowExecutionInfo) (*types.WorkflowExecutionInfo, error) {
 	// special handling of ExecutionTime for cron or retry
 	if execution.ExecutionTime.UnixNano() == 0 {
 		execution.ExecutionTime = execution.StartTime
@@ -394,6 +424,23 @@ func (v *visibilityManagerImpl) convertVisibilityWorkflowExecutionInfo(execution
 			tag.WorkflowRunID(execution.RunID),
 			tag.Error(err))
 	}
+	if memo != nil {
+		fields, redacted, err := decodeMemoFields(v.payloadCodec, memo.Fields)
+		if err != nil {
+			v.logger.Error("failed to decode memo",
+				tag.WorkflowID(execution.WorkflowID),
+				tag.WorkflowRunID(execution.RunID),
+				tag.Error(err))
+			return nil, err
+		}
+		if redacted > 0 {
+			v.logger.Warn("Redacted memo fields encoded with an unavailable key",
+				tag.WorkflowID(execution.WorkflowID),
+				tag.WorkflowRunID(execution.RunID),
+				tag.Counter(redacted))
+		}
+		memo = &types.Memo{Fields: fields}
+	}
 	searchAttributes, err := v.getSearchAttributes(execution.SearchAttributes)
 	if err != nil {
 		v.logger.Error("failed to convert search attributes",
@@ -428,7 +475,7 @@ func (v *visibilityManagerImpl) convertVisibilityWorkflowExecutionInfo(execution
 		convertedExecution.HistoryLength = execution.HistoryLength
 	}
 
-	return convertedExecution
+	return convertedExecution, nil
 }
 
 func (v *visibilityManagerImpl) toInternalListWorkflowExecutionsRequest(req *ListWorkflowExecutionsRequest) *InternalListWorkflowExecutionsRequest {
@@ -445,7 +492,16 @@ func (v *visibilityManagerImpl) toInternalListWorkflowExecutionsRequest(req *Lis
 	}
 }
 
-func (v *visibilityManagerImpl) serializeMemo(visibilityMemo *types.Memo, domainID, wID, rID string) *DataBlob {
+// serializeMemo serializes the memo after escaping it and encoding it with the payload codec if it is configured, failing
+// to encode the memo fails the request while a memo which can't be serialized is only logged and dropped
+func (v *visibilityManagerImpl) serializeMemo(visibilityMemo *types.Memo, domainName, domainID, wID, rID string) (*DataBlob, error) {
+	if visibilityMemo != nil {
+		fields, err := encodeMemoFields(v.payloadCodec, domainName, visibilityMemo.Fields)
+		if err != nil {
+			return nil, err
+		}
+		visibilityMemo = &types.Memo{Fields: fields}
+	}
 	memo, err := v.serializer.SerializeVisibilityMemo(visibilityMemo, constants.EncodingType(v.dc.SerializationEncoding()))
 	if err != nil {
 		v.logger.WithTags(
@@ -456,7 +512,7 @@ func (v *visibilityManagerImpl) serializeMemo(visibilityMemo *types.Memo, domain
 			Error("Unable to encode visibility memo")
 	}
 	if memo == nil {
-		return &DataBlob{}
+		return &DataBlob{}, nil
 	}
-	return memo
+	return memo, nil
 }
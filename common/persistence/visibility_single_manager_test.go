@@ -29,8 +29,10 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 
+	"github.com/uber/cadence/common/constants"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/types"
 )
@@ -48,7 +50,7 @@ func TestNewVisibilityManagerImpl(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			mockVisibilityStore := NewMockVisibilityStore(ctrl)
 			assert.NotPanics(t, func() {
-				NewVisibilityManagerImpl(mockVisibilityStore, log.NewNoop(), NewDefaultDynamicConfiguration())
+				NewVisibilityManagerImpl(mockVisibilityStore, log.NewNoop(), nil, NewDefaultDynamicConfiguration())
 			})
 		})
 	}
@@ -67,7 +69,7 @@ func TestClose(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			mockVisibilityStore := NewMockVisibilityStore(ctrl)
 			mockVisibilityStore.EXPECT().Close().Return().Times(1)
-			visibilityManager := NewVisibilityManagerImpl(mockVisibilityStore, log.NewNoop(), NewDefaultDynamicConfiguration())
+			visibilityManager := NewVisibilityManagerImpl(mockVisibilityStore, log.NewNoop(), nil, NewDefaultDynamicConfiguration())
 			assert.NotPanics(t, func() {
 				visibilityManager.Close()
 			})
@@ -88,7 +90,7 @@ func TestGetName(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			mockVisibilityStore := NewMockVisibilityStore(ctrl)
 			mockVisibilityStore.EXPECT().GetName().Return(testTableName).Times(1)
-			visibilityManager := NewVisibilityManagerImpl(mockVisibilityStore, log.NewNoop(), NewDefaultDynamicConfiguration())
+			visibilityManager := NewVisibilityManagerImpl(mockVisibilityStore, log.NewNoop(), nil, NewDefaultDynamicConfiguration())
 
 			assert.NotPanics(t, func() {
 				visibilityManager.GetName()
@@ -151,7 +153,7 @@ func TestRecordWorkflowExecutionStarted(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			mockVisibilityStore := NewMockVisibilityStore(ctrl)
-			visibilityManager := NewVisibilityManagerImpl(mockVisibilityStore, log.NewNoop(), NewDefaultDynamicConfiguration())
+			visibilityManager := NewVisibilityManagerImpl(mockVisibilityStore, log.NewNoop(), nil, NewDefaultDynamicConfiguration())
 
 			test.visibilityStoreAffordance(mockVisibilityStore)
 
@@ -215,7 +217,7 @@ func TestRecordWorkflowExecutionClosed(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			mockVisibilityStore := NewMockVisibilityStore(ctrl)
-			visibilityManager := NewVisibilityManagerImpl(mockVisibilityStore, log.NewNoop(), NewDefaultDynamicConfiguration())
+			visibilityManager := NewVisibilityManagerImpl(mockVisibilityStore, log.NewNoop(), nil, NewDefaultDynamicConfiguration())
 
 			test.visibilityStoreAffordance(mockVisibilityStore)
 
@@ -269,7 +271,7 @@ func TestRecordWorkflowExecutionUninitialized(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			mockVisibilityStore := NewMockVisibilityStore(ctrl)
-			visibilityManager := NewVisibilityManagerImpl(mockVisibilityStore, log.NewNoop(), NewDefaultDynamicConfiguration())
+			visibilityManager := NewVisibilityManagerImpl(mockVisibilityStore, log.NewNoop(), nil, NewDefaultDynamicConfiguration())
 
 			test.visibilityStoreAffordance(mockVisibilityStore)
 
@@ -333,7 +335,7 @@ func TestUpsertWorkflowExecution(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			mockVisibilityStore := NewMockVisibilityStore(ctrl)
-			visibilityManager := NewVisibilityManagerImpl(mockVisibilityStore, log.NewNoop(), NewDefaultDynamicConfiguration())
+			visibilityManager := NewVisibilityManagerImpl(mockVisibilityStore, log.NewNoop(), nil, NewDefaultDynamicConfiguration())
 
 			test.visibilityStoreAffordance(mockVisibilityStore)
 
@@ -377,7 +379,7 @@ func TestDeleteWorkflowExecution(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			mockVisibilityStore := NewMockVisibilityStore(ctrl)
-			visibilityManager := NewVisibilityManagerImpl(mockVisibilityStore, log.NewNoop(), NewDefaultDynamicConfiguration())
+			visibilityManager := NewVisibilityManagerImpl(mockVisibilityStore, log.NewNoop(), nil, NewDefaultDynamicConfiguration())
 
 			test.visibilityStoreAffordance(mockVisibilityStore)
 
@@ -421,7 +423,7 @@ func TestDeleteUninitializedWorkflowExecution(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			mockVisibilityStore := NewMockVisibilityStore(ctrl)
-			visibilityManager := NewVisibilityManagerImpl(mockVisibilityStore, log.NewNoop(), NewDefaultDynamicConfiguration())
+			visibilityManager := NewVisibilityManagerImpl(mockVisibilityStore, log.NewNoop(), nil, NewDefaultDynamicConfiguration())
 
 			test.visibilityStoreAffordance(mockVisibilityStore)
 
@@ -465,7 +467,7 @@ func TestListOpenWorkflowExecutions(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			mockVisibilityStore := NewMockVisibilityStore(ctrl)
-			visibilityManager := NewVisibilityManagerImpl(mockVisibilityStore, log.NewNoop(), NewDefaultDynamicConfiguration())
+			visibilityManager := NewVisibilityManagerImpl(mockVisibilityStore, log.NewNoop(), nil, NewDefaultDynamicConfiguration())
 
 			test.visibilityStoreAffordance(mockVisibilityStore)
 
@@ -509,7 +511,7 @@ func TestListClosedWorkflowExecutions(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			mockVisibilityStore := NewMockVisibilityStore(ctrl)
-			visibilityManager := NewVisibilityManagerImpl(mockVisibilityStore, log.NewNoop(), NewDefaultDynamicConfiguration())
+			visibilityManager := NewVisibilityManagerImpl(mockVisibilityStore, log.NewNoop(), nil, NewDefaultDynamicConfiguration())
 
 			test.visibilityStoreAffordance(mockVisibilityStore)
 
@@ -553,7 +555,7 @@ func TestListOpenWorkflowExecutionsByType(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			mockVisibilityStore := NewMockVisibilityStore(ctrl)
-			visibilityManager := NewVisibilityManagerImpl(mockVisibilityStore, log.NewNoop(), NewDefaultDynamicConfiguration())
+			visibilityManager := NewVisibilityManagerImpl(mockVisibilityStore, log.NewNoop(), nil, NewDefaultDynamicConfiguration())
 
 			test.visibilityStoreAffordance(mockVisibilityStore)
 
@@ -597,7 +599,7 @@ func TestTestListClosedWorkflowExecutionsByType(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			mockVisibilityStore := NewMockVisibilityStore(ctrl)
-			visibilityManager := NewVisibilityManagerImpl(mockVisibilityStore, log.NewNoop(), NewDefaultDynamicConfiguration())
+			visibilityManager := NewVisibilityManagerImpl(mockVisibilityStore, log.NewNoop(), nil, NewDefaultDynamicConfiguration())
 
 			test.visibilityStoreAffordance(mockVisibilityStore)
 
@@ -641,7 +643,7 @@ func TestListOpenWorkflowExecutionsByWorkflowID(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			mockVisibilityStore := NewMockVisibilityStore(ctrl)
-			visibilityManager := NewVisibilityManagerImpl(mockVisibilityStore, log.NewNoop(), NewDefaultDynamicConfiguration())
+			visibilityManager := NewVisibilityManagerImpl(mockVisibilityStore, log.NewNoop(), nil, NewDefaultDynamicConfiguration())
 
 			test.visibilityStoreAffordance(mockVisibilityStore)
 
@@ -685,7 +687,7 @@ func TestListClosedWorkflowExecutionsByWorkflowID(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			mockVisibilityStore := NewMockVisibilityStore(ctrl)
-			visibilityManager := NewVisibilityManagerImpl(mockVisibilityStore, log.NewNoop(), NewDefaultDynamicConfiguration())
+			visibilityManager := NewVisibilityManagerImpl(mockVisibilityStore, log.NewNoop(), nil, NewDefaultDynamicConfiguration())
 
 			test.visibilityStoreAffordance(mockVisibilityStore)
 
@@ -729,7 +731,7 @@ func TestListClosedWorkflowExecutionsByStatus(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			mockVisibilityStore := NewMockVisibilityStore(ctrl)
-			visibilityManager := NewVisibilityManagerImpl(mockVisibilityStore, log.NewNoop(), NewDefaultDynamicConfiguration())
+			visibilityManager := NewVisibilityManagerImpl(mockVisibilityStore, log.NewNoop(), nil, NewDefaultDynamicConfiguration())
 
 			test.visibilityStoreAffordance(mockVisibilityStore)
 
@@ -780,7 +782,7 @@ func TestGetClosedWorkflowExecution(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			mockVisibilityStore := NewMockVisibilityStore(ctrl)
-			visibilityManager := NewVisibilityManagerImpl(mockVisibilityStore, log.NewNoop(), NewDefaultDynamicConfiguration())
+			visibilityManager := NewVisibilityManagerImpl(mockVisibilityStore, log.NewNoop(), nil, NewDefaultDynamicConfiguration())
 
 			test.visibilityStoreAffordance(mockVisibilityStore)
 
@@ -839,7 +841,7 @@ func TestListWorkflowExecutions(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			mockVisibilityStore := NewMockVisibilityStore(ctrl)
-			visibilityManager := NewVisibilityManagerImpl(mockVisibilityStore, log.NewNoop(), NewDefaultDynamicConfiguration())
+			visibilityManager := NewVisibilityManagerImpl(mockVisibilityStore, log.NewNoop(), nil, NewDefaultDynamicConfiguration())
 
 			test.visibilityStoreAffordance(mockVisibilityStore)
 
@@ -883,7 +885,7 @@ func TestScanWorkflowExecutions(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			mockVisibilityStore := NewMockVisibilityStore(ctrl)
-			visibilityManager := NewVisibilityManagerImpl(mockVisibilityStore, log.NewNoop(), NewDefaultDynamicConfiguration())
+			visibilityManager := NewVisibilityManagerImpl(mockVisibilityStore, log.NewNoop(), nil, NewDefaultDynamicConfiguration())
 
 			test.visibilityStoreAffordance(mockVisibilityStore)
 
@@ -927,7 +929,7 @@ func TestCountWorkflowExecutions(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			mockVisibilityStore := NewMockVisibilityStore(ctrl)
-			visibilityManager := NewVisibilityManagerImpl(mockVisibilityStore, log.NewNoop(), NewDefaultDynamicConfiguration())
+			visibilityManager := NewVisibilityManagerImpl(mockVisibilityStore, log.NewNoop(), nil, NewDefaultDynamicConfiguration())
 
 			test.visibilityStoreAffordance(mockVisibilityStore)
 
@@ -963,7 +965,7 @@ func TestGetSearchAttributes(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			mockVisibilityStore := NewMockVisibilityStore(ctrl)
-			visibilityManager := NewVisibilityManagerImpl(mockVisibilityStore, log.NewNoop(), NewDefaultDynamicConfiguration())
+			visibilityManager := NewVisibilityManagerImpl(mockVisibilityStore, log.NewNoop(), nil, NewDefaultDynamicConfiguration())
 			visibilityManagerImpl := visibilityManager.(*visibilityManagerImpl)
 
 			actualOutput, actualErr := visibilityManagerImpl.getSearchAttributes(*test.input)
@@ -1013,10 +1015,11 @@ func TestConvertVisibilityWorkflowExecutionInfo(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			mockVisibilityStore := NewMockVisibilityStore(ctrl)
-			visibilityManager := NewVisibilityManagerImpl(mockVisibilityStore, log.NewNoop(), NewDefaultDynamicConfiguration())
+			visibilityManager := NewVisibilityManagerImpl(mockVisibilityStore, log.NewNoop(), nil, NewDefaultDynamicConfiguration())
 			visibilityManagerImpl := visibilityManager.(*visibilityManagerImpl)
 
-			actualOutput := visibilityManagerImpl.convertVisibilityWorkflowExecutionInfo(test.input)
+			actualOutput, err := visibilityManagerImpl.convertVisibilityWorkflowExecutionInfo(test.input)
+			assert.NoError(t, err)
 			assert.Equal(t, *test.expectedOutput.ExecutionTime, *actualOutput.ExecutionTime)
 		})
 	}
@@ -1025,7 +1028,7 @@ func TestConvertVisibilityWorkflowExecutionInfo(t *testing.T) {
 func TestToInternalListWorkflowExecutionsRequest(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	mockVisibilityStore := NewMockVisibilityStore(ctrl)
-	visibilityManager := NewVisibilityManagerImpl(mockVisibilityStore, log.NewNoop(), NewDefaultDynamicConfiguration())
+	visibilityManager := NewVisibilityManagerImpl(mockVisibilityStore, log.NewNoop(), nil, NewDefaultDynamicConfiguration())
 	visibilityManagerImpl := visibilityManager.(*visibilityManagerImpl)
 
 	assert.Nil(t, visibilityManagerImpl.toInternalListWorkflowExecutionsRequest(nil))
@@ -1036,10 +1039,36 @@ func TestSerializeMemo(t *testing.T) {
 	mockVisibilityStore := NewMockVisibilityStore(ctrl)
 	mockPayloadSerializer := NewMockPayloadSerializer(ctrl)
 	mockPayloadSerializer.EXPECT().SerializeVisibilityMemo(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("error")).Times(1)
-	visibilityManager := NewVisibilityManagerImpl(mockVisibilityStore, log.NewNoop(), NewDefaultDynamicConfiguration())
+	visibilityManager := NewVisibilityManagerImpl(mockVisibilityStore, log.NewNoop(), nil, NewDefaultDynamicConfiguration())
 	visibilityManagerImpl := visibilityManager.(*visibilityManagerImpl)
 	visibilityManagerImpl.serializer = mockPayloadSerializer
 	assert.NotPanics(t, func() {
-		visibilityManagerImpl.serializeMemo(nil, "testDomain", "testWorkflowID", "testRunID")
+		visibilityManagerImpl.serializeMemo(nil, "testDomainName", "testDomain", "testWorkflowID", "testRunID")
 	})
 }
+
+func TestMemoPayloadCodec(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockVisibilityStore := NewMockVisibilityStore(ctrl)
+	visibilityManager := NewVisibilityManagerImpl(mockVisibilityStore, log.NewNoop(), testPayloadCodec{}, NewDefaultDynamicConfiguration())
+	visibilityManagerImpl := visibilityManager.(*visibilityManagerImpl)
+	memo := &types.Memo{Fields: map[string][]byte{"key": []byte("value")}}
+
+	blob, err := visibilityManagerImpl.serializeMemo(memo, "testDomainName", "testDomain", "testWorkflowID", "testRunID")
+	require.NoError(t, err)
+	persisted, err := NewPayloadSerializer().DeserializeVisibilityMemo(blob)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("enc|value"), persisted.Fields["key"])
+	// the memo of the request is still used by the caller
+	assert.Equal(t, []byte("value"), memo.Fields["key"])
+
+	execution, err := visibilityManagerImpl.convertVisibilityWorkflowExecutionInfo(&InternalVisibilityWorkflowExecutionInfo{Memo: blob})
+	require.NoError(t, err)
+	assert.Equal(t, memo, execution.Memo)
+
+	lostBlob, err := NewPayloadSerializer().SerializeVisibilityMemo(&types.Memo{Fields: map[string][]byte{"key": []byte("lost|value")}}, constants.EncodingTypeThriftRW)
+	require.NoError(t, err)
+	execution, err = visibilityManagerImpl.convertVisibilityWorkflowExecutionInfo(&InternalVisibilityWorkflowExecutionInfo{Memo: lostBlob})
+	require.NoError(t, err)
+	assert.Equal(t, RedactedPayload, execution.Memo.Fields["key"])
+}
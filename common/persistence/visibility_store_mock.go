@@ -40,6 +40,21 @@ func (m *MockVisibilityStore) EXPECT() *MockVisibilityStoreMockRecorder {
 	return m.recorder
 }
 
+// AggregateWorkflowExecutions mocks base method.
+func (m *MockVisibilityStore) AggregateWorkflowExecutions(ctx context.Context, request *AggregateWorkflowExecutionsRequest) (*AggregateWorkflowExecutionsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AggregateWorkflowExecutions", ctx, request)
+	ret0, _ := ret[0].(*AggregateWorkflowExecutionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AggregateWorkflowExecutions indicates an expected call of AggregateWorkflowExecutions.
+func (mr *MockVisibilityStoreMockRecorder) AggregateWorkflowExecutions(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AggregateWorkflowExecutions", reflect.TypeOf((*MockVisibilityStore)(nil).AggregateWorkflowExecutions), ctx, request)
+}
+
 // Close mocks base method.
 func (m *MockVisibilityStore) Close() {
 	m.ctrl.T.Helper()
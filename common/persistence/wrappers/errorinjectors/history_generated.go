@@ -71,6 +71,21 @@ func (c *injectorHistoryManager) DeleteHistoryBranch(ctx context.Context, reques
 	return
 }
 
+func (c *injectorHistoryManager) DeleteOrphanedHistoryPayloads(ctx context.Context, request *_sourcePersistence.DeleteOrphanedHistoryPayloadsRequest) (rp1 *_sourcePersistence.DeleteOrphanedHistoryPayloadsResponse, err error) {
+	fakeErr := generateFakeError(c.errorRate, c.starttime)
+	var forwardCall bool
+	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+		rp1, err = c.wrapped.DeleteOrphanedHistoryPayloads(ctx, request)
+	}
+
+	if fakeErr != nil {
+		logErr(c.logger, "HistoryManager.DeleteOrphanedHistoryPayloads", fakeErr, forwardCall, err)
+		err = fakeErr
+		return
+	}
+	return
+}
+
 func (c *injectorHistoryManager) ForkHistoryBranch(ctx context.Context, request *_sourcePersistence.ForkHistoryBranchRequest) (fp1 *_sourcePersistence.ForkHistoryBranchResponse, err error) {
 	fakeErr := generateFakeError(c.errorRate, c.starttime)
 	var forwardCall bool
@@ -164,3 +179,18 @@ func (c *injectorHistoryManager) ReadRawHistoryBranch(ctx context.Context, reque
 	}
 	return
 }
+
+func (c *injectorHistoryManager) ReencodeHistoryBranch(ctx context.Context, request *_sourcePersistence.ReencodeHistoryBranchRequest) (rp1 *_sourcePersistence.ReencodeHistoryBranchResponse, err error) {
+	fakeErr := generateFakeError(c.errorRate, c.starttime)
+	var forwardCall bool
+	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+		rp1, err = c.wrapped.ReencodeHistoryBranch(ctx, request)
+	}
+
+	if fakeErr != nil {
+		logErr(c.logger, "HistoryManager.ReencodeHistoryBranch", fakeErr, forwardCall, err)
+		err = fakeErr
+		return
+	}
+	return
+}
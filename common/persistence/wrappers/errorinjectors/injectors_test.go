@@ -210,6 +210,7 @@ func builderForPassThrough(t *testing.T, injector any, errorRate float64, logger
 			mocked.EXPECT().DeleteHistoryBranch(gomock.Any(), gomock.Any()).Return(expectedErr)
 			mocked.EXPECT().GetHistoryTree(gomock.Any(), gomock.Any()).Return(&persistence.GetHistoryTreeResponse{}, expectedErr)
 			mocked.EXPECT().GetAllHistoryTreeBranches(gomock.Any(), gomock.Any()).Return(&persistence.GetAllHistoryTreeBranchesResponse{}, expectedErr)
+			mocked.EXPECT().ReencodeHistoryBranch(gomock.Any(), gomock.Any()).Return(&persistence.ReencodeHistoryBranchResponse{}, expectedErr)
 		}
 	case *injectorQueueManager:
 		mocked := persistence.NewMockQueueManager(ctrl)
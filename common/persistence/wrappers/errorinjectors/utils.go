@@ -184,6 +184,10 @@ func historyManagerTags(op string) *tag.Tag {
 		return &tag.StoreOperationReadRawHistoryBranch
 	case "HistoryManager.GetAllHistoryTreeBranches":
 		return &tag.StoreOperationGetAllHistoryTreeBranches
+	case "HistoryManager.ReencodeHistoryBranch":
+		return &tag.StoreOperationReencodeHistoryBranch
+	case "HistoryManager.DeleteOrphanedHistoryPayloads":
+		return &tag.StoreOperationDeleteOrphanedHistoryPayloads
 	}
 	return nil
 }
@@ -36,6 +36,21 @@ func NewVisibilityManager(
 	}
 }
 
+func (c *injectorVisibilityManager) AggregateWorkflowExecutions(ctx context.Context, request *_sourcePersistence.AggregateWorkflowExecutionsRequest) (ap1 *_sourcePersistence.AggregateWorkflowExecutionsResponse, err error) {
+	fakeErr := generateFakeError(c.errorRate, c.starttime)
+	var forwardCall bool
+	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+		ap1, err = c.wrapped.AggregateWorkflowExecutions(ctx, request)
+	}
+
+	if fakeErr != nil {
+		logErr(c.logger, "VisibilityManager.AggregateWorkflowExecutions", fakeErr, forwardCall, err)
+		err = fakeErr
+		return
+	}
+	return
+}
+
 func (c *injectorVisibilityManager) Close() {
 	c.wrapped.Close()
 	return
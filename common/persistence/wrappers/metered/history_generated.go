@@ -69,6 +69,19 @@ func (c *meteredHistoryManager) DeleteHistoryBranch(ctx context.Context, request
 	return
 }
 
+func (c *meteredHistoryManager) DeleteOrphanedHistoryPayloads(ctx context.Context, request *_sourcePersistence.DeleteOrphanedHistoryPayloadsRequest) (rp1 *_sourcePersistence.DeleteOrphanedHistoryPayloadsResponse, err error) {
+	op := func() error {
+		rp1, err = c.wrapped.DeleteOrphanedHistoryPayloads(ctx, request)
+		c.emptyMetric("HistoryManager.DeleteOrphanedHistoryPayloads", request, rp1, err)
+		return err
+	}
+
+	retryCount := getRetryCountFromContext(ctx)
+
+	err = c.call(metrics.PersistenceDeleteOrphanedHistoryPayloadsScope, op, append(getCustomMetricTags(request), metrics.IsRetryTag(retryCount > 0))...)
+	return
+}
+
 func (c *meteredHistoryManager) ForkHistoryBranch(ctx context.Context, request *_sourcePersistence.ForkHistoryBranchRequest) (fp1 *_sourcePersistence.ForkHistoryBranchResponse, err error) {
 	op := func() error {
 		fp1, err = c.wrapped.ForkHistoryBranch(ctx, request)
@@ -150,3 +163,16 @@ func (c *meteredHistoryManager) ReadRawHistoryBranch(ctx context.Context, reques
 	err = c.call(metrics.PersistenceReadRawHistoryBranchScope, op, append(getCustomMetricTags(request), metrics.IsRetryTag(retryCount > 0))...)
 	return
 }
+
+func (c *meteredHistoryManager) ReencodeHistoryBranch(ctx context.Context, request *_sourcePersistence.ReencodeHistoryBranchRequest) (rp1 *_sourcePersistence.ReencodeHistoryBranchResponse, err error) {
+	op := func() error {
+		rp1, err = c.wrapped.ReencodeHistoryBranch(ctx, request)
+		c.emptyMetric("HistoryManager.ReencodeHistoryBranch", request, rp1, err)
+		return err
+	}
+
+	retryCount := getRetryCountFromContext(ctx)
+
+	err = c.call(metrics.PersistenceReencodeHistoryBranchScope, op, append(getCustomMetricTags(request), metrics.IsRetryTag(retryCount > 0))...)
+	return
+}
@@ -38,6 +38,19 @@ func NewVisibilityManager(
 	}
 }
 
+func (c *meteredVisibilityManager) AggregateWorkflowExecutions(ctx context.Context, request *_sourcePersistence.AggregateWorkflowExecutionsRequest) (ap1 *_sourcePersistence.AggregateWorkflowExecutionsResponse, err error) {
+	op := func() error {
+		ap1, err = c.wrapped.AggregateWorkflowExecutions(ctx, request)
+		c.emptyMetric("VisibilityManager.AggregateWorkflowExecutions", request, ap1, err)
+		return err
+	}
+
+	retryCount := getRetryCountFromContext(ctx)
+
+	err = c.call(metrics.PersistenceAggregateWorkflowExecutionsScope, op, append(getCustomMetricTags(request), metrics.IsRetryTag(retryCount > 0))...)
+	return
+}
+
 func (c *meteredVisibilityManager) Close() {
 	c.wrapped.Close()
 	return
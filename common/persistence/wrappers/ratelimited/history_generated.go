@@ -53,6 +53,14 @@ func (c *ratelimitedHistoryManager) DeleteHistoryBranch(ctx context.Context, req
 	return c.wrapped.DeleteHistoryBranch(ctx, request)
 }
 
+func (c *ratelimitedHistoryManager) DeleteOrphanedHistoryPayloads(ctx context.Context, request *_sourcePersistence.DeleteOrphanedHistoryPayloadsRequest) (rp1 *_sourcePersistence.DeleteOrphanedHistoryPayloadsResponse, err error) {
+	if !c.callerBypass.AllowLimiter(ctx, c.rateLimiter) {
+		err = ErrPersistenceLimitExceeded
+		return
+	}
+	return c.wrapped.DeleteOrphanedHistoryPayloads(ctx, request)
+}
+
 func (c *ratelimitedHistoryManager) ForkHistoryBranch(ctx context.Context, request *_sourcePersistence.ForkHistoryBranchRequest) (fp1 *_sourcePersistence.ForkHistoryBranchResponse, err error) {
 	if !c.callerBypass.AllowLimiter(ctx, c.rateLimiter) {
 		err = ErrPersistenceLimitExceeded
@@ -104,3 +112,11 @@ func (c *ratelimitedHistoryManager) ReadRawHistoryBranch(ctx context.Context, re
 	}
 	return c.wrapped.ReadRawHistoryBranch(ctx, request)
 }
+
+func (c *ratelimitedHistoryManager) ReencodeHistoryBranch(ctx context.Context, request *_sourcePersistence.ReencodeHistoryBranchRequest) (rp1 *_sourcePersistence.ReencodeHistoryBranchResponse, err error) {
+	if !c.callerBypass.AllowLimiter(ctx, c.rateLimiter) {
+		err = ErrPersistenceLimitExceeded
+		return
+	}
+	return c.wrapped.ReencodeHistoryBranch(ctx, request)
+}
@@ -32,6 +32,14 @@ func NewVisibilityManager(
 	}
 }
 
+func (c *ratelimitedVisibilityManager) AggregateWorkflowExecutions(ctx context.Context, request *_sourcePersistence.AggregateWorkflowExecutionsRequest) (ap1 *_sourcePersistence.AggregateWorkflowExecutionsResponse, err error) {
+	if !c.callerBypass.AllowLimiter(ctx, c.rateLimiter) {
+		err = ErrPersistenceLimitExceeded
+		return
+	}
+	return c.wrapped.AggregateWorkflowExecutions(ctx, request)
+}
+
 func (c *ratelimitedVisibilityManager) Close() {
 	c.wrapped.Close()
 	return
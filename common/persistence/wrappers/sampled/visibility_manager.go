@@ -281,6 +281,13 @@ func (p *visibilityManager) CountWorkflowExecutions(
 	return p.persistence.CountWorkflowExecutions(ctx, request)
 }
 
+func (p *visibilityManager) AggregateWorkflowExecutions(
+	ctx context.Context,
+	request *persistence.AggregateWorkflowExecutionsRequest,
+) (*persistence.AggregateWorkflowExecutionsResponse, error) {
+	return p.persistence.AggregateWorkflowExecutions(ctx, request)
+}
+
 func (p *visibilityManager) Close() {
 	p.persistence.Close()
 }
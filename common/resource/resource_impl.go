@@ -197,6 +197,7 @@ func New(
 
 	params.PersistenceConfig.HostName = hostname
 
+	persistenceDynamicConfig := persistence.NewDynamicConfiguration(dynamicCollection)
 	persistenceFactory := persistenceClient.NewFactory(
 		&params.PersistenceConfig,
 		func() float64 {
@@ -210,7 +211,8 @@ func New(
 		params.ClusterMetadata.GetCurrentClusterName(),
 		params.MetricsClient,
 		logger,
-		persistence.NewDynamicConfiguration(dynamicCollection),
+		persistenceDynamicConfig,
+		params.BlobstoreClient,
 	)
 	persistenceBean, err := newPersistenceBeanFn(persistenceFactory, &persistenceClient.Params{
 		PersistenceConfig: params.PersistenceConfig,
@@ -296,6 +298,10 @@ func New(
 		serviceConfig.IsErrorRetryableFunction,
 	)
 
+	payloadCodec, err := persistenceClient.NewPayloadCodec(&params.PersistenceConfig, persistenceDynamicConfig)
+	if err != nil {
+		return nil, err
+	}
 	historyArchiverBootstrapContainer := &archiver.HistoryBootstrapContainer{
 		HistoryV2Manager:  persistenceBean.GetHistoryManager(),
 		Logger:            logger,
@@ -303,6 +309,7 @@ func New(
 		ClusterMetadata:   params.ClusterMetadata,
 		DomainCache:       domainCache,
 		DynamicCollection: dynamicCollection,
+		PayloadCodec:      payloadCodec,
 	}
 	visibilityArchiverBootstrapContainer := &archiver.VisibilityBootstrapContainer{
 		Logger:            logger,
@@ -639,6 +646,11 @@ func (h *Impl) GetDomainAuditManager() persistence.DomainAuditManager {
 	return h.persistenceBean.GetDomainAuditManager()
 }
 
+// GetSemaphoreMetadataManager return semaphore metadata manager
+func (h *Impl) GetSemaphoreMetadataManager() persistence.SemaphoreMetadataManager {
+	return h.persistenceBean.GetSemaphoreMetadataManager()
+}
+
 // GetTaskManager return task manager
 func (h *Impl) GetTaskManager() persistence.TaskManager {
 	return h.persistenceBean.GetTaskManager()
@@ -101,6 +101,7 @@ type (
 
 		MetadataMgr     *mocks.MetadataManager
 		DomainAuditMgr  *persistence.MockDomainAuditManager
+		SemaphoreMgr    *persistence.MockSemaphoreMetadataManager
 		TaskMgr         *mocks.TaskManager
 		VisibilityMgr   *mocks.VisibilityManager
 		ShardMgr        *mocks.ShardManager
@@ -156,6 +157,7 @@ func NewTest(
 
 	metadataMgr := &mocks.MetadataManager{}
 	domainAuditMgr := persistence.NewMockDomainAuditManager(controller)
+	semaphoreMgr := persistence.NewMockSemaphoreMetadataManager(controller)
 	taskMgr := &mocks.TaskManager{}
 	visibilityMgr := &mocks.VisibilityManager{}
 	shardMgr := &mocks.ShardManager{}
@@ -167,6 +169,7 @@ func NewTest(
 	persistenceBean := persistenceClient.NewMockBean(controller)
 	persistenceBean.EXPECT().GetDomainManager().Return(metadataMgr).AnyTimes()
 	persistenceBean.EXPECT().GetDomainAuditManager().Return(domainAuditMgr).AnyTimes()
+	persistenceBean.EXPECT().GetSemaphoreMetadataManager().Return(semaphoreMgr).AnyTimes()
 	persistenceBean.EXPECT().GetTaskManager().Return(taskMgr).AnyTimes()
 	persistenceBean.EXPECT().GetVisibilityManager().Return(visibilityMgr).AnyTimes()
 	persistenceBean.EXPECT().GetHistoryManager().Return(historyMgr).AnyTimes()
@@ -225,6 +228,7 @@ func NewTest(
 
 		MetadataMgr:       metadataMgr,
 		DomainAuditMgr:    domainAuditMgr,
+		SemaphoreMgr:      semaphoreMgr,
 		TaskMgr:           taskMgr,
 		VisibilityMgr:     visibilityMgr,
 		ShardMgr:          shardMgr,
@@ -421,6 +425,11 @@ func (s *Test) GetDomainAuditManager() persistence.DomainAuditManager {
 	return s.DomainAuditMgr
 }
 
+// GetSemaphoreMetadataManager for testing
+func (s *Test) GetSemaphoreMetadataManager() persistence.SemaphoreMetadataManager {
+	return s.SemaphoreMgr
+}
+
 // GetTaskManager for testing
 func (s *Test) GetTaskManager() persistence.TaskManager {
 	return s.TaskMgr
@@ -0,0 +1,110 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package semaphore
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"go.uber.org/cadence/workflow"
+)
+
+// Lease is a grant of permits of a semaphore to the calling workflow run.
+type Lease struct {
+	SemaphoreName string
+	RequestID     string
+	Permits       int
+}
+
+// Acquire requests permits of a semaphore of the calling workflow's domain and
+// blocks until they are granted, or ctx is canceled. It is meant to be called
+// from workflow code and is replay safe.
+//
+// The permits are held until Release is called or the calling run closes,
+// including by ContinueAsNew: the semaphore workflow then reclaims them. The
+// grant is delivered on a signal named after the request, so a workflow can
+// wait on the same semaphore from several coroutines at once.
+func Acquire(ctx workflow.Context, semaphoreName string, permits int) (*Lease, error) {
+	if permits <= 0 {
+		permits = 1
+	}
+
+	var requestID string
+	err := workflow.SideEffect(ctx, func(workflow.Context) interface{} {
+		return uuid.New().String()
+	}).Get(&requestID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate request ID: %w", err)
+	}
+
+	info := workflow.GetInfo(ctx)
+	sig := AcquireSignal{
+		RequestID:  requestID,
+		WorkflowID: info.WorkflowExecution.ID,
+		RunID:      info.WorkflowExecution.RunID,
+		Permits:    permits,
+	}
+	if err := workflow.SignalExternalWorkflow(ctx, WorkflowID(semaphoreName), "", SignalNameAcquire, sig).Get(ctx, nil); err != nil {
+		return nil, fmt.Errorf("failed to request permits of semaphore %q: %w", semaphoreName, err)
+	}
+
+	var granted GrantedSignal
+	canceled := false
+	selector := workflow.NewSelector(ctx)
+	selector.AddReceive(workflow.GetSignalChannel(ctx, GrantedSignalName(requestID)), func(c workflow.Channel, more bool) {
+		c.Receive(ctx, &granted)
+	})
+	selector.AddReceive(ctx.Done(), func(c workflow.Channel, more bool) {
+		canceled = true
+	})
+	selector.Select(ctx)
+
+	lease := &Lease{SemaphoreName: semaphoreName, RequestID: requestID, Permits: permits}
+	if canceled {
+		// Withdraw the request, or return the permits if the grant raced
+		// with the cancellation.
+		releaseCtx, cancel := workflow.NewDisconnectedContext(ctx)
+		defer cancel()
+		_ = Release(releaseCtx, lease)
+		return nil, ctx.Err()
+	}
+	if granted.Error != "" {
+		return nil, errors.New(granted.Error)
+	}
+	return lease, nil
+}
+
+// Release returns the permits of a lease to its semaphore. Releasing a lease
+// more than once is a no-op.
+func Release(ctx workflow.Context, lease *Lease) error {
+	err := workflow.SignalExternalWorkflow(
+		ctx,
+		WorkflowID(lease.SemaphoreName),
+		"",
+		SignalNameRelease,
+		ReleaseSignal{RequestID: lease.RequestID},
+	).Get(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to release permits of semaphore %q: %w", lease.SemaphoreName, err)
+	}
+	return nil
+}
@@ -0,0 +1,100 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package semaphore lets workflows acquire and release permits of the domain
+// scoped semaphores created with the CreateSemaphore API.
+//
+// Acquire and Release implement the client side for Go workflows. Workflows
+// written with other clients implement the same signal protocol:
+//
+//   - Every semaphore is managed by a workflow of its domain, whose ID is
+//     returned by WorkflowID. Signals are sent to it with an empty run ID.
+//   - To request permits, a workflow sends an AcquireSignal on
+//     SignalNameAcquire. RequestID identifies the request and must be unique,
+//     WorkflowID and RunID identify the requesting run.
+//   - Once the permits are granted, the semaphore workflow sends a
+//     GrantedSignal to that run, on the signal GrantedSignalName returns for
+//     the RequestID. Error is set instead when the request can never be
+//     granted, e.g. it asks for more permits than the semaphore has. Every
+//     request has its own signal, so a run can wait on several requests at once.
+//   - Sending an AcquireSignal again with the same RequestID is a no-op while
+//     the request waits, and sends the GrantedSignal again once it holds its
+//     permits.
+//   - To return the permits, or to withdraw a request which is still waiting,
+//     the workflow sends a ReleaseSignal on SignalNameRelease. Releasing a
+//     request more than once is a no-op.
+//   - Requests are granted first come first served.
+//   - Permits are bound to the requesting run. If it closes without releasing
+//     them, including by ContinueAsNew, the semaphore workflow reclaims them at
+//     its next check, which runs every minute. A run which continues as new
+//     must acquire again.
+//
+// Signal payloads are JSON encoded, as done by the default data converter of
+// the Go client.
+package semaphore
+
+const (
+	// SignalNameAcquire carries an AcquireSignal from a workflow asking for permits.
+	SignalNameAcquire = "cadence-semaphore-acquire"
+	// SignalNameRelease carries a ReleaseSignal from a workflow returning its permits,
+	// or withdrawing a request that has not been granted yet.
+	SignalNameRelease = "cadence-semaphore-release"
+
+	workflowIDPrefix        = "cadence-semaphore:"
+	grantedSignalNamePrefix = "cadence-semaphore-granted:"
+)
+
+type (
+	// AcquireSignal is sent to the semaphore workflow to request permits. Sending
+	// the same RequestID again is a no-op while the request waits, and re-sends
+	// the grant once it holds the permits.
+	AcquireSignal struct {
+		RequestID  string `json:"requestID,omitempty"`
+		WorkflowID string `json:"workflowID,omitempty"`
+		RunID      string `json:"runID,omitempty"`
+		Permits    int    `json:"permits,omitempty"`
+	}
+
+	// ReleaseSignal is sent to the semaphore workflow to release the permits of a
+	// request.
+	ReleaseSignal struct {
+		RequestID string `json:"requestID,omitempty"`
+	}
+
+	// GrantedSignal is sent by the semaphore workflow to the requesting workflow,
+	// on the signal GrantedSignalName returns for the request, once its permits are
+	// granted or the request is rejected.
+	GrantedSignal struct {
+		RequestID string `json:"requestID,omitempty"`
+		// Error is set when the request can never be granted.
+		Error string `json:"error,omitempty"`
+	}
+)
+
+// WorkflowID returns the ID of the workflow managing the given semaphore.
+func WorkflowID(semaphoreName string) string {
+	return workflowIDPrefix + semaphoreName
+}
+
+// GrantedSignalName returns the name of the signal the semaphore workflow sends
+// to a workflow once the permits of the given request are granted.
+func GrantedSignalName(requestID string) string {
+	return grantedSignalNamePrefix + requestID
+}
@@ -0,0 +1,73 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package taskpriority
+
+import (
+	"hash/fnv"
+)
+
+const (
+	// FairnessKeyPartitionConfigKey is the partition config key carrying a task's fairness key
+	FairnessKeyPartitionConfigKey = "task-fairness-key"
+
+	// MaxFairnessKeyLength is the maximum length of a fairness key
+	MaxFairnessKeyLength = 128
+
+	// FairnessBuckets is the number of backlogs the persisted tasks of a priority level are spread
+	// over by fairness key, so that the backlog of a key only holds back the keys of its bucket
+	FairnessBuckets = 4
+)
+
+// IsValidFairnessKey returns true if the key is either empty or not longer than MaxFairnessKeyLength
+func IsValidFairnessKey(key string) bool {
+	return len(key) <= MaxFairnessKeyLength
+}
+
+// FairnessKeyFromPartitionConfig returns the fairness key recorded in the partition config,
+// an empty key means the task does not belong to any tenant in particular
+func FairnessKeyFromPartitionConfig(partitionConfig map[string]string) string {
+	return partitionConfig[FairnessKeyPartitionConfigKey]
+}
+
+// WithFairnessKey returns a copy of the partition config with the fairness key set.
+// The input is returned unchanged if the key is empty or invalid.
+func WithFairnessKey(partitionConfig map[string]string, key string) map[string]string {
+	if key == "" || !IsValidFairnessKey(key) {
+		return partitionConfig
+	}
+	result := make(map[string]string, len(partitionConfig)+1)
+	for k, v := range partitionConfig {
+		result[k] = v
+	}
+	result[FairnessKeyPartitionConfigKey] = key
+	return result
+}
+
+// FairnessBucket returns the backlog bucket the tasks of the fairness key are persisted in,
+// tasks without a key are persisted in the first bucket
+func FairnessBucket(key string) int32 {
+	if key == "" {
+		return 0
+	}
+	h := fnv.New32a()
+	h.Write([]byte(key))
+	return int32(h.Sum32() % FairnessBuckets)
+}
@@ -0,0 +1,77 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package taskpriority
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsValidFairnessKey(t *testing.T) {
+	tests := map[string]struct {
+		key      string
+		expected bool
+	}{
+		"empty":       {key: "", expected: true},
+		"regular key": {key: "tenant-a", expected: true},
+		"max length":  {key: strings.Repeat("a", MaxFairnessKeyLength), expected: true},
+		"too long":    {key: strings.Repeat("a", MaxFairnessKeyLength+1), expected: false},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, IsValidFairnessKey(tc.key))
+		})
+	}
+}
+
+func TestFairnessKeyFromPartitionConfig(t *testing.T) {
+	assert.Equal(t, "", FairnessKeyFromPartitionConfig(nil))
+	assert.Equal(t, "", FairnessKeyFromPartitionConfig(map[string]string{"isolation-group": "zone-a"}))
+	assert.Equal(t, "tenant-a", FairnessKeyFromPartitionConfig(map[string]string{FairnessKeyPartitionConfigKey: "tenant-a"}))
+}
+
+func TestWithFairnessKey(t *testing.T) {
+	original := map[string]string{PartitionConfigKey: "1"}
+
+	result := WithFairnessKey(original, "tenant-a")
+	assert.Equal(t, map[string]string{PartitionConfigKey: "1", FairnessKeyPartitionConfigKey: "tenant-a"}, result)
+	assert.Equal(t, map[string]string{PartitionConfigKey: "1"}, original, "input must not be mutated")
+
+	assert.Equal(t, original, WithFairnessKey(original, ""))
+	assert.Equal(t, original, WithFairnessKey(original, strings.Repeat("a", MaxFairnessKeyLength+1)))
+	assert.Equal(t, map[string]string{FairnessKeyPartitionConfigKey: "tenant-b"}, WithFairnessKey(nil, "tenant-b"))
+}
+
+func TestFairnessBucket(t *testing.T) {
+	assert.Equal(t, int32(0), FairnessBucket(""))
+	seen := make(map[int32]bool)
+	for i := 0; i < 100; i++ {
+		key := "tenant-" + strconv.Itoa(i)
+		bucket := FairnessBucket(key)
+		assert.Equal(t, bucket, FairnessBucket(key), "the bucket of a key must be stable")
+		assert.True(t, bucket >= 0 && bucket < FairnessBuckets)
+		seen[bucket] = true
+	}
+	assert.Len(t, seen, FairnessBuckets)
+}
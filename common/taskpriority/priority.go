@@ -0,0 +1,101 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package taskpriority defines the priority levels and fairness keys that can
+// be attached to decision and activity tasks, and how they are carried on a
+// task's partition config so they survive persistence and forwarding between
+// task list partitions.
+package taskpriority
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/uber/cadence/common/constants"
+)
+
+const (
+	// PartitionConfigKey is the partition config key carrying a task's priority
+	PartitionConfigKey = "task-priority"
+
+	// Unset means no priority was requested and the default applies
+	Unset int32 = 0
+	// Highest is the most urgent priority level
+	Highest int32 = 1
+	// Default is the priority level given to tasks without an explicit priority
+	Default int32 = 3
+	// Lowest is the least urgent priority level
+	Lowest int32 = 5
+)
+
+// IsValid returns true if p is either Unset or within [Highest, Lowest]
+func IsValid(p int32) bool {
+	return p == Unset || (p >= Highest && p <= Lowest)
+}
+
+// Levels returns all priority levels ordered from highest to lowest
+func Levels() []int32 {
+	levels := make([]int32, 0, Lowest-Highest+1)
+	for p := Highest; p <= Lowest; p++ {
+		levels = append(levels, p)
+	}
+	return levels
+}
+
+// FromPartitionConfig returns the priority recorded in the partition config,
+// falling back to Default if it is missing or malformed
+func FromPartitionConfig(partitionConfig map[string]string) int32 {
+	v, ok := partitionConfig[PartitionConfigKey]
+	if !ok {
+		return Default
+	}
+	p, err := strconv.ParseInt(v, 10, 32)
+	if err != nil || p == int64(Unset) || !IsValid(int32(p)) {
+		return Default
+	}
+	return int32(p)
+}
+
+// BacklogTaskListName returns the name of the task list persisting the backlog of a priority level
+// and fairness bucket of the given task list, so that the backlog of a level is read independently
+// of the others and the backlog of a fairness key only holds back the keys of its bucket.
+// The first bucket of the default level is persisted in the task list itself. The name is reserved
+// and, unlike the name of a partition, does not end with a number, so it never parses as a partition.
+func BacklogTaskListName(taskListName string, p int32, bucket int32) string {
+	if p == Default && bucket == 0 {
+		return taskListName
+	}
+	return fmt.Sprintf("%v%v/__backlog_p%d_f%d", constants.ReservedTaskListPrefix, strings.TrimPrefix(taskListName, constants.ReservedTaskListPrefix), p, bucket)
+}
+
+// WithPriority returns a copy of the partition config with the priority set.
+// The input is returned unchanged if p is Unset or invalid.
+func WithPriority(partitionConfig map[string]string, p int32) map[string]string {
+	if p == Unset || !IsValid(p) {
+		return partitionConfig
+	}
+	result := make(map[string]string, len(partitionConfig)+1)
+	for k, v := range partitionConfig {
+		result[k] = v
+	}
+	result[PartitionConfigKey] = strconv.Itoa(int(p))
+	return result
+}
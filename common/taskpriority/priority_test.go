@@ -0,0 +1,88 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package taskpriority
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsValid(t *testing.T) {
+	tests := map[string]struct {
+		priority int32
+		expected bool
+	}{
+		"unset":        {priority: Unset, expected: true},
+		"highest":      {priority: Highest, expected: true},
+		"default":      {priority: Default, expected: true},
+		"lowest":       {priority: Lowest, expected: true},
+		"negative":     {priority: -1, expected: false},
+		"above lowest": {priority: Lowest + 1, expected: false},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, IsValid(tc.priority))
+		})
+	}
+}
+
+func TestLevels(t *testing.T) {
+	assert.Equal(t, []int32{1, 2, 3, 4, 5}, Levels())
+}
+
+func TestFromPartitionConfig(t *testing.T) {
+	tests := map[string]struct {
+		partitionConfig map[string]string
+		expected        int32
+	}{
+		"nil config":     {partitionConfig: nil, expected: Default},
+		"missing key":    {partitionConfig: map[string]string{"isolation-group": "zone-a"}, expected: Default},
+		"valid priority": {partitionConfig: map[string]string{PartitionConfigKey: "1"}, expected: Highest},
+		"unset priority": {partitionConfig: map[string]string{PartitionConfigKey: "0"}, expected: Default},
+		"out of range":   {partitionConfig: map[string]string{PartitionConfigKey: "9"}, expected: Default},
+		"not a number":   {partitionConfig: map[string]string{PartitionConfigKey: "high"}, expected: Default},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, FromPartitionConfig(tc.partitionConfig))
+		})
+	}
+}
+
+func TestWithPriority(t *testing.T) {
+	original := map[string]string{"isolation-group": "zone-a"}
+
+	result := WithPriority(original, Highest)
+	assert.Equal(t, map[string]string{"isolation-group": "zone-a", PartitionConfigKey: "1"}, result)
+	assert.Equal(t, map[string]string{"isolation-group": "zone-a"}, original, "input must not be mutated")
+
+	assert.Equal(t, original, WithPriority(original, Unset))
+	assert.Equal(t, original, WithPriority(original, Lowest+1))
+	assert.Equal(t, map[string]string{PartitionConfigKey: "5"}, WithPriority(nil, Lowest))
+}
+
+func TestBacklogTaskListName(t *testing.T) {
+	assert.Equal(t, "orders", BacklogTaskListName("orders", Default, 0))
+	assert.Equal(t, "/__cadence_sys/orders/__backlog_p1_f0", BacklogTaskListName("orders", Highest, 0))
+	assert.Equal(t, "/__cadence_sys/orders/__backlog_p3_f2", BacklogTaskListName("orders", Default, 2))
+	assert.Equal(t, "/__cadence_sys/orders/2/__backlog_p5_f3", BacklogTaskListName("/__cadence_sys/orders/2", Lowest, 3))
+}
@@ -0,0 +1,245 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package types
+
+// PauseActivityRequest is the request to stop retrying a pending activity until it is unpaused.
+type PauseActivityRequest struct {
+	Domain            string             `json:"domain,omitempty"`
+	WorkflowExecution *WorkflowExecution `json:"workflowExecution,omitempty"`
+	ActivityID        string             `json:"activityId,omitempty"`
+	Identity          string             `json:"identity,omitempty"`
+}
+
+func (v *PauseActivityRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+func (v *PauseActivityRequest) GetWorkflowExecution() (o *WorkflowExecution) {
+	if v != nil && v.WorkflowExecution != nil {
+		return v.WorkflowExecution
+	}
+	return
+}
+
+func (v *PauseActivityRequest) GetActivityID() (o string) {
+	if v != nil {
+		return v.ActivityID
+	}
+	return
+}
+
+func (v *PauseActivityRequest) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
+	}
+	return
+}
+
+// UnpauseActivityRequest is the request to resume scheduling a paused pending activity.
+type UnpauseActivityRequest struct {
+	Domain            string             `json:"domain,omitempty"`
+	WorkflowExecution *WorkflowExecution `json:"workflowExecution,omitempty"`
+	ActivityID        string             `json:"activityId,omitempty"`
+	Identity          string             `json:"identity,omitempty"`
+}
+
+func (v *UnpauseActivityRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+func (v *UnpauseActivityRequest) GetWorkflowExecution() (o *WorkflowExecution) {
+	if v != nil && v.WorkflowExecution != nil {
+		return v.WorkflowExecution
+	}
+	return
+}
+
+func (v *UnpauseActivityRequest) GetActivityID() (o string) {
+	if v != nil {
+		return v.ActivityID
+	}
+	return
+}
+
+func (v *UnpauseActivityRequest) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
+	}
+	return
+}
+
+// ResetActivityAttemptRequest is the request to reset the attempt count of a pending activity to zero.
+type ResetActivityAttemptRequest struct {
+	Domain            string             `json:"domain,omitempty"`
+	WorkflowExecution *WorkflowExecution `json:"workflowExecution,omitempty"`
+	ActivityID        string             `json:"activityId,omitempty"`
+	Identity          string             `json:"identity,omitempty"`
+}
+
+func (v *ResetActivityAttemptRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+func (v *ResetActivityAttemptRequest) GetWorkflowExecution() (o *WorkflowExecution) {
+	if v != nil && v.WorkflowExecution != nil {
+		return v.WorkflowExecution
+	}
+	return
+}
+
+func (v *ResetActivityAttemptRequest) GetActivityID() (o string) {
+	if v != nil {
+		return v.ActivityID
+	}
+	return
+}
+
+func (v *ResetActivityAttemptRequest) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
+	}
+	return
+}
+
+// RetryActivityNowRequest is the request to schedule the next attempt of a pending activity immediately, skipping its retry backoff.
+type RetryActivityNowRequest struct {
+	Domain            string             `json:"domain,omitempty"`
+	WorkflowExecution *WorkflowExecution `json:"workflowExecution,omitempty"`
+	ActivityID        string             `json:"activityId,omitempty"`
+	Identity          string             `json:"identity,omitempty"`
+}
+
+func (v *RetryActivityNowRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+func (v *RetryActivityNowRequest) GetWorkflowExecution() (o *WorkflowExecution) {
+	if v != nil && v.WorkflowExecution != nil {
+		return v.WorkflowExecution
+	}
+	return
+}
+
+func (v *RetryActivityNowRequest) GetActivityID() (o string) {
+	if v != nil {
+		return v.ActivityID
+	}
+	return
+}
+
+func (v *RetryActivityNowRequest) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
+	}
+	return
+}
+
+// HistoryPauseActivityRequest is the request to pause a pending activity, sent to history.
+type HistoryPauseActivityRequest struct {
+	DomainUUID   string                `json:"domainUUID,omitempty"`
+	PauseRequest *PauseActivityRequest `json:"pauseRequest,omitempty"`
+}
+
+func (v *HistoryPauseActivityRequest) GetDomainUUID() (o string) {
+	if v != nil {
+		return v.DomainUUID
+	}
+	return
+}
+
+func (v *HistoryPauseActivityRequest) GetPauseRequest() (o *PauseActivityRequest) {
+	if v != nil && v.PauseRequest != nil {
+		return v.PauseRequest
+	}
+	return
+}
+
+// HistoryUnpauseActivityRequest is the request to unpause a pending activity, sent to history.
+type HistoryUnpauseActivityRequest struct {
+	DomainUUID     string                  `json:"domainUUID,omitempty"`
+	UnpauseRequest *UnpauseActivityRequest `json:"unpauseRequest,omitempty"`
+}
+
+func (v *HistoryUnpauseActivityRequest) GetDomainUUID() (o string) {
+	if v != nil {
+		return v.DomainUUID
+	}
+	return
+}
+
+func (v *HistoryUnpauseActivityRequest) GetUnpauseRequest() (o *UnpauseActivityRequest) {
+	if v != nil && v.UnpauseRequest != nil {
+		return v.UnpauseRequest
+	}
+	return
+}
+
+// HistoryResetActivityAttemptRequest is the request to reset the attempt count of a pending activity, sent to history.
+type HistoryResetActivityAttemptRequest struct {
+	DomainUUID   string                       `json:"domainUUID,omitempty"`
+	ResetRequest *ResetActivityAttemptRequest `json:"resetRequest,omitempty"`
+}
+
+func (v *HistoryResetActivityAttemptRequest) GetDomainUUID() (o string) {
+	if v != nil {
+		return v.DomainUUID
+	}
+	return
+}
+
+func (v *HistoryResetActivityAttemptRequest) GetResetRequest() (o *ResetActivityAttemptRequest) {
+	if v != nil && v.ResetRequest != nil {
+		return v.ResetRequest
+	}
+	return
+}
+
+// HistoryRetryActivityNowRequest is the request to retry a pending activity now, sent to history.
+type HistoryRetryActivityNowRequest struct {
+	DomainUUID   string                   `json:"domainUUID,omitempty"`
+	RetryRequest *RetryActivityNowRequest `json:"retryRequest,omitempty"`
+}
+
+func (v *HistoryRetryActivityNowRequest) GetDomainUUID() (o string) {
+	if v != nil {
+		return v.DomainUUID
+	}
+	return
+}
+
+func (v *HistoryRetryActivityNowRequest) GetRetryRequest() (o *RetryActivityNowRequest) {
+	if v != nil && v.RetryRequest != nil {
+		return v.RetryRequest
+	}
+	return
+}
@@ -0,0 +1,151 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPauseActivityRequest_Getters(t *testing.T) {
+	var nilRequest *PauseActivityRequest
+	assert.Equal(t, "", nilRequest.GetDomain())
+	assert.Nil(t, nilRequest.GetWorkflowExecution())
+	assert.Equal(t, "", nilRequest.GetActivityID())
+	assert.Equal(t, "", nilRequest.GetIdentity())
+
+	execution := &WorkflowExecution{WorkflowID: "wid", RunID: "rid"}
+	v := &PauseActivityRequest{
+		Domain:            "domain",
+		WorkflowExecution: execution,
+		ActivityID:        "activity-id",
+		Identity:          "identity",
+	}
+	assert.Equal(t, "domain", v.GetDomain())
+	assert.Equal(t, execution, v.GetWorkflowExecution())
+	assert.Equal(t, "activity-id", v.GetActivityID())
+	assert.Equal(t, "identity", v.GetIdentity())
+}
+
+func TestUnpauseActivityRequest_Getters(t *testing.T) {
+	var nilRequest *UnpauseActivityRequest
+	assert.Equal(t, "", nilRequest.GetDomain())
+	assert.Nil(t, nilRequest.GetWorkflowExecution())
+	assert.Equal(t, "", nilRequest.GetActivityID())
+	assert.Equal(t, "", nilRequest.GetIdentity())
+
+	execution := &WorkflowExecution{WorkflowID: "wid", RunID: "rid"}
+	v := &UnpauseActivityRequest{
+		Domain:            "domain",
+		WorkflowExecution: execution,
+		ActivityID:        "activity-id",
+		Identity:          "identity",
+	}
+	assert.Equal(t, "domain", v.GetDomain())
+	assert.Equal(t, execution, v.GetWorkflowExecution())
+	assert.Equal(t, "activity-id", v.GetActivityID())
+	assert.Equal(t, "identity", v.GetIdentity())
+}
+
+func TestResetActivityAttemptRequest_Getters(t *testing.T) {
+	var nilRequest *ResetActivityAttemptRequest
+	assert.Equal(t, "", nilRequest.GetDomain())
+	assert.Nil(t, nilRequest.GetWorkflowExecution())
+	assert.Equal(t, "", nilRequest.GetActivityID())
+	assert.Equal(t, "", nilRequest.GetIdentity())
+
+	execution := &WorkflowExecution{WorkflowID: "wid", RunID: "rid"}
+	v := &ResetActivityAttemptRequest{
+		Domain:            "domain",
+		WorkflowExecution: execution,
+		ActivityID:        "activity-id",
+		Identity:          "identity",
+	}
+	assert.Equal(t, "domain", v.GetDomain())
+	assert.Equal(t, execution, v.GetWorkflowExecution())
+	assert.Equal(t, "activity-id", v.GetActivityID())
+	assert.Equal(t, "identity", v.GetIdentity())
+}
+
+func TestRetryActivityNowRequest_Getters(t *testing.T) {
+	var nilRequest *RetryActivityNowRequest
+	assert.Equal(t, "", nilRequest.GetDomain())
+	assert.Nil(t, nilRequest.GetWorkflowExecution())
+	assert.Equal(t, "", nilRequest.GetActivityID())
+	assert.Equal(t, "", nilRequest.GetIdentity())
+
+	execution := &WorkflowExecution{WorkflowID: "wid", RunID: "rid"}
+	v := &RetryActivityNowRequest{
+		Domain:            "domain",
+		WorkflowExecution: execution,
+		ActivityID:        "activity-id",
+		Identity:          "identity",
+	}
+	assert.Equal(t, "domain", v.GetDomain())
+	assert.Equal(t, execution, v.GetWorkflowExecution())
+	assert.Equal(t, "activity-id", v.GetActivityID())
+	assert.Equal(t, "identity", v.GetIdentity())
+}
+
+func TestHistoryPauseActivityRequest_Getters(t *testing.T) {
+	var nilRequest *HistoryPauseActivityRequest
+	assert.Equal(t, "", nilRequest.GetDomainUUID())
+	assert.Nil(t, nilRequest.GetPauseRequest())
+
+	request := &PauseActivityRequest{Domain: "domain"}
+	v := &HistoryPauseActivityRequest{DomainUUID: "domain-id", PauseRequest: request}
+	assert.Equal(t, "domain-id", v.GetDomainUUID())
+	assert.Equal(t, request, v.GetPauseRequest())
+}
+
+func TestHistoryUnpauseActivityRequest_Getters(t *testing.T) {
+	var nilRequest *HistoryUnpauseActivityRequest
+	assert.Equal(t, "", nilRequest.GetDomainUUID())
+	assert.Nil(t, nilRequest.GetUnpauseRequest())
+
+	request := &UnpauseActivityRequest{Domain: "domain"}
+	v := &HistoryUnpauseActivityRequest{DomainUUID: "domain-id", UnpauseRequest: request}
+	assert.Equal(t, "domain-id", v.GetDomainUUID())
+	assert.Equal(t, request, v.GetUnpauseRequest())
+}
+
+func TestHistoryResetActivityAttemptRequest_Getters(t *testing.T) {
+	var nilRequest *HistoryResetActivityAttemptRequest
+	assert.Equal(t, "", nilRequest.GetDomainUUID())
+	assert.Nil(t, nilRequest.GetResetRequest())
+
+	request := &ResetActivityAttemptRequest{Domain: "domain"}
+	v := &HistoryResetActivityAttemptRequest{DomainUUID: "domain-id", ResetRequest: request}
+	assert.Equal(t, "domain-id", v.GetDomainUUID())
+	assert.Equal(t, request, v.GetResetRequest())
+}
+
+func TestHistoryRetryActivityNowRequest_Getters(t *testing.T) {
+	var nilRequest *HistoryRetryActivityNowRequest
+	assert.Equal(t, "", nilRequest.GetDomainUUID())
+	assert.Nil(t, nilRequest.GetRetryRequest())
+
+	request := &RetryActivityNowRequest{Domain: "domain"}
+	v := &HistoryRetryActivityNowRequest{DomainUUID: "domain-id", RetryRequest: request}
+	assert.Equal(t, "domain-id", v.GetDomainUUID())
+	assert.Equal(t, request, v.GetRetryRequest())
+}
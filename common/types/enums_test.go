@@ -30,7 +30,7 @@ import (
 
 func Test_EventTypeValues(t *testing.T) {
 	result := EventTypeValues()
-	require.Equal(t, 42, len(result))
+	require.Equal(t, 44, len(result))
 }
 
 func Test_DecisionTypeValues(t *testing.T) {
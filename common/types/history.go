@@ -1070,6 +1070,7 @@ type SyncActivityRequest struct {
 	LastFailureDetails []byte          `json:"lastFailureDetails,omitempty"`
 	LastFailureOptions *FailureOptions `json:"lastFailureOptions,omitempty"`
 	VersionHistory     *VersionHistory `json:"versionHistory,omitempty"`
+	Paused             bool            `json:"paused,omitempty"`
 }
 
 // GetDomainID is an internal getter (TBD...)
@@ -1200,6 +1201,14 @@ func (v *SyncActivityRequest) GetVersionHistory() (o *VersionHistory) {
 	return
 }
 
+// GetPaused is an internal getter (TBD...)
+func (v *SyncActivityRequest) GetPaused() (o bool) {
+	if v != nil {
+		return v.Paused
+	}
+	return
+}
+
 // SyncShardStatusRequest is an internal type (TBD...)
 type SyncShardStatusRequest struct {
 	SourceCluster string `json:"sourceCluster,omitempty"`
@@ -5668,13 +5668,19 @@ func ToDataBlobArray(t []*apiv1.DataBlob) []*types.DataBlob {
 	return v
 }
 
+// FromHistoryEventArray skips the workflow pause and unpause events,
+// they have no representation in the public API and are only returned to thrift callers
 func FromHistoryEventArray(t []*types.HistoryEvent) []*apiv1.HistoryEvent {
 	if t == nil {
 		return nil
 	}
-	v := make([]*apiv1.HistoryEvent, len(t))
+	v := make([]*apiv1.HistoryEvent, 0, len(t))
 	for i := range t {
-		v[i] = FromHistoryEvent(t[i])
+		switch t[i].GetEventType() {
+		case types.EventTypeWorkflowExecutionPaused, types.EventTypeWorkflowExecutionUnpaused:
+			continue
+		}
+		v = append(v, FromHistoryEvent(t[i]))
 	}
 	return v
 }
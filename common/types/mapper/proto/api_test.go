@@ -1073,6 +1073,19 @@ func TestHistoryEventArray(t *testing.T) {
 		assert.Equal(t, item, ToHistoryEventArray(FromHistoryEventArray(item)))
 	}
 }
+
+func TestHistoryEventArraySkipsPauseEvents(t *testing.T) {
+	item := []*types.HistoryEvent{
+		&testdata.HistoryEvent_WorkflowExecutionSignaled,
+		&testdata.HistoryEvent_WorkflowExecutionPaused,
+		&testdata.HistoryEvent_WorkflowExecutionUnpaused,
+		&testdata.HistoryEvent_UpsertWorkflowSearchAttributes,
+	}
+	assert.Equal(t, []*types.HistoryEvent{
+		&testdata.HistoryEvent_WorkflowExecutionSignaled,
+		&testdata.HistoryEvent_UpsertWorkflowSearchAttributes,
+	}, ToHistoryEventArray(FromHistoryEventArray(item)))
+}
 func TestTaskListPartitionMetadataArray(t *testing.T) {
 	for _, item := range [][]*types.TaskListPartitionMetadata{nil, {}, testdata.TaskListPartitionMetadataArray} {
 		assert.Equal(t, item, ToTaskListPartitionMetadataArray(FromTaskListPartitionMetadataArray(item)))
@@ -1723,8 +1736,9 @@ func TestPendingActivityInfoArrayFuzz(t *testing.T) {
 	// LastFailureReason and LastFailureDetails have asymmetric mapping:
 	// FromFailure only creates a Failure object if reason is non-nil, so details without reason are dropped
 	// Excluding both fields from comparison to handle this asymmetry
+	// [IDL gap] IsPaused is not part of the IDL yet
 	testutils.RunMapperFuzzTest(t, FromPendingActivityInfoArray, ToPendingActivityInfoArray,
-		testutils.WithExcludedFields("LastFailureReason", "LastFailureDetails", "LastFailureOptions"),
+		testutils.WithExcludedFields("LastFailureReason", "LastFailureDetails", "LastFailureOptions", "IsPaused"),
 	)
 }
 
@@ -1807,6 +1821,7 @@ func TestDescribeTaskListResponseFuzz(t *testing.T) {
 	// From and To are non-invertable operations, so we rely on testdata to verify the mapping is correct
 	testutils.RunMapperFuzzTest(t, FromDescribeTaskListResponse, ToDescribeTaskListResponse,
 		testutils.WithExcludedFields("ReadPartitions", "WritePartitions"),
+		withTaskDispatchIDLGapExcluded(),
 	)
 }
 
@@ -1816,6 +1831,7 @@ func TestStartWorkflowExecutionAsyncRequestFuzz(t *testing.T) {
 			WorkflowIDReusePolicyFuzzer,
 			CronOverlapPolicyFuzzer,
 		),
+		withTaskDispatchIDLGapExcluded(),
 	)
 }
 
@@ -2043,7 +2059,7 @@ func TestHistoryEventFuzz(t *testing.T) {
 				c.Fuzz(h)
 				// Ensure EventType is always non-nil (required by mapper switch statement)
 				if h.EventType == nil {
-					eventType := types.EventType(c.Intn(42)) // 0-41: All EventType values
+					eventType := types.EventType(c.Intn(42)) // 0-41: All EventType values defined in the public API
 					h.EventType = &eventType
 				}
 			},
@@ -2142,6 +2158,7 @@ func TestDecisionFuzz(t *testing.T) {
 			WorkflowIDReusePolicyFuzzer,
 			CronOverlapPolicyFuzzer,
 		),
+		withTaskDispatchIDLGapExcluded(),
 	)
 }
 
@@ -2179,7 +2196,7 @@ func TestPollForDecisionTaskResponseFuzz(t *testing.T) {
 				c.Fuzz(h)
 				// Ensure EventType is always non-nil (required by mapper switch statement)
 				if h.EventType == nil {
-					eventType := types.EventType(c.Intn(42)) // 0-41: All EventType values
+					eventType := types.EventType(c.Intn(42)) // 0-41: All EventType values defined in the public API
 					h.EventType = &eventType
 				}
 			},
@@ -2215,6 +2232,7 @@ func TestDecisionArrayFuzz(t *testing.T) {
 			WorkflowIDReusePolicyFuzzer,
 			CronOverlapPolicyFuzzer,
 		),
+		withTaskDispatchIDLGapExcluded(),
 	)
 }
 
@@ -2375,6 +2393,7 @@ func TestSignalWithStartWorkflowExecutionRequestFuzz(t *testing.T) {
 			WorkflowIDReusePolicyFuzzer,
 			CronOverlapPolicyFuzzer,
 		),
+		withTaskDispatchIDLGapExcluded(),
 	)
 }
 
@@ -2467,7 +2486,9 @@ func TestStartChildWorkflowExecutionFailedEventAttributesFuzz(t *testing.T) {
 }
 
 func TestTaskListStatusFuzz(t *testing.T) {
-	testutils.RunMapperFuzzTest(t, FromTaskListStatus, ToTaskListStatus)
+	testutils.RunMapperFuzzTest(t, FromTaskListStatus, ToTaskListStatus,
+		withTaskDispatchIDLGapExcluded(),
+	)
 }
 
 func TestTaskListPartitionMetadataArrayFuzz(t *testing.T) {
@@ -2551,7 +2572,9 @@ func TestScanWorkflowExecutionsResponseFuzz(t *testing.T) {
 }
 
 func TestScheduleActivityTaskDecisionAttributesFuzz(t *testing.T) {
-	testutils.RunMapperFuzzTest(t, FromScheduleActivityTaskDecisionAttributes, ToScheduleActivityTaskDecisionAttributes)
+	testutils.RunMapperFuzzTest(t, FromScheduleActivityTaskDecisionAttributes, ToScheduleActivityTaskDecisionAttributes,
+		withTaskDispatchIDLGapExcluded(),
+	)
 }
 
 func TestTaskListFuzz(t *testing.T) {
@@ -2591,6 +2614,7 @@ func TestStartWorkflowExecutionRequestFuzz(t *testing.T) {
 		testutils.WithCustomFuncs(
 			WorkflowIDReusePolicyFuzzer,
 		),
+		withTaskDispatchIDLGapExcluded(),
 	)
 }
 
@@ -2619,6 +2643,10 @@ func TestDescribeTaskListResponseMapFuzz(t *testing.T) {
 						resp.PartitionConfig.ReadPartitions = nil
 						resp.PartitionConfig.WritePartitions = nil
 					}
+					// FairnessKeyBacklogCounts is not yet part of the IDL
+					if resp != nil && resp.TaskListStatus != nil {
+						resp.TaskListStatus.FairnessKeyBacklogCounts = nil
+					}
 				}
 			},
 		),
@@ -2727,8 +2755,9 @@ func TestWorkflowExecutionInfoFuzz(t *testing.T) {
 	// [Intended] ParentDomainID, ParentDomain: converted to empty string instead of nil when ParentExecutionInfo exists but field is empty
 	// [Intended] ParentInitiatedID: converted to 0 instead of nil when ParentExecutionInfo exists but field is 0
 	// [BUG] CronSchedule is not round trip safe with an empty string
+	// [IDL gap] IsPaused is not part of the IDL yet
 	testutils.RunMapperFuzzTest(t, FromWorkflowExecutionInfo, ToWorkflowExecutionInfo,
-		testutils.WithExcludedFields("UpdateTime", "ParentDomainID", "ParentDomain", "ParentInitiatedID", "CronSchedule"),
+		testutils.WithExcludedFields("UpdateTime", "ParentDomainID", "ParentDomain", "ParentInitiatedID", "CronSchedule", "IsPaused"),
 	)
 }
 
@@ -2745,6 +2774,7 @@ func TestSignalWithStartWorkflowExecutionAsyncRequestFuzz(t *testing.T) {
 		testutils.WithCustomFuncs(
 			WorkflowIDReusePolicyFuzzer,
 		),
+		withTaskDispatchIDLGapExcluded(),
 	)
 }
 
@@ -2903,8 +2933,10 @@ func PendingActivityInfoFuzzer(a *types.PendingActivityInfo, c fuzz.Continue) {
 }
 
 func TestPendingActivityInfoFuzz(t *testing.T) {
+	// [IDL gap] IsPaused is not part of the IDL yet
 	testutils.RunMapperFuzzTest(t, FromPendingActivityInfo, ToPendingActivityInfo,
 		testutils.WithCustomFuncs(PendingActivityInfoFuzzer),
+		testutils.WithExcludedFields("IsPaused"),
 	)
 }
 
@@ -3123,3 +3155,9 @@ func TestClusterAttributeScopeFuzz(t *testing.T) {
 func TestBadBinaryInfoMapFuzz(t *testing.T) {
 	testutils.RunMapperFuzzTest(t, FromBadBinaryInfoMap, ToBadBinaryInfoMap)
 }
+
+// withTaskDispatchIDLGapExcluded excludes task priority and fairness fields that are
+// not yet part of the IDL and are therefore dropped by the mappers.
+func withTaskDispatchIDLGapExcluded() testutils.FuzzOption {
+	return testutils.WithExcludedFields("Priority", "FairnessKey", "FairnessKeyBacklogCounts")
+}
@@ -1623,3 +1623,340 @@ func ToHistoryRatelimitUpdateResponse(t *historyv1.RatelimitUpdateResponse) *typ
 		Any: ToAny(t.Data),
 	}
 }
+
+func FromHistoryUpdateWorkflowExecutionRequest(t *types.HistoryUpdateWorkflowExecutionRequest) *historyv1.UpdateWorkflowExecutionRequest {
+	if t == nil || t.UpdateRequest == nil {
+		return nil
+	}
+	return &historyv1.UpdateWorkflowExecutionRequest{
+		DomainId:          t.DomainUUID,
+		Domain:            t.UpdateRequest.Domain,
+		WorkflowExecution: FromWorkflowExecution(t.UpdateRequest.WorkflowExecution),
+		UpdateId:          t.UpdateRequest.UpdateID,
+		UpdateName:        t.UpdateRequest.UpdateName,
+		Input:             FromPayload(t.UpdateRequest.Input),
+		Identity:          t.UpdateRequest.Identity,
+	}
+}
+
+func ToHistoryUpdateWorkflowExecutionRequest(t *historyv1.UpdateWorkflowExecutionRequest) *types.HistoryUpdateWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryUpdateWorkflowExecutionRequest{
+		DomainUUID: t.DomainId,
+		UpdateRequest: &types.UpdateWorkflowExecutionRequest{
+			Domain:            t.Domain,
+			WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+			UpdateID:          t.UpdateId,
+			UpdateName:        t.UpdateName,
+			Input:             ToPayload(t.Input),
+			Identity:          t.Identity,
+		},
+	}
+}
+
+func FromHistoryUpdateWorkflowExecutionResponse(t *types.UpdateWorkflowExecutionResponse) *historyv1.UpdateWorkflowExecutionResponse {
+	if t == nil {
+		return nil
+	}
+	return &historyv1.UpdateWorkflowExecutionResponse{
+		UpdateId: t.UpdateID,
+		Result:   FromPayload(t.Result),
+		Failure:  FromFailure(t.FailureReason, t.FailureDetails),
+	}
+}
+
+func ToHistoryUpdateWorkflowExecutionResponse(t *historyv1.UpdateWorkflowExecutionResponse) *types.UpdateWorkflowExecutionResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.UpdateWorkflowExecutionResponse{
+		UpdateID:       t.UpdateId,
+		Result:         ToPayload(t.Result),
+		FailureReason:  ToFailureReason(t.Failure),
+		FailureDetails: ToFailureDetails(t.Failure),
+	}
+}
+
+func FromHistoryPauseWorkflowExecutionRequest(t *types.HistoryPauseWorkflowExecutionRequest) *historyv1.PauseWorkflowExecutionRequest {
+	if t == nil || t.PauseRequest == nil {
+		return nil
+	}
+	return &historyv1.PauseWorkflowExecutionRequest{
+		DomainId:          t.DomainUUID,
+		Domain:            t.PauseRequest.Domain,
+		WorkflowExecution: FromWorkflowExecution(t.PauseRequest.WorkflowExecution),
+		Reason:            t.PauseRequest.Reason,
+		Identity:          t.PauseRequest.Identity,
+		RequestId:         t.PauseRequest.RequestID,
+	}
+}
+
+func ToHistoryPauseWorkflowExecutionRequest(t *historyv1.PauseWorkflowExecutionRequest) *types.HistoryPauseWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryPauseWorkflowExecutionRequest{
+		DomainUUID: t.DomainId,
+		PauseRequest: &types.PauseWorkflowExecutionRequest{
+			Domain:            t.Domain,
+			WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+			Reason:            t.Reason,
+			Identity:          t.Identity,
+			RequestID:         t.RequestId,
+		},
+	}
+}
+
+func FromHistoryUnpauseWorkflowExecutionRequest(t *types.HistoryUnpauseWorkflowExecutionRequest) *historyv1.UnpauseWorkflowExecutionRequest {
+	if t == nil || t.UnpauseRequest == nil {
+		return nil
+	}
+	return &historyv1.UnpauseWorkflowExecutionRequest{
+		DomainId:          t.DomainUUID,
+		Domain:            t.UnpauseRequest.Domain,
+		WorkflowExecution: FromWorkflowExecution(t.UnpauseRequest.WorkflowExecution),
+		Reason:            t.UnpauseRequest.Reason,
+		Identity:          t.UnpauseRequest.Identity,
+		RequestId:         t.UnpauseRequest.RequestID,
+	}
+}
+
+func ToHistoryUnpauseWorkflowExecutionRequest(t *historyv1.UnpauseWorkflowExecutionRequest) *types.HistoryUnpauseWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryUnpauseWorkflowExecutionRequest{
+		DomainUUID: t.DomainId,
+		UnpauseRequest: &types.UnpauseWorkflowExecutionRequest{
+			Domain:            t.Domain,
+			WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+			Reason:            t.Reason,
+			Identity:          t.Identity,
+			RequestID:         t.RequestId,
+		},
+	}
+}
+
+func FromHistoryPauseActivityRequest(t *types.HistoryPauseActivityRequest) *historyv1.PauseActivityRequest {
+	if t == nil || t.PauseRequest == nil {
+		return nil
+	}
+	return &historyv1.PauseActivityRequest{
+		DomainId:          t.DomainUUID,
+		Domain:            t.PauseRequest.Domain,
+		WorkflowExecution: FromWorkflowExecution(t.PauseRequest.WorkflowExecution),
+		ActivityId:        t.PauseRequest.ActivityID,
+		Identity:          t.PauseRequest.Identity,
+	}
+}
+
+func ToHistoryPauseActivityRequest(t *historyv1.PauseActivityRequest) *types.HistoryPauseActivityRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryPauseActivityRequest{
+		DomainUUID: t.DomainId,
+		PauseRequest: &types.PauseActivityRequest{
+			Domain:            t.Domain,
+			WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+			ActivityID:        t.ActivityId,
+			Identity:          t.Identity,
+		},
+	}
+}
+
+func FromHistoryUnpauseActivityRequest(t *types.HistoryUnpauseActivityRequest) *historyv1.UnpauseActivityRequest {
+	if t == nil || t.UnpauseRequest == nil {
+		return nil
+	}
+	return &historyv1.UnpauseActivityRequest{
+		DomainId:          t.DomainUUID,
+		Domain:            t.UnpauseRequest.Domain,
+		WorkflowExecution: FromWorkflowExecution(t.UnpauseRequest.WorkflowExecution),
+		ActivityId:        t.UnpauseRequest.ActivityID,
+		Identity:          t.UnpauseRequest.Identity,
+	}
+}
+
+func ToHistoryUnpauseActivityRequest(t *historyv1.UnpauseActivityRequest) *types.HistoryUnpauseActivityRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryUnpauseActivityRequest{
+		DomainUUID: t.DomainId,
+		UnpauseRequest: &types.UnpauseActivityRequest{
+			Domain:            t.Domain,
+			WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+			ActivityID:        t.ActivityId,
+			Identity:          t.Identity,
+		},
+	}
+}
+
+func FromHistoryResetActivityAttemptRequest(t *types.HistoryResetActivityAttemptRequest) *historyv1.ResetActivityAttemptRequest {
+	if t == nil || t.ResetRequest == nil {
+		return nil
+	}
+	return &historyv1.ResetActivityAttemptRequest{
+		DomainId:          t.DomainUUID,
+		Domain:            t.ResetRequest.Domain,
+		WorkflowExecution: FromWorkflowExecution(t.ResetRequest.WorkflowExecution),
+		ActivityId:        t.ResetRequest.ActivityID,
+		Identity:          t.ResetRequest.Identity,
+	}
+}
+
+func ToHistoryResetActivityAttemptRequest(t *historyv1.ResetActivityAttemptRequest) *types.HistoryResetActivityAttemptRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryResetActivityAttemptRequest{
+		DomainUUID: t.DomainId,
+		ResetRequest: &types.ResetActivityAttemptRequest{
+			Domain:            t.Domain,
+			WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+			ActivityID:        t.ActivityId,
+			Identity:          t.Identity,
+		},
+	}
+}
+
+func FromHistoryRetryActivityNowRequest(t *types.HistoryRetryActivityNowRequest) *historyv1.RetryActivityNowRequest {
+	if t == nil || t.RetryRequest == nil {
+		return nil
+	}
+	return &historyv1.RetryActivityNowRequest{
+		DomainId:          t.DomainUUID,
+		Domain:            t.RetryRequest.Domain,
+		WorkflowExecution: FromWorkflowExecution(t.RetryRequest.WorkflowExecution),
+		ActivityId:        t.RetryRequest.ActivityID,
+		Identity:          t.RetryRequest.Identity,
+	}
+}
+
+func ToHistoryRetryActivityNowRequest(t *historyv1.RetryActivityNowRequest) *types.HistoryRetryActivityNowRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryRetryActivityNowRequest{
+		DomainUUID: t.DomainId,
+		RetryRequest: &types.RetryActivityNowRequest{
+			Domain:            t.Domain,
+			WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+			ActivityID:        t.ActivityId,
+			Identity:          t.Identity,
+		},
+	}
+}
+
+func FromHistoryDeleteWorkflowExecutionRequest(t *types.HistoryDeleteWorkflowExecutionRequest) *historyv1.DeleteWorkflowExecutionRequest {
+	if t == nil || t.DeleteRequest == nil {
+		return nil
+	}
+	return &historyv1.DeleteWorkflowExecutionRequest{
+		DomainId:          t.DomainUUID,
+		Domain:            t.DeleteRequest.Domain,
+		WorkflowExecution: FromWorkflowExecution(t.DeleteRequest.WorkflowExecution),
+		Reason:            t.DeleteRequest.Reason,
+		Identity:          t.DeleteRequest.Identity,
+	}
+}
+
+func ToHistoryDeleteWorkflowExecutionRequest(t *historyv1.DeleteWorkflowExecutionRequest) *types.HistoryDeleteWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryDeleteWorkflowExecutionRequest{
+		DomainUUID: t.DomainId,
+		DeleteRequest: &types.DeleteWorkflowExecutionRequest{
+			Domain:            t.Domain,
+			WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+			Reason:            t.Reason,
+			Identity:          t.Identity,
+		},
+	}
+}
+
+func FromHistoryReplicateDeleteWorkflowExecutionRequest(t *types.DeleteWorkflowExecutionTaskAttributes) *historyv1.ReplicateDeleteWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &historyv1.ReplicateDeleteWorkflowExecutionRequest{
+		DomainId:          t.DomainID,
+		WorkflowExecution: FromWorkflowRunPair(t.WorkflowID, t.RunID),
+		Version:           t.Version,
+	}
+}
+
+func ToHistoryReplicateDeleteWorkflowExecutionRequest(t *historyv1.ReplicateDeleteWorkflowExecutionRequest) *types.DeleteWorkflowExecutionTaskAttributes {
+	if t == nil {
+		return nil
+	}
+	return &types.DeleteWorkflowExecutionTaskAttributes{
+		DomainID:   t.DomainId,
+		WorkflowID: ToWorkflowID(t.WorkflowExecution),
+		RunID:      ToRunID(t.WorkflowExecution),
+		Version:    t.Version,
+	}
+}
+
+func FromHistoryUpsertWorkflowSearchAttributesRequest(t *types.HistoryUpsertWorkflowSearchAttributesRequest) *historyv1.UpsertWorkflowSearchAttributesRequest {
+	if t == nil || t.Request == nil {
+		return nil
+	}
+	return &historyv1.UpsertWorkflowSearchAttributesRequest{
+		DomainId:          t.DomainUUID,
+		Domain:            t.Request.Domain,
+		WorkflowExecution: FromWorkflowExecution(t.Request.Execution),
+		SearchAttributes:  FromSearchAttributes(t.Request.SearchAttributes),
+		Memo:              FromMemo(t.Request.Memo),
+		Reason:            t.Request.Reason,
+		Identity:          t.Request.Identity,
+	}
+}
+
+func ToHistoryUpsertWorkflowSearchAttributesRequest(t *historyv1.UpsertWorkflowSearchAttributesRequest) *types.HistoryUpsertWorkflowSearchAttributesRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryUpsertWorkflowSearchAttributesRequest{
+		DomainUUID: t.DomainId,
+		Request: &types.AdminUpsertWorkflowSearchAttributesRequest{
+			Domain:           t.Domain,
+			Execution:        ToWorkflowExecution(t.WorkflowExecution),
+			SearchAttributes: ToSearchAttributes(t.SearchAttributes),
+			Memo:             ToMemo(t.Memo),
+			Reason:           t.Reason,
+			Identity:         t.Identity,
+		},
+	}
+}
+
+func FromHistoryReplicateUpsertWorkflowSearchAttributesRequest(t *types.UpsertWorkflowSearchAttributesTaskAttributes) *historyv1.ReplicateUpsertWorkflowSearchAttributesRequest {
+	if t == nil {
+		return nil
+	}
+	return &historyv1.ReplicateUpsertWorkflowSearchAttributesRequest{
+		DomainId:          t.DomainID,
+		WorkflowExecution: FromWorkflowRunPair(t.WorkflowID, t.RunID),
+		Version:           t.Version,
+		SearchAttributes:  FromSearchAttributes(t.SearchAttributes),
+		Memo:              FromMemo(t.Memo),
+	}
+}
+
+func ToHistoryReplicateUpsertWorkflowSearchAttributesRequest(t *historyv1.ReplicateUpsertWorkflowSearchAttributesRequest) *types.UpsertWorkflowSearchAttributesTaskAttributes {
+	if t == nil {
+		return nil
+	}
+	return &types.UpsertWorkflowSearchAttributesTaskAttributes{
+		DomainID:         t.DomainId,
+		WorkflowID:       ToWorkflowID(t.WorkflowExecution),
+		RunID:            ToRunID(t.WorkflowExecution),
+		Version:          t.Version,
+		SearchAttributes: ToSearchAttributes(t.SearchAttributes),
+		Memo:             ToMemo(t.Memo),
+	}
+}
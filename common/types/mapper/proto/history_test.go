@@ -396,6 +396,87 @@ func TestRatelimitUpdate(t *testing.T) {
 	})
 }
 
+func TestHistoryUpdateWorkflowExecutionRequest(t *testing.T) {
+	for _, item := range []*types.HistoryUpdateWorkflowExecutionRequest{nil, &testdata.HistoryUpdateWorkflowExecutionRequest} {
+		assert.Equal(t, item, ToHistoryUpdateWorkflowExecutionRequest(FromHistoryUpdateWorkflowExecutionRequest(item)))
+	}
+	assert.Nil(t, FromHistoryUpdateWorkflowExecutionRequest(&types.HistoryUpdateWorkflowExecutionRequest{}))
+}
+
+func TestHistoryUpdateWorkflowExecutionResponse(t *testing.T) {
+	for _, item := range []*types.UpdateWorkflowExecutionResponse{nil, {}, &testdata.UpdateWorkflowExecutionResponse} {
+		assert.Equal(t, item, ToHistoryUpdateWorkflowExecutionResponse(FromHistoryUpdateWorkflowExecutionResponse(item)))
+	}
+}
+
+func TestHistoryPauseWorkflowExecutionRequest(t *testing.T) {
+	for _, item := range []*types.HistoryPauseWorkflowExecutionRequest{nil, &testdata.HistoryPauseWorkflowExecutionRequest} {
+		assert.Equal(t, item, ToHistoryPauseWorkflowExecutionRequest(FromHistoryPauseWorkflowExecutionRequest(item)))
+	}
+	assert.Nil(t, FromHistoryPauseWorkflowExecutionRequest(&types.HistoryPauseWorkflowExecutionRequest{}))
+}
+
+func TestHistoryUnpauseWorkflowExecutionRequest(t *testing.T) {
+	for _, item := range []*types.HistoryUnpauseWorkflowExecutionRequest{nil, &testdata.HistoryUnpauseWorkflowExecutionRequest} {
+		assert.Equal(t, item, ToHistoryUnpauseWorkflowExecutionRequest(FromHistoryUnpauseWorkflowExecutionRequest(item)))
+	}
+	assert.Nil(t, FromHistoryUnpauseWorkflowExecutionRequest(&types.HistoryUnpauseWorkflowExecutionRequest{}))
+}
+
+func TestHistoryPauseActivityRequest(t *testing.T) {
+	for _, item := range []*types.HistoryPauseActivityRequest{nil, &testdata.HistoryPauseActivityRequest} {
+		assert.Equal(t, item, ToHistoryPauseActivityRequest(FromHistoryPauseActivityRequest(item)))
+	}
+	assert.Nil(t, FromHistoryPauseActivityRequest(&types.HistoryPauseActivityRequest{}))
+}
+
+func TestHistoryUnpauseActivityRequest(t *testing.T) {
+	for _, item := range []*types.HistoryUnpauseActivityRequest{nil, &testdata.HistoryUnpauseActivityRequest} {
+		assert.Equal(t, item, ToHistoryUnpauseActivityRequest(FromHistoryUnpauseActivityRequest(item)))
+	}
+	assert.Nil(t, FromHistoryUnpauseActivityRequest(&types.HistoryUnpauseActivityRequest{}))
+}
+
+func TestHistoryResetActivityAttemptRequest(t *testing.T) {
+	for _, item := range []*types.HistoryResetActivityAttemptRequest{nil, &testdata.HistoryResetActivityAttemptRequest} {
+		assert.Equal(t, item, ToHistoryResetActivityAttemptRequest(FromHistoryResetActivityAttemptRequest(item)))
+	}
+	assert.Nil(t, FromHistoryResetActivityAttemptRequest(&types.HistoryResetActivityAttemptRequest{}))
+}
+
+func TestHistoryRetryActivityNowRequest(t *testing.T) {
+	for _, item := range []*types.HistoryRetryActivityNowRequest{nil, &testdata.HistoryRetryActivityNowRequest} {
+		assert.Equal(t, item, ToHistoryRetryActivityNowRequest(FromHistoryRetryActivityNowRequest(item)))
+	}
+	assert.Nil(t, FromHistoryRetryActivityNowRequest(&types.HistoryRetryActivityNowRequest{}))
+}
+
+func TestHistoryDeleteWorkflowExecutionRequest(t *testing.T) {
+	for _, item := range []*types.HistoryDeleteWorkflowExecutionRequest{nil, &testdata.HistoryDeleteWorkflowExecutionRequest} {
+		assert.Equal(t, item, ToHistoryDeleteWorkflowExecutionRequest(FromHistoryDeleteWorkflowExecutionRequest(item)))
+	}
+	assert.Nil(t, FromHistoryDeleteWorkflowExecutionRequest(&types.HistoryDeleteWorkflowExecutionRequest{}))
+}
+
+func TestHistoryReplicateDeleteWorkflowExecutionRequest(t *testing.T) {
+	for _, item := range []*types.DeleteWorkflowExecutionTaskAttributes{nil, {}, &testdata.DeleteWorkflowExecutionTaskAttributes} {
+		assert.Equal(t, item, ToHistoryReplicateDeleteWorkflowExecutionRequest(FromHistoryReplicateDeleteWorkflowExecutionRequest(item)))
+	}
+}
+
+func TestHistoryUpsertWorkflowSearchAttributesRequest(t *testing.T) {
+	for _, item := range []*types.HistoryUpsertWorkflowSearchAttributesRequest{nil, &testdata.HistoryUpsertWorkflowSearchAttributesRequest} {
+		assert.Equal(t, item, ToHistoryUpsertWorkflowSearchAttributesRequest(FromHistoryUpsertWorkflowSearchAttributesRequest(item)))
+	}
+	assert.Nil(t, FromHistoryUpsertWorkflowSearchAttributesRequest(&types.HistoryUpsertWorkflowSearchAttributesRequest{}))
+}
+
+func TestHistoryReplicateUpsertWorkflowSearchAttributesRequest(t *testing.T) {
+	for _, item := range []*types.UpsertWorkflowSearchAttributesTaskAttributes{nil, {}, &testdata.UpsertWorkflowSearchAttributesTaskAttributes} {
+		assert.Equal(t, item, ToHistoryReplicateUpsertWorkflowSearchAttributesRequest(FromHistoryReplicateUpsertWorkflowSearchAttributesRequest(item)))
+	}
+}
+
 // --- Fuzz tests for history mapper functions ---
 
 // HistoryStartWorkflowExecutionRequestFuzzer ensures ContinuedFailureReason is non-nil
@@ -491,6 +572,106 @@ func HistoryRefreshWorkflowTasksRequestFuzzer(r *types.HistoryRefreshWorkflowTas
 	}
 }
 
+// HistoryUpdateWorkflowExecutionRequestFuzzer ensures UpdateRequest is always non-nil.
+// FromHistoryUpdateWorkflowExecutionRequest returns nil when t.UpdateRequest is nil, which breaks
+// the round-trip (To(nil) == nil != &{UpdateRequest: nil}).
+func HistoryUpdateWorkflowExecutionRequestFuzzer(r *types.HistoryUpdateWorkflowExecutionRequest, c fuzz.Continue) {
+	c.FuzzNoCustom(r)
+	if r.UpdateRequest == nil {
+		r.UpdateRequest = &types.UpdateWorkflowExecutionRequest{}
+	}
+}
+
+// HistoryUpdateWorkflowExecutionResponseFuzzer ensures FailureReason is non-nil when
+// FailureDetails is non-nil. FromFailure(nil, details) returns nil, which drops the details.
+func HistoryUpdateWorkflowExecutionResponseFuzzer(r *types.UpdateWorkflowExecutionResponse, c fuzz.Continue) {
+	c.FuzzNoCustom(r)
+	if r.FailureReason == nil && r.FailureDetails != nil {
+		reason := ""
+		r.FailureReason = &reason
+	}
+}
+
+// HistoryPauseWorkflowExecutionRequestFuzzer ensures PauseRequest is always non-nil.
+// FromHistoryPauseWorkflowExecutionRequest returns nil when t.PauseRequest is nil, which breaks
+// the round-trip (To(nil) == nil != &{PauseRequest: nil}).
+func HistoryPauseWorkflowExecutionRequestFuzzer(r *types.HistoryPauseWorkflowExecutionRequest, c fuzz.Continue) {
+	c.FuzzNoCustom(r)
+	if r.PauseRequest == nil {
+		r.PauseRequest = &types.PauseWorkflowExecutionRequest{}
+	}
+}
+
+// HistoryUnpauseWorkflowExecutionRequestFuzzer ensures UnpauseRequest is always non-nil.
+// FromHistoryUnpauseWorkflowExecutionRequest returns nil when t.UnpauseRequest is nil, which breaks
+// the round-trip (To(nil) == nil != &{UnpauseRequest: nil}).
+func HistoryUnpauseWorkflowExecutionRequestFuzzer(r *types.HistoryUnpauseWorkflowExecutionRequest, c fuzz.Continue) {
+	c.FuzzNoCustom(r)
+	if r.UnpauseRequest == nil {
+		r.UnpauseRequest = &types.UnpauseWorkflowExecutionRequest{}
+	}
+}
+
+// HistoryPauseActivityRequestFuzzer ensures PauseRequest is always non-nil.
+// FromHistoryPauseActivityRequest returns nil when t.PauseRequest is nil, which breaks
+// the round-trip (To(nil) == nil != &{PauseRequest: nil}).
+func HistoryPauseActivityRequestFuzzer(r *types.HistoryPauseActivityRequest, c fuzz.Continue) {
+	c.FuzzNoCustom(r)
+	if r.PauseRequest == nil {
+		r.PauseRequest = &types.PauseActivityRequest{}
+	}
+}
+
+// HistoryUnpauseActivityRequestFuzzer ensures UnpauseRequest is always non-nil.
+// FromHistoryUnpauseActivityRequest returns nil when t.UnpauseRequest is nil, which breaks
+// the round-trip (To(nil) == nil != &{UnpauseRequest: nil}).
+func HistoryUnpauseActivityRequestFuzzer(r *types.HistoryUnpauseActivityRequest, c fuzz.Continue) {
+	c.FuzzNoCustom(r)
+	if r.UnpauseRequest == nil {
+		r.UnpauseRequest = &types.UnpauseActivityRequest{}
+	}
+}
+
+// HistoryResetActivityAttemptRequestFuzzer ensures ResetRequest is always non-nil.
+// FromHistoryResetActivityAttemptRequest returns nil when t.ResetRequest is nil, which breaks
+// the round-trip (To(nil) == nil != &{ResetRequest: nil}).
+func HistoryResetActivityAttemptRequestFuzzer(r *types.HistoryResetActivityAttemptRequest, c fuzz.Continue) {
+	c.FuzzNoCustom(r)
+	if r.ResetRequest == nil {
+		r.ResetRequest = &types.ResetActivityAttemptRequest{}
+	}
+}
+
+// HistoryRetryActivityNowRequestFuzzer ensures RetryRequest is always non-nil.
+// FromHistoryRetryActivityNowRequest returns nil when t.RetryRequest is nil, which breaks
+// the round-trip (To(nil) == nil != &{RetryRequest: nil}).
+func HistoryRetryActivityNowRequestFuzzer(r *types.HistoryRetryActivityNowRequest, c fuzz.Continue) {
+	c.FuzzNoCustom(r)
+	if r.RetryRequest == nil {
+		r.RetryRequest = &types.RetryActivityNowRequest{}
+	}
+}
+
+// HistoryDeleteWorkflowExecutionRequestFuzzer ensures DeleteRequest is always non-nil.
+// FromHistoryDeleteWorkflowExecutionRequest returns nil when t.DeleteRequest is nil, which breaks
+// the round-trip (To(nil) == nil != &{DeleteRequest: nil}).
+func HistoryDeleteWorkflowExecutionRequestFuzzer(r *types.HistoryDeleteWorkflowExecutionRequest, c fuzz.Continue) {
+	c.FuzzNoCustom(r)
+	if r.DeleteRequest == nil {
+		r.DeleteRequest = &types.DeleteWorkflowExecutionRequest{}
+	}
+}
+
+// HistoryUpsertWorkflowSearchAttributesRequestFuzzer ensures Request is always non-nil.
+// FromHistoryUpsertWorkflowSearchAttributesRequest returns nil when t.Request is nil, which breaks
+// the round-trip (To(nil) == nil != &{Request: nil}).
+func HistoryUpsertWorkflowSearchAttributesRequestFuzzer(r *types.HistoryUpsertWorkflowSearchAttributesRequest, c fuzz.Continue) {
+	c.FuzzNoCustom(r)
+	if r.Request == nil {
+		r.Request = &types.AdminUpsertWorkflowSearchAttributesRequest{}
+	}
+}
+
 func TestHistoryCloseShardRequestFuzz(t *testing.T) {
 	testutils.RunMapperFuzzTest(t, FromHistoryCloseShardRequest, ToHistoryCloseShardRequest)
 }
@@ -690,8 +871,10 @@ func TestHistorySyncActivityRequestFuzz(t *testing.T) {
 	// the round-trip. SyncActivityRequestFuzzer ensures LastFailureReason is non-nil when
 	// Details/Options is set and normalizes the failure category.
 	// WorkflowID + RunID merge into WorkflowExecution and split back correctly.
+	// [IDL gap] Paused is not part of the proto IDL yet
 	testutils.RunMapperFuzzTest(t, FromHistorySyncActivityRequest, ToHistorySyncActivityRequest,
 		testutils.WithCustomFuncs(SyncActivityRequestFuzzer),
+		testutils.WithExcludedFields("Paused"),
 	)
 }
 
@@ -822,3 +1005,71 @@ func TestHistoryStartWorkflowExecutionRequestFuzz(t *testing.T) {
 		testutils.WithExcludedFields("StartRequest"),
 	)
 }
+
+func TestHistoryUpdateWorkflowExecutionRequestFuzz(t *testing.T) {
+	testutils.RunMapperFuzzTest(t, FromHistoryUpdateWorkflowExecutionRequest, ToHistoryUpdateWorkflowExecutionRequest,
+		testutils.WithCustomFuncs(HistoryUpdateWorkflowExecutionRequestFuzzer),
+	)
+}
+
+func TestHistoryUpdateWorkflowExecutionResponseFuzz(t *testing.T) {
+	testutils.RunMapperFuzzTest(t, FromHistoryUpdateWorkflowExecutionResponse, ToHistoryUpdateWorkflowExecutionResponse,
+		testutils.WithCustomFuncs(HistoryUpdateWorkflowExecutionResponseFuzzer),
+	)
+}
+
+func TestHistoryPauseWorkflowExecutionRequestFuzz(t *testing.T) {
+	testutils.RunMapperFuzzTest(t, FromHistoryPauseWorkflowExecutionRequest, ToHistoryPauseWorkflowExecutionRequest,
+		testutils.WithCustomFuncs(HistoryPauseWorkflowExecutionRequestFuzzer),
+	)
+}
+
+func TestHistoryUnpauseWorkflowExecutionRequestFuzz(t *testing.T) {
+	testutils.RunMapperFuzzTest(t, FromHistoryUnpauseWorkflowExecutionRequest, ToHistoryUnpauseWorkflowExecutionRequest,
+		testutils.WithCustomFuncs(HistoryUnpauseWorkflowExecutionRequestFuzzer),
+	)
+}
+
+func TestHistoryPauseActivityRequestFuzz(t *testing.T) {
+	testutils.RunMapperFuzzTest(t, FromHistoryPauseActivityRequest, ToHistoryPauseActivityRequest,
+		testutils.WithCustomFuncs(HistoryPauseActivityRequestFuzzer),
+	)
+}
+
+func TestHistoryUnpauseActivityRequestFuzz(t *testing.T) {
+	testutils.RunMapperFuzzTest(t, FromHistoryUnpauseActivityRequest, ToHistoryUnpauseActivityRequest,
+		testutils.WithCustomFuncs(HistoryUnpauseActivityRequestFuzzer),
+	)
+}
+
+func TestHistoryResetActivityAttemptRequestFuzz(t *testing.T) {
+	testutils.RunMapperFuzzTest(t, FromHistoryResetActivityAttemptRequest, ToHistoryResetActivityAttemptRequest,
+		testutils.WithCustomFuncs(HistoryResetActivityAttemptRequestFuzzer),
+	)
+}
+
+func TestHistoryRetryActivityNowRequestFuzz(t *testing.T) {
+	testutils.RunMapperFuzzTest(t, FromHistoryRetryActivityNowRequest, ToHistoryRetryActivityNowRequest,
+		testutils.WithCustomFuncs(HistoryRetryActivityNowRequestFuzzer),
+	)
+}
+
+func TestHistoryDeleteWorkflowExecutionRequestFuzz(t *testing.T) {
+	testutils.RunMapperFuzzTest(t, FromHistoryDeleteWorkflowExecutionRequest, ToHistoryDeleteWorkflowExecutionRequest,
+		testutils.WithCustomFuncs(HistoryDeleteWorkflowExecutionRequestFuzzer),
+	)
+}
+
+func TestHistoryReplicateDeleteWorkflowExecutionRequestFuzz(t *testing.T) {
+	testutils.RunMapperFuzzTest(t, FromHistoryReplicateDeleteWorkflowExecutionRequest, ToHistoryReplicateDeleteWorkflowExecutionRequest)
+}
+
+func TestHistoryUpsertWorkflowSearchAttributesRequestFuzz(t *testing.T) {
+	testutils.RunMapperFuzzTest(t, FromHistoryUpsertWorkflowSearchAttributesRequest, ToHistoryUpsertWorkflowSearchAttributesRequest,
+		testutils.WithCustomFuncs(HistoryUpsertWorkflowSearchAttributesRequestFuzzer),
+	)
+}
+
+func TestHistoryReplicateUpsertWorkflowSearchAttributesRequestFuzz(t *testing.T) {
+	testutils.RunMapperFuzzTest(t, FromHistoryReplicateUpsertWorkflowSearchAttributesRequest, ToHistoryReplicateUpsertWorkflowSearchAttributesRequest)
+}
@@ -330,6 +330,7 @@ func TestMatchingDescribeTaskListResponseFuzz(t *testing.T) {
 	// specific partition scenarios are tested in TestToMatchingTaskListPartitionConfig.
 	testutils.RunMapperFuzzTest(t, FromMatchingDescribeTaskListResponse, ToMatchingDescribeTaskListResponse,
 		testutils.WithExcludedFields("PartitionConfig"),
+		withTaskDispatchIDLGapExcluded(),
 	)
 }
 
@@ -402,6 +403,7 @@ func TestMatchingGetTaskListsByDomainResponseFuzz(t *testing.T) {
 	// specific partition scenarios are tested in TestToMatchingTaskListPartitionConfig.
 	testutils.RunMapperFuzzTest(t, FromMatchingGetTaskListsByDomainResponse, ToMatchingGetTaskListsByDomainResponse,
 		testutils.WithExcludedFields("PartitionConfig"),
+		withTaskDispatchIDLGapExcluded(),
 	)
 }
 
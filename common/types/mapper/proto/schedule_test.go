@@ -88,6 +88,7 @@ func TestScheduleListEntry(t *testing.T) {
 func TestScheduleSpecFuzz(t *testing.T) {
 	testutils.RunMapperFuzzTest(t, FromScheduleSpec, ToScheduleSpec,
 		WithScheduleEnumFuzzers(),
+		withScheduleIDLGapExcluded(),
 	)
 }
 
@@ -100,12 +101,14 @@ func TestStartWorkflowActionFuzz(t *testing.T) {
 func TestScheduleActionFuzz(t *testing.T) {
 	testutils.RunMapperFuzzTest(t, FromScheduleAction, ToScheduleAction,
 		WithScheduleEnumFuzzers(),
+		withScheduleIDLGapExcluded(),
 	)
 }
 
 func TestSchedulePoliciesFuzz(t *testing.T) {
 	testutils.RunMapperFuzzTest(t, FromSchedulePolicies, ToSchedulePolicies,
 		WithScheduleEnumFuzzers(),
+		withScheduleIDLGapExcluded(),
 	)
 }
 
@@ -130,6 +133,7 @@ func TestBackfillInfoFuzz(t *testing.T) {
 func TestScheduleInfoFuzz(t *testing.T) {
 	testutils.RunMapperFuzzTest(t, FromScheduleInfo, ToScheduleInfo,
 		WithScheduleEnumFuzzers(),
+		withScheduleIDLGapExcluded(),
 	)
 }
 
@@ -139,6 +143,13 @@ func TestScheduleListEntryFuzz(t *testing.T) {
 	)
 }
 
+// withScheduleIDLGapExcluded excludes the ScheduleSpec, ScheduleAction,
+// SchedulePolicies and ScheduleInfo fields which the api/v1 IDL does not carry
+// yet, so they are dropped by the mapper.
+func withScheduleIDLGapExcluded() testutils.FuzzOption {
+	return testutils.WithExcludedFields("Intervals", "Calendars", "ExcludeCalendars", "SignalWorkflow", "SignalWithStartWorkflow", "RecentActions", "FailureBudget")
+}
+
 // WithScheduleEnumFuzzers adds fuzzers for Schedule-specific enum types
 func WithScheduleEnumFuzzers() testutils.FuzzOption {
 	return testutils.WithCustomFuncs(
@@ -206,6 +217,7 @@ func TestDeleteScheduleResponse(t *testing.T) {
 func TestCreateScheduleRequestFuzz(t *testing.T) {
 	testutils.RunMapperFuzzTest(t, FromCreateScheduleRequest, ToCreateScheduleRequest,
 		WithScheduleEnumFuzzers(),
+		withScheduleIDLGapExcluded(),
 	)
 }
 
@@ -218,12 +230,14 @@ func TestDescribeScheduleRequestFuzz(t *testing.T) {
 func TestDescribeScheduleResponseFuzz(t *testing.T) {
 	testutils.RunMapperFuzzTest(t, FromDescribeScheduleResponse, ToDescribeScheduleResponse,
 		WithScheduleEnumFuzzers(),
+		withScheduleIDLGapExcluded(),
 	)
 }
 
 func TestUpdateScheduleRequestFuzz(t *testing.T) {
 	testutils.RunMapperFuzzTest(t, FromUpdateScheduleRequest, ToUpdateScheduleRequest,
 		WithScheduleEnumFuzzers(),
+		withScheduleIDLGapExcluded(),
 	)
 }
 
@@ -597,8 +597,10 @@ func SyncActivityTaskAttributesFuzzer(r *types.SyncActivityTaskAttributes, c fuz
 func TestSyncActivityTaskAttributesFuzz(t *testing.T) {
 	// LastFailureReason + LastFailureDetails + LastFailureOptions merge into LastFailure (Failure);
 	// the fuzzer keeps reason non-nil when those are set so the round-trip is preserved.
+	// [IDL gap] Paused is not part of the proto IDL yet
 	testutils.RunMapperFuzzTest(t, FromSyncActivityTaskAttributes, ToSyncActivityTaskAttributes,
 		testutils.WithCustomFuncs(SyncActivityTaskAttributesFuzzer),
+		testutils.WithExcludedFields("Paused"),
 	)
 }
 
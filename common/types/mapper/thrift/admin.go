@@ -757,6 +757,36 @@ func ToAdminUpdateOperationalDynamicConfigRequest(t *admin.UpdateOperationalDyna
 	}
 }
 
+// FromAdminUpsertWorkflowSearchAttributesRequest converts internal AdminUpsertWorkflowSearchAttributesRequest type to thrift
+func FromAdminUpsertWorkflowSearchAttributesRequest(t *types.AdminUpsertWorkflowSearchAttributesRequest) *shared.UpsertWorkflowSearchAttributesRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.UpsertWorkflowSearchAttributesRequest{
+		Domain:           &t.Domain,
+		Execution:        FromWorkflowExecution(t.Execution),
+		SearchAttributes: FromSearchAttributes(t.SearchAttributes),
+		Memo:             FromMemo(t.Memo),
+		Reason:           &t.Reason,
+		Identity:         &t.Identity,
+	}
+}
+
+// ToAdminUpsertWorkflowSearchAttributesRequest converts thrift UpsertWorkflowSearchAttributesRequest type to internal
+func ToAdminUpsertWorkflowSearchAttributesRequest(t *shared.UpsertWorkflowSearchAttributesRequest) *types.AdminUpsertWorkflowSearchAttributesRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.AdminUpsertWorkflowSearchAttributesRequest{
+		Domain:           t.GetDomain(),
+		Execution:        ToWorkflowExecution(t.Execution),
+		SearchAttributes: ToSearchAttributes(t.SearchAttributes),
+		Memo:             ToMemo(t.Memo),
+		Reason:           t.GetReason(),
+		Identity:         t.GetIdentity(),
+	}
+}
+
 // FromAdminRestoreOperationalDynamicConfigRequest converts internal RestoreOperationalDynamicConfigRequest type to thrift
 func FromAdminRestoreOperationalDynamicConfigRequest(t *types.RestoreOperationalDynamicConfigRequest) *admin.RestoreOperationalDynamicConfigRequest {
 	if t == nil {
@@ -1006,3 +1006,8 @@ func TestAdminListOperationalDynamicConfigResponse(t *testing.T) {
 		assert.Equal(t, item, ToAdminListOperationalDynamicConfigResponse(FromAdminListOperationalDynamicConfigResponse(item)))
 	}
 }
+func TestAdminUpsertWorkflowSearchAttributesRequest(t *testing.T) {
+	for _, item := range []*types.AdminUpsertWorkflowSearchAttributesRequest{nil, {}, &testdata.AdminUpsertWorkflowSearchAttributesRequest} {
+		assert.Equal(t, item, ToAdminUpsertWorkflowSearchAttributesRequest(FromAdminUpsertWorkflowSearchAttributesRequest(item)))
+	}
+}
@@ -26,72 +26,100 @@ import (
 )
 
 var (
-	FromHistoryDescribeHistoryHostRequest                = FromAdminDescribeHistoryHostRequest
-	ToHistoryDescribeHistoryHostRequest                  = ToAdminDescribeHistoryHostRequest
-	FromHistoryDescribeHistoryHostResponse               = FromAdminDescribeHistoryHostResponse
-	ToHistoryDescribeHistoryHostResponse                 = ToAdminDescribeHistoryHostResponse
-	FromHistoryCloseShardRequest                         = FromAdminCloseShardRequest
-	ToHistoryCloseShardRequest                           = ToAdminCloseShardRequest
-	FromHistoryDescribeQueueRequest                      = FromAdminDescribeQueueRequest
-	ToHistoryDescribeQueueRequest                        = ToAdminDescribeQueueRequest
-	FromHistoryDescribeQueueResponse                     = FromAdminDescribeQueueResponse
-	ToHistoryDescribeQueueResponse                       = ToAdminDescribeQueueResponse
-	FromHistoryDescribeWorkflowExecutionResponse         = FromDescribeWorkflowExecutionResponse
-	ToHistoryDescribeWorkflowExecutionResponse           = ToDescribeWorkflowExecutionResponse
-	FromHistoryGetCrossClusterTasksRequest               = FromAdminGetCrossClusterTasksRequest
-	ToHistoryGetCrossClusterTasksRequest                 = ToAdminGetCrossClusterTasksRequest
-	FromHistoryGetCrossClusterTasksResponse              = FromAdminGetCrossClusterTasksResponse
-	ToHistoryGetCrossClusterTasksResponse                = ToAdminGetCrossClusterTasksResponse
-	FromHistoryGetDLQReplicationMessagesRequest          = FromAdminGetDLQReplicationMessagesRequest
-	ToHistoryGetDLQReplicationMessagesRequest            = ToAdminGetDLQReplicationMessagesRequest
-	FromHistoryGetDLQReplicationMessagesResponse         = FromAdminGetDLQReplicationMessagesResponse
-	ToHistoryGetDLQReplicationMessagesResponse           = ToAdminGetDLQReplicationMessagesResponse
-	FromHistoryGetFailoverInfoRequest                    = FromGetFailoverInfoRequest
-	ToHistoryGetFailoverInfoRequest                      = ToGetFailoverInfoRequest
-	FromHistoryGetFailoverInfoResponse                   = FromGetFailoverInfoResponse
-	ToHistoryGetFailoverInfoResponse                     = ToGetFailoverInfoResponse
-	FromHistoryGetReplicationMessagesRequest             = FromAdminGetReplicationMessagesRequest
-	ToHistoryGetReplicationMessagesRequest               = ToAdminGetReplicationMessagesRequest
-	FromHistoryGetReplicationMessagesResponse            = FromAdminGetReplicationMessagesResponse
-	ToHistoryGetReplicationMessagesResponse              = ToAdminGetReplicationMessagesResponse
-	FromHistoryMergeDLQMessagesRequest                   = FromAdminMergeDLQMessagesRequest
-	ToHistoryMergeDLQMessagesRequest                     = ToAdminMergeDLQMessagesRequest
-	FromHistoryMergeDLQMessagesResponse                  = FromAdminMergeDLQMessagesResponse
-	ToHistoryMergeDLQMessagesResponse                    = ToAdminMergeDLQMessagesResponse
-	FromHistoryPurgeDLQMessagesRequest                   = FromAdminPurgeDLQMessagesRequest
-	ToHistoryPurgeDLQMessagesRequest                     = ToAdminPurgeDLQMessagesRequest
-	FromHistoryReadDLQMessagesRequest                    = FromAdminReadDLQMessagesRequest
-	ToHistoryReadDLQMessagesRequest                      = ToAdminReadDLQMessagesRequest
-	FromHistoryReadDLQMessagesResponse                   = FromAdminReadDLQMessagesResponse
-	ToHistoryReadDLQMessagesResponse                     = ToAdminReadDLQMessagesResponse
-	FromHistoryRecordActivityTaskHeartbeatResponse       = FromRecordActivityTaskHeartbeatResponse
-	ToHistoryRecordActivityTaskHeartbeatResponse         = ToRecordActivityTaskHeartbeatResponse
-	FromHistoryRecordActivityTaskStartedRequest          = FromRecordActivityTaskStartedRequest
-	ToHistoryRecordActivityTaskStartedRequest            = ToRecordActivityTaskStartedRequest
-	FromHistoryRecordActivityTaskStartedResponse         = FromRecordActivityTaskStartedResponse
-	ToHistoryRecordActivityTaskStartedResponse           = ToRecordActivityTaskStartedResponse
-	FromHistoryRecordChildExecutionCompletedRequest      = FromRecordChildExecutionCompletedRequest
-	ToHistoryRecordChildExecutionCompletedRequest        = ToRecordChildExecutionCompletedRequest
-	FromHistoryRecordDecisionTaskStartedRequest          = FromRecordDecisionTaskStartedRequest
-	ToHistoryRecordDecisionTaskStartedRequest            = ToRecordDecisionTaskStartedRequest
-	FromHistoryRecordDecisionTaskStartedResponse         = FromRecordDecisionTaskStartedResponse
-	ToHistoryRecordDecisionTaskStartedResponse           = ToRecordDecisionTaskStartedResponse
-	FromHistoryRemoveTaskRequest                         = FromAdminRemoveTaskRequest
-	ToHistoryRemoveTaskRequest                           = ToAdminRemoveTaskRequest
-	FromHistoryResetQueueRequest                         = FromAdminResetQueueRequest
-	ToHistoryResetQueueRequest                           = ToAdminResetQueueRequest
-	FromHistoryResetWorkflowExecutionResponse            = FromResetWorkflowExecutionResponse
-	ToHistoryResetWorkflowExecutionResponse              = ToResetWorkflowExecutionResponse
-	FromHistoryRespondCrossClusterTasksCompletedRequest  = FromAdminRespondCrossClusterTasksCompletedRequest
-	ToHistoryRespondCrossClusterTasksCompletedRequest    = ToAdminRespondCrossClusterTasksCompletedRequest
-	FromHistoryRespondCrossClusterTasksCompletedResponse = FromAdminRespondCrossClusterTasksCompletedResponse
-	ToHistoryRespondCrossClusterTasksCompletedResponse   = ToAdminRespondCrossClusterTasksCompletedResponse
-	FromHistorySignalWithStartWorkflowExecutionResponse  = FromStartWorkflowExecutionResponse
-	ToHistorySignalWithStartWorkflowExecutionResponse    = ToStartWorkflowExecutionResponse
-	FromHistoryStartWorkflowExecutionResponse            = FromStartWorkflowExecutionResponse
-	ToHistoryStartWorkflowExecutionResponse              = ToStartWorkflowExecutionResponse
+	FromHistoryDescribeHistoryHostRequest                     = FromAdminDescribeHistoryHostRequest
+	ToHistoryDescribeHistoryHostRequest                       = ToAdminDescribeHistoryHostRequest
+	FromHistoryDescribeHistoryHostResponse                    = FromAdminDescribeHistoryHostResponse
+	ToHistoryDescribeHistoryHostResponse                      = ToAdminDescribeHistoryHostResponse
+	FromHistoryCloseShardRequest                              = FromAdminCloseShardRequest
+	ToHistoryCloseShardRequest                                = ToAdminCloseShardRequest
+	FromHistoryDescribeQueueRequest                           = FromAdminDescribeQueueRequest
+	ToHistoryDescribeQueueRequest                             = ToAdminDescribeQueueRequest
+	FromHistoryDescribeQueueResponse                          = FromAdminDescribeQueueResponse
+	ToHistoryDescribeQueueResponse                            = ToAdminDescribeQueueResponse
+	FromHistoryDescribeWorkflowExecutionResponse              = FromDescribeWorkflowExecutionResponse
+	ToHistoryDescribeWorkflowExecutionResponse                = ToDescribeWorkflowExecutionResponse
+	FromHistoryGetCrossClusterTasksRequest                    = FromAdminGetCrossClusterTasksRequest
+	ToHistoryGetCrossClusterTasksRequest                      = ToAdminGetCrossClusterTasksRequest
+	FromHistoryGetCrossClusterTasksResponse                   = FromAdminGetCrossClusterTasksResponse
+	ToHistoryGetCrossClusterTasksResponse                     = ToAdminGetCrossClusterTasksResponse
+	FromHistoryGetDLQReplicationMessagesRequest               = FromAdminGetDLQReplicationMessagesRequest
+	ToHistoryGetDLQReplicationMessagesRequest                 = ToAdminGetDLQReplicationMessagesRequest
+	FromHistoryGetDLQReplicationMessagesResponse              = FromAdminGetDLQReplicationMessagesResponse
+	ToHistoryGetDLQReplicationMessagesResponse                = ToAdminGetDLQReplicationMessagesResponse
+	FromHistoryGetFailoverInfoRequest                         = FromGetFailoverInfoRequest
+	ToHistoryGetFailoverInfoRequest                           = ToGetFailoverInfoRequest
+	FromHistoryGetFailoverInfoResponse                        = FromGetFailoverInfoResponse
+	ToHistoryGetFailoverInfoResponse                          = ToGetFailoverInfoResponse
+	FromHistoryGetReplicationMessagesRequest                  = FromAdminGetReplicationMessagesRequest
+	ToHistoryGetReplicationMessagesRequest                    = ToAdminGetReplicationMessagesRequest
+	FromHistoryGetReplicationMessagesResponse                 = FromAdminGetReplicationMessagesResponse
+	ToHistoryGetReplicationMessagesResponse                   = ToAdminGetReplicationMessagesResponse
+	FromHistoryMergeDLQMessagesRequest                        = FromAdminMergeDLQMessagesRequest
+	ToHistoryMergeDLQMessagesRequest                          = ToAdminMergeDLQMessagesRequest
+	FromHistoryMergeDLQMessagesResponse                       = FromAdminMergeDLQMessagesResponse
+	ToHistoryMergeDLQMessagesResponse                         = ToAdminMergeDLQMessagesResponse
+	FromHistoryPurgeDLQMessagesRequest                        = FromAdminPurgeDLQMessagesRequest
+	ToHistoryPurgeDLQMessagesRequest                          = ToAdminPurgeDLQMessagesRequest
+	FromHistoryReadDLQMessagesRequest                         = FromAdminReadDLQMessagesRequest
+	ToHistoryReadDLQMessagesRequest                           = ToAdminReadDLQMessagesRequest
+	FromHistoryReadDLQMessagesResponse                        = FromAdminReadDLQMessagesResponse
+	ToHistoryReadDLQMessagesResponse                          = ToAdminReadDLQMessagesResponse
+	FromHistoryRecordActivityTaskHeartbeatResponse            = FromRecordActivityTaskHeartbeatResponse
+	ToHistoryRecordActivityTaskHeartbeatResponse              = ToRecordActivityTaskHeartbeatResponse
+	FromHistoryRecordActivityTaskStartedRequest               = FromRecordActivityTaskStartedRequest
+	ToHistoryRecordActivityTaskStartedRequest                 = ToRecordActivityTaskStartedRequest
+	FromHistoryRecordActivityTaskStartedResponse              = FromRecordActivityTaskStartedResponse
+	ToHistoryRecordActivityTaskStartedResponse                = ToRecordActivityTaskStartedResponse
+	FromHistoryRecordChildExecutionCompletedRequest           = FromRecordChildExecutionCompletedRequest
+	ToHistoryRecordChildExecutionCompletedRequest             = ToRecordChildExecutionCompletedRequest
+	FromHistoryRecordDecisionTaskStartedRequest               = FromRecordDecisionTaskStartedRequest
+	ToHistoryRecordDecisionTaskStartedRequest                 = ToRecordDecisionTaskStartedRequest
+	FromHistoryRecordDecisionTaskStartedResponse              = FromRecordDecisionTaskStartedResponse
+	ToHistoryRecordDecisionTaskStartedResponse                = ToRecordDecisionTaskStartedResponse
+	FromHistoryRemoveTaskRequest                              = FromAdminRemoveTaskRequest
+	ToHistoryRemoveTaskRequest                                = ToAdminRemoveTaskRequest
+	FromHistoryReplicateDeleteWorkflowExecutionRequest        = FromDeleteWorkflowExecutionTaskAttributes
+	ToHistoryReplicateDeleteWorkflowExecutionRequest          = ToDeleteWorkflowExecutionTaskAttributes
+	FromHistoryReplicateUpsertWorkflowSearchAttributesRequest = FromUpsertWorkflowSearchAttributesTaskAttributes
+	ToHistoryReplicateUpsertWorkflowSearchAttributesRequest   = ToUpsertWorkflowSearchAttributesTaskAttributes
+	FromHistoryResetQueueRequest                              = FromAdminResetQueueRequest
+	ToHistoryResetQueueRequest                                = ToAdminResetQueueRequest
+	FromHistoryResetWorkflowExecutionResponse                 = FromResetWorkflowExecutionResponse
+	ToHistoryResetWorkflowExecutionResponse                   = ToResetWorkflowExecutionResponse
+	FromHistoryRespondCrossClusterTasksCompletedRequest       = FromAdminRespondCrossClusterTasksCompletedRequest
+	ToHistoryRespondCrossClusterTasksCompletedRequest         = ToAdminRespondCrossClusterTasksCompletedRequest
+	FromHistoryRespondCrossClusterTasksCompletedResponse      = FromAdminRespondCrossClusterTasksCompletedResponse
+	ToHistoryRespondCrossClusterTasksCompletedResponse        = ToAdminRespondCrossClusterTasksCompletedResponse
+	FromHistorySignalWithStartWorkflowExecutionResponse       = FromStartWorkflowExecutionResponse
+	ToHistorySignalWithStartWorkflowExecutionResponse         = ToStartWorkflowExecutionResponse
+	FromHistoryStartWorkflowExecutionResponse                 = FromStartWorkflowExecutionResponse
+	ToHistoryStartWorkflowExecutionResponse                   = ToStartWorkflowExecutionResponse
+	FromHistoryUpdateWorkflowExecutionResponse                = FromUpdateWorkflowExecutionResponse
+	ToHistoryUpdateWorkflowExecutionResponse                  = ToUpdateWorkflowExecutionResponse
 )
 
+// FromHistoryDeleteWorkflowExecutionRequest converts internal DeleteWorkflowExecutionRequest type to thrift
+func FromHistoryDeleteWorkflowExecutionRequest(t *types.HistoryDeleteWorkflowExecutionRequest) *history.DeleteWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &history.DeleteWorkflowExecutionRequest{
+		DomainUUID:    &t.DomainUUID,
+		DeleteRequest: FromDeleteWorkflowExecutionRequest(t.DeleteRequest),
+	}
+}
+
+// ToHistoryDeleteWorkflowExecutionRequest converts thrift DeleteWorkflowExecutionRequest type to internal
+func ToHistoryDeleteWorkflowExecutionRequest(t *history.DeleteWorkflowExecutionRequest) *types.HistoryDeleteWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryDeleteWorkflowExecutionRequest{
+		DomainUUID:    t.GetDomainUUID(),
+		DeleteRequest: ToDeleteWorkflowExecutionRequest(t.DeleteRequest),
+	}
+}
+
 // FromHistoryDescribeMutableStateRequest converts internal DescribeMutableStateRequest type to thrift
 func FromHistoryDescribeMutableStateRequest(t *types.DescribeMutableStateRequest) *history.DescribeMutableStateRequest {
 	if t == nil {
@@ -326,6 +354,50 @@ func ToHistoryNotifyFailoverMarkersRequest(t *history.NotifyFailoverMarkersReque
 	}
 }
 
+// FromHistoryPauseActivityRequest converts internal PauseActivityRequest type to thrift
+func FromHistoryPauseActivityRequest(t *types.HistoryPauseActivityRequest) *history.PauseActivityRequest {
+	if t == nil {
+		return nil
+	}
+	return &history.PauseActivityRequest{
+		DomainUUID:   &t.DomainUUID,
+		PauseRequest: FromPauseActivityRequest(t.PauseRequest),
+	}
+}
+
+// ToHistoryPauseActivityRequest converts thrift PauseActivityRequest type to internal
+func ToHistoryPauseActivityRequest(t *history.PauseActivityRequest) *types.HistoryPauseActivityRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryPauseActivityRequest{
+		DomainUUID:   t.GetDomainUUID(),
+		PauseRequest: ToPauseActivityRequest(t.PauseRequest),
+	}
+}
+
+// FromHistoryPauseWorkflowExecutionRequest converts internal PauseWorkflowExecutionRequest type to thrift
+func FromHistoryPauseWorkflowExecutionRequest(t *types.HistoryPauseWorkflowExecutionRequest) *history.PauseWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &history.PauseWorkflowExecutionRequest{
+		DomainUUID:   &t.DomainUUID,
+		PauseRequest: FromPauseWorkflowExecutionRequest(t.PauseRequest),
+	}
+}
+
+// ToHistoryPauseWorkflowExecutionRequest converts thrift PauseWorkflowExecutionRequest type to internal
+func ToHistoryPauseWorkflowExecutionRequest(t *history.PauseWorkflowExecutionRequest) *types.HistoryPauseWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryPauseWorkflowExecutionRequest{
+		DomainUUID:   t.GetDomainUUID(),
+		PauseRequest: ToPauseWorkflowExecutionRequest(t.PauseRequest),
+	}
+}
+
 // FromHistoryParentExecutionInfo converts internal ParentExecutionInfo type to thrift
 func FromParentExecutionInfo(t *types.ParentExecutionInfo) *history.ParentExecutionInfo {
 	if t == nil {
@@ -830,6 +902,28 @@ func ToHistoryRequestCancelWorkflowExecutionRequest(t *history.RequestCancelWork
 	}
 }
 
+// FromHistoryResetActivityAttemptRequest converts internal ResetActivityAttemptRequest type to thrift
+func FromHistoryResetActivityAttemptRequest(t *types.HistoryResetActivityAttemptRequest) *history.ResetActivityAttemptRequest {
+	if t == nil {
+		return nil
+	}
+	return &history.ResetActivityAttemptRequest{
+		DomainUUID:   &t.DomainUUID,
+		ResetRequest: FromResetActivityAttemptRequest(t.ResetRequest),
+	}
+}
+
+// ToHistoryResetActivityAttemptRequest converts thrift ResetActivityAttemptRequest type to internal
+func ToHistoryResetActivityAttemptRequest(t *history.ResetActivityAttemptRequest) *types.HistoryResetActivityAttemptRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryResetActivityAttemptRequest{
+		DomainUUID:   t.GetDomainUUID(),
+		ResetRequest: ToResetActivityAttemptRequest(t.ResetRequest),
+	}
+}
+
 // FromHistoryResetStickyTaskListRequest converts internal ResetStickyTaskListRequest type to thrift
 func FromHistoryResetStickyTaskListRequest(t *types.HistoryResetStickyTaskListRequest) *history.ResetStickyTaskListRequest {
 	if t == nil {
@@ -1022,6 +1116,28 @@ func ToHistoryRespondDecisionTaskFailedRequest(t *history.RespondDecisionTaskFai
 	}
 }
 
+// FromHistoryRetryActivityNowRequest converts internal RetryActivityNowRequest type to thrift
+func FromHistoryRetryActivityNowRequest(t *types.HistoryRetryActivityNowRequest) *history.RetryActivityNowRequest {
+	if t == nil {
+		return nil
+	}
+	return &history.RetryActivityNowRequest{
+		DomainUUID:   &t.DomainUUID,
+		RetryRequest: FromRetryActivityNowRequest(t.RetryRequest),
+	}
+}
+
+// ToHistoryRetryActivityNowRequest converts thrift RetryActivityNowRequest type to internal
+func ToHistoryRetryActivityNowRequest(t *history.RetryActivityNowRequest) *types.HistoryRetryActivityNowRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryRetryActivityNowRequest{
+		DomainUUID:   t.GetDomainUUID(),
+		RetryRequest: ToRetryActivityNowRequest(t.RetryRequest),
+	}
+}
+
 // FromHistoryScheduleDecisionTaskRequest converts internal ScheduleDecisionTaskRequest type to thrift
 func FromHistoryScheduleDecisionTaskRequest(t *types.ScheduleDecisionTaskRequest) *history.ScheduleDecisionTaskRequest {
 	if t == nil {
@@ -1180,6 +1296,7 @@ func FromHistorySyncActivityRequest(t *types.SyncActivityRequest) *history.SyncA
 		LastFailureDetails: t.LastFailureDetails,
 		LastFailureOptions: FromFailureOptions(t.LastFailureOptions),
 		VersionHistory:     FromVersionHistory(t.VersionHistory),
+		Paused:             &t.Paused,
 	}
 }
 
@@ -1205,6 +1322,7 @@ func ToHistorySyncActivityRequest(t *history.SyncActivityRequest) *types.SyncAct
 		LastFailureDetails: t.LastFailureDetails,
 		LastFailureOptions: ToFailureOptions(t.LastFailureOptions),
 		VersionHistory:     ToVersionHistory(t.VersionHistory),
+		Paused:             t.GetPaused(),
 	}
 }
 
@@ -1258,6 +1376,94 @@ func ToHistoryTerminateWorkflowExecutionRequest(t *history.TerminateWorkflowExec
 	}
 }
 
+// FromHistoryUnpauseActivityRequest converts internal UnpauseActivityRequest type to thrift
+func FromHistoryUnpauseActivityRequest(t *types.HistoryUnpauseActivityRequest) *history.UnpauseActivityRequest {
+	if t == nil {
+		return nil
+	}
+	return &history.UnpauseActivityRequest{
+		DomainUUID:     &t.DomainUUID,
+		UnpauseRequest: FromUnpauseActivityRequest(t.UnpauseRequest),
+	}
+}
+
+// ToHistoryUnpauseActivityRequest converts thrift UnpauseActivityRequest type to internal
+func ToHistoryUnpauseActivityRequest(t *history.UnpauseActivityRequest) *types.HistoryUnpauseActivityRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryUnpauseActivityRequest{
+		DomainUUID:     t.GetDomainUUID(),
+		UnpauseRequest: ToUnpauseActivityRequest(t.UnpauseRequest),
+	}
+}
+
+// FromHistoryUnpauseWorkflowExecutionRequest converts internal UnpauseWorkflowExecutionRequest type to thrift
+func FromHistoryUnpauseWorkflowExecutionRequest(t *types.HistoryUnpauseWorkflowExecutionRequest) *history.UnpauseWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &history.UnpauseWorkflowExecutionRequest{
+		DomainUUID:     &t.DomainUUID,
+		UnpauseRequest: FromUnpauseWorkflowExecutionRequest(t.UnpauseRequest),
+	}
+}
+
+// ToHistoryUnpauseWorkflowExecutionRequest converts thrift UnpauseWorkflowExecutionRequest type to internal
+func ToHistoryUnpauseWorkflowExecutionRequest(t *history.UnpauseWorkflowExecutionRequest) *types.HistoryUnpauseWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryUnpauseWorkflowExecutionRequest{
+		DomainUUID:     t.GetDomainUUID(),
+		UnpauseRequest: ToUnpauseWorkflowExecutionRequest(t.UnpauseRequest),
+	}
+}
+
+// FromHistoryUpdateWorkflowExecutionRequest converts internal UpdateWorkflowExecutionRequest type to thrift
+func FromHistoryUpdateWorkflowExecutionRequest(t *types.HistoryUpdateWorkflowExecutionRequest) *history.UpdateWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &history.UpdateWorkflowExecutionRequest{
+		DomainUUID:    &t.DomainUUID,
+		UpdateRequest: FromUpdateWorkflowExecutionRequest(t.UpdateRequest),
+	}
+}
+
+// ToHistoryUpdateWorkflowExecutionRequest converts thrift UpdateWorkflowExecutionRequest type to internal
+func ToHistoryUpdateWorkflowExecutionRequest(t *history.UpdateWorkflowExecutionRequest) *types.HistoryUpdateWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryUpdateWorkflowExecutionRequest{
+		DomainUUID:    t.GetDomainUUID(),
+		UpdateRequest: ToUpdateWorkflowExecutionRequest(t.UpdateRequest),
+	}
+}
+
+// FromHistoryUpsertWorkflowSearchAttributesRequest converts internal UpsertWorkflowSearchAttributesRequest type to thrift
+func FromHistoryUpsertWorkflowSearchAttributesRequest(t *types.HistoryUpsertWorkflowSearchAttributesRequest) *history.UpsertWorkflowSearchAttributesRequest {
+	if t == nil {
+		return nil
+	}
+	return &history.UpsertWorkflowSearchAttributesRequest{
+		DomainUUID: &t.DomainUUID,
+		Request:    FromAdminUpsertWorkflowSearchAttributesRequest(t.Request),
+	}
+}
+
+// ToHistoryUpsertWorkflowSearchAttributesRequest converts thrift UpsertWorkflowSearchAttributesRequest type to internal
+func ToHistoryUpsertWorkflowSearchAttributesRequest(t *history.UpsertWorkflowSearchAttributesRequest) *types.HistoryUpsertWorkflowSearchAttributesRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryUpsertWorkflowSearchAttributesRequest{
+		DomainUUID: t.GetDomainUUID(),
+		Request:    ToAdminUpsertWorkflowSearchAttributesRequest(t.Request),
+	}
+}
+
 // FromFailoverMarkerTokenArray converts internal FailoverMarkerToken type array to thrift
 func FromFailoverMarkerTokenArray(t []*types.FailoverMarkerToken) []*history.FailoverMarkerToken {
 	if t == nil {
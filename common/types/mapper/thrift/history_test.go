@@ -40,6 +40,62 @@ func TestHistoryTerminateWorkflowExecutionRequestConversion(t *testing.T) {
 	}
 }
 
+func TestHistoryUnpauseActivityRequestConversion(t *testing.T) {
+	testCases := []*types.HistoryUnpauseActivityRequest{
+		nil,
+		{},
+		&testdata.HistoryUnpauseActivityRequest,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromHistoryUnpauseActivityRequest(original)
+		roundTripObj := ToHistoryUnpauseActivityRequest(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestHistoryUnpauseWorkflowExecutionRequestConversion(t *testing.T) {
+	testCases := []*types.HistoryUnpauseWorkflowExecutionRequest{
+		nil,
+		{},
+		&testdata.HistoryUnpauseWorkflowExecutionRequest,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromHistoryUnpauseWorkflowExecutionRequest(original)
+		roundTripObj := ToHistoryUnpauseWorkflowExecutionRequest(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestHistoryUpdateWorkflowExecutionRequestConversion(t *testing.T) {
+	testCases := []*types.HistoryUpdateWorkflowExecutionRequest{
+		nil,
+		{},
+		&testdata.HistoryUpdateWorkflowExecutionRequest,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromHistoryUpdateWorkflowExecutionRequest(original)
+		roundTripObj := ToHistoryUpdateWorkflowExecutionRequest(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestHistoryUpsertWorkflowSearchAttributesRequestConversion(t *testing.T) {
+	testCases := []*types.HistoryUpsertWorkflowSearchAttributesRequest{
+		nil,
+		{},
+		&testdata.HistoryUpsertWorkflowSearchAttributesRequest,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromHistoryUpsertWorkflowSearchAttributesRequest(original)
+		roundTripObj := ToHistoryUpsertWorkflowSearchAttributesRequest(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
 func TestDescribeMutableStateRequestConversion(t *testing.T) {
 	testCases := []*types.DescribeMutableStateRequest{
 		nil,
@@ -82,6 +138,34 @@ func TestHistoryDescribeWorkflowExecutionRequestConversion(t *testing.T) {
 	}
 }
 
+func TestHistoryPauseActivityRequestConversion(t *testing.T) {
+	testCases := []*types.HistoryPauseActivityRequest{
+		nil,
+		{},
+		&testdata.HistoryPauseActivityRequest,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromHistoryPauseActivityRequest(original)
+		roundTripObj := ToHistoryPauseActivityRequest(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestHistoryPauseWorkflowExecutionRequestConversion(t *testing.T) {
+	testCases := []*types.HistoryPauseWorkflowExecutionRequest{
+		nil,
+		{},
+		&testdata.HistoryPauseWorkflowExecutionRequest,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromHistoryPauseWorkflowExecutionRequest(original)
+		roundTripObj := ToHistoryPauseWorkflowExecutionRequest(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
 func TestDomainFilterConversion(t *testing.T) {
 	testCases := []*types.DomainFilter{
 		nil,
@@ -152,6 +236,20 @@ func TestGetMutableStateResponseConversion(t *testing.T) {
 	}
 }
 
+func TestHistoryDeleteWorkflowExecutionRequestConversion(t *testing.T) {
+	testCases := []*types.HistoryDeleteWorkflowExecutionRequest{
+		nil,
+		{},
+		&testdata.HistoryDeleteWorkflowExecutionRequest,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromHistoryDeleteWorkflowExecutionRequest(original)
+		roundTripObj := ToHistoryDeleteWorkflowExecutionRequest(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
 func TestNotifyFailoverMarkersRequestConversion(t *testing.T) {
 	testCases := []*types.NotifyFailoverMarkersRequest{
 		nil,
@@ -432,6 +530,20 @@ func TestHistoryRequestCancelWorkflowExecutionRequestConversion(t *testing.T) {
 	}
 }
 
+func TestHistoryResetActivityAttemptRequestConversion(t *testing.T) {
+	testCases := []*types.HistoryResetActivityAttemptRequest{
+		nil,
+		{},
+		&testdata.HistoryResetActivityAttemptRequest,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromHistoryResetActivityAttemptRequest(original)
+		roundTripObj := ToHistoryResetActivityAttemptRequest(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
 func TestHistoryResetStickyTaskListRequestConversion(t *testing.T) {
 	testCases := []*types.HistoryResetStickyTaskListRequest{
 		nil,
@@ -560,6 +672,20 @@ func TestHistoryRespondDecisionTaskFailedRequestConversion(t *testing.T) {
 	}
 }
 
+func TestHistoryRetryActivityNowRequestConversion(t *testing.T) {
+	testCases := []*types.HistoryRetryActivityNowRequest{
+		nil,
+		{},
+		&testdata.HistoryRetryActivityNowRequest,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromHistoryRetryActivityNowRequest(original)
+		roundTripObj := ToHistoryRetryActivityNowRequest(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
 func TestScheduleDecisionTaskRequestConversion(t *testing.T) {
 	testCases := []*types.ScheduleDecisionTaskRequest{
 		nil,
@@ -650,6 +776,7 @@ func TestSyncActivityRequestConversion(t *testing.T) {
 			LastWorkerIdentity: "test-last-worker-identity",
 			LastFailureDetails: []byte("test-last-failure-details"),
 			VersionHistory:     &testdata.VersionHistory,
+			Paused:             true,
 		},
 	}
 
@@ -57,6 +57,62 @@ func ToDLQType(t *replicator.DLQType) *types.DLQType {
 	panic("unexpected enum value")
 }
 
+// FromDeleteWorkflowExecutionTaskAttributes converts internal DeleteWorkflowExecutionTaskAttributes type to thrift
+func FromDeleteWorkflowExecutionTaskAttributes(t *types.DeleteWorkflowExecutionTaskAttributes) *replicator.DeleteWorkflowExecutionTaskAttributes {
+	if t == nil {
+		return nil
+	}
+	return &replicator.DeleteWorkflowExecutionTaskAttributes{
+		DomainId:   &t.DomainID,
+		WorkflowId: &t.WorkflowID,
+		RunId:      &t.RunID,
+		Version:    &t.Version,
+	}
+}
+
+// ToDeleteWorkflowExecutionTaskAttributes converts thrift DeleteWorkflowExecutionTaskAttributes type to internal
+func ToDeleteWorkflowExecutionTaskAttributes(t *replicator.DeleteWorkflowExecutionTaskAttributes) *types.DeleteWorkflowExecutionTaskAttributes {
+	if t == nil {
+		return nil
+	}
+	return &types.DeleteWorkflowExecutionTaskAttributes{
+		DomainID:   t.GetDomainId(),
+		WorkflowID: t.GetWorkflowId(),
+		RunID:      t.GetRunId(),
+		Version:    t.GetVersion(),
+	}
+}
+
+// FromUpsertWorkflowSearchAttributesTaskAttributes converts internal UpsertWorkflowSearchAttributesTaskAttributes type to thrift
+func FromUpsertWorkflowSearchAttributesTaskAttributes(t *types.UpsertWorkflowSearchAttributesTaskAttributes) *replicator.UpsertWorkflowSearchAttributesTaskAttributes {
+	if t == nil {
+		return nil
+	}
+	return &replicator.UpsertWorkflowSearchAttributesTaskAttributes{
+		DomainId:         &t.DomainID,
+		WorkflowId:       &t.WorkflowID,
+		RunId:            &t.RunID,
+		Version:          &t.Version,
+		SearchAttributes: FromSearchAttributes(t.SearchAttributes),
+		Memo:             FromMemo(t.Memo),
+	}
+}
+
+// ToUpsertWorkflowSearchAttributesTaskAttributes converts thrift UpsertWorkflowSearchAttributesTaskAttributes type to internal
+func ToUpsertWorkflowSearchAttributesTaskAttributes(t *replicator.UpsertWorkflowSearchAttributesTaskAttributes) *types.UpsertWorkflowSearchAttributesTaskAttributes {
+	if t == nil {
+		return nil
+	}
+	return &types.UpsertWorkflowSearchAttributesTaskAttributes{
+		DomainID:         t.GetDomainId(),
+		WorkflowID:       t.GetWorkflowId(),
+		RunID:            t.GetRunId(),
+		Version:          t.GetVersion(),
+		SearchAttributes: ToSearchAttributes(t.SearchAttributes),
+		Memo:             ToMemo(t.Memo),
+	}
+}
+
 // FromDomainOperation converts internal DomainOperation type to thrift
 func FromDomainOperation(t *types.DomainOperation) *replicator.DomainOperation {
 	if t == nil {
@@ -501,6 +557,9 @@ func FromReplicationTask(t *types.ReplicationTask) *replicator.ReplicationTask {
 		HistoryTaskV2Attributes:       FromHistoryTaskV2Attributes(t.HistoryTaskV2Attributes),
 		FailoverMarkerAttributes:      FromFailoverMarkerAttributes(t.FailoverMarkerAttributes),
 		CreationTime:                  t.CreationTime,
+
+		DeleteWorkflowExecutionTaskAttributes:        FromDeleteWorkflowExecutionTaskAttributes(t.DeleteWorkflowExecutionTaskAttributes),
+		UpsertWorkflowSearchAttributesTaskAttributes: FromUpsertWorkflowSearchAttributesTaskAttributes(t.UpsertWorkflowSearchAttributesTaskAttributes),
 	}
 }
 
@@ -518,6 +577,9 @@ func ToReplicationTask(t *replicator.ReplicationTask) *types.ReplicationTask {
 		HistoryTaskV2Attributes:       ToHistoryTaskV2Attributes(t.HistoryTaskV2Attributes),
 		FailoverMarkerAttributes:      ToFailoverMarkerAttributes(t.FailoverMarkerAttributes),
 		CreationTime:                  t.CreationTime,
+
+		DeleteWorkflowExecutionTaskAttributes:        ToDeleteWorkflowExecutionTaskAttributes(t.DeleteWorkflowExecutionTaskAttributes),
+		UpsertWorkflowSearchAttributesTaskAttributes: ToUpsertWorkflowSearchAttributesTaskAttributes(t.UpsertWorkflowSearchAttributesTaskAttributes),
 	}
 }
 
@@ -584,6 +646,12 @@ func FromReplicationTaskType(t *types.ReplicationTaskType) *replicator.Replicati
 	case types.ReplicationTaskTypeFailoverMarker:
 		v := replicator.ReplicationTaskTypeFailoverMarker
 		return &v
+	case types.ReplicationTaskTypeDeleteWorkflowExecution:
+		v := replicator.ReplicationTaskTypeDeleteWorkflowExecution
+		return &v
+	case types.ReplicationTaskTypeUpsertWorkflowSearchAttributes:
+		v := replicator.ReplicationTaskTypeUpsertWorkflowSearchAttributes
+		return &v
 	}
 	panic("unexpected enum value")
 }
@@ -615,6 +683,12 @@ func ToReplicationTaskType(t *replicator.ReplicationTaskType) *types.Replication
 	case replicator.ReplicationTaskTypeFailoverMarker:
 		v := types.ReplicationTaskTypeFailoverMarker
 		return &v
+	case replicator.ReplicationTaskTypeDeleteWorkflowExecution:
+		v := types.ReplicationTaskTypeDeleteWorkflowExecution
+		return &v
+	case replicator.ReplicationTaskTypeUpsertWorkflowSearchAttributes:
+		v := types.ReplicationTaskTypeUpsertWorkflowSearchAttributes
+		return &v
 	}
 	panic("unexpected enum value")
 }
@@ -665,6 +739,7 @@ func FromSyncActivityTaskAttributes(t *types.SyncActivityTaskAttributes) *replic
 		LastFailureDetails: t.LastFailureDetails,
 		LastFailureOptions: FromFailureOptions(t.LastFailureOptions),
 		VersionHistory:     FromVersionHistory(t.VersionHistory),
+		Paused:             &t.Paused,
 	}
 }
 
@@ -690,6 +765,7 @@ func ToSyncActivityTaskAttributes(t *replicator.SyncActivityTaskAttributes) *typ
 		LastFailureDetails: t.LastFailureDetails,
 		LastFailureOptions: ToFailureOptions(t.LastFailureOptions),
 		VersionHistory:     ToVersionHistory(t.VersionHistory),
+		Paused:             t.GetPaused(),
 	}
 }
 
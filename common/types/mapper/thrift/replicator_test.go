@@ -633,6 +633,10 @@ func TestSyncActivityTaskAttributes(t *testing.T) {
 			desc:  "non-nil input test",
 			input: &testdata.SyncActivityTaskAttributes,
 		},
+		{
+			desc:  "paused activity test",
+			input: &types.SyncActivityTaskAttributes{ScheduledID: 5, Paused: true},
+		},
 		{
 			desc:  "empty input test",
 			input: &types.SyncActivityTaskAttributes{},
@@ -59,10 +59,13 @@ func FromScheduleSpec(t *types.ScheduleSpec) *shared.ScheduleSpec {
 		return nil
 	}
 	return &shared.ScheduleSpec{
-		CronExpression:  common.StringPtr(t.CronExpression),
-		StartTimeNano:   timeValToNano(t.StartTime),
-		EndTimeNano:     timeValToNano(t.EndTime),
-		JitterInSeconds: durationToSeconds(t.Jitter),
+		CronExpression:   common.StringPtr(t.CronExpression),
+		StartTimeNano:    timeValToNano(t.StartTime),
+		EndTimeNano:      timeValToNano(t.EndTime),
+		JitterInSeconds:  durationToSeconds(t.Jitter),
+		Intervals:        fromScheduleIntervalSpecSlice(t.Intervals),
+		Calendars:        fromScheduleCalendarSpecSlice(t.Calendars),
+		ExcludeCalendars: fromScheduleCalendarSpecSlice(t.ExcludeCalendars),
 	}
 }
 
@@ -71,10 +74,67 @@ func ToScheduleSpec(t *shared.ScheduleSpec) *types.ScheduleSpec {
 		return nil
 	}
 	return &types.ScheduleSpec{
-		CronExpression: t.GetCronExpression(),
-		StartTime:      nanoToTimeVal(t.StartTimeNano),
-		EndTime:        nanoToTimeVal(t.EndTimeNano),
-		Jitter:         secondsToDuration(t.JitterInSeconds),
+		CronExpression:   t.GetCronExpression(),
+		StartTime:        nanoToTimeVal(t.StartTimeNano),
+		EndTime:          nanoToTimeVal(t.EndTimeNano),
+		Jitter:           secondsToDuration(t.JitterInSeconds),
+		Intervals:        toScheduleIntervalSpecSlice(t.Intervals),
+		Calendars:        toScheduleCalendarSpecSlice(t.Calendars),
+		ExcludeCalendars: toScheduleCalendarSpecSlice(t.ExcludeCalendars),
+	}
+}
+
+func FromScheduleIntervalSpec(t *types.ScheduleIntervalSpec) *shared.ScheduleIntervalSpec {
+	if t == nil {
+		return nil
+	}
+	return &shared.ScheduleIntervalSpec{
+		IntervalInSeconds: durationToSeconds(t.Interval),
+		OffsetInSeconds:   durationToSeconds(t.Offset),
+	}
+}
+
+func ToScheduleIntervalSpec(t *shared.ScheduleIntervalSpec) *types.ScheduleIntervalSpec {
+	if t == nil {
+		return nil
+	}
+	return &types.ScheduleIntervalSpec{
+		Interval: secondsToDuration(t.IntervalInSeconds),
+		Offset:   secondsToDuration(t.OffsetInSeconds),
+	}
+}
+
+func FromScheduleCalendarSpec(t *types.ScheduleCalendarSpec) *shared.ScheduleCalendarSpec {
+	if t == nil {
+		return nil
+	}
+	return &shared.ScheduleCalendarSpec{
+		Second:     common.StringPtr(t.Second),
+		Minute:     common.StringPtr(t.Minute),
+		Hour:       common.StringPtr(t.Hour),
+		DayOfMonth: common.StringPtr(t.DayOfMonth),
+		Month:      common.StringPtr(t.Month),
+		DayOfWeek:  common.StringPtr(t.DayOfWeek),
+		Year:       common.StringPtr(t.Year),
+		TimeZone:   common.StringPtr(t.TimeZone),
+		Comment:    common.StringPtr(t.Comment),
+	}
+}
+
+func ToScheduleCalendarSpec(t *shared.ScheduleCalendarSpec) *types.ScheduleCalendarSpec {
+	if t == nil {
+		return nil
+	}
+	return &types.ScheduleCalendarSpec{
+		Second:     t.GetSecond(),
+		Minute:     t.GetMinute(),
+		Hour:       t.GetHour(),
+		DayOfMonth: t.GetDayOfMonth(),
+		Month:      t.GetMonth(),
+		DayOfWeek:  t.GetDayOfWeek(),
+		Year:       t.GetYear(),
+		TimeZone:   t.GetTimeZone(),
+		Comment:    t.GetComment(),
 	}
 }
 
@@ -112,12 +172,60 @@ func ToStartWorkflowAction(t *shared.ScheduleStartWorkflowAction) *types.StartWo
 	}
 }
 
+func FromSignalWorkflowAction(t *types.SignalWorkflowAction) *shared.ScheduleSignalWorkflowAction {
+	if t == nil {
+		return nil
+	}
+	return &shared.ScheduleSignalWorkflowAction{
+		WorkflowId:  common.StringPtr(t.WorkflowID),
+		SignalName:  common.StringPtr(t.SignalName),
+		SignalInput: t.SignalInput,
+	}
+}
+
+func ToSignalWorkflowAction(t *shared.ScheduleSignalWorkflowAction) *types.SignalWorkflowAction {
+	if t == nil {
+		return nil
+	}
+	return &types.SignalWorkflowAction{
+		WorkflowID:  t.GetWorkflowId(),
+		SignalName:  t.GetSignalName(),
+		SignalInput: t.SignalInput,
+	}
+}
+
+func FromSignalWithStartWorkflowAction(t *types.SignalWithStartWorkflowAction) *shared.ScheduleSignalWithStartWorkflowAction {
+	if t == nil {
+		return nil
+	}
+	return &shared.ScheduleSignalWithStartWorkflowAction{
+		WorkflowId:    common.StringPtr(t.WorkflowID),
+		SignalName:    common.StringPtr(t.SignalName),
+		SignalInput:   t.SignalInput,
+		StartWorkflow: FromStartWorkflowAction(t.StartWorkflow),
+	}
+}
+
+func ToSignalWithStartWorkflowAction(t *shared.ScheduleSignalWithStartWorkflowAction) *types.SignalWithStartWorkflowAction {
+	if t == nil {
+		return nil
+	}
+	return &types.SignalWithStartWorkflowAction{
+		WorkflowID:    t.GetWorkflowId(),
+		SignalName:    t.GetSignalName(),
+		SignalInput:   t.SignalInput,
+		StartWorkflow: ToStartWorkflowAction(t.StartWorkflow),
+	}
+}
+
 func FromScheduleAction(t *types.ScheduleAction) *shared.ScheduleAction {
 	if t == nil {
 		return nil
 	}
 	return &shared.ScheduleAction{
-		StartWorkflow: FromStartWorkflowAction(t.StartWorkflow),
+		StartWorkflow:           FromStartWorkflowAction(t.StartWorkflow),
+		SignalWorkflow:          FromSignalWorkflowAction(t.SignalWorkflow),
+		SignalWithStartWorkflow: FromSignalWithStartWorkflowAction(t.SignalWithStartWorkflow),
 	}
 }
 
@@ -126,7 +234,33 @@ func ToScheduleAction(t *shared.ScheduleAction) *types.ScheduleAction {
 		return nil
 	}
 	return &types.ScheduleAction{
-		StartWorkflow: ToStartWorkflowAction(t.StartWorkflow),
+		StartWorkflow:           ToStartWorkflowAction(t.StartWorkflow),
+		SignalWorkflow:          ToSignalWorkflowAction(t.SignalWorkflow),
+		SignalWithStartWorkflow: ToSignalWithStartWorkflowAction(t.SignalWithStartWorkflow),
+	}
+}
+
+func FromScheduleFailureBudget(t *types.ScheduleFailureBudget) *shared.ScheduleFailureBudget {
+	if t == nil {
+		return nil
+	}
+	return &shared.ScheduleFailureBudget{
+		MaxConsecutiveFailures:     common.Int32Ptr(t.MaxConsecutiveFailures),
+		MaxFailureRate:             common.Float64Ptr(t.MaxFailureRate),
+		FailureRateWindowInSeconds: durationToSeconds(t.FailureRateWindow),
+		MinRunsInWindow:            common.Int32Ptr(t.MinRunsInWindow),
+	}
+}
+
+func ToScheduleFailureBudget(t *shared.ScheduleFailureBudget) *types.ScheduleFailureBudget {
+	if t == nil {
+		return nil
+	}
+	return &types.ScheduleFailureBudget{
+		MaxConsecutiveFailures: t.GetMaxConsecutiveFailures(),
+		MaxFailureRate:         t.GetMaxFailureRate(),
+		FailureRateWindow:      secondsToDuration(t.FailureRateWindowInSeconds),
+		MinRunsInWindow:        t.GetMinRunsInWindow(),
 	}
 }
 
@@ -141,6 +275,7 @@ func FromSchedulePolicies(t *types.SchedulePolicies) *shared.SchedulePolicies {
 		PauseOnFailure:         common.BoolPtr(t.PauseOnFailure),
 		BufferLimit:            common.Int32Ptr(t.BufferLimit),
 		ConcurrencyLimit:       common.Int32Ptr(t.ConcurrencyLimit),
+		FailureBudget:          FromScheduleFailureBudget(t.FailureBudget),
 	}
 }
 
@@ -155,6 +290,7 @@ func ToSchedulePolicies(t *shared.SchedulePolicies) *types.SchedulePolicies {
 		PauseOnFailure:   t.GetPauseOnFailure(),
 		BufferLimit:      t.GetBufferLimit(),
 		ConcurrencyLimit: t.GetConcurrencyLimit(),
+		FailureBudget:    ToScheduleFailureBudget(t.FailureBudget),
 	}
 }
 
@@ -226,6 +362,34 @@ func ToBackfillInfo(t *shared.BackfillInfo) *types.BackfillInfo {
 	}
 }
 
+func FromScheduleActionResult(t *types.ScheduleActionResult) *shared.ScheduleActionResult {
+	if t == nil {
+		return nil
+	}
+	return &shared.ScheduleActionResult{
+		ScheduledTimeNano:   timeValToNano(t.ScheduledTime),
+		ActualStartTimeNano: timeValToNano(t.ActualStartTime),
+		WorkflowId:          common.StringPtr(t.WorkflowID),
+		RunId:               common.StringPtr(t.RunID),
+		TriggerSource:       common.StringPtr(t.TriggerSource),
+		CloseStatus:         FromWorkflowExecutionCloseStatus(t.CloseStatus),
+	}
+}
+
+func ToScheduleActionResult(t *shared.ScheduleActionResult) *types.ScheduleActionResult {
+	if t == nil {
+		return nil
+	}
+	return &types.ScheduleActionResult{
+		ScheduledTime:   nanoToTimeVal(t.ScheduledTimeNano),
+		ActualStartTime: nanoToTimeVal(t.ActualStartTimeNano),
+		WorkflowID:      t.GetWorkflowId(),
+		RunID:           t.GetRunId(),
+		TriggerSource:   t.GetTriggerSource(),
+		CloseStatus:     ToWorkflowExecutionCloseStatus(t.CloseStatus),
+	}
+}
+
 func FromScheduleInfo(t *types.ScheduleInfo) *shared.ScheduleInfo {
 	if t == nil {
 		return nil
@@ -241,6 +405,7 @@ func FromScheduleInfo(t *types.ScheduleInfo) *shared.ScheduleInfo {
 		OngoingBackfills:     fromBackfillInfoSlice(t.OngoingBackfills),
 		BufferedFireCount:    common.Int64Ptr(t.BufferedFireCount),
 		RunningWorkflowCount: common.Int64Ptr(t.RunningWorkflowCount),
+		RecentActions:        fromScheduleActionResultSlice(t.RecentActions),
 	}
 }
 
@@ -259,6 +424,7 @@ func ToScheduleInfo(t *shared.ScheduleInfo) *types.ScheduleInfo {
 		OngoingBackfills:     toBackfillInfoSlice(t.OngoingBackfills),
 		BufferedFireCount:    t.GetBufferedFireCount(),
 		RunningWorkflowCount: t.GetRunningWorkflowCount(),
+		RecentActions:        toScheduleActionResultSlice(t.RecentActions),
 	}
 }
 
@@ -552,6 +718,36 @@ func ToBackfillScheduleResponse(_ *shared.BackfillScheduleResponse) *types.Backf
 	return &types.BackfillScheduleResponse{}
 }
 
+func FromTriggerScheduleRequest(t *types.TriggerScheduleRequest) *shared.TriggerScheduleRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.TriggerScheduleRequest{
+		Domain:        common.StringPtr(t.Domain),
+		ScheduleId:    common.StringPtr(t.ScheduleID),
+		OverlapPolicy: FromScheduleOverlapPolicy(t.OverlapPolicy),
+	}
+}
+
+func ToTriggerScheduleRequest(t *shared.TriggerScheduleRequest) *types.TriggerScheduleRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.TriggerScheduleRequest{
+		Domain:        t.GetDomain(),
+		ScheduleID:    t.GetScheduleId(),
+		OverlapPolicy: ToScheduleOverlapPolicy(t.OverlapPolicy),
+	}
+}
+
+func FromTriggerScheduleResponse(_ *types.TriggerScheduleResponse) *shared.TriggerScheduleResponse {
+	return &shared.TriggerScheduleResponse{}
+}
+
+func ToTriggerScheduleResponse(_ *shared.TriggerScheduleResponse) *types.TriggerScheduleResponse {
+	return &types.TriggerScheduleResponse{}
+}
+
 func FromListSchedulesRequest(t *types.ListSchedulesRequest) *shared.ListSchedulesRequest {
 	if t == nil {
 		return nil
@@ -639,3 +835,69 @@ func toScheduleListEntrySlice(t []*shared.ScheduleListEntry) []*types.ScheduleLi
 	}
 	return result
 }
+
+func fromScheduleIntervalSpecSlice(t []*types.ScheduleIntervalSpec) []*shared.ScheduleIntervalSpec {
+	if t == nil {
+		return nil
+	}
+	result := make([]*shared.ScheduleIntervalSpec, len(t))
+	for i, v := range t {
+		result[i] = FromScheduleIntervalSpec(v)
+	}
+	return result
+}
+
+func toScheduleIntervalSpecSlice(t []*shared.ScheduleIntervalSpec) []*types.ScheduleIntervalSpec {
+	if t == nil {
+		return nil
+	}
+	result := make([]*types.ScheduleIntervalSpec, len(t))
+	for i, v := range t {
+		result[i] = ToScheduleIntervalSpec(v)
+	}
+	return result
+}
+
+func fromScheduleCalendarSpecSlice(t []*types.ScheduleCalendarSpec) []*shared.ScheduleCalendarSpec {
+	if t == nil {
+		return nil
+	}
+	result := make([]*shared.ScheduleCalendarSpec, len(t))
+	for i, v := range t {
+		result[i] = FromScheduleCalendarSpec(v)
+	}
+	return result
+}
+
+func toScheduleCalendarSpecSlice(t []*shared.ScheduleCalendarSpec) []*types.ScheduleCalendarSpec {
+	if t == nil {
+		return nil
+	}
+	result := make([]*types.ScheduleCalendarSpec, len(t))
+	for i, v := range t {
+		result[i] = ToScheduleCalendarSpec(v)
+	}
+	return result
+}
+
+func fromScheduleActionResultSlice(t []*types.ScheduleActionResult) []*shared.ScheduleActionResult {
+	if t == nil {
+		return nil
+	}
+	result := make([]*shared.ScheduleActionResult, len(t))
+	for i, v := range t {
+		result[i] = FromScheduleActionResult(v)
+	}
+	return result
+}
+
+func toScheduleActionResultSlice(t []*shared.ScheduleActionResult) []*types.ScheduleActionResult {
+	if t == nil {
+		return nil
+	}
+	result := make([]*types.ScheduleActionResult, len(t))
+	for i, v := range t {
+		result[i] = ToScheduleActionResult(v)
+	}
+	return result
+}
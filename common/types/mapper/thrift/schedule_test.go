@@ -74,6 +74,22 @@ func TestToScheduleSpecNil(t *testing.T) {
 	assert.Nil(t, ToScheduleSpec(nil))
 }
 
+func TestFromScheduleIntervalSpecNil(t *testing.T) {
+	assert.Nil(t, FromScheduleIntervalSpec(nil))
+}
+
+func TestToScheduleIntervalSpecNil(t *testing.T) {
+	assert.Nil(t, ToScheduleIntervalSpec(nil))
+}
+
+func TestFromScheduleCalendarSpecNil(t *testing.T) {
+	assert.Nil(t, FromScheduleCalendarSpec(nil))
+}
+
+func TestToScheduleCalendarSpecNil(t *testing.T) {
+	assert.Nil(t, ToScheduleCalendarSpec(nil))
+}
+
 func TestFromStartWorkflowActionNil(t *testing.T) {
 	assert.Nil(t, FromStartWorkflowAction(nil))
 }
@@ -82,6 +98,22 @@ func TestToStartWorkflowActionNil(t *testing.T) {
 	assert.Nil(t, ToStartWorkflowAction(nil))
 }
 
+func TestFromSignalWorkflowActionNil(t *testing.T) {
+	assert.Nil(t, FromSignalWorkflowAction(nil))
+}
+
+func TestToSignalWorkflowActionNil(t *testing.T) {
+	assert.Nil(t, ToSignalWorkflowAction(nil))
+}
+
+func TestFromSignalWithStartWorkflowActionNil(t *testing.T) {
+	assert.Nil(t, FromSignalWithStartWorkflowAction(nil))
+}
+
+func TestToSignalWithStartWorkflowActionNil(t *testing.T) {
+	assert.Nil(t, ToSignalWithStartWorkflowAction(nil))
+}
+
 func TestFromScheduleActionNil(t *testing.T) {
 	assert.Nil(t, FromScheduleAction(nil))
 }
@@ -98,6 +130,14 @@ func TestToSchedulePoliciesNil(t *testing.T) {
 	assert.Nil(t, ToSchedulePolicies(nil))
 }
 
+func TestFromScheduleFailureBudgetNil(t *testing.T) {
+	assert.Nil(t, FromScheduleFailureBudget(nil))
+}
+
+func TestToScheduleFailureBudgetNil(t *testing.T) {
+	assert.Nil(t, ToScheduleFailureBudget(nil))
+}
+
 func TestFromSchedulePauseInfoNil(t *testing.T) {
 	assert.Nil(t, FromSchedulePauseInfo(nil))
 }
@@ -122,6 +162,14 @@ func TestToBackfillInfoNil(t *testing.T) {
 	assert.Nil(t, ToBackfillInfo(nil))
 }
 
+func TestFromScheduleActionResultNil(t *testing.T) {
+	assert.Nil(t, FromScheduleActionResult(nil))
+}
+
+func TestToScheduleActionResultNil(t *testing.T) {
+	assert.Nil(t, ToScheduleActionResult(nil))
+}
+
 func TestFromScheduleInfoNil(t *testing.T) {
 	assert.Nil(t, FromScheduleInfo(nil))
 }
@@ -146,6 +194,18 @@ func TestScheduleSpecConversion(t *testing.T) {
 	}
 }
 
+func TestScheduleIntervalSpecConversion(t *testing.T) {
+	for _, item := range []*types.ScheduleIntervalSpec{nil, {}, &testdata.ScheduleIntervalSpec} {
+		assert.Equal(t, item, ToScheduleIntervalSpec(FromScheduleIntervalSpec(item)))
+	}
+}
+
+func TestScheduleCalendarSpecConversion(t *testing.T) {
+	for _, item := range []*types.ScheduleCalendarSpec{nil, {}, &testdata.ScheduleCalendarSpec} {
+		assert.Equal(t, item, ToScheduleCalendarSpec(FromScheduleCalendarSpec(item)))
+	}
+}
+
 func TestSchedulePauseInfoConversion(t *testing.T) {
 	for _, item := range []*types.SchedulePauseInfo{nil, {}, &testdata.SchedulePauseInfoThrift} {
 		assert.Equal(t, item, ToSchedulePauseInfo(FromSchedulePauseInfo(item)))
@@ -158,6 +218,12 @@ func TestBackfillInfoConversion(t *testing.T) {
 	}
 }
 
+func TestScheduleActionResultConversion(t *testing.T) {
+	for _, item := range []*types.ScheduleActionResult{nil, {}, &testdata.ScheduleActionResultThrift} {
+		assert.Equal(t, item, ToScheduleActionResult(FromScheduleActionResult(item)))
+	}
+}
+
 func TestScheduleInfoConversion(t *testing.T) {
 	for _, item := range []*types.ScheduleInfo{nil, {}, &testdata.ScheduleInfoThrift} {
 		assert.Equal(t, item, ToScheduleInfo(FromScheduleInfo(item)))
@@ -182,12 +248,36 @@ func TestStartWorkflowActionConversion(t *testing.T) {
 	}
 }
 
+func TestSignalWorkflowActionConversion(t *testing.T) {
+	for _, item := range []*types.SignalWorkflowAction{nil, {}, &testdata.ScheduleSignalWorkflowAction} {
+		assert.Equal(t, item, ToSignalWorkflowAction(FromSignalWorkflowAction(item)))
+	}
+}
+
+func TestSignalWithStartWorkflowActionConversion(t *testing.T) {
+	for _, item := range []*types.SignalWithStartWorkflowAction{nil, {}, &testdata.ScheduleSignalWithStartWorkflowAction} {
+		assert.Equal(t, item, ToSignalWithStartWorkflowAction(FromSignalWithStartWorkflowAction(item)))
+	}
+}
+
 func TestScheduleActionConversion(t *testing.T) {
-	for _, item := range []*types.ScheduleAction{nil, {}, &testdata.ScheduleAction} {
+	for _, item := range []*types.ScheduleAction{
+		nil,
+		{},
+		&testdata.ScheduleAction,
+		{SignalWorkflow: &testdata.ScheduleSignalWorkflowAction},
+		{SignalWithStartWorkflow: &testdata.ScheduleSignalWithStartWorkflowAction},
+	} {
 		assert.Equal(t, item, ToScheduleAction(FromScheduleAction(item)))
 	}
 }
 
+func TestScheduleFailureBudgetConversion(t *testing.T) {
+	for _, item := range []*types.ScheduleFailureBudget{nil, {}, &testdata.ScheduleFailureBudget} {
+		assert.Equal(t, item, ToScheduleFailureBudget(FromScheduleFailureBudget(item)))
+	}
+}
+
 func TestSchedulePoliciesConversion(t *testing.T) {
 	for _, item := range []*types.SchedulePolicies{
 		nil,
@@ -197,6 +287,7 @@ func TestSchedulePoliciesConversion(t *testing.T) {
 		// those two states distinguishable end-to-end.
 		{BufferLimit: 0, ConcurrencyLimit: 0},
 		&testdata.SchedulePolicies,
+		{PauseOnFailure: true, FailureBudget: &testdata.ScheduleFailureBudget},
 	} {
 		assert.Equal(t, item, ToSchedulePolicies(FromSchedulePolicies(item)))
 	}
@@ -256,6 +347,12 @@ func TestBackfillScheduleRequestConversion(t *testing.T) {
 	}
 }
 
+func TestTriggerScheduleRequestConversion(t *testing.T) {
+	for _, item := range []*types.TriggerScheduleRequest{nil, {}, &testdata.TriggerScheduleRequest} {
+		assert.Equal(t, item, ToTriggerScheduleRequest(FromTriggerScheduleRequest(item)))
+	}
+}
+
 func TestListSchedulesRequestConversion(t *testing.T) {
 	for _, item := range []*types.ListSchedulesRequest{nil, {}, &testdata.ListSchedulesRequest} {
 		assert.Equal(t, item, ToListSchedulesRequest(FromListSchedulesRequest(item)))
@@ -295,6 +392,11 @@ func TestFromBackfillScheduleResponse(t *testing.T) {
 	assert.NotNil(t, FromBackfillScheduleResponse(&types.BackfillScheduleResponse{}))
 }
 
+func TestFromTriggerScheduleResponse(t *testing.T) {
+	assert.NotNil(t, FromTriggerScheduleResponse(nil))
+	assert.NotNil(t, FromTriggerScheduleResponse(&types.TriggerScheduleResponse{}))
+}
+
 func TestToUpdateScheduleResponse(t *testing.T) {
 	assert.Equal(t, &types.UpdateScheduleResponse{}, ToUpdateScheduleResponse(nil))
 	assert.Equal(t, &types.UpdateScheduleResponse{}, ToUpdateScheduleResponse(&shared.UpdateScheduleResponse{}))
@@ -319,3 +421,8 @@ func TestToBackfillScheduleResponse(t *testing.T) {
 	assert.Equal(t, &types.BackfillScheduleResponse{}, ToBackfillScheduleResponse(nil))
 	assert.Equal(t, &types.BackfillScheduleResponse{}, ToBackfillScheduleResponse(&shared.BackfillScheduleResponse{}))
 }
+
+func TestToTriggerScheduleResponse(t *testing.T) {
+	assert.Equal(t, &types.TriggerScheduleResponse{}, ToTriggerScheduleResponse(nil))
+	assert.Equal(t, &types.TriggerScheduleResponse{}, ToTriggerScheduleResponse(&shared.TriggerScheduleResponse{}))
+}
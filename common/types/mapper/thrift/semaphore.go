@@ -0,0 +1,255 @@
+// Copyright (c) 2017-2024 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package thrift
+
+import (
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/types"
+)
+
+// --- Core Types ---
+
+func FromSemaphoreInfo(t *types.SemaphoreInfo) *shared.SemaphoreInfo {
+	if t == nil {
+		return nil
+	}
+	return &shared.SemaphoreInfo{
+		Name:            common.StringPtr(t.Name),
+		Size:            common.Int32Ptr(t.Size),
+		BucketSize:      common.Int32Ptr(t.BucketSize),
+		CreatedTimeNano: timeValToNano(t.CreatedTime),
+	}
+}
+
+func ToSemaphoreInfo(t *shared.SemaphoreInfo) *types.SemaphoreInfo {
+	if t == nil {
+		return nil
+	}
+	return &types.SemaphoreInfo{
+		Name:        t.GetName(),
+		Size:        t.GetSize(),
+		BucketSize:  t.GetBucketSize(),
+		CreatedTime: nanoToTimeVal(t.CreatedTimeNano),
+	}
+}
+
+func FromSemaphorePermitRequest(t *types.SemaphorePermitRequest) *shared.SemaphorePermitRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.SemaphorePermitRequest{
+		RequestId:         common.StringPtr(t.RequestID),
+		Execution:         FromWorkflowExecution(t.Execution),
+		Permits:           common.Int32Ptr(t.Permits),
+		RequestedTimeNano: timeValToNano(t.RequestedTime),
+		AcquiredTimeNano:  timeValToNano(t.AcquiredTime),
+	}
+}
+
+func ToSemaphorePermitRequest(t *shared.SemaphorePermitRequest) *types.SemaphorePermitRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.SemaphorePermitRequest{
+		RequestID:     t.GetRequestId(),
+		Execution:     ToWorkflowExecution(t.Execution),
+		Permits:       t.GetPermits(),
+		RequestedTime: nanoToTimeVal(t.RequestedTimeNano),
+		AcquiredTime:  nanoToTimeVal(t.AcquiredTimeNano),
+	}
+}
+
+// --- Request/Response Types ---
+
+func FromCreateSemaphoreRequest(t *types.CreateSemaphoreRequest) *shared.CreateSemaphoreRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.CreateSemaphoreRequest{
+		Domain:        common.StringPtr(t.Domain),
+		SemaphoreName: common.StringPtr(t.SemaphoreName),
+		Size:          common.Int32Ptr(t.Size),
+		BucketSize:    common.Int32Ptr(t.BucketSize),
+	}
+}
+
+func ToCreateSemaphoreRequest(t *shared.CreateSemaphoreRequest) *types.CreateSemaphoreRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.CreateSemaphoreRequest{
+		Domain:        t.GetDomain(),
+		SemaphoreName: t.GetSemaphoreName(),
+		Size:          t.GetSize(),
+		BucketSize:    t.GetBucketSize(),
+	}
+}
+
+func FromCreateSemaphoreResponse(t *types.CreateSemaphoreResponse) *shared.CreateSemaphoreResponse {
+	if t == nil {
+		return nil
+	}
+	return &shared.CreateSemaphoreResponse{
+		Semaphore: FromSemaphoreInfo(t.Semaphore),
+	}
+}
+
+func ToCreateSemaphoreResponse(t *shared.CreateSemaphoreResponse) *types.CreateSemaphoreResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.CreateSemaphoreResponse{
+		Semaphore: ToSemaphoreInfo(t.Semaphore),
+	}
+}
+
+func FromDescribeSemaphoreRequest(t *types.DescribeSemaphoreRequest) *shared.DescribeSemaphoreRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.DescribeSemaphoreRequest{
+		Domain:        common.StringPtr(t.Domain),
+		SemaphoreName: common.StringPtr(t.SemaphoreName),
+	}
+}
+
+func ToDescribeSemaphoreRequest(t *shared.DescribeSemaphoreRequest) *types.DescribeSemaphoreRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.DescribeSemaphoreRequest{
+		Domain:        t.GetDomain(),
+		SemaphoreName: t.GetSemaphoreName(),
+	}
+}
+
+func FromDescribeSemaphoreResponse(t *types.DescribeSemaphoreResponse) *shared.DescribeSemaphoreResponse {
+	if t == nil {
+		return nil
+	}
+	return &shared.DescribeSemaphoreResponse{
+		Semaphore:        FromSemaphoreInfo(t.Semaphore),
+		AvailablePermits: common.Int32Ptr(t.AvailablePermits),
+		Holders:          fromSemaphorePermitRequestSlice(t.Holders),
+		Waiters:          fromSemaphorePermitRequestSlice(t.Waiters),
+	}
+}
+
+func ToDescribeSemaphoreResponse(t *shared.DescribeSemaphoreResponse) *types.DescribeSemaphoreResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.DescribeSemaphoreResponse{
+		Semaphore:        ToSemaphoreInfo(t.Semaphore),
+		AvailablePermits: t.GetAvailablePermits(),
+		Holders:          toSemaphorePermitRequestSlice(t.Holders),
+		Waiters:          toSemaphorePermitRequestSlice(t.Waiters),
+	}
+}
+
+func FromListSemaphoresRequest(t *types.ListSemaphoresRequest) *shared.ListSemaphoresRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.ListSemaphoresRequest{
+		Domain:        common.StringPtr(t.Domain),
+		PageSize:      common.Int32Ptr(t.PageSize),
+		NextPageToken: t.NextPageToken,
+	}
+}
+
+func ToListSemaphoresRequest(t *shared.ListSemaphoresRequest) *types.ListSemaphoresRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.ListSemaphoresRequest{
+		Domain:        t.GetDomain(),
+		PageSize:      t.GetPageSize(),
+		NextPageToken: t.NextPageToken,
+	}
+}
+
+func FromListSemaphoresResponse(t *types.ListSemaphoresResponse) *shared.ListSemaphoresResponse {
+	if t == nil {
+		return nil
+	}
+	return &shared.ListSemaphoresResponse{
+		Semaphores:    fromSemaphoreInfoSlice(t.Semaphores),
+		NextPageToken: t.NextPageToken,
+	}
+}
+
+func ToListSemaphoresResponse(t *shared.ListSemaphoresResponse) *types.ListSemaphoresResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.ListSemaphoresResponse{
+		Semaphores:    toSemaphoreInfoSlice(t.Semaphores),
+		NextPageToken: t.NextPageToken,
+	}
+}
+
+// --- Slice helpers ---
+
+func fromSemaphoreInfoSlice(t []*types.SemaphoreInfo) []*shared.SemaphoreInfo {
+	if t == nil {
+		return nil
+	}
+	result := make([]*shared.SemaphoreInfo, len(t))
+	for i, v := range t {
+		result[i] = FromSemaphoreInfo(v)
+	}
+	return result
+}
+
+func toSemaphoreInfoSlice(t []*shared.SemaphoreInfo) []*types.SemaphoreInfo {
+	if t == nil {
+		return nil
+	}
+	result := make([]*types.SemaphoreInfo, len(t))
+	for i, v := range t {
+		result[i] = ToSemaphoreInfo(v)
+	}
+	return result
+}
+
+func fromSemaphorePermitRequestSlice(t []*types.SemaphorePermitRequest) []*shared.SemaphorePermitRequest {
+	if t == nil {
+		return nil
+	}
+	result := make([]*shared.SemaphorePermitRequest, len(t))
+	for i, v := range t {
+		result[i] = FromSemaphorePermitRequest(v)
+	}
+	return result
+}
+
+func toSemaphorePermitRequestSlice(t []*shared.SemaphorePermitRequest) []*types.SemaphorePermitRequest {
+	if t == nil {
+		return nil
+	}
+	result := make([]*types.SemaphorePermitRequest, len(t))
+	for i, v := range t {
+		result[i] = ToSemaphorePermitRequest(v)
+	}
+	return result
+}
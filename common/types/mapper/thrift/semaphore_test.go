@@ -0,0 +1,78 @@
+// Copyright (c) 2024 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package thrift
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/common/types/testdata"
+)
+
+func TestSemaphoreInfoConversion(t *testing.T) {
+	for _, item := range []*types.SemaphoreInfo{nil, {}, &testdata.SemaphoreInfo} {
+		assert.Equal(t, item, ToSemaphoreInfo(FromSemaphoreInfo(item)))
+	}
+}
+
+func TestSemaphorePermitRequestConversion(t *testing.T) {
+	for _, item := range []*types.SemaphorePermitRequest{nil, {}, &testdata.SemaphoreHolder, &testdata.SemaphoreWaiter} {
+		assert.Equal(t, item, ToSemaphorePermitRequest(FromSemaphorePermitRequest(item)))
+	}
+}
+
+func TestCreateSemaphoreRequestConversion(t *testing.T) {
+	for _, item := range []*types.CreateSemaphoreRequest{nil, {}, &testdata.CreateSemaphoreRequest} {
+		assert.Equal(t, item, ToCreateSemaphoreRequest(FromCreateSemaphoreRequest(item)))
+	}
+}
+
+func TestCreateSemaphoreResponseConversion(t *testing.T) {
+	for _, item := range []*types.CreateSemaphoreResponse{nil, {}, &testdata.CreateSemaphoreResponse} {
+		assert.Equal(t, item, ToCreateSemaphoreResponse(FromCreateSemaphoreResponse(item)))
+	}
+}
+
+func TestDescribeSemaphoreRequestConversion(t *testing.T) {
+	for _, item := range []*types.DescribeSemaphoreRequest{nil, {}, &testdata.DescribeSemaphoreRequest} {
+		assert.Equal(t, item, ToDescribeSemaphoreRequest(FromDescribeSemaphoreRequest(item)))
+	}
+}
+
+func TestDescribeSemaphoreResponseConversion(t *testing.T) {
+	for _, item := range []*types.DescribeSemaphoreResponse{nil, {}, &testdata.DescribeSemaphoreResponse} {
+		assert.Equal(t, item, ToDescribeSemaphoreResponse(FromDescribeSemaphoreResponse(item)))
+	}
+}
+
+func TestListSemaphoresRequestConversion(t *testing.T) {
+	for _, item := range []*types.ListSemaphoresRequest{nil, {}, &testdata.ListSemaphoresRequest} {
+		assert.Equal(t, item, ToListSemaphoresRequest(FromListSemaphoresRequest(item)))
+	}
+}
+
+func TestListSemaphoresResponseConversion(t *testing.T) {
+	for _, item := range []*types.ListSemaphoresResponse{nil, {}, &testdata.ListSemaphoresResponse} {
+		assert.Equal(t, item, ToListSemaphoresResponse(FromListSemaphoresResponse(item)))
+	}
+}
@@ -375,6 +375,52 @@ func ToActivityType(t *shared.ActivityType) *types.ActivityType {
 	}
 }
 
+// FromAggregateWorkflowExecutionsRequest converts internal AggregateWorkflowExecutionsRequest type to thrift
+func FromAggregateWorkflowExecutionsRequest(t *types.AggregateWorkflowExecutionsRequest) *shared.AggregateWorkflowExecutionsRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.AggregateWorkflowExecutionsRequest{
+		Domain:    &t.Domain,
+		Query:     &t.Query,
+		GroupBy:   &t.GroupBy,
+		MaxGroups: &t.MaxGroups,
+	}
+}
+
+// ToAggregateWorkflowExecutionsRequest converts thrift AggregateWorkflowExecutionsRequest type to internal
+func ToAggregateWorkflowExecutionsRequest(t *shared.AggregateWorkflowExecutionsRequest) *types.AggregateWorkflowExecutionsRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.AggregateWorkflowExecutionsRequest{
+		Domain:    t.GetDomain(),
+		Query:     t.GetQuery(),
+		GroupBy:   t.GetGroupBy(),
+		MaxGroups: t.GetMaxGroups(),
+	}
+}
+
+// FromAggregateWorkflowExecutionsResponse converts internal AggregateWorkflowExecutionsResponse type to thrift
+func FromAggregateWorkflowExecutionsResponse(t *types.AggregateWorkflowExecutionsResponse) *shared.AggregateWorkflowExecutionsResponse {
+	if t == nil {
+		return nil
+	}
+	return &shared.AggregateWorkflowExecutionsResponse{
+		Groups: FromWorkflowExecutionGroupArray(t.Groups),
+	}
+}
+
+// ToAggregateWorkflowExecutionsResponse converts thrift AggregateWorkflowExecutionsResponse type to internal
+func ToAggregateWorkflowExecutionsResponse(t *shared.AggregateWorkflowExecutionsResponse) *types.AggregateWorkflowExecutionsResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.AggregateWorkflowExecutionsResponse{
+		Groups: ToWorkflowExecutionGroupArray(t.Groups),
+	}
+}
+
 // FromArchivalStatus converts internal ArchivalStatus type to thrift
 func FromArchivalStatus(t *types.ArchivalStatus) *shared.ArchivalStatus {
 	if t == nil {
@@ -1603,6 +1649,32 @@ func ToDeleteDomainRequest(t *shared.DeleteDomainRequest) *types.DeleteDomainReq
 	}
 }
 
+// FromDeleteWorkflowExecutionRequest converts internal DeleteWorkflowExecutionRequest type to thrift
+func FromDeleteWorkflowExecutionRequest(t *types.DeleteWorkflowExecutionRequest) *shared.DeleteWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.DeleteWorkflowExecutionRequest{
+		Domain:            &t.Domain,
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		Reason:            &t.Reason,
+		Identity:          &t.Identity,
+	}
+}
+
+// ToDeleteWorkflowExecutionRequest converts thrift DeleteWorkflowExecutionRequest type to internal
+func ToDeleteWorkflowExecutionRequest(t *shared.DeleteWorkflowExecutionRequest) *types.DeleteWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.DeleteWorkflowExecutionRequest{
+		Domain:            t.GetDomain(),
+		WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+		Reason:            t.GetReason(),
+		Identity:          t.GetIdentity(),
+	}
+}
+
 // FromDeprecateDomainRequest converts internal DeprecateDomainRequest type to thrift
 func FromDeprecateDomainRequest(t *types.DeprecateDomainRequest) *shared.DeprecateDomainRequest {
 	if t == nil {
@@ -2543,6 +2615,12 @@ func FromEventType(t *types.EventType) *shared.EventType {
 	case types.EventTypeUpsertWorkflowSearchAttributes:
 		v := shared.EventTypeUpsertWorkflowSearchAttributes
 		return &v
+	case types.EventTypeWorkflowExecutionPaused:
+		v := shared.EventTypeWorkflowExecutionPaused
+		return &v
+	case types.EventTypeWorkflowExecutionUnpaused:
+		v := shared.EventTypeWorkflowExecutionUnpaused
+		return &v
 	}
 	panic("unexpected enum value")
 }
@@ -2679,6 +2757,12 @@ func ToEventType(t *shared.EventType) *types.EventType {
 	case shared.EventTypeUpsertWorkflowSearchAttributes:
 		v := types.EventTypeUpsertWorkflowSearchAttributes
 		return &v
+	case shared.EventTypeWorkflowExecutionPaused:
+		v := types.EventTypeWorkflowExecutionPaused
+		return &v
+	case shared.EventTypeWorkflowExecutionUnpaused:
+		v := types.EventTypeWorkflowExecutionUnpaused
+		return &v
 	}
 	panic("unexpected enum value")
 }
@@ -2976,6 +3060,8 @@ func FromHistoryEvent(t *types.HistoryEvent) *shared.HistoryEvent {
 		SignalExternalWorkflowExecutionFailedEventAttributes:           FromSignalExternalWorkflowExecutionFailedEventAttributes(t.SignalExternalWorkflowExecutionFailedEventAttributes),
 		ExternalWorkflowExecutionSignaledEventAttributes:               FromExternalWorkflowExecutionSignaledEventAttributes(t.ExternalWorkflowExecutionSignaledEventAttributes),
 		UpsertWorkflowSearchAttributesEventAttributes:                  FromUpsertWorkflowSearchAttributesEventAttributes(t.UpsertWorkflowSearchAttributesEventAttributes),
+		WorkflowExecutionPausedEventAttributes:                         FromWorkflowExecutionPausedEventAttributes(t.WorkflowExecutionPausedEventAttributes),
+		WorkflowExecutionUnpausedEventAttributes:                       FromWorkflowExecutionUnpausedEventAttributes(t.WorkflowExecutionUnpausedEventAttributes),
 	}
 }
 
@@ -3032,6 +3118,8 @@ func ToHistoryEvent(t *shared.HistoryEvent) *types.HistoryEvent {
 		SignalExternalWorkflowExecutionFailedEventAttributes:           ToSignalExternalWorkflowExecutionFailedEventAttributes(t.SignalExternalWorkflowExecutionFailedEventAttributes),
 		ExternalWorkflowExecutionSignaledEventAttributes:               ToExternalWorkflowExecutionSignaledEventAttributes(t.ExternalWorkflowExecutionSignaledEventAttributes),
 		UpsertWorkflowSearchAttributesEventAttributes:                  ToUpsertWorkflowSearchAttributesEventAttributes(t.UpsertWorkflowSearchAttributesEventAttributes),
+		WorkflowExecutionPausedEventAttributes:                         ToWorkflowExecutionPausedEventAttributes(t.WorkflowExecutionPausedEventAttributes),
+		WorkflowExecutionUnpausedEventAttributes:                       ToWorkflowExecutionUnpausedEventAttributes(t.WorkflowExecutionUnpausedEventAttributes),
 	}
 }
 
@@ -3835,6 +3923,60 @@ func ToParentClosePolicy(t *shared.ParentClosePolicy) *types.ParentClosePolicy {
 	panic("unexpected enum value")
 }
 
+// FromPauseActivityRequest converts internal PauseActivityRequest type to thrift
+func FromPauseActivityRequest(t *types.PauseActivityRequest) *shared.PauseActivityRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.PauseActivityRequest{
+		Domain:            &t.Domain,
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		ActivityId:        &t.ActivityID,
+		Identity:          &t.Identity,
+	}
+}
+
+// ToPauseActivityRequest converts thrift PauseActivityRequest type to internal
+func ToPauseActivityRequest(t *shared.PauseActivityRequest) *types.PauseActivityRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.PauseActivityRequest{
+		Domain:            t.GetDomain(),
+		WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+		ActivityID:        t.GetActivityId(),
+		Identity:          t.GetIdentity(),
+	}
+}
+
+// FromPauseWorkflowExecutionRequest converts internal PauseWorkflowExecutionRequest type to thrift
+func FromPauseWorkflowExecutionRequest(t *types.PauseWorkflowExecutionRequest) *shared.PauseWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.PauseWorkflowExecutionRequest{
+		Domain:            &t.Domain,
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		Reason:            &t.Reason,
+		Identity:          &t.Identity,
+		RequestId:         &t.RequestID,
+	}
+}
+
+// ToPauseWorkflowExecutionRequest converts thrift PauseWorkflowExecutionRequest type to internal
+func ToPauseWorkflowExecutionRequest(t *shared.PauseWorkflowExecutionRequest) *types.PauseWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.PauseWorkflowExecutionRequest{
+		Domain:            t.GetDomain(),
+		WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+		Reason:            t.GetReason(),
+		Identity:          t.GetIdentity(),
+		RequestID:         t.GetRequestId(),
+	}
+}
+
 // FromPendingActivityInfo converts internal PendingActivityInfo type to thrift
 func FromPendingActivityInfo(t *types.PendingActivityInfo) *shared.PendingActivityInfo {
 	if t == nil {
@@ -4813,6 +4955,32 @@ func ToRequestCancelWorkflowExecutionRequest(t *shared.RequestCancelWorkflowExec
 	}
 }
 
+// FromResetActivityAttemptRequest converts internal ResetActivityAttemptRequest type to thrift
+func FromResetActivityAttemptRequest(t *types.ResetActivityAttemptRequest) *shared.ResetActivityAttemptRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.ResetActivityAttemptRequest{
+		Domain:            &t.Domain,
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		ActivityId:        &t.ActivityID,
+		Identity:          &t.Identity,
+	}
+}
+
+// ToResetActivityAttemptRequest converts thrift ResetActivityAttemptRequest type to internal
+func ToResetActivityAttemptRequest(t *shared.ResetActivityAttemptRequest) *types.ResetActivityAttemptRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.ResetActivityAttemptRequest{
+		Domain:            t.GetDomain(),
+		WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+		ActivityID:        t.GetActivityId(),
+		Identity:          t.GetIdentity(),
+	}
+}
+
 // FromResetPointInfo converts internal ResetPointInfo type to thrift
 func FromResetPointInfo(t *types.ResetPointInfo) *shared.ResetPointInfo {
 	if t == nil {
@@ -5275,6 +5443,9 @@ func FromRetryPolicy(t *types.RetryPolicy) *shared.RetryPolicy {
 		MaximumAttempts:             &t.MaximumAttempts,
 		NonRetriableErrorReasons:    t.NonRetriableErrorReasons,
 		ExpirationIntervalInSeconds: &t.ExpirationIntervalInSeconds,
+		JitterCoefficient:           &t.JitterCoefficient,
+		RetryableErrorReasons:       t.RetryableErrorReasons,
+		ErrorReasonOverrides:        FromRetryPolicyOverrideArray(t.ErrorReasonOverrides),
 	}
 }
 
@@ -5290,7 +5461,62 @@ func ToRetryPolicy(t *shared.RetryPolicy) *types.RetryPolicy {
 		MaximumAttempts:             t.GetMaximumAttempts(),
 		NonRetriableErrorReasons:    t.NonRetriableErrorReasons,
 		ExpirationIntervalInSeconds: t.GetExpirationIntervalInSeconds(),
+		JitterCoefficient:           t.GetJitterCoefficient(),
+		RetryableErrorReasons:       t.RetryableErrorReasons,
+		ErrorReasonOverrides:        ToRetryPolicyOverrideArray(t.ErrorReasonOverrides),
+	}
+}
+
+// FromRetryPolicyOverride converts internal RetryPolicyOverride type to thrift
+func FromRetryPolicyOverride(t *types.RetryPolicyOverride) *shared.RetryPolicyOverride {
+	if t == nil {
+		return nil
+	}
+	return &shared.RetryPolicyOverride{
+		ReasonPattern:            &t.ReasonPattern,
+		InitialIntervalInSeconds: &t.InitialIntervalInSeconds,
+		BackoffCoefficient:       &t.BackoffCoefficient,
+		MaximumIntervalInSeconds: &t.MaximumIntervalInSeconds,
+		MaximumAttempts:          &t.MaximumAttempts,
+	}
+}
+
+// ToRetryPolicyOverride converts thrift RetryPolicyOverride type to internal
+func ToRetryPolicyOverride(t *shared.RetryPolicyOverride) *types.RetryPolicyOverride {
+	if t == nil {
+		return nil
 	}
+	return &types.RetryPolicyOverride{
+		ReasonPattern:            t.GetReasonPattern(),
+		InitialIntervalInSeconds: t.GetInitialIntervalInSeconds(),
+		BackoffCoefficient:       t.GetBackoffCoefficient(),
+		MaximumIntervalInSeconds: t.GetMaximumIntervalInSeconds(),
+		MaximumAttempts:          t.GetMaximumAttempts(),
+	}
+}
+
+// FromRetryPolicyOverrideArray converts internal RetryPolicyOverride type array to thrift
+func FromRetryPolicyOverrideArray(t []*types.RetryPolicyOverride) []*shared.RetryPolicyOverride {
+	if t == nil {
+		return nil
+	}
+	v := make([]*shared.RetryPolicyOverride, len(t))
+	for i := range t {
+		v[i] = FromRetryPolicyOverride(t[i])
+	}
+	return v
+}
+
+// ToRetryPolicyOverrideArray converts thrift RetryPolicyOverride type array to internal
+func ToRetryPolicyOverrideArray(t []*shared.RetryPolicyOverride) []*types.RetryPolicyOverride {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.RetryPolicyOverride, len(t))
+	for i := range t {
+		v[i] = ToRetryPolicyOverride(t[i])
+	}
+	return v
 }
 
 // FromRetryTaskV2Error converts internal RetryTaskV2Error type to thrift
@@ -5369,6 +5595,8 @@ func FromScheduleActivityTaskDecisionAttributes(t *types.ScheduleActivityTaskDec
 		RetryPolicy:                   FromRetryPolicy(t.RetryPolicy),
 		Header:                        FromHeader(t.Header),
 		RequestLocalDispatch:          &t.RequestLocalDispatch,
+		Priority:                      &t.Priority,
+		FairnessKey:                   &t.FairnessKey,
 	}
 }
 
@@ -5390,6 +5618,8 @@ func ToScheduleActivityTaskDecisionAttributes(t *shared.ScheduleActivityTaskDeci
 		RetryPolicy:                   ToRetryPolicy(t.RetryPolicy),
 		Header:                        ToHeader(t.Header),
 		RequestLocalDispatch:          t.GetRequestLocalDispatch(),
+		Priority:                      t.GetPriority(),
+		FairnessKey:                   t.GetFairnessKey(),
 	}
 }
 
@@ -5586,6 +5816,8 @@ func FromSignalWithStartWorkflowExecutionRequest(t *types.SignalWithStartWorkflo
 		FirstRunAtTimestamp:                 t.FirstRunAtTimestamp,
 		CronOverlapPolicy:                   FromCronOverlapPolicy(t.CronOverlapPolicy),
 		ActiveClusterSelectionPolicy:        FromActiveClusterSelectionPolicy(t.ActiveClusterSelectionPolicy),
+		Priority:                            &t.Priority,
+		FairnessKey:                         &t.FairnessKey,
 	}
 }
 
@@ -5616,6 +5848,8 @@ func ToSignalWithStartWorkflowExecutionRequest(t *shared.SignalWithStartWorkflow
 		FirstRunAtTimestamp:                 t.FirstRunAtTimestamp,
 		CronOverlapPolicy:                   ToCronOverlapPolicy(t.CronOverlapPolicy),
 		ActiveClusterSelectionPolicy:        ToActiveClusterSelectionPolicy(t.ActiveClusterSelectionPolicy),
+		Priority:                            t.GetPriority(),
+		FairnessKey:                         t.GetFairnessKey(),
 	}
 }
 
@@ -5930,6 +6164,8 @@ func FromStartWorkflowExecutionRequest(t *types.StartWorkflowExecutionRequest) *
 		FirstRunAtTimestamp:                 t.FirstRunAtTimeStamp,
 		CronOverlapPolicy:                   FromCronOverlapPolicy(t.CronOverlapPolicy),
 		ActiveClusterSelectionPolicy:        thriftPolicy,
+		Priority:                            &t.Priority,
+		FairnessKey:                         &t.FairnessKey,
 	}
 }
 
@@ -5959,6 +6195,8 @@ func ToStartWorkflowExecutionRequest(t *shared.StartWorkflowExecutionRequest) *t
 		FirstRunAtTimeStamp:                 t.FirstRunAtTimestamp,
 		CronOverlapPolicy:                   ToCronOverlapPolicy(t.CronOverlapPolicy),
 		ActiveClusterSelectionPolicy:        ToActiveClusterSelectionPolicy(t.ActiveClusterSelectionPolicy),
+		Priority:                            t.GetPriority(),
+		FairnessKey:                         t.GetFairnessKey(),
 	}
 }
 
@@ -6172,14 +6410,15 @@ func FromTaskListStatus(t *types.TaskListStatus) *shared.TaskListStatus {
 		return nil
 	}
 	return &shared.TaskListStatus{
-		BacklogCountHint:      &t.BacklogCountHint,
-		ReadLevel:             &t.ReadLevel,
-		AckLevel:              &t.AckLevel,
-		RatePerSecond:         &t.RatePerSecond,
-		TaskIDBlock:           FromTaskIDBlock(t.TaskIDBlock),
-		IsolationGroupMetrics: FromIsolationGroupMetricsMap(t.IsolationGroupMetrics),
-		NewTasksPerSecond:     &t.NewTasksPerSecond,
-		Empty:                 &t.Empty,
+		BacklogCountHint:         &t.BacklogCountHint,
+		ReadLevel:                &t.ReadLevel,
+		AckLevel:                 &t.AckLevel,
+		RatePerSecond:            &t.RatePerSecond,
+		TaskIDBlock:              FromTaskIDBlock(t.TaskIDBlock),
+		IsolationGroupMetrics:    FromIsolationGroupMetricsMap(t.IsolationGroupMetrics),
+		NewTasksPerSecond:        &t.NewTasksPerSecond,
+		Empty:                    &t.Empty,
+		FairnessKeyBacklogCounts: t.FairnessKeyBacklogCounts,
 	}
 }
 
@@ -6189,14 +6428,15 @@ func ToTaskListStatus(t *shared.TaskListStatus) *types.TaskListStatus {
 		return nil
 	}
 	return &types.TaskListStatus{
-		BacklogCountHint:      t.GetBacklogCountHint(),
-		ReadLevel:             t.GetReadLevel(),
-		AckLevel:              t.GetAckLevel(),
-		RatePerSecond:         t.GetRatePerSecond(),
-		TaskIDBlock:           ToTaskIDBlock(t.TaskIDBlock),
-		IsolationGroupMetrics: ToIsolationGroupMetricsMap(t.GetIsolationGroupMetrics()),
-		NewTasksPerSecond:     t.GetNewTasksPerSecond(),
-		Empty:                 t.GetEmpty(),
+		BacklogCountHint:         t.GetBacklogCountHint(),
+		ReadLevel:                t.GetReadLevel(),
+		AckLevel:                 t.GetAckLevel(),
+		RatePerSecond:            t.GetRatePerSecond(),
+		TaskIDBlock:              ToTaskIDBlock(t.TaskIDBlock),
+		IsolationGroupMetrics:    ToIsolationGroupMetricsMap(t.GetIsolationGroupMetrics()),
+		NewTasksPerSecond:        t.GetNewTasksPerSecond(),
+		Empty:                    t.GetEmpty(),
+		FairnessKeyBacklogCounts: t.FairnessKeyBacklogCounts,
 	}
 }
 
@@ -6259,6 +6499,32 @@ func ToRestartWorkflowExecutionResponse(t *shared.RestartWorkflowExecutionRespon
 	}
 }
 
+// FromRetryActivityNowRequest converts internal RetryActivityNowRequest type to thrift
+func FromRetryActivityNowRequest(t *types.RetryActivityNowRequest) *shared.RetryActivityNowRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.RetryActivityNowRequest{
+		Domain:            &t.Domain,
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		ActivityId:        &t.ActivityID,
+		Identity:          &t.Identity,
+	}
+}
+
+// ToRetryActivityNowRequest converts thrift RetryActivityNowRequest type to internal
+func ToRetryActivityNowRequest(t *shared.RetryActivityNowRequest) *types.RetryActivityNowRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.RetryActivityNowRequest{
+		Domain:            t.GetDomain(),
+		WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+		ActivityID:        t.GetActivityId(),
+		Identity:          t.GetIdentity(),
+	}
+}
+
 // FromTerminateWorkflowExecutionRequest converts internal TerminateWorkflowExecutionRequest type to thrift
 func FromTerminateWorkflowExecutionRequest(t *types.TerminateWorkflowExecutionRequest) *shared.TerminateWorkflowExecutionRequest {
 	if t == nil {
@@ -6427,6 +6693,60 @@ func ToTransientDecisionInfo(t *shared.TransientDecisionInfo) *types.TransientDe
 	}
 }
 
+// FromUnpauseActivityRequest converts internal UnpauseActivityRequest type to thrift
+func FromUnpauseActivityRequest(t *types.UnpauseActivityRequest) *shared.UnpauseActivityRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.UnpauseActivityRequest{
+		Domain:            &t.Domain,
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		ActivityId:        &t.ActivityID,
+		Identity:          &t.Identity,
+	}
+}
+
+// ToUnpauseActivityRequest converts thrift UnpauseActivityRequest type to internal
+func ToUnpauseActivityRequest(t *shared.UnpauseActivityRequest) *types.UnpauseActivityRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.UnpauseActivityRequest{
+		Domain:            t.GetDomain(),
+		WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+		ActivityID:        t.GetActivityId(),
+		Identity:          t.GetIdentity(),
+	}
+}
+
+// FromUnpauseWorkflowExecutionRequest converts internal UnpauseWorkflowExecutionRequest type to thrift
+func FromUnpauseWorkflowExecutionRequest(t *types.UnpauseWorkflowExecutionRequest) *shared.UnpauseWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.UnpauseWorkflowExecutionRequest{
+		Domain:            &t.Domain,
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		Reason:            &t.Reason,
+		Identity:          &t.Identity,
+		RequestId:         &t.RequestID,
+	}
+}
+
+// ToUnpauseWorkflowExecutionRequest converts thrift UnpauseWorkflowExecutionRequest type to internal
+func ToUnpauseWorkflowExecutionRequest(t *shared.UnpauseWorkflowExecutionRequest) *types.UnpauseWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.UnpauseWorkflowExecutionRequest{
+		Domain:            t.GetDomain(),
+		WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+		Reason:            t.GetReason(),
+		Identity:          t.GetIdentity(),
+		RequestID:         t.GetRequestId(),
+	}
+}
+
 // FromUpdateDomainRequest converts internal UpdateDomainRequest type to thrift
 func FromUpdateDomainRequest(t *types.UpdateDomainRequest) *shared.UpdateDomainRequest {
 	if t == nil {
@@ -6533,6 +6853,62 @@ func ToUpdateDomainResponse(t *shared.UpdateDomainResponse) *types.UpdateDomainR
 	}
 }
 
+// FromUpdateWorkflowExecutionRequest converts internal UpdateWorkflowExecutionRequest type to thrift
+func FromUpdateWorkflowExecutionRequest(t *types.UpdateWorkflowExecutionRequest) *shared.UpdateWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.UpdateWorkflowExecutionRequest{
+		Domain:            &t.Domain,
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		UpdateId:          &t.UpdateID,
+		UpdateName:        &t.UpdateName,
+		Input:             t.Input,
+		Identity:          &t.Identity,
+	}
+}
+
+// ToUpdateWorkflowExecutionRequest converts thrift UpdateWorkflowExecutionRequest type to internal
+func ToUpdateWorkflowExecutionRequest(t *shared.UpdateWorkflowExecutionRequest) *types.UpdateWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.UpdateWorkflowExecutionRequest{
+		Domain:            t.GetDomain(),
+		WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+		UpdateID:          t.GetUpdateId(),
+		UpdateName:        t.GetUpdateName(),
+		Input:             t.Input,
+		Identity:          t.GetIdentity(),
+	}
+}
+
+// FromUpdateWorkflowExecutionResponse converts internal UpdateWorkflowExecutionResponse type to thrift
+func FromUpdateWorkflowExecutionResponse(t *types.UpdateWorkflowExecutionResponse) *shared.UpdateWorkflowExecutionResponse {
+	if t == nil {
+		return nil
+	}
+	return &shared.UpdateWorkflowExecutionResponse{
+		UpdateId:       &t.UpdateID,
+		Result:         t.Result,
+		FailureReason:  t.FailureReason,
+		FailureDetails: t.FailureDetails,
+	}
+}
+
+// ToUpdateWorkflowExecutionResponse converts thrift UpdateWorkflowExecutionResponse type to internal
+func ToUpdateWorkflowExecutionResponse(t *shared.UpdateWorkflowExecutionResponse) *types.UpdateWorkflowExecutionResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.UpdateWorkflowExecutionResponse{
+		UpdateID:       t.GetUpdateId(),
+		Result:         t.Result,
+		FailureReason:  t.FailureReason,
+		FailureDetails: t.FailureDetails,
+	}
+}
+
 // FromUpsertWorkflowSearchAttributesDecisionAttributes converts internal UpsertWorkflowSearchAttributesDecisionAttributes type to thrift
 func FromUpsertWorkflowSearchAttributesDecisionAttributes(t *types.UpsertWorkflowSearchAttributesDecisionAttributes) *shared.UpsertWorkflowSearchAttributesDecisionAttributes {
 	if t == nil {
@@ -7027,6 +7403,28 @@ func ToWorkflowExecutionFilter(t *shared.WorkflowExecutionFilter) *types.Workflo
 	}
 }
 
+// FromWorkflowExecutionGroup converts internal WorkflowExecutionGroup type to thrift
+func FromWorkflowExecutionGroup(t *types.WorkflowExecutionGroup) *shared.WorkflowExecutionGroup {
+	if t == nil {
+		return nil
+	}
+	return &shared.WorkflowExecutionGroup{
+		Key:   &t.Key,
+		Count: &t.Count,
+	}
+}
+
+// ToWorkflowExecutionGroup converts thrift WorkflowExecutionGroup type to internal
+func ToWorkflowExecutionGroup(t *shared.WorkflowExecutionGroup) *types.WorkflowExecutionGroup {
+	if t == nil {
+		return nil
+	}
+	return &types.WorkflowExecutionGroup{
+		Key:   t.GetKey(),
+		Count: t.GetCount(),
+	}
+}
+
 // FromWorkflowExecutionInfo converts internal WorkflowExecutionInfo type to thrift
 func FromWorkflowExecutionInfo(t *types.WorkflowExecutionInfo) *shared.WorkflowExecutionInfo {
 	if t == nil {
@@ -7096,6 +7494,28 @@ func ToWorkflowExecutionInfo(t *shared.WorkflowExecutionInfo) *types.WorkflowExe
 	}
 }
 
+// FromWorkflowExecutionPausedEventAttributes converts internal WorkflowExecutionPausedEventAttributes type to thrift
+func FromWorkflowExecutionPausedEventAttributes(t *types.WorkflowExecutionPausedEventAttributes) *shared.WorkflowExecutionPausedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &shared.WorkflowExecutionPausedEventAttributes{
+		Reason:   &t.Reason,
+		Identity: &t.Identity,
+	}
+}
+
+// ToWorkflowExecutionPausedEventAttributes converts thrift WorkflowExecutionPausedEventAttributes type to internal
+func ToWorkflowExecutionPausedEventAttributes(t *shared.WorkflowExecutionPausedEventAttributes) *types.WorkflowExecutionPausedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &types.WorkflowExecutionPausedEventAttributes{
+		Reason:   t.GetReason(),
+		Identity: t.GetIdentity(),
+	}
+}
+
 // FromWorkflowExecutionSignaledEventAttributes converts internal WorkflowExecutionSignaledEventAttributes type to thrift
 func FromWorkflowExecutionSignaledEventAttributes(t *types.WorkflowExecutionSignaledEventAttributes) *shared.WorkflowExecutionSignaledEventAttributes {
 	if t == nil {
@@ -7284,6 +7704,28 @@ func ToWorkflowExecutionTimedOutEventAttributes(t *shared.WorkflowExecutionTimed
 	}
 }
 
+// FromWorkflowExecutionUnpausedEventAttributes converts internal WorkflowExecutionUnpausedEventAttributes type to thrift
+func FromWorkflowExecutionUnpausedEventAttributes(t *types.WorkflowExecutionUnpausedEventAttributes) *shared.WorkflowExecutionUnpausedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &shared.WorkflowExecutionUnpausedEventAttributes{
+		Reason:   &t.Reason,
+		Identity: &t.Identity,
+	}
+}
+
+// ToWorkflowExecutionUnpausedEventAttributes converts thrift WorkflowExecutionUnpausedEventAttributes type to internal
+func ToWorkflowExecutionUnpausedEventAttributes(t *shared.WorkflowExecutionUnpausedEventAttributes) *types.WorkflowExecutionUnpausedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &types.WorkflowExecutionUnpausedEventAttributes{
+		Reason:   t.GetReason(),
+		Identity: t.GetIdentity(),
+	}
+}
+
 // FromWorkflowIDReusePolicy converts internal WorkflowIDReusePolicy type to thrift
 func FromWorkflowIDReusePolicy(t *types.WorkflowIDReusePolicy) *shared.WorkflowIdReusePolicy {
 	if t == nil {
@@ -7486,6 +7928,30 @@ func ToWorkflowExecutionInfoArray(t []*shared.WorkflowExecutionInfo) []*types.Wo
 	return v
 }
 
+// FromWorkflowExecutionGroupArray converts internal WorkflowExecutionGroup type array to thrift
+func FromWorkflowExecutionGroupArray(t []*types.WorkflowExecutionGroup) []*shared.WorkflowExecutionGroup {
+	if t == nil {
+		return nil
+	}
+	v := make([]*shared.WorkflowExecutionGroup, len(t))
+	for i := range t {
+		v[i] = FromWorkflowExecutionGroup(t[i])
+	}
+	return v
+}
+
+// ToWorkflowExecutionGroupArray converts thrift WorkflowExecutionGroup type array to internal
+func ToWorkflowExecutionGroupArray(t []*shared.WorkflowExecutionGroup) []*types.WorkflowExecutionGroup {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.WorkflowExecutionGroup, len(t))
+	for i := range t {
+		v[i] = ToWorkflowExecutionGroup(t[i])
+	}
+	return v
+}
+
 // FromPollerInfoArray converts internal PollerInfo type array to thrift
 func FromPollerInfoArray(t []*types.PollerInfo) []*shared.PollerInfo {
 	if t == nil {
@@ -462,6 +462,34 @@ func TestActivityTaskTimedEventAttributesConversion(t *testing.T) {
 	}
 }
 
+func TestAggregateWorkflowExecutionsRequestConversion(t *testing.T) {
+	testCases := []*types.AggregateWorkflowExecutionsRequest{
+		nil,
+		{},
+		&testdata.AggregateWorkflowExecutionsRequest,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromAggregateWorkflowExecutionsRequest(original)
+		roundTripObj := ToAggregateWorkflowExecutionsRequest(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestAggregateWorkflowExecutionsResponseConversion(t *testing.T) {
+	testCases := []*types.AggregateWorkflowExecutionsResponse{
+		nil,
+		{},
+		&testdata.AggregateWorkflowExecutionsResponse,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromAggregateWorkflowExecutionsResponse(original)
+		roundTripObj := ToAggregateWorkflowExecutionsResponse(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
 func TestFailureOptionsConversion(t *testing.T) {
 	testCases := []*types.FailureOptions{
 		nil,
@@ -1047,6 +1075,20 @@ func TestDeleteDomainRequestConversion(t *testing.T) {
 	}
 }
 
+func TestDeleteWorkflowExecutionRequestConversion(t *testing.T) {
+	testCases := []*types.DeleteWorkflowExecutionRequest{
+		nil,
+		{},
+		&testdata.DeleteWorkflowExecutionRequest,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromDeleteWorkflowExecutionRequest(original)
+		roundTripObj := ToDeleteWorkflowExecutionRequest(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
 func TestDeprecateDomainRequestConversion(t *testing.T) {
 	testCases := []*types.DeprecateDomainRequest{
 		nil,
@@ -1461,6 +1503,8 @@ func TestEventTypeConversion(t *testing.T) {
 		types.EventTypeSignalExternalWorkflowExecutionFailed.Ptr(),
 		types.EventTypeExternalWorkflowExecutionSignaled.Ptr(),
 		types.EventTypeUpsertWorkflowSearchAttributes.Ptr(),
+		types.EventTypeWorkflowExecutionPaused.Ptr(),
+		types.EventTypeWorkflowExecutionUnpaused.Ptr(),
 	}
 
 	for _, original := range testCases {
@@ -1612,6 +1656,8 @@ func TestHistoryEventConversion(t *testing.T) {
 			EventType:                               types.EventTypeWorkflowExecutionStarted.Ptr(),
 			WorkflowExecutionStartedEventAttributes: &testdata.WorkflowExecutionStartedEventAttributes,
 		},
+		&testdata.HistoryEvent_WorkflowExecutionPaused,
+		&testdata.HistoryEvent_WorkflowExecutionUnpaused,
 	}
 
 	for _, original := range testCases {
@@ -1953,6 +1999,34 @@ func TestParentClosePolicyConversion(t *testing.T) {
 	}
 }
 
+func TestPauseActivityRequestConversion(t *testing.T) {
+	testCases := []*types.PauseActivityRequest{
+		nil,
+		{},
+		&testdata.PauseActivityRequest,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromPauseActivityRequest(original)
+		roundTripObj := ToPauseActivityRequest(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestPauseWorkflowExecutionRequestConversion(t *testing.T) {
+	testCases := []*types.PauseWorkflowExecutionRequest{
+		nil,
+		{},
+		&testdata.PauseWorkflowExecutionRequest,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromPauseWorkflowExecutionRequest(original)
+		roundTripObj := ToPauseWorkflowExecutionRequest(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
 func TestPendingActivityInfoConversion(t *testing.T) {
 	testCases := []*types.PendingActivityInfo{
 		nil,
@@ -2440,6 +2514,20 @@ func TestRequestCancelWorkflowExecutionRequestConversion(t *testing.T) {
 	}
 }
 
+func TestResetActivityAttemptRequestConversion(t *testing.T) {
+	testCases := []*types.ResetActivityAttemptRequest{
+		nil,
+		{},
+		&testdata.ResetActivityAttemptRequest,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromResetActivityAttemptRequest(original)
+		roundTripObj := ToResetActivityAttemptRequest(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
 func TestResetPointInfoConversion(t *testing.T) {
 	testCases := []*types.ResetPointInfo{
 		nil,
@@ -2669,6 +2757,7 @@ func TestRetryPolicyConversion(t *testing.T) {
 		nil,
 		{},
 		&testdata.RetryPolicy,
+		&testdata.RetryPolicyWithExtensions,
 	}
 
 	for _, original := range testCases {
@@ -2711,6 +2800,7 @@ func TestScheduleActivityTaskDecisionAttributesConversion(t *testing.T) {
 		nil,
 		{},
 		&testdata.ScheduleActivityTaskDecisionAttributes,
+		{Priority: 2, FairnessKey: "tenant-a"},
 	}
 
 	for _, original := range testCases {
@@ -2809,6 +2899,7 @@ func TestSignalWithStartWorkflowExecutionRequestConversion(t *testing.T) {
 		nil,
 		{},
 		&testdata.SignalWithStartWorkflowExecutionRequest,
+		{Priority: 2, FairnessKey: "tenant-a"},
 	}
 
 	for _, original := range testCases {
@@ -2907,6 +2998,7 @@ func TestStartWorkflowExecutionRequestConversion(t *testing.T) {
 		nil,
 		{},
 		&testdata.StartWorkflowExecutionRequest,
+		{Priority: 2, FairnessKey: "tenant-a"},
 	}
 
 	for _, original := range testCases {
@@ -2958,6 +3050,20 @@ func TestRestartWorkflowExecutionResponseConversion(t *testing.T) {
 	}
 }
 
+func TestRetryActivityNowRequestConversion(t *testing.T) {
+	testCases := []*types.RetryActivityNowRequest{
+		nil,
+		{},
+		&testdata.RetryActivityNowRequest,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromRetryActivityNowRequest(original)
+		roundTripObj := ToRetryActivityNowRequest(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
 func TestStartWorkflowExecutionResponseConversion(t *testing.T) {
 	testCases := []*types.StartWorkflowExecutionResponse{
 		nil,
@@ -3089,6 +3195,7 @@ func TestTaskListStatusConversion(t *testing.T) {
 		nil,
 		{},
 		&testdata.TaskListStatus,
+		{FairnessKeyBacklogCounts: map[string]int64{"": 3, "tenant-a": 5}},
 	}
 
 	for _, original := range testCases {
@@ -3187,6 +3294,34 @@ func TestTransientDecisionInfoConversion(t *testing.T) {
 	}
 }
 
+func TestUnpauseActivityRequestConversion(t *testing.T) {
+	testCases := []*types.UnpauseActivityRequest{
+		nil,
+		{},
+		&testdata.UnpauseActivityRequest,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromUnpauseActivityRequest(original)
+		roundTripObj := ToUnpauseActivityRequest(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestUnpauseWorkflowExecutionRequestConversion(t *testing.T) {
+	testCases := []*types.UnpauseWorkflowExecutionRequest{
+		nil,
+		{},
+		&testdata.UnpauseWorkflowExecutionRequest,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromUnpauseWorkflowExecutionRequest(original)
+		roundTripObj := ToUnpauseWorkflowExecutionRequest(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
 func TestUpdateDomainRequestConversion(t *testing.T) {
 	testCases := []*types.UpdateDomainRequest{
 		nil,
@@ -3215,6 +3350,34 @@ func TestUpdateDomainResponseConversion(t *testing.T) {
 	}
 }
 
+func TestUpdateWorkflowExecutionRequestConversion(t *testing.T) {
+	testCases := []*types.UpdateWorkflowExecutionRequest{
+		nil,
+		{},
+		&testdata.UpdateWorkflowExecutionRequest,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromUpdateWorkflowExecutionRequest(original)
+		roundTripObj := ToUpdateWorkflowExecutionRequest(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestUpdateWorkflowExecutionResponseConversion(t *testing.T) {
+	testCases := []*types.UpdateWorkflowExecutionResponse{
+		nil,
+		{},
+		&testdata.UpdateWorkflowExecutionResponse,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromUpdateWorkflowExecutionResponse(original)
+		roundTripObj := ToUpdateWorkflowExecutionResponse(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
 func TestUpsertWorkflowSearchAttributesDecisionAttributesConversion(t *testing.T) {
 	testCases := []*types.UpsertWorkflowSearchAttributesDecisionAttributes{
 		nil,
@@ -144,6 +144,138 @@ const (
 	DomainOperationDelete
 )
 
+// DeleteWorkflowExecutionTaskAttributes is an internal type (TBD...)
+type DeleteWorkflowExecutionTaskAttributes struct {
+	DomainID   string `json:"domainId,omitempty"`
+	WorkflowID string `json:"workflowId,omitempty"`
+	RunID      string `json:"runId,omitempty"`
+	Version    int64  `json:"version,omitempty"`
+}
+
+// GetDomainID is an internal getter (TBD...)
+func (v *DeleteWorkflowExecutionTaskAttributes) GetDomainID() (o string) {
+	if v != nil {
+		return v.DomainID
+	}
+	return
+}
+
+// GetWorkflowID is an internal getter (TBD...)
+func (v *DeleteWorkflowExecutionTaskAttributes) GetWorkflowID() (o string) {
+	if v != nil {
+		return v.WorkflowID
+	}
+	return
+}
+
+// GetRunID is an internal getter (TBD...)
+func (v *DeleteWorkflowExecutionTaskAttributes) GetRunID() (o string) {
+	if v != nil {
+		return v.RunID
+	}
+	return
+}
+
+// GetVersion is an internal getter (TBD...)
+func (v *DeleteWorkflowExecutionTaskAttributes) GetVersion() (o int64) {
+	if v != nil {
+		return v.Version
+	}
+	return
+}
+
+// ByteSize returns the approximate memory used in bytes
+func (v *DeleteWorkflowExecutionTaskAttributes) ByteSize() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	size := uint64(unsafe.Sizeof(*v))
+	size += uint64(len(v.DomainID))
+	size += uint64(len(v.WorkflowID))
+	size += uint64(len(v.RunID))
+
+	return size
+}
+
+// UpsertWorkflowSearchAttributesTaskAttributes is an internal type (TBD...)
+type UpsertWorkflowSearchAttributesTaskAttributes struct {
+	DomainID         string            `json:"domainId,omitempty"`
+	WorkflowID       string            `json:"workflowId,omitempty"`
+	RunID            string            `json:"runId,omitempty"`
+	Version          int64             `json:"version,omitempty"`
+	SearchAttributes *SearchAttributes `json:"searchAttributes,omitempty"`
+	Memo             *Memo             `json:"memo,omitempty"`
+}
+
+// GetDomainID is an internal getter (TBD...)
+func (v *UpsertWorkflowSearchAttributesTaskAttributes) GetDomainID() (o string) {
+	if v != nil {
+		return v.DomainID
+	}
+	return
+}
+
+// GetWorkflowID is an internal getter (TBD...)
+func (v *UpsertWorkflowSearchAttributesTaskAttributes) GetWorkflowID() (o string) {
+	if v != nil {
+		return v.WorkflowID
+	}
+	return
+}
+
+// GetRunID is an internal getter (TBD...)
+func (v *UpsertWorkflowSearchAttributesTaskAttributes) GetRunID() (o string) {
+	if v != nil {
+		return v.RunID
+	}
+	return
+}
+
+// GetVersion is an internal getter (TBD...)
+func (v *UpsertWorkflowSearchAttributesTaskAttributes) GetVersion() (o int64) {
+	if v != nil {
+		return v.Version
+	}
+	return
+}
+
+// GetSearchAttributes is an internal getter (TBD...)
+func (v *UpsertWorkflowSearchAttributesTaskAttributes) GetSearchAttributes() (o *SearchAttributes) {
+	if v != nil && v.SearchAttributes != nil {
+		return v.SearchAttributes
+	}
+	return
+}
+
+// GetMemo is an internal getter (TBD...)
+func (v *UpsertWorkflowSearchAttributesTaskAttributes) GetMemo() (o *Memo) {
+	if v != nil && v.Memo != nil {
+		return v.Memo
+	}
+	return
+}
+
+// ByteSize returns the approximate memory used in bytes
+func (v *UpsertWorkflowSearchAttributesTaskAttributes) ByteSize() uint64 {
+	if v == nil {
+		return 0
+	}
+
+	size := uint64(unsafe.Sizeof(*v))
+	size += uint64(len(v.DomainID))
+	size += uint64(len(v.WorkflowID))
+	size += uint64(len(v.RunID))
+	for key, value := range v.GetSearchAttributes().GetIndexedFields() {
+		size += uint64(len(key) + len(value))
+	}
+	for key, value := range v.GetMemo().GetFields() {
+		size += uint64(len(key) + len(value))
+	}
+
+	return size
+}
+
 // DomainTaskAttributes is an internal type (TBD...)
 type DomainTaskAttributes struct {
 	DomainOperation         *DomainOperation                `json:"domainOperation,omitempty"`
@@ -734,6 +866,9 @@ type ReplicationTask struct {
 	HistoryTaskV2Attributes       *HistoryTaskV2Attributes       `json:"historyTaskV2Attributes,omitempty"`
 	FailoverMarkerAttributes      *FailoverMarkerAttributes      `json:"failoverMarkerAttributes,omitempty"`
 	CreationTime                  *int64                         `json:"creationTime,omitempty"`
+
+	DeleteWorkflowExecutionTaskAttributes        *DeleteWorkflowExecutionTaskAttributes        `json:"deleteWorkflowExecutionTaskAttributes,omitempty"`
+	UpsertWorkflowSearchAttributesTaskAttributes *UpsertWorkflowSearchAttributesTaskAttributes `json:"upsertWorkflowSearchAttributesTaskAttributes,omitempty"`
 }
 
 // GetTaskType is an internal getter (TBD...)
@@ -797,6 +932,22 @@ func (v *ReplicationTask) GetCreationTime() (o int64) {
 	return
 }
 
+// GetDeleteWorkflowExecutionTaskAttributes is an internal getter (TBD...)
+func (v *ReplicationTask) GetDeleteWorkflowExecutionTaskAttributes() (o *DeleteWorkflowExecutionTaskAttributes) {
+	if v != nil && v.DeleteWorkflowExecutionTaskAttributes != nil {
+		return v.DeleteWorkflowExecutionTaskAttributes
+	}
+	return
+}
+
+// GetUpsertWorkflowSearchAttributesTaskAttributes is an internal getter (TBD...)
+func (v *ReplicationTask) GetUpsertWorkflowSearchAttributesTaskAttributes() (o *UpsertWorkflowSearchAttributesTaskAttributes) {
+	if v != nil && v.UpsertWorkflowSearchAttributesTaskAttributes != nil {
+		return v.UpsertWorkflowSearchAttributesTaskAttributes
+	}
+	return
+}
+
 // ByteSize returns the approximate memory used in bytes
 func (v *ReplicationTask) ByteSize() uint64 {
 	if v == nil {
@@ -813,6 +964,8 @@ func (v *ReplicationTask) ByteSize() uint64 {
 	if v.CreationTime != nil {
 		size += uint64(unsafe.Sizeof(*v.CreationTime))
 	}
+	size += v.DeleteWorkflowExecutionTaskAttributes.ByteSize()
+	size += v.UpsertWorkflowSearchAttributesTaskAttributes.ByteSize()
 
 	return size
 }
@@ -928,6 +1081,10 @@ func (e ReplicationTaskType) String() string {
 		return "HistoryV2"
 	case 6:
 		return "FailoverMarker"
+	case 7:
+		return "DeleteWorkflowExecution"
+	case 8:
+		return "UpsertWorkflowSearchAttributes"
 	}
 	return fmt.Sprintf("ReplicationTaskType(%d)", w)
 }
@@ -956,6 +1113,12 @@ func (e *ReplicationTaskType) UnmarshalText(value []byte) error {
 	case "FAILOVERMARKER":
 		*e = ReplicationTaskTypeFailoverMarker
 		return nil
+	case "DELETEWORKFLOWEXECUTION":
+		*e = ReplicationTaskTypeDeleteWorkflowExecution
+		return nil
+	case "UPSERTWORKFLOWSEARCHATTRIBUTES":
+		*e = ReplicationTaskTypeUpsertWorkflowSearchAttributes
+		return nil
 	default:
 		val, err := strconv.ParseInt(s, 10, 32)
 		if err != nil {
@@ -986,6 +1149,10 @@ const (
 	ReplicationTaskTypeHistoryV2
 	// ReplicationTaskTypeFailoverMarker is an option for ReplicationTaskType
 	ReplicationTaskTypeFailoverMarker
+	// ReplicationTaskTypeDeleteWorkflowExecution is an option for ReplicationTaskType
+	ReplicationTaskTypeDeleteWorkflowExecution
+	// ReplicationTaskTypeUpsertWorkflowSearchAttributes is an option for ReplicationTaskType
+	ReplicationTaskTypeUpsertWorkflowSearchAttributes
 )
 
 // ByteSize returns the approximate memory used in bytes
@@ -1045,6 +1212,7 @@ type SyncActivityTaskAttributes struct {
 	LastFailureDetails []byte          `json:"lastFailureDetails,omitempty"`
 	LastFailureOptions *FailureOptions `json:"lastFailureOptions,omitempty"`
 	VersionHistory     *VersionHistory `json:"versionHistory,omitempty"`
+	Paused             bool            `json:"paused,omitempty"`
 }
 
 // GetDomainID is an internal getter (TBD...)
@@ -1127,6 +1295,14 @@ func (v *SyncActivityTaskAttributes) GetVersionHistory() (o *VersionHistory) {
 	return
 }
 
+// GetPaused is an internal getter (TBD...)
+func (v *SyncActivityTaskAttributes) GetPaused() (o bool) {
+	if v != nil {
+		return v.Paused
+	}
+	return
+}
+
 // ByteSize returns the approximate memory used in bytes
 func (v *SyncActivityTaskAttributes) ByteSize() uint64 {
 	if v == nil {
@@ -0,0 +1,71 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package types
+
+// RetryPolicyOverride overrides the backoff of a RetryPolicy for the failure reasons
+// fully matching ReasonPattern, a regular expression. Zero values inherit from the RetryPolicy.
+type RetryPolicyOverride struct {
+	ReasonPattern            string  `json:"reasonPattern,omitempty"`
+	InitialIntervalInSeconds int32   `json:"initialIntervalInSeconds,omitempty"`
+	BackoffCoefficient       float64 `json:"backoffCoefficient,omitempty"`
+	MaximumIntervalInSeconds int32   `json:"maximumIntervalInSeconds,omitempty"`
+	MaximumAttempts          int32   `json:"maximumAttempts,omitempty"`
+}
+
+// GetReasonPattern is an internal getter (TBD...)
+func (v *RetryPolicyOverride) GetReasonPattern() (o string) {
+	if v != nil {
+		return v.ReasonPattern
+	}
+	return
+}
+
+// GetInitialIntervalInSeconds is an internal getter (TBD...)
+func (v *RetryPolicyOverride) GetInitialIntervalInSeconds() (o int32) {
+	if v != nil {
+		return v.InitialIntervalInSeconds
+	}
+	return
+}
+
+// GetBackoffCoefficient is an internal getter (TBD...)
+func (v *RetryPolicyOverride) GetBackoffCoefficient() (o float64) {
+	if v != nil {
+		return v.BackoffCoefficient
+	}
+	return
+}
+
+// GetMaximumIntervalInSeconds is an internal getter (TBD...)
+func (v *RetryPolicyOverride) GetMaximumIntervalInSeconds() (o int32) {
+	if v != nil {
+		return v.MaximumIntervalInSeconds
+	}
+	return
+}
+
+// GetMaximumAttempts is an internal getter (TBD...)
+func (v *RetryPolicyOverride) GetMaximumAttempts() (o int32) {
+	if v != nil {
+		return v.MaximumAttempts
+	}
+	return
+}
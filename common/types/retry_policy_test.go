@@ -0,0 +1,72 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRetryPolicy_ExtensionGetters(t *testing.T) {
+	var nilPolicy *RetryPolicy
+	assert.Equal(t, 0.0, nilPolicy.GetJitterCoefficient())
+	assert.Nil(t, nilPolicy.GetRetryableErrorReasons())
+	assert.Nil(t, nilPolicy.GetErrorReasonOverrides())
+
+	override := &RetryPolicyOverride{
+		ReasonPattern:            "rate-limit.*",
+		InitialIntervalInSeconds: 1,
+		BackoffCoefficient:       2,
+		MaximumIntervalInSeconds: 3,
+		MaximumAttempts:          4,
+	}
+	policy := &RetryPolicy{
+		JitterCoefficient:     0.5,
+		RetryableErrorReasons: []string{"transient"},
+		ErrorReasonOverrides:  []*RetryPolicyOverride{override},
+	}
+	assert.Equal(t, 0.5, policy.GetJitterCoefficient())
+	assert.Equal(t, []string{"transient"}, policy.GetRetryableErrorReasons())
+	assert.Equal(t, []*RetryPolicyOverride{override}, policy.GetErrorReasonOverrides())
+}
+
+func TestRetryPolicyOverride_Getters(t *testing.T) {
+	var nilOverride *RetryPolicyOverride
+	assert.Equal(t, "", nilOverride.GetReasonPattern())
+	assert.Equal(t, int32(0), nilOverride.GetInitialIntervalInSeconds())
+	assert.Equal(t, 0.0, nilOverride.GetBackoffCoefficient())
+	assert.Equal(t, int32(0), nilOverride.GetMaximumIntervalInSeconds())
+	assert.Equal(t, int32(0), nilOverride.GetMaximumAttempts())
+
+	override := &RetryPolicyOverride{
+		ReasonPattern:            "rate-limit.*",
+		InitialIntervalInSeconds: 1,
+		BackoffCoefficient:       2,
+		MaximumIntervalInSeconds: 3,
+		MaximumAttempts:          4,
+	}
+	assert.Equal(t, "rate-limit.*", override.GetReasonPattern())
+	assert.Equal(t, int32(1), override.GetInitialIntervalInSeconds())
+	assert.Equal(t, 2.0, override.GetBackoffCoefficient())
+	assert.Equal(t, int32(3), override.GetMaximumIntervalInSeconds())
+	assert.Equal(t, int32(4), override.GetMaximumAttempts())
+}
@@ -142,11 +142,17 @@ func (e ScheduleCatchUpPolicy) MarshalText() ([]byte, error) {
 // --- Core Types ---
 
 // ScheduleSpec defines when a schedule should trigger.
+// The schedule fires at the union of the times matched by CronExpression,
+// Intervals and Calendars, minus the times matched by any of ExcludeCalendars.
+// At least one of CronExpression, Intervals or Calendars must be set.
 type ScheduleSpec struct {
-	CronExpression string        `json:"cronExpression,omitempty"`
-	StartTime      time.Time     `json:"startTime,omitempty"`
-	EndTime        time.Time     `json:"endTime,omitempty"`
-	Jitter         time.Duration `json:"jitter,omitempty"`
+	CronExpression   string                  `json:"cronExpression,omitempty"`
+	StartTime        time.Time               `json:"startTime,omitempty"`
+	EndTime          time.Time               `json:"endTime,omitempty"`
+	Jitter           time.Duration           `json:"jitter,omitempty"`
+	Intervals        []*ScheduleIntervalSpec `json:"intervals,omitempty"`
+	Calendars        []*ScheduleCalendarSpec `json:"calendars,omitempty"`
+	ExcludeCalendars []*ScheduleCalendarSpec `json:"excludeCalendars,omitempty"`
 }
 
 func (v *ScheduleSpec) GetCronExpression() (o string) {
@@ -177,6 +183,142 @@ func (v *ScheduleSpec) GetJitter() (o time.Duration) {
 	return
 }
 
+func (v *ScheduleSpec) GetIntervals() (o []*ScheduleIntervalSpec) {
+	if v != nil {
+		return v.Intervals
+	}
+	return
+}
+
+func (v *ScheduleSpec) GetCalendars() (o []*ScheduleCalendarSpec) {
+	if v != nil {
+		return v.Calendars
+	}
+	return
+}
+
+func (v *ScheduleSpec) GetExcludeCalendars() (o []*ScheduleCalendarSpec) {
+	if v != nil {
+		return v.ExcludeCalendars
+	}
+	return
+}
+
+// HasTriggers returns true if any of CronExpression, Intervals or Calendars is set.
+func (v *ScheduleSpec) HasTriggers() bool {
+	return v.GetCronExpression() != "" || len(v.GetIntervals()) > 0 || len(v.GetCalendars()) > 0
+}
+
+// ScheduleIntervalSpec fires every Interval, at the times aligned to the Unix
+// epoch shifted by Offset. For example, an Interval of 1h with an Offset of 15m
+// fires at minute 15 of every hour.
+type ScheduleIntervalSpec struct {
+	Interval time.Duration `json:"interval,omitempty"`
+	Offset   time.Duration `json:"offset,omitempty"`
+}
+
+func (v *ScheduleIntervalSpec) GetInterval() (o time.Duration) {
+	if v != nil {
+		return v.Interval
+	}
+	return
+}
+
+func (v *ScheduleIntervalSpec) GetOffset() (o time.Duration) {
+	if v != nil {
+		return v.Offset
+	}
+	return
+}
+
+// ScheduleCalendarSpec matches the wall-clock times in TimeZone whose fields all match.
+// Each field uses the syntax of a cron field: "*", a value, a range "a-b", a step
+// "*/n" or "a-b/n", or a comma separated list of them. Month and DayOfWeek also accept
+// three letter names, and DayOfWeek counts from 0 (Sunday) to 6, 7 being Sunday too.
+//
+// DayOfMonth additionally accepts "L", which matches only the last day of the month
+// that matches DayOfWeek. For example, DayOfMonth "L" with DayOfWeek "MON-FRI" is the
+// last business day of the month.
+//
+// Empty Second, Minute and Hour default to "0", and the other empty fields default
+// to "*". In ExcludeCalendars all empty fields default to "*", so that an exclusion of
+// a date covers the whole day.
+type ScheduleCalendarSpec struct {
+	Second     string `json:"second,omitempty"`
+	Minute     string `json:"minute,omitempty"`
+	Hour       string `json:"hour,omitempty"`
+	DayOfMonth string `json:"dayOfMonth,omitempty"`
+	Month      string `json:"month,omitempty"`
+	DayOfWeek  string `json:"dayOfWeek,omitempty"`
+	Year       string `json:"year,omitempty"`
+	// TimeZone is an IANA time zone name such as "America/New_York". Empty means UTC.
+	TimeZone string `json:"timeZone,omitempty"`
+	Comment  string `json:"comment,omitempty"`
+}
+
+func (v *ScheduleCalendarSpec) GetSecond() (o string) {
+	if v != nil {
+		return v.Second
+	}
+	return
+}
+
+func (v *ScheduleCalendarSpec) GetMinute() (o string) {
+	if v != nil {
+		return v.Minute
+	}
+	return
+}
+
+func (v *ScheduleCalendarSpec) GetHour() (o string) {
+	if v != nil {
+		return v.Hour
+	}
+	return
+}
+
+func (v *ScheduleCalendarSpec) GetDayOfMonth() (o string) {
+	if v != nil {
+		return v.DayOfMonth
+	}
+	return
+}
+
+func (v *ScheduleCalendarSpec) GetMonth() (o string) {
+	if v != nil {
+		return v.Month
+	}
+	return
+}
+
+func (v *ScheduleCalendarSpec) GetDayOfWeek() (o string) {
+	if v != nil {
+		return v.DayOfWeek
+	}
+	return
+}
+
+func (v *ScheduleCalendarSpec) GetYear() (o string) {
+	if v != nil {
+		return v.Year
+	}
+	return
+}
+
+func (v *ScheduleCalendarSpec) GetTimeZone() (o string) {
+	if v != nil {
+		return v.TimeZone
+	}
+	return
+}
+
+func (v *ScheduleCalendarSpec) GetComment() (o string) {
+	if v != nil {
+		return v.Comment
+	}
+	return
+}
+
 // StartWorkflowAction defines a workflow to start when the schedule triggers.
 // Input, Memo, and SearchAttributes must JSON-round-trip: the scheduler workflow
 // encodes types.ScheduleAction with encoding/json (create input, update signals,
@@ -228,10 +370,82 @@ func (v *StartWorkflowAction) GetTaskStartToCloseTimeoutSeconds() (o int32) {
 	return
 }
 
+// SignalWorkflowAction signals the current run of an existing workflow when the
+// schedule triggers. The fire is skipped if the workflow is not running.
+// SignalInput must JSON-round-trip, see StartWorkflowAction.
+type SignalWorkflowAction struct {
+	WorkflowID  string `json:"workflowId,omitempty"`
+	SignalName  string `json:"signalName,omitempty"`
+	SignalInput []byte `json:"signalInput,omitempty"`
+}
+
+func (v *SignalWorkflowAction) GetWorkflowID() (o string) {
+	if v != nil {
+		return v.WorkflowID
+	}
+	return
+}
+
+func (v *SignalWorkflowAction) GetSignalName() (o string) {
+	if v != nil {
+		return v.SignalName
+	}
+	return
+}
+
+func (v *SignalWorkflowAction) GetSignalInput() (o []byte) {
+	if v != nil {
+		return v.SignalInput
+	}
+	return
+}
+
+// SignalWithStartWorkflowAction signals the workflow WorkflowID when the schedule
+// triggers, starting it from StartWorkflow first if it is not running.
+// StartWorkflow.WorkflowIDPrefix is ignored, WorkflowID is used as is. The overlap
+// policy applies to a running WorkflowID, so only ScheduleOverlapPolicyConcurrent
+// signals it while it runs.
+type SignalWithStartWorkflowAction struct {
+	WorkflowID    string               `json:"workflowId,omitempty"`
+	SignalName    string               `json:"signalName,omitempty"`
+	SignalInput   []byte               `json:"signalInput,omitempty"`
+	StartWorkflow *StartWorkflowAction `json:"startWorkflow,omitempty"`
+}
+
+func (v *SignalWithStartWorkflowAction) GetWorkflowID() (o string) {
+	if v != nil {
+		return v.WorkflowID
+	}
+	return
+}
+
+func (v *SignalWithStartWorkflowAction) GetSignalName() (o string) {
+	if v != nil {
+		return v.SignalName
+	}
+	return
+}
+
+func (v *SignalWithStartWorkflowAction) GetSignalInput() (o []byte) {
+	if v != nil {
+		return v.SignalInput
+	}
+	return
+}
+
+func (v *SignalWithStartWorkflowAction) GetStartWorkflow() *StartWorkflowAction {
+	if v != nil {
+		return v.StartWorkflow
+	}
+	return nil
+}
+
 // ScheduleAction defines the action to take when the schedule triggers.
 // Exactly one action field must be set.
 type ScheduleAction struct {
-	StartWorkflow *StartWorkflowAction `json:"startWorkflow,omitempty"`
+	StartWorkflow           *StartWorkflowAction           `json:"startWorkflow,omitempty"`
+	SignalWorkflow          *SignalWorkflowAction          `json:"signalWorkflow,omitempty"`
+	SignalWithStartWorkflow *SignalWithStartWorkflowAction `json:"signalWithStartWorkflow,omitempty"`
 }
 
 func (v *ScheduleAction) GetStartWorkflow() *StartWorkflowAction {
@@ -241,6 +455,28 @@ func (v *ScheduleAction) GetStartWorkflow() *StartWorkflowAction {
 	return nil
 }
 
+func (v *ScheduleAction) GetSignalWorkflow() *SignalWorkflowAction {
+	if v != nil {
+		return v.SignalWorkflow
+	}
+	return nil
+}
+
+func (v *ScheduleAction) GetSignalWithStartWorkflow() *SignalWithStartWorkflowAction {
+	if v != nil {
+		return v.SignalWithStartWorkflow
+	}
+	return nil
+}
+
+// GetWorkflowType returns the type of the workflow the action starts, if any.
+func (v *ScheduleAction) GetWorkflowType() *WorkflowType {
+	if sw := v.GetStartWorkflow(); sw != nil {
+		return sw.GetWorkflowType()
+	}
+	return v.GetSignalWithStartWorkflow().GetStartWorkflow().GetWorkflowType()
+}
+
 // SchedulePolicies configures schedule behavior.
 type SchedulePolicies struct {
 	OverlapPolicy    ScheduleOverlapPolicy `json:"overlapPolicy,omitempty"`
@@ -249,6 +485,9 @@ type SchedulePolicies struct {
 	PauseOnFailure   bool                  `json:"pauseOnFailure,omitempty"`
 	BufferLimit      int32                 `json:"bufferLimit,omitempty"`
 	ConcurrencyLimit int32                 `json:"concurrencyLimit,omitempty"`
+	// FailureBudget tunes when PauseOnFailure pauses the schedule. When nil,
+	// failed target runs are only counted and the schedule is never paused.
+	FailureBudget *ScheduleFailureBudget `json:"failureBudget,omitempty"`
 }
 
 func (v *SchedulePolicies) GetOverlapPolicy() (o ScheduleOverlapPolicy) {
@@ -293,6 +532,56 @@ func (v *SchedulePolicies) GetConcurrencyLimit() int32 {
 	return 0
 }
 
+func (v *SchedulePolicies) GetFailureBudget() (o *ScheduleFailureBudget) {
+	if v != nil {
+		return v.FailureBudget
+	}
+	return
+}
+
+// ScheduleFailureBudget describes how many failed target runs a schedule with
+// PauseOnFailure tolerates before it pauses itself. A run counts as failed when
+// it closes as FAILED or TIMED_OUT. A zero field disables its check.
+type ScheduleFailureBudget struct {
+	// MaxConsecutiveFailures pauses the schedule once this many runs in a row failed.
+	MaxConsecutiveFailures int32 `json:"maxConsecutiveFailures,omitempty"`
+	// MaxFailureRate pauses the schedule once the fraction of the runs closed
+	// within FailureRateWindow that failed exceeds it. Must be below 1.
+	MaxFailureRate    float64       `json:"maxFailureRate,omitempty"`
+	FailureRateWindow time.Duration `json:"failureRateWindow,omitempty"`
+	// MinRunsInWindow is the number of runs that must have closed within
+	// FailureRateWindow before MaxFailureRate is checked.
+	MinRunsInWindow int32 `json:"minRunsInWindow,omitempty"`
+}
+
+func (v *ScheduleFailureBudget) GetMaxConsecutiveFailures() (o int32) {
+	if v != nil {
+		return v.MaxConsecutiveFailures
+	}
+	return
+}
+
+func (v *ScheduleFailureBudget) GetMaxFailureRate() (o float64) {
+	if v != nil {
+		return v.MaxFailureRate
+	}
+	return
+}
+
+func (v *ScheduleFailureBudget) GetFailureRateWindow() (o time.Duration) {
+	if v != nil {
+		return v.FailureRateWindow
+	}
+	return
+}
+
+func (v *ScheduleFailureBudget) GetMinRunsInWindow() (o int32) {
+	if v != nil {
+		return v.MinRunsInWindow
+	}
+	return
+}
+
 // SchedulePauseInfo captures the state of a paused schedule. Reason and PausedBy may be
 // provided as input on CreateSchedule; PausedAt is always server-populated.
 type SchedulePauseInfo struct {
@@ -398,6 +687,8 @@ type ScheduleInfo struct {
 	OngoingBackfills     []*BackfillInfo `json:"ongoingBackfills,omitempty"`
 	BufferedFireCount    int64           `json:"bufferedFireCount,omitempty"`
 	RunningWorkflowCount int64           `json:"runningWorkflowCount,omitempty"`
+	// RecentActions holds the most recent fires that started a workflow, oldest first.
+	RecentActions []*ScheduleActionResult `json:"recentActions,omitempty"`
 }
 
 func (v *ScheduleInfo) GetLastRunTime() (o time.Time) {
@@ -470,6 +761,67 @@ func (v *ScheduleInfo) GetRunningWorkflowCount() (o int64) {
 	return
 }
 
+func (v *ScheduleInfo) GetRecentActions() (o []*ScheduleActionResult) {
+	if v != nil {
+		return v.RecentActions
+	}
+	return
+}
+
+// ScheduleActionResult records a schedule fire that started a workflow and how
+// that workflow ended. CloseStatus is nil while the workflow is running, or if
+// its outcome could not be determined.
+type ScheduleActionResult struct {
+	ScheduledTime   time.Time                     `json:"scheduledTime,omitempty"`
+	ActualStartTime time.Time                     `json:"actualStartTime,omitempty"`
+	WorkflowID      string                        `json:"workflowId,omitempty"`
+	RunID           string                        `json:"runId,omitempty"`
+	TriggerSource   string                        `json:"triggerSource,omitempty"`
+	CloseStatus     *WorkflowExecutionCloseStatus `json:"closeStatus,omitempty"`
+}
+
+func (v *ScheduleActionResult) GetScheduledTime() (o time.Time) {
+	if v != nil {
+		return v.ScheduledTime
+	}
+	return
+}
+
+func (v *ScheduleActionResult) GetActualStartTime() (o time.Time) {
+	if v != nil {
+		return v.ActualStartTime
+	}
+	return
+}
+
+func (v *ScheduleActionResult) GetWorkflowID() (o string) {
+	if v != nil {
+		return v.WorkflowID
+	}
+	return
+}
+
+func (v *ScheduleActionResult) GetRunID() (o string) {
+	if v != nil {
+		return v.RunID
+	}
+	return
+}
+
+func (v *ScheduleActionResult) GetTriggerSource() (o string) {
+	if v != nil {
+		return v.TriggerSource
+	}
+	return
+}
+
+func (v *ScheduleActionResult) GetCloseStatus() (o WorkflowExecutionCloseStatus) {
+	if v != nil && v.CloseStatus != nil {
+		return *v.CloseStatus
+	}
+	return
+}
+
 func (v *StartWorkflowAction) GetInput() (o []byte) {
 	if v != nil {
 		return v.Input
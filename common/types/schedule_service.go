@@ -480,3 +480,37 @@ func (v *BackfillScheduleRequest) GetBackfillID() (o string) {
 
 // BackfillScheduleResponse is the response for triggering a backfill.
 type BackfillScheduleResponse struct{}
+
+// TriggerScheduleRequest is the request to fire a schedule's action
+// immediately, outside of its spec.
+type TriggerScheduleRequest struct {
+	Domain     string `json:"domain,omitempty"`
+	ScheduleID string `json:"scheduleId,omitempty"`
+	// OverlapPolicy overrides the schedule's overlap policy for this fire.
+	// Invalid (the zero value) keeps the schedule's policy.
+	OverlapPolicy ScheduleOverlapPolicy `json:"overlapPolicy,omitempty"`
+}
+
+func (v *TriggerScheduleRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+func (v *TriggerScheduleRequest) GetScheduleID() (o string) {
+	if v != nil {
+		return v.ScheduleID
+	}
+	return
+}
+
+func (v *TriggerScheduleRequest) GetOverlapPolicy() (o ScheduleOverlapPolicy) {
+	if v != nil {
+		return v.OverlapPolicy
+	}
+	return
+}
+
+// TriggerScheduleResponse is the response for triggering a schedule.
+type TriggerScheduleResponse struct{}
@@ -164,3 +164,21 @@ func TestBackfillScheduleRequest_Getters(t *testing.T) {
 	assert.Equal(t, ScheduleOverlapPolicyBuffer, v.GetOverlapPolicy())
 	assert.Equal(t, "bf-1", v.GetBackfillID())
 }
+
+func TestTriggerScheduleRequest_NilGetters(t *testing.T) {
+	var v *TriggerScheduleRequest
+	assert.Equal(t, "", v.GetDomain())
+	assert.Equal(t, "", v.GetScheduleID())
+	assert.Equal(t, ScheduleOverlapPolicyInvalid, v.GetOverlapPolicy())
+}
+
+func TestTriggerScheduleRequest_Getters(t *testing.T) {
+	v := &TriggerScheduleRequest{
+		Domain:        "test-domain",
+		ScheduleID:    "sched-1",
+		OverlapPolicy: ScheduleOverlapPolicyConcurrent,
+	}
+	assert.Equal(t, "test-domain", v.GetDomain())
+	assert.Equal(t, "sched-1", v.GetScheduleID())
+	assert.Equal(t, ScheduleOverlapPolicyConcurrent, v.GetOverlapPolicy())
+}
@@ -0,0 +1,255 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package types
+
+import "time"
+
+// SemaphoreInfo is the configuration of a domain scoped distributed semaphore
+type SemaphoreInfo struct {
+	Name        string    `json:"name,omitempty"`
+	Size        int32     `json:"size,omitempty"`
+	BucketSize  int32     `json:"bucketSize,omitempty"`
+	CreatedTime time.Time `json:"createdTime,omitempty"`
+}
+
+func (v *SemaphoreInfo) GetName() (o string) {
+	if v != nil {
+		return v.Name
+	}
+	return
+}
+
+func (v *SemaphoreInfo) GetSize() (o int32) {
+	if v != nil {
+		return v.Size
+	}
+	return
+}
+
+func (v *SemaphoreInfo) GetBucketSize() (o int32) {
+	if v != nil {
+		return v.BucketSize
+	}
+	return
+}
+
+func (v *SemaphoreInfo) GetCreatedTime() (o time.Time) {
+	if v != nil {
+		return v.CreatedTime
+	}
+	return
+}
+
+// SemaphorePermitRequest is a request of a workflow for permits of a semaphore, either granted (a lease) or waiting
+type SemaphorePermitRequest struct {
+	RequestID     string             `json:"requestID,omitempty"`
+	Execution     *WorkflowExecution `json:"execution,omitempty"`
+	Permits       int32              `json:"permits,omitempty"`
+	RequestedTime time.Time          `json:"requestedTime,omitempty"`
+	AcquiredTime  time.Time          `json:"acquiredTime,omitempty"`
+}
+
+func (v *SemaphorePermitRequest) GetRequestID() (o string) {
+	if v != nil {
+		return v.RequestID
+	}
+	return
+}
+
+func (v *SemaphorePermitRequest) GetExecution() (o *WorkflowExecution) {
+	if v != nil && v.Execution != nil {
+		return v.Execution
+	}
+	return
+}
+
+func (v *SemaphorePermitRequest) GetPermits() (o int32) {
+	if v != nil {
+		return v.Permits
+	}
+	return
+}
+
+func (v *SemaphorePermitRequest) GetRequestedTime() (o time.Time) {
+	if v != nil {
+		return v.RequestedTime
+	}
+	return
+}
+
+func (v *SemaphorePermitRequest) GetAcquiredTime() (o time.Time) {
+	if v != nil {
+		return v.AcquiredTime
+	}
+	return
+}
+
+// CreateSemaphoreRequest is the request to create a semaphore in a domain
+type CreateSemaphoreRequest struct {
+	Domain        string `json:"domain,omitempty"`
+	SemaphoreName string `json:"semaphoreName,omitempty"`
+	Size          int32  `json:"size,omitempty"`
+	BucketSize    int32  `json:"bucketSize,omitempty"`
+}
+
+func (v *CreateSemaphoreRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+func (v *CreateSemaphoreRequest) GetSemaphoreName() (o string) {
+	if v != nil {
+		return v.SemaphoreName
+	}
+	return
+}
+
+func (v *CreateSemaphoreRequest) GetSize() (o int32) {
+	if v != nil {
+		return v.Size
+	}
+	return
+}
+
+func (v *CreateSemaphoreRequest) GetBucketSize() (o int32) {
+	if v != nil {
+		return v.BucketSize
+	}
+	return
+}
+
+// CreateSemaphoreResponse is the response to CreateSemaphoreRequest
+type CreateSemaphoreResponse struct {
+	Semaphore *SemaphoreInfo `json:"semaphore,omitempty"`
+}
+
+func (v *CreateSemaphoreResponse) GetSemaphore() (o *SemaphoreInfo) {
+	if v != nil && v.Semaphore != nil {
+		return v.Semaphore
+	}
+	return
+}
+
+// DescribeSemaphoreRequest is the request to describe the configuration and holders of a semaphore
+type DescribeSemaphoreRequest struct {
+	Domain        string `json:"domain,omitempty"`
+	SemaphoreName string `json:"semaphoreName,omitempty"`
+}
+
+func (v *DescribeSemaphoreRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+func (v *DescribeSemaphoreRequest) GetSemaphoreName() (o string) {
+	if v != nil {
+		return v.SemaphoreName
+	}
+	return
+}
+
+// DescribeSemaphoreResponse is the response to DescribeSemaphoreRequest
+type DescribeSemaphoreResponse struct {
+	Semaphore        *SemaphoreInfo            `json:"semaphore,omitempty"`
+	AvailablePermits int32                     `json:"availablePermits,omitempty"`
+	Holders          []*SemaphorePermitRequest `json:"holders,omitempty"`
+	Waiters          []*SemaphorePermitRequest `json:"waiters,omitempty"`
+}
+
+func (v *DescribeSemaphoreResponse) GetSemaphore() (o *SemaphoreInfo) {
+	if v != nil && v.Semaphore != nil {
+		return v.Semaphore
+	}
+	return
+}
+
+func (v *DescribeSemaphoreResponse) GetAvailablePermits() (o int32) {
+	if v != nil {
+		return v.AvailablePermits
+	}
+	return
+}
+
+func (v *DescribeSemaphoreResponse) GetHolders() (o []*SemaphorePermitRequest) {
+	if v != nil && v.Holders != nil {
+		return v.Holders
+	}
+	return
+}
+
+func (v *DescribeSemaphoreResponse) GetWaiters() (o []*SemaphorePermitRequest) {
+	if v != nil && v.Waiters != nil {
+		return v.Waiters
+	}
+	return
+}
+
+// ListSemaphoresRequest is the request to list the semaphores of a domain
+type ListSemaphoresRequest struct {
+	Domain        string `json:"domain,omitempty"`
+	PageSize      int32  `json:"pageSize,omitempty"`
+	NextPageToken []byte `json:"nextPageToken,omitempty"`
+}
+
+func (v *ListSemaphoresRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+func (v *ListSemaphoresRequest) GetPageSize() (o int32) {
+	if v != nil {
+		return v.PageSize
+	}
+	return
+}
+
+func (v *ListSemaphoresRequest) GetNextPageToken() (o []byte) {
+	if v != nil && v.NextPageToken != nil {
+		return v.NextPageToken
+	}
+	return
+}
+
+// ListSemaphoresResponse is the response to ListSemaphoresRequest
+type ListSemaphoresResponse struct {
+	Semaphores    []*SemaphoreInfo `json:"semaphores,omitempty"`
+	NextPageToken []byte           `json:"nextPageToken,omitempty"`
+}
+
+func (v *ListSemaphoresResponse) GetSemaphores() (o []*SemaphoreInfo) {
+	if v != nil && v.Semaphores != nil {
+		return v.Semaphores
+	}
+	return
+}
+
+func (v *ListSemaphoresResponse) GetNextPageToken() (o []byte) {
+	if v != nil && v.NextPageToken != nil {
+		return v.NextPageToken
+	}
+	return
+}
@@ -3177,6 +3177,10 @@ func (e EventType) String() string {
 		return "ExternalWorkflowExecutionSignaled"
 	case 41:
 		return "UpsertWorkflowSearchAttributes"
+	case 42:
+		return "WorkflowExecutionPaused"
+	case 43:
+		return "WorkflowExecutionUnpaused"
 	}
 	return fmt.Sprintf("EventType(%d)", w)
 }
@@ -3310,6 +3314,12 @@ func (e *EventType) UnmarshalText(value []byte) error {
 	case "UPSERTWORKFLOWSEARCHATTRIBUTES":
 		*e = EventTypeUpsertWorkflowSearchAttributes
 		return nil
+	case "WORKFLOWEXECUTIONPAUSED":
+		*e = EventTypeWorkflowExecutionPaused
+		return nil
+	case "WORKFLOWEXECUTIONUNPAUSED":
+		*e = EventTypeWorkflowExecutionUnpaused
+		return nil
 	default:
 		val, err := strconv.ParseInt(s, 10, 32)
 		if err != nil {
@@ -3410,6 +3420,10 @@ const (
 	EventTypeExternalWorkflowExecutionSignaled
 	// EventTypeUpsertWorkflowSearchAttributes is an option for EventType
 	EventTypeUpsertWorkflowSearchAttributes
+	// EventTypeWorkflowExecutionPaused is an option for EventType
+	EventTypeWorkflowExecutionPaused
+	// EventTypeWorkflowExecutionUnpaused is an option for EventType
+	EventTypeWorkflowExecutionUnpaused
 )
 
 // ExternalWorkflowExecutionCancelRequestedEventAttributes is an internal type (TBD...)
@@ -3726,6 +3740,8 @@ type HistoryEvent struct {
 	SignalExternalWorkflowExecutionFailedEventAttributes           *SignalExternalWorkflowExecutionFailedEventAttributes           `json:"signalExternalWorkflowExecutionFailedEventAttributes,omitempty"`
 	ExternalWorkflowExecutionSignaledEventAttributes               *ExternalWorkflowExecutionSignaledEventAttributes               `json:"externalWorkflowExecutionSignaledEventAttributes,omitempty"`
 	UpsertWorkflowSearchAttributesEventAttributes                  *UpsertWorkflowSearchAttributesEventAttributes                  `json:"upsertWorkflowSearchAttributesEventAttributes,omitempty"`
+	WorkflowExecutionPausedEventAttributes                         *WorkflowExecutionPausedEventAttributes                         `json:"workflowExecutionPausedEventAttributes,omitempty"`
+	WorkflowExecutionUnpausedEventAttributes                       *WorkflowExecutionUnpausedEventAttributes                       `json:"workflowExecutionUnpausedEventAttributes,omitempty"`
 }
 
 // GetTimestamp is an internal getter (TBD...)
@@ -4080,6 +4096,22 @@ func (v *HistoryEvent) GetUpsertWorkflowSearchAttributesEventAttributes() (o *Up
 	return
 }
 
+// GetWorkflowExecutionPausedEventAttributes is an internal getter (TBD...)
+func (v *HistoryEvent) GetWorkflowExecutionPausedEventAttributes() (o *WorkflowExecutionPausedEventAttributes) {
+	if v != nil && v.WorkflowExecutionPausedEventAttributes != nil {
+		return v.WorkflowExecutionPausedEventAttributes
+	}
+	return
+}
+
+// GetWorkflowExecutionUnpausedEventAttributes is an internal getter (TBD...)
+func (v *HistoryEvent) GetWorkflowExecutionUnpausedEventAttributes() (o *WorkflowExecutionUnpausedEventAttributes) {
+	if v != nil && v.WorkflowExecutionUnpausedEventAttributes != nil {
+		return v.WorkflowExecutionUnpausedEventAttributes
+	}
+	return
+}
+
 // Size is an internal method to get the estimated size of the event
 func (v *HistoryEvent) ByteSize() uint64 {
 	if v == nil {
@@ -4256,6 +4288,14 @@ func (v *HistoryEvent) ByteSize() uint64 {
 		size += v.UpsertWorkflowSearchAttributesEventAttributes.ByteSize()
 	}
 
+	if v.WorkflowExecutionPausedEventAttributes != nil {
+		size += v.WorkflowExecutionPausedEventAttributes.ByteSize()
+	}
+
+	if v.WorkflowExecutionUnpausedEventAttributes != nil {
+		size += v.WorkflowExecutionUnpausedEventAttributes.ByteSize()
+	}
+
 	return size
 }
 
@@ -4810,6 +4850,8 @@ type PendingActivityInfo struct {
 	LastFailureDetails     []byte                `json:"lastFailureDetails,omitempty"`
 	LastFailureOptions     *FailureOptions       `json:"lastFailureOptions,omitempty"`
 	ScheduleID             int64                 `json:"scheduleID,omitempty"`
+	// IsPaused is only set by DescribeWorkflowExecution
+	IsPaused bool `json:"isPaused,omitempty"`
 }
 
 // GetActivityID is an internal getter (TBD...)
@@ -4900,6 +4942,14 @@ func (v *PendingActivityInfo) GetScheduleID() (o int64) {
 	return
 }
 
+// GetIsPaused is an internal getter (TBD...)
+func (v *PendingActivityInfo) GetIsPaused() (o bool) {
+	if v != nil {
+		return v.IsPaused
+	}
+	return
+}
+
 // PendingActivityState is an internal type (TBD...)
 type PendingActivityState int32
 
@@ -6669,6 +6719,15 @@ type RetryPolicy struct {
 	MaximumAttempts             int32    `json:"maximumAttempts,omitempty"`
 	NonRetriableErrorReasons    []string `json:"nonRetriableErrorReasons,omitempty"`
 	ExpirationIntervalInSeconds int32    `json:"expirationIntervalInSeconds,omitempty"`
+	// JitterCoefficient in [0, 1) shortens each backoff interval by a random fraction up to it,
+	// so that activities and workflows failing together do not retry in lockstep
+	JitterCoefficient float64 `json:"jitterCoefficient,omitempty"`
+	// RetryableErrorReasons switches to allowlist mode when not empty: only the failure reasons
+	// listed are retried. NonRetriableErrorReasons still take precedence.
+	RetryableErrorReasons []string `json:"retryableErrorReasons,omitempty"`
+	// ErrorReasonOverrides overrides the backoff for the failure reasons matching their pattern,
+	// the first matching override is used
+	ErrorReasonOverrides []*RetryPolicyOverride `json:"errorReasonOverrides,omitempty"`
 }
 
 // GetInitialIntervalInSeconds is an internal getter (TBD...)
@@ -6719,6 +6778,30 @@ func (v *RetryPolicy) GetExpirationIntervalInSeconds() (o int32) {
 	return
 }
 
+// GetJitterCoefficient is an internal getter (TBD...)
+func (v *RetryPolicy) GetJitterCoefficient() (o float64) {
+	if v != nil {
+		return v.JitterCoefficient
+	}
+	return
+}
+
+// GetRetryableErrorReasons is an internal getter (TBD...)
+func (v *RetryPolicy) GetRetryableErrorReasons() (o []string) {
+	if v != nil && v.RetryableErrorReasons != nil {
+		return v.RetryableErrorReasons
+	}
+	return
+}
+
+// GetErrorReasonOverrides is an internal getter (TBD...)
+func (v *RetryPolicy) GetErrorReasonOverrides() (o []*RetryPolicyOverride) {
+	if v != nil && v.ErrorReasonOverrides != nil {
+		return v.ErrorReasonOverrides
+	}
+	return
+}
+
 // RetryTaskV2Error is an internal type (TBD...)
 type RetryTaskV2Error struct {
 	Message           string `json:"message,required"`
@@ -6801,6 +6884,8 @@ type ScheduleActivityTaskDecisionAttributes struct {
 	RetryPolicy                   *RetryPolicy  `json:"retryPolicy,omitempty"`
 	Header                        *Header       `json:"header,omitempty"`
 	RequestLocalDispatch          bool          `json:"requestLocalDispatch,omitempty"`
+	Priority                      int32         `json:"priority,omitempty"`
+	FairnessKey                   string        `json:"fairnessKey,omitempty"`
 }
 
 // GetActivityID is an internal getter (TBD...)
@@ -6859,6 +6944,22 @@ func (v *ScheduleActivityTaskDecisionAttributes) GetHeartbeatTimeoutSeconds() (o
 	return
 }
 
+// GetPriority is an internal getter (TBD...)
+func (v *ScheduleActivityTaskDecisionAttributes) GetPriority() (o int32) {
+	if v != nil {
+		return v.Priority
+	}
+	return
+}
+
+// GetFairnessKey is an internal getter (TBD...)
+func (v *ScheduleActivityTaskDecisionAttributes) GetFairnessKey() (o string) {
+	if v != nil {
+		return v.FairnessKey
+	}
+	return
+}
+
 // SearchAttributes is an internal type (TBD...)
 type SearchAttributes struct {
 	IndexedFields map[string][]byte `json:"indexedFields,omitempty"`
@@ -7058,6 +7159,8 @@ type SignalWithStartWorkflowExecutionRequest struct {
 	FirstRunAtTimestamp                 *int64                        `json:"firstRunAtTimestamp,omitempty"`
 	CronOverlapPolicy                   *CronOverlapPolicy            `json:"cronOverlapPolicy,omitempty"`
 	ActiveClusterSelectionPolicy        *ActiveClusterSelectionPolicy `json:"activeClusterSelectionPolicy,omitempty"`
+	Priority                            int32                         `json:"priority,omitempty"`
+	FairnessKey                         string                        `json:"fairnessKey,omitempty"`
 }
 
 // GetDomain is an internal getter (TBD...)
@@ -7156,6 +7259,22 @@ func (v *SignalWithStartWorkflowExecutionRequest) GetCronSchedule() (o string) {
 	return
 }
 
+// GetPriority is an internal getter (TBD...)
+func (v *SignalWithStartWorkflowExecutionRequest) GetPriority() (o int32) {
+	if v != nil {
+		return v.Priority
+	}
+	return
+}
+
+// GetFairnessKey is an internal getter (TBD...)
+func (v *SignalWithStartWorkflowExecutionRequest) GetFairnessKey() (o string) {
+	if v != nil {
+		return v.FairnessKey
+	}
+	return
+}
+
 // SignalWithStartWorkflowExecutionAsyncRequest is an internal type (TBD...)
 type SignalWithStartWorkflowExecutionAsyncRequest struct {
 	*SignalWithStartWorkflowExecutionRequest
@@ -7534,6 +7653,8 @@ type StartWorkflowExecutionRequest struct {
 	FirstRunAtTimeStamp                 *int64                        `json:"firstRunAtTimeStamp,omitempty"`
 	CronOverlapPolicy                   *CronOverlapPolicy            `json:"cronOverlapPolicy,omitempty"`
 	ActiveClusterSelectionPolicy        *ActiveClusterSelectionPolicy `json:"activeClusterSelectionPolicy,omitempty"`
+	Priority                            int32                         `json:"priority,omitempty"`
+	FairnessKey                         string                        `json:"fairnessKey,omitempty"`
 }
 
 // GetDomain is an internal getter (TBD...)
@@ -7624,6 +7745,22 @@ func (v *StartWorkflowExecutionRequest) GetCronSchedule() (o string) {
 	return
 }
 
+// GetPriority is an internal getter (TBD...)
+func (v *StartWorkflowExecutionRequest) GetPriority() (o int32) {
+	if v != nil {
+		return v.Priority
+	}
+	return
+}
+
+// GetFairnessKey is an internal getter (TBD...)
+func (v *StartWorkflowExecutionRequest) GetFairnessKey() (o string) {
+	if v != nil {
+		return v.FairnessKey
+	}
+	return
+}
+
 // GetCronOverlapPolicy is an internal getter (TBD...)
 func (v *StartWorkflowExecutionRequest) GetCronOverlapPolicy() (o CronOverlapPolicy) {
 	if v != nil && v.CronOverlapPolicy != nil {
@@ -7838,6 +7975,9 @@ type TaskListStatus struct {
 	IsolationGroupMetrics map[string]*IsolationGroupMetrics `json:"isolationGroupMetrics,omitempty"`
 	NewTasksPerSecond     float64                           `json:"newTasksPerSecond,omitempty"`
 	Empty                 bool                              `json:"empty,omitempty"`
+	// FairnessKeyBacklogCounts is the number of tasks per fairness key that are loaded in memory
+	// and waiting for dispatch, tasks without a fairness key are counted under the empty key
+	FairnessKeyBacklogCounts map[string]int64 `json:"fairnessKeyBacklogCounts,omitempty"`
 }
 
 // GetBacklogCountHint is an internal getter (TBD...)
@@ -7880,6 +8020,14 @@ func (v *TaskListStatus) GetTaskIDBlock() (o *TaskIDBlock) {
 	return
 }
 
+// GetFairnessKeyBacklogCounts is an internal getter (TBD...)
+func (v *TaskListStatus) GetFairnessKeyBacklogCounts() (o map[string]int64) {
+	if v != nil && v.FairnessKeyBacklogCounts != nil {
+		return v.FairnessKeyBacklogCounts
+	}
+	return
+}
+
 // TaskListType is an internal type (TBD...)
 type TaskListType int32
 
@@ -8762,6 +8910,8 @@ type WorkflowExecutionInfo struct {
 	CronSchedule                 *string                       `json:"cronSchedule,omitempty"`
 	ExecutionStatus              *WorkflowExecutionStatus      `json:"executionStatus,omitempty"`
 	ScheduledExecutionTime       *int64                        `json:"scheduledExecutionTime,omitempty"`
+	// IsPaused is only set by DescribeWorkflowExecution
+	IsPaused bool `json:"isPaused,omitempty"`
 }
 
 // GetExecution is an internal getter (TBD...)
@@ -8844,6 +8994,14 @@ func (v *WorkflowExecutionInfo) GetCronSchedule() (o string) {
 	return
 }
 
+// GetIsPaused is an internal getter (TBD...)
+func (v *WorkflowExecutionInfo) GetIsPaused() (o bool) {
+	if v != nil {
+		return v.IsPaused
+	}
+	return
+}
+
 // GetExecutionStatus is an internal getter (TBD...)
 func (v *WorkflowExecutionInfo) GetExecutionStatus() (o WorkflowExecutionStatus) {
 	if v != nil && v.ExecutionStatus != nil {
@@ -8860,6 +9018,33 @@ func (v *WorkflowExecutionInfo) GetScheduledExecutionTime() (o int64) {
 	return
 }
 
+// WorkflowExecutionPausedEventAttributes is an internal type (TBD...)
+type WorkflowExecutionPausedEventAttributes struct {
+	Reason   string `json:"reason,omitempty"`
+	Identity string `json:"identity,omitempty"`
+}
+
+// GetReason is an internal getter (TBD...)
+func (v *WorkflowExecutionPausedEventAttributes) GetReason() (o string) {
+	if v != nil {
+		return v.Reason
+	}
+	return
+}
+
+// GetIdentity is an internal getter (TBD...)
+func (v *WorkflowExecutionPausedEventAttributes) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
+	}
+	return
+}
+
+// Size returns the approximate memory used in bytes
+func (v *WorkflowExecutionPausedEventAttributes) ByteSize() uint64 {
+	return 0
+}
+
 // WorkflowExecutionSignaledEventAttributes is an internal type (TBD...)
 type WorkflowExecutionSignaledEventAttributes struct {
 	SignalName string `json:"signalName,omitempty"`
@@ -9135,6 +9320,33 @@ func (v *WorkflowExecutionTimedOutEventAttributes) ByteSize() uint64 {
 	return 0
 }
 
+// WorkflowExecutionUnpausedEventAttributes is an internal type (TBD...)
+type WorkflowExecutionUnpausedEventAttributes struct {
+	Reason   string `json:"reason,omitempty"`
+	Identity string `json:"identity,omitempty"`
+}
+
+// GetReason is an internal getter (TBD...)
+func (v *WorkflowExecutionUnpausedEventAttributes) GetReason() (o string) {
+	if v != nil {
+		return v.Reason
+	}
+	return
+}
+
+// GetIdentity is an internal getter (TBD...)
+func (v *WorkflowExecutionUnpausedEventAttributes) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
+	}
+	return
+}
+
+// Size returns the approximate memory used in bytes
+func (v *WorkflowExecutionUnpausedEventAttributes) ByteSize() uint64 {
+	return 0
+}
+
 // WorkflowIDReusePolicy is an internal type (TBD...)
 type WorkflowIDReusePolicy int32
 
@@ -168,6 +168,23 @@ var (
 		NonRetriableErrorReasons:    []string{"a", "b"},
 		ExpirationIntervalInSeconds: 4,
 	}
+	RetryPolicyWithExtensions = types.RetryPolicy{
+		InitialIntervalInSeconds:    1,
+		BackoffCoefficient:          1.1,
+		MaximumIntervalInSeconds:    2,
+		MaximumAttempts:             3,
+		NonRetriableErrorReasons:    []string{"a", "b"},
+		ExpirationIntervalInSeconds: 4,
+		JitterCoefficient:           0.2,
+		RetryableErrorReasons:       []string{"c", "d"},
+		ErrorReasonOverrides: []*types.RetryPolicyOverride{{
+			ReasonPattern:            "rate-limit.*",
+			InitialIntervalInSeconds: 5,
+			BackoffCoefficient:       3,
+			MaximumIntervalInSeconds: 6,
+			MaximumAttempts:          7,
+		}},
+	}
 	Header = types.Header{
 		Fields: map[string][]byte{
 			"HeaderField1": {211, 0},
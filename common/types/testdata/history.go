@@ -246,6 +246,15 @@ var (
 		e.EventType = types.EventTypeUpsertWorkflowSearchAttributes.Ptr()
 		e.UpsertWorkflowSearchAttributesEventAttributes = &UpsertWorkflowSearchAttributesEventAttributes
 	})
+	// the pause events are not part of HistoryEventArray as the public API does not define them
+	HistoryEvent_WorkflowExecutionPaused = generateEvent(func(e *types.HistoryEvent) {
+		e.EventType = types.EventTypeWorkflowExecutionPaused.Ptr()
+		e.WorkflowExecutionPausedEventAttributes = &WorkflowExecutionPausedEventAttributes
+	})
+	HistoryEvent_WorkflowExecutionUnpaused = generateEvent(func(e *types.HistoryEvent) {
+		e.EventType = types.EventTypeWorkflowExecutionUnpaused.Ptr()
+		e.WorkflowExecutionUnpausedEventAttributes = &WorkflowExecutionUnpausedEventAttributes
+	})
 
 	WorkflowExecutionStartedEventAttributes = types.WorkflowExecutionStartedEventAttributes{
 		WorkflowType:                        &WorkflowType,
@@ -583,6 +592,14 @@ var (
 		WorkflowExecution: &WorkflowExecution,
 		Control:           Control,
 	}
+	WorkflowExecutionPausedEventAttributes = types.WorkflowExecutionPausedEventAttributes{
+		Reason:   Reason,
+		Identity: Identity,
+	}
+	WorkflowExecutionUnpausedEventAttributes = types.WorkflowExecutionUnpausedEventAttributes{
+		Reason:   Reason,
+		Identity: Identity,
+	}
 	UpsertWorkflowSearchAttributesEventAttributes = types.UpsertWorkflowSearchAttributesEventAttributes{
 		DecisionTaskCompletedEventID: EventID1,
 		SearchAttributes:             &SearchAttributes,
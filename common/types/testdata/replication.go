@@ -62,6 +62,20 @@ var (
 		NextEventID:  EventID2,
 		ScheduledID:  EventID3,
 	}
+	DeleteWorkflowExecutionTaskAttributes = types.DeleteWorkflowExecutionTaskAttributes{
+		DomainID:   DomainID,
+		WorkflowID: WorkflowID,
+		RunID:      RunID,
+		Version:    Version1,
+	}
+	UpsertWorkflowSearchAttributesTaskAttributes = types.UpsertWorkflowSearchAttributesTaskAttributes{
+		DomainID:         DomainID,
+		WorkflowID:       WorkflowID,
+		RunID:            RunID,
+		Version:          Version1,
+		SearchAttributes: &SearchAttributes,
+		Memo:             &Memo,
+	}
 	ReplicationTaskInfoArray = []*types.ReplicationTaskInfo{
 		&ReplicationTaskInfo,
 	}
@@ -48,6 +48,25 @@ var (
 		StartTime:      scheduleTimeLocal1,
 		EndTime:        scheduleTimeLocal4,
 		Jitter:         30 * time.Second,
+		Intervals:      []*types.ScheduleIntervalSpec{&ScheduleIntervalSpec},
+		Calendars:      []*types.ScheduleCalendarSpec{&ScheduleCalendarSpec},
+		ExcludeCalendars: []*types.ScheduleCalendarSpec{
+			{Month: "DEC", DayOfMonth: "25", Comment: "christmas"},
+		},
+	}
+
+	ScheduleIntervalSpec = types.ScheduleIntervalSpec{
+		Interval: time.Hour,
+		Offset:   15 * time.Minute,
+	}
+
+	ScheduleCalendarSpec = types.ScheduleCalendarSpec{
+		Minute:     "30",
+		Hour:       "9",
+		DayOfMonth: "L",
+		DayOfWeek:  "MON-FRI",
+		TimeZone:   "America/New_York",
+		Comment:    "last business day of the month",
 	}
 
 	SchedulePauseInfoThrift = types.SchedulePauseInfo{
@@ -80,6 +99,16 @@ var (
 		OngoingBackfills:     []*types.BackfillInfo{&ScheduleBackfillInfoThrift},
 		BufferedFireCount:    5,
 		RunningWorkflowCount: 2,
+		RecentActions:        []*types.ScheduleActionResult{&ScheduleActionResultThrift},
+	}
+
+	ScheduleActionResultThrift = types.ScheduleActionResult{
+		ScheduledTime:   scheduleTimeLocal2,
+		ActualStartTime: scheduleTimeLocal2,
+		WorkflowID:      "sched-wf-2026-06-15T12:30:00Z",
+		RunID:           RunID,
+		TriggerSource:   "schedule",
+		CloseStatus:     types.WorkflowExecutionCloseStatusCompleted.Ptr(),
 	}
 
 	ScheduleListEntryThrift = types.ScheduleListEntry{
@@ -156,6 +185,19 @@ var (
 		StartWorkflow: &ScheduleStartWorkflowAction,
 	}
 
+	ScheduleSignalWorkflowAction = types.SignalWorkflowAction{
+		WorkflowID:  WorkflowID,
+		SignalName:  SignalName,
+		SignalInput: Payload1,
+	}
+
+	ScheduleSignalWithStartWorkflowAction = types.SignalWithStartWorkflowAction{
+		WorkflowID:    WorkflowID,
+		SignalName:    SignalName,
+		SignalInput:   Payload2,
+		StartWorkflow: &ScheduleStartWorkflowAction,
+	}
+
 	SchedulePolicies = types.SchedulePolicies{
 		OverlapPolicy:    types.ScheduleOverlapPolicyBuffer,
 		CatchUpPolicy:    types.ScheduleCatchUpPolicyAll,
@@ -165,6 +207,13 @@ var (
 		ConcurrencyLimit: 2,
 	}
 
+	ScheduleFailureBudget = types.ScheduleFailureBudget{
+		MaxConsecutiveFailures: 3,
+		MaxFailureRate:         0.5,
+		FailureRateWindow:      time.Hour,
+		MinRunsInWindow:        10,
+	}
+
 	SchedulePauseInfo = types.SchedulePauseInfo{
 		Reason:   "maintenance window",
 		PausedAt: scheduleTime2,
@@ -299,4 +348,12 @@ var (
 	}
 
 	BackfillScheduleResponse = types.BackfillScheduleResponse{}
+
+	TriggerScheduleRequest = types.TriggerScheduleRequest{
+		Domain:        DomainName,
+		ScheduleID:    "my-schedule-id",
+		OverlapPolicy: types.ScheduleOverlapPolicySkipNew,
+	}
+
+	TriggerScheduleResponse = types.TriggerScheduleResponse{}
 )
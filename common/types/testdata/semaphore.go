@@ -0,0 +1,91 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package testdata
+
+import (
+	"time"
+
+	"github.com/uber/cadence/common/types"
+)
+
+var (
+	// semaphoreTime* use time.Unix so the Location is local — matching what
+	// nanoToTimeVal returns in the thrift mapper round-trip tests.
+	semaphoreTime1 = time.Unix(time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC).Unix(), 0)
+	semaphoreTime2 = time.Unix(time.Date(2026, 3, 1, 10, 5, 0, 0, time.UTC).Unix(), 0)
+	semaphoreTime3 = time.Unix(time.Date(2026, 3, 1, 10, 6, 0, 0, time.UTC).Unix(), 0)
+
+	SemaphoreInfo = types.SemaphoreInfo{
+		Name:        "my-semaphore",
+		Size:        10,
+		BucketSize:  2,
+		CreatedTime: semaphoreTime1,
+	}
+
+	SemaphoreHolder = types.SemaphorePermitRequest{
+		RequestID:     "request-001",
+		Execution:     &WorkflowExecution,
+		Permits:       3,
+		RequestedTime: semaphoreTime2,
+		AcquiredTime:  semaphoreTime3,
+	}
+
+	SemaphoreWaiter = types.SemaphorePermitRequest{
+		RequestID:     "request-002",
+		Execution:     &WorkflowExecution,
+		Permits:       8,
+		RequestedTime: semaphoreTime3,
+	}
+
+	CreateSemaphoreRequest = types.CreateSemaphoreRequest{
+		Domain:        DomainName,
+		SemaphoreName: "my-semaphore",
+		Size:          10,
+		BucketSize:    2,
+	}
+
+	CreateSemaphoreResponse = types.CreateSemaphoreResponse{
+		Semaphore: &SemaphoreInfo,
+	}
+
+	DescribeSemaphoreRequest = types.DescribeSemaphoreRequest{
+		Domain:        DomainName,
+		SemaphoreName: "my-semaphore",
+	}
+
+	DescribeSemaphoreResponse = types.DescribeSemaphoreResponse{
+		Semaphore:        &SemaphoreInfo,
+		AvailablePermits: 7,
+		Holders:          []*types.SemaphorePermitRequest{&SemaphoreHolder},
+		Waiters:          []*types.SemaphorePermitRequest{&SemaphoreWaiter},
+	}
+
+	ListSemaphoresRequest = types.ListSemaphoresRequest{
+		Domain:        DomainName,
+		PageSize:      PageSize,
+		NextPageToken: NextPageToken,
+	}
+
+	ListSemaphoresResponse = types.ListSemaphoresResponse{
+		Semaphores:    []*types.SemaphoreInfo{&SemaphoreInfo},
+		NextPageToken: NextPageToken,
+	}
+)
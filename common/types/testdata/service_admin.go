@@ -46,6 +46,14 @@ var (
 		ExecutionsDeleted: false,
 		VisibilityDeleted: true,
 	}
+	AdminUpsertWorkflowSearchAttributesRequest = types.AdminUpsertWorkflowSearchAttributesRequest{
+		Domain:           DomainName,
+		Execution:        &WorkflowExecution,
+		SearchAttributes: &SearchAttributes,
+		Memo:             &Memo,
+		Reason:           Reason,
+		Identity:         Identity,
+	}
 	AdminDescribeClusterResponse = types.DescribeClusterResponse{
 		SupportedClientVersions: &SupportedClientVersions,
 		MembershipInfo:          &MembershipInfo,
@@ -198,6 +198,18 @@ var (
 	CountWorkflowExecutionsResponse = types.CountWorkflowExecutionsResponse{
 		Count: int64(8),
 	}
+	AggregateWorkflowExecutionsRequest = types.AggregateWorkflowExecutionsRequest{
+		Domain:    DomainName,
+		Query:     VisibilityQuery,
+		GroupBy:   "CustomKeywordField",
+		MaxGroups: 5,
+	}
+	AggregateWorkflowExecutionsResponse = types.AggregateWorkflowExecutionsResponse{
+		Groups: []*types.WorkflowExecutionGroup{
+			{Key: "keyword1", Count: int64(5)},
+			{Key: "keyword2", Count: int64(3)},
+		},
+	}
 	GetSearchAttributesResponse = types.GetSearchAttributesResponse{
 		Keys: IndexedValueTypeMap,
 	}
@@ -383,6 +395,64 @@ var (
 		RequestID:         RequestID,
 		Control:           Control,
 	}
+	UpdateWorkflowExecutionRequest = types.UpdateWorkflowExecutionRequest{
+		Domain:            DomainName,
+		WorkflowExecution: &WorkflowExecution,
+		UpdateID:          RequestID,
+		UpdateName:        SignalName,
+		Input:             Payload1,
+		Identity:          Identity,
+	}
+	UpdateWorkflowExecutionResponse = types.UpdateWorkflowExecutionResponse{
+		UpdateID:       RequestID,
+		Result:         Payload2,
+		FailureReason:  common.StringPtr(FailureReason),
+		FailureDetails: FailureDetails,
+	}
+	PauseWorkflowExecutionRequest = types.PauseWorkflowExecutionRequest{
+		Domain:            DomainName,
+		WorkflowExecution: &WorkflowExecution,
+		Reason:            Reason,
+		Identity:          Identity,
+		RequestID:         RequestID,
+	}
+	UnpauseWorkflowExecutionRequest = types.UnpauseWorkflowExecutionRequest{
+		Domain:            DomainName,
+		WorkflowExecution: &WorkflowExecution,
+		Reason:            Reason,
+		Identity:          Identity,
+		RequestID:         RequestID,
+	}
+	PauseActivityRequest = types.PauseActivityRequest{
+		Domain:            DomainName,
+		WorkflowExecution: &WorkflowExecution,
+		ActivityID:        ActivityID,
+		Identity:          Identity,
+	}
+	UnpauseActivityRequest = types.UnpauseActivityRequest{
+		Domain:            DomainName,
+		WorkflowExecution: &WorkflowExecution,
+		ActivityID:        ActivityID,
+		Identity:          Identity,
+	}
+	ResetActivityAttemptRequest = types.ResetActivityAttemptRequest{
+		Domain:            DomainName,
+		WorkflowExecution: &WorkflowExecution,
+		ActivityID:        ActivityID,
+		Identity:          Identity,
+	}
+	RetryActivityNowRequest = types.RetryActivityNowRequest{
+		Domain:            DomainName,
+		WorkflowExecution: &WorkflowExecution,
+		ActivityID:        ActivityID,
+		Identity:          Identity,
+	}
+	DeleteWorkflowExecutionRequest = types.DeleteWorkflowExecutionRequest{
+		Domain:            DomainName,
+		WorkflowExecution: &WorkflowExecution,
+		Reason:            Reason,
+		Identity:          Identity,
+	}
 	SignalWithStartWorkflowExecutionRequest = types.SignalWithStartWorkflowExecutionRequest{
 		Domain:                              DomainName,
 		WorkflowID:                          WorkflowID,
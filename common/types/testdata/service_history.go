@@ -254,6 +254,42 @@ var (
 		ExternalWorkflowExecution: &WorkflowExecution,
 		ChildWorkflowOnly:         true,
 	}
+	HistoryUpdateWorkflowExecutionRequest = types.HistoryUpdateWorkflowExecutionRequest{
+		DomainUUID:    DomainID,
+		UpdateRequest: &UpdateWorkflowExecutionRequest,
+	}
+	HistoryPauseWorkflowExecutionRequest = types.HistoryPauseWorkflowExecutionRequest{
+		DomainUUID:   DomainID,
+		PauseRequest: &PauseWorkflowExecutionRequest,
+	}
+	HistoryUnpauseWorkflowExecutionRequest = types.HistoryUnpauseWorkflowExecutionRequest{
+		DomainUUID:     DomainID,
+		UnpauseRequest: &UnpauseWorkflowExecutionRequest,
+	}
+	HistoryPauseActivityRequest = types.HistoryPauseActivityRequest{
+		DomainUUID:   DomainID,
+		PauseRequest: &PauseActivityRequest,
+	}
+	HistoryUnpauseActivityRequest = types.HistoryUnpauseActivityRequest{
+		DomainUUID:     DomainID,
+		UnpauseRequest: &UnpauseActivityRequest,
+	}
+	HistoryResetActivityAttemptRequest = types.HistoryResetActivityAttemptRequest{
+		DomainUUID:   DomainID,
+		ResetRequest: &ResetActivityAttemptRequest,
+	}
+	HistoryRetryActivityNowRequest = types.HistoryRetryActivityNowRequest{
+		DomainUUID:   DomainID,
+		RetryRequest: &RetryActivityNowRequest,
+	}
+	HistoryDeleteWorkflowExecutionRequest = types.HistoryDeleteWorkflowExecutionRequest{
+		DomainUUID:    DomainID,
+		DeleteRequest: &DeleteWorkflowExecutionRequest,
+	}
+	HistoryUpsertWorkflowSearchAttributesRequest = types.HistoryUpsertWorkflowSearchAttributesRequest{
+		DomainUUID: DomainID,
+		Request:    &AdminUpsertWorkflowSearchAttributesRequest,
+	}
 	HistoryStartWorkflowExecutionRequest = types.HistoryStartWorkflowExecutionRequest{
 		DomainUUID:                      DomainID,
 		StartRequest:                    &StartWorkflowExecutionRequest,
@@ -0,0 +1,91 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package types
+
+// AggregateWorkflowExecutionsRequest is the request to count the workflow executions of a domain
+// matching a query, grouped by the value of a search attribute.
+type AggregateWorkflowExecutionsRequest struct {
+	Domain    string `json:"domain,omitempty"`
+	Query     string `json:"query,omitempty"`
+	GroupBy   string `json:"groupBy,omitempty"`
+	MaxGroups int32  `json:"maxGroups,omitempty"`
+}
+
+func (v *AggregateWorkflowExecutionsRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+func (v *AggregateWorkflowExecutionsRequest) GetQuery() (o string) {
+	if v != nil {
+		return v.Query
+	}
+	return
+}
+
+func (v *AggregateWorkflowExecutionsRequest) GetGroupBy() (o string) {
+	if v != nil {
+		return v.GroupBy
+	}
+	return
+}
+
+func (v *AggregateWorkflowExecutionsRequest) GetMaxGroups() (o int32) {
+	if v != nil {
+		return v.MaxGroups
+	}
+	return
+}
+
+// AggregateWorkflowExecutionsResponse is the response to AggregateWorkflowExecutions.
+// Groups are ordered by count, largest first.
+type AggregateWorkflowExecutionsResponse struct {
+	Groups []*WorkflowExecutionGroup `json:"groups,omitempty"`
+}
+
+func (v *AggregateWorkflowExecutionsResponse) GetGroups() (o []*WorkflowExecutionGroup) {
+	if v != nil && v.Groups != nil {
+		return v.Groups
+	}
+	return
+}
+
+// WorkflowExecutionGroup is the number of workflow executions sharing the same value of the grouped search attribute
+type WorkflowExecutionGroup struct {
+	Key   string `json:"key,omitempty"`
+	Count int64  `json:"count,omitempty"`
+}
+
+func (v *WorkflowExecutionGroup) GetKey() (o string) {
+	if v != nil {
+		return v.Key
+	}
+	return
+}
+
+func (v *WorkflowExecutionGroup) GetCount() (o int64) {
+	if v != nil {
+		return v.Count
+	}
+	return
+}
@@ -0,0 +1,61 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAggregateWorkflowExecutionsRequest_Getters(t *testing.T) {
+	var nilRequest *AggregateWorkflowExecutionsRequest
+	assert.Equal(t, "", nilRequest.GetDomain())
+	assert.Equal(t, "", nilRequest.GetQuery())
+	assert.Equal(t, "", nilRequest.GetGroupBy())
+	assert.Equal(t, int32(0), nilRequest.GetMaxGroups())
+
+	v := &AggregateWorkflowExecutionsRequest{
+		Domain:    "domain",
+		Query:     "CloseTime = missing",
+		GroupBy:   "WorkflowType",
+		MaxGroups: 10,
+	}
+	assert.Equal(t, "domain", v.GetDomain())
+	assert.Equal(t, "CloseTime = missing", v.GetQuery())
+	assert.Equal(t, "WorkflowType", v.GetGroupBy())
+	assert.Equal(t, int32(10), v.GetMaxGroups())
+}
+
+func TestAggregateWorkflowExecutionsResponse_Getters(t *testing.T) {
+	var nilResponse *AggregateWorkflowExecutionsResponse
+	assert.Nil(t, nilResponse.GetGroups())
+
+	var nilGroup *WorkflowExecutionGroup
+	assert.Equal(t, "", nilGroup.GetKey())
+	assert.Equal(t, int64(0), nilGroup.GetCount())
+
+	group := &WorkflowExecutionGroup{Key: "wf-type", Count: 42}
+	v := &AggregateWorkflowExecutionsResponse{Groups: []*WorkflowExecutionGroup{group}}
+	assert.Equal(t, []*WorkflowExecutionGroup{group}, v.GetGroups())
+	assert.Equal(t, "wf-type", group.GetKey())
+	assert.Equal(t, int64(42), group.GetCount())
+}
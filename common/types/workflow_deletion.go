@@ -0,0 +1,78 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package types
+
+// DeleteWorkflowExecutionRequest is the request to permanently delete a closed workflow, its history
+// and its archived copies.
+type DeleteWorkflowExecutionRequest struct {
+	Domain            string             `json:"domain,omitempty"`
+	WorkflowExecution *WorkflowExecution `json:"workflowExecution,omitempty"`
+	Reason            string             `json:"reason,omitempty"`
+	Identity          string             `json:"identity,omitempty"`
+}
+
+func (v *DeleteWorkflowExecutionRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+func (v *DeleteWorkflowExecutionRequest) GetWorkflowExecution() (o *WorkflowExecution) {
+	if v != nil && v.WorkflowExecution != nil {
+		return v.WorkflowExecution
+	}
+	return
+}
+
+func (v *DeleteWorkflowExecutionRequest) GetReason() (o string) {
+	if v != nil {
+		return v.Reason
+	}
+	return
+}
+
+func (v *DeleteWorkflowExecutionRequest) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
+	}
+	return
+}
+
+// HistoryDeleteWorkflowExecutionRequest is the request to delete a workflow, sent to history.
+type HistoryDeleteWorkflowExecutionRequest struct {
+	DomainUUID    string                          `json:"domainUUID,omitempty"`
+	DeleteRequest *DeleteWorkflowExecutionRequest `json:"deleteRequest,omitempty"`
+}
+
+func (v *HistoryDeleteWorkflowExecutionRequest) GetDomainUUID() (o string) {
+	if v != nil {
+		return v.DomainUUID
+	}
+	return
+}
+
+func (v *HistoryDeleteWorkflowExecutionRequest) GetDeleteRequest() (o *DeleteWorkflowExecutionRequest) {
+	if v != nil && v.DeleteRequest != nil {
+		return v.DeleteRequest
+	}
+	return
+}
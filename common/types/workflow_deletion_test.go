@@ -0,0 +1,58 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeleteWorkflowExecutionRequest_Getters(t *testing.T) {
+	var nilRequest *DeleteWorkflowExecutionRequest
+	assert.Equal(t, "", nilRequest.GetDomain())
+	assert.Nil(t, nilRequest.GetWorkflowExecution())
+	assert.Equal(t, "", nilRequest.GetReason())
+	assert.Equal(t, "", nilRequest.GetIdentity())
+
+	execution := &WorkflowExecution{WorkflowID: "wid", RunID: "rid"}
+	v := &DeleteWorkflowExecutionRequest{
+		Domain:            "domain",
+		WorkflowExecution: execution,
+		Reason:            "reason",
+		Identity:          "identity",
+	}
+	assert.Equal(t, "domain", v.GetDomain())
+	assert.Equal(t, execution, v.GetWorkflowExecution())
+	assert.Equal(t, "reason", v.GetReason())
+	assert.Equal(t, "identity", v.GetIdentity())
+}
+
+func TestHistoryDeleteWorkflowExecutionRequest_Getters(t *testing.T) {
+	var nilRequest *HistoryDeleteWorkflowExecutionRequest
+	assert.Equal(t, "", nilRequest.GetDomainUUID())
+	assert.Nil(t, nilRequest.GetDeleteRequest())
+
+	request := &DeleteWorkflowExecutionRequest{Domain: "domain"}
+	v := &HistoryDeleteWorkflowExecutionRequest{DomainUUID: "domain-id", DeleteRequest: request}
+	assert.Equal(t, "domain-id", v.GetDomainUUID())
+	assert.Equal(t, request, v.GetDeleteRequest())
+}
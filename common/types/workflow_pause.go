@@ -0,0 +1,149 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package types
+
+// PauseWorkflowExecutionRequest is the request to stop dispatching tasks and firing timers of a workflow.
+type PauseWorkflowExecutionRequest struct {
+	Domain            string             `json:"domain,omitempty"`
+	WorkflowExecution *WorkflowExecution `json:"workflowExecution,omitempty"`
+	Reason            string             `json:"reason,omitempty"`
+	Identity          string             `json:"identity,omitempty"`
+	RequestID         string             `json:"requestId,omitempty"`
+}
+
+func (v *PauseWorkflowExecutionRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+func (v *PauseWorkflowExecutionRequest) GetWorkflowExecution() (o *WorkflowExecution) {
+	if v != nil && v.WorkflowExecution != nil {
+		return v.WorkflowExecution
+	}
+	return
+}
+
+func (v *PauseWorkflowExecutionRequest) GetReason() (o string) {
+	if v != nil {
+		return v.Reason
+	}
+	return
+}
+
+func (v *PauseWorkflowExecutionRequest) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
+	}
+	return
+}
+
+func (v *PauseWorkflowExecutionRequest) GetRequestID() (o string) {
+	if v != nil {
+		return v.RequestID
+	}
+	return
+}
+
+// UnpauseWorkflowExecutionRequest is the request to resume a paused workflow from where it stopped.
+type UnpauseWorkflowExecutionRequest struct {
+	Domain            string             `json:"domain,omitempty"`
+	WorkflowExecution *WorkflowExecution `json:"workflowExecution,omitempty"`
+	Reason            string             `json:"reason,omitempty"`
+	Identity          string             `json:"identity,omitempty"`
+	RequestID         string             `json:"requestId,omitempty"`
+}
+
+func (v *UnpauseWorkflowExecutionRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+func (v *UnpauseWorkflowExecutionRequest) GetWorkflowExecution() (o *WorkflowExecution) {
+	if v != nil && v.WorkflowExecution != nil {
+		return v.WorkflowExecution
+	}
+	return
+}
+
+func (v *UnpauseWorkflowExecutionRequest) GetReason() (o string) {
+	if v != nil {
+		return v.Reason
+	}
+	return
+}
+
+func (v *UnpauseWorkflowExecutionRequest) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
+	}
+	return
+}
+
+func (v *UnpauseWorkflowExecutionRequest) GetRequestID() (o string) {
+	if v != nil {
+		return v.RequestID
+	}
+	return
+}
+
+// HistoryPauseWorkflowExecutionRequest is the request to pause a workflow, sent to history.
+type HistoryPauseWorkflowExecutionRequest struct {
+	DomainUUID   string                         `json:"domainUUID,omitempty"`
+	PauseRequest *PauseWorkflowExecutionRequest `json:"pauseRequest,omitempty"`
+}
+
+func (v *HistoryPauseWorkflowExecutionRequest) GetDomainUUID() (o string) {
+	if v != nil {
+		return v.DomainUUID
+	}
+	return
+}
+
+func (v *HistoryPauseWorkflowExecutionRequest) GetPauseRequest() (o *PauseWorkflowExecutionRequest) {
+	if v != nil && v.PauseRequest != nil {
+		return v.PauseRequest
+	}
+	return
+}
+
+// HistoryUnpauseWorkflowExecutionRequest is the request to unpause a workflow, sent to history.
+type HistoryUnpauseWorkflowExecutionRequest struct {
+	DomainUUID     string                           `json:"domainUUID,omitempty"`
+	UnpauseRequest *UnpauseWorkflowExecutionRequest `json:"unpauseRequest,omitempty"`
+}
+
+func (v *HistoryUnpauseWorkflowExecutionRequest) GetDomainUUID() (o string) {
+	if v != nil {
+		return v.DomainUUID
+	}
+	return
+}
+
+func (v *HistoryUnpauseWorkflowExecutionRequest) GetUnpauseRequest() (o *UnpauseWorkflowExecutionRequest) {
+	if v != nil && v.UnpauseRequest != nil {
+		return v.UnpauseRequest
+	}
+	return
+}
@@ -0,0 +1,94 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package types
+
+// AdminUpsertWorkflowSearchAttributesRequest is the request to upsert search attributes and memo of a workflow
+// outside of its decisions.
+type AdminUpsertWorkflowSearchAttributesRequest struct {
+	Domain           string             `json:"domain,omitempty"`
+	Execution        *WorkflowExecution `json:"execution,omitempty"`
+	SearchAttributes *SearchAttributes  `json:"searchAttributes,omitempty"`
+	Memo             *Memo              `json:"memo,omitempty"`
+	Reason           string             `json:"reason,omitempty"`
+	Identity         string             `json:"identity,omitempty"`
+}
+
+func (v *AdminUpsertWorkflowSearchAttributesRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+func (v *AdminUpsertWorkflowSearchAttributesRequest) GetExecution() (o *WorkflowExecution) {
+	if v != nil && v.Execution != nil {
+		return v.Execution
+	}
+	return
+}
+
+func (v *AdminUpsertWorkflowSearchAttributesRequest) GetSearchAttributes() (o *SearchAttributes) {
+	if v != nil && v.SearchAttributes != nil {
+		return v.SearchAttributes
+	}
+	return
+}
+
+func (v *AdminUpsertWorkflowSearchAttributesRequest) GetMemo() (o *Memo) {
+	if v != nil && v.Memo != nil {
+		return v.Memo
+	}
+	return
+}
+
+func (v *AdminUpsertWorkflowSearchAttributesRequest) GetReason() (o string) {
+	if v != nil {
+		return v.Reason
+	}
+	return
+}
+
+func (v *AdminUpsertWorkflowSearchAttributesRequest) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
+	}
+	return
+}
+
+// HistoryUpsertWorkflowSearchAttributesRequest is the request to upsert search attributes of a workflow, sent to history.
+type HistoryUpsertWorkflowSearchAttributesRequest struct {
+	DomainUUID string                                      `json:"domainUUID,omitempty"`
+	Request    *AdminUpsertWorkflowSearchAttributesRequest `json:"request,omitempty"`
+}
+
+func (v *HistoryUpsertWorkflowSearchAttributesRequest) GetDomainUUID() (o string) {
+	if v != nil {
+		return v.DomainUUID
+	}
+	return
+}
+
+func (v *HistoryUpsertWorkflowSearchAttributesRequest) GetRequest() (o *AdminUpsertWorkflowSearchAttributesRequest) {
+	if v != nil && v.Request != nil {
+		return v.Request
+	}
+	return
+}
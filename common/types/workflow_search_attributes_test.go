@@ -0,0 +1,62 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAdminUpsertWorkflowSearchAttributesRequest_Getters(t *testing.T) {
+	var nilRequest *AdminUpsertWorkflowSearchAttributesRequest
+	assert.Equal(t, "", nilRequest.GetDomain())
+	assert.Nil(t, nilRequest.GetExecution())
+	assert.Nil(t, nilRequest.GetSearchAttributes())
+	assert.Equal(t, "", nilRequest.GetReason())
+	assert.Equal(t, "", nilRequest.GetIdentity())
+
+	execution := &WorkflowExecution{WorkflowID: "wid", RunID: "rid"}
+	searchAttributes := &SearchAttributes{IndexedFields: map[string][]byte{"CustomKeywordField": []byte(`"incident-1234"`)}}
+	v := &AdminUpsertWorkflowSearchAttributesRequest{
+		Domain:           "domain",
+		Execution:        execution,
+		SearchAttributes: searchAttributes,
+		Reason:           "reason",
+		Identity:         "identity",
+	}
+	assert.Equal(t, "domain", v.GetDomain())
+	assert.Equal(t, execution, v.GetExecution())
+	assert.Equal(t, searchAttributes, v.GetSearchAttributes())
+	assert.Equal(t, "reason", v.GetReason())
+	assert.Equal(t, "identity", v.GetIdentity())
+}
+
+func TestHistoryUpsertWorkflowSearchAttributesRequest_Getters(t *testing.T) {
+	var nilRequest *HistoryUpsertWorkflowSearchAttributesRequest
+	assert.Equal(t, "", nilRequest.GetDomainUUID())
+	assert.Nil(t, nilRequest.GetRequest())
+
+	request := &AdminUpsertWorkflowSearchAttributesRequest{Domain: "domain"}
+	v := &HistoryUpsertWorkflowSearchAttributesRequest{DomainUUID: "domain-id", Request: request}
+	assert.Equal(t, "domain-id", v.GetDomainUUID())
+	assert.Equal(t, request, v.GetRequest())
+}
@@ -0,0 +1,132 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package types
+
+// UpdateWorkflowExecutionRequest is the request to run an update handler of a workflow and wait for its result.
+type UpdateWorkflowExecutionRequest struct {
+	Domain            string             `json:"domain,omitempty"`
+	WorkflowExecution *WorkflowExecution `json:"workflowExecution,omitempty"`
+	// UpdateID deduplicates retried requests, it is generated by the frontend when not set
+	UpdateID   string `json:"updateId,omitempty"`
+	UpdateName string `json:"updateName,omitempty"`
+	Input      []byte `json:"-"` // Filtering PII
+	Identity   string `json:"identity,omitempty"`
+}
+
+func (v *UpdateWorkflowExecutionRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+func (v *UpdateWorkflowExecutionRequest) GetWorkflowExecution() (o *WorkflowExecution) {
+	if v != nil && v.WorkflowExecution != nil {
+		return v.WorkflowExecution
+	}
+	return
+}
+
+func (v *UpdateWorkflowExecutionRequest) GetUpdateID() (o string) {
+	if v != nil {
+		return v.UpdateID
+	}
+	return
+}
+
+func (v *UpdateWorkflowExecutionRequest) GetUpdateName() (o string) {
+	if v != nil {
+		return v.UpdateName
+	}
+	return
+}
+
+func (v *UpdateWorkflowExecutionRequest) GetInput() (o []byte) {
+	if v != nil && v.Input != nil {
+		return v.Input
+	}
+	return
+}
+
+func (v *UpdateWorkflowExecutionRequest) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
+	}
+	return
+}
+
+// UpdateWorkflowExecutionResponse is the outcome of an update handler. An update that was rejected
+// by the workflow's validator is returned as a QueryFailedError instead, as nothing was recorded for it.
+type UpdateWorkflowExecutionResponse struct {
+	UpdateID string `json:"updateId,omitempty"`
+	Result   []byte `json:"-"` // Filtering PII
+	// FailureReason is set when the update handler failed
+	FailureReason  *string `json:"failureReason,omitempty"`
+	FailureDetails []byte  `json:"-"` // Filtering PII
+}
+
+func (v *UpdateWorkflowExecutionResponse) GetUpdateID() (o string) {
+	if v != nil {
+		return v.UpdateID
+	}
+	return
+}
+
+func (v *UpdateWorkflowExecutionResponse) GetResult() (o []byte) {
+	if v != nil && v.Result != nil {
+		return v.Result
+	}
+	return
+}
+
+func (v *UpdateWorkflowExecutionResponse) GetFailureReason() (o string) {
+	if v != nil && v.FailureReason != nil {
+		return *v.FailureReason
+	}
+	return
+}
+
+func (v *UpdateWorkflowExecutionResponse) GetFailureDetails() (o []byte) {
+	if v != nil && v.FailureDetails != nil {
+		return v.FailureDetails
+	}
+	return
+}
+
+// HistoryUpdateWorkflowExecutionRequest is the request to run an update handler of a workflow, sent to history.
+type HistoryUpdateWorkflowExecutionRequest struct {
+	DomainUUID    string                          `json:"domainUUID,omitempty"`
+	UpdateRequest *UpdateWorkflowExecutionRequest `json:"updateRequest,omitempty"`
+}
+
+func (v *HistoryUpdateWorkflowExecutionRequest) GetDomainUUID() (o string) {
+	if v != nil {
+		return v.DomainUUID
+	}
+	return
+}
+
+func (v *HistoryUpdateWorkflowExecutionRequest) GetUpdateRequest() (o *UpdateWorkflowExecutionRequest) {
+	if v != nil && v.UpdateRequest != nil {
+		return v.UpdateRequest
+	}
+	return
+}
@@ -0,0 +1,86 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUpdateWorkflowExecutionRequest_NilGetters(t *testing.T) {
+	var v *UpdateWorkflowExecutionRequest
+	assert.Equal(t, "", v.GetDomain())
+	assert.Nil(t, v.GetWorkflowExecution())
+	assert.Equal(t, "", v.GetUpdateID())
+	assert.Equal(t, "", v.GetUpdateName())
+	assert.Nil(t, v.GetInput())
+	assert.Equal(t, "", v.GetIdentity())
+}
+
+func TestUpdateWorkflowExecutionRequest_Getters(t *testing.T) {
+	execution := &WorkflowExecution{WorkflowID: "wid", RunID: "rid"}
+	v := &UpdateWorkflowExecutionRequest{
+		Domain:            "domain",
+		WorkflowExecution: execution,
+		UpdateID:          "update-id",
+		UpdateName:        "update-name",
+		Input:             []byte("input"),
+		Identity:          "identity",
+	}
+	assert.Equal(t, "domain", v.GetDomain())
+	assert.Equal(t, execution, v.GetWorkflowExecution())
+	assert.Equal(t, "update-id", v.GetUpdateID())
+	assert.Equal(t, "update-name", v.GetUpdateName())
+	assert.Equal(t, []byte("input"), v.GetInput())
+	assert.Equal(t, "identity", v.GetIdentity())
+}
+
+func TestUpdateWorkflowExecutionResponse_Getters(t *testing.T) {
+	var nilResponse *UpdateWorkflowExecutionResponse
+	assert.Equal(t, "", nilResponse.GetUpdateID())
+	assert.Nil(t, nilResponse.GetResult())
+	assert.Equal(t, "", nilResponse.GetFailureReason())
+	assert.Nil(t, nilResponse.GetFailureDetails())
+
+	reason := "reason"
+	v := &UpdateWorkflowExecutionResponse{
+		UpdateID:       "update-id",
+		Result:         []byte("result"),
+		FailureReason:  &reason,
+		FailureDetails: []byte("details"),
+	}
+	assert.Equal(t, "update-id", v.GetUpdateID())
+	assert.Equal(t, []byte("result"), v.GetResult())
+	assert.Equal(t, "reason", v.GetFailureReason())
+	assert.Equal(t, []byte("details"), v.GetFailureDetails())
+}
+
+func TestHistoryUpdateWorkflowExecutionRequest_Getters(t *testing.T) {
+	var nilRequest *HistoryUpdateWorkflowExecutionRequest
+	assert.Equal(t, "", nilRequest.GetDomainUUID())
+	assert.Nil(t, nilRequest.GetUpdateRequest())
+
+	updateRequest := &UpdateWorkflowExecutionRequest{Domain: "domain"}
+	v := &HistoryUpdateWorkflowExecutionRequest{DomainUUID: "domain-id", UpdateRequest: updateRequest}
+	assert.Equal(t, "domain-id", v.GetDomainUUID())
+	assert.Equal(t, updateRequest, v.GetUpdateRequest())
+}
@@ -27,6 +27,7 @@ import (
 	"fmt"
 	"math"
 	"math/rand"
+	"regexp"
 	"strings"
 	"sync"
 	"time"
@@ -41,6 +42,7 @@ import (
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/taskpriority"
 	"github.com/uber/cadence/common/types"
 )
 
@@ -91,6 +93,10 @@ const (
 	domainCacheMaxInterval        = 5 * time.Second
 	domainCacheExpirationInterval = 2 * time.Minute
 
+	blobstoreOperationInitialInterval    = 100 * time.Millisecond
+	blobstoreOperationMaxInterval        = 10 * time.Second
+	blobstoreOperationExpirationInterval = 1 * time.Minute
+
 	contextExpireThreshold = 10 * time.Millisecond
 
 	// FailureReasonCompleteResultExceedsLimit is failureReason for complete result exceeds limit
@@ -244,6 +250,15 @@ func CreateDomainCacheRetryPolicy() backoff.RetryPolicy {
 	return policy
 }
 
+// CreateBlobstoreRetryPolicy creates a retry policy for blobstore operations
+func CreateBlobstoreRetryPolicy() backoff.RetryPolicy {
+	policy := backoff.NewExponentialRetryPolicy(blobstoreOperationInitialInterval)
+	policy.SetMaximumInterval(blobstoreOperationMaxInterval)
+	policy.SetExpirationInterval(blobstoreOperationExpirationInterval)
+
+	return policy
+}
+
 // IsValidIDLength checks if id is valid according to its length
 func IsValidIDLength(
 	id string,
@@ -500,6 +515,29 @@ func ValidateRetryPolicy(policy *types.RetryPolicy) error {
 	if policy.GetMaximumAttempts() == 0 && policy.GetExpirationIntervalInSeconds() == 0 {
 		return &types.BadRequestError{Message: "MaximumAttempts and ExpirationIntervalInSeconds are both 0. At least one of them must be specified."}
 	}
+	if policy.GetJitterCoefficient() < 0 || policy.GetJitterCoefficient() >= 1 {
+		return &types.BadRequestError{Message: "JitterCoefficient must be in the range [0, 1) on retry policy."}
+	}
+	for _, override := range policy.GetErrorReasonOverrides() {
+		if override == nil {
+			return &types.BadRequestError{Message: "ErrorReasonOverrides cannot contain nil entries on retry policy."}
+		}
+		if _, err := regexp.Compile(override.GetReasonPattern()); err != nil {
+			return &types.BadRequestError{Message: fmt.Sprintf("Invalid ReasonPattern %q in ErrorReasonOverrides on retry policy: %v", override.GetReasonPattern(), err)}
+		}
+		if override.GetInitialIntervalInSeconds() < 0 {
+			return &types.BadRequestError{Message: "InitialIntervalInSeconds cannot be less than 0 in ErrorReasonOverrides on retry policy."}
+		}
+		if override.GetBackoffCoefficient() != 0 && override.GetBackoffCoefficient() < 1 {
+			return &types.BadRequestError{Message: "BackoffCoefficient cannot be less than 1 in ErrorReasonOverrides on retry policy."}
+		}
+		if override.GetMaximumIntervalInSeconds() < 0 {
+			return &types.BadRequestError{Message: "MaximumIntervalInSeconds cannot be less than 0 in ErrorReasonOverrides on retry policy."}
+		}
+		if override.GetMaximumAttempts() < 0 {
+			return &types.BadRequestError{Message: "MaximumAttempts cannot be less than 0 in ErrorReasonOverrides on retry policy."}
+		}
+	}
 	return nil
 }
 
@@ -510,6 +548,8 @@ func CreateHistoryStartWorkflowRequest(
 	now time.Time,
 	partitionConfig map[string]string,
 ) (*types.HistoryStartWorkflowExecutionRequest, error) {
+	partitionConfig = taskpriority.WithPriority(partitionConfig, startRequest.GetPriority())
+	partitionConfig = taskpriority.WithFairnessKey(partitionConfig, startRequest.GetFairnessKey())
 	histRequest := &types.HistoryStartWorkflowExecutionRequest{
 		DomainUUID:      domainID,
 		StartRequest:    startRequest,
@@ -610,6 +650,59 @@ func CheckEventBlobSizeLimit(
 	return ErrBlobSizeExceedsLimit
 }
 
+// CheckHistoryEventBlobSizeLimit checks if the payloads of a history event exceed limits. The payloads larger than
+// offloadThreshold are stored in the blobstore and only referenced from the event, so each of them is limited by
+// offloadedErrorLimit, while the payloads kept in the event are checked together with inlineSize, the size of the
+// data which is never offloaded, by CheckEventBlobSizeLimit. An offloadThreshold of 0 means that payloads are not offloaded.
+func CheckHistoryEventBlobSizeLimit(
+	payloadSizes []int,
+	inlineSize int,
+	warnLimit int,
+	errorLimit int,
+	offloadThreshold int,
+	offloadedErrorLimit int,
+	domainID string,
+	domainName string,
+	workflowID string,
+	runID string,
+	scope metrics.Scope,
+	logger log.Logger,
+	blobSizeViolationOperationTag tag.Tag,
+) error {
+
+	for _, size := range payloadSizes {
+		if offloadThreshold <= 0 || size <= offloadThreshold {
+			inlineSize += size
+			continue
+		}
+		if size > offloadedErrorLimit {
+			scope.Tagged(metrics.DomainTag(domainName)).IncCounter(metrics.EventBlobSizeExceedLimit)
+			logger.Error("Offloaded blob size exceeds limit.",
+				tag.WorkflowDomainName(domainName),
+				tag.WorkflowDomainID(domainID),
+				tag.WorkflowID(workflowID),
+				tag.WorkflowRunID(runID),
+				tag.WorkflowSize(int64(size)),
+				blobSizeViolationOperationTag,
+			)
+			return ErrBlobSizeExceedsLimit
+		}
+	}
+
+	return CheckEventBlobSizeLimit(
+		inlineSize,
+		warnLimit,
+		errorLimit,
+		domainID,
+		domainName,
+		workflowID,
+		runID,
+		scope,
+		logger,
+		blobSizeViolationOperationTag,
+	)
+}
+
 // ValidateLongPollContextTimeout check if the context timeout for a long poll handler is too short or below a normal value.
 // If the timeout is not set or too short, it logs an error, and return ErrContextTimeoutNotSet or ErrContextTimeoutTooShort
 // accordingly. If the timeout is only below a normal value, it just logs an info and return nil.
@@ -797,6 +890,8 @@ func GetSizeOfHistoryEvent(event *types.HistoryEvent) uint64 {
 		if event.UpsertWorkflowSearchAttributesEventAttributes.SearchAttributes != nil {
 			res += GetSizeOfMapStringToByteArray(event.UpsertWorkflowSearchAttributesEventAttributes.SearchAttributes.IndexedFields)
 		}
+	case types.EventTypeWorkflowExecutionPaused:
+	case types.EventTypeWorkflowExecutionUnpaused:
 	}
 	return uint64(res)
 }
@@ -202,6 +202,28 @@ func TestCreateHistoryStartWorkflowRequest_ExpirationTimeWithCron(t *testing.T)
 	require.True(t, time.Unix(0, expirationTime).Sub(now) > 60*time.Second)
 }
 
+func TestCreateHistoryStartWorkflowRequest_Priority(t *testing.T) {
+	partitionConfig := map[string]string{"isolation-group": "zone-a"}
+	request := &types.StartWorkflowExecutionRequest{Priority: 1}
+
+	startRequest, err := CreateHistoryStartWorkflowRequest(uuid.New(), request, time.Now(), partitionConfig)
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"isolation-group": "zone-a", "task-priority": "1"}, startRequest.PartitionConfig)
+	require.Equal(t, map[string]string{"isolation-group": "zone-a"}, partitionConfig)
+
+	startRequest, err = CreateHistoryStartWorkflowRequest(uuid.New(), &types.StartWorkflowExecutionRequest{}, time.Now(), partitionConfig)
+	require.NoError(t, err)
+	require.Equal(t, partitionConfig, startRequest.PartitionConfig)
+}
+
+func TestCreateHistoryStartWorkflowRequest_FairnessKey(t *testing.T) {
+	request := &types.StartWorkflowExecutionRequest{Priority: 5, FairnessKey: "tenant-a"}
+
+	startRequest, err := CreateHistoryStartWorkflowRequest(uuid.New(), request, time.Now(), nil)
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"task-priority": "5", "task-fairness-key": "tenant-a"}, startRequest.PartitionConfig)
+}
+
 // Test to ensure we get the right value for FirstDecisionTaskBackoff during StartWorkflow request,
 // with & without cron, delayStart and jitterStart.
 // - Also see tests in cron_test.go for more exhaustive testing.
@@ -726,6 +748,16 @@ func TestValidateRetryPolicy_Success(t *testing.T) {
 			MaximumAttempts:             0,
 			ExpirationIntervalInSeconds: 1,
 		},
+		"with jitter, allowlist and overrides": {
+			InitialIntervalInSeconds: 2,
+			BackoffCoefficient:       1,
+			MaximumAttempts:          3,
+			JitterCoefficient:        0.2,
+			RetryableErrorReasons:    []string{"transient", "rate-limit"},
+			ErrorReasonOverrides: []*types.RetryPolicyOverride{
+				{ReasonPattern: "rate-limit.*", InitialIntervalInSeconds: 30, BackoffCoefficient: 2, MaximumAttempts: 10},
+			},
+		},
 	} {
 		t.Run(name, func(t *testing.T) {
 			require.NoError(t, ValidateRetryPolicy(policy))
@@ -802,6 +834,51 @@ func TestValidateRetryPolicy_Error(t *testing.T) {
 			},
 			wantErr: &types.BadRequestError{Message: "MaximumAttempts and ExpirationIntervalInSeconds are both 0. At least one of them must be specified."},
 		},
+		"JitterCoefficient equals 1": {
+			policy: &types.RetryPolicy{
+				InitialIntervalInSeconds: 2,
+				BackoffCoefficient:       1,
+				MaximumAttempts:          1,
+				JitterCoefficient:        1,
+			},
+			wantErr: &types.BadRequestError{Message: "JitterCoefficient must be in the range [0, 1) on retry policy."},
+		},
+		"ErrorReasonOverrides contains nil": {
+			policy: &types.RetryPolicy{
+				InitialIntervalInSeconds: 2,
+				BackoffCoefficient:       1,
+				MaximumAttempts:          1,
+				ErrorReasonOverrides:     []*types.RetryPolicyOverride{nil},
+			},
+			wantErr: &types.BadRequestError{Message: "ErrorReasonOverrides cannot contain nil entries on retry policy."},
+		},
+		"ErrorReasonOverrides has invalid ReasonPattern": {
+			policy: &types.RetryPolicy{
+				InitialIntervalInSeconds: 2,
+				BackoffCoefficient:       1,
+				MaximumAttempts:          1,
+				ErrorReasonOverrides:     []*types.RetryPolicyOverride{{ReasonPattern: "("}},
+			},
+			wantErr: &types.BadRequestError{Message: `Invalid ReasonPattern "(" in ErrorReasonOverrides on retry policy`},
+		},
+		"ErrorReasonOverrides has BackoffCoefficient less than 1": {
+			policy: &types.RetryPolicy{
+				InitialIntervalInSeconds: 2,
+				BackoffCoefficient:       1,
+				MaximumAttempts:          1,
+				ErrorReasonOverrides:     []*types.RetryPolicyOverride{{ReasonPattern: "reason", BackoffCoefficient: 0.5}},
+			},
+			wantErr: &types.BadRequestError{Message: "BackoffCoefficient cannot be less than 1 in ErrorReasonOverrides on retry policy."},
+		},
+		"ErrorReasonOverrides has MaximumAttempts less than 0": {
+			policy: &types.RetryPolicy{
+				InitialIntervalInSeconds: 2,
+				BackoffCoefficient:       1,
+				MaximumAttempts:          1,
+				ErrorReasonOverrides:     []*types.RetryPolicyOverride{{ReasonPattern: "reason", MaximumAttempts: -1}},
+			},
+			wantErr: &types.BadRequestError{Message: "MaximumAttempts cannot be less than 0 in ErrorReasonOverrides on retry policy."},
+		},
 	} {
 		t.Run(name, func(t *testing.T) {
 			got := ValidateRetryPolicy(c.policy)
@@ -1703,3 +1780,72 @@ func TestCheckEventBlobSizeLimit(t *testing.T) {
 		})
 	}
 }
+
+func TestCheckHistoryEventBlobSizeLimit(t *testing.T) {
+	for name, c := range map[string]struct {
+		payloadSizes     []int
+		inlineSize       int
+		offloadThreshold int
+		wantErr          error
+		prepareLogger    func(*log.MockLogger)
+	}{
+		"offloading disabled": {
+			payloadSizes: []int{20},
+			inlineSize:   11,
+			wantErr:      ErrBlobSizeExceedsLimit,
+			prepareLogger: func(logger *log.MockLogger) {
+				logger.EXPECT().Error("Blob size exceeds limit.", gomock.Any()).Times(1)
+			},
+		},
+		"offloaded payloads are not counted inline": {
+			payloadSizes:     []int{10, 40},
+			inlineSize:       5,
+			offloadThreshold: 15,
+			wantErr:          nil,
+		},
+		"inline payloads are added to the inline size": {
+			payloadSizes:     []int{15, 40},
+			inlineSize:       16,
+			offloadThreshold: 15,
+			wantErr:          ErrBlobSizeExceedsLimit,
+			prepareLogger: func(logger *log.MockLogger) {
+				logger.EXPECT().Error("Blob size exceeds limit.", gomock.Any()).Times(1)
+			},
+		},
+		"offloaded payload is greater than offloaded error limit": {
+			payloadSizes:     []int{51},
+			offloadThreshold: 15,
+			wantErr:          ErrBlobSizeExceedsLimit,
+			prepareLogger: func(logger *log.MockLogger) {
+				logger.EXPECT().Error("Offloaded blob size exceeds limit.", gomock.Any()).Times(1)
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			testScope := tally.NewTestScope("test", nil)
+			metricsClient := metrics.NewClient(testScope, metrics.History, metrics.MigrationConfig{})
+			logger := log.NewMockLogger(gomock.NewController(t))
+
+			if c.prepareLogger != nil {
+				c.prepareLogger(logger)
+			}
+
+			got := CheckHistoryEventBlobSizeLimit(
+				c.payloadSizes,
+				c.inlineSize,
+				20,
+				30,
+				c.offloadThreshold,
+				50,
+				"testDomainID",
+				"testDomainName",
+				"testWorkflowID",
+				"testRunID",
+				metricsClient.Scope(1),
+				logger,
+				tag.OperationName("testOperation"),
+			)
+			require.Equal(t, c.wantErr, got)
+		})
+	}
+}
@@ -0,0 +1,76 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package visibility
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/uber/cadence/common/constants"
+)
+
+// savedQueryReferencePrefix starts a visibility query which is resolved to the query saved under the name that follows
+const savedQueryReferencePrefix = "@"
+
+var validSavedQueryName = regexp.MustCompile(`^[a-zA-Z][a-zA-Z_\-0-9]*$`)
+
+// ValidateSavedQueryName checks if the name of a saved visibility query has valid format
+func ValidateSavedQueryName(name string) error {
+	if !validSavedQueryName.MatchString(name) {
+		return fmt.Errorf("saved query name %q has to contain alphanumeric, '_' or '-' and start with a letter", name)
+	}
+	return nil
+}
+
+// SavedQueryDomainDataKey returns the key of domain data storing the saved visibility query with the given name
+func SavedQueryDomainDataKey(name string) string {
+	return constants.DomainDataKeyPrefixForSavedVisibilityQuery + name
+}
+
+// SavedQueryReference returns the visibility query referencing the query saved with the given name
+func SavedQueryReference(name string) string {
+	return savedQueryReferencePrefix + name
+}
+
+// ParseSavedQueryReference returns the name of the saved query if the visibility query references one
+func ParseSavedQueryReference(query string) (string, bool) {
+	return strings.CutPrefix(strings.TrimSpace(query), savedQueryReferencePrefix)
+}
+
+// GetSavedQuery returns the visibility query saved in the domain data with the given name
+func GetSavedQuery(domainData map[string]string, name string) (string, bool) {
+	query := domainData[SavedQueryDomainDataKey(name)]
+	return query, query != ""
+}
+
+// GetSavedQueries returns the visibility queries saved in the domain data by name
+func GetSavedQueries(domainData map[string]string) map[string]string {
+	queries := make(map[string]string)
+	for key, query := range domainData {
+		name, ok := strings.CutPrefix(key, constants.DomainDataKeyPrefixForSavedVisibilityQuery)
+		if !ok || query == "" {
+			continue
+		}
+		queries[name] = query
+	}
+	return queries
+}
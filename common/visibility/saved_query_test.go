@@ -0,0 +1,66 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package visibility
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateSavedQueryName(t *testing.T) {
+	for _, name := range []string{"failed", "failed-payments", "failed_payments_2"} {
+		assert.NoError(t, ValidateSavedQueryName(name), name)
+	}
+	for _, name := range []string{"", "2failed", "failed payments", "failed.payments"} {
+		assert.Error(t, ValidateSavedQueryName(name), name)
+	}
+}
+
+func TestGetSavedQueries(t *testing.T) {
+	domainData := map[string]string{
+		"PreferredCluster":                 "cluster0",
+		SavedQueryDomainDataKey("failed"):  "CloseStatus = 'FAILED'",
+		SavedQueryDomainDataKey("deleted"): "",
+	}
+
+	assert.Equal(t, map[string]string{"failed": "CloseStatus = 'FAILED'"}, GetSavedQueries(domainData))
+
+	query, ok := GetSavedQuery(domainData, "failed")
+	assert.True(t, ok)
+	assert.Equal(t, "CloseStatus = 'FAILED'", query)
+	_, ok = GetSavedQuery(domainData, "deleted")
+	assert.False(t, ok)
+	_, ok = GetSavedQuery(domainData, "unknown")
+	assert.False(t, ok)
+	assert.Empty(t, GetSavedQueries(nil))
+}
+
+func TestParseSavedQueryReference(t *testing.T) {
+	name, ok := ParseSavedQueryReference(SavedQueryReference("failed"))
+	assert.True(t, ok)
+	assert.Equal(t, "failed", name)
+	name, ok = ParseSavedQueryReference(" @failed ")
+	assert.True(t, ok)
+	assert.Equal(t, "failed", name)
+	_, ok = ParseSavedQueryReference("CloseStatus = 'FAILED'")
+	assert.False(t, ok)
+}
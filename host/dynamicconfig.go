@@ -199,6 +199,13 @@ func (d *dynamicClient) ListValue(name dynamicproperties.Key) ([]*types.DynamicC
 	return d.client.ListValue(name)
 }
 
+func (d *dynamicClient) UpdateValueWith(
+	name dynamicproperties.Key,
+	update func(current []*types.DynamicConfigValue) ([]*types.DynamicConfigValue, error),
+) error {
+	return d.client.UpdateValueWith(name, update)
+}
+
 func (d *dynamicClient) RestoreValue(name dynamicproperties.Key, filters map[dynamicproperties.Filter]interface{}) error {
 	return d.client.RestoreValue(name, filters)
 }
@@ -77,6 +77,7 @@ import (
 	"github.com/uber/cadence/service/worker"
 	"github.com/uber/cadence/service/worker/archiver"
 	"github.com/uber/cadence/service/worker/asyncworkflow"
+	"github.com/uber/cadence/service/worker/domainworker"
 	"github.com/uber/cadence/service/worker/indexer"
 	"github.com/uber/cadence/service/worker/replicator"
 	"github.com/uber/cadence/service/worker/scheduler"
@@ -120,7 +121,7 @@ type (
 		replicator                    *replicator.Replicator
 		clientWorker                  archiver.ClientWorker
 		indexer                       *indexer.Indexer
-		schedulerWorkerManager        *scheduler.WorkerManager
+		schedulerWorkerManager        *domainworker.Manager
 		archiverMetadata              carchiver.ArchivalMetadata
 		archiverProvider              provider.ArchiverProvider
 		historyConfig                 *HistoryConfig
@@ -113,7 +113,7 @@ func TestPostgresSQLExecutionManagerWithEventsV2(t *testing.T) {
 
 func TestPostgresSQLVisibilityPersistenceSuite(t *testing.T) {
 	testflags.RequirePostgres(t)
-	s := new(pt.DBVisibilityPersistenceSuite)
+	s := new(pt.SQLVisibilityPersistenceSuite)
 	options, err := postgres.GetTestClusterOption()
 	assert.NoError(t, err)
 	s.TestBase = pt.NewTestBaseWithSQL(t, options)
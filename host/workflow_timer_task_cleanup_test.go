@@ -227,6 +227,7 @@ func (s *WorkflowTimerTaskCleanupSuite) newExecutionManager() persistence.Execut
 		metrics.NewNoopMetricsClient(),
 		s.Logger,
 		&s.TestCluster.testBase.DynamicConfiguration,
+		nil,
 	)
 	execMgr, err := factory.NewExecutionManager()
 	s.Require().NoError(err)
@@ -405,6 +406,7 @@ func (s *WorkflowTimerTaskCleanupDisabledSuite) newExecutionManager() persistenc
 		metrics.NewNoopMetricsClient(),
 		s.Logger,
 		&s.TestCluster.testBase.DynamicConfiguration,
+		nil,
 	)
 	execMgr, err := factory.NewExecutionManager()
 	s.Require().NoError(err)
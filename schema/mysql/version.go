@@ -28,7 +28,7 @@ import "github.com/uber/cadence/schema/common"
 const Version = "0.8"
 
 // VisibilityVersion is the MySQL visibility database release version
-const VisibilityVersion = "0.8"
+const VisibilityVersion = "0.9"
 
 var (
 	DefaultSchema    = common.EmbeddedSchema(SchemaFS, Version, "v8/cadence", "schema.sql")
@@ -30,7 +30,7 @@ import (
 const Version = "0.3"
 
 // VisibilityVersion is the SQLite visibility database release version
-const VisibilityVersion = "0.2"
+const VisibilityVersion = "0.3"
 
 var (
 	DefaultSchema    = common.EmbeddedSchema(SchemaFS, Version, "cadence", "schema.sql")
@@ -19,6 +19,7 @@ CREATE TABLE executions_visibility
     cron_schedule            TEXT                       NULL,
     execution_status         INT                        NULL,
     scheduled_execution_time TIMESTAMP                  NULL,
+    search_attributes        TEXT                       NULL,
 
     PRIMARY KEY (domain_id, run_id)
 );
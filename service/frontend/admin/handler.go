@@ -45,6 +45,7 @@ import (
 	"github.com/uber/cadence/common/dynamicconfig"
 	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
 	"github.com/uber/cadence/common/elasticsearch"
+	"github.com/uber/cadence/common/elasticsearch/validator"
 	"github.com/uber/cadence/common/isolationgroup/isolationgroupapi"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
@@ -54,6 +55,7 @@ import (
 	"github.com/uber/cadence/common/resource"
 	"github.com/uber/cadence/common/service"
 	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/common/visibility"
 	"github.com/uber/cadence/service/frontend/config"
 	"github.com/uber/cadence/service/frontend/validate"
 	"github.com/uber/cadence/service/history/execution"
@@ -80,6 +82,8 @@ type (
 		throttleRetry         *backoff.ThrottleRetry
 		isolationGroups       isolationgroupapi.Handler
 		asyncWFQueueConfigs   queueconfigapi.Handler
+
+		searchAttributesValidator *validator.SearchAttributesValidator
 	}
 
 	workflowQueryTemplate struct {
@@ -157,6 +161,14 @@ func NewHandler(
 		),
 		isolationGroups:     isolationgroupapi.New(resource.GetLogger(), resource.GetIsolationGroupStore(), domainHandler),
 		asyncWFQueueConfigs: queueconfigapi.New(resource.GetLogger(), domainHandler),
+		searchAttributesValidator: validator.NewSearchAttributesValidator(
+			resource.GetLogger(),
+			config.EnableQueryAttributeValidation,
+			config.ValidSearchAttributes,
+			config.SearchAttributesNumberOfKeysLimit,
+			config.SearchAttributesSizeOfValueLimit,
+			config.SearchAttributesTotalSizeLimit,
+		),
 	}
 }
 
@@ -1286,6 +1298,65 @@ func (adh *adminHandlerImpl) RefreshWorkflowTasks(
 	return nil
 }
 
+// UpsertWorkflowSearchAttributes upserts search attributes and memo of a workflow outside of its decisions,
+// e.g. to tag workflows related to an incident
+func (adh *adminHandlerImpl) UpsertWorkflowSearchAttributes(
+	ctx context.Context,
+	request *types.AdminUpsertWorkflowSearchAttributesRequest,
+) (err error) {
+	defer func() { log.CapturePanic(recover(), adh.GetLogger(), &err) }()
+	scope, sw := adh.startRequestProfile(ctx, metrics.AdminUpsertWorkflowSearchAttributesScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return adh.error(validate.ErrRequestNotSet, scope)
+	}
+	if request.GetDomain() == "" {
+		return adh.error(validate.ErrDomainNotSet, scope)
+	}
+	if err := validate.CheckExecution(request.Execution); err != nil {
+		return adh.error(err, scope)
+	}
+	if len(request.GetSearchAttributes().GetIndexedFields()) == 0 && len(request.GetMemo().GetFields()) == 0 {
+		return adh.error(validate.ErrSearchAttributesAndMemoNotSet, scope)
+	}
+	for key := range request.GetSearchAttributes().GetIndexedFields() {
+		if err := visibility.ValidateSearchAttributeKey(key); err != nil {
+			return adh.error(&types.BadRequestError{Message: fmt.Sprintf("invalid search attribute key %s: %v", key, err)}, scope)
+		}
+	}
+	if err := adh.searchAttributesValidator.ValidateSearchAttributes(request.SearchAttributes, request.GetDomain()); err != nil {
+		return adh.error(err, scope)
+	}
+	domainID, err := adh.GetDomainCache().GetDomainID(request.GetDomain())
+	if err != nil {
+		return adh.error(err, scope)
+	}
+	if err := common.CheckEventBlobSizeLimit(
+		common.GetSizeOfMapStringToByteArray(request.GetMemo().GetFields()),
+		adh.config.BlobSizeLimitWarn(request.GetDomain()),
+		adh.config.BlobSizeLimitError(request.GetDomain()),
+		domainID,
+		request.GetDomain(),
+		request.Execution.GetWorkflowID(),
+		request.Execution.GetRunID(),
+		scope,
+		adh.GetLogger(),
+		tag.BlobSizeViolationOperation("UpsertWorkflowSearchAttributes"),
+	); err != nil {
+		return adh.error(err, scope)
+	}
+
+	err = adh.GetHistoryClient().UpsertWorkflowSearchAttributes(ctx, &types.HistoryUpsertWorkflowSearchAttributesRequest{
+		DomainUUID: domainID,
+		Request:    request,
+	})
+	if err != nil {
+		return adh.error(err, scope)
+	}
+	return nil
+}
+
 // ResendReplicationTasks requests replication task from remote cluster
 func (adh *adminHandlerImpl) ResendReplicationTasks(
 	ctx context.Context,
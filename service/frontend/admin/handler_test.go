@@ -44,11 +44,13 @@ import (
 	"github.com/uber/cadence/common/backoff"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/definition"
 	"github.com/uber/cadence/common/domain"
 	"github.com/uber/cadence/common/dynamicconfig"
 	"github.com/uber/cadence/common/dynamicconfig/configstore"
 	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
 	esmock "github.com/uber/cadence/common/elasticsearch/mocks"
+	"github.com/uber/cadence/common/elasticsearch/validator"
 	"github.com/uber/cadence/common/isolationgroup/isolationgroupapi"
 	"github.com/uber/cadence/common/log/testlogger"
 	"github.com/uber/cadence/common/membership"
@@ -1216,6 +1218,137 @@ func Test_RemoveTask(t *testing.T) {
 	}
 }
 
+func Test_UpsertWorkflowSearchAttributes(t *testing.T) {
+	execution := &types.WorkflowExecution{WorkflowID: "wid", RunID: "2f3e1a52-8d1b-4bd5-9a0c-6a1c5b0f0b8e"}
+	newRequest := func(fields map[string][]byte) *types.AdminUpsertWorkflowSearchAttributesRequest {
+		return &types.AdminUpsertWorkflowSearchAttributesRequest{
+			Domain:           "test-domain",
+			Execution:        execution,
+			SearchAttributes: &types.SearchAttributes{IndexedFields: fields},
+			Reason:           "incident-1234",
+		}
+	}
+	withMemo := func(request *types.AdminUpsertWorkflowSearchAttributesRequest, fields map[string][]byte) *types.AdminUpsertWorkflowSearchAttributesRequest {
+		request.Memo = &types.Memo{Fields: fields}
+		return request
+	}
+	validFields := map[string][]byte{"CustomKeywordField": []byte(`"incident-1234"`)}
+	validMemo := map[string][]byte{"incident": []byte(`"incident-1234"`)}
+
+	tests := map[string]struct {
+		input         *types.AdminUpsertWorkflowSearchAttributesRequest
+		hcHandlerFunc func(mock *history.MockClient)
+		dcHandlerFunc func(mock *cache.MockDomainCache)
+		wantErr       error
+	}{
+		"nil request": {
+			input:   nil,
+			wantErr: validate.ErrRequestNotSet,
+		},
+		"execution not set": {
+			input:   &types.AdminUpsertWorkflowSearchAttributesRequest{Domain: "test-domain"},
+			wantErr: validate.ErrExecutionNotSet,
+		},
+		"search attributes and memo not set": {
+			input:   newRequest(nil),
+			wantErr: validate.ErrSearchAttributesAndMemoNotSet,
+		},
+		"invalid key": {
+			input:   newRequest(map[string][]byte{"incident-key": []byte(`"incident-1234"`)}),
+			wantErr: &types.BadRequestError{Message: "invalid search attribute key incident-key: has to be contain alphanumeric and start with a letter"},
+		},
+		"key not whitelisted": {
+			input:   newRequest(map[string][]byte{"IncidentKey": []byte(`"incident-1234"`)}),
+			wantErr: &types.BadRequestError{Message: "IncidentKey is not a valid search attribute key"},
+		},
+		"domain not found": {
+			input: newRequest(validFields),
+			dcHandlerFunc: func(mock *cache.MockDomainCache) {
+				mock.EXPECT().GetDomainID("test-domain").Return("", &types.EntityNotExistsError{})
+			},
+			wantErr: &types.EntityNotExistsError{},
+		},
+		"memo too large": {
+			input: withMemo(newRequest(nil), map[string][]byte{"incident": make([]byte, 101)}),
+			dcHandlerFunc: func(mock *cache.MockDomainCache) {
+				mock.EXPECT().GetDomainID("test-domain").Return("test-domain-id", nil)
+			},
+			wantErr: common.ErrBlobSizeExceedsLimit,
+		},
+		"memo only": {
+			input: withMemo(newRequest(nil), validMemo),
+			dcHandlerFunc: func(mock *cache.MockDomainCache) {
+				mock.EXPECT().GetDomainID("test-domain").Return("test-domain-id", nil)
+			},
+			hcHandlerFunc: func(mock *history.MockClient) {
+				mock.EXPECT().UpsertWorkflowSearchAttributes(gomock.Any(), &types.HistoryUpsertWorkflowSearchAttributesRequest{
+					DomainUUID: "test-domain-id",
+					Request:    withMemo(newRequest(nil), validMemo),
+				}).Return(nil)
+			},
+		},
+		"normal request": {
+			input: newRequest(validFields),
+			dcHandlerFunc: func(mock *cache.MockDomainCache) {
+				mock.EXPECT().GetDomainID("test-domain").Return("test-domain-id", nil)
+			},
+			hcHandlerFunc: func(mock *history.MockClient) {
+				mock.EXPECT().UpsertWorkflowSearchAttributes(gomock.Any(), &types.HistoryUpsertWorkflowSearchAttributesRequest{
+					DomainUUID: "test-domain-id",
+					Request:    newRequest(validFields),
+				}).Return(nil)
+			},
+		},
+		"request failed": {
+			input: newRequest(validFields),
+			dcHandlerFunc: func(mock *cache.MockDomainCache) {
+				mock.EXPECT().GetDomainID("test-domain").Return("test-domain-id", nil)
+			},
+			hcHandlerFunc: func(mock *history.MockClient) {
+				mock.EXPECT().UpsertWorkflowSearchAttributes(gomock.Any(), gomock.Any()).Return(&types.InternalServiceError{Message: "error"})
+			},
+			wantErr: &types.InternalServiceError{Message: "error"},
+		},
+	}
+
+	for name, td := range tests {
+		t.Run(name, func(t *testing.T) {
+			goMock := gomock.NewController(t)
+			hcMock := history.NewMockClient(goMock)
+			dcMock := cache.NewMockDomainCache(goMock)
+			if td.hcHandlerFunc != nil {
+				td.hcHandlerFunc(hcMock)
+			}
+			if td.dcHandlerFunc != nil {
+				td.dcHandlerFunc(dcMock)
+			}
+			handler := adminHandlerImpl{
+				Resource: &resource.Test{
+					Logger:        testlogger.New(t),
+					MetricsClient: metrics.NewNoopMetricsClient(),
+					HistoryClient: hcMock,
+					DomainCache:   dcMock,
+				},
+				config: &frontendcfg.Config{
+					BlobSizeLimitWarn:  dynamicproperties.GetIntPropertyFilteredByDomain(50),
+					BlobSizeLimitError: dynamicproperties.GetIntPropertyFilteredByDomain(100),
+				},
+				searchAttributesValidator: validator.NewSearchAttributesValidator(
+					testlogger.New(t),
+					dynamicproperties.GetBoolPropertyFn(true),
+					dynamicproperties.GetMapPropertyFn(definition.GetDefaultIndexedKeys()),
+					dynamicproperties.GetIntPropertyFilteredByDomain(10),
+					dynamicproperties.GetIntPropertyFilteredByDomain(100),
+					dynamicproperties.GetIntPropertyFilteredByDomain(1000),
+				),
+			}
+
+			err := handler.UpsertWorkflowSearchAttributes(context.Background(), td.input)
+			assert.Equal(t, td.wantErr, err)
+		})
+	}
+}
+
 func Test_CloseShard(t *testing.T) {
 	tests := map[string]struct {
 		input         *types.CloseShardRequest
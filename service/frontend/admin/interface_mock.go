@@ -638,3 +638,17 @@ func (mr *MockHandlerMockRecorder) UpdateTaskListPartitionConfig(arg0, arg1 any)
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTaskListPartitionConfig", reflect.TypeOf((*MockHandler)(nil).UpdateTaskListPartitionConfig), arg0, arg1)
 }
+
+// UpsertWorkflowSearchAttributes mocks base method.
+func (m *MockHandler) UpsertWorkflowSearchAttributes(arg0 context.Context, arg1 *types.AdminUpsertWorkflowSearchAttributesRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertWorkflowSearchAttributes", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertWorkflowSearchAttributes indicates an expected call of UpsertWorkflowSearchAttributes.
+func (mr *MockHandlerMockRecorder) UpsertWorkflowSearchAttributes(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertWorkflowSearchAttributes", reflect.TypeOf((*MockHandler)(nil).UpsertWorkflowSearchAttributes), arg0, arg1)
+}
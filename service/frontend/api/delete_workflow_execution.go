@@ -0,0 +1,148 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package api
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/frontend/validate"
+)
+
+// DeleteWorkflowExecution permanently deletes a closed workflow execution, including its history
+// and archived copies. The deletion itself happens asynchronously in the history service, the
+// request is recorded in the domain audit log once it has been accepted.
+func (wh *WorkflowHandler) DeleteWorkflowExecution(
+	ctx context.Context,
+	deleteRequest *types.DeleteWorkflowExecutionRequest,
+) error {
+	if wh.isShuttingDown() {
+		return validate.ErrShuttingDown
+	}
+	if deleteRequest == nil {
+		return validate.ErrRequestNotSet
+	}
+
+	domainName := deleteRequest.GetDomain()
+	if domainName == "" {
+		return validate.ErrDomainNotSet
+	}
+	if err := validate.CheckExecution(deleteRequest.GetWorkflowExecution()); err != nil {
+		return err
+	}
+	// deletion is irreversible, so it has to target an exact run rather than the current one
+	if deleteRequest.GetWorkflowExecution().GetRunID() == "" {
+		return validate.ErrRunIDNotSet
+	}
+
+	scope := getMetricsScopeWithDomain(metrics.FrontendDeleteWorkflowExecutionScope, deleteRequest, wh.GetMetricsClient()).Tagged(metrics.GetContextTags(ctx)...)
+	idLengthWarnLimit := wh.config.MaxIDLengthWarnLimit()
+	if !common.IsValidIDLength(
+		domainName,
+		scope,
+		idLengthWarnLimit,
+		wh.config.DomainNameMaxLength(domainName),
+		metrics.CadenceErrDomainNameExceededWarnLimit,
+		domainName,
+		wh.GetLogger(),
+		tag.IDTypeDomainName) {
+		return validate.ErrDomainTooLong
+	}
+	if !common.IsValidIDLength(
+		deleteRequest.GetIdentity(),
+		scope,
+		idLengthWarnLimit,
+		wh.config.IdentityMaxLength(domainName),
+		metrics.CadenceErrIdentityExceededWarnLimit,
+		domainName,
+		wh.GetLogger(),
+		tag.IDTypeIdentity) {
+		return validate.ErrIdentityTooLong
+	}
+
+	domainID, err := wh.GetDomainCache().GetDomainID(domainName)
+	if err != nil {
+		return err
+	}
+
+	err = wh.GetHistoryClient().DeleteWorkflowExecution(ctx, &types.HistoryDeleteWorkflowExecutionRequest{
+		DomainUUID:    domainID,
+		DeleteRequest: deleteRequest,
+	})
+	if err != nil {
+		return wh.normalizeVersionedErrors(ctx, err)
+	}
+
+	wh.recordWorkflowDeletion(ctx, domainID, deleteRequest)
+	return nil
+}
+
+// recordWorkflowDeletion writes the deletion request to the domain audit log. Like the domain
+// audit entries it is best effort and never fails the request.
+func (wh *WorkflowHandler) recordWorkflowDeletion(
+	ctx context.Context,
+	domainID string,
+	deleteRequest *types.DeleteWorkflowExecutionRequest,
+) {
+	domainAuditManager := wh.GetPersistenceBean().GetDomainAuditManager()
+	if domainAuditManager == nil || !wh.config.EnableDomainAuditLogging() {
+		return
+	}
+
+	execution := deleteRequest.GetWorkflowExecution()
+	logger := wh.GetLogger().WithTags(
+		tag.WorkflowDomainID(domainID),
+		tag.WorkflowID(execution.GetWorkflowID()),
+		tag.WorkflowRunID(execution.GetRunID()),
+	)
+
+	// Must be a UUID v7, since the creation time is embedded in it
+	eventID, err := uuid.NewV7()
+	if err != nil {
+		logger.Error("Failed to generate workflow deletion audit log ID", tag.Error(err))
+		return
+	}
+
+	_, err = domainAuditManager.CreateDomainAuditLog(ctx, &persistence.CreateDomainAuditLogRequest{
+		DomainID:      domainID,
+		EventID:       eventID.String(),
+		CreatedTime:   time.Unix(eventID.Time().UnixTime()),
+		OperationType: persistence.DomainAuditOperationTypeDeleteWorkflow,
+		Identity:      deleteRequest.GetIdentity(),
+		Comment: fmt.Sprintf(
+			"workflowID: %s, runID: %s, reason: %s",
+			execution.GetWorkflowID(),
+			execution.GetRunID(),
+			deleteRequest.GetReason(),
+		),
+	})
+	if err != nil {
+		logger.Error("Failed to create workflow deletion audit log", tag.Error(err))
+	}
+}
@@ -0,0 +1,173 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package api
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/frontend/validate"
+)
+
+func TestDeleteWorkflowExecution(t *testing.T) {
+	validRequest := func() *types.DeleteWorkflowExecutionRequest {
+		return &types.DeleteWorkflowExecutionRequest{
+			Domain: "domain",
+			WorkflowExecution: &types.WorkflowExecution{
+				WorkflowID: "wf",
+				RunID:      "2f3e1a52-8d1b-4bd5-9a0c-6a1c5b0f0b8e",
+			},
+			Reason:   "erasure request",
+			Identity: "identity",
+		}
+	}
+
+	testCases := []struct {
+		name          string
+		req           *types.DeleteWorkflowExecutionRequest
+		setupMocks    func(*WorkflowHandler, *mockDeps)
+		expectedError error
+	}{
+		{
+			name: "success",
+			req:  validRequest(),
+			setupMocks: func(wh *WorkflowHandler, deps *mockDeps) {
+				deps.mockDomainCache.EXPECT().GetDomainID("domain").Return("domain-id", nil)
+				deps.mockHistoryClient.EXPECT().DeleteWorkflowExecution(gomock.Any(), &types.HistoryDeleteWorkflowExecutionRequest{
+					DomainUUID:    "domain-id",
+					DeleteRequest: validRequest(),
+				}).Return(nil)
+			},
+		},
+		{
+			name: "success with audit log",
+			req:  validRequest(),
+			setupMocks: func(wh *WorkflowHandler, deps *mockDeps) {
+				wh.config.EnableDomainAuditLogging = dynamicproperties.GetBoolPropertyFn(true)
+				deps.mockDomainCache.EXPECT().GetDomainID("domain").Return("domain-id", nil)
+				deps.mockHistoryClient.EXPECT().DeleteWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil)
+				deps.mockResource.DomainAuditMgr.EXPECT().CreateDomainAuditLog(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, req *persistence.CreateDomainAuditLogRequest) (*persistence.CreateDomainAuditLogResponse, error) {
+						assert.Equal(t, "domain-id", req.DomainID)
+						assert.Equal(t, persistence.DomainAuditOperationTypeDeleteWorkflow, req.OperationType)
+						assert.Equal(t, "identity", req.Identity)
+						assert.Equal(t, "workflowID: wf, runID: 2f3e1a52-8d1b-4bd5-9a0c-6a1c5b0f0b8e, reason: erasure request", req.Comment)
+						assert.NotEmpty(t, req.EventID)
+						return &persistence.CreateDomainAuditLogResponse{}, nil
+					})
+			},
+		},
+		{
+			name: "audit log failure does not fail the request",
+			req:  validRequest(),
+			setupMocks: func(wh *WorkflowHandler, deps *mockDeps) {
+				wh.config.EnableDomainAuditLogging = dynamicproperties.GetBoolPropertyFn(true)
+				deps.mockDomainCache.EXPECT().GetDomainID("domain").Return("domain-id", nil)
+				deps.mockHistoryClient.EXPECT().DeleteWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil)
+				deps.mockResource.DomainAuditMgr.EXPECT().CreateDomainAuditLog(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("audit error"))
+			},
+		},
+		{
+			name:          "nil request",
+			req:           nil,
+			setupMocks:    func(wh *WorkflowHandler, deps *mockDeps) {},
+			expectedError: validate.ErrRequestNotSet,
+		},
+		{
+			name: "domain not set",
+			req: func() *types.DeleteWorkflowExecutionRequest {
+				req := validRequest()
+				req.Domain = ""
+				return req
+			}(),
+			setupMocks:    func(wh *WorkflowHandler, deps *mockDeps) {},
+			expectedError: validate.ErrDomainNotSet,
+		},
+		{
+			name: "workflow ID not set",
+			req: func() *types.DeleteWorkflowExecutionRequest {
+				req := validRequest()
+				req.WorkflowExecution.WorkflowID = ""
+				return req
+			}(),
+			setupMocks:    func(wh *WorkflowHandler, deps *mockDeps) {},
+			expectedError: validate.ErrWorkflowIDNotSet,
+		},
+		{
+			name: "run ID not set",
+			req: func() *types.DeleteWorkflowExecutionRequest {
+				req := validRequest()
+				req.WorkflowExecution.RunID = ""
+				return req
+			}(),
+			setupMocks:    func(wh *WorkflowHandler, deps *mockDeps) {},
+			expectedError: validate.ErrRunIDNotSet,
+		},
+		{
+			name: "identity too long",
+			req:  validRequest(),
+			setupMocks: func(wh *WorkflowHandler, deps *mockDeps) {
+				wh.config.IdentityMaxLength = dynamicproperties.GetIntPropertyFilteredByDomain(1)
+			},
+			expectedError: validate.ErrIdentityTooLong,
+		},
+		{
+			name: "cache error",
+			req:  validRequest(),
+			setupMocks: func(wh *WorkflowHandler, deps *mockDeps) {
+				deps.mockDomainCache.EXPECT().GetDomainID("domain").Return("", errors.New("cache error"))
+			},
+			expectedError: errors.New("cache error"),
+		},
+		{
+			name: "history client error",
+			req:  validRequest(),
+			setupMocks: func(wh *WorkflowHandler, deps *mockDeps) {
+				deps.mockDomainCache.EXPECT().GetDomainID("domain").Return("domain-id", nil)
+				deps.mockHistoryClient.EXPECT().DeleteWorkflowExecution(gomock.Any(), gomock.Any()).
+					Return(&types.BadRequestError{Message: "workflow is still running"})
+			},
+			expectedError: &types.BadRequestError{Message: "workflow is still running"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			wh, deps := setupMocksForWorkflowHandler(t)
+			tc.setupMocks(wh, deps)
+
+			err := wh.DeleteWorkflowExecution(context.Background(), tc.req)
+			if tc.expectedError != nil {
+				assert.Equal(t, tc.expectedError, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
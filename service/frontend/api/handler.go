@@ -52,10 +52,12 @@ import (
 	persistenceutils "github.com/uber/cadence/common/persistence/persistence-utils"
 	"github.com/uber/cadence/common/resource"
 	"github.com/uber/cadence/common/service"
+	"github.com/uber/cadence/common/taskpriority"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/common/types/mapper/thrift"
 	"github.com/uber/cadence/service/frontend/config"
 	"github.com/uber/cadence/service/frontend/validate"
+	"github.com/uber/cadence/service/history/query"
 	"github.com/uber/cadence/service/worker/diagnostics"
 )
 
@@ -842,10 +844,13 @@ func (wh *WorkflowHandler) RespondActivityTaskCompleted(
 	sizeLimitError := wh.config.BlobSizeLimitError(domainName)
 	sizeLimitWarn := wh.config.BlobSizeLimitWarn(domainName)
 
-	if err := common.CheckEventBlobSizeLimit(
-		len(completeRequest.Result),
+	if err := common.CheckHistoryEventBlobSizeLimit(
+		[]int{len(completeRequest.Result)},
+		0,
 		sizeLimitWarn,
 		sizeLimitError,
+		wh.config.PayloadOffloadThreshold(domainName),
+		wh.config.OffloadedBlobSizeLimitError(domainName),
 		taskToken.DomainID,
 		domainName,
 		taskToken.WorkflowID,
@@ -944,10 +949,13 @@ func (wh *WorkflowHandler) RespondActivityTaskCompletedByID(
 	sizeLimitError := wh.config.BlobSizeLimitError(domainName)
 	sizeLimitWarn := wh.config.BlobSizeLimitWarn(domainName)
 
-	if err := common.CheckEventBlobSizeLimit(
-		len(completeRequest.Result),
+	if err := common.CheckHistoryEventBlobSizeLimit(
+		[]int{len(completeRequest.Result)},
+		0,
 		sizeLimitWarn,
 		sizeLimitError,
+		wh.config.PayloadOffloadThreshold(domainName),
+		wh.config.OffloadedBlobSizeLimitError(domainName),
 		taskToken.DomainID,
 		domainName,
 		taskToken.WorkflowID,
@@ -1037,10 +1045,13 @@ func (wh *WorkflowHandler) RespondActivityTaskFailed(
 	sizeLimitError := wh.config.BlobSizeLimitError(domainName)
 	sizeLimitWarn := wh.config.BlobSizeLimitWarn(domainName)
 
-	if err := common.CheckEventBlobSizeLimit(
-		len(failedRequest.Details),
+	if err := common.CheckHistoryEventBlobSizeLimit(
+		[]int{len(failedRequest.Details)},
+		0,
 		sizeLimitWarn,
 		sizeLimitError,
+		wh.config.PayloadOffloadThreshold(domainName),
+		wh.config.OffloadedBlobSizeLimitError(domainName),
 		taskToken.DomainID,
 		domainName,
 		taskToken.WorkflowID,
@@ -1128,10 +1139,13 @@ func (wh *WorkflowHandler) RespondActivityTaskFailedByID(
 	sizeLimitError := wh.config.BlobSizeLimitError(domainName)
 	sizeLimitWarn := wh.config.BlobSizeLimitWarn(domainName)
 
-	if err := common.CheckEventBlobSizeLimit(
-		len(failedRequest.Details),
+	if err := common.CheckHistoryEventBlobSizeLimit(
+		[]int{len(failedRequest.Details)},
+		0,
 		sizeLimitWarn,
 		sizeLimitError,
+		wh.config.PayloadOffloadThreshold(domainName),
+		wh.config.OffloadedBlobSizeLimitError(domainName),
 		taskToken.DomainID,
 		domainName,
 		taskToken.WorkflowID,
@@ -1214,10 +1228,13 @@ func (wh *WorkflowHandler) RespondActivityTaskCanceled(
 	sizeLimitError := wh.config.BlobSizeLimitError(domainName)
 	sizeLimitWarn := wh.config.BlobSizeLimitWarn(domainName)
 
-	if err := common.CheckEventBlobSizeLimit(
-		len(cancelRequest.Details),
+	if err := common.CheckHistoryEventBlobSizeLimit(
+		[]int{len(cancelRequest.Details)},
+		0,
 		sizeLimitWarn,
 		sizeLimitError,
+		wh.config.PayloadOffloadThreshold(domainName),
+		wh.config.OffloadedBlobSizeLimitError(domainName),
 		taskToken.DomainID,
 		domainName,
 		taskToken.WorkflowID,
@@ -1316,10 +1333,13 @@ func (wh *WorkflowHandler) RespondActivityTaskCanceledByID(
 	sizeLimitError := wh.config.BlobSizeLimitError(domainName)
 	sizeLimitWarn := wh.config.BlobSizeLimitWarn(domainName)
 
-	if err := common.CheckEventBlobSizeLimit(
-		len(cancelRequest.Details),
+	if err := common.CheckHistoryEventBlobSizeLimit(
+		[]int{len(cancelRequest.Details)},
+		0,
 		sizeLimitWarn,
 		sizeLimitError,
+		wh.config.PayloadOffloadThreshold(domainName),
+		wh.config.OffloadedBlobSizeLimitError(domainName),
 		taskToken.DomainID,
 		domainName,
 		taskToken.WorkflowID,
@@ -1496,10 +1516,13 @@ func (wh *WorkflowHandler) RespondDecisionTaskFailed(
 	sizeLimitError := wh.config.BlobSizeLimitError(domainName)
 	sizeLimitWarn := wh.config.BlobSizeLimitWarn(domainName)
 
-	if err := common.CheckEventBlobSizeLimit(
-		len(failedRequest.Details),
+	if err := common.CheckHistoryEventBlobSizeLimit(
+		[]int{len(failedRequest.Details)},
+		0,
 		sizeLimitWarn,
 		sizeLimitError,
+		wh.config.PayloadOffloadThreshold(domainName),
+		wh.config.OffloadedBlobSizeLimitError(domainName),
 		taskToken.DomainID,
 		domainName,
 		taskToken.WorkflowID,
@@ -1765,6 +1788,12 @@ func (wh *WorkflowHandler) validateStartWorkflowExecutionRequest(ctx context.Con
 	if startRequest.GetJitterStartSeconds() < 0 {
 		return validate.ErrInvalidJitterStartSeconds
 	}
+	if !taskpriority.IsValid(startRequest.GetPriority()) {
+		return validate.ErrInvalidTaskPriority
+	}
+	if !taskpriority.IsValidFairnessKey(startRequest.GetFairnessKey()) {
+		return validate.ErrFairnessKeyTooLong
+	}
 	jitter := startRequest.GetJitterStartSeconds()
 	cron := startRequest.GetCronSchedule()
 	if cron != "" {
@@ -1807,14 +1836,18 @@ func (wh *WorkflowHandler) validateStartWorkflowExecutionRequest(ctx context.Con
 	}
 	sizeLimitError := wh.config.BlobSizeLimitError(domainName)
 	sizeLimitWarn := wh.config.BlobSizeLimitWarn(domainName)
-	actualSize := len(startRequest.Input)
+	// the memo is kept in mutable state and visibility too, so it counts towards the size of the event even if it is offloaded
+	memoSize := 0
 	if startRequest.Memo != nil {
-		actualSize += common.GetSizeOfMapStringToByteArray(startRequest.Memo.GetFields())
+		memoSize = common.GetSizeOfMapStringToByteArray(startRequest.Memo.GetFields())
 	}
-	if err := common.CheckEventBlobSizeLimit(
-		actualSize,
+	if err := common.CheckHistoryEventBlobSizeLimit(
+		[]int{len(startRequest.Input)},
+		memoSize,
 		sizeLimitWarn,
 		sizeLimitError,
+		wh.config.PayloadOffloadThreshold(domainName),
+		wh.config.OffloadedBlobSizeLimitError(domainName),
 		domainID,
 		domainName,
 		startRequest.GetWorkflowID(),
@@ -2175,6 +2208,10 @@ func (wh *WorkflowHandler) SignalWorkflowExecution(
 		return validate.ErrSignalNameTooLong
 	}
 
+	if query.IsReservedSignalName(signalRequest.GetSignalName()) {
+		return validate.ErrSignalNameReserved
+	}
+
 	if !common.IsValidIDLength(
 		signalRequest.GetRequestID(),
 		scope,
@@ -2194,10 +2231,13 @@ func (wh *WorkflowHandler) SignalWorkflowExecution(
 
 	sizeLimitError := wh.config.BlobSizeLimitError(domainName)
 	sizeLimitWarn := wh.config.BlobSizeLimitWarn(domainName)
-	if err := common.CheckEventBlobSizeLimit(
-		len(signalRequest.Input),
+	if err := common.CheckHistoryEventBlobSizeLimit(
+		[]int{len(signalRequest.Input)},
+		0,
 		sizeLimitWarn,
 		sizeLimitError,
+		wh.config.PayloadOffloadThreshold(domainName),
+		wh.config.OffloadedBlobSizeLimitError(domainName),
 		domainID,
 		domainName,
 		signalRequest.GetWorkflowExecution().GetWorkflowID(),
@@ -2364,6 +2404,10 @@ func (wh *WorkflowHandler) validateSignalWithStartWorkflowExecutionRequest(ctx c
 		return validate.ErrSignalNameTooLong
 	}
 
+	if query.IsReservedSignalName(signalWithStartRequest.GetSignalName()) {
+		return validate.ErrSignalNameReserved
+	}
+
 	if signalWithStartRequest.WorkflowType == nil || signalWithStartRequest.WorkflowType.GetName() == "" {
 		return validate.ErrWorkflowTypeNotSet
 	}
@@ -2408,6 +2452,13 @@ func (wh *WorkflowHandler) validateSignalWithStartWorkflowExecutionRequest(ctx c
 		return err
 	}
 
+	if !taskpriority.IsValid(signalWithStartRequest.GetPriority()) {
+		return validate.ErrInvalidTaskPriority
+	}
+	if !taskpriority.IsValidFairnessKey(signalWithStartRequest.GetFairnessKey()) {
+		return validate.ErrFairnessKeyTooLong
+	}
+
 	if signalWithStartRequest.GetCronSchedule() != "" {
 		if _, err := backoff.ValidateSchedule(signalWithStartRequest.GetCronSchedule()); err != nil {
 			return err
@@ -2425,10 +2476,13 @@ func (wh *WorkflowHandler) validateSignalWithStartWorkflowExecutionRequest(ctx c
 
 	sizeLimitError := wh.config.BlobSizeLimitError(domainName)
 	sizeLimitWarn := wh.config.BlobSizeLimitWarn(domainName)
-	if err := common.CheckEventBlobSizeLimit(
-		len(signalWithStartRequest.SignalInput),
+	if err := common.CheckHistoryEventBlobSizeLimit(
+		[]int{len(signalWithStartRequest.SignalInput)},
+		0,
 		sizeLimitWarn,
 		sizeLimitError,
+		wh.config.PayloadOffloadThreshold(domainName),
+		wh.config.OffloadedBlobSizeLimitError(domainName),
 		domainID,
 		domainName,
 		signalWithStartRequest.GetWorkflowID(),
@@ -2439,11 +2493,14 @@ func (wh *WorkflowHandler) validateSignalWithStartWorkflowExecutionRequest(ctx c
 	); err != nil {
 		return err
 	}
-	actualSize := len(signalWithStartRequest.Input) + common.GetSizeOfMapStringToByteArray(signalWithStartRequest.Memo.GetFields())
-	if err := common.CheckEventBlobSizeLimit(
-		actualSize,
+	// the memo is kept in mutable state and visibility too, so it counts towards the size of the event even if it is offloaded
+	if err := common.CheckHistoryEventBlobSizeLimit(
+		[]int{len(signalWithStartRequest.Input)},
+		common.GetSizeOfMapStringToByteArray(signalWithStartRequest.Memo.GetFields()),
 		sizeLimitWarn,
 		sizeLimitError,
+		wh.config.PayloadOffloadThreshold(domainName),
+		wh.config.OffloadedBlobSizeLimitError(domainName),
 		domainID,
 		domainName,
 		signalWithStartRequest.GetWorkflowID(),
@@ -100,9 +100,100 @@ func validateSchedulePolicies(policies *types.SchedulePolicies) error {
 				"caught-up fires would be immediately skipped due to overlap with the previous run.",
 		}
 	}
+	return validateScheduleFailureBudget(policies)
+}
+
+// validateScheduleFailureBudget checks that a failure budget is only set
+// together with PauseOnFailure and that its thresholds are in range.
+func validateScheduleFailureBudget(policies *types.SchedulePolicies) error {
+	budget := policies.GetFailureBudget()
+	if budget == nil {
+		return nil
+	}
+	if !policies.GetPauseOnFailure() {
+		return &types.BadRequestError{Message: "Policies.FailureBudget requires Policies.PauseOnFailure to be set."}
+	}
+	if budget.GetMaxConsecutiveFailures() < 0 || budget.GetMinRunsInWindow() < 0 {
+		return &types.BadRequestError{Message: "Policies.FailureBudget counts must not be negative."}
+	}
+	if budget.GetMaxFailureRate() < 0 || budget.GetMaxFailureRate() >= 1 {
+		return &types.BadRequestError{Message: "Policies.FailureBudget.MaxFailureRate must be at least 0 and below 1."}
+	}
+	if budget.GetMaxFailureRate() > 0 && budget.GetFailureRateWindow() <= 0 {
+		return &types.BadRequestError{Message: "Policies.FailureBudget.FailureRateWindow must be positive when MaxFailureRate is set."}
+	}
+	if budget.GetMaxConsecutiveFailures() == 0 && budget.GetMaxFailureRate() == 0 {
+		return &types.BadRequestError{Message: "Policies.FailureBudget must set MaxConsecutiveFailures or MaxFailureRate."}
+	}
 	return nil
 }
 
+// validateScheduleSpec checks that the cron expression, intervals and calendars
+// of the spec compile and that the resulting schedule fires at least once after
+// StartTime and, unless EndTime has already passed, before EndTime. An EndTime
+// in the past is left to the scheduler, which completes the schedule.
+func validateScheduleSpec(spec *types.ScheduleSpec) error {
+	if spec.GetCronExpression() != "" {
+		if _, err := backoff.ValidateSchedule(spec.GetCronExpression()); err != nil {
+			return err
+		}
+	}
+	sched, err := scheduler.NewSchedule(spec)
+	if err != nil {
+		return &types.BadRequestError{Message: fmt.Sprintf("Invalid schedule spec: %v", err)}
+	}
+	now := time.Now()
+	from := now
+	if spec.GetStartTime().After(from) {
+		from = spec.GetStartTime().Add(-time.Second)
+	}
+	next := sched.Next(from)
+	if next.IsZero() {
+		return &types.BadRequestError{Message: "Invalid schedule spec, no next firing time found, maybe impossible date."}
+	}
+	if end := spec.GetEndTime(); end.After(now) && next.After(end) {
+		return &types.BadRequestError{Message: "Invalid schedule spec, no firing time found between StartTime and EndTime."}
+	}
+	return nil
+}
+
+// validateScheduleAction checks that exactly one action is set and that it
+// carries what the scheduler needs to execute it.
+func validateScheduleAction(action *types.ScheduleAction) error {
+	set := 0
+	for _, isSet := range []bool{action.GetStartWorkflow() != nil, action.GetSignalWorkflow() != nil, action.GetSignalWithStartWorkflow() != nil} {
+		if isSet {
+			set++
+		}
+	}
+	if set != 1 {
+		return &types.BadRequestError{Message: "Exactly one of Action.StartWorkflow, Action.SignalWorkflow or Action.SignalWithStartWorkflow must be set on request."}
+	}
+	if sw := action.GetStartWorkflow(); sw != nil {
+		return common.ValidateRetryPolicy(sw.GetRetryPolicy())
+	}
+	if sig := action.GetSignalWorkflow(); sig != nil {
+		if sig.GetWorkflowID() == "" {
+			return &types.BadRequestError{Message: "Action.SignalWorkflow.WorkflowID is not set on request."}
+		}
+		if sig.GetSignalName() == "" {
+			return &types.BadRequestError{Message: "Action.SignalWorkflow.SignalName is not set on request."}
+		}
+		return nil
+	}
+	sws := action.GetSignalWithStartWorkflow()
+	if sws.GetWorkflowID() == "" {
+		return &types.BadRequestError{Message: "Action.SignalWithStartWorkflow.WorkflowID is not set on request."}
+	}
+	if sws.GetSignalName() == "" {
+		return &types.BadRequestError{Message: "Action.SignalWithStartWorkflow.SignalName is not set on request."}
+	}
+	if sws.GetStartWorkflow().GetWorkflowType().GetName() == "" {
+		return &types.BadRequestError{Message: "Action.SignalWithStartWorkflow.StartWorkflow.WorkflowType is not set on request."}
+	}
+	return common.ValidateRetryPolicy(sws.GetStartWorkflow().GetRetryPolicy())
+}
+
 // validateScheduleSpecTimeRange rejects a spec whose EndTime is not after its
 // StartTime when both are set. A zero StartTime or EndTime means "unbounded" and
 // is left unchecked. Mirrors the range validation BackfillSchedule performs, and
@@ -175,6 +266,20 @@ func ongoingBackfillsForResponse(in []types.BackfillInfo) []*types.BackfillInfo
 	return out
 }
 
+// recentActionsForResponse converts the scheduler's recent action history
+// into the pointer slice used by ScheduleInfo, oldest first.
+func recentActionsForResponse(in []types.ScheduleActionResult) []*types.ScheduleActionResult {
+	if len(in) == 0 {
+		return nil
+	}
+	out := make([]*types.ScheduleActionResult, 0, len(in))
+	for i := range in {
+		action := in[i]
+		out = append(out, &action)
+	}
+	return out
+}
+
 func (wh *WorkflowHandler) CreateSchedule(
 	ctx context.Context,
 	request *types.CreateScheduleRequest,
@@ -197,19 +302,19 @@ func (wh *WorkflowHandler) CreateSchedule(
 	if request.GetSpec() == nil {
 		return nil, &types.BadRequestError{Message: "Spec is not set on request."}
 	}
-	if request.GetSpec().GetCronExpression() == "" {
-		return nil, &types.BadRequestError{Message: "CronExpression is not set on request."}
+	if !request.GetSpec().HasTriggers() {
+		return nil, &types.BadRequestError{Message: "One of Spec.CronExpression, Spec.Intervals or Spec.Calendars must be set on request."}
 	}
-	if _, err := backoff.ValidateSchedule(request.GetSpec().GetCronExpression()); err != nil {
+	if err := validateScheduleSpec(request.GetSpec()); err != nil {
 		return nil, err
 	}
 	if err := validateScheduleSpecTimeRange(request.GetSpec()); err != nil {
 		return nil, err
 	}
-	if request.GetAction() == nil || request.GetAction().GetStartWorkflow() == nil {
-		return nil, &types.BadRequestError{Message: "Action.StartWorkflow is not set on request."}
+	if request.GetAction() == nil {
+		return nil, &types.BadRequestError{Message: "Action is not set on request."}
 	}
-	if err := common.ValidateRetryPolicy(request.GetAction().GetStartWorkflow().GetRetryPolicy()); err != nil {
+	if err := validateScheduleAction(request.GetAction()); err != nil {
 		return nil, err
 	}
 	if err := validateSchedulePolicies(request.GetPolicies()); err != nil {
@@ -352,6 +457,7 @@ func (wh *WorkflowHandler) DescribeSchedule(
 			CreateTime:           desc.CreateTime,
 			LastUpdateTime:       desc.LastUpdateTime,
 			OngoingBackfills:     ongoingBackfillsForResponse(desc.OngoingBackfills),
+			RecentActions:        recentActionsForResponse(desc.RecentActions),
 		},
 		Memo:             desc.Memo,
 		SearchAttributes: desc.SearchAttributes,
@@ -384,16 +490,16 @@ func (wh *WorkflowHandler) UpdateSchedule(
 		return nil, err
 	}
 	wh.warnIfBufferLimitExceedsSystemLimit(scheduleID, domainName, request.GetPolicies())
-	if spec := request.GetSpec(); spec != nil && spec.GetCronExpression() != "" {
-		if _, err := backoff.ValidateSchedule(spec.GetCronExpression()); err != nil {
+	if spec := request.GetSpec(); spec != nil && spec.HasTriggers() {
+		if err := validateScheduleSpec(spec); err != nil {
 			return nil, err
 		}
 	}
 	if err := validateScheduleSpecTimeRange(request.GetSpec()); err != nil {
 		return nil, err
 	}
-	if action := request.GetAction(); action != nil && action.GetStartWorkflow() != nil {
-		if err := common.ValidateRetryPolicy(action.GetStartWorkflow().GetRetryPolicy()); err != nil {
+	if action := request.GetAction(); action != nil {
+		if err := validateScheduleAction(action); err != nil {
 			return nil, err
 		}
 	}
@@ -606,6 +712,38 @@ func resolveBackfillID(clientID string) string {
 	return uuid.New().String()
 }
 
+func (wh *WorkflowHandler) TriggerSchedule(
+	ctx context.Context,
+	request *types.TriggerScheduleRequest,
+) (*types.TriggerScheduleResponse, error) {
+	if wh.isShuttingDown() {
+		return nil, validate.ErrShuttingDown
+	}
+	if request == nil {
+		return nil, validate.ErrRequestNotSet
+	}
+
+	domainName := request.GetDomain()
+	if domainName == "" {
+		return nil, validate.ErrDomainNotSet
+	}
+	scheduleID := request.GetScheduleID()
+	if scheduleID == "" {
+		return nil, &types.BadRequestError{Message: "ScheduleID is not set on request."}
+	}
+
+	// The fire time is stamped by the scheduler workflow when the signal is
+	// processed so that it is deterministic on replay.
+	signal := scheduler.TriggerSignal{
+		OverlapPolicy: request.GetOverlapPolicy(),
+	}
+
+	if err := wh.signalScheduleWorkflow(ctx, domainName, scheduleID, scheduler.SignalNameTrigger, signal); err != nil {
+		return nil, err
+	}
+	return &types.TriggerScheduleResponse{}, nil
+}
+
 func (wh *WorkflowHandler) ListSchedules(
 	ctx context.Context,
 	request *types.ListSchedulesRequest,
@@ -24,6 +24,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"strconv"
 	"testing"
 	"time"
 
@@ -162,6 +163,39 @@ func TestCreateSchedule(t *testing.T) {
 			mockFn:  func(f *scheduleTestFixture) {},
 			wantErr: true,
 		},
+		"interval shorter than a second": {
+			request: &types.CreateScheduleRequest{
+				Domain:     testDomain,
+				ScheduleID: "s1",
+				Spec: &types.ScheduleSpec{
+					Intervals: []*types.ScheduleIntervalSpec{{Interval: time.Millisecond}},
+				},
+			},
+			mockFn:  func(f *scheduleTestFixture) {},
+			wantErr: true,
+		},
+		"calendar with out-of-range hour": {
+			request: &types.CreateScheduleRequest{
+				Domain:     testDomain,
+				ScheduleID: "s1",
+				Spec: &types.ScheduleSpec{
+					Calendars: []*types.ScheduleCalendarSpec{{Hour: "25"}},
+				},
+			},
+			mockFn:  func(f *scheduleTestFixture) {},
+			wantErr: true,
+		},
+		"calendar with unknown time zone": {
+			request: &types.CreateScheduleRequest{
+				Domain:     testDomain,
+				ScheduleID: "s1",
+				Spec: &types.ScheduleSpec{
+					Calendars: []*types.ScheduleCalendarSpec{{Hour: "9", TimeZone: "Mars/Olympus_Mons"}},
+				},
+			},
+			mockFn:  func(f *scheduleTestFixture) {},
+			wantErr: true,
+		},
 		"impossible cron date (Feb 30) parses but never fires": {
 			request: &types.CreateScheduleRequest{
 				Domain:     testDomain,
@@ -1486,6 +1520,83 @@ func TestBackfillSchedule(t *testing.T) {
 	}
 }
 
+func TestTriggerSchedule(t *testing.T) {
+	tests := map[string]struct {
+		request *types.TriggerScheduleRequest
+		mockFn  func(*scheduleTestFixture)
+		wantErr bool
+	}{
+		"nil request": {
+			request: nil,
+			mockFn:  func(f *scheduleTestFixture) {},
+			wantErr: true,
+		},
+		"missing domain": {
+			request: &types.TriggerScheduleRequest{ScheduleID: "s1"},
+			mockFn:  func(f *scheduleTestFixture) {},
+			wantErr: true,
+		},
+		"missing schedule id": {
+			request: &types.TriggerScheduleRequest{Domain: testDomain},
+			mockFn:  func(f *scheduleTestFixture) {},
+			wantErr: true,
+		},
+		"success with overlap override": {
+			request: &types.TriggerScheduleRequest{
+				Domain:        testDomain,
+				ScheduleID:    "s1",
+				OverlapPolicy: types.ScheduleOverlapPolicyConcurrent,
+			},
+			mockFn: func(f *scheduleTestFixture) {
+				f.domainCache.EXPECT().GetDomainID(testDomain).Return(testDomainID, nil).AnyTimes()
+				f.historyClient.EXPECT().SignalWorkflowExecution(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, req *types.HistorySignalWorkflowExecutionRequest, _ ...yarpc.CallOption) error {
+						assert.Equal(t, scheduler.SignalNameTrigger, req.SignalRequest.SignalName)
+						var signal scheduler.TriggerSignal
+						require.NoError(t, json.Unmarshal(req.SignalRequest.Input, &signal))
+						assert.Equal(t, types.ScheduleOverlapPolicyConcurrent, signal.OverlapPolicy)
+						assert.True(t, signal.TriggerTime.IsZero())
+						return nil
+					})
+			},
+			wantErr: false,
+		},
+		"success without overlap override": {
+			request: &types.TriggerScheduleRequest{
+				Domain:     testDomain,
+				ScheduleID: "s1",
+			},
+			mockFn: func(f *scheduleTestFixture) {
+				f.domainCache.EXPECT().GetDomainID(testDomain).Return(testDomainID, nil).AnyTimes()
+				f.historyClient.EXPECT().SignalWorkflowExecution(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, req *types.HistorySignalWorkflowExecutionRequest, _ ...yarpc.CallOption) error {
+						var signal scheduler.TriggerSignal
+						require.NoError(t, json.Unmarshal(req.SignalRequest.Input, &signal))
+						assert.Equal(t, types.ScheduleOverlapPolicyInvalid, signal.OverlapPolicy)
+						return nil
+					})
+			},
+			wantErr: false,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			f := newScheduleTestFixture(t)
+			defer f.finish()
+			tt.mockFn(f)
+
+			resp, err := f.handler.TriggerSchedule(context.Background(), tt.request)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, resp)
+			}
+		})
+	}
+}
+
 func TestListSchedules(t *testing.T) {
 	t.Run("nil request", func(t *testing.T) {
 		f := newScheduleTestFixture(t)
@@ -1697,6 +1808,52 @@ func TestValidateSchedulePolicies(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		"valid failure budget": {
+			policies: &types.SchedulePolicies{
+				PauseOnFailure: true,
+				FailureBudget: &types.ScheduleFailureBudget{
+					MaxConsecutiveFailures: 3,
+					MaxFailureRate:         0.5,
+					FailureRateWindow:      24 * time.Hour,
+					MinRunsInWindow:        4,
+				},
+			},
+			wantErr: false,
+		},
+		"failure budget without PauseOnFailure": {
+			policies: &types.SchedulePolicies{
+				FailureBudget: &types.ScheduleFailureBudget{MaxConsecutiveFailures: 3},
+			},
+			wantErr: true,
+		},
+		"empty failure budget": {
+			policies: &types.SchedulePolicies{
+				PauseOnFailure: true,
+				FailureBudget:  &types.ScheduleFailureBudget{},
+			},
+			wantErr: true,
+		},
+		"negative consecutive failures": {
+			policies: &types.SchedulePolicies{
+				PauseOnFailure: true,
+				FailureBudget:  &types.ScheduleFailureBudget{MaxConsecutiveFailures: -1},
+			},
+			wantErr: true,
+		},
+		"failure rate of 1": {
+			policies: &types.SchedulePolicies{
+				PauseOnFailure: true,
+				FailureBudget:  &types.ScheduleFailureBudget{MaxFailureRate: 1, FailureRateWindow: time.Hour},
+			},
+			wantErr: true,
+		},
+		"failure rate without window": {
+			policies: &types.SchedulePolicies{
+				PauseOnFailure: true,
+				FailureBudget:  &types.ScheduleFailureBudget{MaxFailureRate: 0.5},
+			},
+			wantErr: true,
+		},
 	}
 	for name, tt := range tests {
 		t.Run(name, func(t *testing.T) {
@@ -1744,6 +1901,34 @@ func TestOngoingBackfillsForResponse(t *testing.T) {
 	})
 }
 
+func TestRecentActionsForResponse(t *testing.T) {
+	t.Run("empty input returns nil", func(t *testing.T) {
+		assert.Nil(t, recentActionsForResponse(nil))
+		assert.Nil(t, recentActionsForResponse([]types.ScheduleActionResult{}))
+	})
+	t.Run("non-empty input is mapped one-to-one in order", func(t *testing.T) {
+		in := []types.ScheduleActionResult{
+			{
+				ScheduledTime: time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC),
+				WorkflowID:    "wf-1",
+				RunID:         "run-1",
+				TriggerSource: "schedule",
+				CloseStatus:   types.WorkflowExecutionCloseStatusCompleted.Ptr(),
+			},
+			{
+				ScheduledTime: time.Date(2026, 3, 1, 1, 0, 0, 0, time.UTC),
+				WorkflowID:    "wf-2",
+				RunID:         "run-2",
+				TriggerSource: "backfill",
+			},
+		}
+		out := recentActionsForResponse(in)
+		require.Len(t, out, 2)
+		assert.Equal(t, in[0], *out[0])
+		assert.Equal(t, in[1], *out[1])
+	})
+}
+
 // TestValidateUserSearchAttributes verifies that user-supplied search attribute
 // keys colliding with the scheduler-reserved "CadenceSchedule" prefix are
 // rejected, while other keys (including a lowercase near-miss) are allowed. The
@@ -1785,6 +1970,58 @@ func TestValidateUserSearchAttributes(t *testing.T) {
 // TestValidateScheduleSpecTimeRange verifies the spec StartTime/EndTime ordering
 // check: both must be set for the check to apply, and EndTime must be strictly
 // after StartTime.
+func TestValidateScheduleAction(t *testing.T) {
+	start := &types.StartWorkflowAction{WorkflowType: &types.WorkflowType{Name: "wf"}}
+	tests := map[string]struct {
+		action  *types.ScheduleAction
+		wantErr bool
+	}{
+		"start workflow": {action: &types.ScheduleAction{StartWorkflow: start}, wantErr: false},
+		"no action set":  {action: &types.ScheduleAction{}, wantErr: true},
+		"two actions set": {
+			action: &types.ScheduleAction{
+				StartWorkflow:  start,
+				SignalWorkflow: &types.SignalWorkflowAction{WorkflowID: "wid", SignalName: "tick"},
+			},
+			wantErr: true,
+		},
+		"signal workflow": {
+			action:  &types.ScheduleAction{SignalWorkflow: &types.SignalWorkflowAction{WorkflowID: "wid", SignalName: "tick"}},
+			wantErr: false,
+		},
+		"signal workflow without workflow ID": {
+			action:  &types.ScheduleAction{SignalWorkflow: &types.SignalWorkflowAction{SignalName: "tick"}},
+			wantErr: true,
+		},
+		"signal workflow without signal name": {
+			action:  &types.ScheduleAction{SignalWorkflow: &types.SignalWorkflowAction{WorkflowID: "wid"}},
+			wantErr: true,
+		},
+		"signal with start": {
+			action: &types.ScheduleAction{SignalWithStartWorkflow: &types.SignalWithStartWorkflowAction{
+				WorkflowID: "wid", SignalName: "tick", StartWorkflow: start,
+			}},
+			wantErr: false,
+		},
+		"signal with start without start workflow": {
+			action: &types.ScheduleAction{SignalWithStartWorkflow: &types.SignalWithStartWorkflowAction{
+				WorkflowID: "wid", SignalName: "tick",
+			}},
+			wantErr: true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := validateScheduleAction(tt.action)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestValidateScheduleSpecTimeRange(t *testing.T) {
 	t1 := time.Unix(1000, 0)
 	t2 := time.Unix(2000, 0)
@@ -1812,6 +2049,40 @@ func TestValidateScheduleSpecTimeRange(t *testing.T) {
 	}
 }
 
+func TestValidateScheduleSpec(t *testing.T) {
+	now := time.Now()
+	tests := map[string]struct {
+		spec    *types.ScheduleSpec
+		wantErr bool
+	}{
+		"fires":                    {spec: &types.ScheduleSpec{CronExpression: "* * * * *"}, wantErr: false},
+		"impossible date":          {spec: &types.ScheduleSpec{CronExpression: "0 0 30 2 *"}, wantErr: true},
+		"fires after future start": {spec: &types.ScheduleSpec{CronExpression: "0 0 1 1 *", StartTime: now.Add(48 * time.Hour)}, wantErr: false},
+		"fires before end": {
+			spec:    &types.ScheduleSpec{CronExpression: "* * * * *", EndTime: now.Add(time.Hour)},
+			wantErr: false,
+		},
+		"no fire before end": {
+			spec:    &types.ScheduleSpec{Calendars: []*types.ScheduleCalendarSpec{{Year: strconv.Itoa(now.Year() + 2)}}, EndTime: now.Add(time.Hour)},
+			wantErr: true,
+		},
+		"end already passed": {
+			spec:    &types.ScheduleSpec{CronExpression: "* * * * *", EndTime: now.Add(-time.Hour)},
+			wantErr: false,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := validateScheduleSpec(tt.spec)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
 // TestWarnIfBufferLimitExceedsSystemLimit_NilSafe verifies the helper does not
 // panic when policies or policies.BufferLimit is nil, and that it stays a no-op
 // when the overlap policy is not Buffer (BufferLimit has no effect there).
@@ -0,0 +1,238 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/persistence"
+	commonsemaphore "github.com/uber/cadence/common/semaphore"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/frontend/validate"
+	"github.com/uber/cadence/service/worker/semaphore"
+)
+
+const defaultListSemaphoresPageSize = 100
+
+// CreateSemaphore creates a semaphore in a domain and starts the workflow managing its permits
+func (wh *WorkflowHandler) CreateSemaphore(
+	ctx context.Context,
+	request *types.CreateSemaphoreRequest,
+) (*types.CreateSemaphoreResponse, error) {
+	if wh.isShuttingDown() {
+		return nil, validate.ErrShuttingDown
+	}
+	if request == nil {
+		return nil, validate.ErrRequestNotSet
+	}
+	domainName := request.GetDomain()
+	if domainName == "" {
+		return nil, validate.ErrDomainNotSet
+	}
+	if request.GetSemaphoreName() == "" {
+		return nil, validate.ErrSemaphoreNameNotSet
+	}
+	if request.GetSize() <= 0 {
+		return nil, &types.BadRequestError{Message: "Size must be positive."}
+	}
+	if request.GetBucketSize() < 0 {
+		return nil, &types.BadRequestError{Message: "BucketSize must not be negative."}
+	}
+	domainID, err := wh.GetDomainCache().GetDomainID(domainName)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := wh.GetSemaphoreMetadataManager().CreateSemaphore(ctx, &persistence.CreateSemaphoreRequest{
+		DomainID:      domainID,
+		SemaphoreName: request.GetSemaphoreName(),
+		Size:          int(request.GetSize()),
+		BucketSize:    int(request.GetBucketSize()),
+	})
+	if err != nil {
+		var conditionFailed *persistence.ConditionFailedError
+		if errors.As(err, &conditionFailed) {
+			return nil, &types.BadRequestError{
+				Message: fmt.Sprintf("semaphore %q already exists in domain %q", request.GetSemaphoreName(), domainName),
+			}
+		}
+		return nil, err
+	}
+
+	// The semaphore worker manager starts the workflow of every semaphore on its
+	// next refresh, so failing to start it here only delays the first grants.
+	startRequest, err := semaphore.NewStartWorkflowRequest(domainName, resp.Semaphore)
+	if err != nil {
+		return nil, &types.InternalServiceError{Message: fmt.Sprintf("failed to serialize semaphore workflow input: %v", err)}
+	}
+	if _, err := wh.StartWorkflowExecution(ctx, startRequest); err != nil {
+		var alreadyStarted *types.WorkflowExecutionAlreadyStartedError
+		if !errors.As(err, &alreadyStarted) {
+			wh.GetLogger().Warn("failed to start semaphore workflow",
+				tag.WorkflowDomainName(domainName),
+				tag.WorkflowID(startRequest.WorkflowID),
+				tag.Error(err),
+			)
+		}
+	}
+
+	return &types.CreateSemaphoreResponse{Semaphore: toSemaphoreInfo(resp.Semaphore)}, nil
+}
+
+// DescribeSemaphore returns the configuration of a semaphore together with its holders and waiters
+func (wh *WorkflowHandler) DescribeSemaphore(
+	ctx context.Context,
+	request *types.DescribeSemaphoreRequest,
+) (*types.DescribeSemaphoreResponse, error) {
+	if wh.isShuttingDown() {
+		return nil, validate.ErrShuttingDown
+	}
+	if request == nil {
+		return nil, validate.ErrRequestNotSet
+	}
+	domainName := request.GetDomain()
+	if domainName == "" {
+		return nil, validate.ErrDomainNotSet
+	}
+	if request.GetSemaphoreName() == "" {
+		return nil, validate.ErrSemaphoreNameNotSet
+	}
+	domainID, err := wh.GetDomainCache().GetDomainID(domainName)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := wh.GetSemaphoreMetadataManager().GetSemaphore(ctx, &persistence.GetSemaphoreRequest{
+		DomainID:      domainID,
+		SemaphoreName: request.GetSemaphoreName(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	queryResp, err := wh.QueryWorkflow(ctx, &types.QueryWorkflowRequest{
+		Domain:    domainName,
+		Execution: &types.WorkflowExecution{WorkflowID: commonsemaphore.WorkflowID(request.GetSemaphoreName())},
+		Query:     &types.WorkflowQuery{QueryType: semaphore.QueryTypeDescribe},
+	})
+	if err != nil {
+		var notExists *types.EntityNotExistsError
+		if !errors.As(err, &notExists) {
+			return nil, err
+		}
+		// The workflow of a semaphore is started lazily, so a semaphore without
+		// one has never been acquired.
+		return &types.DescribeSemaphoreResponse{
+			Semaphore:        toSemaphoreInfo(resp.Semaphore),
+			AvailablePermits: int32(resp.Semaphore.Size),
+		}, nil
+	}
+
+	var desc semaphore.Description
+	if err := json.Unmarshal(queryResp.GetQueryResult(), &desc); err != nil {
+		return nil, &types.InternalServiceError{Message: fmt.Sprintf("failed to deserialize semaphore describe response: %v", err)}
+	}
+	return &types.DescribeSemaphoreResponse{
+		Semaphore:        toSemaphoreInfo(resp.Semaphore),
+		AvailablePermits: int32(desc.AvailablePermits),
+		Holders:          toSemaphorePermitRequests(desc.Holders),
+		Waiters:          toSemaphorePermitRequests(desc.Waiters),
+	}, nil
+}
+
+// ListSemaphores lists the semaphores of a domain
+func (wh *WorkflowHandler) ListSemaphores(
+	ctx context.Context,
+	request *types.ListSemaphoresRequest,
+) (*types.ListSemaphoresResponse, error) {
+	if wh.isShuttingDown() {
+		return nil, validate.ErrShuttingDown
+	}
+	if request == nil {
+		return nil, validate.ErrRequestNotSet
+	}
+	domainName := request.GetDomain()
+	if domainName == "" {
+		return nil, validate.ErrDomainNotSet
+	}
+	pageSize := int(request.GetPageSize())
+	if pageSize <= 0 {
+		pageSize = defaultListSemaphoresPageSize
+	}
+	domainID, err := wh.GetDomainCache().GetDomainID(domainName)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := wh.GetSemaphoreMetadataManager().ListSemaphores(ctx, &persistence.ListSemaphoresRequest{
+		DomainID:      domainID,
+		PageSize:      pageSize,
+		NextPageToken: request.GetNextPageToken(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	semaphores := make([]*types.SemaphoreInfo, 0, len(resp.Semaphores))
+	for _, s := range resp.Semaphores {
+		semaphores = append(semaphores, toSemaphoreInfo(s))
+	}
+	return &types.ListSemaphoresResponse{
+		Semaphores:    semaphores,
+		NextPageToken: resp.NextPageToken,
+	}, nil
+}
+
+func toSemaphoreInfo(s *persistence.SemaphoreMetadata) *types.SemaphoreInfo {
+	if s == nil {
+		return nil
+	}
+	return &types.SemaphoreInfo{
+		Name:        s.SemaphoreName,
+		Size:        int32(s.Size),
+		BucketSize:  int32(s.BucketSize),
+		CreatedTime: s.CreatedTime,
+	}
+}
+
+func toSemaphorePermitRequests(requests []semaphore.PermitRequest) []*types.SemaphorePermitRequest {
+	if len(requests) == 0 {
+		return nil
+	}
+	result := make([]*types.SemaphorePermitRequest, 0, len(requests))
+	for _, r := range requests {
+		result = append(result, &types.SemaphorePermitRequest{
+			RequestID: r.RequestID,
+			Execution: &types.WorkflowExecution{
+				WorkflowID: r.WorkflowID,
+				RunID:      r.RunID,
+			},
+			Permits:       int32(r.Permits),
+			RequestedTime: r.RequestedTime,
+			AcquiredTime:  r.AcquiredTime,
+		})
+	}
+	return result
+}
@@ -0,0 +1,327 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/yarpc"
+
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/frontend/validate"
+	"github.com/uber/cadence/service/worker/semaphore"
+)
+
+func TestCreateSemaphore(t *testing.T) {
+	createdTime := time.Unix(1700000000, 0).UTC()
+	metadata := &persistence.SemaphoreMetadata{
+		DomainID:      testDomainID,
+		SemaphoreName: "downstream",
+		Size:          10,
+		BucketSize:    persistence.DefaultSemaphoreBucketSize,
+		CreatedTime:   createdTime,
+	}
+	info := &types.SemaphoreInfo{
+		Name:        "downstream",
+		Size:        10,
+		BucketSize:  persistence.DefaultSemaphoreBucketSize,
+		CreatedTime: createdTime,
+	}
+	validRequest := &types.CreateSemaphoreRequest{
+		Domain:        testDomain,
+		SemaphoreName: "downstream",
+		Size:          10,
+	}
+
+	tests := map[string]struct {
+		request *types.CreateSemaphoreRequest
+		mockFn  func(*scheduleTestFixture)
+		want    *types.CreateSemaphoreResponse
+		wantErr error
+	}{
+		"nil request": {
+			request: nil,
+			mockFn:  func(f *scheduleTestFixture) {},
+			wantErr: validate.ErrRequestNotSet,
+		},
+		"empty domain": {
+			request: &types.CreateSemaphoreRequest{SemaphoreName: "downstream", Size: 10},
+			mockFn:  func(f *scheduleTestFixture) {},
+			wantErr: validate.ErrDomainNotSet,
+		},
+		"semaphore name not set": {
+			request: &types.CreateSemaphoreRequest{Domain: testDomain, Size: 10},
+			mockFn:  func(f *scheduleTestFixture) {},
+			wantErr: validate.ErrSemaphoreNameNotSet,
+		},
+		"size not positive": {
+			request: &types.CreateSemaphoreRequest{Domain: testDomain, SemaphoreName: "downstream"},
+			mockFn:  func(f *scheduleTestFixture) {},
+			wantErr: &types.BadRequestError{Message: "Size must be positive."},
+		},
+		"already exists": {
+			request: validRequest,
+			mockFn: func(f *scheduleTestFixture) {
+				f.domainCache.EXPECT().GetDomainID(testDomain).Return(testDomainID, nil).AnyTimes()
+				f.mockResource.SemaphoreMgr.EXPECT().CreateSemaphore(gomock.Any(), gomock.Any()).
+					Return(nil, &persistence.ConditionFailedError{Msg: "exists"})
+			},
+			wantErr: &types.BadRequestError{Message: `semaphore "downstream" already exists in domain "test-domain"`},
+		},
+		"normal request": {
+			request: validRequest,
+			mockFn: func(f *scheduleTestFixture) {
+				f.domainCache.EXPECT().GetDomainID(testDomain).Return(testDomainID, nil).AnyTimes()
+				f.mockResource.SemaphoreMgr.EXPECT().CreateSemaphore(gomock.Any(), &persistence.CreateSemaphoreRequest{
+					DomainID:      testDomainID,
+					SemaphoreName: "downstream",
+					Size:          10,
+				}).Return(&persistence.CreateSemaphoreResponse{Semaphore: metadata}, nil)
+				f.historyClient.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, request *types.HistoryStartWorkflowExecutionRequest, _ ...yarpc.CallOption) (*types.StartWorkflowExecutionResponse, error) {
+						assert.Equal(t, testDomainID, request.DomainUUID)
+						assert.Equal(t, "cadence-semaphore:downstream", request.StartRequest.WorkflowID)
+						assert.Equal(t, semaphore.WorkflowTypeName, request.StartRequest.WorkflowType.Name)
+						return &types.StartWorkflowExecutionResponse{}, nil
+					})
+			},
+			want: &types.CreateSemaphoreResponse{Semaphore: info},
+		},
+		"workflow start failure is not fatal": {
+			request: validRequest,
+			mockFn: func(f *scheduleTestFixture) {
+				f.domainCache.EXPECT().GetDomainID(testDomain).Return(testDomainID, nil).AnyTimes()
+				f.mockResource.SemaphoreMgr.EXPECT().CreateSemaphore(gomock.Any(), gomock.Any()).
+					Return(&persistence.CreateSemaphoreResponse{Semaphore: metadata}, nil)
+				f.historyClient.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).
+					Return(nil, &types.ServiceBusyError{Message: "busy"})
+			},
+			want: &types.CreateSemaphoreResponse{Semaphore: info},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			f := newScheduleTestFixture(t)
+			defer f.finish()
+			tt.mockFn(f)
+
+			resp, err := f.handler.CreateSemaphore(context.Background(), tt.request)
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.want, resp)
+		})
+	}
+}
+
+func TestDescribeSemaphore(t *testing.T) {
+	createdTime := time.Unix(1700000000, 0).UTC()
+	acquiredTime := createdTime.Add(time.Minute)
+	metadata := &persistence.SemaphoreMetadata{
+		DomainID:      testDomainID,
+		SemaphoreName: "downstream",
+		Size:          3,
+		BucketSize:    persistence.DefaultSemaphoreBucketSize,
+		CreatedTime:   createdTime,
+	}
+	info := &types.SemaphoreInfo{
+		Name:        "downstream",
+		Size:        3,
+		BucketSize:  persistence.DefaultSemaphoreBucketSize,
+		CreatedTime: createdTime,
+	}
+	validRequest := &types.DescribeSemaphoreRequest{Domain: testDomain, SemaphoreName: "downstream"}
+	description, err := json.Marshal(semaphore.Description{
+		Size:             3,
+		AvailablePermits: 1,
+		Holders: []semaphore.PermitRequest{
+			{RequestID: "r1", WorkflowID: "holder", RunID: "run1", Permits: 2, RequestedTime: createdTime, AcquiredTime: acquiredTime},
+		},
+		Waiters: []semaphore.PermitRequest{
+			{RequestID: "r2", WorkflowID: "waiter", RunID: "run2", Permits: 2, RequestedTime: acquiredTime},
+		},
+	})
+	require.NoError(t, err)
+
+	tests := map[string]struct {
+		request *types.DescribeSemaphoreRequest
+		mockFn  func(*scheduleTestFixture)
+		want    *types.DescribeSemaphoreResponse
+		wantErr error
+	}{
+		"empty domain": {
+			request: &types.DescribeSemaphoreRequest{SemaphoreName: "downstream"},
+			mockFn:  func(f *scheduleTestFixture) {},
+			wantErr: validate.ErrDomainNotSet,
+		},
+		"semaphore name not set": {
+			request: &types.DescribeSemaphoreRequest{Domain: testDomain},
+			mockFn:  func(f *scheduleTestFixture) {},
+			wantErr: validate.ErrSemaphoreNameNotSet,
+		},
+		"semaphore not found": {
+			request: validRequest,
+			mockFn: func(f *scheduleTestFixture) {
+				f.domainCache.EXPECT().GetDomainID(testDomain).Return(testDomainID, nil).AnyTimes()
+				f.mockResource.SemaphoreMgr.EXPECT().GetSemaphore(gomock.Any(), gomock.Any()).
+					Return(nil, &types.EntityNotExistsError{Message: "not found"})
+			},
+			wantErr: &types.EntityNotExistsError{Message: "not found"},
+		},
+		"workflow not started": {
+			request: validRequest,
+			mockFn: func(f *scheduleTestFixture) {
+				f.domainCache.EXPECT().GetDomainID(testDomain).Return(testDomainID, nil).AnyTimes()
+				f.mockResource.SemaphoreMgr.EXPECT().GetSemaphore(gomock.Any(), gomock.Any()).
+					Return(&persistence.GetSemaphoreResponse{Semaphore: metadata}, nil)
+				f.historyClient.EXPECT().QueryWorkflow(gomock.Any(), gomock.Any()).
+					Return(nil, &types.EntityNotExistsError{})
+			},
+			want: &types.DescribeSemaphoreResponse{Semaphore: info, AvailablePermits: 3},
+		},
+		"normal request": {
+			request: validRequest,
+			mockFn: func(f *scheduleTestFixture) {
+				f.domainCache.EXPECT().GetDomainID(testDomain).Return(testDomainID, nil).AnyTimes()
+				f.mockResource.SemaphoreMgr.EXPECT().GetSemaphore(gomock.Any(), &persistence.GetSemaphoreRequest{
+					DomainID:      testDomainID,
+					SemaphoreName: "downstream",
+				}).Return(&persistence.GetSemaphoreResponse{Semaphore: metadata}, nil)
+				f.historyClient.EXPECT().QueryWorkflow(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, request *types.HistoryQueryWorkflowRequest, _ ...yarpc.CallOption) (*types.HistoryQueryWorkflowResponse, error) {
+						assert.Equal(t, "cadence-semaphore:downstream", request.Request.Execution.WorkflowID)
+						assert.Equal(t, semaphore.QueryTypeDescribe, request.Request.Query.QueryType)
+						return &types.HistoryQueryWorkflowResponse{
+							Response: &types.QueryWorkflowResponse{QueryResult: description},
+						}, nil
+					})
+			},
+			want: &types.DescribeSemaphoreResponse{
+				Semaphore:        info,
+				AvailablePermits: 1,
+				Holders: []*types.SemaphorePermitRequest{{
+					RequestID:     "r1",
+					Execution:     &types.WorkflowExecution{WorkflowID: "holder", RunID: "run1"},
+					Permits:       2,
+					RequestedTime: createdTime,
+					AcquiredTime:  acquiredTime,
+				}},
+				Waiters: []*types.SemaphorePermitRequest{{
+					RequestID:     "r2",
+					Execution:     &types.WorkflowExecution{WorkflowID: "waiter", RunID: "run2"},
+					Permits:       2,
+					RequestedTime: acquiredTime,
+				}},
+			},
+		},
+		"query failed": {
+			request: validRequest,
+			mockFn: func(f *scheduleTestFixture) {
+				f.domainCache.EXPECT().GetDomainID(testDomain).Return(testDomainID, nil).AnyTimes()
+				f.mockResource.SemaphoreMgr.EXPECT().GetSemaphore(gomock.Any(), gomock.Any()).
+					Return(&persistence.GetSemaphoreResponse{Semaphore: metadata}, nil)
+				f.historyClient.EXPECT().QueryWorkflow(gomock.Any(), gomock.Any()).
+					Return(nil, &types.InternalServiceError{Message: "error"})
+			},
+			wantErr: &types.InternalServiceError{Message: "error"},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			f := newScheduleTestFixture(t)
+			defer f.finish()
+			tt.mockFn(f)
+
+			resp, err := f.handler.DescribeSemaphore(context.Background(), tt.request)
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.want, resp)
+		})
+	}
+}
+
+func TestListSemaphores(t *testing.T) {
+	createdTime := time.Unix(1700000000, 0).UTC()
+
+	tests := map[string]struct {
+		request *types.ListSemaphoresRequest
+		mockFn  func(*scheduleTestFixture)
+		want    *types.ListSemaphoresResponse
+		wantErr error
+	}{
+		"empty domain": {
+			request: &types.ListSemaphoresRequest{},
+			mockFn:  func(f *scheduleTestFixture) {},
+			wantErr: validate.ErrDomainNotSet,
+		},
+		"default page size": {
+			request: &types.ListSemaphoresRequest{Domain: testDomain, NextPageToken: []byte("token")},
+			mockFn: func(f *scheduleTestFixture) {
+				f.domainCache.EXPECT().GetDomainID(testDomain).Return(testDomainID, nil).AnyTimes()
+				f.mockResource.SemaphoreMgr.EXPECT().ListSemaphores(gomock.Any(), &persistence.ListSemaphoresRequest{
+					DomainID:      testDomainID,
+					PageSize:      defaultListSemaphoresPageSize,
+					NextPageToken: []byte("token"),
+				}).Return(&persistence.ListSemaphoresResponse{
+					Semaphores: []*persistence.SemaphoreMetadata{
+						{DomainID: testDomainID, SemaphoreName: "a", Size: 1, BucketSize: 100, CreatedTime: createdTime},
+						{DomainID: testDomainID, SemaphoreName: "b", Size: 5, BucketSize: 2, CreatedTime: createdTime},
+					},
+					NextPageToken: []byte("next"),
+				}, nil)
+			},
+			want: &types.ListSemaphoresResponse{
+				Semaphores: []*types.SemaphoreInfo{
+					{Name: "a", Size: 1, BucketSize: 100, CreatedTime: createdTime},
+					{Name: "b", Size: 5, BucketSize: 2, CreatedTime: createdTime},
+				},
+				NextPageToken: []byte("next"),
+			},
+		},
+		"list failed": {
+			request: &types.ListSemaphoresRequest{Domain: testDomain, PageSize: 10},
+			mockFn: func(f *scheduleTestFixture) {
+				f.domainCache.EXPECT().GetDomainID(testDomain).Return(testDomainID, nil).AnyTimes()
+				f.mockResource.SemaphoreMgr.EXPECT().ListSemaphores(gomock.Any(), gomock.Any()).
+					Return(nil, &types.InternalServiceError{Message: "error"})
+			},
+			wantErr: &types.InternalServiceError{Message: "error"},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			f := newScheduleTestFixture(t)
+			defer f.finish()
+			tt.mockFn(f)
+
+			resp, err := f.handler.ListSemaphores(context.Background(), tt.request)
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.want, resp)
+		})
+	}
+}
@@ -25,6 +25,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strings"
 	"testing"
 	"time"
 
@@ -58,9 +59,11 @@ import (
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/resource"
 	"github.com/uber/cadence/common/service"
+	"github.com/uber/cadence/common/taskpriority"
 	"github.com/uber/cadence/common/types"
 	frontendcfg "github.com/uber/cadence/service/frontend/config"
 	"github.com/uber/cadence/service/frontend/validate"
+	"github.com/uber/cadence/service/history/query"
 )
 
 const (
@@ -513,6 +516,54 @@ func (s *workflowHandlerSuite) TestStartWorkflowExecution_Failed_BadDelayStartSe
 	s.Equal(validate.ErrInvalidDelayStartSeconds, err)
 }
 
+func (s *workflowHandlerSuite) TestStartWorkflowExecution_Failed_BadPriority() {
+	config := s.newConfig(dc.NewInMemoryClient())
+	config.UserRPS = dynamicproperties.GetIntPropertyFn(10)
+	wh := s.getWorkflowHandler(config)
+
+	startWorkflowExecutionRequest := &types.StartWorkflowExecutionRequest{
+		Domain:     s.testDomain,
+		WorkflowID: "workflow-id",
+		WorkflowType: &types.WorkflowType{
+			Name: "workflow-type",
+		},
+		TaskList: &types.TaskList{
+			Name: "task-list",
+		},
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(1),
+		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(1),
+		RequestID:                           uuid.New(),
+		Priority:                            6,
+	}
+	_, err := wh.StartWorkflowExecution(context.Background(), startWorkflowExecutionRequest)
+	s.Error(err)
+	s.Equal(validate.ErrInvalidTaskPriority, err)
+}
+
+func (s *workflowHandlerSuite) TestStartWorkflowExecution_Failed_FairnessKeyTooLong() {
+	config := s.newConfig(dc.NewInMemoryClient())
+	config.UserRPS = dynamicproperties.GetIntPropertyFn(10)
+	wh := s.getWorkflowHandler(config)
+
+	startWorkflowExecutionRequest := &types.StartWorkflowExecutionRequest{
+		Domain:     s.testDomain,
+		WorkflowID: "workflow-id",
+		WorkflowType: &types.WorkflowType{
+			Name: "workflow-type",
+		},
+		TaskList: &types.TaskList{
+			Name: "task-list",
+		},
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(1),
+		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(1),
+		RequestID:                           uuid.New(),
+		FairnessKey:                         strings.Repeat("a", taskpriority.MaxFairnessKeyLength+1),
+	}
+	_, err := wh.StartWorkflowExecution(context.Background(), startWorkflowExecutionRequest)
+	s.Error(err)
+	s.Equal(validate.ErrFairnessKeyTooLong, err)
+}
+
 func (s *workflowHandlerSuite) TestStartWorkflowExecution_Failed_StartRequestNotSet() {
 	config := s.newConfig(dc.NewInMemoryClient())
 	config.UserRPS = dynamicproperties.GetIntPropertyFn(10)
@@ -2470,6 +2521,65 @@ func (s *workflowHandlerSuite) TestCountWorkflowExecutions() {
 	s.NotNil(err)
 }
 
+func (s *workflowHandlerSuite) TestCountWorkflowExecutions_SavedQuery() {
+	wh := s.getWorkflowHandler(s.newConfig(dc.NewInMemoryClient()))
+
+	s.mockDomainCache.EXPECT().GetDomain(s.testDomain).Return(cache.NewLocalDomainCacheEntryForTest(
+		&persistence.DomainInfo{Name: s.testDomain, Data: map[string]string{"SavedVisibilityQuery.failed": "CloseStatus = 1"}},
+		&persistence.DomainConfig{},
+		"",
+	), nil).Times(2)
+	s.mockDomainCache.EXPECT().GetDomainID(gomock.Any()).Return(s.testDomainID, nil).AnyTimes()
+	s.mockVisibilityMgr.On("CountWorkflowExecutions", mock.Anything, mock.MatchedBy(func(req *persistence.CountWorkflowExecutionsRequest) bool {
+		return req.Query == "CloseStatus = 1"
+	})).Return(&persistence.CountWorkflowExecutionsResponse{Count: 2}, nil).Once()
+
+	ctx := context.Background()
+	resp, err := wh.CountWorkflowExecutions(ctx, &types.CountWorkflowExecutionsRequest{
+		Domain: s.testDomain,
+		Query:  "@failed",
+	})
+	s.NoError(err)
+	s.Equal(int64(2), resp.GetCount())
+
+	_, err = wh.CountWorkflowExecutions(ctx, &types.CountWorkflowExecutionsRequest{
+		Domain: s.testDomain,
+		Query:  "@unknown",
+	})
+	s.IsType(&types.BadRequestError{}, err)
+}
+
+func (s *workflowHandlerSuite) TestAggregateWorkflowExecutions() {
+	wh := s.getWorkflowHandler(s.newConfig(dc.NewInMemoryClient()))
+
+	s.mockDomainCache.EXPECT().GetDomainID(gomock.Any()).Return(s.testDomainID, nil).AnyTimes()
+	s.mockVisibilityMgr.On("AggregateWorkflowExecutions", mock.Anything, mock.MatchedBy(func(req *persistence.AggregateWorkflowExecutionsRequest) bool {
+		return req.DomainUUID == s.testDomainID && req.GroupBy == "CloseStatus" && req.Query == "WorkflowType = 'wt'"
+	})).Return(&persistence.AggregateWorkflowExecutionsResponse{
+		Groups: []*types.WorkflowExecutionGroup{{Key: "1", Count: 5}, {Key: "3", Count: 2}},
+	}, nil).Once()
+
+	aggregateRequest := &types.AggregateWorkflowExecutionsRequest{
+		Domain:  s.testDomain,
+		Query:   "WorkflowType = 'wt'",
+		GroupBy: "CloseStatus",
+	}
+	ctx := context.Background()
+
+	resp, err := wh.AggregateWorkflowExecutions(ctx, aggregateRequest)
+	s.NoError(err)
+	s.Equal([]*types.WorkflowExecutionGroup{{Key: "FAILED", Count: 5}, {Key: "TERMINATED", Count: 2}}, resp.GetGroups())
+
+	aggregateRequest.Query = "InvalidKey = 'a'"
+	_, err = wh.AggregateWorkflowExecutions(ctx, aggregateRequest)
+	s.NotNil(err)
+
+	aggregateRequest.Query = ""
+	aggregateRequest.GroupBy = ""
+	_, err = wh.AggregateWorkflowExecutions(ctx, aggregateRequest)
+	s.NotNil(err)
+}
+
 func (s *workflowHandlerSuite) TestConvertIndexedKeyToThrift() {
 	wh := s.getWorkflowHandler(s.newConfig(dc.NewInMemoryClient()))
 	m := map[string]interface{}{
@@ -3536,6 +3646,19 @@ func (s *workflowHandlerSuite) TestSignalWorkflowExecution() {
 			expectError:     true,
 			expectErrorType: validate.ErrSignalNameTooLong,
 		},
+		"reserved signal name": {
+			request: &types.SignalWorkflowExecutionRequest{
+				Domain: s.testDomain,
+				WorkflowExecution: &types.WorkflowExecution{
+					WorkflowID: testWorkflowID,
+					RunID:      testRunID,
+				},
+				SignalName: query.UpdateSignalNamePrefix + "update",
+			},
+			mockFn:          func() {},
+			expectError:     true,
+			expectErrorType: validate.ErrSignalNameReserved,
+		},
 		"requestID length exceeds limit": {
 			request: validRequest,
 			mockFn: func() {
@@ -39,6 +39,7 @@ type (
 	// Handler is interface wrapping frontend handler
 	Handler interface {
 		Health(context.Context) (*types.HealthStatus, error)
+		AggregateWorkflowExecutions(context.Context, *types.AggregateWorkflowExecutionsRequest) (*types.AggregateWorkflowExecutionsResponse, error)
 		CountWorkflowExecutions(context.Context, *types.CountWorkflowExecutionsRequest) (*types.CountWorkflowExecutionsResponse, error)
 		DeleteDomain(context.Context, *types.DeleteDomainRequest) error
 		DeprecateDomain(context.Context, *types.DeprecateDomainRequest) error
@@ -84,6 +85,14 @@ type (
 		StartWorkflowExecutionAsync(context.Context, *types.StartWorkflowExecutionAsyncRequest) (*types.StartWorkflowExecutionAsyncResponse, error)
 		TerminateWorkflowExecution(context.Context, *types.TerminateWorkflowExecutionRequest) error
 		UpdateDomain(context.Context, *types.UpdateDomainRequest) (*types.UpdateDomainResponse, error)
+		UpdateWorkflowExecution(context.Context, *types.UpdateWorkflowExecutionRequest) (*types.UpdateWorkflowExecutionResponse, error)
+		PauseWorkflowExecution(context.Context, *types.PauseWorkflowExecutionRequest) error
+		UnpauseWorkflowExecution(context.Context, *types.UnpauseWorkflowExecutionRequest) error
+		PauseActivity(context.Context, *types.PauseActivityRequest) error
+		UnpauseActivity(context.Context, *types.UnpauseActivityRequest) error
+		ResetActivityAttempt(context.Context, *types.ResetActivityAttemptRequest) error
+		RetryActivityNow(context.Context, *types.RetryActivityNowRequest) error
+		DeleteWorkflowExecution(context.Context, *types.DeleteWorkflowExecutionRequest) error
 		FailoverDomain(context.Context, *types.FailoverDomainRequest) (*types.FailoverDomainResponse, error)
 		ListFailoverHistory(context.Context, *types.ListFailoverHistoryRequest) (*types.ListFailoverHistoryResponse, error)
 
@@ -94,6 +103,11 @@ type (
 		PauseSchedule(context.Context, *types.PauseScheduleRequest) (*types.PauseScheduleResponse, error)
 		UnpauseSchedule(context.Context, *types.UnpauseScheduleRequest) (*types.UnpauseScheduleResponse, error)
 		BackfillSchedule(context.Context, *types.BackfillScheduleRequest) (*types.BackfillScheduleResponse, error)
+		TriggerSchedule(context.Context, *types.TriggerScheduleRequest) (*types.TriggerScheduleResponse, error)
 		ListSchedules(context.Context, *types.ListSchedulesRequest) (*types.ListSchedulesResponse, error)
+
+		CreateSemaphore(context.Context, *types.CreateSemaphoreRequest) (*types.CreateSemaphoreResponse, error)
+		DescribeSemaphore(context.Context, *types.DescribeSemaphoreRequest) (*types.DescribeSemaphoreResponse, error)
+		ListSemaphores(context.Context, *types.ListSemaphoresRequest) (*types.ListSemaphoresResponse, error)
 	}
 )
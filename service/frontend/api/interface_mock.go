@@ -37,11 +37,40 @@ func NewMockHandler(ctrl *gomock.Controller) *MockHandler {
 	return mock
 }
 
+// DeleteWorkflowExecution mocks base method.
+func (m *MockHandler) DeleteWorkflowExecution(arg0 context.Context, arg1 *types.DeleteWorkflowExecutionRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWorkflowExecution", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWorkflowExecution indicates an expected call of DeleteWorkflowExecution.
+func (mr *MockHandlerMockRecorder) DeleteWorkflowExecution(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWorkflowExecution", reflect.TypeOf((*MockHandler)(nil).DeleteWorkflowExecution), arg0, arg1)
+}
+
 // EXPECT returns an object that allows the caller to indicate expected use.
 func (m *MockHandler) EXPECT() *MockHandlerMockRecorder {
 	return m.recorder
 }
 
+// AggregateWorkflowExecutions mocks base method.
+func (m *MockHandler) AggregateWorkflowExecutions(arg0 context.Context, arg1 *types.AggregateWorkflowExecutionsRequest) (*types.AggregateWorkflowExecutionsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AggregateWorkflowExecutions", arg0, arg1)
+	ret0, _ := ret[0].(*types.AggregateWorkflowExecutionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AggregateWorkflowExecutions indicates an expected call of AggregateWorkflowExecutions.
+func (mr *MockHandlerMockRecorder) AggregateWorkflowExecutions(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AggregateWorkflowExecutions", reflect.TypeOf((*MockHandler)(nil).AggregateWorkflowExecutions), arg0, arg1)
+}
+
 // BackfillSchedule mocks base method.
 func (m *MockHandler) BackfillSchedule(arg0 context.Context, arg1 *types.BackfillScheduleRequest) (*types.BackfillScheduleResponse, error) {
 	m.ctrl.T.Helper()
@@ -87,6 +116,21 @@ func (mr *MockHandlerMockRecorder) CreateSchedule(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSchedule", reflect.TypeOf((*MockHandler)(nil).CreateSchedule), arg0, arg1)
 }
 
+// CreateSemaphore mocks base method.
+func (m *MockHandler) CreateSemaphore(arg0 context.Context, arg1 *types.CreateSemaphoreRequest) (*types.CreateSemaphoreResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSemaphore", arg0, arg1)
+	ret0, _ := ret[0].(*types.CreateSemaphoreResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSemaphore indicates an expected call of CreateSemaphore.
+func (mr *MockHandlerMockRecorder) CreateSemaphore(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSemaphore", reflect.TypeOf((*MockHandler)(nil).CreateSemaphore), arg0, arg1)
+}
+
 // DeleteDomain mocks base method.
 func (m *MockHandler) DeleteDomain(arg0 context.Context, arg1 *types.DeleteDomainRequest) error {
 	m.ctrl.T.Helper()
@@ -160,6 +204,21 @@ func (mr *MockHandlerMockRecorder) DescribeSchedule(arg0, arg1 any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeSchedule", reflect.TypeOf((*MockHandler)(nil).DescribeSchedule), arg0, arg1)
 }
 
+// DescribeSemaphore mocks base method.
+func (m *MockHandler) DescribeSemaphore(arg0 context.Context, arg1 *types.DescribeSemaphoreRequest) (*types.DescribeSemaphoreResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeSemaphore", arg0, arg1)
+	ret0, _ := ret[0].(*types.DescribeSemaphoreResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeSemaphore indicates an expected call of DescribeSemaphore.
+func (mr *MockHandlerMockRecorder) DescribeSemaphore(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeSemaphore", reflect.TypeOf((*MockHandler)(nil).DescribeSemaphore), arg0, arg1)
+}
+
 // DescribeTaskList mocks base method.
 func (m *MockHandler) DescribeTaskList(arg0 context.Context, arg1 *types.DescribeTaskListRequest) (*types.DescribeTaskListResponse, error) {
 	m.ctrl.T.Helper()
@@ -385,6 +444,21 @@ func (mr *MockHandlerMockRecorder) ListSchedules(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSchedules", reflect.TypeOf((*MockHandler)(nil).ListSchedules), arg0, arg1)
 }
 
+// ListSemaphores mocks base method.
+func (m *MockHandler) ListSemaphores(arg0 context.Context, arg1 *types.ListSemaphoresRequest) (*types.ListSemaphoresResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSemaphores", arg0, arg1)
+	ret0, _ := ret[0].(*types.ListSemaphoresResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSemaphores indicates an expected call of ListSemaphores.
+func (mr *MockHandlerMockRecorder) ListSemaphores(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSemaphores", reflect.TypeOf((*MockHandler)(nil).ListSemaphores), arg0, arg1)
+}
+
 // ListTaskListPartitions mocks base method.
 func (m *MockHandler) ListTaskListPartitions(arg0 context.Context, arg1 *types.ListTaskListPartitionsRequest) (*types.ListTaskListPartitionsResponse, error) {
 	m.ctrl.T.Helper()
@@ -415,6 +489,20 @@ func (mr *MockHandlerMockRecorder) ListWorkflowExecutions(arg0, arg1 any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWorkflowExecutions", reflect.TypeOf((*MockHandler)(nil).ListWorkflowExecutions), arg0, arg1)
 }
 
+// PauseActivity mocks base method.
+func (m *MockHandler) PauseActivity(arg0 context.Context, arg1 *types.PauseActivityRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PauseActivity", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PauseActivity indicates an expected call of PauseActivity.
+func (mr *MockHandlerMockRecorder) PauseActivity(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseActivity", reflect.TypeOf((*MockHandler)(nil).PauseActivity), arg0, arg1)
+}
+
 // PauseSchedule mocks base method.
 func (m *MockHandler) PauseSchedule(arg0 context.Context, arg1 *types.PauseScheduleRequest) (*types.PauseScheduleResponse, error) {
 	m.ctrl.T.Helper()
@@ -430,6 +518,20 @@ func (mr *MockHandlerMockRecorder) PauseSchedule(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseSchedule", reflect.TypeOf((*MockHandler)(nil).PauseSchedule), arg0, arg1)
 }
 
+// PauseWorkflowExecution mocks base method.
+func (m *MockHandler) PauseWorkflowExecution(arg0 context.Context, arg1 *types.PauseWorkflowExecutionRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PauseWorkflowExecution", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PauseWorkflowExecution indicates an expected call of PauseWorkflowExecution.
+func (mr *MockHandlerMockRecorder) PauseWorkflowExecution(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseWorkflowExecution", reflect.TypeOf((*MockHandler)(nil).PauseWorkflowExecution), arg0, arg1)
+}
+
 // PollForActivityTask mocks base method.
 func (m *MockHandler) PollForActivityTask(arg0 context.Context, arg1 *types.PollForActivityTaskRequest) (*types.PollForActivityTaskResponse, error) {
 	m.ctrl.T.Helper()
@@ -547,6 +649,20 @@ func (mr *MockHandlerMockRecorder) RequestCancelWorkflowExecution(arg0, arg1 any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestCancelWorkflowExecution", reflect.TypeOf((*MockHandler)(nil).RequestCancelWorkflowExecution), arg0, arg1)
 }
 
+// ResetActivityAttempt mocks base method.
+func (m *MockHandler) ResetActivityAttempt(arg0 context.Context, arg1 *types.ResetActivityAttemptRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetActivityAttempt", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResetActivityAttempt indicates an expected call of ResetActivityAttempt.
+func (mr *MockHandlerMockRecorder) ResetActivityAttempt(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetActivityAttempt", reflect.TypeOf((*MockHandler)(nil).ResetActivityAttempt), arg0, arg1)
+}
+
 // ResetStickyTaskList mocks base method.
 func (m *MockHandler) ResetStickyTaskList(arg0 context.Context, arg1 *types.ResetStickyTaskListRequest) (*types.ResetStickyTaskListResponse, error) {
 	m.ctrl.T.Helper()
@@ -719,6 +835,20 @@ func (mr *MockHandlerMockRecorder) RestartWorkflowExecution(arg0, arg1 any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestartWorkflowExecution", reflect.TypeOf((*MockHandler)(nil).RestartWorkflowExecution), arg0, arg1)
 }
 
+// RetryActivityNow mocks base method.
+func (m *MockHandler) RetryActivityNow(arg0 context.Context, arg1 *types.RetryActivityNowRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetryActivityNow", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RetryActivityNow indicates an expected call of RetryActivityNow.
+func (mr *MockHandlerMockRecorder) RetryActivityNow(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetryActivityNow", reflect.TypeOf((*MockHandler)(nil).RetryActivityNow), arg0, arg1)
+}
+
 // ScanWorkflowExecutions mocks base method.
 func (m *MockHandler) ScanWorkflowExecutions(arg0 context.Context, arg1 *types.ListWorkflowExecutionsRequest) (*types.ListWorkflowExecutionsResponse, error) {
 	m.ctrl.T.Helper()
@@ -822,6 +952,35 @@ func (mr *MockHandlerMockRecorder) TerminateWorkflowExecution(arg0, arg1 any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TerminateWorkflowExecution", reflect.TypeOf((*MockHandler)(nil).TerminateWorkflowExecution), arg0, arg1)
 }
 
+// TriggerSchedule mocks base method.
+func (m *MockHandler) TriggerSchedule(arg0 context.Context, arg1 *types.TriggerScheduleRequest) (*types.TriggerScheduleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TriggerSchedule", arg0, arg1)
+	ret0, _ := ret[0].(*types.TriggerScheduleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TriggerSchedule indicates an expected call of TriggerSchedule.
+func (mr *MockHandlerMockRecorder) TriggerSchedule(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TriggerSchedule", reflect.TypeOf((*MockHandler)(nil).TriggerSchedule), arg0, arg1)
+}
+
+// UnpauseActivity mocks base method.
+func (m *MockHandler) UnpauseActivity(arg0 context.Context, arg1 *types.UnpauseActivityRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnpauseActivity", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnpauseActivity indicates an expected call of UnpauseActivity.
+func (mr *MockHandlerMockRecorder) UnpauseActivity(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnpauseActivity", reflect.TypeOf((*MockHandler)(nil).UnpauseActivity), arg0, arg1)
+}
+
 // UnpauseSchedule mocks base method.
 func (m *MockHandler) UnpauseSchedule(arg0 context.Context, arg1 *types.UnpauseScheduleRequest) (*types.UnpauseScheduleResponse, error) {
 	m.ctrl.T.Helper()
@@ -837,6 +996,20 @@ func (mr *MockHandlerMockRecorder) UnpauseSchedule(arg0, arg1 any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnpauseSchedule", reflect.TypeOf((*MockHandler)(nil).UnpauseSchedule), arg0, arg1)
 }
 
+// UnpauseWorkflowExecution mocks base method.
+func (m *MockHandler) UnpauseWorkflowExecution(arg0 context.Context, arg1 *types.UnpauseWorkflowExecutionRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnpauseWorkflowExecution", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnpauseWorkflowExecution indicates an expected call of UnpauseWorkflowExecution.
+func (mr *MockHandlerMockRecorder) UnpauseWorkflowExecution(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnpauseWorkflowExecution", reflect.TypeOf((*MockHandler)(nil).UnpauseWorkflowExecution), arg0, arg1)
+}
+
 // UpdateDomain mocks base method.
 func (m *MockHandler) UpdateDomain(arg0 context.Context, arg1 *types.UpdateDomainRequest) (*types.UpdateDomainResponse, error) {
 	m.ctrl.T.Helper()
@@ -866,3 +1039,18 @@ func (mr *MockHandlerMockRecorder) UpdateSchedule(arg0, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSchedule", reflect.TypeOf((*MockHandler)(nil).UpdateSchedule), arg0, arg1)
 }
+
+// UpdateWorkflowExecution mocks base method.
+func (m *MockHandler) UpdateWorkflowExecution(arg0 context.Context, arg1 *types.UpdateWorkflowExecutionRequest) (*types.UpdateWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWorkflowExecution", arg0, arg1)
+	ret0, _ := ret[0].(*types.UpdateWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateWorkflowExecution indicates an expected call of UpdateWorkflowExecution.
+func (mr *MockHandlerMockRecorder) UpdateWorkflowExecution(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkflowExecution", reflect.TypeOf((*MockHandler)(nil).UpdateWorkflowExecution), arg0, arg1)
+}
@@ -24,13 +24,17 @@ package api
 
 import (
 	"context"
+	"fmt"
+	"strconv"
 
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/archiver"
+	"github.com/uber/cadence/common/definition"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/service"
 	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/common/visibility"
 	"github.com/uber/cadence/service/frontend/validate"
 )
 
@@ -45,12 +49,16 @@ func (wh *WorkflowHandler) CountWorkflowExecutions(
 	if err := wh.requestValidator.ValidateCountWorkflowExecutionsRequest(ctx, countRequest); err != nil {
 		return nil, err
 	}
-	validatedQuery, err := wh.visibilityQueryValidator.ValidateQuery(countRequest.GetQuery())
+	domain := countRequest.GetDomain()
+	query, err := wh.resolveSavedQuery(domain, countRequest.GetQuery())
+	if err != nil {
+		return nil, err
+	}
+	validatedQuery, err := wh.visibilityQueryValidator.ValidateQuery(query)
 	if err != nil {
 		return nil, err
 	}
 
-	domain := countRequest.GetDomain()
 	domainID, err := wh.GetDomainCache().GetDomainID(domain)
 	if err != nil {
 		return nil, err
@@ -72,6 +80,86 @@ func (wh *WorkflowHandler) CountWorkflowExecutions(
 	return resp, nil
 }
 
+// AggregateWorkflowExecutions - count number of workflow executions in a domain grouped by a search attribute
+func (wh *WorkflowHandler) AggregateWorkflowExecutions(
+	ctx context.Context,
+	aggregateRequest *types.AggregateWorkflowExecutionsRequest,
+) (resp *types.AggregateWorkflowExecutionsResponse, retError error) {
+	if wh.isShuttingDown() {
+		return nil, validate.ErrShuttingDown
+	}
+	if err := wh.requestValidator.ValidateAggregateWorkflowExecutionsRequest(ctx, aggregateRequest); err != nil {
+		return nil, err
+	}
+	domain := aggregateRequest.GetDomain()
+	query, err := wh.resolveSavedQuery(domain, aggregateRequest.GetQuery())
+	if err != nil {
+		return nil, err
+	}
+	validatedQuery, err := wh.visibilityQueryValidator.ValidateQuery(query)
+	if err != nil {
+		return nil, err
+	}
+
+	domainID, err := wh.GetDomainCache().GetDomainID(domain)
+	if err != nil {
+		return nil, err
+	}
+
+	req := &persistence.AggregateWorkflowExecutionsRequest{
+		DomainUUID: domainID,
+		Domain:     domain,
+		Query:      validatedQuery,
+		GroupBy:    aggregateRequest.GetGroupBy(),
+		MaxGroups:  int(aggregateRequest.GetMaxGroups()),
+	}
+	persistenceResp, err := wh.GetVisibilityManager().AggregateWorkflowExecutions(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp = &types.AggregateWorkflowExecutionsResponse{
+		Groups: persistenceResp.Groups,
+	}
+	for _, group := range resp.Groups {
+		group.Key = readableGroupKey(aggregateRequest.GetGroupBy(), group.Key)
+	}
+	return resp, nil
+}
+
+// resolveSavedQuery returns the visibility query saved in the domain if the query references one
+func (wh *WorkflowHandler) resolveSavedQuery(domain string, query string) (string, error) {
+	name, ok := visibility.ParseSavedQueryReference(query)
+	if !ok {
+		return query, nil
+	}
+	domainEntry, err := wh.GetDomainCache().GetDomain(domain)
+	if err != nil {
+		return "", err
+	}
+	savedQuery, ok := visibility.GetSavedQuery(domainEntry.GetInfo().Data, name)
+	if !ok {
+		return "", &types.BadRequestError{Message: fmt.Sprintf("Saved query %s not found in domain %s.", name, domain)}
+	}
+	return savedQuery, nil
+}
+
+// readableGroupKey converts status values, which are stored as numbers, to their names
+func readableGroupKey(groupBy string, key string) string {
+	value, err := strconv.Atoi(key)
+	if err != nil {
+		return key
+	}
+	switch groupBy {
+	case definition.CloseStatus:
+		return types.WorkflowExecutionCloseStatus(value).String()
+	case definition.ExecutionStatus:
+		return types.WorkflowExecutionStatus(value).String()
+	default:
+		return key
+	}
+}
+
 // ScanWorkflowExecutions - retrieves info for large amount of workflow executions in a domain without order
 func (wh *WorkflowHandler) ScanWorkflowExecutions(
 	ctx context.Context,
@@ -83,12 +171,16 @@ func (wh *WorkflowHandler) ScanWorkflowExecutions(
 	if err := wh.requestValidator.ValidateListWorkflowExecutionsRequest(ctx, listRequest); err != nil {
 		return nil, err
 	}
-	validatedQuery, err := wh.visibilityQueryValidator.ValidateQuery(listRequest.GetQuery())
+	domain := listRequest.GetDomain()
+	query, err := wh.resolveSavedQuery(domain, listRequest.GetQuery())
+	if err != nil {
+		return nil, err
+	}
+	validatedQuery, err := wh.visibilityQueryValidator.ValidateQuery(query)
 	if err != nil {
 		return nil, err
 	}
 
-	domain := listRequest.GetDomain()
 	domainID, err := wh.GetDomainCache().GetDomainID(domain)
 	if err != nil {
 		return nil, err
@@ -337,12 +429,16 @@ func (wh *WorkflowHandler) ListWorkflowExecutions(
 	if err := wh.requestValidator.ValidateListWorkflowExecutionsRequest(ctx, listRequest); err != nil {
 		return nil, err
 	}
-	validatedQuery, err := wh.visibilityQueryValidator.ValidateQuery(listRequest.GetQuery())
+	domain := listRequest.GetDomain()
+	query, err := wh.resolveSavedQuery(domain, listRequest.GetQuery())
+	if err != nil {
+		return nil, err
+	}
+	validatedQuery, err := wh.visibilityQueryValidator.ValidateQuery(query)
 	if err != nil {
 		return nil, err
 	}
 
-	domain := listRequest.GetDomain()
 	domainID, err := wh.GetDomainCache().GetDomainID(domain)
 	if err != nil {
 		return nil, err
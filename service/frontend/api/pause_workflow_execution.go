@@ -0,0 +1,155 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package api
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/frontend/validate"
+)
+
+// PauseWorkflowExecution pauses a running workflow execution. While paused no decision, activity
+// or user timer task is dispatched, signals are still accepted and buffered in the history.
+func (wh *WorkflowHandler) PauseWorkflowExecution(
+	ctx context.Context,
+	pauseRequest *types.PauseWorkflowExecutionRequest,
+) error {
+	if wh.isShuttingDown() {
+		return validate.ErrShuttingDown
+	}
+	if pauseRequest == nil {
+		return validate.ErrRequestNotSet
+	}
+
+	scope := getMetricsScopeWithDomain(metrics.FrontendPauseWorkflowExecutionScope, pauseRequest, wh.GetMetricsClient()).Tagged(metrics.GetContextTags(ctx)...)
+	domainID, err := wh.validatePauseRequest(
+		pauseRequest.GetDomain(),
+		pauseRequest.GetWorkflowExecution(),
+		pauseRequest.GetRequestID(),
+		scope,
+	)
+	if err != nil {
+		return err
+	}
+
+	if pauseRequest.RequestID == "" {
+		// copy the request so that a retry of the caller does not reuse the generated ID
+		request := *pauseRequest
+		request.RequestID = uuid.New().String()
+		pauseRequest = &request
+	}
+
+	err = wh.GetHistoryClient().PauseWorkflowExecution(ctx, &types.HistoryPauseWorkflowExecutionRequest{
+		DomainUUID:   domainID,
+		PauseRequest: pauseRequest,
+	})
+	if err != nil {
+		return wh.normalizeVersionedErrors(ctx, err)
+	}
+	return nil
+}
+
+// UnpauseWorkflowExecution resumes a paused workflow execution, the tasks held back while the workflow
+// was paused are dispatched again.
+func (wh *WorkflowHandler) UnpauseWorkflowExecution(
+	ctx context.Context,
+	unpauseRequest *types.UnpauseWorkflowExecutionRequest,
+) error {
+	if wh.isShuttingDown() {
+		return validate.ErrShuttingDown
+	}
+	if unpauseRequest == nil {
+		return validate.ErrRequestNotSet
+	}
+
+	scope := getMetricsScopeWithDomain(metrics.FrontendUnpauseWorkflowExecutionScope, unpauseRequest, wh.GetMetricsClient()).Tagged(metrics.GetContextTags(ctx)...)
+	domainID, err := wh.validatePauseRequest(
+		unpauseRequest.GetDomain(),
+		unpauseRequest.GetWorkflowExecution(),
+		unpauseRequest.GetRequestID(),
+		scope,
+	)
+	if err != nil {
+		return err
+	}
+
+	if unpauseRequest.RequestID == "" {
+		request := *unpauseRequest
+		request.RequestID = uuid.New().String()
+		unpauseRequest = &request
+	}
+
+	err = wh.GetHistoryClient().UnpauseWorkflowExecution(ctx, &types.HistoryUnpauseWorkflowExecutionRequest{
+		DomainUUID:     domainID,
+		UnpauseRequest: unpauseRequest,
+	})
+	if err != nil {
+		return wh.normalizeVersionedErrors(ctx, err)
+	}
+	return nil
+}
+
+// validatePauseRequest validates the fields shared by pause and unpause requests and returns the domain ID
+func (wh *WorkflowHandler) validatePauseRequest(
+	domainName string,
+	wfExecution *types.WorkflowExecution,
+	requestID string,
+	scope metrics.Scope,
+) (string, error) {
+	if domainName == "" {
+		return "", validate.ErrDomainNotSet
+	}
+	if err := validate.CheckExecution(wfExecution); err != nil {
+		return "", err
+	}
+
+	idLengthWarnLimit := wh.config.MaxIDLengthWarnLimit()
+	if !common.IsValidIDLength(
+		domainName,
+		scope,
+		idLengthWarnLimit,
+		wh.config.DomainNameMaxLength(domainName),
+		metrics.CadenceErrDomainNameExceededWarnLimit,
+		domainName,
+		wh.GetLogger(),
+		tag.IDTypeDomainName) {
+		return "", validate.ErrDomainTooLong
+	}
+	if !common.IsValidIDLength(
+		requestID,
+		scope,
+		idLengthWarnLimit,
+		wh.config.RequestIDMaxLength(domainName),
+		metrics.CadenceErrRequestIDExceededWarnLimit,
+		domainName,
+		wh.GetLogger(),
+		tag.IDTypeRequestID) {
+		return "", validate.ErrRequestIDTooLong
+	}
+
+	return wh.GetDomainCache().GetDomainID(domainName)
+}
@@ -0,0 +1,225 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package api
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/yarpc"
+
+	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/frontend/validate"
+)
+
+func TestPauseWorkflowExecution(t *testing.T) {
+	validRequest := func() *types.PauseWorkflowExecutionRequest {
+		return &types.PauseWorkflowExecutionRequest{
+			Domain: "domain",
+			WorkflowExecution: &types.WorkflowExecution{
+				WorkflowID: "wf",
+				RunID:      "2f3e1a52-8d1b-4bd5-9a0c-6a1c5b0f0b8e",
+			},
+			Reason:    "reason",
+			Identity:  "identity",
+			RequestID: "request-id",
+		}
+	}
+
+	testCases := []struct {
+		name          string
+		req           *types.PauseWorkflowExecutionRequest
+		setupMocks    func(*WorkflowHandler, *mockDeps)
+		expectedError error
+	}{
+		{
+			name: "success",
+			req:  validRequest(),
+			setupMocks: func(wh *WorkflowHandler, deps *mockDeps) {
+				deps.mockDomainCache.EXPECT().GetDomainID("domain").Return("domain-id", nil)
+				deps.mockHistoryClient.EXPECT().PauseWorkflowExecution(gomock.Any(), &types.HistoryPauseWorkflowExecutionRequest{
+					DomainUUID:   "domain-id",
+					PauseRequest: validRequest(),
+				}).Return(nil)
+			},
+		},
+		{
+			name: "request ID is generated when not set",
+			req: func() *types.PauseWorkflowExecutionRequest {
+				req := validRequest()
+				req.RequestID = ""
+				return req
+			}(),
+			setupMocks: func(wh *WorkflowHandler, deps *mockDeps) {
+				deps.mockDomainCache.EXPECT().GetDomainID("domain").Return("domain-id", nil)
+				deps.mockHistoryClient.EXPECT().PauseWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, req *types.HistoryPauseWorkflowExecutionRequest, _ ...yarpc.CallOption) error {
+						assert.NotEmpty(t, req.GetPauseRequest().GetRequestID())
+						return nil
+					})
+			},
+		},
+		{
+			name:          "nil request",
+			req:           nil,
+			setupMocks:    func(wh *WorkflowHandler, deps *mockDeps) {},
+			expectedError: validate.ErrRequestNotSet,
+		},
+		{
+			name: "domain not set",
+			req: func() *types.PauseWorkflowExecutionRequest {
+				req := validRequest()
+				req.Domain = ""
+				return req
+			}(),
+			setupMocks:    func(wh *WorkflowHandler, deps *mockDeps) {},
+			expectedError: validate.ErrDomainNotSet,
+		},
+		{
+			name: "workflow ID not set",
+			req: func() *types.PauseWorkflowExecutionRequest {
+				req := validRequest()
+				req.WorkflowExecution.WorkflowID = ""
+				return req
+			}(),
+			setupMocks:    func(wh *WorkflowHandler, deps *mockDeps) {},
+			expectedError: validate.ErrWorkflowIDNotSet,
+		},
+		{
+			name: "request ID too long",
+			req:  validRequest(),
+			setupMocks: func(wh *WorkflowHandler, deps *mockDeps) {
+				wh.config.RequestIDMaxLength = dynamicproperties.GetIntPropertyFilteredByDomain(1)
+			},
+			expectedError: validate.ErrRequestIDTooLong,
+		},
+		{
+			name: "cache error",
+			req:  validRequest(),
+			setupMocks: func(wh *WorkflowHandler, deps *mockDeps) {
+				deps.mockDomainCache.EXPECT().GetDomainID("domain").Return("", errors.New("cache error"))
+			},
+			expectedError: errors.New("cache error"),
+		},
+		{
+			name: "history client error",
+			req:  validRequest(),
+			setupMocks: func(wh *WorkflowHandler, deps *mockDeps) {
+				deps.mockDomainCache.EXPECT().GetDomainID("domain").Return("domain-id", nil)
+				deps.mockHistoryClient.EXPECT().PauseWorkflowExecution(gomock.Any(), gomock.Any()).
+					Return(&types.EntityNotExistsError{Message: "not found"})
+			},
+			expectedError: &types.EntityNotExistsError{Message: "not found"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			wh, deps := setupMocksForWorkflowHandler(t)
+			tc.setupMocks(wh, deps)
+
+			err := wh.PauseWorkflowExecution(context.Background(), tc.req)
+			if tc.expectedError != nil {
+				assert.Equal(t, tc.expectedError, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestUnpauseWorkflowExecution(t *testing.T) {
+	validRequest := func() *types.UnpauseWorkflowExecutionRequest {
+		return &types.UnpauseWorkflowExecutionRequest{
+			Domain: "domain",
+			WorkflowExecution: &types.WorkflowExecution{
+				WorkflowID: "wf",
+				RunID:      "2f3e1a52-8d1b-4bd5-9a0c-6a1c5b0f0b8e",
+			},
+			Reason:    "reason",
+			Identity:  "identity",
+			RequestID: "request-id",
+		}
+	}
+
+	testCases := []struct {
+		name          string
+		req           *types.UnpauseWorkflowExecutionRequest
+		setupMocks    func(*WorkflowHandler, *mockDeps)
+		expectedError error
+	}{
+		{
+			name: "success",
+			req:  validRequest(),
+			setupMocks: func(wh *WorkflowHandler, deps *mockDeps) {
+				deps.mockDomainCache.EXPECT().GetDomainID("domain").Return("domain-id", nil)
+				deps.mockHistoryClient.EXPECT().UnpauseWorkflowExecution(gomock.Any(), &types.HistoryUnpauseWorkflowExecutionRequest{
+					DomainUUID:     "domain-id",
+					UnpauseRequest: validRequest(),
+				}).Return(nil)
+			},
+		},
+		{
+			name:          "nil request",
+			req:           nil,
+			setupMocks:    func(wh *WorkflowHandler, deps *mockDeps) {},
+			expectedError: validate.ErrRequestNotSet,
+		},
+		{
+			name: "workflow ID not set",
+			req: func() *types.UnpauseWorkflowExecutionRequest {
+				req := validRequest()
+				req.WorkflowExecution.WorkflowID = ""
+				return req
+			}(),
+			setupMocks:    func(wh *WorkflowHandler, deps *mockDeps) {},
+			expectedError: validate.ErrWorkflowIDNotSet,
+		},
+		{
+			name: "history client error",
+			req:  validRequest(),
+			setupMocks: func(wh *WorkflowHandler, deps *mockDeps) {
+				deps.mockDomainCache.EXPECT().GetDomainID("domain").Return("domain-id", nil)
+				deps.mockHistoryClient.EXPECT().UnpauseWorkflowExecution(gomock.Any(), gomock.Any()).
+					Return(&types.EntityNotExistsError{Message: "not found"})
+			},
+			expectedError: &types.EntityNotExistsError{Message: "not found"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			wh, deps := setupMocksForWorkflowHandler(t)
+			tc.setupMocks(wh, deps)
+
+			err := wh.UnpauseWorkflowExecution(context.Background(), tc.req)
+			if tc.expectedError != nil {
+				assert.Equal(t, tc.expectedError, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,197 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package api
+
+import (
+	"context"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/frontend/validate"
+)
+
+// PauseActivity stops dispatching the attempts of a pending activity until it is unpaused. An attempt
+// which is already running is not interrupted.
+func (wh *WorkflowHandler) PauseActivity(
+	ctx context.Context,
+	pauseRequest *types.PauseActivityRequest,
+) error {
+	if wh.isShuttingDown() {
+		return validate.ErrShuttingDown
+	}
+	if pauseRequest == nil {
+		return validate.ErrRequestNotSet
+	}
+
+	scope := getMetricsScopeWithDomain(metrics.FrontendPauseActivityScope, pauseRequest, wh.GetMetricsClient()).Tagged(metrics.GetContextTags(ctx)...)
+	domainID, err := wh.validateActivityOperationRequest(
+		pauseRequest.GetDomain(),
+		pauseRequest.GetWorkflowExecution(),
+		pauseRequest.GetActivityID(),
+		scope,
+	)
+	if err != nil {
+		return err
+	}
+
+	err = wh.GetHistoryClient().PauseActivity(ctx, &types.HistoryPauseActivityRequest{
+		DomainUUID:   domainID,
+		PauseRequest: pauseRequest,
+	})
+	if err != nil {
+		return wh.normalizeVersionedErrors(ctx, err)
+	}
+	return nil
+}
+
+// UnpauseActivity resumes dispatching the attempts of a paused activity
+func (wh *WorkflowHandler) UnpauseActivity(
+	ctx context.Context,
+	unpauseRequest *types.UnpauseActivityRequest,
+) error {
+	if wh.isShuttingDown() {
+		return validate.ErrShuttingDown
+	}
+	if unpauseRequest == nil {
+		return validate.ErrRequestNotSet
+	}
+
+	scope := getMetricsScopeWithDomain(metrics.FrontendUnpauseActivityScope, unpauseRequest, wh.GetMetricsClient()).Tagged(metrics.GetContextTags(ctx)...)
+	domainID, err := wh.validateActivityOperationRequest(
+		unpauseRequest.GetDomain(),
+		unpauseRequest.GetWorkflowExecution(),
+		unpauseRequest.GetActivityID(),
+		scope,
+	)
+	if err != nil {
+		return err
+	}
+
+	err = wh.GetHistoryClient().UnpauseActivity(ctx, &types.HistoryUnpauseActivityRequest{
+		DomainUUID:     domainID,
+		UnpauseRequest: unpauseRequest,
+	})
+	if err != nil {
+		return wh.normalizeVersionedErrors(ctx, err)
+	}
+	return nil
+}
+
+// ResetActivityAttempt sets the attempt count of a pending activity back to 0, the retry policy
+// of the activity is then evaluated as if it had never failed
+func (wh *WorkflowHandler) ResetActivityAttempt(
+	ctx context.Context,
+	resetRequest *types.ResetActivityAttemptRequest,
+) error {
+	if wh.isShuttingDown() {
+		return validate.ErrShuttingDown
+	}
+	if resetRequest == nil {
+		return validate.ErrRequestNotSet
+	}
+
+	scope := getMetricsScopeWithDomain(metrics.FrontendResetActivityAttemptScope, resetRequest, wh.GetMetricsClient()).Tagged(metrics.GetContextTags(ctx)...)
+	domainID, err := wh.validateActivityOperationRequest(
+		resetRequest.GetDomain(),
+		resetRequest.GetWorkflowExecution(),
+		resetRequest.GetActivityID(),
+		scope,
+	)
+	if err != nil {
+		return err
+	}
+
+	err = wh.GetHistoryClient().ResetActivityAttempt(ctx, &types.HistoryResetActivityAttemptRequest{
+		DomainUUID:   domainID,
+		ResetRequest: resetRequest,
+	})
+	if err != nil {
+		return wh.normalizeVersionedErrors(ctx, err)
+	}
+	return nil
+}
+
+// RetryActivityNow dispatches the next attempt of an activity waiting for its retry backoff immediately
+func (wh *WorkflowHandler) RetryActivityNow(
+	ctx context.Context,
+	retryRequest *types.RetryActivityNowRequest,
+) error {
+	if wh.isShuttingDown() {
+		return validate.ErrShuttingDown
+	}
+	if retryRequest == nil {
+		return validate.ErrRequestNotSet
+	}
+
+	scope := getMetricsScopeWithDomain(metrics.FrontendRetryActivityNowScope, retryRequest, wh.GetMetricsClient()).Tagged(metrics.GetContextTags(ctx)...)
+	domainID, err := wh.validateActivityOperationRequest(
+		retryRequest.GetDomain(),
+		retryRequest.GetWorkflowExecution(),
+		retryRequest.GetActivityID(),
+		scope,
+	)
+	if err != nil {
+		return err
+	}
+
+	err = wh.GetHistoryClient().RetryActivityNow(ctx, &types.HistoryRetryActivityNowRequest{
+		DomainUUID:   domainID,
+		RetryRequest: retryRequest,
+	})
+	if err != nil {
+		return wh.normalizeVersionedErrors(ctx, err)
+	}
+	return nil
+}
+
+// validateActivityOperationRequest validates the fields shared by the pending activity operations and returns the domain ID
+func (wh *WorkflowHandler) validateActivityOperationRequest(
+	domainName string,
+	wfExecution *types.WorkflowExecution,
+	activityID string,
+	scope metrics.Scope,
+) (string, error) {
+	if domainName == "" {
+		return "", validate.ErrDomainNotSet
+	}
+	if err := validate.CheckExecution(wfExecution); err != nil {
+		return "", err
+	}
+	if activityID == "" {
+		return "", validate.ErrActivityIDNotSet
+	}
+
+	if !common.IsValidIDLength(
+		domainName,
+		scope,
+		wh.config.MaxIDLengthWarnLimit(),
+		wh.config.DomainNameMaxLength(domainName),
+		metrics.CadenceErrDomainNameExceededWarnLimit,
+		domainName,
+		wh.GetLogger(),
+		tag.IDTypeDomainName) {
+		return "", validate.ErrDomainTooLong
+	}
+
+	return wh.GetDomainCache().GetDomainID(domainName)
+}
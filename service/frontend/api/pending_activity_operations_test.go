@@ -0,0 +1,186 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package api
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/frontend/validate"
+)
+
+func TestPauseActivity(t *testing.T) {
+	validRequest := func() *types.PauseActivityRequest {
+		return &types.PauseActivityRequest{
+			Domain: "domain",
+			WorkflowExecution: &types.WorkflowExecution{
+				WorkflowID: "wf",
+				RunID:      "2f3e1a52-8d1b-4bd5-9a0c-6a1c5b0f0b8e",
+			},
+			ActivityID: "activity",
+			Identity:   "identity",
+		}
+	}
+
+	testCases := []struct {
+		name          string
+		req           *types.PauseActivityRequest
+		setupMocks    func(*WorkflowHandler, *mockDeps)
+		expectedError error
+	}{
+		{
+			name: "success",
+			req:  validRequest(),
+			setupMocks: func(wh *WorkflowHandler, deps *mockDeps) {
+				deps.mockDomainCache.EXPECT().GetDomainID("domain").Return("domain-id", nil)
+				deps.mockHistoryClient.EXPECT().PauseActivity(gomock.Any(), &types.HistoryPauseActivityRequest{
+					DomainUUID:   "domain-id",
+					PauseRequest: validRequest(),
+				}).Return(nil)
+			},
+		},
+		{
+			name:          "nil request",
+			req:           nil,
+			setupMocks:    func(wh *WorkflowHandler, deps *mockDeps) {},
+			expectedError: validate.ErrRequestNotSet,
+		},
+		{
+			name: "domain not set",
+			req: func() *types.PauseActivityRequest {
+				req := validRequest()
+				req.Domain = ""
+				return req
+			}(),
+			setupMocks:    func(wh *WorkflowHandler, deps *mockDeps) {},
+			expectedError: validate.ErrDomainNotSet,
+		},
+		{
+			name: "workflow ID not set",
+			req: func() *types.PauseActivityRequest {
+				req := validRequest()
+				req.WorkflowExecution.WorkflowID = ""
+				return req
+			}(),
+			setupMocks:    func(wh *WorkflowHandler, deps *mockDeps) {},
+			expectedError: validate.ErrWorkflowIDNotSet,
+		},
+		{
+			name: "activity ID not set",
+			req: func() *types.PauseActivityRequest {
+				req := validRequest()
+				req.ActivityID = ""
+				return req
+			}(),
+			setupMocks:    func(wh *WorkflowHandler, deps *mockDeps) {},
+			expectedError: validate.ErrActivityIDNotSet,
+		},
+		{
+			name: "cache error",
+			req:  validRequest(),
+			setupMocks: func(wh *WorkflowHandler, deps *mockDeps) {
+				deps.mockDomainCache.EXPECT().GetDomainID("domain").Return("", errors.New("cache error"))
+			},
+			expectedError: errors.New("cache error"),
+		},
+		{
+			name: "history client error",
+			req:  validRequest(),
+			setupMocks: func(wh *WorkflowHandler, deps *mockDeps) {
+				deps.mockDomainCache.EXPECT().GetDomainID("domain").Return("domain-id", nil)
+				deps.mockHistoryClient.EXPECT().PauseActivity(gomock.Any(), gomock.Any()).
+					Return(&types.EntityNotExistsError{Message: "activity task not found"})
+			},
+			expectedError: &types.EntityNotExistsError{Message: "activity task not found"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			wh, deps := setupMocksForWorkflowHandler(t)
+			tc.setupMocks(wh, deps)
+
+			err := wh.PauseActivity(context.Background(), tc.req)
+			if tc.expectedError != nil {
+				assert.Equal(t, tc.expectedError, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestUnpauseActivity(t *testing.T) {
+	request := &types.UnpauseActivityRequest{
+		Domain:            "domain",
+		WorkflowExecution: &types.WorkflowExecution{WorkflowID: "wf"},
+		ActivityID:        "activity",
+	}
+
+	wh, deps := setupMocksForWorkflowHandler(t)
+	deps.mockDomainCache.EXPECT().GetDomainID("domain").Return("domain-id", nil)
+	deps.mockHistoryClient.EXPECT().UnpauseActivity(gomock.Any(), &types.HistoryUnpauseActivityRequest{
+		DomainUUID:     "domain-id",
+		UnpauseRequest: request,
+	}).Return(nil)
+
+	assert.NoError(t, wh.UnpauseActivity(context.Background(), request))
+	assert.Equal(t, validate.ErrRequestNotSet, wh.UnpauseActivity(context.Background(), nil))
+}
+
+func TestResetActivityAttempt(t *testing.T) {
+	request := &types.ResetActivityAttemptRequest{
+		Domain:            "domain",
+		WorkflowExecution: &types.WorkflowExecution{WorkflowID: "wf"},
+		ActivityID:        "activity",
+	}
+
+	wh, deps := setupMocksForWorkflowHandler(t)
+	deps.mockDomainCache.EXPECT().GetDomainID("domain").Return("domain-id", nil)
+	deps.mockHistoryClient.EXPECT().ResetActivityAttempt(gomock.Any(), &types.HistoryResetActivityAttemptRequest{
+		DomainUUID:   "domain-id",
+		ResetRequest: request,
+	}).Return(nil)
+
+	assert.NoError(t, wh.ResetActivityAttempt(context.Background(), request))
+	assert.Equal(t, validate.ErrRequestNotSet, wh.ResetActivityAttempt(context.Background(), nil))
+}
+
+func TestRetryActivityNow(t *testing.T) {
+	request := &types.RetryActivityNowRequest{
+		Domain:            "domain",
+		WorkflowExecution: &types.WorkflowExecution{WorkflowID: "wf"},
+		ActivityID:        "activity",
+	}
+
+	wh, deps := setupMocksForWorkflowHandler(t)
+	deps.mockDomainCache.EXPECT().GetDomainID("domain").Return("domain-id", nil)
+	deps.mockHistoryClient.EXPECT().RetryActivityNow(gomock.Any(), gomock.Any()).
+		Return(&types.BadRequestError{Message: "Activity is paused, unpause it to retry it."})
+
+	err := wh.RetryActivityNow(context.Background(), request)
+	assert.Equal(t, &types.BadRequestError{Message: "Activity is paused, unpause it to retry it."}, err)
+}
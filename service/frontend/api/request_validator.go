@@ -27,12 +27,17 @@ package api
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/definition"
+	"github.com/uber/cadence/common/elasticsearch/validator"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/common/visibility"
 	"github.com/uber/cadence/service/frontend/config"
 	"github.com/uber/cadence/service/frontend/validate"
 )
@@ -45,6 +50,7 @@ type (
 		ValidateGetTaskListsByDomainRequest(context.Context, *types.GetTaskListsByDomainRequest) error
 		ValidateResetStickyTaskListRequest(context.Context, *types.ResetStickyTaskListRequest) error
 		ValidateCountWorkflowExecutionsRequest(context.Context, *types.CountWorkflowExecutionsRequest) error
+		ValidateAggregateWorkflowExecutionsRequest(context.Context, *types.AggregateWorkflowExecutionsRequest) error
 		ValidateListWorkflowExecutionsRequest(context.Context, *types.ListWorkflowExecutionsRequest) error
 		ValidateListOpenWorkflowExecutionsRequest(context.Context, *types.ListOpenWorkflowExecutionsRequest) error
 		ValidateListArchivedWorkflowExecutionsRequest(context.Context, *types.ListArchivedWorkflowExecutionsRequest) error
@@ -101,6 +107,31 @@ func checkRequiredDomainDataKVs(requiredDomainDataKeys map[string]interface{}, d
 	return nil
 }
 
+// validateSavedQueries validates the names and the queries of the visibility queries saved in domain data,
+// a query updated with an empty value is deleted
+func (v *requestValidatorImpl) validateSavedQueries(domainData map[string]string, isUpdate bool) error {
+	queryValidator := validator.NewQueryValidator(v.config.ValidSearchAttributes, v.config.EnableQueryAttributeValidation)
+	for key, query := range domainData {
+		name, ok := strings.CutPrefix(key, constants.DomainDataKeyPrefixForSavedVisibilityQuery)
+		if !ok {
+			continue
+		}
+		if err := visibility.ValidateSavedQueryName(name); err != nil {
+			return &types.BadRequestError{Message: err.Error()}
+		}
+		if query == "" {
+			if isUpdate {
+				continue
+			}
+			return &types.BadRequestError{Message: fmt.Sprintf("Saved query %s is empty", name)}
+		}
+		if _, err := queryValidator.ValidateQuery(query); err != nil {
+			return &types.BadRequestError{Message: fmt.Sprintf("Invalid saved query %s: %v", name, err)}
+		}
+	}
+	return nil
+}
+
 func checkFailOverPermission(config *config.Config, domainName string) error {
 	if config.Lockdown(domainName) {
 		return validate.ErrDomainInLockdown
@@ -177,6 +208,50 @@ func (v *requestValidatorImpl) ValidateCountWorkflowExecutionsRequest(ctx contex
 	return nil
 }
 
+// nonGroupableKeys are search attributes whose values are unique or close to unique per execution,
+// so grouping by them only produces groups of one
+var nonGroupableKeys = map[string]struct{}{
+	definition.DomainID:               {},
+	definition.RunID:                  {},
+	definition.StartTime:              {},
+	definition.ExecutionTime:          {},
+	definition.CloseTime:              {},
+	definition.UpdateTime:             {},
+	definition.ScheduledExecutionTime: {},
+}
+
+func (v *requestValidatorImpl) ValidateAggregateWorkflowExecutionsRequest(ctx context.Context, aggregateRequest *types.AggregateWorkflowExecutionsRequest) error {
+	if aggregateRequest == nil {
+		return validate.ErrRequestNotSet
+	}
+	if aggregateRequest.GetDomain() == "" {
+		return validate.ErrDomainNotSet
+	}
+	groupBy := aggregateRequest.GetGroupBy()
+	if groupBy == "" {
+		return &types.BadRequestError{Message: "GroupBy is not set on request."}
+	}
+	valueType, ok := v.config.ValidSearchAttributes()[groupBy]
+	if !ok {
+		return &types.BadRequestError{Message: fmt.Sprintf("GroupBy %s is not a valid search attribute.", groupBy)}
+	}
+	if _, ok := nonGroupableKeys[groupBy]; ok {
+		return &types.BadRequestError{Message: fmt.Sprintf("Grouping by %s is not supported.", groupBy)}
+	}
+	switch common.ConvertIndexedValueTypeToInternalType(valueType, v.logger) {
+	case types.IndexedValueTypeKeyword, types.IndexedValueTypeInt, types.IndexedValueTypeBool:
+	default:
+		return &types.BadRequestError{Message: fmt.Sprintf("Grouping by %s is not supported, only keyword, int and bool search attributes can be grouped by.", groupBy)}
+	}
+	if aggregateRequest.GetMaxGroups() <= 0 {
+		aggregateRequest.MaxGroups = int32(v.config.VisibilityMaxPageSize(aggregateRequest.GetDomain()))
+	}
+	if v.isListRequestPageSizeTooLarge(aggregateRequest.GetMaxGroups(), aggregateRequest.GetDomain()) {
+		return &types.BadRequestError{Message: fmt.Sprintf("MaxGroups is larger than allow %d", v.config.ESIndexMaxResultWindow())}
+	}
+	return nil
+}
+
 func (v *requestValidatorImpl) ValidateListWorkflowExecutionsRequest(ctx context.Context, listRequest *types.ListWorkflowExecutionsRequest) error {
 	if listRequest == nil {
 		return validate.ErrRequestNotSet
@@ -308,6 +383,9 @@ func (v *requestValidatorImpl) ValidateRegisterDomainRequest(ctx context.Context
 	if err := checkRequiredDomainDataKVs(v.config.DomainConfig.RequiredDomainDataKeys(), registerRequest.GetData()); err != nil {
 		return err
 	}
+	if err := v.validateSavedQueries(registerRequest.GetData(), false); err != nil {
+		return err
+	}
 	return validate.CheckPermission(v.config, registerRequest.SecurityToken)
 }
 
@@ -331,6 +409,9 @@ func (v *requestValidatorImpl) ValidateUpdateDomainRequest(ctx context.Context,
 	if updateRequest.WorkflowExecutionRetentionPeriodInDays != nil && *updateRequest.WorkflowExecutionRetentionPeriodInDays > int32(v.config.DomainConfig.MaxRetentionDays()) {
 		return validate.ErrInvalidRetention
 	}
+	if err := v.validateSavedQueries(updateRequest.Data, true); err != nil {
+		return err
+	}
 	isFailover := isFailoverRequest(updateRequest)
 	// don't require permission for failover request
 	if isFailover {
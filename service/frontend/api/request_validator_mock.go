@@ -42,6 +42,20 @@ func (m *MockRequestValidator) EXPECT() *MockRequestValidatorMockRecorder {
 	return m.recorder
 }
 
+// ValidateAggregateWorkflowExecutionsRequest mocks base method.
+func (m *MockRequestValidator) ValidateAggregateWorkflowExecutionsRequest(arg0 context.Context, arg1 *types.AggregateWorkflowExecutionsRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateAggregateWorkflowExecutionsRequest", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ValidateAggregateWorkflowExecutionsRequest indicates an expected call of ValidateAggregateWorkflowExecutionsRequest.
+func (mr *MockRequestValidatorMockRecorder) ValidateAggregateWorkflowExecutionsRequest(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateAggregateWorkflowExecutionsRequest", reflect.TypeOf((*MockRequestValidator)(nil).ValidateAggregateWorkflowExecutionsRequest), arg0, arg1)
+}
+
 // ValidateCountWorkflowExecutionsRequest mocks base method.
 func (m *MockRequestValidator) ValidateCountWorkflowExecutionsRequest(arg0 context.Context, arg1 *types.CountWorkflowExecutionsRequest) error {
 	m.ctrl.T.Helper()
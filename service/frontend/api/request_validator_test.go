@@ -35,6 +35,7 @@ import (
 	"github.com/uber/cadence/common/log/testlogger"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/common/visibility"
 	frontendcfg "github.com/uber/cadence/service/frontend/config"
 )
 
@@ -441,6 +442,108 @@ func TestValidateCountWorkflowExecutionsRequest(t *testing.T) {
 	}
 }
 
+func TestValidateAggregateWorkflowExecutionsRequest(t *testing.T) {
+	testCases := []struct {
+		name          string
+		req           *types.AggregateWorkflowExecutionsRequest
+		expectError   bool
+		expectedError string
+	}{
+		{
+			name: "success",
+			req: &types.AggregateWorkflowExecutionsRequest{
+				Domain:  "domain",
+				GroupBy: "WorkflowType",
+			},
+			expectError: false,
+		},
+		{
+			name: "success with custom attribute",
+			req: &types.AggregateWorkflowExecutionsRequest{
+				Domain:  "domain",
+				GroupBy: "CustomKeywordField",
+			},
+			expectError: false,
+		},
+		{
+			name:          "not set",
+			req:           nil,
+			expectError:   true,
+			expectedError: "Request is nil.",
+		},
+		{
+			name: "domain not set",
+			req: &types.AggregateWorkflowExecutionsRequest{
+				Domain:  "",
+				GroupBy: "WorkflowType",
+			},
+			expectError:   true,
+			expectedError: "Domain not set on request.",
+		},
+		{
+			name: "group by not set",
+			req: &types.AggregateWorkflowExecutionsRequest{
+				Domain: "domain",
+			},
+			expectError:   true,
+			expectedError: "GroupBy is not set on request.",
+		},
+		{
+			name: "unknown search attribute",
+			req: &types.AggregateWorkflowExecutionsRequest{
+				Domain:  "domain",
+				GroupBy: "UnknownField",
+			},
+			expectError:   true,
+			expectedError: "GroupBy UnknownField is not a valid search attribute.",
+		},
+		{
+			name: "unique search attribute",
+			req: &types.AggregateWorkflowExecutionsRequest{
+				Domain:  "domain",
+				GroupBy: "RunID",
+			},
+			expectError:   true,
+			expectedError: "Grouping by RunID is not supported.",
+		},
+		{
+			name: "unsupported type",
+			req: &types.AggregateWorkflowExecutionsRequest{
+				Domain:  "domain",
+				GroupBy: "CustomStringField",
+			},
+			expectError:   true,
+			expectedError: "Grouping by CustomStringField is not supported, only keyword, int and bool search attributes can be grouped by.",
+		},
+		{
+			name: "max groups too large",
+			req: &types.AggregateWorkflowExecutionsRequest{
+				Domain:    "domain",
+				GroupBy:   "WorkflowType",
+				MaxGroups: 101,
+			},
+			expectError:   true,
+			expectedError: "MaxGroups is larger than allow 100",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			v, deps := setupMocksForRequestValidator(t)
+			require.NoError(t, deps.dynamicClient.UpdateValue(dynamicproperties.FrontendESIndexMaxResultWindow, 100))
+			require.NoError(t, deps.dynamicClient.UpdateValue(dynamicproperties.FrontendVisibilityMaxPageSize, 10))
+
+			err := v.ValidateAggregateWorkflowExecutionsRequest(context.Background(), tc.req)
+			if tc.expectError {
+				assert.ErrorContains(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, int32(10), tc.req.GetMaxGroups())
+			}
+		})
+	}
+}
+
 func TestValidateListWorkflowExecutionsRequest(t *testing.T) {
 	testCases := []struct {
 		name          string
@@ -969,6 +1072,38 @@ func TestValidateUpdateDomainRequest(t *testing.T) {
 			expectError:   true,
 			expectedError: "No permission to do this operation.",
 		},
+		{
+			name: "success - saved queries",
+			req: &types.UpdateDomainRequest{
+				Name:          "domain",
+				SecurityToken: "token",
+				Data: map[string]string{
+					visibility.SavedQueryDomainDataKey("failed"):  "CloseStatus = 'FAILED'",
+					visibility.SavedQueryDomainDataKey("deleted"): "",
+				},
+			},
+			expectError: false,
+		},
+		{
+			name: "invalid saved query name",
+			req: &types.UpdateDomainRequest{
+				Name:          "domain",
+				SecurityToken: "token",
+				Data:          map[string]string{visibility.SavedQueryDomainDataKey("failed payments"): "CloseStatus = 'FAILED'"},
+			},
+			expectError:   true,
+			expectedError: "saved query name \"failed payments\" has to contain alphanumeric",
+		},
+		{
+			name: "invalid saved query",
+			req: &types.UpdateDomainRequest{
+				Name:          "domain",
+				SecurityToken: "token",
+				Data:          map[string]string{visibility.SavedQueryDomainDataKey("failed"): "CloseStatus = "},
+			},
+			expectError:   true,
+			expectedError: "Invalid saved query failed: Invalid query.",
+		},
 		{
 			name: "lockdown rejects active-passive failover",
 			req: &types.UpdateDomainRequest{
@@ -1072,6 +1207,19 @@ func TestValidateRegisterDomainRequest(t *testing.T) {
 			expectError:   true,
 			expectedError: "domain data error, missing required key tier . All required keys: map[tier:true]",
 		},
+		{
+			name: "empty saved query",
+			req: &types.RegisterDomainRequest{
+				Name:          "domain",
+				SecurityToken: "token",
+				Data: map[string]string{
+					"tier": "3",
+					visibility.SavedQueryDomainDataKey("failed"): "",
+				},
+			},
+			expectError:   true,
+			expectedError: "Saved query failed is empty",
+		},
 		{
 			name: "wrong token",
 			req: &types.RegisterDomainRequest{
@@ -0,0 +1,133 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package api
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/frontend/validate"
+)
+
+// UpdateWorkflowExecution runs an update handler of a workflow execution and waits for its outcome.
+// The update is first validated by the workflow, a rejected update is returned as a QueryFailedError.
+func (wh *WorkflowHandler) UpdateWorkflowExecution(
+	ctx context.Context,
+	updateRequest *types.UpdateWorkflowExecutionRequest,
+) (*types.UpdateWorkflowExecutionResponse, error) {
+	if wh.isShuttingDown() {
+		return nil, validate.ErrShuttingDown
+	}
+	if updateRequest == nil {
+		return nil, validate.ErrRequestNotSet
+	}
+
+	domainName := updateRequest.GetDomain()
+	if domainName == "" {
+		return nil, validate.ErrDomainNotSet
+	}
+	if err := validate.CheckExecution(updateRequest.GetWorkflowExecution()); err != nil {
+		return nil, err
+	}
+
+	scope := getMetricsScopeWithDomain(metrics.FrontendUpdateWorkflowExecutionScope, updateRequest, wh.GetMetricsClient()).Tagged(metrics.GetContextTags(ctx)...)
+	idLengthWarnLimit := wh.config.MaxIDLengthWarnLimit()
+	if !common.IsValidIDLength(
+		domainName,
+		scope,
+		idLengthWarnLimit,
+		wh.config.DomainNameMaxLength(domainName),
+		metrics.CadenceErrDomainNameExceededWarnLimit,
+		domainName,
+		wh.GetLogger(),
+		tag.IDTypeDomainName) {
+		return nil, validate.ErrDomainTooLong
+	}
+
+	if updateRequest.GetUpdateName() == "" {
+		return nil, validate.ErrUpdateNameNotSet
+	}
+
+	// the update is recorded as a signal, so the update name is bound by the signal name limit
+	if !common.IsValidIDLength(
+		updateRequest.GetUpdateName(),
+		scope,
+		idLengthWarnLimit,
+		wh.config.SignalNameMaxLength(domainName),
+		metrics.CadenceErrSignalNameExceededWarnLimit,
+		domainName,
+		wh.GetLogger(),
+		tag.IDTypeSignalName) {
+		return nil, validate.ErrUpdateNameTooLong
+	}
+	if !common.IsValidIDLength(
+		updateRequest.GetUpdateID(),
+		scope,
+		idLengthWarnLimit,
+		wh.config.RequestIDMaxLength(domainName),
+		metrics.CadenceErrRequestIDExceededWarnLimit,
+		domainName,
+		wh.GetLogger(),
+		tag.IDTypeRequestID) {
+		return nil, validate.ErrUpdateIDTooLong
+	}
+
+	domainID, err := wh.GetDomainCache().GetDomainID(domainName)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := common.CheckEventBlobSizeLimit(
+		len(updateRequest.GetInput()),
+		wh.config.BlobSizeLimitWarn(domainName),
+		wh.config.BlobSizeLimitError(domainName),
+		domainID,
+		domainName,
+		updateRequest.GetWorkflowExecution().GetWorkflowID(),
+		updateRequest.GetWorkflowExecution().GetRunID(),
+		scope,
+		wh.GetLogger(),
+		tag.BlobSizeViolationOperation("UpdateWorkflowExecution"),
+	); err != nil {
+		return nil, err
+	}
+
+	if updateRequest.UpdateID == "" {
+		// copy the request so that a retry of the caller does not reuse the generated ID
+		request := *updateRequest
+		request.UpdateID = uuid.New().String()
+		updateRequest = &request
+	}
+
+	resp, err := wh.GetHistoryClient().UpdateWorkflowExecution(ctx, &types.HistoryUpdateWorkflowExecutionRequest{
+		DomainUUID:    domainID,
+		UpdateRequest: updateRequest,
+	})
+	if err != nil {
+		return nil, wh.normalizeVersionedErrors(ctx, err)
+	}
+	return resp, nil
+}
@@ -0,0 +1,186 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package api
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/yarpc"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/frontend/validate"
+)
+
+func TestUpdateWorkflowExecution(t *testing.T) {
+	validRequest := func() *types.UpdateWorkflowExecutionRequest {
+		return &types.UpdateWorkflowExecutionRequest{
+			Domain: "domain",
+			WorkflowExecution: &types.WorkflowExecution{
+				WorkflowID: "wf",
+				RunID:      "2f3e1a52-8d1b-4bd5-9a0c-6a1c5b0f0b8e",
+			},
+			UpdateID:   "update-id",
+			UpdateName: "update",
+			Input:      []byte("input"),
+		}
+	}
+
+	testCases := []struct {
+		name          string
+		req           *types.UpdateWorkflowExecutionRequest
+		setupMocks    func(*WorkflowHandler, *mockDeps)
+		expected      *types.UpdateWorkflowExecutionResponse
+		expectedError error
+	}{
+		{
+			name: "success",
+			req:  validRequest(),
+			setupMocks: func(wh *WorkflowHandler, deps *mockDeps) {
+				deps.mockDomainCache.EXPECT().GetDomainID("domain").Return("domain-id", nil)
+				deps.mockHistoryClient.EXPECT().UpdateWorkflowExecution(gomock.Any(), &types.HistoryUpdateWorkflowExecutionRequest{
+					DomainUUID:    "domain-id",
+					UpdateRequest: validRequest(),
+				}).Return(&types.UpdateWorkflowExecutionResponse{UpdateID: "update-id", Result: []byte("result")}, nil)
+			},
+			expected: &types.UpdateWorkflowExecutionResponse{UpdateID: "update-id", Result: []byte("result")},
+		},
+		{
+			name: "update ID is generated when not set",
+			req: func() *types.UpdateWorkflowExecutionRequest {
+				req := validRequest()
+				req.UpdateID = ""
+				return req
+			}(),
+			setupMocks: func(wh *WorkflowHandler, deps *mockDeps) {
+				deps.mockDomainCache.EXPECT().GetDomainID("domain").Return("domain-id", nil)
+				deps.mockHistoryClient.EXPECT().UpdateWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, req *types.HistoryUpdateWorkflowExecutionRequest, _ ...yarpc.CallOption) (*types.UpdateWorkflowExecutionResponse, error) {
+						assert.NotEmpty(t, req.GetUpdateRequest().GetUpdateID())
+						return &types.UpdateWorkflowExecutionResponse{UpdateID: req.GetUpdateRequest().GetUpdateID()}, nil
+					})
+			},
+		},
+		{
+			name:          "nil request",
+			req:           nil,
+			setupMocks:    func(wh *WorkflowHandler, deps *mockDeps) {},
+			expectedError: validate.ErrRequestNotSet,
+		},
+		{
+			name: "domain not set",
+			req: func() *types.UpdateWorkflowExecutionRequest {
+				req := validRequest()
+				req.Domain = ""
+				return req
+			}(),
+			setupMocks:    func(wh *WorkflowHandler, deps *mockDeps) {},
+			expectedError: validate.ErrDomainNotSet,
+		},
+		{
+			name: "workflow ID not set",
+			req: func() *types.UpdateWorkflowExecutionRequest {
+				req := validRequest()
+				req.WorkflowExecution.WorkflowID = ""
+				return req
+			}(),
+			setupMocks:    func(wh *WorkflowHandler, deps *mockDeps) {},
+			expectedError: validate.ErrWorkflowIDNotSet,
+		},
+		{
+			name: "update name not set",
+			req: func() *types.UpdateWorkflowExecutionRequest {
+				req := validRequest()
+				req.UpdateName = ""
+				return req
+			}(),
+			setupMocks:    func(wh *WorkflowHandler, deps *mockDeps) {},
+			expectedError: validate.ErrUpdateNameNotSet,
+		},
+		{
+			name: "update name too long",
+			req:  validRequest(),
+			setupMocks: func(wh *WorkflowHandler, deps *mockDeps) {
+				wh.config.SignalNameMaxLength = dynamicproperties.GetIntPropertyFilteredByDomain(1)
+			},
+			expectedError: validate.ErrUpdateNameTooLong,
+		},
+		{
+			name: "update ID too long",
+			req:  validRequest(),
+			setupMocks: func(wh *WorkflowHandler, deps *mockDeps) {
+				wh.config.RequestIDMaxLength = dynamicproperties.GetIntPropertyFilteredByDomain(1)
+			},
+			expectedError: validate.ErrUpdateIDTooLong,
+		},
+		{
+			name: "input exceeds blob size limit",
+			req:  validRequest(),
+			setupMocks: func(wh *WorkflowHandler, deps *mockDeps) {
+				deps.mockDomainCache.EXPECT().GetDomainID("domain").Return("domain-id", nil)
+				wh.config.BlobSizeLimitWarn = dynamicproperties.GetIntPropertyFilteredByDomain(1)
+				wh.config.BlobSizeLimitError = dynamicproperties.GetIntPropertyFilteredByDomain(1)
+			},
+			expectedError: common.ErrBlobSizeExceedsLimit,
+		},
+		{
+			name: "history client error",
+			req:  validRequest(),
+			setupMocks: func(wh *WorkflowHandler, deps *mockDeps) {
+				deps.mockDomainCache.EXPECT().GetDomainID("domain").Return("domain-id", nil)
+				deps.mockHistoryClient.EXPECT().UpdateWorkflowExecution(gomock.Any(), gomock.Any()).
+					Return(nil, &types.QueryFailedError{Message: "rejected"})
+			},
+			expectedError: &types.QueryFailedError{Message: "rejected"},
+		},
+		{
+			name: "cache error",
+			req:  validRequest(),
+			setupMocks: func(wh *WorkflowHandler, deps *mockDeps) {
+				deps.mockDomainCache.EXPECT().GetDomainID("domain").Return("", errors.New("cache error"))
+			},
+			expectedError: errors.New("cache error"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			wh, deps := setupMocksForWorkflowHandler(t)
+			tc.setupMocks(wh, deps)
+
+			resp, err := wh.UpdateWorkflowExecution(context.Background(), tc.req)
+			if tc.expectedError != nil {
+				assert.Equal(t, tc.expectedError, err)
+				assert.Nil(t, resp)
+				return
+			}
+			assert.NoError(t, err)
+			if tc.expected != nil {
+				assert.Equal(t, tc.expected, resp)
+			}
+		})
+	}
+}
@@ -98,6 +98,9 @@ type Config struct {
 	// size limit system protection
 	BlobSizeLimitError dynamicproperties.IntPropertyFnWithDomainFilter
 	BlobSizeLimitWarn  dynamicproperties.IntPropertyFnWithDomainFilter
+	// payloads larger than PayloadOffloadThreshold are stored in the blobstore and limited by OffloadedBlobSizeLimitError
+	PayloadOffloadThreshold     dynamicproperties.IntPropertyFnWithDomainFilter
+	OffloadedBlobSizeLimitError dynamicproperties.IntPropertyFnWithDomainFilter
 
 	ThrottledLogRPS dynamicproperties.IntPropertyFn
 
@@ -183,6 +186,8 @@ func NewConfig(dc *dynamicconfig.Collection, numHistoryShards int, isAdvancedVis
 		DisableListVisibilityByFilter:                     dc.GetBoolPropertyFilteredByDomain(dynamicproperties.DisableListVisibilityByFilter),
 		BlobSizeLimitError:                                dc.GetIntPropertyFilteredByDomain(dynamicproperties.BlobSizeLimitError),
 		BlobSizeLimitWarn:                                 dc.GetIntPropertyFilteredByDomain(dynamicproperties.BlobSizeLimitWarn),
+		PayloadOffloadThreshold:                           dc.GetIntPropertyFilteredByDomain(dynamicproperties.PayloadOffloadThreshold),
+		OffloadedBlobSizeLimitError:                       dc.GetIntPropertyFilteredByDomain(dynamicproperties.OffloadedBlobSizeLimitError),
 		ThrottledLogRPS:                                   dc.GetIntProperty(dynamicproperties.FrontendThrottledLogRPS),
 		ShutdownDrainDuration:                             dc.GetDurationProperty(dynamicproperties.FrontendShutdownDrainDuration),
 		WarmupDuration:                                    dc.GetDurationProperty(dynamicproperties.FrontendWarmupDuration),
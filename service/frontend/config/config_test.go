@@ -84,6 +84,8 @@ func TestNewConfig(t *testing.T) {
 		"DisableListVisibilityByFilter":                     {dynamicproperties.DisableListVisibilityByFilter, false},
 		"BlobSizeLimitError":                                {dynamicproperties.BlobSizeLimitError, 29},
 		"BlobSizeLimitWarn":                                 {dynamicproperties.BlobSizeLimitWarn, 30},
+		"PayloadOffloadThreshold":                           {dynamicproperties.PayloadOffloadThreshold, 47},
+		"OffloadedBlobSizeLimitError":                       {dynamicproperties.OffloadedBlobSizeLimitError, 48},
 		"ThrottledLogRPS":                                   {dynamicproperties.FrontendThrottledLogRPS, 31},
 		"ShutdownDrainDuration":                             {dynamicproperties.FrontendShutdownDrainDuration, time.Duration(32)},
 		"WarmupDuration":                                    {dynamicproperties.FrontendWarmupDuration, time.Duration(40)},
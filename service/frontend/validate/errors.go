@@ -38,11 +38,16 @@ var (
 	ErrWorkflowIDNotSet                           = &types.BadRequestError{Message: "WorkflowId is not set on request."}
 	ErrActivityIDNotSet                           = &types.BadRequestError{Message: "ActivityID is not set on request."}
 	ErrSignalNameNotSet                           = &types.BadRequestError{Message: "SignalName is not set on request."}
+	ErrUpdateNameNotSet                           = &types.BadRequestError{Message: "UpdateName is not set on request."}
+	ErrSignalNameReserved                         = &types.BadRequestError{Message: "SignalName is reserved by the server."}
 	ErrInvalidRunID                               = &types.BadRequestError{Message: "Invalid RunId."}
+	ErrRunIDNotSet                                = &types.BadRequestError{Message: "RunId is not set on request."}
 	ErrInvalidNextPageToken                       = &types.BadRequestError{Message: "Invalid NextPageToken."}
 	ErrNextPageTokenRunIDMismatch                 = &types.BadRequestError{Message: "RunID in the request does not match the NextPageToken."}
 	ErrQueryNotSet                                = &types.BadRequestError{Message: "WorkflowQuery is not set on request."}
 	ErrQueryTypeNotSet                            = &types.BadRequestError{Message: "QueryType is not set on request."}
+	ErrSearchAttributesAndMemoNotSet              = &types.BadRequestError{Message: "SearchAttributes and Memo are not set on request."}
+	ErrSemaphoreNameNotSet                        = &types.BadRequestError{Message: "SemaphoreName is not set on request."}
 	ErrRequestNotSet                              = &types.BadRequestError{Message: "Request is nil."}
 	ErrNoPermission                               = &types.BadRequestError{Message: "No permission to do this operation."}
 	ErrWorkflowTypeNotSet                         = &types.BadRequestError{Message: "WorkflowType is not set on request."}
@@ -52,6 +57,8 @@ var (
 	ErrInvalidDelayStartSeconds                   = &types.BadRequestError{Message: "A valid DelayStartSeconds is not set on request."}
 	ErrInvalidJitterStartSeconds                  = &types.BadRequestError{Message: "A valid JitterStartSeconds is not set on request (negative)."}
 	ErrInvalidJitterStartSeconds2                 = &types.BadRequestError{Message: "A valid JitterStartSeconds is not set on request (larger than cron duration)."}
+	ErrInvalidTaskPriority                        = &types.BadRequestError{Message: "Priority must be between 1 (highest) and 5 (lowest), or 0 to use the default."}
+	ErrFairnessKeyTooLong                         = &types.BadRequestError{Message: "FairnessKey exceeds length limit of 128 characters."}
 	ErrQueryDisallowedForDomain                   = &types.BadRequestError{Message: "Domain is not allowed to query, please contact cadence team to re-enable queries."}
 	ErrClusterNameNotSet                          = &types.BadRequestError{Message: "Cluster name is not set."}
 	ErrEmptyReplicationInfo                       = &types.BadRequestError{Message: "Replication task info is not set."}
@@ -67,6 +74,8 @@ var (
 	ErrWorkflowTypeTooLong = &types.BadRequestError{Message: "WorkflowType length exceeds limit."}
 	ErrWorkflowIDTooLong   = &types.BadRequestError{Message: "WorkflowID length exceeds limit."}
 	ErrSignalNameTooLong   = &types.BadRequestError{Message: "SignalName length exceeds limit."}
+	ErrUpdateNameTooLong   = &types.BadRequestError{Message: "UpdateName length exceeds limit."}
+	ErrUpdateIDTooLong     = &types.BadRequestError{Message: "UpdateID length exceeds limit."}
 	ErrTaskListTooLong     = &types.BadRequestError{Message: "TaskList length exceeds limit."}
 	ErrRequestIDTooLong    = &types.BadRequestError{Message: "RequestID length exceeds limit."}
 	ErrIdentityTooLong     = &types.BadRequestError{Message: "Identity length exceeds limit."}
@@ -668,3 +668,19 @@ func (a *adminHandler) UpdateTaskListPartitionConfig(ctx context.Context, up1 *t
 	}
 	return a.handler.UpdateTaskListPartitionConfig(ctx, up1)
 }
+
+func (a *adminHandler) UpsertWorkflowSearchAttributes(ctx context.Context, ap1 *types.AdminUpsertWorkflowSearchAttributesRequest) (err error) {
+	attr := &authorization.Attributes{
+		APIName:     "UpsertWorkflowSearchAttributes",
+		Permission:  authorization.PermissionAdmin,
+		RequestBody: authorization.NewFilteredRequestBody(ap1),
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr)
+	if err != nil {
+		return err
+	}
+	if !isAuthorized {
+		return errUnauthorized
+	}
+	return a.handler.UpsertWorkflowSearchAttributes(ctx, ap1)
+}
@@ -39,6 +39,24 @@ func NewAPIHandler(handler _sourceApi.Handler, resource resource.Resource, autho
 	}
 }
 
+func (a *apiHandler) AggregateWorkflowExecutions(ctx context.Context, ap1 *types.AggregateWorkflowExecutionsRequest) (ap2 *types.AggregateWorkflowExecutionsResponse, err error) {
+	scope := a.getMetricsScopeWithDomain(metrics.FrontendAggregateWorkflowExecutionsScope, ap1.GetDomain())
+	attr := &authorization.Attributes{
+		APIName:     "AggregateWorkflowExecutions",
+		Permission:  authorization.PermissionRead,
+		RequestBody: authorization.NewFilteredRequestBody(ap1),
+		DomainName:  ap1.GetDomain(),
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+	return a.handler.AggregateWorkflowExecutions(ctx, ap1)
+}
+
 func (a *apiHandler) BackfillSchedule(ctx context.Context, bp1 *types.BackfillScheduleRequest) (bp2 *types.BackfillScheduleResponse, err error) {
 	scope := a.getMetricsScopeWithDomain(metrics.FrontendBackfillScheduleScope, bp1.GetDomain())
 	attr := &authorization.Attributes{
@@ -93,6 +111,24 @@ func (a *apiHandler) CreateSchedule(ctx context.Context, cp1 *types.CreateSchedu
 	return a.handler.CreateSchedule(ctx, cp1)
 }
 
+func (a *apiHandler) CreateSemaphore(ctx context.Context, cp1 *types.CreateSemaphoreRequest) (cp2 *types.CreateSemaphoreResponse, err error) {
+	scope := a.getMetricsScopeWithDomain(metrics.FrontendCreateSemaphoreScope, cp1.GetDomain())
+	attr := &authorization.Attributes{
+		APIName:     "CreateSemaphore",
+		Permission:  authorization.PermissionWrite,
+		RequestBody: authorization.NewFilteredRequestBody(cp1),
+		DomainName:  cp1.GetDomain(),
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+	return a.handler.CreateSemaphore(ctx, cp1)
+}
+
 func (a *apiHandler) DeleteDomain(ctx context.Context, dp1 *types.DeleteDomainRequest) (err error) {
 	scope := a.GetMetricsClient().Scope(metrics.FrontendDeleteDomainScope).Tagged(metrics.NonDomainTag())
 	attr := &authorization.Attributes{
@@ -128,6 +164,24 @@ func (a *apiHandler) DeleteSchedule(ctx context.Context, dp1 *types.DeleteSchedu
 	return a.handler.DeleteSchedule(ctx, dp1)
 }
 
+func (a *apiHandler) DeleteWorkflowExecution(ctx context.Context, dp1 *types.DeleteWorkflowExecutionRequest) (err error) {
+	scope := a.getMetricsScopeWithDomain(metrics.FrontendDeleteWorkflowExecutionScope, dp1.GetDomain())
+	attr := &authorization.Attributes{
+		APIName:     "DeleteWorkflowExecution",
+		Permission:  authorization.PermissionWrite,
+		RequestBody: authorization.NewFilteredRequestBody(dp1),
+		DomainName:  dp1.GetDomain(),
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return err
+	}
+	if !isAuthorized {
+		return errUnauthorized
+	}
+	return a.handler.DeleteWorkflowExecution(ctx, dp1)
+}
+
 func (a *apiHandler) DeprecateDomain(ctx context.Context, dp1 *types.DeprecateDomainRequest) (err error) {
 	scope := a.GetMetricsClient().Scope(metrics.FrontendDeprecateDomainScope).Tagged(metrics.NonDomainTag())
 	attr := &authorization.Attributes{
@@ -181,6 +235,24 @@ func (a *apiHandler) DescribeSchedule(ctx context.Context, dp1 *types.DescribeSc
 	return a.handler.DescribeSchedule(ctx, dp1)
 }
 
+func (a *apiHandler) DescribeSemaphore(ctx context.Context, dp1 *types.DescribeSemaphoreRequest) (dp2 *types.DescribeSemaphoreResponse, err error) {
+	scope := a.getMetricsScopeWithDomain(metrics.FrontendDescribeSemaphoreScope, dp1.GetDomain())
+	attr := &authorization.Attributes{
+		APIName:     "DescribeSemaphore",
+		Permission:  authorization.PermissionRead,
+		RequestBody: authorization.NewFilteredRequestBody(dp1),
+		DomainName:  dp1.GetDomain(),
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+	return a.handler.DescribeSemaphore(ctx, dp1)
+}
+
 func (a *apiHandler) DescribeTaskList(ctx context.Context, dp1 *types.DescribeTaskListRequest) (dp2 *types.DescribeTaskListResponse, err error) {
 	scope := a.getMetricsScopeWithDomain(metrics.FrontendDescribeTaskListScope, dp1.GetDomain())
 	attr := &authorization.Attributes{
@@ -367,6 +439,24 @@ func (a *apiHandler) ListSchedules(ctx context.Context, lp1 *types.ListSchedules
 	return a.handler.ListSchedules(ctx, lp1)
 }
 
+func (a *apiHandler) ListSemaphores(ctx context.Context, lp1 *types.ListSemaphoresRequest) (lp2 *types.ListSemaphoresResponse, err error) {
+	scope := a.getMetricsScopeWithDomain(metrics.FrontendListSemaphoresScope, lp1.GetDomain())
+	attr := &authorization.Attributes{
+		APIName:     "ListSemaphores",
+		Permission:  authorization.PermissionRead,
+		RequestBody: authorization.NewFilteredRequestBody(lp1),
+		DomainName:  lp1.GetDomain(),
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+	return a.handler.ListSemaphores(ctx, lp1)
+}
+
 func (a *apiHandler) ListTaskListPartitions(ctx context.Context, lp1 *types.ListTaskListPartitionsRequest) (lp2 *types.ListTaskListPartitionsResponse, err error) {
 	scope := a.getMetricsScopeWithDomain(metrics.FrontendListTaskListPartitionsScope, lp1.GetDomain())
 	attr := &authorization.Attributes{
@@ -403,6 +493,24 @@ func (a *apiHandler) ListWorkflowExecutions(ctx context.Context, lp1 *types.List
 	return a.handler.ListWorkflowExecutions(ctx, lp1)
 }
 
+func (a *apiHandler) PauseActivity(ctx context.Context, pp1 *types.PauseActivityRequest) (err error) {
+	scope := a.getMetricsScopeWithDomain(metrics.FrontendPauseActivityScope, pp1.GetDomain())
+	attr := &authorization.Attributes{
+		APIName:     "PauseActivity",
+		Permission:  authorization.PermissionWrite,
+		RequestBody: authorization.NewFilteredRequestBody(pp1),
+		DomainName:  pp1.GetDomain(),
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return err
+	}
+	if !isAuthorized {
+		return errUnauthorized
+	}
+	return a.handler.PauseActivity(ctx, pp1)
+}
+
 func (a *apiHandler) PauseSchedule(ctx context.Context, pp1 *types.PauseScheduleRequest) (pp2 *types.PauseScheduleResponse, err error) {
 	scope := a.getMetricsScopeWithDomain(metrics.FrontendPauseScheduleScope, pp1.GetDomain())
 	attr := &authorization.Attributes{
@@ -421,6 +529,24 @@ func (a *apiHandler) PauseSchedule(ctx context.Context, pp1 *types.PauseSchedule
 	return a.handler.PauseSchedule(ctx, pp1)
 }
 
+func (a *apiHandler) PauseWorkflowExecution(ctx context.Context, pp1 *types.PauseWorkflowExecutionRequest) (err error) {
+	scope := a.getMetricsScopeWithDomain(metrics.FrontendPauseWorkflowExecutionScope, pp1.GetDomain())
+	attr := &authorization.Attributes{
+		APIName:     "PauseWorkflowExecution",
+		Permission:  authorization.PermissionWrite,
+		RequestBody: authorization.NewFilteredRequestBody(pp1),
+		DomainName:  pp1.GetDomain(),
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return err
+	}
+	if !isAuthorized {
+		return errUnauthorized
+	}
+	return a.handler.PauseWorkflowExecution(ctx, pp1)
+}
+
 func (a *apiHandler) PollForActivityTask(ctx context.Context, pp1 *types.PollForActivityTaskRequest) (pp2 *types.PollForActivityTaskResponse, err error) {
 	scope := a.getMetricsScopeWithDomain(metrics.FrontendPollForActivityTaskScope, pp1.GetDomain())
 	attr := &authorization.Attributes{
@@ -538,6 +664,24 @@ func (a *apiHandler) RequestCancelWorkflowExecution(ctx context.Context, rp1 *ty
 	return a.handler.RequestCancelWorkflowExecution(ctx, rp1)
 }
 
+func (a *apiHandler) ResetActivityAttempt(ctx context.Context, rp1 *types.ResetActivityAttemptRequest) (err error) {
+	scope := a.getMetricsScopeWithDomain(metrics.FrontendResetActivityAttemptScope, rp1.GetDomain())
+	attr := &authorization.Attributes{
+		APIName:     "ResetActivityAttempt",
+		Permission:  authorization.PermissionWrite,
+		RequestBody: authorization.NewFilteredRequestBody(rp1),
+		DomainName:  rp1.GetDomain(),
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return err
+	}
+	if !isAuthorized {
+		return errUnauthorized
+	}
+	return a.handler.ResetActivityAttempt(ctx, rp1)
+}
+
 func (a *apiHandler) ResetStickyTaskList(ctx context.Context, rp1 *types.ResetStickyTaskListRequest) (rp2 *types.ResetStickyTaskListResponse, err error) {
 	return a.handler.ResetStickyTaskList(ctx, rp1)
 }
@@ -614,6 +758,24 @@ func (a *apiHandler) RestartWorkflowExecution(ctx context.Context, rp1 *types.Re
 	return a.handler.RestartWorkflowExecution(ctx, rp1)
 }
 
+func (a *apiHandler) RetryActivityNow(ctx context.Context, rp1 *types.RetryActivityNowRequest) (err error) {
+	scope := a.getMetricsScopeWithDomain(metrics.FrontendRetryActivityNowScope, rp1.GetDomain())
+	attr := &authorization.Attributes{
+		APIName:     "RetryActivityNow",
+		Permission:  authorization.PermissionWrite,
+		RequestBody: authorization.NewFilteredRequestBody(rp1),
+		DomainName:  rp1.GetDomain(),
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return err
+	}
+	if !isAuthorized {
+		return errUnauthorized
+	}
+	return a.handler.RetryActivityNow(ctx, rp1)
+}
+
 func (a *apiHandler) ScanWorkflowExecutions(ctx context.Context, lp1 *types.ListWorkflowExecutionsRequest) (lp2 *types.ListWorkflowExecutionsResponse, err error) {
 	scope := a.getMetricsScopeWithDomain(metrics.FrontendScanWorkflowExecutionsScope, lp1.GetDomain())
 	attr := &authorization.Attributes{
@@ -748,6 +910,42 @@ func (a *apiHandler) TerminateWorkflowExecution(ctx context.Context, tp1 *types.
 	return a.handler.TerminateWorkflowExecution(ctx, tp1)
 }
 
+func (a *apiHandler) TriggerSchedule(ctx context.Context, tp1 *types.TriggerScheduleRequest) (tp2 *types.TriggerScheduleResponse, err error) {
+	scope := a.getMetricsScopeWithDomain(metrics.FrontendTriggerScheduleScope, tp1.GetDomain())
+	attr := &authorization.Attributes{
+		APIName:     "TriggerSchedule",
+		Permission:  authorization.PermissionWrite,
+		RequestBody: authorization.NewFilteredRequestBody(tp1),
+		DomainName:  tp1.GetDomain(),
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+	return a.handler.TriggerSchedule(ctx, tp1)
+}
+
+func (a *apiHandler) UnpauseActivity(ctx context.Context, up1 *types.UnpauseActivityRequest) (err error) {
+	scope := a.getMetricsScopeWithDomain(metrics.FrontendUnpauseActivityScope, up1.GetDomain())
+	attr := &authorization.Attributes{
+		APIName:     "UnpauseActivity",
+		Permission:  authorization.PermissionWrite,
+		RequestBody: authorization.NewFilteredRequestBody(up1),
+		DomainName:  up1.GetDomain(),
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return err
+	}
+	if !isAuthorized {
+		return errUnauthorized
+	}
+	return a.handler.UnpauseActivity(ctx, up1)
+}
+
 func (a *apiHandler) UnpauseSchedule(ctx context.Context, up1 *types.UnpauseScheduleRequest) (up2 *types.UnpauseScheduleResponse, err error) {
 	scope := a.getMetricsScopeWithDomain(metrics.FrontendUnpauseScheduleScope, up1.GetDomain())
 	attr := &authorization.Attributes{
@@ -766,6 +964,24 @@ func (a *apiHandler) UnpauseSchedule(ctx context.Context, up1 *types.UnpauseSche
 	return a.handler.UnpauseSchedule(ctx, up1)
 }
 
+func (a *apiHandler) UnpauseWorkflowExecution(ctx context.Context, up1 *types.UnpauseWorkflowExecutionRequest) (err error) {
+	scope := a.getMetricsScopeWithDomain(metrics.FrontendUnpauseWorkflowExecutionScope, up1.GetDomain())
+	attr := &authorization.Attributes{
+		APIName:     "UnpauseWorkflowExecution",
+		Permission:  authorization.PermissionWrite,
+		RequestBody: authorization.NewFilteredRequestBody(up1),
+		DomainName:  up1.GetDomain(),
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return err
+	}
+	if !isAuthorized {
+		return errUnauthorized
+	}
+	return a.handler.UnpauseWorkflowExecution(ctx, up1)
+}
+
 func (a *apiHandler) UpdateDomain(ctx context.Context, up1 *types.UpdateDomainRequest) (up2 *types.UpdateDomainResponse, err error) {
 	scope := a.GetMetricsClient().Scope(metrics.FrontendUpdateDomainScope).Tagged(metrics.NonDomainTag())
 	attr := &authorization.Attributes{
@@ -800,3 +1016,21 @@ func (a *apiHandler) UpdateSchedule(ctx context.Context, up1 *types.UpdateSchedu
 	}
 	return a.handler.UpdateSchedule(ctx, up1)
 }
+
+func (a *apiHandler) UpdateWorkflowExecution(ctx context.Context, up1 *types.UpdateWorkflowExecutionRequest) (up2 *types.UpdateWorkflowExecutionResponse, err error) {
+	scope := a.getMetricsScopeWithDomain(metrics.FrontendUpdateWorkflowExecutionScope, up1.GetDomain())
+	attr := &authorization.Attributes{
+		APIName:     "UpdateWorkflowExecution",
+		Permission:  authorization.PermissionWrite,
+		RequestBody: authorization.NewFilteredRequestBody(up1),
+		DomainName:  up1.GetDomain(),
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+	return a.handler.UpdateWorkflowExecution(ctx, up1)
+}
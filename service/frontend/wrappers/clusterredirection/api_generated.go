@@ -62,6 +62,45 @@ func NewAPIHandler(
 	}
 }
 
+func (handler *clusterRedirectionHandler) AggregateWorkflowExecutions(ctx context.Context, ap1 *types.AggregateWorkflowExecutionsRequest) (ap2 *types.AggregateWorkflowExecutionsResponse, err error) {
+	var (
+		apiName                   = "AggregateWorkflowExecutions"
+		cluster                   string
+		requestedConsistencyLevel types.QueryConsistencyLevel = getRequestedConsistencyLevelFromContext(ctx)
+	)
+
+	var domainEntry *cache.DomainCacheEntry
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionAggregateWorkflowExecutionsScope)
+	defer func() {
+		handler.afterCall(recover(), scope, startTime, domainEntry, cluster, &err)
+	}()
+
+	domainEntry, err = handler.domainCache.GetDomain(ap1.Domain)
+	if err != nil {
+		return nil, err
+	}
+
+	var actClSelPolicyForNewWF *types.ActiveClusterSelectionPolicy
+	var workflowExecution *types.WorkflowExecution
+
+	err = handler.redirectionPolicy.Redirect(ctx, domainEntry, workflowExecution, actClSelPolicyForNewWF, apiName, requestedConsistencyLevel, func(targetDC string) error {
+		cluster = targetDC
+		switch {
+		case targetDC == handler.currentClusterName:
+			ap2, err = handler.frontendHandler.AggregateWorkflowExecutions(ctx, ap1)
+		default:
+			remoteClient, clientErr := handler.GetRemoteFrontendClient(targetDC)
+			if clientErr != nil {
+				return clientErr
+			}
+			ap2, err = remoteClient.AggregateWorkflowExecutions(ctx, ap1, handler.callOptions...)
+		}
+		return err
+	})
+
+	return ap2, err
+}
+
 func (handler *clusterRedirectionHandler) BackfillSchedule(ctx context.Context, bp1 *types.BackfillScheduleRequest) (bp2 *types.BackfillScheduleResponse, err error) {
 	var (
 		apiName                   = "BackfillSchedule"
@@ -179,6 +218,45 @@ func (handler *clusterRedirectionHandler) CreateSchedule(ctx context.Context, cp
 	return cp2, err
 }
 
+func (handler *clusterRedirectionHandler) CreateSemaphore(ctx context.Context, cp1 *types.CreateSemaphoreRequest) (cp2 *types.CreateSemaphoreResponse, err error) {
+	var (
+		apiName                   = "CreateSemaphore"
+		cluster                   string
+		requestedConsistencyLevel types.QueryConsistencyLevel = getRequestedConsistencyLevelFromContext(ctx)
+	)
+
+	var domainEntry *cache.DomainCacheEntry
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionCreateSemaphoreScope)
+	defer func() {
+		handler.afterCall(recover(), scope, startTime, domainEntry, cluster, &err)
+	}()
+
+	domainEntry, err = handler.domainCache.GetDomain(cp1.Domain)
+	if err != nil {
+		return nil, err
+	}
+
+	var actClSelPolicyForNewWF *types.ActiveClusterSelectionPolicy
+	var workflowExecution *types.WorkflowExecution
+
+	err = handler.redirectionPolicy.Redirect(ctx, domainEntry, workflowExecution, actClSelPolicyForNewWF, apiName, requestedConsistencyLevel, func(targetDC string) error {
+		cluster = targetDC
+		switch {
+		case targetDC == handler.currentClusterName:
+			cp2, err = handler.frontendHandler.CreateSemaphore(ctx, cp1)
+		default:
+			remoteClient, clientErr := handler.GetRemoteFrontendClient(targetDC)
+			if clientErr != nil {
+				return clientErr
+			}
+			cp2, err = remoteClient.CreateSemaphore(ctx, cp1, handler.callOptions...)
+		}
+		return err
+	})
+
+	return cp2, err
+}
+
 func (handler *clusterRedirectionHandler) DeleteDomain(ctx context.Context, dp1 *types.DeleteDomainRequest) (err error) {
 	return handler.frontendHandler.DeleteDomain(ctx, dp1)
 }
@@ -222,6 +300,46 @@ func (handler *clusterRedirectionHandler) DeleteSchedule(ctx context.Context, dp
 	return dp2, err
 }
 
+func (handler *clusterRedirectionHandler) DeleteWorkflowExecution(ctx context.Context, dp1 *types.DeleteWorkflowExecutionRequest) (err error) {
+	var (
+		apiName                   = "DeleteWorkflowExecution"
+		cluster                   string
+		requestedConsistencyLevel types.QueryConsistencyLevel = getRequestedConsistencyLevelFromContext(ctx)
+	)
+
+	var domainEntry *cache.DomainCacheEntry
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionDeleteWorkflowExecutionScope)
+	defer func() {
+		handler.afterCall(recover(), scope, startTime, domainEntry, cluster, &err)
+	}()
+
+	domainEntry, err = handler.domainCache.GetDomain(dp1.Domain)
+	if err != nil {
+		return err
+	}
+
+	var actClSelPolicyForNewWF *types.ActiveClusterSelectionPolicy
+	var workflowExecution *types.WorkflowExecution
+	workflowExecution = dp1.GetWorkflowExecution()
+
+	err = handler.redirectionPolicy.Redirect(ctx, domainEntry, workflowExecution, actClSelPolicyForNewWF, apiName, requestedConsistencyLevel, func(targetDC string) error {
+		cluster = targetDC
+		switch {
+		case targetDC == handler.currentClusterName:
+			err = handler.frontendHandler.DeleteWorkflowExecution(ctx, dp1)
+		default:
+			remoteClient, clientErr := handler.GetRemoteFrontendClient(targetDC)
+			if clientErr != nil {
+				return clientErr
+			}
+			err = remoteClient.DeleteWorkflowExecution(ctx, dp1, handler.callOptions...)
+		}
+		return err
+	})
+
+	return err
+}
+
 func (handler *clusterRedirectionHandler) DeprecateDomain(ctx context.Context, dp1 *types.DeprecateDomainRequest) (err error) {
 	return handler.frontendHandler.DeprecateDomain(ctx, dp1)
 }
@@ -269,6 +387,45 @@ func (handler *clusterRedirectionHandler) DescribeSchedule(ctx context.Context,
 	return dp2, err
 }
 
+func (handler *clusterRedirectionHandler) DescribeSemaphore(ctx context.Context, dp1 *types.DescribeSemaphoreRequest) (dp2 *types.DescribeSemaphoreResponse, err error) {
+	var (
+		apiName                   = "DescribeSemaphore"
+		cluster                   string
+		requestedConsistencyLevel types.QueryConsistencyLevel = getRequestedConsistencyLevelFromContext(ctx)
+	)
+
+	var domainEntry *cache.DomainCacheEntry
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionDescribeSemaphoreScope)
+	defer func() {
+		handler.afterCall(recover(), scope, startTime, domainEntry, cluster, &err)
+	}()
+
+	domainEntry, err = handler.domainCache.GetDomain(dp1.Domain)
+	if err != nil {
+		return nil, err
+	}
+
+	var actClSelPolicyForNewWF *types.ActiveClusterSelectionPolicy
+	var workflowExecution *types.WorkflowExecution
+
+	err = handler.redirectionPolicy.Redirect(ctx, domainEntry, workflowExecution, actClSelPolicyForNewWF, apiName, requestedConsistencyLevel, func(targetDC string) error {
+		cluster = targetDC
+		switch {
+		case targetDC == handler.currentClusterName:
+			dp2, err = handler.frontendHandler.DescribeSemaphore(ctx, dp1)
+		default:
+			remoteClient, clientErr := handler.GetRemoteFrontendClient(targetDC)
+			if clientErr != nil {
+				return clientErr
+			}
+			dp2, err = remoteClient.DescribeSemaphore(ctx, dp1, handler.callOptions...)
+		}
+		return err
+	})
+
+	return dp2, err
+}
+
 func (handler *clusterRedirectionHandler) DescribeTaskList(ctx context.Context, dp1 *types.DescribeTaskListRequest) (dp2 *types.DescribeTaskListResponse, err error) {
 	var (
 		apiName                   = "DescribeTaskList"
@@ -622,6 +779,45 @@ func (handler *clusterRedirectionHandler) ListSchedules(ctx context.Context, lp1
 	return lp2, err
 }
 
+func (handler *clusterRedirectionHandler) ListSemaphores(ctx context.Context, lp1 *types.ListSemaphoresRequest) (lp2 *types.ListSemaphoresResponse, err error) {
+	var (
+		apiName                   = "ListSemaphores"
+		cluster                   string
+		requestedConsistencyLevel types.QueryConsistencyLevel = getRequestedConsistencyLevelFromContext(ctx)
+	)
+
+	var domainEntry *cache.DomainCacheEntry
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionListSemaphoresScope)
+	defer func() {
+		handler.afterCall(recover(), scope, startTime, domainEntry, cluster, &err)
+	}()
+
+	domainEntry, err = handler.domainCache.GetDomain(lp1.Domain)
+	if err != nil {
+		return nil, err
+	}
+
+	var actClSelPolicyForNewWF *types.ActiveClusterSelectionPolicy
+	var workflowExecution *types.WorkflowExecution
+
+	err = handler.redirectionPolicy.Redirect(ctx, domainEntry, workflowExecution, actClSelPolicyForNewWF, apiName, requestedConsistencyLevel, func(targetDC string) error {
+		cluster = targetDC
+		switch {
+		case targetDC == handler.currentClusterName:
+			lp2, err = handler.frontendHandler.ListSemaphores(ctx, lp1)
+		default:
+			remoteClient, clientErr := handler.GetRemoteFrontendClient(targetDC)
+			if clientErr != nil {
+				return clientErr
+			}
+			lp2, err = remoteClient.ListSemaphores(ctx, lp1, handler.callOptions...)
+		}
+		return err
+	})
+
+	return lp2, err
+}
+
 func (handler *clusterRedirectionHandler) ListTaskListPartitions(ctx context.Context, lp1 *types.ListTaskListPartitionsRequest) (lp2 *types.ListTaskListPartitionsResponse, err error) {
 	var (
 		apiName                   = "ListTaskListPartitions"
@@ -700,6 +896,46 @@ func (handler *clusterRedirectionHandler) ListWorkflowExecutions(ctx context.Con
 	return lp2, err
 }
 
+func (handler *clusterRedirectionHandler) PauseActivity(ctx context.Context, pp1 *types.PauseActivityRequest) (err error) {
+	var (
+		apiName                   = "PauseActivity"
+		cluster                   string
+		requestedConsistencyLevel types.QueryConsistencyLevel = getRequestedConsistencyLevelFromContext(ctx)
+	)
+
+	var domainEntry *cache.DomainCacheEntry
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionPauseActivityScope)
+	defer func() {
+		handler.afterCall(recover(), scope, startTime, domainEntry, cluster, &err)
+	}()
+
+	domainEntry, err = handler.domainCache.GetDomain(pp1.Domain)
+	if err != nil {
+		return err
+	}
+
+	var actClSelPolicyForNewWF *types.ActiveClusterSelectionPolicy
+	var workflowExecution *types.WorkflowExecution
+	workflowExecution = pp1.GetWorkflowExecution()
+
+	err = handler.redirectionPolicy.Redirect(ctx, domainEntry, workflowExecution, actClSelPolicyForNewWF, apiName, requestedConsistencyLevel, func(targetDC string) error {
+		cluster = targetDC
+		switch {
+		case targetDC == handler.currentClusterName:
+			err = handler.frontendHandler.PauseActivity(ctx, pp1)
+		default:
+			remoteClient, clientErr := handler.GetRemoteFrontendClient(targetDC)
+			if clientErr != nil {
+				return clientErr
+			}
+			err = remoteClient.PauseActivity(ctx, pp1, handler.callOptions...)
+		}
+		return err
+	})
+
+	return err
+}
+
 func (handler *clusterRedirectionHandler) PauseSchedule(ctx context.Context, pp1 *types.PauseScheduleRequest) (pp2 *types.PauseScheduleResponse, err error) {
 	var (
 		apiName                   = "PauseSchedule"
@@ -739,6 +975,46 @@ func (handler *clusterRedirectionHandler) PauseSchedule(ctx context.Context, pp1
 	return pp2, err
 }
 
+func (handler *clusterRedirectionHandler) PauseWorkflowExecution(ctx context.Context, pp1 *types.PauseWorkflowExecutionRequest) (err error) {
+	var (
+		apiName                   = "PauseWorkflowExecution"
+		cluster                   string
+		requestedConsistencyLevel types.QueryConsistencyLevel = getRequestedConsistencyLevelFromContext(ctx)
+	)
+
+	var domainEntry *cache.DomainCacheEntry
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionPauseWorkflowExecutionScope)
+	defer func() {
+		handler.afterCall(recover(), scope, startTime, domainEntry, cluster, &err)
+	}()
+
+	domainEntry, err = handler.domainCache.GetDomain(pp1.Domain)
+	if err != nil {
+		return err
+	}
+
+	var actClSelPolicyForNewWF *types.ActiveClusterSelectionPolicy
+	var workflowExecution *types.WorkflowExecution
+	workflowExecution = pp1.GetWorkflowExecution()
+
+	err = handler.redirectionPolicy.Redirect(ctx, domainEntry, workflowExecution, actClSelPolicyForNewWF, apiName, requestedConsistencyLevel, func(targetDC string) error {
+		cluster = targetDC
+		switch {
+		case targetDC == handler.currentClusterName:
+			err = handler.frontendHandler.PauseWorkflowExecution(ctx, pp1)
+		default:
+			remoteClient, clientErr := handler.GetRemoteFrontendClient(targetDC)
+			if clientErr != nil {
+				return clientErr
+			}
+			err = remoteClient.PauseWorkflowExecution(ctx, pp1, handler.callOptions...)
+		}
+		return err
+	})
+
+	return err
+}
+
 func (handler *clusterRedirectionHandler) PollForActivityTask(ctx context.Context, pp1 *types.PollForActivityTaskRequest) (pp2 *types.PollForActivityTaskResponse, err error) {
 	var (
 		apiName                   = "PollForActivityTask"
@@ -1028,6 +1304,46 @@ func (handler *clusterRedirectionHandler) RequestCancelWorkflowExecution(ctx con
 	return err
 }
 
+func (handler *clusterRedirectionHandler) ResetActivityAttempt(ctx context.Context, rp1 *types.ResetActivityAttemptRequest) (err error) {
+	var (
+		apiName                   = "ResetActivityAttempt"
+		cluster                   string
+		requestedConsistencyLevel types.QueryConsistencyLevel = getRequestedConsistencyLevelFromContext(ctx)
+	)
+
+	var domainEntry *cache.DomainCacheEntry
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionResetActivityAttemptScope)
+	defer func() {
+		handler.afterCall(recover(), scope, startTime, domainEntry, cluster, &err)
+	}()
+
+	domainEntry, err = handler.domainCache.GetDomain(rp1.Domain)
+	if err != nil {
+		return err
+	}
+
+	var actClSelPolicyForNewWF *types.ActiveClusterSelectionPolicy
+	var workflowExecution *types.WorkflowExecution
+	workflowExecution = rp1.GetWorkflowExecution()
+
+	err = handler.redirectionPolicy.Redirect(ctx, domainEntry, workflowExecution, actClSelPolicyForNewWF, apiName, requestedConsistencyLevel, func(targetDC string) error {
+		cluster = targetDC
+		switch {
+		case targetDC == handler.currentClusterName:
+			err = handler.frontendHandler.ResetActivityAttempt(ctx, rp1)
+		default:
+			remoteClient, clientErr := handler.GetRemoteFrontendClient(targetDC)
+			if clientErr != nil {
+				return clientErr
+			}
+			err = remoteClient.ResetActivityAttempt(ctx, rp1, handler.callOptions...)
+		}
+		return err
+	})
+
+	return err
+}
+
 func (handler *clusterRedirectionHandler) ResetStickyTaskList(ctx context.Context, rp1 *types.ResetStickyTaskListRequest) (rp2 *types.ResetStickyTaskListResponse, err error) {
 	var (
 		apiName                   = "ResetStickyTaskList"
@@ -1528,6 +1844,46 @@ func (handler *clusterRedirectionHandler) RestartWorkflowExecution(ctx context.C
 	return rp2, err
 }
 
+func (handler *clusterRedirectionHandler) RetryActivityNow(ctx context.Context, rp1 *types.RetryActivityNowRequest) (err error) {
+	var (
+		apiName                   = "RetryActivityNow"
+		cluster                   string
+		requestedConsistencyLevel types.QueryConsistencyLevel = getRequestedConsistencyLevelFromContext(ctx)
+	)
+
+	var domainEntry *cache.DomainCacheEntry
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionRetryActivityNowScope)
+	defer func() {
+		handler.afterCall(recover(), scope, startTime, domainEntry, cluster, &err)
+	}()
+
+	domainEntry, err = handler.domainCache.GetDomain(rp1.Domain)
+	if err != nil {
+		return err
+	}
+
+	var actClSelPolicyForNewWF *types.ActiveClusterSelectionPolicy
+	var workflowExecution *types.WorkflowExecution
+	workflowExecution = rp1.GetWorkflowExecution()
+
+	err = handler.redirectionPolicy.Redirect(ctx, domainEntry, workflowExecution, actClSelPolicyForNewWF, apiName, requestedConsistencyLevel, func(targetDC string) error {
+		cluster = targetDC
+		switch {
+		case targetDC == handler.currentClusterName:
+			err = handler.frontendHandler.RetryActivityNow(ctx, rp1)
+		default:
+			remoteClient, clientErr := handler.GetRemoteFrontendClient(targetDC)
+			if clientErr != nil {
+				return clientErr
+			}
+			err = remoteClient.RetryActivityNow(ctx, rp1, handler.callOptions...)
+		}
+		return err
+	})
+
+	return err
+}
+
 func (handler *clusterRedirectionHandler) ScanWorkflowExecutions(ctx context.Context, lp1 *types.ListWorkflowExecutionsRequest) (lp2 *types.ListWorkflowExecutionsResponse, err error) {
 	var (
 		apiName                   = "ScanWorkflowExecutions"
@@ -1822,6 +2178,85 @@ func (handler *clusterRedirectionHandler) TerminateWorkflowExecution(ctx context
 	return err
 }
 
+func (handler *clusterRedirectionHandler) TriggerSchedule(ctx context.Context, tp1 *types.TriggerScheduleRequest) (tp2 *types.TriggerScheduleResponse, err error) {
+	var (
+		apiName                   = "TriggerSchedule"
+		cluster                   string
+		requestedConsistencyLevel types.QueryConsistencyLevel = getRequestedConsistencyLevelFromContext(ctx)
+	)
+
+	var domainEntry *cache.DomainCacheEntry
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionTriggerScheduleScope)
+	defer func() {
+		handler.afterCall(recover(), scope, startTime, domainEntry, cluster, &err)
+	}()
+
+	domainEntry, err = handler.domainCache.GetDomain(tp1.Domain)
+	if err != nil {
+		return nil, err
+	}
+
+	var actClSelPolicyForNewWF *types.ActiveClusterSelectionPolicy
+	var workflowExecution *types.WorkflowExecution
+
+	err = handler.redirectionPolicy.Redirect(ctx, domainEntry, workflowExecution, actClSelPolicyForNewWF, apiName, requestedConsistencyLevel, func(targetDC string) error {
+		cluster = targetDC
+		switch {
+		case targetDC == handler.currentClusterName:
+			tp2, err = handler.frontendHandler.TriggerSchedule(ctx, tp1)
+		default:
+			remoteClient, clientErr := handler.GetRemoteFrontendClient(targetDC)
+			if clientErr != nil {
+				return clientErr
+			}
+			tp2, err = remoteClient.TriggerSchedule(ctx, tp1, handler.callOptions...)
+		}
+		return err
+	})
+
+	return tp2, err
+}
+
+func (handler *clusterRedirectionHandler) UnpauseActivity(ctx context.Context, up1 *types.UnpauseActivityRequest) (err error) {
+	var (
+		apiName                   = "UnpauseActivity"
+		cluster                   string
+		requestedConsistencyLevel types.QueryConsistencyLevel = getRequestedConsistencyLevelFromContext(ctx)
+	)
+
+	var domainEntry *cache.DomainCacheEntry
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionUnpauseActivityScope)
+	defer func() {
+		handler.afterCall(recover(), scope, startTime, domainEntry, cluster, &err)
+	}()
+
+	domainEntry, err = handler.domainCache.GetDomain(up1.Domain)
+	if err != nil {
+		return err
+	}
+
+	var actClSelPolicyForNewWF *types.ActiveClusterSelectionPolicy
+	var workflowExecution *types.WorkflowExecution
+	workflowExecution = up1.GetWorkflowExecution()
+
+	err = handler.redirectionPolicy.Redirect(ctx, domainEntry, workflowExecution, actClSelPolicyForNewWF, apiName, requestedConsistencyLevel, func(targetDC string) error {
+		cluster = targetDC
+		switch {
+		case targetDC == handler.currentClusterName:
+			err = handler.frontendHandler.UnpauseActivity(ctx, up1)
+		default:
+			remoteClient, clientErr := handler.GetRemoteFrontendClient(targetDC)
+			if clientErr != nil {
+				return clientErr
+			}
+			err = remoteClient.UnpauseActivity(ctx, up1, handler.callOptions...)
+		}
+		return err
+	})
+
+	return err
+}
+
 func (handler *clusterRedirectionHandler) UnpauseSchedule(ctx context.Context, up1 *types.UnpauseScheduleRequest) (up2 *types.UnpauseScheduleResponse, err error) {
 	var (
 		apiName                   = "UnpauseSchedule"
@@ -1861,6 +2296,46 @@ func (handler *clusterRedirectionHandler) UnpauseSchedule(ctx context.Context, u
 	return up2, err
 }
 
+func (handler *clusterRedirectionHandler) UnpauseWorkflowExecution(ctx context.Context, up1 *types.UnpauseWorkflowExecutionRequest) (err error) {
+	var (
+		apiName                   = "UnpauseWorkflowExecution"
+		cluster                   string
+		requestedConsistencyLevel types.QueryConsistencyLevel = getRequestedConsistencyLevelFromContext(ctx)
+	)
+
+	var domainEntry *cache.DomainCacheEntry
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionUnpauseWorkflowExecutionScope)
+	defer func() {
+		handler.afterCall(recover(), scope, startTime, domainEntry, cluster, &err)
+	}()
+
+	domainEntry, err = handler.domainCache.GetDomain(up1.Domain)
+	if err != nil {
+		return err
+	}
+
+	var actClSelPolicyForNewWF *types.ActiveClusterSelectionPolicy
+	var workflowExecution *types.WorkflowExecution
+	workflowExecution = up1.GetWorkflowExecution()
+
+	err = handler.redirectionPolicy.Redirect(ctx, domainEntry, workflowExecution, actClSelPolicyForNewWF, apiName, requestedConsistencyLevel, func(targetDC string) error {
+		cluster = targetDC
+		switch {
+		case targetDC == handler.currentClusterName:
+			err = handler.frontendHandler.UnpauseWorkflowExecution(ctx, up1)
+		default:
+			remoteClient, clientErr := handler.GetRemoteFrontendClient(targetDC)
+			if clientErr != nil {
+				return clientErr
+			}
+			err = remoteClient.UnpauseWorkflowExecution(ctx, up1, handler.callOptions...)
+		}
+		return err
+	})
+
+	return err
+}
+
 func (handler *clusterRedirectionHandler) UpdateDomain(ctx context.Context, up1 *types.UpdateDomainRequest) (up2 *types.UpdateDomainResponse, err error) {
 	return handler.frontendHandler.UpdateDomain(ctx, up1)
 }
@@ -1903,3 +2378,43 @@ func (handler *clusterRedirectionHandler) UpdateSchedule(ctx context.Context, up
 
 	return up2, err
 }
+
+func (handler *clusterRedirectionHandler) UpdateWorkflowExecution(ctx context.Context, up1 *types.UpdateWorkflowExecutionRequest) (up2 *types.UpdateWorkflowExecutionResponse, err error) {
+	var (
+		apiName                   = "UpdateWorkflowExecution"
+		cluster                   string
+		requestedConsistencyLevel types.QueryConsistencyLevel = getRequestedConsistencyLevelFromContext(ctx)
+	)
+
+	var domainEntry *cache.DomainCacheEntry
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionUpdateWorkflowExecutionScope)
+	defer func() {
+		handler.afterCall(recover(), scope, startTime, domainEntry, cluster, &err)
+	}()
+
+	domainEntry, err = handler.domainCache.GetDomain(up1.Domain)
+	if err != nil {
+		return nil, err
+	}
+
+	var actClSelPolicyForNewWF *types.ActiveClusterSelectionPolicy
+	var workflowExecution *types.WorkflowExecution
+	workflowExecution = up1.GetWorkflowExecution()
+
+	err = handler.redirectionPolicy.Redirect(ctx, domainEntry, workflowExecution, actClSelPolicyForNewWF, apiName, requestedConsistencyLevel, func(targetDC string) error {
+		cluster = targetDC
+		switch {
+		case targetDC == handler.currentClusterName:
+			up2, err = handler.frontendHandler.UpdateWorkflowExecution(ctx, up1)
+		default:
+			remoteClient, clientErr := handler.GetRemoteFrontendClient(targetDC)
+			if clientErr != nil {
+				return clientErr
+			}
+			up2, err = remoteClient.UpdateWorkflowExecution(ctx, up1, handler.callOptions...)
+		}
+		return err
+	})
+
+	return up2, err
+}
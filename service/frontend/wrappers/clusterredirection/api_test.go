@@ -428,6 +428,59 @@ func (s *clusterRedirectionHandlerSuite) TestCountWorkflowExecutions() {
 	s.Equal(&types.CountWorkflowExecutionsResponse{}, resp)
 }
 
+func (s *clusterRedirectionHandlerSuite) TestAggregateWorkflowExecutions() {
+	apiName := "AggregateWorkflowExecutions"
+
+	ctx := context.Background()
+	req := &types.AggregateWorkflowExecutionsRequest{
+		Domain:  s.domainName,
+		GroupBy: "WorkflowType",
+	}
+	s.mockClusterRedirectionPolicy.EXPECT().Redirect(ctx, s.domainCacheEntry, nil, nil, apiName, types.QueryConsistencyLevelEventual, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, domainCacheEntry *cache.DomainCacheEntry, wfExec *types.WorkflowExecution, selPlcy *types.ActiveClusterSelectionPolicy, apiName string, consistencyLevel types.QueryConsistencyLevel, callFn func(targetDC string) error) error {
+			// validate callFn logic
+			s.mockFrontendHandler.EXPECT().AggregateWorkflowExecutions(ctx, req).Return(&types.AggregateWorkflowExecutionsResponse{}, nil).Times(1)
+			err := callFn(s.currentClusterName)
+			s.Nil(err)
+			s.mockRemoteFrontendClient.EXPECT().AggregateWorkflowExecutions(ctx, req, s.handler.callOptions).Return(&types.AggregateWorkflowExecutionsResponse{}, nil).Times(1)
+			err = callFn(s.alternativeClusterName)
+			s.Nil(err)
+			return nil
+		}).
+		Times(1)
+
+	resp, err := s.handler.AggregateWorkflowExecutions(ctx, req)
+	s.Nil(err)
+	s.Equal(&types.AggregateWorkflowExecutionsResponse{}, resp)
+}
+
+func (s *clusterRedirectionHandlerSuite) TestCreateSemaphore() {
+	apiName := "CreateSemaphore"
+
+	ctx := context.Background()
+	req := &types.CreateSemaphoreRequest{
+		Domain:        s.domainName,
+		SemaphoreName: "some random semaphore name",
+		Size:          2,
+	}
+	s.mockClusterRedirectionPolicy.EXPECT().Redirect(ctx, s.domainCacheEntry, nil, nil, apiName, types.QueryConsistencyLevelEventual, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, domainCacheEntry *cache.DomainCacheEntry, wfExec *types.WorkflowExecution, selPlcy *types.ActiveClusterSelectionPolicy, apiName string, consistencyLevel types.QueryConsistencyLevel, callFn func(targetDC string) error) error {
+			// validate callFn logic
+			s.mockFrontendHandler.EXPECT().CreateSemaphore(ctx, req).Return(&types.CreateSemaphoreResponse{}, nil).Times(1)
+			err := callFn(s.currentClusterName)
+			s.Nil(err)
+			s.mockRemoteFrontendClient.EXPECT().CreateSemaphore(ctx, req, s.handler.callOptions).Return(&types.CreateSemaphoreResponse{}, nil).Times(1)
+			err = callFn(s.alternativeClusterName)
+			s.Nil(err)
+			return nil
+		}).
+		Times(1)
+
+	resp, err := s.handler.CreateSemaphore(ctx, req)
+	s.Nil(err)
+	s.Equal(&types.CreateSemaphoreResponse{}, resp)
+}
+
 func (s *clusterRedirectionHandlerSuite) TestPollForActivityTask() {
 	apiName := "PollForActivityTask"
 
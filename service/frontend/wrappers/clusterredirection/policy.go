@@ -50,6 +50,14 @@ const (
 	// 4. RequestCancelWorkflowExecution
 	// 5. TerminateWorkflowExecution
 	// 6. ResetWorkflow
+	// 7. UpdateWorkflowExecution
+	// 8. PauseWorkflowExecution
+	// 9. UnpauseWorkflowExecution
+	// 10. PauseActivity
+	// 11. UnpauseActivity
+	// 12. ResetActivityAttempt
+	// 13. RetryActivityNow
+	// 14. DeleteWorkflowExecution
 	// please also reference selectedAPIsForwardingRedirectionPolicyAPIAllowlist and DCRedirectionPolicySelectedAPIsForwardingV2
 	DCRedirectionPolicySelectedAPIsForwarding = "selected-apis-forwarding"
 	// DCRedirectionPolicySelectedAPIsForwardingV2 forwards everything in DCRedirectionPolicySelectedAPIsForwarding,
@@ -124,6 +132,14 @@ var selectedAPIsForwardingRedirectionPolicyAPIAllowlist = map[string]struct{}{
 	"StartWorkflowExecution":           {},
 	"SignalWithStartWorkflowExecution": {},
 	"SignalWorkflowExecution":          {},
+	"UpdateWorkflowExecution":          {},
+	"PauseWorkflowExecution":           {},
+	"UnpauseWorkflowExecution":         {},
+	"PauseActivity":                    {},
+	"UnpauseActivity":                  {},
+	"ResetActivityAttempt":             {},
+	"RetryActivityNow":                 {},
+	"DeleteWorkflowExecution":          {},
 	"RequestCancelWorkflowExecution":   {},
 	"TerminateWorkflowExecution":       {},
 	"ResetWorkflowExecution":           {},
@@ -134,6 +150,9 @@ var selectedAPIsForwardingRedirectionPolicyAPIAllowlist = map[string]struct{}{
 	"PauseSchedule":    {},
 	"UnpauseSchedule":  {},
 	"BackfillSchedule": {},
+	"TriggerSchedule":  {},
+	// semaphore write APIs — reads (DescribeSemaphore, ListSemaphores) are served locally on standby
+	"CreateSemaphore": {},
 }
 
 // selectedAPIsForwardingRedirectionPolicyAPIAllowlistV2 contains a list of non-worker APIs which can be redirected.
@@ -143,6 +162,14 @@ var selectedAPIsForwardingRedirectionPolicyAPIAllowlistV2 = map[string]struct{}{
 	"StartWorkflowExecution":           {},
 	"SignalWithStartWorkflowExecution": {},
 	"SignalWorkflowExecution":          {},
+	"UpdateWorkflowExecution":          {},
+	"PauseWorkflowExecution":           {},
+	"UnpauseWorkflowExecution":         {},
+	"PauseActivity":                    {},
+	"UnpauseActivity":                  {},
+	"ResetActivityAttempt":             {},
+	"RetryActivityNow":                 {},
+	"DeleteWorkflowExecution":          {},
 	"RequestCancelWorkflowExecution":   {},
 	"TerminateWorkflowExecution":       {},
 	"ResetWorkflowExecution":           {},
@@ -160,14 +187,18 @@ var selectedAPIsForwardingRedirectionPolicyAPIAllowlistV2 = map[string]struct{}{
 	"PauseSchedule":    {},
 	"UnpauseSchedule":  {},
 	"BackfillSchedule": {},
+	"TriggerSchedule":  {},
+	// semaphore write APIs — reads (DescribeSemaphore, ListSemaphores) are served locally on standby
+	"CreateSemaphore": {},
 }
 
 // allowedAPIsForDeprecatedDomains contains a list of APIs that are allowed to be called on deprecated domains
 var allowedAPIsForDeprecatedDomains = map[string]struct{}{
-	"ListWorkflowExecutions":     {},
-	"CountWorkflowExecutions":    {},
-	"ScanWorkflowExecutions":     {},
-	"TerminateWorkflowExecution": {},
+	"ListWorkflowExecutions":      {},
+	"CountWorkflowExecutions":     {},
+	"AggregateWorkflowExecutions": {},
+	"ScanWorkflowExecutions":      {},
+	"TerminateWorkflowExecution":  {},
 }
 
 // RedirectionPolicyGenerator generate corresponding redirection policy
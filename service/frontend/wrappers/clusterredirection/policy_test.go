@@ -131,6 +131,7 @@ func (s *noopDCRedirectionPolicySuite) TestWithDomainRedirectForAllowedAPIs() {
 	allowedAPIs := []string{
 		"ListWorkflowExecutions",
 		"CountWorkflowExecutions",
+		"AggregateWorkflowExecutions",
 		"ScanWorkflowExecutions",
 		"TerminateWorkflowExecution",
 	}
@@ -40,6 +40,24 @@ func NewAPIHandler(handler _sourceApi.Handler, logger log.Logger, metricsClient
 	}
 }
 
+func (h *apiHandler) AggregateWorkflowExecutions(ctx context.Context, ap1 *types.AggregateWorkflowExecutionsRequest) (ap2 *types.AggregateWorkflowExecutionsResponse, err error) {
+	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
+	tags := []tag.Tag{tag.WorkflowHandlerName("AggregateWorkflowExecutions")}
+	tags = append(tags, toAggregateWorkflowExecutionsRequestTags(ap1)...)
+	scope := h.metricsClient.Scope(metrics.FrontendAggregateWorkflowExecutionsScope).Tagged(append(metrics.GetContextTags(ctx), metrics.DomainTag(ap1.GetDomain()))...)
+	scope.IncCounter(metrics.CadenceRequests)
+	swStart := time.Now()
+	sw := scope.StartTimer(metrics.CadenceLatency)
+	defer func() { sw.Stop(); scope.ExponentialHistogram(metrics.CadenceLatencyHistogram, time.Since(swStart)) }()
+	logger := h.logger.WithTags(tags...)
+
+	ap2, err = h.handler.AggregateWorkflowExecutions(ctx, ap1)
+	if err != nil {
+		return nil, h.handleErr(err, scope, logger)
+	}
+	return ap2, err
+}
+
 func (h *apiHandler) BackfillSchedule(ctx context.Context, bp1 *types.BackfillScheduleRequest) (bp2 *types.BackfillScheduleResponse, err error) {
 	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
 	tags := []tag.Tag{tag.WorkflowHandlerName("BackfillSchedule")}
@@ -91,6 +109,23 @@ func (h *apiHandler) CreateSchedule(ctx context.Context, cp1 *types.CreateSchedu
 	}
 	return cp2, err
 }
+func (h *apiHandler) CreateSemaphore(ctx context.Context, cp1 *types.CreateSemaphoreRequest) (cp2 *types.CreateSemaphoreResponse, err error) {
+	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
+	tags := []tag.Tag{tag.WorkflowHandlerName("CreateSemaphore")}
+	tags = append(tags, toCreateSemaphoreRequestTags(cp1)...)
+	scope := h.metricsClient.Scope(metrics.FrontendCreateSemaphoreScope).Tagged(append(metrics.GetContextTags(ctx), metrics.DomainTag(cp1.GetDomain()))...)
+	scope.IncCounter(metrics.CadenceRequests)
+	swStart := time.Now()
+	sw := scope.StartTimer(metrics.CadenceLatency)
+	defer func() { sw.Stop(); scope.ExponentialHistogram(metrics.CadenceLatencyHistogram, time.Since(swStart)) }()
+	logger := h.logger.WithTags(tags...)
+
+	cp2, err = h.handler.CreateSemaphore(ctx, cp1)
+	if err != nil {
+		return nil, h.handleErr(err, scope, logger)
+	}
+	return cp2, err
+}
 func (h *apiHandler) DeleteDomain(ctx context.Context, dp1 *types.DeleteDomainRequest) (err error) {
 	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
 	tags := []tag.Tag{tag.WorkflowHandlerName("DeleteDomain")}
@@ -124,6 +159,24 @@ func (h *apiHandler) DeleteSchedule(ctx context.Context, dp1 *types.DeleteSchedu
 	}
 	return dp2, err
 }
+func (h *apiHandler) DeleteWorkflowExecution(ctx context.Context, dp1 *types.DeleteWorkflowExecutionRequest) (err error) {
+	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
+	tags := []tag.Tag{tag.WorkflowHandlerName("DeleteWorkflowExecution")}
+	tags = append(tags, toDeleteWorkflowExecutionRequestTags(dp1)...)
+	scope := h.metricsClient.Scope(metrics.FrontendDeleteWorkflowExecutionScope).Tagged(append(metrics.GetContextTags(ctx), metrics.DomainTag(dp1.GetDomain()))...)
+	scope.IncCounter(metrics.CadenceRequests)
+	swStart := time.Now()
+	sw := scope.StartTimer(metrics.CadenceLatency)
+	defer func() { sw.Stop(); scope.ExponentialHistogram(metrics.CadenceLatencyHistogram, time.Since(swStart)) }()
+	logger := h.logger.WithTags(tags...)
+
+	err = h.handler.DeleteWorkflowExecution(ctx, dp1)
+	if err != nil {
+		return h.handleErr(err, scope, logger)
+	}
+	return err
+}
+
 func (h *apiHandler) DeprecateDomain(ctx context.Context, dp1 *types.DeprecateDomainRequest) (err error) {
 	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
 	tags := []tag.Tag{tag.WorkflowHandlerName("DeprecateDomain")}
@@ -173,6 +226,23 @@ func (h *apiHandler) DescribeSchedule(ctx context.Context, dp1 *types.DescribeSc
 	}
 	return dp2, err
 }
+func (h *apiHandler) DescribeSemaphore(ctx context.Context, dp1 *types.DescribeSemaphoreRequest) (dp2 *types.DescribeSemaphoreResponse, err error) {
+	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
+	tags := []tag.Tag{tag.WorkflowHandlerName("DescribeSemaphore")}
+	tags = append(tags, toDescribeSemaphoreRequestTags(dp1)...)
+	scope := h.metricsClient.Scope(metrics.FrontendDescribeSemaphoreScope).Tagged(append(metrics.GetContextTags(ctx), metrics.DomainTag(dp1.GetDomain()))...)
+	scope.IncCounter(metrics.CadenceRequests)
+	swStart := time.Now()
+	sw := scope.StartTimer(metrics.CadenceLatency)
+	defer func() { sw.Stop(); scope.ExponentialHistogram(metrics.CadenceLatencyHistogram, time.Since(swStart)) }()
+	logger := h.logger.WithTags(tags...)
+
+	dp2, err = h.handler.DescribeSemaphore(ctx, dp1)
+	if err != nil {
+		return nil, h.handleErr(err, scope, logger)
+	}
+	return dp2, err
+}
 func (h *apiHandler) DescribeTaskList(ctx context.Context, dp1 *types.DescribeTaskListRequest) (dp2 *types.DescribeTaskListResponse, err error) {
 	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
 	tags := []tag.Tag{tag.WorkflowHandlerName("DescribeTaskList")}
@@ -421,6 +491,23 @@ func (h *apiHandler) ListSchedules(ctx context.Context, lp1 *types.ListSchedules
 	}
 	return lp2, err
 }
+func (h *apiHandler) ListSemaphores(ctx context.Context, lp1 *types.ListSemaphoresRequest) (lp2 *types.ListSemaphoresResponse, err error) {
+	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
+	tags := []tag.Tag{tag.WorkflowHandlerName("ListSemaphores")}
+	tags = append(tags, toListSemaphoresRequestTags(lp1)...)
+	scope := h.metricsClient.Scope(metrics.FrontendListSemaphoresScope).Tagged(append(metrics.GetContextTags(ctx), metrics.DomainTag(lp1.GetDomain()))...)
+	scope.IncCounter(metrics.CadenceRequests)
+	swStart := time.Now()
+	sw := scope.StartTimer(metrics.CadenceLatency)
+	defer func() { sw.Stop(); scope.ExponentialHistogram(metrics.CadenceLatencyHistogram, time.Since(swStart)) }()
+	logger := h.logger.WithTags(tags...)
+
+	lp2, err = h.handler.ListSemaphores(ctx, lp1)
+	if err != nil {
+		return nil, h.handleErr(err, scope, logger)
+	}
+	return lp2, err
+}
 func (h *apiHandler) ListTaskListPartitions(ctx context.Context, lp1 *types.ListTaskListPartitionsRequest) (lp2 *types.ListTaskListPartitionsResponse, err error) {
 	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
 	tags := []tag.Tag{tag.WorkflowHandlerName("ListTaskListPartitions")}
@@ -466,6 +553,24 @@ func (h *apiHandler) ListWorkflowExecutions(ctx context.Context, lp1 *types.List
 	}
 	return lp2, err
 }
+func (h *apiHandler) PauseActivity(ctx context.Context, pp1 *types.PauseActivityRequest) (err error) {
+	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
+	tags := []tag.Tag{tag.WorkflowHandlerName("PauseActivity")}
+	tags = append(tags, toPauseActivityRequestTags(pp1)...)
+	scope := h.metricsClient.Scope(metrics.FrontendPauseActivityScope).Tagged(append(metrics.GetContextTags(ctx), metrics.DomainTag(pp1.GetDomain()))...)
+	scope.IncCounter(metrics.CadenceRequests)
+	swStart := time.Now()
+	sw := scope.StartTimer(metrics.CadenceLatency)
+	defer func() { sw.Stop(); scope.ExponentialHistogram(metrics.CadenceLatencyHistogram, time.Since(swStart)) }()
+	logger := h.logger.WithTags(tags...)
+
+	err = h.handler.PauseActivity(ctx, pp1)
+	if err != nil {
+		return h.handleErr(err, scope, logger)
+	}
+	return err
+}
+
 func (h *apiHandler) PauseSchedule(ctx context.Context, pp1 *types.PauseScheduleRequest) (pp2 *types.PauseScheduleResponse, err error) {
 	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
 	tags := []tag.Tag{tag.WorkflowHandlerName("PauseSchedule")}
@@ -483,6 +588,24 @@ func (h *apiHandler) PauseSchedule(ctx context.Context, pp1 *types.PauseSchedule
 	}
 	return pp2, err
 }
+func (h *apiHandler) PauseWorkflowExecution(ctx context.Context, pp1 *types.PauseWorkflowExecutionRequest) (err error) {
+	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
+	tags := []tag.Tag{tag.WorkflowHandlerName("PauseWorkflowExecution")}
+	tags = append(tags, toPauseWorkflowExecutionRequestTags(pp1)...)
+	scope := h.metricsClient.Scope(metrics.FrontendPauseWorkflowExecutionScope).Tagged(append(metrics.GetContextTags(ctx), metrics.DomainTag(pp1.GetDomain()))...)
+	scope.IncCounter(metrics.CadenceRequests)
+	swStart := time.Now()
+	sw := scope.StartTimer(metrics.CadenceLatency)
+	defer func() { sw.Stop(); scope.ExponentialHistogram(metrics.CadenceLatencyHistogram, time.Since(swStart)) }()
+	logger := h.logger.WithTags(tags...)
+
+	err = h.handler.PauseWorkflowExecution(ctx, pp1)
+	if err != nil {
+		return h.handleErr(err, scope, logger)
+	}
+	return err
+}
+
 func (h *apiHandler) PollForActivityTask(ctx context.Context, pp1 *types.PollForActivityTaskRequest) (pp2 *types.PollForActivityTaskResponse, err error) {
 	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
 	tags := []tag.Tag{tag.WorkflowHandlerName("PollForActivityTask")}
@@ -648,6 +771,24 @@ func (h *apiHandler) RequestCancelWorkflowExecution(ctx context.Context, rp1 *ty
 	}
 	return err
 }
+func (h *apiHandler) ResetActivityAttempt(ctx context.Context, rp1 *types.ResetActivityAttemptRequest) (err error) {
+	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
+	tags := []tag.Tag{tag.WorkflowHandlerName("ResetActivityAttempt")}
+	tags = append(tags, toResetActivityAttemptRequestTags(rp1)...)
+	scope := h.metricsClient.Scope(metrics.FrontendResetActivityAttemptScope).Tagged(append(metrics.GetContextTags(ctx), metrics.DomainTag(rp1.GetDomain()))...)
+	scope.IncCounter(metrics.CadenceRequests)
+	swStart := time.Now()
+	sw := scope.StartTimer(metrics.CadenceLatency)
+	defer func() { sw.Stop(); scope.ExponentialHistogram(metrics.CadenceLatencyHistogram, time.Since(swStart)) }()
+	logger := h.logger.WithTags(tags...)
+
+	err = h.handler.ResetActivityAttempt(ctx, rp1)
+	if err != nil {
+		return h.handleErr(err, scope, logger)
+	}
+	return err
+}
+
 func (h *apiHandler) ResetStickyTaskList(ctx context.Context, rp1 *types.ResetStickyTaskListRequest) (rp2 *types.ResetStickyTaskListResponse, err error) {
 	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
 	tags := []tag.Tag{tag.WorkflowHandlerName("ResetStickyTaskList")}
@@ -900,6 +1041,24 @@ func (h *apiHandler) RestartWorkflowExecution(ctx context.Context, rp1 *types.Re
 	}
 	return rp2, err
 }
+func (h *apiHandler) RetryActivityNow(ctx context.Context, rp1 *types.RetryActivityNowRequest) (err error) {
+	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
+	tags := []tag.Tag{tag.WorkflowHandlerName("RetryActivityNow")}
+	tags = append(tags, toRetryActivityNowRequestTags(rp1)...)
+	scope := h.metricsClient.Scope(metrics.FrontendRetryActivityNowScope).Tagged(append(metrics.GetContextTags(ctx), metrics.DomainTag(rp1.GetDomain()))...)
+	scope.IncCounter(metrics.CadenceRequests)
+	swStart := time.Now()
+	sw := scope.StartTimer(metrics.CadenceLatency)
+	defer func() { sw.Stop(); scope.ExponentialHistogram(metrics.CadenceLatencyHistogram, time.Since(swStart)) }()
+	logger := h.logger.WithTags(tags...)
+
+	err = h.handler.RetryActivityNow(ctx, rp1)
+	if err != nil {
+		return h.handleErr(err, scope, logger)
+	}
+	return err
+}
+
 func (h *apiHandler) ScanWorkflowExecutions(ctx context.Context, lp1 *types.ListWorkflowExecutionsRequest) (lp2 *types.ListWorkflowExecutionsResponse, err error) {
 	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
 	tags := []tag.Tag{tag.WorkflowHandlerName("ScanWorkflowExecutions")}
@@ -1064,6 +1223,42 @@ func (h *apiHandler) TerminateWorkflowExecution(ctx context.Context, tp1 *types.
 	}
 	return err
 }
+func (h *apiHandler) TriggerSchedule(ctx context.Context, tp1 *types.TriggerScheduleRequest) (tp2 *types.TriggerScheduleResponse, err error) {
+	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
+	tags := []tag.Tag{tag.WorkflowHandlerName("TriggerSchedule")}
+	tags = append(tags, toTriggerScheduleRequestTags(tp1)...)
+	scope := h.metricsClient.Scope(metrics.FrontendTriggerScheduleScope).Tagged(append(metrics.GetContextTags(ctx), metrics.DomainTag(tp1.GetDomain()))...)
+	scope.IncCounter(metrics.CadenceRequests)
+	swStart := time.Now()
+	sw := scope.StartTimer(metrics.CadenceLatency)
+	defer func() { sw.Stop(); scope.ExponentialHistogram(metrics.CadenceLatencyHistogram, time.Since(swStart)) }()
+	logger := h.logger.WithTags(tags...)
+
+	tp2, err = h.handler.TriggerSchedule(ctx, tp1)
+	if err != nil {
+		return nil, h.handleErr(err, scope, logger)
+	}
+	return tp2, err
+}
+
+func (h *apiHandler) UnpauseActivity(ctx context.Context, up1 *types.UnpauseActivityRequest) (err error) {
+	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
+	tags := []tag.Tag{tag.WorkflowHandlerName("UnpauseActivity")}
+	tags = append(tags, toUnpauseActivityRequestTags(up1)...)
+	scope := h.metricsClient.Scope(metrics.FrontendUnpauseActivityScope).Tagged(append(metrics.GetContextTags(ctx), metrics.DomainTag(up1.GetDomain()))...)
+	scope.IncCounter(metrics.CadenceRequests)
+	swStart := time.Now()
+	sw := scope.StartTimer(metrics.CadenceLatency)
+	defer func() { sw.Stop(); scope.ExponentialHistogram(metrics.CadenceLatencyHistogram, time.Since(swStart)) }()
+	logger := h.logger.WithTags(tags...)
+
+	err = h.handler.UnpauseActivity(ctx, up1)
+	if err != nil {
+		return h.handleErr(err, scope, logger)
+	}
+	return err
+}
+
 func (h *apiHandler) UnpauseSchedule(ctx context.Context, up1 *types.UnpauseScheduleRequest) (up2 *types.UnpauseScheduleResponse, err error) {
 	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
 	tags := []tag.Tag{tag.WorkflowHandlerName("UnpauseSchedule")}
@@ -1081,6 +1276,24 @@ func (h *apiHandler) UnpauseSchedule(ctx context.Context, up1 *types.UnpauseSche
 	}
 	return up2, err
 }
+func (h *apiHandler) UnpauseWorkflowExecution(ctx context.Context, up1 *types.UnpauseWorkflowExecutionRequest) (err error) {
+	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
+	tags := []tag.Tag{tag.WorkflowHandlerName("UnpauseWorkflowExecution")}
+	tags = append(tags, toUnpauseWorkflowExecutionRequestTags(up1)...)
+	scope := h.metricsClient.Scope(metrics.FrontendUnpauseWorkflowExecutionScope).Tagged(append(metrics.GetContextTags(ctx), metrics.DomainTag(up1.GetDomain()))...)
+	scope.IncCounter(metrics.CadenceRequests)
+	swStart := time.Now()
+	sw := scope.StartTimer(metrics.CadenceLatency)
+	defer func() { sw.Stop(); scope.ExponentialHistogram(metrics.CadenceLatencyHistogram, time.Since(swStart)) }()
+	logger := h.logger.WithTags(tags...)
+
+	err = h.handler.UnpauseWorkflowExecution(ctx, up1)
+	if err != nil {
+		return h.handleErr(err, scope, logger)
+	}
+	return err
+}
+
 func (h *apiHandler) UpdateDomain(ctx context.Context, up1 *types.UpdateDomainRequest) (up2 *types.UpdateDomainResponse, err error) {
 	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
 	tags := []tag.Tag{tag.WorkflowHandlerName("UpdateDomain")}
@@ -1114,3 +1327,21 @@ func (h *apiHandler) UpdateSchedule(ctx context.Context, up1 *types.UpdateSchedu
 	}
 	return up2, err
 }
+
+func (h *apiHandler) UpdateWorkflowExecution(ctx context.Context, up1 *types.UpdateWorkflowExecutionRequest) (up2 *types.UpdateWorkflowExecutionResponse, err error) {
+	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
+	tags := []tag.Tag{tag.WorkflowHandlerName("UpdateWorkflowExecution")}
+	tags = append(tags, toUpdateWorkflowExecutionRequestTags(up1)...)
+	scope := h.metricsClient.Scope(metrics.FrontendUpdateWorkflowExecutionScope).Tagged(append(metrics.GetContextTags(ctx), metrics.DomainTag(up1.GetDomain()))...)
+	scope.IncCounter(metrics.CadenceRequests)
+	swStart := time.Now()
+	sw := scope.StartTimer(metrics.CadenceLatency)
+	defer func() { sw.Stop(); scope.ExponentialHistogram(metrics.CadenceLatencyHistogram, time.Since(swStart)) }()
+	logger := h.logger.WithTags(tags...)
+
+	up2, err = h.handler.UpdateWorkflowExecution(ctx, up1)
+	if err != nil {
+		return nil, h.handleErr(err, scope, logger)
+	}
+	return up2, err
+}
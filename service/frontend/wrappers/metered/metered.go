@@ -135,6 +135,12 @@ func toCountWorkflowExecutionsRequestTags(req *types.CountWorkflowExecutionsRequ
 	}
 }
 
+func toAggregateWorkflowExecutionsRequestTags(req *types.AggregateWorkflowExecutionsRequest) []tag.Tag {
+	return []tag.Tag{
+		tag.WorkflowDomainName(req.GetDomain()),
+	}
+}
+
 func toDescribeTaskListRequestTags(req *types.DescribeTaskListRequest) []tag.Tag {
 	return []tag.Tag{
 		tag.WorkflowDomainName(req.GetDomain()),
@@ -355,6 +361,75 @@ func toTerminateWorkflowExecutionRequestTags(req *types.TerminateWorkflowExecuti
 	}
 }
 
+func toUpdateWorkflowExecutionRequestTags(req *types.UpdateWorkflowExecutionRequest) []tag.Tag {
+	return []tag.Tag{
+		tag.WorkflowDomainName(req.GetDomain()),
+		tag.WorkflowID(req.GetWorkflowExecution().GetWorkflowID()),
+		tag.WorkflowRunID(req.GetWorkflowExecution().GetRunID()),
+		tag.WorkflowUpdateName(req.GetUpdateName()),
+	}
+}
+
+func toPauseWorkflowExecutionRequestTags(req *types.PauseWorkflowExecutionRequest) []tag.Tag {
+	return []tag.Tag{
+		tag.WorkflowDomainName(req.GetDomain()),
+		tag.WorkflowID(req.GetWorkflowExecution().GetWorkflowID()),
+		tag.WorkflowRunID(req.GetWorkflowExecution().GetRunID()),
+	}
+}
+
+func toUnpauseWorkflowExecutionRequestTags(req *types.UnpauseWorkflowExecutionRequest) []tag.Tag {
+	return []tag.Tag{
+		tag.WorkflowDomainName(req.GetDomain()),
+		tag.WorkflowID(req.GetWorkflowExecution().GetWorkflowID()),
+		tag.WorkflowRunID(req.GetWorkflowExecution().GetRunID()),
+	}
+}
+
+func toPauseActivityRequestTags(req *types.PauseActivityRequest) []tag.Tag {
+	return []tag.Tag{
+		tag.WorkflowDomainName(req.GetDomain()),
+		tag.WorkflowID(req.GetWorkflowExecution().GetWorkflowID()),
+		tag.WorkflowRunID(req.GetWorkflowExecution().GetRunID()),
+		tag.WorkflowActivityID(req.GetActivityID()),
+	}
+}
+
+func toUnpauseActivityRequestTags(req *types.UnpauseActivityRequest) []tag.Tag {
+	return []tag.Tag{
+		tag.WorkflowDomainName(req.GetDomain()),
+		tag.WorkflowID(req.GetWorkflowExecution().GetWorkflowID()),
+		tag.WorkflowRunID(req.GetWorkflowExecution().GetRunID()),
+		tag.WorkflowActivityID(req.GetActivityID()),
+	}
+}
+
+func toResetActivityAttemptRequestTags(req *types.ResetActivityAttemptRequest) []tag.Tag {
+	return []tag.Tag{
+		tag.WorkflowDomainName(req.GetDomain()),
+		tag.WorkflowID(req.GetWorkflowExecution().GetWorkflowID()),
+		tag.WorkflowRunID(req.GetWorkflowExecution().GetRunID()),
+		tag.WorkflowActivityID(req.GetActivityID()),
+	}
+}
+
+func toRetryActivityNowRequestTags(req *types.RetryActivityNowRequest) []tag.Tag {
+	return []tag.Tag{
+		tag.WorkflowDomainName(req.GetDomain()),
+		tag.WorkflowID(req.GetWorkflowExecution().GetWorkflowID()),
+		tag.WorkflowRunID(req.GetWorkflowExecution().GetRunID()),
+		tag.WorkflowActivityID(req.GetActivityID()),
+	}
+}
+
+func toDeleteWorkflowExecutionRequestTags(req *types.DeleteWorkflowExecutionRequest) []tag.Tag {
+	return []tag.Tag{
+		tag.WorkflowDomainName(req.GetDomain()),
+		tag.WorkflowID(req.GetWorkflowExecution().GetWorkflowID()),
+		tag.WorkflowRunID(req.GetWorkflowExecution().GetRunID()),
+	}
+}
+
 func toScanWorkflowExecutionsRequestTags(req *types.ListWorkflowExecutionsRequest) []tag.Tag {
 	return []tag.Tag{
 		tag.WorkflowDomainName(req.GetDomain()),
@@ -409,8 +484,32 @@ func toBackfillScheduleRequestTags(req *types.BackfillScheduleRequest) []tag.Tag
 	}
 }
 
+func toTriggerScheduleRequestTags(req *types.TriggerScheduleRequest) []tag.Tag {
+	return []tag.Tag{
+		tag.WorkflowDomainName(req.GetDomain()),
+	}
+}
+
 func toListSchedulesRequestTags(req *types.ListSchedulesRequest) []tag.Tag {
 	return []tag.Tag{
 		tag.WorkflowDomainName(req.GetDomain()),
 	}
 }
+
+func toCreateSemaphoreRequestTags(req *types.CreateSemaphoreRequest) []tag.Tag {
+	return []tag.Tag{
+		tag.WorkflowDomainName(req.GetDomain()),
+	}
+}
+
+func toDescribeSemaphoreRequestTags(req *types.DescribeSemaphoreRequest) []tag.Tag {
+	return []tag.Tag{
+		tag.WorkflowDomainName(req.GetDomain()),
+	}
+}
+
+func toListSemaphoresRequestTags(req *types.ListSemaphoresRequest) []tag.Tag {
+	return []tag.Tag{
+		tag.WorkflowDomainName(req.GetDomain()),
+	}
+}
@@ -53,6 +53,22 @@ func NewAPIHandler(
 	}
 }
 
+func (h *apiHandler) AggregateWorkflowExecutions(ctx context.Context, ap1 *types.AggregateWorkflowExecutionsRequest) (ap2 *types.AggregateWorkflowExecutionsResponse, err error) {
+	if ap1 == nil {
+		err = validate.ErrRequestNotSet
+		return
+	}
+	if ap1.GetDomain() == "" {
+		err = validate.ErrDomainNotSet
+		return
+	}
+	if limitErr := h.allowDomain(ctx, ratelimitTypeVisibility, quotas.Info{Domain: ap1.GetDomain()}); limitErr != nil {
+		err = limitErr
+		return
+	}
+	return h.wrapped.AggregateWorkflowExecutions(ctx, ap1)
+}
+
 func (h *apiHandler) BackfillSchedule(ctx context.Context, bp1 *types.BackfillScheduleRequest) (bp2 *types.BackfillScheduleResponse, err error) {
 	if bp1 == nil {
 		err = validate.ErrRequestNotSet
@@ -101,6 +117,22 @@ func (h *apiHandler) CreateSchedule(ctx context.Context, cp1 *types.CreateSchedu
 	return h.wrapped.CreateSchedule(ctx, cp1)
 }
 
+func (h *apiHandler) CreateSemaphore(ctx context.Context, cp1 *types.CreateSemaphoreRequest) (cp2 *types.CreateSemaphoreResponse, err error) {
+	if cp1 == nil {
+		err = validate.ErrRequestNotSet
+		return
+	}
+	if cp1.GetDomain() == "" {
+		err = validate.ErrDomainNotSet
+		return
+	}
+	if limitErr := h.allowDomain(ctx, ratelimitTypeUser, quotas.Info{Domain: cp1.GetDomain()}); limitErr != nil {
+		err = limitErr
+		return
+	}
+	return h.wrapped.CreateSemaphore(ctx, cp1)
+}
+
 func (h *apiHandler) DeleteDomain(ctx context.Context, dp1 *types.DeleteDomainRequest) (err error) {
 	return h.wrapped.DeleteDomain(ctx, dp1)
 }
@@ -121,6 +153,22 @@ func (h *apiHandler) DeleteSchedule(ctx context.Context, dp1 *types.DeleteSchedu
 	return h.wrapped.DeleteSchedule(ctx, dp1)
 }
 
+func (h *apiHandler) DeleteWorkflowExecution(ctx context.Context, dp1 *types.DeleteWorkflowExecutionRequest) (err error) {
+	if dp1 == nil {
+		err = validate.ErrRequestNotSet
+		return
+	}
+	if dp1.GetDomain() == "" {
+		err = validate.ErrDomainNotSet
+		return
+	}
+	if limitErr := h.allowDomain(ctx, ratelimitTypeUser, quotas.Info{Domain: dp1.GetDomain()}); limitErr != nil {
+		err = limitErr
+		return
+	}
+	return h.wrapped.DeleteWorkflowExecution(ctx, dp1)
+}
+
 func (h *apiHandler) DeprecateDomain(ctx context.Context, dp1 *types.DeprecateDomainRequest) (err error) {
 	return h.wrapped.DeprecateDomain(ctx, dp1)
 }
@@ -145,6 +193,22 @@ func (h *apiHandler) DescribeSchedule(ctx context.Context, dp1 *types.DescribeSc
 	return h.wrapped.DescribeSchedule(ctx, dp1)
 }
 
+func (h *apiHandler) DescribeSemaphore(ctx context.Context, dp1 *types.DescribeSemaphoreRequest) (dp2 *types.DescribeSemaphoreResponse, err error) {
+	if dp1 == nil {
+		err = validate.ErrRequestNotSet
+		return
+	}
+	if dp1.GetDomain() == "" {
+		err = validate.ErrDomainNotSet
+		return
+	}
+	if limitErr := h.allowDomain(ctx, ratelimitTypeUser, quotas.Info{Domain: dp1.GetDomain()}); limitErr != nil {
+		err = limitErr
+		return
+	}
+	return h.wrapped.DescribeSemaphore(ctx, dp1)
+}
+
 func (h *apiHandler) DescribeTaskList(ctx context.Context, dp1 *types.DescribeTaskListRequest) (dp2 *types.DescribeTaskListResponse, err error) {
 	if dp1 == nil {
 		err = validate.ErrRequestNotSet
@@ -325,6 +389,22 @@ func (h *apiHandler) ListSchedules(ctx context.Context, lp1 *types.ListSchedules
 	return h.wrapped.ListSchedules(ctx, lp1)
 }
 
+func (h *apiHandler) ListSemaphores(ctx context.Context, lp1 *types.ListSemaphoresRequest) (lp2 *types.ListSemaphoresResponse, err error) {
+	if lp1 == nil {
+		err = validate.ErrRequestNotSet
+		return
+	}
+	if lp1.GetDomain() == "" {
+		err = validate.ErrDomainNotSet
+		return
+	}
+	if limitErr := h.allowDomain(ctx, ratelimitTypeUser, quotas.Info{Domain: lp1.GetDomain()}); limitErr != nil {
+		err = limitErr
+		return
+	}
+	return h.wrapped.ListSemaphores(ctx, lp1)
+}
+
 func (h *apiHandler) ListTaskListPartitions(ctx context.Context, lp1 *types.ListTaskListPartitionsRequest) (lp2 *types.ListTaskListPartitionsResponse, err error) {
 	if lp1 == nil {
 		err = validate.ErrRequestNotSet
@@ -357,6 +437,22 @@ func (h *apiHandler) ListWorkflowExecutions(ctx context.Context, lp1 *types.List
 	return h.wrapped.ListWorkflowExecutions(ctx, lp1)
 }
 
+func (h *apiHandler) PauseActivity(ctx context.Context, pp1 *types.PauseActivityRequest) (err error) {
+	if pp1 == nil {
+		err = validate.ErrRequestNotSet
+		return
+	}
+	if pp1.GetDomain() == "" {
+		err = validate.ErrDomainNotSet
+		return
+	}
+	if limitErr := h.allowDomain(ctx, ratelimitTypeUser, quotas.Info{Domain: pp1.GetDomain()}); limitErr != nil {
+		err = limitErr
+		return
+	}
+	return h.wrapped.PauseActivity(ctx, pp1)
+}
+
 func (h *apiHandler) PauseSchedule(ctx context.Context, pp1 *types.PauseScheduleRequest) (pp2 *types.PauseScheduleResponse, err error) {
 	if pp1 == nil {
 		err = validate.ErrRequestNotSet
@@ -373,6 +469,22 @@ func (h *apiHandler) PauseSchedule(ctx context.Context, pp1 *types.PauseSchedule
 	return h.wrapped.PauseSchedule(ctx, pp1)
 }
 
+func (h *apiHandler) PauseWorkflowExecution(ctx context.Context, pp1 *types.PauseWorkflowExecutionRequest) (err error) {
+	if pp1 == nil {
+		err = validate.ErrRequestNotSet
+		return
+	}
+	if pp1.GetDomain() == "" {
+		err = validate.ErrDomainNotSet
+		return
+	}
+	if limitErr := h.allowDomain(ctx, ratelimitTypeUser, quotas.Info{Domain: pp1.GetDomain()}); limitErr != nil {
+		err = limitErr
+		return
+	}
+	return h.wrapped.PauseWorkflowExecution(ctx, pp1)
+}
+
 func (h *apiHandler) PollForActivityTask(ctx context.Context, pp1 *types.PollForActivityTaskRequest) (pp2 *types.PollForActivityTaskResponse, err error) {
 	if pp1 == nil {
 		err = validate.ErrRequestNotSet
@@ -499,6 +611,22 @@ func (h *apiHandler) RequestCancelWorkflowExecution(ctx context.Context, rp1 *ty
 	return h.wrapped.RequestCancelWorkflowExecution(ctx, rp1)
 }
 
+func (h *apiHandler) ResetActivityAttempt(ctx context.Context, rp1 *types.ResetActivityAttemptRequest) (err error) {
+	if rp1 == nil {
+		err = validate.ErrRequestNotSet
+		return
+	}
+	if rp1.GetDomain() == "" {
+		err = validate.ErrDomainNotSet
+		return
+	}
+	if limitErr := h.allowDomain(ctx, ratelimitTypeUser, quotas.Info{Domain: rp1.GetDomain()}); limitErr != nil {
+		err = limitErr
+		return
+	}
+	return h.wrapped.ResetActivityAttempt(ctx, rp1)
+}
+
 func (h *apiHandler) ResetStickyTaskList(ctx context.Context, rp1 *types.ResetStickyTaskListRequest) (rp2 *types.ResetStickyTaskListResponse, err error) {
 	if rp1 == nil {
 		err = validate.ErrRequestNotSet
@@ -753,6 +881,22 @@ func (h *apiHandler) RestartWorkflowExecution(ctx context.Context, rp1 *types.Re
 	return h.wrapped.RestartWorkflowExecution(ctx, rp1)
 }
 
+func (h *apiHandler) RetryActivityNow(ctx context.Context, rp1 *types.RetryActivityNowRequest) (err error) {
+	if rp1 == nil {
+		err = validate.ErrRequestNotSet
+		return
+	}
+	if rp1.GetDomain() == "" {
+		err = validate.ErrDomainNotSet
+		return
+	}
+	if limitErr := h.allowDomain(ctx, ratelimitTypeUser, quotas.Info{Domain: rp1.GetDomain()}); limitErr != nil {
+		err = limitErr
+		return
+	}
+	return h.wrapped.RetryActivityNow(ctx, rp1)
+}
+
 func (h *apiHandler) ScanWorkflowExecutions(ctx context.Context, lp1 *types.ListWorkflowExecutionsRequest) (lp2 *types.ListWorkflowExecutionsResponse, err error) {
 	if lp1 == nil {
 		err = validate.ErrRequestNotSet
@@ -865,6 +1009,38 @@ func (h *apiHandler) TerminateWorkflowExecution(ctx context.Context, tp1 *types.
 	return h.wrapped.TerminateWorkflowExecution(ctx, tp1)
 }
 
+func (h *apiHandler) TriggerSchedule(ctx context.Context, tp1 *types.TriggerScheduleRequest) (tp2 *types.TriggerScheduleResponse, err error) {
+	if tp1 == nil {
+		err = validate.ErrRequestNotSet
+		return
+	}
+	if tp1.GetDomain() == "" {
+		err = validate.ErrDomainNotSet
+		return
+	}
+	if limitErr := h.allowDomain(ctx, ratelimitTypeUser, quotas.Info{Domain: tp1.GetDomain()}); limitErr != nil {
+		err = limitErr
+		return
+	}
+	return h.wrapped.TriggerSchedule(ctx, tp1)
+}
+
+func (h *apiHandler) UnpauseActivity(ctx context.Context, up1 *types.UnpauseActivityRequest) (err error) {
+	if up1 == nil {
+		err = validate.ErrRequestNotSet
+		return
+	}
+	if up1.GetDomain() == "" {
+		err = validate.ErrDomainNotSet
+		return
+	}
+	if limitErr := h.allowDomain(ctx, ratelimitTypeUser, quotas.Info{Domain: up1.GetDomain()}); limitErr != nil {
+		err = limitErr
+		return
+	}
+	return h.wrapped.UnpauseActivity(ctx, up1)
+}
+
 func (h *apiHandler) UnpauseSchedule(ctx context.Context, up1 *types.UnpauseScheduleRequest) (up2 *types.UnpauseScheduleResponse, err error) {
 	if up1 == nil {
 		err = validate.ErrRequestNotSet
@@ -881,6 +1057,22 @@ func (h *apiHandler) UnpauseSchedule(ctx context.Context, up1 *types.UnpauseSche
 	return h.wrapped.UnpauseSchedule(ctx, up1)
 }
 
+func (h *apiHandler) UnpauseWorkflowExecution(ctx context.Context, up1 *types.UnpauseWorkflowExecutionRequest) (err error) {
+	if up1 == nil {
+		err = validate.ErrRequestNotSet
+		return
+	}
+	if up1.GetDomain() == "" {
+		err = validate.ErrDomainNotSet
+		return
+	}
+	if limitErr := h.allowDomain(ctx, ratelimitTypeUser, quotas.Info{Domain: up1.GetDomain()}); limitErr != nil {
+		err = limitErr
+		return
+	}
+	return h.wrapped.UnpauseWorkflowExecution(ctx, up1)
+}
+
 func (h *apiHandler) UpdateDomain(ctx context.Context, up1 *types.UpdateDomainRequest) (up2 *types.UpdateDomainResponse, err error) {
 	return h.wrapped.UpdateDomain(ctx, up1)
 }
@@ -900,3 +1092,19 @@ func (h *apiHandler) UpdateSchedule(ctx context.Context, up1 *types.UpdateSchedu
 	}
 	return h.wrapped.UpdateSchedule(ctx, up1)
 }
+
+func (h *apiHandler) UpdateWorkflowExecution(ctx context.Context, up1 *types.UpdateWorkflowExecutionRequest) (up2 *types.UpdateWorkflowExecutionResponse, err error) {
+	if up1 == nil {
+		err = validate.ErrRequestNotSet
+		return
+	}
+	if up1.GetDomain() == "" {
+		err = validate.ErrDomainNotSet
+		return
+	}
+	if limitErr := h.allowDomain(ctx, ratelimitTypeUser, quotas.Info{Domain: up1.GetDomain()}); limitErr != nil {
+		err = limitErr
+		return
+	}
+	return h.wrapped.UpdateWorkflowExecution(ctx, up1)
+}
@@ -167,6 +167,26 @@ func TestHandlerRpcRouting(t *testing.T) {
 				h.wrapped.(*api.MockHandler).EXPECT().CountWorkflowExecutions(gomock.Any(), gomock.Any()).Return(&types.CountWorkflowExecutionsResponse{}, nil).Times(1)
 			},
 		},
+		{
+			name: "AggregateWorkflowExecutions uses visibility limiter with Allow",
+			operation: func(h *apiHandler) (interface{}, error) {
+				return h.AggregateWorkflowExecutions(context.Background(), &types.AggregateWorkflowExecutionsRequest{Domain: testDomain})
+			},
+			limiterSetup: func(h *apiHandler) {
+				h.visibilityRateLimiter.(*mockPolicy).On("Allow", quotas.Info{Domain: testDomain}).Return(true).Once()
+				h.wrapped.(*api.MockHandler).EXPECT().AggregateWorkflowExecutions(gomock.Any(), gomock.Any()).Return(&types.AggregateWorkflowExecutionsResponse{}, nil).Times(1)
+			},
+		},
+		{
+			name: "DescribeSemaphore uses user limiter with Allow",
+			operation: func(h *apiHandler) (interface{}, error) {
+				return h.DescribeSemaphore(context.Background(), &types.DescribeSemaphoreRequest{Domain: testDomain, SemaphoreName: "test-semaphore"})
+			},
+			limiterSetup: func(h *apiHandler) {
+				h.userRateLimiter.(*mockPolicy).On("Allow", quotas.Info{Domain: testDomain}).Return(true).Once()
+				h.wrapped.(*api.MockHandler).EXPECT().DescribeSemaphore(gomock.Any(), gomock.Any()).Return(&types.DescribeSemaphoreResponse{}, nil).Times(1)
+			},
+		},
 		{
 			name: "DescribeTaskList uses user limiter with Allow",
 			operation: func(h *apiHandler) (interface{}, error) {
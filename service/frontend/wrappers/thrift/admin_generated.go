@@ -197,3 +197,8 @@ func (g AdminHandler) UpdateOperationalDynamicConfig(ctx context.Context, Reques
 	err = g.h.UpdateOperationalDynamicConfig(ctx, mapper.ToAdminUpdateOperationalDynamicConfigRequest(Request))
 	return mapper.FromError(err)
 }
+
+func (g AdminHandler) UpsertWorkflowSearchAttributes(ctx context.Context, Request *shared.UpsertWorkflowSearchAttributesRequest) (err error) {
+	err = g.h.UpsertWorkflowSearchAttributes(ctx, mapper.ToAdminUpsertWorkflowSearchAttributesRequest(Request))
+	return mapper.FromError(err)
+}
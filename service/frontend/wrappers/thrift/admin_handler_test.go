@@ -150,4 +150,9 @@ func TestAdminThriftHandler(t *testing.T) {
 		assert.Equal(t, shared.GetCrossClusterTasksResponse{}, *resp)
 		assert.Equal(t, expectedErr, err)
 	})
+	t.Run("UpsertWorkflowSearchAttributes", func(t *testing.T) {
+		h.EXPECT().UpsertWorkflowSearchAttributes(ctx, &types.AdminUpsertWorkflowSearchAttributesRequest{}).Return(internalErr).Times(1)
+		err := th.UpsertWorkflowSearchAttributes(ctx, &shared.UpsertWorkflowSearchAttributesRequest{})
+		assert.Equal(t, expectedErr, err)
+	})
 }
@@ -11,6 +11,11 @@ import (
 	mapper "github.com/uber/cadence/common/types/mapper/thrift"
 )
 
+func (g APIHandler) AggregateWorkflowExecutions(ctx context.Context, AggregateRequest *shared.AggregateWorkflowExecutionsRequest) (ap1 *shared.AggregateWorkflowExecutionsResponse, err error) {
+	response, err := g.h.AggregateWorkflowExecutions(ctx, mapper.ToAggregateWorkflowExecutionsRequest(AggregateRequest))
+	return mapper.FromAggregateWorkflowExecutionsResponse(response), mapper.FromError(err)
+}
+
 func (g APIHandler) BackfillSchedule(ctx context.Context, Request *shared.BackfillScheduleRequest) (bp1 *shared.BackfillScheduleResponse, err error) {
 	response, err := g.h.BackfillSchedule(ctx, mapper.ToBackfillScheduleRequest(Request))
 	return mapper.FromBackfillScheduleResponse(response), mapper.FromError(err)
@@ -26,6 +31,11 @@ func (g APIHandler) CreateSchedule(ctx context.Context, Request *shared.CreateSc
 	return mapper.FromCreateScheduleResponse(response), mapper.FromError(err)
 }
 
+func (g APIHandler) CreateSemaphore(ctx context.Context, Request *shared.CreateSemaphoreRequest) (cp1 *shared.CreateSemaphoreResponse, err error) {
+	response, err := g.h.CreateSemaphore(ctx, mapper.ToCreateSemaphoreRequest(Request))
+	return mapper.FromCreateSemaphoreResponse(response), mapper.FromError(err)
+}
+
 func (g APIHandler) DeleteDomain(ctx context.Context, DeleteRequest *shared.DeleteDomainRequest) (err error) {
 	err = g.h.DeleteDomain(ctx, mapper.ToDeleteDomainRequest(DeleteRequest))
 	return mapper.FromError(err)
@@ -36,6 +46,11 @@ func (g APIHandler) DeleteSchedule(ctx context.Context, Request *shared.DeleteSc
 	return mapper.FromDeleteScheduleResponse(response), mapper.FromError(err)
 }
 
+func (g APIHandler) DeleteWorkflowExecution(ctx context.Context, DeleteRequest *shared.DeleteWorkflowExecutionRequest) (err error) {
+	err = g.h.DeleteWorkflowExecution(ctx, mapper.ToDeleteWorkflowExecutionRequest(DeleteRequest))
+	return mapper.FromError(err)
+}
+
 func (g APIHandler) DeprecateDomain(ctx context.Context, DeprecateRequest *shared.DeprecateDomainRequest) (err error) {
 	err = g.h.DeprecateDomain(ctx, mapper.ToDeprecateDomainRequest(DeprecateRequest))
 	return mapper.FromError(err)
@@ -51,6 +66,11 @@ func (g APIHandler) DescribeSchedule(ctx context.Context, Request *shared.Descri
 	return mapper.FromDescribeScheduleResponse(response), mapper.FromError(err)
 }
 
+func (g APIHandler) DescribeSemaphore(ctx context.Context, Request *shared.DescribeSemaphoreRequest) (dp1 *shared.DescribeSemaphoreResponse, err error) {
+	response, err := g.h.DescribeSemaphore(ctx, mapper.ToDescribeSemaphoreRequest(Request))
+	return mapper.FromDescribeSemaphoreResponse(response), mapper.FromError(err)
+}
+
 func (g APIHandler) DescribeTaskList(ctx context.Context, Request *shared.DescribeTaskListRequest) (dp1 *shared.DescribeTaskListResponse, err error) {
 	response, err := g.h.DescribeTaskList(ctx, mapper.ToDescribeTaskListRequest(Request))
 	return mapper.FromDescribeTaskListResponse(response), mapper.FromError(err)
@@ -121,6 +141,11 @@ func (g APIHandler) ListSchedules(ctx context.Context, Request *shared.ListSched
 	return mapper.FromListSchedulesResponse(response), mapper.FromError(err)
 }
 
+func (g APIHandler) ListSemaphores(ctx context.Context, Request *shared.ListSemaphoresRequest) (lp1 *shared.ListSemaphoresResponse, err error) {
+	response, err := g.h.ListSemaphores(ctx, mapper.ToListSemaphoresRequest(Request))
+	return mapper.FromListSemaphoresResponse(response), mapper.FromError(err)
+}
+
 func (g APIHandler) ListTaskListPartitions(ctx context.Context, Request *shared.ListTaskListPartitionsRequest) (lp1 *shared.ListTaskListPartitionsResponse, err error) {
 	response, err := g.h.ListTaskListPartitions(ctx, mapper.ToListTaskListPartitionsRequest(Request))
 	return mapper.FromListTaskListPartitionsResponse(response), mapper.FromError(err)
@@ -131,11 +156,21 @@ func (g APIHandler) ListWorkflowExecutions(ctx context.Context, ListRequest *sha
 	return mapper.FromListWorkflowExecutionsResponse(response), mapper.FromError(err)
 }
 
+func (g APIHandler) PauseActivity(ctx context.Context, PauseRequest *shared.PauseActivityRequest) (err error) {
+	err = g.h.PauseActivity(ctx, mapper.ToPauseActivityRequest(PauseRequest))
+	return mapper.FromError(err)
+}
+
 func (g APIHandler) PauseSchedule(ctx context.Context, Request *shared.PauseScheduleRequest) (pp1 *shared.PauseScheduleResponse, err error) {
 	response, err := g.h.PauseSchedule(ctx, mapper.ToPauseScheduleRequest(Request))
 	return mapper.FromPauseScheduleResponse(response), mapper.FromError(err)
 }
 
+func (g APIHandler) PauseWorkflowExecution(ctx context.Context, PauseRequest *shared.PauseWorkflowExecutionRequest) (err error) {
+	err = g.h.PauseWorkflowExecution(ctx, mapper.ToPauseWorkflowExecutionRequest(PauseRequest))
+	return mapper.FromError(err)
+}
+
 func (g APIHandler) PollForActivityTask(ctx context.Context, PollRequest *shared.PollForActivityTaskRequest) (pp1 *shared.PollForActivityTaskResponse, err error) {
 	response, err := g.h.PollForActivityTask(ctx, mapper.ToPollForActivityTaskRequest(PollRequest))
 	return mapper.FromPollForActivityTaskResponse(response), mapper.FromError(err)
@@ -176,6 +211,11 @@ func (g APIHandler) RequestCancelWorkflowExecution(ctx context.Context, CancelRe
 	return mapper.FromError(err)
 }
 
+func (g APIHandler) ResetActivityAttempt(ctx context.Context, ResetRequest *shared.ResetActivityAttemptRequest) (err error) {
+	err = g.h.ResetActivityAttempt(ctx, mapper.ToResetActivityAttemptRequest(ResetRequest))
+	return mapper.FromError(err)
+}
+
 func (g APIHandler) ResetStickyTaskList(ctx context.Context, ResetRequest *shared.ResetStickyTaskListRequest) (rp1 *shared.ResetStickyTaskListResponse, err error) {
 	response, err := g.h.ResetStickyTaskList(ctx, mapper.ToResetStickyTaskListRequest(ResetRequest))
 	return mapper.FromResetStickyTaskListResponse(response), mapper.FromError(err)
@@ -236,6 +276,11 @@ func (g APIHandler) RestartWorkflowExecution(ctx context.Context, RestartRequest
 	return mapper.FromRestartWorkflowExecutionResponse(response), mapper.FromError(err)
 }
 
+func (g APIHandler) RetryActivityNow(ctx context.Context, RetryRequest *shared.RetryActivityNowRequest) (err error) {
+	err = g.h.RetryActivityNow(ctx, mapper.ToRetryActivityNowRequest(RetryRequest))
+	return mapper.FromError(err)
+}
+
 func (g APIHandler) ScanWorkflowExecutions(ctx context.Context, ListRequest *shared.ListWorkflowExecutionsRequest) (lp1 *shared.ListWorkflowExecutionsResponse, err error) {
 	response, err := g.h.ScanWorkflowExecutions(ctx, mapper.ToScanWorkflowExecutionsRequest(ListRequest))
 	return mapper.FromScanWorkflowExecutionsResponse(response), mapper.FromError(err)
@@ -271,11 +316,26 @@ func (g APIHandler) TerminateWorkflowExecution(ctx context.Context, TerminateReq
 	return mapper.FromError(err)
 }
 
+func (g APIHandler) TriggerSchedule(ctx context.Context, Request *shared.TriggerScheduleRequest) (tp1 *shared.TriggerScheduleResponse, err error) {
+	response, err := g.h.TriggerSchedule(ctx, mapper.ToTriggerScheduleRequest(Request))
+	return mapper.FromTriggerScheduleResponse(response), mapper.FromError(err)
+}
+
+func (g APIHandler) UnpauseActivity(ctx context.Context, UnpauseRequest *shared.UnpauseActivityRequest) (err error) {
+	err = g.h.UnpauseActivity(ctx, mapper.ToUnpauseActivityRequest(UnpauseRequest))
+	return mapper.FromError(err)
+}
+
 func (g APIHandler) UnpauseSchedule(ctx context.Context, Request *shared.UnpauseScheduleRequest) (up1 *shared.UnpauseScheduleResponse, err error) {
 	response, err := g.h.UnpauseSchedule(ctx, mapper.ToUnpauseScheduleRequest(Request))
 	return mapper.FromUnpauseScheduleResponse(response), mapper.FromError(err)
 }
 
+func (g APIHandler) UnpauseWorkflowExecution(ctx context.Context, UnpauseRequest *shared.UnpauseWorkflowExecutionRequest) (err error) {
+	err = g.h.UnpauseWorkflowExecution(ctx, mapper.ToUnpauseWorkflowExecutionRequest(UnpauseRequest))
+	return mapper.FromError(err)
+}
+
 func (g APIHandler) UpdateDomain(ctx context.Context, UpdateRequest *shared.UpdateDomainRequest) (up1 *shared.UpdateDomainResponse, err error) {
 	response, err := g.h.UpdateDomain(ctx, mapper.ToUpdateDomainRequest(UpdateRequest))
 	return mapper.FromUpdateDomainResponse(response), mapper.FromError(err)
@@ -285,3 +345,8 @@ func (g APIHandler) UpdateSchedule(ctx context.Context, Request *shared.UpdateSc
 	response, err := g.h.UpdateSchedule(ctx, mapper.ToUpdateScheduleRequest(Request))
 	return mapper.FromUpdateScheduleResponse(response), mapper.FromError(err)
 }
+
+func (g APIHandler) UpdateWorkflowExecution(ctx context.Context, UpdateRequest *shared.UpdateWorkflowExecutionRequest) (up1 *shared.UpdateWorkflowExecutionResponse, err error) {
+	response, err := g.h.UpdateWorkflowExecution(ctx, mapper.ToUpdateWorkflowExecutionRequest(UpdateRequest))
+	return mapper.FromUpdateWorkflowExecutionResponse(response), mapper.FromError(err)
+}
@@ -57,6 +57,12 @@ func TestThriftHandler(t *testing.T) {
 		assert.Equal(t, shared.CountWorkflowExecutionsResponse{Count: common.Int64Ptr(0)}, *resp)
 		assert.Equal(t, expectedErr, err)
 	})
+	t.Run("AggregateWorkflowExecutions", func(t *testing.T) {
+		h.EXPECT().AggregateWorkflowExecutions(ctx, &types.AggregateWorkflowExecutionsRequest{}).Return(&types.AggregateWorkflowExecutionsResponse{}, internalErr).Times(1)
+		resp, err := th.AggregateWorkflowExecutions(ctx, &shared.AggregateWorkflowExecutionsRequest{})
+		assert.Equal(t, shared.AggregateWorkflowExecutionsResponse{}, *resp)
+		assert.Equal(t, expectedErr, err)
+	})
 	t.Run("DeprecateDomain", func(t *testing.T) {
 		h.EXPECT().DeprecateDomain(ctx, &types.DeprecateDomainRequest{}).Return(internalErr).Times(1)
 		err := th.DeprecateDomain(ctx, &shared.DeprecateDomainRequest{})
@@ -266,6 +272,47 @@ func TestThriftHandler(t *testing.T) {
 		assert.Equal(t, shared.UpdateDomainResponse{IsGlobalDomain: common.BoolPtr(false), FailoverVersion: common.Int64Ptr(0)}, *resp)
 		assert.Equal(t, expectedErr, err)
 	})
+	t.Run("UpdateWorkflowExecution", func(t *testing.T) {
+		h.EXPECT().UpdateWorkflowExecution(ctx, &types.UpdateWorkflowExecutionRequest{}).Return(&types.UpdateWorkflowExecutionResponse{}, internalErr).Times(1)
+		resp, err := th.UpdateWorkflowExecution(ctx, &shared.UpdateWorkflowExecutionRequest{})
+		assert.Equal(t, shared.UpdateWorkflowExecutionResponse{UpdateId: common.StringPtr("")}, *resp)
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("PauseWorkflowExecution", func(t *testing.T) {
+		h.EXPECT().PauseWorkflowExecution(ctx, &types.PauseWorkflowExecutionRequest{}).Return(internalErr).Times(1)
+		err := th.PauseWorkflowExecution(ctx, &shared.PauseWorkflowExecutionRequest{})
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("UnpauseWorkflowExecution", func(t *testing.T) {
+		h.EXPECT().UnpauseWorkflowExecution(ctx, &types.UnpauseWorkflowExecutionRequest{}).Return(internalErr).Times(1)
+		err := th.UnpauseWorkflowExecution(ctx, &shared.UnpauseWorkflowExecutionRequest{})
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("PauseActivity", func(t *testing.T) {
+		h.EXPECT().PauseActivity(ctx, &types.PauseActivityRequest{}).Return(internalErr).Times(1)
+		err := th.PauseActivity(ctx, &shared.PauseActivityRequest{})
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("UnpauseActivity", func(t *testing.T) {
+		h.EXPECT().UnpauseActivity(ctx, &types.UnpauseActivityRequest{}).Return(internalErr).Times(1)
+		err := th.UnpauseActivity(ctx, &shared.UnpauseActivityRequest{})
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("ResetActivityAttempt", func(t *testing.T) {
+		h.EXPECT().ResetActivityAttempt(ctx, &types.ResetActivityAttemptRequest{}).Return(internalErr).Times(1)
+		err := th.ResetActivityAttempt(ctx, &shared.ResetActivityAttemptRequest{})
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("RetryActivityNow", func(t *testing.T) {
+		h.EXPECT().RetryActivityNow(ctx, &types.RetryActivityNowRequest{}).Return(internalErr).Times(1)
+		err := th.RetryActivityNow(ctx, &shared.RetryActivityNowRequest{})
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("DeleteWorkflowExecution", func(t *testing.T) {
+		h.EXPECT().DeleteWorkflowExecution(ctx, &types.DeleteWorkflowExecutionRequest{}).Return(internalErr).Times(1)
+		err := th.DeleteWorkflowExecution(ctx, &shared.DeleteWorkflowExecutionRequest{})
+		assert.Equal(t, expectedErr, err)
+	})
 	t.Run("CreateSchedule", func(t *testing.T) {
 		h.EXPECT().CreateSchedule(ctx, &types.CreateScheduleRequest{}).Return(&types.CreateScheduleResponse{}, internalErr).Times(1)
 		resp, err := th.CreateSchedule(ctx, &shared.CreateScheduleRequest{})
@@ -308,10 +355,34 @@ func TestThriftHandler(t *testing.T) {
 		assert.Equal(t, shared.BackfillScheduleResponse{}, *resp)
 		assert.Equal(t, expectedErr, err)
 	})
+	t.Run("TriggerSchedule", func(t *testing.T) {
+		h.EXPECT().TriggerSchedule(ctx, &types.TriggerScheduleRequest{}).Return(&types.TriggerScheduleResponse{}, internalErr).Times(1)
+		resp, err := th.TriggerSchedule(ctx, &shared.TriggerScheduleRequest{})
+		assert.Equal(t, shared.TriggerScheduleResponse{}, *resp)
+		assert.Equal(t, expectedErr, err)
+	})
 	t.Run("ListSchedules", func(t *testing.T) {
 		h.EXPECT().ListSchedules(ctx, &types.ListSchedulesRequest{}).Return(&types.ListSchedulesResponse{}, internalErr).Times(1)
 		resp, err := th.ListSchedules(ctx, &shared.ListSchedulesRequest{})
 		assert.Equal(t, shared.ListSchedulesResponse{}, *resp)
 		assert.Equal(t, expectedErr, err)
 	})
+	t.Run("CreateSemaphore", func(t *testing.T) {
+		h.EXPECT().CreateSemaphore(ctx, &types.CreateSemaphoreRequest{}).Return(&types.CreateSemaphoreResponse{}, internalErr).Times(1)
+		resp, err := th.CreateSemaphore(ctx, &shared.CreateSemaphoreRequest{})
+		assert.Equal(t, shared.CreateSemaphoreResponse{}, *resp)
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("DescribeSemaphore", func(t *testing.T) {
+		h.EXPECT().DescribeSemaphore(ctx, &types.DescribeSemaphoreRequest{}).Return(&types.DescribeSemaphoreResponse{}, internalErr).Times(1)
+		resp, err := th.DescribeSemaphore(ctx, &shared.DescribeSemaphoreRequest{})
+		assert.Equal(t, shared.DescribeSemaphoreResponse{AvailablePermits: common.Int32Ptr(0)}, *resp)
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("ListSemaphores", func(t *testing.T) {
+		h.EXPECT().ListSemaphores(ctx, &types.ListSemaphoresRequest{}).Return(&types.ListSemaphoresResponse{}, internalErr).Times(1)
+		resp, err := th.ListSemaphores(ctx, &shared.ListSemaphoresRequest{})
+		assert.Equal(t, shared.ListSemaphoresResponse{}, *resp)
+		assert.Equal(t, expectedErr, err)
+	})
 }
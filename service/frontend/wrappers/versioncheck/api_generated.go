@@ -33,6 +33,14 @@ func NewAPIHandler(
 	}
 }
 
+func (h *versionCheckHandler) AggregateWorkflowExecutions(ctx context.Context, ap1 *types.AggregateWorkflowExecutionsRequest) (ap2 *types.AggregateWorkflowExecutionsResponse, err error) {
+	err = h.versionChecker.ClientSupported(ctx, h.config.EnableClientVersionCheck())
+	if err != nil {
+		return
+	}
+	return h.frontendHandler.AggregateWorkflowExecutions(ctx, ap1)
+}
+
 func (h *versionCheckHandler) BackfillSchedule(ctx context.Context, bp1 *types.BackfillScheduleRequest) (bp2 *types.BackfillScheduleResponse, err error) {
 	err = h.versionChecker.ClientSupported(ctx, h.config.EnableClientVersionCheck())
 	if err != nil {
@@ -57,6 +65,14 @@ func (h *versionCheckHandler) CreateSchedule(ctx context.Context, cp1 *types.Cre
 	return h.frontendHandler.CreateSchedule(ctx, cp1)
 }
 
+func (h *versionCheckHandler) CreateSemaphore(ctx context.Context, cp1 *types.CreateSemaphoreRequest) (cp2 *types.CreateSemaphoreResponse, err error) {
+	err = h.versionChecker.ClientSupported(ctx, h.config.EnableClientVersionCheck())
+	if err != nil {
+		return
+	}
+	return h.frontendHandler.CreateSemaphore(ctx, cp1)
+}
+
 func (h *versionCheckHandler) DeleteDomain(ctx context.Context, dp1 *types.DeleteDomainRequest) (err error) {
 	err = h.versionChecker.ClientSupported(ctx, h.config.EnableClientVersionCheck())
 	if err != nil {
@@ -73,6 +89,14 @@ func (h *versionCheckHandler) DeleteSchedule(ctx context.Context, dp1 *types.Del
 	return h.frontendHandler.DeleteSchedule(ctx, dp1)
 }
 
+func (h *versionCheckHandler) DeleteWorkflowExecution(ctx context.Context, dp1 *types.DeleteWorkflowExecutionRequest) (err error) {
+	err = h.versionChecker.ClientSupported(ctx, h.config.EnableClientVersionCheck())
+	if err != nil {
+		return
+	}
+	return h.frontendHandler.DeleteWorkflowExecution(ctx, dp1)
+}
+
 func (h *versionCheckHandler) DeprecateDomain(ctx context.Context, dp1 *types.DeprecateDomainRequest) (err error) {
 	err = h.versionChecker.ClientSupported(ctx, h.config.EnableClientVersionCheck())
 	if err != nil {
@@ -97,6 +121,14 @@ func (h *versionCheckHandler) DescribeSchedule(ctx context.Context, dp1 *types.D
 	return h.frontendHandler.DescribeSchedule(ctx, dp1)
 }
 
+func (h *versionCheckHandler) DescribeSemaphore(ctx context.Context, dp1 *types.DescribeSemaphoreRequest) (dp2 *types.DescribeSemaphoreResponse, err error) {
+	err = h.versionChecker.ClientSupported(ctx, h.config.EnableClientVersionCheck())
+	if err != nil {
+		return
+	}
+	return h.frontendHandler.DescribeSemaphore(ctx, dp1)
+}
+
 func (h *versionCheckHandler) DescribeTaskList(ctx context.Context, dp1 *types.DescribeTaskListRequest) (dp2 *types.DescribeTaskListResponse, err error) {
 	err = h.versionChecker.ClientSupported(ctx, h.config.EnableClientVersionCheck())
 	if err != nil {
@@ -205,6 +237,14 @@ func (h *versionCheckHandler) ListSchedules(ctx context.Context, lp1 *types.List
 	return h.frontendHandler.ListSchedules(ctx, lp1)
 }
 
+func (h *versionCheckHandler) ListSemaphores(ctx context.Context, lp1 *types.ListSemaphoresRequest) (lp2 *types.ListSemaphoresResponse, err error) {
+	err = h.versionChecker.ClientSupported(ctx, h.config.EnableClientVersionCheck())
+	if err != nil {
+		return
+	}
+	return h.frontendHandler.ListSemaphores(ctx, lp1)
+}
+
 func (h *versionCheckHandler) ListTaskListPartitions(ctx context.Context, lp1 *types.ListTaskListPartitionsRequest) (lp2 *types.ListTaskListPartitionsResponse, err error) {
 	return h.frontendHandler.ListTaskListPartitions(ctx, lp1)
 }
@@ -217,6 +257,14 @@ func (h *versionCheckHandler) ListWorkflowExecutions(ctx context.Context, lp1 *t
 	return h.frontendHandler.ListWorkflowExecutions(ctx, lp1)
 }
 
+func (h *versionCheckHandler) PauseActivity(ctx context.Context, pp1 *types.PauseActivityRequest) (err error) {
+	err = h.versionChecker.ClientSupported(ctx, h.config.EnableClientVersionCheck())
+	if err != nil {
+		return
+	}
+	return h.frontendHandler.PauseActivity(ctx, pp1)
+}
+
 func (h *versionCheckHandler) PauseSchedule(ctx context.Context, pp1 *types.PauseScheduleRequest) (pp2 *types.PauseScheduleResponse, err error) {
 	err = h.versionChecker.ClientSupported(ctx, h.config.EnableClientVersionCheck())
 	if err != nil {
@@ -225,6 +273,14 @@ func (h *versionCheckHandler) PauseSchedule(ctx context.Context, pp1 *types.Paus
 	return h.frontendHandler.PauseSchedule(ctx, pp1)
 }
 
+func (h *versionCheckHandler) PauseWorkflowExecution(ctx context.Context, pp1 *types.PauseWorkflowExecutionRequest) (err error) {
+	err = h.versionChecker.ClientSupported(ctx, h.config.EnableClientVersionCheck())
+	if err != nil {
+		return
+	}
+	return h.frontendHandler.PauseWorkflowExecution(ctx, pp1)
+}
+
 func (h *versionCheckHandler) PollForActivityTask(ctx context.Context, pp1 *types.PollForActivityTaskRequest) (pp2 *types.PollForActivityTaskResponse, err error) {
 	err = h.versionChecker.ClientSupported(ctx, h.config.EnableClientVersionCheck())
 	if err != nil {
@@ -285,6 +341,14 @@ func (h *versionCheckHandler) RequestCancelWorkflowExecution(ctx context.Context
 	return h.frontendHandler.RequestCancelWorkflowExecution(ctx, rp1)
 }
 
+func (h *versionCheckHandler) ResetActivityAttempt(ctx context.Context, rp1 *types.ResetActivityAttemptRequest) (err error) {
+	err = h.versionChecker.ClientSupported(ctx, h.config.EnableClientVersionCheck())
+	if err != nil {
+		return
+	}
+	return h.frontendHandler.ResetActivityAttempt(ctx, rp1)
+}
+
 func (h *versionCheckHandler) ResetStickyTaskList(ctx context.Context, rp1 *types.ResetStickyTaskListRequest) (rp2 *types.ResetStickyTaskListResponse, err error) {
 	err = h.versionChecker.ClientSupported(ctx, h.config.EnableClientVersionCheck())
 	if err != nil {
@@ -381,6 +445,14 @@ func (h *versionCheckHandler) RestartWorkflowExecution(ctx context.Context, rp1
 	return h.frontendHandler.RestartWorkflowExecution(ctx, rp1)
 }
 
+func (h *versionCheckHandler) RetryActivityNow(ctx context.Context, rp1 *types.RetryActivityNowRequest) (err error) {
+	err = h.versionChecker.ClientSupported(ctx, h.config.EnableClientVersionCheck())
+	if err != nil {
+		return
+	}
+	return h.frontendHandler.RetryActivityNow(ctx, rp1)
+}
+
 func (h *versionCheckHandler) ScanWorkflowExecutions(ctx context.Context, lp1 *types.ListWorkflowExecutionsRequest) (lp2 *types.ListWorkflowExecutionsResponse, err error) {
 	err = h.versionChecker.ClientSupported(ctx, h.config.EnableClientVersionCheck())
 	if err != nil {
@@ -437,6 +509,22 @@ func (h *versionCheckHandler) TerminateWorkflowExecution(ctx context.Context, tp
 	return h.frontendHandler.TerminateWorkflowExecution(ctx, tp1)
 }
 
+func (h *versionCheckHandler) TriggerSchedule(ctx context.Context, tp1 *types.TriggerScheduleRequest) (tp2 *types.TriggerScheduleResponse, err error) {
+	err = h.versionChecker.ClientSupported(ctx, h.config.EnableClientVersionCheck())
+	if err != nil {
+		return
+	}
+	return h.frontendHandler.TriggerSchedule(ctx, tp1)
+}
+
+func (h *versionCheckHandler) UnpauseActivity(ctx context.Context, up1 *types.UnpauseActivityRequest) (err error) {
+	err = h.versionChecker.ClientSupported(ctx, h.config.EnableClientVersionCheck())
+	if err != nil {
+		return
+	}
+	return h.frontendHandler.UnpauseActivity(ctx, up1)
+}
+
 func (h *versionCheckHandler) UnpauseSchedule(ctx context.Context, up1 *types.UnpauseScheduleRequest) (up2 *types.UnpauseScheduleResponse, err error) {
 	err = h.versionChecker.ClientSupported(ctx, h.config.EnableClientVersionCheck())
 	if err != nil {
@@ -445,6 +533,14 @@ func (h *versionCheckHandler) UnpauseSchedule(ctx context.Context, up1 *types.Un
 	return h.frontendHandler.UnpauseSchedule(ctx, up1)
 }
 
+func (h *versionCheckHandler) UnpauseWorkflowExecution(ctx context.Context, up1 *types.UnpauseWorkflowExecutionRequest) (err error) {
+	err = h.versionChecker.ClientSupported(ctx, h.config.EnableClientVersionCheck())
+	if err != nil {
+		return
+	}
+	return h.frontendHandler.UnpauseWorkflowExecution(ctx, up1)
+}
+
 func (h *versionCheckHandler) UpdateDomain(ctx context.Context, up1 *types.UpdateDomainRequest) (up2 *types.UpdateDomainResponse, err error) {
 	err = h.versionChecker.ClientSupported(ctx, h.config.EnableClientVersionCheck())
 	if err != nil {
@@ -460,3 +556,11 @@ func (h *versionCheckHandler) UpdateSchedule(ctx context.Context, up1 *types.Upd
 	}
 	return h.frontendHandler.UpdateSchedule(ctx, up1)
 }
+
+func (h *versionCheckHandler) UpdateWorkflowExecution(ctx context.Context, up1 *types.UpdateWorkflowExecutionRequest) (up2 *types.UpdateWorkflowExecutionResponse, err error) {
+	err = h.versionChecker.ClientSupported(ctx, h.config.EnableClientVersionCheck())
+	if err != nil {
+		return
+	}
+	return h.frontendHandler.UpdateWorkflowExecution(ctx, up1)
+}
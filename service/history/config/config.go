@@ -202,6 +202,8 @@ type Config struct {
 	// System Limits
 	MaximumBufferedEventsBatch dynamicproperties.IntPropertyFn
 	MaximumSignalsPerExecution dynamicproperties.IntPropertyFnWithDomainFilter
+	// MaximumCompletedUpdatesPerExecution is max number of completed update outcomes kept in the mutable state
+	MaximumCompletedUpdatesPerExecution dynamicproperties.IntPropertyFnWithDomainFilter
 
 	// ShardUpdateMinInterval the minimal time interval which the shard info can be updated
 	ShardUpdateMinInterval dynamicproperties.DurationPropertyFn
@@ -239,6 +241,8 @@ type Config struct {
 	// Size limit related settings
 	BlobSizeLimitError               dynamicproperties.IntPropertyFnWithDomainFilter
 	BlobSizeLimitWarn                dynamicproperties.IntPropertyFnWithDomainFilter
+	PayloadOffloadThreshold          dynamicproperties.IntPropertyFnWithDomainFilter
+	OffloadedBlobSizeLimitError      dynamicproperties.IntPropertyFnWithDomainFilter
 	HistorySizeLimitError            dynamicproperties.IntPropertyFnWithDomainFilter
 	HistorySizeLimitWarn             dynamicproperties.IntPropertyFnWithDomainFilter
 	HistoryCountLimitError           dynamicproperties.IntPropertyFnWithDomainFilter
@@ -516,11 +520,12 @@ func New(dc *dynamicconfig.Collection, numberOfShards int, maxMessageSize int, i
 		ReplicationBudgetManagerMaxSizeCount:     dc.GetIntProperty(dynamicproperties.ReplicationBudgetManagerMaxSizeCount),
 		ReplicationBudgetManagerSoftCapThreshold: dc.GetFloat64Property(dynamicproperties.ReplicationBudgetManagerSoftCapThreshold),
 
-		MaximumBufferedEventsBatch:      dc.GetIntProperty(dynamicproperties.MaximumBufferedEventsBatch),
-		MaximumSignalsPerExecution:      dc.GetIntPropertyFilteredByDomain(dynamicproperties.MaximumSignalsPerExecution),
-		ShardUpdateMinInterval:          dc.GetDurationProperty(dynamicproperties.ShardUpdateMinInterval),
-		ShardSyncMinInterval:            dc.GetDurationProperty(dynamicproperties.ShardSyncMinInterval),
-		ShardSyncTimerJitterCoefficient: dc.GetFloat64Property(dynamicproperties.TransferProcessorMaxPollIntervalJitterCoefficient),
+		MaximumBufferedEventsBatch:          dc.GetIntProperty(dynamicproperties.MaximumBufferedEventsBatch),
+		MaximumSignalsPerExecution:          dc.GetIntPropertyFilteredByDomain(dynamicproperties.MaximumSignalsPerExecution),
+		MaximumCompletedUpdatesPerExecution: dc.GetIntPropertyFilteredByDomain(dynamicproperties.MaximumCompletedUpdatesPerExecution),
+		ShardUpdateMinInterval:              dc.GetDurationProperty(dynamicproperties.ShardUpdateMinInterval),
+		ShardSyncMinInterval:                dc.GetDurationProperty(dynamicproperties.ShardSyncMinInterval),
+		ShardSyncTimerJitterCoefficient:     dc.GetFloat64Property(dynamicproperties.TransferProcessorMaxPollIntervalJitterCoefficient),
 
 		// history client: client/history/client.go set the client timeout 30s
 		LongPollExpirationInterval:          dc.GetDurationPropertyFilteredByDomain(dynamicproperties.HistoryLongPollExpirationInterval),
@@ -541,6 +546,8 @@ func New(dc *dynamicconfig.Collection, numberOfShards int, maxMessageSize int, i
 
 		BlobSizeLimitError:               dc.GetIntPropertyFilteredByDomain(dynamicproperties.BlobSizeLimitError),
 		BlobSizeLimitWarn:                dc.GetIntPropertyFilteredByDomain(dynamicproperties.BlobSizeLimitWarn),
+		PayloadOffloadThreshold:          dc.GetIntPropertyFilteredByDomain(dynamicproperties.PayloadOffloadThreshold),
+		OffloadedBlobSizeLimitError:      dc.GetIntPropertyFilteredByDomain(dynamicproperties.OffloadedBlobSizeLimitError),
 		HistorySizeLimitError:            dc.GetIntPropertyFilteredByDomain(dynamicproperties.HistorySizeLimitError),
 		HistorySizeLimitWarn:             dc.GetIntPropertyFilteredByDomain(dynamicproperties.HistorySizeLimitWarn),
 		HistoryCountLimitError:           dc.GetIntPropertyFilteredByDomain(dynamicproperties.HistoryCountLimitError),
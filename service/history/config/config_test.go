@@ -177,6 +177,7 @@ func TestNewConfig(t *testing.T) {
 		"ReplicationBudgetManagerSoftCapThreshold":             {dynamicproperties.ReplicationBudgetManagerSoftCapThreshold, 1.0},
 		"MaximumBufferedEventsBatch":                           {dynamicproperties.MaximumBufferedEventsBatch, 59},
 		"MaximumSignalsPerExecution":                           {dynamicproperties.MaximumSignalsPerExecution, 60},
+		"MaximumCompletedUpdatesPerExecution":                  {dynamicproperties.MaximumCompletedUpdatesPerExecution, 160},
 		"ShardUpdateMinInterval":                               {dynamicproperties.ShardUpdateMinInterval, time.Second},
 		"ShardSyncMinInterval":                                 {dynamicproperties.ShardSyncMinInterval, time.Second},
 		"ShardSyncTimerJitterCoefficient":                      {dynamicproperties.TransferProcessorMaxPollIntervalJitterCoefficient, 8.0},
@@ -196,6 +197,8 @@ func TestNewConfig(t *testing.T) {
 		"AllowArchivingIncompleteHistory":                      {dynamicproperties.AllowArchivingIncompleteHistory, true},
 		"BlobSizeLimitError":                                   {dynamicproperties.BlobSizeLimitError, 70},
 		"BlobSizeLimitWarn":                                    {dynamicproperties.BlobSizeLimitWarn, 71},
+		"PayloadOffloadThreshold":                              {dynamicproperties.PayloadOffloadThreshold, 2001},
+		"OffloadedBlobSizeLimitError":                          {dynamicproperties.OffloadedBlobSizeLimitError, 2002},
 		"HistorySizeLimitError":                                {dynamicproperties.HistorySizeLimitError, 72},
 		"HistorySizeLimitWarn":                                 {dynamicproperties.HistorySizeLimitWarn, 73},
 		"HistoryCountLimitError":                               {dynamicproperties.HistoryCountLimitError, 74},
@@ -36,9 +36,11 @@ import (
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/taskpriority"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/history/config"
 	"github.com/uber/cadence/service/history/execution"
+	"github.com/uber/cadence/service/history/query"
 )
 
 type (
@@ -56,6 +58,9 @@ type (
 		blobSizeLimitWarn  int
 		blobSizeLimitError int
 
+		payloadOffloadThreshold     int
+		offloadedBlobSizeLimitError int
+
 		historySizeLimitWarn  int
 		historySizeLimitError int
 
@@ -96,6 +101,8 @@ func newWorkflowSizeChecker(
 	domainName string,
 	blobSizeLimitWarn int,
 	blobSizeLimitError int,
+	payloadOffloadThreshold int,
+	offloadedBlobSizeLimitError int,
 	historySizeLimitWarn int,
 	historySizeLimitError int,
 	historyCountLimitWarn int,
@@ -107,18 +114,20 @@ func newWorkflowSizeChecker(
 	logger log.Logger,
 ) *workflowSizeChecker {
 	return &workflowSizeChecker{
-		domainName:             domainName,
-		blobSizeLimitWarn:      blobSizeLimitWarn,
-		blobSizeLimitError:     blobSizeLimitError,
-		historySizeLimitWarn:   historySizeLimitWarn,
-		historySizeLimitError:  historySizeLimitError,
-		historyCountLimitWarn:  historyCountLimitWarn,
-		historyCountLimitError: historyCountLimitError,
-		completedID:            completedID,
-		mutableState:           mutableState,
-		executionStats:         executionStats,
-		metricsScope:           metricsScope,
-		logger:                 logger,
+		domainName:                  domainName,
+		blobSizeLimitWarn:           blobSizeLimitWarn,
+		blobSizeLimitError:          blobSizeLimitError,
+		payloadOffloadThreshold:     payloadOffloadThreshold,
+		offloadedBlobSizeLimitError: offloadedBlobSizeLimitError,
+		historySizeLimitWarn:        historySizeLimitWarn,
+		historySizeLimitError:       historySizeLimitError,
+		historyCountLimitWarn:       historyCountLimitWarn,
+		historyCountLimitError:      historyCountLimitError,
+		completedID:                 completedID,
+		mutableState:                mutableState,
+		executionStats:              executionStats,
+		metricsScope:                metricsScope,
+		logger:                      logger,
 	}
 }
 
@@ -145,6 +154,41 @@ func (c *workflowSizeChecker) failWorkflowIfBlobSizeExceedsLimit(
 		return false, nil
 	}
 
+	return c.failWorkflowBlobSizeExceedsLimit(message)
+}
+
+// failWorkflowIfPayloadSizeExceedsLimit is failWorkflowIfBlobSizeExceedsLimit for payloads persisted in history events,
+// which are offloaded to the blobstore above payloadOffloadThreshold and then only bound by offloadedBlobSizeLimitError
+func (c *workflowSizeChecker) failWorkflowIfPayloadSizeExceedsLimit(
+	decisionTypeTag metrics.Tag,
+	payload []byte,
+	message string,
+) (bool, error) {
+
+	executionInfo := c.mutableState.GetExecutionInfo()
+	err := common.CheckHistoryEventBlobSizeLimit(
+		[]int{len(payload)},
+		0,
+		c.blobSizeLimitWarn,
+		c.blobSizeLimitError,
+		c.payloadOffloadThreshold,
+		c.offloadedBlobSizeLimitError,
+		executionInfo.DomainID,
+		c.domainName,
+		executionInfo.WorkflowID,
+		executionInfo.RunID,
+		c.metricsScope.Tagged(decisionTypeTag),
+		c.logger,
+		tag.BlobSizeViolationOperation(decisionTypeTag.Value()),
+	)
+	if err == nil {
+		return false, nil
+	}
+
+	return c.failWorkflowBlobSizeExceedsLimit(message)
+}
+
+func (c *workflowSizeChecker) failWorkflowBlobSizeExceedsLimit(message string) (bool, error) {
 	attributes := &types.FailWorkflowExecutionDecisionAttributes{
 		Reason:  common.StringPtr(common.FailureReasonDecisionBlobSizeExceedsLimit),
 		Details: []byte(message),
@@ -288,6 +332,13 @@ func (v *attrValidator) validateActivityScheduleAttributes(
 		attributes.GetStartToCloseTimeoutSeconds() < 0 || attributes.GetHeartbeatTimeoutSeconds() < 0 {
 		return &types.BadRequestError{Message: "A valid timeout may not be negative."}
 	}
+
+	if !taskpriority.IsValid(attributes.GetPriority()) {
+		return &types.BadRequestError{Message: "Priority must be between 1 (highest) and 5 (lowest), or 0 to use the default."}
+	}
+	if !taskpriority.IsValidFairnessKey(attributes.GetFairnessKey()) {
+		return &types.BadRequestError{Message: "FairnessKey exceeds length limit of 128 characters."}
+	}
 	wfTimeout := executionInfo.WorkflowTimeout
 
 	// ensure activity timeout never larger than workflow timeout
@@ -329,14 +380,8 @@ func (v *attrValidator) validateActivityScheduleAttributes(
 	// if SCHEDULE_TO_START timeout is retryable
 	p := attributes.RetryPolicy
 	if p != nil {
-		isScheduleToStartRetryable := true
 		scheduleToStartErrorReason := execution.TimerTypeToReason(execution.TimerTypeScheduleToStart)
-		for _, reason := range p.GetNonRetriableErrorReasons() {
-			if reason == scheduleToStartErrorReason {
-				isScheduleToStartRetryable = false
-				break
-			}
-		}
+		isScheduleToStartRetryable := backoff.IsRetryableErrorReason(p, scheduleToStartErrorReason)
 
 		expiration := p.GetExpirationIntervalInSeconds()
 		if expiration == 0 || expiration > wfTimeout {
@@ -630,6 +675,9 @@ func (v *attrValidator) validateSignalExternalWorkflowExecutionAttributes(
 	if attributes.SignalName == "" {
 		return &types.BadRequestError{Message: "SignalName is not set on decision."}
 	}
+	if query.IsReservedSignalName(attributes.SignalName) {
+		return &types.BadRequestError{Message: "SignalName is reserved by the server."}
+	}
 
 	return nil
 }
@@ -25,6 +25,7 @@ package decision
 import (
 	"maps"
 	"slices"
+	"strings"
 	"testing"
 	"time"
 
@@ -45,10 +46,12 @@ import (
 	"github.com/uber/cadence/common/log/testlogger"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/taskpriority"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/history/config"
 	"github.com/uber/cadence/service/history/constants"
 	"github.com/uber/cadence/service/history/execution"
+	"github.com/uber/cadence/service/history/query"
 )
 
 type (
@@ -156,6 +159,10 @@ func (s *attrValidatorSuite) TestValidateSignalExternalWorkflowExecutionAttribut
 	s.EqualError(err, "Invalid RunId set on decision.")
 	attributes.Execution.RunID = constants.TestRunID
 
+	attributes.SignalName = query.UpdateSignalNamePrefix + "update"
+	err = s.validator.validateSignalExternalWorkflowExecutionAttributes(s.testDomainID, s.testTargetDomainID, attributes, metrics.HistoryRespondDecisionTaskCompletedScope)
+	s.EqualError(err, "SignalName is reserved by the server.")
+
 	attributes.SignalName = "my signal name"
 	err = s.validator.validateSignalExternalWorkflowExecutionAttributes(s.testDomainID, s.testTargetDomainID, attributes, metrics.HistoryRespondDecisionTaskCompletedScope)
 	s.NoError(err)
@@ -784,6 +791,86 @@ func (s *attrValidatorSuite) TestValidateActivityScheduleAttributes_WithRetryPol
 	s.Equal(expectedAttributesAfterValidation, attributes)
 }
 
+func (s *attrValidatorSuite) TestValidateActivityScheduleAttributes_InvalidPriority() {
+	attributes := &types.ScheduleActivityTaskDecisionAttributes{
+		ActivityID: "some random activityID",
+		ActivityType: &types.ActivityType{
+			Name: "some random activity type",
+		},
+		Domain: s.testDomainID,
+		TaskList: &types.TaskList{
+			Name: "some random task list",
+		},
+		ScheduleToCloseTimeoutSeconds: common.Int32Ptr(3),
+		Priority:                      6,
+	}
+
+	domainEntry := cache.NewLocalDomainCacheEntryForTest(
+		&persistence.DomainInfo{Name: s.testDomainID},
+		nil,
+		cluster.TestCurrentClusterName,
+	)
+	targetDomainEntry := cache.NewLocalDomainCacheEntryForTest(
+		&persistence.DomainInfo{Name: s.testTargetDomainID},
+		nil,
+		cluster.TestCurrentClusterName,
+	)
+	executionInfo := &persistence.WorkflowExecutionInfo{
+		WorkflowTimeout: 5,
+	}
+	s.mockDomainCache.EXPECT().GetDomainByID(s.testDomainID).Return(domainEntry, nil).Times(1)
+	s.mockDomainCache.EXPECT().GetDomainByID(s.testTargetDomainID).Return(targetDomainEntry, nil).Times(1)
+
+	err := s.validator.validateActivityScheduleAttributes(
+		s.testDomainID,
+		s.testTargetDomainID,
+		attributes,
+		executionInfo,
+		metrics.HistoryRespondDecisionTaskCompletedScope,
+	)
+	s.IsType(&types.BadRequestError{}, err)
+}
+
+func (s *attrValidatorSuite) TestValidateActivityScheduleAttributes_FairnessKeyTooLong() {
+	attributes := &types.ScheduleActivityTaskDecisionAttributes{
+		ActivityID: "some random activityID",
+		ActivityType: &types.ActivityType{
+			Name: "some random activity type",
+		},
+		Domain: s.testDomainID,
+		TaskList: &types.TaskList{
+			Name: "some random task list",
+		},
+		ScheduleToCloseTimeoutSeconds: common.Int32Ptr(3),
+		FairnessKey:                   strings.Repeat("a", taskpriority.MaxFairnessKeyLength+1),
+	}
+
+	domainEntry := cache.NewLocalDomainCacheEntryForTest(
+		&persistence.DomainInfo{Name: s.testDomainID},
+		nil,
+		cluster.TestCurrentClusterName,
+	)
+	targetDomainEntry := cache.NewLocalDomainCacheEntryForTest(
+		&persistence.DomainInfo{Name: s.testTargetDomainID},
+		nil,
+		cluster.TestCurrentClusterName,
+	)
+	executionInfo := &persistence.WorkflowExecutionInfo{
+		WorkflowTimeout: 5,
+	}
+	s.mockDomainCache.EXPECT().GetDomainByID(s.testDomainID).Return(domainEntry, nil).Times(1)
+	s.mockDomainCache.EXPECT().GetDomainByID(s.testTargetDomainID).Return(targetDomainEntry, nil).Times(1)
+
+	err := s.validator.validateActivityScheduleAttributes(
+		s.testDomainID,
+		s.testTargetDomainID,
+		attributes,
+		executionInfo,
+		metrics.HistoryRespondDecisionTaskCompletedScope,
+	)
+	s.IsType(&types.BadRequestError{}, err)
+}
+
 const (
 	testDomainID   = "test-domain-id"
 	testDomainName = "test-domain"
@@ -874,6 +961,81 @@ func TestWorkflowSizeChecker_failWorkflowIfBlobSizeExceedsLimit(t *testing.T) {
 
 }
 
+func TestWorkflowSizeChecker_failWorkflowIfPayloadSizeExceedsLimit(t *testing.T) {
+	var (
+		testDecisionTag = metrics.DecisionTypeTag(types.DecisionTypeCompleteWorkflowExecution.String())
+		testEventID     = int64(1)
+		testMessage     = "test"
+	)
+
+	for name, tc := range map[string]struct {
+		payloadOffloadThreshold int
+		payload                 []byte
+		expectedLog             string
+		expectFail              bool
+	}{
+		"offloading disabled": {
+			payload:     []byte("should-fail"),
+			expectedLog: "Blob size exceeds limit.",
+			expectFail:  true,
+		},
+		"inline": {
+			payloadOffloadThreshold: 20,
+			payload:                 []byte("should-fail"),
+			expectedLog:             "Blob size exceeds limit.",
+			expectFail:              true,
+		},
+		"offloaded": {
+			payloadOffloadThreshold: 5,
+			payload:                 []byte("should-pass"),
+		},
+		"offloaded too large": {
+			payloadOffloadThreshold: 5,
+			payload:                 []byte("should-fail-offloaded"),
+			expectedLog:             "Offloaded blob size exceeds limit.",
+			expectFail:              true,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mutableState := execution.NewMockMutableState(ctrl)
+			logger, logs := testlogger.NewObserved(t)
+			metricsScope := tally.NewTestScope("test", nil)
+			checker := &workflowSizeChecker{
+				blobSizeLimitWarn:           5,
+				blobSizeLimitError:          10,
+				payloadOffloadThreshold:     tc.payloadOffloadThreshold,
+				offloadedBlobSizeLimitError: 20,
+				completedID:                 testEventID,
+				mutableState:                mutableState,
+				logger:                      logger,
+				metricsScope:                metrics.NewClient(metricsScope, metrics.History, metrics.MigrationConfig{}).Scope(metrics.HistoryRespondDecisionTaskCompletedScope, metrics.DomainTag(testDomainName)),
+			}
+			mutableState.EXPECT().GetExecutionInfo().Return(&persistence.WorkflowExecutionInfo{
+				DomainID:   testDomainID,
+				WorkflowID: testWorkflowID,
+				RunID:      testRunID,
+			}).Times(1)
+			if tc.expectFail {
+				mutableState.EXPECT().AddFailWorkflowEvent(testEventID, &types.FailWorkflowExecutionDecisionAttributes{
+					Reason:  common.StringPtr(common.FailureReasonDecisionBlobSizeExceedsLimit),
+					Details: []byte(testMessage),
+				}).Return(nil, nil).Times(1)
+			}
+			failed, err := checker.failWorkflowIfPayloadSizeExceedsLimit(testDecisionTag, tc.payload, testMessage)
+			require.NoError(t, err)
+			if tc.expectedLog != "" {
+				logEntries := logs.All()
+				require.Len(t, logEntries, 1)
+				assert.Equal(t, tc.expectedLog, logEntries[0].Message)
+			} else {
+				assert.Empty(t, logs.All())
+			}
+			assert.Equal(t, tc.expectFail, failed)
+		})
+	}
+}
+
 func TestWorkflowSizeChecker_failWorkflowSizeExceedsLimit(t *testing.T) {
 	var (
 		testEventID = int64(1)
@@ -183,6 +183,9 @@ func (handler *handlerImpl) HandleDecisionTaskStarted(
 			if !mutableState.IsWorkflowExecutionRunning() {
 				return nil, workflow.ErrNotExists
 			}
+			if mutableState.IsWorkflowExecutionPaused() {
+				return nil, workflow.ErrWorkflowPaused
+			}
 
 			decision, isRunning := mutableState.GetDecisionInfo(scheduleID)
 
@@ -408,6 +411,7 @@ Update_History_Loop:
 			continueAsNewBuilder        execution.MutableState
 			hasUnhandledEvents          bool
 			decisionResults             []*decisionResult
+			completedUpdates            []*types.UpdateWorkflowExecutionResponse
 		)
 		hasUnhandledEvents = msBuilder.HasBufferedEvents()
 
@@ -434,6 +438,8 @@ Update_History_Loop:
 				domainName,
 				handler.config.BlobSizeLimitWarn(domainName),
 				handler.config.BlobSizeLimitError(domainName),
+				handler.config.PayloadOffloadThreshold(domainName),
+				handler.config.OffloadedBlobSizeLimitError(domainName),
 				handler.config.HistorySizeLimitWarn(domainName),
 				handler.config.HistorySizeLimitError(domainName),
 				handler.config.HistoryCountLimitWarn(domainName),
@@ -480,6 +486,7 @@ Update_History_Loop:
 			// continueAsNewTimerTasks is not used by decisionTaskHandler
 			continueAsNewBuilder = decisionTaskHandler.continueAsNewBuilder
 			hasUnhandledEvents = decisionTaskHandler.hasUnhandledEventsBeforeDecisions
+			completedUpdates = decisionTaskHandler.completedUpdates
 		}
 
 		if failDecision {
@@ -492,6 +499,7 @@ Update_History_Loop:
 			}
 			hasUnhandledEvents = true
 			continueAsNewBuilder = nil
+			completedUpdates = nil
 		}
 
 		createNewDecisionTask := msBuilder.IsWorkflowExecutionRunning() && (hasUnhandledEvents || request.GetForceCreateNewDecisionTask() || activityNotStartedCancelled)
@@ -610,6 +618,8 @@ Update_History_Loop:
 			domainEntry,
 			decisionHeartbeating)
 
+		handler.handleCompletedUpdates(msBuilder, completedUpdates, domainEntry)
+
 		if decisionHeartbeatTimeout {
 			// at this point, update is successful, but we still return an error to client so that the worker will give up this workflow
 			return nil, &types.EntityNotExistsError{
@@ -714,6 +724,45 @@ func (handler *handlerImpl) createRecordDecisionTaskStartedResponse(
 	return response, nil
 }
 
+// handleCompletedUpdates unblocks the callers of the updates whose outcome was recorded by the decision,
+// and fails the updates still pending if the decision closed the workflow
+func (handler *handlerImpl) handleCompletedUpdates(
+	msBuilder execution.MutableState,
+	completedUpdates []*types.UpdateWorkflowExecutionResponse,
+	domainEntry *cache.DomainCacheEntry,
+) {
+	updateRegistry := msBuilder.GetUpdateRegistry()
+	if !updateRegistry.HasPendingUpdate() {
+		return
+	}
+
+	scope := handler.metricsClient.Scope(
+		metrics.HistoryRespondDecisionTaskCompletedScope,
+		metrics.DomainTag(domainEntry.GetInfo().Name))
+
+	for _, completion := range completedUpdates {
+		// the caller may be waiting on another host, or may have given up already
+		if err := updateRegistry.CompleteUpdate(completion.UpdateID, completion); err == nil {
+			scope.IncCounter(metrics.WorkflowUpdateCompletedCount)
+		}
+	}
+
+	if msBuilder.IsWorkflowExecutionRunning() {
+		return
+	}
+	for _, id := range updateRegistry.GetPendingIDs() {
+		if err := updateRegistry.FailUpdate(id, workflow.ErrUpdateWorkflowClosed); err != nil {
+			handler.logger.Error(
+				"failed to fail pending update of closed workflow",
+				tag.WorkflowDomainName(domainEntry.GetInfo().Name),
+				tag.WorkflowID(msBuilder.GetExecutionInfo().WorkflowID),
+				tag.WorkflowRunID(msBuilder.GetExecutionInfo().RunID),
+				tag.WorkflowUpdateID(id),
+				tag.Error(err))
+		}
+	}
+}
+
 func (handler *handlerImpl) handleBufferedQueries(
 	msBuilder execution.MutableState,
 	clientImpl string,
@@ -36,6 +36,7 @@ import (
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/history/config"
 	"github.com/uber/cadence/service/history/execution"
+	"github.com/uber/cadence/service/history/query"
 	"github.com/uber/cadence/service/history/workflow"
 )
 
@@ -59,6 +60,7 @@ type (
 		failMessage                       *string
 		activityNotStartedCancelled       bool
 		continueAsNewBuilder              execution.MutableState
+		completedUpdates                  []*types.UpdateWorkflowExecutionResponse
 		stopProcessing                    bool // should stop processing any more decisions
 		mutableState                      execution.MutableState
 
@@ -242,7 +244,7 @@ func (handler *taskHandlerImpl) handleDecisionScheduleActivity(
 		return nil, err
 	}
 
-	failWorkflow, err := handler.sizeLimitChecker.failWorkflowIfBlobSizeExceedsLimit(
+	failWorkflow, err := handler.sizeLimitChecker.failWorkflowIfPayloadSizeExceedsLimit(
 		metrics.DecisionTypeTag(types.DecisionTypeScheduleActivityTask.String()),
 		attr.Input,
 		"ScheduleActivityTaskDecisionAttributes.Input exceeds size limit.",
@@ -433,7 +435,7 @@ func (handler *taskHandlerImpl) handleDecisionCompleteWorkflow(
 		return err
 	}
 
-	failWorkflow, err := handler.sizeLimitChecker.failWorkflowIfBlobSizeExceedsLimit(
+	failWorkflow, err := handler.sizeLimitChecker.failWorkflowIfPayloadSizeExceedsLimit(
 		metrics.DecisionTypeTag(types.DecisionTypeCompleteWorkflowExecution.String()),
 		attr.Result,
 		"CompleteWorkflowExecutionDecisionAttributes.Result exceeds size limit.",
@@ -517,7 +519,7 @@ func (handler *taskHandlerImpl) handleDecisionFailWorkflow(
 		return err
 	}
 
-	failWorkflow, err := handler.sizeLimitChecker.failWorkflowIfBlobSizeExceedsLimit(
+	failWorkflow, err := handler.sizeLimitChecker.failWorkflowIfPayloadSizeExceedsLimit(
 		metrics.DecisionTypeTag(types.DecisionTypeFailWorkflowExecution.String()),
 		attr.Details,
 		"FailWorkflowExecutionDecisionAttributes.Details exceeds size limit.",
@@ -736,19 +738,28 @@ func (handler *taskHandlerImpl) handleDecisionRecordMarker(
 		metrics.DecisionTypeRecordMarkerCounter,
 	)
 
+	completion, isCompletion := query.NewUpdateCompletion(attr)
 	if err := handler.validateDecisionAttr(
 		func() error {
-			return handler.attrValidator.validateRecordMarkerAttributes(
+			if err := handler.attrValidator.validateRecordMarkerAttributes(
 				attr,
 				metrics.HistoryRespondDecisionTaskCompletedScope,
-				handler.domainEntry.GetInfo().Name)
+				handler.domainEntry.GetInfo().Name,
+			); err != nil {
+				return err
+			}
+			// the outcome of an update can only be recorded once, and only for an update accepted by the server
+			if isCompletion && !handler.mutableState.IsUpdateAccepted(completion.UpdateID) {
+				return &types.BadRequestError{Message: "Completed update marker does not match a pending update."}
+			}
+			return nil
 		},
 		types.DecisionTaskFailedCauseBadRecordMarkerAttributes,
 	); err != nil || handler.stopProcessing {
 		return err
 	}
 
-	failWorkflow, err := handler.sizeLimitChecker.failWorkflowIfBlobSizeExceedsLimit(
+	failWorkflow, err := handler.sizeLimitChecker.failWorkflowIfPayloadSizeExceedsLimit(
 		metrics.DecisionTypeTag(types.DecisionTypeRecordMarker.String()),
 		attr.Details,
 		"RecordMarkerDecisionAttributes.Details exceeds size limit.",
@@ -758,8 +769,13 @@ func (handler *taskHandlerImpl) handleDecisionRecordMarker(
 		return err
 	}
 
-	_, err = handler.mutableState.AddRecordMarkerEvent(handler.decisionTaskCompletedID, attr)
-	return err
+	if _, err = handler.mutableState.AddRecordMarkerEvent(handler.decisionTaskCompletedID, attr); err != nil {
+		return err
+	}
+	if isCompletion {
+		handler.completedUpdates = append(handler.completedUpdates, completion)
+	}
+	return nil
 }
 
 func (handler *taskHandlerImpl) handleDecisionContinueAsNewWorkflow(
@@ -795,7 +811,7 @@ func (handler *taskHandlerImpl) handleDecisionContinueAsNewWorkflow(
 		return err
 	}
 
-	failWorkflow, err := handler.sizeLimitChecker.failWorkflowIfBlobSizeExceedsLimit(
+	failWorkflow, err := handler.sizeLimitChecker.failWorkflowIfPayloadSizeExceedsLimit(
 		metrics.DecisionTypeTag(types.DecisionTypeContinueAsNewWorkflowExecution.String()),
 		attr.Input,
 		"ContinueAsNewWorkflowExecutionDecisionAttributes. Input exceeds size limit.",
@@ -895,7 +911,7 @@ func (handler *taskHandlerImpl) handleDecisionStartChildWorkflow(
 		return err
 	}
 
-	failWorkflow, err := handler.sizeLimitChecker.failWorkflowIfBlobSizeExceedsLimit(
+	failWorkflow, err := handler.sizeLimitChecker.failWorkflowIfPayloadSizeExceedsLimit(
 		metrics.DecisionTypeTag(types.DecisionTypeStartChildWorkflowExecution.String()),
 		attr.Input,
 		"StartChildWorkflowExecutionDecisionAttributes.Input exceeds size limit.",
@@ -964,7 +980,7 @@ func (handler *taskHandlerImpl) handleDecisionSignalExternalWorkflow(
 		return err
 	}
 
-	failWorkflow, err := handler.sizeLimitChecker.failWorkflowIfBlobSizeExceedsLimit(
+	failWorkflow, err := handler.sizeLimitChecker.failWorkflowIfPayloadSizeExceedsLimit(
 		metrics.DecisionTypeTag(types.DecisionTypeSignalExternalWorkflowExecution.String()),
 		attr.Input,
 		"SignalExternalWorkflowExecutionDecisionAttributes.Input exceeds size limit.",
@@ -50,6 +50,7 @@ import (
 	"github.com/uber/cadence/service/history/config"
 	"github.com/uber/cadence/service/history/constants"
 	"github.com/uber/cadence/service/history/execution"
+	"github.com/uber/cadence/service/history/query"
 	"github.com/uber/cadence/service/history/workflow"
 )
 
@@ -1214,6 +1215,39 @@ func TestHandleDecisionRecordMarker(t *testing.T) {
 				assert.Nil(t, err)
 			},
 		},
+		{
+			name: "completed update marker of a pending update",
+			attributes: &types.RecordMarkerDecisionAttributes{
+				MarkerName: query.UpdateCompletedMarkerName,
+				Details:    []byte("result"),
+				Header:     &types.Header{Fields: map[string][]byte{query.UpdateIDHeaderKey: []byte("update-id")}},
+			},
+			expectMockCalls: func(taskHandler *taskHandlerImpl, attr *types.RecordMarkerDecisionAttributes) {
+				taskHandler.mutableState.(*execution.MockMutableState).EXPECT().IsUpdateAccepted("update-id").Return(true)
+				taskHandler.mutableState.(*execution.MockMutableState).EXPECT().GetExecutionInfo().Return(&persistence.WorkflowExecutionInfo{})
+				taskHandler.mutableState.(*execution.MockMutableState).EXPECT().AddRecordMarkerEvent(taskHandler.decisionTaskCompletedID, attr)
+			},
+			asserts: func(t *testing.T, taskHandler *taskHandlerImpl, attr *types.RecordMarkerDecisionAttributes, err error) {
+				assert.Nil(t, err)
+				assert.Equal(t, []*types.UpdateWorkflowExecutionResponse{{UpdateID: "update-id", Result: []byte("result")}}, taskHandler.completedUpdates)
+			},
+		},
+		{
+			name: "completed update marker of an unknown update",
+			attributes: &types.RecordMarkerDecisionAttributes{
+				MarkerName: query.UpdateCompletedMarkerName,
+				Header:     &types.Header{Fields: map[string][]byte{query.UpdateIDHeaderKey: []byte("update-id")}},
+			},
+			expectMockCalls: func(taskHandler *taskHandlerImpl, attr *types.RecordMarkerDecisionAttributes) {
+				taskHandler.mutableState.(*execution.MockMutableState).EXPECT().IsUpdateAccepted("update-id").Return(false)
+			},
+			asserts: func(t *testing.T, taskHandler *taskHandlerImpl, attr *types.RecordMarkerDecisionAttributes, err error) {
+				assert.Nil(t, err)
+				assert.Equal(t, types.DecisionTaskFailedCauseBadRecordMarkerAttributes, *taskHandler.failDecisionCause)
+				assert.True(t, taskHandler.stopProcessing)
+				assert.Empty(t, taskHandler.completedUpdates)
+			},
+		},
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
@@ -1630,6 +1664,8 @@ func newTaskHandlerForTest(t *testing.T) *taskHandlerImpl {
 		"testDomain",
 		testConfig.BlobSizeLimitWarn(constants.TestDomainName),
 		testConfig.BlobSizeLimitError(constants.TestDomainName),
+		testConfig.PayloadOffloadThreshold(constants.TestDomainName),
+		testConfig.OffloadedBlobSizeLimitError(constants.TestDomainName),
 		testConfig.HistorySizeLimitWarn(constants.TestDomainName),
 		testConfig.HistorySizeLimitError(constants.TestDomainName),
 		testConfig.HistoryCountLimitWarn(constants.TestDomainName),
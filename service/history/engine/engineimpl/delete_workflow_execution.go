@@ -0,0 +1,207 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package engineimpl
+
+import (
+	"context"
+	"errors"
+
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+	hcommon "github.com/uber/cadence/service/history/common"
+	"github.com/uber/cadence/service/history/execution"
+	"github.com/uber/cadence/service/history/shard"
+	"github.com/uber/cadence/service/history/task"
+)
+
+// DeleteWorkflowExecution schedules the deletion of a closed workflow execution. The execution,
+// its history, visibility records and archived copies are removed by the transfer queue. If the
+// execution has already been removed after retention, only the archived copies are left and their
+// deletion is scheduled the same way.
+func (e *historyEngineImpl) DeleteWorkflowExecution(
+	ctx context.Context,
+	deleteRequest *types.HistoryDeleteWorkflowExecutionRequest,
+) (retError error) {
+	request := deleteRequest.GetDeleteRequest()
+	workflowExecution := types.WorkflowExecution{
+		WorkflowID: request.GetWorkflowExecution().GetWorkflowID(),
+		RunID:      request.GetWorkflowExecution().GetRunID(),
+	}
+	domainEntry, err := e.getActiveDomainByWorkflow(ctx, deleteRequest.GetDomainUUID(), workflowExecution.WorkflowID, workflowExecution.RunID)
+	if err != nil {
+		return err
+	}
+	domainID := domainEntry.GetInfo().ID
+	// reject up front instead of leaving a task behind that can never delete the archives
+	hasArchives, err := hasArchivedCopies(e.shard, domainEntry)
+	if err != nil {
+		return err
+	}
+	replicate := domainEntry.GetReplicationPolicy() == cache.ReplicationPolicyMultiCluster &&
+		e.shard.GetConfig().EnableReplicationTaskGeneration(domainID, workflowExecution.WorkflowID)
+
+	wfContext, release, err := e.executionCache.GetOrCreateWorkflowExecution(ctx, domainID, workflowExecution)
+	if err != nil {
+		return err
+	}
+	defer func() { release(retError) }()
+
+	mutableState, err := wfContext.LoadWorkflowExecution(ctx)
+	if err != nil {
+		var notExistsErr *types.EntityNotExistsError
+		if errors.As(err, &notExistsErr) && hasArchives {
+			return e.scheduleArchivedWorkflowDeletion(ctx, domainEntry, workflowExecution, replicate)
+		}
+		return err
+	}
+	if mutableState.IsWorkflowExecutionRunning() {
+		return &types.BadRequestError{Message: "Workflow execution is still running, terminate it before deleting."}
+	}
+	return e.scheduleWorkflowDeletion(ctx, wfContext, mutableState, replicate)
+}
+
+// ReplicateDeleteWorkflowExecution applies the deletion of a closed workflow execution replicated
+// from the active cluster, the execution is deleted by the standby transfer queue.
+func (e *historyEngineImpl) ReplicateDeleteWorkflowExecution(
+	ctx context.Context,
+	attributes *types.DeleteWorkflowExecutionTaskAttributes,
+) (retError error) {
+	domainEntry, err := e.shard.GetDomainCache().GetDomainByID(attributes.GetDomainID())
+	if err != nil {
+		return err
+	}
+	workflowExecution := types.WorkflowExecution{
+		WorkflowID: attributes.GetWorkflowID(),
+		RunID:      attributes.GetRunID(),
+	}
+
+	wfContext, release, err := e.executionCache.GetOrCreateWorkflowExecution(ctx, attributes.GetDomainID(), workflowExecution)
+	if err != nil {
+		return err
+	}
+	defer func() { release(retError) }()
+
+	mutableState, err := wfContext.LoadWorkflowExecution(ctx)
+	if err != nil {
+		var notExistsErr *types.EntityNotExistsError
+		if errors.As(err, &notExistsErr) {
+			// retention removed the execution in this cluster already, only the archived copies are left
+			hasArchives, err := hasArchivedCopies(e.shard, domainEntry)
+			if err != nil || !hasArchives {
+				return err
+			}
+			return e.scheduleArchivedWorkflowDeletion(ctx, domainEntry, workflowExecution, false)
+		}
+		return err
+	}
+	if mutableState.IsWorkflowExecutionRunning() {
+		// the close of the execution is replicated before its deletion, retry until it is applied
+		return &types.InternalServiceError{Message: "Workflow execution is still running in this cluster, retry the deletion later."}
+	}
+	return e.scheduleWorkflowDeletion(ctx, wfContext, mutableState, false)
+}
+
+// scheduleWorkflowDeletion persists the transfer task which deletes a closed workflow execution,
+// and the replication task which deletes it in the other clusters if replicate is set.
+func (e *historyEngineImpl) scheduleWorkflowDeletion(
+	ctx context.Context,
+	wfContext execution.Context,
+	mutableState execution.MutableState,
+	replicate bool,
+) error {
+	lastWriteVersion, err := mutableState.GetLastWriteVersion()
+	if err != nil {
+		return err
+	}
+	executionInfo := mutableState.GetExecutionInfo()
+	workflowIdentifier := persistence.WorkflowIdentifier{
+		DomainID:   executionInfo.DomainID,
+		WorkflowID: executionInfo.WorkflowID,
+		RunID:      executionInfo.RunID,
+	}
+	mutableState.AddTransferTasks(&persistence.DeleteWorkflowExecutionTask{
+		WorkflowIdentifier: workflowIdentifier,
+		TaskData: persistence.TaskData{
+			Version: lastWriteVersion,
+		},
+		TaskList: executionInfo.TaskList,
+	})
+	if replicate {
+		mutableState.AddReplicationTasks(&persistence.DeleteWorkflowExecutionReplicationTask{
+			WorkflowIdentifier: workflowIdentifier,
+			TaskData: persistence.TaskData{
+				Version: lastWriteVersion,
+			},
+		})
+	}
+	return wfContext.UpdateWorkflowExecutionTasks(ctx, e.shard.GetTimeSource().Now())
+}
+
+// scheduleArchivedWorkflowDeletion persists the transfer task which deletes the archived copies of a
+// workflow execution that is no longer in the execution store, and the replication task which
+// deletes them in the other clusters if replicate is set.
+func (e *historyEngineImpl) scheduleArchivedWorkflowDeletion(
+	ctx context.Context,
+	domainEntry *cache.DomainCacheEntry,
+	workflowExecution types.WorkflowExecution,
+	replicate bool,
+) error {
+	workflowIdentifier := persistence.WorkflowIdentifier{
+		DomainID:   domainEntry.GetInfo().ID,
+		WorkflowID: workflowExecution.WorkflowID,
+		RunID:      workflowExecution.RunID,
+	}
+	transferTask := &persistence.DeleteWorkflowExecutionTask{
+		WorkflowIdentifier: workflowIdentifier,
+		TaskData: persistence.TaskData{
+			Version: domainEntry.GetFailoverVersion(),
+		},
+	}
+	tasks := []persistence.Task{transferTask}
+	if replicate {
+		tasks = append(tasks, &persistence.DeleteWorkflowExecutionReplicationTask{
+			WorkflowIdentifier: workflowIdentifier,
+			TaskData: persistence.TaskData{
+				Version: domainEntry.GetFailoverVersion(),
+			},
+		})
+	}
+	if err := e.shard.ReinjectHistoryTasks(ctx, tasks); err != nil {
+		return err
+	}
+	e.NotifyNewTransferTasks(&hcommon.NotifyTaskInfo{Tasks: []persistence.Task{transferTask}})
+	return nil
+}
+
+// hasArchivedCopies returns true if the history or the visibility records of the domain's workflows
+// are archived, and fails if an archive can't be deleted from
+func hasArchivedCopies(shard shard.Context, domainEntry *cache.DomainCacheEntry) (bool, error) {
+	historyDeleter, _, err := task.GetArchivedHistoryDeleter(shard, domainEntry)
+	if err != nil {
+		return false, err
+	}
+	visibilityDeleter, _, err := task.GetArchivedVisibilityDeleter(shard, domainEntry)
+	if err != nil {
+		return false, err
+	}
+	return historyDeleter != nil || visibilityDeleter != nil, nil
+}
@@ -109,6 +109,7 @@ func createDescribeWorkflowExecutionResponse(ctx context.Context, mutableState e
 	if err != nil {
 		return nil, err
 	}
+	workflowExecutionInfo.IsPaused = mutableState.IsWorkflowExecutionPaused()
 	result.WorkflowExecutionInfo = workflowExecutionInfo
 
 	pendingActivityInfos := mutableState.GetPendingActivityInfos()
@@ -119,6 +120,7 @@ func createDescribeWorkflowExecutionResponse(ctx context.Context, mutableState e
 		}
 
 		p := mapPendingActivityInfo(ai, scheduledEvent)
+		p.IsPaused = mutableState.IsActivityPaused(ai.ScheduleID)
 		result.PendingActivities = append(result.PendingActivities, p)
 	}
 
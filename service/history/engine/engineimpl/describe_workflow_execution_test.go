@@ -80,6 +80,7 @@ func TestDescribeWorkflowExecution(t *testing.T) {
 					},
 				}, nil)
 				mockMutableState.EXPECT().GetNextEventID().Return(int64(10))
+				mockMutableState.EXPECT().IsWorkflowExecutionPaused().Return(false)
 				mockMutableState.EXPECT().GetPendingActivityInfos().Return(map[int64]*persistence.ActivityInfo{})
 				mockMutableState.EXPECT().GetPendingChildExecutionInfos().Return(map[int64]*persistence.ChildExecutionInfo{})
 				mockMutableState.EXPECT().GetDomainEntry().Return(domainEntry)
@@ -272,6 +273,7 @@ func TestCreateDescribeWorkflowExecutionResponse(t *testing.T) {
 				mockMutableState.EXPECT().GetExecutionInfo().Return(executionInfo)
 				mockMutableState.EXPECT().GetStartEvent(gomock.Any()).Return(startEvent, nil)
 				mockMutableState.EXPECT().GetNextEventID().Return(int64(10))
+				mockMutableState.EXPECT().IsWorkflowExecutionPaused().Return(true)
 				mockMutableState.EXPECT().GetPendingActivityInfos().Return(map[int64]*persistence.ActivityInfo{})
 				mockMutableState.EXPECT().GetPendingChildExecutionInfos().Return(map[int64]*persistence.ChildExecutionInfo{})
 				mockMutableState.EXPECT().GetDomainEntry().Return(&cache.DomainCacheEntry{})
@@ -284,6 +286,7 @@ func TestCreateDescribeWorkflowExecutionResponse(t *testing.T) {
 				assert.Empty(t, result.PendingActivities)
 				assert.Empty(t, result.PendingChildren)
 				assert.Nil(t, result.PendingDecision)
+				assert.True(t, result.WorkflowExecutionInfo.IsPaused)
 			},
 		},
 		{
@@ -371,6 +374,7 @@ func TestCreateDescribeWorkflowExecutionResponse(t *testing.T) {
 				mockMutableState.EXPECT().GetExecutionInfo().Return(executionInfo)
 				mockMutableState.EXPECT().GetStartEvent(gomock.Any()).Return(startEvent, nil)
 				mockMutableState.EXPECT().GetNextEventID().Return(int64(10))
+				mockMutableState.EXPECT().IsWorkflowExecutionPaused().Return(false)
 				mockMutableState.EXPECT().GetPendingActivityInfos().Return(map[int64]*persistence.ActivityInfo{123: activityInfo})
 				mockMutableState.EXPECT().GetActivityScheduledEvent(gomock.Any(), int64(123)).Return(nil, &types.InternalServiceError{Message: "Failed to get activity scheduled event"})
 			},
@@ -403,6 +407,7 @@ func TestCreateDescribeWorkflowExecutionResponse(t *testing.T) {
 				mockMutableState.EXPECT().GetExecutionInfo().Return(executionInfo)
 				mockMutableState.EXPECT().GetStartEvent(gomock.Any()).Return(startEvent, nil)
 				mockMutableState.EXPECT().GetNextEventID().Return(int64(10))
+				mockMutableState.EXPECT().IsWorkflowExecutionPaused().Return(false)
 				mockMutableState.EXPECT().GetPendingActivityInfos().Return(map[int64]*persistence.ActivityInfo{})
 				mockMutableState.EXPECT().GetPendingChildExecutionInfos().Return(map[int64]*persistence.ChildExecutionInfo{456: childExecutionInfo})
 				mockMutableState.EXPECT().GetDomainEntry().Return(domainEntry)
@@ -450,8 +455,10 @@ func TestCreateDescribeWorkflowExecutionResponse(t *testing.T) {
 				mockMutableState.EXPECT().GetExecutionInfo().Return(executionInfo)
 				mockMutableState.EXPECT().GetStartEvent(gomock.Any()).Return(startEvent, nil)
 				mockMutableState.EXPECT().GetNextEventID().Return(int64(10))
+				mockMutableState.EXPECT().IsWorkflowExecutionPaused().Return(false)
 				mockMutableState.EXPECT().GetPendingActivityInfos().Return(map[int64]*persistence.ActivityInfo{123: activityInfo})
 				mockMutableState.EXPECT().GetActivityScheduledEvent(gomock.Any(), int64(123)).Return(scheduledEvent, nil)
+				mockMutableState.EXPECT().IsActivityPaused(int64(123)).Return(true)
 				mockMutableState.EXPECT().GetPendingChildExecutionInfos().Return(map[int64]*persistence.ChildExecutionInfo{456: childExecutionInfo})
 				mockMutableState.EXPECT().GetDomainEntry().Return(domainEntry)
 				mockDomainCache.EXPECT().GetDomainName("child-domain-id").Return("child-domain-name", nil)
@@ -462,6 +469,7 @@ func TestCreateDescribeWorkflowExecutionResponse(t *testing.T) {
 				assert.NotNil(t, result.ExecutionConfiguration)
 				assert.NotNil(t, result.WorkflowExecutionInfo)
 				assert.Len(t, result.PendingActivities, 1)
+				assert.True(t, result.PendingActivities[0].IsPaused)
 				assert.Len(t, result.PendingChildren, 1)
 				assert.NotNil(t, result.PendingDecision)
 			},
@@ -147,6 +147,30 @@ func convertToReplicationTask(taskInfo *types.ReplicationTaskInfo) (persistence.
 				Version: taskInfo.Version,
 			},
 		}, nil
+	case persistence.ReplicationTaskTypeDeleteWorkflowExecution:
+		return &persistence.DeleteWorkflowExecutionReplicationTask{
+			WorkflowIdentifier: persistence.WorkflowIdentifier{
+				DomainID:   taskInfo.DomainID,
+				WorkflowID: taskInfo.WorkflowID,
+				RunID:      taskInfo.RunID,
+			},
+			TaskData: persistence.TaskData{
+				TaskID:  taskInfo.TaskID,
+				Version: taskInfo.Version,
+			},
+		}, nil
+	case persistence.ReplicationTaskTypeUpsertWorkflowSearchAttributes:
+		return &persistence.UpsertWorkflowSearchAttributesReplicationTask{
+			WorkflowIdentifier: persistence.WorkflowIdentifier{
+				DomainID:   taskInfo.DomainID,
+				WorkflowID: taskInfo.WorkflowID,
+				RunID:      taskInfo.RunID,
+			},
+			TaskData: persistence.TaskData{
+				TaskID:  taskInfo.TaskID,
+				Version: taskInfo.Version,
+			},
+		}, nil
 	default:
 		return nil, fmt.Errorf("unsupported task type: %v", taskInfo.TaskType)
 	}
@@ -73,7 +73,8 @@ const (
 )
 
 var (
-	errDomainDeprecated = &types.BadRequestError{Message: "Domain is deprecated."}
+	errDomainDeprecated   = &types.BadRequestError{Message: "Domain is deprecated."}
+	errSignalNameReserved = &types.BadRequestError{Message: "SignalName is reserved by the server."}
 )
 
 type historyEngineImpl struct {
@@ -115,12 +115,16 @@ func hydrateReplicationTask(
 		info.ScheduledID = t.ScheduledID
 	case *persistence.FailoverMarkerTask:
 		// No specific fields, but supported
+	case *persistence.DeleteWorkflowExecutionReplicationTask:
+		// No specific fields, but supported
+	case *persistence.UpsertWorkflowSearchAttributesReplicationTask:
+		// No specific fields, but supported
 	default:
 		return nil, errors.New("unknown replication task")
 	}
 
 	hydrator := replication.NewImmediateTaskHydrator(
-		exec.IsRunning(),
+		exec,
 		versionHistories,
 		activities,
 		history.Find(info.BranchToken, info.FirstEventID),
@@ -0,0 +1,85 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package engineimpl
+
+import (
+	"context"
+
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/history/execution"
+	"github.com/uber/cadence/service/history/workflow"
+)
+
+// PauseWorkflowExecution stops dispatching decision and activity tasks and firing user timers of the workflow,
+// signals are still recorded and are handled by the first decision after the workflow is unpaused
+func (e *historyEngineImpl) PauseWorkflowExecution(
+	ctx context.Context,
+	pauseRequest *types.HistoryPauseWorkflowExecutionRequest,
+) error {
+	request := pauseRequest.GetPauseRequest()
+	workflowExecution := types.WorkflowExecution{
+		WorkflowID: request.GetWorkflowExecution().GetWorkflowID(),
+		RunID:      request.GetWorkflowExecution().GetRunID(),
+	}
+	domainEntry, err := e.getActiveDomainByWorkflow(ctx, pauseRequest.GetDomainUUID(), workflowExecution.WorkflowID, workflowExecution.RunID)
+	if err != nil {
+		return err
+	}
+	if domainEntry.GetInfo().Status != persistence.DomainStatusRegistered {
+		return errDomainDeprecated
+	}
+	domainID := domainEntry.GetInfo().ID
+
+	return workflow.UpdateCurrentWithActionFunc(
+		ctx,
+		e.logger,
+		e.executionCache,
+		e.executionManager,
+		e.shard.GetShardID(),
+		domainID,
+		e.shard.GetDomainCache(),
+		workflowExecution,
+		e.timeSource.Now(),
+		func(wfContext execution.Context, mutableState execution.MutableState) (*workflow.UpdateAction, error) {
+			if !mutableState.IsWorkflowExecutionRunning() {
+				return nil, workflow.ErrAlreadyCompleted
+			}
+			if mutableState.IsWorkflowExecutionPaused() {
+				return &workflow.UpdateAction{
+					Noop:           true,
+					CreateDecision: false,
+				}, nil
+			}
+
+			if _, err := mutableState.AddWorkflowExecutionPausedEvent(request.GetReason(), request.GetIdentity()); err != nil {
+				return nil, &types.InternalServiceError{Message: "Unable to pause workflow execution."}
+			}
+			e.logger.Info("Workflow execution paused.",
+				tag.WorkflowDomainID(domainID),
+				tag.WorkflowID(workflowExecution.WorkflowID),
+				tag.WorkflowRunID(workflowExecution.RunID),
+				tag.Reason(request.GetReason()),
+				tag.ActorID(request.GetIdentity()))
+			return workflow.UpdateWithoutDecision, nil
+		})
+}
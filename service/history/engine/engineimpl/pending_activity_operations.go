@@ -0,0 +1,166 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package engineimpl
+
+import (
+	"context"
+
+	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/history/execution"
+	"github.com/uber/cadence/service/history/workflow"
+)
+
+var (
+	errActivityRunning = &types.BadRequestError{Message: "Activity is running, only an activity waiting for its retry backoff can be retried now."}
+	errActivityPaused  = &types.BadRequestError{Message: "Activity is paused, unpause it to retry it."}
+)
+
+// PauseActivity stops dispatching the attempts of a pending activity until it is unpaused
+func (e *historyEngineImpl) PauseActivity(
+	ctx context.Context,
+	pauseRequest *types.HistoryPauseActivityRequest,
+) error {
+	request := pauseRequest.GetPauseRequest()
+	return e.updatePendingActivity(
+		ctx,
+		pauseRequest.GetDomainUUID(),
+		request.GetWorkflowExecution(),
+		request.GetActivityID(),
+		func(mutableState execution.MutableState, ai *persistence.ActivityInfo) (*workflow.UpdateAction, error) {
+			if mutableState.IsActivityPaused(ai.ScheduleID) {
+				return &workflow.UpdateAction{Noop: true}, nil
+			}
+			if err := mutableState.PauseActivity(ai.ScheduleID); err != nil {
+				return nil, &types.InternalServiceError{Message: "Unable to pause activity."}
+			}
+			return workflow.UpdateWithoutDecision, nil
+		})
+}
+
+// UnpauseActivity resumes dispatching the attempts of a paused activity
+func (e *historyEngineImpl) UnpauseActivity(
+	ctx context.Context,
+	unpauseRequest *types.HistoryUnpauseActivityRequest,
+) error {
+	request := unpauseRequest.GetUnpauseRequest()
+	return e.updatePendingActivity(
+		ctx,
+		unpauseRequest.GetDomainUUID(),
+		request.GetWorkflowExecution(),
+		request.GetActivityID(),
+		func(mutableState execution.MutableState, ai *persistence.ActivityInfo) (*workflow.UpdateAction, error) {
+			if !mutableState.IsActivityPaused(ai.ScheduleID) {
+				return &workflow.UpdateAction{Noop: true}, nil
+			}
+			if err := mutableState.UnpauseActivity(ai.ScheduleID); err != nil {
+				return nil, &types.InternalServiceError{Message: "Unable to unpause activity."}
+			}
+			return workflow.UpdateWithoutDecision, nil
+		})
+}
+
+// ResetActivityAttempt sets the attempt count of a pending activity back to 0
+func (e *historyEngineImpl) ResetActivityAttempt(
+	ctx context.Context,
+	resetRequest *types.HistoryResetActivityAttemptRequest,
+) error {
+	request := resetRequest.GetResetRequest()
+	return e.updatePendingActivity(
+		ctx,
+		resetRequest.GetDomainUUID(),
+		request.GetWorkflowExecution(),
+		request.GetActivityID(),
+		func(mutableState execution.MutableState, ai *persistence.ActivityInfo) (*workflow.UpdateAction, error) {
+			if err := mutableState.ResetActivityAttempt(ai.ScheduleID); err != nil {
+				return nil, &types.InternalServiceError{Message: "Unable to reset activity attempt."}
+			}
+			return workflow.UpdateWithoutDecision, nil
+		})
+}
+
+// RetryActivityNow dispatches the next attempt of an activity waiting for its retry backoff immediately
+func (e *historyEngineImpl) RetryActivityNow(
+	ctx context.Context,
+	retryRequest *types.HistoryRetryActivityNowRequest,
+) error {
+	request := retryRequest.GetRetryRequest()
+	return e.updatePendingActivity(
+		ctx,
+		retryRequest.GetDomainUUID(),
+		request.GetWorkflowExecution(),
+		request.GetActivityID(),
+		func(mutableState execution.MutableState, ai *persistence.ActivityInfo) (*workflow.UpdateAction, error) {
+			if ai.StartedID != constants.EmptyEventID {
+				return nil, errActivityRunning
+			}
+			if mutableState.IsActivityPaused(ai.ScheduleID) {
+				return nil, errActivityPaused
+			}
+			if err := mutableState.RetryActivityNow(ai.ScheduleID); err != nil {
+				return nil, &types.InternalServiceError{Message: "Unable to retry activity."}
+			}
+			return workflow.UpdateWithoutDecision, nil
+		})
+}
+
+func (e *historyEngineImpl) updatePendingActivity(
+	ctx context.Context,
+	domainUUID string,
+	requestExecution *types.WorkflowExecution,
+	activityID string,
+	action func(mutableState execution.MutableState, ai *persistence.ActivityInfo) (*workflow.UpdateAction, error),
+) error {
+	workflowExecution := types.WorkflowExecution{
+		WorkflowID: requestExecution.GetWorkflowID(),
+		RunID:      requestExecution.GetRunID(),
+	}
+	domainEntry, err := e.getActiveDomainByWorkflow(ctx, domainUUID, workflowExecution.WorkflowID, workflowExecution.RunID)
+	if err != nil {
+		return err
+	}
+	if domainEntry.GetInfo().Status != persistence.DomainStatusRegistered {
+		return errDomainDeprecated
+	}
+	domainID := domainEntry.GetInfo().ID
+
+	return workflow.UpdateCurrentWithActionFunc(
+		ctx,
+		e.logger,
+		e.executionCache,
+		e.executionManager,
+		e.shard.GetShardID(),
+		domainID,
+		e.shard.GetDomainCache(),
+		workflowExecution,
+		e.timeSource.Now(),
+		func(wfContext execution.Context, mutableState execution.MutableState) (*workflow.UpdateAction, error) {
+			if !mutableState.IsWorkflowExecutionRunning() {
+				return nil, workflow.ErrAlreadyCompleted
+			}
+			ai, ok := mutableState.GetActivityByActivityID(activityID)
+			if !ok {
+				return nil, workflow.ErrActivityTaskNotFound
+			}
+			return action(mutableState, ai)
+		})
+}
@@ -62,6 +62,9 @@ func (e *historyEngineImpl) RecordActivityTaskStarted(
 			if !mutableState.IsWorkflowExecutionRunning() {
 				return workflow.ErrNotExists
 			}
+			if mutableState.IsWorkflowExecutionPaused() {
+				return workflow.ErrWorkflowPaused
+			}
 
 			scheduleID := request.GetScheduleID()
 			requestID := request.GetRequestID()
@@ -148,6 +151,10 @@ func (e *historyEngineImpl) RecordActivityTaskStarted(
 				return &types.EventAlreadyStartedError{Message: "Activity task already started."}
 			}
 
+			if mutableState.IsActivityPaused(scheduleID) {
+				return workflow.ErrActivityPaused
+			}
+
 			if _, err := mutableState.AddActivityTaskStartedEvent(
 				ai, scheduleID, requestID, request.PollRequest.GetIdentity(),
 			); err != nil {
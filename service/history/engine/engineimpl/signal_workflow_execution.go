@@ -28,6 +28,7 @@ import (
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/history/execution"
+	"github.com/uber/cadence/service/history/query"
 	"github.com/uber/cadence/service/history/workflow"
 )
 
@@ -36,6 +37,9 @@ func (e *historyEngineImpl) SignalWorkflowExecution(
 	signalRequest *types.HistorySignalWorkflowExecutionRequest,
 ) error {
 	request := signalRequest.SignalRequest
+	if err := validateSignal(request.GetSignalName()); err != nil {
+		return err
+	}
 	workflowExecution := types.WorkflowExecution{
 		WorkflowID: request.WorkflowExecution.WorkflowID,
 		RunID:      request.WorkflowExecution.RunID,
@@ -128,3 +132,11 @@ func (e *historyEngineImpl) SignalWorkflowExecution(
 			}, nil
 		})
 }
+
+// validateSignal rejects the signals using a name reserved by the server
+func validateSignal(signalName string) error {
+	if query.IsReservedSignalName(signalName) {
+		return errSignalNameReserved
+	}
+	return nil
+}
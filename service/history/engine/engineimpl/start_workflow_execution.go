@@ -484,6 +484,9 @@ func (e *historyEngineImpl) SignalWithStartWorkflowExecution(
 	domainID := domainEntry.GetInfo().ID
 
 	sRequest := signalWithStartRequest.SignalWithStartRequest
+	if err := validateSignal(sRequest.GetSignalName()); err != nil {
+		return nil, err
+	}
 	workflowExecution := types.WorkflowExecution{
 		WorkflowID: sRequest.WorkflowID,
 	}
@@ -666,6 +669,8 @@ func getStartRequest(
 		JitterStartSeconds:                  request.JitterStartSeconds,
 		FirstRunAtTimeStamp:                 request.FirstRunAtTimestamp,
 		ActiveClusterSelectionPolicy:        request.ActiveClusterSelectionPolicy,
+		Priority:                            request.Priority,
+		FairnessKey:                         request.FairnessKey,
 	}
 
 	return common.CreateHistoryStartWorkflowRequest(domainID, req, time.Now(), partitionConfig)
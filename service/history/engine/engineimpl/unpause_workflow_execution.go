@@ -0,0 +1,103 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package engineimpl
+
+import (
+	"context"
+
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/history/execution"
+	"github.com/uber/cadence/service/history/workflow"
+)
+
+// UnpauseWorkflowExecution resumes a paused workflow. The tasks dropped while the workflow was paused
+// are regenerated from the mutable state, so the workflow continues from where it stopped.
+func (e *historyEngineImpl) UnpauseWorkflowExecution(
+	ctx context.Context,
+	unpauseRequest *types.HistoryUnpauseWorkflowExecutionRequest,
+) error {
+	request := unpauseRequest.GetUnpauseRequest()
+	workflowExecution := types.WorkflowExecution{
+		WorkflowID: request.GetWorkflowExecution().GetWorkflowID(),
+		RunID:      request.GetWorkflowExecution().GetRunID(),
+	}
+	domainEntry, err := e.getActiveDomainByWorkflow(ctx, unpauseRequest.GetDomainUUID(), workflowExecution.WorkflowID, workflowExecution.RunID)
+	if err != nil {
+		return err
+	}
+	if domainEntry.GetInfo().Status != persistence.DomainStatusRegistered {
+		return errDomainDeprecated
+	}
+	domainID := domainEntry.GetInfo().ID
+
+	mutableStateTaskRefresher := execution.NewMutableStateTaskRefresher(
+		e.shard.GetConfig(),
+		e.shard.GetClusterMetadata(),
+		e.shard.GetDomainCache(),
+		e.shard.GetEventsCache(),
+		e.shard.GetShardID(),
+		e.logger,
+	)
+
+	return workflow.UpdateCurrentWithActionFunc(
+		ctx,
+		e.logger,
+		e.executionCache,
+		e.executionManager,
+		e.shard.GetShardID(),
+		domainID,
+		e.shard.GetDomainCache(),
+		workflowExecution,
+		e.timeSource.Now(),
+		func(wfContext execution.Context, mutableState execution.MutableState) (*workflow.UpdateAction, error) {
+			if !mutableState.IsWorkflowExecutionRunning() {
+				return nil, workflow.ErrAlreadyCompleted
+			}
+			if !mutableState.IsWorkflowExecutionPaused() {
+				return &workflow.UpdateAction{
+					Noop:           true,
+					CreateDecision: false,
+				}, nil
+			}
+
+			if _, err := mutableState.AddWorkflowExecutionUnpausedEvent(request.GetReason(), request.GetIdentity()); err != nil {
+				return nil, &types.InternalServiceError{Message: "Unable to unpause workflow execution."}
+			}
+			e.logger.Info("Workflow execution unpaused.",
+				tag.WorkflowDomainID(domainID),
+				tag.WorkflowID(workflowExecution.WorkflowID),
+				tag.WorkflowRunID(workflowExecution.RunID),
+				tag.Reason(request.GetReason()),
+				tag.ActorID(request.GetIdentity()))
+
+			// decision and activity tasks, as well as user timers, were dropped while the workflow was paused
+			if err := mutableStateTaskRefresher.RefreshTasks(
+				ctx,
+				mutableState.GetExecutionInfo().StartTimestamp,
+				mutableState,
+			); err != nil {
+				return nil, err
+			}
+			return workflow.UpdateWithoutDecision, nil
+		})
+}
@@ -0,0 +1,244 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package engineimpl
+
+import (
+	"context"
+	"time"
+
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/history/execution"
+	"github.com/uber/cadence/service/history/query"
+	"github.com/uber/cadence/service/history/workflow"
+)
+
+// updateRegistryCheckInterval is how often a caller waiting for the outcome of an update makes sure
+// it still waits on the registry of the loaded mutable state
+const updateRegistryCheckInterval = 5 * time.Second
+
+// UpdateWorkflowExecution runs the validator of the update on the worker, records the accepted update
+// and waits for the worker to record the outcome of its handler, see query.UpdateRegistry for the protocol
+func (e *historyEngineImpl) UpdateWorkflowExecution(
+	ctx context.Context,
+	updateRequest *types.HistoryUpdateWorkflowExecutionRequest,
+) (*types.UpdateWorkflowExecutionResponse, error) {
+	request := updateRequest.GetUpdateRequest()
+	workflowExecution := types.WorkflowExecution{
+		WorkflowID: request.GetWorkflowExecution().GetWorkflowID(),
+		RunID:      request.GetWorkflowExecution().GetRunID(),
+	}
+	domainEntry, err := e.getActiveDomainByWorkflow(ctx, updateRequest.GetDomainUUID(), workflowExecution.WorkflowID, workflowExecution.RunID)
+	if err != nil {
+		return nil, err
+	}
+	if domainEntry.GetInfo().Status != persistence.DomainStatusRegistered {
+		return nil, errDomainDeprecated
+	}
+	domainID := domainEntry.GetInfo().ID
+	domainName := domainEntry.GetInfo().Name
+	scope := e.metricsClient.Scope(metrics.HistoryUpdateWorkflowExecutionScope).Tagged(metrics.DomainTag(domainName))
+
+	// the validator runs as a query so that a rejected update leaves no trace in history
+	consistencyLevel := types.QueryConsistencyLevelEventual
+	if e.config.EnableConsistentQuery() && e.config.EnableConsistentQueryByDomain(domainName) {
+		consistencyLevel = types.QueryConsistencyLevelStrong
+	}
+	if _, err := e.QueryWorkflow(ctx, &types.HistoryQueryWorkflowRequest{
+		DomainUUID: domainID,
+		Request: &types.QueryWorkflowRequest{
+			Domain:    request.GetDomain(),
+			Execution: &workflowExecution,
+			Query: &types.WorkflowQuery{
+				QueryType: query.UpdateValidatorQueryTypePrefix + request.GetUpdateName(),
+				QueryArgs: request.GetInput(),
+			},
+			QueryConsistencyLevel: consistencyLevel.Ptr(),
+		},
+	}); err != nil {
+		if _, ok := err.(*types.QueryFailedError); ok {
+			scope.IncCounter(metrics.WorkflowUpdateRejectedCount)
+		}
+		return nil, err
+	}
+
+	var updateRegistry query.UpdateRegistry
+	var termCh <-chan struct{}
+	var outcome *types.UpdateWorkflowExecutionResponse
+	var alreadyRecorded bool
+	err = workflow.UpdateCurrentWithActionFunc(
+		ctx,
+		e.logger,
+		e.executionCache,
+		e.executionManager,
+		e.shard.GetShardID(),
+		domainID,
+		e.shard.GetDomainCache(),
+		workflowExecution,
+		e.timeSource.Now(),
+		func(wfContext execution.Context, mutableState execution.MutableState) (*workflow.UpdateAction, error) {
+			workflowExecution.RunID = mutableState.GetExecutionInfo().RunID
+
+			// a retried request is answered with the outcome of the update it already recorded
+			var completed bool
+			if outcome, completed = mutableState.GetUpdateOutcome(request.GetUpdateID()); completed {
+				return &workflow.UpdateAction{
+					Noop:           true,
+					CreateDecision: false,
+				}, nil
+			}
+
+			// the registry belongs to the mutable state, which is reloaded if the action is retried
+			updateRegistry = mutableState.GetUpdateRegistry()
+			termCh = updateRegistry.AddUpdate(request.GetUpdateID())
+
+			// or waits for the outcome of the update it already recorded
+			alreadyRecorded = mutableState.IsUpdateAccepted(request.GetUpdateID())
+			if alreadyRecorded {
+				return &workflow.UpdateAction{
+					Noop:           true,
+					CreateDecision: false,
+				}, nil
+			}
+
+			if !mutableState.IsWorkflowExecutionRunning() {
+				return nil, workflow.ErrAlreadyCompleted
+			}
+
+			executionInfo := mutableState.GetExecutionInfo()
+			maxAllowedSignals := e.config.MaximumSignalsPerExecution(domainName)
+			if maxAllowedSignals > 0 && int(executionInfo.SignalCount) >= maxAllowedSignals {
+				e.logger.Info("Execution limit reached for maximum signals", tag.WorkflowSignalCount(executionInfo.SignalCount),
+					tag.WorkflowID(workflowExecution.GetWorkflowID()),
+					tag.WorkflowRunID(workflowExecution.GetRunID()),
+					tag.WorkflowDomainID(domainID))
+				return nil, workflow.ErrSignalsLimitExceeded
+			}
+
+			// the update stays accepted until the worker records its outcome
+			if _, err := mutableState.AddWorkflowExecutionSignaled(
+				query.UpdateSignalNamePrefix+request.GetUpdateName(),
+				request.GetInput(),
+				request.GetIdentity(),
+				request.GetUpdateID(),
+			); err != nil {
+				return nil, &types.InternalServiceError{Message: "Unable to record workflow update."}
+			}
+
+			return &workflow.UpdateAction{
+				Noop:           false,
+				CreateDecision: mutableState.HasProcessedOrPendingDecision(),
+			}, nil
+		})
+	if updateRegistry != nil {
+		defer func() { updateRegistry.RemoveUpdate(request.GetUpdateID()) }()
+	}
+	if err != nil {
+		return nil, err
+	}
+	if outcome != nil {
+		return outcome, nil
+	}
+	if !alreadyRecorded {
+		scope.IncCounter(metrics.WorkflowUpdateAcceptedCount)
+	}
+
+	ticker := e.timeSource.NewTicker(updateRegistryCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-termCh:
+			return updateRegistry.GetUpdateOutcome(request.GetUpdateID())
+		case <-ctx.Done():
+			scope.IncCounter(metrics.WorkflowUpdateTimeoutCount)
+			return nil, ctx.Err()
+		case <-ticker.Chan():
+			// the registry only lives as long as the mutable state it belongs to, so the update is registered
+			// again if the mutable state was reloaded, and its outcome looked up in the mutable state in case
+			// it was recorded in between
+			registry, newTermCh, outcome, err := e.checkUpdate(ctx, domainID, workflowExecution, request.GetUpdateID(), updateRegistry)
+			if err != nil || outcome != nil {
+				return outcome, err
+			}
+			if registry != updateRegistry {
+				updateRegistry.RemoveUpdate(request.GetUpdateID())
+				updateRegistry, termCh = registry, newTermCh
+			}
+		}
+	}
+}
+
+// checkUpdate looks up the outcome of the update in the mutable state of the workflow. If it is not recorded yet,
+// it returns the update registry of the loaded mutable state, with the update registered if it is not the given one.
+// Pending updates are only failed when a decision closes the workflow, so ErrUpdateWorkflowClosed is returned
+// if the workflow was closed otherwise.
+func (e *historyEngineImpl) checkUpdate(
+	ctx context.Context,
+	domainID string,
+	workflowExecution types.WorkflowExecution,
+	updateID string,
+	updateRegistry query.UpdateRegistry,
+) (query.UpdateRegistry, <-chan struct{}, *types.UpdateWorkflowExecutionResponse, error) {
+	var registry query.UpdateRegistry
+	var termCh <-chan struct{}
+	var outcome *types.UpdateWorkflowExecutionResponse
+	var running bool
+	if err := e.loadMutableState(ctx, domainID, workflowExecution, func(mutableState execution.MutableState) error {
+		var completed bool
+		if outcome, completed = mutableState.GetUpdateOutcome(updateID); completed {
+			return nil
+		}
+		running = mutableState.IsWorkflowExecutionRunning()
+		// the update is registered while the mutable state is locked, so that no completion is missed
+		registry = mutableState.GetUpdateRegistry()
+		if running && registry != updateRegistry {
+			termCh = registry.AddUpdate(updateID)
+		}
+		return nil
+	}); err != nil {
+		return nil, nil, nil, err
+	}
+	if outcome == nil && !running {
+		return nil, nil, nil, workflow.ErrUpdateWorkflowClosed
+	}
+	return registry, termCh, outcome, nil
+}
+
+func (e *historyEngineImpl) loadMutableState(
+	ctx context.Context,
+	domainID string,
+	workflowExecution types.WorkflowExecution,
+	fn func(mutableState execution.MutableState) error,
+) (retError error) {
+	wfContext, release, err := e.executionCache.GetOrCreateWorkflowExecution(ctx, domainID, workflowExecution)
+	if err != nil {
+		return err
+	}
+	defer func() { release(retError) }()
+
+	mutableState, err := wfContext.LoadWorkflowExecution(ctx)
+	if err != nil {
+		return err
+	}
+	return fn(mutableState)
+}
@@ -0,0 +1,120 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package engineimpl
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/checksum"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/history/constants"
+	"github.com/uber/cadence/service/history/engine/testdata"
+	"github.com/uber/cadence/service/history/workflow"
+)
+
+func TestCheckUpdate(t *testing.T) {
+	workflowExecution := types.WorkflowExecution{
+		WorkflowID: constants.TestWorkflowID,
+		RunID:      constants.TestRunID,
+	}
+	completedUpdates := []*persistence.CompletedUpdate{
+		{UpdateID: "update-1", Result: []byte("result")},
+		{UpdateID: "update-2", FailureReason: common.StringPtr("bad input"), FailureDetails: []byte("details")},
+	}
+	cases := []struct {
+		name            string
+		state           int
+		updateID        string
+		expectedOutcome *types.UpdateWorkflowExecutionResponse
+		expectedErr     error
+	}{
+		{
+			name:            "completed update",
+			state:           persistence.WorkflowStateRunning,
+			updateID:        "update-2",
+			expectedOutcome: &types.UpdateWorkflowExecutionResponse{UpdateID: "update-2", FailureReason: common.StringPtr("bad input"), FailureDetails: []byte("details")},
+		},
+		{
+			name:            "completed update of a closed workflow",
+			state:           persistence.WorkflowStateCompleted,
+			updateID:        "update-1",
+			expectedOutcome: &types.UpdateWorkflowExecutionResponse{UpdateID: "update-1", Result: []byte("result")},
+		},
+		{
+			name:     "pending update",
+			state:    persistence.WorkflowStateRunning,
+			updateID: "update-3",
+		},
+		{
+			name:        "pending update of a closed workflow",
+			state:       persistence.WorkflowStateCompleted,
+			updateID:    "update-3",
+			expectedErr: workflow.ErrUpdateWorkflowClosed,
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.name, func(t *testing.T) {
+			eft := testdata.NewEngineForTest(t, NewEngineWithShardContext)
+			eft.ShardCtx.Resource.ExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.MatchedBy(func(req *persistence.GetWorkflowExecutionRequest) bool {
+				return req.Execution == workflowExecution
+			})).Return(&persistence.GetWorkflowExecutionResponse{
+				State: &persistence.WorkflowMutableState{
+					ExecutionInfo: &persistence.WorkflowExecutionInfo{
+						DomainID:         constants.TestDomainID,
+						WorkflowID:       workflowExecution.WorkflowID,
+						RunID:            workflowExecution.RunID,
+						State:            testCase.state,
+						CompletedUpdates: completedUpdates,
+					},
+					ExecutionStats: &persistence.ExecutionStats{},
+					Checksum:       checksum.Checksum{},
+				},
+			}, nil)
+			engine := eft.Engine.(*historyEngineImpl)
+
+			registry, termCh, outcome, err := engine.checkUpdate(context.Background(), constants.TestDomainID, workflowExecution, testCase.updateID, nil)
+			assert.Equal(t, testCase.expectedErr, err)
+			assert.Equal(t, testCase.expectedOutcome, outcome)
+			if testCase.expectedErr != nil || testCase.expectedOutcome != nil {
+				return
+			}
+
+			require.NotNil(t, registry)
+			require.NotNil(t, termCh, "the update should be registered on the registry of the loaded mutable state")
+			assert.Equal(t, []string{testCase.updateID}, registry.GetPendingIDs())
+
+			// the registry of the loaded mutable state is not registered twice
+			_, termCh, _, err = engine.checkUpdate(context.Background(), constants.TestDomainID, workflowExecution, testCase.updateID, registry)
+			assert.NoError(t, err)
+			assert.Nil(t, termCh)
+		})
+	}
+}
@@ -0,0 +1,221 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package engineimpl
+
+import (
+	"context"
+	"errors"
+
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/history/execution"
+	"github.com/uber/cadence/service/history/workflow"
+)
+
+// UpsertWorkflowSearchAttributes upserts search attributes and memo of a workflow outside of its decisions. The search
+// attributes of a running workflow are recorded by an UpsertWorkflowSearchAttributes event, the memo and the search
+// attributes of a closed workflow are updated in place, and the visibility record of the workflow is written again.
+// Once the workflow is removed after retention, only its closed visibility record is updated.
+func (e *historyEngineImpl) UpsertWorkflowSearchAttributes(
+	ctx context.Context,
+	upsertRequest *types.HistoryUpsertWorkflowSearchAttributesRequest,
+) error {
+	request := upsertRequest.GetRequest()
+	workflowExecution := types.WorkflowExecution{
+		WorkflowID: request.GetExecution().GetWorkflowID(),
+		RunID:      request.GetExecution().GetRunID(),
+	}
+	domainEntry, err := e.getActiveDomainByWorkflow(ctx, upsertRequest.GetDomainUUID(), workflowExecution.WorkflowID, workflowExecution.RunID)
+	if err != nil {
+		return err
+	}
+	if domainEntry.GetInfo().Status != persistence.DomainStatusRegistered {
+		return errDomainDeprecated
+	}
+	domainID := domainEntry.GetInfo().ID
+	replicate := domainEntry.GetReplicationPolicy() == cache.ReplicationPolicyMultiCluster &&
+		e.shard.GetConfig().EnableReplicationTaskGeneration(domainID, workflowExecution.WorkflowID)
+
+	err = workflow.UpdateCurrentWithActionFunc(
+		ctx,
+		e.logger,
+		e.executionCache,
+		e.executionManager,
+		e.shard.GetShardID(),
+		domainID,
+		e.shard.GetDomainCache(),
+		workflowExecution,
+		e.timeSource.Now(),
+		func(wfContext execution.Context, mutableState execution.MutableState) (*workflow.UpdateAction, error) {
+			isRunning := mutableState.IsWorkflowExecutionRunning()
+			if err := e.upsertWorkflowSearchAttributesAndMemo(mutableState, request.GetSearchAttributes(), request.GetMemo(), replicate); err != nil {
+				return nil, err
+			}
+			e.logger.Info("Workflow search attributes upserted.",
+				tag.WorkflowDomainID(domainID),
+				tag.WorkflowID(workflowExecution.WorkflowID),
+				tag.WorkflowRunID(mutableState.GetExecutionInfo().RunID),
+				tag.Reason(request.GetReason()),
+				tag.ActorID(request.GetIdentity()))
+			if isRunning {
+				return workflow.UpdateWithoutDecision, nil
+			}
+			// a closed workflow can't be updated as active, so only the mutation and its tasks are persisted
+			if err := wfContext.UpdateWorkflowExecutionTasks(ctx, e.shard.GetTimeSource().Now()); err != nil {
+				return nil, err
+			}
+			return &workflow.UpdateAction{Noop: true}, nil
+		})
+	var notExistsErr *types.EntityNotExistsError
+	if errors.As(err, &notExistsErr) && workflowExecution.RunID != "" {
+		return e.upsertClosedVisibilitySearchAttributesAndMemo(ctx, domainEntry, workflowExecution, request.GetSearchAttributes(), request.GetMemo(), err)
+	}
+	return err
+}
+
+// ReplicateUpsertWorkflowSearchAttributes applies the search attributes and memo of a workflow replicated from the
+// active cluster, they replace the ones of the workflow in this cluster as they are the latest ones of the workflow.
+func (e *historyEngineImpl) ReplicateUpsertWorkflowSearchAttributes(
+	ctx context.Context,
+	attributes *types.UpsertWorkflowSearchAttributesTaskAttributes,
+) (retError error) {
+	domainEntry, err := e.shard.GetDomainCache().GetDomainByID(attributes.GetDomainID())
+	if err != nil {
+		return err
+	}
+	workflowExecution := types.WorkflowExecution{
+		WorkflowID: attributes.GetWorkflowID(),
+		RunID:      attributes.GetRunID(),
+	}
+
+	wfContext, release, err := e.executionCache.GetOrCreateWorkflowExecution(ctx, attributes.GetDomainID(), workflowExecution)
+	if err != nil {
+		return err
+	}
+	defer func() { release(retError) }()
+
+	mutableState, err := wfContext.LoadWorkflowExecution(ctx)
+	if err != nil {
+		var notExistsErr *types.EntityNotExistsError
+		if errors.As(err, &notExistsErr) {
+			// retention removed the execution in this cluster already, only the visibility record is left
+			return e.upsertClosedVisibilitySearchAttributesAndMemo(ctx, domainEntry, workflowExecution, attributes.GetSearchAttributes(), attributes.GetMemo(), err)
+		}
+		return err
+	}
+	// the search attributes recorded in the history of a running workflow are merged again, which changes nothing
+	if err := mutableState.UpsertWorkflowSearchAttributesAndMemo(attributes.GetSearchAttributes(), attributes.GetMemo()); err != nil {
+		return &types.InternalServiceError{Message: "Unable to upsert workflow search attributes."}
+	}
+	return wfContext.UpdateWorkflowExecutionTasks(ctx, e.shard.GetTimeSource().Now())
+}
+
+// upsertWorkflowSearchAttributesAndMemo records the search attributes of a running workflow in its history, merges the
+// memo and the search attributes of a closed workflow into the mutable state, and adds the replication task which
+// upserts what the history doesn't carry in the other clusters if replicate is set.
+func (e *historyEngineImpl) upsertWorkflowSearchAttributesAndMemo(
+	mutableState execution.MutableState,
+	searchAttributes *types.SearchAttributes,
+	memo *types.Memo,
+	replicate bool,
+) error {
+	if mutableState.IsWorkflowExecutionRunning() && len(searchAttributes.GetIndexedFields()) != 0 {
+		if _, err := mutableState.AddUpsertWorkflowSearchAttributesEventWithoutDecision(searchAttributes); err != nil {
+			return &types.InternalServiceError{Message: "Unable to upsert workflow search attributes."}
+		}
+		searchAttributes = nil
+	}
+	if len(searchAttributes.GetIndexedFields()) == 0 && len(memo.GetFields()) == 0 {
+		return nil
+	}
+	if err := mutableState.UpsertWorkflowSearchAttributesAndMemo(searchAttributes, memo); err != nil {
+		return &types.InternalServiceError{Message: "Unable to upsert workflow search attributes."}
+	}
+	if !replicate {
+		return nil
+	}
+	lastWriteVersion, err := mutableState.GetLastWriteVersion()
+	if err != nil {
+		return err
+	}
+	executionInfo := mutableState.GetExecutionInfo()
+	mutableState.AddReplicationTasks(&persistence.UpsertWorkflowSearchAttributesReplicationTask{
+		WorkflowIdentifier: persistence.WorkflowIdentifier{
+			DomainID:   executionInfo.DomainID,
+			WorkflowID: executionInfo.WorkflowID,
+			RunID:      executionInfo.RunID,
+		},
+		TaskData: persistence.TaskData{
+			Version: lastWriteVersion,
+		},
+	})
+	return nil
+}
+
+// upsertClosedVisibilitySearchAttributesAndMemo merges the search attributes and memo into the closed visibility
+// record of a workflow which is no longer in the execution store. notExistsErr is returned if there is no record.
+func (e *historyEngineImpl) upsertClosedVisibilitySearchAttributesAndMemo(
+	ctx context.Context,
+	domainEntry *cache.DomainCacheEntry,
+	workflowExecution types.WorkflowExecution,
+	searchAttributes *types.SearchAttributes,
+	memo *types.Memo,
+	notExistsErr error,
+) error {
+	if e.visibilityMgr == nil {
+		return notExistsErr
+	}
+	resp, err := e.visibilityMgr.GetClosedWorkflowExecution(ctx, &persistence.GetClosedWorkflowExecutionRequest{
+		DomainUUID: domainEntry.GetInfo().ID,
+		Domain:     domainEntry.GetInfo().Name,
+		Execution:  workflowExecution,
+	})
+	if err != nil {
+		return err
+	}
+	if resp.Execution == nil {
+		return notExistsErr
+	}
+
+	retentionSeconds := int64(domainEntry.GetRetentionDays(workflowExecution.WorkflowID)) * 24 * 3600
+	request := persistence.NewRecordWorkflowExecutionClosedRequest(domainEntry.GetInfo().ID, domainEntry.GetInfo().Name, retentionSeconds, resp.Execution)
+	request.SearchAttributes = mergeMapOfByteArray(request.SearchAttributes, searchAttributes.GetIndexedFields())
+	request.Memo = &types.Memo{Fields: mergeMapOfByteArray(request.Memo.GetFields(), memo.GetFields())}
+	request.ShardID = int16(e.shard.GetShardID())
+	// the task ID is the condition update version of the record, a new one makes this write win over the previous one
+	if request.TaskID, err = e.shard.GenerateTaskID(); err != nil {
+		return err
+	}
+	return e.visibilityMgr.RecordWorkflowExecutionClosed(ctx, request)
+}
+
+func mergeMapOfByteArray(current, upsert map[string][]byte) map[string][]byte {
+	merged := make(map[string][]byte, len(current)+len(upsert))
+	for key, value := range current {
+		merged[key] = value
+	}
+	for key, value := range upsert {
+		merged[key] = value
+	}
+	return merged
+}
@@ -55,15 +55,26 @@ type (
 		SignalWithStartWorkflowExecution(ctx context.Context, request *types.HistorySignalWithStartWorkflowExecutionRequest) (*types.StartWorkflowExecutionResponse, error)
 		RemoveSignalMutableState(ctx context.Context, request *types.RemoveSignalMutableStateRequest) error
 		TerminateWorkflowExecution(ctx context.Context, request *types.HistoryTerminateWorkflowExecutionRequest) error
+		PauseWorkflowExecution(ctx context.Context, request *types.HistoryPauseWorkflowExecutionRequest) error
+		UnpauseWorkflowExecution(ctx context.Context, request *types.HistoryUnpauseWorkflowExecutionRequest) error
+		PauseActivity(ctx context.Context, request *types.HistoryPauseActivityRequest) error
+		UnpauseActivity(ctx context.Context, request *types.HistoryUnpauseActivityRequest) error
+		ResetActivityAttempt(ctx context.Context, request *types.HistoryResetActivityAttemptRequest) error
+		RetryActivityNow(ctx context.Context, request *types.HistoryRetryActivityNowRequest) error
+		DeleteWorkflowExecution(ctx context.Context, request *types.HistoryDeleteWorkflowExecutionRequest) error
+		UpsertWorkflowSearchAttributes(ctx context.Context, request *types.HistoryUpsertWorkflowSearchAttributesRequest) error
 		ResetWorkflowExecution(ctx context.Context, request *types.HistoryResetWorkflowExecutionRequest) (*types.ResetWorkflowExecutionResponse, error)
 		ScheduleDecisionTask(ctx context.Context, request *types.ScheduleDecisionTaskRequest) error
 		RecordChildExecutionCompleted(ctx context.Context, request *types.RecordChildExecutionCompletedRequest) error
 		ReplicateEventsV2(ctx context.Context, request *types.ReplicateEventsV2Request) error
 		SyncShardStatus(ctx context.Context, request *types.SyncShardStatusRequest) error
 		SyncActivity(ctx context.Context, request *types.SyncActivityRequest) error
+		ReplicateDeleteWorkflowExecution(ctx context.Context, attributes *types.DeleteWorkflowExecutionTaskAttributes) error
+		ReplicateUpsertWorkflowSearchAttributes(ctx context.Context, attributes *types.UpsertWorkflowSearchAttributesTaskAttributes) error
 		GetReplicationMessages(ctx context.Context, pollingCluster string, lastReadMessageID int64) (*types.ReplicationMessages, error)
 		GetDLQReplicationMessages(ctx context.Context, taskInfos []*types.ReplicationTaskInfo) ([]*types.ReplicationTask, error)
 		QueryWorkflow(ctx context.Context, request *types.HistoryQueryWorkflowRequest) (*types.HistoryQueryWorkflowResponse, error)
+		UpdateWorkflowExecution(ctx context.Context, request *types.HistoryUpdateWorkflowExecutionRequest) (*types.UpdateWorkflowExecutionResponse, error)
 		ReapplyEvents(ctx context.Context, domainUUID string, workflowID string, runID string, events []*types.HistoryEvent) error
 		CountDLQMessages(ctx context.Context, forceFetch bool) (map[string]int64, error)
 		ReadDLQMessages(ctx context.Context, messagesRequest *types.ReadDLQMessagesRequest) (*types.ReadDLQMessagesResponse, error)
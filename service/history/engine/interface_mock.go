@@ -59,6 +59,20 @@ func (mr *MockEngineMockRecorder) CountDLQMessages(ctx, forceFetch any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountDLQMessages", reflect.TypeOf((*MockEngine)(nil).CountDLQMessages), ctx, forceFetch)
 }
 
+// DeleteWorkflowExecution mocks base method.
+func (m *MockEngine) DeleteWorkflowExecution(ctx context.Context, request *types.HistoryDeleteWorkflowExecutionRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWorkflowExecution", ctx, request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWorkflowExecution indicates an expected call of DeleteWorkflowExecution.
+func (mr *MockEngineMockRecorder) DeleteWorkflowExecution(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWorkflowExecution", reflect.TypeOf((*MockEngine)(nil).DeleteWorkflowExecution), ctx, request)
+}
+
 // DescribeMutableState mocks base method.
 func (m *MockEngine) DescribeMutableState(ctx context.Context, request *types.DescribeMutableStateRequest) (*types.DescribeMutableStateResponse, error) {
 	m.ctrl.T.Helper()
@@ -227,6 +241,34 @@ func (mr *MockEngineMockRecorder) NotifyNewTransferTasks(info any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotifyNewTransferTasks", reflect.TypeOf((*MockEngine)(nil).NotifyNewTransferTasks), info)
 }
 
+// PauseActivity mocks base method.
+func (m *MockEngine) PauseActivity(ctx context.Context, request *types.HistoryPauseActivityRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PauseActivity", ctx, request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PauseActivity indicates an expected call of PauseActivity.
+func (mr *MockEngineMockRecorder) PauseActivity(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseActivity", reflect.TypeOf((*MockEngine)(nil).PauseActivity), ctx, request)
+}
+
+// PauseWorkflowExecution mocks base method.
+func (m *MockEngine) PauseWorkflowExecution(ctx context.Context, request *types.HistoryPauseWorkflowExecutionRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PauseWorkflowExecution", ctx, request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PauseWorkflowExecution indicates an expected call of PauseWorkflowExecution.
+func (mr *MockEngineMockRecorder) PauseWorkflowExecution(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseWorkflowExecution", reflect.TypeOf((*MockEngine)(nil).PauseWorkflowExecution), ctx, request)
+}
+
 // PollMutableState mocks base method.
 func (m *MockEngine) PollMutableState(ctx context.Context, request *types.PollMutableStateRequest) (*types.PollMutableStateResponse, error) {
 	m.ctrl.T.Helper()
@@ -387,6 +429,20 @@ func (mr *MockEngineMockRecorder) RemoveSignalMutableState(ctx, request any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveSignalMutableState", reflect.TypeOf((*MockEngine)(nil).RemoveSignalMutableState), ctx, request)
 }
 
+// ReplicateDeleteWorkflowExecution mocks base method.
+func (m *MockEngine) ReplicateDeleteWorkflowExecution(ctx context.Context, attributes *types.DeleteWorkflowExecutionTaskAttributes) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplicateDeleteWorkflowExecution", ctx, attributes)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplicateDeleteWorkflowExecution indicates an expected call of ReplicateDeleteWorkflowExecution.
+func (mr *MockEngineMockRecorder) ReplicateDeleteWorkflowExecution(ctx, attributes any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplicateDeleteWorkflowExecution", reflect.TypeOf((*MockEngine)(nil).ReplicateDeleteWorkflowExecution), ctx, attributes)
+}
+
 // ReplicateEventsV2 mocks base method.
 func (m *MockEngine) ReplicateEventsV2(ctx context.Context, request *types.ReplicateEventsV2Request) error {
 	m.ctrl.T.Helper()
@@ -401,6 +457,20 @@ func (mr *MockEngineMockRecorder) ReplicateEventsV2(ctx, request any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplicateEventsV2", reflect.TypeOf((*MockEngine)(nil).ReplicateEventsV2), ctx, request)
 }
 
+// ReplicateUpsertWorkflowSearchAttributes mocks base method.
+func (m *MockEngine) ReplicateUpsertWorkflowSearchAttributes(ctx context.Context, attributes *types.UpsertWorkflowSearchAttributesTaskAttributes) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplicateUpsertWorkflowSearchAttributes", ctx, attributes)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplicateUpsertWorkflowSearchAttributes indicates an expected call of ReplicateUpsertWorkflowSearchAttributes.
+func (mr *MockEngineMockRecorder) ReplicateUpsertWorkflowSearchAttributes(ctx, attributes any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplicateUpsertWorkflowSearchAttributes", reflect.TypeOf((*MockEngine)(nil).ReplicateUpsertWorkflowSearchAttributes), ctx, attributes)
+}
+
 // RequestCancelWorkflowExecution mocks base method.
 func (m *MockEngine) RequestCancelWorkflowExecution(ctx context.Context, request *types.HistoryRequestCancelWorkflowExecutionRequest) error {
 	m.ctrl.T.Helper()
@@ -415,6 +485,20 @@ func (mr *MockEngineMockRecorder) RequestCancelWorkflowExecution(ctx, request an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestCancelWorkflowExecution", reflect.TypeOf((*MockEngine)(nil).RequestCancelWorkflowExecution), ctx, request)
 }
 
+// ResetActivityAttempt mocks base method.
+func (m *MockEngine) ResetActivityAttempt(ctx context.Context, request *types.HistoryResetActivityAttemptRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetActivityAttempt", ctx, request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResetActivityAttempt indicates an expected call of ResetActivityAttempt.
+func (mr *MockEngineMockRecorder) ResetActivityAttempt(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetActivityAttempt", reflect.TypeOf((*MockEngine)(nil).ResetActivityAttempt), ctx, request)
+}
+
 // ResetStickyTaskList mocks base method.
 func (m *MockEngine) ResetStickyTaskList(ctx context.Context, resetRequest *types.HistoryResetStickyTaskListRequest) (*types.HistoryResetStickyTaskListResponse, error) {
 	m.ctrl.T.Helper()
@@ -544,6 +628,20 @@ func (mr *MockEngineMockRecorder) RespondDecisionTaskFailed(ctx, request any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RespondDecisionTaskFailed", reflect.TypeOf((*MockEngine)(nil).RespondDecisionTaskFailed), ctx, request)
 }
 
+// RetryActivityNow mocks base method.
+func (m *MockEngine) RetryActivityNow(ctx context.Context, request *types.HistoryRetryActivityNowRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetryActivityNow", ctx, request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RetryActivityNow indicates an expected call of RetryActivityNow.
+func (mr *MockEngineMockRecorder) RetryActivityNow(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetryActivityNow", reflect.TypeOf((*MockEngine)(nil).RetryActivityNow), ctx, request)
+}
+
 // ScheduleDecisionTask mocks base method.
 func (m *MockEngine) ScheduleDecisionTask(ctx context.Context, request *types.ScheduleDecisionTaskRequest) error {
 	m.ctrl.T.Helper()
@@ -667,3 +765,60 @@ func (mr *MockEngineMockRecorder) TerminateWorkflowExecution(ctx, request any) *
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TerminateWorkflowExecution", reflect.TypeOf((*MockEngine)(nil).TerminateWorkflowExecution), ctx, request)
 }
+
+// UnpauseActivity mocks base method.
+func (m *MockEngine) UnpauseActivity(ctx context.Context, request *types.HistoryUnpauseActivityRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnpauseActivity", ctx, request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnpauseActivity indicates an expected call of UnpauseActivity.
+func (mr *MockEngineMockRecorder) UnpauseActivity(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnpauseActivity", reflect.TypeOf((*MockEngine)(nil).UnpauseActivity), ctx, request)
+}
+
+// UnpauseWorkflowExecution mocks base method.
+func (m *MockEngine) UnpauseWorkflowExecution(ctx context.Context, request *types.HistoryUnpauseWorkflowExecutionRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnpauseWorkflowExecution", ctx, request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnpauseWorkflowExecution indicates an expected call of UnpauseWorkflowExecution.
+func (mr *MockEngineMockRecorder) UnpauseWorkflowExecution(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnpauseWorkflowExecution", reflect.TypeOf((*MockEngine)(nil).UnpauseWorkflowExecution), ctx, request)
+}
+
+// UpdateWorkflowExecution mocks base method.
+func (m *MockEngine) UpdateWorkflowExecution(ctx context.Context, request *types.HistoryUpdateWorkflowExecutionRequest) (*types.UpdateWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWorkflowExecution", ctx, request)
+	ret0, _ := ret[0].(*types.UpdateWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateWorkflowExecution indicates an expected call of UpdateWorkflowExecution.
+func (mr *MockEngineMockRecorder) UpdateWorkflowExecution(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkflowExecution", reflect.TypeOf((*MockEngine)(nil).UpdateWorkflowExecution), ctx, request)
+}
+
+// UpsertWorkflowSearchAttributes mocks base method.
+func (m *MockEngine) UpsertWorkflowSearchAttributes(ctx context.Context, request *types.HistoryUpsertWorkflowSearchAttributesRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertWorkflowSearchAttributes", ctx, request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertWorkflowSearchAttributes indicates an expected call of UpsertWorkflowSearchAttributes.
+func (mr *MockEngineMockRecorder) UpsertWorkflowSearchAttributes(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertWorkflowSearchAttributes", reflect.TypeOf((*MockEngine)(nil).UpsertWorkflowSearchAttributes), ctx, request)
+}
@@ -43,6 +43,7 @@ import (
 	hcommon "github.com/uber/cadence/service/history/common"
 	"github.com/uber/cadence/service/history/engine"
 	"github.com/uber/cadence/service/history/events"
+	"github.com/uber/cadence/service/history/query"
 	"github.com/uber/cadence/service/history/shard"
 )
 
@@ -1396,6 +1397,10 @@ func (c *contextImpl) ReapplyEvents(
 		for _, event := range events.Events {
 			switch event.GetEventType() {
 			case types.EventTypeWorkflowExecutionSignaled:
+				if query.IsReservedSignalName(event.GetWorkflowExecutionSignaledEventAttributes().GetSignalName()) {
+					// accepted updates are not reapplied, their callers are no longer waiting for the outcome
+					continue
+				}
 				reapplyEvents = append(reapplyEvents, event)
 			}
 		}
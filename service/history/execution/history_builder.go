@@ -618,6 +618,28 @@ func (b *HistoryBuilder) AddUpsertWorkflowSearchAttributesEvent(
 	return b.addEventToHistory(event)
 }
 
+// AddWorkflowExecutionPausedEvent adds WorkflowExecutionPaused event to history
+func (b *HistoryBuilder) AddWorkflowExecutionPausedEvent(reason, identity string) *types.HistoryEvent {
+	event := b.msBuilder.CreateNewHistoryEvent(types.EventTypeWorkflowExecutionPaused)
+	event.WorkflowExecutionPausedEventAttributes = &types.WorkflowExecutionPausedEventAttributes{
+		Reason:   reason,
+		Identity: identity,
+	}
+
+	return b.addEventToHistory(event)
+}
+
+// AddWorkflowExecutionUnpausedEvent adds WorkflowExecutionUnpaused event to history
+func (b *HistoryBuilder) AddWorkflowExecutionUnpausedEvent(reason, identity string) *types.HistoryEvent {
+	event := b.msBuilder.CreateNewHistoryEvent(types.EventTypeWorkflowExecutionUnpaused)
+	event.WorkflowExecutionUnpausedEventAttributes = &types.WorkflowExecutionUnpausedEventAttributes{
+		Reason:   reason,
+		Identity: identity,
+	}
+
+	return b.addEventToHistory(event)
+}
+
 // AddSignalExternalWorkflowExecutionFailedEvent adds SignalExternalWorkflowExecutionFailed event to history
 func (b *HistoryBuilder) AddSignalExternalWorkflowExecutionFailedEvent(decisionTaskCompletedEventID, initiatedEventID int64,
 	domain, workflowID, runID string, control []byte, cause types.SignalExternalWorkflowExecutionFailedCause) *types.HistoryEvent {
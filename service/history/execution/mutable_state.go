@@ -100,15 +100,23 @@ type (
 		AddTimerFiredEvent(string) (*types.HistoryEvent, error)
 		AddTimerStartedEvent(int64, *types.StartTimerDecisionAttributes) (*types.HistoryEvent, *persistence.TimerInfo, error)
 		AddUpsertWorkflowSearchAttributesEvent(int64, *types.UpsertWorkflowSearchAttributesDecisionAttributes) (*types.HistoryEvent, error)
+		AddUpsertWorkflowSearchAttributesEventWithoutDecision(*types.SearchAttributes) (*types.HistoryEvent, error)
 		AddWorkflowExecutionCancelRequestedEvent(string, *types.HistoryRequestCancelWorkflowExecutionRequest) (*types.HistoryEvent, error)
 		AddWorkflowExecutionCanceledEvent(int64, *types.CancelWorkflowExecutionDecisionAttributes) (*types.HistoryEvent, error)
+		AddWorkflowExecutionPausedEvent(reason string, identity string) (*types.HistoryEvent, error)
 		AddWorkflowExecutionSignaled(signalName string, input []byte, identity string, reqeustID string) (*types.HistoryEvent, error)
 		AddWorkflowExecutionStartedEvent(types.WorkflowExecution, *types.HistoryStartWorkflowExecutionRequest) (*types.HistoryEvent, error)
 		AddWorkflowExecutionTerminatedEvent(firstEventID int64, reason string, details []byte, identity string) (*types.HistoryEvent, error)
+		AddWorkflowExecutionUnpausedEvent(reason string, identity string) (*types.HistoryEvent, error)
 		ClearStickyness()
 		CheckResettable() error
 		CopyToPersistence() *persistence.WorkflowMutableState
 		RetryActivity(ai *persistence.ActivityInfo, failureReason string, failureDetails []byte, failureOptions *types.FailureOptions) (bool, error)
+		RetryActivityNow(scheduleID int64) error
+		PauseActivity(scheduleID int64) error
+		UnpauseActivity(scheduleID int64) error
+		UpsertWorkflowSearchAttributesAndMemo(searchAttributes *types.SearchAttributes, memo *types.Memo) error
+		ResetActivityAttempt(scheduleID int64) error
 		CreateNewHistoryEvent(eventType types.EventType) *types.HistoryEvent
 		CreateNewHistoryEventWithTimestamp(eventType types.EventType, timestamp int64) *types.HistoryEvent
 		CreateTransientDecisionEvents(di *DecisionInfo, identity string) (*types.HistoryEvent, *types.HistoryEvent)
@@ -155,15 +163,20 @@ type (
 		GetWorkflowStateCloseStatus() (int, int)
 		GetQueryRegistry() query.Registry
 		SetQueryRegistry(query.Registry)
+		GetUpdateRegistry() query.UpdateRegistry
+		GetUpdateOutcome(updateID string) (*types.UpdateWorkflowExecutionResponse, bool)
 		HasBufferedEvents() bool
 		HasInFlightDecision() bool
 		HasParentExecution() bool
 		HasPendingDecision() bool
 		HasProcessedOrPendingDecision() bool
+		IsActivityPaused(scheduleID int64) bool
 		IsCancelRequested() (bool, string)
 		IsCurrentWorkflowGuaranteed() bool
 		IsSignalRequested(requestID string) bool
+		IsUpdateAccepted(updateID string) bool
 		IsStickyTaskListEnabled() bool
+		IsWorkflowExecutionPaused() bool
 		IsWorkflowExecutionRunning() bool
 		IsWorkflowCompleted() bool
 		IsResourceDuplicated(resourceDedupKey definition.DeduplicationID) bool
@@ -190,6 +203,7 @@ type (
 		ReplicateDecisionTaskTimedOutEvent(*types.HistoryEvent) error
 		ReplicateExternalWorkflowExecutionCancelRequested(*types.HistoryEvent) error
 		ReplicateExternalWorkflowExecutionSignaled(*types.HistoryEvent) error
+		ReplicateMarkerRecordedEvent(*types.HistoryEvent) error
 		ReplicateRequestCancelExternalWorkflowExecutionFailedEvent(*types.HistoryEvent) error
 		ReplicateRequestCancelExternalWorkflowExecutionInitiatedEvent(int64, *types.HistoryEvent, string) (*persistence.RequestCancelInfo, error)
 		ReplicateSignalExternalWorkflowExecutionFailedEvent(*types.HistoryEvent) error
@@ -206,10 +220,12 @@ type (
 		ReplicateWorkflowExecutionCompletedEvent(int64, *types.HistoryEvent) error
 		ReplicateWorkflowExecutionContinuedAsNewEvent(int64, string, *types.HistoryEvent) error
 		ReplicateWorkflowExecutionFailedEvent(int64, *types.HistoryEvent) error
+		ReplicateWorkflowExecutionPausedEvent(*types.HistoryEvent) error
 		ReplicateWorkflowExecutionSignaled(*types.HistoryEvent) error
 		ReplicateWorkflowExecutionStartedEvent(*string, types.WorkflowExecution, string, *types.HistoryEvent, bool) error
 		ReplicateWorkflowExecutionTerminatedEvent(int64, *types.HistoryEvent) error
 		ReplicateWorkflowExecutionTimedoutEvent(int64, *types.HistoryEvent) error
+		ReplicateWorkflowExecutionUnpausedEvent(*types.HistoryEvent) error
 		SetCurrentBranchToken(branchToken []byte) error
 		SetHistoryBuilder(hBuilder *HistoryBuilder)
 		SetHistoryTree(treeID string) error
@@ -223,6 +239,7 @@ type (
 
 		AddTransferTasks(transferTasks ...persistence.Task)
 		AddTimerTasks(timerTasks ...persistence.Task)
+		AddReplicationTasks(replicationTasks ...persistence.Task)
 		GetTransferTasks() []persistence.Task
 		GetTimerTasks() []persistence.Task
 		DeleteTransferTasks()
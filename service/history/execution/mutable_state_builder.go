@@ -160,6 +160,7 @@ type (
 		taskGenerator       MutableStateTaskGenerator
 		decisionTaskManager mutableStateDecisionTaskManager
 		queryRegistry       query.Registry
+		updateRegistry      query.UpdateRegistry
 
 		shard                      shard.Context
 		clusterMetadata            cluster.Metadata
@@ -226,7 +227,8 @@ func newMutableStateBuilder(
 		domainEntry:           domainEntry,
 		appliedEvents:         make(map[string]struct{}),
 
-		queryRegistry: query.NewRegistry(),
+		queryRegistry:  query.NewRegistry(),
+		updateRegistry: query.NewUpdateRegistry(),
 
 		shard:           shard,
 		clusterMetadata: shard.GetClusterMetadata(),
@@ -827,9 +829,17 @@ func (e *mutableStateBuilder) CreateNewHistoryEvent(
 func (e *mutableStateBuilder) CreateNewHistoryEventWithTimestamp(
 	eventType types.EventType,
 	timestamp int64,
+) *types.HistoryEvent {
+	return e.newHistoryEvent(eventType, timestamp, e.shouldBufferEvent(eventType))
+}
+
+func (e *mutableStateBuilder) newHistoryEvent(
+	eventType types.EventType,
+	timestamp int64,
+	buffered bool,
 ) *types.HistoryEvent {
 	eventID := e.executionInfo.NextEventID
-	if e.shouldBufferEvent(eventType) {
+	if buffered {
 		eventID = constants.BufferedEventID
 	} else {
 		// only increase NextEventID if event is not buffered
@@ -917,6 +927,10 @@ func (e *mutableStateBuilder) SetQueryRegistry(queryRegistry query.Registry) {
 	e.queryRegistry = queryRegistry
 }
 
+func (e *mutableStateBuilder) GetUpdateRegistry() query.UpdateRegistry {
+	return e.updateRegistry
+}
+
 func (e *mutableStateBuilder) GetRetryBackoffDuration(
 	errReason string,
 ) time.Duration {
@@ -926,9 +940,18 @@ func (e *mutableStateBuilder) GetRetryBackoffDuration(
 		return backoff.NoBackoff
 	}
 
+	now := e.timeSource.Now()
+	expirationTime := info.ExpirationTime
+	if len(info.CronSchedule) != 0 && (info.MaximumAttempts > 0 || !expirationTime.IsZero()) {
+		// retries of a cron run must not run into the next scheduled run
+		if sched, err := backoff.ValidateSchedule(info.CronSchedule); err == nil {
+			expirationTime = backoff.GetRetryExpirationForCron(sched, now, expirationTime)
+		}
+	}
+
 	return getBackoffInterval(
-		e.timeSource.Now(),
-		info.ExpirationTime,
+		now,
+		expirationTime,
 		info.Attempt,
 		info.MaximumAttempts,
 		info.InitialInterval,
@@ -936,6 +959,9 @@ func (e *mutableStateBuilder) GetRetryBackoffDuration(
 		info.BackoffCoefficient,
 		errReason,
 		info.NonRetriableErrors,
+		info.RetryableErrors,
+		info.RetryJitterCoefficient,
+		info.RetryErrorReasonOverrides,
 	)
 }
 
@@ -1140,7 +1166,11 @@ func (e *mutableStateBuilder) AddRecordMarkerEvent(
 		return nil, err
 	}
 
-	return e.hBuilder.AddMarkerRecordedEvent(decisionCompletedEventID, attributes), nil
+	event := e.hBuilder.AddMarkerRecordedEvent(decisionCompletedEventID, attributes)
+	if err := e.ReplicateMarkerRecordedEvent(event); err != nil {
+		return nil, err
+	}
+	return event, nil
 }
 
 func (e *mutableStateBuilder) AddWorkflowExecutionTerminatedEvent(
@@ -1350,6 +1380,15 @@ func (e *mutableStateBuilder) AddTimerTasks(
 	e.insertTimerTasks = append(e.insertTimerTasks, timerTasks...)
 }
 
+// AddReplicationTasks adds replication tasks which are not derived from history events or
+// activities, they are persisted even when the transaction is closed as passive
+func (e *mutableStateBuilder) AddReplicationTasks(
+	replicationTasks ...persistence.Task,
+) {
+
+	e.insertReplicationTasks = append(e.insertReplicationTasks, replicationTasks...)
+}
+
 func (e *mutableStateBuilder) GetTransferTasks() []persistence.Task {
 	return e.insertTransferTasks
 }
@@ -1710,28 +1749,31 @@ func (e *mutableStateBuilder) prepareEventsAndReplicationTasks(
 		return nil, err
 	}
 
+	var replicationTasks []persistence.Task
 	for _, workflowEvents := range workflowEventsSeq {
-		replicationTasks, err := e.eventsToReplicationTask(transactionPolicy, workflowEvents.Events)
+		eventsReplicationTasks, err := e.eventsToReplicationTask(transactionPolicy, workflowEvents.Events)
 		if err != nil {
 			return nil, err
 		}
-		e.insertReplicationTasks = append(
-			e.insertReplicationTasks,
-			replicationTasks...,
+		replicationTasks = append(
+			replicationTasks,
+			eventsReplicationTasks...,
 		)
 	}
 
-	e.insertReplicationTasks = append(
-		e.insertReplicationTasks,
+	replicationTasks = append(
+		replicationTasks,
 		e.syncActivityToReplicationTask(transactionPolicy)...,
 	)
 
-	if transactionPolicy == TransactionPolicyPassive && len(e.insertReplicationTasks) > 0 {
+	// tasks added by AddReplicationTasks are not checked, they are added on purpose
+	if transactionPolicy == TransactionPolicyPassive && len(replicationTasks) > 0 {
 		return nil, &types.InternalServiceError{
 			Message: "should not generate replication task when close transaction as passive",
 		}
 	}
 
+	e.insertReplicationTasks = append(e.insertReplicationTasks, replicationTasks...)
 	return workflowEventsSeq, nil
 }
 
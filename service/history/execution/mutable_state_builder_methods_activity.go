@@ -102,6 +102,7 @@ func (e *mutableStateBuilder) ReplicateActivityInfo(
 	ai.LastFailureDetails = request.GetLastFailureDetails()
 	ai.LastFailureCategory = request.GetLastFailureOptions().GetFailureCategory()
 	ai.LastRetryIntervalSeconds = request.GetLastFailureOptions().GetNextRetryIntervalSeconds()
+	ai.Paused = request.GetPaused()
 
 	if resetActivityTimerTaskStatus {
 		ai.TimerTaskStatus = TimerTaskStatusNone
@@ -260,6 +261,8 @@ func (e *mutableStateBuilder) AddActivityTaskScheduledEvent(
 	if err != nil {
 		return nil, nil, nil, err
 	}
+	ai.Priority = attributes.GetPriority()
+	ai.FairnessKey = attributes.GetFairnessKey()
 	activityStartedScope := e.metricsClient.Scope(metrics.HistoryRecordActivityTaskStartedScope)
 	if e.config.EnableActivityLocalDispatchByDomain(e.domainEntry.GetInfo().Name) && attributes.RequestLocalDispatch {
 		activityStartedScope.IncCounter(metrics.CadenceRequests)
@@ -320,6 +323,9 @@ func (e *mutableStateBuilder) ReplicateActivityTaskScheduledEvent(
 		ai.MaximumInterval = attributes.RetryPolicy.GetMaximumIntervalInSeconds()
 		ai.MaximumAttempts = attributes.RetryPolicy.GetMaximumAttempts()
 		ai.NonRetriableErrors = attributes.RetryPolicy.NonRetriableErrorReasons
+		ai.RetryableErrors = attributes.RetryPolicy.GetRetryableErrorReasons()
+		ai.RetryJitterCoefficient = attributes.RetryPolicy.GetJitterCoefficient()
+		ai.RetryErrorReasonOverrides = attributes.RetryPolicy.GetErrorReasonOverrides()
 		if attributes.RetryPolicy.GetExpirationIntervalInSeconds() != 0 {
 			ai.ExpirationTime = ai.ScheduledTime.Add(time.Duration(attributes.RetryPolicy.GetExpirationIntervalInSeconds()) * time.Second)
 		}
@@ -712,6 +718,9 @@ func (e *mutableStateBuilder) RetryActivity(
 		ai.BackoffCoefficient,
 		failureReason,
 		ai.NonRetriableErrors,
+		ai.RetryableErrors,
+		ai.RetryJitterCoefficient,
+		ai.RetryErrorReasonOverrides,
 	)
 	if backoffInterval == backoff.NoBackoff {
 		return false, nil
@@ -0,0 +1,146 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package execution
+
+import (
+	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/log/tag"
+)
+
+func (e *mutableStateBuilder) IsActivityPaused(
+	scheduleID int64,
+) bool {
+
+	ai, ok := e.GetActivityInfo(scheduleID)
+	return ok && ai.Paused
+}
+
+// PauseActivity stops the retries of a pending activity. An attempt which is already started is not
+// interrupted, but the attempts after it are not dispatched until the activity is unpaused.
+func (e *mutableStateBuilder) PauseActivity(
+	scheduleID int64,
+) error {
+
+	opTag := tag.WorkflowActionActivityTaskPaused
+	if err := e.checkMutability(opTag); err != nil {
+		return err
+	}
+	ai, ok := e.GetActivityInfo(scheduleID)
+	if !ok || ai.Paused {
+		e.logWarn(mutableStateInvalidHistoryActionMsg, opTag,
+			tag.WorkflowScheduleID(scheduleID),
+			tag.ErrorTypeInvalidHistoryAction)
+		return e.createCallerError(opTag)
+	}
+
+	ai.Version = e.GetCurrentVersion()
+	ai.Paused = true
+	e.updateActivityInfos[ai.ScheduleID] = ai
+	e.syncActivityTasks[ai.ScheduleID] = struct{}{}
+	return nil
+}
+
+// UnpauseActivity resumes the retries of a paused activity. The attempt held back while the activity
+// was paused is dispatched at its original schedule time, or immediately if that time has passed.
+func (e *mutableStateBuilder) UnpauseActivity(
+	scheduleID int64,
+) error {
+
+	opTag := tag.WorkflowActionActivityTaskUnpaused
+	if err := e.checkMutability(opTag); err != nil {
+		return err
+	}
+	ai, ok := e.GetActivityInfo(scheduleID)
+	if !ok || !ai.Paused {
+		e.logWarn(mutableStateInvalidHistoryActionMsg, opTag,
+			tag.WorkflowScheduleID(scheduleID),
+			tag.ErrorTypeInvalidHistoryAction)
+		return e.createCallerError(opTag)
+	}
+
+	ai.Version = e.GetCurrentVersion()
+	ai.Paused = false
+	e.updateActivityInfos[ai.ScheduleID] = ai
+	e.syncActivityTasks[ai.ScheduleID] = struct{}{}
+	if ai.StartedID != constants.EmptyEventID {
+		return nil
+	}
+	return e.taskGenerator.GenerateActivityRetryTasks(scheduleID)
+}
+
+// ResetActivityAttempt sets the attempt of a pending activity back to 0, so that the retry policy
+// of the activity is evaluated as if it had never failed. The expiration time is not changed.
+func (e *mutableStateBuilder) ResetActivityAttempt(
+	scheduleID int64,
+) error {
+
+	opTag := tag.WorkflowActionActivityTaskAttemptReset
+	if err := e.checkMutability(opTag); err != nil {
+		return err
+	}
+	ai, ok := e.GetActivityInfo(scheduleID)
+	if !ok {
+		e.logWarn(mutableStateInvalidHistoryActionMsg, opTag,
+			tag.WorkflowScheduleID(scheduleID),
+			tag.ErrorTypeInvalidHistoryAction)
+		return e.createCallerError(opTag)
+	}
+
+	ai.Version = e.GetCurrentVersion()
+	ai.Attempt = 0
+	e.updateActivityInfos[ai.ScheduleID] = ai
+	e.syncActivityTasks[ai.ScheduleID] = struct{}{}
+
+	// the pending retry timer carries the previous version, so it has to be regenerated
+	if ai.StartedID != constants.EmptyEventID || ai.Paused {
+		return nil
+	}
+	return e.taskGenerator.GenerateActivityRetryTasks(scheduleID)
+}
+
+// RetryActivityNow dispatches the next attempt of an activity waiting for its retry backoff immediately
+func (e *mutableStateBuilder) RetryActivityNow(
+	scheduleID int64,
+) error {
+
+	opTag := tag.WorkflowActionActivityTaskRetryNow
+	if err := e.checkMutability(opTag); err != nil {
+		return err
+	}
+	ai, ok := e.GetActivityInfo(scheduleID)
+	if !ok || ai.StartedID != constants.EmptyEventID || ai.Paused {
+		e.logWarn(mutableStateInvalidHistoryActionMsg, opTag,
+			tag.WorkflowScheduleID(scheduleID),
+			tag.ErrorTypeInvalidHistoryAction)
+		return e.createCallerError(opTag)
+	}
+
+	ai.Version = e.GetCurrentVersion()
+	ai.ScheduledTime = e.timeSource.Now()
+	ai.TimerTaskStatus = TimerTaskStatusNone
+	if err := e.taskGenerator.GenerateActivityRetryTasks(scheduleID); err != nil {
+		return err
+	}
+
+	e.updateActivityInfos[ai.ScheduleID] = ai
+	e.syncActivityTasks[ai.ScheduleID] = struct{}{}
+	return nil
+}
@@ -0,0 +1,117 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package execution
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	commonconstants "github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/persistence"
+)
+
+func testMutableStateBuilderWithActivity(t *testing.T, startedID int64) (*mutableStateBuilder, *persistence.ActivityInfo) {
+	mb := testMutableStateBuilder(t)
+	ai := &persistence.ActivityInfo{
+		ScheduleID:    5,
+		StartedID:     startedID,
+		ActivityID:    "activity",
+		Attempt:       3,
+		ScheduledTime: currentTime.Add(time.Hour),
+	}
+	mb.pendingActivityInfoIDs[ai.ScheduleID] = ai
+	mb.pendingActivityIDToEventID[ai.ActivityID] = ai.ScheduleID
+	return mb, ai
+}
+
+func Test__PauseActivity(t *testing.T) {
+	mb, ai := testMutableStateBuilderWithActivity(t, commonconstants.EmptyEventID)
+
+	assert.Error(t, mb.PauseActivity(ai.ScheduleID+1), "activity does not exist")
+
+	require.NoError(t, mb.PauseActivity(ai.ScheduleID))
+	assert.True(t, mb.IsActivityPaused(ai.ScheduleID))
+	assert.True(t, ai.Paused)
+	assert.Equal(t, ai, mb.updateActivityInfos[ai.ScheduleID], "paused state should be persisted")
+	_, ok := mb.syncActivityTasks[ai.ScheduleID]
+	assert.True(t, ok, "paused state should be synced to the standby clusters")
+	assert.Error(t, mb.PauseActivity(ai.ScheduleID), "activity is already paused")
+
+	require.NoError(t, mb.DeleteActivity(ai.ScheduleID))
+	assert.False(t, mb.IsActivityPaused(ai.ScheduleID), "paused state should be removed with the activity")
+}
+
+func Test__UnpauseActivity(t *testing.T) {
+	mb, ai := testMutableStateBuilderWithActivity(t, commonconstants.EmptyEventID)
+
+	assert.Error(t, mb.UnpauseActivity(ai.ScheduleID), "activity is not paused")
+
+	require.NoError(t, mb.PauseActivity(ai.ScheduleID))
+	require.NoError(t, mb.UnpauseActivity(ai.ScheduleID))
+	assert.False(t, mb.IsActivityPaused(ai.ScheduleID))
+	assert.False(t, ai.Paused)
+	assert.Equal(t, ai, mb.updateActivityInfos[ai.ScheduleID], "unpaused state should be persisted")
+	require.Len(t, mb.insertTimerTasks, 1, "held back attempt should be dispatched")
+	task := mb.insertTimerTasks[0].(*persistence.ActivityRetryTimerTask)
+	assert.Equal(t, ai.ScheduleID, task.EventID)
+	assert.Equal(t, ai.ScheduledTime, task.VisibilityTimestamp)
+}
+
+func Test__ResetActivityAttempt(t *testing.T) {
+	mb, ai := testMutableStateBuilderWithActivity(t, commonconstants.EmptyEventID)
+
+	require.NoError(t, mb.ResetActivityAttempt(ai.ScheduleID))
+	assert.Equal(t, int32(0), ai.Attempt)
+	assert.Equal(t, mb.GetCurrentVersion(), ai.Version)
+	assert.Equal(t, ai, mb.updateActivityInfos[ai.ScheduleID])
+	assert.Contains(t, mb.syncActivityTasks, ai.ScheduleID)
+	require.Len(t, mb.insertTimerTasks, 1)
+	assert.Equal(t, int64(0), mb.insertTimerTasks[0].(*persistence.ActivityRetryTimerTask).Attempt)
+}
+
+func Test__RetryActivityNow(t *testing.T) {
+	t.Run("activity waiting for retry", func(t *testing.T) {
+		mb, ai := testMutableStateBuilderWithActivity(t, commonconstants.EmptyEventID)
+
+		require.NoError(t, mb.RetryActivityNow(ai.ScheduleID))
+		assert.Equal(t, currentTime, ai.ScheduledTime)
+		assert.Equal(t, int32(3), ai.Attempt)
+		assert.Equal(t, ai, mb.updateActivityInfos[ai.ScheduleID])
+		require.Len(t, mb.insertTimerTasks, 1)
+		assert.Equal(t, currentTime, mb.insertTimerTasks[0].GetVisibilityTimestamp())
+	})
+	t.Run("activity running", func(t *testing.T) {
+		mb, ai := testMutableStateBuilderWithActivity(t, 6)
+
+		assert.Error(t, mb.RetryActivityNow(ai.ScheduleID))
+		assert.Empty(t, mb.insertTimerTasks)
+	})
+	t.Run("activity paused", func(t *testing.T) {
+		mb, ai := testMutableStateBuilderWithActivity(t, commonconstants.EmptyEventID)
+		require.NoError(t, mb.PauseActivity(ai.ScheduleID))
+
+		assert.Error(t, mb.RetryActivityNow(ai.ScheduleID))
+		assert.Empty(t, mb.insertTimerTasks)
+	})
+}
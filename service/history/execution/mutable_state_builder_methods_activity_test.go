@@ -265,6 +265,7 @@ func Test__ReplicateActivityInfo(t *testing.T) {
 			FailureCategory:          types.FailureCategoryFatal.Ptr(),
 			NextRetryIntervalSeconds: common.Int32Ptr(100),
 		},
+		Paused: true,
 	}
 	ai := &persistence.ActivityInfo{}
 
@@ -281,6 +282,7 @@ func Test__ReplicateActivityInfo(t *testing.T) {
 	assert.Equal(t, now.UTC(), ai.LastHeartBeatUpdatedTime.UTC())
 	assert.Equal(t, request.LastFailureOptions.GetFailureCategory(), ai.LastFailureCategory)
 	assert.Equal(t, request.LastFailureOptions.GetNextRetryIntervalSeconds(), ai.LastRetryIntervalSeconds)
+	assert.True(t, ai.Paused)
 }
 
 func Test__UpdateActivity(t *testing.T) {
@@ -0,0 +1,95 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package execution
+
+import (
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/types"
+)
+
+func (e *mutableStateBuilder) IsWorkflowExecutionPaused() bool {
+	return e.executionInfo.Paused
+}
+
+// AddWorkflowExecutionPausedEvent stops the dispatch of the decision and activity tasks and the user timers of the workflow.
+// The event is buffered while a decision is in flight, same as a signal, and records who paused the workflow.
+func (e *mutableStateBuilder) AddWorkflowExecutionPausedEvent(
+	reason string,
+	identity string,
+) (*types.HistoryEvent, error) {
+
+	opTag := tag.WorkflowActionWorkflowPaused
+	if err := e.checkMutability(opTag); err != nil {
+		return nil, err
+	}
+	if e.IsWorkflowExecutionPaused() {
+		e.logWarn(mutableStateInvalidHistoryActionMsg, opTag,
+			tag.WorkflowEventID(e.GetNextEventID()),
+			tag.ErrorTypeInvalidHistoryAction)
+		return nil, e.createCallerError(opTag)
+	}
+
+	event := e.hBuilder.AddWorkflowExecutionPausedEvent(reason, identity)
+	if err := e.ReplicateWorkflowExecutionPausedEvent(event); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+func (e *mutableStateBuilder) ReplicateWorkflowExecutionPausedEvent(
+	event *types.HistoryEvent,
+) error {
+
+	e.executionInfo.Paused = true
+	return nil
+}
+
+// AddWorkflowExecutionUnpausedEvent resumes the dispatch of the tasks of a paused workflow,
+// the caller is responsible for regenerating the tasks dropped while the workflow was paused
+func (e *mutableStateBuilder) AddWorkflowExecutionUnpausedEvent(
+	reason string,
+	identity string,
+) (*types.HistoryEvent, error) {
+
+	opTag := tag.WorkflowActionWorkflowUnpaused
+	if err := e.checkMutability(opTag); err != nil {
+		return nil, err
+	}
+	if !e.IsWorkflowExecutionPaused() {
+		e.logWarn(mutableStateInvalidHistoryActionMsg, opTag,
+			tag.WorkflowEventID(e.GetNextEventID()),
+			tag.ErrorTypeInvalidHistoryAction)
+		return nil, e.createCallerError(opTag)
+	}
+
+	event := e.hBuilder.AddWorkflowExecutionUnpausedEvent(reason, identity)
+	if err := e.ReplicateWorkflowExecutionUnpausedEvent(event); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+func (e *mutableStateBuilder) ReplicateWorkflowExecutionUnpausedEvent(
+	event *types.HistoryEvent,
+) error {
+
+	e.executionInfo.Paused = false
+	return nil
+}
@@ -0,0 +1,85 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package execution
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common/types"
+)
+
+func Test__AddWorkflowExecutionPausedEvent(t *testing.T) {
+	mb := testMutableStateBuilder(t)
+	nextEventID := mb.GetNextEventID()
+	assert.False(t, mb.IsWorkflowExecutionPaused())
+
+	event, err := mb.AddWorkflowExecutionPausedEvent("incident-1234", "operator")
+	require.NoError(t, err)
+	assert.True(t, mb.IsWorkflowExecutionPaused())
+	assert.True(t, mb.executionInfo.Paused, "paused state should be persisted")
+	assert.Equal(t, nextEventID, event.ID)
+	assert.Equal(t, types.EventTypeWorkflowExecutionPaused, event.GetEventType())
+	assert.Equal(t, &types.WorkflowExecutionPausedEventAttributes{
+		Reason:   "incident-1234",
+		Identity: "operator",
+	}, event.WorkflowExecutionPausedEventAttributes, "the event should record who paused the workflow")
+	assert.Equal(t, nextEventID+1, mb.GetNextEventID())
+
+	_, err = mb.AddWorkflowExecutionPausedEvent("incident-1234", "operator")
+	assert.Error(t, err, "workflow is already paused")
+}
+
+func Test__AddWorkflowExecutionUnpausedEvent(t *testing.T) {
+	mb := testMutableStateBuilder(t)
+
+	_, err := mb.AddWorkflowExecutionUnpausedEvent("resolved", "operator")
+	assert.Error(t, err, "workflow is not paused")
+
+	_, err = mb.AddWorkflowExecutionPausedEvent("incident-1234", "operator")
+	require.NoError(t, err)
+	event, err := mb.AddWorkflowExecutionUnpausedEvent("resolved", "operator")
+	require.NoError(t, err)
+	assert.Equal(t, types.EventTypeWorkflowExecutionUnpaused, event.GetEventType())
+	assert.Equal(t, &types.WorkflowExecutionUnpausedEventAttributes{
+		Reason:   "resolved",
+		Identity: "operator",
+	}, event.WorkflowExecutionUnpausedEventAttributes)
+	assert.False(t, mb.IsWorkflowExecutionPaused())
+	assert.False(t, mb.executionInfo.Paused)
+}
+
+func Test__ReplicateWorkflowExecutionPauseEvents(t *testing.T) {
+	mb := testMutableStateBuilder(t)
+
+	require.NoError(t, mb.ReplicateWorkflowExecutionPausedEvent(&types.HistoryEvent{
+		EventType:                              types.EventTypeWorkflowExecutionPaused.Ptr(),
+		WorkflowExecutionPausedEventAttributes: &types.WorkflowExecutionPausedEventAttributes{},
+	}))
+	assert.True(t, mb.IsWorkflowExecutionPaused())
+
+	require.NoError(t, mb.ReplicateWorkflowExecutionUnpausedEvent(&types.HistoryEvent{
+		EventType:                                types.EventTypeWorkflowExecutionUnpaused.Ptr(),
+		WorkflowExecutionUnpausedEventAttributes: &types.WorkflowExecutionUnpausedEventAttributes{},
+	}))
+	assert.False(t, mb.IsWorkflowExecutionPaused())
+}
@@ -0,0 +1,88 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package execution
+
+import (
+	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+)
+
+// AddUpsertWorkflowSearchAttributesEventWithoutDecision records search attributes upserted outside of the decisions of
+// a running workflow. The event has no decision task completed event ID and, like a signal, it is buffered while a
+// decision is in flight.
+func (e *mutableStateBuilder) AddUpsertWorkflowSearchAttributesEventWithoutDecision(
+	searchAttributes *types.SearchAttributes,
+) (*types.HistoryEvent, error) {
+
+	opTag := tag.WorkflowActionUpsertWorkflowSearchAttributes
+	if err := e.checkMutability(opTag); err != nil {
+		return nil, err
+	}
+
+	event := e.newHistoryEvent(types.EventTypeUpsertWorkflowSearchAttributes, e.timeSource.Now().UnixNano(), true)
+	event.UpsertWorkflowSearchAttributesEventAttributes = &types.UpsertWorkflowSearchAttributesEventAttributes{
+		DecisionTaskCompletedEventID: constants.EmptyEventID,
+		SearchAttributes:             searchAttributes,
+	}
+	e.hBuilder.addEventToHistory(event)
+	if err := e.ReplicateUpsertWorkflowSearchAttributesEvent(event); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+// UpsertWorkflowSearchAttributesAndMemo merges search attributes and memo into the mutable state without recording an
+// event, and schedules the task writing them to visibility. It applies the memo, which history events don't carry, and
+// the search attributes of closed workflows, whose history can't be appended to.
+func (e *mutableStateBuilder) UpsertWorkflowSearchAttributesAndMemo(
+	searchAttributes *types.SearchAttributes,
+	memo *types.Memo,
+) error {
+
+	if len(searchAttributes.GetIndexedFields()) != 0 {
+		e.executionInfo.SearchAttributes = mergeMapOfByteArray(e.executionInfo.SearchAttributes, searchAttributes.GetIndexedFields())
+	}
+	if len(memo.GetFields()) != 0 {
+		e.executionInfo.Memo = mergeMapOfByteArray(e.executionInfo.Memo, memo.GetFields())
+	}
+
+	if e.IsWorkflowExecutionRunning() {
+		return e.taskGenerator.GenerateWorkflowSearchAttrTasks()
+	}
+	lastWriteVersion, err := e.GetLastWriteVersion()
+	if err != nil {
+		return err
+	}
+	e.AddTransferTasks(&persistence.RecordWorkflowClosedTask{
+		WorkflowIdentifier: persistence.WorkflowIdentifier{
+			DomainID:   e.executionInfo.DomainID,
+			WorkflowID: e.executionInfo.WorkflowID,
+			RunID:      e.executionInfo.RunID,
+		},
+		TaskData: persistence.TaskData{
+			Version: lastWriteVersion,
+		},
+		TaskList: e.executionInfo.TaskList,
+	})
+	return nil
+}
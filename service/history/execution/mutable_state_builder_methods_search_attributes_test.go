@@ -0,0 +1,110 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package execution
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+)
+
+func Test__UpsertWorkflowSearchAttributesAndMemo(t *testing.T) {
+	mb := testMutableStateBuilder(t)
+	mb.executionInfo.SearchAttributes = map[string][]byte{
+		"CustomKeywordField": []byte(`"old"`),
+		"CustomIntField":     []byte(`1`),
+	}
+	nextEventID := mb.GetNextEventID()
+
+	require.NoError(t, mb.UpsertWorkflowSearchAttributesAndMemo(&types.SearchAttributes{
+		IndexedFields: map[string][]byte{"CustomKeywordField": []byte(`"incident-1234"`)},
+	}, &types.Memo{
+		Fields: map[string][]byte{"incident": []byte(`"incident-1234"`)},
+	}))
+	assert.Equal(t, map[string][]byte{
+		"CustomKeywordField": []byte(`"incident-1234"`),
+		"CustomIntField":     []byte(`1`),
+	}, mb.executionInfo.SearchAttributes)
+	assert.Equal(t, map[string][]byte{"incident": []byte(`"incident-1234"`)}, mb.executionInfo.Memo)
+	assert.Equal(t, nextEventID, mb.GetNextEventID(), "the upsert should not be recorded in the history")
+	require.Len(t, mb.GetTransferTasks(), 1)
+	assert.IsType(t, &persistence.UpsertWorkflowSearchAttributesTask{}, mb.GetTransferTasks()[0])
+}
+
+func Test__UpsertWorkflowSearchAttributesAndMemo_Closed(t *testing.T) {
+	mb := testMutableStateBuilder(t)
+	mb.versionHistories = nil
+	mb.executionInfo.State = persistence.WorkflowStateCompleted
+	mb.executionInfo.Memo = map[string][]byte{"owner": []byte(`"team"`)}
+
+	require.NoError(t, mb.UpsertWorkflowSearchAttributesAndMemo(nil, &types.Memo{
+		Fields: map[string][]byte{"incident": []byte(`"incident-1234"`)},
+	}))
+	assert.Empty(t, mb.executionInfo.SearchAttributes)
+	assert.Equal(t, map[string][]byte{
+		"owner":    []byte(`"team"`),
+		"incident": []byte(`"incident-1234"`),
+	}, mb.executionInfo.Memo)
+	require.Len(t, mb.GetTransferTasks(), 1)
+	assert.Equal(t, &persistence.RecordWorkflowClosedTask{
+		WorkflowIdentifier: persistence.WorkflowIdentifier{
+			DomainID:   mb.executionInfo.DomainID,
+			WorkflowID: mb.executionInfo.WorkflowID,
+			RunID:      mb.executionInfo.RunID,
+		},
+		TaskData: persistence.TaskData{
+			Version: constants.EmptyVersion,
+		},
+		TaskList: mb.executionInfo.TaskList,
+	}, mb.GetTransferTasks()[0])
+}
+
+func Test__AddUpsertWorkflowSearchAttributesEventWithoutDecision(t *testing.T) {
+	mb := testMutableStateBuilder(t)
+	mb.executionInfo.SearchAttributes = map[string][]byte{"CustomIntField": []byte(`1`)}
+	nextEventID := mb.GetNextEventID()
+	searchAttributes := &types.SearchAttributes{
+		IndexedFields: map[string][]byte{"CustomKeywordField": []byte(`"incident-1234"`)},
+	}
+
+	event, err := mb.AddUpsertWorkflowSearchAttributesEventWithoutDecision(searchAttributes)
+	require.NoError(t, err)
+	assert.Equal(t, constants.BufferedEventID, event.ID, "the event should be buffered like a signal")
+	assert.Equal(t, &types.UpsertWorkflowSearchAttributesEventAttributes{
+		DecisionTaskCompletedEventID: constants.EmptyEventID,
+		SearchAttributes:             searchAttributes,
+	}, event.UpsertWorkflowSearchAttributesEventAttributes)
+	assert.Equal(t, map[string][]byte{
+		"CustomKeywordField": []byte(`"incident-1234"`),
+		"CustomIntField":     []byte(`1`),
+	}, mb.executionInfo.SearchAttributes)
+	require.Len(t, mb.GetTransferTasks(), 1)
+	assert.IsType(t, &persistence.UpsertWorkflowSearchAttributesTask{}, mb.GetTransferTasks()[0])
+
+	require.NoError(t, mb.FlushBufferedEvents())
+	assert.Equal(t, nextEventID, event.ID, "the event should be flushed without a decision in flight")
+	assert.Equal(t, nextEventID+1, mb.GetNextEventID())
+}
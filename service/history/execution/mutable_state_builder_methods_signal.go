@@ -28,6 +28,7 @@ import (
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/history/query"
 )
 
 func (e *mutableStateBuilder) IsSignalRequested(
@@ -79,6 +80,11 @@ func (e *mutableStateBuilder) ReplicateWorkflowExecutionSignaled(
 
 	// Increment signal count in mutable state for this workflow execution
 	e.executionInfo.SignalCount++
+	attributes := event.WorkflowExecutionSignaledEventAttributes
+	if query.IsReservedSignalName(attributes.GetSignalName()) {
+		// the update is pending until its completed update marker is recorded
+		e.AddSignalRequested(attributes.GetRequestID())
+	}
 	e.insertWorkflowRequest(persistence.WorkflowRequest{
 		RequestID:   event.WorkflowExecutionSignaledEventAttributes.RequestID,
 		Version:     event.Version,
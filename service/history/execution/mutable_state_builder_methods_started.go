@@ -265,6 +265,9 @@ func (e *mutableStateBuilder) ReplicateWorkflowExecutionStartedEvent(
 		e.executionInfo.MaximumAttempts = event.RetryPolicy.GetMaximumAttempts()
 		e.executionInfo.MaximumInterval = event.RetryPolicy.GetMaximumIntervalInSeconds()
 		e.executionInfo.NonRetriableErrors = event.RetryPolicy.NonRetriableErrorReasons
+		e.executionInfo.RetryableErrors = event.RetryPolicy.GetRetryableErrorReasons()
+		e.executionInfo.RetryJitterCoefficient = event.RetryPolicy.GetJitterCoefficient()
+		e.executionInfo.RetryErrorReasonOverrides = event.RetryPolicy.GetErrorReasonOverrides()
 	}
 
 	e.executionInfo.AutoResetPoints = rolloverAutoResetPointsWithExpiringTime(
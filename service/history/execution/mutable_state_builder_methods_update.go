@@ -0,0 +1,87 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package execution
+
+import (
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/history/query"
+)
+
+// GetUpdateOutcome returns the outcome of the update if it is among the most recently completed updates
+func (e *mutableStateBuilder) GetUpdateOutcome(
+	updateID string,
+) (*types.UpdateWorkflowExecutionResponse, bool) {
+
+	for _, update := range e.executionInfo.CompletedUpdates {
+		if update.UpdateID == updateID {
+			return &types.UpdateWorkflowExecutionResponse{
+				UpdateID:       update.UpdateID,
+				Result:         update.Result,
+				FailureReason:  update.FailureReason,
+				FailureDetails: update.FailureDetails,
+			}, true
+		}
+	}
+	return nil, false
+}
+
+// IsUpdateAccepted returns true if the update was accepted and its outcome is not recorded yet
+func (e *mutableStateBuilder) IsUpdateAccepted(
+	updateID string,
+) bool {
+
+	// accepted updates are recorded as signals with the update ID as request ID
+	return e.IsSignalRequested(updateID)
+}
+
+// ReplicateMarkerRecordedEvent keeps the outcome of the update completed by the marker, if it is a completed update
+// marker, so that retried updates are answered from the mutable state. Only the most recent outcomes are kept.
+func (e *mutableStateBuilder) ReplicateMarkerRecordedEvent(
+	event *types.HistoryEvent,
+) error {
+
+	attributes := event.MarkerRecordedEventAttributes
+	outcome, ok := query.NewUpdateCompletion(&types.RecordMarkerDecisionAttributes{
+		MarkerName: attributes.GetMarkerName(),
+		Details:    attributes.Details,
+		Header:     attributes.Header,
+	})
+	if !ok {
+		return nil
+	}
+
+	e.DeleteSignalRequested(outcome.UpdateID)
+	// the list is copied rather than appended to, copies of the execution info may share it
+	completedUpdates := make([]*persistence.CompletedUpdate, 0, len(e.executionInfo.CompletedUpdates)+1)
+	completedUpdates = append(completedUpdates, e.executionInfo.CompletedUpdates...)
+	completedUpdates = append(completedUpdates, &persistence.CompletedUpdate{
+		UpdateID:       outcome.UpdateID,
+		Result:         outcome.Result,
+		FailureReason:  outcome.FailureReason,
+		FailureDetails: outcome.FailureDetails,
+	})
+	maxCompletedUpdates := e.config.MaximumCompletedUpdatesPerExecution(e.GetDomainEntry().GetInfo().Name)
+	if maxCompletedUpdates > 0 && len(completedUpdates) > maxCompletedUpdates {
+		completedUpdates = completedUpdates[len(completedUpdates)-maxCompletedUpdates:]
+	}
+	e.executionInfo.CompletedUpdates = completedUpdates
+	return nil
+}
@@ -0,0 +1,101 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package execution
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/history/query"
+)
+
+func updateSignaledEvent(updateID string) *types.HistoryEvent {
+	return &types.HistoryEvent{
+		EventType: types.EventTypeWorkflowExecutionSignaled.Ptr(),
+		WorkflowExecutionSignaledEventAttributes: &types.WorkflowExecutionSignaledEventAttributes{
+			SignalName: query.UpdateSignalNamePrefix + "update",
+			RequestID:  updateID,
+		},
+	}
+}
+
+func updateCompletedEvent(markerName, updateID string, failureReason *string, details []byte) *types.HistoryEvent {
+	fields := map[string][]byte{query.UpdateIDHeaderKey: []byte(updateID)}
+	if failureReason != nil {
+		fields[query.UpdateFailureReasonHeaderKey] = []byte(*failureReason)
+	}
+	return &types.HistoryEvent{
+		EventType: types.EventTypeMarkerRecorded.Ptr(),
+		MarkerRecordedEventAttributes: &types.MarkerRecordedEventAttributes{
+			MarkerName: markerName,
+			Details:    details,
+			Header:     &types.Header{Fields: fields},
+		},
+	}
+}
+
+func Test__ReplicateMarkerRecordedEvent(t *testing.T) {
+	mb := testMutableStateBuilder(t)
+
+	require.NoError(t, mb.ReplicateWorkflowExecutionSignaled(updateSignaledEvent("update-1")))
+	require.NoError(t, mb.ReplicateWorkflowExecutionSignaled(updateSignaledEvent("update-2")))
+	assert.True(t, mb.IsUpdateAccepted("update-1"))
+	assert.True(t, mb.IsUpdateAccepted("update-2"))
+
+	require.NoError(t, mb.ReplicateMarkerRecordedEvent(updateCompletedEvent("some-marker", "update-1", nil, []byte("ignored"))))
+	assert.True(t, mb.IsUpdateAccepted("update-1"), "only completed update markers complete updates")
+
+	require.NoError(t, mb.ReplicateMarkerRecordedEvent(updateCompletedEvent(query.UpdateCompletedMarkerName, "update-2", common.StringPtr("bad input"), []byte("details"))))
+	require.NoError(t, mb.ReplicateMarkerRecordedEvent(updateCompletedEvent(query.UpdateCompletedMarkerName, "update-1", nil, []byte("result"))))
+	assert.False(t, mb.IsUpdateAccepted("update-1"))
+	assert.False(t, mb.IsUpdateAccepted("update-2"))
+	assert.Contains(t, mb.deleteSignalRequestedIDs, "update-1", "the pending update should be removed from the persisted state")
+
+	outcome, ok := mb.GetUpdateOutcome("update-1")
+	assert.True(t, ok)
+	assert.Equal(t, &types.UpdateWorkflowExecutionResponse{UpdateID: "update-1", Result: []byte("result")}, outcome)
+	outcome, ok = mb.GetUpdateOutcome("update-2")
+	assert.True(t, ok)
+	assert.Equal(t, &types.UpdateWorkflowExecutionResponse{
+		UpdateID:       "update-2",
+		FailureReason:  common.StringPtr("bad input"),
+		FailureDetails: []byte("details"),
+	}, outcome)
+	_, ok = mb.GetUpdateOutcome("update-3")
+	assert.False(t, ok)
+}
+
+func Test__ReplicateMarkerRecordedEvent_KeepsMostRecentOutcomes(t *testing.T) {
+	mb := testMutableStateBuilder(t)
+	mb.config.MaximumCompletedUpdatesPerExecution = func(domain string) int { return 2 }
+
+	for _, id := range []string{"update-1", "update-2", "update-3"} {
+		require.NoError(t, mb.ReplicateMarkerRecordedEvent(updateCompletedEvent(query.UpdateCompletedMarkerName, id, nil, nil)))
+	}
+	_, ok := mb.GetUpdateOutcome("update-1")
+	assert.False(t, ok, "the oldest outcome should be dropped")
+	require.Len(t, mb.executionInfo.CompletedUpdates, 2)
+	assert.Equal(t, "update-2", mb.executionInfo.CompletedUpdates[0].UpdateID)
+	assert.Equal(t, "update-3", mb.executionInfo.CompletedUpdates[1].UpdateID)
+}
@@ -2359,6 +2359,32 @@ func TestGetRetryBackoffDuration(t *testing.T) {
 			errorReason:     "some error reason",
 			expectedBackoff: 24 * time.Second,
 		},
+		{
+			name: "CronRetryBeforeNextRun",
+			retryPolicy: &persistence.WorkflowExecutionInfo{
+				HasRetryPolicy:     true,
+				CronSchedule:       "0 * * * *",
+				Attempt:            1,
+				MaximumAttempts:    5,
+				BackoffCoefficient: 2.0,
+				InitialInterval:    12,
+			},
+			errorReason:     "some error reason",
+			expectedBackoff: 24 * time.Second,
+		},
+		{
+			name: "CronRetryPastNextRun",
+			retryPolicy: &persistence.WorkflowExecutionInfo{
+				HasRetryPolicy:     true,
+				CronSchedule:       "* * * * *",
+				Attempt:            1,
+				MaximumAttempts:    5,
+				BackoffCoefficient: 2.0,
+				InitialInterval:    60,
+			},
+			errorReason:     "some error reason",
+			expectedBackoff: backoff.NoBackoff,
+		},
 	}
 
 	for _, tt := range tests {
@@ -2704,6 +2730,7 @@ func TestSimpleGetters(t *testing.T) {
 	assert.Equal(t, msb.pendingRequestCancelInfoIDs, msb.GetPendingRequestCancelExternalInfos())
 	assert.Equal(t, msb.executionInfo.LastProcessedEvent, msb.GetPreviousStartedEventID())
 	assert.Equal(t, msb.queryRegistry, msb.GetQueryRegistry())
+	assert.Equal(t, msb.updateRegistry, msb.GetUpdateRegistry())
 
 	startVersion, err := msb.GetStartVersion()
 	assert.NoError(t, err)
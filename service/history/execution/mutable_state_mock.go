@@ -487,6 +487,22 @@ func (mr *MockMutableStateMockRecorder) AddRecordMarkerEvent(arg0, arg1 any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddRecordMarkerEvent", reflect.TypeOf((*MockMutableState)(nil).AddRecordMarkerEvent), arg0, arg1)
 }
 
+// AddReplicationTasks mocks base method.
+func (m *MockMutableState) AddReplicationTasks(replicationTasks ...persistence.Task) {
+	m.ctrl.T.Helper()
+	varargs := []any{}
+	for _, a := range replicationTasks {
+		varargs = append(varargs, a)
+	}
+	m.ctrl.Call(m, "AddReplicationTasks", varargs...)
+}
+
+// AddReplicationTasks indicates an expected call of AddReplicationTasks.
+func (mr *MockMutableStateMockRecorder) AddReplicationTasks(replicationTasks ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddReplicationTasks", reflect.TypeOf((*MockMutableState)(nil).AddReplicationTasks), replicationTasks...)
+}
+
 // AddRequestCancelActivityTaskFailedEvent mocks base method.
 func (m *MockMutableState) AddRequestCancelActivityTaskFailedEvent(arg0 int64, arg1, arg2 string) (*types.HistoryEvent, error) {
 	m.ctrl.T.Helper()
@@ -715,6 +731,21 @@ func (mr *MockMutableStateMockRecorder) AddUpsertWorkflowSearchAttributesEvent(a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddUpsertWorkflowSearchAttributesEvent", reflect.TypeOf((*MockMutableState)(nil).AddUpsertWorkflowSearchAttributesEvent), arg0, arg1)
 }
 
+// AddUpsertWorkflowSearchAttributesEventWithoutDecision mocks base method.
+func (m *MockMutableState) AddUpsertWorkflowSearchAttributesEventWithoutDecision(arg0 *types.SearchAttributes) (*types.HistoryEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddUpsertWorkflowSearchAttributesEventWithoutDecision", arg0)
+	ret0, _ := ret[0].(*types.HistoryEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddUpsertWorkflowSearchAttributesEventWithoutDecision indicates an expected call of AddUpsertWorkflowSearchAttributesEventWithoutDecision.
+func (mr *MockMutableStateMockRecorder) AddUpsertWorkflowSearchAttributesEventWithoutDecision(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddUpsertWorkflowSearchAttributesEventWithoutDecision", reflect.TypeOf((*MockMutableState)(nil).AddUpsertWorkflowSearchAttributesEventWithoutDecision), arg0)
+}
+
 // AddWorkflowExecutionCancelRequestedEvent mocks base method.
 func (m *MockMutableState) AddWorkflowExecutionCancelRequestedEvent(arg0 string, arg1 *types.HistoryRequestCancelWorkflowExecutionRequest) (*types.HistoryEvent, error) {
 	m.ctrl.T.Helper()
@@ -745,6 +776,21 @@ func (mr *MockMutableStateMockRecorder) AddWorkflowExecutionCanceledEvent(arg0,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddWorkflowExecutionCanceledEvent", reflect.TypeOf((*MockMutableState)(nil).AddWorkflowExecutionCanceledEvent), arg0, arg1)
 }
 
+// AddWorkflowExecutionPausedEvent mocks base method.
+func (m *MockMutableState) AddWorkflowExecutionPausedEvent(reason, identity string) (*types.HistoryEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddWorkflowExecutionPausedEvent", reason, identity)
+	ret0, _ := ret[0].(*types.HistoryEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddWorkflowExecutionPausedEvent indicates an expected call of AddWorkflowExecutionPausedEvent.
+func (mr *MockMutableStateMockRecorder) AddWorkflowExecutionPausedEvent(reason, identity any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddWorkflowExecutionPausedEvent", reflect.TypeOf((*MockMutableState)(nil).AddWorkflowExecutionPausedEvent), reason, identity)
+}
+
 // AddWorkflowExecutionSignaled mocks base method.
 func (m *MockMutableState) AddWorkflowExecutionSignaled(signalName string, input []byte, identity, reqeustID string) (*types.HistoryEvent, error) {
 	m.ctrl.T.Helper()
@@ -790,6 +836,21 @@ func (mr *MockMutableStateMockRecorder) AddWorkflowExecutionTerminatedEvent(firs
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddWorkflowExecutionTerminatedEvent", reflect.TypeOf((*MockMutableState)(nil).AddWorkflowExecutionTerminatedEvent), firstEventID, reason, details, identity)
 }
 
+// AddWorkflowExecutionUnpausedEvent mocks base method.
+func (m *MockMutableState) AddWorkflowExecutionUnpausedEvent(reason, identity string) (*types.HistoryEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddWorkflowExecutionUnpausedEvent", reason, identity)
+	ret0, _ := ret[0].(*types.HistoryEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddWorkflowExecutionUnpausedEvent indicates an expected call of AddWorkflowExecutionUnpausedEvent.
+func (mr *MockMutableStateMockRecorder) AddWorkflowExecutionUnpausedEvent(reason, identity any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddWorkflowExecutionUnpausedEvent", reflect.TypeOf((*MockMutableState)(nil).AddWorkflowExecutionUnpausedEvent), reason, identity)
+}
+
 // ByteSize mocks base method.
 func (m *MockMutableState) ByteSize() uint64 {
 	m.ctrl.T.Helper()
@@ -1541,6 +1602,35 @@ func (mr *MockMutableStateMockRecorder) GetUpdateCondition() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUpdateCondition", reflect.TypeOf((*MockMutableState)(nil).GetUpdateCondition))
 }
 
+// GetUpdateOutcome mocks base method.
+func (m *MockMutableState) GetUpdateOutcome(updateID string) (*types.UpdateWorkflowExecutionResponse, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUpdateOutcome", updateID)
+	ret0, _ := ret[0].(*types.UpdateWorkflowExecutionResponse)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// GetUpdateOutcome indicates an expected call of GetUpdateOutcome.
+func (mr *MockMutableStateMockRecorder) GetUpdateOutcome(updateID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUpdateOutcome", reflect.TypeOf((*MockMutableState)(nil).GetUpdateOutcome), updateID)
+}
+
+// GetUpdateRegistry mocks base method.
+func (m *MockMutableState) GetUpdateRegistry() query.UpdateRegistry {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUpdateRegistry")
+	ret0, _ := ret[0].(query.UpdateRegistry)
+	return ret0
+}
+
+// GetUpdateRegistry indicates an expected call of GetUpdateRegistry.
+func (mr *MockMutableStateMockRecorder) GetUpdateRegistry() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUpdateRegistry", reflect.TypeOf((*MockMutableState)(nil).GetUpdateRegistry))
+}
+
 // GetUserTimerInfo mocks base method.
 func (m *MockMutableState) GetUserTimerInfo(arg0 string) (*persistence.TimerInfo, bool) {
 	m.ctrl.T.Helper()
@@ -1684,6 +1774,20 @@ func (mr *MockMutableStateMockRecorder) HasProcessedOrPendingDecision() *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasProcessedOrPendingDecision", reflect.TypeOf((*MockMutableState)(nil).HasProcessedOrPendingDecision))
 }
 
+// IsActivityPaused mocks base method.
+func (m *MockMutableState) IsActivityPaused(scheduleID int64) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsActivityPaused", scheduleID)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsActivityPaused indicates an expected call of IsActivityPaused.
+func (mr *MockMutableStateMockRecorder) IsActivityPaused(scheduleID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsActivityPaused", reflect.TypeOf((*MockMutableState)(nil).IsActivityPaused), scheduleID)
+}
+
 // IsCancelRequested mocks base method.
 func (m *MockMutableState) IsCancelRequested() (bool, string) {
 	m.ctrl.T.Helper()
@@ -1755,6 +1859,20 @@ func (mr *MockMutableStateMockRecorder) IsStickyTaskListEnabled() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsStickyTaskListEnabled", reflect.TypeOf((*MockMutableState)(nil).IsStickyTaskListEnabled))
 }
 
+// IsUpdateAccepted mocks base method.
+func (m *MockMutableState) IsUpdateAccepted(updateID string) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsUpdateAccepted", updateID)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsUpdateAccepted indicates an expected call of IsUpdateAccepted.
+func (mr *MockMutableStateMockRecorder) IsUpdateAccepted(updateID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsUpdateAccepted", reflect.TypeOf((*MockMutableState)(nil).IsUpdateAccepted), updateID)
+}
+
 // IsWorkflowCompleted mocks base method.
 func (m *MockMutableState) IsWorkflowCompleted() bool {
 	m.ctrl.T.Helper()
@@ -1769,6 +1887,20 @@ func (mr *MockMutableStateMockRecorder) IsWorkflowCompleted() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsWorkflowCompleted", reflect.TypeOf((*MockMutableState)(nil).IsWorkflowCompleted))
 }
 
+// IsWorkflowExecutionPaused mocks base method.
+func (m *MockMutableState) IsWorkflowExecutionPaused() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsWorkflowExecutionPaused")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsWorkflowExecutionPaused indicates an expected call of IsWorkflowExecutionPaused.
+func (mr *MockMutableStateMockRecorder) IsWorkflowExecutionPaused() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsWorkflowExecutionPaused", reflect.TypeOf((*MockMutableState)(nil).IsWorkflowExecutionPaused))
+}
+
 // IsWorkflowExecutionRunning mocks base method.
 func (m *MockMutableState) IsWorkflowExecutionRunning() bool {
 	m.ctrl.T.Helper()
@@ -1795,6 +1927,20 @@ func (mr *MockMutableStateMockRecorder) Load(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Load", reflect.TypeOf((*MockMutableState)(nil).Load), arg0, arg1)
 }
 
+// PauseActivity mocks base method.
+func (m *MockMutableState) PauseActivity(scheduleID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PauseActivity", scheduleID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PauseActivity indicates an expected call of PauseActivity.
+func (mr *MockMutableStateMockRecorder) PauseActivity(scheduleID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseActivity", reflect.TypeOf((*MockMutableState)(nil).PauseActivity), scheduleID)
+}
+
 // ReplicateActivityInfo mocks base method.
 func (m *MockMutableState) ReplicateActivityInfo(arg0 *types.SyncActivityRequest, arg1 bool) error {
 	m.ctrl.T.Helper()
@@ -2092,6 +2238,20 @@ func (mr *MockMutableStateMockRecorder) ReplicateExternalWorkflowExecutionSignal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplicateExternalWorkflowExecutionSignaled", reflect.TypeOf((*MockMutableState)(nil).ReplicateExternalWorkflowExecutionSignaled), arg0)
 }
 
+// ReplicateMarkerRecordedEvent mocks base method.
+func (m *MockMutableState) ReplicateMarkerRecordedEvent(arg0 *types.HistoryEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplicateMarkerRecordedEvent", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplicateMarkerRecordedEvent indicates an expected call of ReplicateMarkerRecordedEvent.
+func (mr *MockMutableStateMockRecorder) ReplicateMarkerRecordedEvent(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplicateMarkerRecordedEvent", reflect.TypeOf((*MockMutableState)(nil).ReplicateMarkerRecordedEvent), arg0)
+}
+
 // ReplicateRequestCancelExternalWorkflowExecutionFailedEvent mocks base method.
 func (m *MockMutableState) ReplicateRequestCancelExternalWorkflowExecutionFailedEvent(arg0 *types.HistoryEvent) error {
 	m.ctrl.T.Helper()
@@ -2320,6 +2480,20 @@ func (mr *MockMutableStateMockRecorder) ReplicateWorkflowExecutionFailedEvent(ar
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplicateWorkflowExecutionFailedEvent", reflect.TypeOf((*MockMutableState)(nil).ReplicateWorkflowExecutionFailedEvent), arg0, arg1)
 }
 
+// ReplicateWorkflowExecutionPausedEvent mocks base method.
+func (m *MockMutableState) ReplicateWorkflowExecutionPausedEvent(arg0 *types.HistoryEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplicateWorkflowExecutionPausedEvent", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplicateWorkflowExecutionPausedEvent indicates an expected call of ReplicateWorkflowExecutionPausedEvent.
+func (mr *MockMutableStateMockRecorder) ReplicateWorkflowExecutionPausedEvent(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplicateWorkflowExecutionPausedEvent", reflect.TypeOf((*MockMutableState)(nil).ReplicateWorkflowExecutionPausedEvent), arg0)
+}
+
 // ReplicateWorkflowExecutionSignaled mocks base method.
 func (m *MockMutableState) ReplicateWorkflowExecutionSignaled(arg0 *types.HistoryEvent) error {
 	m.ctrl.T.Helper()
@@ -2376,6 +2550,34 @@ func (mr *MockMutableStateMockRecorder) ReplicateWorkflowExecutionTimedoutEvent(
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplicateWorkflowExecutionTimedoutEvent", reflect.TypeOf((*MockMutableState)(nil).ReplicateWorkflowExecutionTimedoutEvent), arg0, arg1)
 }
 
+// ReplicateWorkflowExecutionUnpausedEvent mocks base method.
+func (m *MockMutableState) ReplicateWorkflowExecutionUnpausedEvent(arg0 *types.HistoryEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplicateWorkflowExecutionUnpausedEvent", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplicateWorkflowExecutionUnpausedEvent indicates an expected call of ReplicateWorkflowExecutionUnpausedEvent.
+func (mr *MockMutableStateMockRecorder) ReplicateWorkflowExecutionUnpausedEvent(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplicateWorkflowExecutionUnpausedEvent", reflect.TypeOf((*MockMutableState)(nil).ReplicateWorkflowExecutionUnpausedEvent), arg0)
+}
+
+// ResetActivityAttempt mocks base method.
+func (m *MockMutableState) ResetActivityAttempt(scheduleID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetActivityAttempt", scheduleID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResetActivityAttempt indicates an expected call of ResetActivityAttempt.
+func (mr *MockMutableStateMockRecorder) ResetActivityAttempt(scheduleID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetActivityAttempt", reflect.TypeOf((*MockMutableState)(nil).ResetActivityAttempt), scheduleID)
+}
+
 // RetryActivity mocks base method.
 func (m *MockMutableState) RetryActivity(ai *persistence.ActivityInfo, failureReason string, failureDetails []byte, failureOptions *types.FailureOptions) (bool, error) {
 	m.ctrl.T.Helper()
@@ -2391,6 +2593,20 @@ func (mr *MockMutableStateMockRecorder) RetryActivity(ai, failureReason, failure
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetryActivity", reflect.TypeOf((*MockMutableState)(nil).RetryActivity), ai, failureReason, failureDetails, failureOptions)
 }
 
+// RetryActivityNow mocks base method.
+func (m *MockMutableState) RetryActivityNow(scheduleID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetryActivityNow", scheduleID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RetryActivityNow indicates an expected call of RetryActivityNow.
+func (mr *MockMutableStateMockRecorder) RetryActivityNow(scheduleID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetryActivityNow", reflect.TypeOf((*MockMutableState)(nil).RetryActivityNow), scheduleID)
+}
+
 // SetCurrentBranchToken mocks base method.
 func (m *MockMutableState) SetCurrentBranchToken(branchToken []byte) error {
 	m.ctrl.T.Helper()
@@ -2496,6 +2712,20 @@ func (mr *MockMutableStateMockRecorder) StartTransaction(ctx, entry, incomingTas
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTransaction", reflect.TypeOf((*MockMutableState)(nil).StartTransaction), ctx, entry, incomingTaskVersion)
 }
 
+// UnpauseActivity mocks base method.
+func (m *MockMutableState) UnpauseActivity(scheduleID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnpauseActivity", scheduleID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnpauseActivity indicates an expected call of UnpauseActivity.
+func (mr *MockMutableStateMockRecorder) UnpauseActivity(scheduleID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnpauseActivity", reflect.TypeOf((*MockMutableState)(nil).UnpauseActivity), scheduleID)
+}
+
 // UpdateActivity mocks base method.
 func (m *MockMutableState) UpdateActivity(arg0 *persistence.ActivityInfo) error {
 	m.ctrl.T.Helper()
@@ -2587,3 +2817,17 @@ func (mr *MockMutableStateMockRecorder) UpdateWorkflowStateCloseStatus(state, cl
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkflowStateCloseStatus", reflect.TypeOf((*MockMutableState)(nil).UpdateWorkflowStateCloseStatus), state, closeStatus)
 }
+
+// UpsertWorkflowSearchAttributesAndMemo mocks base method.
+func (m *MockMutableState) UpsertWorkflowSearchAttributesAndMemo(searchAttributes *types.SearchAttributes, memo *types.Memo) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertWorkflowSearchAttributesAndMemo", searchAttributes, memo)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertWorkflowSearchAttributesAndMemo indicates an expected call of UpsertWorkflowSearchAttributesAndMemo.
+func (mr *MockMutableStateMockRecorder) UpsertWorkflowSearchAttributesAndMemo(searchAttributes, memo any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertWorkflowSearchAttributesAndMemo", reflect.TypeOf((*MockMutableState)(nil).UpsertWorkflowSearchAttributesAndMemo), searchAttributes, memo)
+}
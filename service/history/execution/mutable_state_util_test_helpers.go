@@ -146,6 +146,10 @@ func CopyWorkflowExecutionInfo(t *testing.T, sourceInfo *persistence.WorkflowExe
 		ExpirationTime:                     sourceInfo.ExpirationTime,
 		MaximumAttempts:                    sourceInfo.MaximumAttempts,
 		NonRetriableErrors:                 sourceInfo.NonRetriableErrors,
+		RetryJitterCoefficient:             sourceInfo.RetryJitterCoefficient,
+		RetryableErrors:                    sourceInfo.RetryableErrors,
+		RetryErrorReasonOverrides:          sourceInfo.RetryErrorReasonOverrides,
+		CompletedUpdates:                   sourceInfo.CompletedUpdates,
 		BranchToken:                        sourceInfo.BranchToken,
 		ExpirationSeconds:                  sourceInfo.ExpirationSeconds,
 		CronOverlapPolicy:                  sourceInfo.CronOverlapPolicy,
@@ -194,6 +198,11 @@ func CopyActivityInfo(t *testing.T, sourceInfo *persistence.ActivityInfo) *persi
 		LastFailureDetails:       sourceInfo.LastFailureDetails,
 		LastFailureCategory:      sourceInfo.LastFailureCategory,
 		LastRetryIntervalSeconds: sourceInfo.LastRetryIntervalSeconds,
+
+		RetryJitterCoefficient:    sourceInfo.RetryJitterCoefficient,
+		RetryableErrors:           sourceInfo.RetryableErrors,
+		RetryErrorReasonOverrides: sourceInfo.RetryErrorReasonOverrides,
+
 		// Not written to database - This is used only for deduping heartbeat timer creation
 		LastHeartbeatTimeoutVisibilityInSeconds: sourceInfo.LastHeartbeatTimeoutVisibilityInSeconds,
 	}
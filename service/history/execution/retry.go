@@ -21,11 +21,11 @@
 package execution
 
 import (
-	"math"
 	"time"
 
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/backoff"
+	"github.com/uber/cadence/common/types"
 )
 
 func getBackoffInterval(
@@ -38,39 +38,11 @@ func getBackoffInterval(
 	backoffCoefficient float64,
 	failureReason string,
 	nonRetriableErrors []string,
+	retryableErrors []string,
+	jitterCoefficient float64,
+	errorReasonOverrides []*types.RetryPolicyOverride,
 ) time.Duration {
 
-	if maxAttempts == 0 && expirationTime.IsZero() {
-		return backoff.NoBackoff
-	}
-
-	if maxAttempts > 0 && currAttempt >= maxAttempts-1 {
-		// currAttempt starts from 0.
-		// MaximumAttempts is the total attempts, including initial (non-retry) attempt.
-		return backoff.NoBackoff
-	}
-
-	nextInterval := int64(float64(initInterval) * math.Pow(backoffCoefficient, float64(currAttempt)))
-	if nextInterval <= 0 {
-		// math.Pow() could overflow
-		if maxInterval > 0 {
-			nextInterval = int64(maxInterval)
-		} else {
-			return backoff.NoBackoff
-		}
-	}
-
-	if maxInterval > 0 && nextInterval > int64(maxInterval) {
-		// cap next interval to MaxInterval
-		nextInterval = int64(maxInterval)
-	}
-
-	backoffInterval := time.Duration(nextInterval) * time.Second
-	nextScheduleTime := now.Add(backoffInterval)
-	if !expirationTime.IsZero() && nextScheduleTime.After(expirationTime) {
-		return backoff.NoBackoff
-	}
-
 	// make sure we don't retry size exceeded error reasons. Note that FailureReasonFailureDetailsExceedsLimit is retryable.
 	if failureReason == common.FailureReasonCancelDetailsExceedsLimit ||
 		failureReason == common.FailureReasonCompleteResultExceedsLimit ||
@@ -79,12 +51,16 @@ func getBackoffInterval(
 		return backoff.NoBackoff
 	}
 
-	// check if error is non-retriable
-	for _, er := range nonRetriableErrors {
-		if er == failureReason {
-			return backoff.NoBackoff
-		}
+	policy := &types.RetryPolicy{
+		InitialIntervalInSeconds: initInterval,
+		BackoffCoefficient:       backoffCoefficient,
+		MaximumIntervalInSeconds: maxInterval,
+		MaximumAttempts:          maxAttempts,
+		NonRetriableErrorReasons: nonRetriableErrors,
+		RetryableErrorReasons:    retryableErrors,
+		JitterCoefficient:        jitterCoefficient,
+		ErrorReasonOverrides:     errorReasonOverrides,
 	}
 
-	return backoffInterval
+	return backoff.GetBackoffForNextRetry(now, expirationTime, currAttempt, policy, failureReason)
 }
@@ -29,6 +29,7 @@ import (
 	"github.com/uber/cadence/common/backoff"
 	"github.com/uber/cadence/common/clock"
 	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
 )
 
 func Test_NextRetry2(t *testing.T) {
@@ -63,6 +64,9 @@ func Test_NextRetry2(t *testing.T) {
 		ai.BackoffCoefficient,
 		reason,
 		ai.NonRetriableErrors,
+		ai.RetryableErrors,
+		ai.RetryJitterCoefficient,
+		ai.RetryErrorReasonOverrides,
 	)
 
 	t.Logf("dur: %v", dur)
@@ -93,6 +97,9 @@ func Test_NextRetry(t *testing.T) {
 		ai.BackoffCoefficient,
 		reason,
 		ai.NonRetriableErrors,
+		ai.RetryableErrors,
+		ai.RetryJitterCoefficient,
+		ai.RetryErrorReasonOverrides,
 	))
 
 	// no retry if cancel requested
@@ -108,6 +115,9 @@ func Test_NextRetry(t *testing.T) {
 		ai.BackoffCoefficient,
 		reason,
 		ai.NonRetriableErrors,
+		ai.RetryableErrors,
+		ai.RetryJitterCoefficient,
+		ai.RetryErrorReasonOverrides,
 	))
 
 	// no retry if both MaximumAttempts and ExpirationTime are not set
@@ -122,6 +132,9 @@ func Test_NextRetry(t *testing.T) {
 		ai.BackoffCoefficient,
 		reason,
 		ai.NonRetriableErrors,
+		ai.RetryableErrors,
+		ai.RetryJitterCoefficient,
+		ai.RetryErrorReasonOverrides,
 	))
 
 	// no retry if MaximumAttempts is 1 (for initial attempt)
@@ -137,6 +150,9 @@ func Test_NextRetry(t *testing.T) {
 		ai.BackoffCoefficient,
 		reason,
 		ai.NonRetriableErrors,
+		ai.RetryableErrors,
+		ai.RetryJitterCoefficient,
+		ai.RetryErrorReasonOverrides,
 	))
 
 	// backoff retry, intervals: 1s, 2s, 4s, 8s.
@@ -152,6 +168,9 @@ func Test_NextRetry(t *testing.T) {
 		ai.BackoffCoefficient,
 		reason,
 		ai.NonRetriableErrors,
+		ai.RetryableErrors,
+		ai.RetryJitterCoefficient,
+		ai.RetryErrorReasonOverrides,
 	))
 	ai.Attempt++
 
@@ -165,6 +184,9 @@ func Test_NextRetry(t *testing.T) {
 		ai.BackoffCoefficient,
 		reason,
 		ai.NonRetriableErrors,
+		ai.RetryableErrors,
+		ai.RetryJitterCoefficient,
+		ai.RetryErrorReasonOverrides,
 	))
 	ai.Attempt++
 
@@ -178,6 +200,9 @@ func Test_NextRetry(t *testing.T) {
 		ai.BackoffCoefficient,
 		reason,
 		ai.NonRetriableErrors,
+		ai.RetryableErrors,
+		ai.RetryJitterCoefficient,
+		ai.RetryErrorReasonOverrides,
 	))
 	ai.Attempt++
 
@@ -193,6 +218,9 @@ func Test_NextRetry(t *testing.T) {
 		ai.BackoffCoefficient,
 		reason,
 		ai.NonRetriableErrors,
+		ai.RetryableErrors,
+		ai.RetryJitterCoefficient,
+		ai.RetryErrorReasonOverrides,
 	))
 
 	reason = "good-reason"
@@ -207,6 +235,9 @@ func Test_NextRetry(t *testing.T) {
 		ai.BackoffCoefficient,
 		reason,
 		ai.NonRetriableErrors,
+		ai.RetryableErrors,
+		ai.RetryJitterCoefficient,
+		ai.RetryErrorReasonOverrides,
 	))
 	ai.Attempt++
 
@@ -222,6 +253,9 @@ func Test_NextRetry(t *testing.T) {
 		ai.BackoffCoefficient,
 		reason,
 		ai.NonRetriableErrors,
+		ai.RetryableErrors,
+		ai.RetryJitterCoefficient,
+		ai.RetryErrorReasonOverrides,
 	))
 
 	// increase max attempts, with max interval cap at 10s
@@ -237,6 +271,9 @@ func Test_NextRetry(t *testing.T) {
 		ai.BackoffCoefficient,
 		reason,
 		ai.NonRetriableErrors,
+		ai.RetryableErrors,
+		ai.RetryJitterCoefficient,
+		ai.RetryErrorReasonOverrides,
 	))
 	ai.Attempt++
 
@@ -253,6 +290,9 @@ func Test_NextRetry(t *testing.T) {
 		ai.BackoffCoefficient,
 		reason,
 		ai.NonRetriableErrors,
+		ai.RetryableErrors,
+		ai.RetryJitterCoefficient,
+		ai.RetryErrorReasonOverrides,
 	))
 
 	// extend expiration, next interval should be 10s
@@ -267,6 +307,9 @@ func Test_NextRetry(t *testing.T) {
 		ai.BackoffCoefficient,
 		reason,
 		ai.NonRetriableErrors,
+		ai.RetryableErrors,
+		ai.RetryJitterCoefficient,
+		ai.RetryErrorReasonOverrides,
 	))
 	ai.Attempt++
 
@@ -283,6 +326,59 @@ func Test_NextRetry(t *testing.T) {
 		ai.BackoffCoefficient,
 		reason,
 		ai.NonRetriableErrors,
+		ai.RetryableErrors,
+		ai.RetryJitterCoefficient,
+		ai.RetryErrorReasonOverrides,
 	))
 	ai.Attempt++
 }
+
+func Test_NextRetryWithPolicyExtensions(t *testing.T) {
+	a := assert.New(t)
+	now, _ := time.Parse(time.RFC3339, "2018-04-13T16:08:08+00:00")
+
+	policy := &types.RetryPolicy{
+		InitialIntervalInSeconds: 1,
+		BackoffCoefficient:       2,
+		MaximumIntervalInSeconds: 10,
+		MaximumAttempts:          3,
+		NonRetriableErrorReasons: []string{"bad-reason"},
+		RetryableErrorReasons:    []string{"good-reason", "rate-limit"},
+		ErrorReasonOverrides: []*types.RetryPolicyOverride{
+			{ReasonPattern: "rate-limit", InitialIntervalInSeconds: 5, MaximumAttempts: 10},
+		},
+	}
+	ai := &persistence.ActivityInfo{
+		HasRetryPolicy:            true,
+		NonRetriableErrors:        policy.NonRetriableErrorReasons,
+		RetryableErrors:           policy.RetryableErrorReasons,
+		RetryErrorReasonOverrides: policy.ErrorReasonOverrides,
+		MaximumAttempts:           policy.MaximumAttempts,
+		InitialInterval:           policy.InitialIntervalInSeconds,
+		BackoffCoefficient:        policy.BackoffCoefficient,
+		MaximumInterval:           policy.MaximumIntervalInSeconds,
+		Attempt:                   1,
+	}
+
+	for reason, expected := range map[string]time.Duration{
+		"good-reason":  2 * time.Second,
+		"bad-reason":   backoff.NoBackoff,
+		"other-reason": backoff.NoBackoff,
+		"rate-limit":   10 * time.Second,
+	} {
+		a.Equal(expected, getBackoffInterval(
+			now,
+			ai.ExpirationTime,
+			ai.Attempt,
+			ai.MaximumAttempts,
+			ai.InitialInterval,
+			ai.MaximumInterval,
+			ai.BackoffCoefficient,
+			reason,
+			ai.NonRetriableErrors,
+			ai.RetryableErrors,
+			ai.RetryJitterCoefficient,
+			ai.RetryErrorReasonOverrides,
+		), reason)
+	}
+}
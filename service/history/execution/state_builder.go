@@ -422,7 +422,11 @@ func (b *stateBuilderImpl) ApplyEvents(
 			}
 
 		case types.EventTypeMarkerRecorded:
-			// No mutable state action is needed
+			if err := b.mutableState.ReplicateMarkerRecordedEvent(
+				event,
+			); err != nil {
+				return nil, err
+			}
 
 		case types.EventTypeWorkflowExecutionSignaled:
 			if err := b.mutableState.ReplicateWorkflowExecutionSignaled(
@@ -445,6 +449,20 @@ func (b *stateBuilderImpl) ApplyEvents(
 				return nil, err
 			}
 
+		case types.EventTypeWorkflowExecutionPaused:
+			if err := b.mutableState.ReplicateWorkflowExecutionPausedEvent(
+				event,
+			); err != nil {
+				return nil, err
+			}
+
+		case types.EventTypeWorkflowExecutionUnpaused:
+			if err := b.mutableState.ReplicateWorkflowExecutionUnpausedEvent(
+				event,
+			); err != nil {
+				return nil, err
+			}
+
 		case types.EventTypeWorkflowExecutionCompleted:
 			if err := b.mutableState.ReplicateWorkflowExecutionCompletedEvent(
 				firstEvent.ID,
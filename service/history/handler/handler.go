@@ -1093,6 +1093,48 @@ func (h *handlerImpl) SignalWorkflowExecution(
 	return nil
 }
 
+// UpdateWorkflowExecution runs an update handler of a workflow execution and waits for its outcome.
+// The update is validated by the worker before a WorkflowExecutionSignaled event records it in the history.
+func (h *handlerImpl) UpdateWorkflowExecution(
+	ctx context.Context,
+	wrappedRequest *types.HistoryUpdateWorkflowExecutionRequest,
+) (resp *types.UpdateWorkflowExecutionResponse, retError error) {
+
+	defer func() { log.CapturePanic(recover(), h.GetLogger(), &retError) }()
+	h.startWG.Wait()
+
+	scope, sw := h.startRequestProfile(ctx, metrics.HistoryUpdateWorkflowExecutionScope)
+	defer sw.Stop()
+
+	if h.isShuttingDown() {
+		return nil, constants.ErrShuttingDown
+	}
+
+	domainID := wrappedRequest.GetDomainUUID()
+	if domainID == "" {
+		return nil, h.error(constants.ErrDomainNotSet, scope, domainID, "", "")
+	}
+
+	if ok := h.rateLimiter.Allow(); !ok {
+		return nil, h.error(constants.ErrHistoryHostThrottle, scope, domainID, "", "")
+	}
+
+	workflowExecution := wrappedRequest.GetUpdateRequest().GetWorkflowExecution()
+	workflowID := workflowExecution.GetWorkflowID()
+	runID := workflowExecution.GetRunID()
+	engine, err1 := h.controller.GetEngine(workflowID)
+	if err1 != nil {
+		return nil, h.error(err1, scope, domainID, workflowID, runID)
+	}
+
+	resp, err2 := engine.UpdateWorkflowExecution(ctx, wrappedRequest)
+	if err2 != nil {
+		return nil, h.error(err2, scope, domainID, workflowID, runID)
+	}
+
+	return resp, nil
+}
+
 // SignalWithStartWorkflowExecution is used to ensure sending a signal event to a workflow execution.
 // If workflow is running, this results in WorkflowExecutionSignaled event recorded in the history
 // and a decision task being created for the execution.
@@ -1237,6 +1279,334 @@ func (h *handlerImpl) TerminateWorkflowExecution(
 	return nil
 }
 
+// PauseWorkflowExecution stops dispatching the tasks of a workflow execution until it is unpaused
+func (h *handlerImpl) PauseWorkflowExecution(
+	ctx context.Context,
+	wrappedRequest *types.HistoryPauseWorkflowExecutionRequest,
+) (retError error) {
+
+	defer func() { log.CapturePanic(recover(), h.GetLogger(), &retError) }()
+	h.startWG.Wait()
+
+	scope, sw := h.startRequestProfile(ctx, metrics.HistoryPauseWorkflowExecutionScope)
+	defer sw.Stop()
+
+	if h.isShuttingDown() {
+		return constants.ErrShuttingDown
+	}
+
+	domainID := wrappedRequest.GetDomainUUID()
+	if domainID == "" {
+		return h.error(constants.ErrDomainNotSet, scope, domainID, "", "")
+	}
+
+	if ok := h.rateLimiter.Allow(); !ok {
+		return h.error(constants.ErrHistoryHostThrottle, scope, domainID, "", "")
+	}
+
+	workflowExecution := wrappedRequest.GetPauseRequest().GetWorkflowExecution()
+	workflowID := workflowExecution.GetWorkflowID()
+	runID := workflowExecution.GetRunID()
+	engine, err1 := h.controller.GetEngine(workflowID)
+	if err1 != nil {
+		return h.error(err1, scope, domainID, workflowID, runID)
+	}
+
+	err2 := engine.PauseWorkflowExecution(ctx, wrappedRequest)
+	if err2 != nil {
+		return h.error(err2, scope, domainID, workflowID, runID)
+	}
+
+	return nil
+}
+
+// UnpauseWorkflowExecution resumes a paused workflow execution
+func (h *handlerImpl) UnpauseWorkflowExecution(
+	ctx context.Context,
+	wrappedRequest *types.HistoryUnpauseWorkflowExecutionRequest,
+) (retError error) {
+
+	defer func() { log.CapturePanic(recover(), h.GetLogger(), &retError) }()
+	h.startWG.Wait()
+
+	scope, sw := h.startRequestProfile(ctx, metrics.HistoryUnpauseWorkflowExecutionScope)
+	defer sw.Stop()
+
+	if h.isShuttingDown() {
+		return constants.ErrShuttingDown
+	}
+
+	domainID := wrappedRequest.GetDomainUUID()
+	if domainID == "" {
+		return h.error(constants.ErrDomainNotSet, scope, domainID, "", "")
+	}
+
+	if ok := h.rateLimiter.Allow(); !ok {
+		return h.error(constants.ErrHistoryHostThrottle, scope, domainID, "", "")
+	}
+
+	workflowExecution := wrappedRequest.GetUnpauseRequest().GetWorkflowExecution()
+	workflowID := workflowExecution.GetWorkflowID()
+	runID := workflowExecution.GetRunID()
+	engine, err1 := h.controller.GetEngine(workflowID)
+	if err1 != nil {
+		return h.error(err1, scope, domainID, workflowID, runID)
+	}
+
+	err2 := engine.UnpauseWorkflowExecution(ctx, wrappedRequest)
+	if err2 != nil {
+		return h.error(err2, scope, domainID, workflowID, runID)
+	}
+
+	return nil
+}
+
+// PauseActivity stops dispatching the attempts of a pending activity until it is unpaused
+func (h *handlerImpl) PauseActivity(
+	ctx context.Context,
+	wrappedRequest *types.HistoryPauseActivityRequest,
+) (retError error) {
+
+	defer func() { log.CapturePanic(recover(), h.GetLogger(), &retError) }()
+	h.startWG.Wait()
+
+	scope, sw := h.startRequestProfile(ctx, metrics.HistoryPauseActivityScope)
+	defer sw.Stop()
+
+	if h.isShuttingDown() {
+		return constants.ErrShuttingDown
+	}
+
+	domainID := wrappedRequest.GetDomainUUID()
+	if domainID == "" {
+		return h.error(constants.ErrDomainNotSet, scope, domainID, "", "")
+	}
+
+	if ok := h.rateLimiter.Allow(); !ok {
+		return h.error(constants.ErrHistoryHostThrottle, scope, domainID, "", "")
+	}
+
+	workflowExecution := wrappedRequest.GetPauseRequest().GetWorkflowExecution()
+	workflowID := workflowExecution.GetWorkflowID()
+	runID := workflowExecution.GetRunID()
+	engine, err1 := h.controller.GetEngine(workflowID)
+	if err1 != nil {
+		return h.error(err1, scope, domainID, workflowID, runID)
+	}
+
+	err2 := engine.PauseActivity(ctx, wrappedRequest)
+	if err2 != nil {
+		return h.error(err2, scope, domainID, workflowID, runID)
+	}
+
+	return nil
+}
+
+// UnpauseActivity resumes dispatching the attempts of a paused activity
+func (h *handlerImpl) UnpauseActivity(
+	ctx context.Context,
+	wrappedRequest *types.HistoryUnpauseActivityRequest,
+) (retError error) {
+
+	defer func() { log.CapturePanic(recover(), h.GetLogger(), &retError) }()
+	h.startWG.Wait()
+
+	scope, sw := h.startRequestProfile(ctx, metrics.HistoryUnpauseActivityScope)
+	defer sw.Stop()
+
+	if h.isShuttingDown() {
+		return constants.ErrShuttingDown
+	}
+
+	domainID := wrappedRequest.GetDomainUUID()
+	if domainID == "" {
+		return h.error(constants.ErrDomainNotSet, scope, domainID, "", "")
+	}
+
+	if ok := h.rateLimiter.Allow(); !ok {
+		return h.error(constants.ErrHistoryHostThrottle, scope, domainID, "", "")
+	}
+
+	workflowExecution := wrappedRequest.GetUnpauseRequest().GetWorkflowExecution()
+	workflowID := workflowExecution.GetWorkflowID()
+	runID := workflowExecution.GetRunID()
+	engine, err1 := h.controller.GetEngine(workflowID)
+	if err1 != nil {
+		return h.error(err1, scope, domainID, workflowID, runID)
+	}
+
+	err2 := engine.UnpauseActivity(ctx, wrappedRequest)
+	if err2 != nil {
+		return h.error(err2, scope, domainID, workflowID, runID)
+	}
+
+	return nil
+}
+
+// ResetActivityAttempt sets the attempt count of a pending activity back to 0
+func (h *handlerImpl) ResetActivityAttempt(
+	ctx context.Context,
+	wrappedRequest *types.HistoryResetActivityAttemptRequest,
+) (retError error) {
+
+	defer func() { log.CapturePanic(recover(), h.GetLogger(), &retError) }()
+	h.startWG.Wait()
+
+	scope, sw := h.startRequestProfile(ctx, metrics.HistoryResetActivityAttemptScope)
+	defer sw.Stop()
+
+	if h.isShuttingDown() {
+		return constants.ErrShuttingDown
+	}
+
+	domainID := wrappedRequest.GetDomainUUID()
+	if domainID == "" {
+		return h.error(constants.ErrDomainNotSet, scope, domainID, "", "")
+	}
+
+	if ok := h.rateLimiter.Allow(); !ok {
+		return h.error(constants.ErrHistoryHostThrottle, scope, domainID, "", "")
+	}
+
+	workflowExecution := wrappedRequest.GetResetRequest().GetWorkflowExecution()
+	workflowID := workflowExecution.GetWorkflowID()
+	runID := workflowExecution.GetRunID()
+	engine, err1 := h.controller.GetEngine(workflowID)
+	if err1 != nil {
+		return h.error(err1, scope, domainID, workflowID, runID)
+	}
+
+	err2 := engine.ResetActivityAttempt(ctx, wrappedRequest)
+	if err2 != nil {
+		return h.error(err2, scope, domainID, workflowID, runID)
+	}
+
+	return nil
+}
+
+// RetryActivityNow dispatches the next attempt of an activity waiting for its retry backoff immediately
+func (h *handlerImpl) RetryActivityNow(
+	ctx context.Context,
+	wrappedRequest *types.HistoryRetryActivityNowRequest,
+) (retError error) {
+
+	defer func() { log.CapturePanic(recover(), h.GetLogger(), &retError) }()
+	h.startWG.Wait()
+
+	scope, sw := h.startRequestProfile(ctx, metrics.HistoryRetryActivityNowScope)
+	defer sw.Stop()
+
+	if h.isShuttingDown() {
+		return constants.ErrShuttingDown
+	}
+
+	domainID := wrappedRequest.GetDomainUUID()
+	if domainID == "" {
+		return h.error(constants.ErrDomainNotSet, scope, domainID, "", "")
+	}
+
+	if ok := h.rateLimiter.Allow(); !ok {
+		return h.error(constants.ErrHistoryHostThrottle, scope, domainID, "", "")
+	}
+
+	workflowExecution := wrappedRequest.GetRetryRequest().GetWorkflowExecution()
+	workflowID := workflowExecution.GetWorkflowID()
+	runID := workflowExecution.GetRunID()
+	engine, err1 := h.controller.GetEngine(workflowID)
+	if err1 != nil {
+		return h.error(err1, scope, domainID, workflowID, runID)
+	}
+
+	err2 := engine.RetryActivityNow(ctx, wrappedRequest)
+	if err2 != nil {
+		return h.error(err2, scope, domainID, workflowID, runID)
+	}
+
+	return nil
+}
+
+// DeleteWorkflowExecution schedules the removal of a closed workflow execution, its history and its archived copies
+func (h *handlerImpl) DeleteWorkflowExecution(
+	ctx context.Context,
+	wrappedRequest *types.HistoryDeleteWorkflowExecutionRequest,
+) (retError error) {
+
+	defer func() { log.CapturePanic(recover(), h.GetLogger(), &retError) }()
+	h.startWG.Wait()
+
+	scope, sw := h.startRequestProfile(ctx, metrics.HistoryDeleteWorkflowExecutionScope)
+	defer sw.Stop()
+
+	if h.isShuttingDown() {
+		return constants.ErrShuttingDown
+	}
+
+	domainID := wrappedRequest.GetDomainUUID()
+	if domainID == "" {
+		return h.error(constants.ErrDomainNotSet, scope, domainID, "", "")
+	}
+
+	if ok := h.rateLimiter.Allow(); !ok {
+		return h.error(constants.ErrHistoryHostThrottle, scope, domainID, "", "")
+	}
+
+	workflowExecution := wrappedRequest.GetDeleteRequest().GetWorkflowExecution()
+	workflowID := workflowExecution.GetWorkflowID()
+	runID := workflowExecution.GetRunID()
+	engine, err1 := h.controller.GetEngine(workflowID)
+	if err1 != nil {
+		return h.error(err1, scope, domainID, workflowID, runID)
+	}
+
+	err2 := engine.DeleteWorkflowExecution(ctx, wrappedRequest)
+	if err2 != nil {
+		return h.error(err2, scope, domainID, workflowID, runID)
+	}
+
+	return nil
+}
+
+// UpsertWorkflowSearchAttributes upserts search attributes of a workflow outside of its decisions
+func (h *handlerImpl) UpsertWorkflowSearchAttributes(
+	ctx context.Context,
+	wrappedRequest *types.HistoryUpsertWorkflowSearchAttributesRequest,
+) (retError error) {
+
+	defer func() { log.CapturePanic(recover(), h.GetLogger(), &retError) }()
+	h.startWG.Wait()
+
+	scope, sw := h.startRequestProfile(ctx, metrics.HistoryUpsertWorkflowSearchAttributesScope)
+	defer sw.Stop()
+
+	if h.isShuttingDown() {
+		return constants.ErrShuttingDown
+	}
+
+	domainID := wrappedRequest.GetDomainUUID()
+	if domainID == "" {
+		return h.error(constants.ErrDomainNotSet, scope, domainID, "", "")
+	}
+
+	if ok := h.rateLimiter.Allow(); !ok {
+		return h.error(constants.ErrHistoryHostThrottle, scope, domainID, "", "")
+	}
+
+	workflowExecution := wrappedRequest.GetRequest().GetExecution()
+	workflowID := workflowExecution.GetWorkflowID()
+	runID := workflowExecution.GetRunID()
+	engine, err1 := h.controller.GetEngine(workflowID)
+	if err1 != nil {
+		return h.error(err1, scope, domainID, workflowID, runID)
+	}
+
+	err2 := engine.UpsertWorkflowSearchAttributes(ctx, wrappedRequest)
+	if err2 != nil {
+		return h.error(err2, scope, domainID, workflowID, runID)
+	}
+
+	return nil
+}
+
 // ResetWorkflowExecution reset an existing workflow execution
 // in the history and immediately terminating the execution instance.
 func (h *handlerImpl) ResetWorkflowExecution(
@@ -1499,6 +1869,84 @@ func (h *handlerImpl) ReplicateEventsV2(
 	return nil
 }
 
+// ReplicateDeleteWorkflowExecution is called by processor to replicate a workflow deletion for passive domains
+func (h *handlerImpl) ReplicateDeleteWorkflowExecution(
+	ctx context.Context,
+	attributes *types.DeleteWorkflowExecutionTaskAttributes,
+) (retError error) {
+
+	defer func() { log.CapturePanic(recover(), h.GetLogger(), &retError) }()
+	h.startWG.Wait()
+
+	if h.isShuttingDown() {
+		return constants.ErrShuttingDown
+	}
+
+	scope, sw := h.startRequestProfile(ctx, metrics.HistoryReplicateDeleteWorkflowExecutionScope)
+	defer sw.Stop()
+
+	domainID := attributes.GetDomainID()
+	if domainID == "" {
+		return h.error(constants.ErrDomainNotSet, scope, domainID, "", "")
+	}
+
+	if ok := h.rateLimiter.Allow(); !ok {
+		return h.error(constants.ErrHistoryHostThrottle, scope, domainID, "", "")
+	}
+
+	workflowID := attributes.GetWorkflowID()
+	runID := attributes.GetRunID()
+	engine, err := h.controller.GetEngine(workflowID)
+	if err != nil {
+		return h.error(err, scope, domainID, workflowID, runID)
+	}
+
+	if err := engine.ReplicateDeleteWorkflowExecution(ctx, attributes); err != nil {
+		return h.error(err, scope, domainID, workflowID, runID)
+	}
+
+	return nil
+}
+
+// ReplicateUpsertWorkflowSearchAttributes is called by processor to replicate search attributes and memo for passive domains
+func (h *handlerImpl) ReplicateUpsertWorkflowSearchAttributes(
+	ctx context.Context,
+	attributes *types.UpsertWorkflowSearchAttributesTaskAttributes,
+) (retError error) {
+
+	defer func() { log.CapturePanic(recover(), h.GetLogger(), &retError) }()
+	h.startWG.Wait()
+
+	if h.isShuttingDown() {
+		return constants.ErrShuttingDown
+	}
+
+	scope, sw := h.startRequestProfile(ctx, metrics.HistoryReplicateUpsertWorkflowSearchAttributesScope)
+	defer sw.Stop()
+
+	domainID := attributes.GetDomainID()
+	if domainID == "" {
+		return h.error(constants.ErrDomainNotSet, scope, domainID, "", "")
+	}
+
+	if ok := h.rateLimiter.Allow(); !ok {
+		return h.error(constants.ErrHistoryHostThrottle, scope, domainID, "", "")
+	}
+
+	workflowID := attributes.GetWorkflowID()
+	runID := attributes.GetRunID()
+	engine, err := h.controller.GetEngine(workflowID)
+	if err != nil {
+		return h.error(err, scope, domainID, workflowID, runID)
+	}
+
+	if err := engine.ReplicateUpsertWorkflowSearchAttributes(ctx, attributes); err != nil {
+		return h.error(err, scope, domainID, workflowID, runID)
+	}
+
+	return nil
+}
+
 // SyncShardStatus is called by processor to sync history shard information from another cluster
 func (h *handlerImpl) SyncShardStatus(
 	ctx context.Context,
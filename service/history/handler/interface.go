@@ -44,6 +44,8 @@ type Handler interface {
 	PrepareToStop(time.Duration) time.Duration
 	Health(context.Context) (*types.HealthStatus, error)
 	CloseShard(context.Context, *types.CloseShardRequest) error
+	DeleteWorkflowExecution(context.Context, *types.HistoryDeleteWorkflowExecutionRequest) error
+	UpsertWorkflowSearchAttributes(context.Context, *types.HistoryUpsertWorkflowSearchAttributesRequest) error
 	DescribeHistoryHost(context.Context, *types.DescribeHistoryHostRequest) (*types.DescribeHistoryHostResponse, error)
 	DescribeMutableState(context.Context, *types.DescribeMutableStateRequest) (*types.DescribeMutableStateResponse, error)
 	DescribeQueue(context.Context, *types.DescribeQueueRequest) (*types.DescribeQueueResponse, error)
@@ -55,6 +57,8 @@ type Handler interface {
 	GetReplicationMessages(context.Context, *types.GetReplicationMessagesRequest) (*types.GetReplicationMessagesResponse, error)
 	MergeDLQMessages(context.Context, *types.MergeDLQMessagesRequest) (*types.MergeDLQMessagesResponse, error)
 	NotifyFailoverMarkers(context.Context, *types.NotifyFailoverMarkersRequest) error
+	PauseActivity(context.Context, *types.HistoryPauseActivityRequest) error
+	PauseWorkflowExecution(context.Context, *types.HistoryPauseWorkflowExecutionRequest) error
 	PollMutableState(context.Context, *types.PollMutableStateRequest) (*types.PollMutableStateResponse, error)
 	PurgeDLQMessages(context.Context, *types.PurgeDLQMessagesRequest) error
 	QueryWorkflow(context.Context, *types.HistoryQueryWorkflowRequest) (*types.HistoryQueryWorkflowResponse, error)
@@ -67,8 +71,11 @@ type Handler interface {
 	RefreshWorkflowTasks(context.Context, *types.HistoryRefreshWorkflowTasksRequest) error
 	RemoveSignalMutableState(context.Context, *types.RemoveSignalMutableStateRequest) error
 	RemoveTask(context.Context, *types.RemoveTaskRequest) error
+	ReplicateDeleteWorkflowExecution(context.Context, *types.DeleteWorkflowExecutionTaskAttributes) error
 	ReplicateEventsV2(context.Context, *types.ReplicateEventsV2Request) error
+	ReplicateUpsertWorkflowSearchAttributes(context.Context, *types.UpsertWorkflowSearchAttributesTaskAttributes) error
 	RequestCancelWorkflowExecution(context.Context, *types.HistoryRequestCancelWorkflowExecutionRequest) error
+	ResetActivityAttempt(context.Context, *types.HistoryResetActivityAttemptRequest) error
 	ResetQueue(context.Context, *types.ResetQueueRequest) error
 	ResetStickyTaskList(context.Context, *types.HistoryResetStickyTaskListRequest) (*types.HistoryResetStickyTaskListResponse, error)
 	ResetWorkflowExecution(context.Context, *types.HistoryResetWorkflowExecutionRequest) (*types.ResetWorkflowExecutionResponse, error)
@@ -78,6 +85,7 @@ type Handler interface {
 	RespondCrossClusterTasksCompleted(context.Context, *types.RespondCrossClusterTasksCompletedRequest) (*types.RespondCrossClusterTasksCompletedResponse, error)
 	RespondDecisionTaskCompleted(context.Context, *types.HistoryRespondDecisionTaskCompletedRequest) (*types.HistoryRespondDecisionTaskCompletedResponse, error)
 	RespondDecisionTaskFailed(context.Context, *types.HistoryRespondDecisionTaskFailedRequest) error
+	RetryActivityNow(context.Context, *types.HistoryRetryActivityNowRequest) error
 	ScheduleDecisionTask(context.Context, *types.ScheduleDecisionTaskRequest) error
 	SignalWithStartWorkflowExecution(context.Context, *types.HistorySignalWithStartWorkflowExecutionRequest) (*types.StartWorkflowExecutionResponse, error)
 	SignalWorkflowExecution(context.Context, *types.HistorySignalWorkflowExecutionRequest) error
@@ -85,6 +93,9 @@ type Handler interface {
 	SyncActivity(context.Context, *types.SyncActivityRequest) error
 	SyncShardStatus(context.Context, *types.SyncShardStatusRequest) error
 	TerminateWorkflowExecution(context.Context, *types.HistoryTerminateWorkflowExecutionRequest) error
+	UnpauseActivity(context.Context, *types.HistoryUnpauseActivityRequest) error
+	UnpauseWorkflowExecution(context.Context, *types.HistoryUnpauseWorkflowExecutionRequest) error
+	UpdateWorkflowExecution(context.Context, *types.HistoryUpdateWorkflowExecutionRequest) (*types.UpdateWorkflowExecutionResponse, error)
 	GetFailoverInfo(context.Context, *types.GetFailoverInfoRequest) (*types.GetFailoverInfoResponse, error)
 	RatelimitUpdate(context.Context, *types.RatelimitUpdateRequest) (*types.RatelimitUpdateResponse, error)
 }
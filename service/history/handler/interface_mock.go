@@ -72,6 +72,20 @@ func (mr *MockHandlerMockRecorder) CountDLQMessages(arg0, arg1 any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountDLQMessages", reflect.TypeOf((*MockHandler)(nil).CountDLQMessages), arg0, arg1)
 }
 
+// DeleteWorkflowExecution mocks base method.
+func (m *MockHandler) DeleteWorkflowExecution(arg0 context.Context, arg1 *types.HistoryDeleteWorkflowExecutionRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWorkflowExecution", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWorkflowExecution indicates an expected call of DeleteWorkflowExecution.
+func (mr *MockHandlerMockRecorder) DeleteWorkflowExecution(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWorkflowExecution", reflect.TypeOf((*MockHandler)(nil).DeleteWorkflowExecution), arg0, arg1)
+}
+
 // DescribeHistoryHost mocks base method.
 func (m *MockHandler) DescribeHistoryHost(arg0 context.Context, arg1 *types.DescribeHistoryHostRequest) (*types.DescribeHistoryHostResponse, error) {
 	m.ctrl.T.Helper()
@@ -251,6 +265,34 @@ func (mr *MockHandlerMockRecorder) NotifyFailoverMarkers(arg0, arg1 any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotifyFailoverMarkers", reflect.TypeOf((*MockHandler)(nil).NotifyFailoverMarkers), arg0, arg1)
 }
 
+// PauseActivity mocks base method.
+func (m *MockHandler) PauseActivity(arg0 context.Context, arg1 *types.HistoryPauseActivityRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PauseActivity", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PauseActivity indicates an expected call of PauseActivity.
+func (mr *MockHandlerMockRecorder) PauseActivity(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseActivity", reflect.TypeOf((*MockHandler)(nil).PauseActivity), arg0, arg1)
+}
+
+// PauseWorkflowExecution mocks base method.
+func (m *MockHandler) PauseWorkflowExecution(arg0 context.Context, arg1 *types.HistoryPauseWorkflowExecutionRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PauseWorkflowExecution", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PauseWorkflowExecution indicates an expected call of PauseWorkflowExecution.
+func (mr *MockHandlerMockRecorder) PauseWorkflowExecution(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseWorkflowExecution", reflect.TypeOf((*MockHandler)(nil).PauseWorkflowExecution), arg0, arg1)
+}
+
 // PollMutableState mocks base method.
 func (m *MockHandler) PollMutableState(arg0 context.Context, arg1 *types.PollMutableStateRequest) (*types.PollMutableStateResponse, error) {
 	m.ctrl.T.Helper()
@@ -454,6 +496,20 @@ func (mr *MockHandlerMockRecorder) RemoveTask(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveTask", reflect.TypeOf((*MockHandler)(nil).RemoveTask), arg0, arg1)
 }
 
+// ReplicateDeleteWorkflowExecution mocks base method.
+func (m *MockHandler) ReplicateDeleteWorkflowExecution(arg0 context.Context, arg1 *types.DeleteWorkflowExecutionTaskAttributes) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplicateDeleteWorkflowExecution", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplicateDeleteWorkflowExecution indicates an expected call of ReplicateDeleteWorkflowExecution.
+func (mr *MockHandlerMockRecorder) ReplicateDeleteWorkflowExecution(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplicateDeleteWorkflowExecution", reflect.TypeOf((*MockHandler)(nil).ReplicateDeleteWorkflowExecution), arg0, arg1)
+}
+
 // ReplicateEventsV2 mocks base method.
 func (m *MockHandler) ReplicateEventsV2(arg0 context.Context, arg1 *types.ReplicateEventsV2Request) error {
 	m.ctrl.T.Helper()
@@ -468,6 +524,20 @@ func (mr *MockHandlerMockRecorder) ReplicateEventsV2(arg0, arg1 any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplicateEventsV2", reflect.TypeOf((*MockHandler)(nil).ReplicateEventsV2), arg0, arg1)
 }
 
+// ReplicateUpsertWorkflowSearchAttributes mocks base method.
+func (m *MockHandler) ReplicateUpsertWorkflowSearchAttributes(arg0 context.Context, arg1 *types.UpsertWorkflowSearchAttributesTaskAttributes) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplicateUpsertWorkflowSearchAttributes", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplicateUpsertWorkflowSearchAttributes indicates an expected call of ReplicateUpsertWorkflowSearchAttributes.
+func (mr *MockHandlerMockRecorder) ReplicateUpsertWorkflowSearchAttributes(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplicateUpsertWorkflowSearchAttributes", reflect.TypeOf((*MockHandler)(nil).ReplicateUpsertWorkflowSearchAttributes), arg0, arg1)
+}
+
 // RequestCancelWorkflowExecution mocks base method.
 func (m *MockHandler) RequestCancelWorkflowExecution(arg0 context.Context, arg1 *types.HistoryRequestCancelWorkflowExecutionRequest) error {
 	m.ctrl.T.Helper()
@@ -482,6 +552,20 @@ func (mr *MockHandlerMockRecorder) RequestCancelWorkflowExecution(arg0, arg1 any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestCancelWorkflowExecution", reflect.TypeOf((*MockHandler)(nil).RequestCancelWorkflowExecution), arg0, arg1)
 }
 
+// ResetActivityAttempt mocks base method.
+func (m *MockHandler) ResetActivityAttempt(arg0 context.Context, arg1 *types.HistoryResetActivityAttemptRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetActivityAttempt", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResetActivityAttempt indicates an expected call of ResetActivityAttempt.
+func (mr *MockHandlerMockRecorder) ResetActivityAttempt(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetActivityAttempt", reflect.TypeOf((*MockHandler)(nil).ResetActivityAttempt), arg0, arg1)
+}
+
 // ResetQueue mocks base method.
 func (m *MockHandler) ResetQueue(arg0 context.Context, arg1 *types.ResetQueueRequest) error {
 	m.ctrl.T.Helper()
@@ -612,6 +696,20 @@ func (mr *MockHandlerMockRecorder) RespondDecisionTaskFailed(arg0, arg1 any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RespondDecisionTaskFailed", reflect.TypeOf((*MockHandler)(nil).RespondDecisionTaskFailed), arg0, arg1)
 }
 
+// RetryActivityNow mocks base method.
+func (m *MockHandler) RetryActivityNow(arg0 context.Context, arg1 *types.HistoryRetryActivityNowRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetryActivityNow", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RetryActivityNow indicates an expected call of RetryActivityNow.
+func (mr *MockHandlerMockRecorder) RetryActivityNow(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetryActivityNow", reflect.TypeOf((*MockHandler)(nil).RetryActivityNow), arg0, arg1)
+}
+
 // ScheduleDecisionTask mocks base method.
 func (m *MockHandler) ScheduleDecisionTask(arg0 context.Context, arg1 *types.ScheduleDecisionTaskRequest) error {
 	m.ctrl.T.Helper()
@@ -735,3 +833,60 @@ func (mr *MockHandlerMockRecorder) TerminateWorkflowExecution(arg0, arg1 any) *g
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TerminateWorkflowExecution", reflect.TypeOf((*MockHandler)(nil).TerminateWorkflowExecution), arg0, arg1)
 }
+
+// UnpauseActivity mocks base method.
+func (m *MockHandler) UnpauseActivity(arg0 context.Context, arg1 *types.HistoryUnpauseActivityRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnpauseActivity", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnpauseActivity indicates an expected call of UnpauseActivity.
+func (mr *MockHandlerMockRecorder) UnpauseActivity(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnpauseActivity", reflect.TypeOf((*MockHandler)(nil).UnpauseActivity), arg0, arg1)
+}
+
+// UnpauseWorkflowExecution mocks base method.
+func (m *MockHandler) UnpauseWorkflowExecution(arg0 context.Context, arg1 *types.HistoryUnpauseWorkflowExecutionRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnpauseWorkflowExecution", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnpauseWorkflowExecution indicates an expected call of UnpauseWorkflowExecution.
+func (mr *MockHandlerMockRecorder) UnpauseWorkflowExecution(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnpauseWorkflowExecution", reflect.TypeOf((*MockHandler)(nil).UnpauseWorkflowExecution), arg0, arg1)
+}
+
+// UpdateWorkflowExecution mocks base method.
+func (m *MockHandler) UpdateWorkflowExecution(arg0 context.Context, arg1 *types.HistoryUpdateWorkflowExecutionRequest) (*types.UpdateWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWorkflowExecution", arg0, arg1)
+	ret0, _ := ret[0].(*types.UpdateWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateWorkflowExecution indicates an expected call of UpdateWorkflowExecution.
+func (mr *MockHandlerMockRecorder) UpdateWorkflowExecution(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkflowExecution", reflect.TypeOf((*MockHandler)(nil).UpdateWorkflowExecution), arg0, arg1)
+}
+
+// UpsertWorkflowSearchAttributes mocks base method.
+func (m *MockHandler) UpsertWorkflowSearchAttributes(arg0 context.Context, arg1 *types.HistoryUpsertWorkflowSearchAttributesRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertWorkflowSearchAttributes", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertWorkflowSearchAttributes indicates an expected call of UpsertWorkflowSearchAttributes.
+func (mr *MockHandlerMockRecorder) UpsertWorkflowSearchAttributes(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertWorkflowSearchAttributes", reflect.TypeOf((*MockHandler)(nil).UpsertWorkflowSearchAttributes), arg0, arg1)
+}
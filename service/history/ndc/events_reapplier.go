@@ -30,6 +30,7 @@ import (
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/history/execution"
+	"github.com/uber/cadence/service/history/query"
 )
 
 type (
@@ -74,6 +75,10 @@ func (r *eventsReapplierImpl) ReapplyEvents(
 	for _, event := range historyEvents {
 		switch event.GetEventType() {
 		case types.EventTypeWorkflowExecutionSignaled:
+			if query.IsReservedSignalName(event.GetWorkflowExecutionSignaledEventAttributes().GetSignalName()) {
+				// accepted updates are not reapplied, their callers are no longer waiting for the outcome
+				continue
+			}
 			dedupResource := definition.NewEventReappliedID(runID, event.ID, event.Version)
 			if msBuilder.IsResourceDuplicated(dedupResource) {
 				// skip already applied event
@@ -0,0 +1,208 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+//go:generate mockgen -package $GOPACKAGE -source $GOFILE -destination update_registry_mock.go -self_package github.com/uber/cadence/service/history/query
+
+package query
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/uber/cadence/common/types"
+)
+
+// An update is run by the worker in three steps, all of them use existing history events so that
+// no new event types are needed:
+//  1. the validator is dispatched as a query of type UpdateValidatorQueryTypePrefix + update name,
+//     failing the query rejects the update before anything is recorded
+//  2. the accepted update is recorded as a signal named UpdateSignalNamePrefix + update name,
+//     with the update ID as request ID
+//  3. the worker records the outcome of the handler as a marker named UpdateCompletedMarkerName,
+//     the update ID and the failure reason, if any, are passed as header fields and the result
+//     or the failure details as marker details
+//
+// The update is pending in the mutable state from the signal to the marker, and the outcomes of the most
+// recently completed updates are kept in the mutable state to answer retried updates.
+const (
+	// UpdateValidatorQueryTypePrefix prefixes the query type of update validators
+	UpdateValidatorQueryTypePrefix = "__cadence_update_validator:"
+	// UpdateSignalNamePrefix prefixes the signal name of accepted updates
+	UpdateSignalNamePrefix = "__cadence_update:"
+	// UpdateCompletedMarkerName is the marker name of completed updates
+	UpdateCompletedMarkerName = "__cadence_update_completed"
+	// UpdateIDHeaderKey is the header field of the completed update marker holding the update ID
+	UpdateIDHeaderKey = "cadence-update-id"
+	// UpdateFailureReasonHeaderKey is the header field of the completed update marker holding the failure reason
+	UpdateFailureReasonHeaderKey = "cadence-update-failure-reason"
+)
+
+var (
+	errUpdateNotExists          = &types.InternalServiceError{Message: "update does not exist"}
+	errUpdateAlreadyTerminated  = &types.InternalServiceError{Message: "update already in terminal state"}
+	errUpdateNotInTerminalState = &types.InternalServiceError{Message: "update not in terminal state"}
+)
+
+type (
+	// UpdateRegistry tracks the accepted updates of a workflow whose callers wait for the result
+	UpdateRegistry interface {
+		HasPendingUpdate() bool
+		GetPendingIDs() []string
+
+		// AddUpdate registers the update if it is not already, and returns the channel closed when it terminates
+		AddUpdate(id string) <-chan struct{}
+		// CompleteUpdate terminates the update with the outcome of its handler
+		CompleteUpdate(id string, response *types.UpdateWorkflowExecutionResponse) error
+		// FailUpdate terminates the update without an outcome, e.g. because the workflow closed first
+		FailUpdate(id string, failure error) error
+		// GetUpdateOutcome returns the outcome of a terminated update
+		GetUpdateOutcome(id string) (*types.UpdateWorkflowExecutionResponse, error)
+		RemoveUpdate(id string)
+	}
+
+	updateRegistryImpl struct {
+		sync.RWMutex
+
+		updates map[string]*update
+	}
+
+	update struct {
+		termCh     chan struct{}
+		terminated bool
+		response   *types.UpdateWorkflowExecutionResponse
+		failure    error
+	}
+)
+
+// NewUpdateRegistry creates a new update registry
+func NewUpdateRegistry() UpdateRegistry {
+	return &updateRegistryImpl{
+		updates: make(map[string]*update),
+	}
+}
+
+// IsReservedSignalName returns true if the signal name is used by the server to record an accepted update,
+// such signals can't be sent by users or workflows
+func IsReservedSignalName(signalName string) bool {
+	return strings.HasPrefix(signalName, UpdateSignalNamePrefix)
+}
+
+// NewUpdateCompletion extracts the outcome of an update from its completed update marker, it returns
+// false if the marker is not a well formed completed update marker
+func NewUpdateCompletion(attr *types.RecordMarkerDecisionAttributes) (*types.UpdateWorkflowExecutionResponse, bool) {
+	if attr.GetMarkerName() != UpdateCompletedMarkerName {
+		return nil, false
+	}
+	var fields map[string][]byte
+	if attr.Header != nil {
+		fields = attr.Header.Fields
+	}
+	id := string(fields[UpdateIDHeaderKey])
+	if id == "" {
+		return nil, false
+	}
+	response := &types.UpdateWorkflowExecutionResponse{UpdateID: id}
+	if reason, ok := fields[UpdateFailureReasonHeaderKey]; ok {
+		failureReason := string(reason)
+		response.FailureReason = &failureReason
+		response.FailureDetails = attr.Details
+	} else {
+		response.Result = attr.Details
+	}
+	return response, true
+}
+
+func (r *updateRegistryImpl) HasPendingUpdate() bool {
+	r.RLock()
+	defer r.RUnlock()
+	for _, u := range r.updates {
+		if !u.terminated {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *updateRegistryImpl) GetPendingIDs() []string {
+	r.RLock()
+	defer r.RUnlock()
+	var ids []string
+	for id, u := range r.updates {
+		if !u.terminated {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+func (r *updateRegistryImpl) AddUpdate(id string) <-chan struct{} {
+	r.Lock()
+	defer r.Unlock()
+	if u, ok := r.updates[id]; ok {
+		return u.termCh
+	}
+	u := &update{termCh: make(chan struct{})}
+	r.updates[id] = u
+	return u.termCh
+}
+
+func (r *updateRegistryImpl) CompleteUpdate(id string, response *types.UpdateWorkflowExecutionResponse) error {
+	return r.terminate(id, response, nil)
+}
+
+func (r *updateRegistryImpl) FailUpdate(id string, failure error) error {
+	return r.terminate(id, nil, failure)
+}
+
+func (r *updateRegistryImpl) GetUpdateOutcome(id string) (*types.UpdateWorkflowExecutionResponse, error) {
+	r.RLock()
+	defer r.RUnlock()
+	u, ok := r.updates[id]
+	if !ok {
+		return nil, errUpdateNotExists
+	}
+	if !u.terminated {
+		return nil, errUpdateNotInTerminalState
+	}
+	return u.response, u.failure
+}
+
+func (r *updateRegistryImpl) RemoveUpdate(id string) {
+	r.Lock()
+	defer r.Unlock()
+	delete(r.updates, id)
+}
+
+func (r *updateRegistryImpl) terminate(id string, response *types.UpdateWorkflowExecutionResponse, failure error) error {
+	r.Lock()
+	defer r.Unlock()
+	u, ok := r.updates[id]
+	if !ok {
+		return errUpdateNotExists
+	}
+	if u.terminated {
+		return errUpdateAlreadyTerminated
+	}
+	u.terminated = true
+	u.response = response
+	u.failure = failure
+	close(u.termCh)
+	return nil
+}
@@ -0,0 +1,135 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package query
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common/types"
+)
+
+func TestUpdateRegistry(t *testing.T) {
+	r := NewUpdateRegistry()
+	assert.False(t, r.HasPendingUpdate())
+
+	termCh1 := r.AddUpdate("update-1")
+	termCh2 := r.AddUpdate("update-2")
+	assert.Equal(t, termCh1, r.AddUpdate("update-1"), "adding an update twice must return the same channel")
+	assert.True(t, r.HasPendingUpdate())
+	assert.ElementsMatch(t, []string{"update-1", "update-2"}, r.GetPendingIDs())
+
+	_, err := r.GetUpdateOutcome("update-1")
+	assert.Equal(t, errUpdateNotInTerminalState, err)
+
+	response := &types.UpdateWorkflowExecutionResponse{UpdateID: "update-1", Result: []byte("result")}
+	require.NoError(t, r.CompleteUpdate("update-1", response))
+	assertClosed(t, termCh1)
+	outcome, err := r.GetUpdateOutcome("update-1")
+	assert.NoError(t, err)
+	assert.Equal(t, response, outcome)
+	assert.Equal(t, errUpdateAlreadyTerminated, r.CompleteUpdate("update-1", response))
+	assert.Equal(t, []string{"update-2"}, r.GetPendingIDs())
+
+	failure := errors.New("workflow closed")
+	require.NoError(t, r.FailUpdate("update-2", failure))
+	assertClosed(t, termCh2)
+	outcome, err = r.GetUpdateOutcome("update-2")
+	assert.Nil(t, outcome)
+	assert.Equal(t, failure, err)
+	assert.False(t, r.HasPendingUpdate())
+
+	r.RemoveUpdate("update-1")
+	r.RemoveUpdate("update-2")
+	_, err = r.GetUpdateOutcome("update-1")
+	assert.Equal(t, errUpdateNotExists, err)
+	assert.Equal(t, errUpdateNotExists, r.CompleteUpdate("update-3", response))
+	assert.Equal(t, errUpdateNotExists, r.FailUpdate("update-3", failure))
+}
+
+func TestNewUpdateCompletion(t *testing.T) {
+	failureReason := "reason"
+	tests := []struct {
+		name     string
+		attr     *types.RecordMarkerDecisionAttributes
+		expected *types.UpdateWorkflowExecutionResponse
+		ok       bool
+	}{
+		{
+			name: "other marker",
+			attr: &types.RecordMarkerDecisionAttributes{MarkerName: "Version"},
+		},
+		{
+			name: "missing update ID",
+			attr: &types.RecordMarkerDecisionAttributes{MarkerName: UpdateCompletedMarkerName},
+		},
+		{
+			name: "completed",
+			attr: &types.RecordMarkerDecisionAttributes{
+				MarkerName: UpdateCompletedMarkerName,
+				Details:    []byte("result"),
+				Header:     &types.Header{Fields: map[string][]byte{UpdateIDHeaderKey: []byte("update-1")}},
+			},
+			expected: &types.UpdateWorkflowExecutionResponse{UpdateID: "update-1", Result: []byte("result")},
+			ok:       true,
+		},
+		{
+			name: "failed",
+			attr: &types.RecordMarkerDecisionAttributes{
+				MarkerName: UpdateCompletedMarkerName,
+				Details:    []byte("details"),
+				Header: &types.Header{Fields: map[string][]byte{
+					UpdateIDHeaderKey:            []byte("update-1"),
+					UpdateFailureReasonHeaderKey: []byte("reason"),
+				}},
+			},
+			expected: &types.UpdateWorkflowExecutionResponse{
+				UpdateID:       "update-1",
+				FailureReason:  &failureReason,
+				FailureDetails: []byte("details"),
+			},
+			ok: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			response, ok := NewUpdateCompletion(tt.attr)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.expected, response)
+		})
+	}
+}
+
+func assertClosed(t *testing.T, ch <-chan struct{}) {
+	select {
+	case <-ch:
+	default:
+		t.Fatal("channel is not closed")
+	}
+}
+
+func TestIsReservedSignalName(t *testing.T) {
+	assert.True(t, IsReservedSignalName(UpdateSignalNamePrefix+"update"))
+	assert.False(t, IsReservedSignalName("signal"))
+}
@@ -99,6 +99,12 @@ func (e *taskExecutorImpl) execute(
 	case types.ReplicationTaskTypeFailoverMarker:
 		scope = metrics.FailoverMarkerScope
 		err = e.handleFailoverReplicationTask(replicationTask)
+	case types.ReplicationTaskTypeDeleteWorkflowExecution:
+		scope = metrics.DeleteWorkflowExecutionReplicationTaskScope
+		err = e.handleDeleteWorkflowExecutionTask(replicationTask, forceApply)
+	case types.ReplicationTaskTypeUpsertWorkflowSearchAttributes:
+		scope = metrics.UpsertWorkflowSearchAttributesReplicationTaskScope
+		err = e.handleUpsertWorkflowSearchAttributesTask(replicationTask, forceApply)
 	default:
 		e.logger.Error("Unknown task type.")
 		scope = metrics.ReplicatorScope
@@ -154,6 +160,7 @@ func (e *taskExecutorImpl) handleActivityTask(
 		LastFailureDetails: attr.LastFailureDetails,
 		LastWorkerIdentity: attr.LastWorkerIdentity,
 		VersionHistory:     attr.GetVersionHistory(),
+		Paused:             attr.GetPaused(),
 	}
 	ctx, cancel := context.WithTimeout(context.Background(), replicationTimeout)
 	defer cancel()
@@ -341,6 +348,54 @@ func (e *taskExecutorImpl) handleFailoverReplicationTask(
 	return e.shard.AddingPendingFailoverMarker(failoverAttributes)
 }
 
+func (e *taskExecutorImpl) handleDeleteWorkflowExecutionTask(
+	task *types.ReplicationTask,
+	forceApply bool,
+) error {
+	attr := task.GetDeleteWorkflowExecutionTaskAttributes()
+	if attr == nil {
+		e.logger.Error("DeleteWorkflowExecution replication task with nil attributes")
+		return ErrEmptyDeleteWorkflowExecutionAttributes
+	}
+	doContinue, err := e.filterTask(attr.GetDomainID(), forceApply)
+	if err != nil || !doContinue {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), replicationTimeout)
+	defer cancel()
+
+	// Check if the number of shards between clusters are equal. If not, redirect the request.
+	if e.shard.GetShardID() != common.WorkflowIDToHistoryShard(attr.WorkflowID, e.shard.GetConfig().NumberOfShards) {
+		return e.shard.GetService().GetClientBean().GetHistoryClient().ReplicateDeleteWorkflowExecution(ctx, attr)
+	}
+	return e.historyEngine.ReplicateDeleteWorkflowExecution(ctx, attr)
+}
+
+func (e *taskExecutorImpl) handleUpsertWorkflowSearchAttributesTask(
+	task *types.ReplicationTask,
+	forceApply bool,
+) error {
+	attr := task.GetUpsertWorkflowSearchAttributesTaskAttributes()
+	if attr == nil {
+		e.logger.Error("UpsertWorkflowSearchAttributes replication task with nil attributes")
+		return ErrEmptyUpsertWorkflowSearchAttributesAttributes
+	}
+	doContinue, err := e.filterTask(attr.GetDomainID(), forceApply)
+	if err != nil || !doContinue {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), replicationTimeout)
+	defer cancel()
+
+	// Check if the number of shards between clusters are equal. If not, redirect the request.
+	if e.shard.GetShardID() != common.WorkflowIDToHistoryShard(attr.WorkflowID, e.shard.GetConfig().NumberOfShards) {
+		return e.shard.GetService().GetClientBean().GetHistoryClient().ReplicateUpsertWorkflowSearchAttributes(ctx, attr)
+	}
+	return e.historyEngine.ReplicateUpsertWorkflowSearchAttributes(ctx, attr)
+}
+
 func (e *taskExecutorImpl) filterTask(
 	domainID string,
 	forceApply bool,
@@ -179,12 +179,14 @@ func (s *taskExecutorSuite) TestProcessTask_SyncActivityReplicationTask_SameShar
 			DomainID:   domainID,
 			WorkflowID: workflowID,
 			RunID:      runID,
+			Paused:     true,
 		},
 	}
 	request := &types.SyncActivityRequest{
 		DomainID:   domainID,
 		WorkflowID: workflowID,
 		RunID:      runID,
+		Paused:     true,
 	}
 
 	s.mockEngine.EXPECT().SyncActivity(gomock.Any(), request).Return(nil).Times(1)
@@ -527,6 +529,91 @@ func (s *taskExecutorSuite) TestProcess_SyncActivityReplicationTask_DifferentSha
 	s.NoError(err)
 }
 
+func (s *taskExecutorSuite) TestProcess_DeleteWorkflowExecutionReplicationTask_SameShardID() {
+	attr := &types.DeleteWorkflowExecutionTaskAttributes{
+		DomainID:   uuid.New(),
+		WorkflowID: "6d89f939-e6a4-4c26-a0ed-626ce27bcc9c", // belong to shard 0
+		RunID:      uuid.New(),
+		Version:    101,
+	}
+	task := &types.ReplicationTask{
+		TaskType:                              types.ReplicationTaskTypeDeleteWorkflowExecution.Ptr(),
+		DeleteWorkflowExecutionTaskAttributes: attr,
+	}
+
+	s.mockEngine.EXPECT().ReplicateDeleteWorkflowExecution(gomock.Any(), attr).Return(nil).Times(1)
+	_, err := s.taskHandler.execute(task, true)
+	s.NoError(err)
+}
+
+func (s *taskExecutorSuite) TestProcess_DeleteWorkflowExecutionReplicationTask_DifferentShardID() {
+	attr := &types.DeleteWorkflowExecutionTaskAttributes{
+		DomainID:   uuid.New(),
+		WorkflowID: "abc", // belong to shard 1
+		RunID:      uuid.New(),
+		Version:    101,
+	}
+	task := &types.ReplicationTask{
+		TaskType:                              types.ReplicationTaskTypeDeleteWorkflowExecution.Ptr(),
+		DeleteWorkflowExecutionTaskAttributes: attr,
+	}
+
+	s.mockEngine.EXPECT().ReplicateDeleteWorkflowExecution(gomock.Any(), attr).Return(nil).Times(0)
+	s.historyClient.EXPECT().ReplicateDeleteWorkflowExecution(gomock.Any(), attr).Return(nil).Times(1)
+	_, err := s.taskHandler.execute(task, true)
+	s.NoError(err)
+}
+
+func (s *taskExecutorSuite) TestProcess_DeleteWorkflowExecutionReplicationTask_NilAttributes() {
+	task := &types.ReplicationTask{
+		TaskType: types.ReplicationTaskTypeDeleteWorkflowExecution.Ptr(),
+	}
+
+	_, err := s.taskHandler.execute(task, true)
+	s.ErrorIs(err, ErrEmptyDeleteWorkflowExecutionAttributes)
+}
+
+func (s *taskExecutorSuite) TestProcess_UpsertWorkflowSearchAttributesReplicationTask_SameShardID() {
+	attr := &types.UpsertWorkflowSearchAttributesTaskAttributes{
+		DomainID:   uuid.New(),
+		WorkflowID: "6d89f939-e6a4-4c26-a0ed-626ce27bcc9c", // belong to shard 0
+		RunID:      uuid.New(),
+		Version:    101,
+		SearchAttributes: &types.SearchAttributes{
+			IndexedFields: map[string][]byte{"CustomKeywordField": []byte(`"value"`)},
+		},
+	}
+	task := &types.ReplicationTask{
+		TaskType: types.ReplicationTaskTypeUpsertWorkflowSearchAttributes.Ptr(),
+		UpsertWorkflowSearchAttributesTaskAttributes: attr,
+	}
+
+	s.mockEngine.EXPECT().ReplicateUpsertWorkflowSearchAttributes(gomock.Any(), attr).Return(nil).Times(1)
+	_, err := s.taskHandler.execute(task, true)
+	s.NoError(err)
+}
+
+func (s *taskExecutorSuite) TestProcess_UpsertWorkflowSearchAttributesReplicationTask_DifferentShardID() {
+	attr := &types.UpsertWorkflowSearchAttributesTaskAttributes{
+		DomainID:   uuid.New(),
+		WorkflowID: "abc", // belong to shard 1
+		RunID:      uuid.New(),
+		Version:    101,
+		SearchAttributes: &types.SearchAttributes{
+			IndexedFields: map[string][]byte{"CustomKeywordField": []byte(`"value"`)},
+		},
+	}
+	task := &types.ReplicationTask{
+		TaskType: types.ReplicationTaskTypeUpsertWorkflowSearchAttributes.Ptr(),
+		UpsertWorkflowSearchAttributesTaskAttributes: attr,
+	}
+
+	s.mockEngine.EXPECT().ReplicateUpsertWorkflowSearchAttributes(gomock.Any(), attr).Return(nil).Times(0)
+	s.historyClient.EXPECT().ReplicateUpsertWorkflowSearchAttributes(gomock.Any(), attr).Return(nil).Times(1)
+	_, err := s.taskHandler.execute(task, true)
+	s.NoError(err)
+}
+
 func (s *taskExecutorSuite) TestProcess_FailoverReplicationTask() {
 	task := &types.ReplicationTask{
 		TaskType: types.ReplicationTaskTypeFailoverMarker.Ptr(),
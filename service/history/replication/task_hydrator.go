@@ -58,14 +58,15 @@ type (
 		IsWorkflowExecutionRunning() bool
 		GetActivityInfo(int64) (*persistence.ActivityInfo, bool)
 		GetVersionHistories() *persistence.VersionHistories
+		GetExecutionInfo() *persistence.WorkflowExecutionInfo
 	}
 )
 
 // NewImmediateTaskHydrator will enrich replication tasks with additional information that is immediately available.
-func NewImmediateTaskHydrator(isRunning bool, vh *persistence.VersionHistories, activities map[int64]*persistence.ActivityInfo, blob, nextBlob *persistence.DataBlob) TaskHydrator {
+func NewImmediateTaskHydrator(executionInfo *persistence.WorkflowExecutionInfo, vh *persistence.VersionHistories, activities map[int64]*persistence.ActivityInfo, blob, nextBlob *persistence.DataBlob) TaskHydrator {
 	return TaskHydrator{
 		history:    immediateHistoryProvider{blob: blob, nextBlob: nextBlob},
-		msProvider: immediateMutableStateProvider{immediateMutableState{isRunning, activities, vh}},
+		msProvider: immediateMutableStateProvider{immediateMutableState{executionInfo, activities, vh}},
 	}
 }
 
@@ -83,6 +84,9 @@ func (h TaskHydrator) Hydrate(ctx context.Context, task persistence.Task) (retTa
 	switch t := task.(type) {
 	case *persistence.FailoverMarkerTask:
 		return hydrateFailoverMarkerTask(t), nil
+	case *persistence.DeleteWorkflowExecutionReplicationTask:
+		// the mutable state may already be deleted by the time the task is read
+		return hydrateDeleteWorkflowExecutionTask(t), nil
 	}
 
 	ms, release, err := h.msProvider.GetMutableState(ctx, task.GetDomainID(), task.GetWorkflowID(), task.GetRunID())
@@ -102,6 +106,8 @@ func (h TaskHydrator) Hydrate(ctx context.Context, task persistence.Task) (retTa
 	switch t := task.(type) {
 	case *persistence.SyncActivityTask:
 		return hydrateSyncActivityTask(t, ms)
+	case *persistence.UpsertWorkflowSearchAttributesReplicationTask:
+		return hydrateUpsertWorkflowSearchAttributesTask(t, ms), nil
 	case *persistence.HistoryReplicationTask:
 		versionHistories := ms.GetVersionHistories()
 		if versionHistories != nil {
@@ -127,6 +133,38 @@ func hydrateFailoverMarkerTask(t *persistence.FailoverMarkerTask) *types.Replica
 	}
 }
 
+func hydrateDeleteWorkflowExecutionTask(t *persistence.DeleteWorkflowExecutionReplicationTask) *types.ReplicationTask {
+	return &types.ReplicationTask{
+		TaskType:     types.ReplicationTaskTypeDeleteWorkflowExecution.Ptr(),
+		SourceTaskID: t.TaskID,
+		DeleteWorkflowExecutionTaskAttributes: &types.DeleteWorkflowExecutionTaskAttributes{
+			DomainID:   t.DomainID,
+			WorkflowID: t.WorkflowID,
+			RunID:      t.RunID,
+			Version:    t.Version,
+		},
+		CreationTime: common.Ptr(t.VisibilityTimestamp.UnixNano()),
+	}
+}
+
+func hydrateUpsertWorkflowSearchAttributesTask(t *persistence.UpsertWorkflowSearchAttributesReplicationTask, ms mutableState) *types.ReplicationTask {
+	// the latest search attributes and memo are sent, so that any upsert overrides older ones on the standby
+	executionInfo := ms.GetExecutionInfo()
+	return &types.ReplicationTask{
+		TaskType:     types.ReplicationTaskTypeUpsertWorkflowSearchAttributes.Ptr(),
+		SourceTaskID: t.TaskID,
+		UpsertWorkflowSearchAttributesTaskAttributes: &types.UpsertWorkflowSearchAttributesTaskAttributes{
+			DomainID:         t.DomainID,
+			WorkflowID:       t.WorkflowID,
+			RunID:            t.RunID,
+			Version:          t.Version,
+			SearchAttributes: &types.SearchAttributes{IndexedFields: executionInfo.CopySearchAttributes()},
+			Memo:             &types.Memo{Fields: executionInfo.CopyMemo()},
+		},
+		CreationTime: common.Ptr(t.VisibilityTimestamp.UnixNano()),
+	}
+}
+
 func hydrateSyncActivityTask(task *persistence.SyncActivityTask, ms mutableState) (*types.ReplicationTask, error) {
 	if !ms.IsWorkflowExecutionRunning() {
 		// workflow already finished, no need to process the replication task
@@ -172,6 +210,7 @@ func hydrateSyncActivityTask(task *persistence.SyncActivityTask, ms mutableState
 			LastWorkerIdentity: activityInfo.LastWorkerIdentity,
 			LastFailureDetails: activityInfo.LastFailureDetails,
 			VersionHistory:     versionHistory,
+			Paused:             activityInfo.Paused,
 		},
 		CreationTime: common.Ptr(task.VisibilityTimestamp.UnixNano()),
 	}, nil
@@ -313,13 +352,13 @@ func (r immediateMutableStateProvider) GetMutableState(_ context.Context, _, _,
 }
 
 type immediateMutableState struct {
-	isRunning        bool
+	executionInfo    *persistence.WorkflowExecutionInfo
 	activities       map[int64]*persistence.ActivityInfo
 	versionHistories *persistence.VersionHistories
 }
 
 func (ms immediateMutableState) IsWorkflowExecutionRunning() bool {
-	return ms.isRunning
+	return ms.executionInfo.IsRunning()
 }
 func (ms immediateMutableState) GetActivityInfo(id int64) (*persistence.ActivityInfo, bool) {
 	info, ok := ms.activities[id]
@@ -328,3 +367,6 @@ func (ms immediateMutableState) GetActivityInfo(id int64) (*persistence.Activity
 func (ms immediateMutableState) GetVersionHistories() *persistence.VersionHistories {
 	return ms.versionHistories
 }
+func (ms immediateMutableState) GetExecutionInfo() *persistence.WorkflowExecutionInfo {
+	return ms.executionInfo
+}
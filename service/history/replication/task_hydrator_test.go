@@ -130,6 +130,47 @@ func TestTaskHydrator_HydrateFailoverMarkerTask(t *testing.T) {
 	assert.Equal(t, &expected, actual)
 }
 
+func TestTaskHydrator_HydrateUpsertWorkflowSearchAttributesTask(t *testing.T) {
+	task := &persistence.UpsertWorkflowSearchAttributesReplicationTask{
+		WorkflowIdentifier: persistence.WorkflowIdentifier{
+			DomainID:   testDomainID,
+			WorkflowID: testWorkflowID,
+			RunID:      testRunID,
+		},
+		TaskData: persistence.TaskData{
+			TaskID:              testTaskID,
+			Version:             testVersion,
+			VisibilityTimestamp: time.Unix(0, testCreationTime),
+		},
+	}
+	executionInfo := &persistence.WorkflowExecutionInfo{
+		SearchAttributes: map[string][]byte{"CustomKeywordField": []byte(`"keyword"`)},
+		Memo:             map[string][]byte{"key": []byte("value")},
+	}
+
+	th := TaskHydrator{msProvider: &fakeMutableStateProvider{
+		workflows: map[definition.WorkflowIdentifier]mutableState{
+			testWorkflowIdentifier: &fakeMutableState{executionInfo: executionInfo},
+		},
+	}}
+	actual, err := th.Hydrate(context.Background(), task)
+	assert.NoError(t, err)
+	assert.Equal(t, &types.ReplicationTask{
+		TaskType:     types.ReplicationTaskTypeUpsertWorkflowSearchAttributes.Ptr(),
+		SourceTaskID: testTaskID,
+		UpsertWorkflowSearchAttributesTaskAttributes: &types.UpsertWorkflowSearchAttributesTaskAttributes{
+			DomainID:         testDomainID,
+			WorkflowID:       testWorkflowID,
+			RunID:            testRunID,
+			Version:          testVersion,
+			SearchAttributes: &types.SearchAttributes{IndexedFields: executionInfo.SearchAttributes},
+			Memo:             &types.Memo{Fields: executionInfo.Memo},
+		},
+		CreationTime: common.Int64Ptr(testCreationTime),
+	}, actual)
+	assert.True(t, th.msProvider.(*fakeMutableStateProvider).released)
+}
+
 func TestTaskHydrator_HydrateSyncActivityTask(t *testing.T) {
 	task := &persistence.SyncActivityTask{
 		WorkflowIdentifier: persistence.WorkflowIdentifier{
@@ -169,6 +210,7 @@ func TestTaskHydrator_HydrateSyncActivityTask(t *testing.T) {
 		LastFailureReason:        testLastFailureReason,
 		LastFailureDetails:       testLastFailureDetails,
 		LastWorkerIdentity:       testWorkerIdentity,
+		Paused:                   true,
 	}
 
 	tests := []struct {
@@ -213,6 +255,7 @@ func TestTaskHydrator_HydrateSyncActivityTask(t *testing.T) {
 						Items:       []*types.VersionHistoryItem{{EventID: testFirstEventID, Version: testVersion}},
 						BranchToken: testBranchTokenVersionHistory,
 					},
+					Paused: true,
 				},
 			},
 		},
@@ -795,7 +838,7 @@ func TestImmediateTaskHydrator(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			h := NewImmediateTaskHydrator(true, tt.versionHistories, tt.activities, tt.blob, tt.nextRunBlob)
+			h := NewImmediateTaskHydrator(&persistence.WorkflowExecutionInfo{State: persistence.WorkflowStateRunning}, tt.versionHistories, tt.activities, tt.blob, tt.nextRunBlob)
 			result, err := h.Hydrate(context.Background(), tt.task)
 
 			if tt.expectErr != "" {
@@ -833,6 +876,7 @@ type fakeMutableState struct {
 	isWorkflowExecutionRunning bool
 	versionHistories           *persistence.VersionHistories
 	activityInfos              map[int64]persistence.ActivityInfo
+	executionInfo              *persistence.WorkflowExecutionInfo
 }
 
 func (ms fakeMutableState) IsWorkflowExecutionRunning() bool {
@@ -845,6 +889,9 @@ func (ms fakeMutableState) GetActivityInfo(scheduleID int64) (*persistence.Activ
 func (ms fakeMutableState) GetVersionHistories() *persistence.VersionHistories {
 	return ms.versionHistories
 }
+func (ms fakeMutableState) GetExecutionInfo() *persistence.WorkflowExecutionInfo {
+	return ms.executionInfo
+}
 
 type historyBlob struct {
 	branch []byte
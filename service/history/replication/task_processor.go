@@ -65,6 +65,10 @@ var (
 	ErrUnknownReplicationTask = &types.BadRequestError{Message: "unknown replication task"}
 	// ErrEmptyFailoverMarkerAttributes is the error returned when a failover marker replication task has nil attributes
 	ErrEmptyFailoverMarkerAttributes = &types.BadRequestError{Message: "empty failover marker attributes"}
+	// ErrEmptyDeleteWorkflowExecutionAttributes is the error returned when a workflow deletion replication task has nil attributes
+	ErrEmptyDeleteWorkflowExecutionAttributes = &types.BadRequestError{Message: "empty delete workflow execution attributes"}
+	// ErrEmptyUpsertWorkflowSearchAttributesAttributes is the error returned when a search attributes upsert replication task has nil attributes
+	ErrEmptyUpsertWorkflowSearchAttributesAttributes = &types.BadRequestError{Message: "empty upsert workflow search attributes attributes"}
 )
 
 type (
@@ -501,6 +505,10 @@ func (p *taskProcessorImpl) processTaskOnce(replicationTask *types.ReplicationTa
 			domainID = replicationTask.SyncActivityTaskAttributes.GetDomainID()
 		case types.ReplicationTaskTypeFailoverMarker:
 			domainID = replicationTask.FailoverMarkerAttributes.GetDomainID()
+		case types.ReplicationTaskTypeDeleteWorkflowExecution:
+			domainID = replicationTask.DeleteWorkflowExecutionTaskAttributes.GetDomainID()
+		case types.ReplicationTaskTypeUpsertWorkflowSearchAttributes:
+			domainID = replicationTask.UpsertWorkflowSearchAttributesTaskAttributes.GetDomainID()
 		}
 		var domainName string
 		if domainID != "" {
@@ -579,7 +587,7 @@ func (p *taskProcessorImpl) putReplicationTaskToDLQ(request *persistence.PutRepl
 func (p *taskProcessorImpl) generateDLQRequest(
 	replicationTask *types.ReplicationTask,
 ) (*persistence.PutReplicationTaskToDLQRequest, error) {
-	switch *replicationTask.TaskType {
+	switch replicationTask.GetTaskType() {
 	case types.ReplicationTaskTypeSyncActivity:
 		taskAttributes := replicationTask.GetSyncActivityTaskAttributes()
 		domainName, err := p.shard.GetDomainCache().GetDomainName(taskAttributes.GetDomainID())
@@ -634,6 +642,48 @@ func (p *taskProcessorImpl) generateDLQRequest(
 			DomainName: domainName,
 			ShardID:    common.Ptr(p.shard.GetShardID()),
 		}, nil
+
+	case types.ReplicationTaskTypeDeleteWorkflowExecution:
+		taskAttributes := replicationTask.GetDeleteWorkflowExecutionTaskAttributes()
+		domainName, err := p.shard.GetDomainCache().GetDomainName(taskAttributes.GetDomainID())
+		if err != nil {
+			return nil, err
+		}
+		return &persistence.PutReplicationTaskToDLQRequest{
+			SourceClusterName: p.sourceCluster,
+			TaskInfo: &persistence.ReplicationTaskInfo{
+				DomainID:   taskAttributes.GetDomainID(),
+				WorkflowID: taskAttributes.GetWorkflowID(),
+				RunID:      taskAttributes.GetRunID(),
+				TaskID:     replicationTask.GetSourceTaskID(),
+				TaskType:   persistence.ReplicationTaskTypeDeleteWorkflowExecution,
+				Version:    taskAttributes.GetVersion(),
+			},
+			Task:       replicationTask,
+			DomainName: domainName,
+			ShardID:    common.Ptr(p.shard.GetShardID()),
+		}, nil
+
+	case types.ReplicationTaskTypeUpsertWorkflowSearchAttributes:
+		taskAttributes := replicationTask.GetUpsertWorkflowSearchAttributesTaskAttributes()
+		domainName, err := p.shard.GetDomainCache().GetDomainName(taskAttributes.GetDomainID())
+		if err != nil {
+			return nil, err
+		}
+		return &persistence.PutReplicationTaskToDLQRequest{
+			SourceClusterName: p.sourceCluster,
+			TaskInfo: &persistence.ReplicationTaskInfo{
+				DomainID:   taskAttributes.GetDomainID(),
+				WorkflowID: taskAttributes.GetWorkflowID(),
+				RunID:      taskAttributes.GetRunID(),
+				TaskID:     replicationTask.GetSourceTaskID(),
+				TaskType:   persistence.ReplicationTaskTypeUpsertWorkflowSearchAttributes,
+				Version:    taskAttributes.GetVersion(),
+			},
+			Task:       replicationTask,
+			DomainName: domainName,
+			ShardID:    common.Ptr(p.shard.GetShardID()),
+		}, nil
 	default:
 		return nil, fmt.Errorf("unknown replication task type")
 	}
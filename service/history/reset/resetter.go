@@ -38,6 +38,7 @@ import (
 	persistenceutils "github.com/uber/cadence/common/persistence/persistence-utils"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/history/execution"
+	"github.com/uber/cadence/service/history/query"
 	"github.com/uber/cadence/service/history/shard"
 )
 
@@ -629,6 +630,10 @@ func (r *workflowResetterImpl) reapplyEvents(
 		switch event.GetEventType() {
 		case types.EventTypeWorkflowExecutionSignaled:
 			attr := event.GetWorkflowExecutionSignaledEventAttributes()
+			if query.IsReservedSignalName(attr.GetSignalName()) {
+				// accepted updates are not reapplied, their callers are no longer waiting for the outcome
+				continue
+			}
 			if _, err := mutableState.AddWorkflowExecutionSignaled(
 				attr.GetSignalName(),
 				attr.GetInput(),
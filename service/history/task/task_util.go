@@ -27,11 +27,15 @@ import (
 
 	"go.uber.org/mock/gomock"
 
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/archiver"
+	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/constants"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/service"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/history/execution"
 	"github.com/uber/cadence/service/history/shard"
@@ -127,6 +131,11 @@ func GetTransferTaskMetricsScope(
 			return metrics.TransferActiveTaskApplyParentClosePolicyScope
 		}
 		return metrics.TransferStandbyTaskApplyParentClosePolicyScope
+	case persistence.TransferTaskTypeDeleteWorkflowExecution:
+		if isActive {
+			return metrics.TransferActiveTaskDeleteWorkflowExecutionScope
+		}
+		return metrics.TransferStandbyTaskDeleteWorkflowExecutionScope
 	default:
 		if isActive {
 			return metrics.TransferActiveQueueProcessorScope
@@ -379,6 +388,120 @@ func getClusterAttributesForTask(
 	return activeClusterSelectionPolicy.ClusterAttribute, nil
 }
 
+// GetArchivedHistoryDeleter returns the deleter of the domain's history archive, or nil if history
+// archival is not configured for the cluster or the domain.
+// ErrArchivedHistoryDeletionNotSupported is returned if the archiver can't delete archived history.
+func GetArchivedHistoryDeleter(
+	shard shard.Context,
+	domainEntry *cache.DomainCacheEntry,
+) (archiver.HistoryDeleter, archiver.URI, error) {
+	historyArchivalURI := domainEntry.GetConfig().HistoryArchivalURI
+	if historyArchivalURI == "" || !shard.GetService().GetArchivalMetadata().GetHistoryConfig().ClusterConfiguredForArchival() {
+		return nil, nil, nil
+	}
+
+	URI, err := archiver.NewURI(historyArchivalURI)
+	if err != nil {
+		return nil, nil, err
+	}
+	historyArchiver, err := shard.GetService().GetArchiverProvider().GetHistoryArchiver(URI.Scheme(), service.History)
+	if err != nil {
+		return nil, nil, err
+	}
+	historyDeleter, ok := historyArchiver.(archiver.HistoryDeleter)
+	if !ok {
+		return nil, nil, ErrArchivedHistoryDeletionNotSupported
+	}
+	return historyDeleter, URI, nil
+}
+
+// DeleteArchivedHistory deletes the archived history of a closed workflow run. Once retention has
+// removed the mutable state of the run, its history may still be waiting for the archival workflow,
+// which deletes the history branch when it is done. mutableStateDeleted has to be set in that case,
+// so that ErrArchivalInFlight is returned while the branch exists instead of deleting an archive
+// that is about to be written.
+func DeleteArchivedHistory(
+	ctx context.Context,
+	shard shard.Context,
+	domainEntry *cache.DomainCacheEntry,
+	workflow persistence.WorkflowIdentifier,
+	mutableStateDeleted bool,
+) error {
+	historyDeleter, URI, err := GetArchivedHistoryDeleter(shard, domainEntry)
+	if err != nil || historyDeleter == nil {
+		return err
+	}
+
+	if mutableStateDeleted {
+		// the history of a run lives in the tree named after its run ID, the root branch of that tree
+		// is the one without ancestors and it is only removed once the history is archived
+		resp, err := shard.GetHistoryManager().GetHistoryTree(ctx, &persistence.GetHistoryTreeRequest{
+			TreeID:     workflow.RunID,
+			ShardID:    common.IntPtr(shard.GetShardID()),
+			DomainName: domainEntry.GetInfo().Name,
+		})
+		if err != nil {
+			return err
+		}
+		for _, branch := range resp.Branches {
+			if len(branch.GetAncestors()) == 0 {
+				return ErrArchivalInFlight
+			}
+		}
+	}
+
+	return historyDeleter.Delete(ctx, URI, &archiver.DeleteHistoryRequest{
+		DomainID:   workflow.DomainID,
+		WorkflowID: workflow.WorkflowID,
+		RunID:      workflow.RunID,
+	})
+}
+
+// GetArchivedVisibilityDeleter returns the deleter of the domain's visibility archive, or nil if
+// visibility archival is not configured for the cluster or the domain.
+// ErrArchivedVisibilityDeletionNotSupported is returned if the archiver can't delete archived records.
+func GetArchivedVisibilityDeleter(
+	shard shard.Context,
+	domainEntry *cache.DomainCacheEntry,
+) (archiver.VisibilityDeleter, archiver.URI, error) {
+	visibilityArchivalURI := domainEntry.GetConfig().VisibilityArchivalURI
+	if visibilityArchivalURI == "" || !shard.GetService().GetArchivalMetadata().GetVisibilityConfig().ClusterConfiguredForArchival() {
+		return nil, nil, nil
+	}
+
+	URI, err := archiver.NewURI(visibilityArchivalURI)
+	if err != nil {
+		return nil, nil, err
+	}
+	visibilityArchiver, err := shard.GetService().GetArchiverProvider().GetVisibilityArchiver(URI.Scheme(), service.History)
+	if err != nil {
+		return nil, nil, err
+	}
+	visibilityDeleter, ok := visibilityArchiver.(archiver.VisibilityDeleter)
+	if !ok {
+		return nil, nil, ErrArchivedVisibilityDeletionNotSupported
+	}
+	return visibilityDeleter, URI, nil
+}
+
+// DeleteArchivedVisibility deletes the archived visibility records of a closed workflow run
+func DeleteArchivedVisibility(
+	ctx context.Context,
+	shard shard.Context,
+	domainEntry *cache.DomainCacheEntry,
+	workflow persistence.WorkflowIdentifier,
+) error {
+	visibilityDeleter, URI, err := GetArchivedVisibilityDeleter(shard, domainEntry)
+	if err != nil || visibilityDeleter == nil {
+		return err
+	}
+	return visibilityDeleter.Delete(ctx, URI, &archiver.DeleteVisibilityRequest{
+		DomainID:   workflow.DomainID,
+		WorkflowID: workflow.WorkflowID,
+		RunID:      workflow.RunID,
+	})
+}
+
 // NewMockTaskMatcher creates a gomock matcher for mock Task
 func NewMockTaskMatcher(mockTask *MockTask) gomock.Matcher {
 	return &mockTaskMatcher{
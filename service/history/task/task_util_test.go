@@ -27,19 +27,25 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"go.uber.org/mock/gomock"
 	"go.uber.org/zap"
 
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/activecluster"
+	"github.com/uber/cadence/common/archiver"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/clock"
 	"github.com/uber/cadence/common/cluster"
 	"github.com/uber/cadence/common/config"
 	commonconstants "github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/types"
+	hconfig "github.com/uber/cadence/service/history/config"
 	"github.com/uber/cadence/service/history/constants"
 	"github.com/uber/cadence/service/history/execution"
 	"github.com/uber/cadence/service/history/shard"
@@ -228,6 +234,18 @@ func TestGetTransferTaskMetricsScope(t *testing.T) {
 			isActive:      false,
 			expectedScope: metrics.TransferStandbyTaskApplyParentClosePolicyScope,
 		},
+		{
+			name:          "TransferTaskTypeDeleteWorkflowExecution - active",
+			taskType:      persistence.TransferTaskTypeDeleteWorkflowExecution,
+			isActive:      true,
+			expectedScope: metrics.TransferActiveTaskDeleteWorkflowExecutionScope,
+		},
+		{
+			name:          "TransferTaskTypeDeleteWorkflowExecution - standby",
+			taskType:      persistence.TransferTaskTypeDeleteWorkflowExecution,
+			isActive:      false,
+			expectedScope: metrics.TransferStandbyTaskDeleteWorkflowExecutionScope,
+		},
 		{
 			name:          "TransferTaskType not caught - active",
 			taskType:      -100,
@@ -575,6 +593,216 @@ func Test_loadMutableState(t *testing.T) {
 	}
 }
 
+type deletableHistoryArchiver struct {
+	*archiver.HistoryArchiverMock
+	*archiver.HistoryDeleterMock
+}
+
+func Test_DeleteArchivedHistory(t *testing.T) {
+	workflowIdentifier := persistence.WorkflowIdentifier{
+		DomainID:   constants.TestDomainID,
+		WorkflowID: constants.TestWorkflowID,
+		RunID:      constants.TestRunID,
+	}
+	deleteRequest := &archiver.DeleteHistoryRequest{
+		DomainID:   constants.TestDomainID,
+		WorkflowID: constants.TestWorkflowID,
+		RunID:      constants.TestRunID,
+	}
+	testCases := []struct {
+		name                string
+		historyArchivalURI  string
+		mutableStateDeleted bool
+		setupMock           func(*shard.TestContext, *archiver.HistoryDeleterMock)
+		historyArchiver     func(*archiver.HistoryDeleterMock) archiver.HistoryArchiver
+		err                 error
+	}{
+		{
+			name:                "archival not configured for domain",
+			mutableStateDeleted: true,
+			setupMock:           func(*shard.TestContext, *archiver.HistoryDeleterMock) {},
+		},
+		{
+			name:               "archiver can't delete",
+			historyArchivalURI: "test:///history/archival",
+			setupMock:          func(*shard.TestContext, *archiver.HistoryDeleterMock) {},
+			historyArchiver: func(*archiver.HistoryDeleterMock) archiver.HistoryArchiver {
+				return &archiver.HistoryArchiverMock{}
+			},
+			err: ErrArchivedHistoryDeletionNotSupported,
+		},
+		{
+			name:                "archival in flight",
+			historyArchivalURI:  "test:///history/archival",
+			mutableStateDeleted: true,
+			setupMock: func(s *shard.TestContext, d *archiver.HistoryDeleterMock) {
+				s.Resource.HistoryMgr.On("GetHistoryTree", mock.Anything, &persistence.GetHistoryTreeRequest{
+					TreeID:     constants.TestRunID,
+					ShardID:    common.IntPtr(0),
+					DomainName: constants.TestDomainName,
+				}).Return(&persistence.GetHistoryTreeResponse{
+					Branches: []*workflow.HistoryBranch{{TreeID: common.StringPtr(constants.TestRunID), BranchID: common.StringPtr("root")}},
+				}, nil).Once()
+			},
+			err: ErrArchivalInFlight,
+		},
+		{
+			name:                "archival done",
+			historyArchivalURI:  "test:///history/archival",
+			mutableStateDeleted: true,
+			setupMock: func(s *shard.TestContext, d *archiver.HistoryDeleterMock) {
+				s.Resource.HistoryMgr.On("GetHistoryTree", mock.Anything, mock.Anything).Return(&persistence.GetHistoryTreeResponse{
+					Branches: []*workflow.HistoryBranch{{
+						TreeID:    common.StringPtr(constants.TestRunID),
+						BranchID:  common.StringPtr("fork"),
+						Ancestors: []*workflow.HistoryBranchRange{{BranchID: common.StringPtr("root")}},
+					}},
+				}, nil).Once()
+				d.On("Delete", mock.Anything, mock.Anything, deleteRequest).Return(nil).Once()
+			},
+		},
+		{
+			name:               "mutable state exists",
+			historyArchivalURI: "test:///history/archival",
+			setupMock: func(s *shard.TestContext, d *archiver.HistoryDeleterMock) {
+				d.On("Delete", mock.Anything, mock.Anything, deleteRequest).Return(nil).Once()
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			s := shard.NewTestContext(t, controller, &persistence.ShardInfo{ShardID: 0, RangeID: 1}, hconfig.NewForTest())
+			defer s.Finish(t)
+
+			historyDeleter := archiver.NewHistoryDeleterMock(t)
+			historyArchiver := archiver.HistoryArchiver(&deletableHistoryArchiver{HistoryDeleterMock: historyDeleter})
+			if tc.historyArchiver != nil {
+				historyArchiver = tc.historyArchiver(historyDeleter)
+			}
+			s.Resource.ArchivalMetadata.On("GetHistoryConfig").Return(archiver.NewArchivalConfig(
+				"enabled", dynamicproperties.GetStringPropertyFn("enabled"), true, dynamicproperties.GetBoolPropertyFn(true), "enabled", "test:///history/archival",
+			)).Maybe()
+			s.Resource.ArchiverProvider.EXPECT().GetHistoryArchiver("test", gomock.Any()).Return(historyArchiver, nil).AnyTimes()
+			tc.setupMock(s, historyDeleter)
+
+			domainEntry := cache.NewGlobalDomainCacheEntryForTest(
+				&persistence.DomainInfo{ID: constants.TestDomainID, Name: constants.TestDomainName},
+				&persistence.DomainConfig{
+					Retention:             1,
+					HistoryArchivalStatus: types.ArchivalStatusEnabled,
+					HistoryArchivalURI:    tc.historyArchivalURI,
+				},
+				&persistence.DomainReplicationConfig{ActiveClusterName: cluster.TestCurrentClusterName},
+				constants.TestVersion,
+			)
+			err := DeleteArchivedHistory(context.Background(), s, domainEntry, workflowIdentifier, tc.mutableStateDeleted)
+			assert.Equal(t, tc.err, err)
+		})
+	}
+}
+
+type deletableVisibilityArchiver struct {
+	*archiver.VisibilityArchiverMock
+	*archiver.VisibilityDeleterMock
+}
+
+func Test_DeleteArchivedVisibility(t *testing.T) {
+	workflowIdentifier := persistence.WorkflowIdentifier{
+		DomainID:   constants.TestDomainID,
+		WorkflowID: constants.TestWorkflowID,
+		RunID:      constants.TestRunID,
+	}
+	deleteRequest := &archiver.DeleteVisibilityRequest{
+		DomainID:   constants.TestDomainID,
+		WorkflowID: constants.TestWorkflowID,
+		RunID:      constants.TestRunID,
+	}
+	testCases := []struct {
+		name                  string
+		visibilityArchivalURI string
+		clusterEnabled        bool
+		setupMock             func(*archiver.VisibilityDeleterMock)
+		visibilityArchiver    func(*archiver.VisibilityDeleterMock) archiver.VisibilityArchiver
+		err                   error
+	}{
+		{
+			name:           "archival not configured for domain",
+			clusterEnabled: true,
+			setupMock:      func(*archiver.VisibilityDeleterMock) {},
+		},
+		{
+			name:                  "archival not configured for cluster",
+			visibilityArchivalURI: "test:///visibility/archival",
+			setupMock:             func(*archiver.VisibilityDeleterMock) {},
+		},
+		{
+			name:                  "archiver can't delete",
+			visibilityArchivalURI: "test:///visibility/archival",
+			clusterEnabled:        true,
+			setupMock:             func(*archiver.VisibilityDeleterMock) {},
+			visibilityArchiver: func(*archiver.VisibilityDeleterMock) archiver.VisibilityArchiver {
+				return &archiver.VisibilityArchiverMock{}
+			},
+			err: ErrArchivedVisibilityDeletionNotSupported,
+		},
+		{
+			name:                  "delete failed",
+			visibilityArchivalURI: "test:///visibility/archival",
+			clusterEnabled:        true,
+			setupMock: func(d *archiver.VisibilityDeleterMock) {
+				d.On("Delete", mock.Anything, mock.Anything, deleteRequest).Return(assert.AnError).Once()
+			},
+			err: assert.AnError,
+		},
+		{
+			name:                  "success",
+			visibilityArchivalURI: "test:///visibility/archival",
+			clusterEnabled:        true,
+			setupMock: func(d *archiver.VisibilityDeleterMock) {
+				d.On("Delete", mock.Anything, mock.Anything, deleteRequest).Return(nil).Once()
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			s := shard.NewTestContext(t, controller, &persistence.ShardInfo{ShardID: 0, RangeID: 1}, hconfig.NewForTest())
+			defer s.Finish(t)
+
+			visibilityDeleter := archiver.NewVisibilityDeleterMock(t)
+			visibilityArchiver := archiver.VisibilityArchiver(&deletableVisibilityArchiver{VisibilityDeleterMock: visibilityDeleter})
+			if tc.visibilityArchiver != nil {
+				visibilityArchiver = tc.visibilityArchiver(visibilityDeleter)
+			}
+			archivalConfig := archiver.NewDisabledArchvialConfig()
+			if tc.clusterEnabled {
+				archivalConfig = archiver.NewArchivalConfig(
+					"enabled", dynamicproperties.GetStringPropertyFn("enabled"), true, dynamicproperties.GetBoolPropertyFn(true), "enabled", "test:///visibility/archival",
+				)
+			}
+			s.Resource.ArchivalMetadata.On("GetVisibilityConfig").Return(archivalConfig).Maybe()
+			s.Resource.ArchiverProvider.EXPECT().GetVisibilityArchiver("test", gomock.Any()).Return(visibilityArchiver, nil).AnyTimes()
+			tc.setupMock(visibilityDeleter)
+
+			domainEntry := cache.NewGlobalDomainCacheEntryForTest(
+				&persistence.DomainInfo{ID: constants.TestDomainID, Name: constants.TestDomainName},
+				&persistence.DomainConfig{
+					Retention:                1,
+					VisibilityArchivalStatus: types.ArchivalStatusEnabled,
+					VisibilityArchivalURI:    tc.visibilityArchivalURI,
+				},
+				&persistence.DomainReplicationConfig{ActiveClusterName: cluster.TestCurrentClusterName},
+				constants.TestVersion,
+			)
+			err := DeleteArchivedVisibility(context.Background(), s, domainEntry, workflowIdentifier)
+			assert.Equal(t, tc.err, err)
+		})
+	}
+}
+
 func Test_timeoutWorkflow(t *testing.T) {
 	eventBatchFirstEventID := int64(2)
 	testCases := []struct {
@@ -160,6 +160,10 @@ func (t *timerActiveTaskExecutor) executeUserTimerTimeoutTask(
 	if mutableState == nil || !mutableState.IsWorkflowExecutionRunning() {
 		return nil
 	}
+	if mutableState.IsWorkflowExecutionPaused() {
+		// user timers are regenerated when the workflow is unpaused, the ones that expired in the meantime fire right away
+		return nil
+	}
 
 	timerSequence := execution.NewTimerSequence(mutableState)
 	referenceTime := t.shard.GetTimeSource().Now()
@@ -703,6 +707,10 @@ func (t *timerActiveTaskExecutor) executeActivityRetryTimerTask(
 		}
 		return nil
 	}
+	if mutableState.IsActivityPaused(scheduledID) {
+		// a new retry timer task is generated when the activity is unpaused
+		return nil
+	}
 	ok, err = verifyTaskVersion(t.shard, t.logger, task.DomainID, activityInfo.Version, task.Version, task)
 	if err != nil || !ok {
 		if err == nil {
@@ -40,6 +40,7 @@ import (
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/taskpriority"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/history/config"
 	"github.com/uber/cadence/service/history/execution"
@@ -60,6 +61,12 @@ var (
 	ErrMissingRequestCancelInfo = &types.InternalServiceError{Message: "unable to get request cancel info"}
 	// ErrMissingSignalInfo indicates missing signal external
 	ErrMissingSignalInfo = &types.InternalServiceError{Message: "unable to get signal info"}
+	// ErrArchivedHistoryDeletionNotSupported indicates the history archiver of a domain can't delete archived history
+	ErrArchivedHistoryDeletionNotSupported = &types.BadRequestError{Message: "History archiver of the domain doesn't support deleting archived history."}
+	// ErrArchivedVisibilityDeletionNotSupported indicates the visibility archiver of a domain can't delete archived visibility records
+	ErrArchivedVisibilityDeletionNotSupported = &types.BadRequestError{Message: "Visibility archiver of the domain doesn't support deleting archived visibility records."}
+	// ErrArchivalInFlight indicates the history of a workflow run is still being archived
+	ErrArchivalInFlight = &types.ServiceBusyError{Message: "Workflow history is still being archived, retry later."}
 )
 
 var (
@@ -158,6 +165,8 @@ func (t *transferActiveTaskExecutor) Execute(task Task) (ExecuteResponse, error)
 		return executeResponse, t.processResetWorkflow(ctx, transferTask)
 	case *persistence.UpsertWorkflowSearchAttributesTask:
 		return executeResponse, t.processUpsertWorkflowSearchAttributes(ctx, transferTask)
+	case *persistence.DeleteWorkflowExecutionTask:
+		return executeResponse, t.deleteWorkflowExecution(ctx, transferTask)
 	default:
 		return executeResponse, errUnknownTransferTask
 	}
@@ -195,6 +204,10 @@ func (t *transferActiveTaskExecutor) processActivityTask(
 	if mutableState == nil || !mutableState.IsWorkflowExecutionRunning() {
 		return nil
 	}
+	if mutableState.IsWorkflowExecutionPaused() {
+		// the task is regenerated when the workflow is unpaused
+		return nil
+	}
 
 	domainName := mutableState.GetDomainEntry().GetInfo().Name
 	ai, ok := mutableState.GetActivityInfo(task.ScheduleID)
@@ -206,6 +219,10 @@ func (t *transferActiveTaskExecutor) processActivityTask(
 	if err != nil || !ok {
 		return err
 	}
+	if mutableState.IsActivityPaused(task.ScheduleID) {
+		// the attempt is dispatched by a retry timer task when the activity is unpaused
+		return nil
+	}
 
 	timeout := min(ai.ScheduleToStartTimeout, constants.MaxTaskTimeout)
 
@@ -216,6 +233,9 @@ func (t *transferActiveTaskExecutor) processActivityTask(
 	if taskList.Name == "" {
 		taskList.Name = task.TaskList
 	}
+	// activity level priority and fairness key take precedence over the ones inherited from the workflow
+	partitionConfig := taskpriority.WithPriority(mutableState.GetExecutionInfo().PartitionConfig, ai.Priority)
+	partitionConfig = taskpriority.WithFairnessKey(partitionConfig, ai.FairnessKey)
 	// release the context lock since we no longer need mutable state builder and
 	// the rest of logic is making RPC call, which takes time.
 	release(nil)
@@ -223,7 +243,7 @@ func (t *transferActiveTaskExecutor) processActivityTask(
 	pushActivityInfo := &pushActivityToMatchingInfo{
 		activityScheduleToStartTimeout: timeout,
 		tasklist:                       taskList,
-		partitionConfig:                mutableState.GetExecutionInfo().PartitionConfig,
+		partitionConfig:                partitionConfig,
 	}
 	err = t.pushActivity(ctx, task, pushActivityInfo)
 	if err == nil {
@@ -260,6 +280,10 @@ func (t *transferActiveTaskExecutor) processDecisionTask(
 	if mutableState == nil || !mutableState.IsWorkflowExecutionRunning() {
 		return nil
 	}
+	if mutableState.IsWorkflowExecutionPaused() {
+		// the task is regenerated when the workflow is unpaused
+		return nil
+	}
 
 	decision, found := mutableState.GetDecisionInfo(task.ScheduleID)
 	if !found {
@@ -135,6 +135,8 @@ func (t *transferStandbyTaskExecutor) Execute(task Task) (ExecuteResponse, error
 		return executeResponse, nil
 	case *persistence.UpsertWorkflowSearchAttributesTask:
 		return executeResponse, t.processUpsertWorkflowSearchAttributes(ctx, transferTask)
+	case *persistence.DeleteWorkflowExecutionTask:
+		return executeResponse, t.deleteWorkflowExecution(ctx, transferTask)
 	default:
 		return executeResponse, errUnknownTransferTask
 	}
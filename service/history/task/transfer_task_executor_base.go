@@ -67,6 +67,8 @@ type (
 		visibilityMgr  persistence.VisibilityManager
 		config         *config.Config
 		throttleRetry  *backoff.ThrottleRetry
+
+		workflowDeleter *timerTaskExecutorBase
 	}
 )
 
@@ -92,6 +94,14 @@ func newTransferTaskExecutorBase(
 			backoff.WithRetryPolicy(taskRetryPolicy),
 			backoff.WithRetryableError(common.IsServiceTransientError),
 		),
+		workflowDeleter: newTimerTaskExecutorBase(
+			shard,
+			archiverClient,
+			executionCache,
+			logger,
+			shard.GetMetricsClient(),
+			config,
+		),
 	}
 }
 
@@ -479,6 +489,76 @@ func (t *transferTaskExecutorBase) recordWorkflowClosed(
 }
 
 // Argument startEvent is to save additional call of msBuilder.GetStartEvent
+// deleteWorkflowExecution deletes a closed workflow execution together with its history, visibility
+// records and archived history. Both the active and the standby cluster delete their own copy, the
+// deletion is replicated to the standby cluster by a replication task.
+func (t *transferTaskExecutorBase) deleteWorkflowExecution(
+	ctx context.Context,
+	task *persistence.DeleteWorkflowExecutionTask,
+) (retError error) {
+
+	wfContext, release, err := t.executionCache.GetOrCreateWorkflowExecutionWithTimeout(
+		task.DomainID,
+		getWorkflowExecution(task),
+		taskGetExecutionContextTimeout,
+	)
+	if err != nil {
+		if err == context.DeadlineExceeded {
+			return errWorkflowBusy
+		}
+		return err
+	}
+	defer func() { release(retError) }()
+
+	domainEntry, err := t.shard.GetDomainCache().GetDomainByID(task.DomainID)
+	if err != nil {
+		return err
+	}
+
+	mutableState, err := loadMutableState(ctx, wfContext, task, t.metricsClient.Scope(metrics.TransferQueueProcessorScope), t.logger, 0)
+	if err != nil {
+		return err
+	}
+	if mutableState == nil {
+		// retention removed the execution, only the archived copies are left
+		if err := DeleteArchivedHistory(ctx, t.shard, domainEntry, task.WorkflowIdentifier, true); err != nil {
+			return err
+		}
+		return DeleteArchivedVisibility(ctx, t.shard, domainEntry, task.WorkflowIdentifier)
+	}
+	if mutableState.IsWorkflowExecutionRunning() {
+		t.logger.Warn("Skipping deletion of running workflow execution.",
+			tag.WorkflowDomainID(task.DomainID),
+			tag.WorkflowID(task.WorkflowID),
+			tag.WorkflowRunID(task.RunID),
+		)
+		return nil
+	}
+
+	lastWriteVersion, err := mutableState.GetLastWriteVersion()
+	if err != nil {
+		return err
+	}
+	ok, err := verifyTaskVersion(t.shard, t.logger, task.DomainID, lastWriteVersion, task.Version, task)
+	if err != nil || !ok {
+		return err
+	}
+
+	// archived copies are removed first, so that the task can be retried while the execution still exists
+	if err := DeleteArchivedHistory(ctx, t.shard, domainEntry, task.WorkflowIdentifier, false); err != nil {
+		return err
+	}
+	if err := DeleteArchivedVisibility(ctx, t.shard, domainEntry, task.WorkflowIdentifier); err != nil {
+		return err
+	}
+
+	return t.workflowDeleter.deleteWorkflow(ctx, &persistence.DeleteHistoryEventTask{
+		WorkflowIdentifier: task.WorkflowIdentifier,
+		TaskData:           task.TaskData,
+		TaskList:           task.TaskList,
+	}, wfContext, mutableState)
+}
+
 func getWorkflowExecutionTimestamp(
 	msBuilder execution.MutableState,
 	startEvent *types.HistoryEvent,
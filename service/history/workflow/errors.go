@@ -59,4 +59,11 @@ var (
 	ErrConsistentQueryBufferExceeded = &types.InternalServiceError{Message: "consistent query buffer is full, cannot accept new consistent queries"}
 	// ErrConcurrentStartRequest is error indicating there is an outstanding start workflow request. The incoming request fails to acquires the lock before the outstanding request finishes.
 	ErrConcurrentStartRequest = &types.ServiceBusyError{Message: "an outstanding start workflow request is in-progress. Failed to acquire the resource."}
+	// ErrUpdateWorkflowClosed is error indicating that the workflow closed before the handler of an accepted update completed
+	ErrUpdateWorkflowClosed = &types.WorkflowExecutionAlreadyCompletedError{Message: "workflow execution closed before the update completed"}
+	// ErrWorkflowPaused is error indicating that the tasks of the workflow are not dispatched until it is unpaused,
+	// matching drops the task and it is regenerated when the workflow is unpaused
+	ErrWorkflowPaused = &types.EntityNotExistsError{Message: "workflow execution is paused"}
+	// ErrActivityPaused is error indicating that the attempts of the activity are not dispatched until it is unpaused
+	ErrActivityPaused = &types.EntityNotExistsError{Message: "activity is paused"}
 )
@@ -30,6 +30,11 @@ func (g GRPCHandler) CountDLQMessages(ctx context.Context, request *historyv1.Co
 	return proto.FromHistoryCountDLQMessagesResponse(response), proto.FromError(err)
 }
 
+func (g GRPCHandler) DeleteWorkflowExecution(ctx context.Context, request *historyv1.DeleteWorkflowExecutionRequest) (*historyv1.DeleteWorkflowExecutionResponse, error) {
+	err := g.h.DeleteWorkflowExecution(ctx, proto.ToHistoryDeleteWorkflowExecutionRequest(request))
+	return &historyv1.DeleteWorkflowExecutionResponse{}, proto.FromError(err)
+}
+
 func (g GRPCHandler) DescribeHistoryHost(ctx context.Context, request *historyv1.DescribeHistoryHostRequest) (*historyv1.DescribeHistoryHostResponse, error) {
 	response, err := g.h.DescribeHistoryHost(ctx, proto.ToHistoryDescribeHistoryHostRequest(request))
 	return proto.FromHistoryDescribeHistoryHostResponse(response), proto.FromError(err)
@@ -85,6 +90,16 @@ func (g GRPCHandler) NotifyFailoverMarkers(ctx context.Context, request *history
 	return &historyv1.NotifyFailoverMarkersResponse{}, proto.FromError(err)
 }
 
+func (g GRPCHandler) PauseActivity(ctx context.Context, request *historyv1.PauseActivityRequest) (*historyv1.PauseActivityResponse, error) {
+	err := g.h.PauseActivity(ctx, proto.ToHistoryPauseActivityRequest(request))
+	return &historyv1.PauseActivityResponse{}, proto.FromError(err)
+}
+
+func (g GRPCHandler) PauseWorkflowExecution(ctx context.Context, request *historyv1.PauseWorkflowExecutionRequest) (*historyv1.PauseWorkflowExecutionResponse, error) {
+	err := g.h.PauseWorkflowExecution(ctx, proto.ToHistoryPauseWorkflowExecutionRequest(request))
+	return &historyv1.PauseWorkflowExecutionResponse{}, proto.FromError(err)
+}
+
 func (g GRPCHandler) PollMutableState(ctx context.Context, request *historyv1.PollMutableStateRequest) (*historyv1.PollMutableStateResponse, error) {
 	response, err := g.h.PollMutableState(ctx, proto.ToHistoryPollMutableStateRequest(request))
 	return proto.FromHistoryPollMutableStateResponse(response), proto.FromError(err)
@@ -150,16 +165,31 @@ func (g GRPCHandler) RemoveTask(ctx context.Context, request *historyv1.RemoveTa
 	return &historyv1.RemoveTaskResponse{}, proto.FromError(err)
 }
 
+func (g GRPCHandler) ReplicateDeleteWorkflowExecution(ctx context.Context, request *historyv1.ReplicateDeleteWorkflowExecutionRequest) (*historyv1.ReplicateDeleteWorkflowExecutionResponse, error) {
+	err := g.h.ReplicateDeleteWorkflowExecution(ctx, proto.ToHistoryReplicateDeleteWorkflowExecutionRequest(request))
+	return &historyv1.ReplicateDeleteWorkflowExecutionResponse{}, proto.FromError(err)
+}
+
 func (g GRPCHandler) ReplicateEventsV2(ctx context.Context, request *historyv1.ReplicateEventsV2Request) (*historyv1.ReplicateEventsV2Response, error) {
 	err := g.h.ReplicateEventsV2(ctx, proto.ToHistoryReplicateEventsV2Request(request))
 	return &historyv1.ReplicateEventsV2Response{}, proto.FromError(err)
 }
 
+func (g GRPCHandler) ReplicateUpsertWorkflowSearchAttributes(ctx context.Context, request *historyv1.ReplicateUpsertWorkflowSearchAttributesRequest) (*historyv1.ReplicateUpsertWorkflowSearchAttributesResponse, error) {
+	err := g.h.ReplicateUpsertWorkflowSearchAttributes(ctx, proto.ToHistoryReplicateUpsertWorkflowSearchAttributesRequest(request))
+	return &historyv1.ReplicateUpsertWorkflowSearchAttributesResponse{}, proto.FromError(err)
+}
+
 func (g GRPCHandler) RequestCancelWorkflowExecution(ctx context.Context, request *historyv1.RequestCancelWorkflowExecutionRequest) (*historyv1.RequestCancelWorkflowExecutionResponse, error) {
 	err := g.h.RequestCancelWorkflowExecution(ctx, proto.ToHistoryRequestCancelWorkflowExecutionRequest(request))
 	return &historyv1.RequestCancelWorkflowExecutionResponse{}, proto.FromError(err)
 }
 
+func (g GRPCHandler) ResetActivityAttempt(ctx context.Context, request *historyv1.ResetActivityAttemptRequest) (*historyv1.ResetActivityAttemptResponse, error) {
+	err := g.h.ResetActivityAttempt(ctx, proto.ToHistoryResetActivityAttemptRequest(request))
+	return &historyv1.ResetActivityAttemptResponse{}, proto.FromError(err)
+}
+
 func (g GRPCHandler) ResetQueue(ctx context.Context, request *historyv1.ResetQueueRequest) (*historyv1.ResetQueueResponse, error) {
 	err := g.h.ResetQueue(ctx, proto.ToHistoryResetQueueRequest(request))
 	return &historyv1.ResetQueueResponse{}, proto.FromError(err)
@@ -205,6 +235,11 @@ func (g GRPCHandler) RespondDecisionTaskFailed(ctx context.Context, request *his
 	return &historyv1.RespondDecisionTaskFailedResponse{}, proto.FromError(err)
 }
 
+func (g GRPCHandler) RetryActivityNow(ctx context.Context, request *historyv1.RetryActivityNowRequest) (*historyv1.RetryActivityNowResponse, error) {
+	err := g.h.RetryActivityNow(ctx, proto.ToHistoryRetryActivityNowRequest(request))
+	return &historyv1.RetryActivityNowResponse{}, proto.FromError(err)
+}
+
 func (g GRPCHandler) ScheduleDecisionTask(ctx context.Context, request *historyv1.ScheduleDecisionTaskRequest) (*historyv1.ScheduleDecisionTaskResponse, error) {
 	err := g.h.ScheduleDecisionTask(ctx, proto.ToHistoryScheduleDecisionTaskRequest(request))
 	return &historyv1.ScheduleDecisionTaskResponse{}, proto.FromError(err)
@@ -239,3 +274,23 @@ func (g GRPCHandler) TerminateWorkflowExecution(ctx context.Context, request *hi
 	err := g.h.TerminateWorkflowExecution(ctx, proto.ToHistoryTerminateWorkflowExecutionRequest(request))
 	return &historyv1.TerminateWorkflowExecutionResponse{}, proto.FromError(err)
 }
+
+func (g GRPCHandler) UnpauseActivity(ctx context.Context, request *historyv1.UnpauseActivityRequest) (*historyv1.UnpauseActivityResponse, error) {
+	err := g.h.UnpauseActivity(ctx, proto.ToHistoryUnpauseActivityRequest(request))
+	return &historyv1.UnpauseActivityResponse{}, proto.FromError(err)
+}
+
+func (g GRPCHandler) UnpauseWorkflowExecution(ctx context.Context, request *historyv1.UnpauseWorkflowExecutionRequest) (*historyv1.UnpauseWorkflowExecutionResponse, error) {
+	err := g.h.UnpauseWorkflowExecution(ctx, proto.ToHistoryUnpauseWorkflowExecutionRequest(request))
+	return &historyv1.UnpauseWorkflowExecutionResponse{}, proto.FromError(err)
+}
+
+func (g GRPCHandler) UpdateWorkflowExecution(ctx context.Context, request *historyv1.UpdateWorkflowExecutionRequest) (*historyv1.UpdateWorkflowExecutionResponse, error) {
+	response, err := g.h.UpdateWorkflowExecution(ctx, proto.ToHistoryUpdateWorkflowExecutionRequest(request))
+	return proto.FromHistoryUpdateWorkflowExecutionResponse(response), proto.FromError(err)
+}
+
+func (g GRPCHandler) UpsertWorkflowSearchAttributes(ctx context.Context, request *historyv1.UpsertWorkflowSearchAttributesRequest) (*historyv1.UpsertWorkflowSearchAttributesResponse, error) {
+	err := g.h.UpsertWorkflowSearchAttributes(ctx, proto.ToHistoryUpsertWorkflowSearchAttributesRequest(request))
+	return &historyv1.UpsertWorkflowSearchAttributesResponse{}, proto.FromError(err)
+}
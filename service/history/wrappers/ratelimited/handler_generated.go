@@ -50,6 +50,33 @@ func (h *historyHandler) CountDLQMessages(ctx context.Context, cp1 *types.CountD
 	return h.wrapped.CountDLQMessages(ctx, cp1)
 }
 
+func (h *historyHandler) DeleteWorkflowExecution(ctx context.Context, hp1 *types.HistoryDeleteWorkflowExecutionRequest) (err error) {
+
+	if hp1 == nil {
+		err = validate.ErrRequestNotSet
+		return
+	}
+
+	if hp1.GetDomainUUID() == "" {
+		err = validate.ErrDomainNotSet
+		return
+	}
+
+	if hp1.DeleteRequest.GetWorkflowExecution().GetWorkflowID() == "" {
+		err = validate.ErrWorkflowIDNotSet
+		return
+	}
+
+	if !h.allowFunc(hp1.GetDomainUUID(), hp1.DeleteRequest.GetWorkflowExecution().GetWorkflowID()) {
+		err = &types.ServiceBusyError{
+			Message: "Too many requests for the workflow ID",
+			Reason:  constants.WorkflowIDRateLimitReason,
+		}
+		return
+	}
+	return h.wrapped.DeleteWorkflowExecution(ctx, hp1)
+}
+
 func (h *historyHandler) DescribeHistoryHost(ctx context.Context, dp1 *types.DescribeHistoryHostRequest) (dp2 *types.DescribeHistoryHostResponse, err error) {
 	return h.wrapped.DescribeHistoryHost(ctx, dp1)
 }
@@ -121,6 +148,60 @@ func (h *historyHandler) NotifyFailoverMarkers(ctx context.Context, np1 *types.N
 	return h.wrapped.NotifyFailoverMarkers(ctx, np1)
 }
 
+func (h *historyHandler) PauseActivity(ctx context.Context, hp1 *types.HistoryPauseActivityRequest) (err error) {
+
+	if hp1 == nil {
+		err = validate.ErrRequestNotSet
+		return
+	}
+
+	if hp1.GetDomainUUID() == "" {
+		err = validate.ErrDomainNotSet
+		return
+	}
+
+	if hp1.PauseRequest.GetWorkflowExecution().GetWorkflowID() == "" {
+		err = validate.ErrWorkflowIDNotSet
+		return
+	}
+
+	if !h.allowFunc(hp1.GetDomainUUID(), hp1.PauseRequest.GetWorkflowExecution().GetWorkflowID()) {
+		err = &types.ServiceBusyError{
+			Message: "Too many requests for the workflow ID",
+			Reason:  constants.WorkflowIDRateLimitReason,
+		}
+		return
+	}
+	return h.wrapped.PauseActivity(ctx, hp1)
+}
+
+func (h *historyHandler) PauseWorkflowExecution(ctx context.Context, hp1 *types.HistoryPauseWorkflowExecutionRequest) (err error) {
+
+	if hp1 == nil {
+		err = validate.ErrRequestNotSet
+		return
+	}
+
+	if hp1.GetDomainUUID() == "" {
+		err = validate.ErrDomainNotSet
+		return
+	}
+
+	if hp1.PauseRequest.GetWorkflowExecution().GetWorkflowID() == "" {
+		err = validate.ErrWorkflowIDNotSet
+		return
+	}
+
+	if !h.allowFunc(hp1.GetDomainUUID(), hp1.PauseRequest.GetWorkflowExecution().GetWorkflowID()) {
+		err = &types.ServiceBusyError{
+			Message: "Too many requests for the workflow ID",
+			Reason:  constants.WorkflowIDRateLimitReason,
+		}
+		return
+	}
+	return h.wrapped.PauseWorkflowExecution(ctx, hp1)
+}
+
 func (h *historyHandler) PollMutableState(ctx context.Context, pp1 *types.PollMutableStateRequest) (pp2 *types.PollMutableStateResponse, err error) {
 	return h.wrapped.PollMutableState(ctx, pp1)
 }
@@ -177,14 +258,49 @@ func (h *historyHandler) RemoveTask(ctx context.Context, rp1 *types.RemoveTaskRe
 	return h.wrapped.RemoveTask(ctx, rp1)
 }
 
+func (h *historyHandler) ReplicateDeleteWorkflowExecution(ctx context.Context, dp1 *types.DeleteWorkflowExecutionTaskAttributes) (err error) {
+	return h.wrapped.ReplicateDeleteWorkflowExecution(ctx, dp1)
+}
+
 func (h *historyHandler) ReplicateEventsV2(ctx context.Context, rp1 *types.ReplicateEventsV2Request) (err error) {
 	return h.wrapped.ReplicateEventsV2(ctx, rp1)
 }
 
+func (h *historyHandler) ReplicateUpsertWorkflowSearchAttributes(ctx context.Context, up1 *types.UpsertWorkflowSearchAttributesTaskAttributes) (err error) {
+	return h.wrapped.ReplicateUpsertWorkflowSearchAttributes(ctx, up1)
+}
+
 func (h *historyHandler) RequestCancelWorkflowExecution(ctx context.Context, hp1 *types.HistoryRequestCancelWorkflowExecutionRequest) (err error) {
 	return h.wrapped.RequestCancelWorkflowExecution(ctx, hp1)
 }
 
+func (h *historyHandler) ResetActivityAttempt(ctx context.Context, hp1 *types.HistoryResetActivityAttemptRequest) (err error) {
+
+	if hp1 == nil {
+		err = validate.ErrRequestNotSet
+		return
+	}
+
+	if hp1.GetDomainUUID() == "" {
+		err = validate.ErrDomainNotSet
+		return
+	}
+
+	if hp1.ResetRequest.GetWorkflowExecution().GetWorkflowID() == "" {
+		err = validate.ErrWorkflowIDNotSet
+		return
+	}
+
+	if !h.allowFunc(hp1.GetDomainUUID(), hp1.ResetRequest.GetWorkflowExecution().GetWorkflowID()) {
+		err = &types.ServiceBusyError{
+			Message: "Too many requests for the workflow ID",
+			Reason:  constants.WorkflowIDRateLimitReason,
+		}
+		return
+	}
+	return h.wrapped.ResetActivityAttempt(ctx, hp1)
+}
+
 func (h *historyHandler) ResetQueue(ctx context.Context, rp1 *types.ResetQueueRequest) (err error) {
 	return h.wrapped.ResetQueue(ctx, rp1)
 }
@@ -221,6 +337,33 @@ func (h *historyHandler) RespondDecisionTaskFailed(ctx context.Context, hp1 *typ
 	return h.wrapped.RespondDecisionTaskFailed(ctx, hp1)
 }
 
+func (h *historyHandler) RetryActivityNow(ctx context.Context, hp1 *types.HistoryRetryActivityNowRequest) (err error) {
+
+	if hp1 == nil {
+		err = validate.ErrRequestNotSet
+		return
+	}
+
+	if hp1.GetDomainUUID() == "" {
+		err = validate.ErrDomainNotSet
+		return
+	}
+
+	if hp1.RetryRequest.GetWorkflowExecution().GetWorkflowID() == "" {
+		err = validate.ErrWorkflowIDNotSet
+		return
+	}
+
+	if !h.allowFunc(hp1.GetDomainUUID(), hp1.RetryRequest.GetWorkflowExecution().GetWorkflowID()) {
+		err = &types.ServiceBusyError{
+			Message: "Too many requests for the workflow ID",
+			Reason:  constants.WorkflowIDRateLimitReason,
+		}
+		return
+	}
+	return h.wrapped.RetryActivityNow(ctx, hp1)
+}
+
 func (h *historyHandler) ScheduleDecisionTask(ctx context.Context, sp1 *types.ScheduleDecisionTaskRequest) (err error) {
 	return h.wrapped.ScheduleDecisionTask(ctx, sp1)
 }
@@ -327,3 +470,88 @@ func (h *historyHandler) SyncShardStatus(ctx context.Context, sp1 *types.SyncSha
 func (h *historyHandler) TerminateWorkflowExecution(ctx context.Context, hp1 *types.HistoryTerminateWorkflowExecutionRequest) (err error) {
 	return h.wrapped.TerminateWorkflowExecution(ctx, hp1)
 }
+
+func (h *historyHandler) UnpauseActivity(ctx context.Context, hp1 *types.HistoryUnpauseActivityRequest) (err error) {
+
+	if hp1 == nil {
+		err = validate.ErrRequestNotSet
+		return
+	}
+
+	if hp1.GetDomainUUID() == "" {
+		err = validate.ErrDomainNotSet
+		return
+	}
+
+	if hp1.UnpauseRequest.GetWorkflowExecution().GetWorkflowID() == "" {
+		err = validate.ErrWorkflowIDNotSet
+		return
+	}
+
+	if !h.allowFunc(hp1.GetDomainUUID(), hp1.UnpauseRequest.GetWorkflowExecution().GetWorkflowID()) {
+		err = &types.ServiceBusyError{
+			Message: "Too many requests for the workflow ID",
+			Reason:  constants.WorkflowIDRateLimitReason,
+		}
+		return
+	}
+	return h.wrapped.UnpauseActivity(ctx, hp1)
+}
+
+func (h *historyHandler) UnpauseWorkflowExecution(ctx context.Context, hp1 *types.HistoryUnpauseWorkflowExecutionRequest) (err error) {
+
+	if hp1 == nil {
+		err = validate.ErrRequestNotSet
+		return
+	}
+
+	if hp1.GetDomainUUID() == "" {
+		err = validate.ErrDomainNotSet
+		return
+	}
+
+	if hp1.UnpauseRequest.GetWorkflowExecution().GetWorkflowID() == "" {
+		err = validate.ErrWorkflowIDNotSet
+		return
+	}
+
+	if !h.allowFunc(hp1.GetDomainUUID(), hp1.UnpauseRequest.GetWorkflowExecution().GetWorkflowID()) {
+		err = &types.ServiceBusyError{
+			Message: "Too many requests for the workflow ID",
+			Reason:  constants.WorkflowIDRateLimitReason,
+		}
+		return
+	}
+	return h.wrapped.UnpauseWorkflowExecution(ctx, hp1)
+}
+
+func (h *historyHandler) UpdateWorkflowExecution(ctx context.Context, hp1 *types.HistoryUpdateWorkflowExecutionRequest) (up1 *types.UpdateWorkflowExecutionResponse, err error) {
+
+	if hp1 == nil {
+		err = validate.ErrRequestNotSet
+		return
+	}
+
+	if hp1.GetDomainUUID() == "" {
+		err = validate.ErrDomainNotSet
+		return
+	}
+
+	if hp1.UpdateRequest.GetWorkflowExecution().GetWorkflowID() == "" {
+		err = validate.ErrWorkflowIDNotSet
+		return
+	}
+
+	if !h.allowFunc(hp1.GetDomainUUID(), hp1.UpdateRequest.GetWorkflowExecution().GetWorkflowID()) {
+		err = &types.ServiceBusyError{
+			Message: "Too many requests for the workflow ID",
+			Reason:  constants.WorkflowIDRateLimitReason,
+		}
+		return
+	}
+	return h.wrapped.UpdateWorkflowExecution(ctx, hp1)
+}
+
+func (h *historyHandler) UpsertWorkflowSearchAttributes(ctx context.Context, hp1 *types.HistoryUpsertWorkflowSearchAttributesRequest) (err error) {
+	return h.wrapped.UpsertWorkflowSearchAttributes(ctx, hp1)
+}
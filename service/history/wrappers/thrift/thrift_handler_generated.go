@@ -18,6 +18,11 @@ func (g ThriftHandler) CloseShard(ctx context.Context, Request *shared.CloseShar
 	return mapper.FromError(err)
 }
 
+func (g ThriftHandler) DeleteWorkflowExecution(ctx context.Context, DeleteRequest *history.DeleteWorkflowExecutionRequest) (err error) {
+	err = g.h.DeleteWorkflowExecution(ctx, mapper.ToHistoryDeleteWorkflowExecutionRequest(DeleteRequest))
+	return mapper.FromError(err)
+}
+
 func (g ThriftHandler) DescribeHistoryHost(ctx context.Context, Request *shared.DescribeHistoryHostRequest) (dp1 *shared.DescribeHistoryHostResponse, err error) {
 	response, err := g.h.DescribeHistoryHost(ctx, mapper.ToHistoryDescribeHistoryHostRequest(Request))
 	return mapper.FromHistoryDescribeHistoryHostResponse(response), mapper.FromError(err)
@@ -73,6 +78,16 @@ func (g ThriftHandler) NotifyFailoverMarkers(ctx context.Context, Request *histo
 	return mapper.FromError(err)
 }
 
+func (g ThriftHandler) PauseActivity(ctx context.Context, PauseRequest *history.PauseActivityRequest) (err error) {
+	err = g.h.PauseActivity(ctx, mapper.ToHistoryPauseActivityRequest(PauseRequest))
+	return mapper.FromError(err)
+}
+
+func (g ThriftHandler) PauseWorkflowExecution(ctx context.Context, PauseRequest *history.PauseWorkflowExecutionRequest) (err error) {
+	err = g.h.PauseWorkflowExecution(ctx, mapper.ToHistoryPauseWorkflowExecutionRequest(PauseRequest))
+	return mapper.FromError(err)
+}
+
 func (g ThriftHandler) PollMutableState(ctx context.Context, PollRequest *history.PollMutableStateRequest) (pp1 *history.PollMutableStateResponse, err error) {
 	response, err := g.h.PollMutableState(ctx, mapper.ToHistoryPollMutableStateRequest(PollRequest))
 	return mapper.FromHistoryPollMutableStateResponse(response), mapper.FromError(err)
@@ -138,16 +153,31 @@ func (g ThriftHandler) RemoveTask(ctx context.Context, Request *shared.RemoveTas
 	return mapper.FromError(err)
 }
 
+func (g ThriftHandler) ReplicateDeleteWorkflowExecution(ctx context.Context, Request *replicator.DeleteWorkflowExecutionTaskAttributes) (err error) {
+	err = g.h.ReplicateDeleteWorkflowExecution(ctx, mapper.ToHistoryReplicateDeleteWorkflowExecutionRequest(Request))
+	return mapper.FromError(err)
+}
+
 func (g ThriftHandler) ReplicateEventsV2(ctx context.Context, ReplicateV2Request *history.ReplicateEventsV2Request) (err error) {
 	err = g.h.ReplicateEventsV2(ctx, mapper.ToHistoryReplicateEventsV2Request(ReplicateV2Request))
 	return mapper.FromError(err)
 }
 
+func (g ThriftHandler) ReplicateUpsertWorkflowSearchAttributes(ctx context.Context, Request *replicator.UpsertWorkflowSearchAttributesTaskAttributes) (err error) {
+	err = g.h.ReplicateUpsertWorkflowSearchAttributes(ctx, mapper.ToHistoryReplicateUpsertWorkflowSearchAttributesRequest(Request))
+	return mapper.FromError(err)
+}
+
 func (g ThriftHandler) RequestCancelWorkflowExecution(ctx context.Context, CancelRequest *history.RequestCancelWorkflowExecutionRequest) (err error) {
 	err = g.h.RequestCancelWorkflowExecution(ctx, mapper.ToHistoryRequestCancelWorkflowExecutionRequest(CancelRequest))
 	return mapper.FromError(err)
 }
 
+func (g ThriftHandler) ResetActivityAttempt(ctx context.Context, ResetRequest *history.ResetActivityAttemptRequest) (err error) {
+	err = g.h.ResetActivityAttempt(ctx, mapper.ToHistoryResetActivityAttemptRequest(ResetRequest))
+	return mapper.FromError(err)
+}
+
 func (g ThriftHandler) ResetQueue(ctx context.Context, Request *shared.ResetQueueRequest) (err error) {
 	err = g.h.ResetQueue(ctx, mapper.ToHistoryResetQueueRequest(Request))
 	return mapper.FromError(err)
@@ -193,6 +223,11 @@ func (g ThriftHandler) RespondDecisionTaskFailed(ctx context.Context, FailedRequ
 	return mapper.FromError(err)
 }
 
+func (g ThriftHandler) RetryActivityNow(ctx context.Context, RetryRequest *history.RetryActivityNowRequest) (err error) {
+	err = g.h.RetryActivityNow(ctx, mapper.ToHistoryRetryActivityNowRequest(RetryRequest))
+	return mapper.FromError(err)
+}
+
 func (g ThriftHandler) ScheduleDecisionTask(ctx context.Context, ScheduleRequest *history.ScheduleDecisionTaskRequest) (err error) {
 	err = g.h.ScheduleDecisionTask(ctx, mapper.ToHistoryScheduleDecisionTaskRequest(ScheduleRequest))
 	return mapper.FromError(err)
@@ -227,3 +262,23 @@ func (g ThriftHandler) TerminateWorkflowExecution(ctx context.Context, Terminate
 	err = g.h.TerminateWorkflowExecution(ctx, mapper.ToHistoryTerminateWorkflowExecutionRequest(TerminateRequest))
 	return mapper.FromError(err)
 }
+
+func (g ThriftHandler) UnpauseActivity(ctx context.Context, UnpauseRequest *history.UnpauseActivityRequest) (err error) {
+	err = g.h.UnpauseActivity(ctx, mapper.ToHistoryUnpauseActivityRequest(UnpauseRequest))
+	return mapper.FromError(err)
+}
+
+func (g ThriftHandler) UnpauseWorkflowExecution(ctx context.Context, UnpauseRequest *history.UnpauseWorkflowExecutionRequest) (err error) {
+	err = g.h.UnpauseWorkflowExecution(ctx, mapper.ToHistoryUnpauseWorkflowExecutionRequest(UnpauseRequest))
+	return mapper.FromError(err)
+}
+
+func (g ThriftHandler) UpdateWorkflowExecution(ctx context.Context, UpdateRequest *history.UpdateWorkflowExecutionRequest) (up1 *shared.UpdateWorkflowExecutionResponse, err error) {
+	response, err := g.h.UpdateWorkflowExecution(ctx, mapper.ToHistoryUpdateWorkflowExecutionRequest(UpdateRequest))
+	return mapper.FromHistoryUpdateWorkflowExecutionResponse(response), mapper.FromError(err)
+}
+
+func (g ThriftHandler) UpsertWorkflowSearchAttributes(ctx context.Context, UpsertRequest *history.UpsertWorkflowSearchAttributesRequest) (err error) {
+	err = g.h.UpsertWorkflowSearchAttributes(ctx, mapper.ToHistoryUpsertWorkflowSearchAttributesRequest(UpsertRequest))
+	return mapper.FromError(err)
+}
@@ -205,6 +205,11 @@ func TestThriftHandler(t *testing.T) {
 		err := th.RemoveTask(ctx, &shared.RemoveTaskRequest{})
 		assert.Equal(t, expectedErr, err)
 	})
+	t.Run("ReplicateDeleteWorkflowExecution", func(t *testing.T) {
+		h.EXPECT().ReplicateDeleteWorkflowExecution(ctx, &types.DeleteWorkflowExecutionTaskAttributes{}).Return(internalErr).Times(1)
+		err := th.ReplicateDeleteWorkflowExecution(ctx, &replicator.DeleteWorkflowExecutionTaskAttributes{})
+		assert.Equal(t, expectedErr, err)
+	})
 	t.Run("ReplicateEventsV2", func(t *testing.T) {
 		h.EXPECT().ReplicateEventsV2(ctx, &types.ReplicateEventsV2Request{}).Return(internalErr).Times(1)
 		err := th.ReplicateEventsV2(ctx, &hist.ReplicateEventsV2Request{})
@@ -301,4 +306,55 @@ func TestThriftHandler(t *testing.T) {
 		err := th.TerminateWorkflowExecution(ctx, &hist.TerminateWorkflowExecutionRequest{})
 		assert.Equal(t, expectedErr, err)
 	})
+	t.Run("UpdateWorkflowExecution", func(t *testing.T) {
+		h.EXPECT().UpdateWorkflowExecution(ctx, &types.HistoryUpdateWorkflowExecutionRequest{}).Return(&types.UpdateWorkflowExecutionResponse{}, internalErr).Times(1)
+		resp, err := th.UpdateWorkflowExecution(ctx, &hist.UpdateWorkflowExecutionRequest{})
+		assert.Equal(t, shared.UpdateWorkflowExecutionResponse{UpdateId: common.StringPtr("")}, *resp)
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("PauseWorkflowExecution", func(t *testing.T) {
+		h.EXPECT().PauseWorkflowExecution(ctx, &types.HistoryPauseWorkflowExecutionRequest{}).Return(internalErr).Times(1)
+		err := th.PauseWorkflowExecution(ctx, &hist.PauseWorkflowExecutionRequest{})
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("UnpauseWorkflowExecution", func(t *testing.T) {
+		h.EXPECT().UnpauseWorkflowExecution(ctx, &types.HistoryUnpauseWorkflowExecutionRequest{}).Return(internalErr).Times(1)
+		err := th.UnpauseWorkflowExecution(ctx, &hist.UnpauseWorkflowExecutionRequest{})
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("PauseActivity", func(t *testing.T) {
+		h.EXPECT().PauseActivity(ctx, &types.HistoryPauseActivityRequest{}).Return(internalErr).Times(1)
+		err := th.PauseActivity(ctx, &hist.PauseActivityRequest{})
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("UnpauseActivity", func(t *testing.T) {
+		h.EXPECT().UnpauseActivity(ctx, &types.HistoryUnpauseActivityRequest{}).Return(internalErr).Times(1)
+		err := th.UnpauseActivity(ctx, &hist.UnpauseActivityRequest{})
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("ResetActivityAttempt", func(t *testing.T) {
+		h.EXPECT().ResetActivityAttempt(ctx, &types.HistoryResetActivityAttemptRequest{}).Return(internalErr).Times(1)
+		err := th.ResetActivityAttempt(ctx, &hist.ResetActivityAttemptRequest{})
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("RetryActivityNow", func(t *testing.T) {
+		h.EXPECT().RetryActivityNow(ctx, &types.HistoryRetryActivityNowRequest{}).Return(internalErr).Times(1)
+		err := th.RetryActivityNow(ctx, &hist.RetryActivityNowRequest{})
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("DeleteWorkflowExecution", func(t *testing.T) {
+		h.EXPECT().DeleteWorkflowExecution(ctx, &types.HistoryDeleteWorkflowExecutionRequest{}).Return(internalErr).Times(1)
+		err := th.DeleteWorkflowExecution(ctx, &hist.DeleteWorkflowExecutionRequest{})
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("UpsertWorkflowSearchAttributes", func(t *testing.T) {
+		h.EXPECT().UpsertWorkflowSearchAttributes(ctx, &types.HistoryUpsertWorkflowSearchAttributesRequest{}).Return(internalErr).Times(1)
+		err := th.UpsertWorkflowSearchAttributes(ctx, &hist.UpsertWorkflowSearchAttributesRequest{})
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("ReplicateUpsertWorkflowSearchAttributes", func(t *testing.T) {
+		h.EXPECT().ReplicateUpsertWorkflowSearchAttributes(ctx, &types.UpsertWorkflowSearchAttributesTaskAttributes{}).Return(internalErr).Times(1)
+		err := th.ReplicateUpsertWorkflowSearchAttributes(ctx, &replicator.UpsertWorkflowSearchAttributesTaskAttributes{})
+		assert.Equal(t, expectedErr, err)
+	})
 }
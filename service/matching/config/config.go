@@ -71,6 +71,10 @@ type (
 		EnablePartitionEmptyCheck                 dynamicproperties.BoolPropertyFnWithTaskListInfoFilters
 		EnableStandbyTaskCompletion               dynamicproperties.BoolPropertyFnWithTaskListInfoFilters
 		EnableClientAutoConfig                    dynamicproperties.BoolPropertyFnWithTaskListInfoFilters
+		EnableTaskPriority                        dynamicproperties.BoolPropertyFnWithTaskListInfoFilters
+		TaskPriorityRoundRobinWeights             dynamicproperties.MapPropertyFn
+		EnableTaskFairness                        dynamicproperties.BoolPropertyFnWithTaskListInfoFilters
+		TaskFairnessKeyWeights                    dynamicproperties.MapPropertyFnWithDomainFilter
 		QPSTrackerInterval                        dynamicproperties.DurationPropertyFnWithTaskListInfoFilters
 		OverrideTaskListRPS                       dynamicproperties.FloatPropertyFnWithTaskListInfoFilters
 		EnablePartitionIsolationGroupAssignment   dynamicproperties.BoolPropertyFnWithTaskListInfoFilters
@@ -177,6 +181,11 @@ type (
 		// standby task completion configuration
 		EnableStandbyTaskCompletion func() bool
 		EnableClientAutoConfig      func() bool
+		// task priority and fairness configuration
+		EnableTaskPriority            func() bool
+		TaskPriorityRoundRobinWeights dynamicproperties.MapPropertyFn
+		EnableTaskFairness            func() bool
+		TaskFairnessKeyWeights        func() map[string]interface{}
 	}
 )
 
@@ -243,6 +252,10 @@ func NewConfig(dc *dynamicconfig.Collection, operationalDC *dynamicconfig.Collec
 		AllIsolationGroups:                         getIsolationGroups,
 		EnableStandbyTaskCompletion:                dc.GetBoolPropertyFilteredByTaskListInfo(dynamicproperties.MatchingEnableStandbyTaskCompletion),
 		EnableClientAutoConfig:                     dc.GetBoolPropertyFilteredByTaskListInfo(dynamicproperties.MatchingEnableClientAutoConfig),
+		EnableTaskPriority:                         dc.GetBoolPropertyFilteredByTaskListInfo(dynamicproperties.MatchingEnableTaskPriority),
+		TaskPriorityRoundRobinWeights:              dc.GetMapProperty(dynamicproperties.MatchingTaskPriorityRoundRobinWeights),
+		EnableTaskFairness:                         dc.GetBoolPropertyFilteredByTaskListInfo(dynamicproperties.MatchingEnableTaskFairness),
+		TaskFairnessKeyWeights:                     dc.GetMapPropertyFilteredByDomain(dynamicproperties.MatchingTaskFairnessKeyWeights),
 		EnableReturnAllTaskListKinds:               dc.GetBoolProperty(dynamicproperties.MatchingEnableReturnAllTaskListKinds),
 		ExcludeShortLivedTaskListsFromShardManager: operationalDC.GetBoolProperty(dynamicproperties.MatchingExcludeShortLivedTaskListsFromShardManager),
 		RecordTaskStartedTimeout:                   dc.GetDurationPropertyFilteredByDomain(dynamicproperties.MatchingRecordTaskStartedTimeout),
@@ -96,6 +96,10 @@ func TestNewConfig(t *testing.T) {
 		"OverrideTaskListRPS":                       {dynamicproperties.MatchingOverrideTaskListRPS, 1500.0},
 		"EnableStandbyTaskCompletion":               {dynamicproperties.MatchingEnableStandbyTaskCompletion, false},
 		"EnableClientAutoConfig":                    {dynamicproperties.MatchingEnableClientAutoConfig, false},
+		"EnableTaskPriority":                        {dynamicproperties.MatchingEnableTaskPriority, true},
+		"TaskPriorityRoundRobinWeights":             {dynamicproperties.MatchingTaskPriorityRoundRobinWeights, map[string]interface{}{"1": 10, "5": 1}},
+		"EnableTaskFairness":                        {dynamicproperties.MatchingEnableTaskFairness, true},
+		"TaskFairnessKeyWeights":                    {dynamicproperties.MatchingTaskFairnessKeyWeights, map[string]interface{}{"tenant-a": 2}},
 		"TaskIsolationDuration":                     {dynamicproperties.TaskIsolationDuration, time.Duration(35)},
 		"TaskIsolationPollerWindow":                 {dynamicproperties.TaskIsolationPollerWindow, time.Duration(36)},
 		"EnablePartitionIsolationGroupAssignment":   {dynamicproperties.EnablePartitionIsolationGroupAssignment, true},
@@ -188,6 +192,8 @@ func getValue(f *reflect.Value) interface{} {
 			return fn()
 		case dynamicproperties.MapPropertyFn:
 			return fn()
+		case dynamicproperties.MapPropertyFnWithDomainFilter:
+			return fn("domain")
 		case dynamicproperties.StringPropertyFn:
 			return fn()
 		case dynamicproperties.FloatPropertyFnWithTaskListInfoFilters:
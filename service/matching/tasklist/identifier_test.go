@@ -117,6 +117,9 @@ func TestInvalidTasklistNames(t *testing.T) {
 		"/__cadence_sys/list0",
 		"/__cadence_sys/list0/0",
 		"/__cadence_sys/list0/-1",
+		// the backlogs of the priority levels and fairness buckets of a task list are not partitions
+		"/__cadence_sys/list0/__backlog_p1_f0",
+		"/__cadence_sys/list0/2/__backlog_p5_f3",
 	}
 	for _, name := range inputs {
 		t.Run(name, func(t *testing.T) {
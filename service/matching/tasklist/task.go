@@ -23,6 +23,7 @@ package tasklist
 import (
 	"github.com/uber/cadence/common/isolationgroup"
 	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/taskpriority"
 	"github.com/uber/cadence/common/types"
 )
 
@@ -94,6 +95,9 @@ func newInternalTask(
 		}
 		partitionConfig[isolationgroup.GroupKey] = isolationGroup
 		partitionConfig[isolationgroup.WorkflowIDKey] = task.Event.PartitionConfig[isolationgroup.WorkflowIDKey]
+		if priority, ok := task.Event.PartitionConfig[taskpriority.PartitionConfigKey]; ok {
+			partitionConfig[taskpriority.PartitionConfigKey] = priority
+		}
 		task.Event.PartitionConfig = partitionConfig
 	}
 	return task
@@ -137,6 +141,14 @@ func (task *InternalTask) IsSyncMatch() bool {
 	return task.ResponseC != nil
 }
 
+// Priority returns the priority of an activity or decision task, other tasks have the default priority
+func (task *InternalTask) Priority() int32 {
+	if task.Event == nil || task.Event.TaskInfo == nil {
+		return taskpriority.Default
+	}
+	return taskpriority.FromPartitionConfig(task.Event.PartitionConfig)
+}
+
 func (task *InternalTask) Info() persistence.TaskInfo {
 	if task == nil || task.Event == nil || task.Event.TaskInfo == nil {
 		return persistence.TaskInfo{}
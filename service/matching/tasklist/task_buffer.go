@@ -0,0 +1,434 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tasklist
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/task"
+	"github.com/uber/cadence/common/taskpriority"
+	"github.com/uber/cadence/service/matching/config"
+)
+
+const (
+	// taskBufferIdleChannelTTLInSeconds is how long the channel of a fairness key is kept around after
+	// its last task was buffered, so that keys of short-lived tenants do not pile up in memory
+	taskBufferIdleChannelTTLInSeconds = 300
+
+	// taskBufferWeightsRefreshInterval is how long the round robin weights read from dynamic config
+	// are reused before being read again
+	taskBufferWeightsRefreshInterval = 10 * time.Second
+)
+
+type (
+	// taskBuffer is the in-memory queue of backlog tasks of a single isolation group
+	taskBuffer interface {
+		start()
+		stop()
+		// add blocks until there is room for the task, it returns false if the context is done first.
+		// The reader is the one which read the task from its persisted backlog and has to complete it.
+		add(ctx context.Context, info *persistence.TaskInfo, reader *taskReader) bool
+		// next blocks until a task is available, it returns false if the context is done first
+		next(ctx context.Context) (*bufferedTask, bool)
+		// len returns the number of buffered tasks
+		len() int
+		// cap returns the maximum number of buffered tasks of a priority level
+		cap() int
+		// hasTasksAbove returns true if tasks with a higher priority than the given one are buffered
+		hasTasksAbove(priority int32) bool
+		// fairnessKeyCounts returns the number of buffered tasks per fairness key
+		fairnessKeyCounts() map[string]int64
+	}
+
+	bufferedTask struct {
+		info        *persistence.TaskInfo
+		reader      *taskReader
+		priority    int32
+		fairnessKey string
+	}
+
+	// fifoTaskBuffer dispatches tasks in the order they were read, it is used when neither task
+	// priority nor task fairness is enabled for the task list
+	fifoTaskBuffer struct {
+		tasks chan *bufferedTask
+	}
+
+	// weightedTaskBuffer queues tasks per priority level and, within a level, per fairness key.
+	// Dequeue first picks a level with weighted round robin so that higher priorities are dispatched
+	// first without starving the lower ones, then picks a fairness key of that level with weighted
+	// round robin so that a single tenant cannot hold back the tasks of the others.
+	// When task priority is disabled every task is queued at the default level, and when task
+	// fairness is disabled every task is queued under the empty key.
+	weightedTaskBuffer struct {
+		enablePriority bool
+		enableFairness bool
+
+		// levels holds one token per buffered task, carrying the priority level it was queued at
+		levels *task.WeightedRoundRobinChannelPool[int32, int32]
+		// keys holds the buffered tasks of each priority level, per fairness key
+		keys [taskpriority.Lowest + 1]*task.WeightedRoundRobinChannelPool[string, *bufferedTask]
+		// slots bounds the number of buffered tasks of each priority level, so that the backlog
+		// of a level does not hold back the reads of the others
+		slots   [taskpriority.Lowest + 1]chan struct{}
+		notifyC chan struct{}
+		counts  [taskpriority.Lowest + 1]atomic.Int64
+		size    atomic.Int64
+
+		keyCountsLock sync.Mutex
+		keyCounts     map[string]int64
+
+		weights    atomic.Pointer[taskBufferWeights]
+		config     *config.TaskListConfig
+		logger     log.Logger
+		timeSource clock.TimeSource
+
+		// iteration state, only used by the single dispatcher of the buffer
+		levelCursor scheduleCursor[int32]
+		keyCursors  [taskpriority.Lowest + 1]scheduleCursor[*bufferedTask]
+	}
+
+	// taskBufferWeights is a snapshot of the round robin weights from dynamic config
+	taskBufferWeights struct {
+		priorities   map[int]int
+		fairnessKeys map[string]int
+		expiry       time.Time
+	}
+
+	// scheduleCursor resumes the iteration of a weighted round robin schedule where the previous
+	// call stopped so that the weights are honoured across calls
+	scheduleCursor[V any] struct {
+		schedule task.Schedule[*task.TTLChannel[V]]
+		iter     task.Iterator[*task.TTLChannel[V]]
+	}
+)
+
+// newTaskBuffer returns a weighted buffer if task priority or fairness is enabled for the task
+// list and a FIFO one otherwise. Like isolation, this is decided once per task list load.
+func newTaskBuffer(
+	capacity int,
+	config *config.TaskListConfig,
+	logger log.Logger,
+	scope metrics.Scope,
+	timeSource clock.TimeSource,
+) taskBuffer {
+	enablePriority, enableFairness := config.EnableTaskPriority(), config.EnableTaskFairness()
+	if !enablePriority && !enableFairness {
+		return newFIFOTaskBuffer(capacity)
+	}
+	return newWeightedTaskBuffer(capacity, enablePriority, enableFairness, config, logger, scope, timeSource)
+}
+
+func newFIFOTaskBuffer(capacity int) *fifoTaskBuffer {
+	return &fifoTaskBuffer{tasks: make(chan *bufferedTask, capacity)}
+}
+
+func (b *fifoTaskBuffer) start() {}
+
+func (b *fifoTaskBuffer) stop() {}
+
+func (b *fifoTaskBuffer) add(ctx context.Context, info *persistence.TaskInfo, reader *taskReader) bool {
+	select {
+	case b.tasks <- &bufferedTask{info: info, reader: reader, priority: taskpriority.Default}:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+func (b *fifoTaskBuffer) next(ctx context.Context) (*bufferedTask, bool) {
+	select {
+	case t := <-b.tasks:
+		return t, true
+	case <-ctx.Done():
+		return nil, false
+	}
+}
+
+func (b *fifoTaskBuffer) len() int {
+	return len(b.tasks)
+}
+
+func (b *fifoTaskBuffer) cap() int {
+	return cap(b.tasks)
+}
+
+func (b *fifoTaskBuffer) hasTasksAbove(int32) bool {
+	return false
+}
+
+func (b *fifoTaskBuffer) fairnessKeyCounts() map[string]int64 {
+	return nil
+}
+
+func newWeightedTaskBuffer(
+	capacity int,
+	enablePriority bool,
+	enableFairness bool,
+	config *config.TaskListConfig,
+	logger log.Logger,
+	scope metrics.Scope,
+	timeSource clock.TimeSource,
+) *weightedTaskBuffer {
+	if capacity < 1 {
+		// an unbuffered slot would never be handed over, as tasks are only released once dequeued
+		capacity = 1
+	}
+	b := &weightedTaskBuffer{
+		enablePriority: enablePriority,
+		enableFairness: enableFairness,
+		// each channel can hold the whole capacity of its level, the slots enforce the limit
+		levels: task.NewWeightedRoundRobinChannelPool[int32, int32](
+			logger,
+			scope,
+			timeSource,
+			task.WeightedRoundRobinChannelPoolOptions{BufferSize: capacity},
+		),
+		notifyC:    make(chan struct{}, 1),
+		keyCounts:  make(map[string]int64),
+		config:     config,
+		logger:     logger,
+		timeSource: timeSource,
+	}
+	for _, p := range taskpriority.Levels() {
+		b.slots[p] = make(chan struct{}, capacity)
+		b.keys[p] = task.NewWeightedRoundRobinChannelPool[string, *bufferedTask](
+			logger,
+			scope,
+			timeSource,
+			task.WeightedRoundRobinChannelPoolOptions{
+				BufferSize:              capacity,
+				IdleChannelTTLInSeconds: taskBufferIdleChannelTTLInSeconds,
+			},
+		)
+	}
+	return b
+}
+
+// start starts the cleanup of idle fairness key channels
+func (b *weightedTaskBuffer) start() {
+	for _, p := range taskpriority.Levels() {
+		b.keys[p].Start()
+	}
+}
+
+// stop stops the cleanup of idle fairness key channels
+func (b *weightedTaskBuffer) stop() {
+	for _, p := range taskpriority.Levels() {
+		b.keys[p].Stop()
+	}
+}
+
+func (b *weightedTaskBuffer) add(ctx context.Context, info *persistence.TaskInfo, reader *taskReader) bool {
+	t := &bufferedTask{
+		info:        info,
+		reader:      reader,
+		priority:    taskpriority.Default,
+		fairnessKey: taskpriority.FairnessKeyFromPartitionConfig(info.PartitionConfig),
+	}
+	if b.enablePriority {
+		t.priority = taskpriority.FromPartitionConfig(info.PartitionConfig)
+	}
+	queueKey := ""
+	if b.enableFairness {
+		queueKey = t.fairnessKey
+	}
+
+	select {
+	case b.slots[t.priority] <- struct{}{}:
+	case <-ctx.Done():
+		return false
+	}
+
+	b.counts[t.priority].Add(1)
+	b.size.Add(1)
+	b.keyCountsLock.Lock()
+	b.keyCounts[t.fairnessKey]++
+	b.keyCountsLock.Unlock()
+
+	// none of the sends below block, a slot was acquired and every channel can hold the whole capacity of its level.
+	// The task must be queued before its token so that the dispatcher always finds it.
+	ch, release := b.keys[t.priority].GetOrCreateChannel(queueKey, b.getFairnessKeyWeight(queueKey))
+	ch <- t
+	release()
+	levelCh, levelRelease := b.levels.GetOrCreateChannel(t.priority, b.getPriorityWeight(t.priority))
+	levelCh <- t.priority
+	levelRelease()
+
+	select {
+	case b.notifyC <- struct{}{}:
+	default:
+	}
+	return true
+}
+
+func (b *weightedTaskBuffer) next(ctx context.Context) (*bufferedTask, bool) {
+	for {
+		if t, ok := b.tryNext(); ok {
+			<-b.slots[t.priority]
+			b.counts[t.priority].Add(-1)
+			b.size.Add(-1)
+			b.keyCountsLock.Lock()
+			if b.keyCounts[t.fairnessKey]--; b.keyCounts[t.fairnessKey] <= 0 {
+				delete(b.keyCounts, t.fairnessKey)
+			}
+			b.keyCountsLock.Unlock()
+			return t, true
+		}
+		select {
+		case <-b.notifyC:
+		case <-ctx.Done():
+			return nil, false
+		}
+	}
+}
+
+func (b *weightedTaskBuffer) tryNext() (*bufferedTask, bool) {
+	priority, ok := b.levelCursor.tryNext(b.levels.GetSchedule())
+	if !ok {
+		return nil, false
+	}
+	t, ok := b.keyCursors[priority].tryNext(b.keys[priority].GetSchedule())
+	if !ok {
+		// cannot happen as tasks are queued before their token, the slot of the task is leaked
+		b.logger.Error("buffered task not found for its priority level", tag.Dynamic("priority", priority))
+		return nil, false
+	}
+	return t, true
+}
+
+func (b *weightedTaskBuffer) len() int {
+	return int(b.size.Load())
+}
+
+func (b *weightedTaskBuffer) cap() int {
+	return cap(b.slots[taskpriority.Default])
+}
+
+func (b *weightedTaskBuffer) hasTasksAbove(priority int32) bool {
+	for p := taskpriority.Highest; p < priority && p <= taskpriority.Lowest; p++ {
+		if b.counts[p].Load() > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// fairnessKeyCounts counts the tasks without a fairness key under the empty key
+func (b *weightedTaskBuffer) fairnessKeyCounts() map[string]int64 {
+	b.keyCountsLock.Lock()
+	defer b.keyCountsLock.Unlock()
+	counts := make(map[string]int64, len(b.keyCounts))
+	for k, v := range b.keyCounts {
+		counts[k] = v
+	}
+	return counts
+}
+
+func (b *weightedTaskBuffer) getPriorityWeight(priority int32) int {
+	weight, ok := b.getWeights().priorities[int(priority)]
+	if !ok || weight <= 0 {
+		// a level without a positive weight would never be dispatched
+		return 1
+	}
+	return weight
+}
+
+func (b *weightedTaskBuffer) getFairnessKeyWeight(key string) int {
+	if key == "" {
+		return 1
+	}
+	weight, ok := b.getWeights().fairnessKeys[key]
+	if !ok || weight <= 0 {
+		// a key without a positive weight would never be dispatched
+		return 1
+	}
+	return weight
+}
+
+// getWeights returns the weights read from dynamic config, they are cached as converting them
+// on every buffered task is too costly for busy task lists
+func (b *weightedTaskBuffer) getWeights() *taskBufferWeights {
+	now := b.timeSource.Now()
+	if w := b.weights.Load(); w != nil && now.Before(w.expiry) {
+		return w
+	}
+	w := &taskBufferWeights{
+		fairnessKeys: make(map[string]int),
+		expiry:       now.Add(taskBufferWeightsRefreshInterval),
+	}
+	var err error
+	w.priorities, err = dynamicproperties.ConvertDynamicConfigMapPropertyToIntMap(b.config.TaskPriorityRoundRobinWeights())
+	if err != nil {
+		b.logger.Error("failed to convert dynamic config map to int map, use default round robin weights", tag.Error(err))
+		w.priorities = dynamicproperties.DefaultMatchingTaskPriorityRoundRobinWeights
+	}
+	if b.enableFairness {
+		for key, weight := range b.config.TaskFairnessKeyWeights() {
+			switch v := weight.(type) {
+			case float64:
+				w.fairnessKeys[key] = int(v)
+			case int:
+				w.fairnessKeys[key] = v
+			case int32:
+				w.fairnessKeys[key] = int(v)
+			case int64:
+				w.fairnessKeys[key] = int(v)
+			default:
+				b.logger.Error("invalid fairness key weight, use 1 instead", tag.Dynamic("fairness-key", key), tag.Dynamic("weight", weight))
+			}
+		}
+	}
+	b.weights.Store(w)
+	return w
+}
+
+// tryNext does at most one pass over the schedule, it returns false if all the channels are empty
+func (c *scheduleCursor[V]) tryNext(schedule task.Schedule[*task.TTLChannel[V]]) (V, bool) {
+	var zero V
+	if c.schedule != schedule {
+		c.schedule = schedule
+		c.iter = schedule.NewIterator()
+	}
+	for i := 0; i < schedule.Len(); i++ {
+		ch, ok := c.iter.TryNext()
+		if !ok {
+			c.iter = schedule.NewIterator()
+			if ch, ok = c.iter.TryNext(); !ok {
+				return zero, false
+			}
+		}
+		select {
+		case v := <-ch.Chan():
+			return v, true
+		default:
+		}
+	}
+	return zero, false
+}
@@ -0,0 +1,346 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tasklist
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
+	"github.com/uber/cadence/common/log/testlogger"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/taskpriority"
+	"github.com/uber/cadence/service/matching/config"
+)
+
+type testTaskBufferConfig struct {
+	enablePriority     bool
+	priorityWeights    map[int]int
+	enableFairness     bool
+	fairnessKeyWeights map[string]interface{}
+}
+
+func newTestTaskBuffer(t *testing.T, capacity int, cfg testTaskBufferConfig) taskBuffer {
+	if cfg.priorityWeights == nil {
+		cfg.priorityWeights = dynamicproperties.DefaultMatchingTaskPriorityRoundRobinWeights
+	}
+	return newTaskBuffer(
+		capacity,
+		&config.TaskListConfig{
+			EnableTaskPriority: func() bool { return cfg.enablePriority },
+			TaskPriorityRoundRobinWeights: func(...dynamicproperties.FilterOption) map[string]interface{} {
+				return dynamicproperties.ConvertIntMapToDynamicConfigMapProperty(cfg.priorityWeights)
+			},
+			EnableTaskFairness:     func() bool { return cfg.enableFairness },
+			TaskFairnessKeyWeights: func() map[string]interface{} { return cfg.fairnessKeyWeights },
+		},
+		testlogger.New(t),
+		metrics.NoopScope,
+		clock.NewMockedTimeSource(),
+	)
+}
+
+func newTestPriorityTask(taskID int64, priority int32) *persistence.TaskInfo {
+	return &persistence.TaskInfo{
+		TaskID:          taskID,
+		PartitionConfig: taskpriority.WithPriority(nil, priority),
+	}
+}
+
+func newTestFairnessTask(taskID int64, fairnessKey string) *persistence.TaskInfo {
+	return &persistence.TaskInfo{
+		TaskID:          taskID,
+		PartitionConfig: taskpriority.WithFairnessKey(nil, fairnessKey),
+	}
+}
+
+func drainTaskBuffer(t *testing.T, b taskBuffer, count int) []int64 {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	var taskIDs []int64
+	for i := 0; i < count; i++ {
+		task, ok := b.next(ctx)
+		require.True(t, ok)
+		taskIDs = append(taskIDs, task.info.TaskID)
+	}
+	return taskIDs
+}
+
+func TestTaskBuffer_FIFOWhenPriorityDisabled(t *testing.T) {
+	b := newTestTaskBuffer(t, 10, testTaskBufferConfig{})
+	for i, p := range []int32{5, 1, 3, 1, 5} {
+		require.True(t, b.add(context.Background(), newTestPriorityTask(int64(i), p), nil))
+	}
+	assert.Equal(t, 5, b.len())
+	assert.False(t, b.hasTasksAbove(taskpriority.Default))
+
+	assert.Equal(t, []int64{0, 1, 2, 3, 4}, drainTaskBuffer(t, b, 5))
+	assert.Equal(t, 0, b.len())
+}
+
+func TestTaskBuffer_WeightedByPriority(t *testing.T) {
+	b := newTestTaskBuffer(t, 20, testTaskBufferConfig{enablePriority: true, priorityWeights: map[int]int{1: 3, 5: 1}})
+	for i := 0; i < 8; i++ {
+		require.True(t, b.add(context.Background(), newTestPriorityTask(int64(i), taskpriority.Lowest), nil))
+	}
+	for i := 8; i < 16; i++ {
+		require.True(t, b.add(context.Background(), newTestPriorityTask(int64(i), taskpriority.Highest), nil))
+	}
+	assert.True(t, b.hasTasksAbove(taskpriority.Default))
+	assert.False(t, b.hasTasksAbove(taskpriority.Highest))
+
+	var highest, lowest int
+	for _, id := range drainTaskBuffer(t, b, 8) {
+		if id >= 8 {
+			highest++
+		} else {
+			lowest++
+		}
+	}
+	// the lowest priority keeps getting a share of the dispatches
+	assert.Equal(t, 6, highest)
+	assert.Equal(t, 2, lowest)
+	assert.Equal(t, 8, b.len())
+}
+
+func TestTaskBuffer_KeepsOrderWithinPriority(t *testing.T) {
+	b := newTestTaskBuffer(t, 10, testTaskBufferConfig{enablePriority: true})
+	for i := 0; i < 3; i++ {
+		require.True(t, b.add(context.Background(), newTestPriorityTask(int64(i), taskpriority.Default), nil))
+	}
+	assert.Equal(t, []int64{0, 1, 2}, drainTaskBuffer(t, b, 3))
+}
+
+func TestTaskBuffer_FIFOWhenFairnessDisabled(t *testing.T) {
+	b := newTestTaskBuffer(t, 10, testTaskBufferConfig{})
+	for i, key := range []string{"tenant-a", "tenant-a", "tenant-b", "", "tenant-a"} {
+		require.True(t, b.add(context.Background(), newTestFairnessTask(int64(i), key), nil))
+	}
+	// counts are only tracked when fairness is enabled
+	assert.Empty(t, b.fairnessKeyCounts())
+
+	assert.Equal(t, []int64{0, 1, 2, 3, 4}, drainTaskBuffer(t, b, 5))
+	assert.Empty(t, b.fairnessKeyCounts())
+}
+
+func TestTaskBuffer_RoundRobinByFairnessKey(t *testing.T) {
+	b := newTestTaskBuffer(t, 20, testTaskBufferConfig{enableFairness: true})
+	for i := 0; i < 8; i++ {
+		require.True(t, b.add(context.Background(), newTestFairnessTask(int64(i), "tenant-a"), nil))
+	}
+	for i := 8; i < 10; i++ {
+		require.True(t, b.add(context.Background(), newTestFairnessTask(int64(i), "tenant-b"), nil))
+	}
+	assert.Equal(t, map[string]int64{"tenant-a": 8, "tenant-b": 2}, b.fairnessKeyCounts())
+
+	// tenant-b does not wait for the backlog of tenant-a to be drained
+	var tenantA, tenantB []int64
+	for _, id := range drainTaskBuffer(t, b, 4) {
+		if id >= 8 {
+			tenantB = append(tenantB, id)
+		} else {
+			tenantA = append(tenantA, id)
+		}
+	}
+	assert.Equal(t, []int64{0, 1}, tenantA)
+	assert.Equal(t, []int64{8, 9}, tenantB)
+	assert.Equal(t, map[string]int64{"tenant-a": 6}, b.fairnessKeyCounts())
+}
+
+func TestTaskBuffer_WeightedByFairnessKey(t *testing.T) {
+	b := newTestTaskBuffer(t, 20, testTaskBufferConfig{
+		enableFairness:     true,
+		fairnessKeyWeights: map[string]interface{}{"tenant-a": 3},
+	})
+	for i := 0; i < 8; i++ {
+		require.True(t, b.add(context.Background(), newTestFairnessTask(int64(i), "tenant-a"), nil))
+	}
+	for i := 8; i < 16; i++ {
+		require.True(t, b.add(context.Background(), newTestFairnessTask(int64(i), "tenant-b"), nil))
+	}
+
+	var tenantA, tenantB int
+	for _, id := range drainTaskBuffer(t, b, 8) {
+		if id >= 8 {
+			tenantB++
+		} else {
+			tenantA++
+		}
+	}
+	assert.Equal(t, 6, tenantA)
+	assert.Equal(t, 2, tenantB)
+}
+
+func TestTaskBuffer_PriorityBeforeFairness(t *testing.T) {
+	b := newTestTaskBuffer(t, 20, testTaskBufferConfig{
+		enablePriority:  true,
+		priorityWeights: map[int]int{1: 1, 5: 1},
+		enableFairness:  true,
+	})
+	// many tenants at the lowest priority do not outweigh a single one at the highest priority
+	for i := 0; i < 4; i++ {
+		info := newTestFairnessTask(int64(i), "tenant-"+strconv.Itoa(i))
+		info.PartitionConfig = taskpriority.WithPriority(info.PartitionConfig, taskpriority.Lowest)
+		require.True(t, b.add(context.Background(), info, nil))
+	}
+	for i := 4; i < 8; i++ {
+		info := newTestFairnessTask(int64(i), "tenant-high")
+		info.PartitionConfig = taskpriority.WithPriority(info.PartitionConfig, taskpriority.Highest)
+		require.True(t, b.add(context.Background(), info, nil))
+	}
+
+	var highest int
+	for _, id := range drainTaskBuffer(t, b, 4) {
+		if id >= 4 {
+			highest++
+		}
+	}
+	assert.Equal(t, 2, highest)
+}
+
+func TestTaskBuffer_AddBlocksWhenFull(t *testing.T) {
+	b := newTestTaskBuffer(t, 2, testTaskBufferConfig{})
+	require.True(t, b.add(context.Background(), newTestPriorityTask(1, taskpriority.Highest), nil))
+	require.True(t, b.add(context.Background(), newTestPriorityTask(2, taskpriority.Lowest), nil))
+	assert.Equal(t, 2, b.cap())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	assert.False(t, b.add(ctx, newTestPriorityTask(3, taskpriority.Default), nil))
+	assert.Equal(t, 2, b.len())
+}
+
+func TestTaskBuffer_AddBlocksWhenPriorityLevelFull(t *testing.T) {
+	b := newTestTaskBuffer(t, 2, testTaskBufferConfig{enablePriority: true, enableFairness: true})
+	require.True(t, b.add(context.Background(), newTestPriorityTask(1, taskpriority.Lowest), nil))
+	require.True(t, b.add(context.Background(), newTestPriorityTask(2, taskpriority.Lowest), nil))
+	assert.Equal(t, 2, b.cap())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	assert.False(t, b.add(ctx, newTestPriorityTask(3, taskpriority.Lowest), nil))
+	// a full level does not hold back the other ones
+	require.True(t, b.add(context.Background(), newTestPriorityTask(4, taskpriority.Highest), nil))
+	assert.Equal(t, 3, b.len())
+	assert.Equal(t, []int64{4}, drainTaskBuffer(t, b, 1))
+}
+
+func TestTaskBuffer_NextWaitsForTask(t *testing.T) {
+	b := newTestTaskBuffer(t, 2, testTaskBufferConfig{enablePriority: true})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, ok := b.next(ctx)
+	assert.False(t, ok)
+
+	go func() {
+		b.add(context.Background(), newTestPriorityTask(1, taskpriority.Default), nil)
+	}()
+	assert.Equal(t, []int64{1}, drainTaskBuffer(t, b, 1))
+}
+
+func TestTaskBuffer_StartStop(t *testing.T) {
+	b := newTestTaskBuffer(t, 2, testTaskBufferConfig{enableFairness: true})
+	b.start()
+	require.True(t, b.add(context.Background(), newTestFairnessTask(1, "tenant-a"), nil))
+	assert.Equal(t, []int64{1}, drainTaskBuffer(t, b, 1))
+	b.stop()
+}
+
+func TestTaskBuffer_InvalidPriorityWeights(t *testing.T) {
+	b := newWeightedTaskBuffer(
+		10,
+		true,
+		false,
+		&config.TaskListConfig{
+			TaskPriorityRoundRobinWeights: func(...dynamicproperties.FilterOption) map[string]interface{} {
+				return map[string]interface{}{"not-a-level": 1}
+			},
+		},
+		testlogger.New(t),
+		metrics.NoopScope,
+		clock.NewMockedTimeSource(),
+	)
+	assert.Equal(t, dynamicproperties.DefaultMatchingTaskPriorityRoundRobinWeights[1], b.getPriorityWeight(taskpriority.Highest))
+
+	b = newTestTaskBuffer(t, 10, testTaskBufferConfig{enablePriority: true, priorityWeights: map[int]int{1: 0}}).(*weightedTaskBuffer)
+	for p := taskpriority.Highest; p <= taskpriority.Lowest; p++ {
+		assert.Equal(t, 1, b.getPriorityWeight(p), strconv.Itoa(int(p)))
+	}
+}
+
+func TestTaskBuffer_FairnessKeyWeights(t *testing.T) {
+	b := newTestTaskBuffer(t, 10, testTaskBufferConfig{
+		enableFairness: true,
+		fairnessKeyWeights: map[string]interface{}{
+			"int":      2,
+			"float":    float64(3),
+			"zero":     0,
+			"negative": -1,
+			"string":   "5",
+		},
+	}).(*weightedTaskBuffer)
+	tests := map[string]int{
+		"":         1,
+		"unknown":  1,
+		"int":      2,
+		"float":    3,
+		"zero":     1,
+		"negative": 1,
+		"string":   1,
+	}
+	for key, expected := range tests {
+		assert.Equal(t, expected, b.getFairnessKeyWeight(key), key)
+	}
+}
+
+func TestTaskBuffer_WeightsAreCached(t *testing.T) {
+	weights := map[string]interface{}{"tenant-a": 2}
+	timeSource := clock.NewMockedTimeSource()
+	b := newWeightedTaskBuffer(
+		10,
+		false,
+		true,
+		&config.TaskListConfig{
+			TaskPriorityRoundRobinWeights: func(...dynamicproperties.FilterOption) map[string]interface{} {
+				return dynamicproperties.ConvertIntMapToDynamicConfigMapProperty(dynamicproperties.DefaultMatchingTaskPriorityRoundRobinWeights)
+			},
+			TaskFairnessKeyWeights: func() map[string]interface{} { return weights },
+		},
+		testlogger.New(t),
+		metrics.NoopScope,
+		timeSource,
+	)
+	assert.Equal(t, 2, b.getFairnessKeyWeight("tenant-a"))
+
+	weights = map[string]interface{}{"tenant-a": 4}
+	assert.Equal(t, 2, b.getFairnessKeyWeight("tenant-a"))
+
+	timeSource.Advance(taskBufferWeightsRefreshInterval)
+	assert.Equal(t, 4, b.getFairnessKeyWeight("tenant-a"))
+}
@@ -49,6 +49,7 @@ import (
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/stats"
+	"github.com/uber/cadence/common/taskpriority"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/matching/config"
 	"github.com/uber/cadence/service/matching/event"
@@ -140,6 +141,7 @@ type (
 		stopWG        sync.WaitGroup
 		stopped       int32
 		stoppedLock   sync.RWMutex
+		backlogsLock  sync.Mutex    // serializes starting the backlogs of the priority levels and fairness buckets with Stop
 		shutdownCh    chan struct{} // closed on Stop to release the watchForStop goroutine
 		registry      TaskListRegistry
 		throttleRetry *backoff.ThrottleRetry
@@ -252,6 +254,28 @@ func NewManager(p ManagerParams) (Manager, error) {
 	tlMgr.matcher = newTaskMatcher(taskListConfig, fwdr, tlMgr.scope, isolationGroups, tlMgr.logger, p.TaskList, p.TaskListKind, tlMgr.limiter).(*taskMatcherImpl)
 	tlMgr.taskWriter = newTaskWriter(tlMgr)
 	tlMgr.taskReader = newTaskReader(tlMgr, isolationGroups)
+	if p.TaskListKind == types.TaskListKindNormal {
+		// every priority level and every fairness bucket of a level is persisted in its own task
+		// list, so that the backlog of a level is read independently of the backlog of the lower
+		// ones and the backlog of a fairness key only holds back the keys of its bucket. A backlog
+		// is only leased and read once a task is written to it or if it still holds tasks when the
+		// task list is loaded, so it is drained after priority or fairness is switched off.
+		for _, priority := range taskpriority.Levels() {
+			for bucket := int32(0); bucket < taskpriority.FairnessBuckets; bucket++ {
+				if priority == taskpriority.Default && bucket == 0 {
+					continue
+				}
+				name := taskpriority.BacklogTaskListName(p.TaskList.GetName(), priority, bucket)
+				backlogDB := newTaskListDB(p.TaskManager, p.TaskList.GetDomainID(), domainName, name, p.TaskList.GetType(), int(p.TaskListKind), p.Logger)
+				backlogAckManager := messaging.NewAckManager(p.Logger)
+				backlogWriter := newBacklogTaskWriter(tlMgr, backlogDB, backlogAckManager)
+				backlog := newBacklogTaskReader(tlMgr, backlogDB, backlogWriter, backlogAckManager, newTaskGC(backlogDB, taskListConfig), tlMgr.taskReader.taskBuffers)
+				backlog.priority = priority
+				backlog.fairnessBucket = bucket
+				tlMgr.taskReader.backlogs = append(tlMgr.taskReader.backlogs, backlog)
+			}
+		}
+	}
 	tlMgr.taskCompleter = newTaskCompleter(tlMgr, historyServiceOperationRetryPolicy)
 	tlMgr.startWG.Add(1)
 	return tlMgr, nil
@@ -303,9 +327,16 @@ func (c *taskListManagerImpl) Start(ctx context.Context) error {
 			}()
 		}
 	}
-	go c.watchForStop()
+	go c.watchForStop(c.taskReader, c.taskWriter)
 	c.liveness.Start()
 	c.taskReader.Start()
+	if len(c.taskReader.backlogs) > 0 {
+		c.stopWG.Add(1)
+		go func() {
+			defer c.stopWG.Done()
+			c.startBacklogsWithTasks()
+		}()
+	}
 	c.qpsTracker.Start()
 	if c.adaptiveScaler != nil {
 		c.adaptiveScaler.Start()
@@ -334,17 +365,79 @@ func (c *taskListManagerImpl) Stop() {
 	c.liveness.Stop()
 	c.taskWriter.Stop()
 	c.taskReader.Stop()
+	c.backlogsLock.Lock()
+	for _, backlog := range c.taskReader.backlogs {
+		if backlog.isStarted() {
+			backlog.taskWriter.Stop()
+			backlog.Stop()
+		}
+	}
+	c.backlogsLock.Unlock()
 	c.matcher.DisconnectBlockedPollers()
 	c.stopWG.Wait()
 	c.logger.Info("Task list manager state changed", tag.LifeCycleStopped)
 }
 
-// watchForStop unloads the task list when the reader or the writer reports a fatal error,
-// usually a lease lost to another host.
-func (c *taskListManagerImpl) watchForStop() {
+// startBacklogsWithTasks starts the backlogs of the priority levels and fairness buckets that still
+// hold tasks persisted before the task list was loaded, whether priority and fairness are enabled or not
+func (c *taskListManagerImpl) startBacklogsWithTasks() {
+	for _, backlog := range c.taskReader.backlogs {
+		if atomic.LoadInt32(&c.stopped) == 1 {
+			return
+		}
+		var size int64
+		err := c.throttleRetry.Do(context.Background(), func(ctx context.Context) error {
+			info, err := backlog.db.GetTaskListInfo(backlog.db.taskListName)
+			if err != nil {
+				return err
+			}
+			size, err = backlog.db.GetTaskListSize(info.AckLevel)
+			return err
+		})
+		var e *types.EntityNotExistsError
+		if errors.As(err, &e) || (err == nil && size == 0) {
+			continue
+		}
+		if err == nil {
+			err = c.startBacklog(backlog)
+		}
+		if err != nil {
+			c.logger.Error("Failed to start the backlog of the task list",
+				tag.WorkflowTaskListName(backlog.db.taskListName),
+				tag.Error(err))
+		}
+	}
+}
+
+// startBacklog leases the task list persisting the backlog of a priority level or fairness bucket
+// and starts reading it, if it is not started yet
+func (c *taskListManagerImpl) startBacklog(backlog *taskReader) error {
+	if backlog.isStarted() {
+		return nil
+	}
+	c.backlogsLock.Lock()
+	defer c.backlogsLock.Unlock()
+	if backlog.isStarted() {
+		return nil
+	}
+	if atomic.LoadInt32(&c.stopped) == 1 {
+		return errShutdown
+	}
+	if err := backlog.taskWriter.Start(); err != nil {
+		return err
+	}
+	go c.watchForStop(backlog, backlog.taskWriter)
+	backlog.Start()
+	atomic.StoreInt32(&backlog.started, 1)
+	return nil
+}
+
+// watchForStop unloads the task list when the reader or the writer of one of its backlogs reports
+// a fatal error, usually a lease lost to another host.
+func (c *taskListManagerImpl) watchForStop(reader *taskReader, writer *taskWriter) {
 	select {
-	case <-c.taskReader.Fatal():
-	case <-c.taskWriter.Fatal():
+	case <-reader.Fatal():
+	case <-writer.Fatal():
 	case <-c.shutdownCh:
 		// the task list is already being stopped by its owner, nothing to do
 		return
@@ -408,7 +501,7 @@ func (c *taskListManagerImpl) LoadBalancerHints() *types.LoadBalancerHints {
 		return nil
 	}
 	return &types.LoadBalancerHints{
-		BacklogCount:  c.taskAckManager.GetBacklogCount(),
+		BacklogCount:  c.taskReader.backlogCount(),
 		RatePerSecond: c.qpsTracker.QPS(),
 	}
 }
@@ -593,17 +686,27 @@ func (c *taskListManagerImpl) AddTask(ctx context.Context, params AddTaskParams)
 
 		// Persist the standby task, but the sync match still fails.
 		// Return the false syncMatch flag along with any error
-		_, err = c.taskWriter.appendTask(ctx, params.TaskInfo)
+		backlog, err := c.backlogFor(params.TaskInfo)
+		if err != nil {
+			return syncMatch, err
+		}
+		_, err = backlog.taskWriter.appendTask(ctx, params.TaskInfo)
 		if err == nil {
 			// Signal the task reader only if appendTask succeeded
-			c.taskReader.Signal()
+			backlog.Signal()
 		}
 		return syncMatch, err
 	}
 
 	isolationGroup, _ := c.getIsolationGroupForTask(ctx, params.TaskInfo)
-	// active task, try sync match first
-	syncMatch, err = c.trySyncMatch(ctx, params, isolationGroup)
+	if c.hasHigherPriorityBacklog(params.TaskInfo, isolationGroup) {
+		// sync matching would let the task jump ahead of the backlog
+		e.EventName = "Skipped SyncMatch because of higher priority backlog"
+		event.Log(e)
+	} else {
+		// active task, try sync match first
+		syncMatch, err = c.trySyncMatch(ctx, params, isolationGroup)
+	}
 	if syncMatch {
 		e.EventName = "SyncMatched so not persisted"
 		event.Log(e)
@@ -620,13 +723,49 @@ func (c *taskListManagerImpl) AddTask(ctx context.Context, params AddTaskParams)
 
 	e.EventName = "Task Sent to Writer"
 	event.Log(e)
-	if _, err := c.taskWriter.appendTask(ctx, params.TaskInfo); err != nil {
+	backlog, err := c.backlogFor(params.TaskInfo)
+	if err != nil {
 		return syncMatch, err
 	}
-	c.taskReader.Signal()
+	if _, err := backlog.taskWriter.appendTask(ctx, params.TaskInfo); err != nil {
+		return syncMatch, err
+	}
+	backlog.Signal()
 	return syncMatch, nil
 }
 
+// backlogFor returns the reader of the backlog the task is persisted in, the one of its priority
+// level and fairness bucket while priority and fairness are enabled, and starts it if needed
+func (c *taskListManagerImpl) backlogFor(task *persistence.TaskInfo) (*taskReader, error) {
+	priority, bucket := taskpriority.Default, int32(0)
+	if c.config.EnableTaskPriority() {
+		priority = taskpriority.FromPartitionConfig(task.PartitionConfig)
+	}
+	if c.config.EnableTaskFairness() {
+		bucket = taskpriority.FairnessBucket(taskpriority.FairnessKeyFromPartitionConfig(task.PartitionConfig))
+	}
+	for _, backlog := range c.taskReader.backlogs {
+		if backlog.priority == priority && backlog.fairnessBucket == bucket {
+			return backlog, c.startBacklog(backlog)
+		}
+	}
+	return c.taskReader, nil
+}
+
+// hasHigherPriorityBacklog returns true if tasks with a higher priority than the given task are
+// buffered for dispatch in its isolation group or persisted and not read yet
+func (c *taskListManagerImpl) hasHigherPriorityBacklog(task *persistence.TaskInfo, isolationGroup string) bool {
+	if !c.config.EnableTaskPriority() {
+		return false
+	}
+	priority := taskpriority.FromPartitionConfig(task.PartitionConfig)
+	buffer, ok := c.taskReader.taskBuffers[isolationGroup]
+	if !ok {
+		buffer = c.taskReader.taskBuffers[defaultTaskBufferIsolationGroup]
+	}
+	return buffer.hasTasksAbove(priority) || c.taskReader.hasBacklogAbove(priority)
+}
+
 // DispatchTask dispatches a task to a poller on the active side. When there are no pollers to pick
 // up the task or if the rate limit is exceeded, this method will return error. Task
 // *will not* be persisted to db. On the passive side, dispatches the task to the taskCompleter; it will attempt
@@ -695,7 +834,7 @@ func (c *taskListManagerImpl) GetTask(
 		return nil, fmt.Errorf("couldn't get task: %w", err)
 	}
 	task.domainName = c.domainName
-	task.BacklogCountHint = c.taskAckManager.GetBacklogCount()
+	task.BacklogCountHint = c.taskReader.backlogCount()
 	return task, nil
 }
 
@@ -790,15 +929,16 @@ func (c *taskListManagerImpl) DescribeTaskList(includeTaskListStatus bool) *type
 	response.TaskListStatus = &types.TaskListStatus{
 		ReadLevel:        c.taskAckManager.GetReadLevel(),
 		AckLevel:         c.taskAckManager.GetAckLevel(),
-		BacklogCountHint: c.taskAckManager.GetBacklogCount(),
+		BacklogCountHint: c.taskReader.backlogCount(),
 		RatePerSecond:    float64(c.limiter.Limit()),
 		TaskIDBlock: &types.TaskIDBlock{
 			StartID: idBlock.start,
 			EndID:   idBlock.end,
 		},
-		IsolationGroupMetrics: isolationGroupMetrics,
-		NewTasksPerSecond:     c.qpsTracker.QPS(),
-		Empty:                 c.taskAckManager.GetAckLevel() == c.taskWriter.GetMaxReadLevel(),
+		IsolationGroupMetrics:    isolationGroupMetrics,
+		NewTasksPerSecond:        c.qpsTracker.QPS(),
+		Empty:                    c.taskReader.isEmpty(),
+		FairnessKeyBacklogCounts: c.taskReader.fairnessKeyBacklogCounts(),
 	}
 
 	return response
@@ -1167,6 +1307,16 @@ func newTaskListConfig(id *Identifier, cfg *config.Config, domainName string) *c
 		EnableClientAutoConfig: func() bool {
 			return cfg.EnableClientAutoConfig(domainName, taskListName, taskType)
 		},
+		EnableTaskPriority: func() bool {
+			return cfg.EnableTaskPriority(domainName, taskListName, taskType)
+		},
+		TaskPriorityRoundRobinWeights: cfg.TaskPriorityRoundRobinWeights,
+		EnableTaskFairness: func() bool {
+			return cfg.EnableTaskFairness(domainName, taskListName, taskType)
+		},
+		TaskFairnessKeyWeights: func() map[string]interface{} {
+			return cfg.TaskFairnessKeyWeights(domainName)
+		},
 	}
 }
 
@@ -54,6 +54,7 @@ import (
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/stats"
+	"github.com/uber/cadence/common/taskpriority"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/history/constants"
 	"github.com/uber/cadence/service/matching/config"
@@ -132,7 +133,7 @@ func TestDeliverBufferTasks(t *testing.T) {
 		func(tlm *taskListManagerImpl) { tlm.taskReader.cancelFunc() },
 		func(tlm *taskListManagerImpl) {
 			tlm.limiter.ReportLimit(0.1)
-			tlm.taskReader.taskBuffers[defaultTaskBufferIsolationGroup] <- &persistence.TaskInfo{}
+			tlm.taskReader.taskBuffers[defaultTaskBufferIsolationGroup].add(context.Background(), &persistence.TaskInfo{}, tlm.taskReader)
 			err := tlm.matcher.(*taskMatcherImpl).ratelimit(context.Background()) // consume the token
 			assert.NoError(t, err)
 			tlm.taskReader.cancelFunc()
@@ -166,7 +167,7 @@ func TestDeliverBufferTasks_NoPollers(t *testing.T) {
 	logger := testlogger.New(t)
 
 	tlm := createTestTaskListManager(t, logger, controller)
-	tlm.taskReader.taskBuffers[defaultTaskBufferIsolationGroup] <- &persistence.TaskInfo{}
+	tlm.taskReader.taskBuffers[defaultTaskBufferIsolationGroup].add(context.Background(), &persistence.TaskInfo{}, tlm.taskReader)
 	var wg sync.WaitGroup
 	wg.Add(1)
 	go func() {
@@ -386,6 +387,31 @@ func TestDescribeTaskList(t *testing.T) {
 				Empty: false,
 			},
 		},
+		{
+			name:          "with status, buffered tasks per fairness key",
+			includeStatus: true,
+			allowance: func(_ *gomock.Controller, tlm *taskListManagerImpl) {
+				// fairness counts are only tracked by the buffer of a task list with fairness enabled
+				buffer := newWeightedTaskBuffer(10, false, true, tlm.config, tlm.logger, tlm.scope, tlm.timeSource)
+				tlm.taskReader.taskBuffers[defaultTaskBufferIsolationGroup] = buffer
+				for _, key := range []string{"tenant-a", "tenant-a", ""} {
+					buffer.add(context.Background(), &persistence.TaskInfo{PartitionConfig: taskpriority.WithFairnessKey(nil, key)}, tlm.taskReader)
+				}
+			},
+			expectedStatus: &types.TaskListStatus{
+				RatePerSecond: defaultRps,
+				TaskIDBlock:   firstIDBlock,
+				IsolationGroupMetrics: map[string]*types.IsolationGroupMetrics{
+					"datacenterA": {},
+					"datacenterB": {},
+				},
+				Empty: true,
+				FairnessKeyBacklogCounts: map[string]int64{
+					"tenant-a": 2,
+					"":         1,
+				},
+			},
+		},
 		{
 			name:          "with status, pollers and metrics",
 			includeStatus: true,
@@ -971,6 +997,146 @@ func TestTaskWriterShutdown(t *testing.T) {
 	tlm.Stop()
 }
 
+func TestAddTaskToPriorityBacklog(t *testing.T) {
+	controller := gomock.NewController(t)
+	logger := testlogger.New(t)
+	cfg := defaultTestConfig()
+	cfg.EnableTaskPriority = func(string, string, int) bool { return true }
+	tlm := createTestTaskListManagerWithConfig(t, logger, controller, cfg, clock.NewMockedTimeSource())
+	require.Len(t, tlm.taskReader.backlogs, len(taskpriority.Levels())*taskpriority.FairnessBuckets-1)
+	require.NoError(t, tlm.Start(context.Background()))
+	defer tlm.Stop()
+
+	// there is no poller, so the tasks are persisted once the sync match times out
+	for i, priority := range []int32{taskpriority.Highest, taskpriority.Default, taskpriority.Highest} {
+		_, err := tlm.AddTask(context.Background(), AddTaskParams{
+			TaskInfo: &persistence.TaskInfo{
+				DomainID:                      "domainId",
+				WorkflowID:                    "workflow1",
+				RunID:                         "run1",
+				ScheduleID:                    int64(i),
+				ScheduleToStartTimeoutSeconds: 5,
+				PartitionConfig:               taskpriority.WithPriority(nil, priority),
+			},
+		})
+		require.NoError(t, err)
+	}
+
+	tm := tlm.db.store.(*TestTaskManager)
+	highest := newBacklogTestTaskListID(tlm.taskListID.GetDomainID(), taskpriority.BacklogTaskListName(tlm.taskListID.GetName(), taskpriority.Highest, 0), tlm.taskListID.GetType())
+	assert.Len(t, tlm.taskReader.startedBacklogs(), 1)
+	assert.Equal(t, 1, tm.GetCreateTaskCount(tlm.taskListID))
+	assert.Equal(t, 2, tm.GetCreateTaskCount(highest))
+	assert.False(t, tlm.taskReader.isEmpty())
+	assert.True(t, tlm.hasHigherPriorityBacklog(&persistence.TaskInfo{}, defaultTaskBufferIsolationGroup))
+}
+
+func TestAddTaskToFairnessBacklog(t *testing.T) {
+	controller := gomock.NewController(t)
+	logger := testlogger.New(t)
+	cfg := defaultTestConfig()
+	cfg.EnableTaskFairness = func(string, string, int) bool { return true }
+	tlm := createTestTaskListManagerWithConfig(t, logger, controller, cfg, clock.NewMockedTimeSource())
+	require.Len(t, tlm.taskReader.backlogs, len(taskpriority.Levels())*taskpriority.FairnessBuckets-1)
+	require.NoError(t, tlm.Start(context.Background()))
+	defer tlm.Stop()
+
+	// find a key of another bucket than the one of the tenant with a large backlog
+	busyKey, otherKey := "tenant-busy", ""
+	for i := 0; otherKey == ""; i++ {
+		if key := "tenant-" + strconv.Itoa(i); taskpriority.FairnessBucket(key) != taskpriority.FairnessBucket(busyKey) {
+			otherKey = key
+		}
+	}
+
+	// there is no poller, so the tasks are persisted once the sync match times out
+	for i, key := range []string{busyKey, busyKey, otherKey} {
+		_, err := tlm.AddTask(context.Background(), AddTaskParams{
+			TaskInfo: &persistence.TaskInfo{
+				DomainID:                      "domainId",
+				WorkflowID:                    "workflow1",
+				RunID:                         "run1",
+				ScheduleID:                    int64(i),
+				ScheduleToStartTimeoutSeconds: 5,
+				PartitionConfig:               taskpriority.WithFairnessKey(nil, key),
+			},
+		})
+		require.NoError(t, err)
+	}
+
+	tm := tlm.db.store.(*TestTaskManager)
+	for key, count := range map[string]int{busyKey: 2, otherKey: 1} {
+		taskList := taskpriority.BacklogTaskListName(tlm.taskListID.GetName(), taskpriority.Default, taskpriority.FairnessBucket(key))
+		assert.Equal(t, count, tm.GetCreateTaskCount(newBacklogTestTaskListID(tlm.taskListID.GetDomainID(), taskList, tlm.taskListID.GetType())), key)
+	}
+}
+
+func TestAddTaskAfterPriorityIsSwitchedOff(t *testing.T) {
+	controller := gomock.NewController(t)
+	logger := testlogger.New(t)
+	cfg := defaultTestConfig()
+	enabled := int32(1)
+	cfg.EnableTaskPriority = func(string, string, int) bool { return atomic.LoadInt32(&enabled) == 1 }
+	tlm := createTestTaskListManagerWithConfig(t, logger, controller, cfg, clock.NewMockedTimeSource())
+	require.NoError(t, tlm.Start(context.Background()))
+	defer tlm.Stop()
+
+	addTask := func(scheduleID int64) {
+		_, err := tlm.AddTask(context.Background(), AddTaskParams{
+			TaskInfo: &persistence.TaskInfo{
+				DomainID:                      "domainId",
+				WorkflowID:                    "workflow1",
+				RunID:                         "run1",
+				ScheduleID:                    scheduleID,
+				ScheduleToStartTimeoutSeconds: 5,
+				PartitionConfig:               taskpriority.WithPriority(nil, taskpriority.Highest),
+			},
+		})
+		require.NoError(t, err)
+	}
+	addTask(1)
+	atomic.StoreInt32(&enabled, 0)
+	addTask(2)
+
+	tm := tlm.db.store.(*TestTaskManager)
+	highest := newBacklogTestTaskListID(tlm.taskListID.GetDomainID(), taskpriority.BacklogTaskListName(tlm.taskListID.GetName(), taskpriority.Highest, 0), tlm.taskListID.GetType())
+	assert.Equal(t, 1, tm.GetCreateTaskCount(highest))
+	assert.Equal(t, 1, tm.GetCreateTaskCount(tlm.taskListID))
+}
+
+func TestStartDrainsBacklogsAfterPriorityIsSwitchedOff(t *testing.T) {
+	controller := gomock.NewController(t)
+	logger := testlogger.New(t)
+	tlm := createTestTaskListManagerWithConfig(t, logger, controller, defaultTestConfig(), clock.NewMockedTimeSource())
+	tm := tlm.db.store.(*TestTaskManager)
+	highest := taskpriority.BacklogTaskListName(tlm.taskListID.GetName(), taskpriority.Highest, 0)
+	_, err := tm.CreateTasks(context.Background(), &persistence.CreateTasksRequest{
+		TaskListInfo: &persistence.TaskListInfo{
+			DomainID: tlm.taskListID.GetDomainID(),
+			Name:     highest,
+			TaskType: tlm.taskListID.GetType(),
+		},
+		Tasks: []*persistence.CreateTaskInfo{{
+			TaskID: 1,
+			Data: &persistence.TaskInfo{
+				DomainID:   "domainId",
+				WorkflowID: "workflow1",
+				RunID:      "run1",
+				ScheduleID: 1,
+				TaskID:     1,
+			},
+		}},
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, tlm.Start(context.Background()))
+	defer tlm.Stop()
+	assert.Eventually(t, func() bool {
+		started := tlm.taskReader.startedBacklogs()
+		return len(started) == 1 && started[0].db.taskListName == highest
+	}, time.Second, 10*time.Millisecond)
+}
+
 func TestTaskListManagerGetTaskBatch(t *testing.T) {
 	const taskCount = 1200
 	const rangeSize = 10
@@ -1031,9 +1197,9 @@ func TestTaskListManagerGetTaskBatch(t *testing.T) {
 
 	// wait until all tasks are read by the task pump and enqeued into the in-memory buffer
 	// at the end of this step, ackManager readLevel will also be equal to the buffer size
-	expectedBufSize := min(cap(tlm.taskReader.taskBuffers[defaultTaskBufferIsolationGroup]), taskCount)
+	expectedBufSize := min(tlm.taskReader.taskBuffers[defaultTaskBufferIsolationGroup].cap(), taskCount)
 	assert.True(t, awaitCondition(func() bool {
-		return len(tlm.taskReader.taskBuffers[defaultTaskBufferIsolationGroup]) == expectedBufSize
+		return tlm.taskReader.taskBuffers[defaultTaskBufferIsolationGroup].len() == expectedBufSize
 	}, 10*time.Second))
 
 	// stop all goroutines that read / write tasks in the background
@@ -1318,7 +1484,7 @@ func TestTaskExpiryAndCompletion(t *testing.T) {
 			// wait until all tasks are loaded by into in-memory buffers by task list manager
 			// the buffer size should be one less than expected because dispatcher will dequeue the head
 			assert.True(t, awaitCondition(func() bool {
-				return len(tlm.taskReader.taskBuffers[defaultTaskBufferIsolationGroup]) >= (taskCount/2 - 1)
+				return tlm.taskReader.taskBuffers[defaultTaskBufferIsolationGroup].len() >= (taskCount/2 - 1)
 			}, time.Second))
 
 			remaining := taskCount
@@ -64,7 +64,14 @@ type (
 		// that are enqueued for pollers to pickup. It's written to by
 		// - getTasksPump - the primary means of loading async matching tasks
 		// - task dispatch redirection - when a task is redirected from another isolation group
-		taskBuffers     map[string]chan *persistence.TaskInfo
+		// The readers of the priority levels persisted in their own task list share the buffers
+		// of the task list, whose reader dispatches the buffered tasks.
+		taskBuffers     map[string]taskBuffer
+		ownsBuffers     bool
+		priority        int32         // priority level of the backlog read, for the readers not owning the buffers
+		fairnessBucket  int32         // fairness bucket of the backlog read, for the readers not owning the buffers
+		backlogs        []*taskReader // readers of the other priority levels and fairness buckets, set on the reader owning the buffers
+		started         int32         // set once the backlog is leased and read, for the readers not owning the buffers
 		notifyC         chan struct{} // Used as signal to notify pump of new tasks
 		tlMgr           *taskListManagerImpl
 		taskListID      *Identifier
@@ -102,8 +109,7 @@ type (
 )
 
 func newTaskReader(tlMgr *taskListManagerImpl, isolationGroups []string) *taskReader {
-	ctx, cancel := context.WithCancel(context.Background())
-	taskBuffers := make(map[string]chan *persistence.TaskInfo)
+	taskBuffers := make(map[string]taskBuffer)
 
 	// Validate batch size to prevent system failures
 	batchSize := tlMgr.config.GetTasksBatchSize()
@@ -115,24 +121,43 @@ func newTaskReader(tlMgr *taskListManagerImpl, isolationGroups []string) *taskRe
 		batchSize = fallback
 	}
 
-	taskBuffers[defaultTaskBufferIsolationGroup] = make(chan *persistence.TaskInfo, batchSize-1)
+	// we always dequeue the head of the buffer and try to dispatch it to a poller
+	// so allocate one less than desired target buffer size
+	newBuffer := func() taskBuffer {
+		return newTaskBuffer(batchSize-1, tlMgr.config, tlMgr.logger, tlMgr.scope, tlMgr.timeSource)
+	}
+	taskBuffers[defaultTaskBufferIsolationGroup] = newBuffer()
 	for _, g := range isolationGroups {
-		taskBuffers[g] = make(chan *persistence.TaskInfo, batchSize-1)
+		taskBuffers[g] = newBuffer()
 	}
+	tr := newBacklogTaskReader(tlMgr, tlMgr.db, tlMgr.taskWriter, tlMgr.taskAckManager, tlMgr.taskGC, taskBuffers)
+	tr.ownsBuffers = true
+	return tr
+}
+
+// newBacklogTaskReader returns a reader of the backlog persisted in the given task list db, which
+// fills the given buffers
+func newBacklogTaskReader(
+	tlMgr *taskListManagerImpl,
+	db *taskListDB,
+	taskWriter *taskWriter,
+	taskAckManager messaging.AckManager,
+	taskGC *taskGC,
+	taskBuffers map[string]taskBuffer,
+) *taskReader {
+	ctx, cancel := context.WithCancel(context.Background())
 	return &taskReader{
-		tlMgr:          tlMgr,
-		taskListID:     tlMgr.taskListID,
-		config:         tlMgr.config,
-		db:             tlMgr.db,
-		taskWriter:     tlMgr.taskWriter,
-		taskGC:         tlMgr.taskGC,
-		taskAckManager: tlMgr.taskAckManager,
-		cancelCtx:      ctx,
-		cancelFunc:     cancel,
-		notifyC:        make(chan struct{}, 1),
-		fatalCh:        make(chan struct{}),
-		// we always dequeue the head of the buffer and try to dispatch it to a poller
-		// so allocate one less than desired target buffer size
+		tlMgr:                    tlMgr,
+		taskListID:               tlMgr.taskListID,
+		config:                   tlMgr.config,
+		db:                       db,
+		taskWriter:               taskWriter,
+		taskGC:                   taskGC,
+		taskAckManager:           taskAckManager,
+		cancelCtx:                ctx,
+		cancelFunc:               cancel,
+		notifyC:                  make(chan struct{}, 1),
+		fatalCh:                  make(chan struct{}),
 		taskBuffers:              taskBuffers,
 		domainCache:              tlMgr.domainCache,
 		clusterMetadata:          tlMgr.clusterMetadata,
@@ -151,13 +176,16 @@ func newTaskReader(tlMgr *taskListManagerImpl, isolationGroups []string) *taskRe
 
 func (tr *taskReader) Start() {
 	tr.Signal()
-	for g := range tr.taskBuffers {
-		g := g
-		tr.stopWg.Add(1)
-		go func() {
-			defer tr.stopWg.Done()
-			tr.dispatchBufferedTasks(g)
-		}()
+	if tr.ownsBuffers {
+		for g, buffer := range tr.taskBuffers {
+			g := g
+			buffer.start()
+			tr.stopWg.Add(1)
+			go func() {
+				defer tr.stopWg.Done()
+				tr.dispatchBufferedTasks(g)
+			}()
+		}
 	}
 	tr.stopWg.Add(1)
 	go func() {
@@ -176,6 +204,11 @@ func (tr *taskReader) Stop() {
 		}
 		tr.taskGC.RunNow(tr.taskAckManager.GetAckLevel())
 		tr.stopWg.Wait()
+		if tr.ownsBuffers {
+			for _, buffer := range tr.taskBuffers {
+				buffer.stop()
+			}
+		}
 	}
 }
 
@@ -189,6 +222,73 @@ func (tr *taskReader) signalFatal() {
 	tr.fatalOnce.Do(func() { close(tr.fatalCh) })
 }
 
+// fairnessKeyBacklogCounts returns the number of buffered tasks per fairness key across all isolation groups
+func (tr *taskReader) fairnessKeyBacklogCounts() map[string]int64 {
+	var counts map[string]int64
+	for _, buffer := range tr.taskBuffers {
+		for k, v := range buffer.fairnessKeyCounts() {
+			if counts == nil {
+				counts = make(map[string]int64)
+			}
+			counts[k] += v
+		}
+	}
+	return counts
+}
+
+// startedBacklogs returns the readers of the other priority levels and fairness buckets that were started
+func (tr *taskReader) startedBacklogs() []*taskReader {
+	var started []*taskReader
+	for _, backlog := range tr.backlogs {
+		if backlog.isStarted() {
+			started = append(started, backlog)
+		}
+	}
+	return started
+}
+
+func (tr *taskReader) isStarted() bool {
+	return atomic.LoadInt32(&tr.started) == 1
+}
+
+// backlogCount returns the number of tasks read from persistence and not completed yet, across
+// the backlogs of all priority levels
+func (tr *taskReader) backlogCount() int64 {
+	count := tr.taskAckManager.GetBacklogCount()
+	for _, backlog := range tr.startedBacklogs() {
+		count += backlog.taskAckManager.GetBacklogCount()
+	}
+	return count
+}
+
+// hasBacklogAbove returns true if the persisted backlog of a priority level higher than the
+// given one is not empty
+func (tr *taskReader) hasBacklogAbove(priority int32) bool {
+	for _, backlog := range tr.startedBacklogs() {
+		if backlog.priority < priority && !backlog.isBacklogEmpty() {
+			return true
+		}
+	}
+	return false
+}
+
+// isEmpty returns true if every task persisted in the backlogs of all priority levels was acked
+func (tr *taskReader) isEmpty() bool {
+	if !tr.isBacklogEmpty() {
+		return false
+	}
+	for _, backlog := range tr.startedBacklogs() {
+		if !backlog.isBacklogEmpty() {
+			return false
+		}
+	}
+	return true
+}
+
+func (tr *taskReader) isBacklogEmpty() bool {
+	return tr.taskAckManager.GetAckLevel() == tr.taskWriter.GetMaxReadLevel()
+}
+
 func (tr *taskReader) Signal() {
 	var event struct{}
 	select {
@@ -198,27 +298,24 @@ func (tr *taskReader) Signal() {
 }
 
 func (tr *taskReader) dispatchBufferedTasks(isolationGroup string) {
-dispatchLoop:
+	buffer := tr.taskBuffers[isolationGroup]
 	for {
-		select {
-		case taskInfo, ok := <-tr.taskBuffers[isolationGroup]:
-			if !ok { // Task list getTasks pump is shutdown
-				break dispatchLoop
-			}
-			event.Log(event.E{
-				TaskListName: tr.taskListID.GetName(),
-				TaskListType: tr.taskListID.GetType(),
-				TaskListKind: &tr.tlMgr.taskListKind,
-				TaskInfo:     *taskInfo,
-				EventName:    "Attempting to Dispatch Buffered Task",
-			})
-			breakDispatchLoop := tr.dispatchSingleTaskFromBufferWithRetries(taskInfo)
-			if breakDispatchLoop {
-				// shutting down
-				break dispatchLoop
-			}
-		case <-tr.cancelCtx.Done():
-			break dispatchLoop
+		task, ok := buffer.next(tr.cancelCtx)
+		if !ok { // Task list is shutting down
+			return
+		}
+		event.Log(event.E{
+			TaskListName: tr.taskListID.GetName(),
+			TaskListType: tr.taskListID.GetType(),
+			TaskListKind: &tr.tlMgr.taskListKind,
+			TaskInfo:     *task.info,
+			EventName:    "Attempting to Dispatch Buffered Task",
+		})
+		// the reader of the backlog the task was read from acks it once completed
+		breakDispatchLoop := task.reader.dispatchSingleTaskFromBufferWithRetries(task.info)
+		if breakDispatchLoop {
+			// shutting down
+			return
 		}
 	}
 }
@@ -228,7 +325,9 @@ func (tr *taskReader) getTasksPump() {
 	defer updateAckTimer.Stop()
 getTasksPumpLoop:
 	for {
-		tr.scope.UpdateGauge(metrics.TaskBacklogPerTaskListGauge, float64(tr.taskAckManager.GetBacklogCount()))
+		if tr.ownsBuffers {
+			tr.scope.UpdateGauge(metrics.TaskBacklogPerTaskListGauge, float64(tr.backlogCount()))
+		}
 		select {
 		case <-tr.cancelCtx.Done():
 			break getTasksPumpLoop
@@ -269,7 +368,12 @@ getTasksPumpLoop:
 		case <-updateAckTimer.Chan():
 			{
 				ackLevel := tr.taskAckManager.GetAckLevel()
-				if size, err := tr.db.GetTaskListSize(ackLevel); err == nil {
+				if size, err := tr.db.GetTaskListSize(ackLevel); err == nil && tr.ownsBuffers {
+					for _, backlog := range tr.startedBacklogs() {
+						if backlogSize, err := backlog.db.GetTaskListSize(backlog.taskAckManager.GetAckLevel()); err == nil {
+							size += backlogSize
+						}
+					}
 					tr.scope.UpdateGauge(metrics.TaskCountPerTaskListGauge, float64(size))
 				}
 				if err := tr.persistAckLevel(); err != nil {
@@ -378,12 +482,7 @@ func (tr *taskReader) addSingleTaskToBuffer(task *persistence.TaskInfo) bool {
 	if !ok {
 		buffer = tr.taskBuffers[defaultTaskBufferIsolationGroup]
 	}
-	select {
-	case buffer <- task:
-		return true
-	case <-tr.cancelCtx.Done():
-		return false
-	}
+	return buffer.add(tr.cancelCtx, task, tr)
 }
 
 func (tr *taskReader) persistAckLevel() error {
@@ -392,7 +491,13 @@ func (tr *taskReader) persistAckLevel() error {
 		maxReadLevel := tr.taskWriter.GetMaxReadLevel()
 		// note: this metrics is only an estimation for the lag. taskID in DB may not be continuous,
 		// especially when task list ownership changes.
-		tr.scope.UpdateGauge(metrics.TaskLagPerTaskListGauge, float64(maxReadLevel-ackLevel))
+		if tr.ownsBuffers {
+			lag := maxReadLevel - ackLevel
+			for _, backlog := range tr.startedBacklogs() {
+				lag += backlog.taskWriter.GetMaxReadLevel() - backlog.taskAckManager.GetAckLevel()
+			}
+			tr.scope.UpdateGauge(metrics.TaskLagPerTaskListGauge, float64(lag))
+		}
 
 		return tr.db.UpdateState(ackLevel)
 	}
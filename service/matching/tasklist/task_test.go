@@ -30,6 +30,7 @@ import (
 
 	"github.com/uber/cadence/common/isolationgroup"
 	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/taskpriority"
 	"github.com/uber/cadence/common/types"
 )
 
@@ -128,6 +129,25 @@ func TestNewInternalTask(t *testing.T) {
 				isolationgroup.WorkflowIDKey:    "workflowID",
 			},
 		},
+		{
+			name:           "tasklist isolation - priority",
+			source:         types.TaskSourceDbBacklog,
+			isolationGroup: "a",
+			partitionConfig: map[string]string{
+				isolationgroup.GroupKey:         "a",
+				isolationgroup.WorkflowIDKey:    "workflowID",
+				taskpriority.PartitionConfigKey: "1",
+			},
+			expectedPartitionConfig: map[string]string{
+				isolationgroup.OriginalGroupKey: "a",
+				isolationgroup.GroupKey:         "a",
+				isolationgroup.WorkflowIDKey:    "workflowID",
+				taskpriority.PartitionConfigKey: "1",
+			},
+			additionalAssertions: func(t *testing.T, task *InternalTask) {
+				assert.Equal(t, taskpriority.Highest, task.Priority())
+			},
+		},
 	}
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -43,11 +43,19 @@ type (
 	TestTaskManager struct {
 		sync.Mutex
 		t          *testing.T
-		taskLists  map[Identifier]*testTaskListManager
+		taskLists  map[testTaskListKey]*testTaskListManager
 		logger     log.Logger
 		timeSource clock.TimeSource
 	}
 
+	// testTaskListKey identifies a persisted task list, including the ones holding the backlogs of
+	// the priority levels and fairness buckets whose names do not parse as task list partitions
+	testTaskListKey struct {
+		domainID string
+		name     string
+		taskType int
+	}
+
 	testTaskListManager struct {
 		sync.RWMutex
 		rangeID                 int64
@@ -66,7 +74,7 @@ func newTestTaskListManager() *testTaskListManager {
 func NewTestTaskManager(t *testing.T, logger log.Logger, timeSource clock.TimeSource) *TestTaskManager {
 	return &TestTaskManager{
 		t:          t,
-		taskLists:  make(map[Identifier]*testTaskListManager),
+		taskLists:  make(map[testTaskListKey]*testTaskListManager),
 		logger:     logger,
 		timeSource: timeSource,
 	}
@@ -84,7 +92,7 @@ func (m *TestTaskManager) LeaseTaskList(
 	_ context.Context,
 	request *persistence.LeaseTaskListRequest,
 ) (*persistence.LeaseTaskListResponse, error) {
-	tlm := m.getTaskListManager(NewTestTaskListID(m.t, request.DomainID, request.TaskList, request.TaskType))
+	tlm := m.getTaskListManagerByKey(testTaskListKey{request.DomainID, request.TaskList, request.TaskType})
 	tlm.Lock()
 	defer tlm.Unlock()
 	if request.RangeID > 0 && request.RangeID != tlm.rangeID {
@@ -114,7 +122,7 @@ func (m *TestTaskManager) GetTaskList(
 	_ context.Context,
 	request *persistence.GetTaskListRequest,
 ) (*persistence.GetTaskListResponse, error) {
-	tlm := m.getTaskListManager(NewTestTaskListID(m.t, request.DomainID, request.TaskList, request.TaskType))
+	tlm := m.getTaskListManagerByKey(testTaskListKey{request.DomainID, request.TaskList, request.TaskType})
 	tlm.RLock()
 	defer tlm.RUnlock()
 	return &persistence.GetTaskListResponse{
@@ -138,7 +146,7 @@ func (m *TestTaskManager) UpdateTaskList(
 	m.logger.Debug(fmt.Sprintf("testTaskManager.UpdateTaskList taskListInfo=%v, ackLevel=%v", request.TaskListInfo, request.TaskListInfo.AckLevel))
 
 	tli := request.TaskListInfo
-	tlm := m.getTaskListManager(NewTestTaskListID(m.t, tli.DomainID, tli.Name, tli.TaskType))
+	tlm := m.getTaskListManagerByKey(testTaskListKey{tli.DomainID, tli.Name, tli.TaskType})
 
 	tlm.Lock()
 	defer tlm.Unlock()
@@ -162,7 +170,7 @@ func (m *TestTaskManager) CompleteTask(
 	}
 
 	tli := request.TaskList
-	tlm := m.getTaskListManager(NewTestTaskListID(m.t, tli.DomainID, tli.Name, tli.TaskType))
+	tlm := m.getTaskListManagerByKey(testTaskListKey{tli.DomainID, tli.Name, tli.TaskType})
 
 	tlm.Lock()
 	defer tlm.Unlock()
@@ -177,7 +185,7 @@ func (m *TestTaskManager) CompleteTasksLessThan(
 	request *persistence.CompleteTasksLessThanRequest,
 ) (*persistence.CompleteTasksLessThanResponse, error) {
 	m.logger.Debug(fmt.Sprintf("testTaskManager.CompleteTasksLessThan taskID=%v", request.TaskID))
-	tlm := m.getTaskListManager(NewTestTaskListID(m.t, request.DomainID, request.TaskListName, request.TaskType))
+	tlm := m.getTaskListManagerByKey(testTaskListKey{request.DomainID, request.TaskListName, request.TaskType})
 	tlm.Lock()
 	defer tlm.Unlock()
 	rowsDeleted := 0
@@ -207,8 +215,7 @@ func (m *TestTaskManager) DeleteTaskList(
 ) error {
 	m.Lock()
 	defer m.Unlock()
-	key := NewTestTaskListID(m.t, request.DomainID, request.TaskListName, request.TaskListType)
-	delete(m.taskLists, *key)
+	delete(m.taskLists, testTaskListKey{request.DomainID, request.TaskListName, request.TaskListType})
 	return nil
 }
 
@@ -222,7 +229,7 @@ func (m *TestTaskManager) CreateTasks(
 	taskType := request.TaskListInfo.TaskType
 	rangeID := request.TaskListInfo.RangeID
 
-	tlm := m.getTaskListManager(NewTestTaskListID(m.t, domainID, taskList, taskType))
+	tlm := m.getTaskListManagerByKey(testTaskListKey{domainID, taskList, taskType})
 	tlm.Lock()
 	defer tlm.Unlock()
 
@@ -276,7 +283,7 @@ func (m *TestTaskManager) GetTasks(
 ) (*persistence.GetTasksResponse, error) {
 	m.logger.Debug(fmt.Sprintf("testTaskManager.GetTasks readLevel=%v, maxReadLevel=%v", request.ReadLevel, *request.MaxReadLevel))
 
-	tlm := m.getTaskListManager(NewTestTaskListID(m.t, request.DomainID, request.TaskList, request.TaskType))
+	tlm := m.getTaskListManagerByKey(testTaskListKey{request.DomainID, request.TaskList, request.TaskType})
 	tlm.Lock()
 	defer tlm.Unlock()
 	var tasks []*persistence.TaskInfo
@@ -298,7 +305,7 @@ func (m *TestTaskManager) GetTasks(
 }
 
 func (m *TestTaskManager) GetTaskListSize(_ context.Context, request *persistence.GetTaskListSizeRequest) (*persistence.GetTaskListSizeResponse, error) {
-	tlm := m.getTaskListManager(NewTestTaskListID(m.t, request.DomainID, request.TaskListName, request.TaskListType))
+	tlm := m.getTaskListManagerByKey(testTaskListKey{request.DomainID, request.TaskListName, request.TaskListType})
 	tlm.Lock()
 	defer tlm.Unlock()
 	count := int64(0)
@@ -348,14 +355,18 @@ func (m *TestTaskManager) GetRangeID(taskList *Identifier) int64 {
 }
 
 func (m *TestTaskManager) getTaskListManager(id *Identifier) *testTaskListManager {
+	return m.getTaskListManagerByKey(testTaskListKey{id.GetDomainID(), id.GetName(), id.GetType()})
+}
+
+func (m *TestTaskManager) getTaskListManagerByKey(key testTaskListKey) *testTaskListManager {
 	m.Lock()
 	defer m.Unlock()
-	result, ok := m.taskLists[*id]
+	result, ok := m.taskLists[key]
 	if ok {
 		return result
 	}
 	result = newTestTaskListManager()
-	m.taskLists[*id] = result
+	m.taskLists[key] = result
 	return result
 }
 
@@ -394,6 +405,16 @@ func NewTestTaskListID(t *testing.T, domainID string, taskListName string, taskT
 	return id
 }
 
+// newBacklogTestTaskListID returns the identifier of the task list holding the backlog of a priority
+// level or fairness bucket, whose name does not parse as a task list partition
+func newBacklogTestTaskListID(domainID string, taskListName string, taskType int) *Identifier {
+	return &Identifier{
+		qualifiedTaskListName: qualifiedTaskListName{name: taskListName, baseName: taskListName},
+		domainID:              domainID,
+		taskType:              taskType,
+	}
+}
+
 func int64Comparator(a, b interface{}) int {
 	aAsserted := a.(int64)
 	bAsserted := b.(int64)
@@ -56,6 +56,38 @@ type ReplicateParams struct {
 	TargetCluster string
 }
 
+// ResetParams is the parameters for resetting workflow
+type ResetParams struct {
+	// ResetType decides how the reset point is chosen for each workflow. See ResetType* constants.
+	ResetType string
+	// BadBinaryChecksum is required by ResetTypeBadBinary
+	BadBinaryChecksum string
+	// SkipSignalReapply skips reapplying signals received after the reset point
+	SkipSignalReapply bool
+	// DryRun only reports the reset point chosen for each workflow without resetting it
+	DryRun bool
+}
+
+// TemplateParams is the parameters for operating workflows with payloads rendered per workflow.
+// The templates are Go text/template rendered with the visibility record of each matched workflow:
+// .WorkflowID, .RunID, .WorkflowType, .TaskList, .StartTime, .Memo and .SearchAttributes
+type TemplateParams struct {
+	// Operation is one of TemplateOperationSignal and TemplateOperationStartWorkflow
+	Operation string
+	// InputTemplate renders the input of the signal or of the started workflow
+	InputTemplate string
+	// SignalName is required by TemplateOperationSignal
+	SignalName string
+	// Below are required by TemplateOperationStartWorkflow
+	// WorkflowIDTemplate renders the ID of the started workflow
+	WorkflowIDTemplate           string
+	WorkflowType                 string
+	TaskList                     string
+	ExecutionStartToCloseTimeout time.Duration
+	// DecisionStartToCloseTimeout is optional, default to DefaultTemplateDecisionTimeout
+	DecisionStartToCloseTimeout time.Duration
+}
+
 // BatchParams is the parameters for batch operation workflow
 type BatchParams struct {
 	// Target domain to execute batch operation
@@ -64,7 +96,7 @@ type BatchParams struct {
 	Query string
 	// Reason for the operation
 	Reason string
-	// Supporting: reset,terminate
+	// Supporting: terminate,cancel,signal,replicate,refresh,reset,template
 	BatchType string
 
 	// Below are all optional
@@ -76,6 +108,10 @@ type BatchParams struct {
 	SignalParams SignalParams
 	// ReplicateParams is params only for BatchTypeReplicate
 	ReplicateParams ReplicateParams
+	// ResetParams is params only for BatchTypeReset
+	ResetParams ResetParams
+	// TemplateParams is params only for BatchTypeTemplate
+	TemplateParams TemplateParams
 	// RPS of processing. Default to DefaultRPS
 	// TODO we will implement smarter way than this static rate limiter: https://github.com/uber/cadence/issues/2138
 	RPS int
@@ -117,11 +153,38 @@ type HeartBeatDetails struct {
 	// running activity. Surfaced in the heartbeat so the UI can display the
 	// live, signal-tuned value.
 	Concurrency int
+	// ResetPoints is the reset point chosen for each workflow by a dry run of BatchTypeReset.
+	// Capped at MaxReportedResetPoints entries to keep the heartbeat small.
+	ResetPoints []ResetPointReport
+	// Failures is the workflows that give up due to errors.
+	// Capped at MaxReportedFailures entries to keep the heartbeat small.
+	Failures []FailureReport
+}
+
+// ResetPointReport is the reset point chosen for a workflow by a dry run of BatchTypeReset
+type ResetPointReport struct {
+	WorkflowID string
+	RunID      string
+	// BaseRunID is the run that would be reset, it differs from RunID when
+	// the bad binary reset point belongs to a previous run of the workflow
+	BaseRunID             string
+	DecisionFinishEventID int64
+}
+
+// FailureReport is the error of a workflow that gives up due to errors
+type FailureReport struct {
+	WorkflowID string
+	RunID      string
+	Error      string
 }
 
 type taskDetail struct {
 	execution types.WorkflowExecution
-	attempts  int
+	// visibility record of execution, used to render templates of BatchTypeTemplate
+	info     *types.WorkflowExecutionInfo
+	attempts int
 	// passing along the current heartbeat details to make heartbeat within a task so that it won't timeout
 	hbd HeartBeatDetails
+	// collecting the per workflow reports of the activity
+	recorder *progressRecorder
 }
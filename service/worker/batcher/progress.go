@@ -0,0 +1,78 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package batcher
+
+import (
+	"sync"
+
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	// MaxReportedResetPoints is the max number of reset points reported by a dry run of BatchTypeReset
+	MaxReportedResetPoints = 1000
+	// MaxReportedFailures is the max number of failed workflows reported by a batch operation
+	MaxReportedFailures = 100
+)
+
+// progressRecorder collects the per workflow reports of task processors until
+// the activity flushes them into its heartbeat details
+type progressRecorder struct {
+	sync.Mutex
+	resetPoints []ResetPointReport
+	failures    []FailureReport
+}
+
+func (r *progressRecorder) recordResetPoint(point ResetPointReport) {
+	r.Lock()
+	defer r.Unlock()
+	r.resetPoints = append(r.resetPoints, point)
+}
+
+func (r *progressRecorder) recordFailure(execution types.WorkflowExecution, err error) {
+	r.Lock()
+	defer r.Unlock()
+	r.failures = append(r.failures, FailureReport{
+		WorkflowID: execution.GetWorkflowID(),
+		RunID:      execution.GetRunID(),
+		Error:      err.Error(),
+	})
+}
+
+// flush moves the recorded reports into hbd, dropping those beyond the max number of reports
+func (r *progressRecorder) flush(hbd *HeartBeatDetails) {
+	r.Lock()
+	defer r.Unlock()
+	for _, p := range r.resetPoints {
+		if len(hbd.ResetPoints) >= MaxReportedResetPoints {
+			break
+		}
+		hbd.ResetPoints = append(hbd.ResetPoints, p)
+	}
+	for _, f := range r.failures {
+		if len(hbd.Failures) >= MaxReportedFailures {
+			break
+		}
+		hbd.Failures = append(hbd.Failures, f)
+	}
+	r.resetPoints = nil
+	r.failures = nil
+}
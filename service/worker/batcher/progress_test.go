@@ -0,0 +1,73 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package batcher
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/uber/cadence/common/types"
+)
+
+func TestProgressRecorderFlush(t *testing.T) {
+	recorder := &progressRecorder{}
+	execution := types.WorkflowExecution{
+		WorkflowID: "workflow-id",
+		RunID:      "run-id",
+	}
+	recorder.recordResetPoint(ResetPointReport{WorkflowID: "workflow-id", DecisionFinishEventID: 4})
+	recorder.recordFailure(execution, errors.New("transient error"))
+
+	var hbd HeartBeatDetails
+	recorder.flush(&hbd)
+	recorder.flush(&hbd)
+
+	if len(hbd.ResetPoints) != 1 || hbd.ResetPoints[0].DecisionFinishEventID != 4 {
+		t.Errorf("ResetPoints = %+v, want the recorded reset point once", hbd.ResetPoints)
+	}
+	wantFailure := FailureReport{WorkflowID: "workflow-id", RunID: "run-id", Error: "transient error"}
+	if len(hbd.Failures) != 1 || hbd.Failures[0] != wantFailure {
+		t.Errorf("Failures = %+v, want [%+v]", hbd.Failures, wantFailure)
+	}
+}
+
+func TestProgressRecorderFlushIsCapped(t *testing.T) {
+	recorder := &progressRecorder{}
+	for i := 0; i < MaxReportedResetPoints+1; i++ {
+		recorder.recordResetPoint(ResetPointReport{DecisionFinishEventID: int64(i)})
+	}
+	for i := 0; i < MaxReportedFailures+1; i++ {
+		recorder.recordFailure(types.WorkflowExecution{}, errors.New("error"))
+	}
+
+	var hbd HeartBeatDetails
+	recorder.flush(&hbd)
+	recorder.recordResetPoint(ResetPointReport{})
+	recorder.recordFailure(types.WorkflowExecution{}, errors.New("error"))
+	recorder.flush(&hbd)
+
+	if len(hbd.ResetPoints) != MaxReportedResetPoints {
+		t.Errorf("len(ResetPoints) = %d, want %d", len(hbd.ResetPoints), MaxReportedResetPoints)
+	}
+	if len(hbd.Failures) != MaxReportedFailures {
+		t.Errorf("len(Failures) = %d, want %d", len(hbd.Failures), MaxReportedFailures)
+	}
+}
@@ -0,0 +1,261 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package batcher
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/cadence/activity"
+	"golang.org/x/time/rate"
+
+	"github.com/uber/cadence/client/frontend"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	// ResetTypeLastDecisionCompleted resets to the last DecisionTaskCompleted event
+	ResetTypeLastDecisionCompleted = "LastDecisionCompleted"
+	// ResetTypeFirstDecisionCompleted resets to the first DecisionTaskCompleted event
+	ResetTypeFirstDecisionCompleted = "FirstDecisionCompleted"
+	// ResetTypeBadBinary resets to the first decision completed by ResetParams.BadBinaryChecksum
+	ResetTypeBadBinary = "BadBinary"
+
+	resetHistoryPageSize = 1000
+)
+
+// AllResetTypes is the reset types supported by BatchTypeReset
+var AllResetTypes = []string{ResetTypeLastDecisionCompleted, ResetTypeFirstDecisionCompleted, ResetTypeBadBinary}
+
+var resetRequestIDNamespace = uuid.NewSHA1(uuid.NameSpaceDNS, []byte("cadence.batcher.reset"))
+
+// resetHistory is what a reset batch needs from the history of a run
+type resetHistory struct {
+	firstDecisionCompletedID int64
+	lastDecisionCompletedID  int64
+	// resets maps the base run of each reset in the history to the request ID of that reset,
+	// a run reset from a run that was itself reset carries the resets of both
+	resets map[string]string
+}
+
+func resetWorkflow(
+	ctx context.Context,
+	client frontend.Client,
+	limiter *rate.Limiter,
+	batchParams BatchParams,
+	recorder *progressRecorder,
+	workflowID string,
+	runID string,
+) error {
+	logger := getActivityLogger(ctx).WithTags(tag.TargetWorkflowID(workflowID), tag.TargetWorkflowRunID(runID))
+	batchWorkflowID := activity.GetInfo(ctx).WorkflowExecution.ID
+	var history *resetHistory
+	if resetTypeNeedsHistory(batchParams.ResetParams.ResetType) {
+		var err error
+		if history, err = getResetHistory(ctx, client, limiter, batchParams.DomainName, workflowID, runID); err != nil {
+			return err
+		}
+		for baseRunID, requestID := range history.resets {
+			if requestID == getResetRequestID(batchWorkflowID, workflowID, baseRunID) {
+				// the run is the result of a reset by this batch, it is found by the query
+				// when the batch is retried or when the new run matches the query
+				logger.Info("Skip resetting workflow because it was reset by this batch", tag.WorkflowResetBaseRunID(baseRunID))
+				return nil
+			}
+		}
+	}
+
+	baseRunID, decisionFinishID, err := getResetPoint(ctx, client, batchParams, history, workflowID, runID)
+	if err != nil {
+		return err
+	}
+	if decisionFinishID == 0 {
+		logger.Info("Skip resetting workflow because no reset point is found")
+		return nil
+	}
+
+	if batchParams.ResetParams.DryRun {
+		logger.Info("Dry run of resetting workflow",
+			tag.WorkflowResetBaseRunID(baseRunID),
+			tag.WorkflowEventID(decisionFinishID))
+		recorder.recordResetPoint(ResetPointReport{
+			WorkflowID:            workflowID,
+			RunID:                 runID,
+			BaseRunID:             baseRunID,
+			DecisionFinishEventID: decisionFinishID,
+		})
+		return nil
+	}
+
+	resp, err := client.ResetWorkflowExecution(ctx, &types.ResetWorkflowExecutionRequest{
+		Domain: batchParams.DomainName,
+		WorkflowExecution: &types.WorkflowExecution{
+			WorkflowID: workflowID,
+			RunID:      baseRunID,
+		},
+		Reason:                batchParams.Reason,
+		DecisionFinishEventID: decisionFinishID,
+		RequestID:             getResetRequestID(batchWorkflowID, workflowID, baseRunID),
+		SkipSignalReapply:     batchParams.ResetParams.SkipSignalReapply,
+	})
+	if err != nil {
+		return err
+	}
+	logger.Info("Reset workflow",
+		tag.WorkflowResetBaseRunID(baseRunID),
+		tag.WorkflowEventID(decisionFinishID),
+		tag.WorkflowResetNewRunID(resp.GetRunID()))
+	return nil
+}
+
+// resetTypeNeedsHistory returns whether the reset point of a reset type is found in the history of the run.
+// ResetTypeBadBinary reads the auto reset points instead, the base run of a run it reset is the base run of
+// the auto reset point, so the reset request ID already dedups it against the reset by this batch.
+func resetTypeNeedsHistory(resetType string) bool {
+	switch resetType {
+	case ResetTypeLastDecisionCompleted, ResetTypeFirstDecisionCompleted:
+		return true
+	default:
+		return false
+	}
+}
+
+// getResetRequestID returns the request ID of the reset of a run by a batch. It is the same
+// for every attempt, so history dedups a retried reset against the run the first attempt created.
+func getResetRequestID(batchWorkflowID, workflowID, baseRunID string) string {
+	return uuid.NewSHA1(resetRequestIDNamespace, []byte(batchWorkflowID+"/"+workflowID+"/"+baseRunID)).String()
+}
+
+// getResetPoint returns the run and the DecisionFinishEventID to reset to, a zero
+// DecisionFinishEventID means the workflow has no reset point of the requested type
+func getResetPoint(
+	ctx context.Context,
+	client frontend.Client,
+	batchParams BatchParams,
+	history *resetHistory,
+	workflowID string,
+	runID string,
+) (baseRunID string, decisionFinishID int64, err error) {
+	switch batchParams.ResetParams.ResetType {
+	case ResetTypeLastDecisionCompleted:
+		return runID, history.lastDecisionCompletedID, nil
+	case ResetTypeFirstDecisionCompleted:
+		return runID, history.firstDecisionCompletedID, nil
+	case ResetTypeBadBinary:
+		return getBadBinaryResetPoint(ctx, client, batchParams.DomainName, workflowID, runID, batchParams.ResetParams.BadBinaryChecksum)
+	default:
+		return "", 0, fmt.Errorf("not supported reset type: %v", batchParams.ResetParams.ResetType)
+	}
+}
+
+// getResetHistory reads the whole history of a run, a reset can follow any DecisionTaskCompleted event.
+// Every page is read through the limiter of the batch, a long history counts as many requests.
+func getResetHistory(
+	ctx context.Context,
+	client frontend.Client,
+	limiter *rate.Limiter,
+	domain string,
+	workflowID string,
+	runID string,
+) (*resetHistory, error) {
+	req := &types.GetWorkflowExecutionHistoryRequest{
+		Domain: domain,
+		Execution: &types.WorkflowExecution{
+			WorkflowID: workflowID,
+			RunID:      runID,
+		},
+		MaximumPageSize: resetHistoryPageSize,
+	}
+
+	history := &resetHistory{resets: make(map[string]string)}
+	for {
+		if err := limiter.Wait(ctx); err != nil {
+			return nil, err
+		}
+		resp, err := client.GetWorkflowExecutionHistory(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		for _, e := range resp.GetHistory().GetEvents() {
+			switch e.GetEventType() {
+			case types.EventTypeDecisionTaskCompleted:
+				if history.firstDecisionCompletedID == 0 {
+					history.firstDecisionCompletedID = e.ID
+				}
+				history.lastDecisionCompletedID = e.ID
+			case types.EventTypeDecisionTaskFailed:
+				attr := e.DecisionTaskFailedEventAttributes
+				if attr.GetCause() == types.DecisionTaskFailedCauseResetWorkflow {
+					history.resets[attr.BaseRunID] = attr.RequestID
+				}
+			case types.EventTypeDecisionTaskTimedOut:
+				attr := e.DecisionTaskTimedOutEventAttributes
+				if attr.GetCause() == types.DecisionTaskTimedOutCauseReset {
+					history.resets[attr.BaseRunID] = attr.RequestID
+				}
+			}
+		}
+		if len(resp.NextPageToken) == 0 {
+			return history, nil
+		}
+		req.NextPageToken = resp.NextPageToken
+	}
+}
+
+func getBadBinaryResetPoint(
+	ctx context.Context,
+	client frontend.Client,
+	domain string,
+	workflowID string,
+	runID string,
+	binaryChecksum string,
+) (string, int64, error) {
+	resp, err := client.DescribeWorkflowExecution(ctx, &types.DescribeWorkflowExecutionRequest{
+		Domain: domain,
+		Execution: &types.WorkflowExecution{
+			WorkflowID: workflowID,
+			RunID:      runID,
+		},
+	})
+	if err != nil {
+		return "", 0, err
+	}
+
+	info := resp.GetWorkflowExecutionInfo()
+	if info == nil || info.AutoResetPoints == nil {
+		return "", 0, nil
+	}
+	nowNano := time.Now().UnixNano()
+	for _, p := range info.AutoResetPoints.Points {
+		if p.GetBinaryChecksum() != binaryChecksum || !p.GetResettable() {
+			continue
+		}
+		if p.GetExpiringTimeNano() > 0 && nowNano > p.GetExpiringTimeNano() {
+			// reset point has expired and the history may be already deleted
+			continue
+		}
+		return p.GetRunID(), p.GetFirstDecisionCompletedID(), nil
+	}
+	return "", 0, nil
+}
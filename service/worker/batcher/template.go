@@ -0,0 +1,267 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package batcher
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"text/template"
+	"time"
+
+	"github.com/uber/cadence/client/frontend"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	// TemplateOperationSignal signals each matched workflow with the rendered input
+	TemplateOperationSignal = "signal"
+	// TemplateOperationStartWorkflow starts a new workflow per matched workflow with the rendered workflow ID and input
+	TemplateOperationStartWorkflow = "start_workflow"
+
+	// DefaultTemplateDecisionTimeout is the default decision timeout of workflows started by TemplateOperationStartWorkflow
+	DefaultTemplateDecisionTimeout = 10 * time.Second
+
+	// maxRenderedTemplateSize caps the output of a template rendered for one workflow
+	maxRenderedTemplateSize = 256 * 1024
+	// templateRenderTimeout caps the time spent rendering a template for one workflow
+	templateRenderTimeout = time.Second
+)
+
+var (
+	errRenderedTemplateTooLarge = fmt.Errorf("rendered template exceeds %d bytes", maxRenderedTemplateSize)
+	errTemplateRenderTimeout    = fmt.Errorf("rendering template exceeds %v", templateRenderTimeout)
+)
+
+// AllTemplateOperations is the operations supported by BatchTypeTemplate
+var AllTemplateOperations = []string{TemplateOperationSignal, TemplateOperationStartWorkflow}
+
+var templateFuncs = template.FuncMap{
+	// json encodes a value so it can be embedded into a JSON input
+	"json": func(v interface{}) (string, error) {
+		b, err := json.Marshal(v)
+		return string(b), err
+	},
+}
+
+// templateData is what the templates of BatchTypeTemplate are rendered with
+type templateData struct {
+	WorkflowID   string
+	RunID        string
+	WorkflowType string
+	TaskList     string
+	StartTime    time.Time
+	// Memo and SearchAttributes are JSON decoded, values that are not JSON are kept as strings
+	Memo             map[string]interface{}
+	SearchAttributes map[string]interface{}
+}
+
+// templateRenderError is returned when a template can not be rendered for a workflow,
+// it is not retried because rendering the same workflow again fails the same way
+type templateRenderError struct {
+	err error
+}
+
+func (e *templateRenderError) Error() string {
+	return fmt.Sprintf("failed to render template: %v", e.err)
+}
+
+func newTemplateData(info *types.WorkflowExecutionInfo) templateData {
+	data := templateData{
+		WorkflowID:   info.GetExecution().GetWorkflowID(),
+		RunID:        info.GetExecution().GetRunID(),
+		WorkflowType: info.GetType().GetName(),
+	}
+	if info.TaskList != nil {
+		data.TaskList = info.TaskList.GetName()
+	}
+	if info.StartTime != nil {
+		data.StartTime = time.Unix(0, *info.StartTime)
+	}
+	if info.Memo != nil {
+		data.Memo = decodeTemplateFields(info.Memo.Fields)
+	}
+	if info.SearchAttributes != nil {
+		data.SearchAttributes = decodeTemplateFields(info.SearchAttributes.IndexedFields)
+	}
+	return data
+}
+
+func decodeTemplateFields(fields map[string][]byte) map[string]interface{} {
+	decoded := make(map[string]interface{}, len(fields))
+	for k, v := range fields {
+		var value interface{}
+		if err := json.Unmarshal(v, &value); err != nil {
+			value = string(v)
+		}
+		decoded[k] = value
+	}
+	return decoded
+}
+
+// batchTemplates is the parsed templates of TemplateParams, parsed once per activity and shared by the task processors
+type batchTemplates struct {
+	input      *template.Template
+	workflowID *template.Template
+}
+
+func parseTemplate(name, text string) (*template.Template, error) {
+	return template.New(name).Funcs(templateFuncs).Option("missingkey=error").Parse(text)
+}
+
+func parseBatchTemplates(params TemplateParams) (*batchTemplates, error) {
+	input, err := parseTemplate("input", params.InputTemplate)
+	if err != nil {
+		return nil, fmt.Errorf("invalid input template: %v", err)
+	}
+	templates := &batchTemplates{input: input}
+	if params.Operation == TemplateOperationStartWorkflow {
+		if templates.workflowID, err = parseTemplate("workflowID", params.WorkflowIDTemplate); err != nil {
+			return nil, fmt.Errorf("invalid workflow ID template: %v", err)
+		}
+	}
+	return templates, nil
+}
+
+// renderWriter fails the rendering once the output is too large or the deadline has passed,
+// which also stops templates that loop while writing
+type renderWriter struct {
+	buf      bytes.Buffer
+	deadline time.Time
+}
+
+func (w *renderWriter) Write(p []byte) (int, error) {
+	if w.buf.Len()+len(p) > maxRenderedTemplateSize {
+		return 0, errRenderedTemplateTooLarge
+	}
+	if time.Now().After(w.deadline) {
+		return 0, errTemplateRenderTimeout
+	}
+	return w.buf.Write(p)
+}
+
+func renderTemplate(ctx context.Context, tmpl *template.Template, data templateData) (string, error) {
+	w := &renderWriter{deadline: time.Now().Add(templateRenderTimeout)}
+	doneCh := make(chan error, 1)
+	go func() {
+		doneCh <- tmpl.Execute(w, data)
+	}()
+
+	timer := time.NewTimer(templateRenderTimeout)
+	defer timer.Stop()
+	select {
+	case err := <-doneCh:
+		if err != nil {
+			return "", &templateRenderError{err: err}
+		}
+		return w.buf.String(), nil
+	case <-timer.C:
+		// a template that loops without writing can not be interrupted, leave it behind
+		return "", &templateRenderError{err: errTemplateRenderTimeout}
+	case <-ctx.Done():
+		return "", ctx.Err()
+	}
+}
+
+func processTemplate(
+	ctx context.Context,
+	client frontend.Client,
+	batchParams BatchParams,
+	templates *batchTemplates,
+	info *types.WorkflowExecutionInfo,
+	workflowID string,
+	runID string,
+	requestID string,
+	identity string,
+) error {
+	params := batchParams.TemplateParams
+	data := newTemplateData(info)
+	input, err := renderTemplate(ctx, templates.input, data)
+	if err != nil {
+		return err
+	}
+
+	switch params.Operation {
+	case TemplateOperationSignal:
+		return client.SignalWorkflowExecution(ctx, &types.SignalWorkflowExecutionRequest{
+			Domain: batchParams.DomainName,
+			WorkflowExecution: &types.WorkflowExecution{
+				WorkflowID: workflowID,
+				RunID:      runID,
+			},
+			Identity:   identity,
+			RequestID:  requestID,
+			SignalName: params.SignalName,
+			Input:      []byte(input),
+		})
+	case TemplateOperationStartWorkflow:
+		newWorkflowID, err := renderTemplate(ctx, templates.workflowID, data)
+		if err != nil {
+			return err
+		}
+		decisionTimeout := params.DecisionStartToCloseTimeout
+		if decisionTimeout <= 0 {
+			decisionTimeout = DefaultTemplateDecisionTimeout
+		}
+		_, err = client.StartWorkflowExecution(ctx, &types.StartWorkflowExecutionRequest{
+			Domain:                              batchParams.DomainName,
+			WorkflowID:                          newWorkflowID,
+			WorkflowType:                        &types.WorkflowType{Name: params.WorkflowType},
+			TaskList:                            &types.TaskList{Name: params.TaskList},
+			Input:                               []byte(input),
+			ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(int32(params.ExecutionStartToCloseTimeout.Seconds())),
+			TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(int32(decisionTimeout.Seconds())),
+			Identity:                            identity,
+			RequestID:                           requestID,
+		})
+		// the workflow is started by a previous attempt or a previous run of the batch operation
+		var alreadyStarted *types.WorkflowExecutionAlreadyStartedError
+		if errors.As(err, &alreadyStarted) {
+			return nil
+		}
+		return err
+	default:
+		return fmt.Errorf("not supported template operation: %v", params.Operation)
+	}
+}
+
+func validateTemplateParams(params TemplateParams) error {
+	switch params.Operation {
+	case TemplateOperationSignal:
+		if params.SignalName == "" {
+			return fmt.Errorf("must provide signal name")
+		}
+	case TemplateOperationStartWorkflow:
+		if params.WorkflowIDTemplate == "" || params.WorkflowType == "" || params.TaskList == "" {
+			return fmt.Errorf("must provide workflow ID template, workflow type and task list")
+		}
+		if params.ExecutionStartToCloseTimeout <= 0 {
+			return fmt.Errorf("must provide execution start to close timeout")
+		}
+	default:
+		return fmt.Errorf("not supported template operation: %v", params.Operation)
+	}
+	_, err := parseBatchTemplates(params)
+	return err
+}
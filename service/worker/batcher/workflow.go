@@ -22,6 +22,7 @@ package batcher
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -85,10 +86,14 @@ const (
 	BatchTypeReplicate = "replicate"
 	// BatchTypeRefresh is batch type for refreshing workflow tasks.
 	BatchTypeRefresh = "refresh"
+	// BatchTypeReset is batch type for resetting workflows
+	BatchTypeReset = "reset"
+	// BatchTypeTemplate is batch type for signaling or starting workflows with payloads rendered per workflow
+	BatchTypeTemplate = "template"
 )
 
 // AllBatchTypes is the batch types we supported
-var AllBatchTypes = []string{BatchTypeTerminate, BatchTypeCancel, BatchTypeSignal, BatchTypeReplicate, BatchTypeRefresh}
+var AllBatchTypes = []string{BatchTypeTerminate, BatchTypeCancel, BatchTypeSignal, BatchTypeReplicate, BatchTypeRefresh, BatchTypeReset, BatchTypeTemplate}
 
 var (
 	BatchActivityRetryPolicy = cadence.RetryPolicy{
@@ -166,11 +171,19 @@ func BatchActivity(ctx context.Context, batchParams BatchParams) (HeartBeatDetai
 		}
 		hbd.TotalEstimate = resp.GetCount()
 	}
+	var templates *batchTemplates
+	if batchParams.BatchType == BatchTypeTemplate {
+		if templates, err = parseBatchTemplates(batchParams.TemplateParams); err != nil {
+			return HeartBeatDetails{}, cadence.NewCustomError(_nonRetriableReason, err.Error())
+		}
+	}
+
 	rateLimiter := rate.NewLimiter(rate.Limit(batchParams.RPS), batchParams.RPS)
+	recorder := &progressRecorder{}
 	taskCh := make(chan taskDetail, batchParams.PageSize)
 	respCh := make(chan error, batchParams.PageSize)
 	for i := 0; i < batchParams.Concurrency; i++ {
-		go startTaskProcessor(ctx, batchParams, domainID, taskCh, respCh, rateLimiter, client, adminClient, BatchWFTypeName)
+		go startTaskProcessor(ctx, batchParams, templates, domainID, taskCh, respCh, rateLimiter, client, adminClient, BatchWFTypeName)
 	}
 
 	for {
@@ -195,8 +208,10 @@ func BatchActivity(ctx context.Context, batchParams BatchParams) (HeartBeatDetai
 		for _, wf := range resp.Executions {
 			taskCh <- taskDetail{
 				execution: *wf.Execution,
+				info:      wf,
 				attempts:  0,
 				hbd:       hbd,
+				recorder:  recorder,
 			}
 		}
 
@@ -224,6 +239,7 @@ func BatchActivity(ctx context.Context, batchParams BatchParams) (HeartBeatDetai
 		hbd.PageToken = resp.NextPageToken
 		hbd.SuccessCount += succCount
 		hbd.ErrorCount += errCount
+		recorder.flush(&hbd)
 		activity.RecordHeartbeat(ctx, hbd)
 
 		if len(hbd.PageToken) == 0 {
@@ -251,6 +267,7 @@ func getHeartBeatDetails(ctx context.Context) (hbd HeartBeatDetails, ok bool) {
 func startTaskProcessor(
 	ctx context.Context,
 	batchParams BatchParams,
+	templates *batchTemplates,
 	domainID string,
 	taskCh chan taskDetail,
 	respCh chan error,
@@ -336,13 +353,25 @@ func startTaskProcessor(
 							},
 						})
 					})
+			case BatchTypeReset:
+				err = processTask(ctx, limiter, task, batchParams, client, common.BoolPtr(false),
+					func(workflowID, runID string) error {
+						return resetWorkflow(ctx, client, limiter, batchParams, task.recorder, workflowID, runID)
+					})
+			case BatchTypeTemplate:
+				err = processTask(ctx, limiter, task, batchParams, client, common.BoolPtr(false),
+					func(workflowID, runID string) error {
+						return processTemplate(ctx, client, batchParams, templates, task.info, workflowID, runID, requestID, identity)
+					})
 			}
 			if err != nil {
 				batcher.metricsClient.IncCounter(metrics.BatcherScope, metrics.BatcherProcessorFailures)
 				getActivityLogger(ctx).Error("Failed to process batch operation task", tag.Error(err))
 
 				_, ok := batchParams._nonRetryableErrors[err.Error()]
-				if ok || task.attempts >= batchParams.AttemptsOnRetryableError {
+				var renderErr *templateRenderError
+				if ok || errors.As(err, &renderErr) || task.attempts >= batchParams.AttemptsOnRetryableError {
+					task.recorder.recordFailure(task.execution, err)
 					respCh <- err
 				} else {
 					// put back to the channel if less than attemptsOnError
@@ -47,6 +47,20 @@ func validateParams(params BatchParams) error {
 			return fmt.Errorf("must provide target cluster")
 		}
 		return nil
+	case BatchTypeReset:
+		switch params.ResetParams.ResetType {
+		case ResetTypeLastDecisionCompleted, ResetTypeFirstDecisionCompleted:
+			return nil
+		case ResetTypeBadBinary:
+			if params.ResetParams.BadBinaryChecksum == "" {
+				return fmt.Errorf("must provide bad binary checksum")
+			}
+			return nil
+		default:
+			return fmt.Errorf("not supported reset type: %v", params.ResetParams.ResetType)
+		}
+	case BatchTypeTemplate:
+		return validateTemplateParams(params.TemplateParams)
 	case BatchTypeCancel:
 		fallthrough
 	case BatchTypeTerminate:
@@ -0,0 +1,490 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package batcher
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go.uber.org/cadence/testsuite"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/yarpc"
+
+	"github.com/uber/cadence/client/frontend"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/types"
+)
+
+func TestResetBatchActivity(t *testing.T) {
+	tests := []struct {
+		name     string
+		activity interface{}
+	}{
+		{
+			name:     "V1",
+			activity: BatchActivity,
+		},
+		{
+			name:     "V2",
+			activity: batchActivityV2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var suite testsuite.WorkflowTestSuite
+			activityEnv := suite.NewTestActivityEnvironment()
+			activityEnv.RegisterActivity(tt.activity)
+
+			batcher, mockResource := setuptest(t)
+			setRefreshActivityWorkerOptions(activityEnv, batcher)
+
+			params := createResetParams(ResetTypeLastDecisionCompleted)
+			params.ResetParams.SkipSignalReapply = true
+			expectedExecution := &types.WorkflowExecution{
+				WorkflowID: "workflow-id",
+				RunID:      "run-id",
+			}
+
+			expectBatchScan(mockResource.FrontendClient, expectedExecution)
+			firstPage := mockResource.FrontendClient.EXPECT().
+				GetWorkflowExecutionHistory(gomock.Any(), &types.GetWorkflowExecutionHistoryRequest{
+					Domain:          params.DomainName,
+					Execution:       expectedExecution,
+					MaximumPageSize: resetHistoryPageSize,
+				}).
+				Return(&types.GetWorkflowExecutionHistoryResponse{
+					History:       historyWithDecisionCompleted(4),
+					NextPageToken: []byte("next-page"),
+				}, nil)
+			secondPage := mockResource.FrontendClient.EXPECT().
+				GetWorkflowExecutionHistory(gomock.Any(), &types.GetWorkflowExecutionHistoryRequest{
+					Domain:          params.DomainName,
+					Execution:       expectedExecution,
+					MaximumPageSize: resetHistoryPageSize,
+					NextPageToken:   []byte("next-page"),
+				}).
+				Return(&types.GetWorkflowExecutionHistoryResponse{
+					History: historyWithDecisionCompleted(8),
+				}, nil)
+			reset := mockResource.FrontendClient.EXPECT().
+				ResetWorkflowExecution(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, req *types.ResetWorkflowExecutionRequest, _ ...yarpc.CallOption) (*types.ResetWorkflowExecutionResponse, error) {
+					if req.DecisionFinishEventID != 8 {
+						t.Errorf("DecisionFinishEventID = %d, want 8", req.DecisionFinishEventID)
+					}
+					if *req.WorkflowExecution != *expectedExecution {
+						t.Errorf("WorkflowExecution = %+v, want %+v", req.WorkflowExecution, expectedExecution)
+					}
+					wantRequestID := getResetRequestID("default-test-workflow-id", "workflow-id", "run-id")
+					if req.Reason != params.Reason || !req.SkipSignalReapply || req.RequestID != wantRequestID {
+						t.Errorf("ResetWorkflowExecution() request = %+v", req)
+					}
+					return &types.ResetWorkflowExecutionResponse{RunID: "new-run-id"}, nil
+				})
+			describe := mockResource.FrontendClient.EXPECT().
+				DescribeWorkflowExecution(gomock.Any(), gomock.Any()).
+				Return(&types.DescribeWorkflowExecutionResponse{}, nil)
+			gomock.InOrder(firstPage, secondPage, reset, describe)
+
+			value, err := activityEnv.ExecuteActivity(tt.activity, params)
+			if err != nil {
+				t.Fatalf("ExecuteActivity() error = %v", err)
+			}
+
+			var result HeartBeatDetails
+			if err := value.Get(&result); err != nil {
+				t.Fatalf("Get() error = %v", err)
+			}
+			if result.SuccessCount != 1 || result.ErrorCount != 0 {
+				t.Errorf("progress = %+v, want one successful reset", result)
+			}
+			if len(result.ResetPoints) != 0 {
+				t.Errorf("ResetPoints = %+v, want none outside of dry run", result.ResetPoints)
+			}
+		})
+	}
+}
+
+func TestResetBatchActivityDryRun(t *testing.T) {
+	var suite testsuite.WorkflowTestSuite
+	activityEnv := suite.NewTestActivityEnvironment()
+	activityEnv.RegisterActivity(batchActivityV2)
+
+	batcher, mockResource := setuptest(t)
+	setRefreshActivityWorkerOptions(activityEnv, batcher)
+
+	params := createResetParams(ResetTypeFirstDecisionCompleted)
+	params.ResetParams.DryRun = true
+	expectedExecution := &types.WorkflowExecution{
+		WorkflowID: "workflow-id",
+		RunID:      "run-id",
+	}
+
+	expectBatchScan(mockResource.FrontendClient, expectedExecution)
+	firstPage := mockResource.FrontendClient.EXPECT().
+		GetWorkflowExecutionHistory(gomock.Any(), gomock.Any()).
+		Return(&types.GetWorkflowExecutionHistoryResponse{
+			History:       historyWithDecisionCompleted(4),
+			NextPageToken: []byte("next-page"),
+		}, nil)
+	mockResource.FrontendClient.EXPECT().
+		GetWorkflowExecutionHistory(gomock.Any(), gomock.Any()).
+		Return(&types.GetWorkflowExecutionHistoryResponse{
+			History: historyWithDecisionCompleted(8),
+		}, nil).
+		After(firstPage)
+	mockResource.FrontendClient.EXPECT().
+		DescribeWorkflowExecution(gomock.Any(), gomock.Any()).
+		Return(&types.DescribeWorkflowExecutionResponse{}, nil)
+
+	value, err := activityEnv.ExecuteActivity(batchActivityV2, params)
+	if err != nil {
+		t.Fatalf("ExecuteActivity() error = %v", err)
+	}
+
+	var result HeartBeatDetails
+	if err := value.Get(&result); err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if result.SuccessCount != 1 || result.ErrorCount != 0 {
+		t.Errorf("progress = %+v, want one successful dry run", result)
+	}
+	want := ResetPointReport{
+		WorkflowID:            "workflow-id",
+		RunID:                 "run-id",
+		BaseRunID:             "run-id",
+		DecisionFinishEventID: 4,
+	}
+	if len(result.ResetPoints) != 1 || result.ResetPoints[0] != want {
+		t.Errorf("ResetPoints = %+v, want [%+v]", result.ResetPoints, want)
+	}
+}
+
+func TestResetBatchActivitySkipsWorkflowWithoutResetPoint(t *testing.T) {
+	var suite testsuite.WorkflowTestSuite
+	activityEnv := suite.NewTestActivityEnvironment()
+	activityEnv.RegisterActivity(batchActivityV2)
+
+	batcher, mockResource := setuptest(t)
+	setRefreshActivityWorkerOptions(activityEnv, batcher)
+
+	params := createResetParams(ResetTypeLastDecisionCompleted)
+	expectedExecution := &types.WorkflowExecution{
+		WorkflowID: "workflow-id",
+		RunID:      "run-id",
+	}
+
+	expectBatchScan(mockResource.FrontendClient, expectedExecution)
+	mockResource.FrontendClient.EXPECT().
+		GetWorkflowExecutionHistory(gomock.Any(), gomock.Any()).
+		Return(&types.GetWorkflowExecutionHistoryResponse{
+			History: &types.History{Events: []*types.HistoryEvent{{
+				ID:        1,
+				EventType: types.EventTypeWorkflowExecutionStarted.Ptr(),
+			}}},
+		}, nil)
+	mockResource.FrontendClient.EXPECT().
+		DescribeWorkflowExecution(gomock.Any(), gomock.Any()).
+		Return(&types.DescribeWorkflowExecutionResponse{}, nil)
+
+	value, err := activityEnv.ExecuteActivity(batchActivityV2, params)
+	if err != nil {
+		t.Fatalf("ExecuteActivity() error = %v", err)
+	}
+
+	var result HeartBeatDetails
+	if err := value.Get(&result); err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if result.SuccessCount != 1 || result.ErrorCount != 0 {
+		t.Errorf("progress = %+v, want one skipped workflow", result)
+	}
+}
+
+func TestResetBatchActivitySkipsRunResetByBatch(t *testing.T) {
+	tests := []struct {
+		name      string
+		requestID string
+		wantReset bool
+	}{
+		{
+			name:      "reset by this batch",
+			requestID: getResetRequestID("default-test-workflow-id", "workflow-id", "base-run-id"),
+		},
+		{
+			name:      "reset by another batch",
+			requestID: getResetRequestID("other-batch-id", "workflow-id", "base-run-id"),
+			wantReset: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var suite testsuite.WorkflowTestSuite
+			activityEnv := suite.NewTestActivityEnvironment()
+			activityEnv.RegisterActivity(batchActivityV2)
+
+			batcher, mockResource := setuptest(t)
+			setRefreshActivityWorkerOptions(activityEnv, batcher)
+
+			params := createResetParams(ResetTypeLastDecisionCompleted)
+			expectedExecution := &types.WorkflowExecution{
+				WorkflowID: "workflow-id",
+				RunID:      "run-id",
+			}
+
+			history := historyWithDecisionCompleted(4)
+			history.Events = append(history.Events, &types.HistoryEvent{
+				ID:        5,
+				EventType: types.EventTypeDecisionTaskFailed.Ptr(),
+				DecisionTaskFailedEventAttributes: &types.DecisionTaskFailedEventAttributes{
+					Cause:     types.DecisionTaskFailedCauseResetWorkflow.Ptr(),
+					BaseRunID: "base-run-id",
+					NewRunID:  "run-id",
+					RequestID: tt.requestID,
+				},
+			})
+			expectBatchScan(mockResource.FrontendClient, expectedExecution)
+			mockResource.FrontendClient.EXPECT().
+				GetWorkflowExecutionHistory(gomock.Any(), gomock.Any()).
+				Return(&types.GetWorkflowExecutionHistoryResponse{History: history}, nil)
+			if tt.wantReset {
+				mockResource.FrontendClient.EXPECT().
+					ResetWorkflowExecution(gomock.Any(), gomock.Any()).
+					Return(&types.ResetWorkflowExecutionResponse{RunID: "new-run-id"}, nil)
+			}
+			mockResource.FrontendClient.EXPECT().
+				DescribeWorkflowExecution(gomock.Any(), gomock.Any()).
+				Return(&types.DescribeWorkflowExecutionResponse{}, nil)
+
+			value, err := activityEnv.ExecuteActivity(batchActivityV2, params)
+			if err != nil {
+				t.Fatalf("ExecuteActivity() error = %v", err)
+			}
+
+			var result HeartBeatDetails
+			if err := value.Get(&result); err != nil {
+				t.Fatalf("Get() error = %v", err)
+			}
+			if result.SuccessCount != 1 || result.ErrorCount != 0 {
+				t.Errorf("progress = %+v, want one processed workflow", result)
+			}
+		})
+	}
+}
+
+func TestResetBatchActivityBadBinaryDoesNotReadHistory(t *testing.T) {
+	var suite testsuite.WorkflowTestSuite
+	activityEnv := suite.NewTestActivityEnvironment()
+	activityEnv.RegisterActivity(batchActivityV2)
+
+	batcher, mockResource := setuptest(t)
+	setRefreshActivityWorkerOptions(activityEnv, batcher)
+
+	params := createResetParams(ResetTypeBadBinary)
+	expectedExecution := &types.WorkflowExecution{
+		WorkflowID: "workflow-id",
+		RunID:      "run-id",
+	}
+
+	expectBatchScan(mockResource.FrontendClient, expectedExecution)
+	resetPoint := mockResource.FrontendClient.EXPECT().
+		DescribeWorkflowExecution(gomock.Any(), gomock.Any()).
+		Return(&types.DescribeWorkflowExecutionResponse{
+			WorkflowExecutionInfo: &types.WorkflowExecutionInfo{AutoResetPoints: &types.ResetPoints{Points: []*types.ResetPointInfo{
+				{BinaryChecksum: "bad-binary", RunID: "base-run-id", FirstDecisionCompletedID: 4, Resettable: true},
+			}}},
+		}, nil)
+	reset := mockResource.FrontendClient.EXPECT().
+		ResetWorkflowExecution(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, req *types.ResetWorkflowExecutionRequest, _ ...yarpc.CallOption) (*types.ResetWorkflowExecutionResponse, error) {
+			if req.WorkflowExecution.RunID != "base-run-id" || req.DecisionFinishEventID != 4 {
+				t.Errorf("ResetWorkflowExecution() request = %+v, want a reset of base-run-id to event 4", req)
+			}
+			if wantRequestID := getResetRequestID("default-test-workflow-id", "workflow-id", "base-run-id"); req.RequestID != wantRequestID {
+				t.Errorf("RequestID = %q, want %q", req.RequestID, wantRequestID)
+			}
+			return &types.ResetWorkflowExecutionResponse{RunID: "new-run-id"}, nil
+		})
+	describe := mockResource.FrontendClient.EXPECT().
+		DescribeWorkflowExecution(gomock.Any(), gomock.Any()).
+		Return(&types.DescribeWorkflowExecutionResponse{}, nil)
+	gomock.InOrder(resetPoint, reset, describe)
+
+	value, err := activityEnv.ExecuteActivity(batchActivityV2, params)
+	if err != nil {
+		t.Fatalf("ExecuteActivity() error = %v", err)
+	}
+
+	var result HeartBeatDetails
+	if err := value.Get(&result); err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if result.SuccessCount != 1 || result.ErrorCount != 0 {
+		t.Errorf("progress = %+v, want one successful reset", result)
+	}
+}
+
+func TestGetResetRequestID(t *testing.T) {
+	requestID := getResetRequestID("batch-id", "workflow-id", "run-id")
+	if again := getResetRequestID("batch-id", "workflow-id", "run-id"); again != requestID {
+		t.Errorf("getResetRequestID() = %q then %q, want the same request ID on retry", requestID, again)
+	}
+	for _, other := range []string{
+		getResetRequestID("other-batch-id", "workflow-id", "run-id"),
+		getResetRequestID("batch-id", "other-workflow-id", "run-id"),
+		getResetRequestID("batch-id", "workflow-id", "other-run-id"),
+	} {
+		if other == requestID {
+			t.Errorf("getResetRequestID() = %q for a different batch or run", other)
+		}
+	}
+}
+
+func TestGetBadBinaryResetPoint(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name          string
+		points        *types.ResetPoints
+		wantBaseRunID string
+		wantEventID   int64
+	}{
+		{
+			name: "no auto reset points",
+		},
+		{
+			name: "first resettable and unexpired point of the checksum",
+			points: &types.ResetPoints{Points: []*types.ResetPointInfo{
+				{BinaryChecksum: "good-binary", RunID: "run-id", FirstDecisionCompletedID: 2, Resettable: true},
+				{BinaryChecksum: "bad-binary", RunID: "run-id", FirstDecisionCompletedID: 4, Resettable: false},
+				{BinaryChecksum: "bad-binary", RunID: "run-id", FirstDecisionCompletedID: 6, Resettable: true, ExpiringTimeNano: common.Int64Ptr(now.Add(-time.Hour).UnixNano())},
+				{BinaryChecksum: "bad-binary", RunID: "previous-run-id", FirstDecisionCompletedID: 8, Resettable: true, ExpiringTimeNano: common.Int64Ptr(now.Add(time.Hour).UnixNano())},
+				{BinaryChecksum: "bad-binary", RunID: "run-id", FirstDecisionCompletedID: 10, Resettable: true},
+			}},
+			wantBaseRunID: "previous-run-id",
+			wantEventID:   8,
+		},
+		{
+			name: "no point of the checksum",
+			points: &types.ResetPoints{Points: []*types.ResetPointInfo{
+				{BinaryChecksum: "good-binary", RunID: "run-id", FirstDecisionCompletedID: 2, Resettable: true},
+			}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, mockResource := setuptest(t)
+			mockResource.FrontendClient.EXPECT().
+				DescribeWorkflowExecution(gomock.Any(), &types.DescribeWorkflowExecutionRequest{
+					Domain: "test-domain",
+					Execution: &types.WorkflowExecution{
+						WorkflowID: "workflow-id",
+						RunID:      "run-id",
+					},
+				}).
+				Return(&types.DescribeWorkflowExecutionResponse{
+					WorkflowExecutionInfo: &types.WorkflowExecutionInfo{AutoResetPoints: tt.points},
+				}, nil)
+
+			baseRunID, eventID, err := getBadBinaryResetPoint(context.Background(), mockResource.FrontendClient, "test-domain", "workflow-id", "run-id", "bad-binary")
+			if err != nil {
+				t.Fatalf("getBadBinaryResetPoint() error = %v", err)
+			}
+			if baseRunID != tt.wantBaseRunID || eventID != tt.wantEventID {
+				t.Errorf("getBadBinaryResetPoint() = (%q, %d), want (%q, %d)", baseRunID, eventID, tt.wantBaseRunID, tt.wantEventID)
+			}
+		})
+	}
+}
+
+func TestValidateResetBatchParams(t *testing.T) {
+	badBinaryWithoutChecksum := createResetParams(ResetTypeBadBinary)
+	badBinaryWithoutChecksum.ResetParams.BadBinaryChecksum = ""
+
+	tests := []struct {
+		name    string
+		params  BatchParams
+		wantErr string
+	}{
+		{
+			name:   "last decision completed",
+			params: createResetParams(ResetTypeLastDecisionCompleted),
+		},
+		{
+			name:   "first decision completed",
+			params: createResetParams(ResetTypeFirstDecisionCompleted),
+		},
+		{
+			name:   "bad binary",
+			params: createResetParams(ResetTypeBadBinary),
+		},
+		{
+			name:    "bad binary without checksum",
+			params:  badBinaryWithoutChecksum,
+			wantErr: "must provide bad binary checksum",
+		},
+		{
+			name:    "unsupported reset type",
+			params:  createResetParams("LastContinuedAsNew"),
+			wantErr: "not supported reset type: LastContinuedAsNew",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateParams(tt.params)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("validateParams() error = %v", err)
+				}
+				return
+			}
+			if err == nil || err.Error() != tt.wantErr {
+				t.Fatalf("validateParams() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func createResetParams(resetType string) BatchParams {
+	params := createParams(BatchTypeReset)
+	params.Concurrency = 1
+	params.RPS = 100
+	params.ResetParams = ResetParams{
+		ResetType:         resetType,
+		BadBinaryChecksum: "bad-binary",
+	}
+	return params
+}
+
+func expectBatchScan(client *frontend.MockClient, execution *types.WorkflowExecution) {
+	expectBatchScanInfo(client, &types.WorkflowExecutionInfo{Execution: execution})
+}
+
+func historyWithDecisionCompleted(decisionCompletedID int64) *types.History {
+	return &types.History{Events: []*types.HistoryEvent{
+		{ID: decisionCompletedID - 1, EventType: types.EventTypeDecisionTaskStarted.Ptr()},
+		{ID: decisionCompletedID, EventType: types.EventTypeDecisionTaskCompleted.Ptr()},
+	}}
+}
@@ -0,0 +1,345 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package batcher
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"go.uber.org/cadence/testsuite"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/yarpc"
+
+	"github.com/uber/cadence/client/frontend"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/types"
+)
+
+func TestTemplateBatchActivitySignal(t *testing.T) {
+	tests := []struct {
+		name     string
+		activity interface{}
+	}{
+		{
+			name:     "V1",
+			activity: BatchActivity,
+		},
+		{
+			name:     "V2",
+			activity: batchActivityV2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var suite testsuite.WorkflowTestSuite
+			activityEnv := suite.NewTestActivityEnvironment()
+			activityEnv.RegisterActivity(tt.activity)
+
+			batcher, mockResource := setuptest(t)
+			setRefreshActivityWorkerOptions(activityEnv, batcher)
+
+			params := createTemplateParams(TemplateOperationSignal)
+			info := createTemplateExecutionInfo()
+
+			expectBatchScanInfo(mockResource.FrontendClient, info)
+			mockResource.FrontendClient.EXPECT().
+				SignalWorkflowExecution(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, req *types.SignalWorkflowExecutionRequest, _ ...yarpc.CallOption) error {
+					if *req.WorkflowExecution != *info.Execution {
+						t.Errorf("WorkflowExecution = %+v, want %+v", req.WorkflowExecution, info.Execution)
+					}
+					if req.SignalName != "migrate" {
+						t.Errorf("SignalName = %q, want %q", req.SignalName, "migrate")
+					}
+					if want := `{"id":"workflow-id","owner":"team-a","shard":3}`; string(req.Input) != want {
+						t.Errorf("Input = %s, want %s", req.Input, want)
+					}
+					return nil
+				})
+			mockResource.FrontendClient.EXPECT().
+				DescribeWorkflowExecution(gomock.Any(), gomock.Any()).
+				Return(&types.DescribeWorkflowExecutionResponse{}, nil)
+
+			value, err := activityEnv.ExecuteActivity(tt.activity, params)
+			if err != nil {
+				t.Fatalf("ExecuteActivity() error = %v", err)
+			}
+
+			var result HeartBeatDetails
+			if err := value.Get(&result); err != nil {
+				t.Fatalf("Get() error = %v", err)
+			}
+			if result.SuccessCount != 1 || result.ErrorCount != 0 || len(result.Failures) != 0 {
+				t.Errorf("progress = %+v, want one successful signal", result)
+			}
+		})
+	}
+}
+
+func TestTemplateBatchActivityStartWorkflow(t *testing.T) {
+	var suite testsuite.WorkflowTestSuite
+	activityEnv := suite.NewTestActivityEnvironment()
+	activityEnv.RegisterActivity(batchActivityV2)
+
+	batcher, mockResource := setuptest(t)
+	setRefreshActivityWorkerOptions(activityEnv, batcher)
+
+	params := createTemplateParams(TemplateOperationStartWorkflow)
+	info := createTemplateExecutionInfo()
+
+	expectBatchScanInfo(mockResource.FrontendClient, info)
+	mockResource.FrontendClient.EXPECT().
+		StartWorkflowExecution(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, req *types.StartWorkflowExecutionRequest, _ ...yarpc.CallOption) (*types.StartWorkflowExecutionResponse, error) {
+			if req.WorkflowID != "workflow-id-companion" {
+				t.Errorf("WorkflowID = %q, want %q", req.WorkflowID, "workflow-id-companion")
+			}
+			if req.WorkflowType.GetName() != "companion-type" || req.GetTaskList().GetName() != "companion-tasklist" {
+				t.Errorf("StartWorkflowExecution() request = %+v", req)
+			}
+			if req.GetExecutionStartToCloseTimeoutSeconds() != 3600 || req.GetTaskStartToCloseTimeoutSeconds() != 10 {
+				t.Errorf("timeouts = (%d, %d), want (3600, 10)", req.GetExecutionStartToCloseTimeoutSeconds(), req.GetTaskStartToCloseTimeoutSeconds())
+			}
+			// a previous attempt has started the workflow
+			return nil, &types.WorkflowExecutionAlreadyStartedError{}
+		})
+	mockResource.FrontendClient.EXPECT().
+		DescribeWorkflowExecution(gomock.Any(), gomock.Any()).
+		Return(&types.DescribeWorkflowExecutionResponse{}, nil)
+
+	value, err := activityEnv.ExecuteActivity(batchActivityV2, params)
+	if err != nil {
+		t.Fatalf("ExecuteActivity() error = %v", err)
+	}
+
+	var result HeartBeatDetails
+	if err := value.Get(&result); err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if result.SuccessCount != 1 || result.ErrorCount != 0 {
+		t.Errorf("progress = %+v, want one successful start", result)
+	}
+}
+
+func TestTemplateBatchActivityReportsRenderFailure(t *testing.T) {
+	var suite testsuite.WorkflowTestSuite
+	activityEnv := suite.NewTestActivityEnvironment()
+	activityEnv.RegisterActivity(batchActivityV2)
+
+	batcher, mockResource := setuptest(t)
+	setRefreshActivityWorkerOptions(activityEnv, batcher)
+
+	params := createTemplateParams(TemplateOperationSignal)
+	params.TemplateParams.InputTemplate = `{{.Memo.missing}}`
+	params.AttemptsOnRetryableError = 5
+	info := createTemplateExecutionInfo()
+
+	expectBatchScanInfo(mockResource.FrontendClient, info)
+
+	value, err := activityEnv.ExecuteActivity(batchActivityV2, params)
+	if err != nil {
+		t.Fatalf("ExecuteActivity() error = %v", err)
+	}
+
+	var result HeartBeatDetails
+	if err := value.Get(&result); err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if result.SuccessCount != 0 || result.ErrorCount != 1 {
+		t.Errorf("progress = %+v, want one failure", result)
+	}
+	if len(result.Failures) != 1 || result.Failures[0].WorkflowID != "workflow-id" || result.Failures[0].RunID != "run-id" {
+		t.Fatalf("Failures = %+v, want the failure of workflow-id/run-id", result.Failures)
+	}
+	if want := `failed to render template: template: input:1:7: executing "input" at <.Memo.missing>: map has no entry for key "missing"`; result.Failures[0].Error != want {
+		t.Errorf("Failures[0].Error = %q, want %q", result.Failures[0].Error, want)
+	}
+}
+
+func TestRenderTemplate(t *testing.T) {
+	tests := []struct {
+		name     string
+		template string
+		data     *templateData
+		want     string
+		wantErr  bool
+	}{
+		{
+			name:     "workflow fields",
+			template: `{{.WorkflowID}}/{{.RunID}}/{{.WorkflowType}}/{{.TaskList}}/{{.StartTime.Unix}}`,
+			want:     "workflow-id/run-id/workflow-type/tasklist/1700000000",
+		},
+		{
+			name:     "json encoded search attributes and memo",
+			template: `{{json .SearchAttributes.CustomKeywordField}} {{json .Memo.owner}} {{.Memo.raw}}`,
+			want:     `"team-a" "team-a" not-json`,
+		},
+		{
+			name:     "missing key",
+			template: `{{.SearchAttributes.missing}}`,
+			wantErr:  true,
+		},
+		{
+			name:     "too large output",
+			template: `{{.WorkflowID}}`,
+			data:     &templateData{WorkflowID: strings.Repeat("x", maxRenderedTemplateSize+1)},
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpl, err := parseTemplate("input", tt.template)
+			if err != nil {
+				t.Fatalf("parseTemplate() error = %v", err)
+			}
+			data := newTemplateData(createTemplateExecutionInfo())
+			if tt.data != nil {
+				data = *tt.data
+			}
+			got, err := renderTemplate(context.Background(), tmpl, data)
+			if tt.wantErr {
+				if _, ok := err.(*templateRenderError); !ok {
+					t.Fatalf("renderTemplate() error = %v, want a templateRenderError", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("renderTemplate() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("renderTemplate() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateTemplateBatchParams(t *testing.T) {
+	startWithoutTimeout := createTemplateParams(TemplateOperationStartWorkflow)
+	startWithoutTimeout.TemplateParams.ExecutionStartToCloseTimeout = 0
+	signalWithoutName := createTemplateParams(TemplateOperationSignal)
+	signalWithoutName.TemplateParams.SignalName = ""
+	invalidInput := createTemplateParams(TemplateOperationSignal)
+	invalidInput.TemplateParams.InputTemplate = `{{.WorkflowID`
+
+	tests := []struct {
+		name    string
+		params  BatchParams
+		wantErr string
+	}{
+		{
+			name:   "signal",
+			params: createTemplateParams(TemplateOperationSignal),
+		},
+		{
+			name:   "start workflow",
+			params: createTemplateParams(TemplateOperationStartWorkflow),
+		},
+		{
+			name:    "signal without signal name",
+			params:  signalWithoutName,
+			wantErr: "must provide signal name",
+		},
+		{
+			name:    "start workflow without timeout",
+			params:  startWithoutTimeout,
+			wantErr: "must provide execution start to close timeout",
+		},
+		{
+			name:    "invalid input template",
+			params:  invalidInput,
+			wantErr: "invalid input template: template: input:1: unclosed action",
+		},
+		{
+			name:    "unsupported operation",
+			params:  createTemplateParams("terminate"),
+			wantErr: "not supported template operation: terminate",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateParams(tt.params)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("validateParams() error = %v", err)
+				}
+				return
+			}
+			if err == nil || err.Error() != tt.wantErr {
+				t.Fatalf("validateParams() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func createTemplateParams(operation string) BatchParams {
+	params := createParams(BatchTypeTemplate)
+	params.Concurrency = 1
+	params.RPS = 100
+	params.TemplateParams = TemplateParams{
+		Operation:                    operation,
+		InputTemplate:                `{"id":{{json .WorkflowID}},"owner":{{json .SearchAttributes.CustomKeywordField}},"shard":{{.Memo.shard}}}`,
+		SignalName:                   "migrate",
+		WorkflowIDTemplate:           `{{.WorkflowID}}-companion`,
+		WorkflowType:                 "companion-type",
+		TaskList:                     "companion-tasklist",
+		ExecutionStartToCloseTimeout: time.Hour,
+	}
+	return params
+}
+
+func createTemplateExecutionInfo() *types.WorkflowExecutionInfo {
+	return &types.WorkflowExecutionInfo{
+		Execution: &types.WorkflowExecution{
+			WorkflowID: "workflow-id",
+			RunID:      "run-id",
+		},
+		Type:      &types.WorkflowType{Name: "workflow-type"},
+		TaskList:  &types.TaskList{Name: "tasklist"},
+		StartTime: common.Int64Ptr(time.Unix(1700000000, 0).UnixNano()),
+		Memo: &types.Memo{Fields: map[string][]byte{
+			"owner": []byte(`"team-a"`),
+			"shard": []byte(`3`),
+			"raw":   []byte(`not-json`),
+		}},
+		SearchAttributes: &types.SearchAttributes{IndexedFields: map[string][]byte{
+			"CustomKeywordField": []byte(`"team-a"`),
+		}},
+	}
+}
+
+func expectBatchScanInfo(client *frontend.MockClient, info *types.WorkflowExecutionInfo) {
+	client.EXPECT().
+		DescribeDomain(gomock.Any(), gomock.Any()).
+		Return(&types.DescribeDomainResponse{}, nil)
+	client.EXPECT().
+		CountWorkflowExecutions(gomock.Any(), gomock.Any()).
+		Return(&types.CountWorkflowExecutionsResponse{Count: 1}, nil)
+	client.EXPECT().
+		ScanWorkflowExecutions(gomock.Any(), gomock.Any()).
+		Return(&types.ListWorkflowExecutionsResponse{
+			Executions: []*types.WorkflowExecutionInfo{info},
+		}, nil)
+}
@@ -187,11 +187,19 @@ func batchActivityV2(ctx context.Context, params BatchParams) (HeartBeatDetails,
 	hbd.RPS = params.RPS
 	hbd.Concurrency = params.Concurrency
 
+	var templates *batchTemplates
+	if params.BatchType == BatchTypeTemplate {
+		if templates, err = parseBatchTemplates(params.TemplateParams); err != nil {
+			return HeartBeatDetails{}, cadence.NewCustomError(_nonRetriableReason, err.Error())
+		}
+	}
+
 	rateLimiter := rate.NewLimiter(rate.Limit(params.RPS), params.RPS)
+	recorder := &progressRecorder{}
 	taskCh := make(chan taskDetail, params.PageSize)
 	respCh := make(chan error, params.PageSize)
 	for i := 0; i < params.Concurrency; i++ {
-		go startTaskProcessor(ctx, params, domainID, taskCh, respCh, rateLimiter, client, adminClient, BatchWFV2TypeName)
+		go startTaskProcessor(ctx, params, templates, domainID, taskCh, respCh, rateLimiter, client, adminClient, BatchWFV2TypeName)
 	}
 
 	for {
@@ -212,8 +220,10 @@ func batchActivityV2(ctx context.Context, params BatchParams) (HeartBeatDetails,
 		for _, wf := range resp.Executions {
 			taskCh <- taskDetail{
 				execution: *wf.Execution,
+				info:      wf,
 				attempts:  0,
 				hbd:       hbd,
+				recorder:  recorder,
 			}
 		}
 
@@ -239,6 +249,7 @@ func batchActivityV2(ctx context.Context, params BatchParams) (HeartBeatDetails,
 		hbd.ErrorCount += errCount
 		hbd.CurrentPage++
 		hbd.PageToken = resp.NextPageToken
+		recorder.flush(&hbd)
 		activity.RecordHeartbeat(ctx, hbd)
 
 		if ctx.Err() != nil {
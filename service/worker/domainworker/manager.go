@@ -0,0 +1,369 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package domainworker runs Cadence SDK workers for the domains a worker
+// service host covers on the membership hashring.
+package domainworker
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/membership"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/service"
+)
+
+const (
+	defaultRefreshInterval  = 1 * time.Minute
+	defaultShutdownTimeout  = 5 * time.Second
+	defaultRedundancyFactor = 1
+)
+
+type (
+	// Worker is the subset of cadenceworker.Worker used by the manager,
+	// extracted to allow unit testing without starting real pollers.
+	Worker interface {
+		Stop()
+	}
+
+	// WorkerFactory creates and starts the worker of a domain.
+	WorkerFactory func(domainName string) (Worker, error)
+
+	// ReconcileFn is called on every refresh for each enabled domain covered by
+	// this host, before its worker is started. Returning false stops the worker
+	// of the domain, returning an error keeps the worker as it is.
+	ReconcileFn func(ctx context.Context, domainEntry *cache.DomainCacheEntry) (bool, error)
+
+	// MetricDefs are the metrics emitted by a manager.
+	MetricDefs struct {
+		Scope                     metrics.ScopeIdx
+		ActiveGauge               metrics.MetricIdx
+		StartedCount              metrics.MetricIdx
+		StoppedCount              metrics.MetricIdx
+		StartErrorsCountPerDomain metrics.MetricIdx
+		RefreshLatencyHistogram   metrics.MetricIdx
+		LookupFailuresCount       metrics.MetricIdx
+		DomainCoverageCount       metrics.MetricIdx
+	}
+
+	// Params contains the parameters needed to create a manager.
+	Params struct {
+		// Name identifies the manager in its membership change subscription.
+		Name          string
+		Logger        log.Logger
+		MetricsClient metrics.Client
+		// Metrics are the metrics emitted by the manager. Nil emits none.
+		Metrics            *MetricDefs
+		DomainCache        cache.DomainCache
+		MembershipResolver membership.Resolver
+		HostInfo           membership.HostInfo
+		// Enabled returns whether workers are run for a domain.
+		Enabled dynamicproperties.BoolPropertyFnWithDomainFilter
+		// RefreshInterval returns how often the manager re-scans the domain
+		// cache to reconcile the per-domain workers. Re-evaluated on every tick
+		// so live dynamic-config changes take effect on the next iteration.
+		// Nil falls back to a sensible default.
+		RefreshInterval dynamicproperties.DurationPropertyFn
+		// RedundancyFactor returns the number of hosts that concurrently run a
+		// worker for a domain. Nil or a non-positive return runs a single one.
+		RedundancyFactor dynamicproperties.IntPropertyFnWithDomainFilter
+		CreateWorker     WorkerFactory
+		// Reconcile is optional, all covered domains run a worker without it.
+		Reconcile ReconcileFn
+	}
+
+	// Manager manages per-domain workers. It periodically scans the domain
+	// cache, and also whenever the membership ring of the worker service
+	// changes, and uses the hashring to determine which domains this host
+	// covers. For each such domain it runs a worker. Each domain is covered by
+	// RedundancyFactor hosts simultaneously so that a single host failure
+	// does not cause a gap.
+	Manager struct {
+		name               string
+		enabledFn          dynamicproperties.BoolPropertyFnWithDomainFilter
+		metricsScope       metrics.Scope
+		metrics            *MetricDefs
+		logger             log.Logger
+		domainCache        cache.DomainCache
+		membershipResolver membership.Resolver
+		hostInfo           membership.HostInfo
+		timeSrc            clock.TimeSource
+		refreshInterval    dynamicproperties.DurationPropertyFn
+		redundancyFactor   dynamicproperties.IntPropertyFnWithDomainFilter
+		shutdownTimeout    time.Duration
+		ctx                context.Context
+		cancelFn           context.CancelFunc
+		wg                 sync.WaitGroup
+		activeWorkers      map[string]Worker // domain name -> worker
+		createWorker       WorkerFactory
+		reconcile          ReconcileFn
+		membershipChangeCh chan *membership.ChangedEvent
+	}
+)
+
+// NewManager creates a new per-domain worker manager.
+func NewManager(params *Params) *Manager {
+	ctx, cancel := context.WithCancel(context.Background())
+	refresh := params.RefreshInterval
+	if refresh == nil {
+		refresh = dynamicproperties.GetDurationPropertyFn(defaultRefreshInterval)
+	}
+	redundancy := params.RedundancyFactor
+	if redundancy == nil {
+		redundancy = dynamicproperties.GetIntPropertyFilteredByDomain(defaultRedundancyFactor)
+	}
+	metricsScope, metricDefs := metrics.NoopScope, &MetricDefs{}
+	if params.MetricsClient != nil && params.Metrics != nil {
+		metricsScope, metricDefs = params.MetricsClient.Scope(params.Metrics.Scope), params.Metrics
+	}
+	return &Manager{
+		name:               params.Name,
+		enabledFn:          params.Enabled,
+		metricsScope:       metricsScope,
+		metrics:            metricDefs,
+		logger:             params.Logger,
+		domainCache:        params.DomainCache,
+		membershipResolver: params.MembershipResolver,
+		hostInfo:           params.HostInfo,
+		timeSrc:            clock.NewRealTimeSource(),
+		refreshInterval:    refresh,
+		redundancyFactor:   redundancy,
+		shutdownTimeout:    defaultShutdownTimeout,
+		ctx:                ctx,
+		cancelFn:           cancel,
+		activeWorkers:      make(map[string]Worker),
+		createWorker:       params.CreateWorker,
+		reconcile:          params.Reconcile,
+		membershipChangeCh: make(chan *membership.ChangedEvent, 10),
+	}
+}
+
+// Start begins the background loop that manages per-domain workers.
+func (m *Manager) Start() {
+	m.logger.Info("domain worker manager starting")
+	if err := m.membershipResolver.Subscribe(service.Worker, m.subscriberName(), m.membershipChangeCh); err != nil {
+		m.logger.Warn("failed to subscribe to membership changes, will rely on periodic refresh only", tag.Error(err))
+	}
+	m.wg.Add(1)
+	go m.run()
+}
+
+// Stop signals the background loop to stop and waits for it to finish.
+// It then stops all active workers.
+func (m *Manager) Stop() {
+	m.logger.Info("domain worker manager stopping")
+	if err := m.membershipResolver.Unsubscribe(service.Worker, m.subscriberName()); err != nil {
+		m.logger.Warn("failed to unsubscribe from membership changes", tag.Error(err))
+	}
+	m.cancelFn()
+	if !common.AwaitWaitGroup(&m.wg, m.shutdownTimeout) {
+		m.logger.Warn("domain worker manager timed out on shutdown")
+	}
+	m.stopAllWorkers()
+	m.logger.Info("domain worker manager stopped")
+}
+
+// subscriberName is the unique name used to subscribe to membership change
+// notifications for the worker service ring.
+func (m *Manager) subscriberName() string {
+	return m.name + "-worker-manager"
+}
+
+func (m *Manager) run() {
+	defer m.wg.Done()
+
+	ticker := m.timeSrc.NewTicker(m.refreshInterval())
+	defer ticker.Stop()
+
+	m.refreshWorkers()
+
+	for {
+		select {
+		case <-ticker.Chan():
+			m.refreshWorkers()
+			ticker.Reset(m.refreshInterval())
+
+		case <-m.membershipChangeCh:
+			drainMembershipCh(m.membershipChangeCh)
+			m.logger.Debug("membership ring changed, refreshing domain workers")
+			m.refreshWorkers()
+			ticker.Reset(m.refreshInterval())
+
+		case <-m.ctx.Done():
+			m.logger.Info("domain worker manager background loop stopped")
+			return
+		}
+	}
+}
+
+// refreshWorkers scans all domains and reconciles the set of active workers
+// with the domains this host owns via the membership hashring.
+func (m *Manager) refreshWorkers() {
+	startTime := time.Now()
+	defer func() {
+		m.metricsScope.ExponentialHistogram(m.metrics.RefreshLatencyHistogram, time.Since(startTime))
+		m.metricsScope.UpdateGauge(m.metrics.ActiveGauge, float64(len(m.activeWorkers)))
+	}()
+
+	domains := m.domainCache.GetAllDomain()
+	ownedDomains := make(map[string]struct{}, len(domains))
+	// keptDomains are the domains whose ownership or state could not be looked
+	// up. Their workers keep running to avoid churn on transient errors.
+	keptDomains := make(map[string]struct{})
+
+	for _, domainEntry := range domains {
+		select {
+		case <-m.ctx.Done():
+			return
+		default:
+		}
+
+		if domainEntry.IsDeprecatedOrDeleted() {
+			continue
+		}
+
+		domainName := domainEntry.GetInfo().Name
+
+		redundancy := m.redundancyFactor(domainName)
+		if redundancy < 1 {
+			redundancy = defaultRedundancyFactor
+		}
+
+		owners, err := m.membershipResolver.LookupN(service.Worker, domainName, redundancy)
+		if err != nil {
+			m.logger.Warn("failed to look up domain owners, skipping",
+				tag.WorkflowDomainName(domainName),
+				tag.Error(err),
+			)
+			m.metricsScope.IncCounter(m.metrics.LookupFailuresCount)
+			keptDomains[domainName] = struct{}{}
+			continue
+		}
+
+		if !containsHost(owners, m.hostInfo) {
+			continue
+		}
+
+		if !m.enabledFn(domainName) {
+			continue
+		}
+
+		if m.reconcile != nil {
+			run, err := m.reconcile(m.ctx, domainEntry)
+			if err != nil {
+				m.logger.Warn("failed to reconcile domain, skipping",
+					tag.WorkflowDomainName(domainName),
+					tag.Error(err),
+				)
+				keptDomains[domainName] = struct{}{}
+				continue
+			}
+			if !run {
+				continue
+			}
+		}
+
+		ownedDomains[domainName] = struct{}{}
+
+		if _, exists := m.activeWorkers[domainName]; exists {
+			continue
+		}
+
+		m.startWorkerForDomain(domainName)
+	}
+
+	for domainName, w := range m.activeWorkers {
+		if _, owned := ownedDomains[domainName]; owned {
+			m.metricsScope.Tagged(metrics.DomainTag(domainName)).IncCounter(m.metrics.DomainCoverageCount)
+			continue
+		}
+		if _, kept := keptDomains[domainName]; kept {
+			continue
+		}
+		m.logger.Info("stopping worker for domain no longer owned",
+			tag.WorkflowDomainName(domainName),
+		)
+		w.Stop()
+		m.metricsScope.IncCounter(m.metrics.StoppedCount)
+		delete(m.activeWorkers, domainName)
+	}
+
+	m.logger.Debug("domain workers refreshed",
+		tag.Dynamic("active-worker-count", len(m.activeWorkers)),
+	)
+}
+
+func (m *Manager) startWorkerForDomain(domainName string) {
+	w, err := m.createWorker(domainName)
+	if err != nil {
+		m.logger.Error("failed to start worker for domain",
+			tag.WorkflowDomainName(domainName),
+			tag.Error(err),
+		)
+		m.metricsScope.Tagged(metrics.DomainTag(domainName)).IncCounter(m.metrics.StartErrorsCountPerDomain)
+		return
+	}
+
+	m.activeWorkers[domainName] = w
+	m.metricsScope.IncCounter(m.metrics.StartedCount)
+	m.logger.Info("started worker for domain",
+		tag.WorkflowDomainName(domainName),
+	)
+}
+
+func (m *Manager) stopAllWorkers() {
+	for domainName, w := range m.activeWorkers {
+		w.Stop()
+		m.logger.Info("stopped worker for domain",
+			tag.WorkflowDomainName(domainName),
+		)
+		delete(m.activeWorkers, domainName)
+	}
+}
+
+// drainMembershipCh consumes all pending events from the channel without
+// blocking, so that a single refreshWorkers call covers all queued changes.
+func drainMembershipCh(ch <-chan *membership.ChangedEvent) {
+	for {
+		select {
+		case <-ch:
+		default:
+			return
+		}
+	}
+}
+
+// containsHost reports whether hosts contains the given target host.
+func containsHost(hosts []membership.HostInfo, target membership.HostInfo) bool {
+	for _, h := range hosts {
+		if h.Identity() == target.Identity() {
+			return true
+		}
+	}
+	return false
+}
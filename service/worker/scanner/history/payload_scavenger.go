@@ -0,0 +1,120 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/cadence/activity"
+	"golang.org/x/time/rate"
+
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
+	p "github.com/uber/cadence/common/persistence"
+)
+
+type (
+	// PayloadScavengerHeartbeatDetails is the heartbeat detail for HistoryPayloadScavengerActivity
+	PayloadScavengerHeartbeatDetails struct {
+		NextPageToken []byte
+		CurrentPage   int
+		ScannedCount  int
+		DeletedCount  int
+	}
+
+	// PayloadScavenger is the type that holds the state for the scavenger of offloaded history event payloads
+	PayloadScavenger struct {
+		db       p.HistoryManager
+		hbd      PayloadScavengerHeartbeatDetails
+		limiter  *rate.Limiter
+		metrics  metrics.Client
+		logger   log.Logger
+		isInTest bool
+	}
+)
+
+const (
+	payloadPageSize = 100
+	// only clean up payloads offloaded before this threshold, as the append offloading a payload persists
+	// the history node referencing it afterwards
+	payloadCleanupThreshold = time.Hour
+)
+
+// NewPayloadScavenger returns an instance of the offloaded payload scavenger daemon.
+// Calling the Run() method will result in one complete iteration over all of the offloaded
+// history event payloads in the blobstore, deleting the ones no history node reads anymore.
+func NewPayloadScavenger(
+	db p.HistoryManager,
+	rps int,
+	hbd PayloadScavengerHeartbeatDetails,
+	metricsClient metrics.Client,
+	logger log.Logger,
+) *PayloadScavenger {
+	// checking a payload takes about one persistence call
+	rateLimiter := rate.NewLimiter(rate.Limit(float64(rps)/payloadPageSize), 1)
+
+	return &PayloadScavenger{
+		db:      db,
+		hbd:     hbd,
+		limiter: rateLimiter,
+		metrics: metricsClient,
+		logger:  logger,
+	}
+}
+
+// Run runs the scavenger
+func (s *PayloadScavenger) Run(ctx context.Context) (PayloadScavengerHeartbeatDetails, error) {
+	offloadedBefore := time.Now().Add(-payloadCleanupThreshold)
+	for {
+		if err := s.limiter.Wait(ctx); err != nil {
+			return s.hbd, err
+		}
+		resp, err := s.db.DeleteOrphanedHistoryPayloads(ctx, &p.DeleteOrphanedHistoryPayloadsRequest{
+			OffloadedBefore: offloadedBefore,
+			PageSize:        payloadPageSize,
+			NextPageToken:   s.hbd.NextPageToken,
+		})
+		if err != nil {
+			s.metrics.IncCounter(metrics.HistoryScavengerScope, metrics.HistoryScavengerErrorCount)
+			s.logger.Error("encounter error when deleting orphaned history payloads", tag.Error(err))
+			return s.hbd, err
+		}
+		s.metrics.AddCounter(metrics.HistoryScavengerScope, metrics.HistoryScavengerPayloadDeletedCount, int64(resp.DeletedCount))
+		if resp.DeletedCount > 0 {
+			s.logger.Info("deleted orphaned history payloads", tag.Counter(resp.DeletedCount))
+		}
+
+		s.hbd.CurrentPage++
+		s.hbd.NextPageToken = resp.NextPageToken
+		s.hbd.ScannedCount += resp.ScannedCount
+		s.hbd.DeletedCount += resp.DeletedCount
+		if !s.isInTest {
+			activity.RecordHeartbeat(ctx, s.hbd)
+		}
+
+		if len(s.hbd.NextPageToken) == 0 {
+			break
+		}
+	}
+	return s.hbd, nil
+}
@@ -0,0 +1,88 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/uber-go/tally"
+
+	"github.com/uber/cadence/common/log/testlogger"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/mocks"
+	p "github.com/uber/cadence/common/persistence"
+)
+
+func createTestPayloadScavenger(t *testing.T) (*mocks.HistoryV2Manager, *PayloadScavenger) {
+	db := &mocks.HistoryV2Manager{}
+	t.Cleanup(func() { db.AssertExpectations(t) })
+	scvgr := NewPayloadScavenger(
+		db,
+		100000,
+		PayloadScavengerHeartbeatDetails{},
+		metrics.NewClient(tally.NoopScope, metrics.Worker, metrics.MigrationConfig{}),
+		testlogger.New(t),
+	)
+	scvgr.isInTest = true
+	return db, scvgr
+}
+
+func TestPayloadScavenger_TwoPages(t *testing.T) {
+	db, scvgr := createTestPayloadScavenger(t)
+	db.On("DeleteOrphanedHistoryPayloads", mock.Anything, mock.MatchedBy(func(req *p.DeleteOrphanedHistoryPayloadsRequest) bool {
+		return req.PageSize == payloadPageSize && req.NextPageToken == nil && !req.OffloadedBefore.IsZero()
+	})).Return(&p.DeleteOrphanedHistoryPayloadsResponse{
+		ScannedCount:  100,
+		DeletedCount:  2,
+		NextPageToken: []byte("page1"),
+	}, nil).Once()
+	db.On("DeleteOrphanedHistoryPayloads", mock.Anything, mock.MatchedBy(func(req *p.DeleteOrphanedHistoryPayloadsRequest) bool {
+		return string(req.NextPageToken) == "page1"
+	})).Return(&p.DeleteOrphanedHistoryPayloadsResponse{
+		ScannedCount: 10,
+		DeletedCount: 1,
+	}, nil).Once()
+
+	hbd, err := scvgr.Run(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, PayloadScavengerHeartbeatDetails{
+		CurrentPage:  2,
+		ScannedCount: 110,
+		DeletedCount: 3,
+	}, hbd)
+}
+
+func TestPayloadScavenger_Error(t *testing.T) {
+	db, scvgr := createTestPayloadScavenger(t)
+	scvgr.hbd = PayloadScavengerHeartbeatDetails{NextPageToken: []byte("page3"), CurrentPage: 3}
+	db.On("DeleteOrphanedHistoryPayloads", mock.Anything, mock.MatchedBy(func(req *p.DeleteOrphanedHistoryPayloadsRequest) bool {
+		return string(req.NextPageToken) == "page3"
+	})).Return(nil, errors.New("blobstore error")).Once()
+
+	// the heartbeat details allow the retried activity to resume from the failed page
+	hbd, err := scvgr.Run(context.Background())
+	require.ErrorContains(t, err, "blobstore error")
+	require.Equal(t, PayloadScavengerHeartbeatDetails{NextPageToken: []byte("page3"), CurrentPage: 3}, hbd)
+}
@@ -50,6 +50,8 @@ const (
 	historyScannerWFTypeName     = "cadence-sys-history-scanner-workflow"
 	historyScannerTaskListName   = "cadence-sys-history-scanner-tasklist-0"
 	historyScavengerActivityName = "cadence-sys-history-scanner-scvg-activity"
+	// historyPayloadScavengerActivityName deletes the offloaded history event payloads no history node reads anymore
+	historyPayloadScavengerActivityName = "cadence-sys-history-scanner-payload-scvg-activity"
 )
 
 var (
@@ -89,6 +91,7 @@ func init() {
 
 	workflow.RegisterWithOptions(HistoryScannerWorkflow, workflow.RegisterOptions{Name: historyScannerWFTypeName})
 	activity.RegisterWithOptions(HistoryScavengerActivity, activity.RegisterOptions{Name: historyScavengerActivityName})
+	activity.RegisterWithOptions(HistoryPayloadScavengerActivity, activity.RegisterOptions{Name: historyPayloadScavengerActivityName})
 
 	workflow.RegisterWithOptions(executions.ConcreteScannerWorkflow, workflow.RegisterOptions{Name: executions.ConcreteExecutionsScannerWFTypeName})
 	workflow.RegisterWithOptions(executions.CurrentScannerWorkflow, workflow.RegisterOptions{Name: executions.CurrentExecutionsScannerWFTypeName})
@@ -116,6 +119,14 @@ func HistoryScannerWorkflow(
 		workflow.WithActivityOptions(ctx, activityOptions),
 		historyScavengerActivityName,
 	)
+	if err := future.Get(ctx, nil); err != nil {
+		return err
+	}
+	// the payloads of the branches deleted above are orphaned unless the deletion cleaned them up
+	future = workflow.ExecuteActivity(
+		workflow.WithActivityOptions(ctx, activityOptions),
+		historyPayloadScavengerActivityName,
+	)
 	return future.Get(ctx, nil)
 }
 
@@ -152,6 +163,38 @@ func HistoryScavengerActivity(
 	return scavenger.Run(activityCtx)
 }
 
+// HistoryPayloadScavengerActivity is the activity that runs the scavenger of offloaded history event payloads
+func HistoryPayloadScavengerActivity(
+	activityCtx context.Context,
+) (history.PayloadScavengerHeartbeatDetails, error) {
+
+	ctx, err := getScannerContext(activityCtx)
+	if err != nil {
+		return history.PayloadScavengerHeartbeatDetails{}, err
+	}
+
+	res := ctx.resource
+	if res.GetBlobstoreClient() == nil {
+		// payloads are only offloaded if a blobstore is configured
+		return history.PayloadScavengerHeartbeatDetails{}, nil
+	}
+
+	hbd := history.PayloadScavengerHeartbeatDetails{}
+	if activity.HasHeartbeatDetails(activityCtx) {
+		if err := activity.GetHeartbeatDetails(activityCtx, &hbd); err != nil {
+			res.GetLogger().Error("Failed to recover from last heartbeat, start over from beginning", tag.Error(err))
+		}
+	}
+	scavenger := history.NewPayloadScavenger(
+		res.GetHistoryManager(),
+		ctx.cfg.ScannerPersistenceMaxQPS(),
+		hbd,
+		res.GetMetricsClient(),
+		res.GetLogger(),
+	)
+	return scavenger.Run(activityCtx)
+}
+
 // TaskListScavengerActivity is the activity that runs task list scavenger
 func TaskListScavengerActivity(
 	activityCtx context.Context,
@@ -40,6 +40,10 @@ import (
 // a uuid column, so plain strings are rejected by the gocql driver.
 var schedulerRequestIDNamespace = uuid.NewSHA1(uuid.NameSpaceDNS, []byte("cadence.scheduler"))
 
+// triggerWorkflowIDTimeLayout is time.RFC3339Nano without dropping trailing
+// zeros, so a trigger on a whole second does not reuse a scheduled fire's ID.
+const triggerWorkflowIDTimeLayout = "2006-01-02T15:04:05.000000000Z07:00"
+
 type contextKey string
 
 const schedulerContextKey contextKey = "schedulerContext"
@@ -52,7 +56,8 @@ type schedulerContext struct {
 
 // processScheduleFireActivity is the single activity that handles a schedule fire.
 // It encapsulates all side effects, checking if the previous workflow is running,
-// enforcing the overlap policy (cancel/terminate), and starting the new workflow.
+// enforcing the overlap policy (cancel/terminate), and starting the new workflow
+// or delivering the signal of a signal action.
 // Keeping all of this in one activity means the workflow history records a single
 // activity call per fire, so the internal logic can evolve freely without
 // introducing nondeterminism.
@@ -80,7 +85,10 @@ func processScheduleFireActivity(ctx context.Context, req ProcessFireRequest) (r
 	// completed entries, and enforce the cap. When under the cap, falls through
 	// to the shared start block; stillRunning is used there to build
 	// result.ActiveWorkflows. When at or over the cap, returns early with a skip.
-	isBoundedConcurrent := policy == types.ScheduleOverlapPolicyConcurrent && req.ConcurrencyLimit > 0
+	// A signal-with-start action only ever has one run of its target, so there
+	// is no concurrency to bound.
+	isBoundedConcurrent := policy == types.ScheduleOverlapPolicyConcurrent && req.ConcurrencyLimit > 0 &&
+		req.SignalWithStartWorkflow == nil
 	var stillRunning []RunningWorkflowInfo
 	if isBoundedConcurrent {
 		effectiveLimit := effectiveConcurrencyLimit(req.ConcurrencyLimit)
@@ -102,8 +110,15 @@ func processScheduleFireActivity(ctx context.Context, req ProcessFireRequest) (r
 		}
 	}
 
-	if policy != types.ScheduleOverlapPolicyConcurrent && req.LastStartedWorkflow != nil {
-		running, err := isWorkflowRunning(ctx, sc.FrontendClient, req.Domain, req.LastStartedWorkflow)
+	// The overlap policy of a signal-with-start action applies to the current run
+	// of its target, whoever started it: only CONCURRENT signals a running target,
+	// the other policies skip, buffer, or close it and start a new run.
+	previous := req.LastStartedWorkflow
+	if sws := req.SignalWithStartWorkflow; sws != nil {
+		previous = &RunningWorkflowInfo{WorkflowID: sws.WorkflowID}
+	}
+	if policy != types.ScheduleOverlapPolicyConcurrent && previous != nil {
+		running, err := isWorkflowRunning(ctx, sc.FrontendClient, req.Domain, previous)
 		if err != nil {
 			return nil, err
 		}
@@ -112,17 +127,17 @@ func processScheduleFireActivity(ctx context.Context, req ProcessFireRequest) (r
 			case types.ScheduleOverlapPolicySkipNew:
 				scope.Tagged(metrics.OverlapPolicyTag(policy.String()), metrics.TriggerSourceTag(string(req.TriggerSource))).IncCounter(metrics.SchedulerFireSkippedCountPerDomain)
 				result.SkippedDelta = 1
-				result.StartedWorkflow = req.LastStartedWorkflow
+				result.StartedWorkflow = previous
 				return result, nil
 			case types.ScheduleOverlapPolicyBuffer:
 				// Defer the fire; the workflow enqueues it in state.BufferedFires.
 				scope.Tagged(metrics.OverlapPolicyTag(policy.String()), metrics.TriggerSourceTag(string(req.TriggerSource))).IncCounter(metrics.SchedulerFireBufferedCountPerDomain)
 				result.Buffered = true
-				result.StartedWorkflow = req.LastStartedWorkflow
+				result.StartedWorkflow = previous
 				return result, nil
 			case types.ScheduleOverlapPolicyCancelPrevious:
 				var cancelled bool
-				if cancelled, err = cancelWorkflow(ctx, sc.FrontendClient, req.Domain, req.LastStartedWorkflow); err != nil {
+				if cancelled, err = cancelWorkflow(ctx, sc.FrontendClient, req.Domain, previous); err != nil {
 					return nil, err
 				}
 				if cancelled {
@@ -130,7 +145,7 @@ func processScheduleFireActivity(ctx context.Context, req ProcessFireRequest) (r
 				}
 			case types.ScheduleOverlapPolicyTerminatePrevious:
 				var terminated bool
-				if terminated, err = terminateWorkflow(ctx, sc.FrontendClient, req.Domain, req.LastStartedWorkflow); err != nil {
+				if terminated, err = terminateWorkflow(ctx, sc.FrontendClient, req.Domain, previous); err != nil {
 					return nil, err
 				}
 				if terminated {
@@ -140,7 +155,33 @@ func processScheduleFireActivity(ctx context.Context, req ProcessFireRequest) (r
 		}
 	}
 
-	workflowID := generateWorkflowID(req.Action.WorkflowIDPrefix, req.ScheduleID, req.ScheduledTime)
+	if req.SignalWorkflow != nil || req.SignalWithStartWorkflow != nil {
+		// A signal action delivers to a workflow the schedule does not own, so no run
+		// is recorded as started. The run of a signal-with-start action is recorded
+		// so that the watcher and the BUFFER policy follow it like a started run.
+		delivered, signalled, err := signalTargetWorkflow(ctx, sc.FrontendClient, req)
+		if err != nil {
+			return nil, err
+		}
+		result.StartedWorkflow = signalled
+		if isBoundedConcurrent {
+			result.ActiveWorkflows = append([]RunningWorkflowInfo{}, stillRunning...)
+		}
+		triggerScope := scope.Tagged(metrics.TriggerSourceTag(string(req.TriggerSource)))
+		if !delivered {
+			triggerScope.IncCounter(metrics.SchedulerFireSkippedCountPerDomain)
+			result.SkippedDelta = 1
+			return result, nil
+		}
+		triggerScope.IncCounter(metrics.SchedulerFireStartedCountPerDomain)
+		if req.TriggerSource == TriggerSourceSchedule {
+			triggerScope.ExponentialHistogram(metrics.SchedulerFireLatencyPerDomainHistogram, time.Since(req.ScheduledTime))
+		}
+		result.TotalDelta = 1
+		return result, nil
+	}
+
+	workflowID := generateWorkflowID(req.Action.WorkflowIDPrefix, req.ScheduleID, req.ScheduledTime, req.TriggerSource)
 	reusePolicy := types.WorkflowIDReusePolicyAllowDuplicate
 	startReq := &types.StartWorkflowExecutionRequest{
 		Domain:                              req.Domain,
@@ -192,14 +233,72 @@ func processScheduleFireActivity(ctx context.Context, req ProcessFireRequest) (r
 	return result, nil
 }
 
+// signalTargetWorkflow delivers the signal of a SignalWorkflow or SignalWithStartWorkflow
+// action. It returns false when the target of a SignalWorkflow action is not running,
+// and the signalled run of a SignalWithStartWorkflow action.
+// The RequestID is derived like the one of a start so that retries are de-duplicated.
+func signalTargetWorkflow(ctx context.Context, client frontend.Client, req ProcessFireRequest) (bool, *RunningWorkflowInfo, error) {
+	requestID := generateRequestID(req.ScheduleID, req.ScheduledTime.UnixNano(), req.TriggerSource)
+	if sig := req.SignalWorkflow; sig != nil {
+		err := client.SignalWorkflowExecution(ctx, &types.SignalWorkflowExecutionRequest{
+			Domain:            req.Domain,
+			WorkflowExecution: &types.WorkflowExecution{WorkflowID: sig.WorkflowID},
+			SignalName:        sig.SignalName,
+			Input:             sig.SignalInput,
+			RequestID:         requestID,
+		})
+		if err != nil {
+			if isEntityNotExistsError(err) {
+				return false, nil, nil
+			}
+			return false, nil, fmt.Errorf("failed to signal workflow: %w", err)
+		}
+		return true, nil, nil
+	}
+
+	sws := req.SignalWithStartWorkflow
+	start := sws.GetStartWorkflow()
+	if start == nil {
+		return false, nil, fmt.Errorf("signal-with-start action has no StartWorkflow configuration")
+	}
+	reusePolicy := types.WorkflowIDReusePolicyAllowDuplicate
+	resp, err := client.SignalWithStartWorkflowExecution(ctx, &types.SignalWithStartWorkflowExecutionRequest{
+		Domain:                              req.Domain,
+		WorkflowID:                          sws.WorkflowID,
+		WorkflowType:                        start.GetWorkflowType(),
+		TaskList:                            start.GetTaskList(),
+		Input:                               start.GetInput(),
+		ExecutionStartToCloseTimeoutSeconds: start.ExecutionStartToCloseTimeoutSeconds,
+		TaskStartToCloseTimeoutSeconds:      start.TaskStartToCloseTimeoutSeconds,
+		RequestID:                           requestID,
+		WorkflowIDReusePolicy:               &reusePolicy,
+		SignalName:                          sws.SignalName,
+		SignalInput:                         sws.SignalInput,
+		RetryPolicy:                         start.GetRetryPolicy(),
+		Memo:                                start.GetMemo(),
+		SearchAttributes:                    buildSearchAttributes(req),
+	})
+	if err != nil {
+		return false, nil, fmt.Errorf("failed to signal-with-start workflow: %w", err)
+	}
+	return true, &RunningWorkflowInfo{WorkflowID: sws.WorkflowID, RunID: resp.GetRunID()}, nil
+}
+
 // generateWorkflowID creates a deterministic workflow ID from the
 // schedule's prefix (or schedule ID) and the scheduled time.
 // Example: "my-prefix-2026-01-15T10:00:00Z"
-func generateWorkflowID(prefix, scheduleID string, scheduledTime time.Time) string {
+// Manual triggers are not aligned to the schedule and several may fire within
+// the same second, so their time keeps its nanoseconds.
+// Example: "my-prefix-2026-01-15T10:00:00.123456789Z"
+func generateWorkflowID(prefix, scheduleID string, scheduledTime time.Time, source TriggerSource) string {
 	if prefix == "" {
 		prefix = scheduleID
 	}
-	return fmt.Sprintf("%s-%s", prefix, scheduledTime.UTC().Format(time.RFC3339))
+	layout := time.RFC3339
+	if source == TriggerSourceTrigger {
+		layout = triggerWorkflowIDTimeLayout
+	}
+	return fmt.Sprintf("%s-%s", prefix, scheduledTime.UTC().Format(layout))
 }
 
 // generateRequestID produces a deterministic UUID from the schedule ID,
@@ -212,6 +311,14 @@ func generateRequestID(scheduleID string, scheduledTimeNanos int64, source Trigg
 }
 
 func isWorkflowRunning(ctx context.Context, client frontend.Client, domain string, wf *RunningWorkflowInfo) (bool, error) {
+	running, _, err := describeWorkflowStatus(ctx, client, domain, wf)
+	return running, err
+}
+
+// describeWorkflowStatus returns whether the workflow is running and, once it is
+// closed, its close status. A workflow that no longer exists is reported as not
+// running with a nil close status.
+func describeWorkflowStatus(ctx context.Context, client frontend.Client, domain string, wf *RunningWorkflowInfo) (bool, *types.WorkflowExecutionCloseStatus, error) {
 	resp, err := client.DescribeWorkflowExecution(ctx, &types.DescribeWorkflowExecutionRequest{
 		Domain: domain,
 		Execution: &types.WorkflowExecution{
@@ -221,14 +328,17 @@ func isWorkflowRunning(ctx context.Context, client frontend.Client, domain strin
 	})
 	if err != nil {
 		if isEntityNotExistsError(err) {
-			return false, nil
+			return false, nil, nil
 		}
-		return false, fmt.Errorf("failed to describe workflow: %w", err)
+		return false, nil, fmt.Errorf("failed to describe workflow: %w", err)
 	}
 
 	running := resp.WorkflowExecutionInfo != nil &&
 		resp.WorkflowExecutionInfo.CloseStatus == nil
-	return running, nil
+	if running || resp.WorkflowExecutionInfo == nil {
+		return running, nil, nil
+	}
+	return false, resp.WorkflowExecutionInfo.CloseStatus, nil
 }
 
 // Cancel is cooperative: the previous workflow receives a cancellation signal
@@ -272,19 +382,21 @@ func terminateWorkflow(ctx context.Context, client frontend.Client, domain strin
 }
 
 // watchWorkflowActivity polls until the target workflow is no longer running,
-// then returns so the scheduler can immediately drain the next buffered fire.
+// then returns its close status so the scheduler can record the outcome in
+// RecentActions and immediately drain the next buffered fire.
 // It heartbeats on every poll so the worker can cancel it (e.g. when the
 // buffer is cleared by a policy update or ContinueAsNew).
-func watchWorkflowActivity(ctx context.Context, domain, workflowID, runID string) error {
+func watchWorkflowActivity(ctx context.Context, domain, workflowID, runID string) (*WatchWorkflowResult, error) {
 	sc, ok := ctx.Value(schedulerContextKey).(schedulerContext)
 	if !ok {
-		return fmt.Errorf("scheduler context not found in activity context")
+		return nil, fmt.Errorf("scheduler context not found in activity context")
 	}
 	wf := &RunningWorkflowInfo{WorkflowID: workflowID, RunID: runID}
+	interval := watcherPollInterval
 	for {
-		running, err := isWorkflowRunning(ctx, sc.FrontendClient, domain, wf)
+		running, closeStatus, err := describeWorkflowStatus(ctx, sc.FrontendClient, domain, wf)
 		if err == nil && !running {
-			return nil
+			return &WatchWorkflowResult{CloseStatus: closeStatus}, nil
 		}
 		// On a transient describe error, continue polling rather than returning;
 		// aborting would cause the main loop to restart the watcher in a spin.
@@ -293,8 +405,11 @@ func watchWorkflowActivity(ctx context.Context, domain, workflowID, runID string
 		}
 		select {
 		case <-ctx.Done():
-			return ctx.Err()
-		case <-time.After(watcherPollInterval):
+			return nil, ctx.Err()
+		case <-time.After(interval):
+		}
+		if err == nil {
+			interval = min(2*interval, watcherMaxPollInterval)
 		}
 	}
 }
@@ -303,8 +418,12 @@ func buildSearchAttributes(req ProcessFireRequest) *types.SearchAttributes {
 	fields := make(map[string][]byte)
 
 	// Preserve any user-provided search attributes from the action config
-	if req.Action.SearchAttributes != nil {
-		for k, v := range req.Action.SearchAttributes.IndexedFields {
+	userAttrs := req.Action.SearchAttributes
+	if req.SignalWithStartWorkflow != nil {
+		userAttrs = req.SignalWithStartWorkflow.GetStartWorkflow().GetSearchAttributes()
+	}
+	if userAttrs != nil {
+		for k, v := range userAttrs.IndexedFields {
 			fields[k] = v
 		}
 	}
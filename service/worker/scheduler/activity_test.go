@@ -78,6 +78,7 @@ func TestGenerateWorkflowID(t *testing.T) {
 		prefix     string
 		scheduleID string
 		time       time.Time
+		source     TriggerSource
 		want       string
 	}{
 		{
@@ -101,10 +102,30 @@ func TestGenerateWorkflowID(t *testing.T) {
 			time:       ts,
 			want:       "wf-2026-01-15T10:00:00Z",
 		},
+		{
+			name:       "manual trigger keeps nanoseconds",
+			prefix:     "wf",
+			scheduleID: "sched-789",
+			time:       ts.Add(123 * time.Millisecond),
+			source:     TriggerSourceTrigger,
+			want:       "wf-2026-01-15T10:00:00.123000000Z",
+		},
+		{
+			name:       "manual trigger on a whole second differs from a scheduled fire",
+			prefix:     "wf",
+			scheduleID: "sched-789",
+			time:       ts,
+			source:     TriggerSourceTrigger,
+			want:       "wf-2026-01-15T10:00:00.000000000Z",
+		},
 	}
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
-			got := generateWorkflowID(tc.prefix, tc.scheduleID, tc.time)
+			source := tc.source
+			if source == "" {
+				source = TriggerSourceSchedule
+			}
+			got := generateWorkflowID(tc.prefix, tc.scheduleID, tc.time, source)
 			assert.Equal(t, tc.want, got)
 		})
 	}
@@ -647,6 +668,196 @@ func TestProcessScheduleFireActivity(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "signal action signals the workflow",
+			req: ProcessFireRequest{
+				Domain:         "test-domain",
+				ScheduleID:     "sched-1",
+				SignalWorkflow: &types.SignalWorkflowAction{WorkflowID: "entity-1", SignalName: "tick", SignalInput: []byte(`1`)},
+				ScheduledTime:  scheduledTime,
+				TriggerSource:  TriggerSourceSchedule,
+				OverlapPolicy:  types.ScheduleOverlapPolicySkipNew,
+			},
+			setupMock: func(m *frontend.MockClient) {
+				m.EXPECT().SignalWorkflowExecution(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, req *types.SignalWorkflowExecutionRequest, _ ...interface{}) error {
+						assert.Equal(t, "test-domain", req.Domain)
+						assert.Equal(t, &types.WorkflowExecution{WorkflowID: "entity-1"}, req.WorkflowExecution)
+						assert.Equal(t, "tick", req.SignalName)
+						assert.Equal(t, []byte(`1`), req.Input)
+						assert.Equal(t, generateRequestID("sched-1", scheduledTime.UnixNano(), TriggerSourceSchedule), req.RequestID)
+						return nil
+					})
+			},
+			wantResult: &ProcessFireResult{TotalDelta: 1},
+		},
+		{
+			name: "signal action skips when the workflow is not running",
+			req: ProcessFireRequest{
+				Domain:         "test-domain",
+				ScheduleID:     "sched-1",
+				SignalWorkflow: &types.SignalWorkflowAction{WorkflowID: "entity-1", SignalName: "tick"},
+				ScheduledTime:  scheduledTime,
+				TriggerSource:  TriggerSourceSchedule,
+			},
+			setupMock: func(m *frontend.MockClient) {
+				m.EXPECT().SignalWorkflowExecution(gomock.Any(), gomock.Any()).
+					Return(&types.EntityNotExistsError{Message: "not found"})
+			},
+			wantResult: &ProcessFireResult{SkippedDelta: 1},
+		},
+		{
+			name: "signal action failure returns error for retry",
+			req: ProcessFireRequest{
+				Domain:         "test-domain",
+				ScheduleID:     "sched-1",
+				SignalWorkflow: &types.SignalWorkflowAction{WorkflowID: "entity-1", SignalName: "tick"},
+				ScheduledTime:  scheduledTime,
+				TriggerSource:  TriggerSourceSchedule,
+			},
+			setupMock: func(m *frontend.MockClient) {
+				m.EXPECT().SignalWorkflowExecution(gomock.Any(), gomock.Any()).
+					Return(errors.New("connection refused"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "signal action still applies the overlap policy to a previously started run",
+			req: ProcessFireRequest{
+				Domain:              "test-domain",
+				ScheduleID:          "sched-1",
+				SignalWorkflow:      &types.SignalWorkflowAction{WorkflowID: "entity-1", SignalName: "tick"},
+				ScheduledTime:       scheduledTime,
+				TriggerSource:       TriggerSourceSchedule,
+				OverlapPolicy:       types.ScheduleOverlapPolicySkipNew,
+				LastStartedWorkflow: &RunningWorkflowInfo{WorkflowID: "old-wf", RunID: "old-run"},
+			},
+			setupMock: func(m *frontend.MockClient) {
+				m.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).
+					Return(&types.DescribeWorkflowExecutionResponse{
+						WorkflowExecutionInfo: &types.WorkflowExecutionInfo{CloseStatus: nil},
+					}, nil)
+			},
+			wantResult: &ProcessFireResult{
+				SkippedDelta:    1,
+				StartedWorkflow: &RunningWorkflowInfo{WorkflowID: "old-wf", RunID: "old-run"},
+			},
+		},
+		{
+			name: "signal-with-start action signals and starts the workflow",
+			req: ProcessFireRequest{
+				Domain:     "test-domain",
+				ScheduleID: "sched-1",
+				SignalWithStartWorkflow: &types.SignalWithStartWorkflowAction{
+					WorkflowID:    "entity-1",
+					SignalName:    "tick",
+					SignalInput:   []byte(`1`),
+					StartWorkflow: &baseReq.Action,
+				},
+				ScheduledTime: scheduledTime,
+				TriggerSource: TriggerSourceSchedule,
+			},
+			setupMock: func(m *frontend.MockClient) {
+				m.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).
+					Return(nil, &types.EntityNotExistsError{Message: "not found"})
+				m.EXPECT().SignalWithStartWorkflowExecution(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, req *types.SignalWithStartWorkflowExecutionRequest, _ ...interface{}) (*types.StartWorkflowExecutionResponse, error) {
+						assert.Equal(t, "entity-1", req.WorkflowID)
+						assert.Equal(t, "my-workflow", req.WorkflowType.Name)
+						assert.Equal(t, "my-tasklist", req.TaskList.Name)
+						assert.Equal(t, []byte(`{"key":"value"}`), req.Input)
+						assert.Equal(t, "tick", req.SignalName)
+						assert.Equal(t, []byte(`1`), req.SignalInput)
+						assert.Equal(t, types.WorkflowIDReusePolicyAllowDuplicate, *req.WorkflowIDReusePolicy)
+						require.Contains(t, req.SearchAttributes.IndexedFields, SearchAttrScheduleID)
+						return &types.StartWorkflowExecutionResponse{RunID: "run-entity"}, nil
+					})
+			},
+			wantResult: &ProcessFireResult{
+				TotalDelta:      1,
+				StartedWorkflow: &RunningWorkflowInfo{WorkflowID: "entity-1", RunID: "run-entity"},
+			},
+		},
+		{
+			name: "signal-with-start action skips a running target with SKIP_NEW",
+			req: ProcessFireRequest{
+				Domain:                  "test-domain",
+				ScheduleID:              "sched-1",
+				SignalWithStartWorkflow: &types.SignalWithStartWorkflowAction{WorkflowID: "entity-1", SignalName: "tick", StartWorkflow: &baseReq.Action},
+				ScheduledTime:           scheduledTime,
+				TriggerSource:           TriggerSourceSchedule,
+				OverlapPolicy:           types.ScheduleOverlapPolicySkipNew,
+			},
+			setupMock: func(m *frontend.MockClient) {
+				m.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, req *types.DescribeWorkflowExecutionRequest, _ ...interface{}) (*types.DescribeWorkflowExecutionResponse, error) {
+						assert.Equal(t, &types.WorkflowExecution{WorkflowID: "entity-1"}, req.Execution)
+						return &types.DescribeWorkflowExecutionResponse{WorkflowExecutionInfo: &types.WorkflowExecutionInfo{}}, nil
+					})
+			},
+			wantResult: &ProcessFireResult{
+				SkippedDelta:    1,
+				StartedWorkflow: &RunningWorkflowInfo{WorkflowID: "entity-1"},
+			},
+		},
+		{
+			name: "signal-with-start action terminates a running target with TERMINATE_PREVIOUS",
+			req: ProcessFireRequest{
+				Domain:                  "test-domain",
+				ScheduleID:              "sched-1",
+				SignalWithStartWorkflow: &types.SignalWithStartWorkflowAction{WorkflowID: "entity-1", SignalName: "tick", StartWorkflow: &baseReq.Action},
+				ScheduledTime:           scheduledTime,
+				TriggerSource:           TriggerSourceSchedule,
+				OverlapPolicy:           types.ScheduleOverlapPolicyTerminatePrevious,
+			},
+			setupMock: func(m *frontend.MockClient) {
+				gomock.InOrder(
+					m.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).
+						Return(&types.DescribeWorkflowExecutionResponse{WorkflowExecutionInfo: &types.WorkflowExecutionInfo{}}, nil),
+					m.EXPECT().TerminateWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil),
+					m.EXPECT().SignalWithStartWorkflowExecution(gomock.Any(), gomock.Any()).
+						Return(&types.StartWorkflowExecutionResponse{RunID: "run-new"}, nil),
+				)
+			},
+			wantResult: &ProcessFireResult{
+				TotalDelta:      1,
+				StartedWorkflow: &RunningWorkflowInfo{WorkflowID: "entity-1", RunID: "run-new"},
+			},
+		},
+		{
+			name: "signal-with-start action signals a running target with CONCURRENT",
+			req: ProcessFireRequest{
+				Domain:                  "test-domain",
+				ScheduleID:              "sched-1",
+				SignalWithStartWorkflow: &types.SignalWithStartWorkflowAction{WorkflowID: "entity-1", SignalName: "tick", StartWorkflow: &baseReq.Action},
+				ScheduledTime:           scheduledTime,
+				TriggerSource:           TriggerSourceSchedule,
+				OverlapPolicy:           types.ScheduleOverlapPolicyConcurrent,
+				ConcurrencyLimit:        1,
+				RunningWorkflows:        []RunningWorkflowInfo{{WorkflowID: "entity-1", RunID: "run-entity"}},
+			},
+			setupMock: func(m *frontend.MockClient) {
+				m.EXPECT().SignalWithStartWorkflowExecution(gomock.Any(), gomock.Any()).
+					Return(&types.StartWorkflowExecutionResponse{RunID: "run-entity"}, nil)
+			},
+			wantResult: &ProcessFireResult{
+				TotalDelta:      1,
+				StartedWorkflow: &RunningWorkflowInfo{WorkflowID: "entity-1", RunID: "run-entity"},
+			},
+		},
+		{
+			name: "signal-with-start action without StartWorkflow returns error",
+			req: ProcessFireRequest{
+				Domain:                  "test-domain",
+				ScheduleID:              "sched-1",
+				SignalWithStartWorkflow: &types.SignalWithStartWorkflowAction{WorkflowID: "entity-1", SignalName: "tick"},
+				ScheduledTime:           scheduledTime,
+				TriggerSource:           TriggerSourceSchedule,
+				OverlapPolicy:           types.ScheduleOverlapPolicyConcurrent,
+			},
+			setupMock: func(m *frontend.MockClient) {},
+			wantErr:   true,
+		},
 		{
 			name:      "missing context returns error",
 			req:       baseReq,
@@ -1118,17 +1329,27 @@ func TestWatchWorkflowActivity(t *testing.T) {
 	// The still-running poll path requires activity.RecordHeartbeat which panics
 	// outside a Cadence activity context. That path is exercised by the E2E test.
 	tests := []struct {
-		name      string
-		setup     func(*frontend.MockClient)
-		noContext bool
-		cancelCtx bool
-		wantErr   string
+		name       string
+		setup      func(*frontend.MockClient)
+		noContext  bool
+		cancelCtx  bool
+		wantResult *WatchWorkflowResult
+		wantErr    string
 	}{
 		{
-			name: "workflow already closed returns nil",
+			name: "workflow already closed returns its close status",
 			setup: func(m *frontend.MockClient) {
 				m.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).Return(closed, nil)
 			},
+			wantResult: &WatchWorkflowResult{CloseStatus: &completedStatus},
+		},
+		{
+			name: "workflow no longer exists returns unknown close status",
+			setup: func(m *frontend.MockClient) {
+				m.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).
+					Return(nil, &types.EntityNotExistsError{Message: "gone"})
+			},
+			wantResult: &WatchWorkflowResult{},
 		},
 		{
 			name: "describe error retried, exits on context cancel",
@@ -1166,12 +1387,13 @@ func TestWatchWorkflowActivity(t *testing.T) {
 				cancel()
 			}
 
-			err := watchWorkflowActivity(ctx, "test-domain", "wf-id", "run-id")
+			result, err := watchWorkflowActivity(ctx, "test-domain", "wf-id", "run-id")
 			if tc.wantErr != "" {
 				require.Error(t, err)
 				assert.Contains(t, err.Error(), tc.wantErr)
 			} else {
 				require.NoError(t, err)
+				assert.Equal(t, tc.wantResult, result)
 			}
 		})
 	}
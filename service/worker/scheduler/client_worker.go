@@ -22,32 +22,25 @@ package scheduler
 
 import (
 	"context"
-	"sync"
-	"time"
 
 	"go.uber.org/cadence/.gen/go/cadence/workflowserviceclient"
 	cadenceworker "go.uber.org/cadence/worker"
 	"go.uber.org/cadence/workflow"
 
 	"github.com/uber/cadence/client/frontend"
-	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/cache"
-	"github.com/uber/cadence/common/clock"
 	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/membership"
 	"github.com/uber/cadence/common/metrics"
-	"github.com/uber/cadence/common/service"
+	"github.com/uber/cadence/service/worker/domainworker"
 )
 
 const (
-	defaultRefreshInterval = 1 * time.Minute
-	defaultShutdownTimeout = 5 * time.Second
-
-	// membershipSubscriberName is the unique name used to subscribe to membership
-	// change notifications for the worker service ring.
-	membershipSubscriberName = "scheduler-worker-manager"
+	// workerManagerName names the subscription of the worker manager to the
+	// membership changes of the worker service ring.
+	workerManagerName = "scheduler"
 
 	// workerRedundancyFactor is the number of hosts that concurrently run a
 	// worker for each domain. Using 2 means every domain has a primary and one
@@ -77,236 +70,58 @@ type BootstrapParams struct {
 	RedundancyFactor dynamicproperties.IntPropertyFnWithDomainFilter
 }
 
-// workerHandle is the subset of cadenceworker.Worker used by the manager,
-// extracted to allow unit testing without starting real pollers.
-type workerHandle interface {
-	Stop()
-}
-
-// workerFactory creates a worker for a given domain. Returns a workerHandle
-// and an error. The default factory creates a real Cadence SDK worker.
-type workerFactory func(domainName string) (workerHandle, error)
-
-// WorkerManager manages per-domain scheduler workers. It periodically scans
-// the domain cache and uses the membership hashring to determine which domains
-// this host should cover. For each such domain it starts a Cadence SDK worker
-// polling the scheduler task list. Each domain is covered by
-// workerRedundancyFactor hosts simultaneously so that a single host failure
-// does not cause a scheduling gap.
-type WorkerManager struct {
-	enabledFn          dynamicproperties.BoolPropertyFnWithDomainFilter
-	serviceClient      workflowserviceclient.Interface
-	frontendClient     frontend.Client
-	metricsClient      metrics.Client
-	logger             log.Logger
-	domainCache        cache.DomainCache
-	membershipResolver membership.Resolver
-	hostInfo           membership.HostInfo
-	timeSrc            clock.TimeSource
-	refreshInterval    dynamicproperties.DurationPropertyFn
-	redundancyFactor   dynamicproperties.IntPropertyFnWithDomainFilter
-	shutdownTimeout    time.Duration
-	ctx                context.Context
-	cancelFn           context.CancelFunc
-	wg                 sync.WaitGroup
-	activeWorkers      map[string]workerHandle // domain name -> worker
-	createWorker       workerFactory
-	membershipChangeCh chan *membership.ChangedEvent
-}
-
-// NewWorkerManager creates a new per-domain scheduler worker manager.
-func NewWorkerManager(params *BootstrapParams, enabledFn dynamicproperties.BoolPropertyFnWithDomainFilter) *WorkerManager {
-	ctx, cancel := context.WithCancel(context.Background())
-	refresh := params.RefreshInterval
-	if refresh == nil {
-		refresh = dynamicproperties.GetDurationPropertyFn(defaultRefreshInterval)
-	}
-	redundancy := params.RedundancyFactor
-	if redundancy == nil {
-		redundancy = dynamicproperties.GetIntPropertyFilteredByDomain(workerRedundancyFactor)
-	}
-	wm := &WorkerManager{
-		enabledFn:          enabledFn,
-		serviceClient:      params.ServiceClient,
-		frontendClient:     params.FrontendClient,
-		metricsClient:      params.MetricsClient,
-		logger:             params.Logger.WithTags(tag.ComponentScheduler),
-		domainCache:        params.DomainCache,
-		membershipResolver: params.MembershipResolver,
-		hostInfo:           params.HostInfo,
-		timeSrc:            clock.NewRealTimeSource(),
-		refreshInterval:    refresh,
-		redundancyFactor:   redundancy,
-		shutdownTimeout:    defaultShutdownTimeout,
-		ctx:                ctx,
-		cancelFn:           cancel,
-		activeWorkers:      make(map[string]workerHandle),
-		membershipChangeCh: make(chan *membership.ChangedEvent, 10),
-	}
-	wm.createWorker = wm.defaultCreateWorker
-	return wm
-}
-
-// Start begins the background loop that manages per-domain workers.
-func (m *WorkerManager) Start() {
-	m.logger.Info("scheduler worker manager starting")
-	if err := m.membershipResolver.Subscribe(service.Worker, membershipSubscriberName, m.membershipChangeCh); err != nil {
-		m.logger.Warn("failed to subscribe to membership changes, will rely on periodic refresh only", tag.Error(err))
-	}
-	m.wg.Add(1)
-	go m.run()
-}
-
-// Stop signals the background loop to stop and waits for it to finish.
-// It then stops all active workers.
-func (m *WorkerManager) Stop() {
-	m.logger.Info("scheduler worker manager stopping")
-	if err := m.membershipResolver.Unsubscribe(service.Worker, membershipSubscriberName); err != nil {
-		m.logger.Warn("failed to unsubscribe from membership changes", tag.Error(err))
-	}
-	m.cancelFn()
-	if !common.AwaitWaitGroup(&m.wg, m.shutdownTimeout) {
-		m.logger.Warn("scheduler worker manager timed out on shutdown")
-	}
-	m.stopAllWorkers()
-	m.logger.Info("scheduler worker manager stopped")
+// NewWorkerManager creates the manager of the per-domain scheduler workers.
+// For each domain this host covers on the membership hashring, it runs a
+// Cadence SDK worker polling the scheduler task list. Each domain is covered
+// by workerRedundancyFactor hosts simultaneously so that a single host
+// failure does not cause a scheduling gap.
+func NewWorkerManager(params *BootstrapParams, enabledFn dynamicproperties.BoolPropertyFnWithDomainFilter) *domainworker.Manager {
+	return domainworker.NewManager(&domainworker.Params{
+		Name:          workerManagerName,
+		Logger:        params.Logger.WithTags(tag.ComponentScheduler),
+		MetricsClient: params.MetricsClient,
+		Metrics: &domainworker.MetricDefs{
+			Scope:                     metrics.SchedulerWorkerScope,
+			ActiveGauge:               metrics.SchedulerWorkerActiveGauge,
+			StartedCount:              metrics.SchedulerWorkerStartedCount,
+			StoppedCount:              metrics.SchedulerWorkerStoppedCount,
+			StartErrorsCountPerDomain: metrics.SchedulerWorkerStartErrorsCountPerDomain,
+			RefreshLatencyHistogram:   metrics.SchedulerWorkerRefreshLatencyHistogram,
+			LookupFailuresCount:       metrics.SchedulerWorkerLookupFailuresCount,
+			DomainCoverageCount:       metrics.SchedulerWorkerDomainCoverageCount,
+		},
+		DomainCache:        params.DomainCache,
+		MembershipResolver: params.MembershipResolver,
+		HostInfo:           params.HostInfo,
+		Enabled:            enabledFn,
+		RefreshInterval:    params.RefreshInterval,
+		RedundancyFactor:   redundancyFactor(params.RedundancyFactor),
+		CreateWorker: func(domainName string) (domainworker.Worker, error) {
+			return createWorker(params, domainName)
+		},
+	})
 }
 
-func (m *WorkerManager) run() {
-	defer m.wg.Done()
-
-	ticker := m.timeSrc.NewTicker(m.refreshInterval())
-	defer ticker.Stop()
-
-	m.refreshWorkers()
-
-	for {
-		select {
-		case <-ticker.Chan():
-			m.refreshWorkers()
-			ticker.Reset(m.refreshInterval())
-
-		case <-m.membershipChangeCh:
-			drainMembershipCh(m.membershipChangeCh)
-			m.logger.Debug("membership ring changed, refreshing scheduler workers")
-			m.refreshWorkers()
-			ticker.Reset(m.refreshInterval())
-
-		case <-m.ctx.Done():
-			m.logger.Info("scheduler worker manager background loop stopped")
-			return
+// redundancyFactor falls back to workerRedundancyFactor when fn is nil or
+// returns a non-positive value.
+func redundancyFactor(fn dynamicproperties.IntPropertyFnWithDomainFilter) dynamicproperties.IntPropertyFnWithDomainFilter {
+	return func(domainName string) int {
+		if fn != nil {
+			if redundancy := fn(domainName); redundancy > 0 {
+				return redundancy
+			}
 		}
+		return workerRedundancyFactor
 	}
 }
 
-// refreshWorkers scans all domains and reconciles the set of active workers
-// with the domains this host owns via the membership hashring.
-func (m *WorkerManager) refreshWorkers() {
-	scope := m.metricsClient.Scope(metrics.SchedulerWorkerScope)
-	startTime := time.Now()
-	defer func() {
-		scope.ExponentialHistogram(metrics.SchedulerWorkerRefreshLatencyHistogram, time.Since(startTime))
-		scope.UpdateGauge(metrics.SchedulerWorkerActiveGauge, float64(len(m.activeWorkers)))
-	}()
-
-	domains := m.domainCache.GetAllDomain()
-	ownedDomains := make(map[string]struct{}, len(domains))
-	lookupFailed := make(map[string]struct{})
-
-	for _, domainEntry := range domains {
-		select {
-		case <-m.ctx.Done():
-			return
-		default:
-		}
-
-		if domainEntry.IsDeprecatedOrDeleted() {
-			continue
-		}
-
-		domainName := domainEntry.GetInfo().Name
-
-		redundancy := m.redundancyFactor(domainName)
-		if redundancy < 1 {
-			redundancy = workerRedundancyFactor
-		}
-
-		owners, err := m.membershipResolver.LookupN(service.Worker, domainName, redundancy)
-		if err != nil {
-			m.logger.Warn("failed to look up domain owners, skipping",
-				tag.WorkflowDomainName(domainName),
-				tag.Error(err),
-			)
-			scope.IncCounter(metrics.SchedulerWorkerLookupFailuresCount)
-			lookupFailed[domainName] = struct{}{}
-			continue
-		}
-
-		if !containsHost(owners, m.hostInfo) {
-			continue
-		}
-
-		if !m.enabledFn(domainName) {
-			continue
-		}
-
-		ownedDomains[domainName] = struct{}{}
-
-		if _, exists := m.activeWorkers[domainName]; exists {
-			continue
-		}
-
-		m.startWorkerForDomain(scope, domainName)
-	}
-
-	for domainName, w := range m.activeWorkers {
-		if _, owned := ownedDomains[domainName]; owned {
-			scope.Tagged(metrics.DomainTag(domainName)).IncCounter(metrics.SchedulerWorkerDomainCoverageCount)
-			continue
-		}
-		// Keep workers running for domains where lookup failed to avoid
-		// unnecessary churn during transient membership ring issues.
-		if _, failed := lookupFailed[domainName]; failed {
-			continue
-		}
-		m.logger.Info("stopping scheduler worker for domain no longer owned",
-			tag.WorkflowDomainName(domainName),
-		)
-		w.Stop()
-		scope.IncCounter(metrics.SchedulerWorkerStoppedCount)
-		delete(m.activeWorkers, domainName)
-	}
-
-	m.logger.Debug("scheduler workers refreshed",
-		tag.Dynamic("active-worker-count", len(m.activeWorkers)),
-	)
-}
-
-func (m *WorkerManager) startWorkerForDomain(scope metrics.Scope, domainName string) {
-	w, err := m.createWorker(domainName)
-	if err != nil {
-		m.logger.Error("failed to start scheduler worker for domain",
-			tag.WorkflowDomainName(domainName),
-			tag.Error(err),
-		)
-		scope.Tagged(metrics.DomainTag(domainName)).IncCounter(metrics.SchedulerWorkerStartErrorsCountPerDomain)
-		return
-	}
-
-	m.activeWorkers[domainName] = w
-	scope.IncCounter(metrics.SchedulerWorkerStartedCount)
-	m.logger.Info("started scheduler worker for domain",
-		tag.WorkflowDomainName(domainName),
-	)
-}
-
-func (m *WorkerManager) defaultCreateWorker(domainName string) (workerHandle, error) {
+func createWorker(params *BootstrapParams, domainName string) (domainworker.Worker, error) {
 	actCtx := context.WithValue(context.Background(), schedulerContextKey, schedulerContext{
-		FrontendClient: m.frontendClient,
-		MetricsClient:  m.metricsClient,
+		FrontendClient: params.FrontendClient,
+		MetricsClient:  params.MetricsClient,
 	})
 
-	w := cadenceworker.New(m.serviceClient, domainName, TaskListName, cadenceworker.Options{
+	w := cadenceworker.New(params.ServiceClient, domainName, TaskListName, cadenceworker.Options{
 		BackgroundActivityContext: actCtx,
 	})
 	w.RegisterWorkflowWithOptions(SchedulerWorkflow, workflow.RegisterOptions{Name: WorkflowTypeName})
@@ -317,35 +132,3 @@ func (m *WorkerManager) defaultCreateWorker(domainName string) (workerHandle, er
 	}
 	return w, nil
 }
-
-func (m *WorkerManager) stopAllWorkers() {
-	for domainName, w := range m.activeWorkers {
-		w.Stop()
-		m.logger.Info("stopped scheduler worker for domain",
-			tag.WorkflowDomainName(domainName),
-		)
-		delete(m.activeWorkers, domainName)
-	}
-}
-
-// drainMembershipCh consumes all pending events from the channel without
-// blocking, so that a single refreshWorkers call covers all queued changes.
-func drainMembershipCh(ch <-chan *membership.ChangedEvent) {
-	for {
-		select {
-		case <-ch:
-		default:
-			return
-		}
-	}
-}
-
-// containsHost reports whether hosts contains the given target host.
-func containsHost(hosts []membership.HostInfo, target membership.HostInfo) bool {
-	for _, h := range hosts {
-		if h.Identity() == target.Identity() {
-			return true
-		}
-	}
-	return false
-}
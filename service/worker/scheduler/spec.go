@@ -0,0 +1,461 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package scheduler
+
+import (
+	"fmt"
+	"math/bits"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/robfig/cron/v3"
+
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	// minScheduleInterval is the smallest interval an interval spec may fire at.
+	minScheduleInterval = time.Second
+	// maxCalendarSearchDays bounds the day-by-day walk of a calendar spec, the
+	// same horizon robfig/cron gives up at for impossible expressions.
+	maxCalendarSearchDays = 5 * 366
+	// maxExcludedSearch bounds how far past an excluded fire time the next
+	// fire time is searched for before the schedule is considered to never
+	// fire again. Excluded periods are skipped as a whole, so this is a time
+	// horizon rather than a number of fires.
+	maxExcludedSearch = maxCalendarSearchDays * 24 * time.Hour
+)
+
+var (
+	monthNames = map[string]int{
+		"JAN": 1, "FEB": 2, "MAR": 3, "APR": 4, "MAY": 5, "JUN": 6,
+		"JUL": 7, "AUG": 8, "SEP": 9, "OCT": 10, "NOV": 11, "DEC": 12,
+	}
+	dayOfWeekNames = map[string]int{
+		"SUN": 0, "MON": 1, "TUE": 2, "WED": 3, "THU": 4, "FRI": 5, "SAT": 6,
+	}
+)
+
+// NewSchedule compiles a ScheduleSpec into a cron.Schedule whose Next returns
+// the earliest time after t matched by the cron expression, any interval or any
+// calendar, skipping the times matched by the exclude calendars. StartTime and
+// EndTime are not applied here, see computeNextRunTime.
+func NewSchedule(spec *types.ScheduleSpec) (cron.Schedule, error) {
+	if !spec.HasTriggers() {
+		return nil, fmt.Errorf("one of CronExpression, Intervals or Calendars must be set")
+	}
+	s := &specSchedule{}
+	if expr := spec.GetCronExpression(); expr != "" {
+		sched, err := cron.ParseStandard(expr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid cron expression %q: %w", expr, err)
+		}
+		s.schedules = append(s.schedules, sched)
+	}
+	for i, interval := range spec.GetIntervals() {
+		sched, err := newIntervalSchedule(interval)
+		if err != nil {
+			return nil, fmt.Errorf("invalid interval %d: %w", i, err)
+		}
+		s.schedules = append(s.schedules, sched)
+	}
+	for i, calendar := range spec.GetCalendars() {
+		sched, err := newCalendarSchedule(calendar, "0")
+		if err != nil {
+			return nil, fmt.Errorf("invalid calendar %d: %w", i, err)
+		}
+		s.schedules = append(s.schedules, sched)
+	}
+	for i, calendar := range spec.GetExcludeCalendars() {
+		sched, err := newCalendarSchedule(calendar, "*")
+		if err != nil {
+			return nil, fmt.Errorf("invalid exclude calendar %d: %w", i, err)
+		}
+		s.excludes = append(s.excludes, sched)
+	}
+	return s, nil
+}
+
+// specSchedule is the union of its schedules minus the times matched by its excludes.
+type specSchedule struct {
+	schedules []cron.Schedule
+	excludes  []*calendarSchedule
+}
+
+func (s *specSchedule) Next(t time.Time) time.Time {
+	horizon := t.Add(maxExcludedSearch)
+	for t.Before(horizon) {
+		var next time.Time
+		for _, sched := range s.schedules {
+			n := sched.Next(t)
+			if !n.IsZero() && (next.IsZero() || n.Before(next)) {
+				next = n
+			}
+		}
+		if next.IsZero() || !s.excluded(next) {
+			return next
+		}
+		// skip the whole excluded period instead of its fire times one by one,
+		// an every second interval may be excluded for days
+		resume := s.endOfExclusion(next)
+		if resume.IsZero() {
+			return time.Time{}
+		}
+		// all the schedules return times strictly after t and whole seconds
+		t = resume.Add(-time.Nanosecond)
+	}
+	return time.Time{}
+}
+
+func (s *specSchedule) excluded(t time.Time) bool {
+	for _, exclude := range s.excludes {
+		if exclude.matches(t) {
+			return true
+		}
+	}
+	return false
+}
+
+// endOfExclusion returns the earliest time at or after t which none of the
+// exclude calendars matches, or zero time if there is none within the search horizon.
+func (s *specSchedule) endOfExclusion(t time.Time) time.Time {
+	for changed := true; changed; {
+		changed = false
+		for _, exclude := range s.excludes {
+			if !exclude.matches(t) {
+				continue
+			}
+			if t = exclude.nextUnmatched(t); t.IsZero() {
+				return t
+			}
+			changed = true
+		}
+	}
+	return t
+}
+
+// intervalSchedule fires at epoch + offset + k * interval.
+type intervalSchedule struct {
+	interval time.Duration
+	offset   time.Duration
+}
+
+func newIntervalSchedule(spec *types.ScheduleIntervalSpec) (*intervalSchedule, error) {
+	if spec.GetInterval() < minScheduleInterval {
+		return nil, fmt.Errorf("interval must be at least %v, got %v", minScheduleInterval, spec.GetInterval())
+	}
+	if spec.GetOffset() < 0 || spec.GetOffset() >= spec.GetInterval() {
+		return nil, fmt.Errorf("offset must be within [0, interval), got %v", spec.GetOffset())
+	}
+	return &intervalSchedule{interval: spec.GetInterval(), offset: spec.GetOffset()}, nil
+}
+
+func (s *intervalSchedule) Next(t time.Time) time.Time {
+	// Fire times are whole seconds, the same as cron.
+	t = t.Truncate(time.Second)
+	elapsed := time.Duration(t.UnixNano()) - s.offset
+	k := elapsed / s.interval
+	if elapsed < 0 && elapsed%s.interval != 0 {
+		k--
+	}
+	return time.Unix(0, int64((k+1)*s.interval+s.offset)).In(t.Location())
+}
+
+// calendarSchedule matches wall-clock times in a location. Each field is a bit set of the matching values.
+type calendarSchedule struct {
+	second, minute, hour, dayOfMonth, month, dayOfWeek uint64
+	// lastDayOfMonth restricts the matching days to the last day of the month that matches dayOfWeek
+	lastDayOfMonth bool
+	// years is nil when any year matches
+	years    []yearRange
+	location *time.Location
+}
+
+type yearRange struct {
+	start, end, step int
+}
+
+func newCalendarSchedule(spec *types.ScheduleCalendarSpec, defaultTimeOfDay string) (*calendarSchedule, error) {
+	var err error
+	s := &calendarSchedule{location: time.UTC}
+	if tz := spec.GetTimeZone(); tz != "" {
+		if s.location, err = time.LoadLocation(tz); err != nil {
+			return nil, fmt.Errorf("invalid time zone %q: %w", tz, err)
+		}
+	}
+	if s.second, err = parseCalendarField("second", withDefault(spec.GetSecond(), defaultTimeOfDay), 0, 59, nil); err != nil {
+		return nil, err
+	}
+	if s.minute, err = parseCalendarField("minute", withDefault(spec.GetMinute(), defaultTimeOfDay), 0, 59, nil); err != nil {
+		return nil, err
+	}
+	if s.hour, err = parseCalendarField("hour", withDefault(spec.GetHour(), defaultTimeOfDay), 0, 23, nil); err != nil {
+		return nil, err
+	}
+	dayOfMonth := withDefault(spec.GetDayOfMonth(), "*")
+	if strings.EqualFold(dayOfMonth, "L") {
+		s.lastDayOfMonth = true
+		dayOfMonth = "*"
+	}
+	if s.dayOfMonth, err = parseCalendarField("day of month", dayOfMonth, 1, 31, nil); err != nil {
+		return nil, err
+	}
+	if s.month, err = parseCalendarField("month", withDefault(spec.GetMonth(), "*"), 1, 12, monthNames); err != nil {
+		return nil, err
+	}
+	if s.dayOfWeek, err = parseCalendarField("day of week", withDefault(spec.GetDayOfWeek(), "*"), 0, 7, dayOfWeekNames); err != nil {
+		return nil, err
+	}
+	// 7 is an alias of Sunday
+	if s.dayOfWeek&(1<<7) != 0 {
+		s.dayOfWeek |= 1
+	}
+	if s.years, err = parseYears(withDefault(spec.GetYear(), "*")); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (s *calendarSchedule) Next(t time.Time) time.Time {
+	origin := t.Location()
+	t = t.In(s.location)
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, s.location)
+	for i := 0; i < maxCalendarSearchDays; i++ {
+		if s.matchesDay(day) {
+			if next := s.nextInDay(day, t); !next.IsZero() {
+				return next.In(origin)
+			}
+		}
+		day = time.Date(day.Year(), day.Month(), day.Day()+1, 0, 0, 0, 0, s.location)
+	}
+	return time.Time{}
+}
+
+// nextInDay returns the earliest matching time of the day which is after t, or zero time if there is none.
+func (s *calendarSchedule) nextInDay(day, t time.Time) time.Time {
+	for hour := 0; hour < 24; hour++ {
+		if s.hour&(1<<hour) == 0 || endOf(day, hour, 59, 59).Before(t) {
+			continue
+		}
+		for minute := 0; minute < 60; minute++ {
+			if s.minute&(1<<minute) == 0 || endOf(day, hour, minute, 59).Before(t) {
+				continue
+			}
+			for second := 0; second < 60; second++ {
+				if s.second&(1<<second) == 0 {
+					continue
+				}
+				candidate := time.Date(day.Year(), day.Month(), day.Day(), hour, minute, second, 0, s.location)
+				// Wall-clock times skipped by a daylight saving transition are normalized by
+				// time.Date into another hour, they don't exist so they never fire.
+				if candidate.Hour() != hour || candidate.Minute() != minute {
+					continue
+				}
+				if candidate.After(t) {
+					return candidate
+				}
+			}
+		}
+	}
+	return time.Time{}
+}
+
+// nextUnmatched returns the earliest whole second at or after t which the calendar doesn't
+// match, or zero time if the calendar matches every second within the search horizon.
+func (s *calendarSchedule) nextUnmatched(t time.Time) time.Time {
+	origin := t.Location()
+	t = t.In(s.location)
+	for i := 0; i < maxCalendarSearchDays; i++ {
+		if !s.matchesDay(t) {
+			return t.In(origin)
+		}
+		if next := s.unmatchedInDay(t); !next.IsZero() {
+			return next.In(origin)
+		}
+		t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, s.location)
+	}
+	return time.Time{}
+}
+
+// unmatchedInDay returns the earliest time of the day of t, at or after t, whose time of day
+// the calendar doesn't match, or zero time if it matches the rest of the day.
+func (s *calendarSchedule) unmatchedInDay(t time.Time) time.Time {
+	notBefore := func(candidate time.Time) time.Time {
+		if candidate.Before(t) {
+			return t
+		}
+		return candidate
+	}
+	for hour := t.Hour(); hour < 24; hour++ {
+		if s.hour&(1<<hour) == 0 {
+			return notBefore(endOf(t, hour, 0, 0))
+		}
+		firstMinute := 0
+		if hour == t.Hour() {
+			firstMinute = t.Minute()
+		}
+		for minute := firstMinute; minute < 60; minute++ {
+			if s.minute&(1<<minute) == 0 {
+				return notBefore(endOf(t, hour, minute, 0))
+			}
+			firstSecond := 0
+			if hour == t.Hour() && minute == t.Minute() {
+				firstSecond = t.Second()
+			}
+			for second := firstSecond; second < 60; second++ {
+				if s.second&(1<<second) == 0 {
+					return notBefore(endOf(t, hour, minute, second))
+				}
+			}
+		}
+	}
+	return time.Time{}
+}
+
+func endOf(day time.Time, hour, minute, second int) time.Time {
+	return time.Date(day.Year(), day.Month(), day.Day(), hour, minute, second, 0, day.Location())
+}
+
+// matches returns true if the wall-clock time of t in the calendar's location matches all the fields.
+func (s *calendarSchedule) matches(t time.Time) bool {
+	t = t.In(s.location)
+	return s.matchesDay(t) &&
+		s.hour&(1<<t.Hour()) != 0 &&
+		s.minute&(1<<t.Minute()) != 0 &&
+		s.second&(1<<t.Second()) != 0
+}
+
+func (s *calendarSchedule) matchesDay(t time.Time) bool {
+	if s.month&(1<<int(t.Month())) == 0 || !s.matchesYear(t.Year()) {
+		return false
+	}
+	if !s.lastDayOfMonth {
+		return s.dayOfMonth&(1<<t.Day()) != 0 && s.dayOfWeek&(1<<int(t.Weekday())) != 0
+	}
+	// find the last day of the month matching the day of week
+	last := time.Date(t.Year(), t.Month()+1, 0, 0, 0, 0, 0, t.Location())
+	for last.Day() > t.Day() && s.dayOfWeek&(1<<int(last.Weekday())) == 0 {
+		last = last.AddDate(0, 0, -1)
+	}
+	return last.Day() == t.Day() && s.dayOfWeek&(1<<int(t.Weekday())) != 0
+}
+
+func (s *calendarSchedule) matchesYear(year int) bool {
+	if s.years == nil {
+		return true
+	}
+	for _, r := range s.years {
+		if year >= r.start && year <= r.end && (year-r.start)%r.step == 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func withDefault(value, defaultValue string) string {
+	if strings.TrimSpace(value) == "" {
+		return defaultValue
+	}
+	return value
+}
+
+// parseCalendarField parses a cron-like field into a bit set of the values within [min, max].
+func parseCalendarField(name, field string, min, max int, names map[string]int) (uint64, error) {
+	var bitSet uint64
+	for _, part := range strings.Split(field, ",") {
+		start, end, step, err := parseRange(strings.TrimSpace(part), min, max, names)
+		if err != nil {
+			return 0, fmt.Errorf("invalid %v %q: %w", name, field, err)
+		}
+		for v := start; v <= end; v += step {
+			bitSet |= 1 << v
+		}
+	}
+	if bits.OnesCount64(bitSet) == 0 {
+		return 0, fmt.Errorf("invalid %v %q: matches nothing", name, field)
+	}
+	return bitSet, nil
+}
+
+func parseYears(field string) ([]yearRange, error) {
+	if strings.TrimSpace(field) == "*" {
+		return nil, nil
+	}
+	var years []yearRange
+	for _, part := range strings.Split(field, ",") {
+		part = strings.TrimSpace(part)
+		if strings.HasPrefix(part, "*") {
+			return nil, fmt.Errorf("invalid year %q: steps of all years are not supported", field)
+		}
+		start, end, step, err := parseRange(part, 1970, 9999, nil)
+		if err != nil {
+			return nil, fmt.Errorf("invalid year %q: %w", field, err)
+		}
+		years = append(years, yearRange{start: start, end: end, step: step})
+	}
+	return years, nil
+}
+
+// parseRange parses "*", "v", "a-b", with an optional "/step" suffix.
+func parseRange(part string, min, max int, names map[string]int) (start, end, step int, err error) {
+	step = 1
+	if rangePart, stepPart, ok := strings.Cut(part, "/"); ok {
+		if step, err = strconv.Atoi(stepPart); err != nil || step <= 0 {
+			return 0, 0, 0, fmt.Errorf("invalid step %q", stepPart)
+		}
+		part = rangePart
+	}
+	if part == "*" {
+		return min, max, step, nil
+	}
+	startPart, endPart, isRange := strings.Cut(part, "-")
+	if start, err = parseValue(startPart, min, max, names); err != nil {
+		return 0, 0, 0, err
+	}
+	end = start
+	if isRange {
+		if end, err = parseValue(endPart, min, max, names); err != nil {
+			return 0, 0, 0, err
+		}
+	} else if step > 1 {
+		// "a/n" means from a to the max, the same as cron
+		end = max
+	}
+	if start > end {
+		return 0, 0, 0, fmt.Errorf("invalid range %q", part)
+	}
+	return start, end, step, nil
+}
+
+func parseValue(value string, min, max int, names map[string]int) (int, error) {
+	if v, ok := names[strings.ToUpper(value)]; ok {
+		return v, nil
+	}
+	v, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value %q", value)
+	}
+	if v < min || v > max {
+		return 0, fmt.Errorf("value %v out of range [%v, %v]", v, min, max)
+	}
+	return v, nil
+}
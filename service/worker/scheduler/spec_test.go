@@ -0,0 +1,196 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package scheduler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common/types"
+)
+
+func mustParseTime(t *testing.T, s string) time.Time {
+	t.Helper()
+	ts, err := time.Parse(time.RFC3339, s)
+	require.NoError(t, err)
+	return ts
+}
+
+func TestNewSchedule_Next(t *testing.T) {
+	tests := []struct {
+		name string
+		spec types.ScheduleSpec
+		from string
+		want []string
+	}{
+		{
+			name: "cron expression",
+			spec: types.ScheduleSpec{CronExpression: "0 * * * *"},
+			from: "2026-01-01T10:20:00Z",
+			want: []string{"2026-01-01T11:00:00Z", "2026-01-01T12:00:00Z"},
+		},
+		{
+			name: "interval with offset",
+			spec: types.ScheduleSpec{Intervals: []*types.ScheduleIntervalSpec{{Interval: time.Hour, Offset: 15 * time.Minute}}},
+			from: "2026-01-01T10:20:00Z",
+			want: []string{"2026-01-01T11:15:00Z", "2026-01-01T12:15:00Z"},
+		},
+		{
+			name: "union of cron and interval",
+			spec: types.ScheduleSpec{
+				CronExpression: "0 * * * *",
+				Intervals:      []*types.ScheduleIntervalSpec{{Interval: time.Hour, Offset: 30 * time.Minute}},
+			},
+			from: "2026-01-01T10:20:00Z",
+			want: []string{"2026-01-01T10:30:00Z", "2026-01-01T11:00:00Z", "2026-01-01T11:30:00Z"},
+		},
+		{
+			name: "last business day of month at 09:00 America/New_York",
+			spec: types.ScheduleSpec{Calendars: []*types.ScheduleCalendarSpec{{
+				Hour:       "9",
+				DayOfMonth: "L",
+				DayOfWeek:  "MON-FRI",
+				TimeZone:   "America/New_York",
+			}}},
+			from: "2026-05-01T00:00:00Z",
+			// May 31st is a Sunday, June 30th a Tuesday
+			want: []string{"2026-05-29T13:00:00Z", "2026-06-30T13:00:00Z"},
+		},
+		{
+			name: "calendar with lists and names",
+			spec: types.ScheduleSpec{Calendars: []*types.ScheduleCalendarSpec{{
+				Minute:    "0,30",
+				Hour:      "12",
+				DayOfWeek: "SAT,SUN",
+			}}},
+			from: "2026-05-01T00:00:00Z",
+			want: []string{"2026-05-02T12:00:00Z", "2026-05-02T12:30:00Z", "2026-05-03T12:00:00Z", "2026-05-03T12:30:00Z", "2026-05-09T12:00:00Z"},
+		},
+		{
+			name: "exclude calendar skips the whole day",
+			spec: types.ScheduleSpec{
+				CronExpression:   "0 12 * * *",
+				ExcludeCalendars: []*types.ScheduleCalendarSpec{{Month: "DEC", DayOfMonth: "25"}},
+			},
+			from: "2026-12-24T13:00:00Z",
+			want: []string{"2026-12-26T12:00:00Z"},
+		},
+		{
+			name: "exclude calendar skips a long excluded period of an every second interval",
+			spec: types.ScheduleSpec{
+				Intervals:        []*types.ScheduleIntervalSpec{{Interval: time.Second}},
+				ExcludeCalendars: []*types.ScheduleCalendarSpec{{Month: "FEB-MAR"}},
+			},
+			from: "2026-01-31T23:59:58Z",
+			want: []string{"2026-01-31T23:59:59Z", "2026-04-01T00:00:00Z", "2026-04-01T00:00:01Z"},
+		},
+		{
+			name: "exclude calendars covering each other are skipped together",
+			spec: types.ScheduleSpec{
+				Intervals: []*types.ScheduleIntervalSpec{{Interval: time.Minute}},
+				ExcludeCalendars: []*types.ScheduleCalendarSpec{
+					{Second: "*", Minute: "*", Hour: "9-12"},
+					{Second: "*", Minute: "0-29", Hour: "13"},
+				},
+			},
+			from: "2026-05-01T08:58:30Z",
+			want: []string{"2026-05-01T08:59:00Z", "2026-05-01T13:30:00Z"},
+		},
+		{
+			name: "wall-clock time skipped by daylight saving never fires",
+			spec: types.ScheduleSpec{Calendars: []*types.ScheduleCalendarSpec{{
+				Minute:   "30",
+				Hour:     "2",
+				TimeZone: "America/New_York",
+			}}},
+			from: "2026-03-07T08:00:00Z",
+			// 02:30 doesn't exist on March 8th in New York
+			want: []string{"2026-03-09T06:30:00Z"},
+		},
+		{
+			name: "year restricts the calendar",
+			spec: types.ScheduleSpec{Calendars: []*types.ScheduleCalendarSpec{{
+				Month:      "1",
+				DayOfMonth: "1",
+				Year:       "2028-2030/2",
+			}}},
+			from: "2026-01-01T00:00:00Z",
+			want: []string{"2028-01-01T00:00:00Z", "2030-01-01T00:00:00Z", ""},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sched, err := NewSchedule(&tt.spec)
+			require.NoError(t, err)
+			next := mustParseTime(t, tt.from)
+			for _, want := range tt.want {
+				next = sched.Next(next)
+				if want == "" {
+					assert.True(t, next.IsZero(), "expected no more fires, got %v", next)
+					return
+				}
+				assert.Equal(t, mustParseTime(t, want), next.UTC())
+			}
+		})
+	}
+}
+
+func TestNewSchedule_ImpossibleCalendarNeverFires(t *testing.T) {
+	sched, err := NewSchedule(&types.ScheduleSpec{Calendars: []*types.ScheduleCalendarSpec{{Month: "2", DayOfMonth: "30"}}})
+	require.NoError(t, err)
+	assert.True(t, sched.Next(mustParseTime(t, "2026-01-01T00:00:00Z")).IsZero())
+}
+
+func TestNewSchedule_FullyExcludedNeverFires(t *testing.T) {
+	sched, err := NewSchedule(&types.ScheduleSpec{
+		Intervals:        []*types.ScheduleIntervalSpec{{Interval: time.Second}},
+		ExcludeCalendars: []*types.ScheduleCalendarSpec{{Second: "*", Minute: "*", Hour: "*"}},
+	})
+	require.NoError(t, err)
+	assert.True(t, sched.Next(mustParseTime(t, "2026-01-01T00:00:00Z")).IsZero())
+}
+
+func TestNewSchedule_Invalid(t *testing.T) {
+	tests := map[string]types.ScheduleSpec{
+		"no triggers":              {},
+		"invalid cron":             {CronExpression: "not-a-cron"},
+		"interval too short":       {Intervals: []*types.ScheduleIntervalSpec{{Interval: time.Millisecond}}},
+		"offset not within":        {Intervals: []*types.ScheduleIntervalSpec{{Interval: time.Hour, Offset: time.Hour}}},
+		"negative offset":          {Intervals: []*types.ScheduleIntervalSpec{{Interval: time.Hour, Offset: -time.Minute}}},
+		"hour out of range":        {Calendars: []*types.ScheduleCalendarSpec{{Hour: "24"}}},
+		"unknown month name":       {Calendars: []*types.ScheduleCalendarSpec{{Month: "SMARCH"}}},
+		"inverted range":           {Calendars: []*types.ScheduleCalendarSpec{{DayOfMonth: "20-10"}}},
+		"zero step":                {Calendars: []*types.ScheduleCalendarSpec{{Minute: "*/0"}}},
+		"unknown time zone":        {Calendars: []*types.ScheduleCalendarSpec{{TimeZone: "Mars/Olympus_Mons"}}},
+		"invalid exclude calendar": {CronExpression: "* * * * *", ExcludeCalendars: []*types.ScheduleCalendarSpec{{DayOfWeek: "9"}}},
+		"step of all years":        {Calendars: []*types.ScheduleCalendarSpec{{Year: "*/2"}}},
+		"exclude calendars only":   {ExcludeCalendars: []*types.ScheduleCalendarSpec{{Month: "1"}}},
+	}
+	for name, spec := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := NewSchedule(&spec)
+			assert.Error(t, err)
+		})
+	}
+}
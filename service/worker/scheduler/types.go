@@ -36,6 +36,7 @@ const (
 	SignalNameUpdate   = "scheduler-update"
 	SignalNameBackfill = "scheduler-backfill"
 	SignalNameDelete   = "scheduler-delete"
+	SignalNameTrigger  = "scheduler-trigger"
 
 	QueryTypeDescribe = "scheduler-describe"
 
@@ -55,11 +56,22 @@ const (
 	// (reason=user_limit) from drops driven by the server-side ceiling that
 	// protects ContinueAsNew payload size (reason=system_limit).
 	SchedulerBufferOverflowCountPerDomain = "scheduler_buffer_overflow_count_per_domain"
+	// SchedulerTriggerRejectedCountPerDomain counts trigger signals dropped by
+	// the workflow because maxPendingTriggers were already queued.
+	SchedulerTriggerRejectedCountPerDomain = "scheduler_trigger_rejected_count_per_domain"
+	// SchedulerTargetFailedCountPerDomain counts target runs seen closing as
+	// FAILED or TIMED_OUT by the watcher activity. Tagged with the close status.
+	SchedulerTargetFailedCountPerDomain = "scheduler_target_failed_count_per_domain"
+	// SchedulerAutoPausedCountPerDomain counts schedules paused by
+	// PauseOnFailure. Tagged with the exhausted budget as the reason
+	// (consecutive_failures, failure_rate).
+	SchedulerAutoPausedCountPerDomain = "scheduler_auto_paused_count_per_domain"
 
 	// Tag key strings for scheduler workflow metrics.
 	SignalTypeTag    = "signal_type"
 	CatchUpPolicyTag = "catch_up_policy"
 	ReasonTag        = "reason"
+	CloseStatusTag   = "close_status"
 
 	// ContinueAsNew reason tag values for scheduler_continue_as_new_count metric.
 	ContinueAsNewReasonMissedRun    = "missed_run"
@@ -83,6 +95,16 @@ const (
 	// than enqueued a second time.
 	BackfillRejectedReasonDuplicateID = "duplicate_id"
 
+	// Reason tag values for scheduler_trigger_rejected_count_per_domain.
+	TriggerRejectedReasonQueueFull = "queue_full"
+
+	// Reason tag values for scheduler_auto_paused_count_per_domain.
+	AutoPauseReasonConsecutiveFailures = "consecutive_failures"
+	AutoPauseReasonFailureRate         = "failure_rate"
+
+	// AutoPausedBy is the PausedBy value of a schedule paused by PauseOnFailure.
+	AutoPausedBy = "cadence-scheduler"
+
 	// MaxBufferedFiresSystemLimit caps the BUFFER overlap policy queue regardless
 	// of buffer_limit (including buffer_limit=0 meaning unlimited). It bounds the
 	// ContinueAsNew payload size: each BufferedFire is ~50 bytes JSON, so 1000
@@ -103,6 +125,7 @@ const (
 	signalTypeTagUpdate   = "update"
 	signalTypeTagBackfill = "backfill"
 	signalTypeTagDelete   = "delete"
+	signalTypeTagTrigger  = "trigger"
 
 	// Search attribute keys set on target workflows started by the scheduler.
 	// The string values are defined in common/definition to make them part of
@@ -137,6 +160,16 @@ const (
 	// bounded by this value before ContinueAsNew.
 	maxActivitiesPerExecution = 500
 	maxPendingBackfills       = 10
+	maxPendingTriggers        = 10
+	// maxRecentActions bounds SchedulerWorkflowState.RecentActions, which is
+	// carried in the ContinueAsNew payload.
+	maxRecentActions = 10
+	// maxRunOutcomes bounds SchedulerWorkflowState.RunOutcomes, so the failure
+	// rate is computed over at most this many of the latest runs in the window.
+	maxRunOutcomes = 100
+	// maxOpenRuns bounds SchedulerWorkflowState.OpenRuns. The outcome of a run
+	// dropped beyond it is not counted against the failure budget.
+	maxOpenRuns = 100
 
 	// maxBackfillRunsTotalCount caps the cron walk that populates
 	// BackfillRequest.RunsTotal. When a backfill range produces more fires
@@ -159,14 +192,30 @@ const (
 	// 24h covers workflows that run for many hours; transient describe errors are
 	// retried within the activity rather than surfaced to the workflow.
 	watcherActivityScheduleToCloseTimeout = 24 * time.Hour
-	// watcherActivityHeartbeatTimeout must exceed watcherPollInterval by enough
+	// watcherActivityHeartbeatTimeout must exceed watcherMaxPollInterval by enough
 	// margin that a single slow poll does not look like a dead worker.
-	watcherActivityHeartbeatTimeout = 65 * time.Second
+	watcherActivityHeartbeatTimeout = 2 * time.Minute
+)
+
+// Change IDs of the workflow.GetVersion gates of the scheduler workflow. Histories
+// recorded before a change replay through its workflow.DefaultVersion branch.
+const (
+	// versionScheduleSpec compiles the whole ScheduleSpec instead of only its
+	// cron expression.
+	versionScheduleSpec = "schedule-spec"
+	// versionWatchRecentActions lets the watcher activity follow the open runs the
+	// schedule started, not only the run blocking the head of BufferedFires.
+	versionWatchRecentActions = "watch-recent-actions"
 )
 
-// watcherPollInterval controls how often the watcher activity calls
-// DescribeWorkflowExecution. 5s balances drain latency against RPC load.
-var watcherPollInterval = 5 * time.Second
+var (
+	// watcherPollInterval is the first interval at which the watcher activity
+	// calls DescribeWorkflowExecution. It doubles after every poll that finds the
+	// workflow still running, up to watcherMaxPollInterval, so a short run is
+	// seen closed within seconds while a long run costs a describe a minute.
+	watcherPollInterval    = 5 * time.Second
+	watcherMaxPollInterval = time.Minute
+)
 
 // SchedulerWorkflowInput is the input to the scheduler workflow.
 // It carries the schedule definition and any prior state (for ContinueAsNew).
@@ -195,6 +244,9 @@ type SchedulerWorkflowState struct {
 	SkippedRuns       int64             `json:"skippedRuns"`
 	Iterations        int               `json:"iterations"`
 	PendingBackfills  []BackfillRequest `json:"pendingBackfills,omitempty"`
+	// PendingTriggers holds TriggerSchedule requests received but not fired
+	// yet, e.g. because a signal forced ContinueAsNew first.
+	PendingTriggers []TriggerSignal `json:"pendingTriggers,omitempty"`
 	// BufferedFires holds fires queued for sequential execution under the BUFFER
 	// overlap policy. Fires are appended when the previous target workflow is
 	// still running at fire time and drained in FIFO order on subsequent
@@ -221,6 +273,34 @@ type SchedulerWorkflowState struct {
 	// PausedAt is the wall-clock time when the schedule was most recently paused.
 	// Zero when the schedule is not paused (or was never paused).
 	PausedAt time.Time `json:"pausedAt,omitempty"`
+	// RecentActions holds the last maxRecentActions fires that started a workflow,
+	// oldest first. The watcher activity fills in their close status.
+	RecentActions []RecentAction `json:"recentActions,omitempty"`
+	// ConsecutiveFailures is the number of target runs in a row that failed,
+	// reset by a successful run and on unpause.
+	ConsecutiveFailures int32 `json:"consecutiveFailures,omitempty"`
+	// RunOutcomes holds the target runs that closed within the failure budget's
+	// FailureRateWindow, oldest first. Reset on unpause.
+	RunOutcomes []RunOutcome `json:"runOutcomes,omitempty"`
+	// OpenRuns holds the started target runs the watcher has not seen closed
+	// yet, oldest first. Unlike RecentActions it is not trimmed to the latest
+	// fires, so every run that closes counts against the failure budget.
+	OpenRuns []RunningWorkflowInfo `json:"openRuns,omitempty"`
+}
+
+// RunOutcome records when a target run closed and whether it failed, for the
+// failure rate check of PauseOnFailure.
+type RunOutcome struct {
+	CloseTime time.Time `json:"closeTime"`
+	Failed    bool      `json:"failed,omitempty"`
+}
+
+// RecentAction is a fire that started a workflow, kept for the describe query.
+type RecentAction struct {
+	types.ScheduleActionResult
+	// Closed is true once the watcher saw the workflow close, even if it could
+	// not tell the close status, so that it is not watched again.
+	Closed bool `json:"closed,omitempty"`
 }
 
 // BufferedFire is a schedule fire queued for sequential execution by the BUFFER
@@ -296,6 +376,13 @@ type BackfillSignal struct {
 	BackfillID    string                      `json:"backfillId,omitempty"`
 }
 
+// TriggerSignal is the payload sent with a trigger signal. The workflow fills
+// in TriggerTime when it receives the signal.
+type TriggerSignal struct {
+	OverlapPolicy types.ScheduleOverlapPolicy `json:"overlapPolicy"`
+	TriggerTime   time.Time                   `json:"triggerTime,omitempty"`
+}
+
 // ScheduleDescription is the query result returned by the describe query handler.
 // It provides a snapshot of the schedule's current configuration and runtime state.
 type ScheduleDescription struct {
@@ -322,6 +409,8 @@ type ScheduleDescription struct {
 	// OngoingBackfills mirrors SchedulerWorkflowState.PendingBackfills at the
 	// time of the describe query.
 	OngoingBackfills []types.BackfillInfo `json:"ongoingBackfills,omitempty"`
+	// RecentActions mirrors SchedulerWorkflowState.RecentActions, oldest first.
+	RecentActions []types.ScheduleActionResult `json:"recentActions,omitempty"`
 }
 
 // TriggerSource identifies what caused a schedule fire, used to differentiate
@@ -332,6 +421,8 @@ type TriggerSource string
 const (
 	TriggerSourceSchedule TriggerSource = "schedule"
 	TriggerSourceBackfill TriggerSource = "backfill"
+	// TriggerSourceTrigger marks fires requested through TriggerSchedule.
+	TriggerSourceTrigger TriggerSource = "trigger"
 )
 
 // fireOutcome is the result of attempting to fire a single schedule run. It
@@ -349,10 +440,10 @@ const (
 )
 
 // ProcessFireRequest is the input to processScheduleFireActivity. It contains
-// everything the activity needs to resolve the overlap policy and start the
-// target workflow. All side effects (describe, cancel, terminate, start) happen
-// inside this single activity so the workflow history stays stable when the
-// overlap logic evolves.
+// everything the activity needs to resolve the overlap policy and start or
+// signal the target workflow. All side effects (describe, cancel, terminate,
+// start, signal) happen inside this single activity so the workflow history
+// stays stable when the overlap logic evolves.
 type ProcessFireRequest struct {
 	Domain              string                      `json:"domain"`
 	ScheduleID          string                      `json:"scheduleId"`
@@ -370,6 +461,16 @@ type ProcessFireRequest struct {
 	RunningWorkflows []RunningWorkflowInfo `json:"runningWorkflows,omitempty"`
 	// BackfillID is non-empty only for fires driven by a schedule backfill (matches RPC BackfillID).
 	BackfillID string `json:"backfillId,omitempty"`
+	// SignalWorkflow and SignalWithStartWorkflow are set instead of Action when
+	// the schedule action is a signal. At most one of them is set.
+	SignalWorkflow          *types.SignalWorkflowAction          `json:"signalWorkflow,omitempty"`
+	SignalWithStartWorkflow *types.SignalWithStartWorkflowAction `json:"signalWithStartWorkflow,omitempty"`
+}
+
+// WatchWorkflowResult is the output of watchWorkflowActivity.
+type WatchWorkflowResult struct {
+	// CloseStatus is nil if the workflow no longer exists.
+	CloseStatus *types.WorkflowExecutionCloseStatus `json:"closeStatus,omitempty"`
 }
 
 // ProcessFireResult is the output of processScheduleFireActivity. The workflow
@@ -41,12 +41,13 @@ type signalChannels struct {
 	update   workflow.Channel
 	backfill workflow.Channel
 	delete   workflow.Channel
+	trigger  workflow.Channel
 }
 
 // SchedulerWorkflow is a long-running workflow that manages a single schedule.
-// It computes the next fire time from the cron expression, waits via a timer,
+// It computes the next fire time from the schedule spec, waits via a timer,
 // and dispatches the configured action. Signals control pause/unpause, update,
-// backfill, and deletion.
+// backfill, manual triggers, and deletion.
 //
 // The main loop follows a state-machine pattern: all inputs (signals and timer)
 // uniformly mutate state, and then a single decision point inspects the resulting
@@ -95,12 +96,13 @@ func SchedulerWorkflow(ctx workflow.Context, input SchedulerWorkflowInput) error
 		update:   workflow.GetSignalChannel(ctx, SignalNameUpdate),
 		backfill: workflow.GetSignalChannel(ctx, SignalNameBackfill),
 		delete:   workflow.GetSignalChannel(ctx, SignalNameDelete),
+		trigger:  workflow.GetSignalChannel(ctx, SignalNameTrigger),
 	}
 
-	sched, err := cron.ParseStandard(input.Spec.CronExpression)
+	sched, err := newVersionedSchedule(ctx, &input.Spec)
 	if err != nil {
-		logger.Error("invalid cron expression, terminating", zap.String("cron", input.Spec.CronExpression), zap.Error(err))
-		return fmt.Errorf("invalid cron expression %q: %w", input.Spec.CronExpression, err)
+		logger.Error("invalid schedule spec, terminating", zap.String("cron", input.Spec.CronExpression), zap.Error(err))
+		return fmt.Errorf("invalid schedule spec: %w", err)
 	}
 
 	// activityBudget is the per-execution ceiling for local-activity dispatches.
@@ -109,12 +111,16 @@ func SchedulerWorkflow(ctx workflow.Context, input SchedulerWorkflowInput) error
 	activityBudget := maxActivitiesPerExecution
 
 	// watcherFuture is a non-nil regular-activity future while the scheduler is
-	// watching a running workflow that is blocking the head of BufferedFires.
-	// When it completes (the watched workflow closed), the main loop immediately
-	// retries the drain instead of waiting for the next cron timer tick.
+	// watching a running workflow, either the one blocking the head of
+	// BufferedFires or the oldest open run it started (see nextWatchTarget).
+	// When it completes (the watched workflow closed), the main loop records the
+	// close status and immediately retries the drain instead of waiting for the
+	// next cron timer tick.
 	var watcherFuture workflow.Future
 	var watcherCtx workflow.Context
 	var watcherCancel func()
+	var watching RunningWorkflowInfo
+	watchRecentActions := workflow.GetVersion(ctx, versionWatchRecentActions, workflow.DefaultVersion, 1) != workflow.DefaultVersion
 
 	// On the first iteration (after ContinueAsNew or fresh start), check for
 	// fires that were missed during the transition gap or prior pause period.
@@ -129,6 +135,10 @@ func SchedulerWorkflow(ctx workflow.Context, input SchedulerWorkflowInput) error
 		return safeContinueAsNew(ctx, logger, scope, ContinueAsNewReasonBackfill, chs.delete, input, state)
 	}
 
+	// Fire any triggers received by the previous execution right before it
+	// continued as new.
+	processPendingTriggers(ctx, logger, scope, &input, state)
+
 	for {
 		state.Iterations++
 
@@ -146,9 +156,18 @@ func SchedulerWorkflow(ctx workflow.Context, input SchedulerWorkflowInput) error
 				}
 				return safeContinueAsNew(ctx, logger, scope, ContinueAsNewReasonBufferDrain, chs.delete, input, state)
 			}
-			// Start the watcher when the drain head is blocked and a running
-			// workflow is known; cancel it when the queue empties or unblocks.
-			if headBlocked && state.LastStartedWorkflow != nil && watcherFuture == nil {
+			// Restart the watcher whenever the workflow worth watching changes, and
+			// cancel it when there is nothing left to watch.
+			// Histories recorded before open runs were watched only ever started
+			// the watcher once per blocked head.
+			target := nextWatchTarget(state, headBlocked, watchRecentActions)
+			if watcherFuture != nil && (target == nil || (watchRecentActions && *target != watching)) {
+				watcherCancel()
+				watcherFuture = nil
+				watcherCancel = nil
+			}
+			if target != nil && watcherFuture == nil {
+				watching = *target
 				watcherCtx, watcherCancel = workflow.WithCancel(ctx)
 				watcherFuture = workflow.ExecuteActivity(
 					workflow.WithActivityOptions(watcherCtx, workflow.ActivityOptions{
@@ -157,15 +176,9 @@ func SchedulerWorkflow(ctx workflow.Context, input SchedulerWorkflowInput) error
 					}),
 					watchWorkflowActivity,
 					input.Domain,
-					state.LastStartedWorkflow.WorkflowID,
-					state.LastStartedWorkflow.RunID,
+					watching.WorkflowID,
+					watching.RunID,
 				)
-			} else if !headBlocked || len(state.BufferedFires) == 0 {
-				if watcherCancel != nil {
-					watcherCancel()
-					watcherFuture = nil
-					watcherCancel = nil
-				}
 			}
 		}
 
@@ -192,7 +205,7 @@ func SchedulerWorkflow(ctx workflow.Context, input SchedulerWorkflowInput) error
 		}
 
 		previousPaused := state.Paused
-		changed, timerFired, watcherDone := applyAllInputs(ctx, logger, scope, timerFuture, watcherFuture, chs, state, &input)
+		changed, timerFired, watcherDone, watchResult := applyAllInputs(ctx, logger, scope, timerFuture, watcherFuture, chs, state, &input)
 
 		if timerCancel != nil {
 			timerCancel()
@@ -200,6 +213,9 @@ func SchedulerWorkflow(ctx workflow.Context, input SchedulerWorkflowInput) error
 		if watcherDone {
 			watcherFuture = nil
 			watcherCancel = nil
+			if watchResult != nil && recordWorkflowClosed(state, watching, watchResult.CloseStatus) {
+				applyRunOutcome(logger, scope, &input, state, watching, watchResult.CloseStatus, workflow.Now(ctx))
+			}
 		}
 
 		if state.Paused != previousPaused {
@@ -229,6 +245,7 @@ func SchedulerWorkflow(ctx workflow.Context, input SchedulerWorkflowInput) error
 		if timerFired && !state.Paused {
 			processScheduleFire(ctx, logger, scope, &input, state, state.NextRunTime, TriggerSourceSchedule, input.Policies.OverlapPolicy, "")
 		}
+		processPendingTriggers(ctx, logger, scope, &input, state)
 
 		if changed || state.Iterations >= maxIterationsBeforeContinueAsNew {
 			reason := ContinueAsNewReasonSignal
@@ -247,9 +264,10 @@ func SchedulerWorkflow(ctx workflow.Context, input SchedulerWorkflowInput) error
 // completion) arrives, processes it, then drains any remaining buffered signals.
 // Signals and the timer are treated uniformly: each mutates state without
 // triggering side effects (no timer cancellation, no ContinueAsNew).
-// Returns (stateChanged, timerFired, watcherDone): stateChanged is true if a
-// state-changing signal was received; timerFired is true if the timer fired;
-// watcherDone is true if the watcher activity returned (blocking workflow closed).
+// Returns (stateChanged, timerFired, watcherDone, watchResult): stateChanged is
+// true if a state-changing signal was received; timerFired is true if the timer
+// fired; watcherDone is true if the watcher activity returned (watched workflow
+// closed), and watchResult is its result unless the activity failed.
 func applyAllInputs(
 	ctx workflow.Context,
 	logger *zap.Logger,
@@ -259,7 +277,7 @@ func applyAllInputs(
 	chs signalChannels,
 	state *SchedulerWorkflowState,
 	input *SchedulerWorkflowInput,
-) (stateChanged, timerFired, watcherDone bool) {
+) (stateChanged, timerFired, watcherDone bool, watchResult *WatchWorkflowResult) {
 	selector := workflow.NewSelector(ctx)
 	if timerFuture != nil {
 		selector.AddFuture(timerFuture, func(f workflow.Future) {
@@ -271,7 +289,10 @@ func applyAllInputs(
 	}
 	if watcherFuture != nil {
 		selector.AddFuture(watcherFuture, func(f workflow.Future) {
-			_ = f.Get(ctx, nil)
+			var result WatchWorkflowResult
+			if f.Get(ctx, &result) == nil {
+				watchResult = &result
+			}
 			watcherDone = true
 		})
 	}
@@ -313,6 +334,13 @@ func applyAllInputs(
 		}
 	})
 
+	selector.AddReceive(chs.trigger, func(c workflow.Channel, more bool) {
+		var sig TriggerSignal
+		c.Receive(ctx, &sig)
+		scope.Tagged(map[string]string{SignalTypeTag: signalTypeTagTrigger}).Counter(SchedulerSignalReceivedCountPerDomain).Inc(1)
+		handleTrigger(logger, scope, sig, state, workflow.Now(ctx))
+	})
+
 	selector.AddReceive(chs.delete, func(c workflow.Channel, more bool) {
 		c.Receive(ctx, nil)
 		scope.Tagged(map[string]string{SignalTypeTag: signalTypeTagDelete}).Counter(SchedulerSignalReceivedCountPerDomain).Inc(1)
@@ -326,7 +354,7 @@ func applyAllInputs(
 		stateChanged = true
 	}
 
-	return stateChanged, timerFired, watcherDone
+	return stateChanged, timerFired, watcherDone, watchResult
 }
 
 // drainBufferedSignals processes any remaining buffered signals without blocking.
@@ -388,6 +416,14 @@ func drainBufferedSignals(
 			stateChanged = true
 		}
 	}
+	for {
+		var sig TriggerSignal
+		if !chs.trigger.ReceiveAsync(&sig) {
+			break
+		}
+		scope.Tagged(map[string]string{SignalTypeTag: signalTypeTagTrigger}).Counter(SchedulerSignalReceivedCountPerDomain).Inc(1)
+		handleTrigger(logger, scope, sig, state, now)
+	}
 
 	return stateChanged
 }
@@ -413,8 +449,8 @@ func buildScheduleSearchAttributes(input *SchedulerWorkflowInput, state *Schedul
 	if cron := input.Spec.CronExpression; cron != "" {
 		sa[SearchAttrScheduleCron] = cron
 	}
-	if sw := input.Action.StartWorkflow; sw != nil && sw.WorkflowType != nil && sw.WorkflowType.Name != "" {
-		sa[SearchAttrScheduleWorkflowType] = sw.WorkflowType.Name
+	if wt := input.Action.GetWorkflowType(); wt != nil && wt.Name != "" {
+		sa[SearchAttrScheduleWorkflowType] = wt.Name
 	}
 	if input.SearchAttributes != nil {
 		for k, v := range input.SearchAttributes.IndexedFields {
@@ -449,6 +485,10 @@ func handleUnpause(logger *zap.Logger, sig UnpauseSignal, state *SchedulerWorkfl
 	state.PauseReason = ""
 	state.PausedBy = ""
 	state.PausedAt = time.Time{}
+	// Start the failure budget afresh so that a schedule auto-paused by
+	// PauseOnFailure is not paused again by the failures that paused it.
+	state.ConsecutiveFailures = 0
+	state.RunOutcomes = nil
 	if sig.CatchUpPolicy != types.ScheduleCatchUpPolicyInvalid {
 		state.UnpauseCatchUpPolicy = sig.CatchUpPolicy
 	}
@@ -456,6 +496,42 @@ func handleUnpause(logger *zap.Logger, sig UnpauseSignal, state *SchedulerWorkfl
 	return true
 }
 
+// handleTrigger queues a TriggerSchedule request to be fired at now by
+// processPendingTriggers. Triggers received in the same decision task share
+// now, so each is queued a nanosecond after the previous one to keep the
+// workflow IDs and RequestIDs of their fires distinct. It does not count as a
+// state change: the fire does not require ContinueAsNew.
+func handleTrigger(logger *zap.Logger, scope tally.Scope, sig TriggerSignal, state *SchedulerWorkflowState, now time.Time) {
+	if len(state.PendingTriggers) >= maxPendingTriggers {
+		scope.Tagged(map[string]string{ReasonTag: TriggerRejectedReasonQueueFull}).
+			Counter(SchedulerTriggerRejectedCountPerDomain).Inc(1)
+		logger.Warn("ignoring trigger signal, too many pending triggers",
+			zap.Int("maxPendingTriggers", maxPendingTriggers))
+		return
+	}
+	sig.TriggerTime = now
+	if n := len(state.PendingTriggers); n > 0 && !state.PendingTriggers[n-1].TriggerTime.Before(now) {
+		sig.TriggerTime = state.PendingTriggers[n-1].TriggerTime.Add(time.Nanosecond)
+	}
+	state.PendingTriggers = append(state.PendingTriggers, sig)
+	logger.Info("schedule trigger queued", zap.String("overlapPolicy", sig.OverlapPolicy.String()))
+}
+
+// processPendingTriggers fires the queued TriggerSchedule requests in order,
+// with the schedule's overlap policy unless the request overrides it. Triggers
+// fire even while the schedule is paused.
+func processPendingTriggers(ctx workflow.Context, logger *zap.Logger, scope tally.Scope, input *SchedulerWorkflowInput, state *SchedulerWorkflowState) {
+	triggers := state.PendingTriggers
+	state.PendingTriggers = nil
+	for _, trigger := range triggers {
+		overlapPolicy := trigger.OverlapPolicy
+		if overlapPolicy == types.ScheduleOverlapPolicyInvalid {
+			overlapPolicy = input.Policies.OverlapPolicy
+		}
+		processScheduleFire(ctx, logger, scope, input, state, trigger.TriggerTime, TriggerSourceTrigger, overlapPolicy, "")
+	}
+}
+
 func handleUpdate(logger *zap.Logger, sig UpdateSignal, input *SchedulerWorkflowInput, state *SchedulerWorkflowState) bool {
 	if sig.Spec == nil && sig.Action == nil && sig.Policies == nil && sig.SearchAttributes == nil {
 		logger.Info("ignoring empty update signal")
@@ -463,8 +539,8 @@ func handleUpdate(logger *zap.Logger, sig UpdateSignal, input *SchedulerWorkflow
 	}
 	changed := false
 	if sig.Spec != nil {
-		if _, err := cron.ParseStandard(sig.Spec.CronExpression); err != nil {
-			logger.Error("ignoring update with invalid cron expression",
+		if _, err := NewSchedule(sig.Spec); err != nil {
+			logger.Error("ignoring update with invalid schedule spec",
 				zap.String("cron", sig.Spec.CronExpression), zap.Error(err))
 		} else {
 			input.Spec = *sig.Spec
@@ -549,9 +625,7 @@ func handleUpdate(logger *zap.Logger, sig UpdateSignal, input *SchedulerWorkflow
 func processScheduleFire(ctx workflow.Context, logger *zap.Logger, scope tally.Scope, input *SchedulerWorkflowInput, state *SchedulerWorkflowState, scheduledTime time.Time, trigger TriggerSource, overlapPolicy types.ScheduleOverlapPolicy, backfillID string) {
 	if overlapPolicy == types.ScheduleOverlapPolicyBuffer && len(state.BufferedFires) > 0 {
 		// Skipping tryStartFire, so advance LastRunTime here.
-		if scheduledTime.After(state.LastRunTime) {
-			state.LastRunTime = scheduledTime
-		}
+		advanceLastRunTime(state, scheduledTime, trigger)
 		enqueueBufferedFire(logger, scope, input, state, scheduledTime, trigger, overlapPolicy, backfillID)
 		return
 	}
@@ -560,24 +634,34 @@ func processScheduleFire(ctx workflow.Context, logger *zap.Logger, scope tally.S
 	}
 }
 
+// advanceLastRunTime moves LastRunTime forward to scheduledTime. It only moves
+// forward: under BUFFER, an older queued fire can drain after a newer fire has
+// already been processed. Manual triggers leave it alone since LastRunTime is
+// the catch-up watermark, and a trigger must not hide the cron fires missed
+// before it.
+func advanceLastRunTime(state *SchedulerWorkflowState, scheduledTime time.Time, trigger TriggerSource) {
+	if trigger == TriggerSourceTrigger {
+		return
+	}
+	if scheduledTime.After(state.LastRunTime) {
+		state.LastRunTime = scheduledTime
+	}
+}
+
 // tryStartFire runs the scheduler activity for a single fire and applies the
 // result to state, returning whether the fire was buffered. Shared by the
 // live-fire and drain-buffered-fire paths; the caller decides how to handle
 // a buffered outcome.
 func tryStartFire(ctx workflow.Context, logger *zap.Logger, input *SchedulerWorkflowInput, state *SchedulerWorkflowState, scheduledTime time.Time, trigger TriggerSource, overlapPolicy types.ScheduleOverlapPolicy, backfillID string) fireOutcome {
-	// LastRunTime moves forward only. Under BUFFER, an older queued fire can
-	// drain after a newer fire has already been processed.
-	if scheduledTime.After(state.LastRunTime) {
-		state.LastRunTime = scheduledTime
-	}
+	advanceLastRunTime(state, scheduledTime, trigger)
 
 	logger.Info("schedule fired",
 		zap.Time("scheduledTime", scheduledTime),
 	)
 
-	if input.Action.StartWorkflow == nil {
+	if input.Action.StartWorkflow == nil && input.Action.SignalWorkflow == nil && input.Action.SignalWithStartWorkflow == nil {
 		state.MissedRuns++
-		logger.Error("schedule action has no StartWorkflow configuration")
+		logger.Error("schedule action has no StartWorkflow, SignalWorkflow or SignalWithStartWorkflow configuration")
 		return fireOutcomeDone
 	}
 
@@ -586,7 +670,6 @@ func tryStartFire(ctx workflow.Context, logger *zap.Logger, input *SchedulerWork
 	req := ProcessFireRequest{
 		Domain:              input.Domain,
 		ScheduleID:          input.ScheduleID,
-		Action:              *input.Action.StartWorkflow,
 		ScheduledTime:       scheduledTime,
 		TriggerSource:       trigger,
 		OverlapPolicy:       overlapPolicy,
@@ -595,6 +678,14 @@ func tryStartFire(ctx workflow.Context, logger *zap.Logger, input *SchedulerWork
 		RunningWorkflows:    state.RunningWorkflows,
 		BackfillID:          backfillID,
 	}
+	switch {
+	case input.Action.StartWorkflow != nil:
+		req.Action = *input.Action.StartWorkflow
+	case input.Action.SignalWorkflow != nil:
+		req.SignalWorkflow = input.Action.SignalWorkflow
+	default:
+		req.SignalWithStartWorkflow = input.Action.SignalWithStartWorkflow
+	}
 
 	var result ProcessFireResult
 	if err := workflow.ExecuteLocalActivity(actCtx, processScheduleFireActivity, req).Get(ctx, &result); err != nil {
@@ -620,6 +711,14 @@ func tryStartFire(ctx workflow.Context, logger *zap.Logger, input *SchedulerWork
 	}
 
 	if result.TotalDelta > 0 && result.StartedWorkflow != nil {
+		recordOpenRun(state, *result.StartedWorkflow)
+		recordRecentAction(state, types.ScheduleActionResult{
+			ScheduledTime:   scheduledTime,
+			ActualStartTime: workflow.Now(ctx),
+			WorkflowID:      result.StartedWorkflow.WorkflowID,
+			RunID:           result.StartedWorkflow.RunID,
+			TriggerSource:   string(trigger),
+		})
 		logger.Info("scheduled workflow started",
 			zap.String("workflowId", result.StartedWorkflow.WorkflowID),
 			zap.String("runId", result.StartedWorkflow.RunID),
@@ -632,6 +731,169 @@ func tryStartFire(ctx workflow.Context, logger *zap.Logger, input *SchedulerWork
 	return fireOutcomeDone
 }
 
+// recordRecentAction appends a started workflow to state.RecentActions,
+// dropping the oldest entries beyond maxRecentActions.
+func recordRecentAction(state *SchedulerWorkflowState, result types.ScheduleActionResult) {
+	state.RecentActions = append(state.RecentActions, RecentAction{ScheduleActionResult: result})
+	if n := len(state.RecentActions); n > maxRecentActions {
+		state.RecentActions = append([]RecentAction(nil), state.RecentActions[n-maxRecentActions:]...)
+	}
+}
+
+// recordOpenRun appends a started workflow to state.OpenRuns, dropping the
+// oldest entries beyond maxOpenRuns.
+func recordOpenRun(state *SchedulerWorkflowState, wf RunningWorkflowInfo) {
+	for _, open := range state.OpenRuns {
+		if open == wf {
+			return
+		}
+	}
+	state.OpenRuns = append(state.OpenRuns, wf)
+	if n := len(state.OpenRuns); n > maxOpenRuns {
+		state.OpenRuns = append([]RunningWorkflowInfo(nil), state.OpenRuns[n-maxOpenRuns:]...)
+	}
+}
+
+// recordWorkflowClosed removes wf from state.OpenRuns and marks its RecentActions
+// entry as closed with the given close status, which is nil if the watcher could
+// not determine it. It returns false if wf was not known to be open, so that the
+// outcome of a run is counted only once.
+func recordWorkflowClosed(state *SchedulerWorkflowState, wf RunningWorkflowInfo, closeStatus *types.WorkflowExecutionCloseStatus) bool {
+	open := false
+	for i, run := range state.OpenRuns {
+		if run == wf {
+			state.OpenRuns = append(state.OpenRuns[:i:i], state.OpenRuns[i+1:]...)
+			open = true
+			break
+		}
+	}
+	for i := range state.RecentActions {
+		action := &state.RecentActions[i]
+		if action.WorkflowID == wf.WorkflowID && action.RunID == wf.RunID && !action.Closed {
+			action.Closed = true
+			action.CloseStatus = closeStatus
+			open = true
+		}
+	}
+	return open
+}
+
+// applyRunOutcome counts a closed target run against the failure budget and
+// pauses the schedule when PauseOnFailure is set and the budget is exhausted.
+// Runs that were canceled or terminated, typically by the overlap policy or an
+// operator, and runs whose close status is unknown are ignored.
+func applyRunOutcome(logger *zap.Logger, scope tally.Scope, input *SchedulerWorkflowInput, state *SchedulerWorkflowState, wf RunningWorkflowInfo, closeStatus *types.WorkflowExecutionCloseStatus, now time.Time) {
+	if closeStatus == nil {
+		return
+	}
+	var failed bool
+	switch *closeStatus {
+	case types.WorkflowExecutionCloseStatusFailed, types.WorkflowExecutionCloseStatusTimedOut:
+		failed = true
+	case types.WorkflowExecutionCloseStatusCompleted, types.WorkflowExecutionCloseStatusContinuedAsNew:
+		failed = false
+	default:
+		return
+	}
+
+	budget := input.Policies.FailureBudget
+	if failed {
+		state.ConsecutiveFailures++
+		scope.Tagged(map[string]string{CloseStatusTag: closeStatus.String()}).
+			Counter(SchedulerTargetFailedCountPerDomain).Inc(1)
+	} else {
+		state.ConsecutiveFailures = 0
+	}
+	state.RunOutcomes = append(state.RunOutcomes, RunOutcome{CloseTime: now, Failed: failed})
+	trimRunOutcomes(state, budget.GetFailureRateWindow(), now)
+
+	// Without a budget PauseOnFailure keeps its behavior from before budgets
+	// existed, the failures are only counted.
+	if !failed || !input.Policies.PauseOnFailure || budget == nil || state.Paused {
+		return
+	}
+
+	var reason, tag string
+	if maxConsecutive := budget.GetMaxConsecutiveFailures(); maxConsecutive > 0 && state.ConsecutiveFailures >= maxConsecutive {
+		tag = AutoPauseReasonConsecutiveFailures
+		reason = fmt.Sprintf("paused after %d consecutive failed runs", state.ConsecutiveFailures)
+	} else if rate, closed, ok := failureRateExceeded(state, budget); ok {
+		tag = AutoPauseReasonFailureRate
+		reason = fmt.Sprintf("paused after %.0f%% of the %d runs closed in the last %s failed",
+			rate*100, closed, budget.GetFailureRateWindow())
+	} else {
+		return
+	}
+	reason = fmt.Sprintf("%s; last failed run: workflowID=%s runID=%s closeStatus=%s",
+		reason, wf.WorkflowID, wf.RunID, closeStatus.String())
+
+	handlePause(logger, PauseSignal{Reason: reason, PausedBy: AutoPausedBy}, state, now)
+	scope.Tagged(map[string]string{ReasonTag: tag}).Counter(SchedulerAutoPausedCountPerDomain).Inc(1)
+}
+
+// trimRunOutcomes drops the outcomes that closed before the failure rate window
+// and keeps at most maxRunOutcomes. Without a window none are kept.
+func trimRunOutcomes(state *SchedulerWorkflowState, window time.Duration, now time.Time) {
+	if window <= 0 {
+		state.RunOutcomes = nil
+		return
+	}
+	cutoff := now.Add(-window)
+	start := 0
+	for start < len(state.RunOutcomes) && state.RunOutcomes[start].CloseTime.Before(cutoff) {
+		start++
+	}
+	if n := len(state.RunOutcomes) - start; n > maxRunOutcomes {
+		start += n - maxRunOutcomes
+	}
+	if start > 0 {
+		state.RunOutcomes = append([]RunOutcome(nil), state.RunOutcomes[start:]...)
+	}
+}
+
+// failureRateExceeded reports whether the fraction of failed runs in
+// state.RunOutcomes exceeds the budget's MaxFailureRate, along with that
+// fraction and the number of runs it was computed over.
+func failureRateExceeded(state *SchedulerWorkflowState, budget *types.ScheduleFailureBudget) (float64, int, bool) {
+	closed := len(state.RunOutcomes)
+	if budget.GetMaxFailureRate() <= 0 || closed == 0 || closed < int(budget.GetMinRunsInWindow()) {
+		return 0, closed, false
+	}
+	failures := 0
+	for _, outcome := range state.RunOutcomes {
+		if outcome.Failed {
+			failures++
+		}
+	}
+	rate := float64(failures) / float64(closed)
+	return rate, closed, rate > budget.GetMaxFailureRate()
+}
+
+// nextWatchTarget returns the workflow the watcher activity should watch: the
+// running workflow blocking the head of BufferedFires if there is one, so that
+// the drain resumes as soon as it closes, or else, when watchRecentActions is
+// set, the oldest run in OpenRuns or RecentActions that has not been seen closed
+// yet. Nil means there is nothing to watch.
+func nextWatchTarget(state *SchedulerWorkflowState, headBlocked, watchRecentActions bool) *RunningWorkflowInfo {
+	if headBlocked && state.LastStartedWorkflow != nil {
+		return state.LastStartedWorkflow
+	}
+	if !watchRecentActions {
+		return nil
+	}
+	if len(state.OpenRuns) > 0 {
+		run := state.OpenRuns[0]
+		return &run
+	}
+	// state carried over from before OpenRuns was kept
+	for _, action := range state.RecentActions {
+		if !action.Closed {
+			return &RunningWorkflowInfo{WorkflowID: action.WorkflowID, RunID: action.RunID}
+		}
+	}
+	return nil
+}
+
 // enqueueBufferedFire appends a fire to state.BufferedFires, enforcing both the
 // user-configured buffer_limit and the MaxBufferedFiresSystemLimit ceiling.
 // Drops increment SkippedRuns and emit scheduler_buffer_overflow_count_per_domain
@@ -758,6 +1020,19 @@ func defaultActivityOptions() workflow.LocalActivityOptions {
 	}
 }
 
+// newVersionedSchedule compiles the spec, or only its cron expression when
+// replaying a history recorded before intervals and calendars were supported.
+func newVersionedSchedule(ctx workflow.Context, spec *types.ScheduleSpec) (cron.Schedule, error) {
+	if workflow.GetVersion(ctx, versionScheduleSpec, workflow.DefaultVersion, 1) == workflow.DefaultVersion {
+		sched, err := cron.ParseStandard(spec.CronExpression)
+		if err != nil {
+			return nil, fmt.Errorf("invalid cron expression %q: %w", spec.CronExpression, err)
+		}
+		return sched, nil
+	}
+	return NewSchedule(spec)
+}
+
 // computeNextRunTime determines the next fire time for the cron schedule,
 // respecting the spec's StartTime and EndTime boundaries.
 func computeNextRunTime(sched cron.Schedule, now time.Time, spec types.ScheduleSpec) time.Time {
@@ -981,6 +1256,13 @@ func buildScheduleDescription(input *SchedulerWorkflowInput, state *SchedulerWor
 			})
 		}
 	}
+	var recent []types.ScheduleActionResult
+	if len(state.RecentActions) > 0 {
+		recent = make([]types.ScheduleActionResult, 0, len(state.RecentActions))
+		for _, action := range state.RecentActions {
+			recent = append(recent, action.ScheduleActionResult)
+		}
+	}
 	return &ScheduleDescription{
 		ScheduleID:           input.ScheduleID,
 		Domain:               input.Domain,
@@ -1003,6 +1285,7 @@ func buildScheduleDescription(input *SchedulerWorkflowInput, state *SchedulerWor
 		Memo:                 input.Memo,
 		SearchAttributes:     input.SearchAttributes,
 		OngoingBackfills:     ongoing,
+		RecentActions:        recent,
 	}
 }
 
@@ -22,6 +22,7 @@ package scheduler
 
 import (
 	"encoding/json"
+	"fmt"
 	"testing"
 	"time"
 
@@ -516,6 +517,54 @@ func TestBuildScheduleDescription(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "recent actions",
+			input: SchedulerWorkflowInput{
+				Domain:     "dev",
+				ScheduleID: "sched-recent",
+			},
+			state: SchedulerWorkflowState{
+				RecentActions: []RecentAction{
+					{
+						ScheduleActionResult: types.ScheduleActionResult{
+							ScheduledTime: time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC),
+							WorkflowID:    "wf-1",
+							RunID:         "run-1",
+							TriggerSource: string(TriggerSourceSchedule),
+							CloseStatus:   types.WorkflowExecutionCloseStatusCompleted.Ptr(),
+						},
+						Closed: true,
+					},
+					{
+						ScheduleActionResult: types.ScheduleActionResult{
+							ScheduledTime: time.Date(2026, 3, 1, 1, 0, 0, 0, time.UTC),
+							WorkflowID:    "wf-2",
+							RunID:         "run-2",
+							TriggerSource: string(TriggerSourceBackfill),
+						},
+					},
+				},
+			},
+			want: &ScheduleDescription{
+				ScheduleID: "sched-recent",
+				Domain:     "dev",
+				RecentActions: []types.ScheduleActionResult{
+					{
+						ScheduledTime: time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC),
+						WorkflowID:    "wf-1",
+						RunID:         "run-1",
+						TriggerSource: string(TriggerSourceSchedule),
+						CloseStatus:   types.WorkflowExecutionCloseStatusCompleted.Ptr(),
+					},
+					{
+						ScheduledTime: time.Date(2026, 3, 1, 1, 0, 0, 0, time.UTC),
+						WorkflowID:    "wf-2",
+						RunID:         "run-2",
+						TriggerSource: string(TriggerSourceBackfill),
+					},
+				},
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -526,6 +575,406 @@ func TestBuildScheduleDescription(t *testing.T) {
 	}
 }
 
+func TestRecordRecentAction(t *testing.T) {
+	state := &SchedulerWorkflowState{}
+	for i := 0; i < maxRecentActions+3; i++ {
+		recordRecentAction(state, types.ScheduleActionResult{
+			WorkflowID: fmt.Sprintf("wf-%d", i),
+			RunID:      fmt.Sprintf("run-%d", i),
+		})
+	}
+
+	require.Len(t, state.RecentActions, maxRecentActions)
+	assert.Equal(t, "wf-3", state.RecentActions[0].WorkflowID)
+	assert.Equal(t, fmt.Sprintf("wf-%d", maxRecentActions+2), state.RecentActions[maxRecentActions-1].WorkflowID)
+}
+
+func TestRecordWorkflowClosed(t *testing.T) {
+	state := &SchedulerWorkflowState{
+		RecentActions: []RecentAction{
+			{ScheduleActionResult: types.ScheduleActionResult{WorkflowID: "wf", RunID: "run-1"}},
+			{ScheduleActionResult: types.ScheduleActionResult{WorkflowID: "wf", RunID: "run-2"}},
+		},
+	}
+
+	assert.True(t, recordWorkflowClosed(state, RunningWorkflowInfo{WorkflowID: "wf", RunID: "run-1"}, types.WorkflowExecutionCloseStatusFailed.Ptr()))
+	assert.True(t, state.RecentActions[0].Closed)
+	assert.Equal(t, types.WorkflowExecutionCloseStatusFailed.Ptr(), state.RecentActions[0].CloseStatus)
+	assert.False(t, state.RecentActions[1].Closed)
+	assert.Nil(t, state.RecentActions[1].CloseStatus)
+
+	assert.True(t, recordWorkflowClosed(state, RunningWorkflowInfo{WorkflowID: "wf", RunID: "run-2"}, nil))
+	assert.True(t, state.RecentActions[1].Closed)
+	assert.Nil(t, state.RecentActions[1].CloseStatus)
+
+	assert.False(t, recordWorkflowClosed(state, RunningWorkflowInfo{WorkflowID: "wf", RunID: "run-2"}, nil), "a run is closed only once")
+}
+
+func TestOpenRunsOutliveRecentActions(t *testing.T) {
+	state := &SchedulerWorkflowState{}
+	for i := 0; i < maxRecentActions+3; i++ {
+		wf := RunningWorkflowInfo{WorkflowID: fmt.Sprintf("wf-%d", i), RunID: fmt.Sprintf("run-%d", i)}
+		recordOpenRun(state, wf)
+		recordRecentAction(state, types.ScheduleActionResult{WorkflowID: wf.WorkflowID, RunID: wf.RunID})
+	}
+	require.Len(t, state.RecentActions, maxRecentActions)
+	require.Len(t, state.OpenRuns, maxRecentActions+3)
+
+	// the oldest run is no longer in RecentActions but is still watched and counted
+	oldest := RunningWorkflowInfo{WorkflowID: "wf-0", RunID: "run-0"}
+	assert.Equal(t, &oldest, nextWatchTarget(state, false, true))
+	assert.True(t, recordWorkflowClosed(state, oldest, types.WorkflowExecutionCloseStatusFailed.Ptr()))
+	assert.Len(t, state.OpenRuns, maxRecentActions+2)
+	assert.Equal(t, &RunningWorkflowInfo{WorkflowID: "wf-1", RunID: "run-1"}, nextWatchTarget(state, false, true))
+
+	for i := 0; i < maxOpenRuns; i++ {
+		recordOpenRun(state, RunningWorkflowInfo{WorkflowID: "wf-new", RunID: fmt.Sprintf("run-new-%d", i)})
+	}
+	assert.Len(t, state.OpenRuns, maxOpenRuns)
+}
+
+func TestNextWatchTarget(t *testing.T) {
+	blocking := &RunningWorkflowInfo{WorkflowID: "wf-blocking", RunID: "run-blocking"}
+	recent := []RecentAction{
+		{ScheduleActionResult: types.ScheduleActionResult{WorkflowID: "wf-1", RunID: "run-1"}, Closed: true},
+		{ScheduleActionResult: types.ScheduleActionResult{WorkflowID: "wf-2", RunID: "run-2"}},
+		{ScheduleActionResult: types.ScheduleActionResult{WorkflowID: "wf-3", RunID: "run-3"}},
+	}
+
+	tests := []struct {
+		name           string
+		state          SchedulerWorkflowState
+		headBlocked    bool
+		defaultVersion bool
+		want           *RunningWorkflowInfo
+	}{
+		{
+			name:  "nothing to watch",
+			state: SchedulerWorkflowState{},
+			want:  nil,
+		},
+		{
+			name:        "blocked head watches last started workflow",
+			state:       SchedulerWorkflowState{LastStartedWorkflow: blocking, RecentActions: recent},
+			headBlocked: true,
+			want:        blocking,
+		},
+		{
+			name:  "unblocked head watches oldest open recent action",
+			state: SchedulerWorkflowState{LastStartedWorkflow: blocking, RecentActions: recent},
+			want:  &RunningWorkflowInfo{WorkflowID: "wf-2", RunID: "run-2"},
+		},
+		{
+			name:           "unblocked head watches nothing before recent actions were watched",
+			state:          SchedulerWorkflowState{LastStartedWorkflow: blocking, RecentActions: recent},
+			defaultVersion: true,
+			want:           nil,
+		},
+		{
+			name: "all recent actions closed",
+			state: SchedulerWorkflowState{RecentActions: []RecentAction{
+				{ScheduleActionResult: types.ScheduleActionResult{WorkflowID: "wf-1", RunID: "run-1"}, Closed: true},
+			}},
+			want: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, nextWatchTarget(&tt.state, tt.headBlocked, !tt.defaultVersion))
+		})
+	}
+}
+
+func TestApplyRunOutcome(t *testing.T) {
+	now := time.Date(2026, 6, 1, 12, 0, 0, 0, time.UTC)
+	wf := RunningWorkflowInfo{WorkflowID: "etl-nightly", RunID: "run-7"}
+	failed := types.WorkflowExecutionCloseStatusFailed.Ptr()
+	outcomes := func(failures, successes int) []RunOutcome {
+		var out []RunOutcome
+		for i := 0; i < failures; i++ {
+			out = append(out, RunOutcome{CloseTime: now.Add(-time.Minute), Failed: true})
+		}
+		for i := 0; i < successes; i++ {
+			out = append(out, RunOutcome{CloseTime: now.Add(-time.Minute)})
+		}
+		return out
+	}
+
+	tests := []struct {
+		name                    string
+		policies                types.SchedulePolicies
+		initial                 SchedulerWorkflowState
+		closeStatus             *types.WorkflowExecutionCloseStatus
+		wantPaused              bool
+		wantAutoPauseReason     string
+		wantConsecutiveFailures int32
+		wantFailedCounter       bool
+	}{
+		{
+			name:                    "failure without PauseOnFailure is only counted",
+			closeStatus:             failed,
+			wantConsecutiveFailures: 1,
+			wantFailedCounter:       true,
+		},
+		{
+			name:                    "failure without a budget is only counted",
+			policies:                types.SchedulePolicies{PauseOnFailure: true},
+			closeStatus:             failed,
+			wantConsecutiveFailures: 1,
+			wantFailedCounter:       true,
+		},
+		{
+			name: "first failure pauses with a budget of one",
+			policies: types.SchedulePolicies{
+				PauseOnFailure: true,
+				FailureBudget:  &types.ScheduleFailureBudget{MaxConsecutiveFailures: 1},
+			},
+			closeStatus:             failed,
+			wantPaused:              true,
+			wantAutoPauseReason:     AutoPauseReasonConsecutiveFailures,
+			wantConsecutiveFailures: 1,
+			wantFailedCounter:       true,
+		},
+		{
+			name: "timeout below the consecutive limit does not pause",
+			policies: types.SchedulePolicies{
+				PauseOnFailure: true,
+				FailureBudget:  &types.ScheduleFailureBudget{MaxConsecutiveFailures: 3},
+			},
+			initial:                 SchedulerWorkflowState{ConsecutiveFailures: 1},
+			closeStatus:             types.WorkflowExecutionCloseStatusTimedOut.Ptr(),
+			wantConsecutiveFailures: 2,
+			wantFailedCounter:       true,
+		},
+		{
+			name: "reaching the consecutive limit pauses",
+			policies: types.SchedulePolicies{
+				PauseOnFailure: true,
+				FailureBudget:  &types.ScheduleFailureBudget{MaxConsecutiveFailures: 3},
+			},
+			initial:                 SchedulerWorkflowState{ConsecutiveFailures: 2},
+			closeStatus:             failed,
+			wantPaused:              true,
+			wantAutoPauseReason:     AutoPauseReasonConsecutiveFailures,
+			wantConsecutiveFailures: 3,
+			wantFailedCounter:       true,
+		},
+		{
+			name:                    "success resets consecutive failures",
+			policies:                types.SchedulePolicies{PauseOnFailure: true},
+			initial:                 SchedulerWorkflowState{ConsecutiveFailures: 2},
+			closeStatus:             types.WorkflowExecutionCloseStatusCompleted.Ptr(),
+			wantConsecutiveFailures: 0,
+		},
+		{
+			name:                    "canceled run is ignored",
+			policies:                types.SchedulePolicies{PauseOnFailure: true},
+			initial:                 SchedulerWorkflowState{ConsecutiveFailures: 2},
+			closeStatus:             types.WorkflowExecutionCloseStatusCanceled.Ptr(),
+			wantConsecutiveFailures: 2,
+		},
+		{
+			name:                    "unknown close status is ignored",
+			policies:                types.SchedulePolicies{PauseOnFailure: true},
+			closeStatus:             nil,
+			wantConsecutiveFailures: 0,
+		},
+		{
+			name: "failure rate above the threshold pauses",
+			policies: types.SchedulePolicies{
+				PauseOnFailure: true,
+				FailureBudget:  &types.ScheduleFailureBudget{MaxFailureRate: 0.5, FailureRateWindow: time.Hour, MinRunsInWindow: 4},
+			},
+			initial:                 SchedulerWorkflowState{RunOutcomes: outcomes(2, 2)},
+			closeStatus:             failed,
+			wantPaused:              true,
+			wantAutoPauseReason:     AutoPauseReasonFailureRate,
+			wantConsecutiveFailures: 1,
+			wantFailedCounter:       true,
+		},
+		{
+			name: "failure rate is not checked below the minimum runs",
+			policies: types.SchedulePolicies{
+				PauseOnFailure: true,
+				FailureBudget:  &types.ScheduleFailureBudget{MaxFailureRate: 0.5, FailureRateWindow: time.Hour, MinRunsInWindow: 4},
+			},
+			initial:                 SchedulerWorkflowState{RunOutcomes: outcomes(2, 0)},
+			closeStatus:             failed,
+			wantConsecutiveFailures: 1,
+			wantFailedCounter:       true,
+		},
+		{
+			name: "outcomes outside the window do not count",
+			policies: types.SchedulePolicies{
+				PauseOnFailure: true,
+				FailureBudget:  &types.ScheduleFailureBudget{MaxFailureRate: 0.5, FailureRateWindow: time.Hour, MinRunsInWindow: 2},
+			},
+			initial: SchedulerWorkflowState{RunOutcomes: []RunOutcome{
+				{CloseTime: now.Add(-2 * time.Hour), Failed: true},
+				{CloseTime: now.Add(-time.Minute)},
+			}},
+			closeStatus:             failed,
+			wantConsecutiveFailures: 1,
+			wantFailedCounter:       true,
+		},
+		{
+			name:                    "already paused schedule is left alone",
+			policies:                types.SchedulePolicies{PauseOnFailure: true},
+			initial:                 SchedulerWorkflowState{Paused: true, PauseReason: "maintenance"},
+			closeStatus:             failed,
+			wantPaused:              true,
+			wantConsecutiveFailures: 1,
+			wantFailedCounter:       true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scope := tally.NewTestScope("", nil)
+			input := &SchedulerWorkflowInput{Policies: tt.policies}
+			state := tt.initial
+			applyRunOutcome(testLogger, scope, input, &state, wf, tt.closeStatus, now)
+
+			assert.Equal(t, tt.wantPaused, state.Paused)
+			assert.Equal(t, tt.wantConsecutiveFailures, state.ConsecutiveFailures)
+
+			counters := scope.Snapshot().Counters()
+			emitted := map[string]bool{}
+			for _, c := range counters {
+				emitted[c.Name()] = true
+			}
+			assert.Equal(t, tt.wantFailedCounter, emitted[SchedulerTargetFailedCountPerDomain])
+			if tt.wantFailedCounter {
+				_, ok := findCounter(counters, SchedulerTargetFailedCountPerDomain, map[string]string{CloseStatusTag: tt.closeStatus.String()})
+				assert.True(t, ok)
+			}
+
+			if tt.wantAutoPauseReason == "" {
+				assert.False(t, emitted[SchedulerAutoPausedCountPerDomain])
+				return
+			}
+			_, ok := findCounter(counters, SchedulerAutoPausedCountPerDomain, map[string]string{ReasonTag: tt.wantAutoPauseReason})
+			assert.True(t, ok)
+			assert.Equal(t, AutoPausedBy, state.PausedBy)
+			assert.Equal(t, now, state.PausedAt)
+			assert.Contains(t, state.PauseReason, "workflowID=etl-nightly runID=run-7 closeStatus=FAILED")
+		})
+	}
+}
+
+func TestTrimRunOutcomes(t *testing.T) {
+	now := time.Date(2026, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	state := &SchedulerWorkflowState{RunOutcomes: []RunOutcome{
+		{CloseTime: now.Add(-2 * time.Hour)},
+		{CloseTime: now.Add(-30 * time.Minute)},
+	}}
+	trimRunOutcomes(state, time.Hour, now)
+	assert.Equal(t, []RunOutcome{{CloseTime: now.Add(-30 * time.Minute)}}, state.RunOutcomes)
+
+	state.RunOutcomes = nil
+	for i := 0; i < maxRunOutcomes+5; i++ {
+		state.RunOutcomes = append(state.RunOutcomes, RunOutcome{CloseTime: now, Failed: i < 5})
+	}
+	trimRunOutcomes(state, time.Hour, now)
+	require.Len(t, state.RunOutcomes, maxRunOutcomes)
+	assert.False(t, state.RunOutcomes[0].Failed)
+
+	trimRunOutcomes(state, 0, now)
+	assert.Nil(t, state.RunOutcomes)
+}
+
+func TestHandleUnpauseResetsFailureBudget(t *testing.T) {
+	state := &SchedulerWorkflowState{
+		Paused:              true,
+		PausedBy:            AutoPausedBy,
+		ConsecutiveFailures: 3,
+		RunOutcomes:         []RunOutcome{{Failed: true}},
+	}
+	assert.True(t, handleUnpause(testLogger, UnpauseSignal{}, state))
+	assert.Zero(t, state.ConsecutiveFailures)
+	assert.Nil(t, state.RunOutcomes)
+}
+
+func TestHandleTrigger(t *testing.T) {
+	now := time.Date(2026, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("queues the trigger at the receive time", func(t *testing.T) {
+		scope := tally.NewTestScope("", nil)
+		state := &SchedulerWorkflowState{}
+		handleTrigger(testLogger, scope, TriggerSignal{OverlapPolicy: types.ScheduleOverlapPolicyConcurrent}, state, now)
+
+		assert.Equal(t, []TriggerSignal{{OverlapPolicy: types.ScheduleOverlapPolicyConcurrent, TriggerTime: now}}, state.PendingTriggers)
+		_, ok := findCounter(scope.Snapshot().Counters(), SchedulerTriggerRejectedCountPerDomain,
+			map[string]string{ReasonTag: TriggerRejectedReasonQueueFull})
+		assert.False(t, ok)
+	})
+
+	t.Run("triggers received together fire at distinct times", func(t *testing.T) {
+		scope := tally.NewTestScope("", nil)
+		state := &SchedulerWorkflowState{}
+		handleTrigger(testLogger, scope, TriggerSignal{}, state, now)
+		handleTrigger(testLogger, scope, TriggerSignal{}, state, now)
+		handleTrigger(testLogger, scope, TriggerSignal{}, state, now.Add(time.Second))
+
+		require.Len(t, state.PendingTriggers, 3)
+		assert.Equal(t, now, state.PendingTriggers[0].TriggerTime)
+		assert.Equal(t, now.Add(time.Nanosecond), state.PendingTriggers[1].TriggerTime)
+		assert.Equal(t, now.Add(time.Second), state.PendingTriggers[2].TriggerTime)
+	})
+
+	t.Run("drops the trigger when the queue is full", func(t *testing.T) {
+		scope := tally.NewTestScope("", nil)
+		state := &SchedulerWorkflowState{PendingTriggers: make([]TriggerSignal, maxPendingTriggers)}
+		handleTrigger(testLogger, scope, TriggerSignal{}, state, now)
+
+		assert.Len(t, state.PendingTriggers, maxPendingTriggers)
+		c, ok := findCounter(scope.Snapshot().Counters(), SchedulerTriggerRejectedCountPerDomain,
+			map[string]string{ReasonTag: TriggerRejectedReasonQueueFull})
+		require.True(t, ok)
+		assert.Equal(t, int64(1), c.Value())
+	})
+}
+
+func TestAdvanceLastRunTime(t *testing.T) {
+	last := time.Date(2026, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name          string
+		scheduledTime time.Time
+		trigger       TriggerSource
+		want          time.Time
+	}{
+		{
+			name:          "later schedule fire advances",
+			scheduledTime: last.Add(time.Hour),
+			trigger:       TriggerSourceSchedule,
+			want:          last.Add(time.Hour),
+		},
+		{
+			name:          "earlier buffered fire does not move it back",
+			scheduledTime: last.Add(-time.Hour),
+			trigger:       TriggerSourceSchedule,
+			want:          last,
+		},
+		{
+			name:          "manual trigger does not advance",
+			scheduledTime: last.Add(time.Hour),
+			trigger:       TriggerSourceTrigger,
+			want:          last,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state := &SchedulerWorkflowState{LastRunTime: last}
+			advanceLastRunTime(state, tt.scheduledTime, tt.trigger)
+			assert.Equal(t, tt.want, state.LastRunTime)
+		})
+	}
+}
+
 func TestHandlePause(t *testing.T) {
 	fixedNow := time.Date(2026, 6, 1, 12, 0, 0, 0, time.UTC)
 
@@ -0,0 +1,68 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package semaphore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/uber/cadence/client/frontend"
+	"github.com/uber/cadence/common/types"
+)
+
+type contextKey string
+
+const semaphoreContextKey contextKey = "semaphoreContext"
+
+// semaphoreContext is the context passed to activities via BackgroundActivityContext.
+type semaphoreContext struct {
+	FrontendClient frontend.Client
+}
+
+// checkExecutionsActivity returns the given workflow runs which have closed or
+// no longer exist.
+func checkExecutionsActivity(ctx context.Context, request checkExecutionsRequest) ([]types.WorkflowExecution, error) {
+	sc, ok := ctx.Value(semaphoreContextKey).(semaphoreContext)
+	if !ok {
+		return nil, fmt.Errorf("semaphore context not found in activity context")
+	}
+
+	var closed []types.WorkflowExecution
+	for _, execution := range request.Executions {
+		resp, err := sc.FrontendClient.DescribeWorkflowExecution(ctx, &types.DescribeWorkflowExecutionRequest{
+			Domain:    request.Domain,
+			Execution: &execution,
+		})
+		if err != nil {
+			var notExists *types.EntityNotExistsError
+			if errors.As(err, &notExists) {
+				closed = append(closed, execution)
+				continue
+			}
+			return nil, fmt.Errorf("failed to describe workflow: %w", err)
+		}
+		if info := resp.GetWorkflowExecutionInfo(); info != nil && info.CloseStatus != nil {
+			closed = append(closed, execution)
+		}
+	}
+	return closed, nil
+}
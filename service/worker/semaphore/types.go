@@ -0,0 +1,140 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package semaphore
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/persistence"
+	commonsemaphore "github.com/uber/cadence/common/semaphore"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	WorkflowTypeName = "cadence-semaphore"
+	TaskListName     = "cadence-semaphore"
+
+	QueryTypeDescribe = "cadence-semaphore-describe"
+
+	// Metric name strings emitted via tally.Scope (workflow.GetMetricsScope).
+	SemaphorePermitsGrantedCountPerDomain = "semaphore_permits_granted_count_per_domain"
+	// SemaphoreLeaseReclaimedCountPerDomain counts leases and waiting requests dropped
+	// because the workflow holding or waiting for them has closed.
+	SemaphoreLeaseReclaimedCountPerDomain = "semaphore_lease_reclaimed_count_per_domain"
+	SemaphoreContinueAsNewCountPerDomain  = "semaphore_continue_as_new_count_per_domain"
+
+	workflowExecutionTimeout = 10 * 365 * 24 * time.Hour // ~10 years
+	workflowDecisionTimeout  = 10 * time.Second
+
+	// leaseCheckInterval is how often the semaphore workflow checks whether the
+	// workflows holding or waiting for permits are still running.
+	leaseCheckInterval = time.Minute
+
+	// maxSignalsPerExecution bounds the history of a semaphore workflow run. Once
+	// this many acquire and release signals have been handled, the workflow
+	// continues as new, carrying its holders and waiters over.
+	maxSignalsPerExecution = 1000
+
+	// MaxWaitersSystemLimit caps the number of waiting requests carried in the
+	// ContinueAsNew payload. Each waiter is ~200 bytes JSON, so 2000 entries stay
+	// well within the workflow input size limit.
+	MaxWaitersSystemLimit = 2000
+)
+
+type (
+	// WorkflowInput is the input of the semaphore workflow. State is empty when the
+	// semaphore is created and carries the holders and waiters across ContinueAsNew.
+	WorkflowInput struct {
+		Domain        string        `json:"domain,omitempty"`
+		SemaphoreName string        `json:"semaphoreName,omitempty"`
+		Size          int           `json:"size,omitempty"`
+		State         WorkflowState `json:"state,omitempty"`
+	}
+
+	// WorkflowState is the state of the semaphore workflow.
+	WorkflowState struct {
+		// Holders are the granted requests, in grant order.
+		Holders []PermitRequest `json:"holders,omitempty"`
+		// Waiters are the requests waiting for permits, in arrival order. They are
+		// granted first come first served: a waiter at the head asking for more
+		// permits than are available blocks the waiters behind it.
+		Waiters []PermitRequest `json:"waiters,omitempty"`
+		// Signals is the number of acquire and release signals handled by the
+		// current run.
+		Signals int `json:"signals,omitempty"`
+	}
+
+	// PermitRequest is a request of a workflow for permits of the semaphore.
+	PermitRequest struct {
+		RequestID     string    `json:"requestID,omitempty"`
+		WorkflowID    string    `json:"workflowID,omitempty"`
+		RunID         string    `json:"runID,omitempty"`
+		Permits       int       `json:"permits,omitempty"`
+		RequestedTime time.Time `json:"requestedTime,omitempty"`
+		AcquiredTime  time.Time `json:"acquiredTime,omitempty"`
+	}
+
+	// Description is the result of the describe query.
+	Description struct {
+		Size             int             `json:"size,omitempty"`
+		AvailablePermits int             `json:"availablePermits,omitempty"`
+		Holders          []PermitRequest `json:"holders,omitempty"`
+		Waiters          []PermitRequest `json:"waiters,omitempty"`
+	}
+
+	// checkExecutionsRequest is the input of checkExecutionsActivity.
+	checkExecutionsRequest struct {
+		Domain     string                    `json:"domain,omitempty"`
+		Executions []types.WorkflowExecution `json:"executions,omitempty"`
+	}
+)
+
+// NewStartWorkflowRequest builds the request starting the workflow managing the
+// given semaphore. A semaphore workflow which is already running makes the start
+// fail with a WorkflowExecutionAlreadyStartedError, so callers can use it to
+// ensure the workflow runs.
+func NewStartWorkflowRequest(domainName string, semaphore *persistence.SemaphoreMetadata) (*types.StartWorkflowExecutionRequest, error) {
+	input, err := json.Marshal(WorkflowInput{
+		Domain:        domainName,
+		SemaphoreName: semaphore.SemaphoreName,
+		Size:          semaphore.Size,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	reusePolicy := types.WorkflowIDReusePolicyAllowDuplicate
+	return &types.StartWorkflowExecutionRequest{
+		Domain:                              domainName,
+		WorkflowID:                          commonsemaphore.WorkflowID(semaphore.SemaphoreName),
+		WorkflowType:                        &types.WorkflowType{Name: WorkflowTypeName},
+		TaskList:                            &types.TaskList{Name: TaskListName},
+		Input:                               input,
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(int32(workflowExecutionTimeout.Seconds())),
+		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(int32(workflowDecisionTimeout.Seconds())),
+		RequestID:                           uuid.New().String(),
+		WorkflowIDReusePolicy:               &reusePolicy,
+	}, nil
+}
@@ -0,0 +1,180 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package semaphore
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.uber.org/cadence/.gen/go/cadence/workflowserviceclient"
+	cadenceworker "go.uber.org/cadence/worker"
+	"go.uber.org/cadence/workflow"
+
+	"github.com/uber/cadence/client/frontend"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/membership"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/worker/domainworker"
+)
+
+const (
+	// workerManagerName names the subscription of the worker manager to the
+	// membership changes of the worker service ring.
+	workerManagerName      = "semaphore"
+	refreshTimeout         = 30 * time.Second
+	listSemaphoresPageSize = 100
+)
+
+// BootstrapParams contains the parameters needed to create a semaphore worker manager.
+type BootstrapParams struct {
+	ServiceClient      workflowserviceclient.Interface
+	FrontendClient     frontend.Client
+	SemaphoreManager   persistence.SemaphoreMetadataManager
+	Logger             log.Logger
+	DomainCache        cache.DomainCache
+	MembershipResolver membership.Resolver
+	HostInfo           membership.HostInfo
+	// RefreshInterval returns how often the manager reconciles the per-domain
+	// workers and semaphore workflows. Nil falls back to a sensible default.
+	RefreshInterval dynamicproperties.DurationPropertyFn
+}
+
+// reconciler keeps the semaphore workflows of the domains covered by this host running.
+type reconciler struct {
+	frontendClient   frontend.Client
+	semaphoreManager persistence.SemaphoreMetadataManager
+	logger           log.Logger
+}
+
+// NewWorkerManager creates the manager of the per-domain semaphore workers.
+// For every domain this host owns on the membership hashring and that has
+// semaphores, it runs a Cadence SDK worker polling the semaphore task list and
+// makes sure the workflow of every semaphore is running.
+//
+// Each domain is covered by a single host. A short gap while ownership moves is
+// harmless: acquire and release requests are signals, which wait in the
+// workflow history until the new owner picks the decision up.
+func NewWorkerManager(params *BootstrapParams, enabledFn dynamicproperties.BoolPropertyFnWithDomainFilter) *domainworker.Manager {
+	logger := params.Logger.WithTags(tag.ComponentSemaphore)
+	r := &reconciler{
+		frontendClient:   params.FrontendClient,
+		semaphoreManager: params.SemaphoreManager,
+		logger:           logger,
+	}
+	return domainworker.NewManager(&domainworker.Params{
+		Name:               workerManagerName,
+		Logger:             logger,
+		DomainCache:        params.DomainCache,
+		MembershipResolver: params.MembershipResolver,
+		HostInfo:           params.HostInfo,
+		Enabled:            enabledFn,
+		RefreshInterval:    params.RefreshInterval,
+		CreateWorker: func(domainName string) (domainworker.Worker, error) {
+			return createWorker(params, domainName)
+		},
+		Reconcile: r.reconcile,
+	})
+}
+
+// reconcile starts the semaphore workflows of a domain which are not running,
+// and returns whether the domain has semaphores and needs a worker.
+func (r *reconciler) reconcile(ctx context.Context, domainEntry *cache.DomainCacheEntry) (bool, error) {
+	semaphores, err := r.listSemaphores(ctx, domainEntry.GetInfo().ID)
+	if err != nil {
+		return false, err
+	}
+	for _, semaphore := range semaphores {
+		r.ensureWorkflowRunning(ctx, domainEntry.GetInfo().Name, semaphore)
+	}
+	return len(semaphores) > 0, nil
+}
+
+func (r *reconciler) listSemaphores(ctx context.Context, domainID string) ([]*persistence.SemaphoreMetadata, error) {
+	var semaphores []*persistence.SemaphoreMetadata
+	var pageToken []byte
+	for {
+		listCtx, cancel := context.WithTimeout(ctx, refreshTimeout)
+		resp, err := r.semaphoreManager.ListSemaphores(listCtx, &persistence.ListSemaphoresRequest{
+			DomainID:      domainID,
+			PageSize:      listSemaphoresPageSize,
+			NextPageToken: pageToken,
+		})
+		cancel()
+		if err != nil {
+			return nil, err
+		}
+		semaphores = append(semaphores, resp.Semaphores...)
+		if len(resp.NextPageToken) == 0 {
+			return semaphores, nil
+		}
+		pageToken = resp.NextPageToken
+	}
+}
+
+// ensureWorkflowRunning starts the workflow of a semaphore unless it is running.
+func (r *reconciler) ensureWorkflowRunning(ctx context.Context, domainName string, semaphore *persistence.SemaphoreMetadata) {
+	request, err := NewStartWorkflowRequest(domainName, semaphore)
+	if err != nil {
+		r.logger.Error("failed to build semaphore workflow start request", tag.WorkflowDomainName(domainName), tag.Error(err))
+		return
+	}
+
+	startCtx, cancel := context.WithTimeout(ctx, refreshTimeout)
+	defer cancel()
+	_, err = r.frontendClient.StartWorkflowExecution(startCtx, request)
+	var alreadyStarted *types.WorkflowExecutionAlreadyStartedError
+	switch {
+	case err == nil:
+		r.logger.Info("started semaphore workflow",
+			tag.WorkflowDomainName(domainName),
+			tag.WorkflowID(request.WorkflowID),
+		)
+	case errors.As(err, &alreadyStarted):
+	default:
+		r.logger.Warn("failed to start semaphore workflow",
+			tag.WorkflowDomainName(domainName),
+			tag.WorkflowID(request.WorkflowID),
+			tag.Error(err),
+		)
+	}
+}
+
+func createWorker(params *BootstrapParams, domainName string) (domainworker.Worker, error) {
+	actCtx := context.WithValue(context.Background(), semaphoreContextKey, semaphoreContext{
+		FrontendClient: params.FrontendClient,
+	})
+
+	w := cadenceworker.New(params.ServiceClient, domainName, TaskListName, cadenceworker.Options{
+		BackgroundActivityContext: actCtx,
+	})
+	w.RegisterWorkflowWithOptions(SemaphoreWorkflow, workflow.RegisterOptions{Name: WorkflowTypeName})
+	w.RegisterActivity(checkExecutionsActivity)
+
+	if err := w.Start(); err != nil {
+		return nil, err
+	}
+	return w, nil
+}
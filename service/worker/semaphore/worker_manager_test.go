@@ -0,0 +1,129 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package semaphore
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/yarpc"
+
+	"github.com/uber/cadence/client/frontend"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/log/testlogger"
+	"github.com/uber/cadence/common/persistence"
+	commonsemaphore "github.com/uber/cadence/common/semaphore"
+	"github.com/uber/cadence/common/types"
+)
+
+func TestReconcile(t *testing.T) {
+	domainEntry := cache.NewDomainCacheEntryForTest(
+		&persistence.DomainInfo{ID: "domain-a-id", Name: "domain-a"},
+		nil, false, nil, 0, nil, 0, 0, 0,
+	)
+
+	tests := []struct {
+		name             string
+		pages            []*persistence.ListSemaphoresResponse
+		listErr          error
+		wantRun          bool
+		wantErr          bool
+		wantStartedFlows []string
+	}{
+		{
+			name: "starts the workflows of all semaphores",
+			pages: []*persistence.ListSemaphoresResponse{
+				{Semaphores: []*persistence.SemaphoreMetadata{{SemaphoreName: "downstream", Size: 2}}, NextPageToken: []byte("next")},
+				{Semaphores: []*persistence.SemaphoreMetadata{{SemaphoreName: "upstream", Size: 1}}},
+			},
+			wantRun:          true,
+			wantStartedFlows: []string{commonsemaphore.WorkflowID("downstream"), commonsemaphore.WorkflowID("upstream")},
+		},
+		{
+			name:  "domain without semaphores needs no worker",
+			pages: []*persistence.ListSemaphoresResponse{{}},
+		},
+		{
+			name:    "list error",
+			listErr: fmt.Errorf("persistence unavailable"),
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			mockSemaphoreManager := persistence.NewMockSemaphoreMetadataManager(ctrl)
+			if tc.listErr != nil {
+				mockSemaphoreManager.EXPECT().ListSemaphores(gomock.Any(), gomock.Any()).Return(nil, tc.listErr)
+			}
+			var pageToken []byte
+			for _, page := range tc.pages {
+				mockSemaphoreManager.EXPECT().ListSemaphores(gomock.Any(), &persistence.ListSemaphoresRequest{
+					DomainID:      "domain-a-id",
+					PageSize:      listSemaphoresPageSize,
+					NextPageToken: pageToken,
+				}).Return(page, nil)
+				pageToken = page.NextPageToken
+			}
+
+			var startedFlows []string
+			mockFrontendClient := frontend.NewMockClient(ctrl)
+			mockFrontendClient.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, request *types.StartWorkflowExecutionRequest, _ ...yarpc.CallOption) (*types.StartWorkflowExecutionResponse, error) {
+					assert.Equal(t, "domain-a", request.Domain)
+					startedFlows = append(startedFlows, request.WorkflowID)
+					return &types.StartWorkflowExecutionResponse{}, nil
+				}).AnyTimes()
+
+			r := &reconciler{
+				frontendClient:   mockFrontendClient,
+				semaphoreManager: mockSemaphoreManager,
+				logger:           testlogger.New(t),
+			}
+			run, err := r.reconcile(context.Background(), domainEntry)
+			if tc.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tc.wantRun, run)
+			assert.ElementsMatch(t, tc.wantStartedFlows, startedFlows)
+		})
+	}
+}
+
+func TestEnsureWorkflowRunningIgnoresAlreadyStarted(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockFrontendClient := frontend.NewMockClient(ctrl)
+	mockFrontendClient.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).
+		Return(nil, &types.WorkflowExecutionAlreadyStartedError{Message: "already started"})
+
+	r := &reconciler{
+		frontendClient: mockFrontendClient,
+		logger:         testlogger.New(t),
+	}
+	r.ensureWorkflowRunning(context.Background(), "domain-a", &persistence.SemaphoreMetadata{SemaphoreName: "downstream", Size: 1})
+}
@@ -0,0 +1,374 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package semaphore
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/uber-go/tally"
+	"go.uber.org/cadence/workflow"
+	"go.uber.org/zap"
+
+	commonsemaphore "github.com/uber/cadence/common/semaphore"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	activityScheduleToStartTimeout = time.Minute
+	activityStartToCloseTimeout    = 5 * time.Minute
+	activityRetryInitialInterval   = time.Second
+	activityRetryMaxInterval       = 10 * time.Second
+	activityRetryExpiration        = 15 * time.Minute
+)
+
+// grantResult is a GrantedSignal to deliver to the workflow of a request.
+type grantResult struct {
+	request PermitRequest
+	err     string
+}
+
+// SemaphoreWorkflow is a long-running workflow that manages the permits of a
+// single semaphore. It implements the server side of the signal protocol
+// documented in the common/semaphore package: workflows request permits by
+// signaling it with SignalNameAcquire, and it answers with a GrantedSignal once
+// the permits are granted. Holders return their permits with SignalNameRelease.
+//
+// Waiters are durable: they are part of the workflow state and carried over
+// ContinueAsNew. Leases are bound to the run of the workflow that acquired them:
+// the semaphore workflow periodically checks the holders and waiters, and
+// reclaims the permits of any run that has closed, including by ContinueAsNew.
+func SemaphoreWorkflow(ctx workflow.Context, input WorkflowInput) error {
+	logger := workflow.GetLogger(ctx).With(
+		zap.String("domain", input.Domain),
+		zap.String("semaphore", input.SemaphoreName),
+	)
+	scope := workflow.GetMetricsScope(ctx).Tagged(map[string]string{"domain": input.Domain})
+
+	state := &input.State
+	state.Signals = 0
+
+	err := workflow.SetQueryHandler(ctx, QueryTypeDescribe, func() (*Description, error) {
+		return buildDescription(&input, state), nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to register query handler: %w", err)
+	}
+
+	acquireCh := workflow.GetSignalChannel(ctx, commonsemaphore.SignalNameAcquire)
+	releaseCh := workflow.GetSignalChannel(ctx, commonsemaphore.SignalNameRelease)
+
+	activityCtx := workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		ScheduleToStartTimeout: activityScheduleToStartTimeout,
+		StartToCloseTimeout:    activityStartToCloseTimeout,
+		RetryPolicy: &workflow.RetryPolicy{
+			InitialInterval:    activityRetryInitialInterval,
+			MaximumInterval:    activityRetryMaxInterval,
+			ExpirationInterval: activityRetryExpiration,
+			BackoffCoefficient: 2,
+		},
+	})
+
+	// checkTimer is pending while there are requests to check. It is kept across
+	// iterations rather than restarted on every signal, so a busy semaphore does
+	// not add a timer pair to its history per signal.
+	var checkTimer workflow.Future
+	for {
+		grantWaiters(ctx, logger, scope, &input, state)
+
+		if state.Signals >= maxSignalsPerExecution {
+			results := drainSignals(logger, workflow.Now(ctx), acquireCh, releaseCh, &input, state)
+			notify(ctx, logger, results)
+			scope.Counter(SemaphoreContinueAsNewCountPerDomain).Inc(1)
+			state.Signals = 0
+			return workflow.NewContinueAsNewError(ctx, WorkflowTypeName, input)
+		}
+
+		if checkTimer == nil && len(state.Holders)+len(state.Waiters) > 0 {
+			checkTimer = workflow.NewTimer(ctx, leaseCheckInterval)
+		}
+
+		var results []grantResult
+		timerFired := false
+		selector := workflow.NewSelector(ctx)
+		selector.AddReceive(acquireCh, func(c workflow.Channel, more bool) {
+			var sig commonsemaphore.AcquireSignal
+			c.Receive(ctx, &sig)
+			results = append(results, handleAcquire(logger, workflow.Now(ctx), sig, &input, state)...)
+		})
+		selector.AddReceive(releaseCh, func(c workflow.Channel, more bool) {
+			var sig commonsemaphore.ReleaseSignal
+			c.Receive(ctx, &sig)
+			handleRelease(logger, sig, state)
+		})
+		if checkTimer != nil {
+			selector.AddFuture(checkTimer, func(workflow.Future) {
+				timerFired = true
+			})
+		}
+		selector.Select(ctx)
+
+		notify(ctx, logger, results)
+		if timerFired {
+			checkTimer = nil
+			reclaimClosed(activityCtx, logger, scope, &input, state)
+		}
+	}
+}
+
+// handleAcquire records an acquire signal. It returns the grant to re-send when
+// the request already holds its permits, or the rejection of a request that can
+// never be granted.
+func handleAcquire(logger *zap.Logger, now time.Time, sig commonsemaphore.AcquireSignal, input *WorkflowInput, state *WorkflowState) []grantResult {
+	state.Signals++
+	if sig.Permits <= 0 {
+		sig.Permits = 1
+	}
+	request := PermitRequest{
+		RequestID:     sig.RequestID,
+		WorkflowID:    sig.WorkflowID,
+		RunID:         sig.RunID,
+		Permits:       sig.Permits,
+		RequestedTime: now,
+	}
+	if sig.RequestID == "" || sig.WorkflowID == "" {
+		logger.Warn("dropping acquire signal without request or workflow ID", zap.String("workflowId", sig.WorkflowID))
+		return nil
+	}
+	if i := findRequest(state.Holders, sig.RequestID); i >= 0 {
+		return []grantResult{{request: state.Holders[i]}}
+	}
+	if findRequest(state.Waiters, sig.RequestID) >= 0 {
+		return nil
+	}
+	if sig.Permits > input.Size {
+		return []grantResult{{
+			request: request,
+			err:     fmt.Sprintf("requested %d permits but semaphore %q has %d", sig.Permits, input.SemaphoreName, input.Size),
+		}}
+	}
+	if len(state.Waiters) >= MaxWaitersSystemLimit {
+		return []grantResult{{
+			request: request,
+			err:     fmt.Sprintf("semaphore %q already has %d waiters", input.SemaphoreName, len(state.Waiters)),
+		}}
+	}
+	state.Waiters = append(state.Waiters, request)
+	return nil
+}
+
+// handleRelease removes a request from the holders, or withdraws it if it is
+// still waiting. Releasing an unknown request is a no-op, which makes releases
+// safe to retry.
+func handleRelease(logger *zap.Logger, sig commonsemaphore.ReleaseSignal, state *WorkflowState) {
+	state.Signals++
+	if i := findRequest(state.Holders, sig.RequestID); i >= 0 {
+		state.Holders = append(state.Holders[:i], state.Holders[i+1:]...)
+		return
+	}
+	if i := findRequest(state.Waiters, sig.RequestID); i >= 0 {
+		state.Waiters = append(state.Waiters[:i], state.Waiters[i+1:]...)
+		return
+	}
+	logger.Debug("ignoring release of unknown request", zap.String("requestId", sig.RequestID))
+}
+
+// grantWaiters grants permits to the waiters at the head of the queue while
+// enough permits are available. A grant which cannot be delivered means the
+// waiting workflow is gone, so its permits go to the next waiters instead.
+func grantWaiters(ctx workflow.Context, logger *zap.Logger, scope tally.Scope, input *WorkflowInput, state *WorkflowState) {
+	for {
+		grants := nextGrants(input, state)
+		if len(grants) == 0 {
+			return
+		}
+		state.Waiters = state.Waiters[len(grants):]
+
+		futures := make([]workflow.Future, 0, len(grants))
+		for _, request := range grants {
+			futures = append(futures, signalGranted(ctx, grantResult{request: request}))
+		}
+		now := workflow.Now(ctx)
+		for i, future := range futures {
+			if err := future.Get(ctx, nil); err != nil {
+				logger.Info("dropping waiter which could not be notified",
+					zap.String("workflowId", grants[i].WorkflowID),
+					zap.String("runId", grants[i].RunID),
+					zap.Error(err),
+				)
+				scope.Counter(SemaphoreLeaseReclaimedCountPerDomain).Inc(1)
+				continue
+			}
+			grants[i].AcquiredTime = now
+			state.Holders = append(state.Holders, grants[i])
+			scope.Counter(SemaphorePermitsGrantedCountPerDomain).Inc(int64(grants[i].Permits))
+		}
+	}
+}
+
+// nextGrants returns the waiters at the head of the queue which fit in the
+// available permits.
+func nextGrants(input *WorkflowInput, state *WorkflowState) []PermitRequest {
+	available := availablePermits(input, state)
+	var grants []PermitRequest
+	for _, waiter := range state.Waiters {
+		if waiter.Permits > available {
+			break
+		}
+		available -= waiter.Permits
+		grants = append(grants, waiter)
+	}
+	return grants
+}
+
+// reclaimClosed drops the holders and waiters whose workflow run has closed.
+func reclaimClosed(ctx workflow.Context, logger *zap.Logger, scope tally.Scope, input *WorkflowInput, state *WorkflowState) {
+	request := checkExecutionsRequest{Domain: input.Domain}
+	seen := make(map[types.WorkflowExecution]struct{})
+	for _, requests := range [][]PermitRequest{state.Holders, state.Waiters} {
+		for _, r := range requests {
+			execution := types.WorkflowExecution{WorkflowID: r.WorkflowID, RunID: r.RunID}
+			if _, ok := seen[execution]; ok {
+				continue
+			}
+			seen[execution] = struct{}{}
+			request.Executions = append(request.Executions, execution)
+		}
+	}
+	if len(request.Executions) == 0 {
+		return
+	}
+
+	var closed []types.WorkflowExecution
+	if err := workflow.ExecuteActivity(ctx, checkExecutionsActivity, request).Get(ctx, &closed); err != nil {
+		logger.Warn("failed to check semaphore holders, will retry on the next check", zap.Error(err))
+		return
+	}
+	if len(closed) == 0 {
+		return
+	}
+
+	closedSet := make(map[types.WorkflowExecution]struct{}, len(closed))
+	for _, execution := range closed {
+		closedSet[execution] = struct{}{}
+	}
+	keepOpen := func(requests []PermitRequest) []PermitRequest {
+		open := requests[:0]
+		for _, r := range requests {
+			if _, ok := closedSet[types.WorkflowExecution{WorkflowID: r.WorkflowID, RunID: r.RunID}]; ok {
+				logger.Info("reclaiming permits of closed workflow",
+					zap.String("workflowId", r.WorkflowID),
+					zap.String("runId", r.RunID),
+					zap.String("requestId", r.RequestID),
+				)
+				scope.Counter(SemaphoreLeaseReclaimedCountPerDomain).Inc(1)
+				continue
+			}
+			open = append(open, r)
+		}
+		return open
+	}
+	state.Holders = keepOpen(state.Holders)
+	state.Waiters = keepOpen(state.Waiters)
+}
+
+// drainSignals handles the signals buffered before ContinueAsNew, so none of
+// them is lost with the current run.
+func drainSignals(
+	logger *zap.Logger,
+	now time.Time,
+	acquireCh workflow.Channel,
+	releaseCh workflow.Channel,
+	input *WorkflowInput,
+	state *WorkflowState,
+) []grantResult {
+	var results []grantResult
+	for {
+		var sig commonsemaphore.ReleaseSignal
+		if !releaseCh.ReceiveAsync(&sig) {
+			break
+		}
+		handleRelease(logger, sig, state)
+	}
+	for {
+		var sig commonsemaphore.AcquireSignal
+		if !acquireCh.ReceiveAsync(&sig) {
+			break
+		}
+		results = append(results, handleAcquire(logger, now, sig, input, state)...)
+	}
+	return results
+}
+
+// notify delivers re-sent grants and rejections. Failures are only logged: a
+// workflow which cannot be signaled has closed, and its lease is reclaimed by
+// the next check.
+func notify(ctx workflow.Context, logger *zap.Logger, results []grantResult) {
+	futures := make([]workflow.Future, 0, len(results))
+	for _, result := range results {
+		futures = append(futures, signalGranted(ctx, result))
+	}
+	for i, future := range futures {
+		if err := future.Get(ctx, nil); err != nil {
+			logger.Info("failed to notify workflow",
+				zap.String("workflowId", results[i].request.WorkflowID),
+				zap.String("runId", results[i].request.RunID),
+				zap.Error(err),
+			)
+		}
+	}
+}
+
+func signalGranted(ctx workflow.Context, result grantResult) workflow.Future {
+	return workflow.SignalExternalWorkflow(
+		ctx,
+		result.request.WorkflowID,
+		result.request.RunID,
+		commonsemaphore.GrantedSignalName(result.request.RequestID),
+		commonsemaphore.GrantedSignal{RequestID: result.request.RequestID, Error: result.err},
+	)
+}
+
+func availablePermits(input *WorkflowInput, state *WorkflowState) int {
+	available := input.Size
+	for _, holder := range state.Holders {
+		available -= holder.Permits
+	}
+	return available
+}
+
+func findRequest(requests []PermitRequest, requestID string) int {
+	for i, r := range requests {
+		if r.RequestID == requestID {
+			return i
+		}
+	}
+	return -1
+}
+
+func buildDescription(input *WorkflowInput, state *WorkflowState) *Description {
+	return &Description{
+		Size:             input.Size,
+		AvailablePermits: availablePermits(input, state),
+		Holders:          state.Holders,
+		Waiters:          state.Waiters,
+	}
+}
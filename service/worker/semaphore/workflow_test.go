@@ -0,0 +1,203 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package semaphore
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+
+	commonsemaphore "github.com/uber/cadence/common/semaphore"
+)
+
+var testLogger = zap.NewNop()
+
+func TestHandleAcquire(t *testing.T) {
+	now := time.Date(2026, 1, 15, 10, 30, 0, 0, time.UTC)
+	holder := PermitRequest{RequestID: "held", WorkflowID: "wf-held", RunID: "run-held", Permits: 1}
+	waiter := PermitRequest{RequestID: "waiting", WorkflowID: "wf-waiting", RunID: "run-waiting", Permits: 2}
+
+	tests := []struct {
+		name        string
+		signal      commonsemaphore.AcquireSignal
+		waiters     []PermitRequest
+		wantResults []grantResult
+		wantWaiters []PermitRequest
+	}{
+		{
+			name:    "queues a new request",
+			signal:  commonsemaphore.AcquireSignal{RequestID: "new", WorkflowID: "wf-new", RunID: "run-new", Permits: 2},
+			waiters: []PermitRequest{waiter},
+			wantWaiters: []PermitRequest{
+				waiter,
+				{RequestID: "new", WorkflowID: "wf-new", RunID: "run-new", Permits: 2, RequestedTime: now},
+			},
+		},
+		{
+			name:   "defaults to one permit",
+			signal: commonsemaphore.AcquireSignal{RequestID: "new", WorkflowID: "wf-new", RunID: "run-new"},
+			wantWaiters: []PermitRequest{
+				{RequestID: "new", WorkflowID: "wf-new", RunID: "run-new", Permits: 1, RequestedTime: now},
+			},
+		},
+		{
+			name:        "re-sends the grant of a holder",
+			signal:      commonsemaphore.AcquireSignal{RequestID: "held", WorkflowID: "wf-held", RunID: "run-held", Permits: 1},
+			wantResults: []grantResult{{request: holder}},
+		},
+		{
+			name:        "ignores a duplicate waiting request",
+			signal:      commonsemaphore.AcquireSignal{RequestID: "waiting", WorkflowID: "wf-waiting", RunID: "run-waiting", Permits: 2},
+			waiters:     []PermitRequest{waiter},
+			wantWaiters: []PermitRequest{waiter},
+		},
+		{
+			name:   "rejects a request larger than the semaphore",
+			signal: commonsemaphore.AcquireSignal{RequestID: "big", WorkflowID: "wf-big", RunID: "run-big", Permits: 4},
+			wantResults: []grantResult{{
+				request: PermitRequest{RequestID: "big", WorkflowID: "wf-big", RunID: "run-big", Permits: 4, RequestedTime: now},
+				err:     `requested 4 permits but semaphore "downstream" has 3`,
+			}},
+		},
+		{
+			name:   "drops a request without workflow ID",
+			signal: commonsemaphore.AcquireSignal{RequestID: "new", Permits: 1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := &WorkflowInput{Domain: "test-domain", SemaphoreName: "downstream", Size: 3}
+			state := &WorkflowState{
+				Holders: []PermitRequest{holder},
+				Waiters: tt.waiters,
+			}
+
+			results := handleAcquire(testLogger, now, tt.signal, input, state)
+
+			assert.Equal(t, tt.wantResults, results)
+			assert.Equal(t, tt.wantWaiters, state.Waiters)
+			assert.Equal(t, 1, state.Signals)
+		})
+	}
+}
+
+func TestHandleRelease(t *testing.T) {
+	holder := PermitRequest{RequestID: "held", WorkflowID: "wf-held", Permits: 1}
+	waiter := PermitRequest{RequestID: "waiting", WorkflowID: "wf-waiting", Permits: 2}
+
+	tests := []struct {
+		name        string
+		requestID   string
+		wantHolders []PermitRequest
+		wantWaiters []PermitRequest
+	}{
+		{
+			name:        "releases the permits of a holder",
+			requestID:   "held",
+			wantHolders: []PermitRequest{},
+			wantWaiters: []PermitRequest{waiter},
+		},
+		{
+			name:        "withdraws a waiting request",
+			requestID:   "waiting",
+			wantHolders: []PermitRequest{holder},
+			wantWaiters: []PermitRequest{},
+		},
+		{
+			name:        "ignores an unknown request",
+			requestID:   "unknown",
+			wantHolders: []PermitRequest{holder},
+			wantWaiters: []PermitRequest{waiter},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state := &WorkflowState{
+				Holders: []PermitRequest{holder},
+				Waiters: []PermitRequest{waiter},
+			}
+
+			handleRelease(testLogger, commonsemaphore.ReleaseSignal{RequestID: tt.requestID}, state)
+
+			assert.Equal(t, tt.wantHolders, state.Holders)
+			assert.Equal(t, tt.wantWaiters, state.Waiters)
+			assert.Equal(t, 1, state.Signals)
+		})
+	}
+}
+
+func TestNextGrants(t *testing.T) {
+	tests := []struct {
+		name    string
+		holders []PermitRequest
+		waiters []PermitRequest
+		want    []string
+	}{
+		{
+			name:    "grants waiters which fit",
+			holders: []PermitRequest{{RequestID: "h", Permits: 1}},
+			waiters: []PermitRequest{{RequestID: "a", Permits: 1}, {RequestID: "b", Permits: 1}, {RequestID: "c", Permits: 1}},
+			want:    []string{"a", "b"},
+		},
+		{
+			name:    "head of the queue blocks the waiters behind it",
+			holders: []PermitRequest{{RequestID: "h", Permits: 1}},
+			waiters: []PermitRequest{{RequestID: "a", Permits: 3}, {RequestID: "b", Permits: 1}},
+		},
+		{
+			name:    "no permits available",
+			holders: []PermitRequest{{RequestID: "h", Permits: 3}},
+			waiters: []PermitRequest{{RequestID: "a", Permits: 1}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := &WorkflowInput{SemaphoreName: "downstream", Size: 3}
+			state := &WorkflowState{Holders: tt.holders, Waiters: tt.waiters}
+
+			var got []string
+			for _, grant := range nextGrants(input, state) {
+				got = append(got, grant.RequestID)
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestBuildDescription(t *testing.T) {
+	input := &WorkflowInput{SemaphoreName: "downstream", Size: 5}
+	state := &WorkflowState{
+		Holders: []PermitRequest{{RequestID: "a", Permits: 2}, {RequestID: "b", Permits: 1}},
+		Waiters: []PermitRequest{{RequestID: "c", Permits: 3}},
+	}
+
+	desc := buildDescription(input, state)
+
+	assert.Equal(t, 5, desc.Size)
+	assert.Equal(t, 2, desc.AvailablePermits)
+	assert.Equal(t, state.Holders, desc.Holders)
+	assert.Equal(t, state.Waiters, desc.Waiters)
+}
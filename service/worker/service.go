@@ -43,6 +43,7 @@ import (
 	"github.com/uber/cadence/service/worker/batcher"
 	"github.com/uber/cadence/service/worker/diagnostics"
 	"github.com/uber/cadence/service/worker/domaindeprecation"
+	"github.com/uber/cadence/service/worker/domainworker"
 	"github.com/uber/cadence/service/worker/esanalyzer"
 	"github.com/uber/cadence/service/worker/failovermanager"
 	"github.com/uber/cadence/service/worker/indexer"
@@ -54,6 +55,8 @@ import (
 	"github.com/uber/cadence/service/worker/scanner/tasklist"
 	"github.com/uber/cadence/service/worker/scanner/timers"
 	"github.com/uber/cadence/service/worker/scheduler"
+	"github.com/uber/cadence/service/worker/semaphore"
+	"github.com/uber/cadence/service/worker/visibilitymigration"
 )
 
 type (
@@ -87,30 +90,50 @@ type (
 		EnableScheduler                     dynamicproperties.BoolPropertyFnWithDomainFilter
 		SchedulerWorkerRefreshInterval      dynamicproperties.DurationPropertyFn
 		SchedulerWorkerRedundancyFactor     dynamicproperties.IntPropertyFnWithDomainFilter
+		EnableSemaphore                     dynamicproperties.BoolPropertyFnWithDomainFilter
+		SemaphoreWorkerRefreshInterval      dynamicproperties.DurationPropertyFn
 		EnableParentClosePolicyWorker       dynamicproperties.BoolPropertyFn
 		NumParentClosePolicySystemWorkflows dynamicproperties.IntPropertyFn
 		EnableFailoverManager               dynamicproperties.BoolPropertyFn
+		EnableVisibilityMigration           dynamicproperties.BoolPropertyFn
 		DomainReplicationMaxRetryDuration   dynamicproperties.DurationPropertyFn
 		EnableESAnalyzer                    dynamicproperties.BoolPropertyFn
 		EnableAsyncWorkflowConsumption      dynamicproperties.BoolPropertyFn
 		EnableDomainAuditLogging            dynamicproperties.BoolPropertyFn
 		HostName                            string
+
+		// configs for the visibility manager, only used by the visibility migration workflow
+		ReadVisibilityStoreName         dynamicproperties.StringPropertyFnWithDomainFilter
+		WriteVisibilityStoreName        dynamicproperties.StringPropertyFn
+		EnableReadFromClosedExecutionV2 dynamicproperties.BoolPropertyFn
+		ESIndexMaxResultWindow          dynamicproperties.IntPropertyFn
+		ValidSearchAttributes           dynamicproperties.MapPropertyFn
+		PinotOptimizedQueryColumns      dynamicproperties.MapPropertyFn
 	}
 )
 
 // NewService builds a new cadence-worker service
 func NewService(params *resource.Params) (resource.Resource, error) {
 	serviceConfig := NewConfig(params)
+	resourceConfig := &service.Config{
+		PersistenceMaxQPS:        serviceConfig.PersistenceMaxQPS,
+		PersistenceGlobalMaxQPS:  serviceConfig.PersistenceGlobalMaxQPS,
+		ThrottledLoggerMaxRPS:    serviceConfig.ThrottledLogRPS,
+		IsErrorRetryableFunction: common.IsServiceTransientError,
+	}
+	// worker service only calls visibilityManager API from the visibility migration workflow
+	if serviceConfig.EnableVisibilityMigration() {
+		resourceConfig.ReadVisibilityStoreName = serviceConfig.ReadVisibilityStoreName
+		resourceConfig.WriteVisibilityStoreName = serviceConfig.WriteVisibilityStoreName
+		resourceConfig.EnableReadDBVisibilityFromClosedExecutionV2 = serviceConfig.EnableReadFromClosedExecutionV2
+		resourceConfig.ESIndexMaxResultWindow = serviceConfig.ESIndexMaxResultWindow
+		resourceConfig.ValidSearchAttributes = serviceConfig.ValidSearchAttributes
+		resourceConfig.PinotOptimizedQueryColumns = serviceConfig.PinotOptimizedQueryColumns
+	}
 	serviceResource, err := resource.New(
 		params,
 		service.Worker,
-		&service.Config{
-			PersistenceMaxQPS:        serviceConfig.PersistenceMaxQPS,
-			PersistenceGlobalMaxQPS:  serviceConfig.PersistenceGlobalMaxQPS,
-			ThrottledLoggerMaxRPS:    serviceConfig.ThrottledLogRPS,
-			IsErrorRetryableFunction: common.IsServiceTransientError,
-			// worker service doesn't need visibility config as it never call visibilityManager API
-		},
+		resourceConfig,
 	)
 	if err != nil {
 		return nil, err
@@ -183,10 +206,13 @@ func NewConfig(params *resource.Params) *Config {
 		EnableScheduler:                     dc.GetBoolPropertyFilteredByDomain(dynamicproperties.EnableScheduler),
 		SchedulerWorkerRefreshInterval:      dc.GetDurationProperty(dynamicproperties.SchedulerWorkerRefreshInterval),
 		SchedulerWorkerRedundancyFactor:     dc.GetIntPropertyFilteredByDomain(dynamicproperties.SchedulerWorkerRedundancyFactor),
+		EnableSemaphore:                     dc.GetBoolPropertyFilteredByDomain(dynamicproperties.EnableSemaphore),
+		SemaphoreWorkerRefreshInterval:      dc.GetDurationProperty(dynamicproperties.SemaphoreWorkerRefreshInterval),
 		EnableParentClosePolicyWorker:       dc.GetBoolProperty(dynamicproperties.EnableParentClosePolicyWorker),
 		NumParentClosePolicySystemWorkflows: dc.GetIntProperty(dynamicproperties.NumParentClosePolicySystemWorkflows),
 		EnableESAnalyzer:                    dc.GetBoolProperty(dynamicproperties.EnableESAnalyzer),
 		EnableFailoverManager:               dc.GetBoolProperty(dynamicproperties.EnableFailoverManager),
+		EnableVisibilityMigration:           dc.GetBoolProperty(dynamicproperties.EnableVisibilityMigration),
 		ThrottledLogRPS:                     dc.GetIntProperty(dynamicproperties.WorkerThrottledLogRPS),
 		PersistenceGlobalMaxQPS:             dc.GetIntProperty(dynamicproperties.WorkerPersistenceGlobalMaxQPS),
 		PersistenceMaxQPS:                   dc.GetIntProperty(dynamicproperties.WorkerPersistenceMaxQPS),
@@ -194,6 +220,12 @@ func NewConfig(params *resource.Params) *Config {
 		EnableAsyncWorkflowConsumption:      dc.GetBoolProperty(dynamicproperties.EnableAsyncWorkflowConsumption),
 		EnableDomainAuditLogging:            dc.GetBoolProperty(dynamicproperties.EnableDomainAuditLogging),
 		HostName:                            params.HostName,
+		ReadVisibilityStoreName:             dc.GetStringPropertyFilteredByDomain(dynamicproperties.ReadVisibilityStoreName),
+		WriteVisibilityStoreName:            dc.GetStringProperty(dynamicproperties.WriteVisibilityStoreName),
+		EnableReadFromClosedExecutionV2:     dc.GetBoolProperty(dynamicproperties.EnableReadFromClosedExecutionV2),
+		ESIndexMaxResultWindow:              dc.GetIntProperty(dynamicproperties.FrontendESIndexMaxResultWindow),
+		ValidSearchAttributes:               dc.GetMapProperty(dynamicproperties.ValidSearchAttributes),
+		PinotOptimizedQueryColumns:          dc.GetMapProperty(dynamicproperties.PinotOptimizedQueryColumns),
 	}
 	advancedVisWritingMode := dc.GetStringProperty(
 		dynamicproperties.WriteVisibilityStoreName,
@@ -249,6 +281,8 @@ func (s *Service) Start() {
 	}
 	sm := s.startSchedulerWorkerManager()
 	defer sm.Stop()
+	smm := s.startSemaphoreWorkerManager()
+	defer smm.Stop()
 	if s.config.EnableParentClosePolicyWorker() {
 		s.startParentClosePolicyProcessor()
 	}
@@ -258,6 +292,9 @@ func (s *Service) Start() {
 	if s.config.EnableFailoverManager() {
 		s.startFailoverManager()
 	}
+	if s.config.EnableVisibilityMigration() && s.GetVisibilityManager() != nil {
+		s.startVisibilityMigration()
+	}
 
 	cm := s.startAsyncWorkflowConsumerManager()
 	defer cm.Stop()
@@ -342,7 +379,7 @@ func (s *Service) startBatcher() {
 	}
 }
 
-func (s *Service) startSchedulerWorkerManager() *scheduler.WorkerManager {
+func (s *Service) startSchedulerWorkerManager() *domainworker.Manager {
 	params := &scheduler.BootstrapParams{
 		ServiceClient:      s.params.PublicClient,
 		FrontendClient:     s.GetClientBean().GetFrontendClient(),
@@ -359,6 +396,22 @@ func (s *Service) startSchedulerWorkerManager() *scheduler.WorkerManager {
 	return wm
 }
 
+func (s *Service) startSemaphoreWorkerManager() *domainworker.Manager {
+	params := &semaphore.BootstrapParams{
+		ServiceClient:      s.params.PublicClient,
+		FrontendClient:     s.GetClientBean().GetFrontendClient(),
+		SemaphoreManager:   s.GetSemaphoreMetadataManager(),
+		Logger:             s.GetLogger(),
+		DomainCache:        s.GetDomainCache(),
+		MembershipResolver: s.GetMembershipResolver(),
+		HostInfo:           s.GetHostInfo(),
+		RefreshInterval:    s.config.SemaphoreWorkerRefreshInterval,
+	}
+	wm := semaphore.NewWorkerManager(params, s.config.EnableSemaphore)
+	wm.Start()
+	return wm
+}
+
 func (s *Service) startScanner() {
 	params := &scanner.BootstrapParams{
 		Config:     *s.config.ScannerCfg,
@@ -488,6 +541,22 @@ func (s *Service) startFailoverManager() {
 	}
 }
 
+func (s *Service) startVisibilityMigration() {
+	params := &visibilitymigration.BootstrapParams{
+		ServiceClient:       s.params.PublicClient,
+		MetricsClient:       s.GetMetricsClient(),
+		Logger:              s.GetLogger(),
+		TallyScope:          s.params.MetricScope,
+		VisibilityManager:   s.GetVisibilityManager(),
+		DomainCache:         s.GetDomainCache(),
+		DynamicConfigClient: s.params.DynamicConfig,
+	}
+	if err := visibilitymigration.New(params).Start(); err != nil {
+		s.Stop()
+		s.GetLogger().Fatal("error starting visibility migration", tag.Error(err))
+	}
+}
+
 func (s *Service) startAsyncWorkflowConsumerManager() common.Daemon {
 	cm := asyncworkflow.NewConsumerManager(
 		s.GetLogger(),
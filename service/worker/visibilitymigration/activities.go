@@ -0,0 +1,318 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package visibilitymigration
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"go.uber.org/cadence/activity"
+
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+)
+
+// BackfillActivity copies closed executions of a domain from the source store into the target store.
+// Progress is heartbeated so a retried attempt resumes from the last finished page.
+func BackfillActivity(ctx context.Context, params *BackfillActivityParams) (*BackfillResult, error) {
+	m := getMigrator(ctx)
+	domainEntry, err := m.domainCache.GetDomain(params.Domain)
+	if err != nil {
+		return nil, err
+	}
+	logger := m.logger.WithTags(tag.WorkflowDomainName(params.Domain))
+	scope := m.metricsClient.Scope(metrics.VisibilityMigrationScope, metrics.DomainTag(params.Domain))
+
+	progress := &BackfillResult{}
+	if activity.HasHeartbeatDetails(ctx) {
+		if err := activity.GetHeartbeatDetails(ctx, progress); err != nil {
+			logger.Warn("failed to load backfill progress, restarting from the first page", tag.Error(err))
+			progress = &BackfillResult{}
+		}
+	}
+
+	sourceCtx := withStore(ctx, params.SourceStore)
+	targetCtx := withStore(ctx, params.TargetStore)
+	for {
+		resp, err := m.visibilityManager.ListClosedWorkflowExecutions(sourceCtx, &persistence.ListWorkflowExecutionsRequest{
+			DomainUUID:    domainEntry.GetInfo().ID,
+			Domain:        params.Domain,
+			EarliestTime:  params.EarliestTime,
+			LatestTime:    params.LatestTime,
+			PageSize:      params.PageSize,
+			NextPageToken: progress.NextPageToken,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, execution := range resp.Executions {
+			if execution.GetExecution() == nil {
+				continue
+			}
+			// a record that cannot be written is not fatal, it shows up as a discrepancy during verification
+			if err := m.visibilityManager.RecordWorkflowExecutionClosed(targetCtx, newRecordClosedRequest(domainEntry, execution)); err != nil {
+				progress.Failed++
+				scope.IncCounter(metrics.VisibilityMigrationBackfillFailedCount)
+				logger.Warn("failed to backfill closed execution",
+					tag.WorkflowID(execution.GetExecution().GetWorkflowID()),
+					tag.WorkflowRunID(execution.GetExecution().GetRunID()),
+					tag.Error(err))
+				continue
+			}
+			progress.Backfilled++
+			scope.IncCounter(metrics.VisibilityMigrationBackfilledCount)
+		}
+		progress.NextPageToken = resp.NextPageToken
+		activity.RecordHeartbeat(ctx, *progress)
+		if len(resp.NextPageToken) == 0 {
+			break
+		}
+	}
+
+	logger.Info(fmt.Sprintf("visibility backfill from %s to %s finished, backfilled: %d, failed: %d",
+		params.SourceStore, params.TargetStore, progress.Backfilled, progress.Failed))
+	return &BackfillResult{Backfilled: progress.Backfilled, Failed: progress.Failed}, nil
+}
+
+// VerifyActivity compares the count of executions and the closed executions within a window between
+// the source and the target store
+func VerifyActivity(ctx context.Context, params *VerifyActivityParams) (*VerifyResult, error) {
+	m := getMigrator(ctx)
+	domainID, err := m.domainCache.GetDomainID(params.Domain)
+	if err != nil {
+		return nil, err
+	}
+	logger := m.logger.WithTags(tag.WorkflowDomainName(params.Domain))
+	scope := m.metricsClient.Scope(metrics.VisibilityMigrationScope, metrics.DomainTag(params.Domain))
+
+	sourceCtx := withStore(ctx, params.SourceStore)
+	targetCtx := withStore(ctx, params.TargetStore)
+	result := &VerifyResult{
+		WindowStart: params.WindowStart,
+		WindowEnd:   params.WindowEnd,
+	}
+
+	countRequest := &persistence.CountWorkflowExecutionsRequest{
+		DomainUUID: domainID,
+		Domain:     params.Domain,
+	}
+	sourceCount, sourceErr := m.visibilityManager.CountWorkflowExecutions(sourceCtx, countRequest)
+	targetCount, targetErr := m.visibilityManager.CountWorkflowExecutions(targetCtx, countRequest)
+	switch {
+	case isOperationNotSupported(sourceErr) || isOperationNotSupported(targetErr):
+		result.CountSkipped = true
+	case sourceErr != nil:
+		return nil, sourceErr
+	case targetErr != nil:
+		return nil, targetErr
+	default:
+		result.SourceCount = sourceCount.Count
+		result.TargetCount = targetCount.Count
+	}
+
+	listRequest := &persistence.ListWorkflowExecutionsRequest{
+		DomainUUID:   domainID,
+		Domain:       params.Domain,
+		EarliestTime: params.WindowStart,
+		LatestTime:   params.WindowEnd,
+		PageSize:     params.PageSize,
+	}
+	sourceRuns, sourceTruncated, err := listClosedExecutions(sourceCtx, m.visibilityManager, listRequest, params.MaxPages)
+	if err != nil {
+		return nil, err
+	}
+	targetRuns, targetTruncated, err := listClosedExecutions(targetCtx, m.visibilityManager, listRequest, params.MaxPages)
+	if err != nil {
+		return nil, err
+	}
+	result.SourceListed = len(sourceRuns)
+	result.TargetListed = len(targetRuns)
+	result.Truncated = sourceTruncated || targetTruncated
+	result.MissingInTargetCount, result.MissingInTarget = diffExecutions(sourceRuns, targetRuns)
+	result.UnexpectedInTargetCount, result.UnexpectedInTarget = diffExecutions(targetRuns, sourceRuns)
+
+	countDiff := result.SourceCount - result.TargetCount
+	if countDiff < 0 {
+		countDiff = -countDiff
+	}
+	result.Matched = !result.Truncated &&
+		result.MissingInTargetCount == 0 &&
+		result.UnexpectedInTargetCount == 0 &&
+		(result.CountSkipped || countDiff <= params.CountTolerance)
+
+	if result.Matched {
+		scope.IncCounter(metrics.VisibilityMigrationVerifyMatchCount)
+		return result, nil
+	}
+	scope.IncCounter(metrics.VisibilityMigrationVerifyMismatchCount)
+	scope.AddCounter(metrics.VisibilityMigrationDiscrepancyCount, int64(result.MissingInTargetCount+result.UnexpectedInTargetCount))
+	logger.Warn(fmt.Sprintf("visibility stores %s and %s disagree, source count: %d, target count: %d, missing in target: %d, unexpected in target: %d, truncated: %v",
+		params.SourceStore, params.TargetStore, result.SourceCount, result.TargetCount,
+		result.MissingInTargetCount, result.UnexpectedInTargetCount, result.Truncated))
+	return result, nil
+}
+
+// CutoverActivity points the per-domain read visibility store config at the target store. Values of the
+// config for other domains or filters are kept as they are.
+func CutoverActivity(ctx context.Context, params *CutoverActivityParams) error {
+	m := getMigrator(ctx)
+	key := dynamicproperties.ReadVisibilityStoreName
+	domainFilter, err := newJSONBlob(params.Domain)
+	if err != nil {
+		return err
+	}
+	storeValue, err := newJSONBlob(params.TargetStore)
+	if err != nil {
+		return err
+	}
+
+	// the values are recomputed from the latest config when a concurrent update of the key wins,
+	// so changes made to other domains' values in the meantime are not lost
+	err = m.dynamicConfigClient.UpdateValueWith(key, func(current []*types.DynamicConfigValue) ([]*types.DynamicConfigValue, error) {
+		values := make([]*types.DynamicConfigValue, 0, len(current)+1)
+		for _, value := range current {
+			if !isDomainOnlyValue(value, params.Domain) {
+				values = append(values, value)
+			}
+		}
+		return append(values, &types.DynamicConfigValue{
+			Value: storeValue,
+			Filters: []*types.DynamicConfigFilter{
+				{
+					Name:  dynamicproperties.DomainName.String(),
+					Value: domainFilter,
+				},
+			},
+		}), nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update %s: %w", key.String(), err)
+	}
+
+	m.metricsClient.Scope(metrics.VisibilityMigrationScope, metrics.DomainTag(params.Domain)).
+		IncCounter(metrics.VisibilityMigrationCutoverCount)
+	m.logger.Info("visibility read store switched",
+		tag.WorkflowDomainName(params.Domain),
+		tag.Value(params.TargetStore))
+	return nil
+}
+
+func getMigrator(ctx context.Context) *Migrator {
+	return ctx.Value(migratorContextKey).(*Migrator)
+}
+
+func withStore(ctx context.Context, store string) context.Context {
+	return context.WithValue(ctx, persistence.ContextKey, store)
+}
+
+func isOperationNotSupported(err error) bool {
+	return errors.Is(err, persistence.ErrVisibilityOperationNotSupported)
+}
+
+func newRecordClosedRequest(
+	domainEntry *cache.DomainCacheEntry,
+	execution *types.WorkflowExecutionInfo,
+) *persistence.RecordWorkflowExecutionClosedRequest {
+	// TaskID is left as zero so any write coming from history takes precedence over the backfilled copy
+	return persistence.NewRecordWorkflowExecutionClosedRequest(
+		domainEntry.GetInfo().ID,
+		domainEntry.GetInfo().Name,
+		int64(domainEntry.GetRetentionDays(execution.GetExecution().GetWorkflowID()))*24*3600,
+		execution,
+	)
+}
+
+// listClosedExecutions returns the closed executions in the request window keyed by run ID,
+// and whether maxPages was reached before the last page
+func listClosedExecutions(
+	ctx context.Context,
+	visibilityManager persistence.VisibilityManager,
+	request *persistence.ListWorkflowExecutionsRequest,
+	maxPages int,
+) (map[string]types.WorkflowExecution, bool, error) {
+	executions := make(map[string]types.WorkflowExecution)
+	pageRequest := *request
+	for page := 0; page < maxPages; page++ {
+		resp, err := visibilityManager.ListClosedWorkflowExecutions(ctx, &pageRequest)
+		if err != nil {
+			return nil, false, err
+		}
+		for _, execution := range resp.Executions {
+			if execution.GetExecution() == nil {
+				continue
+			}
+			executions[execution.GetExecution().GetRunID()] = *execution.GetExecution()
+		}
+		if len(resp.NextPageToken) == 0 {
+			return executions, false, nil
+		}
+		pageRequest.NextPageToken = resp.NextPageToken
+	}
+	return executions, true, nil
+}
+
+// diffExecutions returns the number of executions in a but not in b, and up to maxReportedDiscrepancies of them
+func diffExecutions(a, b map[string]types.WorkflowExecution) (int, []types.WorkflowExecution) {
+	count := 0
+	var reported []types.WorkflowExecution
+	for runID, execution := range a {
+		if _, ok := b[runID]; ok {
+			continue
+		}
+		count++
+		if len(reported) < maxReportedDiscrepancies {
+			reported = append(reported, execution)
+		}
+	}
+	return count, reported
+}
+
+func isDomainOnlyValue(value *types.DynamicConfigValue, domain string) bool {
+	if value == nil || len(value.Filters) != 1 {
+		return false
+	}
+	filter := value.Filters[0]
+	if filter.Name != dynamicproperties.DomainName.String() || filter.Value == nil {
+		return false
+	}
+	var filterValue string
+	if err := json.Unmarshal(filter.Value.Data, &filterValue); err != nil {
+		return false
+	}
+	return filterValue == domain
+}
+
+func newJSONBlob(value interface{}) (*types.DataBlob, error) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	return &types.DataBlob{
+		EncodingType: types.EncodingTypeJSON.Ptr(),
+		Data:         data,
+	}, nil
+}
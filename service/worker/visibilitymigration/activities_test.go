@@ -0,0 +1,318 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package visibilitymigration
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/cadence/testsuite"
+	"go.uber.org/cadence/worker"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	testDomain   = "test-domain"
+	testDomainID = "test-domain-id"
+)
+
+type testDeps struct {
+	visibilityManager *persistence.MockVisibilityManager
+	domainCache       *cache.MockDomainCache
+	dcClient          *dynamicconfig.MockClient
+	env               *testsuite.TestActivityEnvironment
+}
+
+func setupActivityEnv(t *testing.T) *testDeps {
+	ctrl := gomock.NewController(t)
+	deps := &testDeps{
+		visibilityManager: persistence.NewMockVisibilityManager(ctrl),
+		domainCache:       cache.NewMockDomainCache(ctrl),
+		dcClient:          dynamicconfig.NewMockClient(ctrl),
+	}
+	m := &Migrator{
+		metricsClient:       metrics.NewNoopMetricsClient(),
+		logger:              log.NewNoop(),
+		visibilityManager:   deps.visibilityManager,
+		domainCache:         deps.domainCache,
+		dynamicConfigClient: deps.dcClient,
+	}
+	s := testsuite.WorkflowTestSuite{}
+	deps.env = s.NewTestActivityEnvironment()
+	deps.env.SetWorkerOptions(worker.Options{
+		BackgroundActivityContext: context.WithValue(context.Background(), migratorContextKey, m),
+	})
+	deps.env.RegisterActivity(BackfillActivity)
+	deps.env.RegisterActivity(VerifyActivity)
+	deps.env.RegisterActivity(CutoverActivity)
+	return deps
+}
+
+func executionInfo(runID string) *types.WorkflowExecutionInfo {
+	return &types.WorkflowExecutionInfo{
+		Execution:   &types.WorkflowExecution{WorkflowID: "wid-" + runID, RunID: runID},
+		Type:        &types.WorkflowType{Name: "wf-type"},
+		StartTime:   common.Int64Ptr(1),
+		CloseTime:   common.Int64Ptr(2),
+		CloseStatus: types.WorkflowExecutionCloseStatusCompleted.Ptr(),
+		TaskList:    &types.TaskList{Name: "tl"},
+	}
+}
+
+func storeOf(ctx context.Context) string {
+	store, _ := ctx.Value(persistence.ContextKey).(string)
+	return store
+}
+
+func TestBackfillActivity(t *testing.T) {
+	deps := setupActivityEnv(t)
+	domainEntry := cache.NewLocalDomainCacheEntryForTest(
+		&persistence.DomainInfo{ID: testDomainID, Name: testDomain},
+		&persistence.DomainConfig{Retention: 1},
+		"",
+	)
+	deps.domainCache.EXPECT().GetDomain(testDomain).Return(domainEntry, nil)
+
+	pages := [][]*types.WorkflowExecutionInfo{
+		{executionInfo("r1"), executionInfo("r2")},
+		{executionInfo("r3")},
+	}
+	deps.visibilityManager.EXPECT().ListClosedWorkflowExecutions(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, request *persistence.ListWorkflowExecutionsRequest) (*persistence.ListWorkflowExecutionsResponse, error) {
+			assert.Equal(t, "db", storeOf(ctx))
+			assert.Equal(t, testDomainID, request.DomainUUID)
+			if len(request.NextPageToken) == 0 {
+				return &persistence.ListWorkflowExecutionsResponse{Executions: pages[0], NextPageToken: []byte("next")}, nil
+			}
+			return &persistence.ListWorkflowExecutionsResponse{Executions: pages[1]}, nil
+		}).Times(2)
+	deps.visibilityManager.EXPECT().RecordWorkflowExecutionClosed(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, request *persistence.RecordWorkflowExecutionClosedRequest) error {
+			assert.Equal(t, "es", storeOf(ctx))
+			assert.Equal(t, int64(24*3600), request.RetentionSeconds)
+			assert.Equal(t, "tl", request.TaskList)
+			if request.Execution.RunID == "r2" {
+				return &types.InternalServiceError{Message: "write failed"}
+			}
+			return nil
+		}).Times(3)
+
+	val, err := deps.env.ExecuteActivity(BackfillActivity, &BackfillActivityParams{
+		Domain:      testDomain,
+		SourceStore: "db",
+		TargetStore: "es",
+		PageSize:    2,
+	})
+	require.NoError(t, err)
+	var result BackfillResult
+	require.NoError(t, val.Get(&result))
+	assert.Equal(t, int64(2), result.Backfilled)
+	assert.Equal(t, int64(1), result.Failed)
+	assert.Empty(t, result.NextPageToken)
+}
+
+func TestVerifyActivity(t *testing.T) {
+	tests := map[string]struct {
+		sourceCount    *persistence.CountWorkflowExecutionsResponse
+		sourceCountErr error
+		targetCount    *persistence.CountWorkflowExecutionsResponse
+		sourceRuns     []string
+		targetRuns     []string
+		countTolerance int64
+		expected       VerifyResult
+	}{
+		"stores agree": {
+			sourceCount: &persistence.CountWorkflowExecutionsResponse{Count: 5},
+			targetCount: &persistence.CountWorkflowExecutionsResponse{Count: 5},
+			sourceRuns:  []string{"r1", "r2"},
+			targetRuns:  []string{"r2", "r1"},
+			expected: VerifyResult{
+				Matched:      true,
+				SourceCount:  5,
+				TargetCount:  5,
+				SourceListed: 2,
+				TargetListed: 2,
+			},
+		},
+		"count differs within tolerance": {
+			sourceCount:    &persistence.CountWorkflowExecutionsResponse{Count: 5},
+			targetCount:    &persistence.CountWorkflowExecutionsResponse{Count: 4},
+			countTolerance: 1,
+			expected: VerifyResult{
+				Matched:     true,
+				SourceCount: 5,
+				TargetCount: 4,
+			},
+		},
+		"count differs": {
+			sourceCount: &persistence.CountWorkflowExecutionsResponse{Count: 5},
+			targetCount: &persistence.CountWorkflowExecutionsResponse{Count: 3},
+			expected: VerifyResult{
+				SourceCount: 5,
+				TargetCount: 3,
+			},
+		},
+		"count not supported and executions differ": {
+			sourceCountErr: persistence.ErrVisibilityOperationNotSupported,
+			targetCount:    &persistence.CountWorkflowExecutionsResponse{Count: 3},
+			sourceRuns:     []string{"r1", "r2"},
+			targetRuns:     []string{"r2", "r3"},
+			expected: VerifyResult{
+				CountSkipped:            true,
+				SourceListed:            2,
+				TargetListed:            2,
+				MissingInTargetCount:    1,
+				UnexpectedInTargetCount: 1,
+				MissingInTarget:         []types.WorkflowExecution{{WorkflowID: "wid-r1", RunID: "r1"}},
+				UnexpectedInTarget:      []types.WorkflowExecution{{WorkflowID: "wid-r3", RunID: "r3"}},
+			},
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			deps := setupActivityEnv(t)
+			deps.domainCache.EXPECT().GetDomainID(testDomain).Return(testDomainID, nil)
+			deps.visibilityManager.EXPECT().CountWorkflowExecutions(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(ctx context.Context, _ *persistence.CountWorkflowExecutionsRequest) (*persistence.CountWorkflowExecutionsResponse, error) {
+					if storeOf(ctx) == "es" {
+						return test.sourceCount, test.sourceCountErr
+					}
+					return test.targetCount, nil
+				}).Times(2)
+			deps.visibilityManager.EXPECT().ListClosedWorkflowExecutions(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(ctx context.Context, request *persistence.ListWorkflowExecutionsRequest) (*persistence.ListWorkflowExecutionsResponse, error) {
+					assert.Equal(t, int64(10), request.EarliestTime)
+					assert.Equal(t, int64(20), request.LatestTime)
+					runs := test.targetRuns
+					if storeOf(ctx) == "es" {
+						runs = test.sourceRuns
+					}
+					resp := &persistence.ListWorkflowExecutionsResponse{}
+					for _, runID := range runs {
+						resp.Executions = append(resp.Executions, executionInfo(runID))
+					}
+					return resp, nil
+				}).Times(2)
+
+			val, err := deps.env.ExecuteActivity(VerifyActivity, &VerifyActivityParams{
+				Domain:         testDomain,
+				SourceStore:    "es",
+				TargetStore:    "pinot",
+				WindowStart:    10,
+				WindowEnd:      20,
+				PageSize:       10,
+				MaxPages:       10,
+				CountTolerance: test.countTolerance,
+			})
+			require.NoError(t, err)
+			var result VerifyResult
+			require.NoError(t, val.Get(&result))
+			test.expected.WindowStart = 10
+			test.expected.WindowEnd = 20
+			assert.Equal(t, test.expected, result)
+		})
+	}
+}
+
+func TestVerifyActivity_Truncated(t *testing.T) {
+	deps := setupActivityEnv(t)
+	deps.domainCache.EXPECT().GetDomainID(testDomain).Return(testDomainID, nil)
+	deps.visibilityManager.EXPECT().CountWorkflowExecutions(gomock.Any(), gomock.Any()).
+		Return(&persistence.CountWorkflowExecutionsResponse{Count: 1}, nil).Times(2)
+	deps.visibilityManager.EXPECT().ListClosedWorkflowExecutions(gomock.Any(), gomock.Any()).
+		Return(&persistence.ListWorkflowExecutionsResponse{
+			Executions:    []*types.WorkflowExecutionInfo{executionInfo("r1")},
+			NextPageToken: []byte("more"),
+		}, nil).Times(2)
+
+	val, err := deps.env.ExecuteActivity(VerifyActivity, &VerifyActivityParams{
+		Domain:      testDomain,
+		SourceStore: "es",
+		TargetStore: "pinot",
+		PageSize:    1,
+		MaxPages:    1,
+	})
+	require.NoError(t, err)
+	var result VerifyResult
+	require.NoError(t, val.Get(&result))
+	assert.True(t, result.Truncated)
+	assert.False(t, result.Matched)
+}
+
+func TestCutoverActivity(t *testing.T) {
+	deps := setupActivityEnv(t)
+	blob := func(v interface{}) *types.DataBlob {
+		b, err := newJSONBlob(v)
+		require.NoError(t, err)
+		return b
+	}
+	key := dynamicproperties.ReadVisibilityStoreName
+	otherDomainValue := &types.DynamicConfigValue{
+		Value:   blob("db"),
+		Filters: []*types.DynamicConfigFilter{{Name: dynamicproperties.DomainName.String(), Value: blob("other-domain")}},
+	}
+	fallbackValue := &types.DynamicConfigValue{Value: blob("es")}
+	deps.dcClient.EXPECT().UpdateValueWith(key, gomock.Any()).DoAndReturn(
+		func(_ dynamicproperties.Key, update func([]*types.DynamicConfigValue) ([]*types.DynamicConfigValue, error)) error {
+			values, err := update([]*types.DynamicConfigValue{
+				otherDomainValue,
+				fallbackValue,
+				{
+					Value:   blob("db"),
+					Filters: []*types.DynamicConfigFilter{{Name: dynamicproperties.DomainName.String(), Value: blob(testDomain)}},
+				},
+			})
+			require.NoError(t, err)
+			require.Len(t, values, 3)
+			assert.Equal(t, otherDomainValue, values[0])
+			assert.Equal(t, fallbackValue, values[1])
+
+			var store string
+			require.NoError(t, json.Unmarshal(values[2].Value.Data, &store))
+			assert.Equal(t, "pinot", store)
+			assert.True(t, isDomainOnlyValue(values[2], testDomain))
+
+			// the key has no value yet
+			values, err = update(nil)
+			require.NoError(t, err)
+			require.Len(t, values, 1)
+			assert.True(t, isDomainOnlyValue(values[0], testDomain))
+			return nil
+		})
+
+	_, err := deps.env.ExecuteActivity(CutoverActivity, &CutoverActivityParams{
+		Domain:      testDomain,
+		TargetStore: "pinot",
+	})
+	require.NoError(t, err)
+}
@@ -0,0 +1,110 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package visibilitymigration
+
+import (
+	"context"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/uber-go/tally"
+	"go.uber.org/cadence/.gen/go/cadence/workflowserviceclient"
+	"go.uber.org/cadence/activity"
+	"go.uber.org/cadence/worker"
+	"go.uber.org/cadence/workflow"
+
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+)
+
+type (
+	// BootstrapParams contains the set of params needed to bootstrap
+	// the visibility migration worker
+	BootstrapParams struct {
+		// ServiceClient is an instance of cadence service client
+		ServiceClient workflowserviceclient.Interface
+		// MetricsClient is an instance of metrics object for emitting stats
+		MetricsClient metrics.Client
+		Logger        log.Logger
+		// TallyScope is an instance of tally metrics scope
+		TallyScope tally.Scope
+		// VisibilityManager is the hybrid visibility manager with every configured store,
+		// individual stores are addressed through persistence.ContextKey
+		VisibilityManager persistence.VisibilityManager
+		// DomainCache is used to resolve domain IDs and retention
+		DomainCache cache.DomainCache
+		// DynamicConfigClient is used to flip the per-domain read store on cutover
+		DynamicConfigClient dynamicconfig.Client
+	}
+
+	// Migrator hosts the visibility migration workflow and its activities
+	Migrator struct {
+		svcClient           workflowserviceclient.Interface
+		metricsClient       metrics.Client
+		tallyScope          tally.Scope
+		logger              log.Logger
+		visibilityManager   persistence.VisibilityManager
+		domainCache         cache.DomainCache
+		dynamicConfigClient dynamicconfig.Client
+		worker              worker.Worker
+	}
+)
+
+// New returns a new instance of Migrator
+func New(params *BootstrapParams) *Migrator {
+	return &Migrator{
+		svcClient:           params.ServiceClient,
+		metricsClient:       params.MetricsClient,
+		tallyScope:          params.TallyScope,
+		logger:              params.Logger.WithTags(tag.ComponentVisibilityMigration),
+		visibilityManager:   params.VisibilityManager,
+		domainCache:         params.DomainCache,
+		dynamicConfigClient: params.DynamicConfigClient,
+	}
+}
+
+// Start starts the worker
+func (m *Migrator) Start() error {
+	ctx := context.WithValue(context.Background(), migratorContextKey, m)
+	workerOpts := worker.Options{
+		MetricsScope:              m.tallyScope,
+		BackgroundActivityContext: ctx,
+		Tracer:                    opentracing.GlobalTracer(),
+	}
+	migrationWorker := worker.New(m.svcClient, constants.SystemLocalDomainName, TaskListName, workerOpts)
+	migrationWorker.RegisterWorkflowWithOptions(MigrationWorkflow, workflow.RegisterOptions{Name: WorkflowTypeName})
+	migrationWorker.RegisterActivityWithOptions(BackfillActivity, activity.RegisterOptions{Name: backfillActivityName})
+	migrationWorker.RegisterActivityWithOptions(VerifyActivity, activity.RegisterOptions{Name: verifyActivityName})
+	migrationWorker.RegisterActivityWithOptions(CutoverActivity, activity.RegisterOptions{Name: cutoverActivityName})
+	m.worker = migrationWorker
+	return migrationWorker.Start()
+}
+
+// Stop stops the worker
+func (m *Migrator) Stop() {
+	if m.worker != nil {
+		m.worker.Stop()
+	}
+}
@@ -0,0 +1,412 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package visibilitymigration
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"go.uber.org/cadence"
+	"go.uber.org/cadence/workflow"
+
+	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/types"
+)
+
+type contextKey string
+
+const (
+	migratorContextKey contextKey = "visibilityMigratorContext"
+	// TaskListName tasklist
+	TaskListName = "cadence-sys-visibility-migration-tasklist"
+	// WorkflowTypeName workflow type name
+	WorkflowTypeName = "cadence-sys-visibility-migration-workflow"
+	// WorkflowIDPrefix is combined with the domain name so that only one migration runs per domain
+	WorkflowIDPrefix = "cadence-visibility-migration-"
+
+	backfillActivityName = "cadence-sys-visibility-migration-backfill-activity"
+	verifyActivityName   = "cadence-sys-visibility-migration-verify-activity"
+	cutoverActivityName  = "cadence-sys-visibility-migration-cutover-activity"
+
+	defaultBackfillPageSize           = 1000
+	defaultVerifyPageSize             = 1000
+	defaultMaxVerifyPages             = 100
+	defaultVerifyInterval             = 5 * time.Minute
+	defaultVerifyWindow               = time.Hour
+	defaultVerifyDelay                = time.Minute
+	defaultRequiredConsecutiveMatches = 3
+	defaultMaxVerifyRounds            = 100
+	// maxReportedDiscrepancies caps the executions listed per discrepancy kind in a VerifyResult
+	maxReportedDiscrepancies = 20
+
+	errMsgParamsIsNil               = "params is nil"
+	errMsgDomainIsEmpty             = "domain is empty"
+	errMsgInvalidSourceStore        = "sourceStore is not a valid visibility store"
+	errMsgInvalidTargetStore        = "targetStore is not a valid visibility store"
+	errMsgTargetStoreIsSameAsSource = "targetStore is same as sourceStore"
+	errMsgVerificationDidNotAgree   = "source and target visibility stores did not agree"
+
+	// QueryType for migration workflow
+	QueryType = "state"
+
+	// workflow states for query
+
+	// WorkflowInitialized state
+	WorkflowInitialized = "initialized"
+	// WorkflowBackfilling state
+	WorkflowBackfilling = "backfilling"
+	// WorkflowVerifying state
+	WorkflowVerifying = "verifying"
+	// WorkflowCuttingOver state
+	WorkflowCuttingOver = "cutting-over"
+	// WorkflowVerified state, the stores agree but the read store was left untouched because of DryRun
+	WorkflowVerified = "verified"
+	// WorkflowCompleted state
+	WorkflowCompleted = "complete"
+	// WorkflowFailed state
+	WorkflowFailed = "failed"
+)
+
+type (
+	// MigrationParams is the arg for MigrationWorkflow
+	MigrationParams struct {
+		// Domain is the name of the domain to migrate
+		Domain string
+		// SourceStore is the visibility store the domain currently reads from, e.g. "db" or "es"
+		SourceStore string
+		// TargetStore is the visibility store the domain should read from after cutover
+		TargetStore string
+		// SkipBackfill skips copying closed executions, e.g. when the target store has been dual written
+		// for longer than the domain retention
+		SkipBackfill bool
+		// BackfillEarliestTime and BackfillLatestTime bound the close time, in unix nanos, of backfilled
+		// executions. BackfillLatestTime defaults to the workflow start time.
+		BackfillEarliestTime int64
+		BackfillLatestTime   int64
+		// BackfillPageSize is the number of executions read from the source store per page
+		BackfillPageSize int
+		// VerifyInterval is the wait time between verification rounds
+		VerifyInterval time.Duration
+		// VerifyWindow is the close time window whose executions are compared one by one
+		VerifyWindow time.Duration
+		// VerifyDelay excludes the most recent executions from the compared window so asynchronous
+		// writes to advanced visibility stores have time to land
+		VerifyDelay time.Duration
+		// VerifyPageSize is the number of executions read from each store per page during verification
+		VerifyPageSize int
+		// MaxVerifyPages caps the pages read from each store per round, a round that hits it does not agree
+		MaxVerifyPages int
+		// CountTolerance is the allowed difference between the source and target counts
+		CountTolerance int64
+		// RequiredConsecutiveMatches is the number of agreeing rounds in a row needed before cutover
+		RequiredConsecutiveMatches int
+		// MaxVerifyRounds is the number of rounds after which the workflow gives up
+		MaxVerifyRounds int
+		// DryRun stops the workflow once the stores agree without changing the read store
+		DryRun bool
+	}
+
+	// MigrationResult is workflow result
+	MigrationResult struct {
+		Backfilled       int64
+		BackfillFailed   int64
+		VerifyRounds     int
+		LastVerification *VerifyResult
+		CutoverApplied   bool
+	}
+
+	// BackfillActivityParams params for activity
+	BackfillActivityParams struct {
+		Domain       string
+		SourceStore  string
+		TargetStore  string
+		EarliestTime int64
+		LatestTime   int64
+		PageSize     int
+	}
+
+	// BackfillResult result for backfill activity, also used as its heartbeat details
+	BackfillResult struct {
+		NextPageToken []byte
+		Backfilled    int64
+		Failed        int64
+	}
+
+	// VerifyActivityParams params for activity
+	VerifyActivityParams struct {
+		Domain         string
+		SourceStore    string
+		TargetStore    string
+		WindowStart    int64
+		WindowEnd      int64
+		PageSize       int
+		MaxPages       int
+		CountTolerance int64
+	}
+
+	// VerifyResult is the outcome of one verification round
+	VerifyResult struct {
+		Matched bool
+		// CountSkipped is set when either store does not support CountWorkflowExecutions
+		CountSkipped bool
+		SourceCount  int64
+		TargetCount  int64
+		// WindowStart and WindowEnd are the close time bounds, in unix nanos, of the compared executions
+		WindowStart  int64
+		WindowEnd    int64
+		SourceListed int
+		TargetListed int
+		// Truncated is set when MaxVerifyPages was reached on either store
+		Truncated               bool
+		MissingInTargetCount    int
+		UnexpectedInTargetCount int
+		// MissingInTarget and UnexpectedInTarget list up to maxReportedDiscrepancies executions each
+		MissingInTarget    []types.WorkflowExecution
+		UnexpectedInTarget []types.WorkflowExecution
+	}
+
+	// CutoverActivityParams params for activity
+	CutoverActivityParams struct {
+		Domain      string
+		TargetStore string
+	}
+
+	// QueryResult for migration progress
+	QueryResult struct {
+		State              string
+		Domain             string
+		SourceStore        string
+		TargetStore        string
+		DryRun             bool
+		Backfilled         int64
+		BackfillFailed     int64
+		VerifyRounds       int
+		ConsecutiveMatches int
+		LastVerification   *VerifyResult
+	}
+)
+
+// WorkflowID returns the ID of the migration workflow of a domain
+func WorkflowID(domain string) string {
+	return WorkflowIDPrefix + domain
+}
+
+// MigrationWorkflow backfills closed executions of a domain from the source visibility store into the
+// target store, compares both stores until they agree, then switches the domain to read from the target
+func MigrationWorkflow(ctx workflow.Context, params *MigrationParams) (*MigrationResult, error) {
+	if err := validateParams(params); err != nil {
+		return nil, err
+	}
+
+	wfState := WorkflowInitialized
+	result := &MigrationResult{}
+	consecutiveMatches := 0
+	err := workflow.SetQueryHandler(ctx, QueryType, func(input []byte) (*QueryResult, error) {
+		return &QueryResult{
+			State:              wfState,
+			Domain:             params.Domain,
+			SourceStore:        params.SourceStore,
+			TargetStore:        params.TargetStore,
+			DryRun:             params.DryRun,
+			Backfilled:         result.Backfilled,
+			BackfillFailed:     result.BackfillFailed,
+			VerifyRounds:       result.VerifyRounds,
+			ConsecutiveMatches: consecutiveMatches,
+			LastVerification:   result.LastVerification,
+		}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if !params.SkipBackfill {
+		wfState = WorkflowBackfilling
+		latestTime := params.BackfillLatestTime
+		if latestTime == 0 {
+			latestTime = workflow.Now(ctx).UnixNano()
+		}
+		ao := workflow.WithActivityOptions(ctx, getBackfillActivityOptions())
+		var backfillResult BackfillResult
+		err := workflow.ExecuteActivity(ao, backfillActivityName, &BackfillActivityParams{
+			Domain:       params.Domain,
+			SourceStore:  params.SourceStore,
+			TargetStore:  params.TargetStore,
+			EarliestTime: params.BackfillEarliestTime,
+			LatestTime:   latestTime,
+			PageSize:     params.BackfillPageSize,
+		}).Get(ctx, &backfillResult)
+		if err != nil {
+			wfState = WorkflowFailed
+			return nil, err
+		}
+		result.Backfilled = backfillResult.Backfilled
+		result.BackfillFailed = backfillResult.Failed
+	}
+
+	wfState = WorkflowVerifying
+	ao := workflow.WithActivityOptions(ctx, getVerifyActivityOptions())
+	for result.VerifyRounds < params.MaxVerifyRounds && consecutiveMatches < params.RequiredConsecutiveMatches {
+		if result.VerifyRounds > 0 {
+			workflow.Sleep(ctx, params.VerifyInterval)
+		}
+		windowEnd := workflow.Now(ctx).Add(-params.VerifyDelay)
+		var verifyResult VerifyResult
+		err := workflow.ExecuteActivity(ao, verifyActivityName, &VerifyActivityParams{
+			Domain:         params.Domain,
+			SourceStore:    params.SourceStore,
+			TargetStore:    params.TargetStore,
+			WindowStart:    windowEnd.Add(-params.VerifyWindow).UnixNano(),
+			WindowEnd:      windowEnd.UnixNano(),
+			PageSize:       params.VerifyPageSize,
+			MaxPages:       params.MaxVerifyPages,
+			CountTolerance: params.CountTolerance,
+		}).Get(ctx, &verifyResult)
+		if err != nil {
+			wfState = WorkflowFailed
+			return nil, err
+		}
+		result.VerifyRounds++
+		result.LastVerification = &verifyResult
+		if verifyResult.Matched {
+			consecutiveMatches++
+		} else {
+			consecutiveMatches = 0
+		}
+	}
+	if consecutiveMatches < params.RequiredConsecutiveMatches {
+		wfState = WorkflowFailed
+		return nil, fmt.Errorf("%s after %d verification rounds", errMsgVerificationDidNotAgree, result.VerifyRounds)
+	}
+
+	if params.DryRun {
+		wfState = WorkflowVerified
+		return result, nil
+	}
+
+	wfState = WorkflowCuttingOver
+	ao = workflow.WithActivityOptions(ctx, getCutoverActivityOptions())
+	err = workflow.ExecuteActivity(ao, cutoverActivityName, &CutoverActivityParams{
+		Domain:      params.Domain,
+		TargetStore: params.TargetStore,
+	}).Get(ctx, nil)
+	if err != nil {
+		wfState = WorkflowFailed
+		return nil, err
+	}
+	result.CutoverApplied = true
+	wfState = WorkflowCompleted
+	return result, nil
+}
+
+func validateParams(params *MigrationParams) error {
+	if params == nil {
+		return errors.New(errMsgParamsIsNil)
+	}
+	if len(params.Domain) == 0 {
+		return errors.New(errMsgDomainIsEmpty)
+	}
+	if !isValidStore(params.SourceStore) {
+		return errors.New(errMsgInvalidSourceStore)
+	}
+	if !isValidStore(params.TargetStore) {
+		return errors.New(errMsgInvalidTargetStore)
+	}
+	if params.SourceStore == params.TargetStore {
+		return errors.New(errMsgTargetStoreIsSameAsSource)
+	}
+	if params.BackfillPageSize <= 0 {
+		params.BackfillPageSize = defaultBackfillPageSize
+	}
+	if params.VerifyPageSize <= 0 {
+		params.VerifyPageSize = defaultVerifyPageSize
+	}
+	if params.MaxVerifyPages <= 0 {
+		params.MaxVerifyPages = defaultMaxVerifyPages
+	}
+	if params.VerifyInterval <= 0 {
+		params.VerifyInterval = defaultVerifyInterval
+	}
+	if params.VerifyWindow <= 0 {
+		params.VerifyWindow = defaultVerifyWindow
+	}
+	if params.VerifyDelay <= 0 {
+		params.VerifyDelay = defaultVerifyDelay
+	}
+	if params.RequiredConsecutiveMatches <= 0 {
+		params.RequiredConsecutiveMatches = defaultRequiredConsecutiveMatches
+	}
+	if params.MaxVerifyRounds <= 0 {
+		params.MaxVerifyRounds = defaultMaxVerifyRounds
+	}
+	if params.MaxVerifyRounds < params.RequiredConsecutiveMatches {
+		params.MaxVerifyRounds = params.RequiredConsecutiveMatches
+	}
+	return nil
+}
+
+func isValidStore(store string) bool {
+	switch store {
+	case constants.VisibilityModeDB, constants.VisibilityModeES, constants.VisibilityModePinot, constants.VisibilityModeOS:
+		return true
+	}
+	return false
+}
+
+func getBackfillActivityOptions() workflow.ActivityOptions {
+	return workflow.ActivityOptions{
+		ScheduleToStartTimeout: time.Minute,
+		StartToCloseTimeout:    24 * time.Hour,
+		HeartbeatTimeout:       5 * time.Minute,
+		RetryPolicy: &cadence.RetryPolicy{
+			InitialInterval:    10 * time.Second,
+			BackoffCoefficient: 2,
+			MaximumInterval:    10 * time.Minute,
+			ExpirationInterval: 72 * time.Hour,
+		},
+	}
+}
+
+func getVerifyActivityOptions() workflow.ActivityOptions {
+	return workflow.ActivityOptions{
+		ScheduleToStartTimeout: time.Minute,
+		StartToCloseTimeout:    10 * time.Minute,
+		RetryPolicy: &cadence.RetryPolicy{
+			InitialInterval:    10 * time.Second,
+			BackoffCoefficient: 2,
+			MaximumInterval:    time.Minute,
+			ExpirationInterval: 30 * time.Minute,
+		},
+	}
+}
+
+func getCutoverActivityOptions() workflow.ActivityOptions {
+	return workflow.ActivityOptions{
+		ScheduleToStartTimeout: time.Minute,
+		StartToCloseTimeout:    time.Minute,
+		RetryPolicy: &cadence.RetryPolicy{
+			InitialInterval:    2 * time.Second,
+			BackoffCoefficient: 2,
+			MaximumInterval:    time.Minute,
+			ExpirationInterval: 10 * time.Minute,
+		},
+	}
+}
@@ -0,0 +1,170 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package visibilitymigration
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/cadence/activity"
+	"go.uber.org/cadence/testsuite"
+	"go.uber.org/cadence/workflow"
+)
+
+type migrationWorkflowTestSuite struct {
+	suite.Suite
+	testsuite.WorkflowTestSuite
+	workflowEnv *testsuite.TestWorkflowEnvironment
+}
+
+func TestMigrationWorkflowTestSuite(t *testing.T) {
+	suite.Run(t, new(migrationWorkflowTestSuite))
+}
+
+func (s *migrationWorkflowTestSuite) SetupTest() {
+	s.workflowEnv = s.NewTestWorkflowEnvironment()
+	s.workflowEnv.RegisterWorkflowWithOptions(MigrationWorkflow, workflow.RegisterOptions{Name: WorkflowTypeName})
+	s.workflowEnv.RegisterActivityWithOptions(BackfillActivity, activity.RegisterOptions{Name: backfillActivityName})
+	s.workflowEnv.RegisterActivityWithOptions(VerifyActivity, activity.RegisterOptions{Name: verifyActivityName})
+	s.workflowEnv.RegisterActivityWithOptions(CutoverActivity, activity.RegisterOptions{Name: cutoverActivityName})
+}
+
+func (s *migrationWorkflowTestSuite) TearDownTest() {
+	s.workflowEnv.AssertExpectations(s.T())
+}
+
+func (s *migrationWorkflowTestSuite) TestValidateParams() {
+	s.Error(validateParams(nil))
+	params := &MigrationParams{}
+	s.Error(validateParams(params))
+	params.Domain = "d"
+	s.Error(validateParams(params))
+	params.SourceStore = "db"
+	s.Error(validateParams(params))
+	params.TargetStore = "db"
+	s.Error(validateParams(params))
+	params.TargetStore = "unknown"
+	s.Error(validateParams(params))
+	params.TargetStore = "es"
+	s.NoError(validateParams(params))
+	s.Equal(defaultRequiredConsecutiveMatches, params.RequiredConsecutiveMatches)
+	s.Equal(defaultMaxVerifyRounds, params.MaxVerifyRounds)
+	s.Equal(defaultVerifyWindow, params.VerifyWindow)
+}
+
+func (s *migrationWorkflowTestSuite) TestWorkflow_InvalidParams() {
+	s.workflowEnv.ExecuteWorkflow(WorkflowTypeName, &MigrationParams{})
+	s.True(s.workflowEnv.IsWorkflowCompleted())
+	s.Error(s.workflowEnv.GetWorkflowError())
+}
+
+func (s *migrationWorkflowTestSuite) TestWorkflow_Success() {
+	s.workflowEnv.OnActivity(backfillActivityName, mock.Anything, mock.Anything).
+		Return(&BackfillResult{Backfilled: 10, Failed: 1}, nil).Once()
+	s.workflowEnv.OnActivity(verifyActivityName, mock.Anything, mock.Anything).
+		Return(&VerifyResult{Matched: false, MissingInTargetCount: 1}, nil).Once()
+	s.workflowEnv.OnActivity(verifyActivityName, mock.Anything, mock.Anything).
+		Return(&VerifyResult{Matched: true}, nil).Times(2)
+	s.workflowEnv.OnActivity(cutoverActivityName, mock.Anything, &CutoverActivityParams{Domain: "d", TargetStore: "es"}).
+		Return(nil).Once()
+
+	s.workflowEnv.ExecuteWorkflow(WorkflowTypeName, &MigrationParams{
+		Domain:                     "d",
+		SourceStore:                "db",
+		TargetStore:                "es",
+		RequiredConsecutiveMatches: 2,
+	})
+	var result MigrationResult
+	s.NoError(s.workflowEnv.GetWorkflowResult(&result))
+	s.Equal(int64(10), result.Backfilled)
+	s.Equal(int64(1), result.BackfillFailed)
+	s.Equal(3, result.VerifyRounds)
+	s.True(result.CutoverApplied)
+
+	queryResult, err := s.workflowEnv.QueryWorkflow(QueryType)
+	s.NoError(err)
+	var res QueryResult
+	s.NoError(queryResult.Get(&res))
+	s.Equal(WorkflowCompleted, res.State)
+	s.Equal(2, res.ConsecutiveMatches)
+	s.True(res.LastVerification.Matched)
+}
+
+func (s *migrationWorkflowTestSuite) TestWorkflow_DryRunSkipsCutover() {
+	s.workflowEnv.OnActivity(verifyActivityName, mock.Anything, mock.Anything).
+		Return(&VerifyResult{Matched: true}, nil).Once()
+
+	s.workflowEnv.ExecuteWorkflow(WorkflowTypeName, &MigrationParams{
+		Domain:                     "d",
+		SourceStore:                "es",
+		TargetStore:                "pinot",
+		SkipBackfill:               true,
+		RequiredConsecutiveMatches: 1,
+		DryRun:                     true,
+	})
+	var result MigrationResult
+	s.NoError(s.workflowEnv.GetWorkflowResult(&result))
+	s.False(result.CutoverApplied)
+
+	queryResult, err := s.workflowEnv.QueryWorkflow(QueryType)
+	s.NoError(err)
+	var res QueryResult
+	s.NoError(queryResult.Get(&res))
+	s.Equal(WorkflowVerified, res.State)
+}
+
+func (s *migrationWorkflowTestSuite) TestWorkflow_VerificationNeverAgrees() {
+	s.workflowEnv.OnActivity(verifyActivityName, mock.Anything, mock.Anything).
+		Return(&VerifyResult{Matched: false, UnexpectedInTargetCount: 3}, nil).Times(2)
+
+	s.workflowEnv.ExecuteWorkflow(WorkflowTypeName, &MigrationParams{
+		Domain:                     "d",
+		SourceStore:                "es",
+		TargetStore:                "pinot",
+		SkipBackfill:               true,
+		RequiredConsecutiveMatches: 1,
+		MaxVerifyRounds:            2,
+	})
+	s.True(s.workflowEnv.IsWorkflowCompleted())
+	s.ErrorContains(s.workflowEnv.GetWorkflowError(), errMsgVerificationDidNotAgree)
+
+	queryResult, err := s.workflowEnv.QueryWorkflow(QueryType)
+	s.NoError(err)
+	var res QueryResult
+	s.NoError(queryResult.Get(&res))
+	s.Equal(WorkflowFailed, res.State)
+	s.Equal(3, res.LastVerification.UnexpectedInTargetCount)
+}
+
+func (s *migrationWorkflowTestSuite) TestWorkflow_BackfillError() {
+	s.workflowEnv.OnActivity(backfillActivityName, mock.Anything, mock.Anything).
+		Return(nil, errors.New("mockErr"))
+
+	s.workflowEnv.ExecuteWorkflow(WorkflowTypeName, &MigrationParams{
+		Domain:      "d",
+		SourceStore: "db",
+		TargetStore: "es",
+	})
+	s.True(s.workflowEnv.IsWorkflowCompleted())
+	s.Error(s.workflowEnv.GetWorkflowError())
+}
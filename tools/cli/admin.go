@@ -106,6 +106,53 @@ func newAdminWorkflowCommands() []*cli.Command {
 			},
 			Action: AdminRefreshWorkflowTasks,
 		},
+		{
+			Name:    "upsert-search-attr",
+			Aliases: []string{"usa"},
+			Usage:   "Upserts search attributes and memo of a workflow without a decision, e.g. to tag workflows during an incident",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:    FlagWorkflowID,
+					Aliases: []string{"w", "wid"},
+					Usage:   "WorkflowID",
+				},
+				&cli.StringFlag{
+					Name:    FlagRunID,
+					Aliases: []string{"r", "rid"},
+					Usage:   "RunID",
+				},
+				&cli.StringFlag{
+					Name: FlagSearchAttributesKey,
+					Usage: "Search attributes keys to upsert. If there are multiple keys, concatenate them and separate by |. " +
+						"Use 'cluster get-search-attr' cmd to list legal keys.",
+				},
+				&cli.StringFlag{
+					Name: FlagSearchAttributesVal,
+					Usage: "Search attributes values to upsert. If there are multiple values, concatenate them and separate by |. " +
+						"If value is array, use json array like [\"a\",\"b\"], [1,2], [\"true\",\"false\"].",
+				},
+				&cli.StringFlag{
+					Name:  FlagMemoKey,
+					Usage: "Memo keys to upsert. If there are multiple keys, concatenate them and separate by space",
+				},
+				&cli.StringFlag{
+					Name: FlagMemo,
+					Usage: "Memo values to upsert, in JSON format. If there are multiple JSON, concatenate them and separate by space. " +
+						"The order must be same as memo_key",
+				},
+				&cli.StringFlag{
+					Name: FlagMemoFile,
+					Usage: "Memo values to upsert, from JSON format file. If there are multiple JSON, concatenate them and separate by space or newline. " +
+						"The order must be same as memo_key",
+				},
+				&cli.StringFlag{
+					Name:    FlagReason,
+					Aliases: []string{"re"},
+					Usage:   "The reason you want to upsert the search attributes and memo",
+				},
+			},
+			Action: AdminUpsertWorkflowSearchAttributes,
+		},
 		{
 			Name:    "delete",
 			Aliases: []string{"del"},
@@ -925,6 +972,98 @@ func newAdminAsyncQueueCommands() []*cli.Command {
 	}
 }
 
+func newAdminVisibilityMigrationCommands() []*cli.Command {
+	return []*cli.Command{
+		{
+			Name:  "start",
+			Usage: "Start migrating a domain to another visibility store: backfill, verify, then switch the read store",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     FlagSourceStore,
+					Usage:    "Visibility store the domain currently reads from: db, es, pinot or os",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:     FlagTargetStore,
+					Usage:    "Visibility store the domain should read from after the migration: db, es, pinot or os",
+					Required: true,
+				},
+				&cli.BoolFlag{
+					Name:  FlagSkipBackfill,
+					Usage: "Skip copying closed executions, e.g. when the target store has been dual written for longer than the retention",
+				},
+				&cli.StringFlag{
+					Name: FlagEarliestTime,
+					Usage: "Optional earliest close time of backfilled executions, supported formats are '2006-01-02T15:04:05+07:00' " +
+						"and raw UnixNano",
+				},
+				&cli.StringFlag{
+					Name: FlagLatestTime,
+					Usage: "Optional latest close time of backfilled executions, supported formats are '2006-01-02T15:04:05+07:00' " +
+						"and raw UnixNano. Defaults to the workflow start time",
+				},
+				&cli.DurationFlag{
+					Name:  FlagVerifyInterval,
+					Usage: "Optional wait time between verification rounds, defaults to 5m",
+				},
+				&cli.DurationFlag{
+					Name:  FlagVerifyWindow,
+					Usage: "Optional close time window whose executions are compared one by one in each round, defaults to 1h",
+				},
+				&cli.IntFlag{
+					Name:  FlagRequiredMatches,
+					Usage: "Number of agreeing verification rounds in a row required before switching the read store",
+					Value: defaultVisibilityMigrationRequiredConsecutive,
+				},
+				&cli.Int64Flag{
+					Name:  FlagCountTolerance,
+					Usage: "Optional allowed difference between the execution counts of both stores",
+				},
+				&cli.BoolFlag{
+					Name:  FlagDryRun,
+					Usage: "Stop once the stores agree without switching the read store",
+				},
+				&cli.IntFlag{
+					Name:  FlagExecutionTimeout,
+					Usage: "Optional migration workflow timeout in seconds",
+					Value: defaultVisibilityMigrationTimeoutInSeconds,
+				},
+			},
+			Action: AdminStartVisibilityMigration,
+		},
+		{
+			Name:    "query",
+			Aliases: []string{"q"},
+			Usage:   "Show the progress and the last verification result of a visibility migration",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:    FlagRunID,
+					Aliases: []string{"rid", "r"},
+					Usage:   "Optional migration workflow runID, default is the latest runID",
+				},
+			},
+			Action: AdminQueryVisibilityMigration,
+		},
+		{
+			Name:  "abort",
+			Usage: "Abort a visibility migration, the read store of the domain is left unchanged",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:    FlagReason,
+					Aliases: []string{"re"},
+					Usage:   "Optional reason why abort",
+				},
+				&cli.StringFlag{
+					Name:    FlagRunID,
+					Aliases: []string{"rid", "r"},
+					Usage:   "Optional migration workflow runID, default is the latest runID",
+				},
+			},
+			Action: AdminAbortVisibilityMigration,
+		},
+	}
+}
+
 func newDBCommands() []*cli.Command {
 	var collections cli.StringSlice = *cli.NewStringSlice(invariant.CollectionStrings()...)
 
@@ -1031,6 +1170,75 @@ func newDBCommands() []*cli.Command {
 			},
 			Action: AdminDBDataDecodeThrift,
 		},
+		{
+			Name:  "reencrypt-history",
+			Usage: "re-encrypt the history payloads of a closed workflow with the given key, so that the previous keys can be removed from the keyring",
+			Flags: append(getDBFlags(),
+				&cli.IntFlag{
+					Name:     FlagNumberOfShards,
+					Usage:    "NumberOfShards for the cadence cluster (see config for numHistoryShards)",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:     FlagWorkflowID,
+					Aliases:  []string{"w", "wid"},
+					Usage:    "WorkflowID",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:     FlagRunID,
+					Aliases:  []string{"r", "rid"},
+					Usage:    "RunID",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:     FlagPayloadKeyringFile,
+					Usage:    "Path to the payload encryption keyring file, it must contain the keys the history is currently encrypted with",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:  FlagPayloadKeyID,
+					Usage: "ID of the key to encrypt the history with, the active key of the keyring is used when empty",
+				},
+			),
+			Action: AdminDBReencryptHistory,
+		},
+	}
+}
+
+func newAdminArchivalCommands() []*cli.Command {
+	return []*cli.Command{
+		{
+			Name:    "reencode-history",
+			Aliases: []string{"reh"},
+			Usage:   "Re-encode in place the histories archived under an archival URI, only file and s3 URIs are supported",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     FlagHistoryArchivalURI,
+					Aliases:  []string{"huri"},
+					Usage:    "History archival URI, e.g. file:///tmp/cadence_archival/development or s3://bucket/path",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:     FlagArchiveCompression,
+					Usage:    "Compression of the re-encoded histories: none, snappy or zstd",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:  FlagS3Region,
+					Usage: "AWS region of the bucket, required for s3 URIs",
+				},
+				&cli.StringFlag{
+					Name:  FlagS3Endpoint,
+					Usage: "Optional endpoint of an S3 compatible store",
+				},
+				&cli.BoolFlag{
+					Name:  FlagS3ForcePathStyle,
+					Usage: "Use path style addressing for the S3 compatible store",
+				},
+			},
+			Action: AdminReencodeHistoryArchive,
+		},
 	}
 }
 
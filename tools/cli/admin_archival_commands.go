@@ -0,0 +1,77 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"fmt"
+
+	"github.com/urfave/cli/v2"
+
+	"github.com/uber/cadence/common/archiver"
+	"github.com/uber/cadence/common/archiver/filestore"
+	"github.com/uber/cadence/common/archiver/s3store"
+	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/tools/common/commoncli"
+)
+
+// AdminReencodeHistoryArchive re-encodes in place the histories archived under an archival URI
+func AdminReencodeHistoryArchive(c *cli.Context) error {
+	uri, err := getRequiredOption(c, FlagHistoryArchivalURI)
+	if err != nil {
+		return commoncli.Problem("Required flag not present:", err)
+	}
+	URI, err := archiver.NewURI(uri)
+	if err != nil {
+		return commoncli.Problem("Invalid archival URI", err)
+	}
+	// the legacy format has no checksum, histories are never re-encoded to it
+	compression, err := archiver.ParseArchiveCompression(c.String(FlagArchiveCompression))
+	if err == nil && compression == archiver.ArchiveCompressionLegacy {
+		err = archiver.ErrInvalidArchiveCompression
+	}
+	if err != nil {
+		return commoncli.Problem("Invalid compression", err)
+	}
+
+	var count int
+	switch URI.Scheme() {
+	case filestore.URIScheme:
+		count, err = filestore.ReencodeHistory(URI, compression)
+	case s3store.URIScheme, s3store.URISchemeAccessPoint:
+		s3Config := &config.S3Archiver{
+			Region:           c.String(FlagS3Region),
+			S3ForcePathStyle: c.Bool(FlagS3ForcePathStyle),
+		}
+		if c.IsSet(FlagS3Endpoint) {
+			endpoint := c.String(FlagS3Endpoint)
+			s3Config.Endpoint = &endpoint
+		}
+		count, err = s3store.ReencodeHistory(c.Context, URI, s3Config, compression)
+	default:
+		return commoncli.Problem(fmt.Sprintf("Unsupported archival URI scheme %v", URI.Scheme()), nil)
+	}
+	if err != nil {
+		return commoncli.Problem(fmt.Sprintf("Failed to re-encode history archive, %d histories were re-encoded before the failure", count), err)
+	}
+
+	fmt.Fprintf(getDeps(c).Output(), "Re-encoded %d histories\n", count)
+	return nil
+}
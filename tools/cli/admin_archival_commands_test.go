@@ -0,0 +1,96 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common/archiver"
+	"github.com/uber/cadence/tools/cli/clitest"
+)
+
+func TestAdminReencodeHistoryArchive(t *testing.T) {
+	dir := t.TempDir()
+	historyFile := filepath.Join(dir, "123_1.history")
+	data := []byte(`[{"events":[{"eventId":1}]}]`)
+	require.NoError(t, os.WriteFile(historyFile, data, 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "123_1.visibility"), []byte(`{}`), 0644))
+
+	t.Run("success", func(t *testing.T) {
+		td := newCLITestData(t)
+		cliCtx := clitest.NewCLIContext(t, td.app,
+			clitest.StringArgument(FlagHistoryArchivalURI, "file://"+dir),
+			clitest.StringArgument(FlagArchiveCompression, "zstd"),
+		)
+		require.NoError(t, AdminReencodeHistoryArchive(cliCtx))
+		assert.Equal(t, "Re-encoded 1 histories\n", td.consoleOutput())
+
+		blob, err := os.ReadFile(historyFile)
+		require.NoError(t, err)
+		assert.Equal(t, archiver.ArchiveCompressionZstd, archiver.GetArchiveBlobCompression(blob))
+		decoded, err := archiver.DecodeArchiveBlob(blob)
+		require.NoError(t, err)
+		assert.Equal(t, data, decoded)
+
+		visibility, err := os.ReadFile(filepath.Join(dir, "123_1.visibility"))
+		require.NoError(t, err)
+		assert.Equal(t, []byte(`{}`), visibility)
+	})
+
+	t.Run("missing uri", func(t *testing.T) {
+		td := newCLITestData(t)
+		cliCtx := clitest.NewCLIContext(t, td.app,
+			clitest.StringArgument(FlagArchiveCompression, "zstd"),
+		)
+		assert.Error(t, AdminReencodeHistoryArchive(cliCtx))
+	})
+
+	t.Run("invalid compression", func(t *testing.T) {
+		td := newCLITestData(t)
+		cliCtx := clitest.NewCLIContext(t, td.app,
+			clitest.StringArgument(FlagHistoryArchivalURI, "file://"+dir),
+			clitest.StringArgument(FlagArchiveCompression, "gzip"),
+		)
+		assert.ErrorContains(t, AdminReencodeHistoryArchive(cliCtx), "Invalid compression")
+	})
+
+	t.Run("missing compression", func(t *testing.T) {
+		td := newCLITestData(t)
+		cliCtx := clitest.NewCLIContext(t, td.app,
+			clitest.StringArgument(FlagHistoryArchivalURI, "file://"+dir),
+		)
+		assert.ErrorContains(t, AdminReencodeHistoryArchive(cliCtx), "Invalid compression")
+	})
+
+	t.Run("unsupported scheme", func(t *testing.T) {
+		td := newCLITestData(t)
+		cliCtx := clitest.NewCLIContext(t, td.app,
+			clitest.StringArgument(FlagHistoryArchivalURI, "gs://bucket/path"),
+			clitest.StringArgument(FlagArchiveCompression, "zstd"),
+		)
+		assert.ErrorContains(t, AdminReencodeHistoryArchive(cliCtx), "Unsupported archival URI scheme gs")
+	})
+}
@@ -714,6 +714,62 @@ func AdminRefreshWorkflowTasks(c *cli.Context) error {
 	return nil
 }
 
+// AdminUpsertWorkflowSearchAttributes upserts search attributes and memo of a workflow without involving its decisions
+func AdminUpsertWorkflowSearchAttributes(c *cli.Context) error {
+	adminClient, err := getDeps(c).ServerAdminClient(c)
+	if err != nil {
+		return err
+	}
+
+	domain, err := getRequiredOption(c, FlagDomain)
+	if err != nil {
+		return commoncli.Problem("Required flag not found", err)
+	}
+	wid, err := getRequiredOption(c, FlagWorkflowID)
+	if err != nil {
+		return commoncli.Problem("Required flag not found", err)
+	}
+	rid := c.String(FlagRunID)
+	searchAttributes, err := processSearchAttr(c)
+	if err != nil {
+		return commoncli.Problem("Error processing search attributes", err)
+	}
+	memo, err := processMemo(c)
+	if err != nil {
+		return commoncli.Problem("Error processing memo", err)
+	}
+	if len(searchAttributes) == 0 && len(memo) == 0 {
+		return commoncli.Problem("At least one search attribute or memo is required", nil)
+	}
+	request := &types.AdminUpsertWorkflowSearchAttributesRequest{
+		Domain: domain,
+		Execution: &types.WorkflowExecution{
+			WorkflowID: wid,
+			RunID:      rid,
+		},
+		Reason:   c.String(FlagReason),
+		Identity: getCliIdentity(),
+	}
+	if len(searchAttributes) != 0 {
+		request.SearchAttributes = &types.SearchAttributes{IndexedFields: searchAttributes}
+	}
+	if len(memo) != 0 {
+		request.Memo = &types.Memo{Fields: memo}
+	}
+
+	ctx, cancel, err := newContext(c)
+	defer cancel()
+	if err != nil {
+		return commoncli.Problem("Error in creating context: ", err)
+	}
+	err = adminClient.UpsertWorkflowSearchAttributes(ctx, request)
+	if err != nil {
+		return commoncli.Problem("Upsert workflow search attributes failed", err)
+	}
+	fmt.Fprintln(getDeps(c).Output(), "Upsert workflow search attributes succeeded.")
+	return nil
+}
+
 // AdminResetQueue resets task processing queue states
 func AdminResetQueue(c *cli.Context) error {
 	adminClient, err := getDeps(c).ServerAdminClient(c)
@@ -373,6 +373,164 @@ func TestAdminRefreshWorkflowTasks(t *testing.T) {
 	}
 }
 
+func TestAdminUpsertWorkflowSearchAttributes(t *testing.T) {
+	tests := []struct {
+		name           string
+		testSetup      func(td *cliTestData) *cli.Context
+		errContains    string // empty if no error is expected
+		expectedOutput string
+	}{
+		{
+			name: "missing workflowID argument",
+			testSetup: func(td *cliTestData) *cli.Context {
+				return clitest.NewCLIContext(
+					t,
+					td.app,
+					clitest.StringArgument(FlagDomain, testDomain),
+					/* no workflowID argument */
+				)
+			},
+			errContains: "Required flag not found",
+		},
+		{
+			name: "no search attributes or memo",
+			testSetup: func(td *cliTestData) *cli.Context {
+				return clitest.NewCLIContext(
+					t,
+					td.app,
+					clitest.StringArgument(FlagDomain, testDomain),
+					clitest.StringArgument(FlagWorkflowID, testWorkflowID),
+				)
+			},
+			errContains: "At least one search attribute or memo is required",
+		},
+		{
+			name: "number of keys and values are not equal",
+			testSetup: func(td *cliTestData) *cli.Context {
+				return clitest.NewCLIContext(
+					t,
+					td.app,
+					clitest.StringArgument(FlagDomain, testDomain),
+					clitest.StringArgument(FlagWorkflowID, testWorkflowID),
+					clitest.StringArgument(FlagSearchAttributesKey, "CustomKeywordField|CustomIntField"),
+					clitest.StringArgument(FlagSearchAttributesVal, "incident-1"),
+				)
+			},
+			errContains: "Error processing search attributes",
+		},
+		{
+			name: "number of memo keys and values are not equal",
+			testSetup: func(td *cliTestData) *cli.Context {
+				return clitest.NewCLIContext(
+					t,
+					td.app,
+					clitest.StringArgument(FlagDomain, testDomain),
+					clitest.StringArgument(FlagWorkflowID, testWorkflowID),
+					clitest.StringArgument(FlagMemoKey, "owner team"),
+					clitest.StringArgument(FlagMemo, `"oncall"`),
+				)
+			},
+			errContains: "Error processing memo",
+		},
+		{
+			name: "memo only",
+			testSetup: func(td *cliTestData) *cli.Context {
+				cliCtx := clitest.NewCLIContext(
+					t,
+					td.app,
+					clitest.StringArgument(FlagDomain, testDomain),
+					clitest.StringArgument(FlagWorkflowID, testWorkflowID),
+					clitest.StringArgument(FlagRunID, testRunID),
+					clitest.StringArgument(FlagMemoKey, "owner"),
+					clitest.StringArgument(FlagMemo, `"oncall"`),
+				)
+
+				td.mockAdminClient.EXPECT().UpsertWorkflowSearchAttributes(gomock.Any(), &types.AdminUpsertWorkflowSearchAttributesRequest{
+					Domain: testDomain,
+					Execution: &types.WorkflowExecution{
+						WorkflowID: testWorkflowID,
+						RunID:      testRunID,
+					},
+					Memo:     &types.Memo{Fields: map[string][]byte{"owner": []byte(`"oncall"`)}},
+					Identity: getCliIdentity(),
+				}).Return(nil)
+
+				return cliCtx
+			},
+			expectedOutput: "Upsert workflow search attributes succeeded.\n",
+		},
+		{
+			name: "all arguments provided",
+			testSetup: func(td *cliTestData) *cli.Context {
+				cliCtx := clitest.NewCLIContext(
+					t,
+					td.app,
+					clitest.StringArgument(FlagDomain, testDomain),
+					clitest.StringArgument(FlagWorkflowID, testWorkflowID),
+					clitest.StringArgument(FlagRunID, testRunID),
+					clitest.StringArgument(FlagSearchAttributesKey, "CustomKeywordField|CustomIntField"),
+					clitest.StringArgument(FlagSearchAttributesVal, "incident-1|5"),
+					clitest.StringArgument(FlagReason, "incident"),
+				)
+
+				td.mockAdminClient.EXPECT().UpsertWorkflowSearchAttributes(gomock.Any(), &types.AdminUpsertWorkflowSearchAttributesRequest{
+					Domain: testDomain,
+					Execution: &types.WorkflowExecution{
+						WorkflowID: testWorkflowID,
+						RunID:      testRunID,
+					},
+					SearchAttributes: &types.SearchAttributes{
+						IndexedFields: map[string][]byte{
+							"CustomKeywordField": []byte(`"incident-1"`),
+							"CustomIntField":     []byte(`5`),
+						},
+					},
+					Reason:   "incident",
+					Identity: getCliIdentity(),
+				}).Return(nil)
+
+				return cliCtx
+			},
+			errContains:    "",
+			expectedOutput: "Upsert workflow search attributes succeeded.\n",
+		},
+		{
+			name: "UpsertWorkflowSearchAttributes returns an error",
+			testSetup: func(td *cliTestData) *cli.Context {
+				cliCtx := clitest.NewCLIContext(
+					t,
+					td.app,
+					clitest.StringArgument(FlagDomain, testDomain),
+					clitest.StringArgument(FlagWorkflowID, testWorkflowID),
+					clitest.StringArgument(FlagSearchAttributesKey, "CustomKeywordField"),
+					clitest.StringArgument(FlagSearchAttributesVal, "incident-1"),
+				)
+
+				td.mockAdminClient.EXPECT().UpsertWorkflowSearchAttributes(gomock.Any(), gomock.Any()).
+					Return(errors.New("critical error"))
+
+				return cliCtx
+			},
+			errContains: "Upsert workflow search attributes failed",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			td := newCLITestData(t)
+			cliCtx := tt.testSetup(td)
+
+			err := AdminUpsertWorkflowSearchAttributes(cliCtx)
+			if tt.errContains == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.errContains)
+			}
+			assert.Equal(t, tt.expectedOutput, td.consoleOutput())
+		})
+	}
+}
+
 func TestAdminDescribeHistoryHost(t *testing.T) {
 	tests := []struct {
 		name           string
@@ -0,0 +1,119 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"fmt"
+
+	"github.com/urfave/cli/v2"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/tools/common/commoncli"
+)
+
+// AdminDBReencryptHistory rewrites the history of a closed workflow so that its payloads are encrypted with the given key,
+// which allows removing the previous key from the keyring afterwards
+func AdminDBReencryptHistory(c *cli.Context) error {
+	domainName, err := getRequiredOption(c, FlagDomain)
+	if err != nil {
+		return commoncli.Problem("Required flag not found", err)
+	}
+	wid, err := getRequiredOption(c, FlagWorkflowID)
+	if err != nil {
+		return commoncli.Problem("Required flag not found", err)
+	}
+	rid, err := getRequiredOption(c, FlagRunID)
+	if err != nil {
+		return commoncli.Problem("Required flag not found", err)
+	}
+	numberOfShards, err := getRequiredIntOption(c, FlagNumberOfShards)
+	if err != nil {
+		return commoncli.Problem("Required flag not found", err)
+	}
+
+	ctx, cancel, err := newContext(c)
+	defer cancel()
+	if err != nil {
+		return commoncli.Problem("Error in creating context: ", err)
+	}
+
+	domainManager, err := getDeps(c).initializeDomainManager(c)
+	if err != nil {
+		return commoncli.Problem("Unable to initialize domain manager", err)
+	}
+	defer domainManager.Close()
+	domain, err := domainManager.GetDomain(ctx, &persistence.GetDomainRequest{Name: domainName})
+	if err != nil {
+		return commoncli.Problem("GetDomain error", err)
+	}
+
+	shardID := common.WorkflowIDToHistoryShard(wid, numberOfShards)
+	execManager, err := getDeps(c).initializeExecutionManager(c)
+	if err != nil {
+		return commoncli.Problem("Unable to initialize execution manager", err)
+	}
+	defer execManager.Close()
+	resp, err := execManager.GetWorkflowExecution(ctx, &persistence.GetWorkflowExecutionRequest{
+		ShardID:    common.IntPtr(shardID),
+		DomainID:   domain.Info.ID,
+		Execution:  types.WorkflowExecution{WorkflowID: wid, RunID: rid},
+		DomainName: domainName,
+	})
+	if err != nil {
+		return commoncli.Problem("GetWorkflowExecution error", err)
+	}
+	// a running workflow may append to its history concurrently, which is not safe to combine with rewriting it
+	if resp.State.ExecutionInfo.State != persistence.WorkflowStateCompleted {
+		return commoncli.Problem("Only the history of closed workflows can be re-encrypted", nil)
+	}
+
+	branchTokens := [][]byte{resp.State.ExecutionInfo.BranchToken}
+	if resp.State.VersionHistories != nil {
+		// if VersionHistories is set, then all branch infos are stored in VersionHistories
+		branchTokens = [][]byte{}
+		for _, versionHistory := range resp.State.VersionHistories.Histories {
+			branchTokens = append(branchTokens, versionHistory.BranchToken)
+		}
+	}
+
+	historyManager, err := getDeps(c).initializeHistoryManager(c)
+	if err != nil {
+		return commoncli.Problem("Unable to initialize history manager", err)
+	}
+	defer historyManager.Close()
+	reencoded := 0
+	for _, branchToken := range branchTokens {
+		branchResp, err := historyManager.ReencodeHistoryBranch(ctx, &persistence.ReencodeHistoryBranchRequest{
+			BranchToken: branchToken,
+			ShardID:     common.IntPtr(shardID),
+			DomainName:  domainName,
+		})
+		if err != nil {
+			return commoncli.Problem("ReencodeHistoryBranch error", err)
+		}
+		reencoded += branchResp.ReencodedNodeCount
+	}
+
+	fmt.Fprintf(getDeps(c).Output(), "Re-encrypted %d history batches of workflowID: %v, runID: %v\n", reencoded, wid, rid)
+	return nil
+}
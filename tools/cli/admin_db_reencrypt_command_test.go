@@ -0,0 +1,151 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"flag"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli/v2"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+)
+
+func TestAdminDBReencryptHistory(t *testing.T) {
+	const (
+		domainName     = "test-domain"
+		domainID       = "test-domain-id"
+		workflowID     = "test-workflow-id"
+		runID          = "2f3e1a52-8d1b-4bd5-9a0c-6a1c5b0f0b8e"
+		numberOfShards = 16
+	)
+	shardID := common.WorkflowIDToHistoryShard(workflowID, numberOfShards)
+
+	newReencryptContext := func(td *cliTestData, withRunID bool) *cli.Context {
+		set := flag.NewFlagSet("test", 0)
+		set.String(FlagDomain, domainName, "domain")
+		set.String(FlagWorkflowID, workflowID, "workflow ID")
+		set.Int(FlagNumberOfShards, numberOfShards, "number of shards")
+		set.String(FlagRunID, "", "run ID")
+		if withRunID {
+			require.NoError(t, set.Set(FlagRunID, runID))
+		}
+		return cli.NewContext(td.app, set, nil)
+	}
+	mockGetExecution := func(td *cliTestData, state int, versionHistories *persistence.VersionHistories) {
+		mockDomainManager := persistence.NewMockDomainManager(td.ctrl)
+		mockDomainManager.EXPECT().GetDomain(gomock.Any(), &persistence.GetDomainRequest{Name: domainName}).
+			Return(&persistence.GetDomainResponse{Info: &persistence.DomainInfo{ID: domainID, Name: domainName}}, nil)
+		mockDomainManager.EXPECT().Close()
+		td.mockManagerFactory.EXPECT().initializeDomainManager(gomock.Any()).Return(mockDomainManager, nil)
+
+		mockExecManager := persistence.NewMockExecutionManager(td.ctrl)
+		mockExecManager.EXPECT().GetWorkflowExecution(gomock.Any(), &persistence.GetWorkflowExecutionRequest{
+			ShardID:    common.IntPtr(shardID),
+			DomainID:   domainID,
+			Execution:  types.WorkflowExecution{WorkflowID: workflowID, RunID: runID},
+			DomainName: domainName,
+		}).Return(&persistence.GetWorkflowExecutionResponse{
+			State: &persistence.WorkflowMutableState{
+				ExecutionInfo: &persistence.WorkflowExecutionInfo{
+					State:       state,
+					BranchToken: []byte("branch-token"),
+				},
+				VersionHistories: versionHistories,
+			},
+		}, nil)
+		mockExecManager.EXPECT().Close()
+		td.mockManagerFactory.EXPECT().initializeExecutionManager(gomock.Any()).Return(mockExecManager, nil)
+	}
+
+	tests := map[string]struct {
+		withRunID      bool
+		mockSetup      func(td *cliTestData)
+		expectedOutput string
+		expectedError  string
+	}{
+		"success": {
+			withRunID: true,
+			mockSetup: func(td *cliTestData) {
+				mockGetExecution(td, persistence.WorkflowStateCompleted, &persistence.VersionHistories{
+					Histories: []*persistence.VersionHistory{
+						{BranchToken: []byte("branch-token-1")},
+						{BranchToken: []byte("branch-token-2")},
+					},
+				})
+				mockHistoryManager := persistence.NewMockHistoryManager(td.ctrl)
+				for i, branchToken := range []string{"branch-token-1", "branch-token-2"} {
+					mockHistoryManager.EXPECT().ReencodeHistoryBranch(gomock.Any(), &persistence.ReencodeHistoryBranchRequest{
+						BranchToken: []byte(branchToken),
+						ShardID:     common.IntPtr(shardID),
+						DomainName:  domainName,
+					}).Return(&persistence.ReencodeHistoryBranchResponse{ReencodedNodeCount: i + 2}, nil)
+				}
+				mockHistoryManager.EXPECT().Close()
+				td.mockManagerFactory.EXPECT().initializeHistoryManager(gomock.Any()).Return(mockHistoryManager, nil)
+			},
+			expectedOutput: fmt.Sprintf("Re-encrypted 5 history batches of workflowID: %v, runID: %v\n", workflowID, runID),
+		},
+		"workflow not closed": {
+			withRunID: true,
+			mockSetup: func(td *cliTestData) {
+				mockGetExecution(td, persistence.WorkflowStateRunning, nil)
+			},
+			expectedError: "Only the history of closed workflows can be re-encrypted",
+		},
+		"reencode error": {
+			withRunID: true,
+			mockSetup: func(td *cliTestData) {
+				mockGetExecution(td, persistence.WorkflowStateCompleted, nil)
+				mockHistoryManager := persistence.NewMockHistoryManager(td.ctrl)
+				mockHistoryManager.EXPECT().ReencodeHistoryBranch(gomock.Any(), gomock.Any()).Return(nil, persistence.ErrPayloadKeyNotFound)
+				mockHistoryManager.EXPECT().Close()
+				td.mockManagerFactory.EXPECT().initializeHistoryManager(gomock.Any()).Return(mockHistoryManager, nil)
+			},
+			expectedError: "ReencodeHistoryBranch error",
+		},
+		"missing run ID": {
+			mockSetup:     func(td *cliTestData) {},
+			expectedError: "Required flag not found",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			td := newCLITestData(t)
+			test.mockSetup(td)
+
+			err := AdminDBReencryptHistory(newReencryptContext(td, test.withRunID))
+			if test.expectedError != "" {
+				assert.ErrorContains(t, err, test.expectedError)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedOutput, td.ioHandler.outputBytes.String())
+		})
+	}
+}
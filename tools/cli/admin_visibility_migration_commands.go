@@ -0,0 +1,211 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/urfave/cli/v2"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/worker/visibilitymigration"
+	"github.com/uber/cadence/tools/common/commoncli"
+)
+
+const (
+	defaultVisibilityMigrationAbortReason         = "Visibility migration aborted through admin CLI"
+	defaultVisibilityMigrationTimeoutInSeconds    = 7 * 24 * 3600
+	defaultVisibilityMigrationRequiredConsecutive = 3
+)
+
+// AdminStartVisibilityMigration starts the visibility store migration workflow of a domain
+func AdminStartVisibilityMigration(c *cli.Context) error {
+	domain, err := getRequiredOption(c, FlagDomain)
+	if err != nil {
+		return commoncli.Problem("Required flag not found", err)
+	}
+	sourceStore, err := getRequiredOption(c, FlagSourceStore)
+	if err != nil {
+		return commoncli.Problem("Required flag not found", err)
+	}
+	targetStore, err := getRequiredOption(c, FlagTargetStore)
+	if err != nil {
+		return commoncli.Problem("Required flag not found", err)
+	}
+	earliestTime, err := parseTime(c.String(FlagEarliestTime), 0)
+	if err != nil {
+		return commoncli.Problem("Invalid earliest time", err)
+	}
+	latestTime, err := parseTime(c.String(FlagLatestTime), 0)
+	if err != nil {
+		return commoncli.Problem("Invalid latest time", err)
+	}
+
+	client, err := getCadenceClient(c)
+	if err != nil {
+		return err
+	}
+	tcCtx, cancel, err := newContext(c)
+	defer cancel()
+	if err != nil {
+		return commoncli.Problem("Error in creating context: ", err)
+	}
+	op, err := getOperatorFn()
+	if err != nil {
+		return commoncli.Problem("Error in getting operator: ", err)
+	}
+	memo, err := getWorkflowMemo(map[string]interface{}{
+		constants.MemoKeyForOperator: op,
+	})
+	if err != nil {
+		return commoncli.Problem("Failed to serialize memo", err)
+	}
+
+	params := visibilitymigration.MigrationParams{
+		Domain:                     domain,
+		SourceStore:                sourceStore,
+		TargetStore:                targetStore,
+		SkipBackfill:               c.Bool(FlagSkipBackfill),
+		BackfillEarliestTime:       earliestTime,
+		BackfillLatestTime:         latestTime,
+		VerifyInterval:             c.Duration(FlagVerifyInterval),
+		VerifyWindow:               c.Duration(FlagVerifyWindow),
+		CountTolerance:             c.Int64(FlagCountTolerance),
+		RequiredConsecutiveMatches: c.Int(FlagRequiredMatches),
+		DryRun:                     c.Bool(FlagDryRun),
+	}
+	input, err := json.Marshal(params)
+	if err != nil {
+		return commoncli.Problem("Failed to serialize visibility migration params", err)
+	}
+
+	workflowID := visibilitymigration.WorkflowID(domain)
+	resp, err := client.StartWorkflowExecution(tcCtx, &types.StartWorkflowExecutionRequest{
+		Domain:                              constants.SystemLocalDomainName,
+		RequestID:                           uuidFn(),
+		WorkflowID:                          workflowID,
+		WorkflowIDReusePolicy:               types.WorkflowIDReusePolicyAllowDuplicate.Ptr(),
+		TaskList:                            &types.TaskList{Name: visibilitymigration.TaskListName},
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(int32(c.Int(FlagExecutionTimeout))),
+		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(defaultDecisionTimeoutInSeconds),
+		Memo:                                memo,
+		WorkflowType:                        &types.WorkflowType{Name: visibilitymigration.WorkflowTypeName},
+		Input:                               input,
+	})
+	if err != nil {
+		return commoncli.Problem("Failed to start visibility migration workflow", err)
+	}
+	output := getDeps(c).Output()
+	fmt.Fprintln(output, "Visibility migration workflow started")
+	fmt.Fprintln(output, "wid: "+workflowID)
+	fmt.Fprintln(output, "rid: "+resp.GetRunID())
+	return nil
+}
+
+// AdminQueryVisibilityMigration shows the progress and last verification result of a visibility migration
+func AdminQueryVisibilityMigration(c *cli.Context) error {
+	domain, err := getRequiredOption(c, FlagDomain)
+	if err != nil {
+		return commoncli.Problem("Required flag not found", err)
+	}
+	client, err := getCadenceClient(c)
+	if err != nil {
+		return err
+	}
+	tcCtx, cancel, err := newContext(c)
+	defer cancel()
+	if err != nil {
+		return commoncli.Problem("Error in creating context: ", err)
+	}
+
+	execution := &types.WorkflowExecution{
+		WorkflowID: visibilitymigration.WorkflowID(domain),
+		RunID:      getRunID(c),
+	}
+	queryResp, err := client.QueryWorkflow(tcCtx, &types.QueryWorkflowRequest{
+		Domain:    constants.SystemLocalDomainName,
+		Execution: execution,
+		Query: &types.WorkflowQuery{
+			QueryType: visibilitymigration.QueryType,
+		},
+	})
+	if err != nil {
+		return commoncli.Problem("Failed to query visibility migration workflow", err)
+	}
+	if queryResp.GetQueryResult() == nil {
+		return commoncli.Problem("QueryResult has no value", nil)
+	}
+	var result visibilitymigration.QueryResult
+	if err := json.Unmarshal(queryResp.GetQueryResult(), &result); err != nil {
+		return commoncli.Problem("Unable to deserialize QueryResult", err)
+	}
+
+	descResp, err := client.DescribeWorkflowExecution(tcCtx, &types.DescribeWorkflowExecutionRequest{
+		Domain:    constants.SystemLocalDomainName,
+		Execution: execution,
+	})
+	if err != nil {
+		return commoncli.Problem("Failed to describe workflow", err)
+	}
+	if isWorkflowTerminated(descResp) {
+		result.State = visibilitymigration.WorkflowFailed
+	}
+	prettyPrintJSONObject(getDeps(c).Output(), result)
+	return nil
+}
+
+// AdminAbortVisibilityMigration terminates the visibility migration workflow of a domain, the read store is left unchanged
+func AdminAbortVisibilityMigration(c *cli.Context) error {
+	domain, err := getRequiredOption(c, FlagDomain)
+	if err != nil {
+		return commoncli.Problem("Required flag not found", err)
+	}
+	client, err := getCadenceClient(c)
+	if err != nil {
+		return err
+	}
+	tcCtx, cancel, err := newContext(c)
+	defer cancel()
+	if err != nil {
+		return commoncli.Problem("Error in creating context: ", err)
+	}
+	reason := c.String(FlagReason)
+	if len(reason) == 0 {
+		reason = defaultVisibilityMigrationAbortReason
+	}
+	err = client.TerminateWorkflowExecution(tcCtx, &types.TerminateWorkflowExecutionRequest{
+		Domain: constants.SystemLocalDomainName,
+		WorkflowExecution: &types.WorkflowExecution{
+			WorkflowID: visibilitymigration.WorkflowID(domain),
+			RunID:      getRunID(c),
+		},
+		Reason:   reason,
+		Identity: getCliIdentity(),
+	})
+	if err != nil {
+		return commoncli.Problem("Failed to abort visibility migration workflow", err)
+	}
+	fmt.Fprintln(getDeps(c).Output(), "Visibility migration aborted")
+	return nil
+}
@@ -0,0 +1,175 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/yarpc"
+
+	"github.com/uber/cadence/client/frontend"
+	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/worker/visibilitymigration"
+	"github.com/uber/cadence/tools/cli/clitest"
+)
+
+func TestAdminVisibilityMigrationCommands(t *testing.T) {
+	oldUUIDFn := uuidFn
+	uuidFn = func() string { return "test-uuid" }
+	oldGetOperatorFn := getOperatorFn
+	getOperatorFn = func() (string, error) { return "test-user", nil }
+	defer func() {
+		uuidFn = oldUUIDFn
+		getOperatorFn = oldGetOperatorFn
+	}()
+
+	workflowID := visibilitymigration.WorkflowID("test-domain")
+	queryResult, err := json.Marshal(visibilitymigration.QueryResult{
+		State:       visibilitymigration.WorkflowVerifying,
+		Domain:      "test-domain",
+		SourceStore: "db",
+		TargetStore: "es",
+		Backfilled:  42,
+	})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name          string
+		setupMocks    func(*frontend.MockClient)
+		cmdline       string
+		expectedError string
+		expectedStrs  []string
+	}{
+		{
+			name: "start",
+			setupMocks: func(client *frontend.MockClient) {
+				client.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, request *types.StartWorkflowExecutionRequest, _ ...yarpc.CallOption) (*types.StartWorkflowExecutionResponse, error) {
+						assert.Equal(t, constants.SystemLocalDomainName, request.Domain)
+						assert.Equal(t, workflowID, request.WorkflowID)
+						assert.Equal(t, visibilitymigration.TaskListName, request.TaskList.GetName())
+						assert.Equal(t, visibilitymigration.WorkflowTypeName, request.WorkflowType.GetName())
+						assert.Equal(t, int32(defaultVisibilityMigrationTimeoutInSeconds), request.GetExecutionStartToCloseTimeoutSeconds())
+
+						var params visibilitymigration.MigrationParams
+						assert.NoError(t, json.Unmarshal(request.Input, &params))
+						assert.Equal(t, visibilitymigration.MigrationParams{
+							Domain:                     "test-domain",
+							SourceStore:                "db",
+							TargetStore:                "es",
+							VerifyInterval:             10 * time.Minute,
+							RequiredConsecutiveMatches: 5,
+							DryRun:                     true,
+						}, params)
+						return &types.StartWorkflowExecutionResponse{RunID: "test-run-id"}, nil
+					})
+			},
+			cmdline: "cadence --domain test-domain admin visibility-migration start --source_store db --target_store es " +
+				"--verify_interval 10m --required_matches 5 --dry_run",
+			expectedStrs: []string{"Visibility migration workflow started", "wid: " + workflowID, "rid: test-run-id"},
+		},
+		{
+			name:          "start without target store",
+			setupMocks:    func(client *frontend.MockClient) {},
+			cmdline:       "cadence --domain test-domain admin visibility-migration start --source_store db",
+			expectedError: "target_store",
+		},
+		{
+			name: "start failed",
+			setupMocks: func(client *frontend.MockClient) {
+				client.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).
+					Return(nil, &types.WorkflowExecutionAlreadyStartedError{Message: "already started"})
+			},
+			cmdline:       "cadence --domain test-domain admin visibility-migration start --source_store db --target_store es",
+			expectedError: "Failed to start visibility migration workflow",
+		},
+		{
+			name: "query",
+			setupMocks: func(client *frontend.MockClient) {
+				client.EXPECT().QueryWorkflow(gomock.Any(), &types.QueryWorkflowRequest{
+					Domain:    constants.SystemLocalDomainName,
+					Execution: &types.WorkflowExecution{WorkflowID: workflowID},
+					Query:     &types.WorkflowQuery{QueryType: visibilitymigration.QueryType},
+				}).Return(&types.QueryWorkflowResponse{QueryResult: queryResult}, nil)
+				client.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).
+					Return(&types.DescribeWorkflowExecutionResponse{WorkflowExecutionInfo: &types.WorkflowExecutionInfo{}}, nil)
+			},
+			cmdline:      "cadence --domain test-domain admin visibility-migration query",
+			expectedStrs: []string{`"State": "verifying"`, `"Backfilled": 42`},
+		},
+		{
+			name: "query terminated workflow",
+			setupMocks: func(client *frontend.MockClient) {
+				client.EXPECT().QueryWorkflow(gomock.Any(), gomock.Any()).
+					Return(&types.QueryWorkflowResponse{QueryResult: queryResult}, nil)
+				client.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).
+					Return(&types.DescribeWorkflowExecutionResponse{WorkflowExecutionInfo: &types.WorkflowExecutionInfo{
+						CloseStatus: types.WorkflowExecutionCloseStatusTerminated.Ptr(),
+					}}, nil)
+			},
+			cmdline:      "cadence --domain test-domain admin visibility-migration query",
+			expectedStrs: []string{`"State": "failed"`},
+		},
+		{
+			name: "abort",
+			setupMocks: func(client *frontend.MockClient) {
+				client.EXPECT().TerminateWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, request *types.TerminateWorkflowExecutionRequest, _ ...yarpc.CallOption) error {
+						assert.Equal(t, workflowID, request.WorkflowExecution.GetWorkflowID())
+						assert.Equal(t, "test-run-id", request.WorkflowExecution.GetRunID())
+						assert.Equal(t, defaultVisibilityMigrationAbortReason, request.Reason)
+						return nil
+					})
+			},
+			cmdline:      "cadence --domain test-domain admin visibility-migration abort --rid test-run-id",
+			expectedStrs: []string{"Visibility migration aborted"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			frontendClient := frontend.NewMockClient(mockCtrl)
+			tt.setupMocks(frontendClient)
+			ioHandler := &testIOHandler{}
+			app := NewCliApp(&clientFactoryMock{
+				serverFrontendClient: frontendClient,
+			}, WithIOHandler(ioHandler))
+
+			err := clitest.RunCommandLine(t, app, tt.cmdline)
+			if tt.expectedError != "" {
+				assert.ErrorContains(t, err, tt.expectedError)
+				return
+			}
+			assert.NoError(t, err)
+			for _, expected := range tt.expectedStrs {
+				assert.Contains(t, ioHandler.outputBytes.String(), expected)
+			}
+		})
+	}
+}
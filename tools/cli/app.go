@@ -151,6 +151,12 @@ func NewCliApp(cf ClientFactory, opts ...CLIAppOptions) *cli.App {
 			Usage:       "Operate cadence schedules",
 			Subcommands: newScheduleCommands(),
 		},
+		{
+			Name:        "semaphore",
+			Aliases:     []string{"sem"},
+			Usage:       "Operate cadence domain scoped semaphores",
+			Subcommands: newSemaphoreCommands(),
+		},
 		{
 			Name:    "admin",
 			Aliases: []string{"adm"},
@@ -239,6 +245,18 @@ func NewCliApp(cf ClientFactory, opts ...CLIAppOptions) *cli.App {
 					Usage:       "Run admin operation on config store",
 					Subcommands: newAdminConfigStoreCommands(),
 				},
+				{
+					Name:        "archival",
+					Aliases:     []string{"arc"},
+					Usage:       "Run admin operations on archived data",
+					Subcommands: newAdminArchivalCommands(),
+				},
+				{
+					Name:        "visibility-migration",
+					Aliases:     []string{"vm"},
+					Usage:       "Run admin operations to migrate a domain between visibility stores",
+					Subcommands: newAdminVisibilityMigrationCommands(),
+				},
 			},
 		},
 		{
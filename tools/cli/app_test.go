@@ -472,6 +472,76 @@ func (s *cliAppSuite) TestCancelWorkflow_Failed() {
 	s.Error(s.app.Run([]string{"", "--do", domainName, "workflow", "cancel", "-w", "wid"}))
 }
 
+func (s *cliAppSuite) TestPauseWorkflow() {
+	s.serverFrontendClient.EXPECT().PauseWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil)
+	err := s.app.Run([]string{"", "--do", domainName, "workflow", "pause", "-w", "wid", "--reason", "investigating"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestPauseWorkflow_Failed() {
+	s.serverFrontendClient.EXPECT().PauseWorkflowExecution(gomock.Any(), gomock.Any()).Return(&types.BadRequestError{"faked error"})
+	s.Error(s.app.Run([]string{"", "--do", domainName, "workflow", "pause", "-w", "wid"}))
+}
+
+func (s *cliAppSuite) TestUnpauseWorkflow() {
+	s.serverFrontendClient.EXPECT().UnpauseWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil)
+	err := s.app.Run([]string{"", "--do", domainName, "workflow", "unpause", "-w", "wid"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestUnpauseWorkflow_Failed() {
+	s.serverFrontendClient.EXPECT().UnpauseWorkflowExecution(gomock.Any(), gomock.Any()).Return(&types.BadRequestError{"faked error"})
+	s.Error(s.app.Run([]string{"", "--do", domainName, "workflow", "unpause", "-w", "wid"}))
+}
+
+func (s *cliAppSuite) TestPauseActivity() {
+	s.serverFrontendClient.EXPECT().PauseActivity(gomock.Any(), gomock.Any()).Return(nil)
+	err := s.app.Run([]string{"", "--do", domainName, "workflow", "activity", "pause", "-w", "wid", "-aid", "1"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestUnpauseActivity() {
+	s.serverFrontendClient.EXPECT().UnpauseActivity(gomock.Any(), gomock.Any()).Return(nil)
+	err := s.app.Run([]string{"", "--do", domainName, "workflow", "activity", "unpause", "-w", "wid", "-aid", "1"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestResetActivityAttempt() {
+	s.serverFrontendClient.EXPECT().ResetActivityAttempt(gomock.Any(), gomock.Any()).Return(nil)
+	err := s.app.Run([]string{"", "--do", domainName, "workflow", "activity", "reset", "-w", "wid", "-aid", "1"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestRetryActivityNow() {
+	s.serverFrontendClient.EXPECT().RetryActivityNow(gomock.Any(), gomock.Any()).Return(nil)
+	err := s.app.Run([]string{"", "--do", domainName, "workflow", "activity", "retry", "-w", "wid", "-aid", "1"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestRetryActivityNow_Failed() {
+	s.serverFrontendClient.EXPECT().RetryActivityNow(gomock.Any(), gomock.Any()).Return(&types.BadRequestError{"faked error"})
+	s.Error(s.app.Run([]string{"", "--do", domainName, "workflow", "activity", "retry", "-w", "wid", "-aid", "1"}))
+}
+
+func (s *cliAppSuite) TestPauseActivity_MissingActivityID() {
+	s.Error(s.app.Run([]string{"", "--do", domainName, "workflow", "activity", "pause", "-w", "wid"}))
+}
+
+func (s *cliAppSuite) TestDeleteWorkflow() {
+	s.serverFrontendClient.EXPECT().DeleteWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil)
+	err := s.app.Run([]string{"", "--do", domainName, "workflow", "delete", "-w", "wid", "-r", "rid", "--reason", "erasure request"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestDeleteWorkflow_RunIDRequired() {
+	s.Error(s.app.Run([]string{"", "--do", domainName, "workflow", "delete", "-w", "wid"}))
+}
+
+func (s *cliAppSuite) TestDeleteWorkflow_Failed() {
+	s.serverFrontendClient.EXPECT().DeleteWorkflowExecution(gomock.Any(), gomock.Any()).Return(&types.BadRequestError{"faked error"})
+	s.Error(s.app.Run([]string{"", "--do", domainName, "workflow", "delete", "-w", "wid", "-r", "rid"}))
+}
+
 func (s *cliAppSuite) TestSignalWorkflow() {
 	s.serverFrontendClient.EXPECT().SignalWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil)
 	err := s.app.Run([]string{"", "--do", domainName, "workflow", "signal", "-w", "wid", "-n", "signal-name"})
@@ -483,6 +553,25 @@ func (s *cliAppSuite) TestSignalWorkflow_Failed() {
 	s.Error(s.app.Run([]string{"", "--do", domainName, "workflow", "signal", "-w", "wid", "-n", "signal-name"}))
 }
 
+func (s *cliAppSuite) TestUpdateWorkflow() {
+	resp := &types.UpdateWorkflowExecutionResponse{UpdateID: "update-id", Result: []byte("update-result")}
+	s.serverFrontendClient.EXPECT().UpdateWorkflowExecution(gomock.Any(), gomock.Any()).Return(resp, nil)
+	err := s.app.Run([]string{"", "--do", domainName, "workflow", "update", "-w", "wid", "-n", "update-name", "--update_id", "update-id"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestUpdateWorkflow_Failed() {
+	s.serverFrontendClient.EXPECT().UpdateWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil, &types.QueryFailedError{Message: "rejected"})
+	s.Error(s.app.Run([]string{"", "--do", domainName, "workflow", "update", "-w", "wid", "-n", "update-name"}))
+}
+
+func (s *cliAppSuite) TestUpdateWorkflow_HandlerFailed() {
+	reason := "handler failed"
+	resp := &types.UpdateWorkflowExecutionResponse{UpdateID: "update-id", FailureReason: &reason}
+	s.serverFrontendClient.EXPECT().UpdateWorkflowExecution(gomock.Any(), gomock.Any()).Return(resp, nil)
+	s.Error(s.app.Run([]string{"", "--do", domainName, "workflow", "update", "-w", "wid", "-n", "update-name"}))
+}
+
 func (s *cliAppSuite) TestQueryWorkflowUsingStackTrace() {
 	resp := &types.QueryWorkflowResponse{
 		QueryResult: []byte("query-result"),
@@ -621,6 +710,24 @@ func (s *cliAppSuite) TestCountWorkflow() {
 	s.Nil(err)
 }
 
+func (s *cliAppSuite) TestCountWorkflowGroupBy() {
+	resp := &types.AggregateWorkflowExecutionsResponse{
+		Groups: []*types.WorkflowExecutionGroup{{Key: "COMPLETED", Count: 10}, {Key: "FAILED", Count: 2}},
+	}
+	s.serverFrontendClient.EXPECT().AggregateWorkflowExecutions(gomock.Any(), &types.AggregateWorkflowExecutionsRequest{
+		Domain:    domainName,
+		Query:     "WorkflowType = 'wt'",
+		GroupBy:   "CloseStatus",
+		MaxGroups: 5,
+	}).Return(resp, nil)
+	err := s.app.Run([]string{"", "--do", domainName, "workflow", "count", "-q", "WorkflowType = 'wt'", "--group-by", "CloseStatus", "--max-groups", "5"})
+	s.Nil(err)
+
+	s.serverFrontendClient.EXPECT().AggregateWorkflowExecutions(gomock.Any(), gomock.Any()).Return(resp, nil)
+	err = s.app.Run([]string{"", "--do", domainName, "workflow", "count", "--gb", "WorkflowType", "--format", "json"})
+	s.Nil(err)
+}
+
 var describeTaskListResponse = &types.DescribeTaskListResponse{
 	Pollers: []*types.PollerInfo{
 		{
@@ -29,6 +29,7 @@ import (
 	"github.com/urfave/cli/v2"
 
 	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
@@ -248,6 +249,14 @@ func (f *defaultManagerFactory) initPersistenceFactory(c *cli.Context) (client.F
 	}
 	cfg.Persistence.DataStores[cfg.Persistence.DefaultStore] = defaultStore
 
+	dc := persistence.NewDefaultDynamicConfiguration()
+	if keyringFile := c.String(FlagPayloadKeyringFile); keyringFile != "" {
+		// the keyring is only taken by the commands rewriting histories, which always encrypt with the requested key
+		cfg.Persistence.PayloadEncryption = &config.PayloadEncryption{KeyringFile: keyringFile}
+		dc.EnablePayloadEncryption = dynamicproperties.GetBoolPropertyFnFilteredByDomain(true)
+		dc.PayloadEncryptionKeyID = dynamicproperties.GetStringPropertyFnFilteredByDomain(c.String(FlagPayloadKeyID))
+	}
+
 	rps := c.Float64(FlagRPS)
 
 	return client.NewFactory(
@@ -256,7 +265,8 @@ func (f *defaultManagerFactory) initPersistenceFactory(c *cli.Context) (client.F
 		cfg.ClusterGroupMetadata.CurrentClusterName,
 		metrics.NewNoopMetricsClient(),
 		log.NewNoop(),
-		persistence.NewDefaultDynamicConfiguration(),
+		dc,
+		nil,
 	), nil
 }
 
@@ -183,5 +183,49 @@ func newDomainCommands() []*cli.Command {
 				})
 			},
 		},
+		{
+			Name:        "saved-query",
+			Aliases:     []string{"sq"},
+			Usage:       "Manage visibility queries saved in the domain",
+			Subcommands: newSavedQueryCommands(),
+		},
+	}
+}
+
+func newSavedQueryCommands() []*cli.Command {
+	return []*cli.Command{
+		{
+			Name:    "save",
+			Aliases: []string{"s"},
+			Usage:   "Save a visibility query under a name, replacing any query with the same name",
+			Flags:   saveQueryFlags,
+			Action: func(c *cli.Context) error {
+				return withDomainClient(c, false, func(dc *domainCLIImpl) error {
+					return dc.SaveQuery(c)
+				})
+			},
+		},
+		{
+			Name:    "delete",
+			Aliases: []string{"del"},
+			Usage:   "Delete a saved visibility query",
+			Flags:   deleteSavedQueryFlags,
+			Action: func(c *cli.Context) error {
+				return withDomainClient(c, false, func(dc *domainCLIImpl) error {
+					return dc.DeleteSavedQuery(c)
+				})
+			},
+		},
+		{
+			Name:    "list",
+			Aliases: []string{"l"},
+			Usage:   "List the visibility queries saved in the domain",
+			Flags:   []cli.Flag{getFormatFlag()},
+			Action: func(c *cli.Context) error {
+				return withDomainClient(c, false, func(dc *domainCLIImpl) error {
+					return dc.ListSavedQueries(c)
+				})
+			},
+		},
 	}
 }
@@ -28,6 +28,7 @@ import (
 	"fmt"
 	"os"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -43,6 +44,7 @@ import (
 	"github.com/uber/cadence/common/domain"
 	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
 	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/common/visibility"
 	"github.com/uber/cadence/service/worker/domaindeprecation"
 	"github.com/uber/cadence/tools/common/commoncli"
 	"github.com/uber/cadence/tools/common/flag"
@@ -1141,6 +1143,103 @@ func (d *domainCLIImpl) ListFailoverHistory(c *cli.Context) error {
 	return nil
 }
 
+// SaveQuery saves a visibility query in the domain data under the given name
+func (d *domainCLIImpl) SaveQuery(c *cli.Context) error {
+	domainName, err := getRequiredOption(c, FlagDomain)
+	if err != nil {
+		return commoncli.Problem("Required flag not found: ", err)
+	}
+	name := c.String(FlagName)
+	if err := visibility.ValidateSavedQueryName(name); err != nil {
+		return commoncli.Problem(optionErr, err)
+	}
+	query, err := getRequiredOption(c, FlagListQuery)
+	if err != nil {
+		return commoncli.Problem("Required flag not found: ", err)
+	}
+
+	if err := d.updateSavedQuery(c, domainName, name, query); err != nil {
+		return commoncli.Problem("Operation SaveQuery failed.", err)
+	}
+	fmt.Printf("Query %s successfully saved in domain %s.\n", name, domainName)
+	return nil
+}
+
+// DeleteSavedQuery deletes a visibility query saved in the domain data
+func (d *domainCLIImpl) DeleteSavedQuery(c *cli.Context) error {
+	domainName, err := getRequiredOption(c, FlagDomain)
+	if err != nil {
+		return commoncli.Problem("Required flag not found: ", err)
+	}
+	name := c.String(FlagName)
+	if err := visibility.ValidateSavedQueryName(name); err != nil {
+		return commoncli.Problem(optionErr, err)
+	}
+
+	// updating a saved query with an empty value deletes it from the domain data
+	if err := d.updateSavedQuery(c, domainName, name, ""); err != nil {
+		return commoncli.Problem("Operation DeleteSavedQuery failed.", err)
+	}
+	fmt.Printf("Query %s successfully deleted from domain %s.\n", name, domainName)
+	return nil
+}
+
+// SavedQueryRow is a row of the saved query table
+type SavedQueryRow struct {
+	Name  string `header:"Name"`
+	Query string `header:"Query"`
+}
+
+// ListSavedQueries lists the visibility queries saved in the domain data
+func (d *domainCLIImpl) ListSavedQueries(c *cli.Context) error {
+	domainName, err := getRequiredOption(c, FlagDomain)
+	if err != nil {
+		return commoncli.Problem("Required flag not found: ", err)
+	}
+
+	ctx, cancel, err := newContext(c)
+	defer cancel()
+	if err != nil {
+		return commoncli.Problem("Error in creating context: ", err)
+	}
+	resp, err := d.describeDomain(ctx, &types.DescribeDomainRequest{
+		Name: common.StringPtr(domainName),
+	})
+	if err != nil {
+		if _, ok := err.(*types.EntityNotExistsError); ok {
+			return commoncli.Problem(fmt.Sprintf("Domain %s does not exist.", domainName), err)
+		}
+		return commoncli.Problem("Failed to describe domain.", err)
+	}
+
+	queries := visibility.GetSavedQueries(resp.GetDomainInfo().GetData())
+	names := make([]string, 0, len(queries))
+	for name := range queries {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	table := []SavedQueryRow{}
+	for _, name := range names {
+		table = append(table, SavedQueryRow{Name: name, Query: queries[name]})
+	}
+	return Render(c, table, RenderOptions{DefaultTemplate: templateTable, Color: true, Border: true})
+}
+
+func (d *domainCLIImpl) updateSavedQuery(c *cli.Context, domainName, name, query string) error {
+	ctx, cancel, err := newContext(c)
+	defer cancel()
+	if err != nil {
+		return err
+	}
+	_, err = d.updateDomain(ctx, &types.UpdateDomainRequest{
+		Name:          domainName,
+		SecurityToken: c.String(FlagSecurityToken),
+		Data:          map[string]string{visibility.SavedQueryDomainDataKey(name): query},
+	})
+	return err
+}
+
 func (d *domainCLIImpl) describeDomain(
 	ctx context.Context,
 	request *types.DescribeDomainRequest,
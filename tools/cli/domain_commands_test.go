@@ -620,6 +620,81 @@ func (s *cliAppSuite) TestListDomains() {
 	}
 }
 
+func (s *cliAppSuite) TestDomainSavedQuery() {
+	testCases := []testcase{
+		{
+			"save query",
+			"cadence --do test-domain domain saved-query save --name failed --query 'CloseStatus = 1' --st token",
+			"",
+			func() {
+				s.serverFrontendClient.EXPECT().UpdateDomain(gomock.Any(), &types.UpdateDomainRequest{
+					Name:          "test-domain",
+					SecurityToken: "token",
+					Data:          map[string]string{"SavedVisibilityQuery.failed": "CloseStatus = 1"},
+				}).Return(&types.UpdateDomainResponse{}, nil)
+			},
+		},
+		{
+			"save query with invalid name",
+			"cadence --do test-domain domain saved-query save --name 1failed --query 'CloseStatus = 1'",
+			"has to contain alphanumeric",
+			nil,
+		},
+		{
+			"save query failed",
+			"cadence --do test-domain domain saved-query save --name failed --query 'CloseStatus = 1'",
+			"Operation SaveQuery failed.",
+			func() {
+				s.serverFrontendClient.EXPECT().UpdateDomain(gomock.Any(), gomock.Any()).Return(nil, &types.BadRequestError{Message: "Invalid saved query"})
+			},
+		},
+		{
+			"delete query",
+			"cadence --do test-domain domain saved-query delete --name failed",
+			"",
+			func() {
+				s.serverFrontendClient.EXPECT().UpdateDomain(gomock.Any(), &types.UpdateDomainRequest{
+					Name: "test-domain",
+					Data: map[string]string{"SavedVisibilityQuery.failed": ""},
+				}).Return(&types.UpdateDomainResponse{}, nil)
+			},
+		},
+		{
+			"list queries",
+			"cadence --do test-domain domain saved-query list",
+			"",
+			func() {
+				s.serverFrontendClient.EXPECT().DescribeDomain(gomock.Any(), &types.DescribeDomainRequest{
+					Name: common.StringPtr("test-domain"),
+				}).Return(&types.DescribeDomainResponse{
+					DomainInfo: &types.DomainInfo{
+						Name: "test-domain",
+						Data: map[string]string{
+							"key1":                         "value1",
+							"SavedVisibilityQuery.failed":  "CloseStatus = 1",
+							"SavedVisibilityQuery.deleted": "",
+						},
+					},
+				}, nil)
+			},
+		},
+		{
+			"list queries of unknown domain",
+			"cadence --do test-domain domain saved-query list",
+			"Domain test-domain does not exist.",
+			func() {
+				s.serverFrontendClient.EXPECT().DescribeDomain(gomock.Any(), gomock.Any()).Return(nil, &types.EntityNotExistsError{})
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		s.Run(tt.name, func() {
+			s.runTestCase(tt)
+		})
+	}
+}
+
 func TestParseActiveClustersByClusterAttribute(t *testing.T) {
 
 	testCases := map[string]struct {
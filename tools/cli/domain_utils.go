@@ -319,6 +319,40 @@ var (
 		getFormatFlag(),
 	}
 
+	saveQueryFlags = []cli.Flag{
+		&cli.StringFlag{
+			Name:     FlagName,
+			Aliases:  []string{"n"},
+			Usage:    "Name of the saved query, starting with a letter and containing only alphanumeric, '_' or '-'",
+			Required: true,
+		},
+		&cli.StringFlag{
+			Name:     FlagListQuery,
+			Aliases:  []string{"q"},
+			Usage:    "SQL like visibility query to save, e.g. 'WorkflowType = \"wtype\" and CloseStatus = 1'",
+			Required: true,
+		},
+		&cli.StringFlag{
+			Name:    FlagSecurityToken,
+			Aliases: []string{"st"},
+			Usage:   "Optional token for security check",
+		},
+	}
+
+	deleteSavedQueryFlags = []cli.Flag{
+		&cli.StringFlag{
+			Name:     FlagName,
+			Aliases:  []string{"n"},
+			Usage:    "Name of the saved query",
+			Required: true,
+		},
+		&cli.StringFlag{
+			Name:    FlagSecurityToken,
+			Aliases: []string{"st"},
+			Usage:   "Optional token for security check",
+		},
+	}
+
 	adminDomainCommonFlags = getDBFlags()
 
 	adminRegisterDomainFlags = append(
@@ -67,9 +67,19 @@ const (
 	FlagStartTime                      = "start_time"
 	FlagEndTime                        = "end_time"
 	FlagJitter                         = "jitter"
+	FlagInterval                       = "interval"
+	FlagCalendar                       = "calendar"
+	FlagExcludeCalendar                = "exclude_calendar"
+	FlagTimeZone                       = "time_zone"
+	FlagSignalWorkflowID               = "signal_workflow_id"
+	FlagSignalWithStart                = "signal_with_start"
 	FlagWorkflowIDPrefix               = "workflow_id_prefix"
 	FlagCatchUpWindow                  = "catch_up_window"
 	FlagPauseOnFailure                 = "pause_on_failure"
+	FlagMaxConsecutiveFailures         = "max_consecutive_failures"
+	FlagMaxFailureRate                 = "max_failure_rate"
+	FlagFailureRateWindow              = "failure_rate_window"
+	FlagFailureRateMinRuns             = "failure_rate_min_runs"
 	FlagBufferLimit                    = "buffer_limit"
 	FlagCronSchedule                   = "cron"
 	FlagWorkflowType                   = "workflow_type"
@@ -174,7 +184,10 @@ const (
 	FlagListQuery                      = "query"
 	FlagExcludeWorkflowIDByQuery       = "exclude_query"
 	FlagBatchType                      = "batch_type"
+	FlagTemplateOperation              = "template_operation"
+	FlagWorkflowIDTemplate             = "workflow_id_template"
 	FlagSignalName                     = "signal_name"
+	FlagUpdateID                       = "update_id"
 	FlagTaskID                         = "task_id"
 	FlagTaskType                       = "task_type"
 	FlagTaskVisibilityTimestamp        = "task_timestamp"
@@ -225,6 +238,18 @@ const (
 	FlagDateFormat                     = "date_format"
 	FlagShardMultiplier                = "shard_multiplier"
 	FlagBucketSize                     = "bucket_size"
+	FlagSemaphoreName                  = "semaphore"
+	FlagSemaphoreSize                  = "size"
+	FlagSourceStore                    = "source_store"
+	FlagTargetStore                    = "target_store"
+	FlagSkipBackfill                   = "skip_backfill"
+	FlagVerifyInterval                 = "verify_interval"
+	FlagVerifyWindow                   = "verify_window"
+	FlagRequiredMatches                = "required_matches"
+	FlagCountTolerance                 = "count_tolerance"
+	FlagGroupBy                        = "group-by"
+	FlagMaxGroups                      = "max-groups"
+	FlagSavedQuery                     = "saved-query"
 	DelayStartSeconds                  = "delay_start_seconds"
 	JitterStartSeconds                 = "jitter_start_seconds"
 	FirstRunAtTime                     = "first_run_at_time"
@@ -246,6 +271,12 @@ const (
 	FlagClusterAttributeScope          = "cluster_attribute_scope"
 	FlagClusterAttributeName           = "cluster_attribute_name"
 	FlagClusterAttributesJSON          = "cluster_attributes_json"
+	FlagArchiveCompression             = "compression"
+	FlagS3Region                       = "s3_region"
+	FlagS3Endpoint                     = "s3_endpoint"
+	FlagS3ForcePathStyle               = "s3_force_path_style"
+	FlagPayloadKeyringFile             = "payload_keyring_file"
+	FlagPayloadKeyID                   = "payload_key_id"
 	// FlagBatchV1 forces the deprecated v1 batch workflow as a fallback.
 	// TODO: remove together with the v1 batch workflow once it is fully deprecated.
 	FlagBatchV1 = "v1"
@@ -528,6 +559,40 @@ func getFlagsForSignal() []cli.Flag {
 	}
 }
 
+func getFlagsForUpdate() []cli.Flag {
+	return []cli.Flag{
+		&cli.StringFlag{
+			Name:    FlagWorkflowID,
+			Aliases: []string{"w", "wid"},
+			Usage:   "WorkflowID",
+		},
+		&cli.StringFlag{
+			Name:    FlagRunID,
+			Aliases: []string{"r", "rid"},
+			Usage:   "RunID",
+		},
+		&cli.StringFlag{
+			Name:    FlagName,
+			Aliases: []string{"n"},
+			Usage:   "UpdateName",
+		},
+		&cli.StringFlag{
+			Name:  FlagUpdateID,
+			Usage: "UpdateID used to deduplicate the update, a random one is generated if not set",
+		},
+		&cli.StringFlag{
+			Name:    FlagInput,
+			Aliases: []string{"i"},
+			Usage:   "Input for the update, in JSON format.",
+		},
+		&cli.StringFlag{
+			Name:    FlagInputFile,
+			Aliases: []string{"if"},
+			Usage:   "Input for the update from JSON file.",
+		},
+	}
+}
+
 func getFlagsForSignalWithStart() []cli.Flag {
 	return append(getFlagsForStart(),
 		&cli.StringFlag{
@@ -563,6 +628,38 @@ func getFlagsForCancel() []cli.Flag {
 	})
 }
 
+func getFlagsForPause() []cli.Flag {
+	return append(flagsForExecution, &cli.StringFlag{
+		Name:    FlagReason,
+		Aliases: []string{"re"},
+		Usage:   "The reason you want to pause the workflow",
+	})
+}
+
+func getFlagsForUnpause() []cli.Flag {
+	return append(flagsForExecution, &cli.StringFlag{
+		Name:    FlagReason,
+		Aliases: []string{"re"},
+		Usage:   "The reason you want to unpause the workflow",
+	})
+}
+
+func getFlagsForDelete() []cli.Flag {
+	return append(flagsForExecution, &cli.StringFlag{
+		Name:    FlagReason,
+		Aliases: []string{"re"},
+		Usage:   "The reason you want to delete the workflow, it is kept in the domain audit log",
+	})
+}
+
+func getFlagsForActivityOperation() []cli.Flag {
+	return append(flagsForExecution, &cli.StringFlag{
+		Name:    FlagActivityID,
+		Aliases: []string{"aid"},
+		Usage:   "The activityID to operate on",
+	})
+}
+
 func getFormatFlag() cli.Flag {
 	return &cli.StringFlag{
 		Name:  FlagFormat,
@@ -671,6 +768,11 @@ func getFlagsForListAll() []cli.Flag {
 			Usage: "Optional SQL like query for use of search attributes. NOTE: using query will ignore all other filter flags including: " +
 				"[open, earliest_time, latest_time, workflow_id, workflow_type]",
 		},
+		&cli.StringFlag{
+			Name:    FlagSavedQuery,
+			Aliases: []string{"sq"},
+			Usage:   "Optional name of a query saved in the domain, used instead of --" + FlagListQuery,
+		},
 		&cli.StringFlag{
 			Name: FlagExcludeWorkflowIDByQuery,
 			Usage: "Another optional SQL like query, but for excluding the results by workflowIDs. This is useful because a single query cannot do join operation. One use case is to " +
@@ -694,6 +796,11 @@ func getFlagsForScan() []cli.Flag {
 			Aliases: []string{"q"},
 			Usage:   "Optional SQL like query",
 		},
+		&cli.StringFlag{
+			Name:    FlagSavedQuery,
+			Aliases: []string{"sq"},
+			Usage:   "Optional name of a query saved in the domain, used instead of --" + FlagListQuery,
+		},
 	}
 	flagsForScan = append(getCommonFlagsForVisibility(), flagsForScan...)
 	return flagsForScan
@@ -729,6 +836,21 @@ func getFlagsForCount() []cli.Flag {
 			Aliases: []string{"q"},
 			Usage:   "Optional SQL like query. e.g count all open workflows 'CloseTime = missing'; 'WorkflowType=\"wtype\" and CloseTime > 0'",
 		},
+		&cli.StringFlag{
+			Name:    FlagSavedQuery,
+			Aliases: []string{"sq"},
+			Usage:   "Optional name of a query saved in the domain, used instead of --" + FlagListQuery,
+		},
+		&cli.StringFlag{
+			Name:    FlagGroupBy,
+			Aliases: []string{"gb"},
+			Usage:   "Optional search attribute to group the count by, e.g. WorkflowType, CloseStatus or a custom keyword attribute. Open workflows and workflows without the attribute are not counted",
+		},
+		&cli.IntFlag{
+			Name:  FlagMaxGroups,
+			Usage: "Maximum number of groups to return, largest groups first. Only used with --" + FlagGroupBy,
+		},
+		getFormatFlag(),
 	}
 }
 
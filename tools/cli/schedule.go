@@ -33,16 +33,14 @@ var (
 	createScheduleFlags = []cli.Flag{
 		scheduleIDFlag,
 		&cli.StringFlag{
-			Name:     FlagCronExpression,
-			Aliases:  []string{"ce"},
-			Usage:    "Cron expression for the schedule (e.g. '*/5 * * * *')",
-			Required: true,
+			Name:    FlagCronExpression,
+			Aliases: []string{"ce"},
+			Usage:   "Cron expression for the schedule (e.g. '*/5 * * * *'). One of --cron_expression, --interval or --calendar is required",
 		},
 		&cli.StringFlag{
-			Name:     FlagWorkflowType,
-			Aliases:  []string{"wt"},
-			Usage:    "Target workflow type name",
-			Required: true,
+			Name:    FlagWorkflowType,
+			Aliases: []string{"wt"},
+			Usage:   "Target workflow type name (required unless --signal_workflow_id is set without --signal_with_start)",
 		},
 		&cli.StringFlag{
 			Name:    FlagTaskList,
@@ -81,6 +79,39 @@ var (
 			Name:  FlagJitter,
 			Usage: "Random jitter applied to each trigger time (e.g. '30s', '5m')",
 		},
+		&cli.StringFlag{
+			Name:  FlagInterval,
+			Usage: "Fixed interval(s) to trigger at, space-separated, each as 'interval[/offset]' (e.g. '5m', '1h/15m')",
+		},
+		&cli.StringFlag{
+			Name:  FlagCalendar,
+			Usage: "Calendar spec(s) to trigger at as a JSON object or array, e.g. '{\"hour\":\"9\",\"dayOfMonth\":\"L\",\"dayOfWeek\":\"MON-FRI\"}'",
+		},
+		&cli.StringFlag{
+			Name:  FlagExcludeCalendar,
+			Usage: "Calendar spec(s) to skip as a JSON object or array, e.g. '{\"month\":\"12\",\"dayOfMonth\":\"25\"}'",
+		},
+		&cli.StringFlag{
+			Name:  FlagTimeZone,
+			Usage: "IANA time zone for the calendar specs that do not set one (e.g. 'America/New_York')",
+		},
+		// signal action
+		&cli.StringFlag{
+			Name:  FlagSignalWorkflowID,
+			Usage: "Signal this workflow on each fire instead of starting a new workflow",
+		},
+		&cli.StringFlag{
+			Name:  FlagSignalName,
+			Usage: "Name of the signal sent on each fire (required with --signal_workflow_id)",
+		},
+		&cli.StringFlag{
+			Name:  FlagSignalInput,
+			Usage: "Input of the signal sent on each fire (JSON string)",
+		},
+		&cli.BoolFlag{
+			Name:  FlagSignalWithStart,
+			Usage: "Start the signaled workflow from --workflow_type and the other target workflow flags if it is not running",
+		},
 		// action extras
 		&cli.StringFlag{
 			Name:  FlagWorkflowIDPrefix,
@@ -149,6 +180,22 @@ var (
 			Name:  FlagPauseOnFailure,
 			Usage: "Pause the schedule when a triggered workflow fails",
 		},
+		&cli.IntFlag{
+			Name:  FlagMaxConsecutiveFailures,
+			Usage: "With --pause_on_failure, pause only after this many consecutive failed runs (0 = no consecutive limit)",
+		},
+		&cli.Float64Flag{
+			Name:  FlagMaxFailureRate,
+			Usage: "With --pause_on_failure, pause when the fraction of failed runs within --failure_rate_window exceeds this value (e.g. 0.5; 0 = no rate limit)",
+		},
+		&cli.StringFlag{
+			Name:  FlagFailureRateWindow,
+			Usage: "Window for --max_failure_rate (e.g. '24h')",
+		},
+		&cli.IntFlag{
+			Name:  FlagFailureRateMinRuns,
+			Usage: "Minimum runs closed within --failure_rate_window before --max_failure_rate applies",
+		},
 		&cli.IntFlag{
 			Name:  FlagBufferLimit,
 			Usage: "Max buffered runs (only with --overlap_policy buffer; 0 = unlimited)",
@@ -164,12 +211,21 @@ var (
 		},
 	}
 
+	historyScheduleFlags = []cli.Flag{
+		scheduleIDFlag,
+		&cli.BoolFlag{
+			Name:    FlagPrintJSON,
+			Aliases: []string{"pjson"},
+			Usage:   "Print output in JSON format",
+		},
+	}
+
 	updateScheduleFlags = []cli.Flag{
 		scheduleIDFlag,
 		&cli.StringFlag{
 			Name:    FlagCronExpression,
 			Aliases: []string{"ce"},
-			Usage:   "New cron expression (required when updating the schedule spec)",
+			Usage:   "New cron expression (required when updating the schedule spec unless it has intervals or calendars)",
 		},
 		// spec extras
 		&cli.StringFlag{
@@ -186,6 +242,22 @@ var (
 			Name:  FlagJitter,
 			Usage: "New jitter (e.g. '30s', '5m')",
 		},
+		&cli.StringFlag{
+			Name:  FlagInterval,
+			Usage: "New fixed interval(s), space-separated, each as 'interval[/offset]' (e.g. '5m', '1h/15m'). An empty value clears them",
+		},
+		&cli.StringFlag{
+			Name:  FlagCalendar,
+			Usage: "New calendar spec(s) as a JSON object or array. An empty value clears them",
+		},
+		&cli.StringFlag{
+			Name:  FlagExcludeCalendar,
+			Usage: "New calendar spec(s) to skip as a JSON object or array. An empty value clears them",
+		},
+		&cli.StringFlag{
+			Name:  FlagTimeZone,
+			Usage: "IANA time zone for the calendar specs that do not set one (e.g. 'America/New_York')",
+		},
 		// policy flags
 		&cli.StringFlag{
 			Name:  FlagOverlapPolicy,
@@ -207,6 +279,22 @@ var (
 			Name:  FlagPauseOnFailure,
 			Usage: "Pause the schedule when a triggered workflow fails",
 		},
+		&cli.IntFlag{
+			Name:  FlagMaxConsecutiveFailures,
+			Usage: "With --pause_on_failure, pause only after this many consecutive failed runs (0 = no consecutive limit)",
+		},
+		&cli.Float64Flag{
+			Name:  FlagMaxFailureRate,
+			Usage: "With --pause_on_failure, pause when the fraction of failed runs within --failure_rate_window exceeds this value (e.g. 0.5; 0 = no rate limit)",
+		},
+		&cli.StringFlag{
+			Name:  FlagFailureRateWindow,
+			Usage: "Window for --max_failure_rate (e.g. '24h')",
+		},
+		&cli.IntFlag{
+			Name:  FlagFailureRateMinRuns,
+			Usage: "Minimum runs closed within --failure_rate_window before --max_failure_rate applies",
+		},
 		&cli.IntFlag{
 			Name:  FlagBufferLimit,
 			Usage: "New max buffered runs (only with --overlap_policy buffer; 0 = unlimited)",
@@ -259,6 +347,14 @@ var (
 		},
 	}
 
+	triggerScheduleFlags = []cli.Flag{
+		scheduleIDFlag,
+		&cli.StringFlag{
+			Name:  FlagOverlapPolicy,
+			Usage: "Overlap policy for this run only: SkipNew, Buffer, Concurrent, CancelPrevious, TerminatePrevious (defaults to the schedule's policy)",
+		},
+	}
+
 	deleteScheduleFlags = []cli.Flag{
 		scheduleIDFlag,
 	}
@@ -303,6 +399,20 @@ func newScheduleCommands() []*cli.Command {
 				})
 			},
 		},
+		{
+			Name:    "history",
+			Aliases: []string{"hist"},
+			Usage:   "Show the most recent workflows started by a schedule and how they ended",
+			Flags:   historyScheduleFlags,
+			Action: func(c *cli.Context) error {
+				if err := checkNoAdditionalArgsPassed(c); err != nil {
+					return err
+				}
+				return withScheduleClient(c, func(sc *scheduleCLIImpl) error {
+					return sc.ScheduleHistory(c)
+				})
+			},
+		},
 		{
 			Name:    "update",
 			Aliases: []string{"u"},
@@ -373,6 +483,20 @@ func newScheduleCommands() []*cli.Command {
 				})
 			},
 		},
+		{
+			Name:    "trigger",
+			Aliases: []string{"tr"},
+			Usage:   "Start a run of the schedule's action immediately, outside its spec",
+			Flags:   triggerScheduleFlags,
+			Action: func(c *cli.Context) error {
+				if err := checkNoAdditionalArgsPassed(c); err != nil {
+					return err
+				}
+				return withScheduleClient(c, func(sc *scheduleCLIImpl) error {
+					return sc.TriggerSchedule(c)
+				})
+			},
+		},
 		{
 			Name:    "list",
 			Aliases: []string{"l"},
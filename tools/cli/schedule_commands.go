@@ -127,12 +127,23 @@ func (sc *scheduleCLIImpl) CreateSchedule(c *cli.Context) error {
 		}
 		spec.Jitter = d
 	}
+	if err := applyScheduleTriggerFlags(c, spec); err != nil {
+		return err
+	}
+	if !spec.HasTriggers() {
+		return commoncli.Problem("One of --cron_expression, --interval or --calendar is required", nil)
+	}
+
+	scheduleAction, err := buildScheduleActionFromFlags(c, action)
+	if err != nil {
+		return err
+	}
 
 	request := &types.CreateScheduleRequest{
 		Domain:     domain,
 		ScheduleID: scheduleID,
 		Spec:       spec,
-		Action:     &types.ScheduleAction{StartWorkflow: action},
+		Action:     scheduleAction,
 	}
 
 	policies, err := buildPoliciesFromFlags(c, nil)
@@ -199,6 +210,42 @@ func (sc *scheduleCLIImpl) DescribeSchedule(c *cli.Context) error {
 	return nil
 }
 
+func (sc *scheduleCLIImpl) ScheduleHistory(c *cli.Context) error {
+	domain, err := getRequiredOption(c, FlagDomain)
+	if err != nil {
+		return err
+	}
+	scheduleID := c.String(FlagScheduleID)
+	printJSON := c.Bool(FlagPrintJSON)
+
+	ctx, cancel, err := newContext(c)
+	if err != nil {
+		return commoncli.Problem("Error creating context", err)
+	}
+	defer cancel()
+
+	resp, err := sc.frontendClient.DescribeSchedule(ctx, &types.DescribeScheduleRequest{
+		Domain:     domain,
+		ScheduleID: scheduleID,
+	})
+	if err != nil {
+		return commoncli.Problem("Failed to describe schedule", err)
+	}
+	recent := resp.GetInfo().GetRecentActions()
+
+	if printJSON {
+		data, err := json.MarshalIndent(recent, "", "  ")
+		if err != nil {
+			return commoncli.Problem("Failed to marshal response", err)
+		}
+		fmt.Println(string(data))
+		return nil
+	}
+
+	printScheduleHistory(recent)
+	return nil
+}
+
 func (sc *scheduleCLIImpl) UpdateSchedule(c *cli.Context) error {
 	domain, err := getRequiredOption(c, FlagDomain)
 	if err != nil {
@@ -211,7 +258,7 @@ func (sc *scheduleCLIImpl) UpdateSchedule(c *cli.Context) error {
 		ScheduleID: scheduleID,
 	}
 
-	specFlags := []string{FlagCronExpression, FlagStartTime, FlagEndTime, FlagJitter}
+	specFlags := []string{FlagCronExpression, FlagStartTime, FlagEndTime, FlagJitter, FlagInterval, FlagCalendar, FlagExcludeCalendar, FlagTimeZone}
 	specSet := false
 	for _, f := range specFlags {
 		if c.IsSet(f) {
@@ -219,7 +266,7 @@ func (sc *scheduleCLIImpl) UpdateSchedule(c *cli.Context) error {
 			break
 		}
 	}
-	policyFlags := []string{FlagOverlapPolicy, FlagCatchUpPolicy, FlagConcurrencyLimit, FlagCatchUpWindow, FlagPauseOnFailure, FlagBufferLimit}
+	policyFlags := append([]string{FlagOverlapPolicy, FlagCatchUpPolicy, FlagConcurrencyLimit, FlagCatchUpWindow, FlagPauseOnFailure, FlagBufferLimit}, failureBudgetFlags...)
 	policySet := false
 	for _, f := range policyFlags {
 		if c.IsSet(f) {
@@ -257,8 +304,11 @@ func (sc *scheduleCLIImpl) UpdateSchedule(c *cli.Context) error {
 		if c.IsSet(FlagCronExpression) {
 			spec.CronExpression = c.String(FlagCronExpression)
 		}
-		if spec.CronExpression == "" {
-			return commoncli.Problem("--cron_expression is required: the existing schedule has no cron expression set", nil)
+		if err := applyScheduleTriggerFlags(c, spec); err != nil {
+			return err
+		}
+		if !spec.HasTriggers() {
+			return commoncli.Problem("--cron_expression is required unless --interval or --calendar is set: the existing schedule has no cron expression, interval or calendar set", nil)
 		}
 		if c.IsSet(FlagStartTime) {
 			t, err := time.Parse(time.RFC3339, c.String(FlagStartTime))
@@ -451,6 +501,41 @@ func (sc *scheduleCLIImpl) BackfillSchedule(c *cli.Context) error {
 	return nil
 }
 
+func (sc *scheduleCLIImpl) TriggerSchedule(c *cli.Context) error {
+	domain, err := getRequiredOption(c, FlagDomain)
+	if err != nil {
+		return err
+	}
+	scheduleID := c.String(FlagScheduleID)
+
+	request := &types.TriggerScheduleRequest{
+		Domain:     domain,
+		ScheduleID: scheduleID,
+	}
+
+	if c.IsSet(FlagOverlapPolicy) {
+		policy, err := parseOverlapPolicy(c.String(FlagOverlapPolicy))
+		if err != nil {
+			return err
+		}
+		request.OverlapPolicy = policy
+	}
+
+	ctx, cancel, err := newContext(c)
+	if err != nil {
+		return commoncli.Problem("Error creating context", err)
+	}
+	defer cancel()
+
+	_, err = sc.frontendClient.TriggerSchedule(ctx, request)
+	if err != nil {
+		return commoncli.Problem("Failed to trigger schedule", err)
+	}
+
+	fmt.Printf("Schedule %q triggered.\n", scheduleID)
+	return nil
+}
+
 func (sc *scheduleCLIImpl) ListSchedules(c *cli.Context) error {
 	domain, err := getRequiredOption(c, FlagDomain)
 	if err != nil {
@@ -505,7 +590,11 @@ func buildPoliciesFromFlags(c *cli.Context, base *types.SchedulePolicies) (*type
 	hasCatchUpWindow := c.IsSet(FlagCatchUpWindow)
 	hasPauseOnFailure := c.IsSet(FlagPauseOnFailure)
 	hasBufferLimit := c.IsSet(FlagBufferLimit)
-	if !hasOverlap && !hasCatchUp && !hasLimit && !hasCatchUpWindow && !hasPauseOnFailure && !hasBufferLimit {
+	hasFailureBudget := false
+	for _, f := range failureBudgetFlags {
+		hasFailureBudget = hasFailureBudget || c.IsSet(f)
+	}
+	if !hasOverlap && !hasCatchUp && !hasLimit && !hasCatchUpWindow && !hasPauseOnFailure && !hasBufferLimit && !hasFailureBudget {
 		if base != nil {
 			cloned := *base
 			return &cloned, nil
@@ -547,6 +636,24 @@ func buildPoliciesFromFlags(c *cli.Context, base *types.SchedulePolicies) (*type
 	}
 	if hasPauseOnFailure {
 		policies.PauseOnFailure = c.Bool(FlagPauseOnFailure)
+		if !policies.PauseOnFailure {
+			policies.FailureBudget = nil
+		} else if policies.FailureBudget == nil && !hasFailureBudget {
+			// The scheduler only pauses a schedule with a failure budget, pause
+			// on the first failed run unless the budget flags say otherwise.
+			policies.FailureBudget = &types.ScheduleFailureBudget{MaxConsecutiveFailures: 1}
+		}
+	}
+	if hasFailureBudget {
+		if hasPauseOnFailure && !policies.PauseOnFailure {
+			return nil, commoncli.Problem("Failure budget flags cannot be combined with --pause_on_failure=false", nil)
+		}
+		budget, err := buildFailureBudgetFromFlags(c, policies.FailureBudget)
+		if err != nil {
+			return nil, err
+		}
+		policies.PauseOnFailure = true
+		policies.FailureBudget = budget
 	}
 	if hasBufferLimit {
 		limit := int32(c.Int(FlagBufferLimit))
@@ -558,6 +665,179 @@ func buildPoliciesFromFlags(c *cli.Context, base *types.SchedulePolicies) (*type
 	return policies, nil
 }
 
+// failureBudgetFlags are the flags which tune when --pause_on_failure pauses
+// a schedule. Setting any of them turns PauseOnFailure on.
+var failureBudgetFlags = []string{FlagMaxConsecutiveFailures, FlagMaxFailureRate, FlagFailureRateWindow, FlagFailureRateMinRuns}
+
+// buildFailureBudgetFromFlags applies the failure budget flags on top of a copy
+// of base, so that unset flags keep their existing value.
+func buildFailureBudgetFromFlags(c *cli.Context, base *types.ScheduleFailureBudget) (*types.ScheduleFailureBudget, error) {
+	budget := &types.ScheduleFailureBudget{}
+	if base != nil {
+		*budget = *base
+	}
+	if c.IsSet(FlagMaxConsecutiveFailures) {
+		n := int32(c.Int(FlagMaxConsecutiveFailures))
+		if n < 0 {
+			return nil, commoncli.Problem("--max_consecutive_failures must be >= 0", nil)
+		}
+		budget.MaxConsecutiveFailures = n
+	}
+	if c.IsSet(FlagMaxFailureRate) {
+		rate := c.Float64(FlagMaxFailureRate)
+		if rate < 0 || rate >= 1 {
+			return nil, commoncli.Problem("--max_failure_rate must be >= 0 and < 1", nil)
+		}
+		budget.MaxFailureRate = rate
+	}
+	if c.IsSet(FlagFailureRateWindow) {
+		d, err := time.ParseDuration(c.String(FlagFailureRateWindow))
+		if err != nil {
+			return nil, commoncli.Problem("Invalid failure_rate_window format, expected Go duration (e.g. '24h')", err)
+		}
+		budget.FailureRateWindow = d
+	}
+	if c.IsSet(FlagFailureRateMinRuns) {
+		n := int32(c.Int(FlagFailureRateMinRuns))
+		if n < 0 {
+			return nil, commoncli.Problem("--failure_rate_min_runs must be >= 0", nil)
+		}
+		budget.MinRunsInWindow = n
+	}
+	return budget, nil
+}
+
+// buildScheduleActionFromFlags wraps the target workflow built from the CLI flags
+// into a StartWorkflow, SignalWorkflow or SignalWithStartWorkflow action.
+func buildScheduleActionFromFlags(c *cli.Context, start *types.StartWorkflowAction) (*types.ScheduleAction, error) {
+	workflowType := start.GetWorkflowType().GetName()
+	signalWorkflowID := c.String(FlagSignalWorkflowID)
+	if signalWorkflowID == "" {
+		if c.Bool(FlagSignalWithStart) {
+			return nil, commoncli.Problem("--signal_with_start requires --signal_workflow_id", nil)
+		}
+		if workflowType == "" {
+			return nil, commoncli.Problem("--workflow_type is required", nil)
+		}
+		return &types.ScheduleAction{StartWorkflow: start}, nil
+	}
+
+	signalName := c.String(FlagSignalName)
+	if signalName == "" {
+		return nil, commoncli.Problem("--signal_name is required with --signal_workflow_id", nil)
+	}
+	var signalInput []byte
+	if inputStr := c.String(FlagSignalInput); inputStr != "" {
+		if !json.Valid([]byte(inputStr)) {
+			return nil, commoncli.Problem("Signal input is not valid JSON", nil)
+		}
+		signalInput = []byte(inputStr)
+	}
+	if !c.Bool(FlagSignalWithStart) {
+		if workflowType != "" {
+			return nil, commoncli.Problem("--workflow_type requires --signal_with_start when --signal_workflow_id is set", nil)
+		}
+		return &types.ScheduleAction{SignalWorkflow: &types.SignalWorkflowAction{
+			WorkflowID:  signalWorkflowID,
+			SignalName:  signalName,
+			SignalInput: signalInput,
+		}}, nil
+	}
+	if workflowType == "" {
+		return nil, commoncli.Problem("--workflow_type is required with --signal_with_start", nil)
+	}
+	return &types.ScheduleAction{SignalWithStartWorkflow: &types.SignalWithStartWorkflowAction{
+		WorkflowID:    signalWorkflowID,
+		SignalName:    signalName,
+		SignalInput:   signalInput,
+		StartWorkflow: start,
+	}}, nil
+}
+
+// applyScheduleTriggerFlags sets the intervals and calendars of spec from CLI
+// flags. Each flag that is set replaces the corresponding list, and
+// --time_zone fills in the time zone of the calendars that do not set one.
+func applyScheduleTriggerFlags(c *cli.Context, spec *types.ScheduleSpec) error {
+	if c.IsSet(FlagInterval) {
+		intervals, err := parseScheduleIntervals(c.String(FlagInterval))
+		if err != nil {
+			return err
+		}
+		spec.Intervals = intervals
+	}
+	if c.IsSet(FlagCalendar) {
+		calendars, err := parseScheduleCalendars(FlagCalendar, c.String(FlagCalendar))
+		if err != nil {
+			return err
+		}
+		spec.Calendars = calendars
+	}
+	if c.IsSet(FlagExcludeCalendar) {
+		calendars, err := parseScheduleCalendars(FlagExcludeCalendar, c.String(FlagExcludeCalendar))
+		if err != nil {
+			return err
+		}
+		spec.ExcludeCalendars = calendars
+	}
+	if c.IsSet(FlagTimeZone) {
+		tz := c.String(FlagTimeZone)
+		if _, err := time.LoadLocation(tz); err != nil {
+			return commoncli.Problem(fmt.Sprintf("Invalid time_zone %q", tz), err)
+		}
+		for _, calendars := range [][]*types.ScheduleCalendarSpec{spec.Calendars, spec.ExcludeCalendars} {
+			for _, cal := range calendars {
+				if cal.TimeZone == "" {
+					cal.TimeZone = tz
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// parseScheduleIntervals parses space-separated intervals of the form
+// "interval[/offset]", e.g. "5m 1h/15m".
+func parseScheduleIntervals(s string) ([]*types.ScheduleIntervalSpec, error) {
+	var intervals []*types.ScheduleIntervalSpec
+	for _, field := range strings.Fields(s) {
+		intervalStr, offsetStr, hasOffset := strings.Cut(field, "/")
+		interval, err := time.ParseDuration(intervalStr)
+		if err != nil {
+			return nil, commoncli.Problem(fmt.Sprintf("Invalid interval %q, expected 'interval[/offset]' with Go durations (e.g. '1h/15m')", field), err)
+		}
+		spec := &types.ScheduleIntervalSpec{Interval: interval}
+		if hasOffset {
+			offset, err := time.ParseDuration(offsetStr)
+			if err != nil {
+				return nil, commoncli.Problem(fmt.Sprintf("Invalid interval offset %q, expected Go duration (e.g. '15m')", field), err)
+			}
+			spec.Offset = offset
+		}
+		intervals = append(intervals, spec)
+	}
+	return intervals, nil
+}
+
+// parseScheduleCalendars parses a JSON calendar spec object or an array of them.
+func parseScheduleCalendars(flagName, s string) ([]*types.ScheduleCalendarSpec, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return nil, nil
+	}
+	var calendars []*types.ScheduleCalendarSpec
+	if strings.HasPrefix(s, "[") {
+		if err := json.Unmarshal([]byte(s), &calendars); err != nil {
+			return nil, commoncli.Problem(fmt.Sprintf("Invalid %s, expected a JSON object or array of calendar specs", flagName), err)
+		}
+		return calendars, nil
+	}
+	var calendar types.ScheduleCalendarSpec
+	if err := json.Unmarshal([]byte(s), &calendar); err != nil {
+		return nil, commoncli.Problem(fmt.Sprintf("Invalid %s, expected a JSON object or array of calendar specs", flagName), err)
+	}
+	return append(calendars, &calendar), nil
+}
+
 func parseOverlapPolicy(s string) (types.ScheduleOverlapPolicy, error) {
 	switch strings.ToLower(s) {
 	case "skipnew", "skip_new":
@@ -592,7 +872,22 @@ func printDescribeSchedule(resp *types.DescribeScheduleResponse) {
 	fmt.Println("Schedule Configuration:")
 
 	if spec := resp.GetSpec(); spec != nil {
-		fmt.Printf("  Cron Expression:    %s\n", spec.CronExpression)
+		if spec.CronExpression != "" {
+			fmt.Printf("  Cron Expression:    %s\n", spec.CronExpression)
+		}
+		for _, interval := range spec.Intervals {
+			if interval.Offset > 0 {
+				fmt.Printf("  Interval:           %s (offset %s)\n", interval.Interval, interval.Offset)
+			} else {
+				fmt.Printf("  Interval:           %s\n", interval.Interval)
+			}
+		}
+		for _, cal := range spec.Calendars {
+			fmt.Printf("  Calendar:           %s\n", formatScheduleCalendar(cal))
+		}
+		for _, cal := range spec.ExcludeCalendars {
+			fmt.Printf("  Exclude Calendar:   %s\n", formatScheduleCalendar(cal))
+		}
 		if !spec.StartTime.IsZero() {
 			fmt.Printf("  Start Time:         %s\n", spec.StartTime.UTC().Format(time.RFC3339))
 		}
@@ -606,31 +901,19 @@ func printDescribeSchedule(resp *types.DescribeScheduleResponse) {
 
 	if action := resp.GetAction(); action != nil {
 		if sw := action.StartWorkflow; sw != nil {
-			if sw.WorkflowType != nil {
-				fmt.Printf("  Workflow Type:      %s\n", sw.WorkflowType.Name)
-			}
-			if sw.TaskList != nil {
-				fmt.Printf("  Task List:          %s\n", sw.TaskList.Name)
-			}
-			if sw.WorkflowIDPrefix != "" {
-				fmt.Printf("  Workflow ID Prefix: %s\n", sw.WorkflowIDPrefix)
-			}
-			if sw.ExecutionStartToCloseTimeoutSeconds != nil {
-				fmt.Printf("  Execution Timeout:  %ds\n", *sw.ExecutionStartToCloseTimeoutSeconds)
-			}
-			if sw.TaskStartToCloseTimeoutSeconds != nil {
-				fmt.Printf("  Decision Timeout:   %ds\n", *sw.TaskStartToCloseTimeoutSeconds)
-			}
-			if rp := sw.RetryPolicy; rp != nil {
-				fmt.Printf("  Retry Policy:       max_attempts=%d initial=%ds backoff=%.1f",
-					rp.MaximumAttempts, rp.InitialIntervalInSeconds, rp.BackoffCoefficient)
-				if rp.MaximumIntervalInSeconds > 0 {
-					fmt.Printf(" max_interval=%ds", rp.MaximumIntervalInSeconds)
-				}
-				if rp.ExpirationIntervalInSeconds > 0 {
-					fmt.Printf(" expiration=%ds", rp.ExpirationIntervalInSeconds)
-				}
-				fmt.Println()
+			printStartWorkflowAction(sw)
+		}
+		if sig := action.SignalWorkflow; sig != nil {
+			fmt.Printf("  Action:             SignalWorkflow\n")
+			fmt.Printf("  Signal Workflow ID: %s\n", sig.WorkflowID)
+			fmt.Printf("  Signal Name:        %s\n", sig.SignalName)
+		}
+		if sws := action.SignalWithStartWorkflow; sws != nil {
+			fmt.Printf("  Action:             SignalWithStartWorkflow\n")
+			fmt.Printf("  Signal Workflow ID: %s\n", sws.WorkflowID)
+			fmt.Printf("  Signal Name:        %s\n", sws.SignalName)
+			if sws.StartWorkflow != nil {
+				printStartWorkflowAction(sws.StartWorkflow)
 			}
 		}
 	}
@@ -643,6 +926,15 @@ func printDescribeSchedule(resp *types.DescribeScheduleResponse) {
 		}
 		if policies.PauseOnFailure {
 			fmt.Printf("  Pause On Failure:   true\n")
+			if budget := policies.FailureBudget; budget != nil {
+				if budget.MaxConsecutiveFailures > 0 {
+					fmt.Printf("    Max Consecutive Failures: %d\n", budget.MaxConsecutiveFailures)
+				}
+				if budget.MaxFailureRate > 0 {
+					fmt.Printf("    Max Failure Rate:         %.2f over %s (min %d runs)\n",
+						budget.MaxFailureRate, budget.FailureRateWindow, budget.MinRunsInWindow)
+				}
+			}
 		}
 		if policies.BufferLimit > 0 || policies.OverlapPolicy == types.ScheduleOverlapPolicyBuffer {
 			fmt.Printf("  Buffer Limit:       %d (0=unlimited)\n", policies.BufferLimit)
@@ -717,3 +1009,87 @@ func printDescribeSchedule(resp *types.DescribeScheduleResponse) {
 		}
 	}
 }
+
+// printScheduleHistory prints one line per recent action, newest first.
+func printScheduleHistory(recent []*types.ScheduleActionResult) {
+	if len(recent) == 0 {
+		fmt.Println("No recent actions found.")
+		return
+	}
+
+	fmt.Printf("  %-20s  %-20s  %-10s  %-16s  %-40s  %s\n",
+		"SCHEDULED", "STARTED", "TRIGGER", "STATUS", "WORKFLOW ID", "RUN ID")
+	for i := len(recent) - 1; i >= 0; i-- {
+		action := recent[i]
+		if action == nil {
+			continue
+		}
+		status := "Running"
+		if action.CloseStatus != nil {
+			status = action.CloseStatus.String()
+		}
+		fmt.Printf("  %-20s  %-20s  %-10s  %-16s  %-40s  %s\n",
+			action.ScheduledTime.UTC().Format(time.RFC3339),
+			action.ActualStartTime.UTC().Format(time.RFC3339),
+			action.TriggerSource,
+			status,
+			action.WorkflowID,
+			action.RunID,
+		)
+	}
+}
+
+// formatScheduleCalendar renders the fields of a calendar spec which are set.
+func formatScheduleCalendar(cal *types.ScheduleCalendarSpec) string {
+	var parts []string
+	for _, f := range []struct{ name, value string }{
+		{"second", cal.Second},
+		{"minute", cal.Minute},
+		{"hour", cal.Hour},
+		{"day_of_month", cal.DayOfMonth},
+		{"month", cal.Month},
+		{"day_of_week", cal.DayOfWeek},
+		{"year", cal.Year},
+		{"time_zone", cal.TimeZone},
+	} {
+		if f.value != "" {
+			parts = append(parts, f.name+"="+f.value)
+		}
+	}
+	out := strings.Join(parts, " ")
+	if cal.Comment != "" {
+		out += " # " + cal.Comment
+	}
+	return out
+}
+
+// printStartWorkflowAction prints the target workflow of a StartWorkflow or
+// SignalWithStartWorkflow action.
+func printStartWorkflowAction(sw *types.StartWorkflowAction) {
+	if sw.WorkflowType != nil {
+		fmt.Printf("  Workflow Type:      %s\n", sw.WorkflowType.Name)
+	}
+	if sw.TaskList != nil {
+		fmt.Printf("  Task List:          %s\n", sw.TaskList.Name)
+	}
+	if sw.WorkflowIDPrefix != "" {
+		fmt.Printf("  Workflow ID Prefix: %s\n", sw.WorkflowIDPrefix)
+	}
+	if sw.ExecutionStartToCloseTimeoutSeconds != nil {
+		fmt.Printf("  Execution Timeout:  %ds\n", *sw.ExecutionStartToCloseTimeoutSeconds)
+	}
+	if sw.TaskStartToCloseTimeoutSeconds != nil {
+		fmt.Printf("  Decision Timeout:   %ds\n", *sw.TaskStartToCloseTimeoutSeconds)
+	}
+	if rp := sw.RetryPolicy; rp != nil {
+		fmt.Printf("  Retry Policy:       max_attempts=%d initial=%ds backoff=%.1f",
+			rp.MaximumAttempts, rp.InitialIntervalInSeconds, rp.BackoffCoefficient)
+		if rp.MaximumIntervalInSeconds > 0 {
+			fmt.Printf(" max_interval=%ds", rp.MaximumIntervalInSeconds)
+		}
+		if rp.ExpirationIntervalInSeconds > 0 {
+			fmt.Printf(" expiration=%ds", rp.ExpirationIntervalInSeconds)
+		}
+		fmt.Println()
+	}
+}
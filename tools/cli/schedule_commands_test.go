@@ -238,6 +238,62 @@ func TestPrintDescribeSchedule_NoOngoingBackfills(t *testing.T) {
 	assert.NotContains(t, out, "Ongoing Backfills")
 }
 
+func TestScheduleCLI_ScheduleHistory(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	mockClient := frontend.NewMockClient(mockCtrl)
+	app := newScheduleTestApp(t, mockClient)
+
+	mockClient.EXPECT().DescribeSchedule(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ interface{}, req *types.DescribeScheduleRequest, _ ...interface{}) (*types.DescribeScheduleResponse, error) {
+			assert.Equal(t, "test-domain", req.Domain)
+			assert.Equal(t, "my-sched", req.ScheduleID)
+			return &types.DescribeScheduleResponse{
+				Info: &types.ScheduleInfo{
+					RecentActions: []*types.ScheduleActionResult{
+						{WorkflowID: "wf-1", RunID: "run-1", TriggerSource: "schedule"},
+					},
+				},
+			}, nil
+		})
+
+	c := newScheduleCLIContext(app, map[string]string{
+		FlagScheduleID: "my-sched",
+	})
+	sc := &scheduleCLIImpl{frontendClient: mockClient}
+	out := captureStdout(t, func() { assert.NoError(t, sc.ScheduleHistory(c)) })
+	assert.Contains(t, out, "wf-1")
+}
+
+func TestPrintScheduleHistory(t *testing.T) {
+	recent := []*types.ScheduleActionResult{
+		{
+			ScheduledTime:   time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC),
+			ActualStartTime: time.Date(2026, 3, 1, 0, 0, 2, 0, time.UTC),
+			WorkflowID:      "wf-old",
+			RunID:           "run-old",
+			TriggerSource:   "schedule",
+			CloseStatus:     types.WorkflowExecutionCloseStatusFailed.Ptr(),
+		},
+		nil, // nil entries must be tolerated
+		{
+			ScheduledTime:   time.Date(2026, 3, 1, 1, 0, 0, 0, time.UTC),
+			ActualStartTime: time.Date(2026, 3, 1, 1, 0, 1, 0, time.UTC),
+			WorkflowID:      "wf-new",
+			RunID:           "run-new",
+			TriggerSource:   "backfill",
+		},
+	}
+
+	out := captureStdout(t, func() { printScheduleHistory(recent) })
+	assert.Contains(t, out, "2026-03-01T00:00:02Z")
+	assert.Contains(t, out, "FAILED")
+	assert.Contains(t, out, "Running")
+	assert.Less(t, strings.Index(out, "wf-new"), strings.Index(out, "wf-old"), "newest action is printed first")
+
+	out = captureStdout(t, func() { printScheduleHistory(nil) })
+	assert.Equal(t, "No recent actions found.", out)
+}
+
 // captureStdout runs fn with os.Stdout redirected to a pipe and returns
 // what fn wrote. Used to assert against printDescribeSchedule output.
 func captureStdout(t *testing.T, fn func()) string {
@@ -615,6 +671,68 @@ func TestScheduleCLI_BackfillSchedule_InvalidTimeFormat(t *testing.T) {
 	assert.Contains(t, err.Error(), "Invalid start_time format")
 }
 
+func TestScheduleCLI_TriggerSchedule(t *testing.T) {
+	tests := map[string]struct {
+		args       []string
+		wantPolicy types.ScheduleOverlapPolicy
+		wantErr    string
+		expectCall bool
+	}{
+		"default overlap policy": {
+			args:       nil,
+			wantPolicy: types.ScheduleOverlapPolicyInvalid,
+			expectCall: true,
+		},
+		"overlap override": {
+			args:       []string{"--" + FlagOverlapPolicy, "concurrent"},
+			wantPolicy: types.ScheduleOverlapPolicyConcurrent,
+			expectCall: true,
+		},
+		"invalid overlap policy": {
+			args:    []string{"--" + FlagOverlapPolicy, "bogus"},
+			wantErr: "Unknown overlap policy",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			mockClient := frontend.NewMockClient(mockCtrl)
+			if tt.expectCall {
+				mockClient.EXPECT().TriggerSchedule(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ interface{}, req *types.TriggerScheduleRequest, _ ...interface{}) (*types.TriggerScheduleResponse, error) {
+						assert.Equal(t, "test-domain", req.Domain)
+						assert.Equal(t, "my-sched", req.ScheduleID)
+						assert.Equal(t, tt.wantPolicy, req.OverlapPolicy)
+						return &types.TriggerScheduleResponse{}, nil
+					})
+			}
+
+			app := newScheduleTestApp(t, mockClient)
+			set := flag.NewFlagSet("test", 0)
+			set.String(FlagDomain, "", "")
+			set.String(FlagTransport, "", "")
+			set.String(FlagScheduleID, "", "")
+			set.String(FlagOverlapPolicy, "", "")
+			set.Parse(append([]string{
+				"--" + FlagDomain, "test-domain",
+				"--" + FlagTransport, grpcTransport,
+				"--" + FlagScheduleID, "my-sched",
+			}, tt.args...))
+			c := cli.NewContext(app, set, nil)
+
+			sc := &scheduleCLIImpl{frontendClient: mockClient}
+			err := sc.TriggerSchedule(c)
+			if tt.wantErr != "" {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestScheduleCLI_ListSchedules(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 	mockClient := frontend.NewMockClient(mockCtrl)
@@ -1059,9 +1177,12 @@ func TestScheduleCLI_BuildPoliciesFromFlags_WithNewFields(t *testing.T) {
 			wantResult: &types.SchedulePolicies{CatchUpWindow: 2 * time.Hour},
 		},
 		{
-			name:       "pause_on_failure set",
-			args:       []string{"--" + FlagPauseOnFailure},
-			wantResult: &types.SchedulePolicies{PauseOnFailure: true},
+			name: "pause_on_failure set",
+			args: []string{"--" + FlagPauseOnFailure},
+			wantResult: &types.SchedulePolicies{
+				PauseOnFailure: true,
+				FailureBudget:  &types.ScheduleFailureBudget{MaxConsecutiveFailures: 1},
+			},
 		},
 		{
 			name:       "buffer_limit set",
@@ -1079,6 +1200,7 @@ func TestScheduleCLI_BuildPoliciesFromFlags_WithNewFields(t *testing.T) {
 				CatchUpWindow:  30 * time.Minute,
 				PauseOnFailure: true,
 				BufferLimit:    10,
+				FailureBudget:  &types.ScheduleFailureBudget{MaxConsecutiveFailures: 1},
 			},
 		},
 		{
@@ -1107,6 +1229,108 @@ func TestScheduleCLI_BuildPoliciesFromFlags_WithNewFields(t *testing.T) {
 	}
 }
 
+func TestScheduleCLI_BuildPoliciesFromFlags_FailureBudget(t *testing.T) {
+	app := cli.NewApp()
+
+	makeCtx := func(args []string) *cli.Context {
+		set := flag.NewFlagSet("test", 0)
+		set.Bool(FlagPauseOnFailure, false, "")
+		set.Int(FlagMaxConsecutiveFailures, 0, "")
+		set.Float64(FlagMaxFailureRate, 0, "")
+		set.String(FlagFailureRateWindow, "", "")
+		set.Int(FlagFailureRateMinRuns, 0, "")
+		_ = set.Parse(args)
+		return cli.NewContext(app, set, nil)
+	}
+
+	tests := []struct {
+		name       string
+		args       []string
+		base       *types.SchedulePolicies
+		wantResult *types.SchedulePolicies
+		wantErr    bool
+	}{
+		{
+			name: "budget flags turn pause_on_failure on",
+			args: []string{"--" + FlagMaxConsecutiveFailures, "3"},
+			wantResult: &types.SchedulePolicies{
+				PauseOnFailure: true,
+				FailureBudget:  &types.ScheduleFailureBudget{MaxConsecutiveFailures: 3},
+			},
+		},
+		{
+			name: "failure rate flags",
+			args: []string{
+				"--" + FlagMaxFailureRate, "0.5",
+				"--" + FlagFailureRateWindow, "24h",
+				"--" + FlagFailureRateMinRuns, "4",
+			},
+			wantResult: &types.SchedulePolicies{
+				PauseOnFailure: true,
+				FailureBudget: &types.ScheduleFailureBudget{
+					MaxFailureRate:    0.5,
+					FailureRateWindow: 24 * time.Hour,
+					MinRunsInWindow:   4,
+				},
+			},
+		},
+		{
+			name: "unset budget flags keep the base values",
+			args: []string{"--" + FlagMaxConsecutiveFailures, "5"},
+			base: &types.SchedulePolicies{
+				PauseOnFailure: true,
+				FailureBudget:  &types.ScheduleFailureBudget{MaxConsecutiveFailures: 2, MaxFailureRate: 0.2, FailureRateWindow: time.Hour},
+			},
+			wantResult: &types.SchedulePolicies{
+				PauseOnFailure: true,
+				FailureBudget:  &types.ScheduleFailureBudget{MaxConsecutiveFailures: 5, MaxFailureRate: 0.2, FailureRateWindow: time.Hour},
+			},
+		},
+		{
+			name: "disabling pause_on_failure clears the budget",
+			args: []string{"--" + FlagPauseOnFailure + "=false"},
+			base: &types.SchedulePolicies{
+				PauseOnFailure: true,
+				FailureBudget:  &types.ScheduleFailureBudget{MaxConsecutiveFailures: 2},
+			},
+			wantResult: &types.SchedulePolicies{},
+		},
+		{
+			name:    "budget flags with pause_on_failure=false return error",
+			args:    []string{"--" + FlagPauseOnFailure + "=false", "--" + FlagMaxConsecutiveFailures, "2"},
+			wantErr: true,
+		},
+		{
+			name:    "failure rate of 1 returns error",
+			args:    []string{"--" + FlagMaxFailureRate, "1"},
+			wantErr: true,
+		},
+		{
+			name:    "invalid failure_rate_window returns error",
+			args:    []string{"--" + FlagFailureRateWindow, "daily"},
+			wantErr: true,
+		},
+		{
+			name:    "negative max_consecutive_failures returns error",
+			args:    []string{"--" + FlagMaxConsecutiveFailures, "-1"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := makeCtx(tt.args)
+			got, err := buildPoliciesFromFlags(c, tt.base)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.wantResult, got)
+			}
+		})
+	}
+}
+
 func TestScheduleCLI_PrintDescribeSchedule_AllFields(t *testing.T) {
 	pausedAt := time.Date(2024, 6, 1, 9, 0, 0, 0, time.UTC)
 	lastRun := time.Date(2024, 6, 1, 10, 0, 0, 0, time.UTC)
@@ -1254,3 +1478,182 @@ func TestScheduleCLI_CreateSchedule_InvalidJitter(t *testing.T) {
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "jitter")
 }
+
+func TestScheduleCLI_CreateSchedule_IntervalsAndCalendars(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	mockClient := frontend.NewMockClient(mockCtrl)
+	app := newScheduleTestApp(t, mockClient)
+
+	mockClient.EXPECT().CreateSchedule(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ interface{}, req *types.CreateScheduleRequest, _ ...interface{}) (*types.CreateScheduleResponse, error) {
+			assert.Empty(t, req.Spec.CronExpression)
+			assert.Equal(t, []*types.ScheduleIntervalSpec{
+				{Interval: 5 * time.Minute},
+				{Interval: time.Hour, Offset: 15 * time.Minute},
+			}, req.Spec.Intervals)
+			assert.Equal(t, []*types.ScheduleCalendarSpec{
+				{Hour: "9", DayOfMonth: "L", DayOfWeek: "MON-FRI", TimeZone: "America/New_York"},
+			}, req.Spec.Calendars)
+			assert.Equal(t, []*types.ScheduleCalendarSpec{
+				{Month: "12", DayOfMonth: "25", TimeZone: "America/New_York"},
+				{Month: "1", DayOfMonth: "1", TimeZone: "UTC"},
+			}, req.Spec.ExcludeCalendars)
+			return &types.CreateScheduleResponse{ScheduleID: "s"}, nil
+		})
+
+	set := flag.NewFlagSet("test", 0)
+	set.String(FlagDomain, "", "")
+	set.String(FlagTransport, "", "")
+	set.String(FlagScheduleID, "", "")
+	set.String(FlagWorkflowType, "", "")
+	set.Int(FlagExecutionTimeout, 0, "")
+	set.Int(FlagDecisionTimeout, 0, "")
+	set.String(FlagInterval, "", "")
+	set.String(FlagCalendar, "", "")
+	set.String(FlagExcludeCalendar, "", "")
+	set.String(FlagTimeZone, "", "")
+	_ = set.Parse([]string{
+		"--" + FlagDomain, "test-domain",
+		"--" + FlagTransport, grpcTransport,
+		"--" + FlagScheduleID, "s",
+		"--" + FlagWorkflowType, "wf",
+		"--" + FlagExecutionTimeout, "3600",
+		"--" + FlagDecisionTimeout, "10",
+		"--" + FlagInterval, "5m 1h/15m",
+		"--" + FlagCalendar, `{"hour":"9","dayOfMonth":"L","dayOfWeek":"MON-FRI"}`,
+		"--" + FlagExcludeCalendar, `[{"month":"12","dayOfMonth":"25"},{"month":"1","dayOfMonth":"1","timeZone":"UTC"}]`,
+		"--" + FlagTimeZone, "America/New_York",
+	})
+	c := cli.NewContext(app, set, nil)
+	sc := &scheduleCLIImpl{frontendClient: mockClient}
+	assert.NoError(t, sc.CreateSchedule(c))
+}
+
+func TestScheduleCLI_CreateSchedule_NoTriggers(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	mockClient := frontend.NewMockClient(mockCtrl)
+	app := newScheduleTestApp(t, mockClient)
+
+	set := flag.NewFlagSet("test", 0)
+	set.String(FlagDomain, "", "")
+	set.String(FlagTransport, "", "")
+	set.String(FlagScheduleID, "", "")
+	set.String(FlagWorkflowType, "", "")
+	set.Int(FlagExecutionTimeout, 0, "")
+	set.Int(FlagDecisionTimeout, 0, "")
+	_ = set.Parse([]string{
+		"--" + FlagDomain, "test-domain",
+		"--" + FlagTransport, grpcTransport,
+		"--" + FlagScheduleID, "s",
+		"--" + FlagWorkflowType, "wf",
+		"--" + FlagExecutionTimeout, "3600",
+		"--" + FlagDecisionTimeout, "10",
+	})
+	c := cli.NewContext(app, set, nil)
+	sc := &scheduleCLIImpl{frontendClient: mockClient}
+	err := sc.CreateSchedule(c)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "--interval or --calendar is required")
+}
+
+func TestScheduleCLI_ParseScheduleIntervals(t *testing.T) {
+	tests := map[string]struct {
+		input   string
+		want    []*types.ScheduleIntervalSpec
+		wantErr bool
+	}{
+		"empty clears":       {input: "", want: nil},
+		"interval":           {input: "30m", want: []*types.ScheduleIntervalSpec{{Interval: 30 * time.Minute}}},
+		"interval w/ offset": {input: "1h/15m", want: []*types.ScheduleIntervalSpec{{Interval: time.Hour, Offset: 15 * time.Minute}}},
+		"invalid interval":   {input: "often", wantErr: true},
+		"invalid offset":     {input: "1h/later", wantErr: true},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := parseScheduleIntervals(tt.input)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestScheduleCLI_BuildScheduleActionFromFlags(t *testing.T) {
+	start := &types.StartWorkflowAction{WorkflowType: &types.WorkflowType{Name: "wf"}}
+	noType := &types.StartWorkflowAction{WorkflowType: &types.WorkflowType{}}
+	tests := []struct {
+		name    string
+		start   *types.StartWorkflowAction
+		args    []string
+		want    *types.ScheduleAction
+		wantErr string
+	}{
+		{
+			name:  "start workflow",
+			start: start,
+			want:  &types.ScheduleAction{StartWorkflow: start},
+		},
+		{
+			name:    "start workflow requires workflow type",
+			start:   noType,
+			wantErr: "--workflow_type is required",
+		},
+		{
+			name:  "signal workflow",
+			start: noType,
+			args:  []string{"--" + FlagSignalWorkflowID, "entity", "--" + FlagSignalName, "tick", "--" + FlagSignalInput, `{"n":1}`},
+			want: &types.ScheduleAction{SignalWorkflow: &types.SignalWorkflowAction{
+				WorkflowID: "entity", SignalName: "tick", SignalInput: []byte(`{"n":1}`),
+			}},
+		},
+		{
+			name:    "signal workflow requires signal name",
+			start:   noType,
+			args:    []string{"--" + FlagSignalWorkflowID, "entity"},
+			wantErr: "--signal_name is required",
+		},
+		{
+			name:    "signal workflow rejects workflow type",
+			start:   start,
+			args:    []string{"--" + FlagSignalWorkflowID, "entity", "--" + FlagSignalName, "tick"},
+			wantErr: "--workflow_type requires --signal_with_start",
+		},
+		{
+			name:  "signal with start",
+			start: start,
+			args:  []string{"--" + FlagSignalWorkflowID, "entity", "--" + FlagSignalName, "tick", "--" + FlagSignalWithStart},
+			want: &types.ScheduleAction{SignalWithStartWorkflow: &types.SignalWithStartWorkflowAction{
+				WorkflowID: "entity", SignalName: "tick", StartWorkflow: start,
+			}},
+		},
+		{
+			name:    "signal with start requires signal workflow ID",
+			start:   start,
+			args:    []string{"--" + FlagSignalWithStart},
+			wantErr: "--signal_with_start requires --signal_workflow_id",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			set := flag.NewFlagSet("test", 0)
+			set.String(FlagSignalWorkflowID, "", "")
+			set.String(FlagSignalName, "", "")
+			set.String(FlagSignalInput, "", "")
+			set.Bool(FlagSignalWithStart, false, "")
+			require.NoError(t, set.Parse(tt.args))
+			c := cli.NewContext(cli.NewApp(), set, nil)
+
+			got, err := buildScheduleActionFromFlags(c, tt.start)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
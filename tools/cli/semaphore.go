@@ -0,0 +1,84 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import cli "github.com/urfave/cli/v2"
+
+func newSemaphoreCommands() []*cli.Command {
+	return []*cli.Command{
+		{
+			Name:  "create",
+			Usage: "Create a semaphore in a domain",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     FlagSemaphoreName,
+					Usage:    "Name of the semaphore",
+					Required: true,
+				},
+				&cli.IntFlag{
+					Name:     FlagSemaphoreSize,
+					Usage:    "Number of permits of the semaphore",
+					Required: true,
+				},
+				&cli.IntFlag{
+					Name:  FlagBucketSize,
+					Usage: "Optional number of permits per storage bucket, defaults to the server default",
+				},
+			},
+			Action: CreateSemaphore,
+		},
+		{
+			Name:    "describe",
+			Aliases: []string{"desc"},
+			Usage:   "Describe the configuration, holders and waiters of a semaphore",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     FlagSemaphoreName,
+					Usage:    "Name of the semaphore",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:  FlagFormat,
+					Usage: "Output format, table or json",
+				},
+			},
+			Action: DescribeSemaphore,
+		},
+		{
+			Name:    "list",
+			Aliases: []string{"l"},
+			Usage:   "List the semaphores of a domain",
+			Flags: []cli.Flag{
+				&cli.IntFlag{
+					Name:    FlagPageSize,
+					Aliases: []string{"ps"},
+					Value:   100,
+					Usage:   "Number of semaphores fetched per request",
+				},
+				&cli.StringFlag{
+					Name:  FlagFormat,
+					Usage: "Output format, table or json",
+				},
+			},
+			Action: ListSemaphores,
+		},
+	}
+}
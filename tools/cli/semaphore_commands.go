@@ -0,0 +1,202 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/urfave/cli/v2"
+
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/tools/common/commoncli"
+)
+
+type (
+	// SemaphoreRow is a row of the semaphore list table
+	SemaphoreRow struct {
+		Name        string    `header:"Name"`
+		Size        int32     `header:"Size"`
+		BucketSize  int32     `header:"Bucket Size"`
+		CreatedTime time.Time `header:"Created Time"`
+	}
+
+	// SemaphorePermitRow is a row of the holders or waiters table of a semaphore
+	SemaphorePermitRow struct {
+		RequestID     string    `header:"Request ID"`
+		WorkflowID    string    `header:"Workflow ID"`
+		RunID         string    `header:"Run ID"`
+		Permits       int32     `header:"Permits"`
+		RequestedTime time.Time `header:"Requested Time"`
+		AcquiredTime  time.Time `header:"Acquired Time"`
+	}
+)
+
+// CreateSemaphore creates a semaphore in a domain
+func CreateSemaphore(c *cli.Context) error {
+	frontendClient, err := getDeps(c).ServerFrontendClient(c)
+	if err != nil {
+		return err
+	}
+
+	domain, err := getRequiredOption(c, FlagDomain)
+	if err != nil {
+		return commoncli.Problem("Required flag not found", err)
+	}
+	name, err := getRequiredOption(c, FlagSemaphoreName)
+	if err != nil {
+		return commoncli.Problem("Required flag not found", err)
+	}
+
+	ctx, cancel, err := newContext(c)
+	defer cancel()
+	if err != nil {
+		return commoncli.Problem("Error in creating context: ", err)
+	}
+	resp, err := frontendClient.CreateSemaphore(ctx, &types.CreateSemaphoreRequest{
+		Domain:        domain,
+		SemaphoreName: name,
+		Size:          int32(c.Int(FlagSemaphoreSize)),
+		BucketSize:    int32(c.Int(FlagBucketSize)),
+	})
+	if err != nil {
+		return commoncli.Problem("Create semaphore failed", err)
+	}
+	fmt.Fprintf(getDeps(c).Output(), "Semaphore %s created with %d permits.\n", name, resp.GetSemaphore().GetSize())
+	return nil
+}
+
+// DescribeSemaphore describes the configuration, holders and waiters of a semaphore
+func DescribeSemaphore(c *cli.Context) error {
+	frontendClient, err := getDeps(c).ServerFrontendClient(c)
+	if err != nil {
+		return err
+	}
+
+	domain, err := getRequiredOption(c, FlagDomain)
+	if err != nil {
+		return commoncli.Problem("Required flag not found", err)
+	}
+	name, err := getRequiredOption(c, FlagSemaphoreName)
+	if err != nil {
+		return commoncli.Problem("Required flag not found", err)
+	}
+
+	ctx, cancel, err := newContext(c)
+	defer cancel()
+	if err != nil {
+		return commoncli.Problem("Error in creating context: ", err)
+	}
+	resp, err := frontendClient.DescribeSemaphore(ctx, &types.DescribeSemaphoreRequest{
+		Domain:        domain,
+		SemaphoreName: name,
+	})
+	if err != nil {
+		return commoncli.Problem("Describe semaphore failed", err)
+	}
+
+	output := getDeps(c).Output()
+	if c.String(FlagFormat) == formatJSON {
+		prettyPrintJSONObject(output, resp)
+		return nil
+	}
+
+	semaphore := resp.GetSemaphore()
+	fmt.Fprintf(output, "Semaphore %s: %d of %d permits available\n", semaphore.GetName(), resp.GetAvailablePermits(), semaphore.GetSize())
+	fmt.Fprintln(output, "Holders:")
+	if err := RenderTable(output, toSemaphorePermitRows(resp.GetHolders()), RenderOptions{Color: true, Border: true}); err != nil {
+		return fmt.Errorf("failed to render holders: %w", err)
+	}
+	fmt.Fprintln(output, "Waiters:")
+	if err := RenderTable(output, toSemaphorePermitRows(resp.GetWaiters()), RenderOptions{Color: true, Border: true}); err != nil {
+		return fmt.Errorf("failed to render waiters: %w", err)
+	}
+	return nil
+}
+
+// ListSemaphores lists the semaphores of a domain
+func ListSemaphores(c *cli.Context) error {
+	frontendClient, err := getDeps(c).ServerFrontendClient(c)
+	if err != nil {
+		return err
+	}
+
+	domain, err := getRequiredOption(c, FlagDomain)
+	if err != nil {
+		return commoncli.Problem("Required flag not found", err)
+	}
+
+	ctx, cancel, err := newContext(c)
+	defer cancel()
+	if err != nil {
+		return commoncli.Problem("Error in creating context: ", err)
+	}
+
+	var semaphores []*types.SemaphoreInfo
+	var pageToken []byte
+	for {
+		resp, err := frontendClient.ListSemaphores(ctx, &types.ListSemaphoresRequest{
+			Domain:        domain,
+			PageSize:      int32(c.Int(FlagPageSize)),
+			NextPageToken: pageToken,
+		})
+		if err != nil {
+			return commoncli.Problem("List semaphores failed", err)
+		}
+		semaphores = append(semaphores, resp.GetSemaphores()...)
+		pageToken = resp.GetNextPageToken()
+		if len(pageToken) == 0 {
+			break
+		}
+	}
+
+	output := getDeps(c).Output()
+	if c.String(FlagFormat) == formatJSON {
+		prettyPrintJSONObject(output, semaphores)
+		return nil
+	}
+
+	table := make([]SemaphoreRow, 0, len(semaphores))
+	for _, s := range semaphores {
+		table = append(table, SemaphoreRow{
+			Name:        s.GetName(),
+			Size:        s.GetSize(),
+			BucketSize:  s.GetBucketSize(),
+			CreatedTime: s.GetCreatedTime(),
+		})
+	}
+	return RenderTable(output, table, RenderOptions{Color: true, Border: true})
+}
+
+func toSemaphorePermitRows(requests []*types.SemaphorePermitRequest) []SemaphorePermitRow {
+	rows := make([]SemaphorePermitRow, 0, len(requests))
+	for _, r := range requests {
+		rows = append(rows, SemaphorePermitRow{
+			RequestID:     r.GetRequestID(),
+			WorkflowID:    r.GetExecution().GetWorkflowID(),
+			RunID:         r.GetExecution().GetRunID(),
+			Permits:       r.GetPermits(),
+			RequestedTime: r.GetRequestedTime(),
+			AcquiredTime:  r.GetAcquiredTime(),
+		})
+	}
+	return rows
+}
@@ -0,0 +1,162 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/client/frontend"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/tools/cli/clitest"
+)
+
+func TestSemaphoreCommands(t *testing.T) {
+	createdTime := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	info := &types.SemaphoreInfo{Name: "downstream", Size: 3, BucketSize: 100, CreatedTime: createdTime}
+
+	tests := []struct {
+		name          string
+		setupMocks    func(*frontend.MockClient)
+		cmdline       string
+		expectedError string
+		expectedStrs  []string
+	}{
+		{
+			name: "create",
+			setupMocks: func(client *frontend.MockClient) {
+				client.EXPECT().CreateSemaphore(gomock.Any(), &types.CreateSemaphoreRequest{
+					Domain:        "test-domain",
+					SemaphoreName: "downstream",
+					Size:          3,
+				}).Return(&types.CreateSemaphoreResponse{Semaphore: info}, nil)
+			},
+			cmdline:      "cadence --domain test-domain semaphore create --semaphore downstream --size 3",
+			expectedStrs: []string{"Semaphore downstream created with 3 permits."},
+		},
+		{
+			name:          "create without size",
+			setupMocks:    func(client *frontend.MockClient) {},
+			cmdline:       "cadence --domain test-domain semaphore create --semaphore downstream",
+			expectedError: "size",
+		},
+		{
+			name: "create failed",
+			setupMocks: func(client *frontend.MockClient) {
+				client.EXPECT().CreateSemaphore(gomock.Any(), gomock.Any()).
+					Return(nil, &types.BadRequestError{Message: "already exists"})
+			},
+			cmdline:       "cadence --domain test-domain semaphore create --semaphore downstream --size 3",
+			expectedError: "Create semaphore failed",
+		},
+		{
+			name: "describe",
+			setupMocks: func(client *frontend.MockClient) {
+				client.EXPECT().DescribeSemaphore(gomock.Any(), &types.DescribeSemaphoreRequest{
+					Domain:        "test-domain",
+					SemaphoreName: "downstream",
+				}).Return(&types.DescribeSemaphoreResponse{
+					Semaphore:        info,
+					AvailablePermits: 1,
+					Holders: []*types.SemaphorePermitRequest{{
+						RequestID:    "request-1",
+						Execution:    &types.WorkflowExecution{WorkflowID: "holder-wid", RunID: "holder-rid"},
+						Permits:      2,
+						AcquiredTime: createdTime,
+					}},
+					Waiters: []*types.SemaphorePermitRequest{{
+						RequestID: "request-2",
+						Execution: &types.WorkflowExecution{WorkflowID: "waiter-wid", RunID: "waiter-rid"},
+						Permits:   3,
+					}},
+				}, nil)
+			},
+			cmdline:      "cadence --domain test-domain semaphore describe --semaphore downstream",
+			expectedStrs: []string{"1 of 3 permits available", "holder-wid", "waiter-wid"},
+		},
+		{
+			name: "describe not found",
+			setupMocks: func(client *frontend.MockClient) {
+				client.EXPECT().DescribeSemaphore(gomock.Any(), gomock.Any()).
+					Return(nil, &types.EntityNotExistsError{Message: "not found"})
+			},
+			cmdline:       "cadence --domain test-domain semaphore describe --semaphore downstream",
+			expectedError: "Describe semaphore failed",
+		},
+		{
+			name: "list all pages",
+			setupMocks: func(client *frontend.MockClient) {
+				gomock.InOrder(
+					client.EXPECT().ListSemaphores(gomock.Any(), &types.ListSemaphoresRequest{
+						Domain:   "test-domain",
+						PageSize: 1,
+					}).Return(&types.ListSemaphoresResponse{
+						Semaphores:    []*types.SemaphoreInfo{info},
+						NextPageToken: []byte("next"),
+					}, nil),
+					client.EXPECT().ListSemaphores(gomock.Any(), &types.ListSemaphoresRequest{
+						Domain:        "test-domain",
+						PageSize:      1,
+						NextPageToken: []byte("next"),
+					}).Return(&types.ListSemaphoresResponse{
+						Semaphores: []*types.SemaphoreInfo{{Name: "other", Size: 5, BucketSize: 100, CreatedTime: createdTime}},
+					}, nil),
+				)
+			},
+			cmdline:      "cadence --domain test-domain semaphore list --pagesize 1",
+			expectedStrs: []string{"downstream", "other"},
+		},
+		{
+			name: "list failed",
+			setupMocks: func(client *frontend.MockClient) {
+				client.EXPECT().ListSemaphores(gomock.Any(), gomock.Any()).
+					Return(nil, &types.InternalServiceError{Message: "error"})
+			},
+			cmdline:       "cadence --domain test-domain semaphore list",
+			expectedError: "List semaphores failed",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			frontendClient := frontend.NewMockClient(mockCtrl)
+			tt.setupMocks(frontendClient)
+			ioHandler := &testIOHandler{}
+			app := NewCliApp(&clientFactoryMock{
+				serverFrontendClient: frontendClient,
+			}, WithIOHandler(ioHandler))
+
+			err := clitest.RunCommandLine(t, app, tt.cmdline)
+			if tt.expectedError != "" {
+				assert.ErrorContains(t, err, tt.expectedError)
+				return
+			}
+			assert.NoError(t, err)
+			for _, expected := range tt.expectedStrs {
+				assert.Contains(t, ioHandler.outputBytes.String(), expected)
+			}
+		})
+	}
+}
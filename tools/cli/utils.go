@@ -458,6 +458,12 @@ func getEventAttributes(e *types.HistoryEvent) interface{} {
 	case types.EventTypeExternalWorkflowExecutionSignaled:
 		data = e.ExternalWorkflowExecutionSignaledEventAttributes
 
+	case types.EventTypeWorkflowExecutionPaused:
+		data = e.WorkflowExecutionPausedEventAttributes
+
+	case types.EventTypeWorkflowExecutionUnpaused:
+		data = e.WorkflowExecutionUnpausedEventAttributes
+
 	default:
 		data = e
 	}
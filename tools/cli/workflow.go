@@ -97,6 +97,24 @@ func newWorkflowCommands() []*cli.Command {
 			Flags:   getFlagsForCancel(),
 			Action:  CancelWorkflow,
 		},
+		{
+			Name:   "pause",
+			Usage:  "pause a workflow execution, no decision, activity or timer is dispatched until it is unpaused",
+			Flags:  getFlagsForPause(),
+			Action: PauseWorkflow,
+		},
+		{
+			Name:   "unpause",
+			Usage:  "unpause a paused workflow execution",
+			Flags:  getFlagsForUnpause(),
+			Action: UnpauseWorkflow,
+		},
+		{
+			Name:   "delete",
+			Usage:  "permanently delete a closed workflow execution, including its history and archived history",
+			Flags:  getFlagsForDelete(),
+			Action: DeleteWorkflow,
+		},
 		{
 			Name:    "signal",
 			Aliases: []string{"s"},
@@ -104,6 +122,12 @@ func newWorkflowCommands() []*cli.Command {
 			Flags:   getFlagsForSignal(),
 			Action:  SignalWorkflow,
 		},
+		{
+			Name:   "update",
+			Usage:  "update a workflow execution and wait for the result of the update",
+			Flags:  getFlagsForUpdate(),
+			Action: UpdateWorkflow,
+		},
 		{
 			Name:   "signalwithstart",
 			Usage:  "signal the current open workflow if exists, or attempt to start a new run based on IDResuePolicy and signals it",
@@ -441,6 +465,30 @@ func newActivityCommands() []*cli.Command {
 			},
 			Action: FailActivity,
 		},
+		{
+			Name:   "pause",
+			Usage:  "pause a pending activity, its next attempts are not dispatched until it is unpaused",
+			Flags:  getFlagsForActivityOperation(),
+			Action: PauseActivity,
+		},
+		{
+			Name:   "unpause",
+			Usage:  "unpause a paused activity",
+			Flags:  getFlagsForActivityOperation(),
+			Action: UnpauseActivity,
+		},
+		{
+			Name:   "reset",
+			Usage:  "reset the attempt count of a pending activity to 0",
+			Flags:  getFlagsForActivityOperation(),
+			Action: ResetActivityAttempt,
+		},
+		{
+			Name:   "retry",
+			Usage:  "dispatch the next attempt of an activity waiting for its retry backoff now",
+			Flags:  getFlagsForActivityOperation(),
+			Action: RetryActivityNow,
+		},
 	}
 }
 
@@ -518,7 +566,7 @@ func newBatchCommands() []*cli.Command {
 				&cli.StringFlag{
 					Name:    FlagInput,
 					Aliases: []string{"in"},
-					Usage:   "Optional input of signal",
+					Usage:   "Optional input of signal, or the input template of batch template",
 				},
 				&cli.StringFlag{
 					Name:    FlagSourceCluster,
@@ -530,6 +578,51 @@ func newBatchCommands() []*cli.Command {
 					Aliases: []string{"tc"},
 					Usage:   "Required for batch replicate",
 				},
+				&cli.StringFlag{
+					Name:  FlagResetType,
+					Usage: "Required for batch reset, where to reset. Support one of these: " + strings.Join(batcher.AllResetTypes, ","),
+				},
+				&cli.StringFlag{
+					Name:  FlagResetBadBinaryChecksum,
+					Usage: "Required for batch reset with resetType BadBinary, the binary checksum to reset",
+				},
+				&cli.BoolFlag{
+					Name:  FlagSkipSignalReapply,
+					Usage: "Optional for batch reset, whether or not skipping signals reapply after the reset point",
+				},
+				&cli.BoolFlag{
+					Name:  FlagDryRun,
+					Usage: "Optional for batch reset, only report the reset point chosen for each workflow in the job progress without resetting",
+				},
+				&cli.StringFlag{
+					Name:  FlagTemplateOperation,
+					Usage: "Required for batch template, operations supported: " + strings.Join(batcher.AllTemplateOperations, ","),
+				},
+				&cli.StringFlag{
+					Name:  FlagWorkflowIDTemplate,
+					Usage: "Required for batch template start_workflow, the template of the started workflow ID",
+				},
+				&cli.StringFlag{
+					Name:    FlagWorkflowType,
+					Aliases: []string{"wt"},
+					Usage:   "Required for batch template start_workflow, WorkflowTypeName of the started workflow",
+				},
+				&cli.StringFlag{
+					Name:    FlagTaskList,
+					Aliases: []string{"tl"},
+					Usage:   "Required for batch template start_workflow, TaskList of the started workflow",
+				},
+				&cli.IntFlag{
+					Name:    FlagExecutionTimeout,
+					Aliases: []string{"et"},
+					Usage:   "Required for batch template start_workflow, execution start to close timeout of the started workflow in seconds",
+				},
+				&cli.IntFlag{
+					Name:    FlagDecisionTimeout,
+					Aliases: []string{"dt"},
+					Value:   int(batcher.DefaultTemplateDecisionTimeout / time.Second),
+					Usage:   "Optional for batch template start_workflow, decision task start to close timeout of the started workflow in seconds",
+				},
 				&cli.IntFlag{
 					Name:  FlagRPS,
 					Value: batcher.DefaultRPS,
@@ -228,6 +228,28 @@ func StartBatchJob(c *cli.Context) error {
 			return commoncli.Problem("Required flag not found: ", err)
 		}
 	}
+	var resetParams batcher.ResetParams
+	if batchType == batcher.BatchTypeReset {
+		resetParams.ResetType, err = getRequiredOption(c, FlagResetType)
+		if err != nil {
+			return commoncli.Problem("Required flag not found: ", err)
+		}
+		if !validateBatchResetType(resetParams.ResetType) {
+			return commoncli.Problem("resetType is not valid for batch reset, supported:"+strings.Join(batcher.AllResetTypes, ","), nil)
+		}
+		if resetParams.ResetType == batcher.ResetTypeBadBinary {
+			resetParams.BadBinaryChecksum, err = getRequiredOption(c, FlagResetBadBinaryChecksum)
+			if err != nil {
+				return commoncli.Problem("Required flag not found: ", err)
+			}
+		}
+		resetParams.SkipSignalReapply = c.Bool(FlagSkipSignalReapply)
+		resetParams.DryRun = c.Bool(FlagDryRun)
+	}
+	templateParams, err := getBatchTemplateParams(c, batchType)
+	if err != nil {
+		return err
+	}
 	rps := c.Int(FlagRPS)
 	pageSize := c.Int(FlagPageSize)
 	concurrency := c.Int(FlagConcurrency)
@@ -292,6 +314,8 @@ func StartBatchJob(c *cli.Context) error {
 			SourceCluster: sourceCluster,
 			TargetCluster: targetCluster,
 		},
+		ResetParams:              resetParams,
+		TemplateParams:           templateParams,
 		RPS:                      rps,
 		Concurrency:              concurrency,
 		PageSize:                 pageSize,
@@ -366,3 +390,54 @@ func validateBatchType(bt string) bool {
 	}
 	return false
 }
+
+func getBatchTemplateParams(c *cli.Context, batchType string) (batcher.TemplateParams, error) {
+	var params batcher.TemplateParams
+	if batchType != batcher.BatchTypeTemplate {
+		return params, nil
+	}
+	var err error
+	params.Operation, err = getRequiredOption(c, FlagTemplateOperation)
+	if err != nil {
+		return params, commoncli.Problem("Required flag not found: ", err)
+	}
+	params.InputTemplate = c.String(FlagInput)
+	switch params.Operation {
+	case batcher.TemplateOperationSignal:
+		params.SignalName, err = getRequiredOption(c, FlagSignalName)
+		if err != nil {
+			return params, commoncli.Problem("Required flag not found: ", err)
+		}
+	case batcher.TemplateOperationStartWorkflow:
+		params.WorkflowIDTemplate, err = getRequiredOption(c, FlagWorkflowIDTemplate)
+		if err != nil {
+			return params, commoncli.Problem("Required flag not found: ", err)
+		}
+		params.WorkflowType, err = getRequiredOption(c, FlagWorkflowType)
+		if err != nil {
+			return params, commoncli.Problem("Required flag not found: ", err)
+		}
+		params.TaskList, err = getRequiredOption(c, FlagTaskList)
+		if err != nil {
+			return params, commoncli.Problem("Required flag not found: ", err)
+		}
+		executionTimeout := c.Int(FlagExecutionTimeout)
+		if executionTimeout <= 0 {
+			return params, commoncli.Problem("Required flag not found: ", fmt.Errorf("option %s is required", FlagExecutionTimeout))
+		}
+		params.ExecutionStartToCloseTimeout = time.Duration(executionTimeout) * time.Second
+		params.DecisionStartToCloseTimeout = time.Duration(c.Int(FlagDecisionTimeout)) * time.Second
+	default:
+		return params, commoncli.Problem("templateOperation is not valid, supported:"+strings.Join(batcher.AllTemplateOperations, ","), nil)
+	}
+	return params, nil
+}
+
+func validateBatchResetType(rt string) bool {
+	for _, r := range batcher.AllResetTypes {
+		if r == rt {
+			return true
+		}
+	}
+	return false
+}
@@ -21,14 +21,17 @@
 package cli
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"flag"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/urfave/cli/v2"
 	"go.uber.org/mock/gomock"
+	"go.uber.org/yarpc"
 
 	"github.com/uber/cadence/client/frontend"
 	"github.com/uber/cadence/common"
@@ -86,6 +89,146 @@ func TestStartBatchJob(t *testing.T) {
 			expectedError:  "",
 			expectedOutput: "batch job is started",
 		},
+		{
+			name: "Valid Start Reset Batch Job",
+			setup: func(mockClient *frontend.MockClient) {
+				mockClient.EXPECT().CountWorkflowExecutions(gomock.Any(), gomock.Any()).Return(&types.CountWorkflowExecutionsResponse{
+					Count: 100,
+				}, nil)
+				mockClient.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, req *types.StartWorkflowExecutionRequest, _ ...yarpc.CallOption) (*types.StartWorkflowExecutionResponse, error) {
+						var params batcher.BatchParams
+						assert.NoError(t, json.Unmarshal(req.Input, &params))
+						assert.Equal(t, batcher.ResetParams{
+							ResetType:         batcher.ResetTypeBadBinary,
+							BadBinaryChecksum: "bad-checksum",
+							DryRun:            true,
+						}, params.ResetParams)
+						return &types.StartWorkflowExecutionResponse{RunID: "run-id-example"}, nil
+					})
+			},
+			flags: map[string]interface{}{
+				FlagDomain:                 "test-domain",
+				FlagListQuery:              "workflowType='batch'",
+				FlagReason:                 "Testing batch reset job",
+				FlagBatchType:              batcher.BatchTypeReset,
+				FlagResetType:              batcher.ResetTypeBadBinary,
+				FlagResetBadBinaryChecksum: "bad-checksum",
+				FlagDryRun:                 true,
+				FlagYes:                    true,
+			},
+			expectedError:  "",
+			expectedOutput: "batch job is started",
+		},
+		{
+			name:  "Missing Reset Type",
+			setup: func(mockClient *frontend.MockClient) {},
+			flags: map[string]interface{}{
+				FlagDomain:    "test-domain",
+				FlagListQuery: "workflowType='batch'",
+				FlagReason:    "Testing batch reset job",
+				FlagBatchType: batcher.BatchTypeReset,
+			},
+			expectedError: "Required flag not found: : option reset_type is required",
+		},
+		{
+			name:  "Invalid Reset Type",
+			setup: func(mockClient *frontend.MockClient) {},
+			flags: map[string]interface{}{
+				FlagDomain:    "test-domain",
+				FlagListQuery: "workflowType='batch'",
+				FlagReason:    "Testing batch reset job",
+				FlagBatchType: batcher.BatchTypeReset,
+				FlagResetType: "LastContinuedAsNew",
+			},
+			expectedError: "resetType is not valid for batch reset, supported:LastDecisionCompleted,FirstDecisionCompleted,BadBinary",
+		},
+		{
+			name:  "Missing Bad Binary Checksum",
+			setup: func(mockClient *frontend.MockClient) {},
+			flags: map[string]interface{}{
+				FlagDomain:    "test-domain",
+				FlagListQuery: "workflowType='batch'",
+				FlagReason:    "Testing batch reset job",
+				FlagBatchType: batcher.BatchTypeReset,
+				FlagResetType: batcher.ResetTypeBadBinary,
+			},
+			expectedError: "Required flag not found: : option reset_bad_binary_checksum is required",
+		},
+		{
+			name: "Valid Start Template Batch Job",
+			setup: func(mockClient *frontend.MockClient) {
+				mockClient.EXPECT().CountWorkflowExecutions(gomock.Any(), gomock.Any()).Return(&types.CountWorkflowExecutionsResponse{
+					Count: 100,
+				}, nil)
+				mockClient.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, req *types.StartWorkflowExecutionRequest, _ ...yarpc.CallOption) (*types.StartWorkflowExecutionResponse, error) {
+						var params batcher.BatchParams
+						assert.NoError(t, json.Unmarshal(req.Input, &params))
+						assert.Equal(t, batcher.TemplateParams{
+							Operation:                    batcher.TemplateOperationStartWorkflow,
+							InputTemplate:                "{{json .WorkflowID}}",
+							WorkflowIDTemplate:           "{{.WorkflowID}}-companion",
+							WorkflowType:                 "companion-type",
+							TaskList:                     "companion-tasklist",
+							ExecutionStartToCloseTimeout: time.Hour,
+							DecisionStartToCloseTimeout:  10 * time.Second,
+						}, params.TemplateParams)
+						return &types.StartWorkflowExecutionResponse{RunID: "run-id-example"}, nil
+					})
+			},
+			flags: map[string]interface{}{
+				FlagDomain:             "test-domain",
+				FlagListQuery:          "workflowType='batch'",
+				FlagReason:             "Testing batch template job",
+				FlagBatchType:          batcher.BatchTypeTemplate,
+				FlagTemplateOperation:  batcher.TemplateOperationStartWorkflow,
+				FlagInput:              "{{json .WorkflowID}}",
+				FlagWorkflowIDTemplate: "{{.WorkflowID}}-companion",
+				FlagWorkflowType:       "companion-type",
+				FlagTaskList:           "companion-tasklist",
+				FlagExecutionTimeout:   3600,
+				FlagDecisionTimeout:    10,
+				FlagYes:                true,
+			},
+			expectedError:  "",
+			expectedOutput: "batch job is started",
+		},
+		{
+			name:  "Missing Template Operation",
+			setup: func(mockClient *frontend.MockClient) {},
+			flags: map[string]interface{}{
+				FlagDomain:    "test-domain",
+				FlagListQuery: "workflowType='batch'",
+				FlagReason:    "Testing batch template job",
+				FlagBatchType: batcher.BatchTypeTemplate,
+			},
+			expectedError: "Required flag not found: : option template_operation is required",
+		},
+		{
+			name:  "Invalid Template Operation",
+			setup: func(mockClient *frontend.MockClient) {},
+			flags: map[string]interface{}{
+				FlagDomain:            "test-domain",
+				FlagListQuery:         "workflowType='batch'",
+				FlagReason:            "Testing batch template job",
+				FlagBatchType:         batcher.BatchTypeTemplate,
+				FlagTemplateOperation: "terminate",
+			},
+			expectedError: "templateOperation is not valid, supported:signal,start_workflow",
+		},
+		{
+			name:  "Missing Template Workflow ID",
+			setup: func(mockClient *frontend.MockClient) {},
+			flags: map[string]interface{}{
+				FlagDomain:            "test-domain",
+				FlagListQuery:         "workflowType='batch'",
+				FlagReason:            "Testing batch template job",
+				FlagBatchType:         batcher.BatchTypeTemplate,
+				FlagTemplateOperation: batcher.TemplateOperationStartWorkflow,
+			},
+			expectedError: "Required flag not found: : option workflow_id_template is required",
+		},
 		{
 			name:  "Missing Domain",
 			setup: func(mockClient *frontend.MockClient) {},
@@ -560,6 +703,11 @@ func TestValidateBatchType(t *testing.T) {
 			batchType: batcher.BatchTypeRefresh,
 			expected:  true,
 		},
+		{
+			name:      "Valid batch type - reset",
+			batchType: batcher.BatchTypeReset,
+			expected:  true,
+		},
 		{
 			name:      "Invalid batch type",
 			batchType: "invalid",
@@ -45,6 +45,7 @@ import (
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/clock"
 	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/common/visibility"
 	"github.com/uber/cadence/service/history/execution"
 	"github.com/uber/cadence/tools/common/commoncli"
 )
@@ -743,6 +744,143 @@ func TerminateWorkflow(c *cli.Context) error {
 	return nil
 }
 
+// PauseWorkflow pauses a workflow execution
+func PauseWorkflow(c *cli.Context) error {
+	wfClient, err := getWorkflowClient(c)
+	if err != nil {
+		return err
+	}
+
+	domain, err := getRequiredOption(c, FlagDomain)
+	if err != nil {
+		return commoncli.Problem("Required flag not found: ", err)
+	}
+	wid, err := getRequiredOption(c, FlagWorkflowID)
+	if err != nil {
+		return commoncli.Problem("Required flag not found: ", err)
+	}
+	rid := c.String(FlagRunID)
+	reason := c.String(FlagReason)
+
+	ctx, cancel, err := newContext(c)
+	defer cancel()
+	if err != nil {
+		return commoncli.Problem("Error creating context: ", err)
+	}
+	err = wfClient.PauseWorkflowExecution(
+		ctx,
+		&types.PauseWorkflowExecutionRequest{
+			Domain: domain,
+			WorkflowExecution: &types.WorkflowExecution{
+				WorkflowID: wid,
+				RunID:      rid,
+			},
+			Reason:    reason,
+			Identity:  getCliIdentity(),
+			RequestID: uuid.New(),
+		},
+	)
+
+	if err != nil {
+		return commoncli.Problem("Pause workflow failed.", err)
+	}
+	fmt.Println("Pause workflow succeeded.")
+
+	return nil
+}
+
+// UnpauseWorkflow unpauses a paused workflow execution
+func UnpauseWorkflow(c *cli.Context) error {
+	wfClient, err := getWorkflowClient(c)
+	if err != nil {
+		return err
+	}
+
+	domain, err := getRequiredOption(c, FlagDomain)
+	if err != nil {
+		return commoncli.Problem("Required flag not found: ", err)
+	}
+	wid, err := getRequiredOption(c, FlagWorkflowID)
+	if err != nil {
+		return commoncli.Problem("Required flag not found: ", err)
+	}
+	rid := c.String(FlagRunID)
+	reason := c.String(FlagReason)
+
+	ctx, cancel, err := newContext(c)
+	defer cancel()
+	if err != nil {
+		return commoncli.Problem("Error creating context: ", err)
+	}
+	err = wfClient.UnpauseWorkflowExecution(
+		ctx,
+		&types.UnpauseWorkflowExecutionRequest{
+			Domain: domain,
+			WorkflowExecution: &types.WorkflowExecution{
+				WorkflowID: wid,
+				RunID:      rid,
+			},
+			Reason:    reason,
+			Identity:  getCliIdentity(),
+			RequestID: uuid.New(),
+		},
+	)
+
+	if err != nil {
+		return commoncli.Problem("Unpause workflow failed.", err)
+	}
+	fmt.Println("Unpause workflow succeeded.")
+
+	return nil
+}
+
+// DeleteWorkflow permanently deletes a closed workflow execution together with its history
+func DeleteWorkflow(c *cli.Context) error {
+	wfClient, err := getWorkflowClient(c)
+	if err != nil {
+		return err
+	}
+
+	domain, err := getRequiredOption(c, FlagDomain)
+	if err != nil {
+		return commoncli.Problem("Required flag not found: ", err)
+	}
+	wid, err := getRequiredOption(c, FlagWorkflowID)
+	if err != nil {
+		return commoncli.Problem("Required flag not found: ", err)
+	}
+	rid, err := getRequiredOption(c, FlagRunID)
+	if err != nil {
+		return commoncli.Problem("Required flag not found: ", err)
+	}
+	reason := c.String(FlagReason)
+
+	ctx, cancel, err := newContext(c)
+	defer cancel()
+	if err != nil {
+		return commoncli.Problem("Error creating context: ", err)
+	}
+	err = wfClient.DeleteWorkflowExecution(
+		ctx,
+		&types.DeleteWorkflowExecutionRequest{
+			Domain: domain,
+			WorkflowExecution: &types.WorkflowExecution{
+				WorkflowID: wid,
+				RunID:      rid,
+			},
+			Reason:   reason,
+			Identity: getCliIdentity(),
+		},
+	)
+
+	if err != nil {
+		return commoncli.Problem("Delete workflow failed.", err)
+	}
+	fmt.Println("Delete workflow accepted, the workflow and its history will be removed shortly.")
+
+	return nil
+}
+
 // CancelWorkflow cancels a workflow execution
 func CancelWorkflow(c *cli.Context) error {
 	wfClient, err := getWorkflowClient(c)
@@ -837,6 +975,70 @@ func SignalWorkflow(c *cli.Context) error {
 	return nil
 }
 
+// UpdateWorkflow updates a workflow execution and prints the result of the update
+func UpdateWorkflow(c *cli.Context) error {
+	serviceClient, err := getDeps(c).ServerFrontendClient(c)
+	if err != nil {
+		return err
+	}
+
+	domain, err := getRequiredOption(c, FlagDomain)
+	if err != nil {
+		return commoncli.Problem("Required flag not found: ", err)
+	}
+	wid, err := getRequiredOption(c, FlagWorkflowID)
+	if err != nil {
+		return commoncli.Problem("Required flag not found: ", err)
+	}
+	rid := c.String(FlagRunID)
+	name, err := getRequiredOption(c, FlagName)
+	if err != nil {
+		return commoncli.Problem("Required flag not found: ", err)
+	}
+	updateID := c.String(FlagUpdateID)
+	if updateID == "" {
+		updateID = uuid.New()
+	}
+	input, err := processJSONInput(c)
+	if err != nil {
+		return commoncli.Problem("Error proccessing JSON input: ", err)
+	}
+	tcCtx, cancel, err := newContext(c)
+	defer cancel()
+	if err != nil {
+		return commoncli.Problem("Error creating context: ", err)
+	}
+	resp, err := serviceClient.UpdateWorkflowExecution(
+		tcCtx,
+		&types.UpdateWorkflowExecutionRequest{
+			Domain: domain,
+			WorkflowExecution: &types.WorkflowExecution{
+				WorkflowID: wid,
+				RunID:      rid,
+			},
+			UpdateID:   updateID,
+			UpdateName: name,
+			Input:      []byte(input),
+			Identity:   getCliIdentity(),
+		},
+	)
+	if err != nil {
+		return commoncli.Problem("Update workflow failed.", err)
+	}
+
+	if resp.FailureReason != nil {
+		return commoncli.Problem(
+			fmt.Sprintf("Update %v failed with reason: %v", resp.GetUpdateID(), resp.GetFailureReason()),
+			errors.New(string(resp.GetFailureDetails())),
+		)
+	}
+	fmt.Printf("Update %v succeeded.\n", resp.GetUpdateID())
+	if len(resp.GetResult()) > 0 {
+		fmt.Print(string(resp.GetResult()))
+	}
+	return nil
+}
+
 // SignalWithStartWorkflowExecution starts a workflow execution if not already exists and signals it
 func SignalWithStartWorkflowExecution(c *cli.Context) error {
 	serviceClient, err := getDeps(c).ServerFrontendClient(c)
@@ -1030,6 +1232,21 @@ func isQueryOpen(query string) bool {
 	return openWFPattern.MatchString(query)
 }
 
+// getListQuery returns the visibility query given by --query, or the reference to the query saved in the domain under --saved-query
+func getListQuery(c *cli.Context) (string, error) {
+	if !c.IsSet(FlagSavedQuery) {
+		return c.String(FlagListQuery), nil
+	}
+	if c.IsSet(FlagListQuery) {
+		return "", commoncli.Problem(optionErr, fmt.Errorf("you can use --%s or --%s, but not both", FlagListQuery, FlagSavedQuery))
+	}
+	name := c.String(FlagSavedQuery)
+	if err := visibility.ValidateSavedQueryName(name); err != nil {
+		return "", commoncli.Problem(optionErr, err)
+	}
+	return visibility.SavedQueryReference(name), nil
+}
+
 // CountWorkflow count number of workflows
 func CountWorkflow(c *cli.Context) error {
 	wfClient, err := getWorkflowClient(c)
@@ -1041,7 +1258,13 @@ func CountWorkflow(c *cli.Context) error {
 	if err != nil {
 		return commoncli.Problem("Required flag not found: ", err)
 	}
-	query := c.String(FlagListQuery)
+	query, err := getListQuery(c)
+	if err != nil {
+		return err
+	}
+	if c.IsSet(FlagGroupBy) {
+		return countWorkflowByGroup(c, wfClient, domain, query)
+	}
 	request := &types.CountWorkflowExecutionsRequest{
 		Domain: domain,
 		Query:  query,
@@ -1061,6 +1284,40 @@ func CountWorkflow(c *cli.Context) error {
 	return nil
 }
 
+// WorkflowGroupRow is a row of the grouped workflow count table
+type WorkflowGroupRow struct {
+	Group string `header:"Group"`
+	Count int64  `header:"Count"`
+}
+
+func countWorkflowByGroup(c *cli.Context, wfClient frontend.Client, domain, query string) error {
+	request := &types.AggregateWorkflowExecutionsRequest{
+		Domain:    domain,
+		Query:     query,
+		GroupBy:   c.String(FlagGroupBy),
+		MaxGroups: int32(c.Int(FlagMaxGroups)),
+	}
+
+	ctx, cancel, err := newContextForLongPoll(c)
+	defer cancel()
+	if err != nil {
+		return commoncli.Problem("Error creating context: ", err)
+	}
+	response, err := wfClient.AggregateWorkflowExecutions(ctx, request)
+	if err != nil {
+		return commoncli.Problem("Failed to count workflow by group.", err)
+	}
+
+	table := []WorkflowGroupRow{}
+	for _, group := range response.GetGroups() {
+		table = append(table, WorkflowGroupRow{
+			Group: group.GetKey(),
+			Count: group.GetCount(),
+		})
+	}
+	return Render(c, table, RenderOptions{DefaultTemplate: templateTable, Color: true, Border: true})
+}
+
 // ListArchivedWorkflow lists archived workflow executions based on filters
 func ListArchivedWorkflow(c *cli.Context) error {
 	printAll := c.Bool(FlagAll)
@@ -1205,6 +1462,7 @@ type workflowExecutionInfo struct {
 	PartitionConfig              map[string]string
 	CronOverlapPolicy            *types.CronOverlapPolicy
 	ActiveClusterSelectionPolicy *types.ActiveClusterSelectionPolicy
+	IsPaused                     bool `json:",omitempty"`
 }
 
 // pendingActivityInfo has same fields as types.PendingActivityInfo, but different field type for better display
@@ -1223,6 +1481,7 @@ type pendingActivityInfo struct {
 	LastWorkerIdentity     string  `json:",omitempty"`
 	LastFailureDetails     *string `json:",omitempty"` // change from []byte
 	ScheduleID             int64   `json:",omitempty"`
+	IsPaused               bool    `json:",omitempty"`
 }
 
 type pendingDecisionInfo struct {
@@ -1257,6 +1516,7 @@ func convertDescribeWorkflowExecutionResponse(resp *types.DescribeWorkflowExecut
 		PartitionConfig:              info.PartitionConfig,
 		CronOverlapPolicy:            info.CronOverlapPolicy,
 		ActiveClusterSelectionPolicy: info.ActiveClusterSelectionPolicy,
+		IsPaused:                     info.GetIsPaused(),
 	}
 
 	var pendingActs []*pendingActivityInfo
@@ -1275,6 +1535,7 @@ func convertDescribeWorkflowExecutionResponse(resp *types.DescribeWorkflowExecut
 			LastFailureReason:      pa.LastFailureReason,
 			LastWorkerIdentity:     pa.LastWorkerIdentity,
 			ScheduleID:             pa.ScheduleID,
+			IsPaused:               pa.IsPaused,
 		}
 		if pa.HeartbeatDetails != nil {
 			tmpAct.HeartbeatDetails = common.StringPtr(string(pa.HeartbeatDetails))
@@ -1722,6 +1983,11 @@ func listWorkflows(c *cli.Context) (getWorkflowPageFn, error) {
 		return nil, commoncli.Problem(optionErr, errors.New("you can filter on workflow_id or workflow_type, but not on both"))
 	}
 
+	listQuery, err := getListQuery(c)
+	if err != nil {
+		return nil, err
+	}
+
 	ctx, cancel, err := newContext(c)
 	defer cancel()
 	if err != nil {
@@ -1731,7 +1997,7 @@ func listWorkflows(c *cli.Context) (getWorkflowPageFn, error) {
 		ctx,
 		&types.CountWorkflowExecutionsRequest{
 			Domain: domain,
-			Query:  c.String(FlagListQuery),
+			Query:  listQuery,
 		},
 	)
 	if err == nil {
@@ -1741,8 +2007,7 @@ func listWorkflows(c *cli.Context) (getWorkflowPageFn, error) {
 		}
 	}
 
-	if c.IsSet(FlagListQuery) {
-		listQuery := c.String(FlagListQuery)
+	if c.IsSet(FlagListQuery) || c.IsSet(FlagSavedQuery) {
 		return listWorkflowExecutions(wfClient, pageSize, domain, listQuery, c), nil
 	} else if queryOpen {
 		return listOpenWorkflow(wfClient, pageSize, earliestTime, latestTime, domain, workflowID, workflowType, c), nil
@@ -1800,7 +2065,10 @@ func scanWorkflows(c *cli.Context) (getWorkflowPageFn, error) {
 	if err != nil {
 		return nil, err
 	}
-	listQuery := c.String(FlagListQuery)
+	listQuery, err := getListQuery(c)
+	if err != nil {
+		return nil, err
+	}
 	pageSize := c.Int(FlagPageSize)
 	if pageSize <= 0 {
 		pageSize = defaultPageSizeForScan
@@ -2695,6 +2963,138 @@ func FailActivity(c *cli.Context) error {
 	return nil
 }
 
+// PauseActivity pauses a pending activity
+func PauseActivity(c *cli.Context) error {
+	domain, wfExecution, activityID, err := getActivityOperationArgs(c)
+	if err != nil {
+		return err
+	}
+	ctx, cancel, err := newContext(c)
+	defer cancel()
+	if err != nil {
+		return commoncli.Problem("Error creating context: ", err)
+	}
+	frontendClient, err := getDeps(c).ServerFrontendClient(c)
+	if err != nil {
+		return err
+	}
+	err = frontendClient.PauseActivity(ctx, &types.PauseActivityRequest{
+		Domain:            domain,
+		WorkflowExecution: wfExecution,
+		ActivityID:        activityID,
+		Identity:          getCliIdentity(),
+	})
+	if err != nil {
+		return commoncli.Problem("Pause activity failed.", err)
+	}
+	fmt.Println("Pause activity succeeded.")
+	return nil
+}
+
+// UnpauseActivity unpauses a paused activity
+func UnpauseActivity(c *cli.Context) error {
+	domain, wfExecution, activityID, err := getActivityOperationArgs(c)
+	if err != nil {
+		return err
+	}
+	ctx, cancel, err := newContext(c)
+	defer cancel()
+	if err != nil {
+		return commoncli.Problem("Error creating context: ", err)
+	}
+	frontendClient, err := getDeps(c).ServerFrontendClient(c)
+	if err != nil {
+		return err
+	}
+	err = frontendClient.UnpauseActivity(ctx, &types.UnpauseActivityRequest{
+		Domain:            domain,
+		WorkflowExecution: wfExecution,
+		ActivityID:        activityID,
+		Identity:          getCliIdentity(),
+	})
+	if err != nil {
+		return commoncli.Problem("Unpause activity failed.", err)
+	}
+	fmt.Println("Unpause activity succeeded.")
+	return nil
+}
+
+// ResetActivityAttempt resets the attempt count of a pending activity
+func ResetActivityAttempt(c *cli.Context) error {
+	domain, wfExecution, activityID, err := getActivityOperationArgs(c)
+	if err != nil {
+		return err
+	}
+	ctx, cancel, err := newContext(c)
+	defer cancel()
+	if err != nil {
+		return commoncli.Problem("Error creating context: ", err)
+	}
+	frontendClient, err := getDeps(c).ServerFrontendClient(c)
+	if err != nil {
+		return err
+	}
+	err = frontendClient.ResetActivityAttempt(ctx, &types.ResetActivityAttemptRequest{
+		Domain:            domain,
+		WorkflowExecution: wfExecution,
+		ActivityID:        activityID,
+		Identity:          getCliIdentity(),
+	})
+	if err != nil {
+		return commoncli.Problem("Reset activity attempt failed.", err)
+	}
+	fmt.Println("Reset activity attempt succeeded.")
+	return nil
+}
+
+// RetryActivityNow dispatches the next attempt of a pending activity now
+func RetryActivityNow(c *cli.Context) error {
+	domain, wfExecution, activityID, err := getActivityOperationArgs(c)
+	if err != nil {
+		return err
+	}
+	ctx, cancel, err := newContext(c)
+	defer cancel()
+	if err != nil {
+		return commoncli.Problem("Error creating context: ", err)
+	}
+	frontendClient, err := getDeps(c).ServerFrontendClient(c)
+	if err != nil {
+		return err
+	}
+	err = frontendClient.RetryActivityNow(ctx, &types.RetryActivityNowRequest{
+		Domain:            domain,
+		WorkflowExecution: wfExecution,
+		ActivityID:        activityID,
+		Identity:          getCliIdentity(),
+	})
+	if err != nil {
+		return commoncli.Problem("Retry activity failed.", err)
+	}
+	fmt.Println("Retry activity succeeded.")
+	return nil
+}
+
+func getActivityOperationArgs(c *cli.Context) (string, *types.WorkflowExecution, string, error) {
+	domain, err := getRequiredOption(c, FlagDomain)
+	if err != nil {
+		return "", nil, "", commoncli.Problem("Required flag not found: ", err)
+	}
+	wid, err := getRequiredOption(c, FlagWorkflowID)
+	if err != nil {
+		return "", nil, "", commoncli.Problem("Required flag not found: ", err)
+	}
+	activityID, err := getRequiredOption(c, FlagActivityID)
+	if err != nil {
+		return "", nil, "", commoncli.Problem("Required flag not found: ", err)
+	}
+	wfExecution := &types.WorkflowExecution{
+		WorkflowID: wid,
+		RunID:      c.String(FlagRunID),
+	}
+	return domain, wfExecution, activityID, nil
+}
+
 // ObserveHistoryWithID show the process of running workflow
 func ObserveHistoryWithID(c *cli.Context) error {
 	domain, err := getRequiredOption(c, FlagDomain)
@@ -2655,6 +2655,96 @@ func Test_CountWorkflow_Errors(t *testing.T) {
 	serverFrontendClient.EXPECT().CountWorkflowExecutions(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("test-error")).AnyTimes()
 	err = CountWorkflow(ctx)
 	assert.ErrorContains(t, err, "test-error")
+	ctx = clitest.NewCLIContext(t, app, clitest.StringArgument(FlagDomain, "test-domain"), clitest.StringArgument(FlagGroupBy, "WorkflowType"))
+	serverFrontendClient.EXPECT().AggregateWorkflowExecutions(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("test-aggregate-error")).Times(1)
+	err = CountWorkflow(ctx)
+	assert.ErrorContains(t, err, "test-aggregate-error")
+}
+
+func Test_CountWorkflow_SavedQuery(t *testing.T) {
+	tests := []struct {
+		name        string
+		setupMock   func(*frontend.MockClient)
+		args        []clitest.CliArgument
+		errContains string
+	}{
+		{
+			name: "saved query",
+			setupMock: func(client *frontend.MockClient) {
+				client.EXPECT().CountWorkflowExecutions(gomock.Any(), &types.CountWorkflowExecutionsRequest{
+					Domain: "test-domain",
+					Query:  "@failed",
+				}).Return(&types.CountWorkflowExecutionsResponse{Count: 2}, nil)
+			},
+			args: []clitest.CliArgument{
+				clitest.StringArgument(FlagDomain, "test-domain"),
+				clitest.StringArgument(FlagSavedQuery, "failed"),
+			},
+		},
+		{
+			name: "saved query grouped",
+			setupMock: func(client *frontend.MockClient) {
+				client.EXPECT().AggregateWorkflowExecutions(gomock.Any(), &types.AggregateWorkflowExecutionsRequest{
+					Domain:  "test-domain",
+					Query:   "@failed",
+					GroupBy: "WorkflowType",
+				}).Return(&types.AggregateWorkflowExecutionsResponse{}, nil)
+			},
+			args: []clitest.CliArgument{
+				clitest.StringArgument(FlagDomain, "test-domain"),
+				clitest.StringArgument(FlagSavedQuery, "failed"),
+				clitest.StringArgument(FlagGroupBy, "WorkflowType"),
+			},
+		},
+		{
+			name:      "saved query and query",
+			setupMock: func(_ *frontend.MockClient) {},
+			args: []clitest.CliArgument{
+				clitest.StringArgument(FlagDomain, "test-domain"),
+				clitest.StringArgument(FlagSavedQuery, "failed"),
+				clitest.StringArgument(FlagListQuery, "CloseStatus = 2"),
+			},
+			errContains: optionErr,
+		},
+		{
+			name:      "invalid saved query name",
+			setupMock: func(_ *frontend.MockClient) {},
+			args: []clitest.CliArgument{
+				clitest.StringArgument(FlagDomain, "test-domain"),
+				clitest.StringArgument(FlagSavedQuery, "1failed"),
+			},
+			errContains: "has to contain alphanumeric",
+		},
+		{
+			name: "saved query not found",
+			setupMock: func(client *frontend.MockClient) {
+				client.EXPECT().CountWorkflowExecutions(gomock.Any(), gomock.Any()).Return(nil, &types.BadRequestError{Message: "Saved query unknown not found in domain test-domain."})
+			},
+			args: []clitest.CliArgument{
+				clitest.StringArgument(FlagDomain, "test-domain"),
+				clitest.StringArgument(FlagSavedQuery, "unknown"),
+			},
+			errContains: "Saved query unknown not found in domain test-domain",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			serverFrontendClient := frontend.NewMockClient(mockCtrl)
+			app := NewCliApp(&clientFactoryMock{
+				serverFrontendClient: serverFrontendClient,
+			})
+			tt.setupMock(serverFrontendClient)
+			ctx := clitest.NewCLIContext(t, app, tt.args...)
+			err := CountWorkflow(ctx)
+			if tt.errContains == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.errContains)
+			}
+		})
+	}
 }
 
 func Test_DescribeWorkflow_Errors(t *testing.T) {